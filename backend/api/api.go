@@ -15,6 +15,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/api/handler/coze/loop/apis"
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -33,6 +34,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/lofile"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/louser"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/llm/loruntime"
+	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/observability/lotrace"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/prompt/loexecute"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/prompt/lomanage"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
@@ -54,13 +56,14 @@ func Init(
 	limiterFactory limiter.IRateLimiterFactory,
 	ckDB ck.Provider,
 	translater i18n.ITranslater,
+	kms dkms.IDKMS,
 ) (*apis.APIHandler, error) {
 	foundationHandler, err := apis.InitFoundationHandler(idgen, db, batchObjectStorage, configFactory)
 	if err != nil {
 		return nil, err
 	}
 
-	llmHandler, err := apis.InitLLMHandler(ctx, idgen, db, cmdable, configFactory, limiterFactory, loauth.NewLocalAuthService(foundationHandler.AuthService))
+	llmHandler, err := apis.InitLLMHandler(ctx, idgen, db, cmdable, configFactory, limiterFactory, loauth.NewLocalAuthService(foundationHandler.AuthService), kms)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +88,8 @@ func Init(
 		return nil, err
 	}
 
+	// 评测模块的 trace 回放依赖观测模块，而观测模块又依赖评测模块，先注入占位实现，观测模块初始化后再绑定
+	evalTraceService := &deferredTraceService{}
 	evaluationHandler, err := apis.InitEvaluationHandler(
 		ctx, idgen, db, ckDB, cmdable, configFactory, mqFactory,
 		lodataset.NewLocalDatasetService(dataHandler.IDatasetApplication, validator.KiteXValidatorMW),
@@ -100,12 +105,14 @@ func Init(
 		lofile.NewLocalFileService(foundationHandler.FileService),
 		lotag.NewLocalTagService(dataHandler.TagService),
 		objectStorage,
+		lotrace.NewLocalTraceService(evalTraceService),
+		kms,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	observabilityHandler, err := apis.InitObservabilityHandler(ctx, db, ckDB, meter, mqFactory, configFactory, idgen,
+	observabilityHandler, err := apis.InitObservabilityHandler(ctx, db, ckDB, cmdable, meter, mqFactory, configFactory, idgen,
 		benefitSvc,
 		lofile.NewLocalFileService(foundationHandler.FileService),
 		loauth.NewLocalAuthService(foundationHandler.AuthService),
@@ -119,6 +126,7 @@ func Init(
 	if err != nil {
 		return nil, err
 	}
+	evalTraceService.Bind(observabilityHandler.ITraceApplication)
 	observabilityHandler.RunAsync(ctx)
 
 	return &apis.APIHandler{
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by hertz generator.
+
+package apis
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/apis/evaluationopenapiservice"
+)
+
+var evaluationOpenAPIClient evaluationopenapiservice.Client
+
+// SubmitExperimentOApi .
+// @router /v1/loop/evaluation/experiments [POST]
+func SubmitExperimentOApi(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, evaluationOpenAPIClient.SubmitExperimentOApi)
+}
+
+// GetExperimentOApi .
+// @router /v1/loop/evaluation/experiments/:expt_id [GET]
+func GetExperimentOApi(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, evaluationOpenAPIClient.GetExperimentOApi)
+}
+
+// GetExperimentAggrResultOApi .
+// @router /v1/loop/evaluation/experiments/:expt_id/aggr_results [GET]
+func GetExperimentAggrResultOApi(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, evaluationOpenAPIClient.GetExperimentAggrResultOApi)
+}
+
+// ListExperimentResultOApi .
+// @router /v1/loop/evaluation/experiments/:expt_id/results/list [POST]
+func ListExperimentResultOApi(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, evaluationOpenAPIClient.ListExperimentResultOApi)
+}
+
+// BatchCreateEvaluationSetItemsOApi .
+// @router /v1/loop/evaluation/evaluation_sets/:evaluation_set_id/items/batch_create [POST]
+func BatchCreateEvaluationSetItemsOApi(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, evaluationOpenAPIClient.BatchCreateEvaluationSetItemsOApi)
+}
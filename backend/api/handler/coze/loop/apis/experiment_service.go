@@ -59,6 +59,18 @@ func KillExperiment(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, localExptSvc.KillExperiment)
 }
 
+// PauseExperiment .
+// @router /api/evaluation/v1/experiments/:expt_id/pause [POST]
+func PauseExperiment(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.PauseExperiment)
+}
+
+// ResumeExperiment .
+// @router /api/evaluation/v1/experiments/:expt_id/resume [POST]
+func ResumeExperiment(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.ResumeExperiment)
+}
+
 // BatchGetExperiments .
 // @router /api/evaluation/v2/experiments/batch_get [POST]
 func BatchGetExperiments(ctx context.Context, c *app.RequestContext) {
@@ -89,6 +101,12 @@ func BatchGetExperimentAggrResult(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, localExptSvc.BatchGetExperimentAggrResult_)
 }
 
+// CompareExperiments .
+// @router /api/evaluation/v1/experiments/:expt_id/compare [POST]
+func CompareExperiments(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.CompareExperiments)
+}
+
 // InvokeExperiment .
 // @router /api/evaluation/v3/experiments/:experiment_id/invoke [POST]
 func InvokeExperiment(ctx context.Context, c *app.RequestContext) {
@@ -244,3 +262,33 @@ func GetExptResultExportRecord(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// CreateExptSchedule .
+// @router /api/evaluation/v1/expt_schedules [POST]
+func CreateExptSchedule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.CreateExptSchedule)
+}
+
+// UpdateExptSchedule .
+// @router /api/evaluation/v1/expt_schedules/:schedule_id [PATCH]
+func UpdateExptSchedule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.UpdateExptSchedule)
+}
+
+// DeleteExptSchedule .
+// @router /api/evaluation/v1/expt_schedules/:schedule_id [DELETE]
+func DeleteExptSchedule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.DeleteExptSchedule)
+}
+
+// ListExptSchedules .
+// @router /api/evaluation/v1/expt_schedules/list [POST]
+func ListExptSchedules(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.ListExptSchedules)
+}
+
+// ListExptScheduleRuns .
+// @router /api/evaluation/v1/expt_schedules/:schedule_id/runs/list [POST]
+func ListExptScheduleRuns(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.ListExptScheduleRuns)
+}
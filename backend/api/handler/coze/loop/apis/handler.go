@@ -25,6 +25,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/eval_target"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluator"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	evalopenapi "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/auth"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/authn"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/file"
@@ -32,6 +33,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/space"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/user"
 	llmmanage "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
+	llmopenapi "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime"
 	traceopenapi "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/trace"
@@ -45,12 +47,14 @@ import (
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/evaluation/loeval_target"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/evaluation/loevaluator"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/evaluation/loexpt"
+	evalloopenapi "github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/evaluation/loopenapi"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/loauthn"
 	foundationlofile "github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/lofile"
 	foundationloopenapi "github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/loopenapi"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/lospace"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/louser"
 	lollmmanage "github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/llm/lomanage"
+	lollmopenapi "github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/llm/loopenapi"
 	looptraceopenapi "github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/observability/loopenapi"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/observability/lotrace"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/prompt/lodebug"
@@ -82,6 +86,7 @@ type EvaluationHandler struct {
 	evaluation.EvaluatorService
 	evaluation.EvaluationSetService
 	evaluation.EvalTargetService
+	evaluation.EvaluationOpenAPIService
 }
 
 type FoundationHandler struct {
@@ -122,17 +127,20 @@ func NewEvaluationHandler(
 	evaluatorApp evaluation.EvaluatorService,
 	evaluationSetApp evaluation.EvaluationSetService,
 	evalTargetService evaluation.EvalTargetService,
+	evaluationOpenAPIApp evaluation.EvaluationOpenAPIService,
 ) *EvaluationHandler {
 	h := &EvaluationHandler{
-		EvaluatorService:       evaluatorApp,
-		IExperimentApplication: exptApp,
-		EvaluationSetService:   evaluationSetApp,
-		EvalTargetService:      evalTargetService,
+		EvaluatorService:         evaluatorApp,
+		IExperimentApplication:   exptApp,
+		EvaluationSetService:     evaluationSetApp,
+		EvalTargetService:        evalTargetService,
+		EvaluationOpenAPIService: evaluationOpenAPIApp,
 	}
 	bindLocalCallClient(expt.ExperimentService(h), &localExptSvc, loexpt.NewLocalExperimentService)
 	bindLocalCallClient(evaluator.EvaluatorService(h), &localEvaluatorSvc, loevaluator.NewLocalEvaluatorService)
 	bindLocalCallClient(eval_set.EvaluationSetService(h), &localEvalSetSvc, loeval_set.NewLocalEvaluationSetService)
 	bindLocalCallClient(eval_target.EvalTargetService(h), &localEvalTargetSvc, loeval_target.NewLocalEvalTargetService)
+	bindLocalCallClient(evalopenapi.EvaluationOpenAPIService(h), &evaluationOpenAPIClient, evalloopenapi.NewLocalEvaluationOpenAPIService)
 	return h
 }
 
@@ -176,17 +184,21 @@ func NewPromptHandler(
 type LLMHandler struct {
 	llmmanage.LLMManageService
 	runtime.LLMRuntimeService
+	llmopenapi.LLMOpenAPIService
 }
 
 func NewLLMHandler(
 	manageApp llmmanage.LLMManageService,
 	runtimeApp runtime.LLMRuntimeService,
+	openAPIApp llmopenapi.LLMOpenAPIService,
 ) *LLMHandler {
 	h := &LLMHandler{
 		LLMManageService:  manageApp,
 		LLMRuntimeService: runtimeApp,
+		LLMOpenAPIService: openAPIApp,
 	}
 	bindLocalCallClient(llmmanage.LLMManageService(h), &llmManageSvc, lollmmanage.NewLocalLLMManageService)
+	bindLocalCallClient(llmopenapi.LLMOpenAPIService(h), &llmOpenAPISvc, lollmopenapi.NewLocalLLMOpenAPIService)
 	return h
 }
 
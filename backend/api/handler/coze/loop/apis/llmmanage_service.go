@@ -15,6 +15,18 @@ import (
 
 var llmManageSvc llmmanageservice.Client
 
+// CreateModel .
+// @router /api/llm/v1/models [POST]
+func CreateModel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.CreateModel)
+}
+
+// DeleteModel .
+// @router /api/llm/v1/models/:model_id [DELETE]
+func DeleteModel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.DeleteModel)
+}
+
 // GetModel .
 // @router /api/llm/v1/model/:model_id [POST]
 func GetModel(ctx context.Context, c *app.RequestContext) {
@@ -26,3 +38,21 @@ func GetModel(ctx context.Context, c *app.RequestContext) {
 func ListModels(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, llmManageSvc.ListModels)
 }
+
+// UpdateModel .
+// @router /api/llm/v1/models/:model_id [PUT]
+func UpdateModel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.UpdateModel)
+}
+
+// QueryModelUsage .
+// @router /api/llm/v1/usage/query [POST]
+func QueryModelUsage(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.QueryModelUsage)
+}
+
+// GetQuotaStatus .
+// @router /api/llm/v1/quotas/status [POST]
+func GetQuotaStatus(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.GetQuotaStatus)
+}
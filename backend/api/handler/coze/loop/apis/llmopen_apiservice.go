@@ -0,0 +1,102 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by hertz generator.
+
+package apis
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/hertz-contrib/sse"
+
+	middleware "github.com/coze-dev/coze-loop/backend/infra/middleware/errors"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi/llmopenapiservice"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	oai "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/openai"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+var llmOpenAPISvc llmopenapiservice.Client
+
+// ChatCompletions .
+// @router /v1/loop/llm/openai/:workspace_id/chat/completions [POST]
+func ChatCompletions(ctx context.Context, c *app.RequestContext) {
+	workspaceID, err := strconv.ParseInt(c.Param("workspace_id"), 10, 64)
+	if err != nil {
+		renderOpenAIError(ctx, c, kerrors.NewBizStatusError(llm_errorx.CommonBadRequestCode, "invalid workspace_id"))
+		return
+	}
+	req := &openapi.ChatCompletionsRequest{
+		Body:        c.Request.Body(),
+		WorkspaceID: workspaceID,
+	}
+	// 与 OpenAI 一致，由请求体中的 stream 字段决定是否流式返回
+	var isStream bool
+	if node, err := sonic.Get(req.Body, "stream"); err == nil {
+		isStream, _ = node.Bool()
+	}
+	if !isStream {
+		resp, err := llmOpenAPISvc.ChatCompletions(ctx, req)
+		if err != nil {
+			renderOpenAIError(ctx, c, err)
+			return
+		}
+		c.Data(http.StatusOK, "application/json", resp.GetBody())
+		return
+	}
+
+	stream, err := llmOpenAPISvc.ChatCompletionsStream(ctx, req)
+	if err != nil {
+		renderOpenAIError(ctx, c, err)
+		return
+	}
+	// 首个分片返回前的错误以 HTTP 状态码返回，便于 SDK 识别
+	resp, err := stream.Recv(ctx)
+	if err != nil && err != io.EOF {
+		renderOpenAIError(ctx, c, err)
+		return
+	}
+	c.SetStatusCode(http.StatusOK)
+	s := sse.NewStream(c)
+	for ; err == nil; resp, err = stream.Recv(ctx) {
+		if err = s.Publish(&sse.Event{Data: resp.GetChunk()}); err != nil {
+			logs.CtxError(ctx, "publish event error: %s", err.Error())
+			return
+		}
+	}
+	if err != io.EOF {
+		_, errResp := openAIErrorResponse(err)
+		data, _ := json.Marshal(errResp)
+		if publishErr := s.Publish(&sse.Event{Data: data}); publishErr != nil {
+			logs.CtxError(ctx, "publish event error: %s", publishErr.Error())
+		}
+		return
+	}
+	if err = s.Publish(&sse.Event{Data: []byte(oai.StreamDone)}); err != nil {
+		logs.CtxError(ctx, "publish event error: %s", err.Error())
+	}
+}
+
+func renderOpenAIError(ctx context.Context, c *app.RequestContext, err error) {
+	status, errResp := openAIErrorResponse(err)
+	if status == http.StatusInternalServerError {
+		logs.CtxError(ctx, "chat completions failed, err=%v", err)
+	}
+	c.JSON(status, errResp)
+}
+
+func openAIErrorResponse(err error) (int, *oai.ErrorResponse) {
+	if statusErr, ok := kerrors.FromBizStatusError(err); ok {
+		return oai.NewErrorResponse(statusErr.BizStatusCode(), statusErr.BizMessage())
+	}
+	return oai.NewErrorResponse(middleware.ServiceInternalErrorCode, middleware.DefaultErrorMsg)
+}
@@ -65,6 +65,30 @@ func ListViews(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, observabilityClient.ListViews)
 }
 
+// CreateOnlineEvalRule .
+// @router /api/observability/v1/online_eval_rules [POST]
+func CreateOnlineEvalRule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.CreateOnlineEvalRule)
+}
+
+// UpdateOnlineEvalRule .
+// @router /api/observability/v1/online_eval_rules/:rule_id [PUT]
+func UpdateOnlineEvalRule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.UpdateOnlineEvalRule)
+}
+
+// DeleteOnlineEvalRule .
+// @router /api/observability/v1/online_eval_rules/:rule_id [DELETE]
+func DeleteOnlineEvalRule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.DeleteOnlineEvalRule)
+}
+
+// ListOnlineEvalRules .
+// @router /api/observability/v1/online_eval_rules/list [POST]
+func ListOnlineEvalRules(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.ListOnlineEvalRules)
+}
+
 // CreateManualAnnotation .
 // @router /api/observability/v1/annotations [POST]
 func CreateManualAnnotation(ctx context.Context, c *app.RequestContext) {
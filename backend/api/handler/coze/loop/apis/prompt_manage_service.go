@@ -74,3 +74,39 @@ func CommitDraft(ctx context.Context, c *app.RequestContext) {
 func RevertDraftFromCommit(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, promptManageSvc.RevertDraftFromCommit)
 }
+
+// SetPromptLabel .
+// @router /api/prompt/v1/prompts/:prompt_id/labels/set [POST]
+func SetPromptLabel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, promptManageSvc.SetPromptLabel)
+}
+
+// ListPromptLabel .
+// @router /api/prompt/v1/prompts/:prompt_id/labels/list [POST]
+func ListPromptLabel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, promptManageSvc.ListPromptLabel)
+}
+
+// ListPromptLabelHistory .
+// @router /api/prompt/v1/prompts/:prompt_id/labels/history/list [POST]
+func ListPromptLabelHistory(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, promptManageSvc.ListPromptLabelHistory)
+}
+
+// RollbackPromptLabel .
+// @router /api/prompt/v1/prompts/:prompt_id/labels/rollback [POST]
+func RollbackPromptLabel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, promptManageSvc.RollbackPromptLabel)
+}
+
+// DiffCommit .
+// @router /api/prompt/v1/prompts/:prompt_id/commits/diff [POST]
+func DiffCommit(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, promptManageSvc.DiffCommit)
+}
+
+// ListSnippetReference .
+// @router /api/prompt/v1/prompts/:prompt_id/snippet_references/list [POST]
+func ListSnippetReference(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, promptManageSvc.ListSnippetReference)
+}
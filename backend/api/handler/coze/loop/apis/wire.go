@@ -14,6 +14,7 @@ import (
 
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -31,8 +32,10 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/file/fileservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/user/userservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime/llmruntimeservice"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/observabilitytraceservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/promptmanageservice"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/loauth"
+	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/llm/loruntime"
 	dataapp "github.com/coze-dev/coze-loop/backend/modules/data/application"
 	conf2 "github.com/coze-dev/coze-loop/backend/modules/data/infra/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/data/infra/rpc/foundation"
@@ -63,6 +66,10 @@ var (
 		NewLLMHandler,
 		llmapp.InitManageApplication,
 		llmapp.InitRuntimeApplication,
+		llmapp.InitOpenAPIApplication,
+		wire.Value([]endpoint.Middleware(nil)),
+		wire.Bind(new(llmruntimeservice.Client), new(*loruntime.LocalLLMRuntimeService)),
+		loruntime.NewLocalLLMRuntimeService,
 	)
 	promptSet = wire.NewSet(
 		NewPromptHandler,
@@ -79,6 +86,7 @@ var (
 		evaluationapp.InitEvaluatorApplication,
 		evaluationapp.InitEvaluationSetApplication,
 		evaluationapp.InitEvalTargetApplication,
+		evaluationapp.NewEvaluationOpenAPIApplication,
 	)
 	dataSet = wire.NewSet(
 		NewDataHandler,
@@ -136,6 +144,7 @@ func InitLLMHandler(
 	configFactory conf.IConfigLoaderFactory,
 	limiterFactory limiter.IRateLimiterFactory,
 	authClient authservice.Client,
+	kms dkms.IDKMS,
 ) (*LLMHandler, error) {
 	wire.Build(
 		llmSet,
@@ -164,6 +173,8 @@ func InitEvaluationHandler(
 	fileClient fileservice.Client,
 	tagClient tagservice.Client,
 	objectStorage fileserver.ObjectStorage,
+	traceClient observabilitytraceservice.Client,
+	kms dkms.IDKMS,
 ) (*EvaluationHandler, error) {
 	wire.Build(
 		evaluationSet,
@@ -194,6 +205,7 @@ func InitObservabilityHandler(
 	ctx context.Context,
 	db db.Provider,
 	ckDb ck.Provider,
+	cmdable redis.Cmdable,
 	meter metrics.Meter,
 	mqFactory mq.IFactory,
 	configFactory conf.IConfigLoaderFactory,
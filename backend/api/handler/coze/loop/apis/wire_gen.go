@@ -8,10 +8,10 @@ package apis
 
 import (
 	"context"
-
 	"github.com/cloudwego/kitex/pkg/endpoint"
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -29,8 +29,10 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/file/fileservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/user/userservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime/llmruntimeservice"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/observabilitytraceservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/promptmanageservice"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/loauth"
+	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/llm/loruntime"
 	application5 "github.com/coze-dev/coze-loop/backend/modules/data/application"
 	conf2 "github.com/coze-dev/coze-loop/backend/modules/data/infra/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/data/infra/rpc/foundation"
@@ -103,31 +105,45 @@ func InitPromptHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Pr
 	return promptHandler, nil
 }
 
-func InitLLMHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Provider, cmdable redis.Cmdable, configFactory conf.IConfigLoaderFactory, limiterFactory limiter.IRateLimiterFactory, authClient authservice.Client) (*LLMHandler, error) {
-	llmManageService, err := application3.InitManageApplication(ctx, configFactory, authClient)
+func InitLLMHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Provider, cmdable redis.Cmdable, configFactory conf.IConfigLoaderFactory, limiterFactory limiter.IRateLimiterFactory, authClient authservice.Client, kms dkms.IDKMS) (*LLMHandler, error) {
+	llmManageService, err := application3.InitManageApplication(ctx, idgen2, configFactory, db2, cmdable, authClient, kms)
 	if err != nil {
 		return nil, err
 	}
-	llmRuntimeService, err := application3.InitRuntimeApplication(ctx, idgen2, configFactory, db2, cmdable, limiterFactory)
+	llmRuntimeService, err := application3.InitRuntimeApplication(ctx, idgen2, configFactory, db2, cmdable, limiterFactory, kms)
 	if err != nil {
 		return nil, err
 	}
-	llmHandler := NewLLMHandler(llmManageService, llmRuntimeService)
+	v := _wireValue2
+	localLLMRuntimeService := loruntime.NewLocalLLMRuntimeService(llmRuntimeService, v...)
+	llmOpenAPIService, err := application3.InitOpenAPIApplication(ctx, idgen2, configFactory, db2, authClient, localLLMRuntimeService, kms)
+	if err != nil {
+		return nil, err
+	}
+	llmHandler := NewLLMHandler(llmManageService, llmRuntimeService, llmOpenAPIService)
 	return llmHandler, nil
 }
 
-func InitEvaluationHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Provider, ckDb ck.Provider, cmdable redis.Cmdable, configFactory conf.IConfigLoaderFactory, mqFactory mq.IFactory, client datasetservice.Client, promptClient promptmanageservice.Client, pec promptexecuteservice.Client, authClient authservice.Client, meter metrics.Meter, auditClient audit.IAuditService, llmClient llmruntimeservice.Client, userClient userservice.Client, benefitSvc benefit.IBenefitService, limiterFactory limiter.IRateLimiterFactory, fileClient fileservice.Client, tagClient tagservice.Client, objectStorage fileserver.ObjectStorage) (*EvaluationHandler, error) {
+var (
+	_wireValue2 = []endpoint.Middleware(nil)
+)
+
+func InitEvaluationHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Provider, ckDb ck.Provider, cmdable redis.Cmdable, configFactory conf.IConfigLoaderFactory, mqFactory mq.IFactory, client datasetservice.Client, promptClient promptmanageservice.Client, pec promptexecuteservice.Client, authClient authservice.Client, meter metrics.Meter, auditClient audit.IAuditService, llmClient llmruntimeservice.Client, userClient userservice.Client, benefitSvc benefit.IBenefitService, limiterFactory limiter.IRateLimiterFactory, fileClient fileservice.Client, tagClient tagservice.Client, objectStorage fileserver.ObjectStorage, traceClient observabilitytraceservice.Client, kms dkms.IDKMS) (*EvaluationHandler, error) {
 	evaluationSetService := application4.InitEvaluationSetApplication(client, authClient, meter, userClient)
 	evaluatorService, err := application4.InitEvaluatorApplication(ctx, idgen2, authClient, db2, configFactory, mqFactory, llmClient, meter, userClient, auditClient, cmdable, benefitSvc, limiterFactory, fileClient)
 	if err != nil {
 		return nil, err
 	}
-	evalTargetService := application4.InitEvalTargetApplication(ctx, idgen2, db2, promptClient, pec, authClient, cmdable, meter)
-	iExperimentApplication, err := application4.InitExperimentApplication(ctx, idgen2, db2, configFactory, mqFactory, cmdable, auditClient, meter, authClient, evaluationSetService, evaluatorService, evalTargetService, userClient, promptClient, pec, client, limiterFactory, llmClient, benefitSvc, ckDb, tagClient, objectStorage)
+	evalTargetService, err := application4.InitEvalTargetApplication(ctx, idgen2, db2, promptClient, pec, authClient, cmdable, configFactory, meter, traceClient, kms)
+	if err != nil {
+		return nil, err
+	}
+	iExperimentApplication, err := application4.InitExperimentApplication(ctx, idgen2, db2, configFactory, mqFactory, cmdable, auditClient, meter, authClient, evaluationSetService, evaluatorService, evalTargetService, userClient, promptClient, pec, client, limiterFactory, llmClient, benefitSvc, ckDb, tagClient, objectStorage, traceClient, kms)
 	if err != nil {
 		return nil, err
 	}
-	evaluationHandler := NewEvaluationHandler(iExperimentApplication, evaluatorService, evaluationSetService, evalTargetService)
+	evaluationOpenAPIService := application4.NewEvaluationOpenAPIApplication(iExperimentApplication, evaluationSetService)
+	evaluationHandler := NewEvaluationHandler(iExperimentApplication, evaluatorService, evaluationSetService, evalTargetService, evaluationOpenAPIService)
 	return evaluationHandler, nil
 }
 
@@ -149,7 +165,7 @@ func InitDataHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Prov
 	return dataHandler, nil
 }
 
-func InitObservabilityHandler(ctx context.Context, db2 db.Provider, ckDb ck.Provider, meter metrics.Meter, mqFactory mq.IFactory, configFactory conf.IConfigLoaderFactory, idgen2 idgen.IIDGenerator, benefit2 benefit.IBenefitService, fileClient fileservice.Client, authCli authservice.Client, userClient userservice.Client, evalClient evaluatorservice.Client, evalSetClient evaluationsetservice.Client, tagClient tagservice.Client, limiterFactory limiter.IRateLimiterFactory, datasetClient datasetservice.Client) (*ObservabilityHandler, error) {
+func InitObservabilityHandler(ctx context.Context, db2 db.Provider, ckDb ck.Provider, cmdable redis.Cmdable, meter metrics.Meter, mqFactory mq.IFactory, configFactory conf.IConfigLoaderFactory, idgen2 idgen.IIDGenerator, benefit2 benefit.IBenefitService, fileClient fileservice.Client, authCli authservice.Client, userClient userservice.Client, evalClient evaluatorservice.Client, evalSetClient evaluationsetservice.Client, tagClient tagservice.Client, limiterFactory limiter.IRateLimiterFactory, datasetClient datasetservice.Client) (*ObservabilityHandler, error) {
 	iTraceApplication, err := application6.InitTraceApplication(db2, ckDb, meter, mqFactory, configFactory, idgen2, fileClient, benefit2, authCli, userClient, evalClient, evalSetClient, tagClient, datasetClient)
 	if err != nil {
 		return nil, err
@@ -158,7 +174,7 @@ func InitObservabilityHandler(ctx context.Context, db2 db.Provider, ckDb ck.Prov
 	if err != nil {
 		return nil, err
 	}
-	iObservabilityOpenAPIApplication, err := application6.InitOpenAPIApplication(mqFactory, configFactory, fileClient, ckDb, benefit2, limiterFactory, authCli, meter)
+	iObservabilityOpenAPIApplication, err := application6.InitOpenAPIApplication(db2, idgen2, mqFactory, configFactory, fileClient, ckDb, benefit2, limiterFactory, authCli, meter, evalClient, cmdable)
 	if err != nil {
 		return nil, err
 	}
@@ -173,13 +189,13 @@ var (
 		NewFoundationHandler, application.InitAuthApplication, application.InitAuthNApplication, application.InitSpaceApplication, application.InitUserApplication, application.InitFileApplication, application.InitFoundationOpenAPIApplication, wire.Value([]endpoint.Middleware(nil)), wire.Bind(new(authservice.Client), new(*loauth.LocalAuthService)), loauth.NewLocalAuthService,
 	)
 	llmSet = wire.NewSet(
-		NewLLMHandler, application3.InitManageApplication, application3.InitRuntimeApplication,
+		NewLLMHandler, application3.InitManageApplication, application3.InitRuntimeApplication, application3.InitOpenAPIApplication, wire.Value([]endpoint.Middleware(nil)), wire.Bind(new(llmruntimeservice.Client), new(*loruntime.LocalLLMRuntimeService)), loruntime.NewLocalLLMRuntimeService,
 	)
 	promptSet = wire.NewSet(
 		NewPromptHandler, application2.InitPromptManageApplication, application2.InitPromptDebugApplication, application2.InitPromptExecuteApplication, application2.InitPromptOpenAPIApplication,
 	)
 	evaluationSet = wire.NewSet(
-		NewEvaluationHandler, data.NewDatasetRPCAdapter, prompt.NewPromptRPCAdapter, application4.InitExperimentApplication, application4.InitEvaluatorApplication, application4.InitEvaluationSetApplication, application4.InitEvalTargetApplication,
+		NewEvaluationHandler, data.NewDatasetRPCAdapter, prompt.NewPromptRPCAdapter, application4.InitExperimentApplication, application4.InitEvaluatorApplication, application4.InitEvaluationSetApplication, application4.InitEvalTargetApplication, application4.NewEvaluationOpenAPIApplication,
 	)
 	dataSet = wire.NewSet(
 		NewDataHandler, application5.InitDatasetApplication, application5.InitTagApplication, foundation.NewAuthRPCProvider, conf2.NewConfigerFactory,
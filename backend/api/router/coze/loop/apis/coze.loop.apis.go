@@ -153,6 +153,16 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 				_evaluator_id0.PATCH("/update_draft", append(_updateevaluatordraftMw(handler), apis.UpdateEvaluatorDraft)...)
 				_evaluators.POST("/get_template_info", append(_gettemplateinfoMw(handler), apis.GetTemplateInfo)...)
 				_evaluators.POST("/list_template", append(_listtemplatesMw(handler), apis.ListTemplates)...)
+				_v11.POST("/expt_schedules", append(_expt_schedulesMw(handler), apis.CreateExptSchedule)...)
+				_expt_schedules := _v11.Group("/expt_schedules", _expt_schedulesMw(handler)...)
+				_expt_schedules.DELETE("/:schedule_id", append(_schedule_idMw(handler), apis.DeleteExptSchedule)...)
+				_schedule_id := _expt_schedules.Group("/:schedule_id", _schedule_idMw(handler)...)
+				{
+					_runs := _schedule_id.Group("/runs", _runsMw(handler)...)
+					_runs.POST("/list", append(_listexptschedulerunsMw(handler), apis.ListExptScheduleRuns)...)
+				}
+				_expt_schedules.PATCH("/:schedule_id", append(_updateexptscheduleMw(handler), apis.UpdateExptSchedule)...)
+				_expt_schedules.POST("/list", append(_listexptschedulesMw(handler), apis.ListExptSchedules)...)
 				{
 					_eval_target_records := _v11.Group("/eval_target_records", _eval_target_recordsMw(handler)...)
 					_eval_target_records.POST("/batch_get", append(_batchgetevaltargetrecordsMw(handler), apis.BatchGetEvalTargetRecords)...)
@@ -193,8 +203,11 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_expt_id := _experiments.Group("/:expt_id", _expt_idMw(handler)...)
 					_expt_id.POST("/associate_tag", append(_associateannotationtagMw(handler), apis.AssociateAnnotationTag)...)
 					_expt_id.POST("/clone", append(_cloneexperimentMw(handler), apis.CloneExperiment)...)
+					_expt_id.POST("/compare", append(_compareexperimentsMw(handler), apis.CompareExperiments)...)
 					_expt_id.DELETE("/delete_tag", append(_deleteannotationtagMw(handler), apis.DeleteAnnotationTag)...)
 					_expt_id.POST("/kill", append(_killexperimentMw(handler), apis.KillExperiment)...)
+					_expt_id.POST("/pause", append(_pauseexperimentMw(handler), apis.PauseExperiment)...)
+					_expt_id.POST("/resume", append(_resumeexperimentMw(handler), apis.ResumeExperiment)...)
 					_expt_id.POST("/retry", append(_retryexperimentMw(handler), apis.RetryExperiment)...)
 					{
 						_annotate_record := _expt_id.Group("/annotate_record", _annotate_recordMw(handler)...)
@@ -252,11 +265,16 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 			_llm := _api.Group("/llm", _llmMw(handler)...)
 			{
 				_v13 := _llm.Group("/v1", _v13Mw(handler)...)
-				{
-					_models := _v13.Group("/models", _modelsMw(handler)...)
-					_models.POST("/list", append(_listmodelsMw(handler), apis.ListModels)...)
-					_models.POST("/:model_id", append(_getmodelMw(handler), apis.GetModel)...)
-				}
+				_v13.POST("/models", append(_modelsMw(handler), apis.CreateModel)...)
+				_models := _v13.Group("/models", _modelsMw(handler)...)
+				_models.DELETE("/:model_id", append(_deletemodelMw(handler), apis.DeleteModel)...)
+				_models.PUT("/:model_id", append(_updatemodelMw(handler), apis.UpdateModel)...)
+				_models.POST("/list", append(_listmodelsMw(handler), apis.ListModels)...)
+				_models.POST("/:model_id", append(_getmodelMw(handler), apis.GetModel)...)
+				_quotas := _v13.Group("/quotas", _quotasMw(handler)...)
+				_quotas.POST("/status", append(_getquotastatusMw(handler), apis.GetQuotaStatus)...)
+				_usage := _v13.Group("/usage", _usageMw(handler)...)
+				_usage.POST("/query", append(_querymodelusageMw(handler), apis.QueryModelUsage)...)
 			}
 		}
 		{
@@ -268,6 +286,11 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 				_annotations.DELETE("/:annotation_id", append(_deletemanualannotationMw(handler), apis.DeleteManualAnnotation)...)
 				_annotations.PUT("/:annotation_id", append(_updatemanualannotationMw(handler), apis.UpdateManualAnnotation)...)
 				_annotations.POST("/list", append(_listannotationsMw(handler), apis.ListAnnotations)...)
+				_v14.POST("/online_eval_rules", append(_online_eval_rulesMw(handler), apis.CreateOnlineEvalRule)...)
+				_online_eval_rules := _v14.Group("/online_eval_rules", _online_eval_rulesMw(handler)...)
+				_online_eval_rules.POST("/list", append(_listonlineevalrulesMw(handler), apis.ListOnlineEvalRules)...)
+				_online_eval_rules.DELETE("/:rule_id", append(_deleteonlineevalruleMw(handler), apis.DeleteOnlineEvalRule)...)
+				_online_eval_rules.PUT("/:rule_id", append(_updateonlineevalruleMw(handler), apis.UpdateOnlineEvalRule)...)
 				_v14.POST("/views", append(_viewsMw(handler), apis.CreateView)...)
 				_views := _v14.Group("/views", _viewsMw(handler)...)
 				_views.POST("/list", append(_listviewsMw(handler), apis.ListViews)...)
@@ -299,6 +322,7 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 				_prompt_id.POST("/debug_streaming", append(_debugstreamingMw(handler), apis.DebugStreaming)...)
 				{
 					_commits := _prompt_id.Group("/commits", _commitsMw(handler)...)
+					_commits.POST("/diff", append(_diffcommitMw(handler), apis.DiffCommit)...)
 					_commits.POST("/list", append(_listcommitMw(handler), apis.ListCommit)...)
 				}
 				{
@@ -316,6 +340,20 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_drafts.POST("/revert_from_commit", append(_revertdraftfromcommitMw(handler), apis.RevertDraftFromCommit)...)
 					_drafts.POST("/save", append(_savedraftMw(handler), apis.SaveDraft)...)
 				}
+				{
+					_labels := _prompt_id.Group("/labels", _labelsMw(handler)...)
+					_labels.POST("/list", append(_listpromptlabelMw(handler), apis.ListPromptLabel)...)
+					_labels.POST("/rollback", append(_rollbackpromptlabelMw(handler), apis.RollbackPromptLabel)...)
+					_labels.POST("/set", append(_setpromptlabelMw(handler), apis.SetPromptLabel)...)
+					{
+						_history := _labels.Group("/history", _historyMw(handler)...)
+						_history.POST("/list", append(_listpromptlabelhistoryMw(handler), apis.ListPromptLabelHistory)...)
+					}
+				}
+				{
+					_snippet_references := _prompt_id.Group("/snippet_references", _snippet_referencesMw(handler)...)
+					_snippet_references.POST("/list", append(_listsnippetreferenceMw(handler), apis.ListSnippetReference)...)
+				}
 				_prompts.GET("/:prompt_id", append(_getpromptMw(handler), apis.GetPrompt)...)
 				_prompts.PUT("/:prompt_id", append(_updatepromptMw(handler), apis.UpdatePrompt)...)
 				{
@@ -329,10 +367,45 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 		_v16 := root.Group("/v1", _v16Mw(handler)...)
 		{
 			_loop := _v16.Group("/loop", _loopMw(handler)...)
+			{
+				_evaluation0 := _loop.Group("/evaluation", _evaluation0Mw(handler)...)
+				{
+					_evaluation_sets0 := _evaluation0.Group("/evaluation_sets", _evaluation_sets0Mw(handler)...)
+					{
+						_evaluation_set_id0 := _evaluation_sets0.Group("/:evaluation_set_id", _evaluation_set_id0Mw(handler)...)
+						{
+							_items2 := _evaluation_set_id0.Group("/items", _items2Mw(handler)...)
+							_items2.POST("/batch_create", append(_batchcreateevaluationsetitemsoapiMw(handler), apis.BatchCreateEvaluationSetItemsOApi)...)
+						}
+					}
+				}
+				_evaluation0.POST("/experiments", append(_experiments0Mw(handler), apis.SubmitExperimentOApi)...)
+				_experiments0 := _evaluation0.Group("/experiments", _experiments0Mw(handler)...)
+				_experiments0.GET("/:expt_id", append(_expt_id0Mw(handler), apis.GetExperimentOApi)...)
+				_expt_id0 := _experiments0.Group("/:expt_id", _expt_id0Mw(handler)...)
+				_expt_id0.GET("/aggr_results", append(_getexperimentaggrresultoapiMw(handler), apis.GetExperimentAggrResultOApi)...)
+				{
+					_results1 := _expt_id0.Group("/results", _results1Mw(handler)...)
+					_results1.POST("/list", append(_listexperimentresultoapiMw(handler), apis.ListExperimentResultOApi)...)
+				}
+			}
 			{
 				_files := _loop.Group("/files", _filesMw(handler)...)
 				_files.POST("/upload", append(_uploadloopfileMw(handler), apis.UploadLoopFile)...)
 			}
+			{
+				_llm0 := _loop.Group("/llm", _llm0Mw(handler)...)
+				{
+					_openai := _llm0.Group("/openai", _openaiMw(handler)...)
+					{
+						_workspace_id := _openai.Group("/:workspace_id", _workspace_idMw(handler)...)
+						{
+							_chat := _workspace_id.Group("/chat", _chatMw(handler)...)
+							_chat.POST("/completions", append(_chatcompletionsMw(handler), apis.ChatCompletions)...)
+						}
+					}
+				}
+			}
 			{
 				_opentelemetry := _loop.Group("/opentelemetry", _opentelemetryMw(handler)...)
 				{
@@ -342,6 +415,8 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 			}
 			{
 				_prompts0 := _loop.Group("/prompts", _prompts0Mw(handler)...)
+				_prompts0.POST("/execute", append(_executeMw(handler), apis.Execute)...)
+				_prompts0.POST("/execute_streaming", append(_executestreamingMw(handler), apis.ExecuteStreaming)...)
 				_prompts0.POST("/mget", append(_batchgetpromptbypromptkeyMw(handler), apis.BatchGetPromptByPromptKey)...)
 			}
 			{
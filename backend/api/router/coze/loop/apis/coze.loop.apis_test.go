@@ -0,0 +1,124 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apis
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/api/handler/coze/loop/apis"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/authn"
+	duser "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/domain/user"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/user"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
+	"github.com/coze-dev/coze-loop/backend/modules/foundation/application/mocks"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+type fakeLLMOpenAPIService struct {
+	resp *openapi.ChatCompletionsResponse
+	err  error
+}
+
+func (f *fakeLLMOpenAPIService) ChatCompletions(ctx context.Context, req *openapi.ChatCompletionsRequest) (*openapi.ChatCompletionsResponse, error) {
+	return f.resp, f.err
+}
+
+func (f *fakeLLMOpenAPIService) ChatCompletionsStream(ctx context.Context, req *openapi.ChatCompletionsRequest, stream openapi.LLMOpenAPIService_ChatCompletionsStreamServer) error {
+	return f.err
+}
+
+func TestRegister_ChatCompletions(t *testing.T) {
+	const path = "/v1/loop/llm/openai/100/chat/completions"
+	completion := `{"id":"chatcmpl-1","object":"chat.completion","choices":[{"index":0,"message":{"role":"assistant","content":"hi"}}]}`
+
+	tests := []struct {
+		name       string
+		authHeader string
+		svc        *fakeLLMOpenAPIService
+		setupMocks func(as *mocks.MockAuthNService, us *mocks.MockUserService)
+		wantStatus int
+		wantBody   map[string]any
+	}{
+		{
+			name:       "success body is returned as is",
+			authHeader: "Bearer token",
+			svc:        &fakeLLMOpenAPIService{resp: &openapi.ChatCompletionsResponse{Body: []byte(completion)}},
+			setupMocks: func(as *mocks.MockAuthNService, us *mocks.MockUserService) {
+				as.EXPECT().VerifyToken(gomock.Any(), gomock.Any()).Return(&authn.VerifyTokenResponse{Valid: gptr.Of(true), UserID: gptr.Of("1")}, nil)
+				us.EXPECT().GetUserInfo(gomock.Any(), gomock.Any()).Return(&user.GetUserInfoResponse{UserInfo: &duser.UserInfoDetail{UserID: gptr.Of("1")}}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: map[string]any{
+				"id":     "chatcmpl-1",
+				"object": "chat.completion",
+				"choices": []any{
+					map[string]any{"index": float64(0), "message": map[string]any{"role": "assistant", "content": "hi"}},
+				},
+			},
+		},
+		{
+			name:       "service error in openai format",
+			authHeader: "Bearer token",
+			svc:        &fakeLLMOpenAPIService{err: kerrors.NewBizStatusError(llm_errorx.ModelTPMLimitCode, "tpm limit")},
+			setupMocks: func(as *mocks.MockAuthNService, us *mocks.MockUserService) {
+				as.EXPECT().VerifyToken(gomock.Any(), gomock.Any()).Return(&authn.VerifyTokenResponse{Valid: gptr.Of(true), UserID: gptr.Of("1")}, nil)
+				us.EXPECT().GetUserInfo(gomock.Any(), gomock.Any()).Return(&user.GetUserInfoResponse{UserInfo: &duser.UserInfoDetail{UserID: gptr.Of("1")}}, nil)
+			},
+			wantStatus: http.StatusTooManyRequests,
+			wantBody: map[string]any{
+				"error": map[string]any{"message": "tpm limit", "type": "rate_limit_error", "code": strconv.Itoa(llm_errorx.ModelTPMLimitCode)},
+			},
+		},
+		{
+			name:       "token verify error in openai format",
+			authHeader: "Bearer token",
+			svc:        &fakeLLMOpenAPIService{},
+			setupMocks: func(as *mocks.MockAuthNService, us *mocks.MockUserService) {
+				as.EXPECT().VerifyToken(gomock.Any(), gomock.Any()).Return(nil, kerrors.NewBizStatusError(llm_errorx.CommonNoPermissionCode, "no permission"))
+			},
+			wantStatus: http.StatusForbidden,
+			wantBody: map[string]any{
+				"error": map[string]any{"message": "no permission", "type": "permission_error", "code": strconv.Itoa(llm_errorx.CommonNoPermissionCode)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			as := mocks.NewMockAuthNService(ctrl)
+			us := mocks.NewMockUserService(ctrl)
+			tt.setupMocks(as, us)
+
+			h := server.New()
+			Register(h, &apis.APIHandler{
+				LLMHandler:        apis.NewLLMHandler(nil, nil, tt.svc),
+				FoundationHandler: apis.NewFoundationHandler(nil, as, nil, us, nil, nil),
+			})
+
+			w := ut.PerformRequest(h.Engine, http.MethodPost, path,
+				&ut.Body{Body: bytes.NewBufferString(`{"model":"1","messages":[{"role":"user","content":"hi"}]}`), Len: -1},
+				ut.Header{Key: "Authorization", Value: tt.authHeader},
+				ut.Header{Key: "Content-Type", Value: "application/json"})
+			resp := w.Result()
+			assert.Equal(t, tt.wantStatus, resp.StatusCode())
+			got := map[string]any{}
+			assert.Nil(t, json.Unmarshal(resp.Body(), &got))
+			assert.Equal(t, tt.wantBody, got)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/coze-dev/coze-loop/backend/infra/i18n"
 	"github.com/coze-dev/coze-loop/backend/modules/foundation/pkg/errno"
+	oai "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/openai"
 	"github.com/coze-dev/coze-loop/backend/pkg/consts"
 	"github.com/coze-dev/coze-loop/backend/pkg/json"
 	"github.com/coze-dev/coze-loop/backend/pkg/logs"
@@ -35,10 +36,22 @@ const (
 	affectStableValue            = "1"
 )
 
+// rawRespRoutes 响应体需保持原样的路由（如兼容 OpenAI 的接口），不注入 code/msg，错误也按该协议的格式返回
+var rawRespRoutes = map[string]bool{
+	"/v1/loop/llm/openai/:workspace_id/chat/completions": true,
+}
+
 func PacketAdapterMW(translater i18n.ITranslater) app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
 		c.Next(ctx)
 
+		if rawRespRoutes[c.FullPath()] {
+			if ep := parseErrPacket(ctx, c); ep != nil {
+				c.JSON(oai.NewErrorResponse(ep.Code, ep.Message))
+			}
+			return
+		}
+
 		if ep := parseErrPacket(ctx, c); ep != nil {
 			c.JSON(http.StatusOK, ep.localizeMessage(ctx, string(c.Cookie(consts.CookieLanguageKey)), translater))
 			return
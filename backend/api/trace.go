@@ -0,0 +1,184 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/trace"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// deferredTraceService 评测模块与观测模块互相依赖，评测模块先持有该占位实现，观测模块初始化后通过 Bind 绑定。
+// 绑定前的调用返回错误而不是空指针 panic
+type deferredTraceService struct {
+	svc atomic.Pointer[trace.TraceService]
+}
+
+var _ trace.TraceService = (*deferredTraceService)(nil)
+
+func (d *deferredTraceService) Bind(svc trace.TraceService) {
+	d.svc.Store(&svc)
+}
+
+func (d *deferredTraceService) get() (trace.TraceService, error) {
+	svc := d.svc.Load()
+	if svc == nil || *svc == nil {
+		return nil, errorx.New("trace service is not initialized")
+	}
+	return *svc, nil
+}
+
+func (d *deferredTraceService) ListSpans(ctx context.Context, req *trace.ListSpansRequest) (r *trace.ListSpansResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.ListSpans(ctx, req)
+}
+
+func (d *deferredTraceService) GetTrace(ctx context.Context, req *trace.GetTraceRequest) (r *trace.GetTraceResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.GetTrace(ctx, req)
+}
+
+func (d *deferredTraceService) BatchGetTracesAdvanceInfo(ctx context.Context, req *trace.BatchGetTracesAdvanceInfoRequest) (r *trace.BatchGetTracesAdvanceInfoResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.BatchGetTracesAdvanceInfo(ctx, req)
+}
+
+func (d *deferredTraceService) IngestTracesInner(ctx context.Context, req *trace.IngestTracesRequest) (r *trace.IngestTracesResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.IngestTracesInner(ctx, req)
+}
+
+func (d *deferredTraceService) GetTracesMetaInfo(ctx context.Context, req *trace.GetTracesMetaInfoRequest) (r *trace.GetTracesMetaInfoResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.GetTracesMetaInfo(ctx, req)
+}
+
+func (d *deferredTraceService) CreateView(ctx context.Context, req *trace.CreateViewRequest) (r *trace.CreateViewResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.CreateView(ctx, req)
+}
+
+func (d *deferredTraceService) UpdateView(ctx context.Context, req *trace.UpdateViewRequest) (r *trace.UpdateViewResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.UpdateView(ctx, req)
+}
+
+func (d *deferredTraceService) DeleteView(ctx context.Context, req *trace.DeleteViewRequest) (r *trace.DeleteViewResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.DeleteView(ctx, req)
+}
+
+func (d *deferredTraceService) ListViews(ctx context.Context, req *trace.ListViewsRequest) (r *trace.ListViewsResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.ListViews(ctx, req)
+}
+
+func (d *deferredTraceService) CreateOnlineEvalRule(ctx context.Context, req *trace.CreateOnlineEvalRuleRequest) (r *trace.CreateOnlineEvalRuleResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.CreateOnlineEvalRule(ctx, req)
+}
+
+func (d *deferredTraceService) UpdateOnlineEvalRule(ctx context.Context, req *trace.UpdateOnlineEvalRuleRequest) (r *trace.UpdateOnlineEvalRuleResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.UpdateOnlineEvalRule(ctx, req)
+}
+
+func (d *deferredTraceService) DeleteOnlineEvalRule(ctx context.Context, req *trace.DeleteOnlineEvalRuleRequest) (r *trace.DeleteOnlineEvalRuleResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.DeleteOnlineEvalRule(ctx, req)
+}
+
+func (d *deferredTraceService) ListOnlineEvalRules(ctx context.Context, req *trace.ListOnlineEvalRulesRequest) (r *trace.ListOnlineEvalRulesResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.ListOnlineEvalRules(ctx, req)
+}
+
+func (d *deferredTraceService) CreateManualAnnotation(ctx context.Context, req *trace.CreateManualAnnotationRequest) (r *trace.CreateManualAnnotationResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.CreateManualAnnotation(ctx, req)
+}
+
+func (d *deferredTraceService) UpdateManualAnnotation(ctx context.Context, req *trace.UpdateManualAnnotationRequest) (r *trace.UpdateManualAnnotationResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.UpdateManualAnnotation(ctx, req)
+}
+
+func (d *deferredTraceService) DeleteManualAnnotation(ctx context.Context, req *trace.DeleteManualAnnotationRequest) (r *trace.DeleteManualAnnotationResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.DeleteManualAnnotation(ctx, req)
+}
+
+func (d *deferredTraceService) ListAnnotations(ctx context.Context, req *trace.ListAnnotationsRequest) (r *trace.ListAnnotationsResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.ListAnnotations(ctx, req)
+}
+
+func (d *deferredTraceService) ExportTracesToDataset(ctx context.Context, req *trace.ExportTracesToDatasetRequest) (r *trace.ExportTracesToDatasetResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.ExportTracesToDataset(ctx, req)
+}
+
+func (d *deferredTraceService) PreviewExportTracesToDataset(ctx context.Context, req *trace.PreviewExportTracesToDatasetRequest) (r *trace.PreviewExportTracesToDatasetResponse, err error) {
+	svc, err := d.get()
+	if err != nil {
+		return nil, err
+	}
+	return svc.PreviewExportTracesToDataset(ctx, req)
+}
@@ -38,7 +38,7 @@ func mustInitConsumerWorkers(
 	if err != nil {
 		panic(err)
 	}
-	workers, err = obconsumer.NewConsumerWorkers(loader, obApplication)
+	workers, err = obconsumer.NewConsumerWorkers(loader, obApplication, obApplication)
 	if err != nil {
 		panic(err)
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/api/handler/coze/loop/apis"
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -39,6 +40,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/redis"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/lofile"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/observability/lotrace"
+	llmapp "github.com/coze-dev/coze-loop/backend/modules/llm/application"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf/viper"
 	"github.com/coze-dev/coze-loop/backend/pkg/file"
@@ -52,7 +54,7 @@ func main() {
 		panic(err)
 	}
 
-	handler, err := api.Init(ctx, c.idgen, c.db, c.redis, c.cfgFactory, c.mqFactory, c.objectStorage, c.batchObjectStorage, c.benefitSvc, c.auditClient, c.metric, c.limiterFactory, c.ckDb, c.translater)
+	handler, err := api.Init(ctx, c.idgen, c.db, c.redis, c.cfgFactory, c.mqFactory, c.objectStorage, c.batchObjectStorage, c.benefitSvc, c.auditClient, c.metric, c.limiterFactory, c.ckDb, c.translater, c.kms)
 	if err != nil {
 		panic(err)
 	}
@@ -65,6 +67,8 @@ func main() {
 		panic(err)
 	}
 
+	handler.StartExptScheduleTrigger(ctx)
+
 	api.Start(handler)
 }
 
@@ -131,6 +135,7 @@ type component struct {
 	limiterFactory     limiter.IRateLimiterFactory
 	ckDb               ck.Provider
 	translater         i18n.ITranslater
+	kms                dkms.IDKMS
 }
 
 func initTracer(handler *apis.APIHandler) error {
@@ -248,6 +253,16 @@ func newComponent(ctx context.Context) (*component, error) {
 		return nil, err
 	}
 
+	kms, err := dkms.NewAESDKMS(getDKMSMasterKey())
+	if err != nil {
+		return nil, err
+	}
+
+	quotaSrv, err := llmapp.InitQuotaService(ctx, cfgFactory, cmdable)
+	if err != nil {
+		return nil, err
+	}
+
 	return &component{
 		idgen:              idgenerator,
 		db:                 db,
@@ -256,12 +271,13 @@ func newComponent(ctx context.Context) (*component, error) {
 		mqFactory:          rocketmq.NewFactory(),
 		objectStorage:      objectStorage,
 		batchObjectStorage: objectStorage,
-		benefitSvc:         benefit.NewNoopBenefitService(),
+		benefitSvc:         benefit.NewLocalBenefitService(quotaSrv),
 		auditClient:        audit.NewNoopAuditService(),
 		metric:             metrics.GetMeter(),
 		limiterFactory:     dist.NewRateLimiterFactory(cmdable),
 		ckDb:               ckDb,
 		translater:         translater,
+		kms:                kms,
 	}, nil
 }
 
@@ -351,3 +367,12 @@ func getOssForcePathStyle() *bool {
 	}
 	return gptr.Of(false)
 }
+
+// getDKMSMasterKey 主密钥通过环境变量配置，未配置时仍可启动，但读写加密凭证会失败
+func getDKMSMasterKey() string {
+	key := os.Getenv("COZE_LOOP_DKMS_MASTER_KEY")
+	if key == "" {
+		logs.Warn("env COZE_LOOP_DKMS_MASTER_KEY is not set, encrypted credentials can not be read or written")
+	}
+	return key
+}
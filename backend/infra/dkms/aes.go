@@ -0,0 +1,76 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dkms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// ErrMasterKeyNotConfigured 未配置主密钥时，加解密操作返回该错误
+var ErrMasterKeyNotConfigured = errors.New("dkms master key is not configured, set env COZE_LOOP_DKMS_MASTER_KEY")
+
+// AESDKMS 基于 AES-GCM 的本地实现，数据密钥由主密钥与 dataKey 派生，适用于开源部署
+type AESDKMS struct {
+	masterKey []byte
+}
+
+// NewAESDKMS 允许主密钥为空，以免未使用加密凭证的部署无法启动；此时仅在加解密时报错
+func NewAESDKMS(masterKey string) (IDKMS, error) {
+	return &AESDKMS{masterKey: []byte(masterKey)}, nil
+}
+
+func (d *AESDKMS) Encrypt(ctx context.Context, dataKey, plaintext string) (string, error) {
+	gcm, err := d.newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.WithMessage(err, "generate nonce")
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (d *AESDKMS) Decrypt(ctx context.Context, dataKey, ciphertext string) (string, error) {
+	gcm, err := d.newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", errors.WithMessage(err, "decode ciphertext")
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("ciphertext is too short")
+	}
+	nonce, data := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", errors.WithMessage(err, "decrypt ciphertext")
+	}
+	return string(plaintext), nil
+}
+
+func (d *AESDKMS) newGCM(dataKey string) (cipher.AEAD, error) {
+	if len(d.masterKey) == 0 {
+		return nil, ErrMasterKeyNotConfigured
+	}
+	h := sha256.New()
+	h.Write(d.masterKey)
+	h.Write([]byte(dataKey))
+	block, err := aes.NewCipher(h.Sum(nil))
+	if err != nil {
+		return nil, errors.WithMessage(err, "new aes cipher")
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dkms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAESDKMS(t *testing.T) {
+	ctx := context.Background()
+
+	empty, err := NewAESDKMS("")
+	assert.NoError(t, err)
+	_, err = empty.Encrypt(ctx, "data-key", "sk-123456")
+	assert.ErrorIs(t, err, ErrMasterKeyNotConfigured)
+	_, err = empty.Decrypt(ctx, "data-key", "AAAA")
+	assert.ErrorIs(t, err, ErrMasterKeyNotConfigured)
+
+	kms, err := NewAESDKMS("master-key")
+	assert.NoError(t, err)
+
+	ciphertext, err := kms.Encrypt(ctx, "data-key", "sk-123456")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "sk-123456", ciphertext)
+
+	another, err := kms.Encrypt(ctx, "data-key", "sk-123456")
+	assert.NoError(t, err)
+	assert.NotEqual(t, ciphertext, another)
+
+	plaintext, err := kms.Decrypt(ctx, "data-key", ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "sk-123456", plaintext)
+
+	_, err = kms.Decrypt(ctx, "other-key", ciphertext)
+	assert.Error(t, err)
+
+	_, err = kms.Decrypt(ctx, "data-key", "not base64")
+	assert.Error(t, err)
+
+	_, err = kms.Decrypt(ctx, "data-key", "AAAA")
+	assert.Error(t, err)
+}
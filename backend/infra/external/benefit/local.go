@@ -0,0 +1,52 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package benefit
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// QuotaChecker 校验空间的模型调用额度，额度用完时返回错误，modelID 为0时只校验空间级额度
+type QuotaChecker interface {
+	CheckQuota(ctx context.Context, spaceID int64, modelID int64) error
+}
+
+// LocalBenefitServiceImpl 基于本地模型调用额度的权益实现：空间额度用完时拒绝 Prompt 调试、评估器调试与评测，其余权益不做限制
+type LocalBenefitServiceImpl struct {
+	NoopBenefitServiceImpl
+	quotaChecker QuotaChecker
+}
+
+func NewLocalBenefitService(quotaChecker QuotaChecker) IBenefitService {
+	return &LocalBenefitServiceImpl{
+		quotaChecker: quotaChecker,
+	}
+}
+
+func (l *LocalBenefitServiceImpl) CheckPromptBenefit(ctx context.Context, param *CheckPromptBenefitParams) (result *CheckPromptBenefitResult, err error) {
+	return &CheckPromptBenefitResult{DenyReason: l.checkSpaceQuota(ctx, param.SpaceID)}, nil
+}
+
+func (l *LocalBenefitServiceImpl) CheckEvaluatorBenefit(ctx context.Context, param *CheckEvaluatorBenefitParams) (result *CheckEvaluatorBenefitResult, err error) {
+	return &CheckEvaluatorBenefitResult{DenyReason: l.checkSpaceQuota(ctx, param.SpaceID)}, nil
+}
+
+func (l *LocalBenefitServiceImpl) CheckAndDeductEvalBenefit(ctx context.Context, param *CheckAndDeductEvalBenefitParams) (result *CheckAndDeductEvalBenefitResult, err error) {
+	return &CheckAndDeductEvalBenefitResult{DenyReason: l.checkSpaceQuota(ctx, param.SpaceID)}, nil
+}
+
+// checkSpaceQuota 额度用完时返回 DenyReasonInsufficient，具体额度在模型调用时仍会再次校验
+func (l *LocalBenefitServiceImpl) checkSpaceQuota(ctx context.Context, spaceID int64) *DenyReason {
+	if l.quotaChecker == nil {
+		return nil
+	}
+	if err := l.quotaChecker.CheckQuota(ctx, spaceID, 0); err != nil {
+		logs.CtxInfo(ctx, "space quota is used up, space_id:%d, err:%v", spaceID, err)
+		reason := DenyReasonInsufficient
+		return &reason
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package benefit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeQuotaChecker struct {
+	exceeded map[int64]bool
+}
+
+func (f *fakeQuotaChecker) CheckQuota(ctx context.Context, spaceID int64, modelID int64) error {
+	if f.exceeded[spaceID] {
+		return errors.New("quota is used up")
+	}
+	return nil
+}
+
+func TestLocalBenefitServiceImpl(t *testing.T) {
+	ctx := context.Background()
+	svc := NewLocalBenefitService(&fakeQuotaChecker{exceeded: map[int64]bool{2: true}})
+
+	promptResult, err := svc.CheckPromptBenefit(ctx, &CheckPromptBenefitParams{SpaceID: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, promptResult.DenyReason)
+
+	promptResult, err = svc.CheckPromptBenefit(ctx, &CheckPromptBenefitParams{SpaceID: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, DenyReasonInsufficient, *promptResult.DenyReason)
+
+	evaluatorResult, err := svc.CheckEvaluatorBenefit(ctx, &CheckEvaluatorBenefitParams{SpaceID: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, DenyReasonInsufficient, *evaluatorResult.DenyReason)
+
+	evalResult, err := svc.CheckAndDeductEvalBenefit(ctx, &CheckAndDeductEvalBenefitParams{SpaceID: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, evalResult.DenyReason)
+
+	// 与额度无关的权益不做限制
+	traceResult, err := svc.CheckTraceBenefit(ctx, &CheckTraceBenefitParams{SpaceID: 2})
+	assert.Nil(t, err)
+	assert.True(t, traceResult.IsEnough)
+}
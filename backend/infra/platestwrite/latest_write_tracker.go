@@ -127,6 +127,7 @@ const (
 	ResourceTypePromptBasic  ResourceType = "prompt_basic"
 	ResourceTypePromptDraft  ResourceType = "prompt_draft"
 	ResourceTypePromptCommit ResourceType = "prompt_commit"
+	ResourceTypePromptLabel  ResourceType = "prompt_label"
 
 	ResourceTypeExperiment    ResourceType = "experiment"
 	ResourceTypeEvalSet       ResourceType = "eval_set"
@@ -10,6 +10,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/eval_target"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluator"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	openapi0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/auth"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/authn"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/file"
@@ -17,13 +18,14 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/space"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/user"
 	manage0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
+	openapi2 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime"
-	openapi1 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/openapi"
+	openapi3 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/trace"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/debug"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/execute"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/manage"
-	openapi0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/openapi"
+	openapi1 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/openapi"
 )
 
 type EvaluationSetService interface {
@@ -130,6 +132,32 @@ func NewExperimentServiceClient(c thrift.TClient) *ExperimentServiceClient {
 	}
 }
 
+type EvaluationOpenAPIService interface {
+	openapi0.EvaluationOpenAPIService
+}
+
+type EvaluationOpenAPIServiceClient struct {
+	*openapi0.EvaluationOpenAPIServiceClient
+}
+
+func NewEvaluationOpenAPIServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *EvaluationOpenAPIServiceClient {
+	return &EvaluationOpenAPIServiceClient{
+		EvaluationOpenAPIServiceClient: openapi0.NewEvaluationOpenAPIServiceClientFactory(t, f),
+	}
+}
+
+func NewEvaluationOpenAPIServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *EvaluationOpenAPIServiceClient {
+	return &EvaluationOpenAPIServiceClient{
+		EvaluationOpenAPIServiceClient: openapi0.NewEvaluationOpenAPIServiceClientProtocol(t, iprot, oprot),
+	}
+}
+
+func NewEvaluationOpenAPIServiceClient(c thrift.TClient) *EvaluationOpenAPIServiceClient {
+	return &EvaluationOpenAPIServiceClient{
+		EvaluationOpenAPIServiceClient: openapi0.NewEvaluationOpenAPIServiceClient(c),
+	}
+}
+
 type DatasetService interface {
 	dataset.DatasetService
 }
@@ -261,28 +289,28 @@ func NewPromptExecuteServiceClient(c thrift.TClient) *PromptExecuteServiceClient
 }
 
 type PromptOpenAPIService interface {
-	openapi0.PromptOpenAPIService
+	openapi1.PromptOpenAPIService
 }
 
 type PromptOpenAPIServiceClient struct {
-	*openapi0.PromptOpenAPIServiceClient
+	*openapi1.PromptOpenAPIServiceClient
 }
 
 func NewPromptOpenAPIServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *PromptOpenAPIServiceClient {
 	return &PromptOpenAPIServiceClient{
-		PromptOpenAPIServiceClient: openapi0.NewPromptOpenAPIServiceClientFactory(t, f),
+		PromptOpenAPIServiceClient: openapi1.NewPromptOpenAPIServiceClientFactory(t, f),
 	}
 }
 
 func NewPromptOpenAPIServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *PromptOpenAPIServiceClient {
 	return &PromptOpenAPIServiceClient{
-		PromptOpenAPIServiceClient: openapi0.NewPromptOpenAPIServiceClientProtocol(t, iprot, oprot),
+		PromptOpenAPIServiceClient: openapi1.NewPromptOpenAPIServiceClientProtocol(t, iprot, oprot),
 	}
 }
 
 func NewPromptOpenAPIServiceClient(c thrift.TClient) *PromptOpenAPIServiceClient {
 	return &PromptOpenAPIServiceClient{
-		PromptOpenAPIServiceClient: openapi0.NewPromptOpenAPIServiceClient(c),
+		PromptOpenAPIServiceClient: openapi1.NewPromptOpenAPIServiceClient(c),
 	}
 }
 
@@ -338,6 +366,32 @@ func NewLLMRuntimeServiceClient(c thrift.TClient) *LLMRuntimeServiceClient {
 	}
 }
 
+type LLMOpenAPIService interface {
+	openapi2.LLMOpenAPIService
+}
+
+type LLMOpenAPIServiceClient struct {
+	*openapi2.LLMOpenAPIServiceClient
+}
+
+func NewLLMOpenAPIServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *LLMOpenAPIServiceClient {
+	return &LLMOpenAPIServiceClient{
+		LLMOpenAPIServiceClient: openapi2.NewLLMOpenAPIServiceClientFactory(t, f),
+	}
+}
+
+func NewLLMOpenAPIServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *LLMOpenAPIServiceClient {
+	return &LLMOpenAPIServiceClient{
+		LLMOpenAPIServiceClient: openapi2.NewLLMOpenAPIServiceClientProtocol(t, iprot, oprot),
+	}
+}
+
+func NewLLMOpenAPIServiceClient(c thrift.TClient) *LLMOpenAPIServiceClient {
+	return &LLMOpenAPIServiceClient{
+		LLMOpenAPIServiceClient: openapi2.NewLLMOpenAPIServiceClient(c),
+	}
+}
+
 type ObservabilityTraceService interface {
 	trace.TraceService
 }
@@ -365,28 +419,28 @@ func NewObservabilityTraceServiceClient(c thrift.TClient) *ObservabilityTraceSer
 }
 
 type ObservabilityOpenAPIService interface {
-	openapi1.OpenAPIService
+	openapi3.OpenAPIService
 }
 
 type ObservabilityOpenAPIServiceClient struct {
-	*openapi1.OpenAPIServiceClient
+	*openapi3.OpenAPIServiceClient
 }
 
 func NewObservabilityOpenAPIServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ObservabilityOpenAPIServiceClient {
 	return &ObservabilityOpenAPIServiceClient{
-		OpenAPIServiceClient: openapi1.NewOpenAPIServiceClientFactory(t, f),
+		OpenAPIServiceClient: openapi3.NewOpenAPIServiceClientFactory(t, f),
 	}
 }
 
 func NewObservabilityOpenAPIServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ObservabilityOpenAPIServiceClient {
 	return &ObservabilityOpenAPIServiceClient{
-		OpenAPIServiceClient: openapi1.NewOpenAPIServiceClientProtocol(t, iprot, oprot),
+		OpenAPIServiceClient: openapi3.NewOpenAPIServiceClientProtocol(t, iprot, oprot),
 	}
 }
 
 func NewObservabilityOpenAPIServiceClient(c thrift.TClient) *ObservabilityOpenAPIServiceClient {
 	return &ObservabilityOpenAPIServiceClient{
-		OpenAPIServiceClient: openapi1.NewOpenAPIServiceClient(c),
+		OpenAPIServiceClient: openapi3.NewOpenAPIServiceClient(c),
 	}
 }
 
@@ -582,6 +636,15 @@ func NewExperimentServiceProcessor(handler ExperimentService) *ExperimentService
 	return self
 }
 
+type EvaluationOpenAPIServiceProcessor struct {
+	*openapi0.EvaluationOpenAPIServiceProcessor
+}
+
+func NewEvaluationOpenAPIServiceProcessor(handler EvaluationOpenAPIService) *EvaluationOpenAPIServiceProcessor {
+	self := &EvaluationOpenAPIServiceProcessor{openapi0.NewEvaluationOpenAPIServiceProcessor(handler)}
+	return self
+}
+
 type DatasetServiceProcessor struct {
 	*dataset.DatasetServiceProcessor
 }
@@ -628,11 +691,11 @@ func NewPromptExecuteServiceProcessor(handler PromptExecuteService) *PromptExecu
 }
 
 type PromptOpenAPIServiceProcessor struct {
-	*openapi0.PromptOpenAPIServiceProcessor
+	*openapi1.PromptOpenAPIServiceProcessor
 }
 
 func NewPromptOpenAPIServiceProcessor(handler PromptOpenAPIService) *PromptOpenAPIServiceProcessor {
-	self := &PromptOpenAPIServiceProcessor{openapi0.NewPromptOpenAPIServiceProcessor(handler)}
+	self := &PromptOpenAPIServiceProcessor{openapi1.NewPromptOpenAPIServiceProcessor(handler)}
 	return self
 }
 
@@ -654,6 +717,15 @@ func NewLLMRuntimeServiceProcessor(handler LLMRuntimeService) *LLMRuntimeService
 	return self
 }
 
+type LLMOpenAPIServiceProcessor struct {
+	*openapi2.LLMOpenAPIServiceProcessor
+}
+
+func NewLLMOpenAPIServiceProcessor(handler LLMOpenAPIService) *LLMOpenAPIServiceProcessor {
+	self := &LLMOpenAPIServiceProcessor{openapi2.NewLLMOpenAPIServiceProcessor(handler)}
+	return self
+}
+
 type ObservabilityTraceServiceProcessor struct {
 	*trace.TraceServiceProcessor
 }
@@ -664,11 +736,11 @@ func NewObservabilityTraceServiceProcessor(handler ObservabilityTraceService) *O
 }
 
 type ObservabilityOpenAPIServiceProcessor struct {
-	*openapi1.OpenAPIServiceProcessor
+	*openapi3.OpenAPIServiceProcessor
 }
 
 func NewObservabilityOpenAPIServiceProcessor(handler ObservabilityOpenAPIService) *ObservabilityOpenAPIServiceProcessor {
-	self := &ObservabilityOpenAPIServiceProcessor{openapi1.NewOpenAPIServiceProcessor(handler)}
+	self := &ObservabilityOpenAPIServiceProcessor{openapi3.NewOpenAPIServiceProcessor(handler)}
 	return self
 }
 
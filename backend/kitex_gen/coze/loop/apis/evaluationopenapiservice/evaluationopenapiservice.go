@@ -0,0 +1,240 @@
+// Code generated by Kitex v0.13.1. DO NOT EDIT.
+
+package evaluationopenapiservice
+
+import (
+	"context"
+	"errors"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	apis "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/apis"
+	openapi "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/openapi"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"SubmitExperimentOApi": kitex.NewMethodInfo(
+		submitExperimentOApiHandler,
+		newEvaluationOpenAPIServiceSubmitExperimentOApiArgs,
+		newEvaluationOpenAPIServiceSubmitExperimentOApiResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetExperimentOApi": kitex.NewMethodInfo(
+		getExperimentOApiHandler,
+		newEvaluationOpenAPIServiceGetExperimentOApiArgs,
+		newEvaluationOpenAPIServiceGetExperimentOApiResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetExperimentAggrResultOApi": kitex.NewMethodInfo(
+		getExperimentAggrResultOApiHandler,
+		newEvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs,
+		newEvaluationOpenAPIServiceGetExperimentAggrResultOApiResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListExperimentResultOApi": kitex.NewMethodInfo(
+		listExperimentResultOApiHandler,
+		newEvaluationOpenAPIServiceListExperimentResultOApiArgs,
+		newEvaluationOpenAPIServiceListExperimentResultOApiResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"BatchCreateEvaluationSetItemsOApi": kitex.NewMethodInfo(
+		batchCreateEvaluationSetItemsOApiHandler,
+		newEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs,
+		newEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+}
+
+var (
+	evaluationOpenAPIServiceServiceInfo = NewServiceInfo()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return evaluationOpenAPIServiceServiceInfo
+}
+
+// NewServiceInfo creates a new ServiceInfo
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo()
+}
+
+func newServiceInfo() *kitex.ServiceInfo {
+	serviceName := "EvaluationOpenAPIService"
+	handlerType := (*apis.EvaluationOpenAPIService)(nil)
+	extra := map[string]interface{}{
+		"PackageName": "apis",
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         serviceMethods,
+		PayloadCodec:    kitex.Thrift,
+		KiteXGenVersion: "v0.13.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func submitExperimentOApiHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*openapi.EvaluationOpenAPIServiceSubmitExperimentOApiArgs)
+	realResult := result.(*openapi.EvaluationOpenAPIServiceSubmitExperimentOApiResult)
+	success, err := handler.(openapi.EvaluationOpenAPIService).SubmitExperimentOApi(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationOpenAPIServiceSubmitExperimentOApiArgs() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceSubmitExperimentOApiArgs()
+}
+
+func newEvaluationOpenAPIServiceSubmitExperimentOApiResult() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceSubmitExperimentOApiResult()
+}
+
+func getExperimentOApiHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*openapi.EvaluationOpenAPIServiceGetExperimentOApiArgs)
+	realResult := result.(*openapi.EvaluationOpenAPIServiceGetExperimentOApiResult)
+	success, err := handler.(openapi.EvaluationOpenAPIService).GetExperimentOApi(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationOpenAPIServiceGetExperimentOApiArgs() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceGetExperimentOApiArgs()
+}
+
+func newEvaluationOpenAPIServiceGetExperimentOApiResult() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceGetExperimentOApiResult()
+}
+
+func getExperimentAggrResultOApiHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*openapi.EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs)
+	realResult := result.(*openapi.EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult)
+	success, err := handler.(openapi.EvaluationOpenAPIService).GetExperimentAggrResultOApi(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs()
+}
+
+func newEvaluationOpenAPIServiceGetExperimentAggrResultOApiResult() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceGetExperimentAggrResultOApiResult()
+}
+
+func listExperimentResultOApiHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*openapi.EvaluationOpenAPIServiceListExperimentResultOApiArgs)
+	realResult := result.(*openapi.EvaluationOpenAPIServiceListExperimentResultOApiResult)
+	success, err := handler.(openapi.EvaluationOpenAPIService).ListExperimentResultOApi(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationOpenAPIServiceListExperimentResultOApiArgs() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceListExperimentResultOApiArgs()
+}
+
+func newEvaluationOpenAPIServiceListExperimentResultOApiResult() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceListExperimentResultOApiResult()
+}
+
+func batchCreateEvaluationSetItemsOApiHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*openapi.EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs)
+	realResult := result.(*openapi.EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult)
+	success, err := handler.(openapi.EvaluationOpenAPIService).BatchCreateEvaluationSetItemsOApi(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs()
+}
+
+func newEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult()
+}
+
+type kClient struct {
+	c  client.Client
+	sc client.Streaming
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c:  c,
+		sc: c.(client.Streaming),
+	}
+}
+
+func (p *kClient) SubmitExperimentOApi(ctx context.Context, req *openapi.SubmitExperimentOApiRequest) (r *openapi.SubmitExperimentOApiResponse, err error) {
+	var _args openapi.EvaluationOpenAPIServiceSubmitExperimentOApiArgs
+	_args.Req = req
+	var _result openapi.EvaluationOpenAPIServiceSubmitExperimentOApiResult
+	if err = p.c.Call(ctx, "SubmitExperimentOApi", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetExperimentOApi(ctx context.Context, req *openapi.GetExperimentOApiRequest) (r *openapi.GetExperimentOApiResponse, err error) {
+	var _args openapi.EvaluationOpenAPIServiceGetExperimentOApiArgs
+	_args.Req = req
+	var _result openapi.EvaluationOpenAPIServiceGetExperimentOApiResult
+	if err = p.c.Call(ctx, "GetExperimentOApi", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetExperimentAggrResultOApi(ctx context.Context, req *openapi.GetExperimentAggrResultOApiRequest) (r *openapi.GetExperimentAggrResultOApiResponse, err error) {
+	var _args openapi.EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs
+	_args.Req = req
+	var _result openapi.EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult
+	if err = p.c.Call(ctx, "GetExperimentAggrResultOApi", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListExperimentResultOApi(ctx context.Context, req *openapi.ListExperimentResultOApiRequest) (r *openapi.ListExperimentResultOApiResponse, err error) {
+	var _args openapi.EvaluationOpenAPIServiceListExperimentResultOApiArgs
+	_args.Req = req
+	var _result openapi.EvaluationOpenAPIServiceListExperimentResultOApiResult
+	if err = p.c.Call(ctx, "ListExperimentResultOApi", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchCreateEvaluationSetItemsOApi(ctx context.Context, req *openapi.BatchCreateEvaluationSetItemsOApiRequest) (r *openapi.BatchCreateEvaluationSetItemsOApiResponse, err error) {
+	var _args openapi.EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs
+	_args.Req = req
+	var _result openapi.EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult
+	if err = p.c.Call(ctx, "BatchCreateEvaluationSetItemsOApi", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
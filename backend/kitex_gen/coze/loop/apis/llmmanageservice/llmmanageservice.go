@@ -28,6 +28,41 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateModel": kitex.NewMethodInfo(
+		createModelHandler,
+		newLLMManageServiceCreateModelArgs,
+		newLLMManageServiceCreateModelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateModel": kitex.NewMethodInfo(
+		updateModelHandler,
+		newLLMManageServiceUpdateModelArgs,
+		newLLMManageServiceUpdateModelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteModel": kitex.NewMethodInfo(
+		deleteModelHandler,
+		newLLMManageServiceDeleteModelArgs,
+		newLLMManageServiceDeleteModelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"QueryModelUsage": kitex.NewMethodInfo(
+		queryModelUsageHandler,
+		newLLMManageServiceQueryModelUsageArgs,
+		newLLMManageServiceQueryModelUsageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetQuotaStatus": kitex.NewMethodInfo(
+		getQuotaStatusHandler,
+		newLLMManageServiceGetQuotaStatusArgs,
+		newLLMManageServiceGetQuotaStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -99,6 +134,101 @@ func newLLMManageServiceGetModelResult() interface{} {
 	return manage.NewLLMManageServiceGetModelResult()
 }
 
+func createModelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceCreateModelArgs)
+	realResult := result.(*manage.LLMManageServiceCreateModelResult)
+	success, err := handler.(manage.LLMManageService).CreateModel(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceCreateModelArgs() interface{} {
+	return manage.NewLLMManageServiceCreateModelArgs()
+}
+
+func newLLMManageServiceCreateModelResult() interface{} {
+	return manage.NewLLMManageServiceCreateModelResult()
+}
+
+func updateModelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceUpdateModelArgs)
+	realResult := result.(*manage.LLMManageServiceUpdateModelResult)
+	success, err := handler.(manage.LLMManageService).UpdateModel(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceUpdateModelArgs() interface{} {
+	return manage.NewLLMManageServiceUpdateModelArgs()
+}
+
+func newLLMManageServiceUpdateModelResult() interface{} {
+	return manage.NewLLMManageServiceUpdateModelResult()
+}
+
+func deleteModelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceDeleteModelArgs)
+	realResult := result.(*manage.LLMManageServiceDeleteModelResult)
+	success, err := handler.(manage.LLMManageService).DeleteModel(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceDeleteModelArgs() interface{} {
+	return manage.NewLLMManageServiceDeleteModelArgs()
+}
+
+func newLLMManageServiceDeleteModelResult() interface{} {
+	return manage.NewLLMManageServiceDeleteModelResult()
+}
+
+func queryModelUsageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceQueryModelUsageArgs)
+	realResult := result.(*manage.LLMManageServiceQueryModelUsageResult)
+	success, err := handler.(manage.LLMManageService).QueryModelUsage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceQueryModelUsageArgs() interface{} {
+	return manage.NewLLMManageServiceQueryModelUsageArgs()
+}
+
+func newLLMManageServiceQueryModelUsageResult() interface{} {
+	return manage.NewLLMManageServiceQueryModelUsageResult()
+}
+
+func getQuotaStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceGetQuotaStatusArgs)
+	realResult := result.(*manage.LLMManageServiceGetQuotaStatusResult)
+	success, err := handler.(manage.LLMManageService).GetQuotaStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceGetQuotaStatusArgs() interface{} {
+	return manage.NewLLMManageServiceGetQuotaStatusArgs()
+}
+
+func newLLMManageServiceGetQuotaStatusResult() interface{} {
+	return manage.NewLLMManageServiceGetQuotaStatusResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -130,3 +260,53 @@ func (p *kClient) GetModel(ctx context.Context, req *manage.GetModelRequest) (r
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateModel(ctx context.Context, req *manage.CreateModelRequest) (r *manage.CreateModelResponse, err error) {
+	var _args manage.LLMManageServiceCreateModelArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceCreateModelResult
+	if err = p.c.Call(ctx, "CreateModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateModel(ctx context.Context, req *manage.UpdateModelRequest) (r *manage.UpdateModelResponse, err error) {
+	var _args manage.LLMManageServiceUpdateModelArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceUpdateModelResult
+	if err = p.c.Call(ctx, "UpdateModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteModel(ctx context.Context, req *manage.DeleteModelRequest) (r *manage.DeleteModelResponse, err error) {
+	var _args manage.LLMManageServiceDeleteModelArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceDeleteModelResult
+	if err = p.c.Call(ctx, "DeleteModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) QueryModelUsage(ctx context.Context, req *manage.QueryModelUsageRequest) (r *manage.QueryModelUsageResponse, err error) {
+	var _args manage.LLMManageServiceQueryModelUsageArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceQueryModelUsageResult
+	if err = p.c.Call(ctx, "QueryModelUsage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetQuotaStatus(ctx context.Context, req *manage.GetQuotaStatusRequest) (r *manage.GetQuotaStatusResponse, err error) {
+	var _args manage.LLMManageServiceGetQuotaStatusArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceGetQuotaStatusResult
+	if err = p.c.Call(ctx, "GetQuotaStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
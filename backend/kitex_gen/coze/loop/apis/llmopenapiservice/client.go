@@ -0,0 +1,62 @@
+// Code generated by Kitex v0.13.1. DO NOT EDIT.
+
+package llmopenapiservice
+
+import (
+	"context"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+	streamcall "github.com/cloudwego/kitex/client/callopt/streamcall"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	transport "github.com/cloudwego/kitex/transport"
+	openapi "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	ChatCompletions(ctx context.Context, req *openapi.ChatCompletionsRequest, callOptions ...callopt.Option) (r *openapi.ChatCompletionsResponse, err error)
+	ChatCompletionsStream(ctx context.Context, req *openapi.ChatCompletionsRequest, callOptions ...streamcall.Option) (stream LLMOpenAPIService_ChatCompletionsStreamClient, err error)
+}
+
+type LLMOpenAPIService_ChatCompletionsStreamClient streaming.ServerStreamingClient[openapi.ChatCompletionsStreamResponse]
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, client.WithTransportProtocol(transport.TTHeaderStreaming))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kLLMOpenAPIServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kLLMOpenAPIServiceClient struct {
+	*kClient
+}
+
+func (p *kLLMOpenAPIServiceClient) ChatCompletions(ctx context.Context, req *openapi.ChatCompletionsRequest, callOptions ...callopt.Option) (r *openapi.ChatCompletionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ChatCompletions(ctx, req)
+}
+
+func (p *kLLMOpenAPIServiceClient) ChatCompletionsStream(ctx context.Context, req *openapi.ChatCompletionsRequest, callOptions ...streamcall.Option) (stream LLMOpenAPIService_ChatCompletionsStreamClient, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, streamcall.GetCallOptions(callOptions))
+	return p.kClient.ChatCompletionsStream(ctx, req)
+}
@@ -26,6 +26,10 @@ type Client interface {
 	ListAnnotations(ctx context.Context, req *trace.ListAnnotationsRequest, callOptions ...callopt.Option) (r *trace.ListAnnotationsResponse, err error)
 	ExportTracesToDataset(ctx context.Context, req *trace.ExportTracesToDatasetRequest, callOptions ...callopt.Option) (r *trace.ExportTracesToDatasetResponse, err error)
 	PreviewExportTracesToDataset(ctx context.Context, req *trace.PreviewExportTracesToDatasetRequest, callOptions ...callopt.Option) (r *trace.PreviewExportTracesToDatasetResponse, err error)
+	CreateOnlineEvalRule(ctx context.Context, req *trace.CreateOnlineEvalRuleRequest, callOptions ...callopt.Option) (r *trace.CreateOnlineEvalRuleResponse, err error)
+	UpdateOnlineEvalRule(ctx context.Context, req *trace.UpdateOnlineEvalRuleRequest, callOptions ...callopt.Option) (r *trace.UpdateOnlineEvalRuleResponse, err error)
+	DeleteOnlineEvalRule(ctx context.Context, req *trace.DeleteOnlineEvalRuleRequest, callOptions ...callopt.Option) (r *trace.DeleteOnlineEvalRuleResponse, err error)
+	ListOnlineEvalRules(ctx context.Context, req *trace.ListOnlineEvalRulesRequest, callOptions ...callopt.Option) (r *trace.ListOnlineEvalRulesResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -131,3 +135,23 @@ func (p *kObservabilityTraceServiceClient) PreviewExportTracesToDataset(ctx cont
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.PreviewExportTracesToDataset(ctx, req)
 }
+
+func (p *kObservabilityTraceServiceClient) CreateOnlineEvalRule(ctx context.Context, req *trace.CreateOnlineEvalRuleRequest, callOptions ...callopt.Option) (r *trace.CreateOnlineEvalRuleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateOnlineEvalRule(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) UpdateOnlineEvalRule(ctx context.Context, req *trace.UpdateOnlineEvalRuleRequest, callOptions ...callopt.Option) (r *trace.UpdateOnlineEvalRuleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateOnlineEvalRule(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) DeleteOnlineEvalRule(ctx context.Context, req *trace.DeleteOnlineEvalRuleRequest, callOptions ...callopt.Option) (r *trace.DeleteOnlineEvalRuleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteOnlineEvalRule(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) ListOnlineEvalRules(ctx context.Context, req *trace.ListOnlineEvalRulesRequest, callOptions ...callopt.Option) (r *trace.ListOnlineEvalRulesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListOnlineEvalRules(ctx, req)
+}
@@ -119,6 +119,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateOnlineEvalRule": kitex.NewMethodInfo(
+		createOnlineEvalRuleHandler,
+		newTraceServiceCreateOnlineEvalRuleArgs,
+		newTraceServiceCreateOnlineEvalRuleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateOnlineEvalRule": kitex.NewMethodInfo(
+		updateOnlineEvalRuleHandler,
+		newTraceServiceUpdateOnlineEvalRuleArgs,
+		newTraceServiceUpdateOnlineEvalRuleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteOnlineEvalRule": kitex.NewMethodInfo(
+		deleteOnlineEvalRuleHandler,
+		newTraceServiceDeleteOnlineEvalRuleArgs,
+		newTraceServiceDeleteOnlineEvalRuleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListOnlineEvalRules": kitex.NewMethodInfo(
+		listOnlineEvalRulesHandler,
+		newTraceServiceListOnlineEvalRulesArgs,
+		newTraceServiceListOnlineEvalRulesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -437,6 +465,82 @@ func newTraceServicePreviewExportTracesToDatasetResult() interface{} {
 	return trace.NewTraceServicePreviewExportTracesToDatasetResult()
 }
 
+func createOnlineEvalRuleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceCreateOnlineEvalRuleArgs)
+	realResult := result.(*trace.TraceServiceCreateOnlineEvalRuleResult)
+	success, err := handler.(trace.TraceService).CreateOnlineEvalRule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceCreateOnlineEvalRuleArgs() interface{} {
+	return trace.NewTraceServiceCreateOnlineEvalRuleArgs()
+}
+
+func newTraceServiceCreateOnlineEvalRuleResult() interface{} {
+	return trace.NewTraceServiceCreateOnlineEvalRuleResult()
+}
+
+func updateOnlineEvalRuleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceUpdateOnlineEvalRuleArgs)
+	realResult := result.(*trace.TraceServiceUpdateOnlineEvalRuleResult)
+	success, err := handler.(trace.TraceService).UpdateOnlineEvalRule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceUpdateOnlineEvalRuleArgs() interface{} {
+	return trace.NewTraceServiceUpdateOnlineEvalRuleArgs()
+}
+
+func newTraceServiceUpdateOnlineEvalRuleResult() interface{} {
+	return trace.NewTraceServiceUpdateOnlineEvalRuleResult()
+}
+
+func deleteOnlineEvalRuleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceDeleteOnlineEvalRuleArgs)
+	realResult := result.(*trace.TraceServiceDeleteOnlineEvalRuleResult)
+	success, err := handler.(trace.TraceService).DeleteOnlineEvalRule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceDeleteOnlineEvalRuleArgs() interface{} {
+	return trace.NewTraceServiceDeleteOnlineEvalRuleArgs()
+}
+
+func newTraceServiceDeleteOnlineEvalRuleResult() interface{} {
+	return trace.NewTraceServiceDeleteOnlineEvalRuleResult()
+}
+
+func listOnlineEvalRulesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceListOnlineEvalRulesArgs)
+	realResult := result.(*trace.TraceServiceListOnlineEvalRulesResult)
+	success, err := handler.(trace.TraceService).ListOnlineEvalRules(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceListOnlineEvalRulesArgs() interface{} {
+	return trace.NewTraceServiceListOnlineEvalRulesArgs()
+}
+
+func newTraceServiceListOnlineEvalRulesResult() interface{} {
+	return trace.NewTraceServiceListOnlineEvalRulesResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -598,3 +702,43 @@ func (p *kClient) PreviewExportTracesToDataset(ctx context.Context, req *trace.P
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateOnlineEvalRule(ctx context.Context, req *trace.CreateOnlineEvalRuleRequest) (r *trace.CreateOnlineEvalRuleResponse, err error) {
+	var _args trace.TraceServiceCreateOnlineEvalRuleArgs
+	_args.Req = req
+	var _result trace.TraceServiceCreateOnlineEvalRuleResult
+	if err = p.c.Call(ctx, "CreateOnlineEvalRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateOnlineEvalRule(ctx context.Context, req *trace.UpdateOnlineEvalRuleRequest) (r *trace.UpdateOnlineEvalRuleResponse, err error) {
+	var _args trace.TraceServiceUpdateOnlineEvalRuleArgs
+	_args.Req = req
+	var _result trace.TraceServiceUpdateOnlineEvalRuleResult
+	if err = p.c.Call(ctx, "UpdateOnlineEvalRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteOnlineEvalRule(ctx context.Context, req *trace.DeleteOnlineEvalRuleRequest) (r *trace.DeleteOnlineEvalRuleResponse, err error) {
+	var _args trace.TraceServiceDeleteOnlineEvalRuleArgs
+	_args.Req = req
+	var _result trace.TraceServiceDeleteOnlineEvalRuleResult
+	if err = p.c.Call(ctx, "DeleteOnlineEvalRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListOnlineEvalRules(ctx context.Context, req *trace.ListOnlineEvalRulesRequest) (r *trace.ListOnlineEvalRulesResponse, err error) {
+	var _args trace.TraceServiceListOnlineEvalRulesArgs
+	_args.Req = req
+	var _result trace.TraceServiceListOnlineEvalRulesResult
+	if err = p.c.Call(ctx, "ListOnlineEvalRules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -91,6 +91,48 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"DiffCommit": kitex.NewMethodInfo(
+		diffCommitHandler,
+		newPromptManageServiceDiffCommitArgs,
+		newPromptManageServiceDiffCommitResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetPromptLabel": kitex.NewMethodInfo(
+		setPromptLabelHandler,
+		newPromptManageServiceSetPromptLabelArgs,
+		newPromptManageServiceSetPromptLabelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListPromptLabel": kitex.NewMethodInfo(
+		listPromptLabelHandler,
+		newPromptManageServiceListPromptLabelArgs,
+		newPromptManageServiceListPromptLabelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListPromptLabelHistory": kitex.NewMethodInfo(
+		listPromptLabelHistoryHandler,
+		newPromptManageServiceListPromptLabelHistoryArgs,
+		newPromptManageServiceListPromptLabelHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RollbackPromptLabel": kitex.NewMethodInfo(
+		rollbackPromptLabelHandler,
+		newPromptManageServiceRollbackPromptLabelArgs,
+		newPromptManageServiceRollbackPromptLabelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListSnippetReference": kitex.NewMethodInfo(
+		listSnippetReferenceHandler,
+		newPromptManageServiceListSnippetReferenceArgs,
+		newPromptManageServiceListSnippetReferenceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -333,6 +375,120 @@ func newPromptManageServiceRevertDraftFromCommitResult() interface{} {
 	return manage.NewPromptManageServiceRevertDraftFromCommitResult()
 }
 
+func diffCommitHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.PromptManageServiceDiffCommitArgs)
+	realResult := result.(*manage.PromptManageServiceDiffCommitResult)
+	success, err := handler.(manage.PromptManageService).DiffCommit(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newPromptManageServiceDiffCommitArgs() interface{} {
+	return manage.NewPromptManageServiceDiffCommitArgs()
+}
+
+func newPromptManageServiceDiffCommitResult() interface{} {
+	return manage.NewPromptManageServiceDiffCommitResult()
+}
+
+func setPromptLabelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.PromptManageServiceSetPromptLabelArgs)
+	realResult := result.(*manage.PromptManageServiceSetPromptLabelResult)
+	success, err := handler.(manage.PromptManageService).SetPromptLabel(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newPromptManageServiceSetPromptLabelArgs() interface{} {
+	return manage.NewPromptManageServiceSetPromptLabelArgs()
+}
+
+func newPromptManageServiceSetPromptLabelResult() interface{} {
+	return manage.NewPromptManageServiceSetPromptLabelResult()
+}
+
+func listPromptLabelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.PromptManageServiceListPromptLabelArgs)
+	realResult := result.(*manage.PromptManageServiceListPromptLabelResult)
+	success, err := handler.(manage.PromptManageService).ListPromptLabel(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newPromptManageServiceListPromptLabelArgs() interface{} {
+	return manage.NewPromptManageServiceListPromptLabelArgs()
+}
+
+func newPromptManageServiceListPromptLabelResult() interface{} {
+	return manage.NewPromptManageServiceListPromptLabelResult()
+}
+
+func listPromptLabelHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.PromptManageServiceListPromptLabelHistoryArgs)
+	realResult := result.(*manage.PromptManageServiceListPromptLabelHistoryResult)
+	success, err := handler.(manage.PromptManageService).ListPromptLabelHistory(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newPromptManageServiceListPromptLabelHistoryArgs() interface{} {
+	return manage.NewPromptManageServiceListPromptLabelHistoryArgs()
+}
+
+func newPromptManageServiceListPromptLabelHistoryResult() interface{} {
+	return manage.NewPromptManageServiceListPromptLabelHistoryResult()
+}
+
+func rollbackPromptLabelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.PromptManageServiceRollbackPromptLabelArgs)
+	realResult := result.(*manage.PromptManageServiceRollbackPromptLabelResult)
+	success, err := handler.(manage.PromptManageService).RollbackPromptLabel(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newPromptManageServiceRollbackPromptLabelArgs() interface{} {
+	return manage.NewPromptManageServiceRollbackPromptLabelArgs()
+}
+
+func newPromptManageServiceRollbackPromptLabelResult() interface{} {
+	return manage.NewPromptManageServiceRollbackPromptLabelResult()
+}
+
+func listSnippetReferenceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.PromptManageServiceListSnippetReferenceArgs)
+	realResult := result.(*manage.PromptManageServiceListSnippetReferenceResult)
+	success, err := handler.(manage.PromptManageService).ListSnippetReference(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newPromptManageServiceListSnippetReferenceArgs() interface{} {
+	return manage.NewPromptManageServiceListSnippetReferenceArgs()
+}
+
+func newPromptManageServiceListSnippetReferenceResult() interface{} {
+	return manage.NewPromptManageServiceListSnippetReferenceResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -454,3 +610,63 @@ func (p *kClient) RevertDraftFromCommit(ctx context.Context, request *manage.Rev
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) DiffCommit(ctx context.Context, request *manage.DiffCommitRequest) (r *manage.DiffCommitResponse, err error) {
+	var _args manage.PromptManageServiceDiffCommitArgs
+	_args.Request = request
+	var _result manage.PromptManageServiceDiffCommitResult
+	if err = p.c.Call(ctx, "DiffCommit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetPromptLabel(ctx context.Context, request *manage.SetPromptLabelRequest) (r *manage.SetPromptLabelResponse, err error) {
+	var _args manage.PromptManageServiceSetPromptLabelArgs
+	_args.Request = request
+	var _result manage.PromptManageServiceSetPromptLabelResult
+	if err = p.c.Call(ctx, "SetPromptLabel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPromptLabel(ctx context.Context, request *manage.ListPromptLabelRequest) (r *manage.ListPromptLabelResponse, err error) {
+	var _args manage.PromptManageServiceListPromptLabelArgs
+	_args.Request = request
+	var _result manage.PromptManageServiceListPromptLabelResult
+	if err = p.c.Call(ctx, "ListPromptLabel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPromptLabelHistory(ctx context.Context, request *manage.ListPromptLabelHistoryRequest) (r *manage.ListPromptLabelHistoryResponse, err error) {
+	var _args manage.PromptManageServiceListPromptLabelHistoryArgs
+	_args.Request = request
+	var _result manage.PromptManageServiceListPromptLabelHistoryResult
+	if err = p.c.Call(ctx, "ListPromptLabelHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RollbackPromptLabel(ctx context.Context, request *manage.RollbackPromptLabelRequest) (r *manage.RollbackPromptLabelResponse, err error) {
+	var _args manage.PromptManageServiceRollbackPromptLabelArgs
+	_args.Request = request
+	var _result manage.PromptManageServiceRollbackPromptLabelResult
+	if err = p.c.Call(ctx, "RollbackPromptLabel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListSnippetReference(ctx context.Context, request *manage.ListSnippetReferenceRequest) (r *manage.ListSnippetReferenceResponse, err error) {
+	var _args manage.PromptManageServiceListSnippetReferenceArgs
+	_args.Request = request
+	var _result manage.PromptManageServiceListSnippetReferenceResult
+	if err = p.c.Call(ctx, "ListSnippetReference", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/eval_target"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluator"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/openapi"
 )
 
 type EvaluationSetService interface {
@@ -114,6 +115,32 @@ func NewEvalTargetServiceClient(c thrift.TClient) *EvalTargetServiceClient {
 	}
 }
 
+type EvaluationOpenAPIService interface {
+	openapi.EvaluationOpenAPIService
+}
+
+type EvaluationOpenAPIServiceClient struct {
+	*openapi.EvaluationOpenAPIServiceClient
+}
+
+func NewEvaluationOpenAPIServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *EvaluationOpenAPIServiceClient {
+	return &EvaluationOpenAPIServiceClient{
+		EvaluationOpenAPIServiceClient: openapi.NewEvaluationOpenAPIServiceClientFactory(t, f),
+	}
+}
+
+func NewEvaluationOpenAPIServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *EvaluationOpenAPIServiceClient {
+	return &EvaluationOpenAPIServiceClient{
+		EvaluationOpenAPIServiceClient: openapi.NewEvaluationOpenAPIServiceClientProtocol(t, iprot, oprot),
+	}
+}
+
+func NewEvaluationOpenAPIServiceClient(c thrift.TClient) *EvaluationOpenAPIServiceClient {
+	return &EvaluationOpenAPIServiceClient{
+		EvaluationOpenAPIServiceClient: openapi.NewEvaluationOpenAPIServiceClient(c),
+	}
+}
+
 type EvaluationSetServiceProcessor struct {
 	*eval_set.EvaluationSetServiceProcessor
 }
@@ -149,3 +176,12 @@ func NewEvalTargetServiceProcessor(handler EvalTargetService) *EvalTargetService
 	self := &EvalTargetServiceProcessor{eval_target.NewEvalTargetServiceProcessor(handler)}
 	return self
 }
+
+type EvaluationOpenAPIServiceProcessor struct {
+	*openapi.EvaluationOpenAPIServiceProcessor
+}
+
+func NewEvaluationOpenAPIServiceProcessor(handler EvaluationOpenAPIService) *EvaluationOpenAPIServiceProcessor {
+	self := &EvaluationOpenAPIServiceProcessor{openapi.NewEvaluationOpenAPIServiceProcessor(handler)}
+	return self
+}
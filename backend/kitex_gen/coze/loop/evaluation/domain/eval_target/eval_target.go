@@ -21,6 +21,8 @@ const (
 	// Trace
 	EvalTargetType_Trace        EvalTargetType = 3
 	EvalTargetType_CozeWorkflow EvalTargetType = 4
+	// 自定义 HTTP 服务
+	EvalTargetType_HTTP EvalTargetType = 5
 )
 
 func (p EvalTargetType) String() string {
@@ -33,6 +35,8 @@ func (p EvalTargetType) String() string {
 		return "Trace"
 	case EvalTargetType_CozeWorkflow:
 		return "CozeWorkflow"
+	case EvalTargetType_HTTP:
+		return "HTTP"
 	}
 	return "<UNSET>"
 }
@@ -47,6 +51,8 @@ func EvalTargetTypeFromString(s string) (EvalTargetType, error) {
 		return EvalTargetType_Trace, nil
 	case "CozeWorkflow":
 		return EvalTargetType_CozeWorkflow, nil
+	case "HTTP":
+		return EvalTargetType_HTTP, nil
 	}
 	return EvalTargetType(0), fmt.Errorf("not a valid EvalTargetType string")
 }
@@ -66,6 +72,56 @@ func (p *EvalTargetType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type HTTPAuthType int64
+
+const (
+	// Authorization: Bearer <token>
+	HTTPAuthType_Bearer HTTPAuthType = 1
+	// Authorization: Basic base64(username:password)
+	HTTPAuthType_Basic HTTPAuthType = 2
+	// <header_name>: <token>
+	HTTPAuthType_APIKey HTTPAuthType = 3
+)
+
+func (p HTTPAuthType) String() string {
+	switch p {
+	case HTTPAuthType_Bearer:
+		return "Bearer"
+	case HTTPAuthType_Basic:
+		return "Basic"
+	case HTTPAuthType_APIKey:
+		return "APIKey"
+	}
+	return "<UNSET>"
+}
+
+func HTTPAuthTypeFromString(s string) (HTTPAuthType, error) {
+	switch s {
+	case "Bearer":
+		return HTTPAuthType_Bearer, nil
+	case "Basic":
+		return HTTPAuthType_Basic, nil
+	case "APIKey":
+		return HTTPAuthType_APIKey, nil
+	}
+	return HTTPAuthType(0), fmt.Errorf("not a valid HTTPAuthType string")
+}
+
+func HTTPAuthTypePtr(v HTTPAuthType) *HTTPAuthType { return &v }
+func (p *HTTPAuthType) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = HTTPAuthType(result.Int64)
+	return
+}
+
+func (p *HTTPAuthType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type SubmitStatus int64
 
 const (
@@ -1379,6 +1435,8 @@ type EvalTargetContent struct {
 	Prompt *EvalPrompt `thrift:"prompt,102,optional" frugal:"102,optional,EvalPrompt" form:"prompt" json:"prompt,omitempty" query:"prompt"`
 	// EvalTargetType=4 时，传参此字段。 评测对象为 CozeWorkflow 时, 需要设置 CozeWorkflow 信息
 	CozeWorkflow *CozeWorkflow `thrift:"coze_workflow,103,optional" frugal:"103,optional,CozeWorkflow" form:"coze_workflow" json:"coze_workflow,omitempty" query:"coze_workflow"`
+	// EvalTargetType=5 时，传参此字段。 评测对象为 HTTP 服务时, 需要设置 HTTP 调用信息
+	HTTPTarget *HTTPTarget `thrift:"http_target,104,optional" frugal:"104,optional,HTTPTarget" form:"http_target" json:"http_target,omitempty" query:"http_target"`
 }
 
 func NewEvalTargetContent() *EvalTargetContent {
@@ -1459,6 +1517,18 @@ func (p *EvalTargetContent) GetCozeWorkflow() (v *CozeWorkflow) {
 	}
 	return p.CozeWorkflow
 }
+
+var EvalTargetContent_HTTPTarget_DEFAULT *HTTPTarget
+
+func (p *EvalTargetContent) GetHTTPTarget() (v *HTTPTarget) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHTTPTarget() {
+		return EvalTargetContent_HTTPTarget_DEFAULT
+	}
+	return p.HTTPTarget
+}
 func (p *EvalTargetContent) SetInputSchemas(val []*common.ArgsSchema) {
 	p.InputSchemas = val
 }
@@ -1477,6 +1547,9 @@ func (p *EvalTargetContent) SetPrompt(val *EvalPrompt) {
 func (p *EvalTargetContent) SetCozeWorkflow(val *CozeWorkflow) {
 	p.CozeWorkflow = val
 }
+func (p *EvalTargetContent) SetHTTPTarget(val *HTTPTarget) {
+	p.HTTPTarget = val
+}
 
 var fieldIDToName_EvalTargetContent = map[int16]string{
 	1:   "input_schemas",
@@ -1485,6 +1558,7 @@ var fieldIDToName_EvalTargetContent = map[int16]string{
 	101: "coze_bot",
 	102: "prompt",
 	103: "coze_workflow",
+	104: "http_target",
 }
 
 func (p *EvalTargetContent) IsSetInputSchemas() bool {
@@ -1511,6 +1585,10 @@ func (p *EvalTargetContent) IsSetCozeWorkflow() bool {
 	return p.CozeWorkflow != nil
 }
 
+func (p *EvalTargetContent) IsSetHTTPTarget() bool {
+	return p.HTTPTarget != nil
+}
+
 func (p *EvalTargetContent) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1577,6 +1655,14 @@ func (p *EvalTargetContent) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 104:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField104(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1687,6 +1773,14 @@ func (p *EvalTargetContent) ReadField103(iprot thrift.TProtocol) error {
 	p.CozeWorkflow = _field
 	return nil
 }
+func (p *EvalTargetContent) ReadField104(iprot thrift.TProtocol) error {
+	_field := NewHTTPTarget()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.HTTPTarget = _field
+	return nil
+}
 
 func (p *EvalTargetContent) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1718,6 +1812,10 @@ func (p *EvalTargetContent) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 103
 			goto WriteFieldError
 		}
+		if err = p.writeField104(oprot); err != nil {
+			fieldId = 104
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1783,53 +1881,1724 @@ func (p *EvalTargetContent) writeField2(oprot thrift.TProtocol) (err error) {
 		}
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvalTargetContent) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuntimeParamJSONDemo() {
+		if err = oprot.WriteFieldBegin("runtime_param_json_demo", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RuntimeParamJSONDemo); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvalTargetContent) writeField101(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCozeBot() {
+		if err = oprot.WriteFieldBegin("coze_bot", thrift.STRUCT, 101); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.CozeBot.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+}
+func (p *EvalTargetContent) writeField102(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPrompt() {
+		if err = oprot.WriteFieldBegin("prompt", thrift.STRUCT, 102); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Prompt.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
+}
+func (p *EvalTargetContent) writeField103(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCozeWorkflow() {
+		if err = oprot.WriteFieldBegin("coze_workflow", thrift.STRUCT, 103); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.CozeWorkflow.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
+}
+func (p *EvalTargetContent) writeField104(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHTTPTarget() {
+		if err = oprot.WriteFieldBegin("http_target", thrift.STRUCT, 104); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.HTTPTarget.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 104 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 104 end error: ", p), err)
+}
+
+func (p *EvalTargetContent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvalTargetContent(%+v)", *p)
+
+}
+
+func (p *EvalTargetContent) DeepEqual(ano *EvalTargetContent) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.InputSchemas) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.OutputSchemas) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RuntimeParamJSONDemo) {
+		return false
+	}
+	if !p.Field101DeepEqual(ano.CozeBot) {
+		return false
+	}
+	if !p.Field102DeepEqual(ano.Prompt) {
+		return false
+	}
+	if !p.Field103DeepEqual(ano.CozeWorkflow) {
+		return false
+	}
+	if !p.Field104DeepEqual(ano.HTTPTarget) {
+		return false
+	}
+	return true
+}
+
+func (p *EvalTargetContent) Field1DeepEqual(src []*common.ArgsSchema) bool {
+
+	if len(p.InputSchemas) != len(src) {
+		return false
+	}
+	for i, v := range p.InputSchemas {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalTargetContent) Field2DeepEqual(src []*common.ArgsSchema) bool {
+
+	if len(p.OutputSchemas) != len(src) {
+		return false
+	}
+	for i, v := range p.OutputSchemas {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalTargetContent) Field3DeepEqual(src *string) bool {
+
+	if p.RuntimeParamJSONDemo == src {
+		return true
+	} else if p.RuntimeParamJSONDemo == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.RuntimeParamJSONDemo, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvalTargetContent) Field101DeepEqual(src *CozeBot) bool {
+
+	if !p.CozeBot.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvalTargetContent) Field102DeepEqual(src *EvalPrompt) bool {
+
+	if !p.Prompt.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvalTargetContent) Field103DeepEqual(src *CozeWorkflow) bool {
+
+	if !p.CozeWorkflow.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvalTargetContent) Field104DeepEqual(src *HTTPTarget) bool {
+
+	if !p.HTTPTarget.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type HTTPTarget struct {
+	// 请求地址，可用 {{key}} 引用输入变量
+	URL *string `thrift:"url,1,optional" frugal:"1,optional,string" form:"url" json:"url,omitempty" query:"url"`
+	// 请求方法，默认 POST
+	Method  *string           `thrift:"method,2,optional" frugal:"2,optional,string" form:"method" json:"method,omitempty" query:"method"`
+	Headers map[string]string `thrift:"headers,3,optional" frugal:"3,optional,map<string:string>" form:"headers" json:"headers,omitempty" query:"headers"`
+	Auth    *HTTPAuth         `thrift:"auth,4,optional" frugal:"4,optional,HTTPAuth" form:"auth" json:"auth,omitempty" query:"auth"`
+	// 请求体模板，可用 {{key}} 引用输入变量，变量值按 JSON 字符串转义
+	BodyTemplate *string `thrift:"body_template,5,optional" frugal:"5,optional,string" form:"body_template" json:"body_template,omitempty" query:"body_template"`
+	// 响应字段映射，为空时整个响应体作为 actual_output
+	OutputMappings []*HTTPOutputMapping `thrift:"output_mappings,6,optional" frugal:"6,optional,list<HTTPOutputMapping>" form:"output_mappings" json:"output_mappings,omitempty" query:"output_mappings"`
+	// 单次请求超时时间
+	TimeoutMs *int64 `thrift:"timeout_ms,7,optional" frugal:"7,optional,i64" form:"timeout_ms" json:"timeout_ms" query:"timeout_ms"`
+	// 失败重试次数，网络错误、429 与 5xx 时重试
+	RetryTimes      *int32 `thrift:"retry_times,8,optional" frugal:"8,optional,i32" form:"retry_times" json:"retry_times,omitempty" query:"retry_times"`
+	RetryIntervalMs *int64 `thrift:"retry_interval_ms,9,optional" frugal:"9,optional,i64" form:"retry_interval_ms" json:"retry_interval_ms" query:"retry_interval_ms"`
+}
+
+func NewHTTPTarget() *HTTPTarget {
+	return &HTTPTarget{}
+}
+
+func (p *HTTPTarget) InitDefault() {
+}
+
+var HTTPTarget_URL_DEFAULT string
+
+func (p *HTTPTarget) GetURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURL() {
+		return HTTPTarget_URL_DEFAULT
+	}
+	return *p.URL
+}
+
+var HTTPTarget_Method_DEFAULT string
+
+func (p *HTTPTarget) GetMethod() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMethod() {
+		return HTTPTarget_Method_DEFAULT
+	}
+	return *p.Method
+}
+
+var HTTPTarget_Headers_DEFAULT map[string]string
+
+func (p *HTTPTarget) GetHeaders() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHeaders() {
+		return HTTPTarget_Headers_DEFAULT
+	}
+	return p.Headers
+}
+
+var HTTPTarget_Auth_DEFAULT *HTTPAuth
+
+func (p *HTTPTarget) GetAuth() (v *HTTPAuth) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAuth() {
+		return HTTPTarget_Auth_DEFAULT
+	}
+	return p.Auth
+}
+
+var HTTPTarget_BodyTemplate_DEFAULT string
+
+func (p *HTTPTarget) GetBodyTemplate() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBodyTemplate() {
+		return HTTPTarget_BodyTemplate_DEFAULT
+	}
+	return *p.BodyTemplate
+}
+
+var HTTPTarget_OutputMappings_DEFAULT []*HTTPOutputMapping
+
+func (p *HTTPTarget) GetOutputMappings() (v []*HTTPOutputMapping) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputMappings() {
+		return HTTPTarget_OutputMappings_DEFAULT
+	}
+	return p.OutputMappings
+}
+
+var HTTPTarget_TimeoutMs_DEFAULT int64
+
+func (p *HTTPTarget) GetTimeoutMs() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTimeoutMs() {
+		return HTTPTarget_TimeoutMs_DEFAULT
+	}
+	return *p.TimeoutMs
+}
+
+var HTTPTarget_RetryTimes_DEFAULT int32
+
+func (p *HTTPTarget) GetRetryTimes() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRetryTimes() {
+		return HTTPTarget_RetryTimes_DEFAULT
+	}
+	return *p.RetryTimes
+}
+
+var HTTPTarget_RetryIntervalMs_DEFAULT int64
+
+func (p *HTTPTarget) GetRetryIntervalMs() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRetryIntervalMs() {
+		return HTTPTarget_RetryIntervalMs_DEFAULT
+	}
+	return *p.RetryIntervalMs
+}
+func (p *HTTPTarget) SetURL(val *string) {
+	p.URL = val
+}
+func (p *HTTPTarget) SetMethod(val *string) {
+	p.Method = val
+}
+func (p *HTTPTarget) SetHeaders(val map[string]string) {
+	p.Headers = val
+}
+func (p *HTTPTarget) SetAuth(val *HTTPAuth) {
+	p.Auth = val
+}
+func (p *HTTPTarget) SetBodyTemplate(val *string) {
+	p.BodyTemplate = val
+}
+func (p *HTTPTarget) SetOutputMappings(val []*HTTPOutputMapping) {
+	p.OutputMappings = val
+}
+func (p *HTTPTarget) SetTimeoutMs(val *int64) {
+	p.TimeoutMs = val
+}
+func (p *HTTPTarget) SetRetryTimes(val *int32) {
+	p.RetryTimes = val
+}
+func (p *HTTPTarget) SetRetryIntervalMs(val *int64) {
+	p.RetryIntervalMs = val
+}
+
+var fieldIDToName_HTTPTarget = map[int16]string{
+	1: "url",
+	2: "method",
+	3: "headers",
+	4: "auth",
+	5: "body_template",
+	6: "output_mappings",
+	7: "timeout_ms",
+	8: "retry_times",
+	9: "retry_interval_ms",
+}
+
+func (p *HTTPTarget) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *HTTPTarget) IsSetMethod() bool {
+	return p.Method != nil
+}
+
+func (p *HTTPTarget) IsSetHeaders() bool {
+	return p.Headers != nil
+}
+
+func (p *HTTPTarget) IsSetAuth() bool {
+	return p.Auth != nil
+}
+
+func (p *HTTPTarget) IsSetBodyTemplate() bool {
+	return p.BodyTemplate != nil
+}
+
+func (p *HTTPTarget) IsSetOutputMappings() bool {
+	return p.OutputMappings != nil
+}
+
+func (p *HTTPTarget) IsSetTimeoutMs() bool {
+	return p.TimeoutMs != nil
+}
+
+func (p *HTTPTarget) IsSetRetryTimes() bool {
+	return p.RetryTimes != nil
+}
+
+func (p *HTTPTarget) IsSetRetryIntervalMs() bool {
+	return p.RetryIntervalMs != nil
+}
+
+func (p *HTTPTarget) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPTarget[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *HTTPTarget) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *HTTPTarget) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Method = _field
+	return nil
+}
+func (p *HTTPTarget) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Headers = _field
+	return nil
+}
+func (p *HTTPTarget) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewHTTPAuth()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Auth = _field
+	return nil
+}
+func (p *HTTPTarget) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BodyTemplate = _field
+	return nil
+}
+func (p *HTTPTarget) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*HTTPOutputMapping, 0, size)
+	values := make([]HTTPOutputMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OutputMappings = _field
+	return nil
+}
+func (p *HTTPTarget) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TimeoutMs = _field
+	return nil
+}
+func (p *HTTPTarget) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RetryTimes = _field
+	return nil
+}
+func (p *HTTPTarget) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RetryIntervalMs = _field
+	return nil
+}
+
+func (p *HTTPTarget) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HTTPTarget"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HTTPTarget) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL() {
+		if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *HTTPTarget) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMethod() {
+		if err = oprot.WriteFieldBegin("method", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Method); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *HTTPTarget) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeaders() {
+		if err = oprot.WriteFieldBegin("headers", thrift.MAP, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Headers)); err != nil {
+			return err
+		}
+		for k, v := range p.Headers {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *HTTPTarget) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuth() {
+		if err = oprot.WriteFieldBegin("auth", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Auth.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *HTTPTarget) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBodyTemplate() {
+		if err = oprot.WriteFieldBegin("body_template", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.BodyTemplate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *HTTPTarget) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputMappings() {
+		if err = oprot.WriteFieldBegin("output_mappings", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputMappings)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputMappings {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *HTTPTarget) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeoutMs() {
+		if err = oprot.WriteFieldBegin("timeout_ms", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TimeoutMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *HTTPTarget) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryTimes() {
+		if err = oprot.WriteFieldBegin("retry_times", thrift.I32, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RetryTimes); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *HTTPTarget) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryIntervalMs() {
+		if err = oprot.WriteFieldBegin("retry_interval_ms", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RetryIntervalMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *HTTPTarget) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HTTPTarget(%+v)", *p)
+
+}
+
+func (p *HTTPTarget) DeepEqual(ano *HTTPTarget) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.URL) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Method) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Headers) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Auth) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.BodyTemplate) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.OutputMappings) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.TimeoutMs) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.RetryTimes) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.RetryIntervalMs) {
+		return false
+	}
+	return true
+}
+
+func (p *HTTPTarget) Field1DeepEqual(src *string) bool {
+
+	if p.URL == src {
+		return true
+	} else if p.URL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPTarget) Field2DeepEqual(src *string) bool {
+
+	if p.Method == src {
+		return true
+	} else if p.Method == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Method, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPTarget) Field3DeepEqual(src map[string]string) bool {
+
+	if len(p.Headers) != len(src) {
+		return false
+	}
+	for k, v := range p.Headers {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *HTTPTarget) Field4DeepEqual(src *HTTPAuth) bool {
+
+	if !p.Auth.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *HTTPTarget) Field5DeepEqual(src *string) bool {
+
+	if p.BodyTemplate == src {
+		return true
+	} else if p.BodyTemplate == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.BodyTemplate, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPTarget) Field6DeepEqual(src []*HTTPOutputMapping) bool {
+
+	if len(p.OutputMappings) != len(src) {
+		return false
+	}
+	for i, v := range p.OutputMappings {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *HTTPTarget) Field7DeepEqual(src *int64) bool {
+
+	if p.TimeoutMs == src {
+		return true
+	} else if p.TimeoutMs == nil || src == nil {
+		return false
+	}
+	if *p.TimeoutMs != *src {
+		return false
+	}
+	return true
+}
+func (p *HTTPTarget) Field8DeepEqual(src *int32) bool {
+
+	if p.RetryTimes == src {
+		return true
+	} else if p.RetryTimes == nil || src == nil {
+		return false
+	}
+	if *p.RetryTimes != *src {
+		return false
+	}
+	return true
+}
+func (p *HTTPTarget) Field9DeepEqual(src *int64) bool {
+
+	if p.RetryIntervalMs == src {
+		return true
+	} else if p.RetryIntervalMs == nil || src == nil {
+		return false
+	}
+	if *p.RetryIntervalMs != *src {
+		return false
+	}
+	return true
+}
+
+type HTTPAuth struct {
+	AuthType   *HTTPAuthType `thrift:"auth_type,1,optional" frugal:"1,optional,HTTPAuthType" form:"auth_type" json:"auth_type,omitempty" query:"auth_type"`
+	Token      *string       `thrift:"token,2,optional" frugal:"2,optional,string" form:"token" json:"token,omitempty" query:"token"`
+	Username   *string       `thrift:"username,3,optional" frugal:"3,optional,string" form:"username" json:"username,omitempty" query:"username"`
+	Password   *string       `thrift:"password,4,optional" frugal:"4,optional,string" form:"password" json:"password,omitempty" query:"password"`
+	HeaderName *string       `thrift:"header_name,5,optional" frugal:"5,optional,string" form:"header_name" json:"header_name,omitempty" query:"header_name"`
+}
+
+func NewHTTPAuth() *HTTPAuth {
+	return &HTTPAuth{}
+}
+
+func (p *HTTPAuth) InitDefault() {
+}
+
+var HTTPAuth_AuthType_DEFAULT HTTPAuthType
+
+func (p *HTTPAuth) GetAuthType() (v HTTPAuthType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAuthType() {
+		return HTTPAuth_AuthType_DEFAULT
+	}
+	return *p.AuthType
+}
+
+var HTTPAuth_Token_DEFAULT string
+
+func (p *HTTPAuth) GetToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetToken() {
+		return HTTPAuth_Token_DEFAULT
+	}
+	return *p.Token
+}
+
+var HTTPAuth_Username_DEFAULT string
+
+func (p *HTTPAuth) GetUsername() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUsername() {
+		return HTTPAuth_Username_DEFAULT
+	}
+	return *p.Username
+}
+
+var HTTPAuth_Password_DEFAULT string
+
+func (p *HTTPAuth) GetPassword() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassword() {
+		return HTTPAuth_Password_DEFAULT
+	}
+	return *p.Password
+}
+
+var HTTPAuth_HeaderName_DEFAULT string
+
+func (p *HTTPAuth) GetHeaderName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHeaderName() {
+		return HTTPAuth_HeaderName_DEFAULT
+	}
+	return *p.HeaderName
+}
+func (p *HTTPAuth) SetAuthType(val *HTTPAuthType) {
+	p.AuthType = val
+}
+func (p *HTTPAuth) SetToken(val *string) {
+	p.Token = val
+}
+func (p *HTTPAuth) SetUsername(val *string) {
+	p.Username = val
+}
+func (p *HTTPAuth) SetPassword(val *string) {
+	p.Password = val
+}
+func (p *HTTPAuth) SetHeaderName(val *string) {
+	p.HeaderName = val
+}
+
+var fieldIDToName_HTTPAuth = map[int16]string{
+	1: "auth_type",
+	2: "token",
+	3: "username",
+	4: "password",
+	5: "header_name",
+}
+
+func (p *HTTPAuth) IsSetAuthType() bool {
+	return p.AuthType != nil
+}
+
+func (p *HTTPAuth) IsSetToken() bool {
+	return p.Token != nil
+}
+
+func (p *HTTPAuth) IsSetUsername() bool {
+	return p.Username != nil
+}
+
+func (p *HTTPAuth) IsSetPassword() bool {
+	return p.Password != nil
+}
+
+func (p *HTTPAuth) IsSetHeaderName() bool {
+	return p.HeaderName != nil
+}
+
+func (p *HTTPAuth) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPAuth[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *HTTPAuth) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *HTTPAuthType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := HTTPAuthType(v)
+		_field = &tmp
+	}
+	p.AuthType = _field
+	return nil
+}
+func (p *HTTPAuth) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Token = _field
+	return nil
+}
+func (p *HTTPAuth) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Username = _field
+	return nil
+}
+func (p *HTTPAuth) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Password = _field
+	return nil
+}
+func (p *HTTPAuth) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.HeaderName = _field
+	return nil
+}
+
+func (p *HTTPAuth) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HTTPAuth"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HTTPAuth) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthType() {
+		if err = oprot.WriteFieldBegin("auth_type", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.AuthType)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *HTTPAuth) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToken() {
+		if err = oprot.WriteFieldBegin("token", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Token); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *HTTPAuth) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUsername() {
+		if err = oprot.WriteFieldBegin("username", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Username); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *HTTPAuth) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassword() {
+		if err = oprot.WriteFieldBegin("password", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Password); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *HTTPAuth) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeaderName() {
+		if err = oprot.WriteFieldBegin("header_name", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.HeaderName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *HTTPAuth) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HTTPAuth(%+v)", *p)
+
+}
+
+func (p *HTTPAuth) DeepEqual(ano *HTTPAuth) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AuthType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Token) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Username) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Password) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HeaderName) {
+		return false
+	}
+	return true
+}
+
+func (p *HTTPAuth) Field1DeepEqual(src *HTTPAuthType) bool {
+
+	if p.AuthType == src {
+		return true
+	} else if p.AuthType == nil || src == nil {
+		return false
+	}
+	if *p.AuthType != *src {
+		return false
+	}
+	return true
+}
+func (p *HTTPAuth) Field2DeepEqual(src *string) bool {
+
+	if p.Token == src {
+		return true
+	} else if p.Token == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Token, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPAuth) Field3DeepEqual(src *string) bool {
+
+	if p.Username == src {
+		return true
+	} else if p.Username == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Username, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPAuth) Field4DeepEqual(src *string) bool {
+
+	if p.Password == src {
+		return true
+	} else if p.Password == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Password, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPAuth) Field5DeepEqual(src *string) bool {
+
+	if p.HeaderName == src {
+		return true
+	} else if p.HeaderName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.HeaderName, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type HTTPOutputMapping struct {
+	// 输出字段名
+	FieldKey *string `thrift:"field_key,1,optional" frugal:"1,optional,string" form:"field_key" json:"field_key,omitempty" query:"field_key"`
+	// 响应体中的 JSONPath，例如 $.data.answer
+	JSONPath *string `thrift:"json_path,2,optional" frugal:"2,optional,string" form:"json_path" json:"json_path,omitempty" query:"json_path"`
+}
+
+func NewHTTPOutputMapping() *HTTPOutputMapping {
+	return &HTTPOutputMapping{}
+}
+
+func (p *HTTPOutputMapping) InitDefault() {
+}
+
+var HTTPOutputMapping_FieldKey_DEFAULT string
+
+func (p *HTTPOutputMapping) GetFieldKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldKey() {
+		return HTTPOutputMapping_FieldKey_DEFAULT
+	}
+	return *p.FieldKey
+}
+
+var HTTPOutputMapping_JSONPath_DEFAULT string
+
+func (p *HTTPOutputMapping) GetJSONPath() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetJSONPath() {
+		return HTTPOutputMapping_JSONPath_DEFAULT
+	}
+	return *p.JSONPath
+}
+func (p *HTTPOutputMapping) SetFieldKey(val *string) {
+	p.FieldKey = val
+}
+func (p *HTTPOutputMapping) SetJSONPath(val *string) {
+	p.JSONPath = val
+}
+
+var fieldIDToName_HTTPOutputMapping = map[int16]string{
+	1: "field_key",
+	2: "json_path",
+}
+
+func (p *HTTPOutputMapping) IsSetFieldKey() bool {
+	return p.FieldKey != nil
+}
+
+func (p *HTTPOutputMapping) IsSetJSONPath() bool {
+	return p.JSONPath != nil
+}
+
+func (p *HTTPOutputMapping) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPOutputMapping[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *HTTPOutputMapping) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FieldKey = _field
+	return nil
 }
-func (p *EvalTargetContent) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRuntimeParamJSONDemo() {
-		if err = oprot.WriteFieldBegin("runtime_param_json_demo", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.RuntimeParamJSONDemo); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *HTTPOutputMapping) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.JSONPath = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvalTargetContent) writeField101(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCozeBot() {
-		if err = oprot.WriteFieldBegin("coze_bot", thrift.STRUCT, 101); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.CozeBot.Write(oprot); err != nil {
-			return err
+
+func (p *HTTPOutputMapping) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HTTPOutputMapping"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
 		}
 	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *EvalTargetContent) writeField102(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPrompt() {
-		if err = oprot.WriteFieldBegin("prompt", thrift.STRUCT, 102); err != nil {
+
+func (p *HTTPOutputMapping) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldKey() {
+		if err = oprot.WriteFieldBegin("field_key", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Prompt.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.FieldKey); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1838,16 +3607,16 @@ func (p *EvalTargetContent) writeField102(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvalTargetContent) writeField103(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCozeWorkflow() {
-		if err = oprot.WriteFieldBegin("coze_workflow", thrift.STRUCT, 103); err != nil {
+func (p *HTTPOutputMapping) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJSONPath() {
+		if err = oprot.WriteFieldBegin("json_path", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.CozeWorkflow.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.JSONPath); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1856,101 +3625,54 @@ func (p *EvalTargetContent) writeField103(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *EvalTargetContent) String() string {
+func (p *HTTPOutputMapping) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvalTargetContent(%+v)", *p)
+	return fmt.Sprintf("HTTPOutputMapping(%+v)", *p)
 
 }
 
-func (p *EvalTargetContent) DeepEqual(ano *EvalTargetContent) bool {
+func (p *HTTPOutputMapping) DeepEqual(ano *HTTPOutputMapping) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.InputSchemas) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.OutputSchemas) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.RuntimeParamJSONDemo) {
-		return false
-	}
-	if !p.Field101DeepEqual(ano.CozeBot) {
-		return false
-	}
-	if !p.Field102DeepEqual(ano.Prompt) {
+	if !p.Field1DeepEqual(ano.FieldKey) {
 		return false
 	}
-	if !p.Field103DeepEqual(ano.CozeWorkflow) {
-		return false
-	}
-	return true
-}
-
-func (p *EvalTargetContent) Field1DeepEqual(src []*common.ArgsSchema) bool {
-
-	if len(p.InputSchemas) != len(src) {
+	if !p.Field2DeepEqual(ano.JSONPath) {
 		return false
 	}
-	for i, v := range p.InputSchemas {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *EvalTargetContent) Field2DeepEqual(src []*common.ArgsSchema) bool {
 
-	if len(p.OutputSchemas) != len(src) {
-		return false
-	}
-	for i, v := range p.OutputSchemas {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *EvalTargetContent) Field3DeepEqual(src *string) bool {
+func (p *HTTPOutputMapping) Field1DeepEqual(src *string) bool {
 
-	if p.RuntimeParamJSONDemo == src {
+	if p.FieldKey == src {
 		return true
-	} else if p.RuntimeParamJSONDemo == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.RuntimeParamJSONDemo, *src) != 0 {
+	} else if p.FieldKey == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *EvalTargetContent) Field101DeepEqual(src *CozeBot) bool {
-
-	if !p.CozeBot.DeepEqual(src) {
+	if strings.Compare(*p.FieldKey, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvalTargetContent) Field102DeepEqual(src *EvalPrompt) bool {
+func (p *HTTPOutputMapping) Field2DeepEqual(src *string) bool {
 
-	if !p.Prompt.DeepEqual(src) {
+	if p.JSONPath == src {
+		return true
+	} else if p.JSONPath == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *EvalTargetContent) Field103DeepEqual(src *CozeWorkflow) bool {
-
-	if !p.CozeWorkflow.DeepEqual(src) {
+	if strings.Compare(*p.JSONPath, *src) != 0 {
 		return false
 	}
 	return true
@@ -2528,6 +4250,8 @@ type EvalPrompt struct {
 	SubmitStatus *SubmitStatus `thrift:"submit_status,5,optional" frugal:"5,optional,SubmitStatus" form:"submit_status" json:"submit_status,omitempty" query:"submit_status"`
 	// DTO使用，不存数据库
 	Description *string `thrift:"description,6,optional" frugal:"6,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	// 发布标签，非空时每次执行按标签解析最新指向的提交版本
+	Label *string `thrift:"label,7,optional" frugal:"7,optional,string" form:"label" json:"label,omitempty" query:"label"`
 }
 
 func NewEvalPrompt() *EvalPrompt {
@@ -2608,6 +4332,18 @@ func (p *EvalPrompt) GetDescription() (v string) {
 	}
 	return *p.Description
 }
+
+var EvalPrompt_Label_DEFAULT string
+
+func (p *EvalPrompt) GetLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return EvalPrompt_Label_DEFAULT
+	}
+	return *p.Label
+}
 func (p *EvalPrompt) SetPromptID(val *int64) {
 	p.PromptID = val
 }
@@ -2626,6 +4362,9 @@ func (p *EvalPrompt) SetSubmitStatus(val *SubmitStatus) {
 func (p *EvalPrompt) SetDescription(val *string) {
 	p.Description = val
 }
+func (p *EvalPrompt) SetLabel(val *string) {
+	p.Label = val
+}
 
 var fieldIDToName_EvalPrompt = map[int16]string{
 	1: "prompt_id",
@@ -2634,6 +4373,7 @@ var fieldIDToName_EvalPrompt = map[int16]string{
 	4: "prompt_key",
 	5: "submit_status",
 	6: "description",
+	7: "label",
 }
 
 func (p *EvalPrompt) IsSetPromptID() bool {
@@ -2660,6 +4400,10 @@ func (p *EvalPrompt) IsSetDescription() bool {
 	return p.Description != nil
 }
 
+func (p *EvalPrompt) IsSetLabel() bool {
+	return p.Label != nil
+}
+
 func (p *EvalPrompt) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2726,6 +4470,14 @@ func (p *EvalPrompt) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2822,6 +4574,17 @@ func (p *EvalPrompt) ReadField6(iprot thrift.TProtocol) error {
 	p.Description = _field
 	return nil
 }
+func (p *EvalPrompt) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Label = _field
+	return nil
+}
 
 func (p *EvalPrompt) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2853,6 +4616,10 @@ func (p *EvalPrompt) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2979,6 +4746,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
+func (p *EvalPrompt) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
 func (p *EvalPrompt) String() string {
 	if p == nil {
@@ -3012,6 +4797,9 @@ func (p *EvalPrompt) DeepEqual(ano *EvalPrompt) bool {
 	if !p.Field6DeepEqual(ano.Description) {
 		return false
 	}
+	if !p.Field7DeepEqual(ano.Label) {
+		return false
+	}
 	return true
 }
 
@@ -3087,6 +4875,18 @@ func (p *EvalPrompt) Field6DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *EvalPrompt) Field7DeepEqual(src *string) bool {
+
+	if p.Label == src {
+		return true
+	} else if p.Label == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Label, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 // Coze2.0Bot
 type CozeBot struct {
@@ -5884,6 +7684,8 @@ type EvalTargetOutputData struct {
 	EvalTargetRunError *EvalTargetRunError `thrift:"eval_target_run_error,3,optional" frugal:"3,optional,EvalTargetRunError" form:"eval_target_run_error" json:"eval_target_run_error,omitempty" query:"eval_target_run_error"`
 	// 运行耗时
 	TimeConsumingMs *int64 `thrift:"time_consuming_ms,4,optional" frugal:"4,optional,i64" json:"time_consuming_ms" form:"time_consuming_ms" query:"time_consuming_ms"`
+	// 按发布标签执行时实际运行的提交版本
+	ResolvedSourceTargetVersion *string `thrift:"resolved_source_target_version,5,optional" frugal:"5,optional,string" form:"resolved_source_target_version" json:"resolved_source_target_version,omitempty" query:"resolved_source_target_version"`
 }
 
 func NewEvalTargetOutputData() *EvalTargetOutputData {
@@ -5940,6 +7742,18 @@ func (p *EvalTargetOutputData) GetTimeConsumingMs() (v int64) {
 	}
 	return *p.TimeConsumingMs
 }
+
+var EvalTargetOutputData_ResolvedSourceTargetVersion_DEFAULT string
+
+func (p *EvalTargetOutputData) GetResolvedSourceTargetVersion() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetResolvedSourceTargetVersion() {
+		return EvalTargetOutputData_ResolvedSourceTargetVersion_DEFAULT
+	}
+	return *p.ResolvedSourceTargetVersion
+}
 func (p *EvalTargetOutputData) SetOutputFields(val map[string]*common.Content) {
 	p.OutputFields = val
 }
@@ -5952,12 +7766,16 @@ func (p *EvalTargetOutputData) SetEvalTargetRunError(val *EvalTargetRunError) {
 func (p *EvalTargetOutputData) SetTimeConsumingMs(val *int64) {
 	p.TimeConsumingMs = val
 }
+func (p *EvalTargetOutputData) SetResolvedSourceTargetVersion(val *string) {
+	p.ResolvedSourceTargetVersion = val
+}
 
 var fieldIDToName_EvalTargetOutputData = map[int16]string{
 	1: "output_fields",
 	2: "eval_target_usage",
 	3: "eval_target_run_error",
 	4: "time_consuming_ms",
+	5: "resolved_source_target_version",
 }
 
 func (p *EvalTargetOutputData) IsSetOutputFields() bool {
@@ -5976,6 +7794,10 @@ func (p *EvalTargetOutputData) IsSetTimeConsumingMs() bool {
 	return p.TimeConsumingMs != nil
 }
 
+func (p *EvalTargetOutputData) IsSetResolvedSourceTargetVersion() bool {
+	return p.ResolvedSourceTargetVersion != nil
+}
+
 func (p *EvalTargetOutputData) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6026,6 +7848,14 @@ func (p *EvalTargetOutputData) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6111,6 +7941,17 @@ func (p *EvalTargetOutputData) ReadField4(iprot thrift.TProtocol) error {
 	p.TimeConsumingMs = _field
 	return nil
 }
+func (p *EvalTargetOutputData) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ResolvedSourceTargetVersion = _field
+	return nil
+}
 
 func (p *EvalTargetOutputData) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -6134,6 +7975,10 @@ func (p *EvalTargetOutputData) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6235,6 +8080,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
+func (p *EvalTargetOutputData) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResolvedSourceTargetVersion() {
+		if err = oprot.WriteFieldBegin("resolved_source_target_version", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ResolvedSourceTargetVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
 func (p *EvalTargetOutputData) String() string {
 	if p == nil {
@@ -6262,6 +8125,9 @@ func (p *EvalTargetOutputData) DeepEqual(ano *EvalTargetOutputData) bool {
 	if !p.Field4DeepEqual(ano.TimeConsumingMs) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.ResolvedSourceTargetVersion) {
+		return false
+	}
 	return true
 }
 
@@ -6304,6 +8170,18 @@ func (p *EvalTargetOutputData) Field4DeepEqual(src *int64) bool {
 	}
 	return true
 }
+func (p *EvalTargetOutputData) Field5DeepEqual(src *string) bool {
+
+	if p.ResolvedSourceTargetVersion == src {
+		return true
+	} else if p.ResolvedSourceTargetVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ResolvedSourceTargetVersion, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type EvalTargetUsage struct {
 	InputTokens  int64 `thrift:"input_tokens,1" frugal:"1,default,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
@@ -63,6 +63,25 @@ func (p *EvalTargetContent) IsValid() error {
 			return fmt.Errorf("field CozeWorkflow not valid, %w", err)
 		}
 	}
+	if p.HTTPTarget != nil {
+		if err := p.HTTPTarget.IsValid(); err != nil {
+			return fmt.Errorf("field HTTPTarget not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *HTTPTarget) IsValid() error {
+	if p.Auth != nil {
+		if err := p.Auth.IsValid(); err != nil {
+			return fmt.Errorf("field Auth not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *HTTPAuth) IsValid() error {
+	return nil
+}
+func (p *HTTPOutputMapping) IsValid() error {
 	return nil
 }
 func (p *CozeWorkflow) IsValid() error {
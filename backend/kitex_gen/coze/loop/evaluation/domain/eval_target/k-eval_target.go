@@ -908,6 +908,20 @@ func (p *EvalTargetContent) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 104:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField104(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1026,6 +1040,18 @@ func (p *EvalTargetContent) FastReadField103(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *EvalTargetContent) FastReadField104(buf []byte) (int, error) {
+	offset := 0
+	_field := NewHTTPTarget()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.HTTPTarget = _field
+	return offset, nil
+}
+
 func (p *EvalTargetContent) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -1039,6 +1065,7 @@ func (p *EvalTargetContent) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) i
 		offset += p.fastWriteField101(buf[offset:], w)
 		offset += p.fastWriteField102(buf[offset:], w)
 		offset += p.fastWriteField103(buf[offset:], w)
+		offset += p.fastWriteField104(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -1053,6 +1080,7 @@ func (p *EvalTargetContent) BLength() int {
 		l += p.field101Length()
 		l += p.field102Length()
 		l += p.field103Length()
+		l += p.field104Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -1085,179 +1113,1353 @@ func (p *EvalTargetContent) fastWriteField2(buf []byte, w thrift.NocopyWriter) i
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvalTargetContent) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRuntimeParamJSONDemo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.RuntimeParamJSONDemo)
+	}
+	return offset
+}
+
+func (p *EvalTargetContent) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCozeBot() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 101)
+		offset += p.CozeBot.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvalTargetContent) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPrompt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 102)
+		offset += p.Prompt.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvalTargetContent) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCozeWorkflow() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 103)
+		offset += p.CozeWorkflow.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvalTargetContent) fastWriteField104(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHTTPTarget() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 104)
+		offset += p.HTTPTarget.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvalTargetContent) field1Length() int {
+	l := 0
+	if p.IsSetInputSchemas() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.InputSchemas {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvalTargetContent) field2Length() int {
+	l := 0
+	if p.IsSetOutputSchemas() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OutputSchemas {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvalTargetContent) field3Length() int {
+	l := 0
+	if p.IsSetRuntimeParamJSONDemo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.RuntimeParamJSONDemo)
+	}
+	return l
+}
+
+func (p *EvalTargetContent) field101Length() int {
+	l := 0
+	if p.IsSetCozeBot() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.CozeBot.BLength()
+	}
+	return l
+}
+
+func (p *EvalTargetContent) field102Length() int {
+	l := 0
+	if p.IsSetPrompt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Prompt.BLength()
+	}
+	return l
+}
+
+func (p *EvalTargetContent) field103Length() int {
+	l := 0
+	if p.IsSetCozeWorkflow() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.CozeWorkflow.BLength()
+	}
+	return l
+}
+
+func (p *EvalTargetContent) field104Length() int {
+	l := 0
+	if p.IsSetHTTPTarget() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.HTTPTarget.BLength()
+	}
+	return l
+}
+
+func (p *EvalTargetContent) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvalTargetContent)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.InputSchemas != nil {
+		p.InputSchemas = make([]*common.ArgsSchema, 0, len(src.InputSchemas))
+		for _, elem := range src.InputSchemas {
+			var _elem *common.ArgsSchema
+			if elem != nil {
+				_elem = &common.ArgsSchema{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.InputSchemas = append(p.InputSchemas, _elem)
+		}
+	}
+
+	if src.OutputSchemas != nil {
+		p.OutputSchemas = make([]*common.ArgsSchema, 0, len(src.OutputSchemas))
+		for _, elem := range src.OutputSchemas {
+			var _elem *common.ArgsSchema
+			if elem != nil {
+				_elem = &common.ArgsSchema{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.OutputSchemas = append(p.OutputSchemas, _elem)
+		}
+	}
+
+	if src.RuntimeParamJSONDemo != nil {
+		var tmp string
+		if *src.RuntimeParamJSONDemo != "" {
+			tmp = kutils.StringDeepCopy(*src.RuntimeParamJSONDemo)
+		}
+		p.RuntimeParamJSONDemo = &tmp
+	}
+
+	var _cozeBot *CozeBot
+	if src.CozeBot != nil {
+		_cozeBot = &CozeBot{}
+		if err := _cozeBot.DeepCopy(src.CozeBot); err != nil {
+			return err
+		}
+	}
+	p.CozeBot = _cozeBot
+
+	var _prompt *EvalPrompt
+	if src.Prompt != nil {
+		_prompt = &EvalPrompt{}
+		if err := _prompt.DeepCopy(src.Prompt); err != nil {
+			return err
+		}
+	}
+	p.Prompt = _prompt
+
+	var _cozeWorkflow *CozeWorkflow
+	if src.CozeWorkflow != nil {
+		_cozeWorkflow = &CozeWorkflow{}
+		if err := _cozeWorkflow.DeepCopy(src.CozeWorkflow); err != nil {
+			return err
+		}
+	}
+	p.CozeWorkflow = _cozeWorkflow
+
+	var _hTTPTarget *HTTPTarget
+	if src.HTTPTarget != nil {
+		_hTTPTarget = &HTTPTarget{}
+		if err := _hTTPTarget.DeepCopy(src.HTTPTarget); err != nil {
+			return err
+		}
+	}
+	p.HTTPTarget = _hTTPTarget
+
+	return nil
+}
+
+func (p *HTTPTarget) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPTarget[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *HTTPTarget) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.URL = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Method = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.Headers = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewHTTPAuth()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Auth = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.BodyTemplate = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*HTTPOutputMapping, 0, size)
+	values := make([]HTTPOutputMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.OutputMappings = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TimeoutMs = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.RetryTimes = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.RetryIntervalMs = _field
+	return offset, nil
+}
+
+func (p *HTTPTarget) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *HTTPTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *HTTPTarget) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *HTTPTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetURL() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URL)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMethod() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Method)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHeaders() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Headers {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAuth() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.Auth.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBodyTemplate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BodyTemplate)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOutputMappings() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.OutputMappings {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTimeoutMs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 7)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TimeoutMs)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRetryTimes() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 8)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.RetryTimes)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRetryIntervalMs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 9)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.RetryIntervalMs)
+	}
+	return offset
+}
+
+func (p *HTTPTarget) field1Length() int {
+	l := 0
+	if p.IsSetURL() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.URL)
+	}
+	return l
+}
+
+func (p *HTTPTarget) field2Length() int {
+	l := 0
+	if p.IsSetMethod() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Method)
+	}
+	return l
+}
+
+func (p *HTTPTarget) field3Length() int {
+	l := 0
+	if p.IsSetHeaders() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Headers {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *HTTPTarget) field4Length() int {
+	l := 0
+	if p.IsSetAuth() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Auth.BLength()
+	}
+	return l
+}
+
+func (p *HTTPTarget) field5Length() int {
+	l := 0
+	if p.IsSetBodyTemplate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.BodyTemplate)
+	}
+	return l
+}
+
+func (p *HTTPTarget) field6Length() int {
+	l := 0
+	if p.IsSetOutputMappings() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OutputMappings {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *HTTPTarget) field7Length() int {
+	l := 0
+	if p.IsSetTimeoutMs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *HTTPTarget) field8Length() int {
+	l := 0
+	if p.IsSetRetryTimes() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *HTTPTarget) field9Length() int {
+	l := 0
+	if p.IsSetRetryIntervalMs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *HTTPTarget) DeepCopy(s interface{}) error {
+	src, ok := s.(*HTTPTarget)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.URL != nil {
+		var tmp string
+		if *src.URL != "" {
+			tmp = kutils.StringDeepCopy(*src.URL)
+		}
+		p.URL = &tmp
+	}
+
+	if src.Method != nil {
+		var tmp string
+		if *src.Method != "" {
+			tmp = kutils.StringDeepCopy(*src.Method)
+		}
+		p.Method = &tmp
+	}
+
+	if src.Headers != nil {
+		p.Headers = make(map[string]string, len(src.Headers))
+		for key, val := range src.Headers {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val string
+			if val != "" {
+				_val = kutils.StringDeepCopy(val)
+			}
+
+			p.Headers[_key] = _val
+		}
+	}
+
+	var _auth *HTTPAuth
+	if src.Auth != nil {
+		_auth = &HTTPAuth{}
+		if err := _auth.DeepCopy(src.Auth); err != nil {
+			return err
+		}
+	}
+	p.Auth = _auth
+
+	if src.BodyTemplate != nil {
+		var tmp string
+		if *src.BodyTemplate != "" {
+			tmp = kutils.StringDeepCopy(*src.BodyTemplate)
+		}
+		p.BodyTemplate = &tmp
+	}
+
+	if src.OutputMappings != nil {
+		p.OutputMappings = make([]*HTTPOutputMapping, 0, len(src.OutputMappings))
+		for _, elem := range src.OutputMappings {
+			var _elem *HTTPOutputMapping
+			if elem != nil {
+				_elem = &HTTPOutputMapping{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.OutputMappings = append(p.OutputMappings, _elem)
+		}
+	}
+
+	if src.TimeoutMs != nil {
+		tmp := *src.TimeoutMs
+		p.TimeoutMs = &tmp
+	}
+
+	if src.RetryTimes != nil {
+		tmp := *src.RetryTimes
+		p.RetryTimes = &tmp
+	}
+
+	if src.RetryIntervalMs != nil {
+		tmp := *src.RetryIntervalMs
+		p.RetryIntervalMs = &tmp
+	}
+
+	return nil
+}
+
+func (p *HTTPAuth) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPAuth[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *HTTPAuth) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *HTTPAuthType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := HTTPAuthType(v)
+		_field = &tmp
+	}
+	p.AuthType = _field
+	return offset, nil
+}
+
+func (p *HTTPAuth) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Token = _field
+	return offset, nil
+}
+
+func (p *HTTPAuth) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Username = _field
+	return offset, nil
+}
+
+func (p *HTTPAuth) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Password = _field
+	return offset, nil
+}
+
+func (p *HTTPAuth) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.HeaderName = _field
+	return offset, nil
+}
+
+func (p *HTTPAuth) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *HTTPAuth) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *HTTPAuth) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *HTTPAuth) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAuthType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.AuthType))
+	}
+	return offset
+}
+
+func (p *HTTPAuth) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Token)
+	}
+	return offset
+}
+
+func (p *HTTPAuth) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUsername() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Username)
+	}
+	return offset
+}
+
+func (p *HTTPAuth) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPassword() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Password)
+	}
+	return offset
+}
+
+func (p *HTTPAuth) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHeaderName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.HeaderName)
+	}
+	return offset
+}
+
+func (p *HTTPAuth) field1Length() int {
+	l := 0
+	if p.IsSetAuthType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *HTTPAuth) field2Length() int {
+	l := 0
+	if p.IsSetToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Token)
+	}
+	return l
+}
+
+func (p *HTTPAuth) field3Length() int {
+	l := 0
+	if p.IsSetUsername() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Username)
+	}
+	return l
+}
+
+func (p *HTTPAuth) field4Length() int {
+	l := 0
+	if p.IsSetPassword() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Password)
+	}
+	return l
+}
+
+func (p *HTTPAuth) field5Length() int {
+	l := 0
+	if p.IsSetHeaderName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.HeaderName)
+	}
+	return l
+}
+
+func (p *HTTPAuth) DeepCopy(s interface{}) error {
+	src, ok := s.(*HTTPAuth)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.AuthType != nil {
+		tmp := *src.AuthType
+		p.AuthType = &tmp
+	}
+
+	if src.Token != nil {
+		var tmp string
+		if *src.Token != "" {
+			tmp = kutils.StringDeepCopy(*src.Token)
+		}
+		p.Token = &tmp
+	}
+
+	if src.Username != nil {
+		var tmp string
+		if *src.Username != "" {
+			tmp = kutils.StringDeepCopy(*src.Username)
+		}
+		p.Username = &tmp
+	}
+
+	if src.Password != nil {
+		var tmp string
+		if *src.Password != "" {
+			tmp = kutils.StringDeepCopy(*src.Password)
+		}
+		p.Password = &tmp
+	}
+
+	if src.HeaderName != nil {
+		var tmp string
+		if *src.HeaderName != "" {
+			tmp = kutils.StringDeepCopy(*src.HeaderName)
+		}
+		p.HeaderName = &tmp
+	}
+
+	return nil
+}
+
+func (p *HTTPOutputMapping) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	return offset
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPOutputMapping[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvalTargetContent) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *HTTPOutputMapping) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetRuntimeParamJSONDemo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.RuntimeParamJSONDemo)
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return offset
+	p.FieldKey = _field
+	return offset, nil
 }
 
-func (p *EvalTargetContent) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+func (p *HTTPOutputMapping) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetCozeBot() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 101)
-		offset += p.CozeBot.FastWriteNocopy(buf[offset:], w)
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return offset
+	p.JSONPath = _field
+	return offset, nil
 }
 
-func (p *EvalTargetContent) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPrompt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 102)
-		offset += p.Prompt.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
+func (p *HTTPOutputMapping) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvalTargetContent) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+func (p *HTTPOutputMapping) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetCozeWorkflow() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 103)
-		offset += p.CozeWorkflow.FastWriteNocopy(buf[offset:], w)
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvalTargetContent) field1Length() int {
-	l := 0
-	if p.IsSetInputSchemas() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.InputSchemas {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *EvalTargetContent) field2Length() int {
+func (p *HTTPOutputMapping) BLength() int {
 	l := 0
-	if p.IsSetOutputSchemas() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.OutputSchemas {
-			_ = v
-			l += v.BLength()
-		}
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
 	}
+	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvalTargetContent) field3Length() int {
-	l := 0
-	if p.IsSetRuntimeParamJSONDemo() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.RuntimeParamJSONDemo)
+func (p *HTTPOutputMapping) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldKey)
 	}
-	return l
+	return offset
 }
 
-func (p *EvalTargetContent) field101Length() int {
-	l := 0
-	if p.IsSetCozeBot() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.CozeBot.BLength()
+func (p *HTTPOutputMapping) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetJSONPath() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.JSONPath)
 	}
-	return l
+	return offset
 }
 
-func (p *EvalTargetContent) field102Length() int {
+func (p *HTTPOutputMapping) field1Length() int {
 	l := 0
-	if p.IsSetPrompt() {
+	if p.IsSetFieldKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Prompt.BLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FieldKey)
 	}
 	return l
 }
 
-func (p *EvalTargetContent) field103Length() int {
+func (p *HTTPOutputMapping) field2Length() int {
 	l := 0
-	if p.IsSetCozeWorkflow() {
+	if p.IsSetJSONPath() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.CozeWorkflow.BLength()
+		l += thrift.Binary.StringLengthNocopy(*p.JSONPath)
 	}
 	return l
 }
 
-func (p *EvalTargetContent) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvalTargetContent)
+func (p *HTTPOutputMapping) DeepCopy(s interface{}) error {
+	src, ok := s.(*HTTPOutputMapping)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.InputSchemas != nil {
-		p.InputSchemas = make([]*common.ArgsSchema, 0, len(src.InputSchemas))
-		for _, elem := range src.InputSchemas {
-			var _elem *common.ArgsSchema
-			if elem != nil {
-				_elem = &common.ArgsSchema{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.InputSchemas = append(p.InputSchemas, _elem)
-		}
-	}
-
-	if src.OutputSchemas != nil {
-		p.OutputSchemas = make([]*common.ArgsSchema, 0, len(src.OutputSchemas))
-		for _, elem := range src.OutputSchemas {
-			var _elem *common.ArgsSchema
-			if elem != nil {
-				_elem = &common.ArgsSchema{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.OutputSchemas = append(p.OutputSchemas, _elem)
-		}
-	}
-
-	if src.RuntimeParamJSONDemo != nil {
+	if src.FieldKey != nil {
 		var tmp string
-		if *src.RuntimeParamJSONDemo != "" {
-			tmp = kutils.StringDeepCopy(*src.RuntimeParamJSONDemo)
-		}
-		p.RuntimeParamJSONDemo = &tmp
-	}
-
-	var _cozeBot *CozeBot
-	if src.CozeBot != nil {
-		_cozeBot = &CozeBot{}
-		if err := _cozeBot.DeepCopy(src.CozeBot); err != nil {
-			return err
-		}
-	}
-	p.CozeBot = _cozeBot
-
-	var _prompt *EvalPrompt
-	if src.Prompt != nil {
-		_prompt = &EvalPrompt{}
-		if err := _prompt.DeepCopy(src.Prompt); err != nil {
-			return err
+		if *src.FieldKey != "" {
+			tmp = kutils.StringDeepCopy(*src.FieldKey)
 		}
+		p.FieldKey = &tmp
 	}
-	p.Prompt = _prompt
 
-	var _cozeWorkflow *CozeWorkflow
-	if src.CozeWorkflow != nil {
-		_cozeWorkflow = &CozeWorkflow{}
-		if err := _cozeWorkflow.DeepCopy(src.CozeWorkflow); err != nil {
-			return err
+	if src.JSONPath != nil {
+		var tmp string
+		if *src.JSONPath != "" {
+			tmp = kutils.StringDeepCopy(*src.JSONPath)
 		}
+		p.JSONPath = &tmp
 	}
-	p.CozeWorkflow = _cozeWorkflow
 
 	return nil
 }
@@ -1762,6 +2964,20 @@ func (p *EvalPrompt) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1866,6 +3082,20 @@ func (p *EvalPrompt) FastReadField6(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *EvalPrompt) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Label = _field
+	return offset, nil
+}
+
 func (p *EvalPrompt) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -1879,6 +3109,7 @@ func (p *EvalPrompt) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -1893,6 +3124,7 @@ func (p *EvalPrompt) BLength() int {
 		l += p.field4Length()
 		l += p.field5Length()
 		l += p.field6Length()
+		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -1952,6 +3184,15 @@ func (p *EvalPrompt) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *EvalPrompt) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Label)
+	}
+	return offset
+}
+
 func (p *EvalPrompt) field1Length() int {
 	l := 0
 	if p.IsSetPromptID() {
@@ -2006,6 +3247,15 @@ func (p *EvalPrompt) field6Length() int {
 	return l
 }
 
+func (p *EvalPrompt) field7Length() int {
+	l := 0
+	if p.IsSetLabel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Label)
+	}
+	return l
+}
+
 func (p *EvalPrompt) DeepCopy(s interface{}) error {
 	src, ok := s.(*EvalPrompt)
 	if !ok {
@@ -2054,6 +3304,14 @@ func (p *EvalPrompt) DeepCopy(s interface{}) error {
 		p.Description = &tmp
 	}
 
+	if src.Label != nil {
+		var tmp string
+		if *src.Label != "" {
+			tmp = kutils.StringDeepCopy(*src.Label)
+		}
+		p.Label = &tmp
+	}
+
 	return nil
 }
 
@@ -4159,6 +5417,20 @@ func (p *EvalTargetOutputData) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -4248,6 +5520,20 @@ func (p *EvalTargetOutputData) FastReadField4(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *EvalTargetOutputData) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ResolvedSourceTargetVersion = _field
+	return offset, nil
+}
+
 func (p *EvalTargetOutputData) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -4259,6 +5545,7 @@ func (p *EvalTargetOutputData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -4271,6 +5558,7 @@ func (p *EvalTargetOutputData) BLength() int {
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -4320,6 +5608,15 @@ func (p *EvalTargetOutputData) fastWriteField4(buf []byte, w thrift.NocopyWriter
 	return offset
 }
 
+func (p *EvalTargetOutputData) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetResolvedSourceTargetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ResolvedSourceTargetVersion)
+	}
+	return offset
+}
+
 func (p *EvalTargetOutputData) field1Length() int {
 	l := 0
 	if p.IsSetOutputFields() {
@@ -4362,6 +5659,15 @@ func (p *EvalTargetOutputData) field4Length() int {
 	return l
 }
 
+func (p *EvalTargetOutputData) field5Length() int {
+	l := 0
+	if p.IsSetResolvedSourceTargetVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ResolvedSourceTargetVersion)
+	}
+	return l
+}
+
 func (p *EvalTargetOutputData) DeepCopy(s interface{}) error {
 	src, ok := s.(*EvalTargetOutputData)
 	if !ok {
@@ -4411,6 +5717,14 @@ func (p *EvalTargetOutputData) DeepCopy(s interface{}) error {
 		p.TimeConsumingMs = &tmp
 	}
 
+	if src.ResolvedSourceTargetVersion != nil {
+		var tmp string
+		if *src.ResolvedSourceTargetVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.ResolvedSourceTargetVersion)
+		}
+		p.ResolvedSourceTargetVersion = &tmp
+	}
+
 	return nil
 }
 
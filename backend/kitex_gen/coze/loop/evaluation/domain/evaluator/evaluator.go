@@ -14,8 +14,10 @@ import (
 type EvaluatorType int64
 
 const (
-	EvaluatorType_Prompt EvaluatorType = 1
-	EvaluatorType_Code   EvaluatorType = 2
+	EvaluatorType_Prompt   EvaluatorType = 1
+	EvaluatorType_Code     EvaluatorType = 2
+	EvaluatorType_Builtin  EvaluatorType = 3
+	EvaluatorType_Pairwise EvaluatorType = 4
 )
 
 func (p EvaluatorType) String() string {
@@ -24,6 +26,10 @@ func (p EvaluatorType) String() string {
 		return "Prompt"
 	case EvaluatorType_Code:
 		return "Code"
+	case EvaluatorType_Builtin:
+		return "Builtin"
+	case EvaluatorType_Pairwise:
+		return "Pairwise"
 	}
 	return "<UNSET>"
 }
@@ -34,6 +40,10 @@ func EvaluatorTypeFromString(s string) (EvaluatorType, error) {
 		return EvaluatorType_Prompt, nil
 	case "Code":
 		return EvaluatorType_Code, nil
+	case "Builtin":
+		return EvaluatorType_Builtin, nil
+	case "Pairwise":
+		return EvaluatorType_Pairwise, nil
 	}
 	return EvaluatorType(0), fmt.Errorf("not a valid EvaluatorType string")
 }
@@ -95,6 +105,133 @@ func (p *LanguageType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+// 内置评估器类型，纯规则计算，不依赖 LLM
+type BuiltinEvaluatorType int64
+
+const (
+	// 精确匹配
+	BuiltinEvaluatorType_ExactMatch BuiltinEvaluatorType = 1
+	// 基于编辑距离的模糊匹配
+	BuiltinEvaluatorType_FuzzyMatch BuiltinEvaluatorType = 2
+	BuiltinEvaluatorType_RougeL     BuiltinEvaluatorType = 3
+	BuiltinEvaluatorType_BLEU       BuiltinEvaluatorType = 4
+	// 编辑距离，分数为 1 - 距离/较长文本长度，取值 [0, 1]
+	BuiltinEvaluatorType_EditDistance BuiltinEvaluatorType = 5
+	// JSON 合法性及 schema 校验
+	BuiltinEvaluatorType_JSONSchema BuiltinEvaluatorType = 6
+	// 正则匹配
+	BuiltinEvaluatorType_Regex BuiltinEvaluatorType = 7
+	// 数值误差容忍
+	BuiltinEvaluatorType_NumericTolerance BuiltinEvaluatorType = 8
+)
+
+func (p BuiltinEvaluatorType) String() string {
+	switch p {
+	case BuiltinEvaluatorType_ExactMatch:
+		return "ExactMatch"
+	case BuiltinEvaluatorType_FuzzyMatch:
+		return "FuzzyMatch"
+	case BuiltinEvaluatorType_RougeL:
+		return "RougeL"
+	case BuiltinEvaluatorType_BLEU:
+		return "BLEU"
+	case BuiltinEvaluatorType_EditDistance:
+		return "EditDistance"
+	case BuiltinEvaluatorType_JSONSchema:
+		return "JSONSchema"
+	case BuiltinEvaluatorType_Regex:
+		return "Regex"
+	case BuiltinEvaluatorType_NumericTolerance:
+		return "NumericTolerance"
+	}
+	return "<UNSET>"
+}
+
+func BuiltinEvaluatorTypeFromString(s string) (BuiltinEvaluatorType, error) {
+	switch s {
+	case "ExactMatch":
+		return BuiltinEvaluatorType_ExactMatch, nil
+	case "FuzzyMatch":
+		return BuiltinEvaluatorType_FuzzyMatch, nil
+	case "RougeL":
+		return BuiltinEvaluatorType_RougeL, nil
+	case "BLEU":
+		return BuiltinEvaluatorType_BLEU, nil
+	case "EditDistance":
+		return BuiltinEvaluatorType_EditDistance, nil
+	case "JSONSchema":
+		return BuiltinEvaluatorType_JSONSchema, nil
+	case "Regex":
+		return BuiltinEvaluatorType_Regex, nil
+	case "NumericTolerance":
+		return BuiltinEvaluatorType_NumericTolerance, nil
+	}
+	return BuiltinEvaluatorType(0), fmt.Errorf("not a valid BuiltinEvaluatorType string")
+}
+
+func BuiltinEvaluatorTypePtr(v BuiltinEvaluatorType) *BuiltinEvaluatorType { return &v }
+func (p *BuiltinEvaluatorType) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = BuiltinEvaluatorType(result.Int64)
+	return
+}
+
+func (p *BuiltinEvaluatorType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// 对比评估器的偏好结果，A 为基准实验的输出，B 为当前实验的输出
+type PairwisePreference int64
+
+const (
+	PairwisePreference_A   PairwisePreference = 1
+	PairwisePreference_B   PairwisePreference = 2
+	PairwisePreference_Tie PairwisePreference = 3
+)
+
+func (p PairwisePreference) String() string {
+	switch p {
+	case PairwisePreference_A:
+		return "A"
+	case PairwisePreference_B:
+		return "B"
+	case PairwisePreference_Tie:
+		return "Tie"
+	}
+	return "<UNSET>"
+}
+
+func PairwisePreferenceFromString(s string) (PairwisePreference, error) {
+	switch s {
+	case "A":
+		return PairwisePreference_A, nil
+	case "B":
+		return PairwisePreference_B, nil
+	case "Tie":
+		return PairwisePreference_Tie, nil
+	}
+	return PairwisePreference(0), fmt.Errorf("not a valid PairwisePreference string")
+}
+
+func PairwisePreferencePtr(v PairwisePreference) *PairwisePreference { return &v }
+func (p *PairwisePreference) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = PairwisePreference(result.Int64)
+	return
+}
+
+func (p *PairwisePreference) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type PromptSourceType int64
 
 const (
@@ -1526,7 +1663,1345 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CodeEvaluator[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CodeEvaluator[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CodeEvaluator) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *LanguageType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := LanguageType(v)
+		_field = &tmp
+	}
+	p.LanguageType = _field
+	return nil
+}
+func (p *CodeEvaluator) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Code = _field
+	return nil
+}
+
+func (p *CodeEvaluator) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CodeEvaluator"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CodeEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLanguageType() {
+		if err = oprot.WriteFieldBegin("language_type", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.LanguageType)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CodeEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCode() {
+		if err = oprot.WriteFieldBegin("code", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Code); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CodeEvaluator) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CodeEvaluator(%+v)", *p)
+
+}
+
+func (p *CodeEvaluator) DeepEqual(ano *CodeEvaluator) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.LanguageType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Code) {
+		return false
+	}
+	return true
+}
+
+func (p *CodeEvaluator) Field1DeepEqual(src *LanguageType) bool {
+
+	if p.LanguageType == src {
+		return true
+	} else if p.LanguageType == nil || src == nil {
+		return false
+	}
+	if *p.LanguageType != *src {
+		return false
+	}
+	return true
+}
+func (p *CodeEvaluator) Field2DeepEqual(src *string) bool {
+
+	if p.Code == src {
+		return true
+	} else if p.Code == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Code, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type BuiltinEvaluatorConfig struct {
+	// 字符串比较是否区分大小写
+	CaseSensitive *bool `thrift:"case_sensitive,1,optional" frugal:"1,optional,bool" form:"case_sensitive" json:"case_sensitive,omitempty" query:"case_sensitive"`
+	// 比较前是否去除首尾空白并合并连续空白
+	IgnoreWhitespace *bool `thrift:"ignore_whitespace,2,optional" frugal:"2,optional,bool" form:"ignore_whitespace" json:"ignore_whitespace,omitempty" query:"ignore_whitespace"`
+	// 模糊匹配阈值，设置后分数为 0/1
+	Threshold *float64 `thrift:"threshold,3,optional" frugal:"3,optional,double" form:"threshold" json:"threshold,omitempty" query:"threshold"`
+	// 正则表达式
+	Pattern *string `thrift:"pattern,4,optional" frugal:"4,optional,string" form:"pattern" json:"pattern,omitempty" query:"pattern"`
+	// 正则是否要求整串匹配
+	FullMatch *bool `thrift:"full_match,5,optional" frugal:"5,optional,bool" form:"full_match" json:"full_match,omitempty" query:"full_match"`
+	// 为空时只校验 JSON 合法性
+	JSONSchema *string `thrift:"json_schema,6,optional" frugal:"6,optional,string" form:"json_schema" json:"json_schema,omitempty" query:"json_schema"`
+	// 数值容忍误差
+	Tolerance *float64 `thrift:"tolerance,7,optional" frugal:"7,optional,double" form:"tolerance" json:"tolerance,omitempty" query:"tolerance"`
+	// 是否按参考值的相对误差计算
+	RelativeTolerance *bool `thrift:"relative_tolerance,8,optional" frugal:"8,optional,bool" form:"relative_tolerance" json:"relative_tolerance,omitempty" query:"relative_tolerance"`
+	// BLEU 最大 n-gram 阶数，默认 4
+	MaxNgram *int32 `thrift:"max_ngram,9,optional" frugal:"9,optional,i32" form:"max_ngram" json:"max_ngram,omitempty" query:"max_ngram"`
+}
+
+func NewBuiltinEvaluatorConfig() *BuiltinEvaluatorConfig {
+	return &BuiltinEvaluatorConfig{}
+}
+
+func (p *BuiltinEvaluatorConfig) InitDefault() {
+}
+
+var BuiltinEvaluatorConfig_CaseSensitive_DEFAULT bool
+
+func (p *BuiltinEvaluatorConfig) GetCaseSensitive() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCaseSensitive() {
+		return BuiltinEvaluatorConfig_CaseSensitive_DEFAULT
+	}
+	return *p.CaseSensitive
+}
+
+var BuiltinEvaluatorConfig_IgnoreWhitespace_DEFAULT bool
+
+func (p *BuiltinEvaluatorConfig) GetIgnoreWhitespace() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetIgnoreWhitespace() {
+		return BuiltinEvaluatorConfig_IgnoreWhitespace_DEFAULT
+	}
+	return *p.IgnoreWhitespace
+}
+
+var BuiltinEvaluatorConfig_Threshold_DEFAULT float64
+
+func (p *BuiltinEvaluatorConfig) GetThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetThreshold() {
+		return BuiltinEvaluatorConfig_Threshold_DEFAULT
+	}
+	return *p.Threshold
+}
+
+var BuiltinEvaluatorConfig_Pattern_DEFAULT string
+
+func (p *BuiltinEvaluatorConfig) GetPattern() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPattern() {
+		return BuiltinEvaluatorConfig_Pattern_DEFAULT
+	}
+	return *p.Pattern
+}
+
+var BuiltinEvaluatorConfig_FullMatch_DEFAULT bool
+
+func (p *BuiltinEvaluatorConfig) GetFullMatch() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFullMatch() {
+		return BuiltinEvaluatorConfig_FullMatch_DEFAULT
+	}
+	return *p.FullMatch
+}
+
+var BuiltinEvaluatorConfig_JSONSchema_DEFAULT string
+
+func (p *BuiltinEvaluatorConfig) GetJSONSchema() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetJSONSchema() {
+		return BuiltinEvaluatorConfig_JSONSchema_DEFAULT
+	}
+	return *p.JSONSchema
+}
+
+var BuiltinEvaluatorConfig_Tolerance_DEFAULT float64
+
+func (p *BuiltinEvaluatorConfig) GetTolerance() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTolerance() {
+		return BuiltinEvaluatorConfig_Tolerance_DEFAULT
+	}
+	return *p.Tolerance
+}
+
+var BuiltinEvaluatorConfig_RelativeTolerance_DEFAULT bool
+
+func (p *BuiltinEvaluatorConfig) GetRelativeTolerance() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRelativeTolerance() {
+		return BuiltinEvaluatorConfig_RelativeTolerance_DEFAULT
+	}
+	return *p.RelativeTolerance
+}
+
+var BuiltinEvaluatorConfig_MaxNgram_DEFAULT int32
+
+func (p *BuiltinEvaluatorConfig) GetMaxNgram() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxNgram() {
+		return BuiltinEvaluatorConfig_MaxNgram_DEFAULT
+	}
+	return *p.MaxNgram
+}
+func (p *BuiltinEvaluatorConfig) SetCaseSensitive(val *bool) {
+	p.CaseSensitive = val
+}
+func (p *BuiltinEvaluatorConfig) SetIgnoreWhitespace(val *bool) {
+	p.IgnoreWhitespace = val
+}
+func (p *BuiltinEvaluatorConfig) SetThreshold(val *float64) {
+	p.Threshold = val
+}
+func (p *BuiltinEvaluatorConfig) SetPattern(val *string) {
+	p.Pattern = val
+}
+func (p *BuiltinEvaluatorConfig) SetFullMatch(val *bool) {
+	p.FullMatch = val
+}
+func (p *BuiltinEvaluatorConfig) SetJSONSchema(val *string) {
+	p.JSONSchema = val
+}
+func (p *BuiltinEvaluatorConfig) SetTolerance(val *float64) {
+	p.Tolerance = val
+}
+func (p *BuiltinEvaluatorConfig) SetRelativeTolerance(val *bool) {
+	p.RelativeTolerance = val
+}
+func (p *BuiltinEvaluatorConfig) SetMaxNgram(val *int32) {
+	p.MaxNgram = val
+}
+
+var fieldIDToName_BuiltinEvaluatorConfig = map[int16]string{
+	1: "case_sensitive",
+	2: "ignore_whitespace",
+	3: "threshold",
+	4: "pattern",
+	5: "full_match",
+	6: "json_schema",
+	7: "tolerance",
+	8: "relative_tolerance",
+	9: "max_ngram",
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetCaseSensitive() bool {
+	return p.CaseSensitive != nil
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetIgnoreWhitespace() bool {
+	return p.IgnoreWhitespace != nil
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetThreshold() bool {
+	return p.Threshold != nil
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetPattern() bool {
+	return p.Pattern != nil
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetFullMatch() bool {
+	return p.FullMatch != nil
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetJSONSchema() bool {
+	return p.JSONSchema != nil
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetTolerance() bool {
+	return p.Tolerance != nil
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetRelativeTolerance() bool {
+	return p.RelativeTolerance != nil
+}
+
+func (p *BuiltinEvaluatorConfig) IsSetMaxNgram() bool {
+	return p.MaxNgram != nil
+}
+
+func (p *BuiltinEvaluatorConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BuiltinEvaluatorConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BuiltinEvaluatorConfig) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CaseSensitive = _field
+	return nil
+}
+func (p *BuiltinEvaluatorConfig) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IgnoreWhitespace = _field
+	return nil
+}
+func (p *BuiltinEvaluatorConfig) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Threshold = _field
+	return nil
+}
+func (p *BuiltinEvaluatorConfig) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Pattern = _field
+	return nil
+}
+func (p *BuiltinEvaluatorConfig) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FullMatch = _field
+	return nil
+}
+func (p *BuiltinEvaluatorConfig) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.JSONSchema = _field
+	return nil
+}
+func (p *BuiltinEvaluatorConfig) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Tolerance = _field
+	return nil
+}
+func (p *BuiltinEvaluatorConfig) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RelativeTolerance = _field
+	return nil
+}
+func (p *BuiltinEvaluatorConfig) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxNgram = _field
+	return nil
+}
+
+func (p *BuiltinEvaluatorConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BuiltinEvaluatorConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BuiltinEvaluatorConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCaseSensitive() {
+		if err = oprot.WriteFieldBegin("case_sensitive", thrift.BOOL, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.CaseSensitive); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *BuiltinEvaluatorConfig) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIgnoreWhitespace() {
+		if err = oprot.WriteFieldBegin("ignore_whitespace", thrift.BOOL, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IgnoreWhitespace); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *BuiltinEvaluatorConfig) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThreshold() {
+		if err = oprot.WriteFieldBegin("threshold", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Threshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *BuiltinEvaluatorConfig) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPattern() {
+		if err = oprot.WriteFieldBegin("pattern", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Pattern); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *BuiltinEvaluatorConfig) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFullMatch() {
+		if err = oprot.WriteFieldBegin("full_match", thrift.BOOL, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.FullMatch); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *BuiltinEvaluatorConfig) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJSONSchema() {
+		if err = oprot.WriteFieldBegin("json_schema", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.JSONSchema); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *BuiltinEvaluatorConfig) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTolerance() {
+		if err = oprot.WriteFieldBegin("tolerance", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Tolerance); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *BuiltinEvaluatorConfig) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelativeTolerance() {
+		if err = oprot.WriteFieldBegin("relative_tolerance", thrift.BOOL, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.RelativeTolerance); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *BuiltinEvaluatorConfig) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxNgram() {
+		if err = oprot.WriteFieldBegin("max_ngram", thrift.I32, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxNgram); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *BuiltinEvaluatorConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BuiltinEvaluatorConfig(%+v)", *p)
+
+}
+
+func (p *BuiltinEvaluatorConfig) DeepEqual(ano *BuiltinEvaluatorConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.CaseSensitive) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.IgnoreWhitespace) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Threshold) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Pattern) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.FullMatch) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.JSONSchema) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Tolerance) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.RelativeTolerance) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.MaxNgram) {
+		return false
+	}
+	return true
+}
+
+func (p *BuiltinEvaluatorConfig) Field1DeepEqual(src *bool) bool {
+
+	if p.CaseSensitive == src {
+		return true
+	} else if p.CaseSensitive == nil || src == nil {
+		return false
+	}
+	if *p.CaseSensitive != *src {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluatorConfig) Field2DeepEqual(src *bool) bool {
+
+	if p.IgnoreWhitespace == src {
+		return true
+	} else if p.IgnoreWhitespace == nil || src == nil {
+		return false
+	}
+	if *p.IgnoreWhitespace != *src {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluatorConfig) Field3DeepEqual(src *float64) bool {
+
+	if p.Threshold == src {
+		return true
+	} else if p.Threshold == nil || src == nil {
+		return false
+	}
+	if *p.Threshold != *src {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluatorConfig) Field4DeepEqual(src *string) bool {
+
+	if p.Pattern == src {
+		return true
+	} else if p.Pattern == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Pattern, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluatorConfig) Field5DeepEqual(src *bool) bool {
+
+	if p.FullMatch == src {
+		return true
+	} else if p.FullMatch == nil || src == nil {
+		return false
+	}
+	if *p.FullMatch != *src {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluatorConfig) Field6DeepEqual(src *string) bool {
+
+	if p.JSONSchema == src {
+		return true
+	} else if p.JSONSchema == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.JSONSchema, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluatorConfig) Field7DeepEqual(src *float64) bool {
+
+	if p.Tolerance == src {
+		return true
+	} else if p.Tolerance == nil || src == nil {
+		return false
+	}
+	if *p.Tolerance != *src {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluatorConfig) Field8DeepEqual(src *bool) bool {
+
+	if p.RelativeTolerance == src {
+		return true
+	} else if p.RelativeTolerance == nil || src == nil {
+		return false
+	}
+	if *p.RelativeTolerance != *src {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluatorConfig) Field9DeepEqual(src *int32) bool {
+
+	if p.MaxNgram == src {
+		return true
+	} else if p.MaxNgram == nil || src == nil {
+		return false
+	}
+	if *p.MaxNgram != *src {
+		return false
+	}
+	return true
+}
+
+type BuiltinEvaluator struct {
+	BuiltinEvaluatorType *BuiltinEvaluatorType   `thrift:"builtin_evaluator_type,1,optional" frugal:"1,optional,BuiltinEvaluatorType" form:"builtin_evaluator_type" json:"builtin_evaluator_type,omitempty" query:"builtin_evaluator_type"`
+	Config               *BuiltinEvaluatorConfig `thrift:"config,2,optional" frugal:"2,optional,BuiltinEvaluatorConfig" form:"config" json:"config,omitempty" query:"config"`
+}
+
+func NewBuiltinEvaluator() *BuiltinEvaluator {
+	return &BuiltinEvaluator{}
+}
+
+func (p *BuiltinEvaluator) InitDefault() {
+}
+
+var BuiltinEvaluator_BuiltinEvaluatorType_DEFAULT BuiltinEvaluatorType
+
+func (p *BuiltinEvaluator) GetBuiltinEvaluatorType() (v BuiltinEvaluatorType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBuiltinEvaluatorType() {
+		return BuiltinEvaluator_BuiltinEvaluatorType_DEFAULT
+	}
+	return *p.BuiltinEvaluatorType
+}
+
+var BuiltinEvaluator_Config_DEFAULT *BuiltinEvaluatorConfig
+
+func (p *BuiltinEvaluator) GetConfig() (v *BuiltinEvaluatorConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetConfig() {
+		return BuiltinEvaluator_Config_DEFAULT
+	}
+	return p.Config
+}
+func (p *BuiltinEvaluator) SetBuiltinEvaluatorType(val *BuiltinEvaluatorType) {
+	p.BuiltinEvaluatorType = val
+}
+func (p *BuiltinEvaluator) SetConfig(val *BuiltinEvaluatorConfig) {
+	p.Config = val
+}
+
+var fieldIDToName_BuiltinEvaluator = map[int16]string{
+	1: "builtin_evaluator_type",
+	2: "config",
+}
+
+func (p *BuiltinEvaluator) IsSetBuiltinEvaluatorType() bool {
+	return p.BuiltinEvaluatorType != nil
+}
+
+func (p *BuiltinEvaluator) IsSetConfig() bool {
+	return p.Config != nil
+}
+
+func (p *BuiltinEvaluator) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BuiltinEvaluator[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BuiltinEvaluator) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *BuiltinEvaluatorType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := BuiltinEvaluatorType(v)
+		_field = &tmp
+	}
+	p.BuiltinEvaluatorType = _field
+	return nil
+}
+func (p *BuiltinEvaluator) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewBuiltinEvaluatorConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Config = _field
+	return nil
+}
+
+func (p *BuiltinEvaluator) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BuiltinEvaluator"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BuiltinEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBuiltinEvaluatorType() {
+		if err = oprot.WriteFieldBegin("builtin_evaluator_type", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.BuiltinEvaluatorType)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *BuiltinEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfig() {
+		if err = oprot.WriteFieldBegin("config", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Config.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *BuiltinEvaluator) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BuiltinEvaluator(%+v)", *p)
+
+}
+
+func (p *BuiltinEvaluator) DeepEqual(ano *BuiltinEvaluator) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BuiltinEvaluatorType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Config) {
+		return false
+	}
+	return true
+}
+
+func (p *BuiltinEvaluator) Field1DeepEqual(src *BuiltinEvaluatorType) bool {
+
+	if p.BuiltinEvaluatorType == src {
+		return true
+	} else if p.BuiltinEvaluatorType == nil || src == nil {
+		return false
+	}
+	if *p.BuiltinEvaluatorType != *src {
+		return false
+	}
+	return true
+}
+func (p *BuiltinEvaluator) Field2DeepEqual(src *BuiltinEvaluatorConfig) bool {
+
+	if !p.Config.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 对比评估器，由 LLM 比较两个实验在同一评测集数据上的输出 output_a、output_b 并给出偏好
+type PairwiseEvaluator struct {
+	MessageList []*common.Message   `thrift:"message_list,1" frugal:"1,default,list<common.Message>" form:"message_list" json:"message_list" query:"message_list"`
+	ModelConfig *common.ModelConfig `thrift:"model_config,2,optional" frugal:"2,optional,common.ModelConfig" form:"model_config" json:"model_config,omitempty" query:"model_config"`
+	// 交换 A、B 位置再评估一次，两次结论不一致时判为平局，用于消除位置偏差
+	SwapCheck *bool `thrift:"swap_check,3,optional" frugal:"3,optional,bool" form:"swap_check" json:"swap_check,omitempty" query:"swap_check"`
+}
+
+func NewPairwiseEvaluator() *PairwiseEvaluator {
+	return &PairwiseEvaluator{}
+}
+
+func (p *PairwiseEvaluator) InitDefault() {
+}
+
+func (p *PairwiseEvaluator) GetMessageList() (v []*common.Message) {
+	if p != nil {
+		return p.MessageList
+	}
+	return
+}
+
+var PairwiseEvaluator_ModelConfig_DEFAULT *common.ModelConfig
+
+func (p *PairwiseEvaluator) GetModelConfig() (v *common.ModelConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelConfig() {
+		return PairwiseEvaluator_ModelConfig_DEFAULT
+	}
+	return p.ModelConfig
+}
+
+var PairwiseEvaluator_SwapCheck_DEFAULT bool
+
+func (p *PairwiseEvaluator) GetSwapCheck() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSwapCheck() {
+		return PairwiseEvaluator_SwapCheck_DEFAULT
+	}
+	return *p.SwapCheck
+}
+func (p *PairwiseEvaluator) SetMessageList(val []*common.Message) {
+	p.MessageList = val
+}
+func (p *PairwiseEvaluator) SetModelConfig(val *common.ModelConfig) {
+	p.ModelConfig = val
+}
+func (p *PairwiseEvaluator) SetSwapCheck(val *bool) {
+	p.SwapCheck = val
+}
+
+var fieldIDToName_PairwiseEvaluator = map[int16]string{
+	1: "message_list",
+	2: "model_config",
+	3: "swap_check",
+}
+
+func (p *PairwiseEvaluator) IsSetModelConfig() bool {
+	return p.ModelConfig != nil
+}
+
+func (p *PairwiseEvaluator) IsSetSwapCheck() bool {
+	return p.SwapCheck != nil
+}
+
+func (p *PairwiseEvaluator) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PairwiseEvaluator[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1536,33 +3011,52 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CodeEvaluator) ReadField1(iprot thrift.TProtocol) error {
+func (p *PairwiseEvaluator) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*common.Message, 0, size)
+	values := make([]common.Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *LanguageType
-	if v, err := iprot.ReadI32(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		tmp := LanguageType(v)
-		_field = &tmp
 	}
-	p.LanguageType = _field
+	p.MessageList = _field
 	return nil
 }
-func (p *CodeEvaluator) ReadField2(iprot thrift.TProtocol) error {
+func (p *PairwiseEvaluator) ReadField2(iprot thrift.TProtocol) error {
+	_field := common.NewModelConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ModelConfig = _field
+	return nil
+}
+func (p *PairwiseEvaluator) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Code = _field
+	p.SwapCheck = _field
 	return nil
 }
 
-func (p *CodeEvaluator) Write(oprot thrift.TProtocol) (err error) {
+func (p *PairwiseEvaluator) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CodeEvaluator"); err != nil {
+	if err = oprot.WriteStructBegin("PairwiseEvaluator"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1574,6 +3068,10 @@ func (p *CodeEvaluator) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1592,12 +3090,36 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CodeEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLanguageType() {
-		if err = oprot.WriteFieldBegin("language_type", thrift.I32, 1); err != nil {
+func (p *PairwiseEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message_list", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.MessageList)); err != nil {
+		return err
+	}
+	for _, v := range p.MessageList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PairwiseEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelConfig() {
+		if err = oprot.WriteFieldBegin("model_config", thrift.STRUCT, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.LanguageType)); err != nil {
+		if err := p.ModelConfig.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1606,16 +3128,16 @@ func (p *CodeEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *CodeEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCode() {
-		if err = oprot.WriteFieldBegin("code", thrift.STRING, 2); err != nil {
+func (p *PairwiseEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSwapCheck() {
+		if err = oprot.WriteFieldBegin("swap_check", thrift.BOOL, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Code); err != nil {
+		if err := oprot.WriteBool(*p.SwapCheck); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1624,54 +3146,65 @@ func (p *CodeEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *CodeEvaluator) String() string {
+func (p *PairwiseEvaluator) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CodeEvaluator(%+v)", *p)
+	return fmt.Sprintf("PairwiseEvaluator(%+v)", *p)
 
 }
 
-func (p *CodeEvaluator) DeepEqual(ano *CodeEvaluator) bool {
+func (p *PairwiseEvaluator) DeepEqual(ano *PairwiseEvaluator) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.LanguageType) {
+	if !p.Field1DeepEqual(ano.MessageList) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Code) {
+	if !p.Field2DeepEqual(ano.ModelConfig) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.SwapCheck) {
 		return false
 	}
 	return true
 }
 
-func (p *CodeEvaluator) Field1DeepEqual(src *LanguageType) bool {
+func (p *PairwiseEvaluator) Field1DeepEqual(src []*common.Message) bool {
 
-	if p.LanguageType == src {
-		return true
-	} else if p.LanguageType == nil || src == nil {
+	if len(p.MessageList) != len(src) {
 		return false
 	}
-	if *p.LanguageType != *src {
+	for i, v := range p.MessageList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *PairwiseEvaluator) Field2DeepEqual(src *common.ModelConfig) bool {
+
+	if !p.ModelConfig.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *CodeEvaluator) Field2DeepEqual(src *string) bool {
+func (p *PairwiseEvaluator) Field3DeepEqual(src *bool) bool {
 
-	if p.Code == src {
+	if p.SwapCheck == src {
 		return true
-	} else if p.Code == nil || src == nil {
+	} else if p.SwapCheck == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Code, *src) != 0 {
+	if *p.SwapCheck != *src {
 		return false
 	}
 	return true
@@ -2155,8 +3688,10 @@ type EvaluatorContent struct {
 	ReceiveChatHistory *bool                `thrift:"receive_chat_history,1,optional" frugal:"1,optional,bool" mapstructure:"receive_chat_history" form:"receive_chat_history" json:"receive_chat_history,omitempty" query:"receive_chat_history"`
 	InputSchemas       []*common.ArgsSchema `thrift:"input_schemas,2,optional" frugal:"2,optional,list<common.ArgsSchema>" mapstructure:"input_schemas" form:"input_schemas" json:"input_schemas,omitempty" query:"input_schemas"`
 	// 101-200 Evaluator类型
-	PromptEvaluator *PromptEvaluator `thrift:"prompt_evaluator,101,optional" frugal:"101,optional,PromptEvaluator" mapstructure:"prompt_evaluator" form:"prompt_evaluator" json:"prompt_evaluator,omitempty" query:"prompt_evaluator"`
-	CodeEvaluator   *CodeEvaluator   `thrift:"code_evaluator,102,optional" frugal:"102,optional,CodeEvaluator" form:"code_evaluator" json:"code_evaluator,omitempty" query:"code_evaluator"`
+	PromptEvaluator   *PromptEvaluator   `thrift:"prompt_evaluator,101,optional" frugal:"101,optional,PromptEvaluator" mapstructure:"prompt_evaluator" form:"prompt_evaluator" json:"prompt_evaluator,omitempty" query:"prompt_evaluator"`
+	CodeEvaluator     *CodeEvaluator     `thrift:"code_evaluator,102,optional" frugal:"102,optional,CodeEvaluator" form:"code_evaluator" json:"code_evaluator,omitempty" query:"code_evaluator"`
+	BuiltinEvaluator  *BuiltinEvaluator  `thrift:"builtin_evaluator,103,optional" frugal:"103,optional,BuiltinEvaluator" form:"builtin_evaluator" json:"builtin_evaluator,omitempty" query:"builtin_evaluator"`
+	PairwiseEvaluator *PairwiseEvaluator `thrift:"pairwise_evaluator,104,optional" frugal:"104,optional,PairwiseEvaluator" form:"pairwise_evaluator" json:"pairwise_evaluator,omitempty" query:"pairwise_evaluator"`
 }
 
 func NewEvaluatorContent() *EvaluatorContent {
@@ -2213,6 +3748,30 @@ func (p *EvaluatorContent) GetCodeEvaluator() (v *CodeEvaluator) {
 	}
 	return p.CodeEvaluator
 }
+
+var EvaluatorContent_BuiltinEvaluator_DEFAULT *BuiltinEvaluator
+
+func (p *EvaluatorContent) GetBuiltinEvaluator() (v *BuiltinEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBuiltinEvaluator() {
+		return EvaluatorContent_BuiltinEvaluator_DEFAULT
+	}
+	return p.BuiltinEvaluator
+}
+
+var EvaluatorContent_PairwiseEvaluator_DEFAULT *PairwiseEvaluator
+
+func (p *EvaluatorContent) GetPairwiseEvaluator() (v *PairwiseEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairwiseEvaluator() {
+		return EvaluatorContent_PairwiseEvaluator_DEFAULT
+	}
+	return p.PairwiseEvaluator
+}
 func (p *EvaluatorContent) SetReceiveChatHistory(val *bool) {
 	p.ReceiveChatHistory = val
 }
@@ -2225,12 +3784,20 @@ func (p *EvaluatorContent) SetPromptEvaluator(val *PromptEvaluator) {
 func (p *EvaluatorContent) SetCodeEvaluator(val *CodeEvaluator) {
 	p.CodeEvaluator = val
 }
+func (p *EvaluatorContent) SetBuiltinEvaluator(val *BuiltinEvaluator) {
+	p.BuiltinEvaluator = val
+}
+func (p *EvaluatorContent) SetPairwiseEvaluator(val *PairwiseEvaluator) {
+	p.PairwiseEvaluator = val
+}
 
 var fieldIDToName_EvaluatorContent = map[int16]string{
 	1:   "receive_chat_history",
 	2:   "input_schemas",
 	101: "prompt_evaluator",
 	102: "code_evaluator",
+	103: "builtin_evaluator",
+	104: "pairwise_evaluator",
 }
 
 func (p *EvaluatorContent) IsSetReceiveChatHistory() bool {
@@ -2249,6 +3816,14 @@ func (p *EvaluatorContent) IsSetCodeEvaluator() bool {
 	return p.CodeEvaluator != nil
 }
 
+func (p *EvaluatorContent) IsSetBuiltinEvaluator() bool {
+	return p.BuiltinEvaluator != nil
+}
+
+func (p *EvaluatorContent) IsSetPairwiseEvaluator() bool {
+	return p.PairwiseEvaluator != nil
+}
+
 func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2299,6 +3874,22 @@ func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 103:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField103(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 104:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField104(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2378,6 +3969,22 @@ func (p *EvaluatorContent) ReadField102(iprot thrift.TProtocol) error {
 	p.CodeEvaluator = _field
 	return nil
 }
+func (p *EvaluatorContent) ReadField103(iprot thrift.TProtocol) error {
+	_field := NewBuiltinEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BuiltinEvaluator = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField104(iprot thrift.TProtocol) error {
+	_field := NewPairwiseEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.PairwiseEvaluator = _field
+	return nil
+}
 
 func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2401,6 +4008,14 @@ func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 102
 			goto WriteFieldError
 		}
+		if err = p.writeField103(oprot); err != nil {
+			fieldId = 103
+			goto WriteFieldError
+		}
+		if err = p.writeField104(oprot); err != nil {
+			fieldId = 104
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2499,6 +4114,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
 }
+func (p *EvaluatorContent) writeField103(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBuiltinEvaluator() {
+		if err = oprot.WriteFieldBegin("builtin_evaluator", thrift.STRUCT, 103); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BuiltinEvaluator.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
+}
+func (p *EvaluatorContent) writeField104(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairwiseEvaluator() {
+		if err = oprot.WriteFieldBegin("pairwise_evaluator", thrift.STRUCT, 104); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.PairwiseEvaluator.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 104 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 104 end error: ", p), err)
+}
 
 func (p *EvaluatorContent) String() string {
 	if p == nil {
@@ -2526,6 +4177,12 @@ func (p *EvaluatorContent) DeepEqual(ano *EvaluatorContent) bool {
 	if !p.Field102DeepEqual(ano.CodeEvaluator) {
 		return false
 	}
+	if !p.Field103DeepEqual(ano.BuiltinEvaluator) {
+		return false
+	}
+	if !p.Field104DeepEqual(ano.PairwiseEvaluator) {
+		return false
+	}
 	return true
 }
 
@@ -2568,6 +4225,20 @@ func (p *EvaluatorContent) Field102DeepEqual(src *CodeEvaluator) bool {
 	}
 	return true
 }
+func (p *EvaluatorContent) Field103DeepEqual(src *BuiltinEvaluator) bool {
+
+	if !p.BuiltinEvaluator.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorContent) Field104DeepEqual(src *PairwiseEvaluator) bool {
+
+	if !p.PairwiseEvaluator.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 type Evaluator struct {
 	EvaluatorID    *int64            `thrift:"evaluator_id,1,optional" frugal:"1,optional,i64" json:"evaluator_id" form:"evaluator_id" query:"evaluator_id"`
@@ -5190,6 +6861,8 @@ type EvaluatorResult_ struct {
 	Score      *float64    `thrift:"score,1,optional" frugal:"1,optional,double" form:"score" json:"score,omitempty" query:"score"`
 	Correction *Correction `thrift:"correction,2,optional" frugal:"2,optional,Correction" form:"correction" json:"correction,omitempty" query:"correction"`
 	Reasoning  *string     `thrift:"reasoning,3,optional" frugal:"3,optional,string" form:"reasoning" json:"reasoning,omitempty" query:"reasoning"`
+	// 仅对比评估器返回
+	Preference *PairwisePreference `thrift:"preference,4,optional" frugal:"4,optional,PairwisePreference" form:"preference" json:"preference,omitempty" query:"preference"`
 }
 
 func NewEvaluatorResult_() *EvaluatorResult_ {
@@ -5234,6 +6907,18 @@ func (p *EvaluatorResult_) GetReasoning() (v string) {
 	}
 	return *p.Reasoning
 }
+
+var EvaluatorResult__Preference_DEFAULT PairwisePreference
+
+func (p *EvaluatorResult_) GetPreference() (v PairwisePreference) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPreference() {
+		return EvaluatorResult__Preference_DEFAULT
+	}
+	return *p.Preference
+}
 func (p *EvaluatorResult_) SetScore(val *float64) {
 	p.Score = val
 }
@@ -5243,11 +6928,15 @@ func (p *EvaluatorResult_) SetCorrection(val *Correction) {
 func (p *EvaluatorResult_) SetReasoning(val *string) {
 	p.Reasoning = val
 }
+func (p *EvaluatorResult_) SetPreference(val *PairwisePreference) {
+	p.Preference = val
+}
 
 var fieldIDToName_EvaluatorResult_ = map[int16]string{
 	1: "score",
 	2: "correction",
 	3: "reasoning",
+	4: "preference",
 }
 
 func (p *EvaluatorResult_) IsSetScore() bool {
@@ -5262,6 +6951,10 @@ func (p *EvaluatorResult_) IsSetReasoning() bool {
 	return p.Reasoning != nil
 }
 
+func (p *EvaluatorResult_) IsSetPreference() bool {
+	return p.Preference != nil
+}
+
 func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5304,6 +6997,14 @@ func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5363,6 +7064,18 @@ func (p *EvaluatorResult_) ReadField3(iprot thrift.TProtocol) error {
 	p.Reasoning = _field
 	return nil
 }
+func (p *EvaluatorResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *PairwisePreference
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := PairwisePreference(v)
+		_field = &tmp
+	}
+	p.Preference = _field
+	return nil
+}
 
 func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -5382,6 +7095,10 @@ func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5454,6 +7171,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *EvaluatorResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreference() {
+		if err = oprot.WriteFieldBegin("preference", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Preference)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *EvaluatorResult_) String() string {
 	if p == nil {
@@ -5478,6 +7213,9 @@ func (p *EvaluatorResult_) DeepEqual(ano *EvaluatorResult_) bool {
 	if !p.Field3DeepEqual(ano.Reasoning) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Preference) {
+		return false
+	}
 	return true
 }
 
@@ -5512,6 +7250,18 @@ func (p *EvaluatorResult_) Field3DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *EvaluatorResult_) Field4DeepEqual(src *PairwisePreference) bool {
+
+	if p.Preference == src {
+		return true
+	} else if p.Preference == nil || src == nil {
+		return false
+	}
+	if *p.Preference != *src {
+		return false
+	}
+	return true
+}
 
 type EvaluatorUsage struct {
 	InputTokens  *int64 `thrift:"input_tokens,1,optional" frugal:"1,optional,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
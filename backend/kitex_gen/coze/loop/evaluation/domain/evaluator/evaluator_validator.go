@@ -43,6 +43,25 @@ func (p *PromptEvaluator) IsValid() error {
 func (p *CodeEvaluator) IsValid() error {
 	return nil
 }
+func (p *BuiltinEvaluatorConfig) IsValid() error {
+	return nil
+}
+func (p *BuiltinEvaluator) IsValid() error {
+	if p.Config != nil {
+		if err := p.Config.IsValid(); err != nil {
+			return fmt.Errorf("field Config not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *PairwiseEvaluator) IsValid() error {
+	if p.ModelConfig != nil {
+		if err := p.ModelConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ModelConfig not valid, %w", err)
+		}
+	}
+	return nil
+}
 func (p *EvaluatorVersion) IsValid() error {
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
@@ -67,6 +86,16 @@ func (p *EvaluatorContent) IsValid() error {
 			return fmt.Errorf("field CodeEvaluator not valid, %w", err)
 		}
 	}
+	if p.BuiltinEvaluator != nil {
+		if err := p.BuiltinEvaluator.IsValid(); err != nil {
+			return fmt.Errorf("field BuiltinEvaluator not valid, %w", err)
+		}
+	}
+	if p.PairwiseEvaluator != nil {
+		if err := p.PairwiseEvaluator.IsValid(); err != nil {
+			return fmt.Errorf("field PairwiseEvaluator not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *Evaluator) IsValid() error {
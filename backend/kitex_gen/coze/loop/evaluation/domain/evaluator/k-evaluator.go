@@ -1046,6 +1046,980 @@ func (p *CodeEvaluator) DeepCopy(s interface{}) error {
 	return nil
 }
 
+func (p *BuiltinEvaluatorConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BuiltinEvaluatorConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.CaseSensitive = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.IgnoreWhitespace = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Threshold = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Pattern = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FullMatch = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.JSONSchema = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Tolerance = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.RelativeTolerance = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.MaxNgram = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluatorConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BuiltinEvaluatorConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCaseSensitive() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 1)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.CaseSensitive)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetIgnoreWhitespace() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 2)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.IgnoreWhitespace)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Threshold)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPattern() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Pattern)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFullMatch() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 5)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.FullMatch)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetJSONSchema() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.JSONSchema)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTolerance() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 7)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Tolerance)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRelativeTolerance() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 8)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.RelativeTolerance)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxNgram() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 9)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxNgram)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluatorConfig) field1Length() int {
+	l := 0
+	if p.IsSetCaseSensitive() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) field2Length() int {
+	l := 0
+	if p.IsSetIgnoreWhitespace() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) field3Length() int {
+	l := 0
+	if p.IsSetThreshold() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) field4Length() int {
+	l := 0
+	if p.IsSetPattern() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Pattern)
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) field5Length() int {
+	l := 0
+	if p.IsSetFullMatch() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) field6Length() int {
+	l := 0
+	if p.IsSetJSONSchema() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.JSONSchema)
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) field7Length() int {
+	l := 0
+	if p.IsSetTolerance() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) field8Length() int {
+	l := 0
+	if p.IsSetRelativeTolerance() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) field9Length() int {
+	l := 0
+	if p.IsSetMaxNgram() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluatorConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*BuiltinEvaluatorConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.CaseSensitive != nil {
+		tmp := *src.CaseSensitive
+		p.CaseSensitive = &tmp
+	}
+
+	if src.IgnoreWhitespace != nil {
+		tmp := *src.IgnoreWhitespace
+		p.IgnoreWhitespace = &tmp
+	}
+
+	if src.Threshold != nil {
+		tmp := *src.Threshold
+		p.Threshold = &tmp
+	}
+
+	if src.Pattern != nil {
+		var tmp string
+		if *src.Pattern != "" {
+			tmp = kutils.StringDeepCopy(*src.Pattern)
+		}
+		p.Pattern = &tmp
+	}
+
+	if src.FullMatch != nil {
+		tmp := *src.FullMatch
+		p.FullMatch = &tmp
+	}
+
+	if src.JSONSchema != nil {
+		var tmp string
+		if *src.JSONSchema != "" {
+			tmp = kutils.StringDeepCopy(*src.JSONSchema)
+		}
+		p.JSONSchema = &tmp
+	}
+
+	if src.Tolerance != nil {
+		tmp := *src.Tolerance
+		p.Tolerance = &tmp
+	}
+
+	if src.RelativeTolerance != nil {
+		tmp := *src.RelativeTolerance
+		p.RelativeTolerance = &tmp
+	}
+
+	if src.MaxNgram != nil {
+		tmp := *src.MaxNgram
+		p.MaxNgram = &tmp
+	}
+
+	return nil
+}
+
+func (p *BuiltinEvaluator) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BuiltinEvaluator[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *BuiltinEvaluator) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *BuiltinEvaluatorType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := BuiltinEvaluatorType(v)
+		_field = &tmp
+	}
+	p.BuiltinEvaluatorType = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluator) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBuiltinEvaluatorConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Config = _field
+	return offset, nil
+}
+
+func (p *BuiltinEvaluator) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BuiltinEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BuiltinEvaluator) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BuiltinEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBuiltinEvaluatorType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.BuiltinEvaluatorType))
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.Config.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *BuiltinEvaluator) field1Length() int {
+	l := 0
+	if p.IsSetBuiltinEvaluatorType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluator) field2Length() int {
+	l := 0
+	if p.IsSetConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Config.BLength()
+	}
+	return l
+}
+
+func (p *BuiltinEvaluator) DeepCopy(s interface{}) error {
+	src, ok := s.(*BuiltinEvaluator)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.BuiltinEvaluatorType != nil {
+		tmp := *src.BuiltinEvaluatorType
+		p.BuiltinEvaluatorType = &tmp
+	}
+
+	var _config *BuiltinEvaluatorConfig
+	if src.Config != nil {
+		_config = &BuiltinEvaluatorConfig{}
+		if err := _config.DeepCopy(src.Config); err != nil {
+			return err
+		}
+	}
+	p.Config = _config
+
+	return nil
+}
+
+func (p *PairwiseEvaluator) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PairwiseEvaluator[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PairwiseEvaluator) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*common.Message, 0, size)
+	values := make([]common.Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.MessageList = _field
+	return offset, nil
+}
+
+func (p *PairwiseEvaluator) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewModelConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ModelConfig = _field
+	return offset, nil
+}
+
+func (p *PairwiseEvaluator) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SwapCheck = _field
+	return offset, nil
+}
+
+func (p *PairwiseEvaluator) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PairwiseEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PairwiseEvaluator) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PairwiseEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.MessageList {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *PairwiseEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetModelConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.ModelConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PairwiseEvaluator) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSwapCheck() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 3)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.SwapCheck)
+	}
+	return offset
+}
+
+func (p *PairwiseEvaluator) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.MessageList {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *PairwiseEvaluator) field2Length() int {
+	l := 0
+	if p.IsSetModelConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ModelConfig.BLength()
+	}
+	return l
+}
+
+func (p *PairwiseEvaluator) field3Length() int {
+	l := 0
+	if p.IsSetSwapCheck() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *PairwiseEvaluator) DeepCopy(s interface{}) error {
+	src, ok := s.(*PairwiseEvaluator)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.MessageList != nil {
+		p.MessageList = make([]*common.Message, 0, len(src.MessageList))
+		for _, elem := range src.MessageList {
+			var _elem *common.Message
+			if elem != nil {
+				_elem = &common.Message{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.MessageList = append(p.MessageList, _elem)
+		}
+	}
+
+	var _modelConfig *common.ModelConfig
+	if src.ModelConfig != nil {
+		_modelConfig = &common.ModelConfig{}
+		if err := _modelConfig.DeepCopy(src.ModelConfig); err != nil {
+			return err
+		}
+	}
+	p.ModelConfig = _modelConfig
+
+	if src.SwapCheck != nil {
+		tmp := *src.SwapCheck
+		p.SwapCheck = &tmp
+	}
+
+	return nil
+}
+
 func (p *EvaluatorVersion) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -1458,6 +2432,34 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 103:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField103(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 104:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField104(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1539,6 +2541,30 @@ func (p *EvaluatorContent) FastReadField102(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *EvaluatorContent) FastReadField103(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBuiltinEvaluator()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BuiltinEvaluator = _field
+	return offset, nil
+}
+
+func (p *EvaluatorContent) FastReadField104(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPairwiseEvaluator()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.PairwiseEvaluator = _field
+	return offset, nil
+}
+
 func (p *EvaluatorContent) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -1550,6 +2576,8 @@ func (p *EvaluatorContent) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) in
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField101(buf[offset:], w)
 		offset += p.fastWriteField102(buf[offset:], w)
+		offset += p.fastWriteField103(buf[offset:], w)
+		offset += p.fastWriteField104(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -1562,6 +2590,8 @@ func (p *EvaluatorContent) BLength() int {
 		l += p.field2Length()
 		l += p.field101Length()
 		l += p.field102Length()
+		l += p.field103Length()
+		l += p.field104Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -1610,6 +2640,24 @@ func (p *EvaluatorContent) fastWriteField102(buf []byte, w thrift.NocopyWriter)
 	return offset
 }
 
+func (p *EvaluatorContent) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBuiltinEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 103)
+		offset += p.BuiltinEvaluator.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorContent) fastWriteField104(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPairwiseEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 104)
+		offset += p.PairwiseEvaluator.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *EvaluatorContent) field1Length() int {
 	l := 0
 	if p.IsSetReceiveChatHistory() {
@@ -1650,6 +2698,24 @@ func (p *EvaluatorContent) field102Length() int {
 	return l
 }
 
+func (p *EvaluatorContent) field103Length() int {
+	l := 0
+	if p.IsSetBuiltinEvaluator() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BuiltinEvaluator.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorContent) field104Length() int {
+	l := 0
+	if p.IsSetPairwiseEvaluator() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.PairwiseEvaluator.BLength()
+	}
+	return l
+}
+
 func (p *EvaluatorContent) DeepCopy(s interface{}) error {
 	src, ok := s.(*EvaluatorContent)
 	if !ok {
@@ -1694,6 +2760,24 @@ func (p *EvaluatorContent) DeepCopy(s interface{}) error {
 	}
 	p.CodeEvaluator = _codeEvaluator
 
+	var _builtinEvaluator *BuiltinEvaluator
+	if src.BuiltinEvaluator != nil {
+		_builtinEvaluator = &BuiltinEvaluator{}
+		if err := _builtinEvaluator.DeepCopy(src.BuiltinEvaluator); err != nil {
+			return err
+		}
+	}
+	p.BuiltinEvaluator = _builtinEvaluator
+
+	var _pairwiseEvaluator *PairwiseEvaluator
+	if src.PairwiseEvaluator != nil {
+		_pairwiseEvaluator = &PairwiseEvaluator{}
+		if err := _pairwiseEvaluator.DeepCopy(src.PairwiseEvaluator); err != nil {
+			return err
+		}
+	}
+	p.PairwiseEvaluator = _pairwiseEvaluator
+
 	return nil
 }
 
@@ -3634,6 +4718,20 @@ func (p *EvaluatorResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -3692,6 +4790,22 @@ func (p *EvaluatorResult_) FastReadField3(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *EvaluatorResult_) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *PairwisePreference
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := PairwisePreference(v)
+		_field = &tmp
+	}
+	p.Preference = _field
+	return offset, nil
+}
+
 func (p *EvaluatorResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -3702,6 +4816,7 @@ func (p *EvaluatorResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) in
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -3713,6 +4828,7 @@ func (p *EvaluatorResult_) BLength() int {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -3745,6 +4861,15 @@ func (p *EvaluatorResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) in
 	return offset
 }
 
+func (p *EvaluatorResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPreference() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.Preference))
+	}
+	return offset
+}
+
 func (p *EvaluatorResult_) field1Length() int {
 	l := 0
 	if p.IsSetScore() {
@@ -3772,6 +4897,15 @@ func (p *EvaluatorResult_) field3Length() int {
 	return l
 }
 
+func (p *EvaluatorResult_) field4Length() int {
+	l := 0
+	if p.IsSetPreference() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
 func (p *EvaluatorResult_) DeepCopy(s interface{}) error {
 	src, ok := s.(*EvaluatorResult_)
 	if !ok {
@@ -3800,6 +4934,11 @@ func (p *EvaluatorResult_) DeepCopy(s interface{}) error {
 		p.Reasoning = &tmp
 	}
 
+	if src.Preference != nil {
+		tmp := *src.Preference
+		p.Preference = &tmp
+	}
+
 	return nil
 }
 
@@ -18,6 +18,12 @@ import (
 const (
 	ExptResultExportTypeCSV = "CSV"
 
+	ExptResultExportTypeJSONL = "JSONL"
+
+	ExptResultExportTypeParquet = "Parquet"
+
+	ExptResultExportTypeXLSX = "XLSX"
+
 	CSVExportStatusUnknown = "Unknown"
 
 	CSVExportStatusRunning = "Running"
@@ -25,6 +31,12 @@ const (
 	CSVExportStatusSuccess = "Success"
 
 	CSVExportStatusFailed = "Failed"
+
+	ExptScheduleRunStatusUnknown = "Unknown"
+
+	ExptScheduleRunStatusSuccess = "Success"
+
+	ExptScheduleRunStatusFailed = "Failed"
 )
 
 type ExptStatus int64
@@ -35,6 +47,8 @@ const (
 	ExptStatus_Pending ExptStatus = 2
 	// In progress
 	ExptStatus_Processing ExptStatus = 3
+	// Paused, no new items are submitted
+	ExptStatus_Paused ExptStatus = 4
 	// Execution succeeded
 	ExptStatus_Success ExptStatus = 11
 	// Execution failed
@@ -55,6 +69,8 @@ func (p ExptStatus) String() string {
 		return "Pending"
 	case ExptStatus_Processing:
 		return "Processing"
+	case ExptStatus_Paused:
+		return "Paused"
 	case ExptStatus_Success:
 		return "Success"
 	case ExptStatus_Failed:
@@ -77,6 +93,8 @@ func ExptStatusFromString(s string) (ExptStatus, error) {
 		return ExptStatus_Pending, nil
 	case "Processing":
 		return ExptStatus_Processing, nil
+	case "Paused":
+		return ExptStatus_Paused, nil
 	case "Success":
 		return ExptStatus_Success, nil
 	case "Failed":
@@ -761,6 +779,20 @@ const (
 	AggregatorType_Min     AggregatorType = 4
 	// 得分的分布情况
 	AggregatorType_Distribution AggregatorType = 5
+	// 对比评估器中当前实验的胜率
+	AggregatorType_WinRate AggregatorType = 6
+	// 对比评估器中的平局率
+	AggregatorType_TieRate AggregatorType = 7
+	// 对比评估器中当前实验的负率
+	AggregatorType_LossRate AggregatorType = 8
+	// 中位数
+	AggregatorType_P50 AggregatorType = 9
+	AggregatorType_P90 AggregatorType = 10
+	AggregatorType_P99 AggregatorType = 11
+	// 样本标准差
+	AggregatorType_StdDev AggregatorType = 12
+	// 得分不低于通过阈值的比例，未配置通过阈值时不计算，聚合结果中不包含该项
+	AggregatorType_PassRate AggregatorType = 13
 )
 
 func (p AggregatorType) String() string {
@@ -775,6 +807,22 @@ func (p AggregatorType) String() string {
 		return "Min"
 	case AggregatorType_Distribution:
 		return "Distribution"
+	case AggregatorType_WinRate:
+		return "WinRate"
+	case AggregatorType_TieRate:
+		return "TieRate"
+	case AggregatorType_LossRate:
+		return "LossRate"
+	case AggregatorType_P50:
+		return "P50"
+	case AggregatorType_P90:
+		return "P90"
+	case AggregatorType_P99:
+		return "P99"
+	case AggregatorType_StdDev:
+		return "StdDev"
+	case AggregatorType_PassRate:
+		return "PassRate"
 	}
 	return "<UNSET>"
 }
@@ -791,6 +839,22 @@ func AggregatorTypeFromString(s string) (AggregatorType, error) {
 		return AggregatorType_Min, nil
 	case "Distribution":
 		return AggregatorType_Distribution, nil
+	case "WinRate":
+		return AggregatorType_WinRate, nil
+	case "TieRate":
+		return AggregatorType_TieRate, nil
+	case "LossRate":
+		return AggregatorType_LossRate, nil
+	case "P50":
+		return AggregatorType_P50, nil
+	case "P90":
+		return AggregatorType_P90, nil
+	case "P99":
+		return AggregatorType_P99, nil
+	case "StdDev":
+		return AggregatorType_StdDev, nil
+	case "PassRate":
+		return AggregatorType_PassRate, nil
 	}
 	return AggregatorType(0), fmt.Errorf("not a valid AggregatorType string")
 }
@@ -864,6 +928,8 @@ type ExptResultExportType = string
 
 type CSVExportStatus = string
 
+type ExptScheduleRunStatus = string
+
 type Experiment struct {
 	ID                    *int64                   `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
 	Name                  *string                  `thrift:"name,2,optional" frugal:"2,optional,string" form:"name" json:"name,omitempty" query:"name"`
@@ -891,6 +957,7 @@ type Experiment struct {
 	MaxAliveTime          *int64                   `thrift:"max_alive_time,41,optional" frugal:"41,optional,i64" form:"max_alive_time" json:"max_alive_time,omitempty" query:"max_alive_time"`
 	SourceType            *SourceType              `thrift:"source_type,42,optional" frugal:"42,optional,SourceType" form:"source_type" json:"source_type,omitempty" query:"source_type"`
 	SourceID              *string                  `thrift:"source_id,43,optional" frugal:"43,optional,string" form:"source_id" json:"source_id,omitempty" query:"source_id"`
+	Budget                *ExptBudget              `thrift:"budget,50,optional" frugal:"50,optional,ExptBudget" form:"budget" json:"budget,omitempty" query:"budget"`
 }
 
 func NewExperiment() *Experiment {
@@ -1211,6 +1278,18 @@ func (p *Experiment) GetSourceID() (v string) {
 	}
 	return *p.SourceID
 }
+
+var Experiment_Budget_DEFAULT *ExptBudget
+
+func (p *Experiment) GetBudget() (v *ExptBudget) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBudget() {
+		return Experiment_Budget_DEFAULT
+	}
+	return p.Budget
+}
 func (p *Experiment) SetID(val *int64) {
 	p.ID = val
 }
@@ -1289,6 +1368,9 @@ func (p *Experiment) SetSourceType(val *SourceType) {
 func (p *Experiment) SetSourceID(val *string) {
 	p.SourceID = val
 }
+func (p *Experiment) SetBudget(val *ExptBudget) {
+	p.Budget = val
+}
 
 var fieldIDToName_Experiment = map[int16]string{
 	1:  "id",
@@ -1317,6 +1399,7 @@ var fieldIDToName_Experiment = map[int16]string{
 	41: "max_alive_time",
 	42: "source_type",
 	43: "source_id",
+	50: "budget",
 }
 
 func (p *Experiment) IsSetID() bool {
@@ -1423,6 +1506,10 @@ func (p *Experiment) IsSetSourceID() bool {
 	return p.SourceID != nil
 }
 
+func (p *Experiment) IsSetBudget() bool {
+	return p.Budget != nil
+}
+
 func (p *Experiment) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1649,6 +1736,14 @@ func (p *Experiment) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 50:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField50(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1985,6 +2080,14 @@ func (p *Experiment) ReadField43(iprot thrift.TProtocol) error {
 	p.SourceID = _field
 	return nil
 }
+func (p *Experiment) ReadField50(iprot thrift.TProtocol) error {
+	_field := NewExptBudget()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Budget = _field
+	return nil
+}
 
 func (p *Experiment) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2096,6 +2199,10 @@ func (p *Experiment) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 43
 			goto WriteFieldError
 		}
+		if err = p.writeField50(oprot); err != nil {
+			fieldId = 50
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2606,6 +2713,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 43 end error: ", p), err)
 }
+func (p *Experiment) writeField50(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBudget() {
+		if err = oprot.WriteFieldBegin("budget", thrift.STRUCT, 50); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Budget.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 50 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 50 end error: ", p), err)
+}
 
 func (p *Experiment) String() string {
 	if p == nil {
@@ -2699,6 +2824,9 @@ func (p *Experiment) DeepEqual(ano *Experiment) bool {
 	if !p.Field43DeepEqual(ano.SourceID) {
 		return false
 	}
+	if !p.Field50DeepEqual(ano.Budget) {
+		return false
+	}
 	return true
 }
 
@@ -2987,6 +3115,196 @@ func (p *Experiment) Field43DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *Experiment) Field50DeepEqual(src *ExptBudget) bool {
+
+	if !p.Budget.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 实验预算，超出后实验被系统终止
+type ExptBudget struct {
+	// 评测对象与评估器的 input + output token 总量上限
+	MaxTokens *int64 `thrift:"max_tokens,1,optional" frugal:"1,optional,i64" json:"max_tokens" form:"max_tokens" query:"max_tokens"`
+}
+
+func NewExptBudget() *ExptBudget {
+	return &ExptBudget{}
+}
+
+func (p *ExptBudget) InitDefault() {
+}
+
+var ExptBudget_MaxTokens_DEFAULT int64
+
+func (p *ExptBudget) GetMaxTokens() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxTokens() {
+		return ExptBudget_MaxTokens_DEFAULT
+	}
+	return *p.MaxTokens
+}
+func (p *ExptBudget) SetMaxTokens(val *int64) {
+	p.MaxTokens = val
+}
+
+var fieldIDToName_ExptBudget = map[int16]string{
+	1: "max_tokens",
+}
+
+func (p *ExptBudget) IsSetMaxTokens() bool {
+	return p.MaxTokens != nil
+}
+
+func (p *ExptBudget) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptBudget[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptBudget) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxTokens = _field
+	return nil
+}
+
+func (p *ExptBudget) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptBudget"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptBudget) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxTokens() {
+		if err = oprot.WriteFieldBegin("max_tokens", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MaxTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExptBudget) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptBudget(%+v)", *p)
+
+}
+
+func (p *ExptBudget) DeepEqual(ano *ExptBudget) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.MaxTokens) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptBudget) Field1DeepEqual(src *int64) bool {
+
+	if p.MaxTokens == src {
+		return true
+	} else if p.MaxTokens == nil || src == nil {
+		return false
+	}
+	if *p.MaxTokens != *src {
+		return false
+	}
+	return true
+}
 
 type TokenUsage struct {
 	InputTokens  *int64 `thrift:"input_tokens,1,optional" frugal:"1,optional,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
@@ -4443,6 +4761,14 @@ type EvaluatorFieldMapping struct {
 	EvaluatorVersionID int64           `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
 	FromEvalSet        []*FieldMapping `thrift:"from_eval_set,2,optional" frugal:"2,optional,list<FieldMapping>" form:"from_eval_set" json:"from_eval_set,omitempty" query:"from_eval_set"`
 	FromTarget         []*FieldMapping `thrift:"from_target,3,optional" frugal:"3,optional,list<FieldMapping>" form:"from_target" json:"from_target,omitempty" query:"from_target"`
+	// 对比评估器的基准实验
+	BaselineExptID *int64 `thrift:"baseline_expt_id,4,optional" frugal:"4,optional,i64" json:"baseline_expt_id" form:"baseline_expt_id" query:"baseline_expt_id"`
+	// 对比评估器从基准实验的评测对象输出中取值
+	FromBaselineTarget []*FieldMapping `thrift:"from_baseline_target,5,optional" frugal:"5,optional,list<FieldMapping>" form:"from_baseline_target" json:"from_baseline_target,omitempty" query:"from_baseline_target"`
+	// 额外启用的聚合器，支持 P50/P90/P99/StdDev/PassRate
+	AggregatorTypes []AggregatorType `thrift:"aggregator_types,6,optional" frugal:"6,optional,list<AggregatorType>" form:"aggregator_types" json:"aggregator_types,omitempty" query:"aggregator_types"`
+	// 得分不低于该值视为通过，启用 PassRate 时必填
+	PassThreshold *float64 `thrift:"pass_threshold,7,optional" frugal:"7,optional,double" form:"pass_threshold" json:"pass_threshold,omitempty" query:"pass_threshold"`
 }
 
 func NewEvaluatorFieldMapping() *EvaluatorFieldMapping {
@@ -4482,6 +4808,54 @@ func (p *EvaluatorFieldMapping) GetFromTarget() (v []*FieldMapping) {
 	}
 	return p.FromTarget
 }
+
+var EvaluatorFieldMapping_BaselineExptID_DEFAULT int64
+
+func (p *EvaluatorFieldMapping) GetBaselineExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaselineExptID() {
+		return EvaluatorFieldMapping_BaselineExptID_DEFAULT
+	}
+	return *p.BaselineExptID
+}
+
+var EvaluatorFieldMapping_FromBaselineTarget_DEFAULT []*FieldMapping
+
+func (p *EvaluatorFieldMapping) GetFromBaselineTarget() (v []*FieldMapping) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFromBaselineTarget() {
+		return EvaluatorFieldMapping_FromBaselineTarget_DEFAULT
+	}
+	return p.FromBaselineTarget
+}
+
+var EvaluatorFieldMapping_AggregatorTypes_DEFAULT []AggregatorType
+
+func (p *EvaluatorFieldMapping) GetAggregatorTypes() (v []AggregatorType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAggregatorTypes() {
+		return EvaluatorFieldMapping_AggregatorTypes_DEFAULT
+	}
+	return p.AggregatorTypes
+}
+
+var EvaluatorFieldMapping_PassThreshold_DEFAULT float64
+
+func (p *EvaluatorFieldMapping) GetPassThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassThreshold() {
+		return EvaluatorFieldMapping_PassThreshold_DEFAULT
+	}
+	return *p.PassThreshold
+}
 func (p *EvaluatorFieldMapping) SetEvaluatorVersionID(val int64) {
 	p.EvaluatorVersionID = val
 }
@@ -4491,11 +4865,27 @@ func (p *EvaluatorFieldMapping) SetFromEvalSet(val []*FieldMapping) {
 func (p *EvaluatorFieldMapping) SetFromTarget(val []*FieldMapping) {
 	p.FromTarget = val
 }
+func (p *EvaluatorFieldMapping) SetBaselineExptID(val *int64) {
+	p.BaselineExptID = val
+}
+func (p *EvaluatorFieldMapping) SetFromBaselineTarget(val []*FieldMapping) {
+	p.FromBaselineTarget = val
+}
+func (p *EvaluatorFieldMapping) SetAggregatorTypes(val []AggregatorType) {
+	p.AggregatorTypes = val
+}
+func (p *EvaluatorFieldMapping) SetPassThreshold(val *float64) {
+	p.PassThreshold = val
+}
 
 var fieldIDToName_EvaluatorFieldMapping = map[int16]string{
 	1: "evaluator_version_id",
 	2: "from_eval_set",
 	3: "from_target",
+	4: "baseline_expt_id",
+	5: "from_baseline_target",
+	6: "aggregator_types",
+	7: "pass_threshold",
 }
 
 func (p *EvaluatorFieldMapping) IsSetFromEvalSet() bool {
@@ -4506,6 +4896,22 @@ func (p *EvaluatorFieldMapping) IsSetFromTarget() bool {
 	return p.FromTarget != nil
 }
 
+func (p *EvaluatorFieldMapping) IsSetBaselineExptID() bool {
+	return p.BaselineExptID != nil
+}
+
+func (p *EvaluatorFieldMapping) IsSetFromBaselineTarget() bool {
+	return p.FromBaselineTarget != nil
+}
+
+func (p *EvaluatorFieldMapping) IsSetAggregatorTypes() bool {
+	return p.AggregatorTypes != nil
+}
+
+func (p *EvaluatorFieldMapping) IsSetPassThreshold() bool {
+	return p.PassThreshold != nil
+}
+
 func (p *EvaluatorFieldMapping) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4550,22 +4956,54 @@ func (p *EvaluatorFieldMapping) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
 	return nil
 ReadStructBeginError:
@@ -4642,6 +5080,74 @@ func (p *EvaluatorFieldMapping) ReadField3(iprot thrift.TProtocol) error {
 	p.FromTarget = _field
 	return nil
 }
+func (p *EvaluatorFieldMapping) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaselineExptID = _field
+	return nil
+}
+func (p *EvaluatorFieldMapping) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FromBaselineTarget = _field
+	return nil
+}
+func (p *EvaluatorFieldMapping) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]AggregatorType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem AggregatorType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = AggregatorType(v)
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AggregatorTypes = _field
+	return nil
+}
+func (p *EvaluatorFieldMapping) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PassThreshold = _field
+	return nil
+}
 
 func (p *EvaluatorFieldMapping) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -4661,6 +5167,22 @@ func (p *EvaluatorFieldMapping) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4747,6 +5269,94 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *EvaluatorFieldMapping) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaselineExptID() {
+		if err = oprot.WriteFieldBegin("baseline_expt_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.BaselineExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorFieldMapping) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromBaselineTarget() {
+		if err = oprot.WriteFieldBegin("from_baseline_target", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromBaselineTarget)); err != nil {
+			return err
+		}
+		for _, v := range p.FromBaselineTarget {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorFieldMapping) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregatorTypes() {
+		if err = oprot.WriteFieldBegin("aggregator_types", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.AggregatorTypes)); err != nil {
+			return err
+		}
+		for _, v := range p.AggregatorTypes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluatorFieldMapping) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassThreshold() {
+		if err = oprot.WriteFieldBegin("pass_threshold", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PassThreshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
 func (p *EvaluatorFieldMapping) String() string {
 	if p == nil {
@@ -4771,6 +5381,18 @@ func (p *EvaluatorFieldMapping) DeepEqual(ano *EvaluatorFieldMapping) bool {
 	if !p.Field3DeepEqual(ano.FromTarget) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.BaselineExptID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.FromBaselineTarget) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.AggregatorTypes) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.PassThreshold) {
+		return false
+	}
 	return true
 }
 
@@ -4807,6 +5429,56 @@ func (p *EvaluatorFieldMapping) Field3DeepEqual(src []*FieldMapping) bool {
 	}
 	return true
 }
+func (p *EvaluatorFieldMapping) Field4DeepEqual(src *int64) bool {
+
+	if p.BaselineExptID == src {
+		return true
+	} else if p.BaselineExptID == nil || src == nil {
+		return false
+	}
+	if *p.BaselineExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorFieldMapping) Field5DeepEqual(src []*FieldMapping) bool {
+
+	if len(p.FromBaselineTarget) != len(src) {
+		return false
+	}
+	for i, v := range p.FromBaselineTarget {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorFieldMapping) Field6DeepEqual(src []AggregatorType) bool {
+
+	if len(p.AggregatorTypes) != len(src) {
+		return false
+	}
+	for i, v := range p.AggregatorTypes {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorFieldMapping) Field7DeepEqual(src *float64) bool {
+
+	if p.PassThreshold == src {
+		return true
+	} else if p.PassThreshold == nil || src == nil {
+		return false
+	}
+	if *p.PassThreshold != *src {
+		return false
+	}
+	return true
+}
 
 type FieldMapping struct {
 	FieldName     *string `thrift:"field_name,1,optional" frugal:"1,optional,string" form:"field_name" json:"field_name,omitempty" query:"field_name"`
@@ -12367,6 +13039,7 @@ type ExptAggregateResult_ struct {
 	Status           *ExptAggregateCalculateStatus        `thrift:"status,3,optional" frugal:"3,optional,ExptAggregateCalculateStatus" form:"status" json:"status,omitempty" query:"status"`
 	// tag_key_id -> result
 	AnnotationResults map[int64]*AnnotationAggregateResult_ `thrift:"annotation_results,4,optional" frugal:"4,optional,map<i64:AnnotationAggregateResult_>" json:"annotation_results" form:"annotation_results" query:"annotation_results"`
+	TargetResult_     *TargetAggregateResult_               `thrift:"target_result,5,optional" frugal:"5,optional,TargetAggregateResult_" form:"target_result" json:"target_result,omitempty" query:"target_result"`
 }
 
 func NewExptAggregateResult_() *ExptAggregateResult_ {
@@ -12418,6 +13091,18 @@ func (p *ExptAggregateResult_) GetAnnotationResults() (v map[int64]*AnnotationAg
 	}
 	return p.AnnotationResults
 }
+
+var ExptAggregateResult__TargetResult__DEFAULT *TargetAggregateResult_
+
+func (p *ExptAggregateResult_) GetTargetResult_() (v *TargetAggregateResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetResult_() {
+		return ExptAggregateResult__TargetResult__DEFAULT
+	}
+	return p.TargetResult_
+}
 func (p *ExptAggregateResult_) SetExperimentID(val int64) {
 	p.ExperimentID = val
 }
@@ -12430,12 +13115,16 @@ func (p *ExptAggregateResult_) SetStatus(val *ExptAggregateCalculateStatus) {
 func (p *ExptAggregateResult_) SetAnnotationResults(val map[int64]*AnnotationAggregateResult_) {
 	p.AnnotationResults = val
 }
+func (p *ExptAggregateResult_) SetTargetResult_(val *TargetAggregateResult_) {
+	p.TargetResult_ = val
+}
 
 var fieldIDToName_ExptAggregateResult_ = map[int16]string{
 	1: "experiment_id",
 	2: "evaluator_results",
 	3: "status",
 	4: "annotation_results",
+	5: "target_result",
 }
 
 func (p *ExptAggregateResult_) IsSetEvaluatorResults() bool {
@@ -12450,6 +13139,10 @@ func (p *ExptAggregateResult_) IsSetAnnotationResults() bool {
 	return p.AnnotationResults != nil
 }
 
+func (p *ExptAggregateResult_) IsSetTargetResult_() bool {
+	return p.TargetResult_ != nil
+}
+
 func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12502,6 +13195,14 @@ func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -12618,6 +13319,14 @@ func (p *ExptAggregateResult_) ReadField4(iprot thrift.TProtocol) error {
 	p.AnnotationResults = _field
 	return nil
 }
+func (p *ExptAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewTargetAggregateResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TargetResult_ = _field
+	return nil
+}
 
 func (p *ExptAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -12641,6 +13350,10 @@ func (p *ExptAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12751,10 +13464,28 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-
-func (p *ExptAggregateResult_) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ExptAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetResult_() {
+		if err = oprot.WriteFieldBegin("target_result", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TargetResult_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ExptAggregateResult_) String() string {
+	if p == nil {
+		return "<nil>"
 	}
 	return fmt.Sprintf("ExptAggregateResult_(%+v)", *p)
 
@@ -12778,6 +13509,9 @@ func (p *ExptAggregateResult_) DeepEqual(ano *ExptAggregateResult_) bool {
 	if !p.Field4DeepEqual(ano.AnnotationResults) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.TargetResult_) {
+		return false
+	}
 	return true
 }
 
@@ -12826,6 +13560,13 @@ func (p *ExptAggregateResult_) Field4DeepEqual(src map[int64]*AnnotationAggregat
 	}
 	return true
 }
+func (p *ExptAggregateResult_) Field5DeepEqual(src *TargetAggregateResult_) bool {
+
+	if !p.TargetResult_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 // 评估器版本粒度聚合结果
 type EvaluatorAggregateResult_ struct {
@@ -13253,78 +13994,87 @@ func (p *EvaluatorAggregateResult_) Field4DeepEqual(src *string) bool {
 	return true
 }
 
-// 人工标注项粒度聚合结果
-type AnnotationAggregateResult_ struct {
-	TagKeyID          int64                `thrift:"tag_key_id,1,required" frugal:"1,required,i64" json:"tag_key_id" form:"tag_key_id,required" query:"tag_key_id,required"`
-	AggregatorResults []*AggregatorResult_ `thrift:"aggregator_results,2,optional" frugal:"2,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
-	Name              *string              `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty" query:"name"`
+// 评测对象运行指标聚合结果
+type TargetAggregateResult_ struct {
+	// 运行耗时，单位毫秒
+	LatencyMs    []*AggregatorResult_ `thrift:"latency_ms,1,optional" frugal:"1,optional,list<AggregatorResult_>" form:"latency_ms" json:"latency_ms,omitempty" query:"latency_ms"`
+	InputTokens  []*AggregatorResult_ `thrift:"input_tokens,2,optional" frugal:"2,optional,list<AggregatorResult_>" form:"input_tokens" json:"input_tokens,omitempty" query:"input_tokens"`
+	OutputTokens []*AggregatorResult_ `thrift:"output_tokens,3,optional" frugal:"3,optional,list<AggregatorResult_>" form:"output_tokens" json:"output_tokens,omitempty" query:"output_tokens"`
 }
 
-func NewAnnotationAggregateResult_() *AnnotationAggregateResult_ {
-	return &AnnotationAggregateResult_{}
+func NewTargetAggregateResult_() *TargetAggregateResult_ {
+	return &TargetAggregateResult_{}
 }
 
-func (p *AnnotationAggregateResult_) InitDefault() {
+func (p *TargetAggregateResult_) InitDefault() {
 }
 
-func (p *AnnotationAggregateResult_) GetTagKeyID() (v int64) {
-	if p != nil {
-		return p.TagKeyID
+var TargetAggregateResult__LatencyMs_DEFAULT []*AggregatorResult_
+
+func (p *TargetAggregateResult_) GetLatencyMs() (v []*AggregatorResult_) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetLatencyMs() {
+		return TargetAggregateResult__LatencyMs_DEFAULT
+	}
+	return p.LatencyMs
 }
 
-var AnnotationAggregateResult__AggregatorResults_DEFAULT []*AggregatorResult_
+var TargetAggregateResult__InputTokens_DEFAULT []*AggregatorResult_
 
-func (p *AnnotationAggregateResult_) GetAggregatorResults() (v []*AggregatorResult_) {
+func (p *TargetAggregateResult_) GetInputTokens() (v []*AggregatorResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAggregatorResults() {
-		return AnnotationAggregateResult__AggregatorResults_DEFAULT
+	if !p.IsSetInputTokens() {
+		return TargetAggregateResult__InputTokens_DEFAULT
 	}
-	return p.AggregatorResults
+	return p.InputTokens
 }
 
-var AnnotationAggregateResult__Name_DEFAULT string
+var TargetAggregateResult__OutputTokens_DEFAULT []*AggregatorResult_
 
-func (p *AnnotationAggregateResult_) GetName() (v string) {
+func (p *TargetAggregateResult_) GetOutputTokens() (v []*AggregatorResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetName() {
-		return AnnotationAggregateResult__Name_DEFAULT
+	if !p.IsSetOutputTokens() {
+		return TargetAggregateResult__OutputTokens_DEFAULT
 	}
-	return *p.Name
+	return p.OutputTokens
 }
-func (p *AnnotationAggregateResult_) SetTagKeyID(val int64) {
-	p.TagKeyID = val
+func (p *TargetAggregateResult_) SetLatencyMs(val []*AggregatorResult_) {
+	p.LatencyMs = val
 }
-func (p *AnnotationAggregateResult_) SetAggregatorResults(val []*AggregatorResult_) {
-	p.AggregatorResults = val
+func (p *TargetAggregateResult_) SetInputTokens(val []*AggregatorResult_) {
+	p.InputTokens = val
 }
-func (p *AnnotationAggregateResult_) SetName(val *string) {
-	p.Name = val
+func (p *TargetAggregateResult_) SetOutputTokens(val []*AggregatorResult_) {
+	p.OutputTokens = val
 }
 
-var fieldIDToName_AnnotationAggregateResult_ = map[int16]string{
-	1: "tag_key_id",
-	2: "aggregator_results",
-	3: "name",
+var fieldIDToName_TargetAggregateResult_ = map[int16]string{
+	1: "latency_ms",
+	2: "input_tokens",
+	3: "output_tokens",
 }
 
-func (p *AnnotationAggregateResult_) IsSetAggregatorResults() bool {
-	return p.AggregatorResults != nil
+func (p *TargetAggregateResult_) IsSetLatencyMs() bool {
+	return p.LatencyMs != nil
 }
 
-func (p *AnnotationAggregateResult_) IsSetName() bool {
-	return p.Name != nil
+func (p *TargetAggregateResult_) IsSetInputTokens() bool {
+	return p.InputTokens != nil
 }
 
-func (p *AnnotationAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *TargetAggregateResult_) IsSetOutputTokens() bool {
+	return p.OutputTokens != nil
+}
+
+func (p *TargetAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetTagKeyID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -13341,11 +14091,10 @@ func (p *AnnotationAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetTagKeyID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -13358,7 +14107,7 @@ func (p *AnnotationAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13378,17 +14127,13 @@ func (p *AnnotationAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetTagKeyID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AnnotationAggregateResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TargetAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13396,22 +14141,32 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AnnotationAggregateResult_[fieldId]))
 }
 
-func (p *AnnotationAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *TargetAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.TagKeyID = _field
+	p.LatencyMs = _field
 	return nil
 }
-func (p *AnnotationAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+func (p *TargetAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -13431,24 +14186,36 @@ func (p *AnnotationAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.AggregatorResults = _field
+	p.InputTokens = _field
 	return nil
 }
-func (p *AnnotationAggregateResult_) ReadField3(iprot thrift.TProtocol) error {
+func (p *TargetAggregateResult_) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Name = _field
+	p.OutputTokens = _field
 	return nil
 }
 
-func (p *AnnotationAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
+func (p *TargetAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AnnotationAggregateResult"); err != nil {
+	if err = oprot.WriteStructBegin("TargetAggregateResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13482,15 +14249,25 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AnnotationAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tag_key_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.TagKeyID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *TargetAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatencyMs() {
+		if err = oprot.WriteFieldBegin("latency_ms", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.LatencyMs)); err != nil {
+			return err
+		}
+		for _, v := range p.LatencyMs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -13498,15 +14275,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AnnotationAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAggregatorResults() {
-		if err = oprot.WriteFieldBegin("aggregator_results", thrift.LIST, 2); err != nil {
+func (p *TargetAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputTokens() {
+		if err = oprot.WriteFieldBegin("input_tokens", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AggregatorResults)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InputTokens)); err != nil {
 			return err
 		}
-		for _, v := range p.AggregatorResults {
+		for _, v := range p.InputTokens {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -13524,12 +14301,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *AnnotationAggregateResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+func (p *TargetAggregateResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputTokens() {
+		if err = oprot.WriteFieldBegin("output_tokens", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputTokens)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputTokens {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13543,45 +14328,51 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *AnnotationAggregateResult_) String() string {
+func (p *TargetAggregateResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnnotationAggregateResult_(%+v)", *p)
+	return fmt.Sprintf("TargetAggregateResult_(%+v)", *p)
 
 }
 
-func (p *AnnotationAggregateResult_) DeepEqual(ano *AnnotationAggregateResult_) bool {
+func (p *TargetAggregateResult_) DeepEqual(ano *TargetAggregateResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TagKeyID) {
+	if !p.Field1DeepEqual(ano.LatencyMs) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.AggregatorResults) {
+	if !p.Field2DeepEqual(ano.InputTokens) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Name) {
+	if !p.Field3DeepEqual(ano.OutputTokens) {
 		return false
 	}
 	return true
 }
 
-func (p *AnnotationAggregateResult_) Field1DeepEqual(src int64) bool {
+func (p *TargetAggregateResult_) Field1DeepEqual(src []*AggregatorResult_) bool {
 
-	if p.TagKeyID != src {
+	if len(p.LatencyMs) != len(src) {
 		return false
 	}
+	for i, v := range p.LatencyMs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *AnnotationAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) bool {
+func (p *TargetAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) bool {
 
-	if len(p.AggregatorResults) != len(src) {
+	if len(p.InputTokens) != len(src) {
 		return false
 	}
-	for i, v := range p.AggregatorResults {
+	for i, v := range p.InputTokens {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -13589,70 +14380,92 @@ func (p *AnnotationAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) b
 	}
 	return true
 }
-func (p *AnnotationAggregateResult_) Field3DeepEqual(src *string) bool {
+func (p *TargetAggregateResult_) Field3DeepEqual(src []*AggregatorResult_) bool {
 
-	if p.Name == src {
-		return true
-	} else if p.Name == nil || src == nil {
+	if len(p.OutputTokens) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.Name, *src) != 0 {
-		return false
+	for i, v := range p.OutputTokens {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
 
-// 一种聚合器类型的聚合结果
-type AggregatorResult_ struct {
-	AggregatorType AggregatorType `thrift:"aggregator_type,1,required" frugal:"1,required,AggregatorType" form:"aggregator_type,required" json:"aggregator_type,required" query:"aggregator_type,required"`
-	Data           *AggregateData `thrift:"data,2,optional" frugal:"2,optional,AggregateData" form:"data" json:"data,omitempty" query:"data"`
+// 人工标注项粒度聚合结果
+type AnnotationAggregateResult_ struct {
+	TagKeyID          int64                `thrift:"tag_key_id,1,required" frugal:"1,required,i64" json:"tag_key_id" form:"tag_key_id,required" query:"tag_key_id,required"`
+	AggregatorResults []*AggregatorResult_ `thrift:"aggregator_results,2,optional" frugal:"2,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
+	Name              *string              `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty" query:"name"`
 }
 
-func NewAggregatorResult_() *AggregatorResult_ {
-	return &AggregatorResult_{}
+func NewAnnotationAggregateResult_() *AnnotationAggregateResult_ {
+	return &AnnotationAggregateResult_{}
 }
 
-func (p *AggregatorResult_) InitDefault() {
+func (p *AnnotationAggregateResult_) InitDefault() {
 }
 
-func (p *AggregatorResult_) GetAggregatorType() (v AggregatorType) {
+func (p *AnnotationAggregateResult_) GetTagKeyID() (v int64) {
 	if p != nil {
-		return p.AggregatorType
+		return p.TagKeyID
 	}
 	return
 }
 
-var AggregatorResult__Data_DEFAULT *AggregateData
+var AnnotationAggregateResult__AggregatorResults_DEFAULT []*AggregatorResult_
 
-func (p *AggregatorResult_) GetData() (v *AggregateData) {
+func (p *AnnotationAggregateResult_) GetAggregatorResults() (v []*AggregatorResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetData() {
-		return AggregatorResult__Data_DEFAULT
+	if !p.IsSetAggregatorResults() {
+		return AnnotationAggregateResult__AggregatorResults_DEFAULT
 	}
-	return p.Data
-}
-func (p *AggregatorResult_) SetAggregatorType(val AggregatorType) {
-	p.AggregatorType = val
-}
-func (p *AggregatorResult_) SetData(val *AggregateData) {
-	p.Data = val
+	return p.AggregatorResults
 }
 
-var fieldIDToName_AggregatorResult_ = map[int16]string{
-	1: "aggregator_type",
-	2: "data",
-}
+var AnnotationAggregateResult__Name_DEFAULT string
 
-func (p *AggregatorResult_) IsSetData() bool {
-	return p.Data != nil
+func (p *AnnotationAggregateResult_) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return AnnotationAggregateResult__Name_DEFAULT
+	}
+	return *p.Name
 }
-
-func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *AnnotationAggregateResult_) SetTagKeyID(val int64) {
+	p.TagKeyID = val
+}
+func (p *AnnotationAggregateResult_) SetAggregatorResults(val []*AggregatorResult_) {
+	p.AggregatorResults = val
+}
+func (p *AnnotationAggregateResult_) SetName(val *string) {
+	p.Name = val
+}
+
+var fieldIDToName_AnnotationAggregateResult_ = map[int16]string{
+	1: "tag_key_id",
+	2: "aggregator_results",
+	3: "name",
+}
+
+func (p *AnnotationAggregateResult_) IsSetAggregatorResults() bool {
+	return p.AggregatorResults != nil
+}
+
+func (p *AnnotationAggregateResult_) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *AnnotationAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetAggregatorType bool = false
+	var issetTagKeyID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -13669,22 +14482,30 @@ func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAggregatorType = true
+				issetTagKeyID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -13698,7 +14519,7 @@ func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetAggregatorType {
+	if !issetTagKeyID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
@@ -13708,7 +14529,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregatorResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AnnotationAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13717,32 +14538,58 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AggregatorResult_[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AnnotationAggregateResult_[fieldId]))
 }
 
-func (p *AggregatorResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *AnnotationAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field AggregatorType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = AggregatorType(v)
+		_field = v
 	}
-	p.AggregatorType = _field
+	p.TagKeyID = _field
 	return nil
 }
-func (p *AggregatorResult_) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewAggregateData()
-	if err := _field.Read(iprot); err != nil {
+func (p *AnnotationAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.Data = _field
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AggregatorResults = _field
 	return nil
 }
+func (p *AnnotationAggregateResult_) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+
+func (p *AnnotationAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AggregatorResult"); err != nil {
+	if err = oprot.WriteStructBegin("AnnotationAggregateResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13754,6 +14601,10 @@ func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13772,11 +14623,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AggregatorResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("aggregator_type", thrift.I32, 1); err != nil {
+func (p *AnnotationAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tag_key_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(int32(p.AggregatorType)); err != nil {
+	if err := oprot.WriteI64(p.TagKeyID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13788,12 +14639,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AggregatorResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetData() {
-		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 2); err != nil {
+func (p *AnnotationAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregatorResults() {
+		if err = oprot.WriteFieldBegin("aggregator_results", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Data.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AggregatorResults)); err != nil {
+			return err
+		}
+		for _, v := range p.AggregatorResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13806,82 +14665,135 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *AnnotationAggregateResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
-func (p *AggregatorResult_) String() string {
+func (p *AnnotationAggregateResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregatorResult_(%+v)", *p)
+	return fmt.Sprintf("AnnotationAggregateResult_(%+v)", *p)
 
 }
 
-func (p *AggregatorResult_) DeepEqual(ano *AggregatorResult_) bool {
+func (p *AnnotationAggregateResult_) DeepEqual(ano *AnnotationAggregateResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.AggregatorType) {
+	if !p.Field1DeepEqual(ano.TagKeyID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Data) {
+	if !p.Field2DeepEqual(ano.AggregatorResults) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Name) {
 		return false
 	}
 	return true
 }
 
-func (p *AggregatorResult_) Field1DeepEqual(src AggregatorType) bool {
+func (p *AnnotationAggregateResult_) Field1DeepEqual(src int64) bool {
 
-	if p.AggregatorType != src {
+	if p.TagKeyID != src {
 		return false
 	}
 	return true
 }
-func (p *AggregatorResult_) Field2DeepEqual(src *AggregateData) bool {
+func (p *AnnotationAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) bool {
 
-	if !p.Data.DeepEqual(src) {
+	if len(p.AggregatorResults) != len(src) {
 		return false
 	}
+	for i, v := range p.AggregatorResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
+func (p *AnnotationAggregateResult_) Field3DeepEqual(src *string) bool {
 
-type ScoreDistribution struct {
-	ScoreDistributionItems []*ScoreDistributionItem `thrift:"score_distribution_items,1,optional" frugal:"1,optional,list<ScoreDistributionItem>" form:"score_distribution_items" json:"score_distribution_items,omitempty" query:"score_distribution_items"`
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
 }
 
-func NewScoreDistribution() *ScoreDistribution {
-	return &ScoreDistribution{}
+// 一种聚合器类型的聚合结果
+type AggregatorResult_ struct {
+	AggregatorType AggregatorType `thrift:"aggregator_type,1,required" frugal:"1,required,AggregatorType" form:"aggregator_type,required" json:"aggregator_type,required" query:"aggregator_type,required"`
+	Data           *AggregateData `thrift:"data,2,optional" frugal:"2,optional,AggregateData" form:"data" json:"data,omitempty" query:"data"`
 }
 
-func (p *ScoreDistribution) InitDefault() {
+func NewAggregatorResult_() *AggregatorResult_ {
+	return &AggregatorResult_{}
 }
 
-var ScoreDistribution_ScoreDistributionItems_DEFAULT []*ScoreDistributionItem
+func (p *AggregatorResult_) InitDefault() {
+}
 
-func (p *ScoreDistribution) GetScoreDistributionItems() (v []*ScoreDistributionItem) {
+func (p *AggregatorResult_) GetAggregatorType() (v AggregatorType) {
+	if p != nil {
+		return p.AggregatorType
+	}
+	return
+}
+
+var AggregatorResult__Data_DEFAULT *AggregateData
+
+func (p *AggregatorResult_) GetData() (v *AggregateData) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetScoreDistributionItems() {
-		return ScoreDistribution_ScoreDistributionItems_DEFAULT
+	if !p.IsSetData() {
+		return AggregatorResult__Data_DEFAULT
 	}
-	return p.ScoreDistributionItems
+	return p.Data
 }
-func (p *ScoreDistribution) SetScoreDistributionItems(val []*ScoreDistributionItem) {
-	p.ScoreDistributionItems = val
+func (p *AggregatorResult_) SetAggregatorType(val AggregatorType) {
+	p.AggregatorType = val
+}
+func (p *AggregatorResult_) SetData(val *AggregateData) {
+	p.Data = val
 }
 
-var fieldIDToName_ScoreDistribution = map[int16]string{
-	1: "score_distribution_items",
+var fieldIDToName_AggregatorResult_ = map[int16]string{
+	1: "aggregator_type",
+	2: "data",
 }
 
-func (p *ScoreDistribution) IsSetScoreDistributionItems() bool {
-	return p.ScoreDistributionItems != nil
+func (p *AggregatorResult_) IsSetData() bool {
+	return p.Data != nil
 }
 
-func (p *ScoreDistribution) Read(iprot thrift.TProtocol) (err error) {
+func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetAggregatorType bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -13898,10 +14810,19 @@ func (p *ScoreDistribution) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetAggregatorType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -13918,13 +14839,17 @@ func (p *ScoreDistribution) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetAggregatorType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistribution[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregatorResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13932,15 +14857,231 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AggregatorResult_[fieldId]))
 }
 
-func (p *ScoreDistribution) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *AggregatorResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field AggregatorType
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = AggregatorType(v)
 	}
-	_field := make([]*ScoreDistributionItem, 0, size)
-	values := make([]ScoreDistributionItem, size)
+	p.AggregatorType = _field
+	return nil
+}
+func (p *AggregatorResult_) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewAggregateData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+
+func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AggregatorResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AggregatorResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("aggregator_type", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.AggregatorType)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AggregatorResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetData() {
+		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Data.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AggregatorResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregatorResult_(%+v)", *p)
+
+}
+
+func (p *AggregatorResult_) DeepEqual(ano *AggregatorResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AggregatorType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Data) {
+		return false
+	}
+	return true
+}
+
+func (p *AggregatorResult_) Field1DeepEqual(src AggregatorType) bool {
+
+	if p.AggregatorType != src {
+		return false
+	}
+	return true
+}
+func (p *AggregatorResult_) Field2DeepEqual(src *AggregateData) bool {
+
+	if !p.Data.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ScoreDistribution struct {
+	ScoreDistributionItems []*ScoreDistributionItem `thrift:"score_distribution_items,1,optional" frugal:"1,optional,list<ScoreDistributionItem>" form:"score_distribution_items" json:"score_distribution_items,omitempty" query:"score_distribution_items"`
+}
+
+func NewScoreDistribution() *ScoreDistribution {
+	return &ScoreDistribution{}
+}
+
+func (p *ScoreDistribution) InitDefault() {
+}
+
+var ScoreDistribution_ScoreDistributionItems_DEFAULT []*ScoreDistributionItem
+
+func (p *ScoreDistribution) GetScoreDistributionItems() (v []*ScoreDistributionItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScoreDistributionItems() {
+		return ScoreDistribution_ScoreDistributionItems_DEFAULT
+	}
+	return p.ScoreDistributionItems
+}
+func (p *ScoreDistribution) SetScoreDistributionItems(val []*ScoreDistributionItem) {
+	p.ScoreDistributionItems = val
+}
+
+var fieldIDToName_ScoreDistribution = map[int16]string{
+	1: "score_distribution_items",
+}
+
+func (p *ScoreDistribution) IsSetScoreDistributionItems() bool {
+	return p.ScoreDistributionItems != nil
+}
+
+func (p *ScoreDistribution) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistribution[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ScoreDistribution) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ScoreDistributionItem, 0, size)
+	values := make([]ScoreDistributionItem, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -16423,293 +17564,4212 @@ func (p *ColumnAnnotation) DeepEqual(ano *ColumnAnnotation) bool {
 	if !p.Field4DeepEqual(ano.Status) {
 		return false
 	}
-	if !p.Field13DeepEqual(ano.TagValues) {
+	if !p.Field13DeepEqual(ano.TagValues) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.ContentType) {
+		return false
+	}
+	if !p.Field15DeepEqual(ano.ContentSpec) {
+		return false
+	}
+	return true
+}
+
+func (p *ColumnAnnotation) Field1DeepEqual(src *int64) bool {
+
+	if p.TagKeyID == src {
+		return true
+	} else if p.TagKeyID == nil || src == nil {
+		return false
+	}
+	if *p.TagKeyID != *src {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field2DeepEqual(src *string) bool {
+
+	if p.TagKeyName == src {
+		return true
+	} else if p.TagKeyName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TagKeyName, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field3DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field4DeepEqual(src *tag.TagStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field13DeepEqual(src []*tag.TagValue) bool {
+
+	if len(p.TagValues) != len(src) {
+		return false
+	}
+	for i, v := range p.TagValues {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field14DeepEqual(src *tag.TagContentType) bool {
+
+	if p.ContentType == src {
+		return true
+	} else if p.ContentType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ContentType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field15DeepEqual(src *tag.TagContentSpec) bool {
+
+	if !p.ContentSpec.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExptResultExportRecord struct {
+	ExportID        int64            `thrift:"export_id,1,required" frugal:"1,required,i64" json:"export_id" form:"export_id,required" query:"export_id,required"`
+	WorkspaceID     int64            `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
+	ExptID          int64            `thrift:"expt_id,3,required" frugal:"3,required,i64" json:"expt_id" form:"expt_id,required" query:"expt_id,required"`
+	CsvExportStatus CSVExportStatus  `thrift:"csv_export_status,4,required" frugal:"4,required,string" form:"csv_export_status,required" json:"csv_export_status,required" query:"csv_export_status,required"`
+	BaseInfo        *common.BaseInfo `thrift:"base_info,5,optional" frugal:"5,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	StartTime       *int64           `thrift:"start_time,6,optional" frugal:"6,optional,i64" json:"start_time" form:"start_time" query:"start_time"`
+	EndTime         *int64           `thrift:"end_time,7,optional" frugal:"7,optional,i64" json:"end_time" form:"end_time" query:"end_time"`
+	URL             *string          `thrift:"URL,8,optional" frugal:"8,optional,string" form:"URL" json:"URL,omitempty" query:"URL"`
+	Expired         *bool            `thrift:"expired,9,optional" frugal:"9,optional,bool" form:"expired" json:"expired,omitempty" query:"expired"`
+	Error           *RunError        `thrift:"error,10,optional" frugal:"10,optional,RunError" form:"error" json:"error,omitempty" query:"error"`
+}
+
+func NewExptResultExportRecord() *ExptResultExportRecord {
+	return &ExptResultExportRecord{}
+}
+
+func (p *ExptResultExportRecord) InitDefault() {
+}
+
+func (p *ExptResultExportRecord) GetExportID() (v int64) {
+	if p != nil {
+		return p.ExportID
+	}
+	return
+}
+
+func (p *ExptResultExportRecord) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *ExptResultExportRecord) GetExptID() (v int64) {
+	if p != nil {
+		return p.ExptID
+	}
+	return
+}
+
+func (p *ExptResultExportRecord) GetCsvExportStatus() (v CSVExportStatus) {
+	if p != nil {
+		return p.CsvExportStatus
+	}
+	return
+}
+
+var ExptResultExportRecord_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *ExptResultExportRecord) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return ExptResultExportRecord_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+
+var ExptResultExportRecord_StartTime_DEFAULT int64
+
+func (p *ExptResultExportRecord) GetStartTime() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStartTime() {
+		return ExptResultExportRecord_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ExptResultExportRecord_EndTime_DEFAULT int64
+
+func (p *ExptResultExportRecord) GetEndTime() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEndTime() {
+		return ExptResultExportRecord_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var ExptResultExportRecord_URL_DEFAULT string
+
+func (p *ExptResultExportRecord) GetURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURL() {
+		return ExptResultExportRecord_URL_DEFAULT
+	}
+	return *p.URL
+}
+
+var ExptResultExportRecord_Expired_DEFAULT bool
+
+func (p *ExptResultExportRecord) GetExpired() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExpired() {
+		return ExptResultExportRecord_Expired_DEFAULT
+	}
+	return *p.Expired
+}
+
+var ExptResultExportRecord_Error_DEFAULT *RunError
+
+func (p *ExptResultExportRecord) GetError() (v *RunError) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetError() {
+		return ExptResultExportRecord_Error_DEFAULT
+	}
+	return p.Error
+}
+func (p *ExptResultExportRecord) SetExportID(val int64) {
+	p.ExportID = val
+}
+func (p *ExptResultExportRecord) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *ExptResultExportRecord) SetExptID(val int64) {
+	p.ExptID = val
+}
+func (p *ExptResultExportRecord) SetCsvExportStatus(val CSVExportStatus) {
+	p.CsvExportStatus = val
+}
+func (p *ExptResultExportRecord) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+func (p *ExptResultExportRecord) SetStartTime(val *int64) {
+	p.StartTime = val
+}
+func (p *ExptResultExportRecord) SetEndTime(val *int64) {
+	p.EndTime = val
+}
+func (p *ExptResultExportRecord) SetURL(val *string) {
+	p.URL = val
+}
+func (p *ExptResultExportRecord) SetExpired(val *bool) {
+	p.Expired = val
+}
+func (p *ExptResultExportRecord) SetError(val *RunError) {
+	p.Error = val
+}
+
+var fieldIDToName_ExptResultExportRecord = map[int16]string{
+	1:  "export_id",
+	2:  "workspace_id",
+	3:  "expt_id",
+	4:  "csv_export_status",
+	5:  "base_info",
+	6:  "start_time",
+	7:  "end_time",
+	8:  "URL",
+	9:  "expired",
+	10: "error",
+}
+
+func (p *ExptResultExportRecord) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *ExptResultExportRecord) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ExptResultExportRecord) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ExptResultExportRecord) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *ExptResultExportRecord) IsSetExpired() bool {
+	return p.Expired != nil
+}
+
+func (p *ExptResultExportRecord) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetExportID bool = false
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetCsvExportStatus bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExportID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCsvExportStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetExportID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCsvExportStatus {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptResultExportRecord[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptResultExportRecord[fieldId]))
+}
+
+func (p *ExptResultExportRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExportID = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExptID = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field CSVExportStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CsvExportStatus = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField5(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Expired = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewRunError()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Error = _field
+	return nil
+}
+
+func (p *ExptResultExportRecord) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptResultExportRecord"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptResultExportRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("export_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExportID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExptID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("csv_export_status", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CsvExportStatus); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL() {
+		if err = oprot.WriteFieldBegin("URL", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpired() {
+		if err = oprot.WriteFieldBegin("expired", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Expired); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Error.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *ExptResultExportRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptResultExportRecord(%+v)", *p)
+
+}
+
+func (p *ExptResultExportRecord) DeepEqual(ano *ExptResultExportRecord) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ExportID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ExptID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CsvExportStatus) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.URL) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Expired) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Error) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptResultExportRecord) Field1DeepEqual(src int64) bool {
+
+	if p.ExportID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field2DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field3DeepEqual(src int64) bool {
+
+	if p.ExptID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field4DeepEqual(src CSVExportStatus) bool {
+
+	if strings.Compare(p.CsvExportStatus, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field5DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field6DeepEqual(src *int64) bool {
+
+	if p.StartTime == src {
+		return true
+	} else if p.StartTime == nil || src == nil {
+		return false
+	}
+	if *p.StartTime != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field7DeepEqual(src *int64) bool {
+
+	if p.EndTime == src {
+		return true
+	} else if p.EndTime == nil || src == nil {
+		return false
+	}
+	if *p.EndTime != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field8DeepEqual(src *string) bool {
+
+	if p.URL == src {
+		return true
+	} else if p.URL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field9DeepEqual(src *bool) bool {
+
+	if p.Expired == src {
+		return true
+	} else if p.Expired == nil || src == nil {
+		return false
+	}
+	if *p.Expired != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field10DeepEqual(src *RunError) bool {
+
+	if !p.Error.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 置信区间
+type ConfidenceInterval struct {
+	Lower           *float64 `thrift:"lower,1,optional" frugal:"1,optional,double" form:"lower" json:"lower,omitempty" query:"lower"`
+	Upper           *float64 `thrift:"upper,2,optional" frugal:"2,optional,double" form:"upper" json:"upper,omitempty" query:"upper"`
+	ConfidenceLevel *float64 `thrift:"confidence_level,3,optional" frugal:"3,optional,double" form:"confidence_level" json:"confidence_level,omitempty" query:"confidence_level"`
+}
+
+func NewConfidenceInterval() *ConfidenceInterval {
+	return &ConfidenceInterval{}
+}
+
+func (p *ConfidenceInterval) InitDefault() {
+}
+
+var ConfidenceInterval_Lower_DEFAULT float64
+
+func (p *ConfidenceInterval) GetLower() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLower() {
+		return ConfidenceInterval_Lower_DEFAULT
+	}
+	return *p.Lower
+}
+
+var ConfidenceInterval_Upper_DEFAULT float64
+
+func (p *ConfidenceInterval) GetUpper() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUpper() {
+		return ConfidenceInterval_Upper_DEFAULT
+	}
+	return *p.Upper
+}
+
+var ConfidenceInterval_ConfidenceLevel_DEFAULT float64
+
+func (p *ConfidenceInterval) GetConfidenceLevel() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetConfidenceLevel() {
+		return ConfidenceInterval_ConfidenceLevel_DEFAULT
+	}
+	return *p.ConfidenceLevel
+}
+func (p *ConfidenceInterval) SetLower(val *float64) {
+	p.Lower = val
+}
+func (p *ConfidenceInterval) SetUpper(val *float64) {
+	p.Upper = val
+}
+func (p *ConfidenceInterval) SetConfidenceLevel(val *float64) {
+	p.ConfidenceLevel = val
+}
+
+var fieldIDToName_ConfidenceInterval = map[int16]string{
+	1: "lower",
+	2: "upper",
+	3: "confidence_level",
+}
+
+func (p *ConfidenceInterval) IsSetLower() bool {
+	return p.Lower != nil
+}
+
+func (p *ConfidenceInterval) IsSetUpper() bool {
+	return p.Upper != nil
+}
+
+func (p *ConfidenceInterval) IsSetConfidenceLevel() bool {
+	return p.ConfidenceLevel != nil
+}
+
+func (p *ConfidenceInterval) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfidenceInterval[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ConfidenceInterval) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Lower = _field
+	return nil
+}
+func (p *ConfidenceInterval) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Upper = _field
+	return nil
+}
+func (p *ConfidenceInterval) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ConfidenceLevel = _field
+	return nil
+}
+
+func (p *ConfidenceInterval) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfidenceInterval"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ConfidenceInterval) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLower() {
+		if err = oprot.WriteFieldBegin("lower", thrift.DOUBLE, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Lower); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ConfidenceInterval) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpper() {
+		if err = oprot.WriteFieldBegin("upper", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Upper); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ConfidenceInterval) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfidenceLevel() {
+		if err = oprot.WriteFieldBegin("confidence_level", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ConfidenceLevel); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ConfidenceInterval) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfidenceInterval(%+v)", *p)
+
+}
+
+func (p *ConfidenceInterval) DeepEqual(ano *ConfidenceInterval) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Lower) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Upper) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ConfidenceLevel) {
+		return false
+	}
+	return true
+}
+
+func (p *ConfidenceInterval) Field1DeepEqual(src *float64) bool {
+
+	if p.Lower == src {
+		return true
+	} else if p.Lower == nil || src == nil {
+		return false
+	}
+	if *p.Lower != *src {
+		return false
+	}
+	return true
+}
+func (p *ConfidenceInterval) Field2DeepEqual(src *float64) bool {
+
+	if p.Upper == src {
+		return true
+	} else if p.Upper == nil || src == nil {
+		return false
+	}
+	if *p.Upper != *src {
+		return false
+	}
+	return true
+}
+func (p *ConfidenceInterval) Field3DeepEqual(src *float64) bool {
+
+	if p.ConfidenceLevel == src {
+		return true
+	} else if p.ConfidenceLevel == nil || src == nil {
+		return false
+	}
+	if *p.ConfidenceLevel != *src {
+		return false
+	}
+	return true
+}
+
+// 配对 t 检验结果
+type PairedTTestResult_ struct {
+	TStatistic       *float64 `thrift:"t_statistic,1,optional" frugal:"1,optional,double" form:"t_statistic" json:"t_statistic,omitempty" query:"t_statistic"`
+	PValue           *float64 `thrift:"p_value,2,optional" frugal:"2,optional,double" form:"p_value" json:"p_value,omitempty" query:"p_value"`
+	DegreesOfFreedom *int32   `thrift:"degrees_of_freedom,3,optional" frugal:"3,optional,i32" form:"degrees_of_freedom" json:"degrees_of_freedom,omitempty" query:"degrees_of_freedom"`
+	// 得分差均值的 t 分布置信区间
+	ConfidenceInterval *ConfidenceInterval `thrift:"confidence_interval,4,optional" frugal:"4,optional,ConfidenceInterval" form:"confidence_interval" json:"confidence_interval,omitempty" query:"confidence_interval"`
+}
+
+func NewPairedTTestResult_() *PairedTTestResult_ {
+	return &PairedTTestResult_{}
+}
+
+func (p *PairedTTestResult_) InitDefault() {
+}
+
+var PairedTTestResult__TStatistic_DEFAULT float64
+
+func (p *PairedTTestResult_) GetTStatistic() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTStatistic() {
+		return PairedTTestResult__TStatistic_DEFAULT
+	}
+	return *p.TStatistic
+}
+
+var PairedTTestResult__PValue_DEFAULT float64
+
+func (p *PairedTTestResult_) GetPValue() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPValue() {
+		return PairedTTestResult__PValue_DEFAULT
+	}
+	return *p.PValue
+}
+
+var PairedTTestResult__DegreesOfFreedom_DEFAULT int32
+
+func (p *PairedTTestResult_) GetDegreesOfFreedom() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDegreesOfFreedom() {
+		return PairedTTestResult__DegreesOfFreedom_DEFAULT
+	}
+	return *p.DegreesOfFreedom
+}
+
+var PairedTTestResult__ConfidenceInterval_DEFAULT *ConfidenceInterval
+
+func (p *PairedTTestResult_) GetConfidenceInterval() (v *ConfidenceInterval) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetConfidenceInterval() {
+		return PairedTTestResult__ConfidenceInterval_DEFAULT
+	}
+	return p.ConfidenceInterval
+}
+func (p *PairedTTestResult_) SetTStatistic(val *float64) {
+	p.TStatistic = val
+}
+func (p *PairedTTestResult_) SetPValue(val *float64) {
+	p.PValue = val
+}
+func (p *PairedTTestResult_) SetDegreesOfFreedom(val *int32) {
+	p.DegreesOfFreedom = val
+}
+func (p *PairedTTestResult_) SetConfidenceInterval(val *ConfidenceInterval) {
+	p.ConfidenceInterval = val
+}
+
+var fieldIDToName_PairedTTestResult_ = map[int16]string{
+	1: "t_statistic",
+	2: "p_value",
+	3: "degrees_of_freedom",
+	4: "confidence_interval",
+}
+
+func (p *PairedTTestResult_) IsSetTStatistic() bool {
+	return p.TStatistic != nil
+}
+
+func (p *PairedTTestResult_) IsSetPValue() bool {
+	return p.PValue != nil
+}
+
+func (p *PairedTTestResult_) IsSetDegreesOfFreedom() bool {
+	return p.DegreesOfFreedom != nil
+}
+
+func (p *PairedTTestResult_) IsSetConfidenceInterval() bool {
+	return p.ConfidenceInterval != nil
+}
+
+func (p *PairedTTestResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PairedTTestResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PairedTTestResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TStatistic = _field
+	return nil
+}
+func (p *PairedTTestResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PValue = _field
+	return nil
+}
+func (p *PairedTTestResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DegreesOfFreedom = _field
+	return nil
+}
+func (p *PairedTTestResult_) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewConfidenceInterval()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ConfidenceInterval = _field
+	return nil
+}
+
+func (p *PairedTTestResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PairedTTestResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PairedTTestResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTStatistic() {
+		if err = oprot.WriteFieldBegin("t_statistic", thrift.DOUBLE, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TStatistic); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PairedTTestResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPValue() {
+		if err = oprot.WriteFieldBegin("p_value", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *PairedTTestResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDegreesOfFreedom() {
+		if err = oprot.WriteFieldBegin("degrees_of_freedom", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.DegreesOfFreedom); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *PairedTTestResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfidenceInterval() {
+		if err = oprot.WriteFieldBegin("confidence_interval", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ConfidenceInterval.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *PairedTTestResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PairedTTestResult_(%+v)", *p)
+
+}
+
+func (p *PairedTTestResult_) DeepEqual(ano *PairedTTestResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TStatistic) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PValue) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.DegreesOfFreedom) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ConfidenceInterval) {
+		return false
+	}
+	return true
+}
+
+func (p *PairedTTestResult_) Field1DeepEqual(src *float64) bool {
+
+	if p.TStatistic == src {
+		return true
+	} else if p.TStatistic == nil || src == nil {
+		return false
+	}
+	if *p.TStatistic != *src {
+		return false
+	}
+	return true
+}
+func (p *PairedTTestResult_) Field2DeepEqual(src *float64) bool {
+
+	if p.PValue == src {
+		return true
+	} else if p.PValue == nil || src == nil {
+		return false
+	}
+	if *p.PValue != *src {
+		return false
+	}
+	return true
+}
+func (p *PairedTTestResult_) Field3DeepEqual(src *int32) bool {
+
+	if p.DegreesOfFreedom == src {
+		return true
+	} else if p.DegreesOfFreedom == nil || src == nil {
+		return false
+	}
+	if *p.DegreesOfFreedom != *src {
+		return false
+	}
+	return true
+}
+func (p *PairedTTestResult_) Field4DeepEqual(src *ConfidenceInterval) bool {
+
+	if !p.ConfidenceInterval.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 单条数据上的得分变化
+type ItemScoreDiff struct {
+	ItemID        int64    `thrift:"item_id,1,required" frugal:"1,required,i64" form:"item_id" json:"item_id" query:"item_id"`
+	TurnID        *int64   `thrift:"turn_id,2,optional" frugal:"2,optional,i64" form:"turn_id" json:"turn_id" query:"turn_id"`
+	BaselineScore *float64 `thrift:"baseline_score,3,optional" frugal:"3,optional,double" form:"baseline_score" json:"baseline_score,omitempty" query:"baseline_score"`
+	Score         *float64 `thrift:"score,4,optional" frugal:"4,optional,double" form:"score" json:"score,omitempty" query:"score"`
+	// score - baseline_score
+	Diff *float64 `thrift:"diff,5,optional" frugal:"5,optional,double" form:"diff" json:"diff,omitempty" query:"diff"`
+}
+
+func NewItemScoreDiff() *ItemScoreDiff {
+	return &ItemScoreDiff{}
+}
+
+func (p *ItemScoreDiff) InitDefault() {
+}
+
+func (p *ItemScoreDiff) GetItemID() (v int64) {
+	if p != nil {
+		return p.ItemID
+	}
+	return
+}
+
+var ItemScoreDiff_TurnID_DEFAULT int64
+
+func (p *ItemScoreDiff) GetTurnID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTurnID() {
+		return ItemScoreDiff_TurnID_DEFAULT
+	}
+	return *p.TurnID
+}
+
+var ItemScoreDiff_BaselineScore_DEFAULT float64
+
+func (p *ItemScoreDiff) GetBaselineScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaselineScore() {
+		return ItemScoreDiff_BaselineScore_DEFAULT
+	}
+	return *p.BaselineScore
+}
+
+var ItemScoreDiff_Score_DEFAULT float64
+
+func (p *ItemScoreDiff) GetScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScore() {
+		return ItemScoreDiff_Score_DEFAULT
+	}
+	return *p.Score
+}
+
+var ItemScoreDiff_Diff_DEFAULT float64
+
+func (p *ItemScoreDiff) GetDiff() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDiff() {
+		return ItemScoreDiff_Diff_DEFAULT
+	}
+	return *p.Diff
+}
+func (p *ItemScoreDiff) SetItemID(val int64) {
+	p.ItemID = val
+}
+func (p *ItemScoreDiff) SetTurnID(val *int64) {
+	p.TurnID = val
+}
+func (p *ItemScoreDiff) SetBaselineScore(val *float64) {
+	p.BaselineScore = val
+}
+func (p *ItemScoreDiff) SetScore(val *float64) {
+	p.Score = val
+}
+func (p *ItemScoreDiff) SetDiff(val *float64) {
+	p.Diff = val
+}
+
+var fieldIDToName_ItemScoreDiff = map[int16]string{
+	1: "item_id",
+	2: "turn_id",
+	3: "baseline_score",
+	4: "score",
+	5: "diff",
+}
+
+func (p *ItemScoreDiff) IsSetTurnID() bool {
+	return p.TurnID != nil
+}
+
+func (p *ItemScoreDiff) IsSetBaselineScore() bool {
+	return p.BaselineScore != nil
+}
+
+func (p *ItemScoreDiff) IsSetScore() bool {
+	return p.Score != nil
+}
+
+func (p *ItemScoreDiff) IsSetDiff() bool {
+	return p.Diff != nil
+}
+
+func (p *ItemScoreDiff) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetItemID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetItemID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetItemID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemScoreDiff[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ItemScoreDiff[fieldId]))
+}
+
+func (p *ItemScoreDiff) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ItemID = _field
+	return nil
+}
+func (p *ItemScoreDiff) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TurnID = _field
+	return nil
+}
+func (p *ItemScoreDiff) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaselineScore = _field
+	return nil
+}
+func (p *ItemScoreDiff) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *ItemScoreDiff) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Diff = _field
+	return nil
+}
+
+func (p *ItemScoreDiff) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ItemScoreDiff"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ItemScoreDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("item_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ItemID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ItemScoreDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnID() {
+		if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TurnID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ItemScoreDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaselineScore() {
+		if err = oprot.WriteFieldBegin("baseline_score", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BaselineScore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ItemScoreDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Score); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ItemScoreDiff) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiff() {
+		if err = oprot.WriteFieldBegin("diff", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Diff); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ItemScoreDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ItemScoreDiff(%+v)", *p)
+
+}
+
+func (p *ItemScoreDiff) DeepEqual(ano *ItemScoreDiff) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ItemID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TurnID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.BaselineScore) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Score) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Diff) {
+		return false
+	}
+	return true
+}
+
+func (p *ItemScoreDiff) Field1DeepEqual(src int64) bool {
+
+	if p.ItemID != src {
+		return false
+	}
+	return true
+}
+func (p *ItemScoreDiff) Field2DeepEqual(src *int64) bool {
+
+	if p.TurnID == src {
+		return true
+	} else if p.TurnID == nil || src == nil {
+		return false
+	}
+	if *p.TurnID != *src {
+		return false
+	}
+	return true
+}
+func (p *ItemScoreDiff) Field3DeepEqual(src *float64) bool {
+
+	if p.BaselineScore == src {
+		return true
+	} else if p.BaselineScore == nil || src == nil {
+		return false
+	}
+	if *p.BaselineScore != *src {
+		return false
+	}
+	return true
+}
+func (p *ItemScoreDiff) Field4DeepEqual(src *float64) bool {
+
+	if p.Score == src {
+		return true
+	} else if p.Score == nil || src == nil {
+		return false
+	}
+	if *p.Score != *src {
+		return false
+	}
+	return true
+}
+func (p *ItemScoreDiff) Field5DeepEqual(src *float64) bool {
+
+	if p.Diff == src {
+		return true
+	} else if p.Diff == nil || src == nil {
+		return false
+	}
+	if *p.Diff != *src {
+		return false
+	}
+	return true
+}
+
+// 评估器版本粒度的实验对比结果，差值均为当前实验减去基准实验
+type EvaluatorCompareResult_ struct {
+	EvaluatorVersionID int64 `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" form:"evaluator_version_id" json:"evaluator_version_id" query:"evaluator_version_id"`
+	// 两个实验均有得分的数据条数
+	PairedCount                 *int32              `thrift:"paired_count,2,optional" frugal:"2,optional,i32" form:"paired_count" json:"paired_count,omitempty" query:"paired_count"`
+	BaselineMean                *float64            `thrift:"baseline_mean,3,optional" frugal:"3,optional,double" form:"baseline_mean" json:"baseline_mean,omitempty" query:"baseline_mean"`
+	Mean                        *float64            `thrift:"mean,4,optional" frugal:"4,optional,double" form:"mean" json:"mean,omitempty" query:"mean"`
+	MeanDiff                    *float64            `thrift:"mean_diff,5,optional" frugal:"5,optional,double" form:"mean_diff" json:"mean_diff,omitempty" query:"mean_diff"`
+	TTest                       *PairedTTestResult_ `thrift:"t_test,6,optional" frugal:"6,optional,PairedTTestResult_" form:"t_test" json:"t_test,omitempty" query:"t_test"`
+	BootstrapConfidenceInterval *ConfidenceInterval `thrift:"bootstrap_confidence_interval,7,optional" frugal:"7,optional,ConfidenceInterval" form:"bootstrap_confidence_interval" json:"bootstrap_confidence_interval,omitempty" query:"bootstrap_confidence_interval"`
+	// t 检验的 p 值小于 1 - confidence_level
+	Significant *bool `thrift:"significant,8,optional" frugal:"8,optional,bool" form:"significant" json:"significant,omitempty" query:"significant"`
+	// 得分下降超过阈值的数据，按下降幅度倒序
+	Regressions []*ItemScoreDiff `thrift:"regressions,9,optional" frugal:"9,optional,list<ItemScoreDiff>" form:"regressions" json:"regressions,omitempty" query:"regressions"`
+}
+
+func NewEvaluatorCompareResult_() *EvaluatorCompareResult_ {
+	return &EvaluatorCompareResult_{}
+}
+
+func (p *EvaluatorCompareResult_) InitDefault() {
+}
+
+func (p *EvaluatorCompareResult_) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
+}
+
+var EvaluatorCompareResult__PairedCount_DEFAULT int32
+
+func (p *EvaluatorCompareResult_) GetPairedCount() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairedCount() {
+		return EvaluatorCompareResult__PairedCount_DEFAULT
+	}
+	return *p.PairedCount
+}
+
+var EvaluatorCompareResult__BaselineMean_DEFAULT float64
+
+func (p *EvaluatorCompareResult_) GetBaselineMean() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaselineMean() {
+		return EvaluatorCompareResult__BaselineMean_DEFAULT
+	}
+	return *p.BaselineMean
+}
+
+var EvaluatorCompareResult__Mean_DEFAULT float64
+
+func (p *EvaluatorCompareResult_) GetMean() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMean() {
+		return EvaluatorCompareResult__Mean_DEFAULT
+	}
+	return *p.Mean
+}
+
+var EvaluatorCompareResult__MeanDiff_DEFAULT float64
+
+func (p *EvaluatorCompareResult_) GetMeanDiff() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMeanDiff() {
+		return EvaluatorCompareResult__MeanDiff_DEFAULT
+	}
+	return *p.MeanDiff
+}
+
+var EvaluatorCompareResult__TTest_DEFAULT *PairedTTestResult_
+
+func (p *EvaluatorCompareResult_) GetTTest() (v *PairedTTestResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTTest() {
+		return EvaluatorCompareResult__TTest_DEFAULT
+	}
+	return p.TTest
+}
+
+var EvaluatorCompareResult__BootstrapConfidenceInterval_DEFAULT *ConfidenceInterval
+
+func (p *EvaluatorCompareResult_) GetBootstrapConfidenceInterval() (v *ConfidenceInterval) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBootstrapConfidenceInterval() {
+		return EvaluatorCompareResult__BootstrapConfidenceInterval_DEFAULT
+	}
+	return p.BootstrapConfidenceInterval
+}
+
+var EvaluatorCompareResult__Significant_DEFAULT bool
+
+func (p *EvaluatorCompareResult_) GetSignificant() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSignificant() {
+		return EvaluatorCompareResult__Significant_DEFAULT
+	}
+	return *p.Significant
+}
+
+var EvaluatorCompareResult__Regressions_DEFAULT []*ItemScoreDiff
+
+func (p *EvaluatorCompareResult_) GetRegressions() (v []*ItemScoreDiff) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRegressions() {
+		return EvaluatorCompareResult__Regressions_DEFAULT
+	}
+	return p.Regressions
+}
+func (p *EvaluatorCompareResult_) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *EvaluatorCompareResult_) SetPairedCount(val *int32) {
+	p.PairedCount = val
+}
+func (p *EvaluatorCompareResult_) SetBaselineMean(val *float64) {
+	p.BaselineMean = val
+}
+func (p *EvaluatorCompareResult_) SetMean(val *float64) {
+	p.Mean = val
+}
+func (p *EvaluatorCompareResult_) SetMeanDiff(val *float64) {
+	p.MeanDiff = val
+}
+func (p *EvaluatorCompareResult_) SetTTest(val *PairedTTestResult_) {
+	p.TTest = val
+}
+func (p *EvaluatorCompareResult_) SetBootstrapConfidenceInterval(val *ConfidenceInterval) {
+	p.BootstrapConfidenceInterval = val
+}
+func (p *EvaluatorCompareResult_) SetSignificant(val *bool) {
+	p.Significant = val
+}
+func (p *EvaluatorCompareResult_) SetRegressions(val []*ItemScoreDiff) {
+	p.Regressions = val
+}
+
+var fieldIDToName_EvaluatorCompareResult_ = map[int16]string{
+	1: "evaluator_version_id",
+	2: "paired_count",
+	3: "baseline_mean",
+	4: "mean",
+	5: "mean_diff",
+	6: "t_test",
+	7: "bootstrap_confidence_interval",
+	8: "significant",
+	9: "regressions",
+}
+
+func (p *EvaluatorCompareResult_) IsSetPairedCount() bool {
+	return p.PairedCount != nil
+}
+
+func (p *EvaluatorCompareResult_) IsSetBaselineMean() bool {
+	return p.BaselineMean != nil
+}
+
+func (p *EvaluatorCompareResult_) IsSetMean() bool {
+	return p.Mean != nil
+}
+
+func (p *EvaluatorCompareResult_) IsSetMeanDiff() bool {
+	return p.MeanDiff != nil
+}
+
+func (p *EvaluatorCompareResult_) IsSetTTest() bool {
+	return p.TTest != nil
+}
+
+func (p *EvaluatorCompareResult_) IsSetBootstrapConfidenceInterval() bool {
+	return p.BootstrapConfidenceInterval != nil
+}
+
+func (p *EvaluatorCompareResult_) IsSetSignificant() bool {
+	return p.Significant != nil
+}
+
+func (p *EvaluatorCompareResult_) IsSetRegressions() bool {
+	return p.Regressions != nil
+}
+
+func (p *EvaluatorCompareResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetEvaluatorVersionID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorCompareResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluatorCompareResult_[fieldId]))
+}
+
+func (p *EvaluatorCompareResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *EvaluatorCompareResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PairedCount = _field
+	return nil
+}
+func (p *EvaluatorCompareResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaselineMean = _field
+	return nil
+}
+func (p *EvaluatorCompareResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Mean = _field
+	return nil
+}
+func (p *EvaluatorCompareResult_) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MeanDiff = _field
+	return nil
+}
+func (p *EvaluatorCompareResult_) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewPairedTTestResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TTest = _field
+	return nil
+}
+func (p *EvaluatorCompareResult_) ReadField7(iprot thrift.TProtocol) error {
+	_field := NewConfidenceInterval()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BootstrapConfidenceInterval = _field
+	return nil
+}
+func (p *EvaluatorCompareResult_) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Significant = _field
+	return nil
+}
+func (p *EvaluatorCompareResult_) ReadField9(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ItemScoreDiff, 0, size)
+	values := make([]ItemScoreDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Regressions = _field
+	return nil
+}
+
+func (p *EvaluatorCompareResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorCompareResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorCompareResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorCompareResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairedCount() {
+		if err = oprot.WriteFieldBegin("paired_count", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PairedCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorCompareResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaselineMean() {
+		if err = oprot.WriteFieldBegin("baseline_mean", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BaselineMean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorCompareResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMean() {
+		if err = oprot.WriteFieldBegin("mean", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Mean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorCompareResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanDiff() {
+		if err = oprot.WriteFieldBegin("mean_diff", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MeanDiff); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorCompareResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTTest() {
+		if err = oprot.WriteFieldBegin("t_test", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TTest.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluatorCompareResult_) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBootstrapConfidenceInterval() {
+		if err = oprot.WriteFieldBegin("bootstrap_confidence_interval", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BootstrapConfidenceInterval.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluatorCompareResult_) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSignificant() {
+		if err = oprot.WriteFieldBegin("significant", thrift.BOOL, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Significant); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *EvaluatorCompareResult_) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegressions() {
+		if err = oprot.WriteFieldBegin("regressions", thrift.LIST, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Regressions)); err != nil {
+			return err
+		}
+		for _, v := range p.Regressions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *EvaluatorCompareResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorCompareResult_(%+v)", *p)
+
+}
+
+func (p *EvaluatorCompareResult_) DeepEqual(ano *EvaluatorCompareResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PairedCount) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.BaselineMean) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Mean) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MeanDiff) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.TTest) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.BootstrapConfidenceInterval) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Significant) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Regressions) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorCompareResult_) Field1DeepEqual(src int64) bool {
+
+	if p.EvaluatorVersionID != src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCompareResult_) Field2DeepEqual(src *int32) bool {
+
+	if p.PairedCount == src {
+		return true
+	} else if p.PairedCount == nil || src == nil {
+		return false
+	}
+	if *p.PairedCount != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCompareResult_) Field3DeepEqual(src *float64) bool {
+
+	if p.BaselineMean == src {
+		return true
+	} else if p.BaselineMean == nil || src == nil {
+		return false
+	}
+	if *p.BaselineMean != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCompareResult_) Field4DeepEqual(src *float64) bool {
+
+	if p.Mean == src {
+		return true
+	} else if p.Mean == nil || src == nil {
+		return false
+	}
+	if *p.Mean != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCompareResult_) Field5DeepEqual(src *float64) bool {
+
+	if p.MeanDiff == src {
+		return true
+	} else if p.MeanDiff == nil || src == nil {
+		return false
+	}
+	if *p.MeanDiff != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCompareResult_) Field6DeepEqual(src *PairedTTestResult_) bool {
+
+	if !p.TTest.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCompareResult_) Field7DeepEqual(src *ConfidenceInterval) bool {
+
+	if !p.BootstrapConfidenceInterval.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCompareResult_) Field8DeepEqual(src *bool) bool {
+
+	if p.Significant == src {
+		return true
+	} else if p.Significant == nil || src == nil {
+		return false
+	}
+	if *p.Significant != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCompareResult_) Field9DeepEqual(src []*ItemScoreDiff) bool {
+
+	if len(p.Regressions) != len(src) {
+		return false
+	}
+	for i, v := range p.Regressions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 定时实验，按 cron 表达式以模板实验为蓝本周期性创建并运行新实验
+type ExptSchedule struct {
+	ID          *int64  `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id"`
+	WorkspaceID *int64  `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id"`
+	Name        *string `thrift:"name,3,optional" frugal:"3,optional,string" json:"name,omitempty"`
+	Desc        *string `thrift:"desc,4,optional" frugal:"4,optional,string" json:"desc,omitempty"`
+	// 模板实验，沿用其评测集、评测对象、评估器与字段映射
+	TemplateExptID *int64 `thrift:"template_expt_id,5,optional" frugal:"5,optional,i64" json:"template_expt_id"`
+	// 5 段 cron 表达式 (分 时 日 月 周)，也支持 @daily 等
+	CronExpr *string `thrift:"cron_expr,6,optional" frugal:"6,optional,string" json:"cron_expr,omitempty"`
+	// IANA 时区，为空时为 UTC
+	Timezone *string `thrift:"timezone,7,optional" frugal:"7,optional,string" json:"timezone,omitempty"`
+	// 使用评测对象的最新提交版本，仅 Prompt 评测对象生效
+	UseLatestTargetVersion *bool `thrift:"use_latest_target_version,8,optional" frugal:"8,optional,bool" json:"use_latest_target_version,omitempty"`
+	// 使用评测集的最新版本
+	UseLatestEvalSetVersion *bool            `thrift:"use_latest_eval_set_version,9,optional" frugal:"9,optional,bool" json:"use_latest_eval_set_version,omitempty"`
+	Enabled                 *bool            `thrift:"enabled,10,optional" frugal:"10,optional,bool" json:"enabled,omitempty"`
+	NextTriggerTime         *int64           `thrift:"next_trigger_time,11,optional" frugal:"11,optional,i64" json:"next_trigger_time"`
+	LastTriggerTime         *int64           `thrift:"last_trigger_time,12,optional" frugal:"12,optional,i64" json:"last_trigger_time"`
+	BaseInfo                *common.BaseInfo `thrift:"base_info,13,optional" frugal:"13,optional,common.BaseInfo" json:"base_info,omitempty"`
+}
+
+func NewExptSchedule() *ExptSchedule {
+	return &ExptSchedule{}
+}
+
+func (p *ExptSchedule) InitDefault() {
+}
+
+var ExptSchedule_ID_DEFAULT int64
+
+func (p *ExptSchedule) GetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return ExptSchedule_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var ExptSchedule_WorkspaceID_DEFAULT int64
+
+func (p *ExptSchedule) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return ExptSchedule_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var ExptSchedule_Name_DEFAULT string
+
+func (p *ExptSchedule) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return ExptSchedule_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var ExptSchedule_Desc_DEFAULT string
+
+func (p *ExptSchedule) GetDesc() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDesc() {
+		return ExptSchedule_Desc_DEFAULT
+	}
+	return *p.Desc
+}
+
+var ExptSchedule_TemplateExptID_DEFAULT int64
+
+func (p *ExptSchedule) GetTemplateExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTemplateExptID() {
+		return ExptSchedule_TemplateExptID_DEFAULT
+	}
+	return *p.TemplateExptID
+}
+
+var ExptSchedule_CronExpr_DEFAULT string
+
+func (p *ExptSchedule) GetCronExpr() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCronExpr() {
+		return ExptSchedule_CronExpr_DEFAULT
+	}
+	return *p.CronExpr
+}
+
+var ExptSchedule_Timezone_DEFAULT string
+
+func (p *ExptSchedule) GetTimezone() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTimezone() {
+		return ExptSchedule_Timezone_DEFAULT
+	}
+	return *p.Timezone
+}
+
+var ExptSchedule_UseLatestTargetVersion_DEFAULT bool
+
+func (p *ExptSchedule) GetUseLatestTargetVersion() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUseLatestTargetVersion() {
+		return ExptSchedule_UseLatestTargetVersion_DEFAULT
+	}
+	return *p.UseLatestTargetVersion
+}
+
+var ExptSchedule_UseLatestEvalSetVersion_DEFAULT bool
+
+func (p *ExptSchedule) GetUseLatestEvalSetVersion() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUseLatestEvalSetVersion() {
+		return ExptSchedule_UseLatestEvalSetVersion_DEFAULT
+	}
+	return *p.UseLatestEvalSetVersion
+}
+
+var ExptSchedule_Enabled_DEFAULT bool
+
+func (p *ExptSchedule) GetEnabled() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEnabled() {
+		return ExptSchedule_Enabled_DEFAULT
+	}
+	return *p.Enabled
+}
+
+var ExptSchedule_NextTriggerTime_DEFAULT int64
+
+func (p *ExptSchedule) GetNextTriggerTime() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetNextTriggerTime() {
+		return ExptSchedule_NextTriggerTime_DEFAULT
+	}
+	return *p.NextTriggerTime
+}
+
+var ExptSchedule_LastTriggerTime_DEFAULT int64
+
+func (p *ExptSchedule) GetLastTriggerTime() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLastTriggerTime() {
+		return ExptSchedule_LastTriggerTime_DEFAULT
+	}
+	return *p.LastTriggerTime
+}
+
+var ExptSchedule_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *ExptSchedule) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return ExptSchedule_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+func (p *ExptSchedule) SetID(val *int64) {
+	p.ID = val
+}
+func (p *ExptSchedule) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *ExptSchedule) SetName(val *string) {
+	p.Name = val
+}
+func (p *ExptSchedule) SetDesc(val *string) {
+	p.Desc = val
+}
+func (p *ExptSchedule) SetTemplateExptID(val *int64) {
+	p.TemplateExptID = val
+}
+func (p *ExptSchedule) SetCronExpr(val *string) {
+	p.CronExpr = val
+}
+func (p *ExptSchedule) SetTimezone(val *string) {
+	p.Timezone = val
+}
+func (p *ExptSchedule) SetUseLatestTargetVersion(val *bool) {
+	p.UseLatestTargetVersion = val
+}
+func (p *ExptSchedule) SetUseLatestEvalSetVersion(val *bool) {
+	p.UseLatestEvalSetVersion = val
+}
+func (p *ExptSchedule) SetEnabled(val *bool) {
+	p.Enabled = val
+}
+func (p *ExptSchedule) SetNextTriggerTime(val *int64) {
+	p.NextTriggerTime = val
+}
+func (p *ExptSchedule) SetLastTriggerTime(val *int64) {
+	p.LastTriggerTime = val
+}
+func (p *ExptSchedule) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+
+var fieldIDToName_ExptSchedule = map[int16]string{
+	1:  "id",
+	2:  "workspace_id",
+	3:  "name",
+	4:  "desc",
+	5:  "template_expt_id",
+	6:  "cron_expr",
+	7:  "timezone",
+	8:  "use_latest_target_version",
+	9:  "use_latest_eval_set_version",
+	10: "enabled",
+	11: "next_trigger_time",
+	12: "last_trigger_time",
+	13: "base_info",
+}
+
+func (p *ExptSchedule) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *ExptSchedule) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *ExptSchedule) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *ExptSchedule) IsSetDesc() bool {
+	return p.Desc != nil
+}
+
+func (p *ExptSchedule) IsSetTemplateExptID() bool {
+	return p.TemplateExptID != nil
+}
+
+func (p *ExptSchedule) IsSetCronExpr() bool {
+	return p.CronExpr != nil
+}
+
+func (p *ExptSchedule) IsSetTimezone() bool {
+	return p.Timezone != nil
+}
+
+func (p *ExptSchedule) IsSetUseLatestTargetVersion() bool {
+	return p.UseLatestTargetVersion != nil
+}
+
+func (p *ExptSchedule) IsSetUseLatestEvalSetVersion() bool {
+	return p.UseLatestEvalSetVersion != nil
+}
+
+func (p *ExptSchedule) IsSetEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ExptSchedule) IsSetNextTriggerTime() bool {
+	return p.NextTriggerTime != nil
+}
+
+func (p *ExptSchedule) IsSetLastTriggerTime() bool {
+	return p.LastTriggerTime != nil
+}
+
+func (p *ExptSchedule) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *ExptSchedule) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptSchedule[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptSchedule) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Desc = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TemplateExptID = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CronExpr = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Timezone = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UseLatestTargetVersion = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UseLatestEvalSetVersion = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Enabled = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextTriggerTime = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastTriggerTime = _field
+	return nil
+}
+func (p *ExptSchedule) ReadField13(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+
+func (p *ExptSchedule) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptSchedule"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptSchedule) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDesc() {
+		if err = oprot.WriteFieldBegin("desc", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Desc); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTemplateExptID() {
+		if err = oprot.WriteFieldBegin("template_expt_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TemplateExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCronExpr() {
+		if err = oprot.WriteFieldBegin("cron_expr", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CronExpr); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimezone() {
+		if err = oprot.WriteFieldBegin("timezone", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Timezone); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUseLatestTargetVersion() {
+		if err = oprot.WriteFieldBegin("use_latest_target_version", thrift.BOOL, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.UseLatestTargetVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUseLatestEvalSetVersion() {
+		if err = oprot.WriteFieldBegin("use_latest_eval_set_version", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.UseLatestEvalSetVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnabled() {
+		if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Enabled); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextTriggerTime() {
+		if err = oprot.WriteFieldBegin("next_trigger_time", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.NextTriggerTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastTriggerTime() {
+		if err = oprot.WriteFieldBegin("last_trigger_time", thrift.I64, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LastTriggerTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *ExptSchedule) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *ExptSchedule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptSchedule(%+v)", *p)
+
+}
+
+func (p *ExptSchedule) DeepEqual(ano *ExptSchedule) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Desc) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.TemplateExptID) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.CronExpr) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Timezone) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.UseLatestTargetVersion) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.UseLatestEvalSetVersion) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Enabled) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.NextTriggerTime) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.LastTriggerTime) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptSchedule) Field1DeepEqual(src *int64) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if *p.ID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptSchedule) Field2DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptSchedule) Field3DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptSchedule) Field4DeepEqual(src *string) bool {
+
+	if p.Desc == src {
+		return true
+	} else if p.Desc == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Desc, *src) != 0 {
 		return false
 	}
-	if !p.Field14DeepEqual(ano.ContentType) {
+	return true
+}
+func (p *ExptSchedule) Field5DeepEqual(src *int64) bool {
+
+	if p.TemplateExptID == src {
+		return true
+	} else if p.TemplateExptID == nil || src == nil {
 		return false
 	}
-	if !p.Field15DeepEqual(ano.ContentSpec) {
+	if *p.TemplateExptID != *src {
 		return false
 	}
 	return true
 }
+func (p *ExptSchedule) Field6DeepEqual(src *string) bool {
 
-func (p *ColumnAnnotation) Field1DeepEqual(src *int64) bool {
-
-	if p.TagKeyID == src {
+	if p.CronExpr == src {
 		return true
-	} else if p.TagKeyID == nil || src == nil {
+	} else if p.CronExpr == nil || src == nil {
 		return false
 	}
-	if *p.TagKeyID != *src {
+	if strings.Compare(*p.CronExpr, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ColumnAnnotation) Field2DeepEqual(src *string) bool {
+func (p *ExptSchedule) Field7DeepEqual(src *string) bool {
 
-	if p.TagKeyName == src {
+	if p.Timezone == src {
 		return true
-	} else if p.TagKeyName == nil || src == nil {
+	} else if p.Timezone == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.TagKeyName, *src) != 0 {
+	if strings.Compare(*p.Timezone, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ColumnAnnotation) Field3DeepEqual(src *string) bool {
+func (p *ExptSchedule) Field8DeepEqual(src *bool) bool {
 
-	if p.Description == src {
+	if p.UseLatestTargetVersion == src {
 		return true
-	} else if p.Description == nil || src == nil {
+	} else if p.UseLatestTargetVersion == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Description, *src) != 0 {
+	if *p.UseLatestTargetVersion != *src {
 		return false
 	}
 	return true
 }
-func (p *ColumnAnnotation) Field4DeepEqual(src *tag.TagStatus) bool {
+func (p *ExptSchedule) Field9DeepEqual(src *bool) bool {
 
-	if p.Status == src {
+	if p.UseLatestEvalSetVersion == src {
 		return true
-	} else if p.Status == nil || src == nil {
+	} else if p.UseLatestEvalSetVersion == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Status, *src) != 0 {
+	if *p.UseLatestEvalSetVersion != *src {
 		return false
 	}
 	return true
 }
-func (p *ColumnAnnotation) Field13DeepEqual(src []*tag.TagValue) bool {
+func (p *ExptSchedule) Field10DeepEqual(src *bool) bool {
 
-	if len(p.TagValues) != len(src) {
+	if p.Enabled == src {
+		return true
+	} else if p.Enabled == nil || src == nil {
 		return false
 	}
-	for i, v := range p.TagValues {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.Enabled != *src {
+		return false
 	}
 	return true
 }
-func (p *ColumnAnnotation) Field14DeepEqual(src *tag.TagContentType) bool {
+func (p *ExptSchedule) Field11DeepEqual(src *int64) bool {
 
-	if p.ContentType == src {
+	if p.NextTriggerTime == src {
 		return true
-	} else if p.ContentType == nil || src == nil {
+	} else if p.NextTriggerTime == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.ContentType, *src) != 0 {
+	if *p.NextTriggerTime != *src {
 		return false
 	}
 	return true
 }
-func (p *ColumnAnnotation) Field15DeepEqual(src *tag.TagContentSpec) bool {
+func (p *ExptSchedule) Field12DeepEqual(src *int64) bool {
 
-	if !p.ContentSpec.DeepEqual(src) {
+	if p.LastTriggerTime == src {
+		return true
+	} else if p.LastTriggerTime == nil || src == nil {
+		return false
+	}
+	if *p.LastTriggerTime != *src {
 		return false
 	}
 	return true
 }
+func (p *ExptSchedule) Field13DeepEqual(src *common.BaseInfo) bool {
 
-type ExptResultExportRecord struct {
-	ExportID        int64            `thrift:"export_id,1,required" frugal:"1,required,i64" json:"export_id" form:"export_id,required" query:"export_id,required"`
-	WorkspaceID     int64            `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
-	ExptID          int64            `thrift:"expt_id,3,required" frugal:"3,required,i64" json:"expt_id" form:"expt_id,required" query:"expt_id,required"`
-	CsvExportStatus CSVExportStatus  `thrift:"csv_export_status,4,required" frugal:"4,required,string" form:"csv_export_status,required" json:"csv_export_status,required" query:"csv_export_status,required"`
-	BaseInfo        *common.BaseInfo `thrift:"base_info,5,optional" frugal:"5,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
-	StartTime       *int64           `thrift:"start_time,6,optional" frugal:"6,optional,i64" json:"start_time" form:"start_time" query:"start_time"`
-	EndTime         *int64           `thrift:"end_time,7,optional" frugal:"7,optional,i64" json:"end_time" form:"end_time" query:"end_time"`
-	URL             *string          `thrift:"URL,8,optional" frugal:"8,optional,string" form:"URL" json:"URL,omitempty" query:"URL"`
-	Expired         *bool            `thrift:"expired,9,optional" frugal:"9,optional,bool" form:"expired" json:"expired,omitempty" query:"expired"`
-	Error           *RunError        `thrift:"error,10,optional" frugal:"10,optional,RunError" form:"error" json:"error,omitempty" query:"error"`
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewExptResultExportRecord() *ExptResultExportRecord {
-	return &ExptResultExportRecord{}
+// 定时实验的一次触发记录，可结合 BatchGetExperimentAggrResult 绘制趋势
+type ExptScheduleRun struct {
+	ID           *int64                 `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id"`
+	ScheduleID   *int64                 `thrift:"schedule_id,2,optional" frugal:"2,optional,i64" json:"schedule_id"`
+	ExptID       *int64                 `thrift:"expt_id,3,optional" frugal:"3,optional,i64" json:"expt_id"`
+	RunID        *int64                 `thrift:"run_id,4,optional" frugal:"4,optional,i64" json:"run_id"`
+	Status       *ExptScheduleRunStatus `thrift:"status,5,optional" frugal:"5,optional,string" json:"status,omitempty"`
+	ErrorMessage *string                `thrift:"error_message,6,optional" frugal:"6,optional,string" json:"error_message,omitempty"`
+	TriggerTime  *int64                 `thrift:"trigger_time,7,optional" frugal:"7,optional,i64" json:"trigger_time"`
 }
 
-func (p *ExptResultExportRecord) InitDefault() {
+func NewExptScheduleRun() *ExptScheduleRun {
+	return &ExptScheduleRun{}
 }
 
-func (p *ExptResultExportRecord) GetExportID() (v int64) {
-	if p != nil {
-		return p.ExportID
-	}
-	return
+func (p *ExptScheduleRun) InitDefault() {
 }
 
-func (p *ExptResultExportRecord) GetWorkspaceID() (v int64) {
-	if p != nil {
-		return p.WorkspaceID
-	}
-	return
-}
+var ExptScheduleRun_ID_DEFAULT int64
 
-func (p *ExptResultExportRecord) GetExptID() (v int64) {
-	if p != nil {
-		return p.ExptID
+func (p *ExptScheduleRun) GetID() (v int64) {
+	if p == nil {
+		return
 	}
-	return
-}
-
-func (p *ExptResultExportRecord) GetCsvExportStatus() (v CSVExportStatus) {
-	if p != nil {
-		return p.CsvExportStatus
+	if !p.IsSetID() {
+		return ExptScheduleRun_ID_DEFAULT
 	}
-	return
+	return *p.ID
 }
 
-var ExptResultExportRecord_BaseInfo_DEFAULT *common.BaseInfo
+var ExptScheduleRun_ScheduleID_DEFAULT int64
 
-func (p *ExptResultExportRecord) GetBaseInfo() (v *common.BaseInfo) {
+func (p *ExptScheduleRun) GetScheduleID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBaseInfo() {
-		return ExptResultExportRecord_BaseInfo_DEFAULT
+	if !p.IsSetScheduleID() {
+		return ExptScheduleRun_ScheduleID_DEFAULT
 	}
-	return p.BaseInfo
+	return *p.ScheduleID
 }
 
-var ExptResultExportRecord_StartTime_DEFAULT int64
+var ExptScheduleRun_ExptID_DEFAULT int64
 
-func (p *ExptResultExportRecord) GetStartTime() (v int64) {
+func (p *ExptScheduleRun) GetExptID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetStartTime() {
-		return ExptResultExportRecord_StartTime_DEFAULT
+	if !p.IsSetExptID() {
+		return ExptScheduleRun_ExptID_DEFAULT
 	}
-	return *p.StartTime
+	return *p.ExptID
 }
 
-var ExptResultExportRecord_EndTime_DEFAULT int64
+var ExptScheduleRun_RunID_DEFAULT int64
 
-func (p *ExptResultExportRecord) GetEndTime() (v int64) {
+func (p *ExptScheduleRun) GetRunID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEndTime() {
-		return ExptResultExportRecord_EndTime_DEFAULT
+	if !p.IsSetRunID() {
+		return ExptScheduleRun_RunID_DEFAULT
 	}
-	return *p.EndTime
+	return *p.RunID
 }
 
-var ExptResultExportRecord_URL_DEFAULT string
+var ExptScheduleRun_Status_DEFAULT ExptScheduleRunStatus
 
-func (p *ExptResultExportRecord) GetURL() (v string) {
+func (p *ExptScheduleRun) GetStatus() (v ExptScheduleRunStatus) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetURL() {
-		return ExptResultExportRecord_URL_DEFAULT
+	if !p.IsSetStatus() {
+		return ExptScheduleRun_Status_DEFAULT
 	}
-	return *p.URL
+	return *p.Status
 }
 
-var ExptResultExportRecord_Expired_DEFAULT bool
+var ExptScheduleRun_ErrorMessage_DEFAULT string
 
-func (p *ExptResultExportRecord) GetExpired() (v bool) {
+func (p *ExptScheduleRun) GetErrorMessage() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExpired() {
-		return ExptResultExportRecord_Expired_DEFAULT
+	if !p.IsSetErrorMessage() {
+		return ExptScheduleRun_ErrorMessage_DEFAULT
 	}
-	return *p.Expired
+	return *p.ErrorMessage
 }
 
-var ExptResultExportRecord_Error_DEFAULT *RunError
+var ExptScheduleRun_TriggerTime_DEFAULT int64
 
-func (p *ExptResultExportRecord) GetError() (v *RunError) {
+func (p *ExptScheduleRun) GetTriggerTime() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetError() {
-		return ExptResultExportRecord_Error_DEFAULT
+	if !p.IsSetTriggerTime() {
+		return ExptScheduleRun_TriggerTime_DEFAULT
 	}
-	return p.Error
+	return *p.TriggerTime
 }
-func (p *ExptResultExportRecord) SetExportID(val int64) {
-	p.ExportID = val
+func (p *ExptScheduleRun) SetID(val *int64) {
+	p.ID = val
 }
-func (p *ExptResultExportRecord) SetWorkspaceID(val int64) {
-	p.WorkspaceID = val
+func (p *ExptScheduleRun) SetScheduleID(val *int64) {
+	p.ScheduleID = val
 }
-func (p *ExptResultExportRecord) SetExptID(val int64) {
+func (p *ExptScheduleRun) SetExptID(val *int64) {
 	p.ExptID = val
 }
-func (p *ExptResultExportRecord) SetCsvExportStatus(val CSVExportStatus) {
-	p.CsvExportStatus = val
-}
-func (p *ExptResultExportRecord) SetBaseInfo(val *common.BaseInfo) {
-	p.BaseInfo = val
-}
-func (p *ExptResultExportRecord) SetStartTime(val *int64) {
-	p.StartTime = val
+func (p *ExptScheduleRun) SetRunID(val *int64) {
+	p.RunID = val
 }
-func (p *ExptResultExportRecord) SetEndTime(val *int64) {
-	p.EndTime = val
+func (p *ExptScheduleRun) SetStatus(val *ExptScheduleRunStatus) {
+	p.Status = val
 }
-func (p *ExptResultExportRecord) SetURL(val *string) {
-	p.URL = val
+func (p *ExptScheduleRun) SetErrorMessage(val *string) {
+	p.ErrorMessage = val
 }
-func (p *ExptResultExportRecord) SetExpired(val *bool) {
-	p.Expired = val
+func (p *ExptScheduleRun) SetTriggerTime(val *int64) {
+	p.TriggerTime = val
 }
-func (p *ExptResultExportRecord) SetError(val *RunError) {
-	p.Error = val
+
+var fieldIDToName_ExptScheduleRun = map[int16]string{
+	1: "id",
+	2: "schedule_id",
+	3: "expt_id",
+	4: "run_id",
+	5: "status",
+	6: "error_message",
+	7: "trigger_time",
 }
 
-var fieldIDToName_ExptResultExportRecord = map[int16]string{
-	1:  "export_id",
-	2:  "workspace_id",
-	3:  "expt_id",
-	4:  "csv_export_status",
-	5:  "base_info",
-	6:  "start_time",
-	7:  "end_time",
-	8:  "URL",
-	9:  "expired",
-	10: "error",
+func (p *ExptScheduleRun) IsSetID() bool {
+	return p.ID != nil
 }
 
-func (p *ExptResultExportRecord) IsSetBaseInfo() bool {
-	return p.BaseInfo != nil
+func (p *ExptScheduleRun) IsSetScheduleID() bool {
+	return p.ScheduleID != nil
 }
 
-func (p *ExptResultExportRecord) IsSetStartTime() bool {
-	return p.StartTime != nil
+func (p *ExptScheduleRun) IsSetExptID() bool {
+	return p.ExptID != nil
 }
 
-func (p *ExptResultExportRecord) IsSetEndTime() bool {
-	return p.EndTime != nil
+func (p *ExptScheduleRun) IsSetRunID() bool {
+	return p.RunID != nil
 }
 
-func (p *ExptResultExportRecord) IsSetURL() bool {
-	return p.URL != nil
+func (p *ExptScheduleRun) IsSetStatus() bool {
+	return p.Status != nil
 }
 
-func (p *ExptResultExportRecord) IsSetExpired() bool {
-	return p.Expired != nil
+func (p *ExptScheduleRun) IsSetErrorMessage() bool {
+	return p.ErrorMessage != nil
 }
 
-func (p *ExptResultExportRecord) IsSetError() bool {
-	return p.Error != nil
+func (p *ExptScheduleRun) IsSetTriggerTime() bool {
+	return p.TriggerTime != nil
 }
 
-func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptScheduleRun) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExportID bool = false
-	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetCsvExportStatus bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -16730,7 +21790,6 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExportID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -16739,7 +21798,6 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -16748,21 +21806,19 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCsvExportStatus = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -16770,7 +21826,7 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -16785,30 +21841,6 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -16822,96 +21854,45 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetExportID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetWorkspaceID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExptID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCsvExportStatus {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptResultExportRecord[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptScheduleRun[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptResultExportRecord[fieldId]))
-}
-
-func (p *ExptResultExportRecord) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ExportID = _field
-	return nil
-}
-func (p *ExptResultExportRecord) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.WorkspaceID = _field
-	return nil
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *ExptResultExportRecord) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
+func (p *ExptScheduleRun) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ExptID = _field
+	p.ID = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField4(iprot thrift.TProtocol) error {
+func (p *ExptScheduleRun) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field CSVExportStatus
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
-	}
-	p.CsvExportStatus = _field
-	return nil
-}
-func (p *ExptResultExportRecord) ReadField5(iprot thrift.TProtocol) error {
-	_field := common.NewBaseInfo()
-	if err := _field.Read(iprot); err != nil {
-		return err
+		_field = &v
 	}
-	p.BaseInfo = _field
+	p.ScheduleID = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField6(iprot thrift.TProtocol) error {
+func (p *ExptScheduleRun) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -16919,10 +21900,10 @@ func (p *ExptResultExportRecord) ReadField6(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.StartTime = _field
+	p.ExptID = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField7(iprot thrift.TProtocol) error {
+func (p *ExptScheduleRun) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -16930,43 +21911,46 @@ func (p *ExptResultExportRecord) ReadField7(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.EndTime = _field
+	p.RunID = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField8(iprot thrift.TProtocol) error {
+func (p *ExptScheduleRun) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *ExptScheduleRunStatus
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.URL = _field
+	p.Status = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField9(iprot thrift.TProtocol) error {
+func (p *ExptScheduleRun) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Expired = _field
+	p.ErrorMessage = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField10(iprot thrift.TProtocol) error {
-	_field := NewRunError()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExptScheduleRun) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Error = _field
+	p.TriggerTime = _field
 	return nil
 }
 
-func (p *ExptResultExportRecord) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptScheduleRun) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptResultExportRecord"); err != nil {
+	if err = oprot.WriteStructBegin("ExptScheduleRun"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16998,18 +21982,6 @@ func (p *ExptResultExportRecord) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -17028,15 +22000,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptResultExportRecord) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("export_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExportID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptScheduleRun) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -17044,60 +22018,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExptID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("csv_export_status", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.CsvExportStatus); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseInfo() {
-		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 5); err != nil {
+func (p *ExptScheduleRun) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScheduleID() {
+		if err = oprot.WriteFieldBegin("schedule_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseInfo.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.ScheduleID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -17106,16 +22032,16 @@ func (p *ExptResultExportRecord) writeField5(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStartTime() {
-		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 6); err != nil {
+func (p *ExptScheduleRun) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptID() {
+		if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.StartTime); err != nil {
+		if err := oprot.WriteI64(*p.ExptID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -17124,16 +22050,16 @@ func (p *ExptResultExportRecord) writeField6(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEndTime() {
-		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 7); err != nil {
+func (p *ExptScheduleRun) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRunID() {
+		if err = oprot.WriteFieldBegin("run_id", thrift.I64, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.EndTime); err != nil {
+		if err := oprot.WriteI64(*p.RunID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -17142,16 +22068,16 @@ func (p *ExptResultExportRecord) writeField7(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetURL() {
-		if err = oprot.WriteFieldBegin("URL", thrift.STRING, 8); err != nil {
+func (p *ExptScheduleRun) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.URL); err != nil {
+		if err := oprot.WriteString(*p.Status); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -17160,16 +22086,16 @@ func (p *ExptResultExportRecord) writeField8(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExpired() {
-		if err = oprot.WriteFieldBegin("expired", thrift.BOOL, 9); err != nil {
+func (p *ExptScheduleRun) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorMessage() {
+		if err = oprot.WriteFieldBegin("error_message", thrift.STRING, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.Expired); err != nil {
+		if err := oprot.WriteString(*p.ErrorMessage); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -17178,16 +22104,16 @@ func (p *ExptResultExportRecord) writeField9(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetError() {
-		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 10); err != nil {
+func (p *ExptScheduleRun) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTriggerTime() {
+		if err = oprot.WriteFieldBegin("trigger_time", thrift.I64, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Error.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.TriggerTime); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -17196,144 +22122,129 @@ func (p *ExptResultExportRecord) writeField10(oprot thrift.TProtocol) (err error
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *ExptResultExportRecord) String() string {
+func (p *ExptScheduleRun) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptResultExportRecord(%+v)", *p)
+	return fmt.Sprintf("ExptScheduleRun(%+v)", *p)
 
 }
 
-func (p *ExptResultExportRecord) DeepEqual(ano *ExptResultExportRecord) bool {
+func (p *ExptScheduleRun) DeepEqual(ano *ExptScheduleRun) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExportID) {
+	if !p.Field1DeepEqual(ano.ID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.WorkspaceID) {
+	if !p.Field2DeepEqual(ano.ScheduleID) {
 		return false
 	}
 	if !p.Field3DeepEqual(ano.ExptID) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.CsvExportStatus) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.BaseInfo) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.StartTime) {
+	if !p.Field4DeepEqual(ano.RunID) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.EndTime) {
-		return false
-	}
-	if !p.Field8DeepEqual(ano.URL) {
+	if !p.Field5DeepEqual(ano.Status) {
 		return false
 	}
-	if !p.Field9DeepEqual(ano.Expired) {
+	if !p.Field6DeepEqual(ano.ErrorMessage) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.Error) {
+	if !p.Field7DeepEqual(ano.TriggerTime) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptResultExportRecord) Field1DeepEqual(src int64) bool {
-
-	if p.ExportID != src {
-		return false
-	}
-	return true
-}
-func (p *ExptResultExportRecord) Field2DeepEqual(src int64) bool {
+func (p *ExptScheduleRun) Field1DeepEqual(src *int64) bool {
 
-	if p.WorkspaceID != src {
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ExptResultExportRecord) Field3DeepEqual(src int64) bool {
-
-	if p.ExptID != src {
+	if *p.ID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field4DeepEqual(src CSVExportStatus) bool {
+func (p *ExptScheduleRun) Field2DeepEqual(src *int64) bool {
 
-	if strings.Compare(p.CsvExportStatus, src) != 0 {
+	if p.ScheduleID == src {
+		return true
+	} else if p.ScheduleID == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ExptResultExportRecord) Field5DeepEqual(src *common.BaseInfo) bool {
-
-	if !p.BaseInfo.DeepEqual(src) {
+	if *p.ScheduleID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field6DeepEqual(src *int64) bool {
+func (p *ExptScheduleRun) Field3DeepEqual(src *int64) bool {
 
-	if p.StartTime == src {
+	if p.ExptID == src {
 		return true
-	} else if p.StartTime == nil || src == nil {
+	} else if p.ExptID == nil || src == nil {
 		return false
 	}
-	if *p.StartTime != *src {
+	if *p.ExptID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field7DeepEqual(src *int64) bool {
+func (p *ExptScheduleRun) Field4DeepEqual(src *int64) bool {
 
-	if p.EndTime == src {
+	if p.RunID == src {
 		return true
-	} else if p.EndTime == nil || src == nil {
+	} else if p.RunID == nil || src == nil {
 		return false
 	}
-	if *p.EndTime != *src {
+	if *p.RunID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field8DeepEqual(src *string) bool {
+func (p *ExptScheduleRun) Field5DeepEqual(src *ExptScheduleRunStatus) bool {
 
-	if p.URL == src {
+	if p.Status == src {
 		return true
-	} else if p.URL == nil || src == nil {
+	} else if p.Status == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.URL, *src) != 0 {
+	if strings.Compare(*p.Status, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field9DeepEqual(src *bool) bool {
+func (p *ExptScheduleRun) Field6DeepEqual(src *string) bool {
 
-	if p.Expired == src {
+	if p.ErrorMessage == src {
 		return true
-	} else if p.Expired == nil || src == nil {
+	} else if p.ErrorMessage == nil || src == nil {
 		return false
 	}
-	if *p.Expired != *src {
+	if strings.Compare(*p.ErrorMessage, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field10DeepEqual(src *RunError) bool {
+func (p *ExptScheduleRun) Field7DeepEqual(src *int64) bool {
 
-	if !p.Error.DeepEqual(src) {
+	if p.TriggerTime == src {
+		return true
+	} else if p.TriggerTime == nil || src == nil {
+		return false
+	}
+	if *p.TriggerTime != *src {
 		return false
 	}
 	return true
@@ -52,6 +52,14 @@ func (p *Experiment) IsValid() error {
 			return fmt.Errorf("field TargetRuntimeParam not valid, %w", err)
 		}
 	}
+	if p.Budget != nil {
+		if err := p.Budget.IsValid(); err != nil {
+			return fmt.Errorf("field Budget not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptBudget) IsValid() error {
 	return nil
 }
 func (p *TokenUsage) IsValid() error {
@@ -224,11 +232,19 @@ func (p *SourceTarget) IsValid() error {
 	return nil
 }
 func (p *ExptAggregateResult_) IsValid() error {
+	if p.TargetResult_ != nil {
+		if err := p.TargetResult_.IsValid(); err != nil {
+			return fmt.Errorf("field TargetResult_ not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *EvaluatorAggregateResult_) IsValid() error {
 	return nil
 }
+func (p *TargetAggregateResult_) IsValid() error {
+	return nil
+}
 func (p *AnnotationAggregateResult_) IsValid() error {
 	return nil
 }
@@ -297,3 +313,41 @@ func (p *ExptResultExportRecord) IsValid() error {
 	}
 	return nil
 }
+func (p *ConfidenceInterval) IsValid() error {
+	return nil
+}
+func (p *PairedTTestResult_) IsValid() error {
+	if p.ConfidenceInterval != nil {
+		if err := p.ConfidenceInterval.IsValid(); err != nil {
+			return fmt.Errorf("field ConfidenceInterval not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ItemScoreDiff) IsValid() error {
+	return nil
+}
+func (p *EvaluatorCompareResult_) IsValid() error {
+	if p.TTest != nil {
+		if err := p.TTest.IsValid(); err != nil {
+			return fmt.Errorf("field TTest not valid, %w", err)
+		}
+	}
+	if p.BootstrapConfidenceInterval != nil {
+		if err := p.BootstrapConfidenceInterval.IsValid(); err != nil {
+			return fmt.Errorf("field BootstrapConfidenceInterval not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptSchedule) IsValid() error {
+	if p.BaseInfo != nil {
+		if err := p.BaseInfo.IsValid(); err != nil {
+			return fmt.Errorf("field BaseInfo not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptScheduleRun) IsValid() error {
+	return nil
+}
@@ -329,6 +329,10 @@ type CreateEvalTargetParam struct {
 	BotInfoType         *eval_target.CozeBotInfoType `thrift:"bot_info_type,4,optional" frugal:"4,optional,CozeBotInfoType" form:"bot_info_type" json:"bot_info_type,omitempty" query:"bot_info_type"`
 	// 如果是发布版本则需要填充这个字段
 	BotPublishVersion *string `thrift:"bot_publish_version,5,optional" frugal:"5,optional,string" form:"bot_publish_version" json:"bot_publish_version,omitempty" query:"bot_publish_version"`
+	// eval_target_type 为 HTTP 时需要填充这个字段
+	HTTPTarget *eval_target.HTTPTarget `thrift:"http_target,6,optional" frugal:"6,optional,eval_target.HTTPTarget" form:"http_target" json:"http_target,omitempty" query:"http_target"`
+	// eval_target_type 为 CozeLoopPrompt 时可选，填充后按发布标签执行，忽略 source_target_version
+	PromptLabel *string `thrift:"prompt_label,7,optional" frugal:"7,optional,string" form:"prompt_label" json:"prompt_label,omitempty" query:"prompt_label"`
 }
 
 func NewCreateEvalTargetParam() *CreateEvalTargetParam {
@@ -397,6 +401,30 @@ func (p *CreateEvalTargetParam) GetBotPublishVersion() (v string) {
 	}
 	return *p.BotPublishVersion
 }
+
+var CreateEvalTargetParam_HTTPTarget_DEFAULT *eval_target.HTTPTarget
+
+func (p *CreateEvalTargetParam) GetHTTPTarget() (v *eval_target.HTTPTarget) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHTTPTarget() {
+		return CreateEvalTargetParam_HTTPTarget_DEFAULT
+	}
+	return p.HTTPTarget
+}
+
+var CreateEvalTargetParam_PromptLabel_DEFAULT string
+
+func (p *CreateEvalTargetParam) GetPromptLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptLabel() {
+		return CreateEvalTargetParam_PromptLabel_DEFAULT
+	}
+	return *p.PromptLabel
+}
 func (p *CreateEvalTargetParam) SetSourceTargetID(val *string) {
 	p.SourceTargetID = val
 }
@@ -412,6 +440,12 @@ func (p *CreateEvalTargetParam) SetBotInfoType(val *eval_target.CozeBotInfoType)
 func (p *CreateEvalTargetParam) SetBotPublishVersion(val *string) {
 	p.BotPublishVersion = val
 }
+func (p *CreateEvalTargetParam) SetHTTPTarget(val *eval_target.HTTPTarget) {
+	p.HTTPTarget = val
+}
+func (p *CreateEvalTargetParam) SetPromptLabel(val *string) {
+	p.PromptLabel = val
+}
 
 var fieldIDToName_CreateEvalTargetParam = map[int16]string{
 	1: "source_target_id",
@@ -419,6 +453,8 @@ var fieldIDToName_CreateEvalTargetParam = map[int16]string{
 	3: "eval_target_type",
 	4: "bot_info_type",
 	5: "bot_publish_version",
+	6: "http_target",
+	7: "prompt_label",
 }
 
 func (p *CreateEvalTargetParam) IsSetSourceTargetID() bool {
@@ -441,6 +477,14 @@ func (p *CreateEvalTargetParam) IsSetBotPublishVersion() bool {
 	return p.BotPublishVersion != nil
 }
 
+func (p *CreateEvalTargetParam) IsSetHTTPTarget() bool {
+	return p.HTTPTarget != nil
+}
+
+func (p *CreateEvalTargetParam) IsSetPromptLabel() bool {
+	return p.PromptLabel != nil
+}
+
 func (p *CreateEvalTargetParam) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -499,6 +543,22 @@ func (p *CreateEvalTargetParam) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -585,6 +645,25 @@ func (p *CreateEvalTargetParam) ReadField5(iprot thrift.TProtocol) error {
 	p.BotPublishVersion = _field
 	return nil
 }
+func (p *CreateEvalTargetParam) ReadField6(iprot thrift.TProtocol) error {
+	_field := eval_target.NewHTTPTarget()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.HTTPTarget = _field
+	return nil
+}
+func (p *CreateEvalTargetParam) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PromptLabel = _field
+	return nil
+}
 
 func (p *CreateEvalTargetParam) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -612,6 +691,14 @@ func (p *CreateEvalTargetParam) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -720,6 +807,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
+func (p *CreateEvalTargetParam) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHTTPTarget() {
+		if err = oprot.WriteFieldBegin("http_target", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.HTTPTarget.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *CreateEvalTargetParam) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptLabel() {
+		if err = oprot.WriteFieldBegin("prompt_label", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PromptLabel); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
 func (p *CreateEvalTargetParam) String() string {
 	if p == nil {
@@ -750,6 +873,12 @@ func (p *CreateEvalTargetParam) DeepEqual(ano *CreateEvalTargetParam) bool {
 	if !p.Field5DeepEqual(ano.BotPublishVersion) {
 		return false
 	}
+	if !p.Field6DeepEqual(ano.HTTPTarget) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.PromptLabel) {
+		return false
+	}
 	return true
 }
 
@@ -813,6 +942,25 @@ func (p *CreateEvalTargetParam) Field5DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *CreateEvalTargetParam) Field6DeepEqual(src *eval_target.HTTPTarget) bool {
+
+	if !p.HTTPTarget.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateEvalTargetParam) Field7DeepEqual(src *string) bool {
+
+	if p.PromptLabel == src {
+		return true
+	} else if p.PromptLabel == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PromptLabel, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type CreateEvalTargetResponse struct {
 	ID        *int64         `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
@@ -8383,18 +8531,24 @@ func (p *ListSourceEvalTargetVersionsResponse) Field255DeepEqual(src *base.BaseR
 type EvalTargetService interface {
 	// 创建评测对象
 	CreateEvalTarget(ctx context.Context, request *CreateEvalTargetRequest) (r *CreateEvalTargetResponse, err error)
+
 	// 根据source target获取评测对象信息
 	BatchGetEvalTargetsBySource(ctx context.Context, request *BatchGetEvalTargetsBySourceRequest) (r *BatchGetEvalTargetsBySourceResponse, err error)
+
 	// 获取评测对象+版本
 	GetEvalTargetVersion(ctx context.Context, request *GetEvalTargetVersionRequest) (r *GetEvalTargetVersionResponse, err error)
+
 	// 批量获取+版本
 	BatchGetEvalTargetVersions(ctx context.Context, request *BatchGetEvalTargetVersionsRequest) (r *BatchGetEvalTargetVersionsResponse, err error)
+
 	// Source评测对象列表
 	ListSourceEvalTargets(ctx context.Context, request *ListSourceEvalTargetsRequest) (r *ListSourceEvalTargetsResponse, err error)
+
 	// Source评测对象版本列表
 	ListSourceEvalTargetVersions(ctx context.Context, request *ListSourceEvalTargetVersionsRequest) (r *ListSourceEvalTargetVersionsResponse, err error)
 
 	BatchGetSourceEvalTargets(ctx context.Context, request *BatchGetSourceEvalTargetsRequest) (r *BatchGetSourceEvalTargetsResponse, err error)
+
 	// 执行
 	ExecuteEvalTarget(ctx context.Context, request *ExecuteEvalTargetRequest) (r *ExecuteEvalTargetResponse, err error)
 
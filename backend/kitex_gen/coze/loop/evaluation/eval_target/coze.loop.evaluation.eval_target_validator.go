@@ -35,6 +35,11 @@ func (p *CreateEvalTargetRequest) IsValid() error {
 	return nil
 }
 func (p *CreateEvalTargetParam) IsValid() error {
+	if p.HTTPTarget != nil {
+		if err := p.HTTPTarget.IsValid(); err != nil {
+			return fmt.Errorf("field HTTPTarget not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *CreateEvalTargetResponse) IsValid() error {
@@ -346,6 +346,34 @@ func (p *CreateEvalTargetParam) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -438,6 +466,32 @@ func (p *CreateEvalTargetParam) FastReadField5(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *CreateEvalTargetParam) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_target.NewHTTPTarget()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.HTTPTarget = _field
+	return offset, nil
+}
+
+func (p *CreateEvalTargetParam) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptLabel = _field
+	return offset, nil
+}
+
 func (p *CreateEvalTargetParam) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -450,6 +504,8 @@ func (p *CreateEvalTargetParam) FastWriteNocopy(buf []byte, w thrift.NocopyWrite
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -463,6 +519,8 @@ func (p *CreateEvalTargetParam) BLength() int {
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -513,6 +571,24 @@ func (p *CreateEvalTargetParam) fastWriteField5(buf []byte, w thrift.NocopyWrite
 	return offset
 }
 
+func (p *CreateEvalTargetParam) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHTTPTarget() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 6)
+		offset += p.HTTPTarget.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateEvalTargetParam) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptLabel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptLabel)
+	}
+	return offset
+}
+
 func (p *CreateEvalTargetParam) field1Length() int {
 	l := 0
 	if p.IsSetSourceTargetID() {
@@ -558,6 +634,24 @@ func (p *CreateEvalTargetParam) field5Length() int {
 	return l
 }
 
+func (p *CreateEvalTargetParam) field6Length() int {
+	l := 0
+	if p.IsSetHTTPTarget() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.HTTPTarget.BLength()
+	}
+	return l
+}
+
+func (p *CreateEvalTargetParam) field7Length() int {
+	l := 0
+	if p.IsSetPromptLabel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PromptLabel)
+	}
+	return l
+}
+
 func (p *CreateEvalTargetParam) DeepCopy(s interface{}) error {
 	src, ok := s.(*CreateEvalTargetParam)
 	if !ok {
@@ -598,6 +692,23 @@ func (p *CreateEvalTargetParam) DeepCopy(s interface{}) error {
 		p.BotPublishVersion = &tmp
 	}
 
+	var _hTTPTarget *eval_target.HTTPTarget
+	if src.HTTPTarget != nil {
+		_hTTPTarget = &eval_target.HTTPTarget{}
+		if err := _hTTPTarget.DeepCopy(src.HTTPTarget); err != nil {
+			return err
+		}
+	}
+	p.HTTPTarget = _hTTPTarget
+
+	if src.PromptLabel != nil {
+		var tmp string
+		if *src.PromptLabel != "" {
+			tmp = kutils.StringDeepCopy(*src.PromptLabel)
+		}
+		p.PromptLabel = &tmp
+	}
+
 	return nil
 }
 
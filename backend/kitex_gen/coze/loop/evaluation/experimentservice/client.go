@@ -23,8 +23,11 @@ type Client interface {
 	RunExperiment(ctx context.Context, req *expt.RunExperimentRequest, callOptions ...callopt.Option) (r *expt.RunExperimentResponse, err error)
 	RetryExperiment(ctx context.Context, req *expt.RetryExperimentRequest, callOptions ...callopt.Option) (r *expt.RetryExperimentResponse, err error)
 	KillExperiment(ctx context.Context, req *expt.KillExperimentRequest, callOptions ...callopt.Option) (r *expt.KillExperimentResponse, err error)
+	PauseExperiment(ctx context.Context, req *expt.PauseExperimentRequest, callOptions ...callopt.Option) (r *expt.PauseExperimentResponse, err error)
+	ResumeExperiment(ctx context.Context, req *expt.ResumeExperimentRequest, callOptions ...callopt.Option) (r *expt.ResumeExperimentResponse, err error)
 	BatchGetExperimentResult_(ctx context.Context, req *expt.BatchGetExperimentResultRequest, callOptions ...callopt.Option) (r *expt.BatchGetExperimentResultResponse, err error)
 	BatchGetExperimentAggrResult_(ctx context.Context, req *expt.BatchGetExperimentAggrResultRequest, callOptions ...callopt.Option) (r *expt.BatchGetExperimentAggrResultResponse, err error)
+	CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest, callOptions ...callopt.Option) (r *expt.CompareExperimentsResponse, err error)
 	InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest, callOptions ...callopt.Option) (r *expt.InvokeExperimentResponse, err error)
 	FinishExperiment(ctx context.Context, req *expt.FinishExperimentRequest, callOptions ...callopt.Option) (r *expt.FinishExperimentResponse, err error)
 	ListExperimentStats(ctx context.Context, req *expt.ListExperimentStatsRequest, callOptions ...callopt.Option) (r *expt.ListExperimentStatsResponse, err error)
@@ -36,6 +39,11 @@ type Client interface {
 	ExportExptResult_(ctx context.Context, req *expt.ExportExptResultRequest, callOptions ...callopt.Option) (r *expt.ExportExptResultResponse, err error)
 	ListExptResultExportRecord(ctx context.Context, req *expt.ListExptResultExportRecordRequest, callOptions ...callopt.Option) (r *expt.ListExptResultExportRecordResponse, err error)
 	GetExptResultExportRecord(ctx context.Context, req *expt.GetExptResultExportRecordRequest, callOptions ...callopt.Option) (r *expt.GetExptResultExportRecordResponse, err error)
+	CreateExptSchedule(ctx context.Context, req *expt.CreateExptScheduleRequest, callOptions ...callopt.Option) (r *expt.CreateExptScheduleResponse, err error)
+	UpdateExptSchedule(ctx context.Context, req *expt.UpdateExptScheduleRequest, callOptions ...callopt.Option) (r *expt.UpdateExptScheduleResponse, err error)
+	DeleteExptSchedule(ctx context.Context, req *expt.DeleteExptScheduleRequest, callOptions ...callopt.Option) (r *expt.DeleteExptScheduleResponse, err error)
+	ListExptSchedules(ctx context.Context, req *expt.ListExptSchedulesRequest, callOptions ...callopt.Option) (r *expt.ListExptSchedulesResponse, err error)
+	ListExptScheduleRuns(ctx context.Context, req *expt.ListExptScheduleRunsRequest, callOptions ...callopt.Option) (r *expt.ListExptScheduleRunsResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -127,6 +135,16 @@ func (p *kExperimentServiceClient) KillExperiment(ctx context.Context, req *expt
 	return p.kClient.KillExperiment(ctx, req)
 }
 
+func (p *kExperimentServiceClient) PauseExperiment(ctx context.Context, req *expt.PauseExperimentRequest, callOptions ...callopt.Option) (r *expt.PauseExperimentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PauseExperiment(ctx, req)
+}
+
+func (p *kExperimentServiceClient) ResumeExperiment(ctx context.Context, req *expt.ResumeExperimentRequest, callOptions ...callopt.Option) (r *expt.ResumeExperimentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResumeExperiment(ctx, req)
+}
+
 func (p *kExperimentServiceClient) BatchGetExperimentResult_(ctx context.Context, req *expt.BatchGetExperimentResultRequest, callOptions ...callopt.Option) (r *expt.BatchGetExperimentResultResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.BatchGetExperimentResult_(ctx, req)
@@ -137,6 +155,11 @@ func (p *kExperimentServiceClient) BatchGetExperimentAggrResult_(ctx context.Con
 	return p.kClient.BatchGetExperimentAggrResult_(ctx, req)
 }
 
+func (p *kExperimentServiceClient) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest, callOptions ...callopt.Option) (r *expt.CompareExperimentsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CompareExperiments(ctx, req)
+}
+
 func (p *kExperimentServiceClient) InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest, callOptions ...callopt.Option) (r *expt.InvokeExperimentResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.InvokeExperiment(ctx, req)
@@ -191,3 +214,28 @@ func (p *kExperimentServiceClient) GetExptResultExportRecord(ctx context.Context
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetExptResultExportRecord(ctx, req)
 }
+
+func (p *kExperimentServiceClient) CreateExptSchedule(ctx context.Context, req *expt.CreateExptScheduleRequest, callOptions ...callopt.Option) (r *expt.CreateExptScheduleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateExptSchedule(ctx, req)
+}
+
+func (p *kExperimentServiceClient) UpdateExptSchedule(ctx context.Context, req *expt.UpdateExptScheduleRequest, callOptions ...callopt.Option) (r *expt.UpdateExptScheduleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateExptSchedule(ctx, req)
+}
+
+func (p *kExperimentServiceClient) DeleteExptSchedule(ctx context.Context, req *expt.DeleteExptScheduleRequest, callOptions ...callopt.Option) (r *expt.DeleteExptScheduleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteExptSchedule(ctx, req)
+}
+
+func (p *kExperimentServiceClient) ListExptSchedules(ctx context.Context, req *expt.ListExptSchedulesRequest, callOptions ...callopt.Option) (r *expt.ListExptSchedulesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListExptSchedules(ctx, req)
+}
+
+func (p *kExperimentServiceClient) ListExptScheduleRuns(ctx context.Context, req *expt.ListExptScheduleRunsRequest, callOptions ...callopt.Option) (r *expt.ListExptScheduleRunsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListExptScheduleRuns(ctx, req)
+}
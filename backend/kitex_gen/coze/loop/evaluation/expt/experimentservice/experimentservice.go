@@ -97,6 +97,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"PauseExperiment": kitex.NewMethodInfo(
+		pauseExperimentHandler,
+		newExperimentServicePauseExperimentArgs,
+		newExperimentServicePauseExperimentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResumeExperiment": kitex.NewMethodInfo(
+		resumeExperimentHandler,
+		newExperimentServiceResumeExperimentArgs,
+		newExperimentServiceResumeExperimentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"BatchGetExperimentResult": kitex.NewMethodInfo(
 		batchGetExperimentResult_Handler,
 		newExperimentServiceBatchGetExperimentResultArgs,
@@ -111,6 +125,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CompareExperiments": kitex.NewMethodInfo(
+		compareExperimentsHandler,
+		newExperimentServiceCompareExperimentsArgs,
+		newExperimentServiceCompareExperimentsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"InvokeExperiment": kitex.NewMethodInfo(
 		invokeExperimentHandler,
 		newExperimentServiceInvokeExperimentArgs,
@@ -188,6 +209,41 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateExptSchedule": kitex.NewMethodInfo(
+		createExptScheduleHandler,
+		newExperimentServiceCreateExptScheduleArgs,
+		newExperimentServiceCreateExptScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateExptSchedule": kitex.NewMethodInfo(
+		updateExptScheduleHandler,
+		newExperimentServiceUpdateExptScheduleArgs,
+		newExperimentServiceUpdateExptScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteExptSchedule": kitex.NewMethodInfo(
+		deleteExptScheduleHandler,
+		newExperimentServiceDeleteExptScheduleArgs,
+		newExperimentServiceDeleteExptScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListExptSchedules": kitex.NewMethodInfo(
+		listExptSchedulesHandler,
+		newExperimentServiceListExptSchedulesArgs,
+		newExperimentServiceListExptSchedulesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListExptScheduleRuns": kitex.NewMethodInfo(
+		listExptScheduleRunsHandler,
+		newExperimentServiceListExptScheduleRunsArgs,
+		newExperimentServiceListExptScheduleRunsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -449,6 +505,44 @@ func newExperimentServiceKillExperimentResult() interface{} {
 	return expt.NewExperimentServiceKillExperimentResult()
 }
 
+func pauseExperimentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServicePauseExperimentArgs)
+	realResult := result.(*expt.ExperimentServicePauseExperimentResult)
+	success, err := handler.(expt.ExperimentService).PauseExperiment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServicePauseExperimentArgs() interface{} {
+	return expt.NewExperimentServicePauseExperimentArgs()
+}
+
+func newExperimentServicePauseExperimentResult() interface{} {
+	return expt.NewExperimentServicePauseExperimentResult()
+}
+
+func resumeExperimentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceResumeExperimentArgs)
+	realResult := result.(*expt.ExperimentServiceResumeExperimentResult)
+	success, err := handler.(expt.ExperimentService).ResumeExperiment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceResumeExperimentArgs() interface{} {
+	return expt.NewExperimentServiceResumeExperimentArgs()
+}
+
+func newExperimentServiceResumeExperimentResult() interface{} {
+	return expt.NewExperimentServiceResumeExperimentResult()
+}
+
 func batchGetExperimentResult_Handler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*expt.ExperimentServiceBatchGetExperimentResultArgs)
 	realResult := result.(*expt.ExperimentServiceBatchGetExperimentResultResult)
@@ -487,6 +581,25 @@ func newExperimentServiceBatchGetExperimentAggrResultResult() interface{} {
 	return expt.NewExperimentServiceBatchGetExperimentAggrResultResult()
 }
 
+func compareExperimentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceCompareExperimentsArgs)
+	realResult := result.(*expt.ExperimentServiceCompareExperimentsResult)
+	success, err := handler.(expt.ExperimentService).CompareExperiments(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceCompareExperimentsArgs() interface{} {
+	return expt.NewExperimentServiceCompareExperimentsArgs()
+}
+
+func newExperimentServiceCompareExperimentsResult() interface{} {
+	return expt.NewExperimentServiceCompareExperimentsResult()
+}
+
 func invokeExperimentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*expt.ExperimentServiceInvokeExperimentArgs)
 	realResult := result.(*expt.ExperimentServiceInvokeExperimentResult)
@@ -696,6 +809,101 @@ func newExperimentServiceGetExptResultExportRecordResult() interface{} {
 	return expt.NewExperimentServiceGetExptResultExportRecordResult()
 }
 
+func createExptScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceCreateExptScheduleArgs)
+	realResult := result.(*expt.ExperimentServiceCreateExptScheduleResult)
+	success, err := handler.(expt.ExperimentService).CreateExptSchedule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceCreateExptScheduleArgs() interface{} {
+	return expt.NewExperimentServiceCreateExptScheduleArgs()
+}
+
+func newExperimentServiceCreateExptScheduleResult() interface{} {
+	return expt.NewExperimentServiceCreateExptScheduleResult()
+}
+
+func updateExptScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceUpdateExptScheduleArgs)
+	realResult := result.(*expt.ExperimentServiceUpdateExptScheduleResult)
+	success, err := handler.(expt.ExperimentService).UpdateExptSchedule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceUpdateExptScheduleArgs() interface{} {
+	return expt.NewExperimentServiceUpdateExptScheduleArgs()
+}
+
+func newExperimentServiceUpdateExptScheduleResult() interface{} {
+	return expt.NewExperimentServiceUpdateExptScheduleResult()
+}
+
+func deleteExptScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceDeleteExptScheduleArgs)
+	realResult := result.(*expt.ExperimentServiceDeleteExptScheduleResult)
+	success, err := handler.(expt.ExperimentService).DeleteExptSchedule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceDeleteExptScheduleArgs() interface{} {
+	return expt.NewExperimentServiceDeleteExptScheduleArgs()
+}
+
+func newExperimentServiceDeleteExptScheduleResult() interface{} {
+	return expt.NewExperimentServiceDeleteExptScheduleResult()
+}
+
+func listExptSchedulesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceListExptSchedulesArgs)
+	realResult := result.(*expt.ExperimentServiceListExptSchedulesResult)
+	success, err := handler.(expt.ExperimentService).ListExptSchedules(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceListExptSchedulesArgs() interface{} {
+	return expt.NewExperimentServiceListExptSchedulesArgs()
+}
+
+func newExperimentServiceListExptSchedulesResult() interface{} {
+	return expt.NewExperimentServiceListExptSchedulesResult()
+}
+
+func listExptScheduleRunsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceListExptScheduleRunsArgs)
+	realResult := result.(*expt.ExperimentServiceListExptScheduleRunsResult)
+	success, err := handler.(expt.ExperimentService).ListExptScheduleRuns(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceListExptScheduleRunsArgs() interface{} {
+	return expt.NewExperimentServiceListExptScheduleRunsArgs()
+}
+
+func newExperimentServiceListExptScheduleRunsResult() interface{} {
+	return expt.NewExperimentServiceListExptScheduleRunsResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -828,6 +1036,26 @@ func (p *kClient) KillExperiment(ctx context.Context, req *expt.KillExperimentRe
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) PauseExperiment(ctx context.Context, req *expt.PauseExperimentRequest) (r *expt.PauseExperimentResponse, err error) {
+	var _args expt.ExperimentServicePauseExperimentArgs
+	_args.Req = req
+	var _result expt.ExperimentServicePauseExperimentResult
+	if err = p.c.Call(ctx, "PauseExperiment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResumeExperiment(ctx context.Context, req *expt.ResumeExperimentRequest) (r *expt.ResumeExperimentResponse, err error) {
+	var _args expt.ExperimentServiceResumeExperimentArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceResumeExperimentResult
+	if err = p.c.Call(ctx, "ResumeExperiment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) BatchGetExperimentResult_(ctx context.Context, req *expt.BatchGetExperimentResultRequest) (r *expt.BatchGetExperimentResultResponse, err error) {
 	var _args expt.ExperimentServiceBatchGetExperimentResultArgs
 	_args.Req = req
@@ -848,6 +1076,16 @@ func (p *kClient) BatchGetExperimentAggrResult_(ctx context.Context, req *expt.B
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest) (r *expt.CompareExperimentsResponse, err error) {
+	var _args expt.ExperimentServiceCompareExperimentsArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceCompareExperimentsResult
+	if err = p.c.Call(ctx, "CompareExperiments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest) (r *expt.InvokeExperimentResponse, err error) {
 	var _args expt.ExperimentServiceInvokeExperimentArgs
 	_args.Req = req
@@ -957,3 +1195,53 @@ func (p *kClient) GetExptResultExportRecord(ctx context.Context, req *expt.GetEx
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateExptSchedule(ctx context.Context, req *expt.CreateExptScheduleRequest) (r *expt.CreateExptScheduleResponse, err error) {
+	var _args expt.ExperimentServiceCreateExptScheduleArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceCreateExptScheduleResult
+	if err = p.c.Call(ctx, "CreateExptSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateExptSchedule(ctx context.Context, req *expt.UpdateExptScheduleRequest) (r *expt.UpdateExptScheduleResponse, err error) {
+	var _args expt.ExperimentServiceUpdateExptScheduleArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceUpdateExptScheduleResult
+	if err = p.c.Call(ctx, "UpdateExptSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteExptSchedule(ctx context.Context, req *expt.DeleteExptScheduleRequest) (r *expt.DeleteExptScheduleResponse, err error) {
+	var _args expt.ExperimentServiceDeleteExptScheduleArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceDeleteExptScheduleResult
+	if err = p.c.Call(ctx, "DeleteExptSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListExptSchedules(ctx context.Context, req *expt.ListExptSchedulesRequest) (r *expt.ListExptSchedulesResponse, err error) {
+	var _args expt.ExperimentServiceListExptSchedulesArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceListExptSchedulesResult
+	if err = p.c.Call(ctx, "ListExptSchedules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListExptScheduleRuns(ctx context.Context, req *expt.ListExptScheduleRunsRequest) (r *expt.ListExptScheduleRunsResponse, err error) {
+	var _args expt.ExperimentServiceListExptScheduleRunsArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceListExptScheduleRunsResult
+	if err = p.c.Call(ctx, "ListExptScheduleRuns", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -252,6 +252,20 @@ func (p *CreateExperimentRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 26:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField26(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 30:
 			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField30(buf[offset:])
@@ -571,6 +585,18 @@ func (p *CreateExperimentRequest) FastReadField25(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *CreateExperimentRequest) FastReadField26(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptBudget()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Budget = _field
+	return offset, nil
+}
+
 func (p *CreateExperimentRequest) FastReadField30(buf []byte) (int, error) {
 	offset := 0
 
@@ -677,6 +703,7 @@ func (p *CreateExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 		offset += p.fastWriteField21(buf[offset:], w)
 		offset += p.fastWriteField24(buf[offset:], w)
 		offset += p.fastWriteField25(buf[offset:], w)
+		offset += p.fastWriteField26(buf[offset:], w)
 		offset += p.fastWriteField30(buf[offset:], w)
 		offset += p.fastWriteField32(buf[offset:], w)
 		offset += p.fastWriteField33(buf[offset:], w)
@@ -704,6 +731,7 @@ func (p *CreateExperimentRequest) BLength() int {
 		l += p.field23Length()
 		l += p.field24Length()
 		l += p.field25Length()
+		l += p.field26Length()
 		l += p.field30Length()
 		l += p.field31Length()
 		l += p.field32Length()
@@ -853,6 +881,15 @@ func (p *CreateExperimentRequest) fastWriteField25(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
+func (p *CreateExperimentRequest) fastWriteField26(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBudget() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 26)
+		offset += p.Budget.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *CreateExperimentRequest) fastWriteField30(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetExptType() {
@@ -1037,6 +1074,15 @@ func (p *CreateExperimentRequest) field25Length() int {
 	return l
 }
 
+func (p *CreateExperimentRequest) field26Length() int {
+	l := 0
+	if p.IsSetBudget() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Budget.BLength()
+	}
+	return l
+}
+
 func (p *CreateExperimentRequest) field30Length() int {
 	l := 0
 	if p.IsSetExptType() {
@@ -1196,6 +1242,15 @@ func (p *CreateExperimentRequest) DeepCopy(s interface{}) error {
 	}
 	p.TargetRuntimeParam = _targetRuntimeParam
 
+	var _budget *expt.ExptBudget
+	if src.Budget != nil {
+		_budget = &expt.ExptBudget{}
+		if err := _budget.DeepCopy(src.Budget); err != nil {
+			return err
+		}
+	}
+	p.Budget = _budget
+
 	if src.ExptType != nil {
 		tmp := *src.ExptType
 		p.ExptType = &tmp
@@ -1625,6 +1680,20 @@ func (p *SubmitExperimentRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 26:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField26(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 30:
 			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField30(buf[offset:])
@@ -1958,6 +2027,18 @@ func (p *SubmitExperimentRequest) FastReadField25(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *SubmitExperimentRequest) FastReadField26(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptBudget()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Budget = _field
+	return offset, nil
+}
+
 func (p *SubmitExperimentRequest) FastReadField30(buf []byte) (int, error) {
 	offset := 0
 
@@ -2096,6 +2177,7 @@ func (p *SubmitExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 		offset += p.fastWriteField21(buf[offset:], w)
 		offset += p.fastWriteField24(buf[offset:], w)
 		offset += p.fastWriteField25(buf[offset:], w)
+		offset += p.fastWriteField26(buf[offset:], w)
 		offset += p.fastWriteField30(buf[offset:], w)
 		offset += p.fastWriteField32(buf[offset:], w)
 		offset += p.fastWriteField33(buf[offset:], w)
@@ -2124,6 +2206,7 @@ func (p *SubmitExperimentRequest) BLength() int {
 		l += p.field23Length()
 		l += p.field24Length()
 		l += p.field25Length()
+		l += p.field26Length()
 		l += p.field30Length()
 		l += p.field31Length()
 		l += p.field32Length()
@@ -2274,6 +2357,15 @@ func (p *SubmitExperimentRequest) fastWriteField25(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
+func (p *SubmitExperimentRequest) fastWriteField26(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBudget() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 26)
+		offset += p.Budget.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *SubmitExperimentRequest) fastWriteField30(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetExptType() {
@@ -2475,6 +2567,15 @@ func (p *SubmitExperimentRequest) field25Length() int {
 	return l
 }
 
+func (p *SubmitExperimentRequest) field26Length() int {
+	l := 0
+	if p.IsSetBudget() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Budget.BLength()
+	}
+	return l
+}
+
 func (p *SubmitExperimentRequest) field30Length() int {
 	l := 0
 	if p.IsSetExptType() {
@@ -2649,6 +2750,15 @@ func (p *SubmitExperimentRequest) DeepCopy(s interface{}) error {
 	}
 	p.TargetRuntimeParam = _targetRuntimeParam
 
+	var _budget *expt.ExptBudget
+	if src.Budget != nil {
+		_budget = &expt.ExptBudget{}
+		if err := _budget.DeepCopy(src.Budget); err != nil {
+			return err
+		}
+	}
+	p.Budget = _budget
+
 	if src.ExptType != nil {
 		tmp := *src.ExptType
 		p.ExptType = &tmp
@@ -6910,7 +7020,7 @@ func (p *KillExperimentResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *CloneExperimentRequest) FastRead(buf []byte) (int, error) {
+func (p *PauseExperimentRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6982,12 +7092,12 @@ func (p *CloneExperimentRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CloneExperimentRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PauseExperimentRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CloneExperimentRequest) FastReadField1(buf []byte) (int, error) {
+func (p *PauseExperimentRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -7001,7 +7111,7 @@ func (p *CloneExperimentRequest) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *CloneExperimentRequest) FastReadField2(buf []byte) (int, error) {
+func (p *PauseExperimentRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -7015,7 +7125,7 @@ func (p *CloneExperimentRequest) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *CloneExperimentRequest) FastReadField255(buf []byte) (int, error) {
+func (p *PauseExperimentRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -7027,11 +7137,11 @@ func (p *CloneExperimentRequest) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *CloneExperimentRequest) FastWrite(buf []byte) int {
+func (p *PauseExperimentRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CloneExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PauseExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -7042,7 +7152,7 @@ func (p *CloneExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
-func (p *CloneExperimentRequest) BLength() int {
+func (p *PauseExperimentRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -7053,7 +7163,7 @@ func (p *CloneExperimentRequest) BLength() int {
 	return l
 }
 
-func (p *CloneExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PauseExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetExptID() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
@@ -7062,7 +7172,7 @@ func (p *CloneExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
-func (p *CloneExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *PauseExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetWorkspaceID() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
@@ -7071,7 +7181,7 @@ func (p *CloneExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
-func (p *CloneExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *PauseExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -7080,7 +7190,7 @@ func (p *CloneExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *CloneExperimentRequest) field1Length() int {
+func (p *PauseExperimentRequest) field1Length() int {
 	l := 0
 	if p.IsSetExptID() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7089,7 +7199,7 @@ func (p *CloneExperimentRequest) field1Length() int {
 	return l
 }
 
-func (p *CloneExperimentRequest) field2Length() int {
+func (p *PauseExperimentRequest) field2Length() int {
 	l := 0
 	if p.IsSetWorkspaceID() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7098,7 +7208,7 @@ func (p *CloneExperimentRequest) field2Length() int {
 	return l
 }
 
-func (p *CloneExperimentRequest) field255Length() int {
+func (p *PauseExperimentRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7107,8 +7217,8 @@ func (p *CloneExperimentRequest) field255Length() int {
 	return l
 }
 
-func (p *CloneExperimentRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*CloneExperimentRequest)
+func (p *PauseExperimentRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*PauseExperimentRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -7135,7 +7245,7 @@ func (p *CloneExperimentRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *CloneExperimentResponse) FastRead(buf []byte) (int, error) {
+func (p *PauseExperimentResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7152,20 +7262,6 @@ func (p *CloneExperimentResponse) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -7193,24 +7289,12 @@ func (p *CloneExperimentResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CloneExperimentResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PauseExperimentResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CloneExperimentResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-	_field := expt.NewExperiment()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Experiment = _field
-	return offset, nil
-}
-
-func (p *CloneExperimentResponse) FastReadField255(buf []byte) (int, error) {
+func (p *PauseExperimentResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -7222,77 +7306,48 @@ func (p *CloneExperimentResponse) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *CloneExperimentResponse) FastWrite(buf []byte) int {
+func (p *PauseExperimentResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CloneExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PauseExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *CloneExperimentResponse) BLength() int {
+func (p *PauseExperimentResponse) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field1Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *CloneExperimentResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExperiment() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.Experiment.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *CloneExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *PauseExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *CloneExperimentResponse) field1Length() int {
-	l := 0
-	if p.IsSetExperiment() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Experiment.BLength()
-	}
-	return l
-}
-
-func (p *CloneExperimentResponse) field255Length() int {
+func (p *PauseExperimentResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *CloneExperimentResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*CloneExperimentResponse)
+func (p *PauseExperimentResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*PauseExperimentResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _experiment *expt.Experiment
-	if src.Experiment != nil {
-		_experiment = &expt.Experiment{}
-		if err := _experiment.DeepCopy(src.Experiment); err != nil {
-			return err
-		}
-	}
-	p.Experiment = _experiment
-
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -7305,15 +7360,13 @@ func (p *CloneExperimentResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchGetExperimentResultRequest) FastRead(buf []byte) (int, error) {
+func (p *ResumeExperimentRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetExperimentIds bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -7331,7 +7384,6 @@ func (p *BatchGetExperimentResultRequest) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -7340,79 +7392,8 @@ func (p *BatchGetExperimentResultRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetExperimentIds = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 10:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField10(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 20:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField20(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 21:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField21(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 30:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField30(buf[offset:])
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7447,154 +7428,44 @@ func (p *BatchGetExperimentResultRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExperimentIds {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentResultRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResumeExperimentRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchGetExperimentResultRequest[fieldId]))
 }
 
-func (p *BatchGetExperimentResultRequest) FastReadField1(buf []byte) (int, error) {
+func (p *ResumeExperimentRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
+	var _field *int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.WorkspaceID = _field
+	p.ExptID = _field
 	return offset, nil
 }
 
-func (p *BatchGetExperimentResultRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ResumeExperimentRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.ExperimentIds = _field
-	return offset, nil
-}
-
-func (p *BatchGetExperimentResultRequest) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.BaselineExperimentID = _field
-	return offset, nil
-}
-
-func (p *BatchGetExperimentResultRequest) FastReadField10(buf []byte) (int, error) {
-	offset := 0
-
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make(map[int64]*expt.ExperimentFilter, size)
-	values := make([]expt.ExperimentFilter, size)
-	for i := 0; i < size; i++ {
-		var _key int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if l, err := _val.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field[_key] = _val
-	}
-	p.Filters = _field
-	return offset, nil
-}
-
-func (p *BatchGetExperimentResultRequest) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.PageNumber = _field
-	return offset, nil
-}
-
-func (p *BatchGetExperimentResultRequest) FastReadField21(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.PageSize = _field
-	return offset, nil
-}
-
-func (p *BatchGetExperimentResultRequest) FastReadField30(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.UseAccelerator = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *BatchGetExperimentResultRequest) FastReadField255(buf []byte) (int, error) {
+func (p *ResumeExperimentRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -7606,117 +7477,51 @@ func (p *BatchGetExperimentResultRequest) FastReadField255(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *BatchGetExperimentResultRequest) FastWrite(buf []byte) int {
+func (p *ResumeExperimentRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchGetExperimentResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ResumeExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField20(buf[offset:], w)
-		offset += p.fastWriteField21(buf[offset:], w)
-		offset += p.fastWriteField30(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *BatchGetExperimentResultRequest) BLength() int {
+func (p *ResumeExperimentRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field10Length()
-		l += p.field20Length()
-		l += p.field21Length()
-		l += p.field30Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchGetExperimentResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
-	return offset
-}
-
-func (p *BatchGetExperimentResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.ExperimentIds {
-		length++
-		offset += thrift.Binary.WriteI64(buf[offset:], v)
-	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
-	return offset
-}
-
-func (p *BatchGetExperimentResultRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetBaselineExperimentID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.BaselineExperimentID)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentResultRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetFilters() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 10)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.Filters {
-			length++
-			offset += thrift.Binary.WriteI64(buf[offset:], k)
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentResultRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPageNumber() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 20)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentResultRequest) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
+func (p *ResumeExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageSize() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 21)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	if p.IsSetExptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExptID)
 	}
 	return offset
 }
 
-func (p *BatchGetExperimentResultRequest) fastWriteField30(buf []byte, w thrift.NocopyWriter) int {
+func (p *ResumeExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetUseAccelerator() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 30)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.UseAccelerator)
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
 	}
 	return offset
 }
 
-func (p *BatchGetExperimentResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ResumeExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -7725,74 +7530,25 @@ func (p *BatchGetExperimentResultRequest) fastWriteField255(buf []byte, w thrift
 	return offset
 }
 
-func (p *BatchGetExperimentResultRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *BatchGetExperimentResultRequest) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	l +=
-		thrift.Binary.I64Length() * len(p.ExperimentIds)
-	return l
-}
-
-func (p *BatchGetExperimentResultRequest) field3Length() int {
+func (p *ResumeExperimentRequest) field1Length() int {
 	l := 0
-	if p.IsSetBaselineExperimentID() {
+	if p.IsSetExptID() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *BatchGetExperimentResultRequest) field10Length() int {
-	l := 0
-	if p.IsSetFilters() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.Filters {
-			_, _ = k, v
-
-			l += thrift.Binary.I64Length()
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *BatchGetExperimentResultRequest) field20Length() int {
-	l := 0
-	if p.IsSetPageNumber() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
-	return l
-}
-
-func (p *BatchGetExperimentResultRequest) field21Length() int {
-	l := 0
-	if p.IsSetPageSize() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
-	return l
-}
-
-func (p *BatchGetExperimentResultRequest) field30Length() int {
+func (p *ResumeExperimentRequest) field2Length() int {
 	l := 0
-	if p.IsSetUseAccelerator() {
+	if p.IsSetWorkspaceID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *BatchGetExperimentResultRequest) field255Length() int {
+func (p *ResumeExperimentRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7801,59 +7557,20 @@ func (p *BatchGetExperimentResultRequest) field255Length() int {
 	return l
 }
 
-func (p *BatchGetExperimentResultRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchGetExperimentResultRequest)
+func (p *ResumeExperimentRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ResumeExperimentRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.WorkspaceID = src.WorkspaceID
-
-	if src.ExperimentIds != nil {
-		p.ExperimentIds = make([]int64, 0, len(src.ExperimentIds))
-		for _, elem := range src.ExperimentIds {
-			var _elem int64
-			_elem = elem
-			p.ExperimentIds = append(p.ExperimentIds, _elem)
-		}
+	if src.ExptID != nil {
+		tmp := *src.ExptID
+		p.ExptID = &tmp
 	}
 
-	if src.BaselineExperimentID != nil {
-		tmp := *src.BaselineExperimentID
-		p.BaselineExperimentID = &tmp
-	}
-
-	if src.Filters != nil {
-		p.Filters = make(map[int64]*expt.ExperimentFilter, len(src.Filters))
-		for key, val := range src.Filters {
-			var _key int64
-			_key = key
-
-			var _val *expt.ExperimentFilter
-			if val != nil {
-				_val = &expt.ExperimentFilter{}
-				if err := _val.DeepCopy(val); err != nil {
-					return err
-				}
-			}
-
-			p.Filters[_key] = _val
-		}
-	}
-
-	if src.PageNumber != nil {
-		tmp := *src.PageNumber
-		p.PageNumber = &tmp
-	}
-
-	if src.PageSize != nil {
-		tmp := *src.PageSize
-		p.PageSize = &tmp
-	}
-
-	if src.UseAccelerator != nil {
-		tmp := *src.UseAccelerator
-		p.UseAccelerator = &tmp
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
 	}
 
 	var _base *base.Base
@@ -7868,14 +7585,13 @@ func (p *BatchGetExperimentResultRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchGetExperimentResultResponse) FastRead(buf []byte) (int, error) {
+func (p *ResumeExperimentResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetColumnEvalSetFields bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -7886,38 +7602,9 @@ func (p *BatchGetExperimentResultResponse) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetColumnEvalSetFields = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField3(buf[offset:])
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7929,23 +7616,110 @@ func (p *BatchGetExperimentResultResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField10(buf[offset:])
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResumeExperimentResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ResumeExperimentResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ResumeExperimentResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ResumeExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ResumeExperimentResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ResumeExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ResumeExperimentResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ResumeExperimentResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ResumeExperimentResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *CloneExperimentRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7957,9 +7731,9 @@ func (p *BatchGetExperimentResultResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 20:
+		case 2:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField20(buf[offset:])
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7994,161 +7768,239 @@ func (p *BatchGetExperimentResultResponse) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetColumnEvalSetFields {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentResultResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CloneExperimentRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchGetExperimentResultResponse[fieldId]))
 }
 
-func (p *BatchGetExperimentResultResponse) FastReadField1(buf []byte) (int, error) {
+func (p *CloneExperimentRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*expt.ColumnEvalSetField, 0, size)
-	values := make([]expt.ColumnEvalSetField, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.ColumnEvalSetFields = _field
+	p.ExptID = _field
 	return offset, nil
 }
 
-func (p *BatchGetExperimentResultResponse) FastReadField2(buf []byte) (int, error) {
+func (p *CloneExperimentRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*expt.ColumnEvaluator, 0, size)
-	values := make([]expt.ColumnEvaluator, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.ColumnEvaluators = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *BatchGetExperimentResultResponse) FastReadField3(buf []byte) (int, error) {
+func (p *CloneExperimentRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
 	}
-	_field := make([]*expt.ExptColumnEvaluator, 0, size)
-	values := make([]expt.ExptColumnEvaluator, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.ExptColumnEvaluators = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *BatchGetExperimentResultResponse) FastReadField4(buf []byte) (int, error) {
+func (p *CloneExperimentRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CloneExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
+func (p *CloneExperimentRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
 	}
-	_field := make([]*expt.ExptColumnAnnotation, 0, size)
-	values := make([]expt.ExptColumnAnnotation, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
 
-		_field = append(_field, _elem)
+func (p *CloneExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExptID)
 	}
-	p.ExptColumnAnnotations = _field
-	return offset, nil
+	return offset
 }
 
-func (p *BatchGetExperimentResultResponse) FastReadField10(buf []byte) (int, error) {
+func (p *CloneExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	}
+	return offset
+}
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
+func (p *CloneExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	}
-	_field := make([]*expt.ItemResult_, 0, size)
-	values := make([]expt.ItemResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
+	return offset
+}
+
+func (p *CloneExperimentRequest) field1Length() int {
+	l := 0
+	if p.IsSetExptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *CloneExperimentRequest) field2Length() int {
+	l := 0
+	if p.IsSetWorkspaceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *CloneExperimentRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *CloneExperimentRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*CloneExperimentRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ExptID != nil {
+		tmp := *src.ExptID
+		p.ExptID = &tmp
+	}
+
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
 		}
+	}
+	p.Base = _base
 
-		_field = append(_field, _elem)
+	return nil
+}
+
+func (p *CloneExperimentResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	p.ItemResults = _field
+
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CloneExperimentResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *BatchGetExperimentResultResponse) FastReadField20(buf []byte) (int, error) {
+func (p *CloneExperimentResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := expt.NewExperiment()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Total = _field
+	p.Experiment = _field
 	return offset, nil
 }
 
-func (p *BatchGetExperimentResultResponse) FastReadField255(buf []byte) (int, error) {
+func (p *CloneExperimentResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -8160,298 +8012,76 @@ func (p *BatchGetExperimentResultResponse) FastReadField255(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *BatchGetExperimentResultResponse) FastWrite(buf []byte) int {
+func (p *CloneExperimentResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchGetExperimentResultResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CloneExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *BatchGetExperimentResultResponse) BLength() int {
+func (p *CloneExperimentResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field10Length()
-		l += p.field20Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchGetExperimentResultResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CloneExperimentResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.ColumnEvalSetFields {
-		length++
-		offset += v.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetExperiment() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Experiment.FastWriteNocopy(buf[offset:], w)
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *BatchGetExperimentResultResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetColumnEvaluators() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ColumnEvaluators {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentResultResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExptColumnEvaluators() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ExptColumnEvaluators {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentResultResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExptColumnAnnotations() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ExptColumnAnnotations {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentResultResponse) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemResults() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 10)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ItemResults {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentResultResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTotal() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentResultResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *CloneExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *BatchGetExperimentResultResponse) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.ColumnEvalSetFields {
-		_ = v
-		l += v.BLength()
-	}
-	return l
-}
-
-func (p *BatchGetExperimentResultResponse) field2Length() int {
-	l := 0
-	if p.IsSetColumnEvaluators() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ColumnEvaluators {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *BatchGetExperimentResultResponse) field3Length() int {
-	l := 0
-	if p.IsSetExptColumnEvaluators() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ExptColumnEvaluators {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *BatchGetExperimentResultResponse) field4Length() int {
-	l := 0
-	if p.IsSetExptColumnAnnotations() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ExptColumnAnnotations {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *BatchGetExperimentResultResponse) field10Length() int {
-	l := 0
-	if p.IsSetItemResults() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ItemResults {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *BatchGetExperimentResultResponse) field20Length() int {
+func (p *CloneExperimentResponse) field1Length() int {
 	l := 0
-	if p.IsSetTotal() {
+	if p.IsSetExperiment() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += p.Experiment.BLength()
 	}
 	return l
 }
 
-func (p *BatchGetExperimentResultResponse) field255Length() int {
+func (p *CloneExperimentResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *BatchGetExperimentResultResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchGetExperimentResultResponse)
+func (p *CloneExperimentResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*CloneExperimentResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ColumnEvalSetFields != nil {
-		p.ColumnEvalSetFields = make([]*expt.ColumnEvalSetField, 0, len(src.ColumnEvalSetFields))
-		for _, elem := range src.ColumnEvalSetFields {
-			var _elem *expt.ColumnEvalSetField
-			if elem != nil {
-				_elem = &expt.ColumnEvalSetField{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.ColumnEvalSetFields = append(p.ColumnEvalSetFields, _elem)
-		}
-	}
-
-	if src.ColumnEvaluators != nil {
-		p.ColumnEvaluators = make([]*expt.ColumnEvaluator, 0, len(src.ColumnEvaluators))
-		for _, elem := range src.ColumnEvaluators {
-			var _elem *expt.ColumnEvaluator
-			if elem != nil {
-				_elem = &expt.ColumnEvaluator{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.ColumnEvaluators = append(p.ColumnEvaluators, _elem)
-		}
-	}
-
-	if src.ExptColumnEvaluators != nil {
-		p.ExptColumnEvaluators = make([]*expt.ExptColumnEvaluator, 0, len(src.ExptColumnEvaluators))
-		for _, elem := range src.ExptColumnEvaluators {
-			var _elem *expt.ExptColumnEvaluator
-			if elem != nil {
-				_elem = &expt.ExptColumnEvaluator{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.ExptColumnEvaluators = append(p.ExptColumnEvaluators, _elem)
-		}
-	}
-
-	if src.ExptColumnAnnotations != nil {
-		p.ExptColumnAnnotations = make([]*expt.ExptColumnAnnotation, 0, len(src.ExptColumnAnnotations))
-		for _, elem := range src.ExptColumnAnnotations {
-			var _elem *expt.ExptColumnAnnotation
-			if elem != nil {
-				_elem = &expt.ExptColumnAnnotation{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.ExptColumnAnnotations = append(p.ExptColumnAnnotations, _elem)
-		}
-	}
-
-	if src.ItemResults != nil {
-		p.ItemResults = make([]*expt.ItemResult_, 0, len(src.ItemResults))
-		for _, elem := range src.ItemResults {
-			var _elem *expt.ItemResult_
-			if elem != nil {
-				_elem = &expt.ItemResult_{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.ItemResults = append(p.ItemResults, _elem)
+	var _experiment *expt.Experiment
+	if src.Experiment != nil {
+		_experiment = &expt.Experiment{}
+		if err := _experiment.DeepCopy(src.Experiment); err != nil {
+			return err
 		}
 	}
-
-	if src.Total != nil {
-		tmp := *src.Total
-		p.Total = &tmp
-	}
+	p.Experiment = _experiment
 
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
@@ -8465,7 +8095,7 @@ func (p *BatchGetExperimentResultResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchGetExperimentAggrResultRequest) FastRead(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8514,9 +8144,9 @@ func (p *BatchGetExperimentAggrResultRequest) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -8528,18 +8158,88 @@ func (p *BatchGetExperimentAggrResultRequest) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		case 10:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
 			}
-		}
-	}
-
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+		case 20:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 21:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField21(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 30:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField30(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
 
 	if !issetExperimentIds {
@@ -8550,14 +8250,14 @@ func (p *BatchGetExperimentAggrResultRequest) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentAggrResultRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentResultRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchGetExperimentAggrResultRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchGetExperimentResultRequest[fieldId]))
 }
 
-func (p *BatchGetExperimentAggrResultRequest) FastReadField1(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -8571,7 +8271,7 @@ func (p *BatchGetExperimentAggrResultRequest) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *BatchGetExperimentAggrResultRequest) FastReadField2(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -8595,7 +8295,96 @@ func (p *BatchGetExperimentAggrResultRequest) FastReadField2(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *BatchGetExperimentAggrResultRequest) FastReadField255(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.BaselineExperimentID = _field
+	return offset, nil
+}
+
+func (p *BatchGetExperimentResultRequest) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[int64]*expt.ExperimentFilter, size)
+	values := make([]expt.ExperimentFilter, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if l, err := _val.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field[_key] = _val
+	}
+	p.Filters = _field
+	return offset, nil
+}
+
+func (p *BatchGetExperimentResultRequest) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageNumber = _field
+	return offset, nil
+}
+
+func (p *BatchGetExperimentResultRequest) FastReadField21(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *BatchGetExperimentResultRequest) FastReadField30(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.UseAccelerator = _field
+	return offset, nil
+}
+
+func (p *BatchGetExperimentResultRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -8607,40 +8396,50 @@ func (p *BatchGetExperimentAggrResultRequest) FastReadField255(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *BatchGetExperimentAggrResultRequest) FastWrite(buf []byte) int {
+func (p *BatchGetExperimentResultRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchGetExperimentAggrResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField21(buf[offset:], w)
+		offset += p.fastWriteField30(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *BatchGetExperimentAggrResultRequest) BLength() int {
+func (p *BatchGetExperimentResultRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field10Length()
+		l += p.field20Length()
+		l += p.field21Length()
+		l += p.field30Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchGetExperimentAggrResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *BatchGetExperimentAggrResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
 	listBeginOffset := offset
@@ -8654,7 +8453,60 @@ func (p *BatchGetExperimentAggrResultRequest) fastWriteField2(buf []byte, w thri
 	return offset
 }
 
-func (p *BatchGetExperimentAggrResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaselineExperimentID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.BaselineExperimentID)
+	}
+	return offset
+}
+
+func (p *BatchGetExperimentResultRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 10)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Filters {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], k)
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *BatchGetExperimentResultRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 20)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	}
+	return offset
+}
+
+func (p *BatchGetExperimentResultRequest) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 21)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *BatchGetExperimentResultRequest) fastWriteField30(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUseAccelerator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 30)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.UseAccelerator)
+	}
+	return offset
+}
+
+func (p *BatchGetExperimentResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -8663,14 +8515,14 @@ func (p *BatchGetExperimentAggrResultRequest) fastWriteField255(buf []byte, w th
 	return offset
 }
 
-func (p *BatchGetExperimentAggrResultRequest) field1Length() int {
+func (p *BatchGetExperimentResultRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchGetExperimentAggrResultRequest) field2Length() int {
+func (p *BatchGetExperimentResultRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.ListBeginLength()
@@ -8679,19 +8531,70 @@ func (p *BatchGetExperimentAggrResultRequest) field2Length() int {
 	return l
 }
 
-func (p *BatchGetExperimentAggrResultRequest) field255Length() int {
+func (p *BatchGetExperimentResultRequest) field3Length() int {
 	l := 0
-	if p.IsSetBase() {
+	if p.IsSetBaselineExperimentID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *BatchGetExperimentAggrResultRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchGetExperimentAggrResultRequest)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *BatchGetExperimentResultRequest) field10Length() int {
+	l := 0
+	if p.IsSetFilters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Filters {
+			_, _ = k, v
+
+			l += thrift.Binary.I64Length()
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultRequest) field20Length() int {
+	l := 0
+	if p.IsSetPageNumber() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultRequest) field21Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultRequest) field30Length() int {
+	l := 0
+	if p.IsSetUseAccelerator() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchGetExperimentResultRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
@@ -8705,6 +8608,44 @@ func (p *BatchGetExperimentAggrResultRequest) DeepCopy(s interface{}) error {
 		}
 	}
 
+	if src.BaselineExperimentID != nil {
+		tmp := *src.BaselineExperimentID
+		p.BaselineExperimentID = &tmp
+	}
+
+	if src.Filters != nil {
+		p.Filters = make(map[int64]*expt.ExperimentFilter, len(src.Filters))
+		for key, val := range src.Filters {
+			var _key int64
+			_key = key
+
+			var _val *expt.ExperimentFilter
+			if val != nil {
+				_val = &expt.ExperimentFilter{}
+				if err := _val.DeepCopy(val); err != nil {
+					return err
+				}
+			}
+
+			p.Filters[_key] = _val
+		}
+	}
+
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
+	}
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	if src.UseAccelerator != nil {
+		tmp := *src.UseAccelerator
+		p.UseAccelerator = &tmp
+	}
+
 	var _base *base.Base
 	if src.Base != nil {
 		_base = &base.Base{}
@@ -8717,13 +8658,14 @@ func (p *BatchGetExperimentAggrResultRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchGetExperimentAggrResultResponse) FastRead(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetColumnEvalSetFields bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -8741,6 +8683,77 @@ func (p *BatchGetExperimentAggrResultResponse) FastRead(buf []byte) (int, error)
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetColumnEvalSetFields = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -8771,16 +8784,22 @@ func (p *BatchGetExperimentAggrResultResponse) FastRead(buf []byte) (int, error)
 		}
 	}
 
+	if !issetColumnEvalSetFields {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentAggrResultResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentResultResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchGetExperimentResultResponse[fieldId]))
 }
 
-func (p *BatchGetExperimentAggrResultResponse) FastReadField1(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -8788,8 +8807,8 @@ func (p *BatchGetExperimentAggrResultResponse) FastReadField1(buf []byte) (int,
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*expt.ExptAggregateResult_, 0, size)
-	values := make([]expt.ExptAggregateResult_, size)
+	_field := make([]*expt.ColumnEvalSetField, 0, size)
+	values := make([]expt.ColumnEvalSetField, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -8801,358 +8820,450 @@ func (p *BatchGetExperimentAggrResultResponse) FastReadField1(buf []byte) (int,
 
 		_field = append(_field, _elem)
 	}
-	p.ExptAggregateResults = _field
+	p.ColumnEvalSetFields = _field
 	return offset, nil
 }
 
-func (p *BatchGetExperimentAggrResultResponse) FastReadField255(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
 	}
-	p.BaseResp = _field
-	return offset, nil
-}
+	_field := make([]*expt.ColumnEvaluator, 0, size)
+	values := make([]expt.ColumnEvaluator, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
 
-func (p *BatchGetExperimentAggrResultResponse) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
+		_field = append(_field, _elem)
+	}
+	p.ColumnEvaluators = _field
+	return offset, nil
 }
 
-func (p *BatchGetExperimentAggrResultResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultResponse) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
 	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
+	_field := make([]*expt.ExptColumnEvaluator, 0, size)
+	values := make([]expt.ExptColumnEvaluator, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
 
-func (p *BatchGetExperimentAggrResultResponse) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field255Length()
+		_field = append(_field, _elem)
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+	p.ExptColumnEvaluators = _field
+	return offset, nil
 }
 
-func (p *BatchGetExperimentAggrResultResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultResponse) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetExptAggregateResults() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ExptAggregateResults {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *BatchGetExperimentAggrResultResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *BatchGetExperimentAggrResultResponse) field1Length() int {
-	l := 0
-	if p.IsSetExptAggregateResults() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ExptAggregateResults {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *BatchGetExperimentAggrResultResponse) field255Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
-	return l
-}
-
-func (p *BatchGetExperimentAggrResultResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchGetExperimentAggrResultResponse)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	if src.ExptAggregateResults != nil {
-		p.ExptAggregateResults = make([]*expt.ExptAggregateResult_, 0, len(src.ExptAggregateResults))
-		for _, elem := range src.ExptAggregateResults {
-			var _elem *expt.ExptAggregateResult_
-			if elem != nil {
-				_elem = &expt.ExptAggregateResult_{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
 
-			p.ExptAggregateResults = append(p.ExptAggregateResults, _elem)
-		}
-	}
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
-			return err
-		}
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
 	}
-	p.BaseResp = _baseResp
-
-	return nil
-}
-
-func (p *CheckExperimentNameRequest) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetWorkspaceID bool = false
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetWorkspaceID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+	_field := make([]*expt.ExptColumnAnnotation, 0, size)
+	values := make([]expt.ExptColumnAnnotation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
 			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
 		}
-	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+		_field = append(_field, _elem)
 	}
+	p.ExptColumnAnnotations = _field
 	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CheckExperimentNameRequest[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CheckExperimentNameRequest[fieldId]))
 }
 
-func (p *CheckExperimentNameRequest) FastReadField1(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultResponse) FastReadField10(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = v
 	}
-	p.WorkspaceID = _field
+	_field := make([]*expt.ItemResult_, 0, size)
+	values := make([]expt.ItemResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ItemResults = _field
 	return offset, nil
 }
 
-func (p *CheckExperimentNameRequest) FastReadField2(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultResponse) FastReadField20(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Name = _field
+	p.Total = _field
 	return offset, nil
 }
 
-func (p *CheckExperimentNameRequest) FastReadField255(buf []byte) (int, error) {
+func (p *BatchGetExperimentResultResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.BaseResp = _field
 	return offset, nil
 }
 
-func (p *CheckExperimentNameRequest) FastWrite(buf []byte) int {
+func (p *BatchGetExperimentResultResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CheckExperimentNameRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *CheckExperimentNameRequest) BLength() int {
+func (p *BatchGetExperimentResultResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field10Length()
+		l += p.field20Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *CheckExperimentNameRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.ColumnEvalSetFields {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *CheckExperimentNameRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	if p.IsSetColumnEvaluators() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ColumnEvaluators {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *CheckExperimentNameRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentResultResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetExptColumnEvaluators() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ExptColumnEvaluators {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *CheckExperimentNameRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+func (p *BatchGetExperimentResultResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExptColumnAnnotations() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ExptColumnAnnotations {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
 }
 
-func (p *CheckExperimentNameRequest) field2Length() int {
+func (p *BatchGetExperimentResultResponse) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 10)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ItemResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *BatchGetExperimentResultResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *BatchGetExperimentResultResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *BatchGetExperimentResultResponse) field1Length() int {
 	l := 0
-	if p.IsSetName() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.ColumnEvalSetFields {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultResponse) field2Length() int {
+	l := 0
+	if p.IsSetColumnEvaluators() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ColumnEvaluators {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *CheckExperimentNameRequest) field255Length() int {
+func (p *BatchGetExperimentResultResponse) field3Length() int {
 	l := 0
-	if p.IsSetBase() {
+	if p.IsSetExptColumnEvaluators() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ExptColumnEvaluators {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *CheckExperimentNameRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*CheckExperimentNameRequest)
+func (p *BatchGetExperimentResultResponse) field4Length() int {
+	l := 0
+	if p.IsSetExptColumnAnnotations() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ExptColumnAnnotations {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultResponse) field10Length() int {
+	l := 0
+	if p.IsSetItemResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ItemResults {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultResponse) field20Length() int {
+	l := 0
+	if p.IsSetTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *BatchGetExperimentResultResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *BatchGetExperimentResultResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchGetExperimentResultResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.WorkspaceID = src.WorkspaceID
+	if src.ColumnEvalSetFields != nil {
+		p.ColumnEvalSetFields = make([]*expt.ColumnEvalSetField, 0, len(src.ColumnEvalSetFields))
+		for _, elem := range src.ColumnEvalSetFields {
+			var _elem *expt.ColumnEvalSetField
+			if elem != nil {
+				_elem = &expt.ColumnEvalSetField{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
 
-	if src.Name != nil {
-		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
+			p.ColumnEvalSetFields = append(p.ColumnEvalSetFields, _elem)
 		}
-		p.Name = &tmp
 	}
 
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
+	if src.ColumnEvaluators != nil {
+		p.ColumnEvaluators = make([]*expt.ColumnEvaluator, 0, len(src.ColumnEvaluators))
+		for _, elem := range src.ColumnEvaluators {
+			var _elem *expt.ColumnEvaluator
+			if elem != nil {
+				_elem = &expt.ColumnEvaluator{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ColumnEvaluators = append(p.ColumnEvaluators, _elem)
+		}
+	}
+
+	if src.ExptColumnEvaluators != nil {
+		p.ExptColumnEvaluators = make([]*expt.ExptColumnEvaluator, 0, len(src.ExptColumnEvaluators))
+		for _, elem := range src.ExptColumnEvaluators {
+			var _elem *expt.ExptColumnEvaluator
+			if elem != nil {
+				_elem = &expt.ExptColumnEvaluator{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ExptColumnEvaluators = append(p.ExptColumnEvaluators, _elem)
+		}
+	}
+
+	if src.ExptColumnAnnotations != nil {
+		p.ExptColumnAnnotations = make([]*expt.ExptColumnAnnotation, 0, len(src.ExptColumnAnnotations))
+		for _, elem := range src.ExptColumnAnnotations {
+			var _elem *expt.ExptColumnAnnotation
+			if elem != nil {
+				_elem = &expt.ExptColumnAnnotation{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ExptColumnAnnotations = append(p.ExptColumnAnnotations, _elem)
+		}
+	}
+
+	if src.ItemResults != nil {
+		p.ItemResults = make([]*expt.ItemResult_, 0, len(src.ItemResults))
+		for _, elem := range src.ItemResults {
+			var _elem *expt.ItemResult_
+			if elem != nil {
+				_elem = &expt.ItemResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ItemResults = append(p.ItemResults, _elem)
+		}
+	}
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
 			return err
 		}
 	}
-	p.Base = _base
+	p.BaseResp = _baseResp
 
 	return nil
 }
 
-func (p *CheckExperimentNameResponse) FastRead(buf []byte) (int, error) {
+func (p *BatchGetExperimentAggrResultRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExperimentIds bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -9164,12 +9275,13 @@ func (p *CheckExperimentNameResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -9178,12 +9290,13 @@ func (p *CheckExperimentNameResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetExperimentIds = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -9214,60 +9327,81 @@ func (p *CheckExperimentNameResponse) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExperimentIds {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CheckExperimentNameResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentAggrResultRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchGetExperimentAggrResultRequest[fieldId]))
 }
 
-func (p *CheckExperimentNameResponse) FastReadField1(buf []byte) (int, error) {
+func (p *BatchGetExperimentAggrResultRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.Pass = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *CheckExperimentNameResponse) FastReadField2(buf []byte) (int, error) {
+func (p *BatchGetExperimentAggrResultRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.Message = _field
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ExperimentIds = _field
 	return offset, nil
 }
 
-func (p *CheckExperimentNameResponse) FastReadField255(buf []byte) (int, error) {
+func (p *BatchGetExperimentAggrResultRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *CheckExperimentNameResponse) FastWrite(buf []byte) int {
+func (p *BatchGetExperimentAggrResultRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CheckExperimentNameResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentAggrResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -9278,7 +9412,7 @@ func (p *CheckExperimentNameResponse) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *CheckExperimentNameResponse) BLength() int {
+func (p *BatchGetExperimentAggrResultRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -9289,96 +9423,97 @@ func (p *CheckExperimentNameResponse) BLength() int {
 	return l
 }
 
-func (p *CheckExperimentNameResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentAggrResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPass() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 1)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.Pass)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *CheckExperimentNameResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentAggrResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetMessage() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Message)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.ExperimentIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
 	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	return offset
 }
 
-func (p *CheckExperimentNameResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentAggrResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
 	return offset
 }
 
-func (p *CheckExperimentNameResponse) field1Length() int {
+func (p *BatchGetExperimentAggrResultRequest) field1Length() int {
 	l := 0
-	if p.IsSetPass() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *CheckExperimentNameResponse) field2Length() int {
+func (p *BatchGetExperimentAggrResultRequest) field2Length() int {
 	l := 0
-	if p.IsSetMessage() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Message)
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.ExperimentIds)
 	return l
 }
 
-func (p *CheckExperimentNameResponse) field255Length() int {
+func (p *BatchGetExperimentAggrResultRequest) field255Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
 	return l
 }
 
-func (p *CheckExperimentNameResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*CheckExperimentNameResponse)
+func (p *BatchGetExperimentAggrResultRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchGetExperimentAggrResultRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Pass != nil {
-		tmp := *src.Pass
-		p.Pass = &tmp
-	}
+	p.WorkspaceID = src.WorkspaceID
 
-	if src.Message != nil {
-		var tmp string
-		if *src.Message != "" {
-			tmp = kutils.StringDeepCopy(*src.Message)
+	if src.ExperimentIds != nil {
+		p.ExperimentIds = make([]int64, 0, len(src.ExperimentIds))
+		for _, elem := range src.ExperimentIds {
+			var _elem int64
+			_elem = elem
+			p.ExperimentIds = append(p.ExperimentIds, _elem)
 		}
-		p.Message = &tmp
 	}
 
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Base = _base
 
 	return nil
 }
 
-func (p *InvokeExperimentRequest) FastRead(buf []byte) (int, error) {
+func (p *BatchGetExperimentAggrResultResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetEvaluationSetID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -9390,122 +9525,8 @@ func (p *InvokeExperimentRequest) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetWorkspaceID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetEvaluationSetID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
 			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 10:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField10(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 11:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField11(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 20:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField20(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 21:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField21(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField100(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -9540,64 +9561,25 @@ func (p *InvokeExperimentRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluationSetID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InvokeExperimentRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentAggrResultResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_InvokeExperimentRequest[fieldId]))
 }
 
-func (p *InvokeExperimentRequest) FastReadField1(buf []byte) (int, error) {
+func (p *BatchGetExperimentAggrResultResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = v
 	}
-	p.WorkspaceID = _field
-	return offset, nil
-}
-
-func (p *InvokeExperimentRequest) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.EvaluationSetID = _field
-	return offset, nil
-}
-
-func (p *InvokeExperimentRequest) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*eval_set.EvaluationSetItem, 0, size)
-	values := make([]eval_set.EvaluationSetItem, size)
+	_field := make([]*expt.ExptAggregateResult_, 0, size)
+	values := make([]expt.ExptAggregateResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -9609,184 +9591,54 @@ func (p *InvokeExperimentRequest) FastReadField3(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.Items = _field
-	return offset, nil
-}
-
-func (p *InvokeExperimentRequest) FastReadField10(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.SkipInvalidItems = _field
-	return offset, nil
-}
-
-func (p *InvokeExperimentRequest) FastReadField11(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.AllowPartialAdd = _field
-	return offset, nil
-}
-
-func (p *InvokeExperimentRequest) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ExperimentID = _field
-	return offset, nil
-}
-
-func (p *InvokeExperimentRequest) FastReadField21(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ExperimentRunID = _field
-	return offset, nil
-}
-
-func (p *InvokeExperimentRequest) FastReadField100(buf []byte) (int, error) {
-	offset := 0
-
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		var _val string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	p.Ext = _field
-	return offset, nil
-}
-
-func (p *InvokeExperimentRequest) FastReadField200(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Session = _field
+	p.ExptAggregateResults = _field
 	return offset, nil
 }
 
-func (p *InvokeExperimentRequest) FastReadField255(buf []byte) (int, error) {
+func (p *BatchGetExperimentAggrResultResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.BaseResp = _field
 	return offset, nil
 }
 
-func (p *InvokeExperimentRequest) FastWrite(buf []byte) int {
+func (p *BatchGetExperimentAggrResultResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *InvokeExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentAggrResultResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
-		offset += p.fastWriteField11(buf[offset:], w)
-		offset += p.fastWriteField20(buf[offset:], w)
-		offset += p.fastWriteField21(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField100(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *InvokeExperimentRequest) BLength() int {
+func (p *BatchGetExperimentAggrResultResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field10Length()
-		l += p.field11Length()
-		l += p.field20Length()
-		l += p.field21Length()
-		l += p.field100Length()
-		l += p.field200Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *InvokeExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
-	return offset
-}
-
-func (p *InvokeExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
-	return offset
-}
-
-func (p *InvokeExperimentRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentAggrResultResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	if p.IsSetExptAggregateResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.Items {
+		for _, v := range p.ExptAggregateResults {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -9795,60 +9647,6717 @@ func (p *InvokeExperimentRequest) fastWriteField3(buf []byte, w thrift.NocopyWri
 	return offset
 }
 
-func (p *InvokeExperimentRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchGetExperimentAggrResultResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSkipInvalidItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 10)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.SkipInvalidItems)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *InvokeExperimentRequest) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetAllowPartialAdd() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 11)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.AllowPartialAdd)
+func (p *BatchGetExperimentAggrResultResponse) field1Length() int {
+	l := 0
+	if p.IsSetExptAggregateResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ExptAggregateResults {
+			_ = v
+			l += v.BLength()
+		}
 	}
-	return offset
+	return l
 }
 
-func (p *InvokeExperimentRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExperimentID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
-	}
-	return offset
+func (p *BatchGetExperimentAggrResultResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
 }
 
-func (p *InvokeExperimentRequest) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExperimentRunID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 21)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentRunID)
+func (p *BatchGetExperimentAggrResultResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchGetExperimentAggrResultResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	return offset
-}
 
-func (p *InvokeExperimentRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 100)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.Ext {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	if src.ExptAggregateResults != nil {
+		p.ExptAggregateResults = make([]*expt.ExptAggregateResult_, 0, len(src.ExptAggregateResults))
+		for _, elem := range src.ExptAggregateResults {
+			var _elem *expt.ExptAggregateResult_
+			if elem != nil {
+				_elem = &expt.ExptAggregateResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ExptAggregateResults = append(p.ExptAggregateResults, _elem)
 		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
 	}
-	return offset
-}
 
-func (p *InvokeExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *CompareExperimentsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetBaselineExptID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetBaselineExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetBaselineExptID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CompareExperimentsRequest[fieldId]))
+}
+
+func (p *CompareExperimentsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.BaselineExptID = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.EvaluatorVersionIds = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ConfidenceLevel = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.BootstrapIterations = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.RegressionThreshold = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CompareExperimentsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CompareExperimentsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CompareExperimentsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.BaselineExptID)
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorVersionIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.EvaluatorVersionIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetConfidenceLevel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.ConfidenceLevel)
+	}
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBootstrapIterations() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.BootstrapIterations)
+	}
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRegressionThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 7)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.RegressionThreshold)
+	}
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CompareExperimentsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CompareExperimentsRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CompareExperimentsRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CompareExperimentsRequest) field4Length() int {
+	l := 0
+	if p.IsSetEvaluatorVersionIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.EvaluatorVersionIds)
+	}
+	return l
+}
+
+func (p *CompareExperimentsRequest) field5Length() int {
+	l := 0
+	if p.IsSetConfidenceLevel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *CompareExperimentsRequest) field6Length() int {
+	l := 0
+	if p.IsSetBootstrapIterations() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *CompareExperimentsRequest) field7Length() int {
+	l := 0
+	if p.IsSetRegressionThreshold() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *CompareExperimentsRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *CompareExperimentsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*CompareExperimentsRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	p.BaselineExptID = src.BaselineExptID
+
+	if src.EvaluatorVersionIds != nil {
+		p.EvaluatorVersionIds = make([]int64, 0, len(src.EvaluatorVersionIds))
+		for _, elem := range src.EvaluatorVersionIds {
+			var _elem int64
+			_elem = elem
+			p.EvaluatorVersionIds = append(p.EvaluatorVersionIds, _elem)
+		}
+	}
+
+	if src.ConfidenceLevel != nil {
+		tmp := *src.ConfidenceLevel
+		p.ConfidenceLevel = &tmp
+	}
+
+	if src.BootstrapIterations != nil {
+		tmp := *src.BootstrapIterations
+		p.BootstrapIterations = &tmp
+	}
+
+	if src.RegressionThreshold != nil {
+		tmp := *src.RegressionThreshold
+		p.RegressionThreshold = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *CompareExperimentsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CompareExperimentsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*expt.EvaluatorCompareResult_, 0, size)
+	values := make([]expt.EvaluatorCompareResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.EvaluatorCompareResults = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CompareExperimentsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CompareExperimentsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CompareExperimentsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorCompareResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.EvaluatorCompareResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *CompareExperimentsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CompareExperimentsResponse) field1Length() int {
+	l := 0
+	if p.IsSetEvaluatorCompareResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.EvaluatorCompareResults {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *CompareExperimentsResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *CompareExperimentsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*CompareExperimentsResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.EvaluatorCompareResults != nil {
+		p.EvaluatorCompareResults = make([]*expt.EvaluatorCompareResult_, 0, len(src.EvaluatorCompareResults))
+		for _, elem := range src.EvaluatorCompareResults {
+			var _elem *expt.EvaluatorCompareResult_
+			if elem != nil {
+				_elem = &expt.EvaluatorCompareResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.EvaluatorCompareResults = append(p.EvaluatorCompareResults, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *CheckExperimentNameRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CheckExperimentNameRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CheckExperimentNameRequest[fieldId]))
+}
+
+func (p *CheckExperimentNameRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *CheckExperimentNameRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *CheckExperimentNameRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *CheckExperimentNameRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CheckExperimentNameRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CheckExperimentNameRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CheckExperimentNameRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *CheckExperimentNameRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *CheckExperimentNameRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CheckExperimentNameRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CheckExperimentNameRequest) field2Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
+	}
+	return l
+}
+
+func (p *CheckExperimentNameRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *CheckExperimentNameRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*CheckExperimentNameRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *CheckExperimentNameResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CheckExperimentNameResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CheckExperimentNameResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Pass = _field
+	return offset, nil
+}
+
+func (p *CheckExperimentNameResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Message = _field
+	return offset, nil
+}
+
+func (p *CheckExperimentNameResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *CheckExperimentNameResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CheckExperimentNameResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CheckExperimentNameResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CheckExperimentNameResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPass() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 1)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Pass)
+	}
+	return offset
+}
+
+func (p *CheckExperimentNameResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMessage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Message)
+	}
+	return offset
+}
+
+func (p *CheckExperimentNameResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CheckExperimentNameResponse) field1Length() int {
+	l := 0
+	if p.IsSetPass() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *CheckExperimentNameResponse) field2Length() int {
+	l := 0
+	if p.IsSetMessage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Message)
+	}
+	return l
+}
+
+func (p *CheckExperimentNameResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *CheckExperimentNameResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*CheckExperimentNameResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Pass != nil {
+		tmp := *src.Pass
+		p.Pass = &tmp
+	}
+
+	if src.Message != nil {
+		var tmp string
+		if *src.Message != "" {
+			tmp = kutils.StringDeepCopy(*src.Message)
+		}
+		p.Message = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *InvokeExperimentRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 21:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField21(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField100(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InvokeExperimentRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_InvokeExperimentRequest[fieldId]))
+}
+
+func (p *InvokeExperimentRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*eval_set.EvaluationSetItem, 0, size)
+	values := make([]eval_set.EvaluationSetItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Items = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SkipInvalidItems = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.AllowPartialAdd = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExperimentID = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField21(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExperimentRunID = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField100(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.Ext = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *InvokeExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField21(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *InvokeExperimentRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field10Length()
+		l += p.field11Length()
+		l += p.field20Length()
+		l += p.field21Length()
+		l += p.field100Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *InvokeExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Items {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSkipInvalidItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 10)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.SkipInvalidItems)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAllowPartialAdd() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 11)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.AllowPartialAdd)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentRunID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 21)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentRunID)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 100)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Ext {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *InvokeExperimentRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *InvokeExperimentRequest) field3Length() int {
+	l := 0
+	if p.IsSetItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Items {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *InvokeExperimentRequest) field10Length() int {
+	l := 0
+	if p.IsSetSkipInvalidItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *InvokeExperimentRequest) field11Length() int {
+	l := 0
+	if p.IsSetAllowPartialAdd() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *InvokeExperimentRequest) field20Length() int {
+	l := 0
+	if p.IsSetExperimentID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *InvokeExperimentRequest) field21Length() int {
+	l := 0
+	if p.IsSetExperimentRunID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *InvokeExperimentRequest) field100Length() int {
+	l := 0
+	if p.IsSetExt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Ext {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *InvokeExperimentRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *InvokeExperimentRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *InvokeExperimentRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*InvokeExperimentRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	if src.Items != nil {
+		p.Items = make([]*eval_set.EvaluationSetItem, 0, len(src.Items))
+		for _, elem := range src.Items {
+			var _elem *eval_set.EvaluationSetItem
+			if elem != nil {
+				_elem = &eval_set.EvaluationSetItem{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Items = append(p.Items, _elem)
+		}
+	}
+
+	if src.SkipInvalidItems != nil {
+		tmp := *src.SkipInvalidItems
+		p.SkipInvalidItems = &tmp
+	}
+
+	if src.AllowPartialAdd != nil {
+		tmp := *src.AllowPartialAdd
+		p.AllowPartialAdd = &tmp
+	}
+
+	if src.ExperimentID != nil {
+		tmp := *src.ExperimentID
+		p.ExperimentID = &tmp
+	}
+
+	if src.ExperimentRunID != nil {
+		tmp := *src.ExperimentRunID
+		p.ExperimentRunID = &tmp
+	}
+
+	if src.Ext != nil {
+		p.Ext = make(map[string]string, len(src.Ext))
+		for key, val := range src.Ext {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val string
+			if val != "" {
+				_val = kutils.StringDeepCopy(val)
+			}
+
+			p.Ext[_key] = _val
+		}
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *InvokeExperimentResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InvokeExperimentResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *InvokeExperimentResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.AddedItems = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*dataset.ItemErrorGroup, 0, size)
+	values := make([]dataset.ItemErrorGroup, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Errors = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *InvokeExperimentResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *InvokeExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *InvokeExperimentResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *InvokeExperimentResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAddedItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 1)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.AddedItems {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], k)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetErrors() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Errors {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *InvokeExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *InvokeExperimentResponse) field1Length() int {
+	l := 0
+	if p.IsSetAddedItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		l += (thrift.Binary.I64Length() +
+			thrift.Binary.I64Length()) * len(p.AddedItems)
+	}
+	return l
+}
+
+func (p *InvokeExperimentResponse) field2Length() int {
+	l := 0
+	if p.IsSetErrors() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Errors {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *InvokeExperimentResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *InvokeExperimentResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*InvokeExperimentResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.AddedItems != nil {
+		p.AddedItems = make(map[int64]int64, len(src.AddedItems))
+		for key, val := range src.AddedItems {
+			var _key int64
+			_key = key
+
+			var _val int64
+			_val = val
+
+			p.AddedItems[_key] = _val
+		}
+	}
+
+	if src.Errors != nil {
+		p.Errors = make([]*dataset.ItemErrorGroup, 0, len(src.Errors))
+		for _, elem := range src.Errors {
+			var _elem *dataset.ItemErrorGroup
+			if elem != nil {
+				_elem = &dataset.ItemErrorGroup{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Errors = append(p.Errors, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *FinishExperimentRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 100:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField100(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FinishExperimentRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *FinishExperimentRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *FinishExperimentRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExperimentID = _field
+	return offset, nil
+}
+
+func (p *FinishExperimentRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExperimentRunID = _field
+	return offset, nil
+}
+
+func (p *FinishExperimentRequest) FastReadField100(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Cid = _field
+	return offset, nil
+}
+
+func (p *FinishExperimentRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *FinishExperimentRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *FinishExperimentRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FinishExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FinishExperimentRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field100Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FinishExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	}
+	return offset
+}
+
+func (p *FinishExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
+	}
+	return offset
+}
+
+func (p *FinishExperimentRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentRunID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentRunID)
+	}
+	return offset
+}
+
+func (p *FinishExperimentRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCid() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 100)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Cid)
+	}
+	return offset
+}
+
+func (p *FinishExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *FinishExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *FinishExperimentRequest) field1Length() int {
+	l := 0
+	if p.IsSetWorkspaceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *FinishExperimentRequest) field2Length() int {
+	l := 0
+	if p.IsSetExperimentID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *FinishExperimentRequest) field3Length() int {
+	l := 0
+	if p.IsSetExperimentRunID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *FinishExperimentRequest) field100Length() int {
+	l := 0
+	if p.IsSetCid() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Cid)
+	}
+	return l
+}
+
+func (p *FinishExperimentRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *FinishExperimentRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *FinishExperimentRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*FinishExperimentRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	if src.ExperimentID != nil {
+		tmp := *src.ExperimentID
+		p.ExperimentID = &tmp
+	}
+
+	if src.ExperimentRunID != nil {
+		tmp := *src.ExperimentRunID
+		p.ExperimentRunID = &tmp
+	}
+
+	if src.Cid != nil {
+		var tmp string
+		if *src.Cid != "" {
+			tmp = kutils.StringDeepCopy(*src.Cid)
+		}
+		p.Cid = &tmp
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *FinishExperimentResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FinishExperimentResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *FinishExperimentResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *FinishExperimentResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FinishExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FinishExperimentResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FinishExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *FinishExperimentResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *FinishExperimentResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*FinishExperimentResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListExperimentStatsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 300:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField300(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExperimentStatsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExperimentStatsRequest[fieldId]))
+}
+
+func (p *ListExperimentStatsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageNumber = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsRequest) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptFilterOption()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.FilterOption = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsRequest) FastReadField300(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListExperimentStatsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField300(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListExperimentStatsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field20Length()
+		l += p.field300Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListExperimentStatsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListExperimentStatsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	}
+	return offset
+}
+
+func (p *ListExperimentStatsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListExperimentStatsRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilterOption() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 20)
+		offset += p.FilterOption.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListExperimentStatsRequest) fastWriteField300(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 300)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListExperimentStatsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListExperimentStatsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListExperimentStatsRequest) field2Length() int {
+	l := 0
+	if p.IsSetPageNumber() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListExperimentStatsRequest) field3Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListExperimentStatsRequest) field20Length() int {
+	l := 0
+	if p.IsSetFilterOption() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.FilterOption.BLength()
+	}
+	return l
+}
+
+func (p *ListExperimentStatsRequest) field300Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *ListExperimentStatsRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListExperimentStatsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExperimentStatsRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
+	}
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	var _filterOption *expt.ExptFilterOption
+	if src.FilterOption != nil {
+		_filterOption = &expt.ExptFilterOption{}
+		if err := _filterOption.DeepCopy(src.FilterOption); err != nil {
+			return err
+		}
+	}
+	p.FilterOption = _filterOption
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListExperimentStatsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExperimentStatsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListExperimentStatsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*expt.ExptStatsInfo, 0, size)
+	values := make([]expt.ExptStatsInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ExptStatsInfos = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Total = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListExperimentStatsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListExperimentStatsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListExperimentStatsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListExperimentStatsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExptStatsInfos() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ExptStatsInfos {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListExperimentStatsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *ListExperimentStatsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ListExperimentStatsResponse) field1Length() int {
+	l := 0
+	if p.IsSetExptStatsInfos() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ExptStatsInfos {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListExperimentStatsResponse) field2Length() int {
+	l := 0
+	if p.IsSetTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListExperimentStatsResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ListExperimentStatsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExperimentStatsResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ExptStatsInfos != nil {
+		p.ExptStatsInfos = make([]*expt.ExptStatsInfo, 0, len(src.ExptStatsInfos))
+		for _, elem := range src.ExptStatsInfos {
+			var _elem *expt.ExptStatsInfo
+			if elem != nil {
+				_elem = &expt.ExptStatsInfo{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ExptStatsInfos = append(p.ExptStatsInfos, _elem)
+		}
+	}
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *UpsertExptTurnResultFilterRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpsertExptTurnResultFilterRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpsertExptTurnResultFilterRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *UpsertExptTurnResultFilterRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExperimentID = _field
+	return offset, nil
+}
+
+func (p *UpsertExptTurnResultFilterRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ItemIds = _field
+	return offset, nil
+}
+
+func (p *UpsertExptTurnResultFilterRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *UpsertExptTurnResultFilterType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FilterType = _field
+	return offset, nil
+}
+
+func (p *UpsertExptTurnResultFilterRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.RetryTimes = _field
+	return offset, nil
+}
+
+func (p *UpsertExptTurnResultFilterRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpsertExptTurnResultFilterRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpsertExptTurnResultFilterRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpsertExptTurnResultFilterRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	}
+	return offset
+}
+
+func (p *UpsertExptTurnResultFilterRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
+	}
+	return offset
+}
+
+func (p *UpsertExptTurnResultFilterRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ItemIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *UpsertExptTurnResultFilterRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilterType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FilterType)
+	}
+	return offset
+}
+
+func (p *UpsertExptTurnResultFilterRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRetryTimes() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.RetryTimes)
+	}
+	return offset
+}
+
+func (p *UpsertExptTurnResultFilterRequest) field1Length() int {
+	l := 0
+	if p.IsSetWorkspaceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *UpsertExptTurnResultFilterRequest) field2Length() int {
+	l := 0
+	if p.IsSetExperimentID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *UpsertExptTurnResultFilterRequest) field3Length() int {
+	l := 0
+	if p.IsSetItemIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.ItemIds)
+	}
+	return l
+}
+
+func (p *UpsertExptTurnResultFilterRequest) field4Length() int {
+	l := 0
+	if p.IsSetFilterType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FilterType)
+	}
+	return l
+}
+
+func (p *UpsertExptTurnResultFilterRequest) field5Length() int {
+	l := 0
+	if p.IsSetRetryTimes() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *UpsertExptTurnResultFilterRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpsertExptTurnResultFilterRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	if src.ExperimentID != nil {
+		tmp := *src.ExperimentID
+		p.ExperimentID = &tmp
+	}
+
+	if src.ItemIds != nil {
+		p.ItemIds = make([]int64, 0, len(src.ItemIds))
+		for _, elem := range src.ItemIds {
+			var _elem int64
+			_elem = elem
+			p.ItemIds = append(p.ItemIds, _elem)
+		}
+	}
+
+	if src.FilterType != nil {
+		tmp := *src.FilterType
+		p.FilterType = &tmp
+	}
+
+	if src.RetryTimes != nil {
+		tmp := *src.RetryTimes
+		p.RetryTimes = &tmp
+	}
+
+	return nil
+}
+
+func (p *UpsertExptTurnResultFilterResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpsertExptTurnResultFilterResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpsertExptTurnResultFilterResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *UpsertExptTurnResultFilterResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpsertExptTurnResultFilterResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpsertExptTurnResultFilterResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpsertExptTurnResultFilterResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpsertExptTurnResultFilterResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *UpsertExptTurnResultFilterResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpsertExptTurnResultFilterResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *AssociateAnnotationTagReq) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AssociateAnnotationTagReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_AssociateAnnotationTagReq[fieldId]))
+}
+
+func (p *AssociateAnnotationTagReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *AssociateAnnotationTagReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *AssociateAnnotationTagReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TagKeyID = _field
+	return offset, nil
+}
+
+func (p *AssociateAnnotationTagReq) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *AssociateAnnotationTagReq) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *AssociateAnnotationTagReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *AssociateAnnotationTagReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *AssociateAnnotationTagReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *AssociateAnnotationTagReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *AssociateAnnotationTagReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *AssociateAnnotationTagReq) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTagKeyID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TagKeyID)
+	}
+	return offset
+}
+
+func (p *AssociateAnnotationTagReq) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *AssociateAnnotationTagReq) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *AssociateAnnotationTagReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *AssociateAnnotationTagReq) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *AssociateAnnotationTagReq) field3Length() int {
+	l := 0
+	if p.IsSetTagKeyID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *AssociateAnnotationTagReq) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *AssociateAnnotationTagReq) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *AssociateAnnotationTagReq) DeepCopy(s interface{}) error {
+	src, ok := s.(*AssociateAnnotationTagReq)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	if src.TagKeyID != nil {
+		tmp := *src.TagKeyID
+		p.TagKeyID = &tmp
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *AssociateAnnotationTagResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AssociateAnnotationTagResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *AssociateAnnotationTagResp) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *AssociateAnnotationTagResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *AssociateAnnotationTagResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *AssociateAnnotationTagResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *AssociateAnnotationTagResp) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *AssociateAnnotationTagResp) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *AssociateAnnotationTagResp) DeepCopy(s interface{}) error {
+	src, ok := s.(*AssociateAnnotationTagResp)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *DeleteAnnotationTagReq) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteAnnotationTagReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DeleteAnnotationTagReq[fieldId]))
+}
+
+func (p *DeleteAnnotationTagReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *DeleteAnnotationTagReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *DeleteAnnotationTagReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TagKeyID = _field
+	return offset, nil
+}
+
+func (p *DeleteAnnotationTagReq) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *DeleteAnnotationTagReq) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *DeleteAnnotationTagReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DeleteAnnotationTagReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DeleteAnnotationTagReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DeleteAnnotationTagReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *DeleteAnnotationTagReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *DeleteAnnotationTagReq) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTagKeyID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TagKeyID)
+	}
+	return offset
+}
+
+func (p *DeleteAnnotationTagReq) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DeleteAnnotationTagReq) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DeleteAnnotationTagReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *DeleteAnnotationTagReq) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *DeleteAnnotationTagReq) field3Length() int {
+	l := 0
+	if p.IsSetTagKeyID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *DeleteAnnotationTagReq) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *DeleteAnnotationTagReq) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *DeleteAnnotationTagReq) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteAnnotationTagReq)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	if src.TagKeyID != nil {
+		tmp := *src.TagKeyID
+		p.TagKeyID = &tmp
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *DeleteAnnotationTagResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteAnnotationTagResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *DeleteAnnotationTagResp) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *DeleteAnnotationTagResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DeleteAnnotationTagResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DeleteAnnotationTagResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DeleteAnnotationTagResp) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *DeleteAnnotationTagResp) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *DeleteAnnotationTagResp) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteAnnotationTagResp)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *CreateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetAnnotateRecord bool = false
+	var issetItemID bool = false
+	var issetTurnID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetAnnotateRecord = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetTurnID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAnnotateRecord {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTurnID {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateAnnotateRecordReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CreateAnnotateRecordReq[fieldId]))
+}
+
+func (p *CreateAnnotateRecordReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewAnnotateRecord()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.AnnotateRecord = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordReq) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordReq) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.TurnID = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordReq) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordReq) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CreateAnnotateRecordReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CreateAnnotateRecordReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CreateAnnotateRecordReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *CreateAnnotateRecordReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *CreateAnnotateRecordReq) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+	offset += p.AnnotateRecord.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CreateAnnotateRecordReq) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	return offset
+}
+
+func (p *CreateAnnotateRecordReq) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.TurnID)
+	return offset
+}
+
+func (p *CreateAnnotateRecordReq) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateAnnotateRecordReq) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateAnnotateRecordReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CreateAnnotateRecordReq) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CreateAnnotateRecordReq) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.AnnotateRecord.BLength()
+	return l
+}
+
+func (p *CreateAnnotateRecordReq) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CreateAnnotateRecordReq) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CreateAnnotateRecordReq) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *CreateAnnotateRecordReq) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *CreateAnnotateRecordReq) DeepCopy(s interface{}) error {
+	src, ok := s.(*CreateAnnotateRecordReq)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	var _annotateRecord *expt.AnnotateRecord
+	if src.AnnotateRecord != nil {
+		_annotateRecord = &expt.AnnotateRecord{}
+		if err := _annotateRecord.DeepCopy(src.AnnotateRecord); err != nil {
+			return err
+		}
+	}
+	p.AnnotateRecord = _annotateRecord
+
+	p.ItemID = src.ItemID
+
+	p.TurnID = src.TurnID
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *CreateAnnotateRecordResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAnnotateRecordID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetAnnotateRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetAnnotateRecordID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateAnnotateRecordResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CreateAnnotateRecordResp[fieldId]))
+}
+
+func (p *CreateAnnotateRecordResp) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.AnnotateRecordID = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordResp) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *CreateAnnotateRecordResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CreateAnnotateRecordResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CreateAnnotateRecordResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CreateAnnotateRecordResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.AnnotateRecordID)
+	return offset
+}
+
+func (p *CreateAnnotateRecordResp) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CreateAnnotateRecordResp) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CreateAnnotateRecordResp) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *CreateAnnotateRecordResp) DeepCopy(s interface{}) error {
+	src, ok := s.(*CreateAnnotateRecordResp)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.AnnotateRecordID = src.AnnotateRecordID
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetAnnotateRecords bool = false
+	var issetAnnotateRecordID bool = false
+	var issetItemID bool = false
+	var issetTurnID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetAnnotateRecords = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetAnnotateRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetTurnID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAnnotateRecords {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAnnotateRecordID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemID {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTurnID {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateAnnotateRecordReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateAnnotateRecordReq[fieldId]))
+}
+
+func (p *UpdateAnnotateRecordReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewAnnotateRecord()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.AnnotateRecords = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.AnnotateRecordID = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.TurnID = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateAnnotateRecordReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+	offset += p.AnnotateRecords.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.AnnotateRecordID)
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.TurnID)
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpdateAnnotateRecordReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.AnnotateRecords.BLength()
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) field6Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *UpdateAnnotateRecordReq) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateAnnotateRecordReq)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	var _annotateRecords *expt.AnnotateRecord
+	if src.AnnotateRecords != nil {
+		_annotateRecords = &expt.AnnotateRecord{}
+		if err := _annotateRecords.DeepCopy(src.AnnotateRecords); err != nil {
+			return err
+		}
+	}
+	p.AnnotateRecords = _annotateRecords
+
+	p.AnnotateRecordID = src.AnnotateRecordID
+
+	p.ItemID = src.ItemID
+
+	p.TurnID = src.TurnID
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *UpdateAnnotateRecordResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateAnnotateRecordResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpdateAnnotateRecordResp) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *UpdateAnnotateRecordResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateAnnotateRecordResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateAnnotateRecordResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateAnnotateRecordResp) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpdateAnnotateRecordResp) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *UpdateAnnotateRecordResp) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateAnnotateRecordResp)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ExportExptResultRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportExptResultRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportExptResultRequest[fieldId]))
+}
+
+func (p *ExportExptResultRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ExportExptResultRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *ExportExptResultRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *expt.ExptResultExportType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExportType = _field
+	return offset, nil
+}
+
+func (p *ExportExptResultRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *ExportExptResultRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ExportExptResultRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExportExptResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExportExptResultRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field4Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExportExptResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ExportExptResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *ExportExptResultRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExportType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ExportType)
+	}
+	return offset
+}
+
+func (p *ExportExptResultRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExportExptResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExportExptResultRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportExptResultRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportExptResultRequest) field4Length() int {
+	l := 0
+	if p.IsSetExportType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ExportType)
+	}
+	return l
+}
+
+func (p *ExportExptResultRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *ExportExptResultRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ExportExptResultRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExportExptResultRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	if src.ExportType != nil {
+		tmp := *src.ExportType
+		p.ExportType = &tmp
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ExportExptResultResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetExportID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExportID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetExportID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportExptResultResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportExptResultResponse[fieldId]))
+}
+
+func (p *ExportExptResultResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExportID = _field
+	return offset, nil
+}
+
+func (p *ExportExptResultResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ExportExptResultResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExportExptResultResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExportExptResultResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExportExptResultResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExportID)
+	return offset
+}
+
+func (p *ExportExptResultResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExportExptResultResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportExptResultResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ExportExptResultResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExportExptResultResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.ExportID = src.ExportID
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListExptResultExportRecordRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptResultExportRecordRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptResultExportRecordRequest[fieldId]))
+}
+
+func (p *ListExptResultExportRecordRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListExptResultExportRecordRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *ListExptResultExportRecordRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageNumber = _field
+	return offset, nil
+}
+
+func (p *ListExptResultExportRecordRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListExptResultExportRecordRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *ListExptResultExportRecordRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListExptResultExportRecordRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListExptResultExportRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListExptResultExportRecordRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListExptResultExportRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListExptResultExportRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *ListExptResultExportRecordRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	}
+	return offset
+}
+
+func (p *ListExptResultExportRecordRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListExptResultExportRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
@@ -9857,7 +16366,7 @@ func (p *InvokeExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *InvokeExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptResultExportRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -9866,85 +16375,39 @@ func (p *InvokeExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *InvokeExperimentRequest) field1Length() int {
+func (p *ListExptResultExportRecordRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *InvokeExperimentRequest) field2Length() int {
+func (p *ListExptResultExportRecordRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *InvokeExperimentRequest) field3Length() int {
-	l := 0
-	if p.IsSetItems() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Items {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *InvokeExperimentRequest) field10Length() int {
-	l := 0
-	if p.IsSetSkipInvalidItems() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
-	}
-	return l
-}
-
-func (p *InvokeExperimentRequest) field11Length() int {
-	l := 0
-	if p.IsSetAllowPartialAdd() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
-	}
-	return l
-}
-
-func (p *InvokeExperimentRequest) field20Length() int {
-	l := 0
-	if p.IsSetExperimentID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *InvokeExperimentRequest) field21Length() int {
+func (p *ListExptResultExportRecordRequest) field3Length() int {
 	l := 0
-	if p.IsSetExperimentRunID() {
+	if p.IsSetPageNumber() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *InvokeExperimentRequest) field100Length() int {
+func (p *ListExptResultExportRecordRequest) field4Length() int {
 	l := 0
-	if p.IsSetExt() {
+	if p.IsSetPageSize() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.Ext {
-			_, _ = k, v
-
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += thrift.Binary.StringLengthNocopy(v)
-		}
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *InvokeExperimentRequest) field200Length() int {
+func (p *ListExptResultExportRecordRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
 		l += thrift.Binary.FieldBeginLength()
@@ -9953,7 +16416,7 @@ func (p *InvokeExperimentRequest) field200Length() int {
 	return l
 }
 
-func (p *InvokeExperimentRequest) field255Length() int {
+func (p *ListExptResultExportRecordRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -9962,66 +16425,24 @@ func (p *InvokeExperimentRequest) field255Length() int {
 	return l
 }
 
-func (p *InvokeExperimentRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*InvokeExperimentRequest)
+func (p *ListExptResultExportRecordRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptResultExportRecordRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.EvaluationSetID = src.EvaluationSetID
-
-	if src.Items != nil {
-		p.Items = make([]*eval_set.EvaluationSetItem, 0, len(src.Items))
-		for _, elem := range src.Items {
-			var _elem *eval_set.EvaluationSetItem
-			if elem != nil {
-				_elem = &eval_set.EvaluationSetItem{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Items = append(p.Items, _elem)
-		}
-	}
-
-	if src.SkipInvalidItems != nil {
-		tmp := *src.SkipInvalidItems
-		p.SkipInvalidItems = &tmp
-	}
-
-	if src.AllowPartialAdd != nil {
-		tmp := *src.AllowPartialAdd
-		p.AllowPartialAdd = &tmp
-	}
-
-	if src.ExperimentID != nil {
-		tmp := *src.ExperimentID
-		p.ExperimentID = &tmp
-	}
+	p.ExptID = src.ExptID
 
-	if src.ExperimentRunID != nil {
-		tmp := *src.ExperimentRunID
-		p.ExperimentRunID = &tmp
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
 	}
 
-	if src.Ext != nil {
-		p.Ext = make(map[string]string, len(src.Ext))
-		for key, val := range src.Ext {
-			var _key string
-			if key != "" {
-				_key = kutils.StringDeepCopy(key)
-			}
-
-			var _val string
-			if val != "" {
-				_val = kutils.StringDeepCopy(val)
-			}
-
-			p.Ext[_key] = _val
-		}
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
 	}
 
 	var _session *common.Session
@@ -10045,13 +16466,14 @@ func (p *InvokeExperimentRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *InvokeExperimentResponse) FastRead(buf []byte) (int, error) {
+func (p *ListExptResultExportRecordResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetExptResultExportRecords bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -10063,12 +16485,13 @@ func (p *InvokeExperimentResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetExptResultExportRecords = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -10076,9 +16499,9 @@ func (p *InvokeExperimentResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField20(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -10113,73 +16536,61 @@ func (p *InvokeExperimentResponse) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetExptResultExportRecords {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InvokeExperimentResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptResultExportRecordResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptResultExportRecordResponse[fieldId]))
 }
 
-func (p *InvokeExperimentResponse) FastReadField1(buf []byte) (int, error) {
+func (p *ListExptResultExportRecordResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
 	offset += l
 	if err != nil {
 		return offset, err
 	}
-	_field := make(map[int64]int64, size)
+	_field := make([]*expt.ExptResultExportRecord, 0, size)
+	values := make([]expt.ExptResultExportRecord, size)
 	for i := 0; i < size; i++ {
-		var _key int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		var _val int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
-			_val = v
 		}
 
-		_field[_key] = _val
+		_field = append(_field, _elem)
 	}
-	p.AddedItems = _field
+	p.ExptResultExportRecords = _field
 	return offset, nil
 }
 
-func (p *InvokeExperimentResponse) FastReadField2(buf []byte) (int, error) {
+func (p *ListExptResultExportRecordResponse) FastReadField20(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*dataset.ItemErrorGroup, 0, size)
-	values := make([]dataset.ItemErrorGroup, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.Errors = _field
+	p.Total = _field
 	return offset, nil
 }
 
-func (p *InvokeExperimentResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ListExptResultExportRecordResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -10191,137 +16602,115 @@ func (p *InvokeExperimentResponse) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *InvokeExperimentResponse) FastWrite(buf []byte) int {
+func (p *ListExptResultExportRecordResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *InvokeExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptResultExportRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *InvokeExperimentResponse) BLength() int {
+func (p *ListExptResultExportRecordResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
+		l += p.field20Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *InvokeExperimentResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptResultExportRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetAddedItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 1)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.AddedItems {
-			length++
-			offset += thrift.Binary.WriteI64(buf[offset:], k)
-			offset += thrift.Binary.WriteI64(buf[offset:], v)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.I64, length)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.ExptResultExportRecords {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
 	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *InvokeExperimentResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptResultExportRecordResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetErrors() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Errors {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
 	}
 	return offset
 }
 
-func (p *InvokeExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptResultExportRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *InvokeExperimentResponse) field1Length() int {
+func (p *ListExptResultExportRecordResponse) field1Length() int {
 	l := 0
-	if p.IsSetAddedItems() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		l += (thrift.Binary.I64Length() +
-			thrift.Binary.I64Length()) * len(p.AddedItems)
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.ExptResultExportRecords {
+		_ = v
+		l += v.BLength()
 	}
 	return l
 }
 
-func (p *InvokeExperimentResponse) field2Length() int {
+func (p *ListExptResultExportRecordResponse) field20Length() int {
 	l := 0
-	if p.IsSetErrors() {
+	if p.IsSetTotal() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Errors {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *InvokeExperimentResponse) field255Length() int {
+func (p *ListExptResultExportRecordResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *InvokeExperimentResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*InvokeExperimentResponse)
+func (p *ListExptResultExportRecordResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptResultExportRecordResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.AddedItems != nil {
-		p.AddedItems = make(map[int64]int64, len(src.AddedItems))
-		for key, val := range src.AddedItems {
-			var _key int64
-			_key = key
-
-			var _val int64
-			_val = val
-
-			p.AddedItems[_key] = _val
-		}
-	}
-
-	if src.Errors != nil {
-		p.Errors = make([]*dataset.ItemErrorGroup, 0, len(src.Errors))
-		for _, elem := range src.Errors {
-			var _elem *dataset.ItemErrorGroup
+	if src.ExptResultExportRecords != nil {
+		p.ExptResultExportRecords = make([]*expt.ExptResultExportRecord, 0, len(src.ExptResultExportRecords))
+		for _, elem := range src.ExptResultExportRecords {
+			var _elem *expt.ExptResultExportRecord
 			if elem != nil {
-				_elem = &dataset.ItemErrorGroup{}
+				_elem = &expt.ExptResultExportRecord{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.Errors = append(p.Errors, _elem)
+			p.ExptResultExportRecords = append(p.ExptResultExportRecords, _elem)
 		}
 	}
 
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -10334,13 +16723,16 @@ func (p *InvokeExperimentResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *FinishExperimentRequest) FastRead(buf []byte) (int, error) {
+func (p *GetExptResultExportRecordRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetExportID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -10358,6 +16750,7 @@ func (p *FinishExperimentRequest) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -10372,6 +16765,7 @@ func (p *FinishExperimentRequest) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetExptID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -10379,27 +16773,14 @@ func (p *FinishExperimentRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
+		case 4:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 100:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField100(buf[offset:])
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetExportID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -10444,72 +16825,74 @@ func (p *FinishExperimentRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExportID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FinishExperimentRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptResultExportRecordRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetExptResultExportRecordRequest[fieldId]))
 }
 
-func (p *FinishExperimentRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetExptResultExportRecordRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
 	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *FinishExperimentRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GetExptResultExportRecordRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.ExperimentID = _field
+	p.ExptID = _field
 	return offset, nil
 }
 
-func (p *FinishExperimentRequest) FastReadField3(buf []byte) (int, error) {
+func (p *GetExptResultExportRecordRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
-	}
-	p.ExperimentRunID = _field
-	return offset, nil
-}
-
-func (p *FinishExperimentRequest) FastReadField100(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+		_field = v
 	}
-	p.Cid = _field
+	p.ExportID = _field
 	return offset, nil
 }
 
-func (p *FinishExperimentRequest) FastReadField200(buf []byte) (int, error) {
+func (p *GetExptResultExportRecordRequest) FastReadField200(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewSession()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -10521,7 +16904,7 @@ func (p *FinishExperimentRequest) FastReadField200(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *FinishExperimentRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptResultExportRecordRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -10533,17 +16916,16 @@ func (p *FinishExperimentRequest) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *FinishExperimentRequest) FastWrite(buf []byte) int {
+func (p *GetExptResultExportRecordRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *FinishExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptResultExportRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -10551,13 +16933,12 @@ func (p *FinishExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 	return offset
 }
 
-func (p *FinishExperimentRequest) BLength() int {
+func (p *GetExptResultExportRecordRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field100Length()
+		l += p.field4Length()
 		l += p.field200Length()
 		l += p.field255Length()
 	}
@@ -10565,43 +16946,28 @@ func (p *FinishExperimentRequest) BLength() int {
 	return l
 }
 
-func (p *FinishExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetWorkspaceID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
-	}
-	return offset
-}
-
-func (p *FinishExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptResultExportRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExperimentID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *FinishExperimentRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptResultExportRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExperimentRunID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentRunID)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
 	return offset
 }
 
-func (p *FinishExperimentRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptResultExportRecordRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetCid() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 100)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Cid)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExportID)
 	return offset
 }
 
-func (p *FinishExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptResultExportRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
@@ -10610,7 +16976,7 @@ func (p *FinishExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *FinishExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptResultExportRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -10619,43 +16985,28 @@ func (p *FinishExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *FinishExperimentRequest) field1Length() int {
-	l := 0
-	if p.IsSetWorkspaceID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *FinishExperimentRequest) field2Length() int {
+func (p *GetExptResultExportRecordRequest) field1Length() int {
 	l := 0
-	if p.IsSetExperimentID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *FinishExperimentRequest) field3Length() int {
+func (p *GetExptResultExportRecordRequest) field2Length() int {
 	l := 0
-	if p.IsSetExperimentRunID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *FinishExperimentRequest) field100Length() int {
+func (p *GetExptResultExportRecordRequest) field4Length() int {
 	l := 0
-	if p.IsSetCid() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Cid)
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *FinishExperimentRequest) field200Length() int {
+func (p *GetExptResultExportRecordRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
 		l += thrift.Binary.FieldBeginLength()
@@ -10664,7 +17015,7 @@ func (p *FinishExperimentRequest) field200Length() int {
 	return l
 }
 
-func (p *FinishExperimentRequest) field255Length() int {
+func (p *GetExptResultExportRecordRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -10673,34 +17024,17 @@ func (p *FinishExperimentRequest) field255Length() int {
 	return l
 }
 
-func (p *FinishExperimentRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*FinishExperimentRequest)
+func (p *GetExptResultExportRecordRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptResultExportRecordRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.WorkspaceID != nil {
-		tmp := *src.WorkspaceID
-		p.WorkspaceID = &tmp
-	}
-
-	if src.ExperimentID != nil {
-		tmp := *src.ExperimentID
-		p.ExperimentID = &tmp
-	}
+	p.WorkspaceID = src.WorkspaceID
 
-	if src.ExperimentRunID != nil {
-		tmp := *src.ExperimentRunID
-		p.ExperimentRunID = &tmp
-	}
+	p.ExptID = src.ExptID
 
-	if src.Cid != nil {
-		var tmp string
-		if *src.Cid != "" {
-			tmp = kutils.StringDeepCopy(*src.Cid)
-		}
-		p.Cid = &tmp
-	}
+	p.ExportID = src.ExportID
 
 	var _session *common.Session
 	if src.Session != nil {
@@ -10723,7 +17057,7 @@ func (p *FinishExperimentRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *FinishExperimentResponse) FastRead(buf []byte) (int, error) {
+func (p *GetExptResultExportRecordResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -10740,6 +17074,20 @@ func (p *FinishExperimentResponse) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -10767,12 +17115,24 @@ func (p *FinishExperimentResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FinishExperimentResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptResultExportRecordResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *FinishExperimentResponse) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptResultExportRecordResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptResultExportRecord()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ExptResultExportRecord = _field
+	return offset, nil
+}
+
+func (p *GetExptResultExportRecordResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -10784,48 +17144,77 @@ func (p *FinishExperimentResponse) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *FinishExperimentResponse) FastWrite(buf []byte) int {
+func (p *GetExptResultExportRecordResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *FinishExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptResultExportRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *FinishExperimentResponse) BLength() int {
+func (p *GetExptResultExportRecordResponse) BLength() int {
 	l := 0
 	if p != nil {
+		l += p.field1Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *FinishExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptResultExportRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExptResultExportRecord() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.ExptResultExportRecord.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetExptResultExportRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *FinishExperimentResponse) field255Length() int {
+func (p *GetExptResultExportRecordResponse) field1Length() int {
+	l := 0
+	if p.IsSetExptResultExportRecord() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ExptResultExportRecord.BLength()
+	}
+	return l
+}
+
+func (p *GetExptResultExportRecordResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *FinishExperimentResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*FinishExperimentResponse)
+func (p *GetExptResultExportRecordResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptResultExportRecordResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
+	var _exptResultExportRecord *expt.ExptResultExportRecord
+	if src.ExptResultExportRecord != nil {
+		_exptResultExportRecord = &expt.ExptResultExportRecord{}
+		if err := _exptResultExportRecord.DeepCopy(src.ExptResultExportRecord); err != nil {
+			return err
+		}
+	}
+	p.ExptResultExportRecord = _exptResultExportRecord
+
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -10838,7 +17227,7 @@ func (p *FinishExperimentResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListExperimentStatsRequest) FastRead(buf []byte) (int, error) {
+func (p *CreateExptScheduleRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -10846,6 +17235,9 @@ func (p *ListExperimentStatsRequest) FastRead(buf []byte) (int, error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
+	var issetName bool = false
+	var issetTemplateExptID bool = false
+	var issetCronExpr bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -10872,12 +17264,13 @@ func (p *ListExperimentStatsRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetName = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -10886,7 +17279,7 @@ func (p *ListExperimentStatsRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -10899,13 +17292,14 @@ func (p *ListExperimentStatsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 20:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField20(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetTemplateExptID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -10913,9 +17307,66 @@ func (p *ListExperimentStatsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 300:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField300(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetCronExpr = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField9(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -10954,18 +17405,33 @@ func (p *ListExperimentStatsRequest) FastRead(buf []byte) (int, error) {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetName {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTemplateExptID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCronExpr {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExperimentStatsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateExptScheduleRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExperimentStatsRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CreateExptScheduleRequest[fieldId]))
 }
 
-func (p *ListExperimentStatsRequest) FastReadField1(buf []byte) (int, error) {
+func (p *CreateExptScheduleRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -10979,59 +17445,119 @@ func (p *ListExperimentStatsRequest) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *ListExperimentStatsRequest) FastReadField2(buf []byte) (int, error) {
+func (p *CreateExptScheduleRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *CreateExptScheduleRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Desc = _field
+	return offset, nil
+}
+
+func (p *CreateExptScheduleRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.TemplateExptID = _field
+	return offset, nil
+}
+
+func (p *CreateExptScheduleRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.CronExpr = _field
+	return offset, nil
+}
+
+func (p *CreateExptScheduleRequest) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.PageNumber = _field
+	p.Timezone = _field
 	return offset, nil
 }
 
-func (p *ListExperimentStatsRequest) FastReadField3(buf []byte) (int, error) {
+func (p *CreateExptScheduleRequest) FastReadField7(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.PageSize = _field
+	p.UseLatestTargetVersion = _field
 	return offset, nil
 }
 
-func (p *ListExperimentStatsRequest) FastReadField20(buf []byte) (int, error) {
+func (p *CreateExptScheduleRequest) FastReadField8(buf []byte) (int, error) {
 	offset := 0
-	_field := expt.NewExptFilterOption()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.FilterOption = _field
+	p.UseLatestEvalSetVersion = _field
 	return offset, nil
 }
 
-func (p *ListExperimentStatsRequest) FastReadField300(buf []byte) (int, error) {
+func (p *CreateExptScheduleRequest) FastReadField9(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Session = _field
+	p.Enabled = _field
 	return offset, nil
 }
 
-func (p *ListExperimentStatsRequest) FastReadField255(buf []byte) (int, error) {
+func (p *CreateExptScheduleRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -11043,82 +17569,120 @@ func (p *ListExperimentStatsRequest) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *ListExperimentStatsRequest) FastWrite(buf []byte) int {
+func (p *CreateExptScheduleRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExperimentStatsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField20(buf[offset:], w)
-		offset += p.fastWriteField300(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListExperimentStatsRequest) BLength() int {
+func (p *CreateExptScheduleRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field20Length()
-		l += p.field300Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExperimentStatsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *ListExperimentStatsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageNumber() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Name)
+	return offset
+}
+
+func (p *CreateExptScheduleRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDesc() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Desc)
 	}
 	return offset
 }
 
-func (p *ListExperimentStatsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageSize() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.TemplateExptID)
+	return offset
+}
+
+func (p *CreateExptScheduleRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.CronExpr)
+	return offset
+}
+
+func (p *CreateExptScheduleRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTimezone() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Timezone)
 	}
 	return offset
 }
 
-func (p *ListExperimentStatsRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilterOption() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 20)
-		offset += p.FilterOption.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetUseLatestTargetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.UseLatestTargetVersion)
 	}
 	return offset
 }
 
-func (p *ListExperimentStatsRequest) fastWriteField300(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleRequest) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 300)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetUseLatestEvalSetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 8)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.UseLatestEvalSetVersion)
 	}
 	return offset
 }
 
-func (p *ListExperimentStatsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleRequest) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEnabled() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 9)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Enabled)
+	}
+	return offset
+}
+
+func (p *CreateExptScheduleRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -11127,50 +17691,80 @@ func (p *ListExperimentStatsRequest) fastWriteField255(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *ListExperimentStatsRequest) field1Length() int {
+func (p *CreateExptScheduleRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListExperimentStatsRequest) field2Length() int {
+func (p *CreateExptScheduleRequest) field2Length() int {
 	l := 0
-	if p.IsSetPageNumber() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Name)
+	return l
+}
+
+func (p *CreateExptScheduleRequest) field3Length() int {
+	l := 0
+	if p.IsSetDesc() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.StringLengthNocopy(*p.Desc)
 	}
 	return l
 }
 
-func (p *ListExperimentStatsRequest) field3Length() int {
+func (p *CreateExptScheduleRequest) field4Length() int {
 	l := 0
-	if p.IsSetPageSize() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CreateExptScheduleRequest) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.CronExpr)
+	return l
+}
+
+func (p *CreateExptScheduleRequest) field6Length() int {
+	l := 0
+	if p.IsSetTimezone() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.StringLengthNocopy(*p.Timezone)
 	}
 	return l
 }
 
-func (p *ListExperimentStatsRequest) field20Length() int {
+func (p *CreateExptScheduleRequest) field7Length() int {
 	l := 0
-	if p.IsSetFilterOption() {
+	if p.IsSetUseLatestTargetVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.FilterOption.BLength()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *ListExperimentStatsRequest) field300Length() int {
+func (p *CreateExptScheduleRequest) field8Length() int {
 	l := 0
-	if p.IsSetSession() {
+	if p.IsSetUseLatestEvalSetVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *ListExperimentStatsRequest) field255Length() int {
+func (p *CreateExptScheduleRequest) field9Length() int {
+	l := 0
+	if p.IsSetEnabled() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *CreateExptScheduleRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -11179,41 +17773,54 @@ func (p *ListExperimentStatsRequest) field255Length() int {
 	return l
 }
 
-func (p *ListExperimentStatsRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListExperimentStatsRequest)
+func (p *CreateExptScheduleRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*CreateExptScheduleRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	if src.PageNumber != nil {
-		tmp := *src.PageNumber
-		p.PageNumber = &tmp
+	if src.Name != "" {
+		p.Name = kutils.StringDeepCopy(src.Name)
 	}
 
-	if src.PageSize != nil {
-		tmp := *src.PageSize
-		p.PageSize = &tmp
+	if src.Desc != nil {
+		var tmp string
+		if *src.Desc != "" {
+			tmp = kutils.StringDeepCopy(*src.Desc)
+		}
+		p.Desc = &tmp
 	}
 
-	var _filterOption *expt.ExptFilterOption
-	if src.FilterOption != nil {
-		_filterOption = &expt.ExptFilterOption{}
-		if err := _filterOption.DeepCopy(src.FilterOption); err != nil {
-			return err
-		}
+	p.TemplateExptID = src.TemplateExptID
+
+	if src.CronExpr != "" {
+		p.CronExpr = kutils.StringDeepCopy(src.CronExpr)
 	}
-	p.FilterOption = _filterOption
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
+	if src.Timezone != nil {
+		var tmp string
+		if *src.Timezone != "" {
+			tmp = kutils.StringDeepCopy(*src.Timezone)
 		}
+		p.Timezone = &tmp
+	}
+
+	if src.UseLatestTargetVersion != nil {
+		tmp := *src.UseLatestTargetVersion
+		p.UseLatestTargetVersion = &tmp
+	}
+
+	if src.UseLatestEvalSetVersion != nil {
+		tmp := *src.UseLatestEvalSetVersion
+		p.UseLatestEvalSetVersion = &tmp
+	}
+
+	if src.Enabled != nil {
+		tmp := *src.Enabled
+		p.Enabled = &tmp
 	}
-	p.Session = _session
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -11227,7 +17834,7 @@ func (p *ListExperimentStatsRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListExperimentStatsResponse) FastRead(buf []byte) (int, error) {
+func (p *CreateExptScheduleResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11245,7 +17852,7 @@ func (p *ListExperimentStatsResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11258,20 +17865,6 @@ func (p *ListExperimentStatsResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -11299,51 +17892,24 @@ func (p *ListExperimentStatsResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExperimentStatsResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateExptScheduleResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ListExperimentStatsResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*expt.ExptStatsInfo, 0, size)
-	values := make([]expt.ExptStatsInfo, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.ExptStatsInfos = _field
-	return offset, nil
-}
-
-func (p *ListExperimentStatsResponse) FastReadField2(buf []byte) (int, error) {
+func (p *CreateExptScheduleResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	_field := expt.NewExptSchedule()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Total = _field
+	p.Schedule = _field
 	return offset, nil
 }
 
-func (p *ListExperimentStatsResponse) FastReadField255(buf []byte) (int, error) {
+func (p *CreateExptScheduleResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -11355,14 +17921,13 @@ func (p *ListExperimentStatsResponse) FastReadField255(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *ListExperimentStatsResponse) FastWrite(buf []byte) int {
+func (p *CreateExptScheduleResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExperimentStatsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -11370,103 +17935,62 @@ func (p *ListExperimentStatsResponse) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *ListExperimentStatsResponse) BLength() int {
+func (p *CreateExptScheduleResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExperimentStatsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExptStatsInfos() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ExptStatsInfos {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *ListExperimentStatsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTotal() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.Total)
+	if p.IsSetSchedule() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Schedule.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *ListExperimentStatsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *CreateExptScheduleResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *ListExperimentStatsResponse) field1Length() int {
-	l := 0
-	if p.IsSetExptStatsInfos() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ExptStatsInfos {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
 }
 
-func (p *ListExperimentStatsResponse) field2Length() int {
+func (p *CreateExptScheduleResponse) field1Length() int {
 	l := 0
-	if p.IsSetTotal() {
+	if p.IsSetSchedule() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += p.Schedule.BLength()
 	}
 	return l
 }
 
-func (p *ListExperimentStatsResponse) field255Length() int {
+func (p *CreateExptScheduleResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *ListExperimentStatsResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListExperimentStatsResponse)
+func (p *CreateExptScheduleResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*CreateExptScheduleResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ExptStatsInfos != nil {
-		p.ExptStatsInfos = make([]*expt.ExptStatsInfo, 0, len(src.ExptStatsInfos))
-		for _, elem := range src.ExptStatsInfos {
-			var _elem *expt.ExptStatsInfo
-			if elem != nil {
-				_elem = &expt.ExptStatsInfo{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.ExptStatsInfos = append(p.ExptStatsInfos, _elem)
+	var _schedule *expt.ExptSchedule
+	if src.Schedule != nil {
+		_schedule = &expt.ExptSchedule{}
+		if err := _schedule.DeepCopy(src.Schedule); err != nil {
+			return err
 		}
 	}
-
-	if src.Total != nil {
-		tmp := *src.Total
-		p.Total = &tmp
-	}
+	p.Schedule = _schedule
 
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
@@ -11480,13 +18004,15 @@ func (p *ListExperimentStatsResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *UpsertExptTurnResultFilterRequest) FastRead(buf []byte) (int, error) {
+func (p *UpdateExptScheduleRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetScheduleID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -11504,6 +18030,7 @@ func (p *UpsertExptTurnResultFilterRequest) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11518,6 +18045,7 @@ func (p *UpsertExptTurnResultFilterRequest) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetScheduleID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11526,7 +18054,7 @@ func (p *UpsertExptTurnResultFilterRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11554,7 +18082,7 @@ func (p *UpsertExptTurnResultFilterRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11567,6 +18095,76 @@ func (p *UpsertExptTurnResultFilterRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -11576,113 +18174,187 @@ func (p *UpsertExptTurnResultFilterRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetScheduleID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpsertExptTurnResultFilterRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateExptScheduleRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateExptScheduleRequest[fieldId]))
 }
 
-func (p *UpsertExptTurnResultFilterRequest) FastReadField1(buf []byte) (int, error) {
+func (p *UpdateExptScheduleRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
 	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *UpsertExptTurnResultFilterRequest) FastReadField2(buf []byte) (int, error) {
+func (p *UpdateExptScheduleRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ScheduleID = _field
+	return offset, nil
+}
+
+func (p *UpdateExptScheduleRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.ExperimentID = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *UpsertExptTurnResultFilterRequest) FastReadField3(buf []byte) (int, error) {
+func (p *UpdateExptScheduleRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
+	p.Desc = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *UpdateExptScheduleRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.ItemIds = _field
+	p.CronExpr = _field
 	return offset, nil
 }
 
-func (p *UpsertExptTurnResultFilterRequest) FastReadField4(buf []byte) (int, error) {
+func (p *UpdateExptScheduleRequest) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *UpsertExptTurnResultFilterType
+	var _field *string
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.FilterType = _field
+	p.Timezone = _field
 	return offset, nil
 }
 
-func (p *UpsertExptTurnResultFilterRequest) FastReadField5(buf []byte) (int, error) {
+func (p *UpdateExptScheduleRequest) FastReadField7(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.RetryTimes = _field
+	p.UseLatestTargetVersion = _field
 	return offset, nil
 }
 
-func (p *UpsertExptTurnResultFilterRequest) FastWrite(buf []byte) int {
+func (p *UpdateExptScheduleRequest) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.UseLatestEvalSetVersion = _field
+	return offset, nil
+}
+
+func (p *UpdateExptScheduleRequest) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Enabled = _field
+	return offset, nil
+}
+
+func (p *UpdateExptScheduleRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UpdateExptScheduleRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpsertExptTurnResultFilterRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateExptScheduleRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UpsertExptTurnResultFilterRequest) BLength() int {
+func (p *UpdateExptScheduleRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -11690,149 +18362,258 @@ func (p *UpsertExptTurnResultFilterRequest) BLength() int {
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateExptScheduleRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *UpdateExptScheduleRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ScheduleID)
+	return offset
+}
+
+func (p *UpdateExptScheduleRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *UpdateExptScheduleRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDesc() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Desc)
+	}
+	return offset
+}
+
+func (p *UpdateExptScheduleRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCronExpr() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.CronExpr)
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+	return offset
 }
 
-func (p *UpsertExptTurnResultFilterRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateExptScheduleRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetWorkspaceID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	if p.IsSetTimezone() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Timezone)
 	}
 	return offset
 }
 
-func (p *UpsertExptTurnResultFilterRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateExptScheduleRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExperimentID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
+	if p.IsSetUseLatestTargetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.UseLatestTargetVersion)
 	}
 	return offset
 }
 
-func (p *UpsertExptTurnResultFilterRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateExptScheduleRequest) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemIds() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ItemIds {
-			length++
-			offset += thrift.Binary.WriteI64(buf[offset:], v)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	if p.IsSetUseLatestEvalSetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 8)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.UseLatestEvalSetVersion)
 	}
 	return offset
 }
 
-func (p *UpsertExptTurnResultFilterRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateExptScheduleRequest) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilterType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FilterType)
+	if p.IsSetEnabled() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 9)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Enabled)
 	}
 	return offset
 }
 
-func (p *UpsertExptTurnResultFilterRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateExptScheduleRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetRetryTimes() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.RetryTimes)
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *UpsertExptTurnResultFilterRequest) field1Length() int {
+func (p *UpdateExptScheduleRequest) field1Length() int {
 	l := 0
-	if p.IsSetWorkspaceID() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateExptScheduleRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateExptScheduleRequest) field3Length() int {
+	l := 0
+	if p.IsSetName() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
 	return l
 }
 
-func (p *UpsertExptTurnResultFilterRequest) field2Length() int {
+func (p *UpdateExptScheduleRequest) field4Length() int {
 	l := 0
-	if p.IsSetExperimentID() {
+	if p.IsSetDesc() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.StringLengthNocopy(*p.Desc)
 	}
 	return l
 }
 
-func (p *UpsertExptTurnResultFilterRequest) field3Length() int {
+func (p *UpdateExptScheduleRequest) field5Length() int {
 	l := 0
-	if p.IsSetItemIds() {
+	if p.IsSetCronExpr() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		l +=
-			thrift.Binary.I64Length() * len(p.ItemIds)
+		l += thrift.Binary.StringLengthNocopy(*p.CronExpr)
 	}
 	return l
 }
 
-func (p *UpsertExptTurnResultFilterRequest) field4Length() int {
+func (p *UpdateExptScheduleRequest) field6Length() int {
 	l := 0
-	if p.IsSetFilterType() {
+	if p.IsSetTimezone() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.FilterType)
+		l += thrift.Binary.StringLengthNocopy(*p.Timezone)
 	}
 	return l
 }
 
-func (p *UpsertExptTurnResultFilterRequest) field5Length() int {
+func (p *UpdateExptScheduleRequest) field7Length() int {
 	l := 0
-	if p.IsSetRetryTimes() {
+	if p.IsSetUseLatestTargetVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *UpsertExptTurnResultFilterRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpsertExptTurnResultFilterRequest)
+func (p *UpdateExptScheduleRequest) field8Length() int {
+	l := 0
+	if p.IsSetUseLatestEvalSetVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *UpdateExptScheduleRequest) field9Length() int {
+	l := 0
+	if p.IsSetEnabled() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *UpdateExptScheduleRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *UpdateExptScheduleRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateExptScheduleRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.WorkspaceID != nil {
-		tmp := *src.WorkspaceID
-		p.WorkspaceID = &tmp
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ScheduleID = src.ScheduleID
+
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
 	}
 
-	if src.ExperimentID != nil {
-		tmp := *src.ExperimentID
-		p.ExperimentID = &tmp
+	if src.Desc != nil {
+		var tmp string
+		if *src.Desc != "" {
+			tmp = kutils.StringDeepCopy(*src.Desc)
+		}
+		p.Desc = &tmp
 	}
 
-	if src.ItemIds != nil {
-		p.ItemIds = make([]int64, 0, len(src.ItemIds))
-		for _, elem := range src.ItemIds {
-			var _elem int64
-			_elem = elem
-			p.ItemIds = append(p.ItemIds, _elem)
+	if src.CronExpr != nil {
+		var tmp string
+		if *src.CronExpr != "" {
+			tmp = kutils.StringDeepCopy(*src.CronExpr)
 		}
+		p.CronExpr = &tmp
 	}
 
-	if src.FilterType != nil {
-		tmp := *src.FilterType
-		p.FilterType = &tmp
+	if src.Timezone != nil {
+		var tmp string
+		if *src.Timezone != "" {
+			tmp = kutils.StringDeepCopy(*src.Timezone)
+		}
+		p.Timezone = &tmp
 	}
 
-	if src.RetryTimes != nil {
-		tmp := *src.RetryTimes
-		p.RetryTimes = &tmp
+	if src.UseLatestTargetVersion != nil {
+		tmp := *src.UseLatestTargetVersion
+		p.UseLatestTargetVersion = &tmp
+	}
+
+	if src.UseLatestEvalSetVersion != nil {
+		tmp := *src.UseLatestEvalSetVersion
+		p.UseLatestEvalSetVersion = &tmp
+	}
+
+	if src.Enabled != nil {
+		tmp := *src.Enabled
+		p.Enabled = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
 	}
+	p.Base = _base
 
 	return nil
 }
 
-func (p *UpsertExptTurnResultFilterResponse) FastRead(buf []byte) (int, error) {
+func (p *UpdateExptScheduleResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11849,6 +18630,20 @@ func (p *UpsertExptTurnResultFilterResponse) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -11876,12 +18671,24 @@ func (p *UpsertExptTurnResultFilterResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpsertExptTurnResultFilterResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateExptScheduleResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UpsertExptTurnResultFilterResponse) FastReadField255(buf []byte) (int, error) {
+func (p *UpdateExptScheduleResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptSchedule()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Schedule = _field
+	return offset, nil
+}
+
+func (p *UpdateExptScheduleResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -11893,48 +18700,77 @@ func (p *UpsertExptTurnResultFilterResponse) FastReadField255(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *UpsertExptTurnResultFilterResponse) FastWrite(buf []byte) int {
+func (p *UpdateExptScheduleResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpsertExptTurnResultFilterResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateExptScheduleResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UpsertExptTurnResultFilterResponse) BLength() int {
+func (p *UpdateExptScheduleResponse) BLength() int {
 	l := 0
 	if p != nil {
+		l += p.field1Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UpsertExptTurnResultFilterResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateExptScheduleResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSchedule() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Schedule.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpdateExptScheduleResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UpsertExptTurnResultFilterResponse) field255Length() int {
+func (p *UpdateExptScheduleResponse) field1Length() int {
+	l := 0
+	if p.IsSetSchedule() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Schedule.BLength()
+	}
+	return l
+}
+
+func (p *UpdateExptScheduleResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *UpsertExptTurnResultFilterResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpsertExptTurnResultFilterResponse)
+func (p *UpdateExptScheduleResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateExptScheduleResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
+	var _schedule *expt.ExptSchedule
+	if src.Schedule != nil {
+		_schedule = &expt.ExptSchedule{}
+		if err := _schedule.DeepCopy(src.Schedule); err != nil {
+			return err
+		}
+	}
+	p.Schedule = _schedule
+
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -11947,7 +18783,7 @@ func (p *UpsertExptTurnResultFilterResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *AssociateAnnotationTagReq) FastRead(buf []byte) (int, error) {
+func (p *DeleteExptScheduleRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11955,7 +18791,7 @@ func (p *AssociateAnnotationTagReq) FastRead(buf []byte) (int, error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
+	var issetScheduleID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -11988,35 +18824,7 @@ func (p *AssociateAnnotationTagReq) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
+				issetScheduleID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12052,7 +18860,7 @@ func (p *AssociateAnnotationTagReq) FastRead(buf []byte) (int, error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetScheduleID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
@@ -12060,68 +18868,42 @@ func (p *AssociateAnnotationTagReq) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AssociateAnnotationTagReq[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteExptScheduleRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_AssociateAnnotationTagReq[fieldId]))
-}
-
-func (p *AssociateAnnotationTagReq) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.WorkspaceID = _field
-	return offset, nil
-}
-
-func (p *AssociateAnnotationTagReq) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.ExptID = _field
-	return offset, nil
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DeleteExptScheduleRequest[fieldId]))
 }
 
-func (p *AssociateAnnotationTagReq) FastReadField3(buf []byte) (int, error) {
+func (p *DeleteExptScheduleRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.TagKeyID = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *AssociateAnnotationTagReq) FastReadField200(buf []byte) (int, error) {
+func (p *DeleteExptScheduleRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.Session = _field
+	p.ScheduleID = _field
 	return offset, nil
 }
 
-func (p *AssociateAnnotationTagReq) FastReadField255(buf []byte) (int, error) {
+func (p *DeleteExptScheduleRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -12133,69 +18915,47 @@ func (p *AssociateAnnotationTagReq) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *AssociateAnnotationTagReq) FastWrite(buf []byte) int {
+func (p *DeleteExptScheduleRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *AssociateAnnotationTagReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteExptScheduleRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *AssociateAnnotationTagReq) BLength() int {
+func (p *DeleteExptScheduleRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field200Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *AssociateAnnotationTagReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteExptScheduleRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *AssociateAnnotationTagReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteExptScheduleRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
-	return offset
-}
-
-func (p *AssociateAnnotationTagReq) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTagKeyID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TagKeyID)
-	}
-	return offset
-}
-
-func (p *AssociateAnnotationTagReq) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
-	}
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ScheduleID)
 	return offset
 }
 
-func (p *AssociateAnnotationTagReq) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteExptScheduleRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -12204,39 +18964,21 @@ func (p *AssociateAnnotationTagReq) fastWriteField255(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *AssociateAnnotationTagReq) field1Length() int {
+func (p *DeleteExptScheduleRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *AssociateAnnotationTagReq) field2Length() int {
+func (p *DeleteExptScheduleRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *AssociateAnnotationTagReq) field3Length() int {
-	l := 0
-	if p.IsSetTagKeyID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *AssociateAnnotationTagReq) field200Length() int {
-	l := 0
-	if p.IsSetSession() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
-	}
-	return l
-}
-
-func (p *AssociateAnnotationTagReq) field255Length() int {
+func (p *DeleteExptScheduleRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -12245,29 +18987,15 @@ func (p *AssociateAnnotationTagReq) field255Length() int {
 	return l
 }
 
-func (p *AssociateAnnotationTagReq) DeepCopy(s interface{}) error {
-	src, ok := s.(*AssociateAnnotationTagReq)
+func (p *DeleteExptScheduleRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteExptScheduleRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
-
-	if src.TagKeyID != nil {
-		tmp := *src.TagKeyID
-		p.TagKeyID = &tmp
-	}
-
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
-	}
-	p.Session = _session
+	p.ScheduleID = src.ScheduleID
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -12281,7 +19009,7 @@ func (p *AssociateAnnotationTagReq) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *AssociateAnnotationTagResp) FastRead(buf []byte) (int, error) {
+func (p *DeleteExptScheduleResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12325,12 +19053,12 @@ func (p *AssociateAnnotationTagResp) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AssociateAnnotationTagResp[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteExptScheduleResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *AssociateAnnotationTagResp) FastReadField255(buf []byte) (int, error) {
+func (p *DeleteExptScheduleResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -12342,11 +19070,11 @@ func (p *AssociateAnnotationTagResp) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *AssociateAnnotationTagResp) FastWrite(buf []byte) int {
+func (p *DeleteExptScheduleResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *AssociateAnnotationTagResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteExptScheduleResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField255(buf[offset:], w)
@@ -12355,7 +19083,7 @@ func (p *AssociateAnnotationTagResp) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *AssociateAnnotationTagResp) BLength() int {
+func (p *DeleteExptScheduleResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field255Length()
@@ -12364,22 +19092,22 @@ func (p *AssociateAnnotationTagResp) BLength() int {
 	return l
 }
 
-func (p *AssociateAnnotationTagResp) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteExptScheduleResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *AssociateAnnotationTagResp) field255Length() int {
+func (p *DeleteExptScheduleResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *AssociateAnnotationTagResp) DeepCopy(s interface{}) error {
-	src, ok := s.(*AssociateAnnotationTagResp)
+func (p *DeleteExptScheduleResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteExptScheduleResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -12396,7 +19124,7 @@ func (p *AssociateAnnotationTagResp) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *DeleteAnnotationTagReq) FastRead(buf []byte) (int, error) {
+func (p *ListExptSchedulesRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12404,7 +19132,6 @@ func (p *DeleteAnnotationTagReq) FastRead(buf []byte) (int, error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -12437,7 +19164,6 @@ func (p *DeleteAnnotationTagReq) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12446,7 +19172,7 @@ func (p *DeleteAnnotationTagReq) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -12459,9 +19185,9 @@ func (p *DeleteAnnotationTagReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -12500,23 +19226,18 @@ func (p *DeleteAnnotationTagReq) FastRead(buf []byte) (int, error) {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetExptID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteAnnotationTagReq[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptSchedulesRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DeleteAnnotationTagReq[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptSchedulesRequest[fieldId]))
 }
 
-func (p *DeleteAnnotationTagReq) FastReadField1(buf []byte) (int, error) {
+func (p *ListExptSchedulesRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -12530,47 +19251,49 @@ func (p *DeleteAnnotationTagReq) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *DeleteAnnotationTagReq) FastReadField2(buf []byte) (int, error) {
+func (p *ListExptSchedulesRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
+	var _field *int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.ExptID = _field
+	p.TemplateExptID = _field
 	return offset, nil
 }
 
-func (p *DeleteAnnotationTagReq) FastReadField3(buf []byte) (int, error) {
+func (p *ListExptSchedulesRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.TagKeyID = _field
+	p.PageNumber = _field
 	return offset, nil
 }
 
-func (p *DeleteAnnotationTagReq) FastReadField200(buf []byte) (int, error) {
+func (p *ListExptSchedulesRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Session = _field
+	p.PageSize = _field
 	return offset, nil
 }
 
-func (p *DeleteAnnotationTagReq) FastReadField255(buf []byte) (int, error) {
+func (p *ListExptSchedulesRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -12582,69 +19305,71 @@ func (p *DeleteAnnotationTagReq) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *DeleteAnnotationTagReq) FastWrite(buf []byte) int {
+func (p *ListExptSchedulesRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *DeleteAnnotationTagReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptSchedulesRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *DeleteAnnotationTagReq) BLength() int {
+func (p *ListExptSchedulesRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field200Length()
+		l += p.field4Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *DeleteAnnotationTagReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptSchedulesRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *DeleteAnnotationTagReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptSchedulesRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	if p.IsSetTemplateExptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TemplateExptID)
+	}
 	return offset
 }
 
-func (p *DeleteAnnotationTagReq) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptSchedulesRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTagKeyID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TagKeyID)
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
 	}
 	return offset
 }
 
-func (p *DeleteAnnotationTagReq) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptSchedulesRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
 	}
 	return offset
 }
 
-func (p *DeleteAnnotationTagReq) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptSchedulesRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -12653,39 +19378,41 @@ func (p *DeleteAnnotationTagReq) fastWriteField255(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *DeleteAnnotationTagReq) field1Length() int {
+func (p *ListExptSchedulesRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *DeleteAnnotationTagReq) field2Length() int {
+func (p *ListExptSchedulesRequest) field2Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetTemplateExptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
 	return l
 }
 
-func (p *DeleteAnnotationTagReq) field3Length() int {
+func (p *ListExptSchedulesRequest) field3Length() int {
 	l := 0
-	if p.IsSetTagKeyID() {
+	if p.IsSetPageNumber() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *DeleteAnnotationTagReq) field200Length() int {
+func (p *ListExptSchedulesRequest) field4Length() int {
 	l := 0
-	if p.IsSetSession() {
+	if p.IsSetPageSize() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *DeleteAnnotationTagReq) field255Length() int {
+func (p *ListExptSchedulesRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -12694,29 +19421,28 @@ func (p *DeleteAnnotationTagReq) field255Length() int {
 	return l
 }
 
-func (p *DeleteAnnotationTagReq) DeepCopy(s interface{}) error {
-	src, ok := s.(*DeleteAnnotationTagReq)
+func (p *ListExptSchedulesRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptSchedulesRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
+	if src.TemplateExptID != nil {
+		tmp := *src.TemplateExptID
+		p.TemplateExptID = &tmp
+	}
 
-	if src.TagKeyID != nil {
-		tmp := *src.TagKeyID
-		p.TagKeyID = &tmp
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
 	}
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
 	}
-	p.Session = _session
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -12730,7 +19456,7 @@ func (p *DeleteAnnotationTagReq) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *DeleteAnnotationTagResp) FastRead(buf []byte) (int, error) {
+func (p *ListExptSchedulesResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12747,6 +19473,34 @@ func (p *DeleteAnnotationTagResp) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -12771,15 +19525,54 @@ func (p *DeleteAnnotationTagResp) FastRead(buf []byte) (int, error) {
 	}
 
 	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteAnnotationTagResp[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptSchedulesResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListExptSchedulesResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*expt.ExptSchedule, 0, size)
+	values := make([]expt.ExptSchedule, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Schedules = _field
+	return offset, nil
+}
+
+func (p *ListExptSchedulesResponse) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Total = _field
+	return offset, nil
 }
 
-func (p *DeleteAnnotationTagResp) FastReadField255(buf []byte) (int, error) {
+func (p *ListExptSchedulesResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -12791,48 +19584,119 @@ func (p *DeleteAnnotationTagResp) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *DeleteAnnotationTagResp) FastWrite(buf []byte) int {
+func (p *ListExptSchedulesResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *DeleteAnnotationTagResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptSchedulesResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *DeleteAnnotationTagResp) BLength() int {
+func (p *ListExptSchedulesResponse) BLength() int {
 	l := 0
 	if p != nil {
+		l += p.field1Length()
+		l += p.field20Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *DeleteAnnotationTagResp) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptSchedulesResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSchedules() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Schedules {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListExptSchedulesResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *ListExptSchedulesResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *DeleteAnnotationTagResp) field255Length() int {
+func (p *ListExptSchedulesResponse) field1Length() int {
+	l := 0
+	if p.IsSetSchedules() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Schedules {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListExptSchedulesResponse) field20Length() int {
+	l := 0
+	if p.IsSetTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListExptSchedulesResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *DeleteAnnotationTagResp) DeepCopy(s interface{}) error {
-	src, ok := s.(*DeleteAnnotationTagResp)
+func (p *ListExptSchedulesResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptSchedulesResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
+	if src.Schedules != nil {
+		p.Schedules = make([]*expt.ExptSchedule, 0, len(src.Schedules))
+		for _, elem := range src.Schedules {
+			var _elem *expt.ExptSchedule
+			if elem != nil {
+				_elem = &expt.ExptSchedule{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Schedules = append(p.Schedules, _elem)
+		}
+	}
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -12845,7 +19709,7 @@ func (p *DeleteAnnotationTagResp) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *CreateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12853,10 +19717,7 @@ func (p *CreateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetAnnotateRecord bool = false
-	var issetItemID bool = false
-	var issetTurnID bool = false
+	var issetScheduleID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -12889,7 +19750,7 @@ func (p *CreateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
+				issetScheduleID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12898,13 +19759,12 @@ func (p *CreateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetAnnotateRecord = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12913,42 +19773,12 @@ func (p *CreateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetTurnID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12984,37 +19814,22 @@ func (p *CreateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetScheduleID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetAnnotateRecord {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetItemID {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetTurnID {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateAnnotateRecordReq[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptScheduleRunsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CreateAnnotateRecordReq[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptScheduleRunsRequest[fieldId]))
 }
 
-func (p *CreateAnnotateRecordReq) FastReadField1(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -13028,7 +19843,7 @@ func (p *CreateAnnotateRecordReq) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *CreateAnnotateRecordReq) FastReadField2(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -13038,63 +19853,39 @@ func (p *CreateAnnotateRecordReq) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = v
 	}
-	p.ExptID = _field
-	return offset, nil
-}
-
-func (p *CreateAnnotateRecordReq) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-	_field := expt.NewAnnotateRecord()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.AnnotateRecord = _field
+	p.ScheduleID = _field
 	return offset, nil
 }
 
-func (p *CreateAnnotateRecordReq) FastReadField4(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.ItemID = _field
+	p.PageNumber = _field
 	return offset, nil
 }
 
-func (p *CreateAnnotateRecordReq) FastReadField5(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.TurnID = _field
-	return offset, nil
-}
-
-func (p *CreateAnnotateRecordReq) FastReadField200(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Session = _field
+	p.PageSize = _field
 	return offset, nil
 }
 
-func (p *CreateAnnotateRecordReq) FastReadField255(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -13106,85 +19897,69 @@ func (p *CreateAnnotateRecordReq) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *CreateAnnotateRecordReq) FastWrite(buf []byte) int {
+func (p *ListExptScheduleRunsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CreateAnnotateRecordReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *CreateAnnotateRecordReq) BLength() int {
+func (p *ListExptScheduleRunsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field200Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *CreateAnnotateRecordReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *CreateAnnotateRecordReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
-	return offset
-}
-
-func (p *CreateAnnotateRecordReq) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-	offset += p.AnnotateRecord.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *CreateAnnotateRecordReq) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ScheduleID)
 	return offset
 }
 
-func (p *CreateAnnotateRecordReq) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.TurnID)
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	}
 	return offset
 }
 
-func (p *CreateAnnotateRecordReq) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
 	}
 	return offset
 }
 
-func (p *CreateAnnotateRecordReq) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -13193,51 +19968,39 @@ func (p *CreateAnnotateRecordReq) fastWriteField255(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *CreateAnnotateRecordReq) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *CreateAnnotateRecordReq) field2Length() int {
+func (p *ListExptScheduleRunsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *CreateAnnotateRecordReq) field3Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.AnnotateRecord.BLength()
-	return l
-}
-
-func (p *CreateAnnotateRecordReq) field4Length() int {
+func (p *ListExptScheduleRunsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *CreateAnnotateRecordReq) field5Length() int {
+func (p *ListExptScheduleRunsRequest) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetPageNumber() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
 	return l
 }
 
-func (p *CreateAnnotateRecordReq) field200Length() int {
+func (p *ListExptScheduleRunsRequest) field4Length() int {
 	l := 0
-	if p.IsSetSession() {
+	if p.IsSetPageSize() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *CreateAnnotateRecordReq) field255Length() int {
+func (p *ListExptScheduleRunsRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -13246,37 +20009,25 @@ func (p *CreateAnnotateRecordReq) field255Length() int {
 	return l
 }
 
-func (p *CreateAnnotateRecordReq) DeepCopy(s interface{}) error {
-	src, ok := s.(*CreateAnnotateRecordReq)
+func (p *ListExptScheduleRunsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptScheduleRunsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
+	p.ScheduleID = src.ScheduleID
 
-	var _annotateRecord *expt.AnnotateRecord
-	if src.AnnotateRecord != nil {
-		_annotateRecord = &expt.AnnotateRecord{}
-		if err := _annotateRecord.DeepCopy(src.AnnotateRecord); err != nil {
-			return err
-		}
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
 	}
-	p.AnnotateRecord = _annotateRecord
-
-	p.ItemID = src.ItemID
-
-	p.TurnID = src.TurnID
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
 	}
-	p.Session = _session
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -13290,14 +20041,13 @@ func (p *CreateAnnotateRecordReq) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *CreateAnnotateRecordResp) FastRead(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetAnnotateRecordID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -13309,13 +20059,26 @@ func (p *CreateAnnotateRecordResp) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetAnnotateRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -13346,36 +20109,55 @@ func (p *CreateAnnotateRecordResp) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetAnnotateRecordID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateAnnotateRecordResp[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptScheduleRunsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CreateAnnotateRecordResp[fieldId]))
 }
 
-func (p *CreateAnnotateRecordResp) FastReadField1(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*expt.ExptScheduleRun, 0, size)
+	values := make([]expt.ExptScheduleRun, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Runs = _field
+	return offset, nil
+}
+
+func (p *ListExptScheduleRunsResponse) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.AnnotateRecordID = _field
+	p.Total = _field
 	return offset, nil
 }
 
-func (p *CreateAnnotateRecordResp) FastReadField255(buf []byte) (int, error) {
+func (p *ListExptScheduleRunsResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -13387,13 +20169,14 @@ func (p *CreateAnnotateRecordResp) FastReadField255(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *CreateAnnotateRecordResp) FastWrite(buf []byte) int {
+func (p *ListExptScheduleRunsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CreateAnnotateRecordResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -13401,51 +20184,103 @@ func (p *CreateAnnotateRecordResp) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *CreateAnnotateRecordResp) BLength() int {
+func (p *ListExptScheduleRunsResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
+		l += p.field20Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *CreateAnnotateRecordResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.AnnotateRecordID)
+	if p.IsSetRuns() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Runs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
 	return offset
 }
 
-func (p *CreateAnnotateRecordResp) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListExptScheduleRunsResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *ListExptScheduleRunsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *CreateAnnotateRecordResp) field1Length() int {
+func (p *ListExptScheduleRunsResponse) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetRuns() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Runs {
+			_ = v
+			l += v.BLength()
+		}
+	}
 	return l
 }
 
-func (p *CreateAnnotateRecordResp) field255Length() int {
+func (p *ListExptScheduleRunsResponse) field20Length() int {
+	l := 0
+	if p.IsSetTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListExptScheduleRunsResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *CreateAnnotateRecordResp) DeepCopy(s interface{}) error {
-	src, ok := s.(*CreateAnnotateRecordResp)
+func (p *ListExptScheduleRunsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptScheduleRunsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.AnnotateRecordID = src.AnnotateRecordID
+	if src.Runs != nil {
+		p.Runs = make([]*expt.ExptScheduleRun, 0, len(src.Runs))
+		for _, elem := range src.Runs {
+			var _elem *expt.ExptScheduleRun
+			if elem != nil {
+				_elem = &expt.ExptScheduleRun{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Runs = append(p.Runs, _elem)
+		}
+	}
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
 
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
@@ -13459,19 +20294,13 @@ func (p *CreateAnnotateRecordResp) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *UpdateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCheckExperimentNameArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetAnnotateRecords bool = false
-	var issetAnnotateRecordID bool = false
-	var issetItemID bool = false
-	var issetTurnID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -13483,112 +20312,8 @@ func (p *UpdateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetWorkspaceID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetExptID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetAnnotateRecords = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetAnnotateRecordID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetItemID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetTurnID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -13609,355 +20334,435 @@ func (p *UpdateAnnotateRecordReq) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCheckExperimentNameArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCheckExperimentNameRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
+	p.Req = _field
+	return offset, nil
+}
 
-	if !issetExptID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
+func (p *ExperimentServiceCheckExperimentNameArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
 
-	if !issetAnnotateRecords {
-		fieldId = 3
-		goto RequiredFieldNotSetError
+func (p *ExperimentServiceCheckExperimentNameArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
 	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
 
-	if !issetAnnotateRecordID {
-		fieldId = 4
-		goto RequiredFieldNotSetError
+func (p *ExperimentServiceCheckExperimentNameArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCheckExperimentNameArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if !issetItemID {
-		fieldId = 5
-		goto RequiredFieldNotSetError
+	var _req *CheckExperimentNameRequest
+	if src.Req != nil {
+		_req = &CheckExperimentNameRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
 	}
+	p.Req = _req
 
-	if !issetTurnID {
-		fieldId = 6
-		goto RequiredFieldNotSetError
+	return nil
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
+
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateAnnotateRecordReq[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCheckExperimentNameResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateAnnotateRecordReq[fieldId]))
 }
 
-func (p *UpdateAnnotateRecordReq) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCheckExperimentNameResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := NewCheckExperimentNameResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.WorkspaceID = _field
+	p.Success = _field
 	return offset, nil
 }
 
-func (p *UpdateAnnotateRecordReq) FastReadField2(buf []byte) (int, error) {
-	offset := 0
+func (p *ExperimentServiceCheckExperimentNameResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+func (p *ExperimentServiceCheckExperimentNameResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
-	p.ExptID = _field
-	return offset, nil
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
 }
 
-func (p *UpdateAnnotateRecordReq) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-	_field := expt.NewAnnotateRecord()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
+func (p *ExperimentServiceCheckExperimentNameResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
 	}
-	p.AnnotateRecords = _field
-	return offset, nil
+	l += thrift.Binary.FieldStopLength()
+	return l
 }
 
-func (p *UpdateAnnotateRecordReq) FastReadField4(buf []byte) (int, error) {
+func (p *ExperimentServiceCheckExperimentNameResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+func (p *ExperimentServiceCheckExperimentNameResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
 	}
-	p.AnnotateRecordID = _field
-	return offset, nil
+	return l
 }
 
-func (p *UpdateAnnotateRecordReq) FastReadField5(buf []byte) (int, error) {
-	offset := 0
+func (p *ExperimentServiceCheckExperimentNameResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCheckExperimentNameResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+	var _success *CheckExperimentNameResponse
+	if src.Success != nil {
+		_success = &CheckExperimentNameResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
 	}
-	p.ItemID = _field
-	return offset, nil
+	p.Success = _success
+
+	return nil
 }
 
-func (p *UpdateAnnotateRecordReq) FastReadField6(buf []byte) (int, error) {
-	offset := 0
+func (p *ExperimentServiceCreateExperimentArgs) FastRead(buf []byte) (int, error) {
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
-		_field = v
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	p.TurnID = _field
-	return offset, nil
-}
 
-func (p *UpdateAnnotateRecordReq) FastReadField200(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Session = _field
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExperimentArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UpdateAnnotateRecordReq) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := NewCreateExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *UpdateAnnotateRecordReq) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCreateExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpdateAnnotateRecordReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UpdateAnnotateRecordReq) BLength() int {
+func (p *ExperimentServiceCreateExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field200Length()
-		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UpdateAnnotateRecordReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
-	return offset
-}
-
-func (p *UpdateAnnotateRecordReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
-	return offset
-}
-
-func (p *UpdateAnnotateRecordReq) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-	offset += p.AnnotateRecords.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *UpdateAnnotateRecordReq) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.AnnotateRecordID)
-	return offset
-}
-
-func (p *UpdateAnnotateRecordReq) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UpdateAnnotateRecordReq) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.TurnID)
-	return offset
+func (p *ExperimentServiceCreateExperimentArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
 }
 
-func (p *UpdateAnnotateRecordReq) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+func (p *ExperimentServiceCreateExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateExperimentArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	return offset
-}
 
-func (p *UpdateAnnotateRecordReq) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	var _req *CreateExperimentRequest
+	if src.Req != nil {
+		_req = &CreateExperimentRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
 	}
-	return offset
-}
+	p.Req = _req
 
-func (p *UpdateAnnotateRecordReq) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+	return nil
 }
 
-func (p *UpdateAnnotateRecordReq) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
+func (p *ExperimentServiceCreateExperimentResult) FastRead(buf []byte) (int, error) {
 
-func (p *UpdateAnnotateRecordReq) field3Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.AnnotateRecords.BLength()
-	return l
-}
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
 
-func (p *UpdateAnnotateRecordReq) field4Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExperimentResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UpdateAnnotateRecordReq) field5Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+func (p *ExperimentServiceCreateExperimentResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateExperimentResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
 }
 
-func (p *UpdateAnnotateRecordReq) field6Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+func (p *ExperimentServiceCreateExperimentResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpdateAnnotateRecordReq) field200Length() int {
-	l := 0
-	if p.IsSetSession() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+func (p *ExperimentServiceCreateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
-	return l
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
 }
 
-func (p *UpdateAnnotateRecordReq) field255Length() int {
+func (p *ExperimentServiceCreateExperimentResult) BLength() int {
 	l := 0
-	if p.IsSetBase() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
+	if p != nil {
+		l += p.field0Length()
 	}
+	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UpdateAnnotateRecordReq) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpdateAnnotateRecordReq)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	p.WorkspaceID = src.WorkspaceID
-
-	p.ExptID = src.ExptID
-
-	var _annotateRecords *expt.AnnotateRecord
-	if src.AnnotateRecords != nil {
-		_annotateRecords = &expt.AnnotateRecord{}
-		if err := _annotateRecords.DeepCopy(src.AnnotateRecords); err != nil {
-			return err
-		}
+func (p *ExperimentServiceCreateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
-	p.AnnotateRecords = _annotateRecords
-
-	p.AnnotateRecordID = src.AnnotateRecordID
-
-	p.ItemID = src.ItemID
+	return offset
+}
 
-	p.TurnID = src.TurnID
+func (p *ExperimentServiceCreateExperimentResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
+func (p *ExperimentServiceCreateExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateExperimentResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	p.Session = _session
 
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
+	var _success *CreateExperimentResponse
+	if src.Success != nil {
+		_success = &CreateExperimentResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
 	}
-	p.Base = _base
+	p.Success = _success
 
 	return nil
 }
 
-func (p *UpdateAnnotateRecordResp) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13974,9 +20779,9 @@ func (p *UpdateAnnotateRecordResp) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 255:
+		case 1:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -14001,86 +20806,84 @@ func (p *UpdateAnnotateRecordResp) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateAnnotateRecordResp[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UpdateAnnotateRecordResp) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := NewSubmitExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *UpdateAnnotateRecordResp) FastWrite(buf []byte) int {
+func (p *ExperimentServiceSubmitExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpdateAnnotateRecordResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceSubmitExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UpdateAnnotateRecordResp) BLength() int {
+func (p *ExperimentServiceSubmitExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field255Length()
+		l += p.field1Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UpdateAnnotateRecordResp) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceSubmitExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UpdateAnnotateRecordResp) field255Length() int {
+func (p *ExperimentServiceSubmitExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
+	l += p.Req.BLength()
 	return l
 }
 
-func (p *UpdateAnnotateRecordResp) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpdateAnnotateRecordResp)
+func (p *ExperimentServiceSubmitExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceSubmitExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _req *SubmitExperimentRequest
+	if src.Req != nil {
+		_req = &SubmitExperimentRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Req = _req
 
 	return nil
 }
 
-func (p *ExportExptResultRequest) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetExptID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -14091,67 +20894,9 @@ func (p *ExportExptResultRequest) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetWorkspaceID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetExptID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
+		case 0:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField0(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -14172,248 +20917,326 @@ func (p *ExportExptResultRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExptID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportExptResultRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportExptResultRequest[fieldId]))
 }
 
-func (p *ExportExptResultRequest) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := NewSubmitExperimentResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.WorkspaceID = _field
+	p.Success = _field
 	return offset, nil
 }
 
-func (p *ExportExptResultRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExperimentResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+func (p *ExperimentServiceSubmitExperimentResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
 	}
-	p.ExptID = _field
-	return offset, nil
+	l += thrift.Binary.FieldStopLength()
+	return l
 }
 
-func (p *ExportExptResultRequest) FastReadField4(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
 
-	var _field *expt.ExptResultExportType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
+func (p *ExperimentServiceSubmitExperimentResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceSubmitExperimentResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *SubmitExperimentResponse
+	if src.Success != nil {
+		_success = &SubmitExperimentResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
-		_field = &v
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	p.ExportType = _field
-	return offset, nil
-}
 
-func (p *ExportExptResultRequest) FastReadField200(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Session = _field
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExportExptResultRequest) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := NewBatchGetExperimentsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *ExportExptResultRequest) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExportExptResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExportExptResultRequest) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field4Length()
-		l += p.field200Length()
-		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExportExptResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExportExptResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
-	return offset
+func (p *ExperimentServiceBatchGetExperimentsArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
 }
 
-func (p *ExportExptResultRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExportType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ExportType)
+func (p *ExperimentServiceBatchGetExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentsArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	return offset
+
+	var _req *BatchGetExperimentsRequest
+	if src.Req != nil {
+		_req = &BatchGetExperimentsRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
 }
 
-func (p *ExportExptResultRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+func (p *ExperimentServiceBatchGetExperimentsResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	return offset
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExportExptResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	_field := NewBatchGetExperimentsResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	return offset
+	p.Success = _field
+	return offset, nil
 }
 
-func (p *ExportExptResultRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+func (p *ExperimentServiceBatchGetExperimentsResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExportExptResultRequest) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+func (p *ExperimentServiceBatchGetExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
 }
 
-func (p *ExportExptResultRequest) field4Length() int {
+func (p *ExperimentServiceBatchGetExperimentsResult) BLength() int {
 	l := 0
-	if p.IsSetExportType() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ExportType)
+	if p != nil {
+		l += p.field0Length()
 	}
+	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExportExptResultRequest) field200Length() int {
-	l := 0
-	if p.IsSetSession() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+func (p *ExperimentServiceBatchGetExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
-	return l
+	return offset
 }
 
-func (p *ExportExptResultRequest) field255Length() int {
+func (p *ExperimentServiceBatchGetExperimentsResult) field0Length() int {
 	l := 0
-	if p.IsSetBase() {
+	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *ExportExptResultRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExportExptResultRequest)
+func (p *ExperimentServiceBatchGetExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.WorkspaceID = src.WorkspaceID
-
-	p.ExptID = src.ExptID
-
-	if src.ExportType != nil {
-		tmp := *src.ExportType
-		p.ExportType = &tmp
-	}
-
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
-	}
-	p.Session = _session
-
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
+	var _success *BatchGetExperimentsResponse
+	if src.Success != nil {
+		_success = &BatchGetExperimentsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
 	}
-	p.Base = _base
+	p.Success = _success
 
 	return nil
 }
 
-func (p *ExportExptResultResponse) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExportID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -14425,23 +21248,8 @@ func (p *ExportExptResultResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetExportID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -14461,129 +21269,89 @@ func (p *ExportExptResultResponse) FastRead(buf []byte) (int, error) {
 			}
 		}
 	}
-
-	if !issetExportID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
+
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportExptResultResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportExptResultResponse[fieldId]))
-}
-
-func (p *ExportExptResultResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.ExportID = _field
-	return offset, nil
 }
 
-func (p *ExportExptResultResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := NewListExperimentsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *ExportExptResultResponse) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExperimentsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExportExptResultResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExportExptResultResponse) BLength() int {
+func (p *ExperimentServiceListExperimentsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExportExptResultResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExportID)
-	return offset
-}
-
-func (p *ExportExptResultResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExportExptResultResponse) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *ExportExptResultResponse) field255Length() int {
+func (p *ExperimentServiceListExperimentsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
+	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExportExptResultResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExportExptResultResponse)
+func (p *ExperimentServiceListExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.ExportID = src.ExportID
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _req *ListExperimentsRequest
+	if src.Req != nil {
+		_req = &ListExperimentsRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Req = _req
 
 	return nil
 }
 
-func (p *ListExptResultExportRecordRequest) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetExptID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -14594,81 +21362,9 @@ func (p *ListExptResultExportRecordRequest) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetWorkspaceID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetExptID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
+		case 0:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField0(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -14689,287 +21385,326 @@ func (p *ListExptResultExportRecordRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExptID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptResultExportRecordRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptResultExportRecordRequest[fieldId]))
 }
 
-func (p *ListExptResultExportRecordRequest) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := NewListExperimentsResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.WorkspaceID = _field
+	p.Success = _field
 	return offset, nil
 }
 
-func (p *ListExptResultExportRecordRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentsResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+func (p *ExperimentServiceListExperimentsResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
 	}
-	p.ExptID = _field
-	return offset, nil
+	l += thrift.Binary.FieldStopLength()
+	return l
 }
 
-func (p *ListExptResultExportRecordRequest) FastReadField3(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+func (p *ExperimentServiceListExperimentsResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
 	}
-	p.PageNumber = _field
-	return offset, nil
+	return l
 }
 
-func (p *ListExptResultExportRecordRequest) FastReadField4(buf []byte) (int, error) {
-	offset := 0
+func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentsResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+	var _success *ListExperimentsResponse
+	if src.Success != nil {
+		_success = &ListExperimentsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
 	}
-	p.PageSize = _field
-	return offset, nil
+	p.Success = _success
+
+	return nil
 }
 
-func (p *ListExptResultExportRecordRequest) FastReadField200(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
+func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	p.Session = _field
+
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ListExptResultExportRecordRequest) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := NewUpdateExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *ListExptResultExportRecordRequest) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExptResultExportRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListExptResultExportRecordRequest) BLength() int {
+func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field200Length()
-		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExptResultExportRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ListExptResultExportRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
-	return offset
+func (p *ExperimentServiceUpdateExperimentArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
 }
 
-func (p *ListExptResultExportRecordRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPageNumber() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExperimentArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	return offset
-}
 
-func (p *ListExptResultExportRecordRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPageSize() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	var _req *UpdateExperimentRequest
+	if src.Req != nil {
+		_req = &UpdateExperimentRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
 	}
-	return offset
+	p.Req = _req
+
+	return nil
 }
 
-func (p *ListExptResultExportRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	return offset
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ListExptResultExportRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	_field := NewUpdateExperimentResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	return offset
-}
-
-func (p *ListExptResultExportRecordRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+	p.Success = _field
+	return offset, nil
 }
 
-func (p *ListExptResultExportRecordRequest) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+func (p *ExperimentServiceUpdateExperimentResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExptResultExportRecordRequest) field3Length() int {
-	l := 0
-	if p.IsSetPageNumber() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
-	return l
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
 }
 
-func (p *ListExptResultExportRecordRequest) field4Length() int {
+func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
 	l := 0
-	if p.IsSetPageSize() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+	if p != nil {
+		l += p.field0Length()
 	}
+	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExptResultExportRecordRequest) field200Length() int {
-	l := 0
-	if p.IsSetSession() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
-	return l
+	return offset
 }
 
-func (p *ListExptResultExportRecordRequest) field255Length() int {
+func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
 	l := 0
-	if p.IsSetBase() {
+	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *ListExptResultExportRecordRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListExptResultExportRecordRequest)
+func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.WorkspaceID = src.WorkspaceID
-
-	p.ExptID = src.ExptID
-
-	if src.PageNumber != nil {
-		tmp := *src.PageNumber
-		p.PageNumber = &tmp
-	}
-
-	if src.PageSize != nil {
-		tmp := *src.PageSize
-		p.PageSize = &tmp
-	}
-
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
-	}
-	p.Session = _session
-
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
+	var _success *UpdateExperimentResponse
+	if src.Success != nil {
+		_success = &UpdateExperimentResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
 	}
-	p.Base = _base
+	p.Success = _success
 
 	return nil
 }
 
-func (p *ListExptResultExportRecordResponse) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExptResultExportRecords bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -14981,37 +21716,8 @@ func (p *ListExptResultExportRecordResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetExptResultExportRecords = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 20:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField20(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -15032,203 +21738,207 @@ func (p *ListExptResultExportRecordResponse) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetExptResultExportRecords {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptResultExportRecordResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptResultExportRecordResponse[fieldId]))
-}
-
-func (p *ListExptResultExportRecordResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*expt.ExptResultExportRecord, 0, size)
-	values := make([]expt.ExptResultExportRecord, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.ExptResultExportRecords = _field
-	return offset, nil
-}
-
-func (p *ListExptResultExportRecordResponse) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Total = _field
-	return offset, nil
 }
 
-func (p *ListExptResultExportRecordResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := NewDeleteExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *ListExptResultExportRecordResponse) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExptResultExportRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListExptResultExportRecordResponse) BLength() int {
+func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field20Length()
-		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExptResultExportRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.ExptResultExportRecords {
-		length++
-		offset += v.FastWriteNocopy(buf[offset:], w)
-	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ListExptResultExportRecordResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTotal() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+func (p *ExperimentServiceDeleteExperimentArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExperimentArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	return offset
+
+	var _req *DeleteExperimentRequest
+	if src.Req != nil {
+		_req = &DeleteExperimentRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
 }
 
-func (p *ListExptResultExportRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
+func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ListExptResultExportRecordResponse) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.ExptResultExportRecords {
-		_ = v
-		l += v.BLength()
+func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeleteExperimentResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	return l
+	p.Success = _field
+	return offset, nil
 }
 
-func (p *ListExptResultExportRecordResponse) field20Length() int {
-	l := 0
-	if p.IsSetTotal() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+func (p *ExperimentServiceDeleteExperimentResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
-	return l
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
 }
 
-func (p *ListExptResultExportRecordResponse) field255Length() int {
+func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExptResultExportRecordResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListExptResultExportRecordResponse)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	if src.ExptResultExportRecords != nil {
-		p.ExptResultExportRecords = make([]*expt.ExptResultExportRecord, 0, len(src.ExptResultExportRecords))
-		for _, elem := range src.ExptResultExportRecords {
-			var _elem *expt.ExptResultExportRecord
-			if elem != nil {
-				_elem = &expt.ExptResultExportRecord{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
 
-			p.ExptResultExportRecords = append(p.ExptResultExportRecords, _elem)
-		}
+func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
 	}
+	return l
+}
 
-	if src.Total != nil {
-		tmp := *src.Total
-		p.Total = &tmp
+func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExperimentResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _success *DeleteExperimentResponse
+	if src.Success != nil {
+		_success = &DeleteExperimentResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Success = _success
 
 	return nil
 }
 
-func (p *GetExptResultExportRecordRequest) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetExportID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -15240,67 +21950,8 @@ func (p *GetExptResultExportRecordRequest) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetWorkspaceID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetExptID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetExportID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 200:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -15321,239 +21972,82 @@ func (p *GetExptResultExportRecordRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExptID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExportID {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptResultExportRecordRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetExptResultExportRecordRequest[fieldId]))
-}
-
-func (p *GetExptResultExportRecordRequest) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.WorkspaceID = _field
-	return offset, nil
 }
 
-func (p *GetExptResultExportRecordRequest) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.ExptID = _field
-	return offset, nil
-}
-
-func (p *GetExptResultExportRecordRequest) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.ExportID = _field
-	return offset, nil
-}
-
-func (p *GetExptResultExportRecordRequest) FastReadField200(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Session = _field
-	return offset, nil
-}
-
-func (p *GetExptResultExportRecordRequest) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := NewBatchDeleteExperimentsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *GetExptResultExportRecordRequest) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetExptResultExportRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetExptResultExportRecordRequest) BLength() int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field4Length()
-		l += p.field200Length()
-		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetExptResultExportRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
-	return offset
-}
-
-func (p *GetExptResultExportRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
-	return offset
-}
-
-func (p *GetExptResultExportRecordRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExportID)
-	return offset
-}
-
-func (p *GetExptResultExportRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *GetExptResultExportRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *GetExptResultExportRecordRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *GetExptResultExportRecordRequest) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *GetExptResultExportRecordRequest) field4Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *GetExptResultExportRecordRequest) field200Length() int {
-	l := 0
-	if p.IsSetSession() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
-	}
-	return l
-}
-
-func (p *GetExptResultExportRecordRequest) field255Length() int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) field1Length() int {
 	l := 0
-	if p.IsSetBase() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
 	return l
 }
 
-func (p *GetExptResultExportRecordRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetExptResultExportRecordRequest)
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.WorkspaceID = src.WorkspaceID
-
-	p.ExptID = src.ExptID
-
-	p.ExportID = src.ExportID
-
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
-	}
-	p.Session = _session
-
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
+	var _req *BatchDeleteExperimentsRequest
+	if src.Req != nil {
+		_req = &BatchDeleteExperimentsRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
 	}
-	p.Base = _base
+	p.Req = _req
 
 	return nil
 }
 
-func (p *GetExptResultExportRecordResponse) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15570,23 +22064,9 @@ func (p *GetExptResultExportRecordResponse) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
+		case 0:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField0(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -15611,119 +22091,82 @@ func (p *GetExptResultExportRecordResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptResultExportRecordResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *GetExptResultExportRecordResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-	_field := expt.NewExptResultExportRecord()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.ExptResultExportRecord = _field
-	return offset, nil
-}
-
-func (p *GetExptResultExportRecordResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := NewBatchDeleteExperimentsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Success = _field
 	return offset, nil
 }
 
-func (p *GetExptResultExportRecordResponse) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetExptResultExportRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetExptResultExportRecordResponse) BLength() int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field1Length()
-		l += p.field255Length()
+		l += p.field0Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetExptResultExportRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExptResultExportRecord() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.ExptResultExportRecord.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *GetExptResultExportRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *GetExptResultExportRecordResponse) field1Length() int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
 	l := 0
-	if p.IsSetExptResultExportRecord() {
+	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.ExptResultExportRecord.BLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *GetExptResultExportRecordResponse) field255Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
-	return l
-}
-
-func (p *GetExptResultExportRecordResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetExptResultExportRecordResponse)
+func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _exptResultExportRecord *expt.ExptResultExportRecord
-	if src.ExptResultExportRecord != nil {
-		_exptResultExportRecord = &expt.ExptResultExportRecord{}
-		if err := _exptResultExportRecord.DeepCopy(src.ExptResultExportRecord); err != nil {
-			return err
-		}
-	}
-	p.ExptResultExportRecord = _exptResultExportRecord
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _success *BatchDeleteExperimentsResponse
+	if src.Success != nil {
+		_success = &BatchDeleteExperimentsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Success = _success
 
 	return nil
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15767,14 +22210,14 @@ func (p *ExperimentServiceCheckExperimentNameArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCheckExperimentNameArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCheckExperimentNameRequest()
+	_field := NewCloneExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -15784,11 +22227,11 @@ func (p *ExperimentServiceCheckExperimentNameArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCloneExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -15797,7 +22240,7 @@ func (p *ExperimentServiceCheckExperimentNameArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) BLength() int {
+func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -15806,29 +22249,29 @@ func (p *ExperimentServiceCheckExperimentNameArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) field1Length() int {
+func (p *ExperimentServiceCloneExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCheckExperimentNameArgs)
+func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCloneExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CheckExperimentNameRequest
+	var _req *CloneExperimentRequest
 	if src.Req != nil {
-		_req = &CheckExperimentNameRequest{}
+		_req = &CloneExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -15838,7 +22281,7 @@ func (p *ExperimentServiceCheckExperimentNameArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15882,14 +22325,14 @@ func (p *ExperimentServiceCheckExperimentNameResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCheckExperimentNameResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCheckExperimentNameResponse()
+	_field := NewCloneExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -15899,11 +22342,11 @@ func (p *ExperimentServiceCheckExperimentNameResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCloneExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -15912,7 +22355,7 @@ func (p *ExperimentServiceCheckExperimentNameResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) BLength() int {
+func (p *ExperimentServiceCloneExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -15921,7 +22364,7 @@ func (p *ExperimentServiceCheckExperimentNameResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -15930,7 +22373,7 @@ func (p *ExperimentServiceCheckExperimentNameResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) field0Length() int {
+func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -15939,15 +22382,15 @@ func (p *ExperimentServiceCheckExperimentNameResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCheckExperimentNameResult)
+func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCloneExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CheckExperimentNameResponse
+	var _success *CloneExperimentResponse
 	if src.Success != nil {
-		_success = &CheckExperimentNameResponse{}
+		_success = &CloneExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -15957,7 +22400,7 @@ func (p *ExperimentServiceCheckExperimentNameResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16001,14 +22444,14 @@ func (p *ExperimentServiceCreateExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateExperimentRequest()
+	_field := NewRunExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16018,11 +22461,11 @@ func (p *ExperimentServiceCreateExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRunExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16031,7 +22474,7 @@ func (p *ExperimentServiceCreateExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) BLength() int {
+func (p *ExperimentServiceRunExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16040,29 +22483,29 @@ func (p *ExperimentServiceCreateExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) field1Length() int {
+func (p *ExperimentServiceRunExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCreateExperimentArgs)
+func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRunExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateExperimentRequest
+	var _req *RunExperimentRequest
 	if src.Req != nil {
-		_req = &CreateExperimentRequest{}
+		_req = &RunExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -16072,7 +22515,7 @@ func (p *ExperimentServiceCreateExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceCreateExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16116,14 +22559,14 @@ func (p *ExperimentServiceCreateExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCreateExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateExperimentResponse()
+	_field := NewRunExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16133,11 +22576,11 @@ func (p *ExperimentServiceCreateExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceCreateExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRunExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCreateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -16146,7 +22589,7 @@ func (p *ExperimentServiceCreateExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceCreateExperimentResult) BLength() int {
+func (p *ExperimentServiceRunExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -16155,7 +22598,7 @@ func (p *ExperimentServiceCreateExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16164,7 +22607,7 @@ func (p *ExperimentServiceCreateExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceCreateExperimentResult) field0Length() int {
+func (p *ExperimentServiceRunExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16173,15 +22616,15 @@ func (p *ExperimentServiceCreateExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCreateExperimentResult)
+func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRunExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateExperimentResponse
+	var _success *RunExperimentResponse
 	if src.Success != nil {
-		_success = &CreateExperimentResponse{}
+		_success = &RunExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16191,7 +22634,7 @@ func (p *ExperimentServiceCreateExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16235,14 +22678,14 @@ func (p *ExperimentServiceSubmitExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSubmitExperimentRequest()
+	_field := NewRetryExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16252,11 +22695,11 @@ func (p *ExperimentServiceSubmitExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRetryExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16265,7 +22708,7 @@ func (p *ExperimentServiceSubmitExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) BLength() int {
+func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16274,29 +22717,29 @@ func (p *ExperimentServiceSubmitExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) field1Length() int {
+func (p *ExperimentServiceRetryExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceSubmitExperimentArgs)
+func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRetryExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *SubmitExperimentRequest
+	var _req *RetryExperimentRequest
 	if src.Req != nil {
-		_req = &SubmitExperimentRequest{}
+		_req = &RetryExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -16306,7 +22749,7 @@ func (p *ExperimentServiceSubmitExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16350,14 +22793,14 @@ func (p *ExperimentServiceSubmitExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSubmitExperimentResponse()
+	_field := NewRetryExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16367,11 +22810,11 @@ func (p *ExperimentServiceSubmitExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRetryExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -16380,7 +22823,7 @@ func (p *ExperimentServiceSubmitExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) BLength() int {
+func (p *ExperimentServiceRetryExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -16389,7 +22832,7 @@ func (p *ExperimentServiceSubmitExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16398,7 +22841,7 @@ func (p *ExperimentServiceSubmitExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) field0Length() int {
+func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16407,15 +22850,15 @@ func (p *ExperimentServiceSubmitExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceSubmitExperimentResult)
+func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRetryExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *SubmitExperimentResponse
+	var _success *RetryExperimentResponse
 	if src.Success != nil {
-		_success = &SubmitExperimentResponse{}
+		_success = &RetryExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16425,7 +22868,7 @@ func (p *ExperimentServiceSubmitExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16469,14 +22912,14 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentsRequest()
+	_field := NewKillExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16486,11 +22929,11 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceKillExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16499,7 +22942,7 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) BLength() int {
+func (p *ExperimentServiceKillExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16508,29 +22951,29 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) field1Length() int {
+func (p *ExperimentServiceKillExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentsArgs)
+func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceKillExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetExperimentsRequest
+	var _req *KillExperimentRequest
 	if src.Req != nil {
-		_req = &BatchGetExperimentsRequest{}
+		_req = &KillExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -16540,7 +22983,7 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16584,14 +23027,14 @@ func (p *ExperimentServiceBatchGetExperimentsResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentsResponse()
+	_field := NewKillExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16601,11 +23044,11 @@ func (p *ExperimentServiceBatchGetExperimentsResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceKillExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -16614,7 +23057,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) BLength() int {
+func (p *ExperimentServiceKillExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -16623,7 +23066,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16632,7 +23075,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) field0Length() int {
+func (p *ExperimentServiceKillExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16641,15 +23084,15 @@ func (p *ExperimentServiceBatchGetExperimentsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentsResult)
+func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceKillExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetExperimentsResponse
+	var _success *KillExperimentResponse
 	if src.Success != nil {
-		_success = &BatchGetExperimentsResponse{}
+		_success = &KillExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16659,7 +23102,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServicePauseExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16703,14 +23146,14 @@ func (p *ExperimentServiceListExperimentsArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServicePauseExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServicePauseExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentsRequest()
+	_field := NewPauseExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16720,11 +23163,11 @@ func (p *ExperimentServiceListExperimentsArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServicePauseExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServicePauseExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16733,7 +23176,7 @@ func (p *ExperimentServiceListExperimentsArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsArgs) BLength() int {
+func (p *ExperimentServicePauseExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16742,29 +23185,29 @@ func (p *ExperimentServiceListExperimentsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServicePauseExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsArgs) field1Length() int {
+func (p *ExperimentServicePauseExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentsArgs)
+func (p *ExperimentServicePauseExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServicePauseExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExperimentsRequest
+	var _req *PauseExperimentRequest
 	if src.Req != nil {
-		_req = &ListExperimentsRequest{}
+		_req = &PauseExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -16774,7 +23217,7 @@ func (p *ExperimentServiceListExperimentsArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServicePauseExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16818,14 +23261,14 @@ func (p *ExperimentServiceListExperimentsResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServicePauseExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServicePauseExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentsResponse()
+	_field := NewPauseExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16835,11 +23278,11 @@ func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServicePauseExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServicePauseExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -16848,7 +23291,7 @@ func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsResult) BLength() int {
+func (p *ExperimentServicePauseExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -16857,7 +23300,7 @@ func (p *ExperimentServiceListExperimentsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServicePauseExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16866,7 +23309,7 @@ func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsResult) field0Length() int {
+func (p *ExperimentServicePauseExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16875,15 +23318,15 @@ func (p *ExperimentServiceListExperimentsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentsResult)
+func (p *ExperimentServicePauseExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServicePauseExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExperimentsResponse
+	var _success *PauseExperimentResponse
 	if src.Success != nil {
-		_success = &ListExperimentsResponse{}
+		_success = &PauseExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16893,7 +23336,7 @@ func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceResumeExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16937,14 +23380,14 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceResumeExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceResumeExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateExperimentRequest()
+	_field := NewResumeExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16954,11 +23397,11 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceResumeExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceResumeExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16967,7 +23410,7 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
+func (p *ExperimentServiceResumeExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16976,29 +23419,29 @@ func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceResumeExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) field1Length() int {
+func (p *ExperimentServiceResumeExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateExperimentArgs)
+func (p *ExperimentServiceResumeExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceResumeExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateExperimentRequest
+	var _req *ResumeExperimentRequest
 	if src.Req != nil {
-		_req = &UpdateExperimentRequest{}
+		_req = &ResumeExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17008,7 +23451,7 @@ func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceResumeExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17052,14 +23495,14 @@ func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceResumeExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceResumeExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateExperimentResponse()
+	_field := NewResumeExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17069,11 +23512,11 @@ func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceResumeExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceResumeExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17082,7 +23525,7 @@ func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
+func (p *ExperimentServiceResumeExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17091,7 +23534,7 @@ func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceResumeExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17100,7 +23543,7 @@ func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
+func (p *ExperimentServiceResumeExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17109,15 +23552,15 @@ func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateExperimentResult)
+func (p *ExperimentServiceResumeExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceResumeExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateExperimentResponse
+	var _success *ResumeExperimentResponse
 	if src.Success != nil {
-		_success = &UpdateExperimentResponse{}
+		_success = &ResumeExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -17127,7 +23570,7 @@ func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17171,14 +23614,14 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteExperimentRequest()
+	_field := NewBatchGetExperimentResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17188,11 +23631,11 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17201,7 +23644,7 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17210,29 +23653,29 @@ func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) field1Length() int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteExperimentArgs)
+func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteExperimentRequest
+	var _req *BatchGetExperimentResultRequest
 	if src.Req != nil {
-		_req = &DeleteExperimentRequest{}
+		_req = &BatchGetExperimentResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17242,7 +23685,7 @@ func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17286,14 +23729,14 @@ func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteExperimentResponse()
+	_field := NewBatchGetExperimentResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17303,11 +23746,11 @@ func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17316,7 +23759,7 @@ func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17325,7 +23768,7 @@ func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17334,7 +23777,7 @@ func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17343,15 +23786,15 @@ func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteExperimentResult)
+func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteExperimentResponse
+	var _success *BatchGetExperimentResultResponse
 	if src.Success != nil {
-		_success = &DeleteExperimentResponse{}
+		_success = &BatchGetExperimentResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -17361,7 +23804,7 @@ func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17405,14 +23848,14 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDeleteExperimentsRequest()
+	_field := NewBatchGetExperimentAggrResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17422,11 +23865,11 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17435,7 +23878,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17444,29 +23887,29 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) field1Length() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsArgs)
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchDeleteExperimentsRequest
+	var _req *BatchGetExperimentAggrResultRequest
 	if src.Req != nil {
-		_req = &BatchDeleteExperimentsRequest{}
+		_req = &BatchGetExperimentAggrResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17476,7 +23919,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17520,14 +23963,14 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDeleteExperimentsResponse()
+	_field := NewBatchGetExperimentAggrResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17537,11 +23980,11 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byt
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17550,7 +23993,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17559,7 +24002,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17568,7 +24011,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17577,15 +24020,15 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsResult)
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchDeleteExperimentsResponse
+	var _success *BatchGetExperimentAggrResultResponse
 	if src.Success != nil {
-		_success = &BatchDeleteExperimentsResponse{}
+		_success = &BatchGetExperimentAggrResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -17595,7 +24038,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17639,14 +24082,14 @@ func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCompareExperimentsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCloneExperimentRequest()
+	_field := NewCompareExperimentsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17656,11 +24099,11 @@ func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCompareExperimentsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17669,7 +24112,7 @@ func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
+func (p *ExperimentServiceCompareExperimentsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17678,29 +24121,29 @@ func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) field1Length() int {
+func (p *ExperimentServiceCompareExperimentsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCloneExperimentArgs)
+func (p *ExperimentServiceCompareExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCompareExperimentsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CloneExperimentRequest
+	var _req *CompareExperimentsRequest
 	if src.Req != nil {
-		_req = &CloneExperimentRequest{}
+		_req = &CompareExperimentsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17710,7 +24153,7 @@ func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17754,14 +24197,14 @@ func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCompareExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCloneExperimentResponse()
+	_field := NewCompareExperimentsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17771,11 +24214,11 @@ func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCompareExperimentsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17784,7 +24227,7 @@ func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentResult) BLength() int {
+func (p *ExperimentServiceCompareExperimentsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17793,7 +24236,7 @@ func (p *ExperimentServiceCloneExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17802,7 +24245,7 @@ func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
+func (p *ExperimentServiceCompareExperimentsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17811,15 +24254,15 @@ func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCloneExperimentResult)
+func (p *ExperimentServiceCompareExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCompareExperimentsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CloneExperimentResponse
+	var _success *CompareExperimentsResponse
 	if src.Success != nil {
-		_success = &CloneExperimentResponse{}
+		_success = &CompareExperimentsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -17829,7 +24272,7 @@ func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceInvokeExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17873,14 +24316,14 @@ func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvokeExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceInvokeExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunExperimentRequest()
+	_field := NewInvokeExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17890,11 +24333,11 @@ func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceInvokeExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvokeExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17903,7 +24346,7 @@ func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentArgs) BLength() int {
+func (p *ExperimentServiceInvokeExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17912,29 +24355,29 @@ func (p *ExperimentServiceRunExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvokeExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentArgs) field1Length() int {
+func (p *ExperimentServiceInvokeExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRunExperimentArgs)
+func (p *ExperimentServiceInvokeExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceInvokeExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *RunExperimentRequest
+	var _req *InvokeExperimentRequest
 	if src.Req != nil {
-		_req = &RunExperimentRequest{}
+		_req = &InvokeExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17944,7 +24387,7 @@ func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceInvokeExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17988,14 +24431,14 @@ func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvokeExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceInvokeExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunExperimentResponse()
+	_field := NewInvokeExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18005,11 +24448,11 @@ func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceInvokeExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvokeExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18018,7 +24461,7 @@ func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentResult) BLength() int {
+func (p *ExperimentServiceInvokeExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18027,7 +24470,7 @@ func (p *ExperimentServiceRunExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvokeExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18036,7 +24479,7 @@ func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentResult) field0Length() int {
+func (p *ExperimentServiceInvokeExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18045,15 +24488,15 @@ func (p *ExperimentServiceRunExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRunExperimentResult)
+func (p *ExperimentServiceInvokeExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceInvokeExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *RunExperimentResponse
+	var _success *InvokeExperimentResponse
 	if src.Success != nil {
-		_success = &RunExperimentResponse{}
+		_success = &InvokeExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18063,7 +24506,7 @@ func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceFinishExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18107,14 +24550,14 @@ func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFinishExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceFinishExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRetryExperimentRequest()
+	_field := NewFinishExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18124,11 +24567,11 @@ func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceFinishExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFinishExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18137,7 +24580,7 @@ func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
+func (p *ExperimentServiceFinishExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18146,29 +24589,29 @@ func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFinishExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) field1Length() int {
+func (p *ExperimentServiceFinishExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRetryExperimentArgs)
+func (p *ExperimentServiceFinishExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceFinishExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *RetryExperimentRequest
+	var _req *FinishExperimentRequest
 	if src.Req != nil {
-		_req = &RetryExperimentRequest{}
+		_req = &FinishExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18178,7 +24621,7 @@ func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceFinishExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18222,14 +24665,14 @@ func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFinishExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceFinishExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRetryExperimentResponse()
+	_field := NewFinishExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18239,11 +24682,11 @@ func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceFinishExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFinishExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18252,7 +24695,7 @@ func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentResult) BLength() int {
+func (p *ExperimentServiceFinishExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18261,7 +24704,7 @@ func (p *ExperimentServiceRetryExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFinishExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18270,7 +24713,7 @@ func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
+func (p *ExperimentServiceFinishExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18279,15 +24722,15 @@ func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRetryExperimentResult)
+func (p *ExperimentServiceFinishExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceFinishExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *RetryExperimentResponse
+	var _success *FinishExperimentResponse
 	if src.Success != nil {
-		_success = &RetryExperimentResponse{}
+		_success = &FinishExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18297,7 +24740,7 @@ func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStatsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18341,14 +24784,14 @@ func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStatsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStatsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewKillExperimentRequest()
+	_field := NewListExperimentStatsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18358,11 +24801,11 @@ func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExperimentStatsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStatsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18371,7 +24814,7 @@ func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentArgs) BLength() int {
+func (p *ExperimentServiceListExperimentStatsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18380,29 +24823,29 @@ func (p *ExperimentServiceKillExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStatsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentArgs) field1Length() int {
+func (p *ExperimentServiceListExperimentStatsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceKillExperimentArgs)
+func (p *ExperimentServiceListExperimentStatsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentStatsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *KillExperimentRequest
+	var _req *ListExperimentStatsRequest
 	if src.Req != nil {
-		_req = &KillExperimentRequest{}
+		_req = &ListExperimentStatsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18412,7 +24855,7 @@ func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStatsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18456,14 +24899,14 @@ func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStatsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStatsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewKillExperimentResponse()
+	_field := NewListExperimentStatsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18473,11 +24916,11 @@ func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExperimentStatsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStatsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18486,7 +24929,7 @@ func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentResult) BLength() int {
+func (p *ExperimentServiceListExperimentStatsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18495,7 +24938,7 @@ func (p *ExperimentServiceKillExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStatsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18504,7 +24947,7 @@ func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentResult) field0Length() int {
+func (p *ExperimentServiceListExperimentStatsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18513,15 +24956,15 @@ func (p *ExperimentServiceKillExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceKillExperimentResult)
+func (p *ExperimentServiceListExperimentStatsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentStatsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *KillExperimentResponse
+	var _success *ListExperimentStatsResponse
 	if src.Success != nil {
-		_success = &KillExperimentResponse{}
+		_success = &ListExperimentStatsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18531,7 +24974,7 @@ func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18575,14 +25018,14 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpsertExptTurnResultFilterArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentResultRequest()
+	_field := NewUpsertExptTurnResultFilterRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18592,11 +25035,11 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byt
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18605,7 +25048,7 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18614,29 +25057,29 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) field1Length() int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentResultArgs)
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpsertExptTurnResultFilterArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetExperimentResultRequest
+	var _req *UpsertExptTurnResultFilterRequest
 	if src.Req != nil {
-		_req = &BatchGetExperimentResultRequest{}
+		_req = &UpsertExptTurnResultFilterRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18646,7 +25089,7 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18690,14 +25133,14 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpsertExptTurnResultFilterResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentResultResponse()
+	_field := NewUpsertExptTurnResultFilterResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18707,11 +25150,11 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []b
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18720,7 +25163,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18729,7 +25172,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18738,7 +25181,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18747,15 +25190,15 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentResultResult)
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpsertExptTurnResultFilterResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetExperimentResultResponse
+	var _success *UpsertExptTurnResultFilterResponse
 	if src.Success != nil {
-		_success = &BatchGetExperimentResultResponse{}
+		_success = &UpsertExptTurnResultFilterResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18765,7 +25208,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18809,14 +25252,14 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceAssociateAnnotationTagArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentAggrResultRequest()
+	_field := NewAssociateAnnotationTagReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18826,11 +25269,11 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf [
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18839,7 +25282,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18848,29 +25291,29 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) field1Length() int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultArgs)
+func (p *ExperimentServiceAssociateAnnotationTagArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceAssociateAnnotationTagArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetExperimentAggrResultRequest
+	var _req *AssociateAnnotationTagReq
 	if src.Req != nil {
-		_req = &BatchGetExperimentAggrResultRequest{}
+		_req = &AssociateAnnotationTagReq{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18880,7 +25323,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceAssociateAnnotationTagResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18924,14 +25367,14 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byt
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceAssociateAnnotationTagResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceAssociateAnnotationTagResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentAggrResultResponse()
+	_field := NewAssociateAnnotationTagResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18941,11 +25384,11 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18954,7 +25397,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(bu
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18963,7 +25406,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18972,7 +25415,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(bu
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18981,15 +25424,15 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultResult)
+func (p *ExperimentServiceAssociateAnnotationTagResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceAssociateAnnotationTagResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetExperimentAggrResultResponse
+	var _success *AssociateAnnotationTagResp
 	if src.Success != nil {
-		_success = &BatchGetExperimentAggrResultResponse{}
+		_success = &AssociateAnnotationTagResp{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18999,7 +25442,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepCopy(s interfa
 	return nil
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19043,14 +25486,14 @@ func (p *ExperimentServiceInvokeExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvokeExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteAnnotationTagArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewInvokeExperimentRequest()
+	_field := NewDeleteAnnotationTagReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19060,11 +25503,11 @@ func (p *ExperimentServiceInvokeExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19073,7 +25516,7 @@ func (p *ExperimentServiceInvokeExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) BLength() int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19082,29 +25525,29 @@ func (p *ExperimentServiceInvokeExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) field1Length() int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceInvokeExperimentArgs)
+func (p *ExperimentServiceDeleteAnnotationTagArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteAnnotationTagArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *InvokeExperimentRequest
+	var _req *DeleteAnnotationTagReq
 	if src.Req != nil {
-		_req = &InvokeExperimentRequest{}
+		_req = &DeleteAnnotationTagReq{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -19114,7 +25557,7 @@ func (p *ExperimentServiceInvokeExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteAnnotationTagResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19158,14 +25601,14 @@ func (p *ExperimentServiceInvokeExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvokeExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteAnnotationTagResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteAnnotationTagResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewInvokeExperimentResponse()
+	_field := NewDeleteAnnotationTagResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19175,11 +25618,11 @@ func (p *ExperimentServiceInvokeExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19188,7 +25631,7 @@ func (p *ExperimentServiceInvokeExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) BLength() int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19197,7 +25640,7 @@ func (p *ExperimentServiceInvokeExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19206,7 +25649,7 @@ func (p *ExperimentServiceInvokeExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) field0Length() int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19215,15 +25658,15 @@ func (p *ExperimentServiceInvokeExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceInvokeExperimentResult)
+func (p *ExperimentServiceDeleteAnnotationTagResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteAnnotationTagResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *InvokeExperimentResponse
+	var _success *DeleteAnnotationTagResp
 	if src.Success != nil {
-		_success = &InvokeExperimentResponse{}
+		_success = &DeleteAnnotationTagResp{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19233,7 +25676,7 @@ func (p *ExperimentServiceInvokeExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19277,14 +25720,14 @@ func (p *ExperimentServiceFinishExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFinishExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateAnnotateRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFinishExperimentRequest()
+	_field := NewCreateAnnotateRecordReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19294,11 +25737,11 @@ func (p *ExperimentServiceFinishExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19307,7 +25750,7 @@ func (p *ExperimentServiceFinishExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) BLength() int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19316,29 +25759,29 @@ func (p *ExperimentServiceFinishExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) field1Length() int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceFinishExperimentArgs)
+func (p *ExperimentServiceCreateAnnotateRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateAnnotateRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *FinishExperimentRequest
+	var _req *CreateAnnotateRecordReq
 	if src.Req != nil {
-		_req = &FinishExperimentRequest{}
+		_req = &CreateAnnotateRecordReq{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -19348,7 +25791,7 @@ func (p *ExperimentServiceFinishExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceFinishExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateAnnotateRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19392,14 +25835,14 @@ func (p *ExperimentServiceFinishExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFinishExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateAnnotateRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceFinishExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateAnnotateRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFinishExperimentResponse()
+	_field := NewCreateAnnotateRecordResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19409,11 +25852,11 @@ func (p *ExperimentServiceFinishExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceFinishExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceFinishExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19422,7 +25865,7 @@ func (p *ExperimentServiceFinishExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceFinishExperimentResult) BLength() int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19431,7 +25874,7 @@ func (p *ExperimentServiceFinishExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceFinishExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19440,7 +25883,7 @@ func (p *ExperimentServiceFinishExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceFinishExperimentResult) field0Length() int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19449,15 +25892,15 @@ func (p *ExperimentServiceFinishExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceFinishExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceFinishExperimentResult)
+func (p *ExperimentServiceCreateAnnotateRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateAnnotateRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *FinishExperimentResponse
+	var _success *CreateAnnotateRecordResp
 	if src.Success != nil {
-		_success = &FinishExperimentResponse{}
+		_success = &CreateAnnotateRecordResp{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19467,7 +25910,7 @@ func (p *ExperimentServiceFinishExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19511,14 +25954,14 @@ func (p *ExperimentServiceListExperimentStatsArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStatsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateAnnotateRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentStatsRequest()
+	_field := NewUpdateAnnotateRecordReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19528,11 +25971,11 @@ func (p *ExperimentServiceListExperimentStatsArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19541,7 +25984,7 @@ func (p *ExperimentServiceListExperimentStatsArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) BLength() int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19550,29 +25993,29 @@ func (p *ExperimentServiceListExperimentStatsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) field1Length() int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentStatsArgs)
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateAnnotateRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExperimentStatsRequest
+	var _req *UpdateAnnotateRecordReq
 	if src.Req != nil {
-		_req = &ListExperimentStatsRequest{}
+		_req = &UpdateAnnotateRecordReq{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -19582,7 +26025,7 @@ func (p *ExperimentServiceListExperimentStatsArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19626,14 +26069,14 @@ func (p *ExperimentServiceListExperimentStatsResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStatsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateAnnotateRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentStatsResponse()
+	_field := NewUpdateAnnotateRecordResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19643,11 +26086,11 @@ func (p *ExperimentServiceListExperimentStatsResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19656,7 +26099,7 @@ func (p *ExperimentServiceListExperimentStatsResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) BLength() int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19665,7 +26108,7 @@ func (p *ExperimentServiceListExperimentStatsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19674,7 +26117,7 @@ func (p *ExperimentServiceListExperimentStatsResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) field0Length() int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19683,15 +26126,15 @@ func (p *ExperimentServiceListExperimentStatsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentStatsResult)
+func (p *ExperimentServiceUpdateAnnotateRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateAnnotateRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExperimentStatsResponse
+	var _success *UpdateAnnotateRecordResp
 	if src.Success != nil {
-		_success = &ListExperimentStatsResponse{}
+		_success = &UpdateAnnotateRecordResp{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19701,7 +26144,7 @@ func (p *ExperimentServiceListExperimentStatsResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19745,14 +26188,14 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpsertExptTurnResultFilterArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpsertExptTurnResultFilterRequest()
+	_field := NewExportExptResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19762,11 +26205,11 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastReadField1(buf []b
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceExportExptResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19775,7 +26218,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) BLength() int {
+func (p *ExperimentServiceExportExptResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19784,29 +26227,29 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) field1Length() int {
+func (p *ExperimentServiceExportExptResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpsertExptTurnResultFilterArgs)
+func (p *ExperimentServiceExportExptResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceExportExptResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpsertExptTurnResultFilterRequest
+	var _req *ExportExptResultRequest
 	if src.Req != nil {
-		_req = &UpsertExptTurnResultFilterRequest{}
+		_req = &ExportExptResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -19816,7 +26259,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19860,14 +26303,14 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpsertExptTurnResultFilterResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpsertExptTurnResultFilterResponse()
+	_field := NewExportExptResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19877,11 +26320,11 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastReadField0(buf [
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceExportExptResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19890,7 +26333,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) BLength() int {
+func (p *ExperimentServiceExportExptResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19899,7 +26342,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19908,7 +26351,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) fastWriteField0(buf
 	return offset
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) field0Length() int {
+func (p *ExperimentServiceExportExptResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19917,15 +26360,15 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpsertExptTurnResultFilterResult)
+func (p *ExperimentServiceExportExptResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceExportExptResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpsertExptTurnResultFilterResponse
+	var _success *ExportExptResultResponse
 	if src.Success != nil {
-		_success = &UpsertExptTurnResultFilterResponse{}
+		_success = &ExportExptResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19935,7 +26378,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) DeepCopy(s interface
 	return nil
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptResultExportRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19979,14 +26422,14 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceAssociateAnnotationTagArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptResultExportRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptResultExportRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAssociateAnnotationTagReq()
+	_field := NewListExptResultExportRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19996,11 +26439,11 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20009,7 +26452,7 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) BLength() int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20018,29 +26461,29 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) field1Length() int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceAssociateAnnotationTagArgs)
+func (p *ExperimentServiceListExptResultExportRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptResultExportRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *AssociateAnnotationTagReq
+	var _req *ListExptResultExportRecordRequest
 	if src.Req != nil {
-		_req = &AssociateAnnotationTagReq{}
+		_req = &ListExptResultExportRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20050,7 +26493,7 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptResultExportRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20094,14 +26537,14 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceAssociateAnnotationTagResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptResultExportRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptResultExportRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAssociateAnnotationTagResp()
+	_field := NewListExptResultExportRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20111,11 +26554,11 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) FastReadField0(buf []byt
 	return offset, nil
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptResultExportRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptResultExportRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20124,7 +26567,7 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) BLength() int {
+func (p *ExperimentServiceListExptResultExportRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20133,7 +26576,7 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptResultExportRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20142,7 +26585,7 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) fastWriteField0(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) field0Length() int {
+func (p *ExperimentServiceListExptResultExportRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20151,15 +26594,15 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceAssociateAnnotationTagResult)
+func (p *ExperimentServiceListExptResultExportRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptResultExportRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *AssociateAnnotationTagResp
+	var _success *ListExptResultExportRecordResponse
 	if src.Success != nil {
-		_success = &AssociateAnnotationTagResp{}
+		_success = &ListExptResultExportRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20169,7 +26612,7 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20213,14 +26656,14 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteAnnotationTagArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptResultExportRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteAnnotationTagReq()
+	_field := NewGetExptResultExportRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20230,11 +26673,11 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20243,7 +26686,7 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) BLength() int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20252,29 +26695,29 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) field1Length() int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteAnnotationTagArgs)
+func (p *ExperimentServiceGetExptResultExportRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptResultExportRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteAnnotationTagReq
+	var _req *GetExptResultExportRecordRequest
 	if src.Req != nil {
-		_req = &DeleteAnnotationTagReq{}
+		_req = &GetExptResultExportRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20284,7 +26727,7 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptResultExportRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20328,14 +26771,14 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteAnnotationTagResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptResultExportRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptResultExportRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteAnnotationTagResp()
+	_field := NewGetExptResultExportRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20345,11 +26788,11 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20358,7 +26801,7 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) BLength() int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20367,7 +26810,7 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20376,7 +26819,7 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) field0Length() int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20385,15 +26828,15 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteAnnotationTagResult)
+func (p *ExperimentServiceGetExptResultExportRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptResultExportRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteAnnotationTagResp
+	var _success *GetExptResultExportRecordResponse
 	if src.Success != nil {
-		_success = &DeleteAnnotationTagResp{}
+		_success = &GetExptResultExportRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20403,7 +26846,7 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateExptScheduleArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20447,14 +26890,14 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateAnnotateRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExptScheduleArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateExptScheduleArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateAnnotateRecordReq()
+	_field := NewCreateExptScheduleRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20464,11 +26907,11 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCreateExptScheduleArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateExptScheduleArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20477,7 +26920,7 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) BLength() int {
+func (p *ExperimentServiceCreateExptScheduleArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20486,29 +26929,29 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateExptScheduleArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) field1Length() int {
+func (p *ExperimentServiceCreateExptScheduleArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCreateAnnotateRecordArgs)
+func (p *ExperimentServiceCreateExptScheduleArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateExptScheduleArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateAnnotateRecordReq
+	var _req *CreateExptScheduleRequest
 	if src.Req != nil {
-		_req = &CreateAnnotateRecordReq{}
+		_req = &CreateExptScheduleRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20518,7 +26961,7 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateExptScheduleResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20562,14 +27005,14 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateAnnotateRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExptScheduleResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateExptScheduleResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateAnnotateRecordResp()
+	_field := NewCreateExptScheduleResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20579,11 +27022,11 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCreateExptScheduleResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateExptScheduleResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20592,7 +27035,7 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) BLength() int {
+func (p *ExperimentServiceCreateExptScheduleResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20601,7 +27044,7 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateExptScheduleResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20610,7 +27053,7 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) field0Length() int {
+func (p *ExperimentServiceCreateExptScheduleResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20619,15 +27062,15 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCreateAnnotateRecordResult)
+func (p *ExperimentServiceCreateExptScheduleResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateExptScheduleResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateAnnotateRecordResp
+	var _success *CreateExptScheduleResponse
 	if src.Success != nil {
-		_success = &CreateAnnotateRecordResp{}
+		_success = &CreateExptScheduleResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20637,7 +27080,7 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateExptScheduleArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20681,14 +27124,14 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateAnnotateRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExptScheduleArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateExptScheduleArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateAnnotateRecordReq()
+	_field := NewUpdateExptScheduleRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20698,11 +27141,11 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateExptScheduleArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExptScheduleArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20711,7 +27154,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) BLength() int {
+func (p *ExperimentServiceUpdateExptScheduleArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20720,29 +27163,29 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExptScheduleArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) field1Length() int {
+func (p *ExperimentServiceUpdateExptScheduleArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateAnnotateRecordArgs)
+func (p *ExperimentServiceUpdateExptScheduleArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExptScheduleArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateAnnotateRecordReq
+	var _req *UpdateExptScheduleRequest
 	if src.Req != nil {
-		_req = &UpdateAnnotateRecordReq{}
+		_req = &UpdateExptScheduleRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20752,7 +27195,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateExptScheduleResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20796,14 +27239,14 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateAnnotateRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExptScheduleResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateExptScheduleResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateAnnotateRecordResp()
+	_field := NewUpdateExptScheduleResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20813,11 +27256,11 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateExptScheduleResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExptScheduleResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20826,7 +27269,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) BLength() int {
+func (p *ExperimentServiceUpdateExptScheduleResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20835,7 +27278,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExptScheduleResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20844,7 +27287,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) field0Length() int {
+func (p *ExperimentServiceUpdateExptScheduleResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20853,15 +27296,15 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateAnnotateRecordResult)
+func (p *ExperimentServiceUpdateExptScheduleResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExptScheduleResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateAnnotateRecordResp
+	var _success *UpdateExptScheduleResponse
 	if src.Success != nil {
-		_success = &UpdateAnnotateRecordResp{}
+		_success = &UpdateExptScheduleResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20871,7 +27314,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceExportExptResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExptScheduleArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20915,14 +27358,14 @@ func (p *ExperimentServiceExportExptResultArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExptScheduleArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceExportExptResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExptScheduleArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExportExptResultRequest()
+	_field := NewDeleteExptScheduleRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20932,11 +27375,11 @@ func (p *ExperimentServiceExportExptResultArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceExportExptResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExptScheduleArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceExportExptResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExptScheduleArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20945,7 +27388,7 @@ func (p *ExperimentServiceExportExptResultArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceExportExptResultArgs) BLength() int {
+func (p *ExperimentServiceDeleteExptScheduleArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20954,29 +27397,29 @@ func (p *ExperimentServiceExportExptResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceExportExptResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExptScheduleArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceExportExptResultArgs) field1Length() int {
+func (p *ExperimentServiceDeleteExptScheduleArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceExportExptResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceExportExptResultArgs)
+func (p *ExperimentServiceDeleteExptScheduleArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExptScheduleArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ExportExptResultRequest
+	var _req *DeleteExptScheduleRequest
 	if src.Req != nil {
-		_req = &ExportExptResultRequest{}
+		_req = &DeleteExptScheduleRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20986,7 +27429,7 @@ func (p *ExperimentServiceExportExptResultArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceExportExptResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExptScheduleResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21030,14 +27473,14 @@ func (p *ExperimentServiceExportExptResultResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExptScheduleResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceExportExptResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExptScheduleResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExportExptResultResponse()
+	_field := NewDeleteExptScheduleResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21047,11 +27490,11 @@ func (p *ExperimentServiceExportExptResultResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceExportExptResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExptScheduleResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceExportExptResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExptScheduleResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21060,7 +27503,7 @@ func (p *ExperimentServiceExportExptResultResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceExportExptResultResult) BLength() int {
+func (p *ExperimentServiceDeleteExptScheduleResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21069,7 +27512,7 @@ func (p *ExperimentServiceExportExptResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceExportExptResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExptScheduleResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21078,7 +27521,7 @@ func (p *ExperimentServiceExportExptResultResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceExportExptResultResult) field0Length() int {
+func (p *ExperimentServiceDeleteExptScheduleResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21087,15 +27530,15 @@ func (p *ExperimentServiceExportExptResultResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceExportExptResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceExportExptResultResult)
+func (p *ExperimentServiceDeleteExptScheduleResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExptScheduleResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ExportExptResultResponse
+	var _success *DeleteExptScheduleResponse
 	if src.Success != nil {
-		_success = &ExportExptResultResponse{}
+		_success = &DeleteExptScheduleResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21105,7 +27548,7 @@ func (p *ExperimentServiceExportExptResultResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptSchedulesArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21149,14 +27592,14 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptResultExportRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptSchedulesArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptSchedulesArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExptResultExportRecordRequest()
+	_field := NewListExptSchedulesRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21166,11 +27609,11 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) FastReadField1(buf []b
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptSchedulesArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptSchedulesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21179,7 +27622,7 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) BLength() int {
+func (p *ExperimentServiceListExptSchedulesArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21188,29 +27631,29 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptSchedulesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) field1Length() int {
+func (p *ExperimentServiceListExptSchedulesArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExptResultExportRecordArgs)
+func (p *ExperimentServiceListExptSchedulesArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptSchedulesArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExptResultExportRecordRequest
+	var _req *ListExptSchedulesRequest
 	if src.Req != nil {
-		_req = &ListExptResultExportRecordRequest{}
+		_req = &ListExptSchedulesRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -21220,7 +27663,7 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptSchedulesResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21264,14 +27707,14 @@ func (p *ExperimentServiceListExptResultExportRecordResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptResultExportRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptSchedulesResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptSchedulesResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExptResultExportRecordResponse()
+	_field := NewListExptSchedulesResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21281,11 +27724,11 @@ func (p *ExperimentServiceListExptResultExportRecordResult) FastReadField0(buf [
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptSchedulesResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptSchedulesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21294,7 +27737,7 @@ func (p *ExperimentServiceListExptResultExportRecordResult) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) BLength() int {
+func (p *ExperimentServiceListExptSchedulesResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21303,7 +27746,7 @@ func (p *ExperimentServiceListExptResultExportRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptSchedulesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21312,7 +27755,7 @@ func (p *ExperimentServiceListExptResultExportRecordResult) fastWriteField0(buf
 	return offset
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) field0Length() int {
+func (p *ExperimentServiceListExptSchedulesResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21321,15 +27764,15 @@ func (p *ExperimentServiceListExptResultExportRecordResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExptResultExportRecordResult)
+func (p *ExperimentServiceListExptSchedulesResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptSchedulesResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExptResultExportRecordResponse
+	var _success *ListExptSchedulesResponse
 	if src.Success != nil {
-		_success = &ListExptResultExportRecordResponse{}
+		_success = &ListExptSchedulesResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21339,7 +27782,7 @@ func (p *ExperimentServiceListExptResultExportRecordResult) DeepCopy(s interface
 	return nil
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptScheduleRunsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21383,14 +27826,14 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) FastRead(buf []byte) (i
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptResultExportRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptScheduleRunsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptScheduleRunsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetExptResultExportRecordRequest()
+	_field := NewListExptScheduleRunsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21400,11 +27843,11 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) FastReadField1(buf []by
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptScheduleRunsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptScheduleRunsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21413,7 +27856,7 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWriteNocopy(buf []b
 	return offset
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) BLength() int {
+func (p *ExperimentServiceListExptScheduleRunsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21422,29 +27865,29 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptScheduleRunsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) field1Length() int {
+func (p *ExperimentServiceListExptScheduleRunsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetExptResultExportRecordArgs)
+func (p *ExperimentServiceListExptScheduleRunsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptScheduleRunsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetExptResultExportRecordRequest
+	var _req *ListExptScheduleRunsRequest
 	if src.Req != nil {
-		_req = &GetExptResultExportRecordRequest{}
+		_req = &ListExptScheduleRunsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -21454,7 +27897,7 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptScheduleRunsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21498,14 +27941,14 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptResultExportRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptScheduleRunsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptScheduleRunsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetExptResultExportRecordResponse()
+	_field := NewListExptScheduleRunsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21515,11 +27958,11 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) FastReadField0(buf []
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptScheduleRunsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptScheduleRunsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21528,7 +27971,7 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) FastWriteNocopy(buf [
 	return offset
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) BLength() int {
+func (p *ExperimentServiceListExptScheduleRunsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21537,7 +27980,7 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptScheduleRunsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21546,7 +27989,7 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) fastWriteField0(buf [
 	return offset
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) field0Length() int {
+func (p *ExperimentServiceListExptScheduleRunsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21555,15 +27998,15 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetExptResultExportRecordResult)
+func (p *ExperimentServiceListExptScheduleRunsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptScheduleRunsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetExptResultExportRecordResponse
+	var _success *ListExptScheduleRunsResponse
 	if src.Success != nil {
-		_success = &GetExptResultExportRecordResponse{}
+		_success = &ListExptScheduleRunsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21669,6 +28112,22 @@ func (p *ExperimentServiceKillExperimentResult) GetResult() interface{} {
 	return p.Success
 }
 
+func (p *ExperimentServicePauseExperimentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServicePauseExperimentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceResumeExperimentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceResumeExperimentResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *ExperimentServiceBatchGetExperimentResultArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
@@ -21685,6 +28144,14 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) GetResult() interf
 	return p.Success
 }
 
+func (p *ExperimentServiceCompareExperimentsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceCompareExperimentsResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *ExperimentServiceInvokeExperimentArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
@@ -21772,3 +28239,43 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) GetFirstArgument() inte
 func (p *ExperimentServiceGetExptResultExportRecordResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *ExperimentServiceCreateExptScheduleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceCreateExptScheduleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceUpdateExptScheduleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceUpdateExptScheduleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceDeleteExptScheduleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceDeleteExptScheduleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceListExptSchedulesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceListExptSchedulesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceListExptScheduleRunsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceListExptScheduleRunsResult) GetResult() interface{} {
+	return p.Success
+}
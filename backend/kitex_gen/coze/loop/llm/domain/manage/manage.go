@@ -28,6 +28,12 @@ const (
 
 	ProtocolArkbot = "arkbot"
 
+	ModelErrorTypeRateLimit = "rate_limit"
+
+	ModelErrorTypeTimeout = "timeout"
+
+	ModelErrorTypeServerError = "server_error"
+
 	ParamTypeFloat = "float"
 
 	ParamTypeInt = "int"
@@ -35,12 +41,38 @@ const (
 	ParamTypeBoolean = "boolean"
 
 	ParamTypeString = "string"
+
+	UsageDimensionWorkspace = "workspace"
+
+	UsageDimensionUser = "user"
+
+	UsageDimensionModel = "model"
+
+	UsageDimensionScenario = "scenario"
+
+	UsageGranularityHour = "hour"
+
+	UsageGranularityDay = "day"
+
+	UsageGranularityMonth = "month"
+
+	QuotaPeriodDay = "day"
+
+	QuotaPeriodMonth = "month"
 )
 
 type Protocol = string
 
+type ModelErrorType = string
+
 type ParamType = string
 
+type UsageDimension = string
+
+type UsageGranularity = string
+
+type QuotaPeriod = string
+
 type Model struct {
 	ModelID         *int64                              `thrift:"model_id,1,optional" frugal:"1,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
 	WorkspaceID     *int64                              `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
@@ -51,6 +83,8 @@ type Model struct {
 	ProtocolConfig  *ProtocolConfig                     `thrift:"protocol_config,7,optional" frugal:"7,optional,ProtocolConfig" form:"protocol_config" json:"protocol_config,omitempty" query:"protocol_config"`
 	ScenarioConfigs map[common.Scenario]*ScenarioConfig `thrift:"scenario_configs,8,optional" frugal:"8,optional,map<string:ScenarioConfig>" form:"scenario_configs" json:"scenario_configs,omitempty" query:"scenario_configs"`
 	ParamConfig     *ParamConfig                        `thrift:"param_config,9,optional" frugal:"9,optional,ParamConfig" form:"param_config" json:"param_config,omitempty" query:"param_config"`
+	// 模型单价，用于统计调用成本
+	Price *Price `thrift:"price,10,optional" frugal:"10,optional,Price" form:"price" json:"price,omitempty" query:"price"`
 }
 
 func NewModel() *Model {
@@ -167,6 +201,18 @@ func (p *Model) GetParamConfig() (v *ParamConfig) {
 	}
 	return p.ParamConfig
 }
+
+var Model_Price_DEFAULT *Price
+
+func (p *Model) GetPrice() (v *Price) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPrice() {
+		return Model_Price_DEFAULT
+	}
+	return p.Price
+}
 func (p *Model) SetModelID(val *int64) {
 	p.ModelID = val
 }
@@ -194,17 +240,21 @@ func (p *Model) SetScenarioConfigs(val map[common.Scenario]*ScenarioConfig) {
 func (p *Model) SetParamConfig(val *ParamConfig) {
 	p.ParamConfig = val
 }
+func (p *Model) SetPrice(val *Price) {
+	p.Price = val
+}
 
 var fieldIDToName_Model = map[int16]string{
-	1: "model_id",
-	2: "workspace_id",
-	3: "name",
-	4: "desc",
-	5: "ability",
-	6: "protocol",
-	7: "protocol_config",
-	8: "scenario_configs",
-	9: "param_config",
+	1:  "model_id",
+	2:  "workspace_id",
+	3:  "name",
+	4:  "desc",
+	5:  "ability",
+	6:  "protocol",
+	7:  "protocol_config",
+	8:  "scenario_configs",
+	9:  "param_config",
+	10: "price",
 }
 
 func (p *Model) IsSetModelID() bool {
@@ -243,6 +293,10 @@ func (p *Model) IsSetParamConfig() bool {
 	return p.ParamConfig != nil
 }
 
+func (p *Model) IsSetPrice() bool {
+	return p.Price != nil
+}
+
 func (p *Model) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -333,6 +387,14 @@ func (p *Model) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -470,6 +532,14 @@ func (p *Model) ReadField9(iprot thrift.TProtocol) error {
 	p.ParamConfig = _field
 	return nil
 }
+func (p *Model) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewPrice()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Price = _field
+	return nil
+}
 
 func (p *Model) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -513,6 +583,10 @@ func (p *Model) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 9
 			goto WriteFieldError
 		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -704,6 +778,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
+func (p *Model) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPrice() {
+		if err = oprot.WriteFieldBegin("price", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Price.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
 
 func (p *Model) String() string {
 	if p == nil {
@@ -746,6 +838,9 @@ func (p *Model) DeepEqual(ano *Model) bool {
 	if !p.Field9DeepEqual(ano.ParamConfig) {
 		return false
 	}
+	if !p.Field10DeepEqual(ano.Price) {
+		return false
+	}
 	return true
 }
 
@@ -843,6 +938,13 @@ func (p *Model) Field9DeepEqual(src *ParamConfig) bool {
 	}
 	return true
 }
+func (p *Model) Field10DeepEqual(src *Price) bool {
+
+	if !p.Price.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 type Ability struct {
 	MaxContextTokens  *int64             `thrift:"max_context_tokens,1,optional" frugal:"1,optional,i64" json:"max_context_tokens" form:"max_context_tokens" query:"max_context_tokens"`
@@ -852,6 +954,8 @@ type Ability struct {
 	JSONMode          *bool              `thrift:"json_mode,5,optional" frugal:"5,optional,bool" form:"json_mode" json:"json_mode,omitempty" query:"json_mode"`
 	MultiModal        *bool              `thrift:"multi_modal,6,optional" frugal:"6,optional,bool" form:"multi_modal" json:"multi_modal,omitempty" query:"multi_modal"`
 	AbilityMultiModal *AbilityMultiModal `thrift:"ability_multi_modal,7,optional" frugal:"7,optional,AbilityMultiModal" form:"ability_multi_modal" json:"ability_multi_modal,omitempty" query:"ability_multi_modal"`
+	// 是否为向量化模型，向量化模型只能通过向量化接口调用
+	Embedding *bool `thrift:"embedding,8,optional" frugal:"8,optional,bool" form:"embedding" json:"embedding,omitempty" query:"embedding"`
 }
 
 func NewAbility() *Ability {
@@ -944,6 +1048,18 @@ func (p *Ability) GetAbilityMultiModal() (v *AbilityMultiModal) {
 	}
 	return p.AbilityMultiModal
 }
+
+var Ability_Embedding_DEFAULT bool
+
+func (p *Ability) GetEmbedding() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEmbedding() {
+		return Ability_Embedding_DEFAULT
+	}
+	return *p.Embedding
+}
 func (p *Ability) SetMaxContextTokens(val *int64) {
 	p.MaxContextTokens = val
 }
@@ -965,6 +1081,9 @@ func (p *Ability) SetMultiModal(val *bool) {
 func (p *Ability) SetAbilityMultiModal(val *AbilityMultiModal) {
 	p.AbilityMultiModal = val
 }
+func (p *Ability) SetEmbedding(val *bool) {
+	p.Embedding = val
+}
 
 var fieldIDToName_Ability = map[int16]string{
 	1: "max_context_tokens",
@@ -974,6 +1093,7 @@ var fieldIDToName_Ability = map[int16]string{
 	5: "json_mode",
 	6: "multi_modal",
 	7: "ability_multi_modal",
+	8: "embedding",
 }
 
 func (p *Ability) IsSetMaxContextTokens() bool {
@@ -1004,6 +1124,10 @@ func (p *Ability) IsSetAbilityMultiModal() bool {
 	return p.AbilityMultiModal != nil
 }
 
+func (p *Ability) IsSetEmbedding() bool {
+	return p.Embedding != nil
+}
+
 func (p *Ability) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1078,6 +1202,14 @@ func (p *Ability) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1181,6 +1313,17 @@ func (p *Ability) ReadField7(iprot thrift.TProtocol) error {
 	p.AbilityMultiModal = _field
 	return nil
 }
+func (p *Ability) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Embedding = _field
+	return nil
+}
 
 func (p *Ability) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1216,6 +1359,10 @@ func (p *Ability) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1360,6 +1507,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
+func (p *Ability) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEmbedding() {
+		if err = oprot.WriteFieldBegin("embedding", thrift.BOOL, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Embedding); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
 
 func (p *Ability) String() string {
 	if p == nil {
@@ -1396,6 +1561,9 @@ func (p *Ability) DeepEqual(ano *Ability) bool {
 	if !p.Field7DeepEqual(ano.AbilityMultiModal) {
 		return false
 	}
+	if !p.Field8DeepEqual(ano.Embedding) {
+		return false
+	}
 	return true
 }
 
@@ -1478,6 +1646,18 @@ func (p *Ability) Field7DeepEqual(src *AbilityMultiModal) bool {
 	}
 	return true
 }
+func (p *Ability) Field8DeepEqual(src *bool) bool {
+
+	if p.Embedding == src {
+		return true
+	} else if p.Embedding == nil || src == nil {
+		return false
+	}
+	if *p.Embedding != *src {
+		return false
+	}
+	return true
+}
 
 type AbilityMultiModal struct {
 	Image        *bool         `thrift:"image,1,optional" frugal:"1,optional,bool" form:"image" json:"image,omitempty" query:"image"`
@@ -6920,6 +7100,8 @@ type ScenarioConfig struct {
 	Scenario    *common.Scenario `thrift:"scenario,1,optional" frugal:"1,optional,string" form:"scenario" json:"scenario,omitempty" query:"scenario"`
 	Quota       *Quota           `thrift:"quota,3,optional" frugal:"3,optional,Quota" form:"quota" json:"quota,omitempty" query:"quota"`
 	Unavailable *bool            `thrift:"unavailable,4,optional" frugal:"4,optional,bool" form:"unavailable" json:"unavailable,omitempty" query:"unavailable"`
+	// 该场景下的路由策略
+	Routing *RoutingPolicy `thrift:"routing,5,optional" frugal:"5,optional,RoutingPolicy" form:"routing" json:"routing,omitempty" query:"routing"`
 }
 
 func NewScenarioConfig() *ScenarioConfig {
@@ -6964,6 +7146,18 @@ func (p *ScenarioConfig) GetUnavailable() (v bool) {
 	}
 	return *p.Unavailable
 }
+
+var ScenarioConfig_Routing_DEFAULT *RoutingPolicy
+
+func (p *ScenarioConfig) GetRouting() (v *RoutingPolicy) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRouting() {
+		return ScenarioConfig_Routing_DEFAULT
+	}
+	return p.Routing
+}
 func (p *ScenarioConfig) SetScenario(val *common.Scenario) {
 	p.Scenario = val
 }
@@ -6973,11 +7167,15 @@ func (p *ScenarioConfig) SetQuota(val *Quota) {
 func (p *ScenarioConfig) SetUnavailable(val *bool) {
 	p.Unavailable = val
 }
+func (p *ScenarioConfig) SetRouting(val *RoutingPolicy) {
+	p.Routing = val
+}
 
 var fieldIDToName_ScenarioConfig = map[int16]string{
 	1: "scenario",
 	3: "quota",
 	4: "unavailable",
+	5: "routing",
 }
 
 func (p *ScenarioConfig) IsSetScenario() bool {
@@ -6992,6 +7190,10 @@ func (p *ScenarioConfig) IsSetUnavailable() bool {
 	return p.Unavailable != nil
 }
 
+func (p *ScenarioConfig) IsSetRouting() bool {
+	return p.Routing != nil
+}
+
 func (p *ScenarioConfig) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7034,6 +7236,14 @@ func (p *ScenarioConfig) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7093,6 +7303,14 @@ func (p *ScenarioConfig) ReadField4(iprot thrift.TProtocol) error {
 	p.Unavailable = _field
 	return nil
 }
+func (p *ScenarioConfig) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewRoutingPolicy()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Routing = _field
+	return nil
+}
 
 func (p *ScenarioConfig) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -7112,6 +7330,10 @@ func (p *ScenarioConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7184,6 +7406,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
+func (p *ScenarioConfig) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRouting() {
+		if err = oprot.WriteFieldBegin("routing", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Routing.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
 func (p *ScenarioConfig) String() string {
 	if p == nil {
@@ -7208,6 +7448,9 @@ func (p *ScenarioConfig) DeepEqual(ano *ScenarioConfig) bool {
 	if !p.Field4DeepEqual(ano.Unavailable) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.Routing) {
+		return false
+	}
 	return true
 }
 
@@ -7242,42 +7485,116 @@ func (p *ScenarioConfig) Field4DeepEqual(src *bool) bool {
 	}
 	return true
 }
+func (p *ScenarioConfig) Field5DeepEqual(src *RoutingPolicy) bool {
 
-type ParamConfig struct {
-	ParamSchemas []*ParamSchema `thrift:"param_schemas,1,optional" frugal:"1,optional,list<ParamSchema>" form:"param_schemas" json:"param_schemas,omitempty" query:"param_schemas"`
+	if !p.Routing.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewParamConfig() *ParamConfig {
-	return &ParamConfig{}
+type RoutingPolicy struct {
+	// 主模型在负载均衡中的权重
+	Weight *int64 `thrift:"weight,1,optional" frugal:"1,optional,i64" json:"weight" form:"weight" query:"weight"`
+	// 与主模型等价的模型，按权重分流
+	Balance []*RoutingTarget `thrift:"balance,2,optional" frugal:"2,optional,list<RoutingTarget>" form:"balance" json:"balance,omitempty" query:"balance"`
+	// 请求失败时按顺序降级的模型
+	Fallbacks []*RoutingTarget `thrift:"fallbacks,3,optional" frugal:"3,optional,list<RoutingTarget>" form:"fallbacks" json:"fallbacks,omitempty" query:"fallbacks"`
+	// 触发降级的错误类型，为空时限流、超时、服务端错误均降级
+	FallbackOn []ModelErrorType `thrift:"fallback_on,4,optional" frugal:"4,optional,list<string>" form:"fallback_on" json:"fallback_on,omitempty" query:"fallback_on"`
 }
 
-func (p *ParamConfig) InitDefault() {
+func NewRoutingPolicy() *RoutingPolicy {
+	return &RoutingPolicy{}
 }
 
-var ParamConfig_ParamSchemas_DEFAULT []*ParamSchema
+func (p *RoutingPolicy) InitDefault() {
+}
 
-func (p *ParamConfig) GetParamSchemas() (v []*ParamSchema) {
+var RoutingPolicy_Weight_DEFAULT int64
+
+func (p *RoutingPolicy) GetWeight() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetParamSchemas() {
-		return ParamConfig_ParamSchemas_DEFAULT
+	if !p.IsSetWeight() {
+		return RoutingPolicy_Weight_DEFAULT
 	}
-	return p.ParamSchemas
+	return *p.Weight
 }
-func (p *ParamConfig) SetParamSchemas(val []*ParamSchema) {
-	p.ParamSchemas = val
+
+var RoutingPolicy_Balance_DEFAULT []*RoutingTarget
+
+func (p *RoutingPolicy) GetBalance() (v []*RoutingTarget) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBalance() {
+		return RoutingPolicy_Balance_DEFAULT
+	}
+	return p.Balance
 }
 
-var fieldIDToName_ParamConfig = map[int16]string{
-	1: "param_schemas",
+var RoutingPolicy_Fallbacks_DEFAULT []*RoutingTarget
+
+func (p *RoutingPolicy) GetFallbacks() (v []*RoutingTarget) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFallbacks() {
+		return RoutingPolicy_Fallbacks_DEFAULT
+	}
+	return p.Fallbacks
 }
 
-func (p *ParamConfig) IsSetParamSchemas() bool {
-	return p.ParamSchemas != nil
+var RoutingPolicy_FallbackOn_DEFAULT []ModelErrorType
+
+func (p *RoutingPolicy) GetFallbackOn() (v []ModelErrorType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFallbackOn() {
+		return RoutingPolicy_FallbackOn_DEFAULT
+	}
+	return p.FallbackOn
+}
+func (p *RoutingPolicy) SetWeight(val *int64) {
+	p.Weight = val
+}
+func (p *RoutingPolicy) SetBalance(val []*RoutingTarget) {
+	p.Balance = val
+}
+func (p *RoutingPolicy) SetFallbacks(val []*RoutingTarget) {
+	p.Fallbacks = val
+}
+func (p *RoutingPolicy) SetFallbackOn(val []ModelErrorType) {
+	p.FallbackOn = val
 }
 
-func (p *ParamConfig) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_RoutingPolicy = map[int16]string{
+	1: "weight",
+	2: "balance",
+	3: "fallbacks",
+	4: "fallback_on",
+}
+
+func (p *RoutingPolicy) IsSetWeight() bool {
+	return p.Weight != nil
+}
+
+func (p *RoutingPolicy) IsSetBalance() bool {
+	return p.Balance != nil
+}
+
+func (p *RoutingPolicy) IsSetFallbacks() bool {
+	return p.Fallbacks != nil
+}
+
+func (p *RoutingPolicy) IsSetFallbackOn() bool {
+	return p.FallbackOn != nil
+}
+
+func (p *RoutingPolicy) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7296,13 +7613,37 @@ func (p *ParamConfig) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7322,7 +7663,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParamConfig[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoutingPolicy[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7332,13 +7673,24 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ParamConfig) ReadField1(iprot thrift.TProtocol) error {
+func (p *RoutingPolicy) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Weight = _field
+	return nil
+}
+func (p *RoutingPolicy) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*ParamSchema, 0, size)
-	values := make([]ParamSchema, size)
+	_field := make([]*RoutingTarget, 0, size)
+	values := make([]RoutingTarget, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -7352,13 +7704,59 @@ func (p *ParamConfig) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.ParamSchemas = _field
+	p.Balance = _field
 	return nil
 }
-
-func (p *ParamConfig) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoutingPolicy) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*RoutingTarget, 0, size)
+	values := make([]RoutingTarget, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Fallbacks = _field
+	return nil
+}
+func (p *RoutingPolicy) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]ModelErrorType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem ModelErrorType
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FallbackOn = _field
+	return nil
+}
+
+func (p *RoutingPolicy) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ParamConfig"); err != nil {
+	if err = oprot.WriteStructBegin("RoutingPolicy"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7366,6 +7764,18 @@ func (p *ParamConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7384,15 +7794,33 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ParamConfig) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetParamSchemas() {
-		if err = oprot.WriteFieldBegin("param_schemas", thrift.LIST, 1); err != nil {
+func (p *RoutingPolicy) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeight() {
+		if err = oprot.WriteFieldBegin("weight", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ParamSchemas)); err != nil {
+		if err := oprot.WriteI64(*p.Weight); err != nil {
 			return err
 		}
-		for _, v := range p.ParamSchemas {
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RoutingPolicy) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBalance() {
+		if err = oprot.WriteFieldBegin("balance", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Balance)); err != nil {
+			return err
+		}
+		for _, v := range p.Balance {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -7406,37 +7834,110 @@ func (p *ParamConfig) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RoutingPolicy) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFallbacks() {
+		if err = oprot.WriteFieldBegin("fallbacks", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Fallbacks)); err != nil {
+			return err
+		}
+		for _, v := range p.Fallbacks {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RoutingPolicy) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFallbackOn() {
+		if err = oprot.WriteFieldBegin("fallback_on", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.FallbackOn)); err != nil {
+			return err
+		}
+		for _, v := range p.FallbackOn {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ParamConfig) String() string {
+func (p *RoutingPolicy) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ParamConfig(%+v)", *p)
+	return fmt.Sprintf("RoutingPolicy(%+v)", *p)
 
 }
 
-func (p *ParamConfig) DeepEqual(ano *ParamConfig) bool {
+func (p *RoutingPolicy) DeepEqual(ano *RoutingPolicy) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ParamSchemas) {
+	if !p.Field1DeepEqual(ano.Weight) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Balance) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Fallbacks) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.FallbackOn) {
 		return false
 	}
 	return true
 }
 
-func (p *ParamConfig) Field1DeepEqual(src []*ParamSchema) bool {
+func (p *RoutingPolicy) Field1DeepEqual(src *int64) bool {
 
-	if len(p.ParamSchemas) != len(src) {
+	if p.Weight == src {
+		return true
+	} else if p.Weight == nil || src == nil {
 		return false
 	}
-	for i, v := range p.ParamSchemas {
+	if *p.Weight != *src {
+		return false
+	}
+	return true
+}
+func (p *RoutingPolicy) Field2DeepEqual(src []*RoutingTarget) bool {
+
+	if len(p.Balance) != len(src) {
+		return false
+	}
+	for i, v := range p.Balance {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -7444,191 +7945,2065 @@ func (p *ParamConfig) Field1DeepEqual(src []*ParamSchema) bool {
 	}
 	return true
 }
+func (p *RoutingPolicy) Field3DeepEqual(src []*RoutingTarget) bool {
 
-type ParamSchema struct {
-	// 实际名称
-	Name *string `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	// 展示名称
-	Label        *string        `thrift:"label,2,optional" frugal:"2,optional,string" form:"label" json:"label,omitempty" query:"label"`
-	Desc         *string        `thrift:"desc,3,optional" frugal:"3,optional,string" form:"desc" json:"desc,omitempty" query:"desc"`
-	Type         *ParamType     `thrift:"type,4,optional" frugal:"4,optional,string" form:"type" json:"type,omitempty" query:"type"`
-	Min          *string        `thrift:"min,5,optional" frugal:"5,optional,string" form:"min" json:"min,omitempty" query:"min"`
-	Max          *string        `thrift:"max,6,optional" frugal:"6,optional,string" form:"max" json:"max,omitempty" query:"max"`
-	DefaultValue *string        `thrift:"default_value,7,optional" frugal:"7,optional,string" form:"default_value" json:"default_value,omitempty" query:"default_value"`
-	Options      []*ParamOption `thrift:"options,8,optional" frugal:"8,optional,list<ParamOption>" form:"options" json:"options,omitempty" query:"options"`
+	if len(p.Fallbacks) != len(src) {
+		return false
+	}
+	for i, v := range p.Fallbacks {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
+func (p *RoutingPolicy) Field4DeepEqual(src []ModelErrorType) bool {
 
-func NewParamSchema() *ParamSchema {
-	return &ParamSchema{}
+	if len(p.FallbackOn) != len(src) {
+		return false
+	}
+	for i, v := range p.FallbackOn {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
 }
 
-func (p *ParamSchema) InitDefault() {
+type RoutingTarget struct {
+	ModelID *int64 `thrift:"model_id,1,optional" frugal:"1,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	Weight  *int64 `thrift:"weight,2,optional" frugal:"2,optional,i64" json:"weight" form:"weight" query:"weight"`
 }
 
-var ParamSchema_Name_DEFAULT string
-
-func (p *ParamSchema) GetName() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetName() {
-		return ParamSchema_Name_DEFAULT
-	}
-	return *p.Name
+func NewRoutingTarget() *RoutingTarget {
+	return &RoutingTarget{}
 }
 
-var ParamSchema_Label_DEFAULT string
-
-func (p *ParamSchema) GetLabel() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetLabel() {
-		return ParamSchema_Label_DEFAULT
-	}
-	return *p.Label
+func (p *RoutingTarget) InitDefault() {
 }
 
-var ParamSchema_Desc_DEFAULT string
+var RoutingTarget_ModelID_DEFAULT int64
 
-func (p *ParamSchema) GetDesc() (v string) {
+func (p *RoutingTarget) GetModelID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDesc() {
-		return ParamSchema_Desc_DEFAULT
+	if !p.IsSetModelID() {
+		return RoutingTarget_ModelID_DEFAULT
 	}
-	return *p.Desc
+	return *p.ModelID
 }
 
-var ParamSchema_Type_DEFAULT ParamType
+var RoutingTarget_Weight_DEFAULT int64
 
-func (p *ParamSchema) GetType() (v ParamType) {
+func (p *RoutingTarget) GetWeight() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetType() {
-		return ParamSchema_Type_DEFAULT
+	if !p.IsSetWeight() {
+		return RoutingTarget_Weight_DEFAULT
 	}
-	return *p.Type
+	return *p.Weight
+}
+func (p *RoutingTarget) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *RoutingTarget) SetWeight(val *int64) {
+	p.Weight = val
 }
 
-var ParamSchema_Min_DEFAULT string
-
-func (p *ParamSchema) GetMin() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetMin() {
-		return ParamSchema_Min_DEFAULT
-	}
-	return *p.Min
+var fieldIDToName_RoutingTarget = map[int16]string{
+	1: "model_id",
+	2: "weight",
 }
 
-var ParamSchema_Max_DEFAULT string
+func (p *RoutingTarget) IsSetModelID() bool {
+	return p.ModelID != nil
+}
 
-func (p *ParamSchema) GetMax() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetMax() {
-		return ParamSchema_Max_DEFAULT
-	}
-	return *p.Max
+func (p *RoutingTarget) IsSetWeight() bool {
+	return p.Weight != nil
 }
 
-var ParamSchema_DefaultValue_DEFAULT string
+func (p *RoutingTarget) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *ParamSchema) GetDefaultValue() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetDefaultValue() {
-		return ParamSchema_DefaultValue_DEFAULT
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return *p.DefaultValue
-}
 
-var ParamSchema_Options_DEFAULT []*ParamOption
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func (p *ParamSchema) GetOptions() (v []*ParamOption) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetOptions() {
-		return ParamSchema_Options_DEFAULT
-	}
-	return p.Options
-}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoutingTarget[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RoutingTarget) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *RoutingTarget) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Weight = _field
+	return nil
+}
+
+func (p *RoutingTarget) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RoutingTarget"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RoutingTarget) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RoutingTarget) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeight() {
+		if err = oprot.WriteFieldBegin("weight", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Weight); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RoutingTarget) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoutingTarget(%+v)", *p)
+
+}
+
+func (p *RoutingTarget) DeepEqual(ano *RoutingTarget) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Weight) {
+		return false
+	}
+	return true
+}
+
+func (p *RoutingTarget) Field1DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *RoutingTarget) Field2DeepEqual(src *int64) bool {
+
+	if p.Weight == src {
+		return true
+	} else if p.Weight == nil || src == nil {
+		return false
+	}
+	if *p.Weight != *src {
+		return false
+	}
+	return true
+}
+
+type ParamConfig struct {
+	ParamSchemas []*ParamSchema `thrift:"param_schemas,1,optional" frugal:"1,optional,list<ParamSchema>" form:"param_schemas" json:"param_schemas,omitempty" query:"param_schemas"`
+}
+
+func NewParamConfig() *ParamConfig {
+	return &ParamConfig{}
+}
+
+func (p *ParamConfig) InitDefault() {
+}
+
+var ParamConfig_ParamSchemas_DEFAULT []*ParamSchema
+
+func (p *ParamConfig) GetParamSchemas() (v []*ParamSchema) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetParamSchemas() {
+		return ParamConfig_ParamSchemas_DEFAULT
+	}
+	return p.ParamSchemas
+}
+func (p *ParamConfig) SetParamSchemas(val []*ParamSchema) {
+	p.ParamSchemas = val
+}
+
+var fieldIDToName_ParamConfig = map[int16]string{
+	1: "param_schemas",
+}
+
+func (p *ParamConfig) IsSetParamSchemas() bool {
+	return p.ParamSchemas != nil
+}
+
+func (p *ParamConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParamConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ParamConfig) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ParamSchema, 0, size)
+	values := make([]ParamSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ParamSchemas = _field
+	return nil
+}
+
+func (p *ParamConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ParamConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ParamConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParamSchemas() {
+		if err = oprot.WriteFieldBegin("param_schemas", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ParamSchemas)); err != nil {
+			return err
+		}
+		for _, v := range p.ParamSchemas {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ParamConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ParamConfig(%+v)", *p)
+
+}
+
+func (p *ParamConfig) DeepEqual(ano *ParamConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ParamSchemas) {
+		return false
+	}
+	return true
+}
+
+func (p *ParamConfig) Field1DeepEqual(src []*ParamSchema) bool {
+
+	if len(p.ParamSchemas) != len(src) {
+		return false
+	}
+	for i, v := range p.ParamSchemas {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ParamSchema struct {
+	// 实际名称
+	Name *string `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	// 展示名称
+	Label        *string        `thrift:"label,2,optional" frugal:"2,optional,string" form:"label" json:"label,omitempty" query:"label"`
+	Desc         *string        `thrift:"desc,3,optional" frugal:"3,optional,string" form:"desc" json:"desc,omitempty" query:"desc"`
+	Type         *ParamType     `thrift:"type,4,optional" frugal:"4,optional,string" form:"type" json:"type,omitempty" query:"type"`
+	Min          *string        `thrift:"min,5,optional" frugal:"5,optional,string" form:"min" json:"min,omitempty" query:"min"`
+	Max          *string        `thrift:"max,6,optional" frugal:"6,optional,string" form:"max" json:"max,omitempty" query:"max"`
+	DefaultValue *string        `thrift:"default_value,7,optional" frugal:"7,optional,string" form:"default_value" json:"default_value,omitempty" query:"default_value"`
+	Options      []*ParamOption `thrift:"options,8,optional" frugal:"8,optional,list<ParamOption>" form:"options" json:"options,omitempty" query:"options"`
+}
+
+func NewParamSchema() *ParamSchema {
+	return &ParamSchema{}
+}
+
+func (p *ParamSchema) InitDefault() {
+}
+
+var ParamSchema_Name_DEFAULT string
+
+func (p *ParamSchema) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return ParamSchema_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var ParamSchema_Label_DEFAULT string
+
+func (p *ParamSchema) GetLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return ParamSchema_Label_DEFAULT
+	}
+	return *p.Label
+}
+
+var ParamSchema_Desc_DEFAULT string
+
+func (p *ParamSchema) GetDesc() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDesc() {
+		return ParamSchema_Desc_DEFAULT
+	}
+	return *p.Desc
+}
+
+var ParamSchema_Type_DEFAULT ParamType
+
+func (p *ParamSchema) GetType() (v ParamType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetType() {
+		return ParamSchema_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var ParamSchema_Min_DEFAULT string
+
+func (p *ParamSchema) GetMin() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMin() {
+		return ParamSchema_Min_DEFAULT
+	}
+	return *p.Min
+}
+
+var ParamSchema_Max_DEFAULT string
+
+func (p *ParamSchema) GetMax() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMax() {
+		return ParamSchema_Max_DEFAULT
+	}
+	return *p.Max
+}
+
+var ParamSchema_DefaultValue_DEFAULT string
+
+func (p *ParamSchema) GetDefaultValue() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDefaultValue() {
+		return ParamSchema_DefaultValue_DEFAULT
+	}
+	return *p.DefaultValue
+}
+
+var ParamSchema_Options_DEFAULT []*ParamOption
+
+func (p *ParamSchema) GetOptions() (v []*ParamOption) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOptions() {
+		return ParamSchema_Options_DEFAULT
+	}
+	return p.Options
+}
 func (p *ParamSchema) SetName(val *string) {
 	p.Name = val
 }
-func (p *ParamSchema) SetLabel(val *string) {
-	p.Label = val
+func (p *ParamSchema) SetLabel(val *string) {
+	p.Label = val
+}
+func (p *ParamSchema) SetDesc(val *string) {
+	p.Desc = val
+}
+func (p *ParamSchema) SetType(val *ParamType) {
+	p.Type = val
+}
+func (p *ParamSchema) SetMin(val *string) {
+	p.Min = val
+}
+func (p *ParamSchema) SetMax(val *string) {
+	p.Max = val
+}
+func (p *ParamSchema) SetDefaultValue(val *string) {
+	p.DefaultValue = val
+}
+func (p *ParamSchema) SetOptions(val []*ParamOption) {
+	p.Options = val
+}
+
+var fieldIDToName_ParamSchema = map[int16]string{
+	1: "name",
+	2: "label",
+	3: "desc",
+	4: "type",
+	5: "min",
+	6: "max",
+	7: "default_value",
+	8: "options",
+}
+
+func (p *ParamSchema) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *ParamSchema) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *ParamSchema) IsSetDesc() bool {
+	return p.Desc != nil
+}
+
+func (p *ParamSchema) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *ParamSchema) IsSetMin() bool {
+	return p.Min != nil
+}
+
+func (p *ParamSchema) IsSetMax() bool {
+	return p.Max != nil
+}
+
+func (p *ParamSchema) IsSetDefaultValue() bool {
+	return p.DefaultValue != nil
+}
+
+func (p *ParamSchema) IsSetOptions() bool {
+	return p.Options != nil
+}
+
+func (p *ParamSchema) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParamSchema[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ParamSchema) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *ParamSchema) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Label = _field
+	return nil
+}
+func (p *ParamSchema) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Desc = _field
+	return nil
+}
+func (p *ParamSchema) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *ParamType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *ParamSchema) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Min = _field
+	return nil
+}
+func (p *ParamSchema) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Max = _field
+	return nil
+}
+func (p *ParamSchema) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DefaultValue = _field
+	return nil
+}
+func (p *ParamSchema) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ParamOption, 0, size)
+	values := make([]ParamOption, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Options = _field
+	return nil
+}
+
+func (p *ParamSchema) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ParamSchema"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ParamSchema) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ParamSchema) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ParamSchema) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDesc() {
+		if err = oprot.WriteFieldBegin("desc", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Desc); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ParamSchema) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Type); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ParamSchema) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMin() {
+		if err = oprot.WriteFieldBegin("min", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Min); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ParamSchema) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMax() {
+		if err = oprot.WriteFieldBegin("max", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Max); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ParamSchema) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDefaultValue() {
+		if err = oprot.WriteFieldBegin("default_value", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DefaultValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ParamSchema) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOptions() {
+		if err = oprot.WriteFieldBegin("options", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Options)); err != nil {
+			return err
+		}
+		for _, v := range p.Options {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ParamSchema) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ParamSchema(%+v)", *p)
+
+}
+
+func (p *ParamSchema) DeepEqual(ano *ParamSchema) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Label) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Desc) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Min) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Max) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.DefaultValue) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Options) {
+		return false
+	}
+	return true
+}
+
+func (p *ParamSchema) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ParamSchema) Field2DeepEqual(src *string) bool {
+
+	if p.Label == src {
+		return true
+	} else if p.Label == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Label, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ParamSchema) Field3DeepEqual(src *string) bool {
+
+	if p.Desc == src {
+		return true
+	} else if p.Desc == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Desc, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ParamSchema) Field4DeepEqual(src *ParamType) bool {
+
+	if p.Type == src {
+		return true
+	} else if p.Type == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Type, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ParamSchema) Field5DeepEqual(src *string) bool {
+
+	if p.Min == src {
+		return true
+	} else if p.Min == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Min, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ParamSchema) Field6DeepEqual(src *string) bool {
+
+	if p.Max == src {
+		return true
+	} else if p.Max == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Max, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ParamSchema) Field7DeepEqual(src *string) bool {
+
+	if p.DefaultValue == src {
+		return true
+	} else if p.DefaultValue == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.DefaultValue, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ParamSchema) Field8DeepEqual(src []*ParamOption) bool {
+
+	if len(p.Options) != len(src) {
+		return false
+	}
+	for i, v := range p.Options {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ParamOption struct {
+	// 实际值
+	Value *string `thrift:"value,1,optional" frugal:"1,optional,string" form:"value" json:"value,omitempty" query:"value"`
+	// 展示值
+	Label *string `thrift:"label,2,optional" frugal:"2,optional,string" form:"label" json:"label,omitempty" query:"label"`
+}
+
+func NewParamOption() *ParamOption {
+	return &ParamOption{}
+}
+
+func (p *ParamOption) InitDefault() {
+}
+
+var ParamOption_Value_DEFAULT string
+
+func (p *ParamOption) GetValue() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetValue() {
+		return ParamOption_Value_DEFAULT
+	}
+	return *p.Value
+}
+
+var ParamOption_Label_DEFAULT string
+
+func (p *ParamOption) GetLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return ParamOption_Label_DEFAULT
+	}
+	return *p.Label
+}
+func (p *ParamOption) SetValue(val *string) {
+	p.Value = val
+}
+func (p *ParamOption) SetLabel(val *string) {
+	p.Label = val
+}
+
+var fieldIDToName_ParamOption = map[int16]string{
+	1: "value",
+	2: "label",
+}
+
+func (p *ParamOption) IsSetValue() bool {
+	return p.Value != nil
+}
+
+func (p *ParamOption) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *ParamOption) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParamOption[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ParamOption) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Value = _field
+	return nil
+}
+func (p *ParamOption) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Label = _field
+	return nil
+}
+
+func (p *ParamOption) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ParamOption"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ParamOption) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err = oprot.WriteFieldBegin("value", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Value); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ParamOption) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ParamOption) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ParamOption(%+v)", *p)
+
+}
+
+func (p *ParamOption) DeepEqual(ano *ParamOption) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Value) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Label) {
+		return false
+	}
+	return true
+}
+
+func (p *ParamOption) Field1DeepEqual(src *string) bool {
+
+	if p.Value == src {
+		return true
+	} else if p.Value == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Value, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ParamOption) Field2DeepEqual(src *string) bool {
+
+	if p.Label == src {
+		return true
+	} else if p.Label == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Label, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 单价均为每百万 token 的价格，全局使用同一币种
+type Price struct {
+	InputPrice  *float64 `thrift:"input_price,1,optional" frugal:"1,optional,double" form:"input_price" json:"input_price,omitempty" query:"input_price"`
+	OutputPrice *float64 `thrift:"output_price,2,optional" frugal:"2,optional,double" form:"output_price" json:"output_price,omitempty" query:"output_price"`
+}
+
+func NewPrice() *Price {
+	return &Price{}
+}
+
+func (p *Price) InitDefault() {
+}
+
+var Price_InputPrice_DEFAULT float64
+
+func (p *Price) GetInputPrice() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputPrice() {
+		return Price_InputPrice_DEFAULT
+	}
+	return *p.InputPrice
+}
+
+var Price_OutputPrice_DEFAULT float64
+
+func (p *Price) GetOutputPrice() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputPrice() {
+		return Price_OutputPrice_DEFAULT
+	}
+	return *p.OutputPrice
+}
+func (p *Price) SetInputPrice(val *float64) {
+	p.InputPrice = val
+}
+func (p *Price) SetOutputPrice(val *float64) {
+	p.OutputPrice = val
+}
+
+var fieldIDToName_Price = map[int16]string{
+	1: "input_price",
+	2: "output_price",
+}
+
+func (p *Price) IsSetInputPrice() bool {
+	return p.InputPrice != nil
+}
+
+func (p *Price) IsSetOutputPrice() bool {
+	return p.OutputPrice != nil
+}
+
+func (p *Price) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Price[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Price) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.InputPrice = _field
+	return nil
+}
+func (p *Price) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OutputPrice = _field
+	return nil
+}
+
+func (p *Price) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Price"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Price) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputPrice() {
+		if err = oprot.WriteFieldBegin("input_price", thrift.DOUBLE, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.InputPrice); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Price) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputPrice() {
+		if err = oprot.WriteFieldBegin("output_price", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.OutputPrice); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *Price) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Price(%+v)", *p)
+
+}
+
+func (p *Price) DeepEqual(ano *Price) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.InputPrice) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.OutputPrice) {
+		return false
+	}
+	return true
+}
+
+func (p *Price) Field1DeepEqual(src *float64) bool {
+
+	if p.InputPrice == src {
+		return true
+	} else if p.InputPrice == nil || src == nil {
+		return false
+	}
+	if *p.InputPrice != *src {
+		return false
+	}
+	return true
+}
+func (p *Price) Field2DeepEqual(src *float64) bool {
+
+	if p.OutputPrice == src {
+		return true
+	} else if p.OutputPrice == nil || src == nil {
+		return false
+	}
+	if *p.OutputPrice != *src {
+		return false
+	}
+	return true
+}
+
+// 模型调用统计，未参与分组的维度为空
+type ModelUsageStat struct {
+	WorkspaceID *int64           `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	UserID      *string          `thrift:"user_id,2,optional" frugal:"2,optional,string" form:"user_id" json:"user_id,omitempty" query:"user_id"`
+	ModelID     *int64           `thrift:"model_id,3,optional" frugal:"3,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	ModelName   *string          `thrift:"model_name,4,optional" frugal:"4,optional,string" form:"model_name" json:"model_name,omitempty" query:"model_name"`
+	Scenario    *common.Scenario `thrift:"scenario,5,optional" frugal:"5,optional,string" form:"scenario" json:"scenario,omitempty" query:"scenario"`
+	// 时间桶的起始时间，毫秒时间戳
+	TimeBucket    *int64   `thrift:"time_bucket,6,optional" frugal:"6,optional,i64" json:"time_bucket" form:"time_bucket" query:"time_bucket"`
+	RequestCount  *int64   `thrift:"request_count,20,optional" frugal:"20,optional,i64" json:"request_count" form:"request_count" query:"request_count"`
+	ErrorCount    *int64   `thrift:"error_count,21,optional" frugal:"21,optional,i64" json:"error_count" form:"error_count" query:"error_count"`
+	ErrorRate     *float64 `thrift:"error_rate,22,optional" frugal:"22,optional,double" form:"error_rate" json:"error_rate,omitempty" query:"error_rate"`
+	CacheHitCount *int64   `thrift:"cache_hit_count,23,optional" frugal:"23,optional,i64" json:"cache_hit_count" form:"cache_hit_count" query:"cache_hit_count"`
+	InputTokens   *int64   `thrift:"input_tokens,24,optional" frugal:"24,optional,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
+	OutputTokens  *int64   `thrift:"output_tokens,25,optional" frugal:"25,optional,i64" json:"output_tokens" form:"output_tokens" query:"output_tokens"`
+	Cost          *float64 `thrift:"cost,26,optional" frugal:"26,optional,double" form:"cost" json:"cost,omitempty" query:"cost"`
+	AvgLatencyMs  *float64 `thrift:"avg_latency_ms,27,optional" frugal:"27,optional,double" form:"avg_latency_ms" json:"avg_latency_ms,omitempty" query:"avg_latency_ms"`
+}
+
+func NewModelUsageStat() *ModelUsageStat {
+	return &ModelUsageStat{}
+}
+
+func (p *ModelUsageStat) InitDefault() {
+}
+
+var ModelUsageStat_WorkspaceID_DEFAULT int64
+
+func (p *ModelUsageStat) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return ModelUsageStat_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var ModelUsageStat_UserID_DEFAULT string
+
+func (p *ModelUsageStat) GetUserID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUserID() {
+		return ModelUsageStat_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var ModelUsageStat_ModelID_DEFAULT int64
+
+func (p *ModelUsageStat) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return ModelUsageStat_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var ModelUsageStat_ModelName_DEFAULT string
+
+func (p *ModelUsageStat) GetModelName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelName() {
+		return ModelUsageStat_ModelName_DEFAULT
+	}
+	return *p.ModelName
+}
+
+var ModelUsageStat_Scenario_DEFAULT common.Scenario
+
+func (p *ModelUsageStat) GetScenario() (v common.Scenario) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScenario() {
+		return ModelUsageStat_Scenario_DEFAULT
+	}
+	return *p.Scenario
+}
+
+var ModelUsageStat_TimeBucket_DEFAULT int64
+
+func (p *ModelUsageStat) GetTimeBucket() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTimeBucket() {
+		return ModelUsageStat_TimeBucket_DEFAULT
+	}
+	return *p.TimeBucket
+}
+
+var ModelUsageStat_RequestCount_DEFAULT int64
+
+func (p *ModelUsageStat) GetRequestCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequestCount() {
+		return ModelUsageStat_RequestCount_DEFAULT
+	}
+	return *p.RequestCount
+}
+
+var ModelUsageStat_ErrorCount_DEFAULT int64
+
+func (p *ModelUsageStat) GetErrorCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetErrorCount() {
+		return ModelUsageStat_ErrorCount_DEFAULT
+	}
+	return *p.ErrorCount
+}
+
+var ModelUsageStat_ErrorRate_DEFAULT float64
+
+func (p *ModelUsageStat) GetErrorRate() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetErrorRate() {
+		return ModelUsageStat_ErrorRate_DEFAULT
+	}
+	return *p.ErrorRate
 }
-func (p *ParamSchema) SetDesc(val *string) {
-	p.Desc = val
+
+var ModelUsageStat_CacheHitCount_DEFAULT int64
+
+func (p *ModelUsageStat) GetCacheHitCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCacheHitCount() {
+		return ModelUsageStat_CacheHitCount_DEFAULT
+	}
+	return *p.CacheHitCount
 }
-func (p *ParamSchema) SetType(val *ParamType) {
-	p.Type = val
+
+var ModelUsageStat_InputTokens_DEFAULT int64
+
+func (p *ModelUsageStat) GetInputTokens() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputTokens() {
+		return ModelUsageStat_InputTokens_DEFAULT
+	}
+	return *p.InputTokens
 }
-func (p *ParamSchema) SetMin(val *string) {
-	p.Min = val
+
+var ModelUsageStat_OutputTokens_DEFAULT int64
+
+func (p *ModelUsageStat) GetOutputTokens() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputTokens() {
+		return ModelUsageStat_OutputTokens_DEFAULT
+	}
+	return *p.OutputTokens
 }
-func (p *ParamSchema) SetMax(val *string) {
-	p.Max = val
+
+var ModelUsageStat_Cost_DEFAULT float64
+
+func (p *ModelUsageStat) GetCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCost() {
+		return ModelUsageStat_Cost_DEFAULT
+	}
+	return *p.Cost
 }
-func (p *ParamSchema) SetDefaultValue(val *string) {
-	p.DefaultValue = val
+
+var ModelUsageStat_AvgLatencyMs_DEFAULT float64
+
+func (p *ModelUsageStat) GetAvgLatencyMs() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAvgLatencyMs() {
+		return ModelUsageStat_AvgLatencyMs_DEFAULT
+	}
+	return *p.AvgLatencyMs
 }
-func (p *ParamSchema) SetOptions(val []*ParamOption) {
-	p.Options = val
+func (p *ModelUsageStat) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *ModelUsageStat) SetUserID(val *string) {
+	p.UserID = val
+}
+func (p *ModelUsageStat) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *ModelUsageStat) SetModelName(val *string) {
+	p.ModelName = val
+}
+func (p *ModelUsageStat) SetScenario(val *common.Scenario) {
+	p.Scenario = val
+}
+func (p *ModelUsageStat) SetTimeBucket(val *int64) {
+	p.TimeBucket = val
+}
+func (p *ModelUsageStat) SetRequestCount(val *int64) {
+	p.RequestCount = val
+}
+func (p *ModelUsageStat) SetErrorCount(val *int64) {
+	p.ErrorCount = val
+}
+func (p *ModelUsageStat) SetErrorRate(val *float64) {
+	p.ErrorRate = val
+}
+func (p *ModelUsageStat) SetCacheHitCount(val *int64) {
+	p.CacheHitCount = val
+}
+func (p *ModelUsageStat) SetInputTokens(val *int64) {
+	p.InputTokens = val
+}
+func (p *ModelUsageStat) SetOutputTokens(val *int64) {
+	p.OutputTokens = val
+}
+func (p *ModelUsageStat) SetCost(val *float64) {
+	p.Cost = val
+}
+func (p *ModelUsageStat) SetAvgLatencyMs(val *float64) {
+	p.AvgLatencyMs = val
 }
 
-var fieldIDToName_ParamSchema = map[int16]string{
-	1: "name",
-	2: "label",
-	3: "desc",
-	4: "type",
-	5: "min",
-	6: "max",
-	7: "default_value",
-	8: "options",
+var fieldIDToName_ModelUsageStat = map[int16]string{
+	1:  "workspace_id",
+	2:  "user_id",
+	3:  "model_id",
+	4:  "model_name",
+	5:  "scenario",
+	6:  "time_bucket",
+	20: "request_count",
+	21: "error_count",
+	22: "error_rate",
+	23: "cache_hit_count",
+	24: "input_tokens",
+	25: "output_tokens",
+	26: "cost",
+	27: "avg_latency_ms",
 }
 
-func (p *ParamSchema) IsSetName() bool {
-	return p.Name != nil
+func (p *ModelUsageStat) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
 }
 
-func (p *ParamSchema) IsSetLabel() bool {
-	return p.Label != nil
+func (p *ModelUsageStat) IsSetUserID() bool {
+	return p.UserID != nil
 }
 
-func (p *ParamSchema) IsSetDesc() bool {
-	return p.Desc != nil
+func (p *ModelUsageStat) IsSetModelID() bool {
+	return p.ModelID != nil
 }
 
-func (p *ParamSchema) IsSetType() bool {
-	return p.Type != nil
+func (p *ModelUsageStat) IsSetModelName() bool {
+	return p.ModelName != nil
 }
 
-func (p *ParamSchema) IsSetMin() bool {
-	return p.Min != nil
+func (p *ModelUsageStat) IsSetScenario() bool {
+	return p.Scenario != nil
 }
 
-func (p *ParamSchema) IsSetMax() bool {
-	return p.Max != nil
+func (p *ModelUsageStat) IsSetTimeBucket() bool {
+	return p.TimeBucket != nil
 }
 
-func (p *ParamSchema) IsSetDefaultValue() bool {
-	return p.DefaultValue != nil
+func (p *ModelUsageStat) IsSetRequestCount() bool {
+	return p.RequestCount != nil
 }
 
-func (p *ParamSchema) IsSetOptions() bool {
-	return p.Options != nil
+func (p *ModelUsageStat) IsSetErrorCount() bool {
+	return p.ErrorCount != nil
 }
 
-func (p *ParamSchema) Read(iprot thrift.TProtocol) (err error) {
+func (p *ModelUsageStat) IsSetErrorRate() bool {
+	return p.ErrorRate != nil
+}
+
+func (p *ModelUsageStat) IsSetCacheHitCount() bool {
+	return p.CacheHitCount != nil
+}
+
+func (p *ModelUsageStat) IsSetInputTokens() bool {
+	return p.InputTokens != nil
+}
+
+func (p *ModelUsageStat) IsSetOutputTokens() bool {
+	return p.OutputTokens != nil
+}
+
+func (p *ModelUsageStat) IsSetCost() bool {
+	return p.Cost != nil
+}
+
+func (p *ModelUsageStat) IsSetAvgLatencyMs() bool {
+	return p.AvgLatencyMs != nil
+}
+
+func (p *ModelUsageStat) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7647,7 +10022,7 @@ func (p *ParamSchema) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7663,7 +10038,7 @@ func (p *ParamSchema) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7687,24 +10062,72 @@ func (p *ParamSchema) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField20(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 8:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField8(iprot); err != nil {
+		case 21:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField21(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 22:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField22(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 23:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField23(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 24:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField24(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 25:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField25(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 26:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField26(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 27:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField27(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -7729,7 +10152,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParamSchema[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModelUsageStat[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7739,18 +10162,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ParamSchema) ReadField1(iprot thrift.TProtocol) error {
+func (p *ModelUsageStat) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Name = _field
+	p.WorkspaceID = _field
 	return nil
 }
-func (p *ParamSchema) ReadField2(iprot thrift.TProtocol) error {
+func (p *ModelUsageStat) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -7758,10 +10181,21 @@ func (p *ParamSchema) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Label = _field
+	p.UserID = _field
 	return nil
 }
-func (p *ParamSchema) ReadField3(iprot thrift.TProtocol) error {
+func (p *ModelUsageStat) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *ModelUsageStat) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -7769,80 +10203,123 @@ func (p *ParamSchema) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Desc = _field
+	p.ModelName = _field
 	return nil
 }
-func (p *ParamSchema) ReadField4(iprot thrift.TProtocol) error {
+func (p *ModelUsageStat) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *ParamType
+	var _field *common.Scenario
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Type = _field
+	p.Scenario = _field
 	return nil
 }
-func (p *ParamSchema) ReadField5(iprot thrift.TProtocol) error {
+func (p *ModelUsageStat) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Min = _field
+	p.TimeBucket = _field
 	return nil
 }
-func (p *ParamSchema) ReadField6(iprot thrift.TProtocol) error {
+func (p *ModelUsageStat) ReadField20(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Max = _field
+	p.RequestCount = _field
 	return nil
 }
-func (p *ParamSchema) ReadField7(iprot thrift.TProtocol) error {
+func (p *ModelUsageStat) ReadField21(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.DefaultValue = _field
+	p.ErrorCount = _field
 	return nil
 }
-func (p *ParamSchema) ReadField8(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *ModelUsageStat) ReadField22(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*ParamOption, 0, size)
-	values := make([]ParamOption, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
+	p.ErrorRate = _field
+	return nil
+}
+func (p *ModelUsageStat) ReadField23(iprot thrift.TProtocol) error {
 
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CacheHitCount = _field
+	return nil
+}
+func (p *ModelUsageStat) ReadField24(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.InputTokens = _field
+	return nil
+}
+func (p *ModelUsageStat) ReadField25(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OutputTokens = _field
+	return nil
+}
+func (p *ModelUsageStat) ReadField26(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.Cost = _field
+	return nil
+}
+func (p *ModelUsageStat) ReadField27(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Options = _field
+	p.AvgLatencyMs = _field
 	return nil
 }
 
-func (p *ParamSchema) Write(oprot thrift.TProtocol) (err error) {
+func (p *ModelUsageStat) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ParamSchema"); err != nil {
+	if err = oprot.WriteStructBegin("ModelUsageStat"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7870,12 +10347,36 @@ func (p *ParamSchema) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
 			goto WriteFieldError
 		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
+		if err = p.writeField21(oprot); err != nil {
+			fieldId = 21
+			goto WriteFieldError
+		}
+		if err = p.writeField22(oprot); err != nil {
+			fieldId = 22
+			goto WriteFieldError
+		}
+		if err = p.writeField23(oprot); err != nil {
+			fieldId = 23
+			goto WriteFieldError
+		}
+		if err = p.writeField24(oprot); err != nil {
+			fieldId = 24
+			goto WriteFieldError
+		}
+		if err = p.writeField25(oprot); err != nil {
+			fieldId = 25
+			goto WriteFieldError
+		}
+		if err = p.writeField26(oprot); err != nil {
+			fieldId = 26
+			goto WriteFieldError
+		}
+		if err = p.writeField27(oprot); err != nil {
+			fieldId = 27
 			goto WriteFieldError
 		}
 	}
@@ -7896,12 +10397,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ParamSchema) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+func (p *ModelUsageStat) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7914,12 +10415,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ParamSchema) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLabel() {
-		if err = oprot.WriteFieldBegin("label", thrift.STRING, 2); err != nil {
+func (p *ModelUsageStat) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Label); err != nil {
+		if err := oprot.WriteString(*p.UserID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7932,12 +10433,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ParamSchema) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDesc() {
-		if err = oprot.WriteFieldBegin("desc", thrift.STRING, 3); err != nil {
+func (p *ModelUsageStat) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Desc); err != nil {
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7950,12 +10451,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ParamSchema) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err = oprot.WriteFieldBegin("type", thrift.STRING, 4); err != nil {
+func (p *ModelUsageStat) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelName() {
+		if err = oprot.WriteFieldBegin("model_name", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Type); err != nil {
+		if err := oprot.WriteString(*p.ModelName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7968,12 +10469,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ParamSchema) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMin() {
-		if err = oprot.WriteFieldBegin("min", thrift.STRING, 5); err != nil {
+func (p *ModelUsageStat) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScenario() {
+		if err = oprot.WriteFieldBegin("scenario", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Min); err != nil {
+		if err := oprot.WriteString(*p.Scenario); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7986,12 +10487,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *ParamSchema) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMax() {
-		if err = oprot.WriteFieldBegin("max", thrift.STRING, 6); err != nil {
+func (p *ModelUsageStat) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeBucket() {
+		if err = oprot.WriteFieldBegin("time_bucket", thrift.I64, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Max); err != nil {
+		if err := oprot.WriteI64(*p.TimeBucket); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8004,12 +10505,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *ParamSchema) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDefaultValue() {
-		if err = oprot.WriteFieldBegin("default_value", thrift.STRING, 7); err != nil {
+func (p *ModelUsageStat) writeField20(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequestCount() {
+		if err = oprot.WriteFieldBegin("request_count", thrift.I64, 20); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.DefaultValue); err != nil {
+		if err := oprot.WriteI64(*p.RequestCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8018,24 +10519,34 @@ func (p *ParamSchema) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
 }
-func (p *ParamSchema) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOptions() {
-		if err = oprot.WriteFieldBegin("options", thrift.LIST, 8); err != nil {
+func (p *ModelUsageStat) writeField21(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorCount() {
+		if err = oprot.WriteFieldBegin("error_count", thrift.I64, 21); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Options)); err != nil {
+		if err := oprot.WriteI64(*p.ErrorCount); err != nil {
 			return err
 		}
-		for _, v := range p.Options {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
+}
+func (p *ModelUsageStat) writeField22(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorRate() {
+		if err = oprot.WriteFieldBegin("error_rate", thrift.DOUBLE, 22); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ErrorRate); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8044,208 +10555,537 @@ func (p *ParamSchema) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
+}
+func (p *ModelUsageStat) writeField23(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCacheHitCount() {
+		if err = oprot.WriteFieldBegin("cache_hit_count", thrift.I64, 23); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CacheHitCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
+}
+func (p *ModelUsageStat) writeField24(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputTokens() {
+		if err = oprot.WriteFieldBegin("input_tokens", thrift.I64, 24); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.InputTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
+}
+func (p *ModelUsageStat) writeField25(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputTokens() {
+		if err = oprot.WriteFieldBegin("output_tokens", thrift.I64, 25); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.OutputTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 end error: ", p), err)
+}
+func (p *ModelUsageStat) writeField26(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCost() {
+		if err = oprot.WriteFieldBegin("cost", thrift.DOUBLE, 26); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Cost); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 26 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 26 end error: ", p), err)
+}
+func (p *ModelUsageStat) writeField27(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvgLatencyMs() {
+		if err = oprot.WriteFieldBegin("avg_latency_ms", thrift.DOUBLE, 27); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.AvgLatencyMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 27 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 27 end error: ", p), err)
 }
 
-func (p *ParamSchema) String() string {
+func (p *ModelUsageStat) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ParamSchema(%+v)", *p)
+	return fmt.Sprintf("ModelUsageStat(%+v)", *p)
 
 }
 
-func (p *ParamSchema) DeepEqual(ano *ParamSchema) bool {
+func (p *ModelUsageStat) DeepEqual(ano *ModelUsageStat) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Name) {
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Label) {
+	if !p.Field2DeepEqual(ano.UserID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Desc) {
+	if !p.Field3DeepEqual(ano.ModelID) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Type) {
+	if !p.Field4DeepEqual(ano.ModelName) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.Min) {
+	if !p.Field5DeepEqual(ano.Scenario) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.Max) {
+	if !p.Field6DeepEqual(ano.TimeBucket) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.DefaultValue) {
+	if !p.Field20DeepEqual(ano.RequestCount) {
 		return false
 	}
-	if !p.Field8DeepEqual(ano.Options) {
+	if !p.Field21DeepEqual(ano.ErrorCount) {
+		return false
+	}
+	if !p.Field22DeepEqual(ano.ErrorRate) {
+		return false
+	}
+	if !p.Field23DeepEqual(ano.CacheHitCount) {
+		return false
+	}
+	if !p.Field24DeepEqual(ano.InputTokens) {
+		return false
+	}
+	if !p.Field25DeepEqual(ano.OutputTokens) {
+		return false
+	}
+	if !p.Field26DeepEqual(ano.Cost) {
+		return false
+	}
+	if !p.Field27DeepEqual(ano.AvgLatencyMs) {
 		return false
 	}
 	return true
 }
 
-func (p *ParamSchema) Field1DeepEqual(src *string) bool {
+func (p *ModelUsageStat) Field1DeepEqual(src *int64) bool {
 
-	if p.Name == src {
+	if p.WorkspaceID == src {
 		return true
-	} else if p.Name == nil || src == nil {
+	} else if p.WorkspaceID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	if *p.WorkspaceID != *src {
 		return false
 	}
 	return true
 }
-func (p *ParamSchema) Field2DeepEqual(src *string) bool {
+func (p *ModelUsageStat) Field2DeepEqual(src *string) bool {
 
-	if p.Label == src {
+	if p.UserID == src {
 		return true
-	} else if p.Label == nil || src == nil {
+	} else if p.UserID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Label, *src) != 0 {
+	if strings.Compare(*p.UserID, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ParamSchema) Field3DeepEqual(src *string) bool {
+func (p *ModelUsageStat) Field3DeepEqual(src *int64) bool {
 
-	if p.Desc == src {
+	if p.ModelID == src {
 		return true
-	} else if p.Desc == nil || src == nil {
+	} else if p.ModelID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Desc, *src) != 0 {
+	if *p.ModelID != *src {
 		return false
 	}
 	return true
 }
-func (p *ParamSchema) Field4DeepEqual(src *ParamType) bool {
+func (p *ModelUsageStat) Field4DeepEqual(src *string) bool {
 
-	if p.Type == src {
+	if p.ModelName == src {
 		return true
-	} else if p.Type == nil || src == nil {
+	} else if p.ModelName == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Type, *src) != 0 {
+	if strings.Compare(*p.ModelName, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ParamSchema) Field5DeepEqual(src *string) bool {
+func (p *ModelUsageStat) Field5DeepEqual(src *common.Scenario) bool {
 
-	if p.Min == src {
+	if p.Scenario == src {
 		return true
-	} else if p.Min == nil || src == nil {
+	} else if p.Scenario == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Min, *src) != 0 {
+	if strings.Compare(*p.Scenario, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ParamSchema) Field6DeepEqual(src *string) bool {
+func (p *ModelUsageStat) Field6DeepEqual(src *int64) bool {
 
-	if p.Max == src {
+	if p.TimeBucket == src {
 		return true
-	} else if p.Max == nil || src == nil {
+	} else if p.TimeBucket == nil || src == nil {
+		return false
+	}
+	if *p.TimeBucket != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelUsageStat) Field20DeepEqual(src *int64) bool {
+
+	if p.RequestCount == src {
+		return true
+	} else if p.RequestCount == nil || src == nil {
+		return false
+	}
+	if *p.RequestCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelUsageStat) Field21DeepEqual(src *int64) bool {
+
+	if p.ErrorCount == src {
+		return true
+	} else if p.ErrorCount == nil || src == nil {
+		return false
+	}
+	if *p.ErrorCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelUsageStat) Field22DeepEqual(src *float64) bool {
+
+	if p.ErrorRate == src {
+		return true
+	} else if p.ErrorRate == nil || src == nil {
+		return false
+	}
+	if *p.ErrorRate != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelUsageStat) Field23DeepEqual(src *int64) bool {
+
+	if p.CacheHitCount == src {
+		return true
+	} else if p.CacheHitCount == nil || src == nil {
+		return false
+	}
+	if *p.CacheHitCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelUsageStat) Field24DeepEqual(src *int64) bool {
+
+	if p.InputTokens == src {
+		return true
+	} else if p.InputTokens == nil || src == nil {
+		return false
+	}
+	if *p.InputTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelUsageStat) Field25DeepEqual(src *int64) bool {
+
+	if p.OutputTokens == src {
+		return true
+	} else if p.OutputTokens == nil || src == nil {
+		return false
+	}
+	if *p.OutputTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelUsageStat) Field26DeepEqual(src *float64) bool {
+
+	if p.Cost == src {
+		return true
+	} else if p.Cost == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Max, *src) != 0 {
+	if *p.Cost != *src {
 		return false
 	}
 	return true
 }
-func (p *ParamSchema) Field7DeepEqual(src *string) bool {
+func (p *ModelUsageStat) Field27DeepEqual(src *float64) bool {
 
-	if p.DefaultValue == src {
+	if p.AvgLatencyMs == src {
 		return true
-	} else if p.DefaultValue == nil || src == nil {
+	} else if p.AvgLatencyMs == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.DefaultValue, *src) != 0 {
+	if *p.AvgLatencyMs != *src {
 		return false
 	}
 	return true
 }
-func (p *ParamSchema) Field8DeepEqual(src []*ParamOption) bool {
 
-	if len(p.Options) != len(src) {
-		return false
+// 空间或模型在当前周期内的额度使用情况
+type QuotaStatus struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	// 为0时表示空间内所有模型共用的额度
+	ModelID *int64       `thrift:"model_id,2,optional" frugal:"2,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	Period  *QuotaPeriod `thrift:"period,3,optional" frugal:"3,optional,string" form:"period" json:"period,omitempty" query:"period"`
+	// 为0时不限制
+	MaxTokens  *int64 `thrift:"max_tokens,4,optional" frugal:"4,optional,i64" json:"max_tokens" form:"max_tokens" query:"max_tokens"`
+	UsedTokens *int64 `thrift:"used_tokens,5,optional" frugal:"5,optional,i64" json:"used_tokens" form:"used_tokens" query:"used_tokens"`
+	// 为0时不限制
+	MaxCost  *float64 `thrift:"max_cost,6,optional" frugal:"6,optional,double" form:"max_cost" json:"max_cost,omitempty" query:"max_cost"`
+	UsedCost *float64 `thrift:"used_cost,7,optional" frugal:"7,optional,double" form:"used_cost" json:"used_cost,omitempty" query:"used_cost"`
+	// 额度重置时间，毫秒时间戳
+	ResetAt  *int64 `thrift:"reset_at,8,optional" frugal:"8,optional,i64" json:"reset_at" form:"reset_at" query:"reset_at"`
+	Exceeded *bool  `thrift:"exceeded,9,optional" frugal:"9,optional,bool" form:"exceeded" json:"exceeded,omitempty" query:"exceeded"`
+}
+
+func NewQuotaStatus() *QuotaStatus {
+	return &QuotaStatus{}
+}
+
+func (p *QuotaStatus) InitDefault() {
+}
+
+var QuotaStatus_WorkspaceID_DEFAULT int64
+
+func (p *QuotaStatus) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
 	}
-	for i, v := range p.Options {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if !p.IsSetWorkspaceID() {
+		return QuotaStatus_WorkspaceID_DEFAULT
 	}
-	return true
+	return *p.WorkspaceID
 }
 
-type ParamOption struct {
-	// 实际值
-	Value *string `thrift:"value,1,optional" frugal:"1,optional,string" form:"value" json:"value,omitempty" query:"value"`
-	// 展示值
-	Label *string `thrift:"label,2,optional" frugal:"2,optional,string" form:"label" json:"label,omitempty" query:"label"`
+var QuotaStatus_ModelID_DEFAULT int64
+
+func (p *QuotaStatus) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return QuotaStatus_ModelID_DEFAULT
+	}
+	return *p.ModelID
 }
 
-func NewParamOption() *ParamOption {
-	return &ParamOption{}
+var QuotaStatus_Period_DEFAULT QuotaPeriod
+
+func (p *QuotaStatus) GetPeriod() (v QuotaPeriod) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPeriod() {
+		return QuotaStatus_Period_DEFAULT
+	}
+	return *p.Period
 }
 
-func (p *ParamOption) InitDefault() {
+var QuotaStatus_MaxTokens_DEFAULT int64
+
+func (p *QuotaStatus) GetMaxTokens() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxTokens() {
+		return QuotaStatus_MaxTokens_DEFAULT
+	}
+	return *p.MaxTokens
 }
 
-var ParamOption_Value_DEFAULT string
+var QuotaStatus_UsedTokens_DEFAULT int64
 
-func (p *ParamOption) GetValue() (v string) {
+func (p *QuotaStatus) GetUsedTokens() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetValue() {
-		return ParamOption_Value_DEFAULT
+	if !p.IsSetUsedTokens() {
+		return QuotaStatus_UsedTokens_DEFAULT
 	}
-	return *p.Value
+	return *p.UsedTokens
 }
 
-var ParamOption_Label_DEFAULT string
+var QuotaStatus_MaxCost_DEFAULT float64
 
-func (p *ParamOption) GetLabel() (v string) {
+func (p *QuotaStatus) GetMaxCost() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetLabel() {
-		return ParamOption_Label_DEFAULT
+	if !p.IsSetMaxCost() {
+		return QuotaStatus_MaxCost_DEFAULT
 	}
-	return *p.Label
+	return *p.MaxCost
 }
-func (p *ParamOption) SetValue(val *string) {
-	p.Value = val
+
+var QuotaStatus_UsedCost_DEFAULT float64
+
+func (p *QuotaStatus) GetUsedCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUsedCost() {
+		return QuotaStatus_UsedCost_DEFAULT
+	}
+	return *p.UsedCost
 }
-func (p *ParamOption) SetLabel(val *string) {
-	p.Label = val
+
+var QuotaStatus_ResetAt_DEFAULT int64
+
+func (p *QuotaStatus) GetResetAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetResetAt() {
+		return QuotaStatus_ResetAt_DEFAULT
+	}
+	return *p.ResetAt
 }
 
-var fieldIDToName_ParamOption = map[int16]string{
-	1: "value",
-	2: "label",
+var QuotaStatus_Exceeded_DEFAULT bool
+
+func (p *QuotaStatus) GetExceeded() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExceeded() {
+		return QuotaStatus_Exceeded_DEFAULT
+	}
+	return *p.Exceeded
+}
+func (p *QuotaStatus) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *QuotaStatus) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *QuotaStatus) SetPeriod(val *QuotaPeriod) {
+	p.Period = val
+}
+func (p *QuotaStatus) SetMaxTokens(val *int64) {
+	p.MaxTokens = val
+}
+func (p *QuotaStatus) SetUsedTokens(val *int64) {
+	p.UsedTokens = val
+}
+func (p *QuotaStatus) SetMaxCost(val *float64) {
+	p.MaxCost = val
+}
+func (p *QuotaStatus) SetUsedCost(val *float64) {
+	p.UsedCost = val
+}
+func (p *QuotaStatus) SetResetAt(val *int64) {
+	p.ResetAt = val
+}
+func (p *QuotaStatus) SetExceeded(val *bool) {
+	p.Exceeded = val
 }
 
-func (p *ParamOption) IsSetValue() bool {
-	return p.Value != nil
+var fieldIDToName_QuotaStatus = map[int16]string{
+	1: "workspace_id",
+	2: "model_id",
+	3: "period",
+	4: "max_tokens",
+	5: "used_tokens",
+	6: "max_cost",
+	7: "used_cost",
+	8: "reset_at",
+	9: "exceeded",
 }
 
-func (p *ParamOption) IsSetLabel() bool {
-	return p.Label != nil
+func (p *QuotaStatus) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
 }
 
-func (p *ParamOption) Read(iprot thrift.TProtocol) (err error) {
+func (p *QuotaStatus) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *QuotaStatus) IsSetPeriod() bool {
+	return p.Period != nil
+}
+
+func (p *QuotaStatus) IsSetMaxTokens() bool {
+	return p.MaxTokens != nil
+}
+
+func (p *QuotaStatus) IsSetUsedTokens() bool {
+	return p.UsedTokens != nil
+}
+
+func (p *QuotaStatus) IsSetMaxCost() bool {
+	return p.MaxCost != nil
+}
+
+func (p *QuotaStatus) IsSetUsedCost() bool {
+	return p.UsedCost != nil
+}
+
+func (p *QuotaStatus) IsSetResetAt() bool {
+	return p.ResetAt != nil
+}
+
+func (p *QuotaStatus) IsSetExceeded() bool {
+	return p.Exceeded != nil
+}
+
+func (p *QuotaStatus) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8264,7 +11104,7 @@ func (p *ParamOption) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8272,13 +11112,69 @@ func (p *ParamOption) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8291,49 +11187,126 @@ func (p *ParamOption) Read(iprot thrift.TProtocol) (err error) {
 	if err = iprot.ReadStructEnd(); err != nil {
 		goto ReadStructEndError
 	}
-
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QuotaStatus[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QuotaStatus) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *QuotaStatus) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *QuotaStatus) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *QuotaPeriod
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Period = _field
+	return nil
+}
+func (p *QuotaStatus) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxTokens = _field
+	return nil
+}
+func (p *QuotaStatus) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UsedTokens = _field
+	return nil
+}
+func (p *QuotaStatus) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxCost = _field
+	return nil
+}
+func (p *QuotaStatus) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UsedCost = _field
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParamOption[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
+func (p *QuotaStatus) ReadField8(iprot thrift.TProtocol) error {
 
-func (p *ParamOption) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Value = _field
+	p.ResetAt = _field
 	return nil
 }
-func (p *ParamOption) ReadField2(iprot thrift.TProtocol) error {
+func (p *QuotaStatus) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Label = _field
+	p.Exceeded = _field
 	return nil
 }
 
-func (p *ParamOption) Write(oprot thrift.TProtocol) (err error) {
+func (p *QuotaStatus) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ParamOption"); err != nil {
+	if err = oprot.WriteStructBegin("QuotaStatus"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8345,6 +11318,34 @@ func (p *ParamOption) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8363,12 +11364,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ParamOption) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetValue() {
-		if err = oprot.WriteFieldBegin("value", thrift.STRING, 1); err != nil {
+func (p *QuotaStatus) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Value); err != nil {
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8381,12 +11382,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ParamOption) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLabel() {
-		if err = oprot.WriteFieldBegin("label", thrift.STRING, 2); err != nil {
+func (p *QuotaStatus) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Label); err != nil {
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8399,50 +11400,281 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *QuotaStatus) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPeriod() {
+		if err = oprot.WriteFieldBegin("period", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Period); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *QuotaStatus) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxTokens() {
+		if err = oprot.WriteFieldBegin("max_tokens", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MaxTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *QuotaStatus) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUsedTokens() {
+		if err = oprot.WriteFieldBegin("used_tokens", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UsedTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *QuotaStatus) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxCost() {
+		if err = oprot.WriteFieldBegin("max_cost", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxCost); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *QuotaStatus) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUsedCost() {
+		if err = oprot.WriteFieldBegin("used_cost", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.UsedCost); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *QuotaStatus) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResetAt() {
+		if err = oprot.WriteFieldBegin("reset_at", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ResetAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *QuotaStatus) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExceeded() {
+		if err = oprot.WriteFieldBegin("exceeded", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Exceeded); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
 
-func (p *ParamOption) String() string {
+func (p *QuotaStatus) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ParamOption(%+v)", *p)
+	return fmt.Sprintf("QuotaStatus(%+v)", *p)
 
 }
 
-func (p *ParamOption) DeepEqual(ano *ParamOption) bool {
+func (p *QuotaStatus) DeepEqual(ano *QuotaStatus) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Value) {
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Label) {
+	if !p.Field2DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Period) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.MaxTokens) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.UsedTokens) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.MaxCost) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.UsedCost) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ResetAt) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Exceeded) {
 		return false
 	}
 	return true
 }
 
-func (p *ParamOption) Field1DeepEqual(src *string) bool {
+func (p *QuotaStatus) Field1DeepEqual(src *int64) bool {
 
-	if p.Value == src {
+	if p.WorkspaceID == src {
 		return true
-	} else if p.Value == nil || src == nil {
+	} else if p.WorkspaceID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Value, *src) != 0 {
+	if *p.WorkspaceID != *src {
 		return false
 	}
 	return true
 }
-func (p *ParamOption) Field2DeepEqual(src *string) bool {
+func (p *QuotaStatus) Field2DeepEqual(src *int64) bool {
 
-	if p.Label == src {
+	if p.ModelID == src {
 		return true
-	} else if p.Label == nil || src == nil {
+	} else if p.ModelID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Label, *src) != 0 {
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *QuotaStatus) Field3DeepEqual(src *QuotaPeriod) bool {
+
+	if p.Period == src {
+		return true
+	} else if p.Period == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Period, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *QuotaStatus) Field4DeepEqual(src *int64) bool {
+
+	if p.MaxTokens == src {
+		return true
+	} else if p.MaxTokens == nil || src == nil {
+		return false
+	}
+	if *p.MaxTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *QuotaStatus) Field5DeepEqual(src *int64) bool {
+
+	if p.UsedTokens == src {
+		return true
+	} else if p.UsedTokens == nil || src == nil {
+		return false
+	}
+	if *p.UsedTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *QuotaStatus) Field6DeepEqual(src *float64) bool {
+
+	if p.MaxCost == src {
+		return true
+	} else if p.MaxCost == nil || src == nil {
+		return false
+	}
+	if *p.MaxCost != *src {
+		return false
+	}
+	return true
+}
+func (p *QuotaStatus) Field7DeepEqual(src *float64) bool {
+
+	if p.UsedCost == src {
+		return true
+	} else if p.UsedCost == nil || src == nil {
+		return false
+	}
+	if *p.UsedCost != *src {
+		return false
+	}
+	return true
+}
+func (p *QuotaStatus) Field8DeepEqual(src *int64) bool {
+
+	if p.ResetAt == src {
+		return true
+	} else if p.ResetAt == nil || src == nil {
+		return false
+	}
+	if *p.ResetAt != *src {
+		return false
+	}
+	return true
+}
+func (p *QuotaStatus) Field9DeepEqual(src *bool) bool {
+
+	if p.Exceeded == src {
+		return true
+	} else if p.Exceeded == nil || src == nil {
+		return false
+	}
+	if *p.Exceeded != *src {
 		return false
 	}
 	return true
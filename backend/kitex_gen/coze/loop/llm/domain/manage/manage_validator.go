@@ -37,6 +37,11 @@ func (p *Model) IsValid() error {
 			return fmt.Errorf("field ParamConfig not valid, %w", err)
 		}
 	}
+	if p.Price != nil {
+		if err := p.Price.IsValid(); err != nil {
+			return fmt.Errorf("field Price not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *Ability) IsValid() error {
@@ -142,6 +147,17 @@ func (p *ScenarioConfig) IsValid() error {
 			return fmt.Errorf("field Quota not valid, %w", err)
 		}
 	}
+	if p.Routing != nil {
+		if err := p.Routing.IsValid(); err != nil {
+			return fmt.Errorf("field Routing not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *RoutingPolicy) IsValid() error {
+	return nil
+}
+func (p *RoutingTarget) IsValid() error {
 	return nil
 }
 func (p *ParamConfig) IsValid() error {
@@ -153,6 +169,15 @@ func (p *ParamSchema) IsValid() error {
 func (p *ParamOption) IsValid() error {
 	return nil
 }
+func (p *Price) IsValid() error {
+	return nil
+}
+func (p *ModelUsageStat) IsValid() error {
+	return nil
+}
+func (p *QuotaStatus) IsValid() error {
+	return nil
+}
 func (p *Quota) IsValid() error {
 	return nil
 }
@@ -1560,230 +1560,6052 @@ func (p *GetModelResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
+type CreateModelRequest struct {
+	WorkspaceID *int64        `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	Model       *manage.Model `thrift:"model,2,optional" frugal:"2,optional,manage.Model" form:"model" json:"model,omitempty" query:"model"`
+	Base        *base.Base    `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewCreateModelRequest() *CreateModelRequest {
+	return &CreateModelRequest{}
+}
+
+func (p *CreateModelRequest) InitDefault() {
+}
+
+var CreateModelRequest_WorkspaceID_DEFAULT int64
+
+func (p *CreateModelRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return CreateModelRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var CreateModelRequest_Model_DEFAULT *manage.Model
+
+func (p *CreateModelRequest) GetModel() (v *manage.Model) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModel() {
+		return CreateModelRequest_Model_DEFAULT
+	}
+	return p.Model
+}
+
+var CreateModelRequest_Base_DEFAULT *base.Base
+
+func (p *CreateModelRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return CreateModelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *CreateModelRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *CreateModelRequest) SetModel(val *manage.Model) {
+	p.Model = val
+}
+func (p *CreateModelRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_CreateModelRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "model",
+	255: "Base",
+}
+
+func (p *CreateModelRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *CreateModelRequest) IsSetModel() bool {
+	return p.Model != nil
+}
+
+func (p *CreateModelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateModelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateModelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateModelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *CreateModelRequest) ReadField2(iprot thrift.TProtocol) error {
+	_field := manage.NewModel()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Model = _field
+	return nil
+}
+func (p *CreateModelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *CreateModelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateModelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateModelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateModelRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModel() {
+		if err = oprot.WriteFieldBegin("model", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Model.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CreateModelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *CreateModelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateModelRequest(%+v)", *p)
+
+}
+
+func (p *CreateModelRequest) DeepEqual(ano *CreateModelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Model) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateModelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *CreateModelRequest) Field2DeepEqual(src *manage.Model) bool {
+
+	if !p.Model.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateModelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type CreateModelResponse struct {
+	ModelID  *int64         `thrift:"model_id,1,optional" frugal:"1,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewCreateModelResponse() *CreateModelResponse {
+	return &CreateModelResponse{}
+}
+
+func (p *CreateModelResponse) InitDefault() {
+}
+
+var CreateModelResponse_ModelID_DEFAULT int64
+
+func (p *CreateModelResponse) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return CreateModelResponse_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var CreateModelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *CreateModelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return CreateModelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CreateModelResponse) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *CreateModelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_CreateModelResponse = map[int16]string{
+	1:   "model_id",
+	255: "BaseResp",
+}
+
+func (p *CreateModelResponse) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *CreateModelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateModelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateModelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateModelResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *CreateModelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *CreateModelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateModelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateModelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateModelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *CreateModelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateModelResponse(%+v)", *p)
+
+}
+
+func (p *CreateModelResponse) DeepEqual(ano *CreateModelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateModelResponse) Field1DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *CreateModelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UpdateModelRequest struct {
+	WorkspaceID *int64           `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	ModelID     *int64           `thrift:"model_id,2,optional" frugal:"2,optional,i64" json:"model_id" path:"model_id" `
+	Name        *string          `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Desc        *string          `thrift:"desc,4,optional" frugal:"4,optional,string" form:"desc" json:"desc,omitempty" query:"desc"`
+	Ability     *manage.Ability  `thrift:"ability,5,optional" frugal:"5,optional,manage.Ability" form:"ability" json:"ability,omitempty" query:"ability"`
+	Protocol    *manage.Protocol `thrift:"protocol,6,optional" frugal:"6,optional,string" form:"protocol" json:"protocol,omitempty" query:"protocol"`
+	// 为空时保持原有协议配置（含密钥）不变
+	ProtocolConfig  *manage.ProtocolConfig                     `thrift:"protocol_config,7,optional" frugal:"7,optional,manage.ProtocolConfig" form:"protocol_config" json:"protocol_config,omitempty" query:"protocol_config"`
+	ScenarioConfigs map[common.Scenario]*manage.ScenarioConfig `thrift:"scenario_configs,8,optional" frugal:"8,optional,map<string:manage.ScenarioConfig>" form:"scenario_configs" json:"scenario_configs,omitempty" query:"scenario_configs"`
+	ParamConfig     *manage.ParamConfig                        `thrift:"param_config,9,optional" frugal:"9,optional,manage.ParamConfig" form:"param_config" json:"param_config,omitempty" query:"param_config"`
+	Price           *manage.Price                              `thrift:"price,10,optional" frugal:"10,optional,manage.Price" form:"price" json:"price,omitempty" query:"price"`
+	Base            *base.Base                                 `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewUpdateModelRequest() *UpdateModelRequest {
+	return &UpdateModelRequest{}
+}
+
+func (p *UpdateModelRequest) InitDefault() {
+}
+
+var UpdateModelRequest_WorkspaceID_DEFAULT int64
+
+func (p *UpdateModelRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return UpdateModelRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var UpdateModelRequest_ModelID_DEFAULT int64
+
+func (p *UpdateModelRequest) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return UpdateModelRequest_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var UpdateModelRequest_Name_DEFAULT string
+
+func (p *UpdateModelRequest) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return UpdateModelRequest_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateModelRequest_Desc_DEFAULT string
+
+func (p *UpdateModelRequest) GetDesc() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDesc() {
+		return UpdateModelRequest_Desc_DEFAULT
+	}
+	return *p.Desc
+}
+
+var UpdateModelRequest_Ability_DEFAULT *manage.Ability
+
+func (p *UpdateModelRequest) GetAbility() (v *manage.Ability) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAbility() {
+		return UpdateModelRequest_Ability_DEFAULT
+	}
+	return p.Ability
+}
+
+var UpdateModelRequest_Protocol_DEFAULT manage.Protocol
+
+func (p *UpdateModelRequest) GetProtocol() (v manage.Protocol) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetProtocol() {
+		return UpdateModelRequest_Protocol_DEFAULT
+	}
+	return *p.Protocol
+}
+
+var UpdateModelRequest_ProtocolConfig_DEFAULT *manage.ProtocolConfig
+
+func (p *UpdateModelRequest) GetProtocolConfig() (v *manage.ProtocolConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetProtocolConfig() {
+		return UpdateModelRequest_ProtocolConfig_DEFAULT
+	}
+	return p.ProtocolConfig
+}
+
+var UpdateModelRequest_ScenarioConfigs_DEFAULT map[common.Scenario]*manage.ScenarioConfig
+
+func (p *UpdateModelRequest) GetScenarioConfigs() (v map[common.Scenario]*manage.ScenarioConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScenarioConfigs() {
+		return UpdateModelRequest_ScenarioConfigs_DEFAULT
+	}
+	return p.ScenarioConfigs
+}
+
+var UpdateModelRequest_ParamConfig_DEFAULT *manage.ParamConfig
+
+func (p *UpdateModelRequest) GetParamConfig() (v *manage.ParamConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetParamConfig() {
+		return UpdateModelRequest_ParamConfig_DEFAULT
+	}
+	return p.ParamConfig
+}
+
+var UpdateModelRequest_Price_DEFAULT *manage.Price
+
+func (p *UpdateModelRequest) GetPrice() (v *manage.Price) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPrice() {
+		return UpdateModelRequest_Price_DEFAULT
+	}
+	return p.Price
+}
+
+var UpdateModelRequest_Base_DEFAULT *base.Base
+
+func (p *UpdateModelRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return UpdateModelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateModelRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *UpdateModelRequest) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *UpdateModelRequest) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateModelRequest) SetDesc(val *string) {
+	p.Desc = val
+}
+func (p *UpdateModelRequest) SetAbility(val *manage.Ability) {
+	p.Ability = val
+}
+func (p *UpdateModelRequest) SetProtocol(val *manage.Protocol) {
+	p.Protocol = val
+}
+func (p *UpdateModelRequest) SetProtocolConfig(val *manage.ProtocolConfig) {
+	p.ProtocolConfig = val
+}
+func (p *UpdateModelRequest) SetScenarioConfigs(val map[common.Scenario]*manage.ScenarioConfig) {
+	p.ScenarioConfigs = val
+}
+func (p *UpdateModelRequest) SetParamConfig(val *manage.ParamConfig) {
+	p.ParamConfig = val
+}
+func (p *UpdateModelRequest) SetPrice(val *manage.Price) {
+	p.Price = val
+}
+func (p *UpdateModelRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_UpdateModelRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "model_id",
+	3:   "name",
+	4:   "desc",
+	5:   "ability",
+	6:   "protocol",
+	7:   "protocol_config",
+	8:   "scenario_configs",
+	9:   "param_config",
+	10:  "price",
+	255: "Base",
+}
+
+func (p *UpdateModelRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *UpdateModelRequest) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *UpdateModelRequest) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateModelRequest) IsSetDesc() bool {
+	return p.Desc != nil
+}
+
+func (p *UpdateModelRequest) IsSetAbility() bool {
+	return p.Ability != nil
+}
+
+func (p *UpdateModelRequest) IsSetProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *UpdateModelRequest) IsSetProtocolConfig() bool {
+	return p.ProtocolConfig != nil
+}
+
+func (p *UpdateModelRequest) IsSetScenarioConfigs() bool {
+	return p.ScenarioConfigs != nil
+}
+
+func (p *UpdateModelRequest) IsSetParamConfig() bool {
+	return p.ParamConfig != nil
+}
+
+func (p *UpdateModelRequest) IsSetPrice() bool {
+	return p.Price != nil
+}
+
+func (p *UpdateModelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateModelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateModelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateModelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Desc = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField5(iprot thrift.TProtocol) error {
+	_field := manage.NewAbility()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Ability = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *manage.Protocol
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Protocol = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField7(iprot thrift.TProtocol) error {
+	_field := manage.NewProtocolConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ProtocolConfig = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField8(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[common.Scenario]*manage.ScenarioConfig, size)
+	values := make([]manage.ScenarioConfig, size)
+	for i := 0; i < size; i++ {
+		var _key common.Scenario
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.ScenarioConfigs = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField9(iprot thrift.TProtocol) error {
+	_field := manage.NewParamConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ParamConfig = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField10(iprot thrift.TProtocol) error {
+	_field := manage.NewPrice()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Price = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *UpdateModelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateModelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDesc() {
+		if err = oprot.WriteFieldBegin("desc", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Desc); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAbility() {
+		if err = oprot.WriteFieldBegin("ability", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Ability.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProtocol() {
+		if err = oprot.WriteFieldBegin("protocol", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Protocol); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProtocolConfig() {
+		if err = oprot.WriteFieldBegin("protocol_config", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ProtocolConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScenarioConfigs() {
+		if err = oprot.WriteFieldBegin("scenario_configs", thrift.MAP, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.ScenarioConfigs)); err != nil {
+			return err
+		}
+		for k, v := range p.ScenarioConfigs {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParamConfig() {
+		if err = oprot.WriteFieldBegin("param_config", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ParamConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPrice() {
+		if err = oprot.WriteFieldBegin("price", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Price.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateModelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateModelRequest(%+v)", *p)
+
+}
+
+func (p *UpdateModelRequest) DeepEqual(ano *UpdateModelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Desc) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Ability) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Protocol) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ProtocolConfig) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ScenarioConfigs) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.ParamConfig) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Price) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateModelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Desc == src {
+		return true
+	} else if p.Desc == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Desc, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field5DeepEqual(src *manage.Ability) bool {
+
+	if !p.Ability.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field6DeepEqual(src *manage.Protocol) bool {
+
+	if p.Protocol == src {
+		return true
+	} else if p.Protocol == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Protocol, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field7DeepEqual(src *manage.ProtocolConfig) bool {
+
+	if !p.ProtocolConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field8DeepEqual(src map[common.Scenario]*manage.ScenarioConfig) bool {
+
+	if len(p.ScenarioConfigs) != len(src) {
+		return false
+	}
+	for k, v := range p.ScenarioConfigs {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field9DeepEqual(src *manage.ParamConfig) bool {
+
+	if !p.ParamConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field10DeepEqual(src *manage.Price) bool {
+
+	if !p.Price.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UpdateModelResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewUpdateModelResponse() *UpdateModelResponse {
+	return &UpdateModelResponse{}
+}
+
+func (p *UpdateModelResponse) InitDefault() {
+}
+
+var UpdateModelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *UpdateModelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return UpdateModelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateModelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_UpdateModelResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *UpdateModelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateModelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateModelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateModelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *UpdateModelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateModelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateModelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateModelResponse(%+v)", *p)
+
+}
+
+func (p *UpdateModelResponse) DeepEqual(ano *UpdateModelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateModelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type DeleteModelRequest struct {
+	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	ModelID     *int64     `thrift:"model_id,2,optional" frugal:"2,optional,i64" json:"model_id" path:"model_id" `
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewDeleteModelRequest() *DeleteModelRequest {
+	return &DeleteModelRequest{}
+}
+
+func (p *DeleteModelRequest) InitDefault() {
+}
+
+var DeleteModelRequest_WorkspaceID_DEFAULT int64
+
+func (p *DeleteModelRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return DeleteModelRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var DeleteModelRequest_ModelID_DEFAULT int64
+
+func (p *DeleteModelRequest) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return DeleteModelRequest_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var DeleteModelRequest_Base_DEFAULT *base.Base
+
+func (p *DeleteModelRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return DeleteModelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DeleteModelRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *DeleteModelRequest) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *DeleteModelRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_DeleteModelRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "model_id",
+	255: "Base",
+}
+
+func (p *DeleteModelRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *DeleteModelRequest) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *DeleteModelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DeleteModelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteModelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteModelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *DeleteModelRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *DeleteModelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *DeleteModelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteModelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteModelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DeleteModelRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *DeleteModelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DeleteModelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteModelRequest(%+v)", *p)
+
+}
+
+func (p *DeleteModelRequest) DeepEqual(ano *DeleteModelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteModelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *DeleteModelRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *DeleteModelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type DeleteModelResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewDeleteModelResponse() *DeleteModelResponse {
+	return &DeleteModelResponse{}
+}
+
+func (p *DeleteModelResponse) InitDefault() {
+}
+
+var DeleteModelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *DeleteModelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return DeleteModelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteModelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_DeleteModelResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *DeleteModelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteModelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteModelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteModelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *DeleteModelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteModelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteModelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DeleteModelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteModelResponse(%+v)", *p)
+
+}
+
+func (p *DeleteModelResponse) DeepEqual(ano *DeleteModelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteModelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type QueryModelUsageRequest struct {
+	WorkspaceIds []int64 `thrift:"workspace_ids,1,optional" frugal:"1,optional,list<i64>" json:"workspace_ids" form:"workspace_ids" query:"workspace_ids"`
+	// 毫秒时间戳，包含
+	StartTime *int64 `thrift:"start_time,2,optional" frugal:"2,optional,i64" json:"start_time" form:"start_time" query:"start_time"`
+	// 毫秒时间戳，不包含
+	EndTime *int64                  `thrift:"end_time,3,optional" frugal:"3,optional,i64" json:"end_time" form:"end_time" query:"end_time"`
+	GroupBy []manage.UsageDimension `thrift:"group_by,4,optional" frugal:"4,optional,list<string>" form:"group_by" json:"group_by,omitempty" query:"group_by"`
+	// 为空时不按时间分桶
+	Granularity *manage.UsageGranularity `thrift:"granularity,5,optional" frugal:"5,optional,string" form:"granularity" json:"granularity,omitempty" query:"granularity"`
+	UserID      *string                  `thrift:"user_id,6,optional" frugal:"6,optional,string" form:"user_id" json:"user_id,omitempty" query:"user_id"`
+	ModelID     *int64                   `thrift:"model_id,7,optional" frugal:"7,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	Scenario    *common.Scenario         `thrift:"scenario,8,optional" frugal:"8,optional,string" form:"scenario" json:"scenario,omitempty" query:"scenario"`
+	Base        *base.Base               `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewQueryModelUsageRequest() *QueryModelUsageRequest {
+	return &QueryModelUsageRequest{}
+}
+
+func (p *QueryModelUsageRequest) InitDefault() {
+}
+
+var QueryModelUsageRequest_WorkspaceIds_DEFAULT []int64
+
+func (p *QueryModelUsageRequest) GetWorkspaceIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceIds() {
+		return QueryModelUsageRequest_WorkspaceIds_DEFAULT
+	}
+	return p.WorkspaceIds
+}
+
+var QueryModelUsageRequest_StartTime_DEFAULT int64
+
+func (p *QueryModelUsageRequest) GetStartTime() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStartTime() {
+		return QueryModelUsageRequest_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var QueryModelUsageRequest_EndTime_DEFAULT int64
+
+func (p *QueryModelUsageRequest) GetEndTime() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEndTime() {
+		return QueryModelUsageRequest_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var QueryModelUsageRequest_GroupBy_DEFAULT []manage.UsageDimension
+
+func (p *QueryModelUsageRequest) GetGroupBy() (v []manage.UsageDimension) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGroupBy() {
+		return QueryModelUsageRequest_GroupBy_DEFAULT
+	}
+	return p.GroupBy
+}
+
+var QueryModelUsageRequest_Granularity_DEFAULT manage.UsageGranularity
+
+func (p *QueryModelUsageRequest) GetGranularity() (v manage.UsageGranularity) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGranularity() {
+		return QueryModelUsageRequest_Granularity_DEFAULT
+	}
+	return *p.Granularity
+}
+
+var QueryModelUsageRequest_UserID_DEFAULT string
+
+func (p *QueryModelUsageRequest) GetUserID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUserID() {
+		return QueryModelUsageRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var QueryModelUsageRequest_ModelID_DEFAULT int64
+
+func (p *QueryModelUsageRequest) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return QueryModelUsageRequest_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var QueryModelUsageRequest_Scenario_DEFAULT common.Scenario
+
+func (p *QueryModelUsageRequest) GetScenario() (v common.Scenario) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScenario() {
+		return QueryModelUsageRequest_Scenario_DEFAULT
+	}
+	return *p.Scenario
+}
+
+var QueryModelUsageRequest_Base_DEFAULT *base.Base
+
+func (p *QueryModelUsageRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return QueryModelUsageRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *QueryModelUsageRequest) SetWorkspaceIds(val []int64) {
+	p.WorkspaceIds = val
+}
+func (p *QueryModelUsageRequest) SetStartTime(val *int64) {
+	p.StartTime = val
+}
+func (p *QueryModelUsageRequest) SetEndTime(val *int64) {
+	p.EndTime = val
+}
+func (p *QueryModelUsageRequest) SetGroupBy(val []manage.UsageDimension) {
+	p.GroupBy = val
+}
+func (p *QueryModelUsageRequest) SetGranularity(val *manage.UsageGranularity) {
+	p.Granularity = val
+}
+func (p *QueryModelUsageRequest) SetUserID(val *string) {
+	p.UserID = val
+}
+func (p *QueryModelUsageRequest) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *QueryModelUsageRequest) SetScenario(val *common.Scenario) {
+	p.Scenario = val
+}
+func (p *QueryModelUsageRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_QueryModelUsageRequest = map[int16]string{
+	1:   "workspace_ids",
+	2:   "start_time",
+	3:   "end_time",
+	4:   "group_by",
+	5:   "granularity",
+	6:   "user_id",
+	7:   "model_id",
+	8:   "scenario",
+	255: "Base",
+}
+
+func (p *QueryModelUsageRequest) IsSetWorkspaceIds() bool {
+	return p.WorkspaceIds != nil
+}
+
+func (p *QueryModelUsageRequest) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *QueryModelUsageRequest) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *QueryModelUsageRequest) IsSetGroupBy() bool {
+	return p.GroupBy != nil
+}
+
+func (p *QueryModelUsageRequest) IsSetGranularity() bool {
+	return p.Granularity != nil
+}
+
+func (p *QueryModelUsageRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *QueryModelUsageRequest) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *QueryModelUsageRequest) IsSetScenario() bool {
+	return p.Scenario != nil
+}
+
+func (p *QueryModelUsageRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *QueryModelUsageRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryModelUsageRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryModelUsageRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.WorkspaceIds = _field
+	return nil
+}
+func (p *QueryModelUsageRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *QueryModelUsageRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *QueryModelUsageRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]manage.UsageDimension, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem manage.UsageDimension
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.GroupBy = _field
+	return nil
+}
+func (p *QueryModelUsageRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *manage.UsageGranularity
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Granularity = _field
+	return nil
+}
+func (p *QueryModelUsageRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *QueryModelUsageRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *QueryModelUsageRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *common.Scenario
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Scenario = _field
+	return nil
+}
+func (p *QueryModelUsageRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *QueryModelUsageRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryModelUsageRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryModelUsageRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceIds() {
+		if err = oprot.WriteFieldBegin("workspace_ids", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.WorkspaceIds)); err != nil {
+			return err
+		}
+		for _, v := range p.WorkspaceIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryModelUsageRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *QueryModelUsageRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *QueryModelUsageRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroupBy() {
+		if err = oprot.WriteFieldBegin("group_by", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.GroupBy)); err != nil {
+			return err
+		}
+		for _, v := range p.GroupBy {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *QueryModelUsageRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGranularity() {
+		if err = oprot.WriteFieldBegin("granularity", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Granularity); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *QueryModelUsageRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *QueryModelUsageRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *QueryModelUsageRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScenario() {
+		if err = oprot.WriteFieldBegin("scenario", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Scenario); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *QueryModelUsageRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryModelUsageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryModelUsageRequest(%+v)", *p)
+
+}
+
+func (p *QueryModelUsageRequest) DeepEqual(ano *QueryModelUsageRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceIds) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.GroupBy) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Granularity) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.UserID) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Scenario) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *QueryModelUsageRequest) Field1DeepEqual(src []int64) bool {
+
+	if len(p.WorkspaceIds) != len(src) {
+		return false
+	}
+	for i, v := range p.WorkspaceIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *QueryModelUsageRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.StartTime == src {
+		return true
+	} else if p.StartTime == nil || src == nil {
+		return false
+	}
+	if *p.StartTime != *src {
+		return false
+	}
+	return true
+}
+func (p *QueryModelUsageRequest) Field3DeepEqual(src *int64) bool {
+
+	if p.EndTime == src {
+		return true
+	} else if p.EndTime == nil || src == nil {
+		return false
+	}
+	if *p.EndTime != *src {
+		return false
+	}
+	return true
+}
+func (p *QueryModelUsageRequest) Field4DeepEqual(src []manage.UsageDimension) bool {
+
+	if len(p.GroupBy) != len(src) {
+		return false
+	}
+	for i, v := range p.GroupBy {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *QueryModelUsageRequest) Field5DeepEqual(src *manage.UsageGranularity) bool {
+
+	if p.Granularity == src {
+		return true
+	} else if p.Granularity == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Granularity, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *QueryModelUsageRequest) Field6DeepEqual(src *string) bool {
+
+	if p.UserID == src {
+		return true
+	} else if p.UserID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.UserID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *QueryModelUsageRequest) Field7DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *QueryModelUsageRequest) Field8DeepEqual(src *common.Scenario) bool {
+
+	if p.Scenario == src {
+		return true
+	} else if p.Scenario == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Scenario, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *QueryModelUsageRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type QueryModelUsageResponse struct {
+	Stats    []*manage.ModelUsageStat `thrift:"stats,1,optional" frugal:"1,optional,list<manage.ModelUsageStat>" form:"stats" json:"stats,omitempty" query:"stats"`
+	BaseResp *base.BaseResp           `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewQueryModelUsageResponse() *QueryModelUsageResponse {
+	return &QueryModelUsageResponse{}
+}
+
+func (p *QueryModelUsageResponse) InitDefault() {
+}
+
+var QueryModelUsageResponse_Stats_DEFAULT []*manage.ModelUsageStat
+
+func (p *QueryModelUsageResponse) GetStats() (v []*manage.ModelUsageStat) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStats() {
+		return QueryModelUsageResponse_Stats_DEFAULT
+	}
+	return p.Stats
+}
+
+var QueryModelUsageResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *QueryModelUsageResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return QueryModelUsageResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *QueryModelUsageResponse) SetStats(val []*manage.ModelUsageStat) {
+	p.Stats = val
+}
+func (p *QueryModelUsageResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_QueryModelUsageResponse = map[int16]string{
+	1:   "stats",
+	255: "BaseResp",
+}
+
+func (p *QueryModelUsageResponse) IsSetStats() bool {
+	return p.Stats != nil
+}
+
+func (p *QueryModelUsageResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *QueryModelUsageResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryModelUsageResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryModelUsageResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*manage.ModelUsageStat, 0, size)
+	values := make([]manage.ModelUsageStat, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Stats = _field
+	return nil
+}
+func (p *QueryModelUsageResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *QueryModelUsageResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryModelUsageResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryModelUsageResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStats() {
+		if err = oprot.WriteFieldBegin("stats", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Stats)); err != nil {
+			return err
+		}
+		for _, v := range p.Stats {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryModelUsageResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryModelUsageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryModelUsageResponse(%+v)", *p)
+
+}
+
+func (p *QueryModelUsageResponse) DeepEqual(ano *QueryModelUsageResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Stats) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *QueryModelUsageResponse) Field1DeepEqual(src []*manage.ModelUsageStat) bool {
+
+	if len(p.Stats) != len(src) {
+		return false
+	}
+	for i, v := range p.Stats {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *QueryModelUsageResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetQuotaStatusRequest struct {
+	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewGetQuotaStatusRequest() *GetQuotaStatusRequest {
+	return &GetQuotaStatusRequest{}
+}
+
+func (p *GetQuotaStatusRequest) InitDefault() {
+}
+
+var GetQuotaStatusRequest_WorkspaceID_DEFAULT int64
+
+func (p *GetQuotaStatusRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return GetQuotaStatusRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var GetQuotaStatusRequest_Base_DEFAULT *base.Base
+
+func (p *GetQuotaStatusRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return GetQuotaStatusRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *GetQuotaStatusRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *GetQuotaStatusRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_GetQuotaStatusRequest = map[int16]string{
+	1:   "workspace_id",
+	255: "Base",
+}
+
+func (p *GetQuotaStatusRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *GetQuotaStatusRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetQuotaStatusRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetQuotaStatusRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetQuotaStatusRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *GetQuotaStatusRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *GetQuotaStatusRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetQuotaStatusRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetQuotaStatusRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetQuotaStatusRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetQuotaStatusRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetQuotaStatusRequest(%+v)", *p)
+
+}
+
+func (p *GetQuotaStatusRequest) DeepEqual(ano *GetQuotaStatusRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *GetQuotaStatusRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *GetQuotaStatusRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetQuotaStatusResponse struct {
+	Quotas   []*manage.QuotaStatus `thrift:"quotas,1,optional" frugal:"1,optional,list<manage.QuotaStatus>" form:"quotas" json:"quotas,omitempty" query:"quotas"`
+	BaseResp *base.BaseResp        `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewGetQuotaStatusResponse() *GetQuotaStatusResponse {
+	return &GetQuotaStatusResponse{}
+}
+
+func (p *GetQuotaStatusResponse) InitDefault() {
+}
+
+var GetQuotaStatusResponse_Quotas_DEFAULT []*manage.QuotaStatus
+
+func (p *GetQuotaStatusResponse) GetQuotas() (v []*manage.QuotaStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQuotas() {
+		return GetQuotaStatusResponse_Quotas_DEFAULT
+	}
+	return p.Quotas
+}
+
+var GetQuotaStatusResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *GetQuotaStatusResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return GetQuotaStatusResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetQuotaStatusResponse) SetQuotas(val []*manage.QuotaStatus) {
+	p.Quotas = val
+}
+func (p *GetQuotaStatusResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_GetQuotaStatusResponse = map[int16]string{
+	1:   "quotas",
+	255: "BaseResp",
+}
+
+func (p *GetQuotaStatusResponse) IsSetQuotas() bool {
+	return p.Quotas != nil
+}
+
+func (p *GetQuotaStatusResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetQuotaStatusResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetQuotaStatusResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetQuotaStatusResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*manage.QuotaStatus, 0, size)
+	values := make([]manage.QuotaStatus, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Quotas = _field
+	return nil
+}
+func (p *GetQuotaStatusResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *GetQuotaStatusResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetQuotaStatusResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetQuotaStatusResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQuotas() {
+		if err = oprot.WriteFieldBegin("quotas", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Quotas)); err != nil {
+			return err
+		}
+		for _, v := range p.Quotas {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetQuotaStatusResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetQuotaStatusResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetQuotaStatusResponse(%+v)", *p)
+
+}
+
+func (p *GetQuotaStatusResponse) DeepEqual(ano *GetQuotaStatusResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Quotas) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *GetQuotaStatusResponse) Field1DeepEqual(src []*manage.QuotaStatus) bool {
+
+	if len(p.Quotas) != len(src) {
+		return false
+	}
+	for i, v := range p.Quotas {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *GetQuotaStatusResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
 type LLMManageService interface {
 	ListModels(ctx context.Context, req *ListModelsRequest) (r *ListModelsResponse, err error)
 
-	GetModel(ctx context.Context, req *GetModelRequest) (r *GetModelResponse, err error)
+	GetModel(ctx context.Context, req *GetModelRequest) (r *GetModelResponse, err error)
+
+	CreateModel(ctx context.Context, req *CreateModelRequest) (r *CreateModelResponse, err error)
+
+	UpdateModel(ctx context.Context, req *UpdateModelRequest) (r *UpdateModelResponse, err error)
+
+	DeleteModel(ctx context.Context, req *DeleteModelRequest) (r *DeleteModelResponse, err error)
+
+	// 按空间、用户、模型、场景及时间窗口聚合模型调用的 token、成本、错误率与耗时
+	QueryModelUsage(ctx context.Context, req *QueryModelUsageRequest) (r *QueryModelUsageResponse, err error)
+
+	// 查询空间在当前日/月周期内的 token 与成本额度使用情况
+	GetQuotaStatus(ctx context.Context, req *GetQuotaStatusRequest) (r *GetQuotaStatusResponse, err error)
+}
+
+type LLMManageServiceClient struct {
+	c thrift.TClient
+}
+
+func NewLLMManageServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *LLMManageServiceClient {
+	return &LLMManageServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewLLMManageServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *LLMManageServiceClient {
+	return &LLMManageServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewLLMManageServiceClient(c thrift.TClient) *LLMManageServiceClient {
+	return &LLMManageServiceClient{
+		c: c,
+	}
+}
+
+func (p *LLMManageServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *LLMManageServiceClient) ListModels(ctx context.Context, req *ListModelsRequest) (r *ListModelsResponse, err error) {
+	var _args LLMManageServiceListModelsArgs
+	_args.Req = req
+	var _result LLMManageServiceListModelsResult
+	if err = p.Client_().Call(ctx, "ListModels", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) GetModel(ctx context.Context, req *GetModelRequest) (r *GetModelResponse, err error) {
+	var _args LLMManageServiceGetModelArgs
+	_args.Req = req
+	var _result LLMManageServiceGetModelResult
+	if err = p.Client_().Call(ctx, "GetModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) CreateModel(ctx context.Context, req *CreateModelRequest) (r *CreateModelResponse, err error) {
+	var _args LLMManageServiceCreateModelArgs
+	_args.Req = req
+	var _result LLMManageServiceCreateModelResult
+	if err = p.Client_().Call(ctx, "CreateModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) UpdateModel(ctx context.Context, req *UpdateModelRequest) (r *UpdateModelResponse, err error) {
+	var _args LLMManageServiceUpdateModelArgs
+	_args.Req = req
+	var _result LLMManageServiceUpdateModelResult
+	if err = p.Client_().Call(ctx, "UpdateModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) DeleteModel(ctx context.Context, req *DeleteModelRequest) (r *DeleteModelResponse, err error) {
+	var _args LLMManageServiceDeleteModelArgs
+	_args.Req = req
+	var _result LLMManageServiceDeleteModelResult
+	if err = p.Client_().Call(ctx, "DeleteModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) QueryModelUsage(ctx context.Context, req *QueryModelUsageRequest) (r *QueryModelUsageResponse, err error) {
+	var _args LLMManageServiceQueryModelUsageArgs
+	_args.Req = req
+	var _result LLMManageServiceQueryModelUsageResult
+	if err = p.Client_().Call(ctx, "QueryModelUsage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) GetQuotaStatus(ctx context.Context, req *GetQuotaStatusRequest) (r *GetQuotaStatusResponse, err error) {
+	var _args LLMManageServiceGetQuotaStatusArgs
+	_args.Req = req
+	var _result LLMManageServiceGetQuotaStatusResult
+	if err = p.Client_().Call(ctx, "GetQuotaStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type LLMManageServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      LLMManageService
+}
+
+func (p *LLMManageServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *LLMManageServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *LLMManageServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewLLMManageServiceProcessor(handler LLMManageService) *LLMManageServiceProcessor {
+	self := &LLMManageServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListModels", &lLMManageServiceProcessorListModels{handler: handler})
+	self.AddToProcessorMap("GetModel", &lLMManageServiceProcessorGetModel{handler: handler})
+	self.AddToProcessorMap("CreateModel", &lLMManageServiceProcessorCreateModel{handler: handler})
+	self.AddToProcessorMap("UpdateModel", &lLMManageServiceProcessorUpdateModel{handler: handler})
+	self.AddToProcessorMap("DeleteModel", &lLMManageServiceProcessorDeleteModel{handler: handler})
+	self.AddToProcessorMap("QueryModelUsage", &lLMManageServiceProcessorQueryModelUsage{handler: handler})
+	self.AddToProcessorMap("GetQuotaStatus", &lLMManageServiceProcessorGetQuotaStatus{handler: handler})
+	return self
+}
+func (p *LLMManageServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type lLMManageServiceProcessorListModels struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorListModels) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceListModelsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListModels", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceListModelsResult{}
+	var retval *ListModelsResponse
+	if retval, err2 = p.handler.ListModels(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListModels: "+err2.Error())
+		oprot.WriteMessageBegin("ListModels", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListModels", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorGetModel struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorGetModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceGetModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceGetModelResult{}
+	var retval *GetModelResponse
+	if retval, err2 = p.handler.GetModel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetModel: "+err2.Error())
+		oprot.WriteMessageBegin("GetModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorCreateModel struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorCreateModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceCreateModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceCreateModelResult{}
+	var retval *CreateModelResponse
+	if retval, err2 = p.handler.CreateModel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateModel: "+err2.Error())
+		oprot.WriteMessageBegin("CreateModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorUpdateModel struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorUpdateModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceUpdateModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceUpdateModelResult{}
+	var retval *UpdateModelResponse
+	if retval, err2 = p.handler.UpdateModel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateModel: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorDeleteModel struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorDeleteModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceDeleteModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceDeleteModelResult{}
+	var retval *DeleteModelResponse
+	if retval, err2 = p.handler.DeleteModel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteModel: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorQueryModelUsage struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorQueryModelUsage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceQueryModelUsageArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryModelUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceQueryModelUsageResult{}
+	var retval *QueryModelUsageResponse
+	if retval, err2 = p.handler.QueryModelUsage(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryModelUsage: "+err2.Error())
+		oprot.WriteMessageBegin("QueryModelUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryModelUsage", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorGetQuotaStatus struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorGetQuotaStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceGetQuotaStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetQuotaStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceGetQuotaStatusResult{}
+	var retval *GetQuotaStatusResponse
+	if retval, err2 = p.handler.GetQuotaStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetQuotaStatus: "+err2.Error())
+		oprot.WriteMessageBegin("GetQuotaStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetQuotaStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type LLMManageServiceListModelsArgs struct {
+	Req *ListModelsRequest `thrift:"req,1" frugal:"1,default,ListModelsRequest"`
+}
+
+func NewLLMManageServiceListModelsArgs() *LLMManageServiceListModelsArgs {
+	return &LLMManageServiceListModelsArgs{}
+}
+
+func (p *LLMManageServiceListModelsArgs) InitDefault() {
+}
+
+var LLMManageServiceListModelsArgs_Req_DEFAULT *ListModelsRequest
+
+func (p *LLMManageServiceListModelsArgs) GetReq() (v *ListModelsRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceListModelsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *LLMManageServiceListModelsArgs) SetReq(val *ListModelsRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_LLMManageServiceListModelsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceListModelsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceListModelsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceListModelsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListModelsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *LLMManageServiceListModelsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListModels_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceListModelsArgs(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceListModelsArgs) DeepEqual(ano *LLMManageServiceListModelsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceListModelsArgs) Field1DeepEqual(src *ListModelsRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceListModelsResult struct {
+	Success *ListModelsResponse `thrift:"success,0,optional" frugal:"0,optional,ListModelsResponse"`
+}
+
+func NewLLMManageServiceListModelsResult() *LLMManageServiceListModelsResult {
+	return &LLMManageServiceListModelsResult{}
+}
+
+func (p *LLMManageServiceListModelsResult) InitDefault() {
+}
+
+var LLMManageServiceListModelsResult_Success_DEFAULT *ListModelsResponse
+
+func (p *LLMManageServiceListModelsResult) GetSuccess() (v *ListModelsResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceListModelsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceListModelsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListModelsResponse)
+}
+
+var fieldIDToName_LLMManageServiceListModelsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceListModelsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceListModelsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceListModelsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListModelsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *LLMManageServiceListModelsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListModels_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceListModelsResult(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceListModelsResult) DeepEqual(ano *LLMManageServiceListModelsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceListModelsResult) Field0DeepEqual(src *ListModelsResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceGetModelArgs struct {
+	Req *GetModelRequest `thrift:"req,1" frugal:"1,default,GetModelRequest"`
+}
+
+func NewLLMManageServiceGetModelArgs() *LLMManageServiceGetModelArgs {
+	return &LLMManageServiceGetModelArgs{}
+}
+
+func (p *LLMManageServiceGetModelArgs) InitDefault() {
+}
+
+var LLMManageServiceGetModelArgs_Req_DEFAULT *GetModelRequest
+
+func (p *LLMManageServiceGetModelArgs) GetReq() (v *GetModelRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceGetModelArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *LLMManageServiceGetModelArgs) SetReq(val *GetModelRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_LLMManageServiceGetModelArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceGetModelArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceGetModelArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetModelRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *LLMManageServiceGetModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetModel_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceGetModelArgs(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceGetModelArgs) DeepEqual(ano *LLMManageServiceGetModelArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceGetModelArgs) Field1DeepEqual(src *GetModelRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceGetModelResult struct {
+	Success *GetModelResponse `thrift:"success,0,optional" frugal:"0,optional,GetModelResponse"`
+}
+
+func NewLLMManageServiceGetModelResult() *LLMManageServiceGetModelResult {
+	return &LLMManageServiceGetModelResult{}
+}
+
+func (p *LLMManageServiceGetModelResult) InitDefault() {
+}
+
+var LLMManageServiceGetModelResult_Success_DEFAULT *GetModelResponse
+
+func (p *LLMManageServiceGetModelResult) GetSuccess() (v *GetModelResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceGetModelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceGetModelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetModelResponse)
+}
+
+var fieldIDToName_LLMManageServiceGetModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceGetModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceGetModelResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *LLMManageServiceGetModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetModel_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceGetModelResult(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceGetModelResult) DeepEqual(ano *LLMManageServiceGetModelResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceGetModelResult) Field0DeepEqual(src *GetModelResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceCreateModelArgs struct {
+	Req *CreateModelRequest `thrift:"req,1" frugal:"1,default,CreateModelRequest"`
+}
+
+func NewLLMManageServiceCreateModelArgs() *LLMManageServiceCreateModelArgs {
+	return &LLMManageServiceCreateModelArgs{}
+}
+
+func (p *LLMManageServiceCreateModelArgs) InitDefault() {
+}
+
+var LLMManageServiceCreateModelArgs_Req_DEFAULT *CreateModelRequest
+
+func (p *LLMManageServiceCreateModelArgs) GetReq() (v *CreateModelRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceCreateModelArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *LLMManageServiceCreateModelArgs) SetReq(val *CreateModelRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_LLMManageServiceCreateModelArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceCreateModelArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceCreateModelArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceCreateModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateModelRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *LLMManageServiceCreateModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateModel_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceCreateModelArgs(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceCreateModelArgs) DeepEqual(ano *LLMManageServiceCreateModelArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceCreateModelArgs) Field1DeepEqual(src *CreateModelRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceCreateModelResult struct {
+	Success *CreateModelResponse `thrift:"success,0,optional" frugal:"0,optional,CreateModelResponse"`
+}
+
+func NewLLMManageServiceCreateModelResult() *LLMManageServiceCreateModelResult {
+	return &LLMManageServiceCreateModelResult{}
+}
+
+func (p *LLMManageServiceCreateModelResult) InitDefault() {
+}
+
+var LLMManageServiceCreateModelResult_Success_DEFAULT *CreateModelResponse
+
+func (p *LLMManageServiceCreateModelResult) GetSuccess() (v *CreateModelResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceCreateModelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceCreateModelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateModelResponse)
+}
+
+var fieldIDToName_LLMManageServiceCreateModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceCreateModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceCreateModelResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceCreateModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *LLMManageServiceCreateModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateModel_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceCreateModelResult(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceCreateModelResult) DeepEqual(ano *LLMManageServiceCreateModelResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceCreateModelResult) Field0DeepEqual(src *CreateModelResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceUpdateModelArgs struct {
+	Req *UpdateModelRequest `thrift:"req,1" frugal:"1,default,UpdateModelRequest"`
+}
+
+func NewLLMManageServiceUpdateModelArgs() *LLMManageServiceUpdateModelArgs {
+	return &LLMManageServiceUpdateModelArgs{}
+}
+
+func (p *LLMManageServiceUpdateModelArgs) InitDefault() {
+}
+
+var LLMManageServiceUpdateModelArgs_Req_DEFAULT *UpdateModelRequest
+
+func (p *LLMManageServiceUpdateModelArgs) GetReq() (v *UpdateModelRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceUpdateModelArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *LLMManageServiceUpdateModelArgs) SetReq(val *UpdateModelRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_LLMManageServiceUpdateModelArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceUpdateModelArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceUpdateModelArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceUpdateModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceUpdateModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateModelRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *LLMManageServiceUpdateModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModel_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceUpdateModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *LLMManageServiceUpdateModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceUpdateModelArgs(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceUpdateModelArgs) DeepEqual(ano *LLMManageServiceUpdateModelArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceUpdateModelArgs) Field1DeepEqual(src *UpdateModelRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceUpdateModelResult struct {
+	Success *UpdateModelResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateModelResponse"`
+}
+
+func NewLLMManageServiceUpdateModelResult() *LLMManageServiceUpdateModelResult {
+	return &LLMManageServiceUpdateModelResult{}
+}
+
+func (p *LLMManageServiceUpdateModelResult) InitDefault() {
+}
+
+var LLMManageServiceUpdateModelResult_Success_DEFAULT *UpdateModelResponse
+
+func (p *LLMManageServiceUpdateModelResult) GetSuccess() (v *UpdateModelResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceUpdateModelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceUpdateModelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateModelResponse)
+}
+
+var fieldIDToName_LLMManageServiceUpdateModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceUpdateModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceUpdateModelResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceUpdateModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceUpdateModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *LLMManageServiceUpdateModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModel_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceUpdateModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *LLMManageServiceUpdateModelResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceUpdateModelResult(%+v)", *p)
+
 }
 
-type LLMManageServiceClient struct {
-	c thrift.TClient
+func (p *LLMManageServiceUpdateModelResult) DeepEqual(ano *LLMManageServiceUpdateModelResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
 }
 
-func NewLLMManageServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *LLMManageServiceClient {
-	return &LLMManageServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *LLMManageServiceUpdateModelResult) Field0DeepEqual(src *UpdateModelResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
+	return true
 }
 
-func NewLLMManageServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *LLMManageServiceClient {
-	return &LLMManageServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+type LLMManageServiceDeleteModelArgs struct {
+	Req *DeleteModelRequest `thrift:"req,1" frugal:"1,default,DeleteModelRequest"`
+}
+
+func NewLLMManageServiceDeleteModelArgs() *LLMManageServiceDeleteModelArgs {
+	return &LLMManageServiceDeleteModelArgs{}
+}
+
+func (p *LLMManageServiceDeleteModelArgs) InitDefault() {
+}
+
+var LLMManageServiceDeleteModelArgs_Req_DEFAULT *DeleteModelRequest
+
+func (p *LLMManageServiceDeleteModelArgs) GetReq() (v *DeleteModelRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceDeleteModelArgs_Req_DEFAULT
 	}
+	return p.Req
+}
+func (p *LLMManageServiceDeleteModelArgs) SetReq(val *DeleteModelRequest) {
+	p.Req = val
 }
 
-func NewLLMManageServiceClient(c thrift.TClient) *LLMManageServiceClient {
-	return &LLMManageServiceClient{
-		c: c,
+var fieldIDToName_LLMManageServiceDeleteModelArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceDeleteModelArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceDeleteModelArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceDeleteModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *LLMManageServiceDeleteModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteModelRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
 }
 
-func (p *LLMManageServiceClient) ListModels(ctx context.Context, req *ListModelsRequest) (r *ListModelsResponse, err error) {
-	var _args LLMManageServiceListModelsArgs
-	_args.Req = req
-	var _result LLMManageServiceListModelsResult
-	if err = p.Client_().Call(ctx, "ListModels", &_args, &_result); err != nil {
-		return
+func (p *LLMManageServiceDeleteModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteModel_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *LLMManageServiceClient) GetModel(ctx context.Context, req *GetModelRequest) (r *GetModelResponse, err error) {
-	var _args LLMManageServiceGetModelArgs
-	_args.Req = req
-	var _result LLMManageServiceGetModelResult
-	if err = p.Client_().Call(ctx, "GetModel", &_args, &_result); err != nil {
-		return
+
+func (p *LLMManageServiceDeleteModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type LLMManageServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      LLMManageService
+func (p *LLMManageServiceDeleteModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceDeleteModelArgs(%+v)", *p)
+
 }
 
-func (p *LLMManageServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *LLMManageServiceDeleteModelArgs) DeepEqual(ano *LLMManageServiceDeleteModelArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
 }
 
-func (p *LLMManageServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *LLMManageServiceDeleteModelArgs) Field1DeepEqual(src *DeleteModelRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *LLMManageServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+type LLMManageServiceDeleteModelResult struct {
+	Success *DeleteModelResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteModelResponse"`
 }
 
-func NewLLMManageServiceProcessor(handler LLMManageService) *LLMManageServiceProcessor {
-	self := &LLMManageServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("ListModels", &lLMManageServiceProcessorListModels{handler: handler})
-	self.AddToProcessorMap("GetModel", &lLMManageServiceProcessorGetModel{handler: handler})
-	return self
+func NewLLMManageServiceDeleteModelResult() *LLMManageServiceDeleteModelResult {
+	return &LLMManageServiceDeleteModelResult{}
 }
-func (p *LLMManageServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+func (p *LLMManageServiceDeleteModelResult) InitDefault() {
+}
+
+var LLMManageServiceDeleteModelResult_Success_DEFAULT *DeleteModelResponse
+
+func (p *LLMManageServiceDeleteModelResult) GetSuccess() (v *DeleteModelResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceDeleteModelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceDeleteModelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteModelResponse)
+}
+
+var fieldIDToName_LLMManageServiceDeleteModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceDeleteModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceDeleteModelResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type lLMManageServiceProcessorListModels struct {
-	handler LLMManageService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceDeleteModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *lLMManageServiceProcessorListModels) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := LLMManageServiceListModelsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListModels", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *LLMManageServiceDeleteModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := LLMManageServiceListModelsResult{}
-	var retval *ListModelsResponse
-	if retval, err2 = p.handler.ListModels(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListModels: "+err2.Error())
-		oprot.WriteMessageBegin("ListModels", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListModels", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *LLMManageServiceDeleteModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteModel_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type lLMManageServiceProcessorGetModel struct {
-	handler LLMManageService
+func (p *LLMManageServiceDeleteModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *lLMManageServiceProcessorGetModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := LLMManageServiceGetModelArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetModel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *LLMManageServiceDeleteModelResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("LLMManageServiceDeleteModelResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := LLMManageServiceGetModelResult{}
-	var retval *GetModelResponse
-	if retval, err2 = p.handler.GetModel(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetModel: "+err2.Error())
-		oprot.WriteMessageBegin("GetModel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetModel", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *LLMManageServiceDeleteModelResult) DeepEqual(ano *LLMManageServiceDeleteModelResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *LLMManageServiceDeleteModelResult) Field0DeepEqual(src *DeleteModelResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type LLMManageServiceListModelsArgs struct {
-	Req *ListModelsRequest `thrift:"req,1" frugal:"1,default,ListModelsRequest"`
+type LLMManageServiceQueryModelUsageArgs struct {
+	Req *QueryModelUsageRequest `thrift:"req,1" frugal:"1,default,QueryModelUsageRequest"`
 }
 
-func NewLLMManageServiceListModelsArgs() *LLMManageServiceListModelsArgs {
-	return &LLMManageServiceListModelsArgs{}
+func NewLLMManageServiceQueryModelUsageArgs() *LLMManageServiceQueryModelUsageArgs {
+	return &LLMManageServiceQueryModelUsageArgs{}
 }
 
-func (p *LLMManageServiceListModelsArgs) InitDefault() {
+func (p *LLMManageServiceQueryModelUsageArgs) InitDefault() {
 }
 
-var LLMManageServiceListModelsArgs_Req_DEFAULT *ListModelsRequest
+var LLMManageServiceQueryModelUsageArgs_Req_DEFAULT *QueryModelUsageRequest
 
-func (p *LLMManageServiceListModelsArgs) GetReq() (v *ListModelsRequest) {
+func (p *LLMManageServiceQueryModelUsageArgs) GetReq() (v *QueryModelUsageRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return LLMManageServiceListModelsArgs_Req_DEFAULT
+		return LLMManageServiceQueryModelUsageArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *LLMManageServiceListModelsArgs) SetReq(val *ListModelsRequest) {
+func (p *LLMManageServiceQueryModelUsageArgs) SetReq(val *QueryModelUsageRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_LLMManageServiceListModelsArgs = map[int16]string{
+var fieldIDToName_LLMManageServiceQueryModelUsageArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *LLMManageServiceListModelsArgs) IsSetReq() bool {
+func (p *LLMManageServiceQueryModelUsageArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *LLMManageServiceListModelsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceQueryModelUsageArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1828,7 +7650,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceListModelsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceQueryModelUsageArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1838,8 +7660,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListModelsRequest()
+func (p *LLMManageServiceQueryModelUsageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryModelUsageRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1847,9 +7669,9 @@ func (p *LLMManageServiceListModelsArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *LLMManageServiceListModelsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceQueryModelUsageArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListModels_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryModelUsage_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1875,7 +7697,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceQueryModelUsageArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1892,15 +7714,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsArgs) String() string {
+func (p *LLMManageServiceQueryModelUsageArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMManageServiceListModelsArgs(%+v)", *p)
+	return fmt.Sprintf("LLMManageServiceQueryModelUsageArgs(%+v)", *p)
 
 }
 
-func (p *LLMManageServiceListModelsArgs) DeepEqual(ano *LLMManageServiceListModelsArgs) bool {
+func (p *LLMManageServiceQueryModelUsageArgs) DeepEqual(ano *LLMManageServiceQueryModelUsageArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1912,7 +7734,7 @@ func (p *LLMManageServiceListModelsArgs) DeepEqual(ano *LLMManageServiceListMode
 	return true
 }
 
-func (p *LLMManageServiceListModelsArgs) Field1DeepEqual(src *ListModelsRequest) bool {
+func (p *LLMManageServiceQueryModelUsageArgs) Field1DeepEqual(src *QueryModelUsageRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1920,41 +7742,41 @@ func (p *LLMManageServiceListModelsArgs) Field1DeepEqual(src *ListModelsRequest)
 	return true
 }
 
-type LLMManageServiceListModelsResult struct {
-	Success *ListModelsResponse `thrift:"success,0,optional" frugal:"0,optional,ListModelsResponse"`
+type LLMManageServiceQueryModelUsageResult struct {
+	Success *QueryModelUsageResponse `thrift:"success,0,optional" frugal:"0,optional,QueryModelUsageResponse"`
 }
 
-func NewLLMManageServiceListModelsResult() *LLMManageServiceListModelsResult {
-	return &LLMManageServiceListModelsResult{}
+func NewLLMManageServiceQueryModelUsageResult() *LLMManageServiceQueryModelUsageResult {
+	return &LLMManageServiceQueryModelUsageResult{}
 }
 
-func (p *LLMManageServiceListModelsResult) InitDefault() {
+func (p *LLMManageServiceQueryModelUsageResult) InitDefault() {
 }
 
-var LLMManageServiceListModelsResult_Success_DEFAULT *ListModelsResponse
+var LLMManageServiceQueryModelUsageResult_Success_DEFAULT *QueryModelUsageResponse
 
-func (p *LLMManageServiceListModelsResult) GetSuccess() (v *ListModelsResponse) {
+func (p *LLMManageServiceQueryModelUsageResult) GetSuccess() (v *QueryModelUsageResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return LLMManageServiceListModelsResult_Success_DEFAULT
+		return LLMManageServiceQueryModelUsageResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *LLMManageServiceListModelsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListModelsResponse)
+func (p *LLMManageServiceQueryModelUsageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QueryModelUsageResponse)
 }
 
-var fieldIDToName_LLMManageServiceListModelsResult = map[int16]string{
+var fieldIDToName_LLMManageServiceQueryModelUsageResult = map[int16]string{
 	0: "success",
 }
 
-func (p *LLMManageServiceListModelsResult) IsSetSuccess() bool {
+func (p *LLMManageServiceQueryModelUsageResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *LLMManageServiceListModelsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceQueryModelUsageResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1999,7 +7821,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceListModelsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceQueryModelUsageResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2009,8 +7831,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListModelsResponse()
+func (p *LLMManageServiceQueryModelUsageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryModelUsageResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2018,9 +7840,9 @@ func (p *LLMManageServiceListModelsResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *LLMManageServiceListModelsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceQueryModelUsageResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListModels_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryModelUsage_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2046,7 +7868,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceQueryModelUsageResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2065,15 +7887,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsResult) String() string {
+func (p *LLMManageServiceQueryModelUsageResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMManageServiceListModelsResult(%+v)", *p)
+	return fmt.Sprintf("LLMManageServiceQueryModelUsageResult(%+v)", *p)
 
 }
 
-func (p *LLMManageServiceListModelsResult) DeepEqual(ano *LLMManageServiceListModelsResult) bool {
+func (p *LLMManageServiceQueryModelUsageResult) DeepEqual(ano *LLMManageServiceQueryModelUsageResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2085,7 +7907,7 @@ func (p *LLMManageServiceListModelsResult) DeepEqual(ano *LLMManageServiceListMo
 	return true
 }
 
-func (p *LLMManageServiceListModelsResult) Field0DeepEqual(src *ListModelsResponse) bool {
+func (p *LLMManageServiceQueryModelUsageResult) Field0DeepEqual(src *QueryModelUsageResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2093,41 +7915,41 @@ func (p *LLMManageServiceListModelsResult) Field0DeepEqual(src *ListModelsRespon
 	return true
 }
 
-type LLMManageServiceGetModelArgs struct {
-	Req *GetModelRequest `thrift:"req,1" frugal:"1,default,GetModelRequest"`
+type LLMManageServiceGetQuotaStatusArgs struct {
+	Req *GetQuotaStatusRequest `thrift:"req,1" frugal:"1,default,GetQuotaStatusRequest"`
 }
 
-func NewLLMManageServiceGetModelArgs() *LLMManageServiceGetModelArgs {
-	return &LLMManageServiceGetModelArgs{}
+func NewLLMManageServiceGetQuotaStatusArgs() *LLMManageServiceGetQuotaStatusArgs {
+	return &LLMManageServiceGetQuotaStatusArgs{}
 }
 
-func (p *LLMManageServiceGetModelArgs) InitDefault() {
+func (p *LLMManageServiceGetQuotaStatusArgs) InitDefault() {
 }
 
-var LLMManageServiceGetModelArgs_Req_DEFAULT *GetModelRequest
+var LLMManageServiceGetQuotaStatusArgs_Req_DEFAULT *GetQuotaStatusRequest
 
-func (p *LLMManageServiceGetModelArgs) GetReq() (v *GetModelRequest) {
+func (p *LLMManageServiceGetQuotaStatusArgs) GetReq() (v *GetQuotaStatusRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return LLMManageServiceGetModelArgs_Req_DEFAULT
+		return LLMManageServiceGetQuotaStatusArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *LLMManageServiceGetModelArgs) SetReq(val *GetModelRequest) {
+func (p *LLMManageServiceGetQuotaStatusArgs) SetReq(val *GetQuotaStatusRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_LLMManageServiceGetModelArgs = map[int16]string{
+var fieldIDToName_LLMManageServiceGetQuotaStatusArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *LLMManageServiceGetModelArgs) IsSetReq() bool {
+func (p *LLMManageServiceGetQuotaStatusArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *LLMManageServiceGetModelArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceGetQuotaStatusArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2172,7 +7994,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetModelArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetQuotaStatusArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2182,8 +8004,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetModelRequest()
+func (p *LLMManageServiceGetQuotaStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetQuotaStatusRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2191,9 +8013,9 @@ func (p *LLMManageServiceGetModelArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *LLMManageServiceGetModelArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceGetQuotaStatusArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetModel_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetQuotaStatus_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2219,7 +8041,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceGetQuotaStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2236,15 +8058,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelArgs) String() string {
+func (p *LLMManageServiceGetQuotaStatusArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMManageServiceGetModelArgs(%+v)", *p)
+	return fmt.Sprintf("LLMManageServiceGetQuotaStatusArgs(%+v)", *p)
 
 }
 
-func (p *LLMManageServiceGetModelArgs) DeepEqual(ano *LLMManageServiceGetModelArgs) bool {
+func (p *LLMManageServiceGetQuotaStatusArgs) DeepEqual(ano *LLMManageServiceGetQuotaStatusArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2256,7 +8078,7 @@ func (p *LLMManageServiceGetModelArgs) DeepEqual(ano *LLMManageServiceGetModelAr
 	return true
 }
 
-func (p *LLMManageServiceGetModelArgs) Field1DeepEqual(src *GetModelRequest) bool {
+func (p *LLMManageServiceGetQuotaStatusArgs) Field1DeepEqual(src *GetQuotaStatusRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2264,41 +8086,41 @@ func (p *LLMManageServiceGetModelArgs) Field1DeepEqual(src *GetModelRequest) boo
 	return true
 }
 
-type LLMManageServiceGetModelResult struct {
-	Success *GetModelResponse `thrift:"success,0,optional" frugal:"0,optional,GetModelResponse"`
+type LLMManageServiceGetQuotaStatusResult struct {
+	Success *GetQuotaStatusResponse `thrift:"success,0,optional" frugal:"0,optional,GetQuotaStatusResponse"`
 }
 
-func NewLLMManageServiceGetModelResult() *LLMManageServiceGetModelResult {
-	return &LLMManageServiceGetModelResult{}
+func NewLLMManageServiceGetQuotaStatusResult() *LLMManageServiceGetQuotaStatusResult {
+	return &LLMManageServiceGetQuotaStatusResult{}
 }
 
-func (p *LLMManageServiceGetModelResult) InitDefault() {
+func (p *LLMManageServiceGetQuotaStatusResult) InitDefault() {
 }
 
-var LLMManageServiceGetModelResult_Success_DEFAULT *GetModelResponse
+var LLMManageServiceGetQuotaStatusResult_Success_DEFAULT *GetQuotaStatusResponse
 
-func (p *LLMManageServiceGetModelResult) GetSuccess() (v *GetModelResponse) {
+func (p *LLMManageServiceGetQuotaStatusResult) GetSuccess() (v *GetQuotaStatusResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return LLMManageServiceGetModelResult_Success_DEFAULT
+		return LLMManageServiceGetQuotaStatusResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *LLMManageServiceGetModelResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetModelResponse)
+func (p *LLMManageServiceGetQuotaStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetQuotaStatusResponse)
 }
 
-var fieldIDToName_LLMManageServiceGetModelResult = map[int16]string{
+var fieldIDToName_LLMManageServiceGetQuotaStatusResult = map[int16]string{
 	0: "success",
 }
 
-func (p *LLMManageServiceGetModelResult) IsSetSuccess() bool {
+func (p *LLMManageServiceGetQuotaStatusResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *LLMManageServiceGetModelResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceGetQuotaStatusResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2343,7 +8165,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetModelResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetQuotaStatusResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2353,8 +8175,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetModelResponse()
+func (p *LLMManageServiceGetQuotaStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetQuotaStatusResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2362,9 +8184,9 @@ func (p *LLMManageServiceGetModelResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *LLMManageServiceGetModelResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceGetQuotaStatusResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetModel_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetQuotaStatus_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2390,7 +8212,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceGetQuotaStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2409,15 +8231,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelResult) String() string {
+func (p *LLMManageServiceGetQuotaStatusResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMManageServiceGetModelResult(%+v)", *p)
+	return fmt.Sprintf("LLMManageServiceGetQuotaStatusResult(%+v)", *p)
 
 }
 
-func (p *LLMManageServiceGetModelResult) DeepEqual(ano *LLMManageServiceGetModelResult) bool {
+func (p *LLMManageServiceGetQuotaStatusResult) DeepEqual(ano *LLMManageServiceGetQuotaStatusResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2429,7 +8251,7 @@ func (p *LLMManageServiceGetModelResult) DeepEqual(ano *LLMManageServiceGetModel
 	return true
 }
 
-func (p *LLMManageServiceGetModelResult) Field0DeepEqual(src *GetModelResponse) bool {
+func (p *LLMManageServiceGetQuotaStatusResult) Field0DeepEqual(src *GetQuotaStatusResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
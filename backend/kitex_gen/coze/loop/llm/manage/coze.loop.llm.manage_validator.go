@@ -70,3 +70,157 @@ func (p *GetModelResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *CreateModelRequest) IsValid() error {
+	if p.WorkspaceID == nil {
+		return fmt.Errorf("field WorkspaceID not_nil rule failed")
+	}
+	if *p.WorkspaceID <= int64(0) {
+		return fmt.Errorf("field WorkspaceID gt rule failed, current value: %v", *p.WorkspaceID)
+	}
+	if p.Model == nil {
+		return fmt.Errorf("field Model not_nil rule failed")
+	}
+	if err := p.Model.IsValid(); err != nil {
+		return fmt.Errorf("field Model not valid, %w", err)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *CreateModelResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *UpdateModelRequest) IsValid() error {
+	if p.WorkspaceID == nil {
+		return fmt.Errorf("field WorkspaceID not_nil rule failed")
+	}
+	if *p.WorkspaceID <= int64(0) {
+		return fmt.Errorf("field WorkspaceID gt rule failed, current value: %v", *p.WorkspaceID)
+	}
+	if p.ModelID == nil {
+		return fmt.Errorf("field ModelID not_nil rule failed")
+	}
+	if *p.ModelID <= int64(0) {
+		return fmt.Errorf("field ModelID gt rule failed, current value: %v", *p.ModelID)
+	}
+	if p.Ability != nil {
+		if err := p.Ability.IsValid(); err != nil {
+			return fmt.Errorf("field Ability not valid, %w", err)
+		}
+	}
+	if p.ProtocolConfig != nil {
+		if err := p.ProtocolConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ProtocolConfig not valid, %w", err)
+		}
+	}
+	if p.ParamConfig != nil {
+		if err := p.ParamConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ParamConfig not valid, %w", err)
+		}
+	}
+	if p.Price != nil {
+		if err := p.Price.IsValid(); err != nil {
+			return fmt.Errorf("field Price not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *UpdateModelResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *DeleteModelRequest) IsValid() error {
+	if p.WorkspaceID == nil {
+		return fmt.Errorf("field WorkspaceID not_nil rule failed")
+	}
+	if *p.WorkspaceID <= int64(0) {
+		return fmt.Errorf("field WorkspaceID gt rule failed, current value: %v", *p.WorkspaceID)
+	}
+	if p.ModelID == nil {
+		return fmt.Errorf("field ModelID not_nil rule failed")
+	}
+	if *p.ModelID <= int64(0) {
+		return fmt.Errorf("field ModelID gt rule failed, current value: %v", *p.ModelID)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *DeleteModelResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *QueryModelUsageRequest) IsValid() error {
+	if len(p.WorkspaceIds) < int(1) {
+		return fmt.Errorf("field WorkspaceIds MinLen rule failed, current value: %v", p.WorkspaceIds)
+	}
+	if len(p.WorkspaceIds) > int(100) {
+		return fmt.Errorf("field WorkspaceIds MaxLen rule failed, current value: %v", p.WorkspaceIds)
+	}
+	if p.StartTime == nil {
+		return fmt.Errorf("field StartTime not_nil rule failed")
+	}
+	if p.EndTime == nil {
+		return fmt.Errorf("field EndTime not_nil rule failed")
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *QueryModelUsageResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetQuotaStatusRequest) IsValid() error {
+	if p.WorkspaceID == nil {
+		return fmt.Errorf("field WorkspaceID not_nil rule failed")
+	}
+	if *p.WorkspaceID <= int64(0) {
+		return fmt.Errorf("field WorkspaceID gt rule failed, current value: %v", *p.WorkspaceID)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetQuotaStatusResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
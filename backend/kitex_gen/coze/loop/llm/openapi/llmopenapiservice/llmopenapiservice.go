@@ -0,0 +1,139 @@
+// Code generated by Kitex v0.13.1. DO NOT EDIT.
+
+package llmopenapiservice
+
+import (
+	"context"
+	"errors"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	openapi "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"ChatCompletions": kitex.NewMethodInfo(
+		chatCompletionsHandler,
+		newLLMOpenAPIServiceChatCompletionsArgs,
+		newLLMOpenAPIServiceChatCompletionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ChatCompletionsStream": kitex.NewMethodInfo(
+		chatCompletionsStreamHandler,
+		newLLMOpenAPIServiceChatCompletionsStreamArgs,
+		newLLMOpenAPIServiceChatCompletionsStreamResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingServer),
+	),
+}
+
+var (
+	lLMOpenAPIServiceServiceInfo = NewServiceInfo()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return lLMOpenAPIServiceServiceInfo
+}
+
+// NewServiceInfo creates a new ServiceInfo
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo()
+}
+
+func newServiceInfo() *kitex.ServiceInfo {
+	serviceName := "LLMOpenAPIService"
+	handlerType := (*openapi.LLMOpenAPIService)(nil)
+	extra := map[string]interface{}{
+		"PackageName": "openapi",
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         serviceMethods,
+		PayloadCodec:    kitex.Thrift,
+		KiteXGenVersion: "v0.13.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func chatCompletionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*openapi.LLMOpenAPIServiceChatCompletionsArgs)
+	realResult := result.(*openapi.LLMOpenAPIServiceChatCompletionsResult)
+	success, err := handler.(openapi.LLMOpenAPIService).ChatCompletions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMOpenAPIServiceChatCompletionsArgs() interface{} {
+	return openapi.NewLLMOpenAPIServiceChatCompletionsArgs()
+}
+
+func newLLMOpenAPIServiceChatCompletionsResult() interface{} {
+	return openapi.NewLLMOpenAPIServiceChatCompletionsResult()
+}
+
+func chatCompletionsStreamHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	st, err := streaming.GetServerStreamFromArg(arg)
+	if err != nil {
+		return err
+	}
+	stream := streaming.NewServerStreamingServer[openapi.ChatCompletionsStreamResponse](st)
+	req := new(openapi.ChatCompletionsRequest)
+	if err := stream.RecvMsg(ctx, req); err != nil {
+		return err
+	}
+	return handler.(openapi.LLMOpenAPIService).ChatCompletionsStream(ctx, req, stream)
+}
+
+func newLLMOpenAPIServiceChatCompletionsStreamArgs() interface{} {
+	return openapi.NewLLMOpenAPIServiceChatCompletionsStreamArgs()
+}
+
+func newLLMOpenAPIServiceChatCompletionsStreamResult() interface{} {
+	return openapi.NewLLMOpenAPIServiceChatCompletionsStreamResult()
+}
+
+type kClient struct {
+	c  client.Client
+	sc client.Streaming
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c:  c,
+		sc: c.(client.Streaming),
+	}
+}
+
+func (p *kClient) ChatCompletions(ctx context.Context, req *openapi.ChatCompletionsRequest) (r *openapi.ChatCompletionsResponse, err error) {
+	var _args openapi.LLMOpenAPIServiceChatCompletionsArgs
+	_args.Req = req
+	var _result openapi.LLMOpenAPIServiceChatCompletionsResult
+	if err = p.c.Call(ctx, "ChatCompletions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ChatCompletionsStream(ctx context.Context, req *openapi.ChatCompletionsRequest) (LLMOpenAPIService_ChatCompletionsStreamClient, error) {
+	st, err := p.sc.StreamX(ctx, "ChatCompletionsStream")
+	if err != nil {
+		return nil, err
+	}
+	stream := streaming.NewServerStreamingClient[openapi.ChatCompletionsStreamResponse](st)
+	if err := stream.SendMsg(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(ctx); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cloudwego/kitex/pkg/streaming"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/base"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/runtime"
+	"strings"
 )
 
 type ChatRequest struct {
@@ -762,187 +763,1455 @@ func (p *ChatResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type LLMRuntimeService interface {
-	// 非流式接口
-	Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error)
-	// 流式接口
-	ChatStream(ctx context.Context, req *ChatRequest, stream LLMRuntimeService_ChatStreamServer) (err error)
+type EmbedRequest struct {
+	// 向量化模型id
+	ModelID *int64 `thrift:"model_id,1,optional" frugal:"1,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	// 待向量化的文本，返回的向量与之一一对应
+	Texts []string `thrift:"texts,2,optional" frugal:"2,optional,list<string>" form:"texts" json:"texts,omitempty" query:"texts"`
+	// 输出向量的维度，仅部分模型支持
+	Dimensions *int32 `thrift:"dimensions,3,optional" frugal:"3,optional,i32" form:"dimensions" json:"dimensions,omitempty" query:"dimensions"`
+	// 业务参数
+	BizParam *runtime.BizParam `thrift:"biz_param,4,optional" frugal:"4,optional,runtime.BizParam" form:"biz_param" json:"biz_param,omitempty" query:"biz_param"`
+	Base     *base.Base        `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-type LLMRuntimeServiceClient struct {
-	c thrift.TClient
+func NewEmbedRequest() *EmbedRequest {
+	return &EmbedRequest{}
 }
 
-func NewLLMRuntimeServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *LLMRuntimeServiceClient {
-	return &LLMRuntimeServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *EmbedRequest) InitDefault() {
+}
+
+var EmbedRequest_ModelID_DEFAULT int64
+
+func (p *EmbedRequest) GetModelID() (v int64) {
+	if p == nil {
+		return
 	}
+	if !p.IsSetModelID() {
+		return EmbedRequest_ModelID_DEFAULT
+	}
+	return *p.ModelID
 }
 
-func NewLLMRuntimeServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *LLMRuntimeServiceClient {
-	return &LLMRuntimeServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+var EmbedRequest_Texts_DEFAULT []string
+
+func (p *EmbedRequest) GetTexts() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTexts() {
+		return EmbedRequest_Texts_DEFAULT
 	}
+	return p.Texts
 }
 
-func NewLLMRuntimeServiceClient(c thrift.TClient) *LLMRuntimeServiceClient {
-	return &LLMRuntimeServiceClient{
-		c: c,
+var EmbedRequest_Dimensions_DEFAULT int32
+
+func (p *EmbedRequest) GetDimensions() (v int32) {
+	if p == nil {
+		return
 	}
+	if !p.IsSetDimensions() {
+		return EmbedRequest_Dimensions_DEFAULT
+	}
+	return *p.Dimensions
 }
 
-func (p *LLMRuntimeServiceClient) Client_() thrift.TClient {
-	return p.c
+var EmbedRequest_BizParam_DEFAULT *runtime.BizParam
+
+func (p *EmbedRequest) GetBizParam() (v *runtime.BizParam) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBizParam() {
+		return EmbedRequest_BizParam_DEFAULT
+	}
+	return p.BizParam
 }
 
-func (p *LLMRuntimeServiceClient) Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error) {
-	var _args LLMRuntimeServiceChatArgs
-	_args.Req = req
-	var _result LLMRuntimeServiceChatResult
-	if err = p.Client_().Call(ctx, "Chat", &_args, &_result); err != nil {
+var EmbedRequest_Base_DEFAULT *base.Base
+
+func (p *EmbedRequest) GetBase() (v *base.Base) {
+	if p == nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	if !p.IsSetBase() {
+		return EmbedRequest_Base_DEFAULT
+	}
+	return p.Base
 }
-func (p *LLMRuntimeServiceClient) ChatStream(ctx context.Context, req *ChatRequest, stream LLMRuntimeService_ChatStreamServer) (err error) {
-	panic("streaming method LLMRuntimeService.ChatStream(mode = server) not available, please use Kitex Thrift Streaming Client.")
+func (p *EmbedRequest) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *EmbedRequest) SetTexts(val []string) {
+	p.Texts = val
+}
+func (p *EmbedRequest) SetDimensions(val *int32) {
+	p.Dimensions = val
+}
+func (p *EmbedRequest) SetBizParam(val *runtime.BizParam) {
+	p.BizParam = val
+}
+func (p *EmbedRequest) SetBase(val *base.Base) {
+	p.Base = val
 }
 
-type LLMRuntimeService_ChatStreamServer streaming.ServerStreamingServer[ChatResponse]
+var fieldIDToName_EmbedRequest = map[int16]string{
+	1:   "model_id",
+	2:   "texts",
+	3:   "dimensions",
+	4:   "biz_param",
+	255: "Base",
+}
 
-type LLMRuntimeServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      LLMRuntimeService
+func (p *EmbedRequest) IsSetModelID() bool {
+	return p.ModelID != nil
 }
 
-func (p *LLMRuntimeServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *EmbedRequest) IsSetTexts() bool {
+	return p.Texts != nil
 }
 
-func (p *LLMRuntimeServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *EmbedRequest) IsSetDimensions() bool {
+	return p.Dimensions != nil
 }
 
-func (p *LLMRuntimeServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *EmbedRequest) IsSetBizParam() bool {
+	return p.BizParam != nil
 }
 
-func NewLLMRuntimeServiceProcessor(handler LLMRuntimeService) *LLMRuntimeServiceProcessor {
-	self := &LLMRuntimeServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("Chat", &lLMRuntimeServiceProcessorChat{handler: handler})
-	self.AddToProcessorMap("ChatStream", &lLMRuntimeServiceProcessorChatStream{handler: handler})
-	return self
+func (p *EmbedRequest) IsSetBase() bool {
+	return p.Base != nil
 }
-func (p *LLMRuntimeServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
+
+func (p *EmbedRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EmbedRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EmbedRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *EmbedRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
 	if err != nil {
-		return false, err
+		return err
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Texts = _field
+	return nil
+}
+func (p *EmbedRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Dimensions = _field
+	return nil
+}
+func (p *EmbedRequest) ReadField4(iprot thrift.TProtocol) error {
+	_field := runtime.NewBizParam()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BizParam = _field
+	return nil
+}
+func (p *EmbedRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
 }
 
-type lLMRuntimeServiceProcessorChat struct {
-	handler LLMRuntimeService
+func (p *EmbedRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EmbedRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EmbedRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EmbedRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTexts() {
+		if err = oprot.WriteFieldBegin("texts", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Texts)); err != nil {
+			return err
+		}
+		for _, v := range p.Texts {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EmbedRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDimensions() {
+		if err = oprot.WriteFieldBegin("dimensions", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Dimensions); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EmbedRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBizParam() {
+		if err = oprot.WriteFieldBegin("biz_param", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BizParam.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EmbedRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *EmbedRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EmbedRequest(%+v)", *p)
+
+}
+
+func (p *EmbedRequest) DeepEqual(ano *EmbedRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Texts) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Dimensions) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.BizParam) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *EmbedRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *EmbedRequest) Field2DeepEqual(src []string) bool {
+
+	if len(p.Texts) != len(src) {
+		return false
+	}
+	for i, v := range p.Texts {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *EmbedRequest) Field3DeepEqual(src *int32) bool {
+
+	if p.Dimensions == src {
+		return true
+	} else if p.Dimensions == nil || src == nil {
+		return false
+	}
+	if *p.Dimensions != *src {
+		return false
+	}
+	return true
+}
+func (p *EmbedRequest) Field4DeepEqual(src *runtime.BizParam) bool {
+
+	if !p.BizParam.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EmbedRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type EmbedResponse struct {
+	Embeddings [][]float64         `thrift:"embeddings,1,optional" frugal:"1,optional,list<list<double>>" form:"embeddings" json:"embeddings,omitempty" query:"embeddings"`
+	Usage      *runtime.TokenUsage `thrift:"usage,2,optional" frugal:"2,optional,runtime.TokenUsage" form:"usage" json:"usage,omitempty" query:"usage"`
+	BaseResp   *base.BaseResp      `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewEmbedResponse() *EmbedResponse {
+	return &EmbedResponse{}
+}
+
+func (p *EmbedResponse) InitDefault() {
+}
+
+var EmbedResponse_Embeddings_DEFAULT [][]float64
+
+func (p *EmbedResponse) GetEmbeddings() (v [][]float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEmbeddings() {
+		return EmbedResponse_Embeddings_DEFAULT
+	}
+	return p.Embeddings
+}
+
+var EmbedResponse_Usage_DEFAULT *runtime.TokenUsage
+
+func (p *EmbedResponse) GetUsage() (v *runtime.TokenUsage) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUsage() {
+		return EmbedResponse_Usage_DEFAULT
+	}
+	return p.Usage
+}
+
+var EmbedResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *EmbedResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return EmbedResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *EmbedResponse) SetEmbeddings(val [][]float64) {
+	p.Embeddings = val
+}
+func (p *EmbedResponse) SetUsage(val *runtime.TokenUsage) {
+	p.Usage = val
+}
+func (p *EmbedResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_EmbedResponse = map[int16]string{
+	1:   "embeddings",
+	2:   "usage",
+	255: "BaseResp",
+}
+
+func (p *EmbedResponse) IsSetEmbeddings() bool {
+	return p.Embeddings != nil
+}
+
+func (p *EmbedResponse) IsSetUsage() bool {
+	return p.Usage != nil
+}
+
+func (p *EmbedResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *EmbedResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EmbedResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EmbedResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([][]float64, 0, size)
+	for i := 0; i < size; i++ {
+		_, size, err := iprot.ReadListBegin()
+		if err != nil {
+			return err
+		}
+		_elem := make([]float64, 0, size)
+		for i := 0; i < size; i++ {
+
+			var _elem1 float64
+			if v, err := iprot.ReadDouble(); err != nil {
+				return err
+			} else {
+				_elem1 = v
+			}
+
+			_elem = append(_elem, _elem1)
+		}
+		if err := iprot.ReadListEnd(); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Embeddings = _field
+	return nil
+}
+func (p *EmbedResponse) ReadField2(iprot thrift.TProtocol) error {
+	_field := runtime.NewTokenUsage()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Usage = _field
+	return nil
+}
+func (p *EmbedResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *EmbedResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EmbedResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EmbedResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEmbeddings() {
+		if err = oprot.WriteFieldBegin("embeddings", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.LIST, len(p.Embeddings)); err != nil {
+			return err
+		}
+		for _, v := range p.Embeddings {
+			if err := oprot.WriteListBegin(thrift.DOUBLE, len(v)); err != nil {
+				return err
+			}
+			for _, v := range v {
+				if err := oprot.WriteDouble(v); err != nil {
+					return err
+				}
+			}
+			if err := oprot.WriteListEnd(); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EmbedResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUsage() {
+		if err = oprot.WriteFieldBegin("usage", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Usage.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EmbedResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *EmbedResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EmbedResponse(%+v)", *p)
+
+}
+
+func (p *EmbedResponse) DeepEqual(ano *EmbedResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Embeddings) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Usage) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *EmbedResponse) Field1DeepEqual(src [][]float64) bool {
+
+	if len(p.Embeddings) != len(src) {
+		return false
+	}
+	for i, v := range p.Embeddings {
+		_src := src[i]
+		if len(v) != len(_src) {
+			return false
+		}
+		for i, v := range v {
+			_src1 := _src[i]
+			if v != _src1 {
+				return false
+			}
+		}
+	}
+	return true
+}
+func (p *EmbedResponse) Field2DeepEqual(src *runtime.TokenUsage) bool {
+
+	if !p.Usage.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EmbedResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMRuntimeService interface {
+	// 非流式接口
+	Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error)
+
+	// 流式接口
+	ChatStream(ctx context.Context, req *ChatRequest, stream LLMRuntimeService_ChatStreamServer) (err error)
+
+	// 批量向量化接口
+	Embed(ctx context.Context, req *EmbedRequest) (r *EmbedResponse, err error)
+}
+
+type LLMRuntimeServiceClient struct {
+	c thrift.TClient
+}
+
+func NewLLMRuntimeServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *LLMRuntimeServiceClient {
+	return &LLMRuntimeServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewLLMRuntimeServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *LLMRuntimeServiceClient {
+	return &LLMRuntimeServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewLLMRuntimeServiceClient(c thrift.TClient) *LLMRuntimeServiceClient {
+	return &LLMRuntimeServiceClient{
+		c: c,
+	}
+}
+
+func (p *LLMRuntimeServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *LLMRuntimeServiceClient) Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error) {
+	var _args LLMRuntimeServiceChatArgs
+	_args.Req = req
+	var _result LLMRuntimeServiceChatResult
+	if err = p.Client_().Call(ctx, "Chat", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMRuntimeServiceClient) ChatStream(ctx context.Context, req *ChatRequest, stream LLMRuntimeService_ChatStreamServer) (err error) {
+	panic("streaming method LLMRuntimeService.ChatStream(mode = server) not available, please use Kitex Thrift Streaming Client.")
+}
+func (p *LLMRuntimeServiceClient) Embed(ctx context.Context, req *EmbedRequest) (r *EmbedResponse, err error) {
+	var _args LLMRuntimeServiceEmbedArgs
+	_args.Req = req
+	var _result LLMRuntimeServiceEmbedResult
+	if err = p.Client_().Call(ctx, "Embed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type LLMRuntimeService_ChatStreamServer streaming.ServerStreamingServer[ChatResponse]
+
+type LLMRuntimeServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      LLMRuntimeService
+}
+
+func (p *LLMRuntimeServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *LLMRuntimeServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *LLMRuntimeServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewLLMRuntimeServiceProcessor(handler LLMRuntimeService) *LLMRuntimeServiceProcessor {
+	self := &LLMRuntimeServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("Chat", &lLMRuntimeServiceProcessorChat{handler: handler})
+	self.AddToProcessorMap("ChatStream", &lLMRuntimeServiceProcessorChatStream{handler: handler})
+	self.AddToProcessorMap("Embed", &lLMRuntimeServiceProcessorEmbed{handler: handler})
+	return self
+}
+func (p *LLMRuntimeServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type lLMRuntimeServiceProcessorChat struct {
+	handler LLMRuntimeService
+}
+
+func (p *lLMRuntimeServiceProcessorChat) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMRuntimeServiceChatArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMRuntimeServiceChatResult{}
+	var retval *ChatResponse
+	if retval, err2 = p.handler.Chat(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Chat: "+err2.Error())
+		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Chat", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMRuntimeServiceProcessorChatStream struct {
+	handler LLMRuntimeService
+}
+
+func (p *lLMRuntimeServiceProcessorChatStream) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	panic("streaming method LLMRuntimeService.ChatStream(mode = server) not available, please use Kitex Thrift Streaming Client.")
+}
+
+type lLMRuntimeServiceProcessorEmbed struct {
+	handler LLMRuntimeService
+}
+
+func (p *lLMRuntimeServiceProcessorEmbed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMRuntimeServiceEmbedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Embed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMRuntimeServiceEmbedResult{}
+	var retval *EmbedResponse
+	if retval, err2 = p.handler.Embed(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Embed: "+err2.Error())
+		oprot.WriteMessageBegin("Embed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Embed", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type LLMRuntimeServiceChatArgs struct {
+	Req *ChatRequest `thrift:"req,1" frugal:"1,default,ChatRequest"`
+}
+
+func NewLLMRuntimeServiceChatArgs() *LLMRuntimeServiceChatArgs {
+	return &LLMRuntimeServiceChatArgs{}
+}
+
+func (p *LLMRuntimeServiceChatArgs) InitDefault() {
+}
+
+var LLMRuntimeServiceChatArgs_Req_DEFAULT *ChatRequest
+
+func (p *LLMRuntimeServiceChatArgs) GetReq() (v *ChatRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMRuntimeServiceChatArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *LLMRuntimeServiceChatArgs) SetReq(val *ChatRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_LLMRuntimeServiceChatArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMRuntimeServiceChatArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMRuntimeServiceChatArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceChatArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMRuntimeServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChatRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *LLMRuntimeServiceChatArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Chat_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMRuntimeServiceChatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *LLMRuntimeServiceChatArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMRuntimeServiceChatArgs(%+v)", *p)
+
+}
+
+func (p *LLMRuntimeServiceChatArgs) DeepEqual(ano *LLMRuntimeServiceChatArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMRuntimeServiceChatArgs) Field1DeepEqual(src *ChatRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMRuntimeServiceChatResult struct {
+	Success *ChatResponse `thrift:"success,0,optional" frugal:"0,optional,ChatResponse"`
+}
+
+func NewLLMRuntimeServiceChatResult() *LLMRuntimeServiceChatResult {
+	return &LLMRuntimeServiceChatResult{}
+}
+
+func (p *LLMRuntimeServiceChatResult) InitDefault() {
+}
+
+var LLMRuntimeServiceChatResult_Success_DEFAULT *ChatResponse
+
+func (p *LLMRuntimeServiceChatResult) GetSuccess() (v *ChatResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMRuntimeServiceChatResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMRuntimeServiceChatResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ChatResponse)
+}
+
+var fieldIDToName_LLMRuntimeServiceChatResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMRuntimeServiceChatResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMRuntimeServiceChatResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceChatResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *lLMRuntimeServiceProcessorChat) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := LLMRuntimeServiceChatArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *LLMRuntimeServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewChatResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := LLMRuntimeServiceChatResult{}
-	var retval *ChatResponse
-	if retval, err2 = p.handler.Chat(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Chat: "+err2.Error())
-		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *LLMRuntimeServiceChatResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Chat_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("Chat", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMRuntimeServiceChatResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *LLMRuntimeServiceChatResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("LLMRuntimeServiceChatResult(%+v)", *p)
+
 }
 
-type lLMRuntimeServiceProcessorChatStream struct {
-	handler LLMRuntimeService
+func (p *LLMRuntimeServiceChatResult) DeepEqual(ano *LLMRuntimeServiceChatResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
 }
 
-func (p *lLMRuntimeServiceProcessorChatStream) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	panic("streaming method LLMRuntimeService.ChatStream(mode = server) not available, please use Kitex Thrift Streaming Client.")
+func (p *LLMRuntimeServiceChatResult) Field0DeepEqual(src *ChatResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-type LLMRuntimeServiceChatArgs struct {
+type LLMRuntimeServiceChatStreamArgs struct {
 	Req *ChatRequest `thrift:"req,1" frugal:"1,default,ChatRequest"`
 }
 
-func NewLLMRuntimeServiceChatArgs() *LLMRuntimeServiceChatArgs {
-	return &LLMRuntimeServiceChatArgs{}
+func NewLLMRuntimeServiceChatStreamArgs() *LLMRuntimeServiceChatStreamArgs {
+	return &LLMRuntimeServiceChatStreamArgs{}
 }
 
-func (p *LLMRuntimeServiceChatArgs) InitDefault() {
+func (p *LLMRuntimeServiceChatStreamArgs) InitDefault() {
 }
 
-var LLMRuntimeServiceChatArgs_Req_DEFAULT *ChatRequest
+var LLMRuntimeServiceChatStreamArgs_Req_DEFAULT *ChatRequest
 
-func (p *LLMRuntimeServiceChatArgs) GetReq() (v *ChatRequest) {
+func (p *LLMRuntimeServiceChatStreamArgs) GetReq() (v *ChatRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return LLMRuntimeServiceChatArgs_Req_DEFAULT
+		return LLMRuntimeServiceChatStreamArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *LLMRuntimeServiceChatArgs) SetReq(val *ChatRequest) {
+func (p *LLMRuntimeServiceChatStreamArgs) SetReq(val *ChatRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_LLMRuntimeServiceChatArgs = map[int16]string{
+var fieldIDToName_LLMRuntimeServiceChatStreamArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *LLMRuntimeServiceChatArgs) IsSetReq() bool {
+func (p *LLMRuntimeServiceChatStreamArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *LLMRuntimeServiceChatArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceChatStreamArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -987,7 +2256,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceChatArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceChatStreamArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -997,7 +2266,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
+func (p *LLMRuntimeServiceChatStreamArgs) ReadField1(iprot thrift.TProtocol) error {
 	_field := NewChatRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -1006,9 +2275,9 @@ func (p *LLMRuntimeServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *LLMRuntimeServiceChatArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceChatStreamArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Chat_args"); err != nil {
+	if err = oprot.WriteStructBegin("ChatStream_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1034,7 +2303,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceChatStreamArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1051,15 +2320,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatArgs) String() string {
+func (p *LLMRuntimeServiceChatStreamArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMRuntimeServiceChatArgs(%+v)", *p)
+	return fmt.Sprintf("LLMRuntimeServiceChatStreamArgs(%+v)", *p)
 
 }
 
-func (p *LLMRuntimeServiceChatArgs) DeepEqual(ano *LLMRuntimeServiceChatArgs) bool {
+func (p *LLMRuntimeServiceChatStreamArgs) DeepEqual(ano *LLMRuntimeServiceChatStreamArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1071,7 +2340,7 @@ func (p *LLMRuntimeServiceChatArgs) DeepEqual(ano *LLMRuntimeServiceChatArgs) bo
 	return true
 }
 
-func (p *LLMRuntimeServiceChatArgs) Field1DeepEqual(src *ChatRequest) bool {
+func (p *LLMRuntimeServiceChatStreamArgs) Field1DeepEqual(src *ChatRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1079,41 +2348,41 @@ func (p *LLMRuntimeServiceChatArgs) Field1DeepEqual(src *ChatRequest) bool {
 	return true
 }
 
-type LLMRuntimeServiceChatResult struct {
+type LLMRuntimeServiceChatStreamResult struct {
 	Success *ChatResponse `thrift:"success,0,optional" frugal:"0,optional,ChatResponse"`
 }
 
-func NewLLMRuntimeServiceChatResult() *LLMRuntimeServiceChatResult {
-	return &LLMRuntimeServiceChatResult{}
+func NewLLMRuntimeServiceChatStreamResult() *LLMRuntimeServiceChatStreamResult {
+	return &LLMRuntimeServiceChatStreamResult{}
 }
 
-func (p *LLMRuntimeServiceChatResult) InitDefault() {
+func (p *LLMRuntimeServiceChatStreamResult) InitDefault() {
 }
 
-var LLMRuntimeServiceChatResult_Success_DEFAULT *ChatResponse
+var LLMRuntimeServiceChatStreamResult_Success_DEFAULT *ChatResponse
 
-func (p *LLMRuntimeServiceChatResult) GetSuccess() (v *ChatResponse) {
+func (p *LLMRuntimeServiceChatStreamResult) GetSuccess() (v *ChatResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return LLMRuntimeServiceChatResult_Success_DEFAULT
+		return LLMRuntimeServiceChatStreamResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *LLMRuntimeServiceChatResult) SetSuccess(x interface{}) {
+func (p *LLMRuntimeServiceChatStreamResult) SetSuccess(x interface{}) {
 	p.Success = x.(*ChatResponse)
 }
 
-var fieldIDToName_LLMRuntimeServiceChatResult = map[int16]string{
+var fieldIDToName_LLMRuntimeServiceChatStreamResult = map[int16]string{
 	0: "success",
 }
 
-func (p *LLMRuntimeServiceChatResult) IsSetSuccess() bool {
+func (p *LLMRuntimeServiceChatStreamResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *LLMRuntimeServiceChatResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceChatStreamResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1158,7 +2427,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceChatResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceChatStreamResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1168,7 +2437,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *LLMRuntimeServiceChatStreamResult) ReadField0(iprot thrift.TProtocol) error {
 	_field := NewChatResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -1177,9 +2446,9 @@ func (p *LLMRuntimeServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *LLMRuntimeServiceChatResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceChatStreamResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Chat_result"); err != nil {
+	if err = oprot.WriteStructBegin("ChatStream_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1205,7 +2474,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceChatStreamResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1224,15 +2493,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatResult) String() string {
+func (p *LLMRuntimeServiceChatStreamResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMRuntimeServiceChatResult(%+v)", *p)
+	return fmt.Sprintf("LLMRuntimeServiceChatStreamResult(%+v)", *p)
 
 }
 
-func (p *LLMRuntimeServiceChatResult) DeepEqual(ano *LLMRuntimeServiceChatResult) bool {
+func (p *LLMRuntimeServiceChatStreamResult) DeepEqual(ano *LLMRuntimeServiceChatStreamResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1244,7 +2513,7 @@ func (p *LLMRuntimeServiceChatResult) DeepEqual(ano *LLMRuntimeServiceChatResult
 	return true
 }
 
-func (p *LLMRuntimeServiceChatResult) Field0DeepEqual(src *ChatResponse) bool {
+func (p *LLMRuntimeServiceChatStreamResult) Field0DeepEqual(src *ChatResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1252,41 +2521,41 @@ func (p *LLMRuntimeServiceChatResult) Field0DeepEqual(src *ChatResponse) bool {
 	return true
 }
 
-type LLMRuntimeServiceChatStreamArgs struct {
-	Req *ChatRequest `thrift:"req,1" frugal:"1,default,ChatRequest"`
+type LLMRuntimeServiceEmbedArgs struct {
+	Req *EmbedRequest `thrift:"req,1" frugal:"1,default,EmbedRequest"`
 }
 
-func NewLLMRuntimeServiceChatStreamArgs() *LLMRuntimeServiceChatStreamArgs {
-	return &LLMRuntimeServiceChatStreamArgs{}
+func NewLLMRuntimeServiceEmbedArgs() *LLMRuntimeServiceEmbedArgs {
+	return &LLMRuntimeServiceEmbedArgs{}
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) InitDefault() {
+func (p *LLMRuntimeServiceEmbedArgs) InitDefault() {
 }
 
-var LLMRuntimeServiceChatStreamArgs_Req_DEFAULT *ChatRequest
+var LLMRuntimeServiceEmbedArgs_Req_DEFAULT *EmbedRequest
 
-func (p *LLMRuntimeServiceChatStreamArgs) GetReq() (v *ChatRequest) {
+func (p *LLMRuntimeServiceEmbedArgs) GetReq() (v *EmbedRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return LLMRuntimeServiceChatStreamArgs_Req_DEFAULT
+		return LLMRuntimeServiceEmbedArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *LLMRuntimeServiceChatStreamArgs) SetReq(val *ChatRequest) {
+func (p *LLMRuntimeServiceEmbedArgs) SetReq(val *EmbedRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_LLMRuntimeServiceChatStreamArgs = map[int16]string{
+var fieldIDToName_LLMRuntimeServiceEmbedArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) IsSetReq() bool {
+func (p *LLMRuntimeServiceEmbedArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceEmbedArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1331,7 +2600,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceChatStreamArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceEmbedArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1341,8 +2610,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewChatRequest()
+func (p *LLMRuntimeServiceEmbedArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewEmbedRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1350,9 +2619,9 @@ func (p *LLMRuntimeServiceChatStreamArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceEmbedArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatStream_args"); err != nil {
+	if err = oprot.WriteStructBegin("Embed_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1378,7 +2647,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceEmbedArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1395,15 +2664,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) String() string {
+func (p *LLMRuntimeServiceEmbedArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMRuntimeServiceChatStreamArgs(%+v)", *p)
+	return fmt.Sprintf("LLMRuntimeServiceEmbedArgs(%+v)", *p)
 
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) DeepEqual(ano *LLMRuntimeServiceChatStreamArgs) bool {
+func (p *LLMRuntimeServiceEmbedArgs) DeepEqual(ano *LLMRuntimeServiceEmbedArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1415,7 +2684,7 @@ func (p *LLMRuntimeServiceChatStreamArgs) DeepEqual(ano *LLMRuntimeServiceChatSt
 	return true
 }
 
-func (p *LLMRuntimeServiceChatStreamArgs) Field1DeepEqual(src *ChatRequest) bool {
+func (p *LLMRuntimeServiceEmbedArgs) Field1DeepEqual(src *EmbedRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1423,41 +2692,41 @@ func (p *LLMRuntimeServiceChatStreamArgs) Field1DeepEqual(src *ChatRequest) bool
 	return true
 }
 
-type LLMRuntimeServiceChatStreamResult struct {
-	Success *ChatResponse `thrift:"success,0,optional" frugal:"0,optional,ChatResponse"`
+type LLMRuntimeServiceEmbedResult struct {
+	Success *EmbedResponse `thrift:"success,0,optional" frugal:"0,optional,EmbedResponse"`
 }
 
-func NewLLMRuntimeServiceChatStreamResult() *LLMRuntimeServiceChatStreamResult {
-	return &LLMRuntimeServiceChatStreamResult{}
+func NewLLMRuntimeServiceEmbedResult() *LLMRuntimeServiceEmbedResult {
+	return &LLMRuntimeServiceEmbedResult{}
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) InitDefault() {
+func (p *LLMRuntimeServiceEmbedResult) InitDefault() {
 }
 
-var LLMRuntimeServiceChatStreamResult_Success_DEFAULT *ChatResponse
+var LLMRuntimeServiceEmbedResult_Success_DEFAULT *EmbedResponse
 
-func (p *LLMRuntimeServiceChatStreamResult) GetSuccess() (v *ChatResponse) {
+func (p *LLMRuntimeServiceEmbedResult) GetSuccess() (v *EmbedResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return LLMRuntimeServiceChatStreamResult_Success_DEFAULT
+		return LLMRuntimeServiceEmbedResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *LLMRuntimeServiceChatStreamResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ChatResponse)
+func (p *LLMRuntimeServiceEmbedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EmbedResponse)
 }
 
-var fieldIDToName_LLMRuntimeServiceChatStreamResult = map[int16]string{
+var fieldIDToName_LLMRuntimeServiceEmbedResult = map[int16]string{
 	0: "success",
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) IsSetSuccess() bool {
+func (p *LLMRuntimeServiceEmbedResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceEmbedResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1502,7 +2771,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceChatStreamResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMRuntimeServiceEmbedResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1512,8 +2781,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewChatResponse()
+func (p *LLMRuntimeServiceEmbedResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewEmbedResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1521,9 +2790,9 @@ func (p *LLMRuntimeServiceChatStreamResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceEmbedResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatStream_result"); err != nil {
+	if err = oprot.WriteStructBegin("Embed_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1549,7 +2818,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *LLMRuntimeServiceEmbedResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1568,15 +2837,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) String() string {
+func (p *LLMRuntimeServiceEmbedResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMRuntimeServiceChatStreamResult(%+v)", *p)
+	return fmt.Sprintf("LLMRuntimeServiceEmbedResult(%+v)", *p)
 
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) DeepEqual(ano *LLMRuntimeServiceChatStreamResult) bool {
+func (p *LLMRuntimeServiceEmbedResult) DeepEqual(ano *LLMRuntimeServiceEmbedResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1588,7 +2857,7 @@ func (p *LLMRuntimeServiceChatStreamResult) DeepEqual(ano *LLMRuntimeServiceChat
 	return true
 }
 
-func (p *LLMRuntimeServiceChatStreamResult) Field0DeepEqual(src *ChatResponse) bool {
+func (p *LLMRuntimeServiceEmbedResult) Field0DeepEqual(src *EmbedResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
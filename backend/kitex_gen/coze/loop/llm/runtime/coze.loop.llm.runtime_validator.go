@@ -52,3 +52,29 @@ func (p *ChatResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *EmbedRequest) IsValid() error {
+	if p.BizParam != nil {
+		if err := p.BizParam.IsValid(); err != nil {
+			return fmt.Errorf("field BizParam not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EmbedResponse) IsValid() error {
+	if p.Usage != nil {
+		if err := p.Usage.IsValid(); err != nil {
+			return fmt.Errorf("field Usage not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 type Client interface {
 	Chat(ctx context.Context, req *runtime.ChatRequest, callOptions ...callopt.Option) (r *runtime.ChatResponse, err error)
 	ChatStream(ctx context.Context, req *runtime.ChatRequest, callOptions ...streamcall.Option) (stream LLMRuntimeService_ChatStreamClient, err error)
+	Embed(ctx context.Context, req *runtime.EmbedRequest, callOptions ...callopt.Option) (r *runtime.EmbedResponse, err error)
 }
 
 type LLMRuntimeService_ChatStreamClient streaming.ServerStreamingClient[runtime.ChatResponse]
@@ -60,3 +61,8 @@ func (p *kLLMRuntimeServiceClient) ChatStream(ctx context.Context, req *runtime.
 	ctx = client.NewCtxWithCallOptions(ctx, streamcall.GetCallOptions(callOptions))
 	return p.kClient.ChatStream(ctx, req)
 }
+
+func (p *kLLMRuntimeServiceClient) Embed(ctx context.Context, req *runtime.EmbedRequest, callOptions ...callopt.Option) (r *runtime.EmbedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Embed(ctx, req)
+}
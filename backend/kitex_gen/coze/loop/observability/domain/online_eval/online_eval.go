@@ -0,0 +1,1155 @@
+// Code generated by thriftgo (0.4.1). DO NOT EDIT.
+
+package online_eval
+
+import (
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
+	"strings"
+)
+
+// 在线评测规则: 对新上报且满足过滤条件的 span 按采样率运行评估器, 并将得分回写为 span 标注
+type OnlineEvalRule struct {
+	ID          *int64               `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
+	WorkspaceID *int64               `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	Name        *string              `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Enabled     *bool                `thrift:"enabled,4,optional" frugal:"4,optional,bool" form:"enabled" json:"enabled,omitempty" query:"enabled"`
+	SpanFilters *filter.FilterFields `thrift:"span_filters,5,optional" frugal:"5,optional,filter.FilterFields" form:"span_filters" json:"span_filters,omitempty" query:"span_filters"`
+	// (0, 1]
+	SampleRate          *float64                  `thrift:"sample_rate,6,optional" frugal:"6,optional,double" form:"sample_rate" json:"sample_rate,omitempty" query:"sample_rate"`
+	EvaluatorVersionIds []int64                   `thrift:"evaluator_version_ids,7,optional" frugal:"7,optional,list<i64>" json:"evaluator_version_ids" form:"evaluator_version_ids" query:"evaluator_version_ids"`
+	FieldMappings       []*OnlineEvalFieldMapping `thrift:"field_mappings,8,optional" frugal:"8,optional,list<OnlineEvalFieldMapping>" form:"field_mappings" json:"field_mappings,omitempty" query:"field_mappings"`
+	BaseInfo            *common.BaseInfo          `thrift:"base_info,100,optional" frugal:"100,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+}
+
+func NewOnlineEvalRule() *OnlineEvalRule {
+	return &OnlineEvalRule{}
+}
+
+func (p *OnlineEvalRule) InitDefault() {
+}
+
+var OnlineEvalRule_ID_DEFAULT int64
+
+func (p *OnlineEvalRule) GetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return OnlineEvalRule_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var OnlineEvalRule_WorkspaceID_DEFAULT int64
+
+func (p *OnlineEvalRule) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return OnlineEvalRule_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var OnlineEvalRule_Name_DEFAULT string
+
+func (p *OnlineEvalRule) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return OnlineEvalRule_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var OnlineEvalRule_Enabled_DEFAULT bool
+
+func (p *OnlineEvalRule) GetEnabled() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEnabled() {
+		return OnlineEvalRule_Enabled_DEFAULT
+	}
+	return *p.Enabled
+}
+
+var OnlineEvalRule_SpanFilters_DEFAULT *filter.FilterFields
+
+func (p *OnlineEvalRule) GetSpanFilters() (v *filter.FilterFields) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSpanFilters() {
+		return OnlineEvalRule_SpanFilters_DEFAULT
+	}
+	return p.SpanFilters
+}
+
+var OnlineEvalRule_SampleRate_DEFAULT float64
+
+func (p *OnlineEvalRule) GetSampleRate() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSampleRate() {
+		return OnlineEvalRule_SampleRate_DEFAULT
+	}
+	return *p.SampleRate
+}
+
+var OnlineEvalRule_EvaluatorVersionIds_DEFAULT []int64
+
+func (p *OnlineEvalRule) GetEvaluatorVersionIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionIds() {
+		return OnlineEvalRule_EvaluatorVersionIds_DEFAULT
+	}
+	return p.EvaluatorVersionIds
+}
+
+var OnlineEvalRule_FieldMappings_DEFAULT []*OnlineEvalFieldMapping
+
+func (p *OnlineEvalRule) GetFieldMappings() (v []*OnlineEvalFieldMapping) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldMappings() {
+		return OnlineEvalRule_FieldMappings_DEFAULT
+	}
+	return p.FieldMappings
+}
+
+var OnlineEvalRule_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *OnlineEvalRule) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return OnlineEvalRule_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+func (p *OnlineEvalRule) SetID(val *int64) {
+	p.ID = val
+}
+func (p *OnlineEvalRule) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *OnlineEvalRule) SetName(val *string) {
+	p.Name = val
+}
+func (p *OnlineEvalRule) SetEnabled(val *bool) {
+	p.Enabled = val
+}
+func (p *OnlineEvalRule) SetSpanFilters(val *filter.FilterFields) {
+	p.SpanFilters = val
+}
+func (p *OnlineEvalRule) SetSampleRate(val *float64) {
+	p.SampleRate = val
+}
+func (p *OnlineEvalRule) SetEvaluatorVersionIds(val []int64) {
+	p.EvaluatorVersionIds = val
+}
+func (p *OnlineEvalRule) SetFieldMappings(val []*OnlineEvalFieldMapping) {
+	p.FieldMappings = val
+}
+func (p *OnlineEvalRule) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+
+var fieldIDToName_OnlineEvalRule = map[int16]string{
+	1:   "id",
+	2:   "workspace_id",
+	3:   "name",
+	4:   "enabled",
+	5:   "span_filters",
+	6:   "sample_rate",
+	7:   "evaluator_version_ids",
+	8:   "field_mappings",
+	100: "base_info",
+}
+
+func (p *OnlineEvalRule) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *OnlineEvalRule) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *OnlineEvalRule) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *OnlineEvalRule) IsSetEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *OnlineEvalRule) IsSetSpanFilters() bool {
+	return p.SpanFilters != nil
+}
+
+func (p *OnlineEvalRule) IsSetSampleRate() bool {
+	return p.SampleRate != nil
+}
+
+func (p *OnlineEvalRule) IsSetEvaluatorVersionIds() bool {
+	return p.EvaluatorVersionIds != nil
+}
+
+func (p *OnlineEvalRule) IsSetFieldMappings() bool {
+	return p.FieldMappings != nil
+}
+
+func (p *OnlineEvalRule) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *OnlineEvalRule) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OnlineEvalRule[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OnlineEvalRule) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *OnlineEvalRule) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *OnlineEvalRule) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *OnlineEvalRule) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Enabled = _field
+	return nil
+}
+func (p *OnlineEvalRule) ReadField5(iprot thrift.TProtocol) error {
+	_field := filter.NewFilterFields()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.SpanFilters = _field
+	return nil
+}
+func (p *OnlineEvalRule) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SampleRate = _field
+	return nil
+}
+func (p *OnlineEvalRule) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorVersionIds = _field
+	return nil
+}
+func (p *OnlineEvalRule) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*OnlineEvalFieldMapping, 0, size)
+	values := make([]OnlineEvalFieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldMappings = _field
+	return nil
+}
+func (p *OnlineEvalRule) ReadField100(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+
+func (p *OnlineEvalRule) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("OnlineEvalRule"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OnlineEvalRule) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *OnlineEvalRule) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *OnlineEvalRule) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *OnlineEvalRule) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnabled() {
+		if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Enabled); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *OnlineEvalRule) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpanFilters() {
+		if err = oprot.WriteFieldBegin("span_filters", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.SpanFilters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *OnlineEvalRule) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleRate() {
+		if err = oprot.WriteFieldBegin("sample_rate", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.SampleRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *OnlineEvalRule) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionIds() {
+		if err = oprot.WriteFieldBegin("evaluator_version_ids", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.EvaluatorVersionIds)); err != nil {
+			return err
+		}
+		for _, v := range p.EvaluatorVersionIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *OnlineEvalRule) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldMappings() {
+		if err = oprot.WriteFieldBegin("field_mappings", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldMappings)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldMappings {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *OnlineEvalRule) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+
+func (p *OnlineEvalRule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OnlineEvalRule(%+v)", *p)
+
+}
+
+func (p *OnlineEvalRule) DeepEqual(ano *OnlineEvalRule) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Enabled) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.SpanFilters) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.SampleRate) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.EvaluatorVersionIds) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.FieldMappings) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *OnlineEvalRule) Field1DeepEqual(src *int64) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if *p.ID != *src {
+		return false
+	}
+	return true
+}
+func (p *OnlineEvalRule) Field2DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *OnlineEvalRule) Field3DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *OnlineEvalRule) Field4DeepEqual(src *bool) bool {
+
+	if p.Enabled == src {
+		return true
+	} else if p.Enabled == nil || src == nil {
+		return false
+	}
+	if *p.Enabled != *src {
+		return false
+	}
+	return true
+}
+func (p *OnlineEvalRule) Field5DeepEqual(src *filter.FilterFields) bool {
+
+	if !p.SpanFilters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *OnlineEvalRule) Field6DeepEqual(src *float64) bool {
+
+	if p.SampleRate == src {
+		return true
+	} else if p.SampleRate == nil || src == nil {
+		return false
+	}
+	if *p.SampleRate != *src {
+		return false
+	}
+	return true
+}
+func (p *OnlineEvalRule) Field7DeepEqual(src []int64) bool {
+
+	if len(p.EvaluatorVersionIds) != len(src) {
+		return false
+	}
+	for i, v := range p.EvaluatorVersionIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *OnlineEvalRule) Field8DeepEqual(src []*OnlineEvalFieldMapping) bool {
+
+	if len(p.FieldMappings) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldMappings {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *OnlineEvalRule) Field100DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 评估器输入字段与 span 字段的映射, trace_field_key 取值 Input/Output/Tags.xxx
+type OnlineEvalFieldMapping struct {
+	EvaluatorFieldKey  string  `thrift:"evaluator_field_key,1,required" frugal:"1,required,string" form:"evaluator_field_key,required" json:"evaluator_field_key,required" query:"evaluator_field_key,required"`
+	TraceFieldKey      string  `thrift:"trace_field_key,2,required" frugal:"2,required,string" form:"trace_field_key,required" json:"trace_field_key,required" query:"trace_field_key,required"`
+	TraceFieldJsonpath *string `thrift:"trace_field_jsonpath,3,optional" frugal:"3,optional,string" form:"trace_field_jsonpath" json:"trace_field_jsonpath,omitempty" query:"trace_field_jsonpath"`
+}
+
+func NewOnlineEvalFieldMapping() *OnlineEvalFieldMapping {
+	return &OnlineEvalFieldMapping{}
+}
+
+func (p *OnlineEvalFieldMapping) InitDefault() {
+}
+
+func (p *OnlineEvalFieldMapping) GetEvaluatorFieldKey() (v string) {
+	if p != nil {
+		return p.EvaluatorFieldKey
+	}
+	return
+}
+
+func (p *OnlineEvalFieldMapping) GetTraceFieldKey() (v string) {
+	if p != nil {
+		return p.TraceFieldKey
+	}
+	return
+}
+
+var OnlineEvalFieldMapping_TraceFieldJsonpath_DEFAULT string
+
+func (p *OnlineEvalFieldMapping) GetTraceFieldJsonpath() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTraceFieldJsonpath() {
+		return OnlineEvalFieldMapping_TraceFieldJsonpath_DEFAULT
+	}
+	return *p.TraceFieldJsonpath
+}
+func (p *OnlineEvalFieldMapping) SetEvaluatorFieldKey(val string) {
+	p.EvaluatorFieldKey = val
+}
+func (p *OnlineEvalFieldMapping) SetTraceFieldKey(val string) {
+	p.TraceFieldKey = val
+}
+func (p *OnlineEvalFieldMapping) SetTraceFieldJsonpath(val *string) {
+	p.TraceFieldJsonpath = val
+}
+
+var fieldIDToName_OnlineEvalFieldMapping = map[int16]string{
+	1: "evaluator_field_key",
+	2: "trace_field_key",
+	3: "trace_field_jsonpath",
+}
+
+func (p *OnlineEvalFieldMapping) IsSetTraceFieldJsonpath() bool {
+	return p.TraceFieldJsonpath != nil
+}
+
+func (p *OnlineEvalFieldMapping) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetEvaluatorFieldKey bool = false
+	var issetTraceFieldKey bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorFieldKey = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTraceFieldKey = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetEvaluatorFieldKey {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTraceFieldKey {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OnlineEvalFieldMapping[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OnlineEvalFieldMapping[fieldId]))
+}
+
+func (p *OnlineEvalFieldMapping) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorFieldKey = _field
+	return nil
+}
+func (p *OnlineEvalFieldMapping) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TraceFieldKey = _field
+	return nil
+}
+func (p *OnlineEvalFieldMapping) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TraceFieldJsonpath = _field
+	return nil
+}
+
+func (p *OnlineEvalFieldMapping) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("OnlineEvalFieldMapping"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OnlineEvalFieldMapping) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_field_key", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.EvaluatorFieldKey); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *OnlineEvalFieldMapping) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("trace_field_key", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TraceFieldKey); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *OnlineEvalFieldMapping) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTraceFieldJsonpath() {
+		if err = oprot.WriteFieldBegin("trace_field_jsonpath", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TraceFieldJsonpath); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *OnlineEvalFieldMapping) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OnlineEvalFieldMapping(%+v)", *p)
+
+}
+
+func (p *OnlineEvalFieldMapping) DeepEqual(ano *OnlineEvalFieldMapping) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorFieldKey) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TraceFieldKey) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TraceFieldJsonpath) {
+		return false
+	}
+	return true
+}
+
+func (p *OnlineEvalFieldMapping) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.EvaluatorFieldKey, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *OnlineEvalFieldMapping) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.TraceFieldKey, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *OnlineEvalFieldMapping) Field3DeepEqual(src *string) bool {
+
+	if p.TraceFieldJsonpath == src {
+		return true
+	} else if p.TraceFieldJsonpath == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TraceFieldJsonpath, *src) != 0 {
+		return false
+	}
+	return true
+}
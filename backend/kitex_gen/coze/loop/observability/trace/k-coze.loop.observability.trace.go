@@ -17,6 +17,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
 	dataset0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/online_eval"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/span"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/view"
 )
@@ -28,6 +29,7 @@ var (
 	_ = common.KitexUnusedProtection
 	_ = dataset0.KitexUnusedProtection
 	_ = filter.KitexUnusedProtection
+	_ = online_eval.KitexUnusedProtection
 	_ = span.KitexUnusedProtection
 	_ = view.KitexUnusedProtection
 )
@@ -10823,6 +10825,1859 @@ func (p *PreviewExportTracesToDatasetResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
+func (p *CreateOnlineEvalRuleRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetRule bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetRule = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRule {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateOnlineEvalRuleRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CreateOnlineEvalRuleRequest[fieldId]))
+}
+
+func (p *CreateOnlineEvalRuleRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *CreateOnlineEvalRuleRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := online_eval.NewOnlineEvalRule()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Rule = _field
+	return offset, nil
+}
+
+func (p *CreateOnlineEvalRuleRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *CreateOnlineEvalRuleRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CreateOnlineEvalRuleRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CreateOnlineEvalRuleRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CreateOnlineEvalRuleRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *CreateOnlineEvalRuleRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+	offset += p.Rule.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CreateOnlineEvalRuleRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateOnlineEvalRuleRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CreateOnlineEvalRuleRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Rule.BLength()
+	return l
+}
+
+func (p *CreateOnlineEvalRuleRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *CreateOnlineEvalRuleRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*CreateOnlineEvalRuleRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	var _rule *online_eval.OnlineEvalRule
+	if src.Rule != nil {
+		_rule = &online_eval.OnlineEvalRule{}
+		if err := _rule.DeepCopy(src.Rule); err != nil {
+			return err
+		}
+	}
+	p.Rule = _rule
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *CreateOnlineEvalRuleResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateOnlineEvalRuleResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CreateOnlineEvalRuleResponse[fieldId]))
+}
+
+func (p *CreateOnlineEvalRuleResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ID = _field
+	return offset, nil
+}
+
+func (p *CreateOnlineEvalRuleResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *CreateOnlineEvalRuleResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CreateOnlineEvalRuleResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CreateOnlineEvalRuleResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CreateOnlineEvalRuleResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ID)
+	return offset
+}
+
+func (p *CreateOnlineEvalRuleResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateOnlineEvalRuleResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CreateOnlineEvalRuleResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *CreateOnlineEvalRuleResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*CreateOnlineEvalRuleResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.ID = src.ID
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetWorkspaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateOnlineEvalRuleRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateOnlineEvalRuleRequest[fieldId]))
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ID = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Enabled = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+	_field := filter.NewFilterFields()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.SpanFilters = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SampleRate = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.EvaluatorVersionIds = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*online_eval.OnlineEvalFieldMapping, 0, size)
+	values := make([]online_eval.OnlineEvalFieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FieldMappings = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateOnlineEvalRuleRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ID)
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEnabled() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Enabled)
+	}
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSpanFilters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
+		offset += p.SpanFilters.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSampleRate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.SampleRate)
+	}
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorVersionIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 7)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.EvaluatorVersionIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldMappings() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 8)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FieldMappings {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field3Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
+	}
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field4Length() int {
+	l := 0
+	if p.IsSetEnabled() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field5Length() int {
+	l := 0
+	if p.IsSetSpanFilters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.SpanFilters.BLength()
+	}
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field6Length() int {
+	l := 0
+	if p.IsSetSampleRate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field7Length() int {
+	l := 0
+	if p.IsSetEvaluatorVersionIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.EvaluatorVersionIds)
+	}
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field8Length() int {
+	l := 0
+	if p.IsSetFieldMappings() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FieldMappings {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateOnlineEvalRuleRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.ID = src.ID
+
+	p.WorkspaceID = src.WorkspaceID
+
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	if src.Enabled != nil {
+		tmp := *src.Enabled
+		p.Enabled = &tmp
+	}
+
+	var _spanFilters *filter.FilterFields
+	if src.SpanFilters != nil {
+		_spanFilters = &filter.FilterFields{}
+		if err := _spanFilters.DeepCopy(src.SpanFilters); err != nil {
+			return err
+		}
+	}
+	p.SpanFilters = _spanFilters
+
+	if src.SampleRate != nil {
+		tmp := *src.SampleRate
+		p.SampleRate = &tmp
+	}
+
+	if src.EvaluatorVersionIds != nil {
+		p.EvaluatorVersionIds = make([]int64, 0, len(src.EvaluatorVersionIds))
+		for _, elem := range src.EvaluatorVersionIds {
+			var _elem int64
+			_elem = elem
+			p.EvaluatorVersionIds = append(p.EvaluatorVersionIds, _elem)
+		}
+	}
+
+	if src.FieldMappings != nil {
+		p.FieldMappings = make([]*online_eval.OnlineEvalFieldMapping, 0, len(src.FieldMappings))
+		for _, elem := range src.FieldMappings {
+			var _elem *online_eval.OnlineEvalFieldMapping
+			if elem != nil {
+				_elem = &online_eval.OnlineEvalFieldMapping{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FieldMappings = append(p.FieldMappings, _elem)
+		}
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *UpdateOnlineEvalRuleResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateOnlineEvalRuleResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpdateOnlineEvalRuleResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *UpdateOnlineEvalRuleResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateOnlineEvalRuleResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpdateOnlineEvalRuleResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *UpdateOnlineEvalRuleResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateOnlineEvalRuleResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *DeleteOnlineEvalRuleRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetWorkspaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteOnlineEvalRuleRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DeleteOnlineEvalRuleRequest[fieldId]))
+}
+
+func (p *DeleteOnlineEvalRuleRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ID = _field
+	return offset, nil
+}
+
+func (p *DeleteOnlineEvalRuleRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *DeleteOnlineEvalRuleRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *DeleteOnlineEvalRuleRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DeleteOnlineEvalRuleRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DeleteOnlineEvalRuleRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DeleteOnlineEvalRuleRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ID)
+	return offset
+}
+
+func (p *DeleteOnlineEvalRuleRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *DeleteOnlineEvalRuleRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DeleteOnlineEvalRuleRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *DeleteOnlineEvalRuleRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *DeleteOnlineEvalRuleRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *DeleteOnlineEvalRuleRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteOnlineEvalRuleRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.ID = src.ID
+
+	p.WorkspaceID = src.WorkspaceID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *DeleteOnlineEvalRuleResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteOnlineEvalRuleResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *DeleteOnlineEvalRuleResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *DeleteOnlineEvalRuleResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DeleteOnlineEvalRuleResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DeleteOnlineEvalRuleResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DeleteOnlineEvalRuleResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DeleteOnlineEvalRuleResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *DeleteOnlineEvalRuleResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteOnlineEvalRuleResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListOnlineEvalRulesRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListOnlineEvalRulesRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListOnlineEvalRulesRequest[fieldId]))
+}
+
+func (p *ListOnlineEvalRulesRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListOnlineEvalRulesRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListOnlineEvalRulesRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListOnlineEvalRulesRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListOnlineEvalRulesRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListOnlineEvalRulesRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListOnlineEvalRulesRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListOnlineEvalRulesRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListOnlineEvalRulesRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListOnlineEvalRulesRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListOnlineEvalRulesRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListOnlineEvalRulesResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRules bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetRules = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetRules {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListOnlineEvalRulesResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListOnlineEvalRulesResponse[fieldId]))
+}
+
+func (p *ListOnlineEvalRulesResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*online_eval.OnlineEvalRule, 0, size)
+	values := make([]online_eval.OnlineEvalRule, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Rules = _field
+	return offset, nil
+}
+
+func (p *ListOnlineEvalRulesResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListOnlineEvalRulesResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListOnlineEvalRulesResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListOnlineEvalRulesResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListOnlineEvalRulesResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.Rules {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *ListOnlineEvalRulesResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListOnlineEvalRulesResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.Rules {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *ListOnlineEvalRulesResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *ListOnlineEvalRulesResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListOnlineEvalRulesResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Rules != nil {
+		p.Rules = make([]*online_eval.OnlineEvalRule, 0, len(src.Rules))
+		for _, elem := range src.Rules {
+			var _elem *online_eval.OnlineEvalRule
+			if elem != nil {
+				_elem = &online_eval.OnlineEvalRule{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Rules = append(p.Rules, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
 func (p *TraceServiceListSpansArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -10867,14 +12722,950 @@ func (p *TraceServiceListSpansArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceListSpansArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListSpansRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceListSpansArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceListSpansArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceListSpansArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceListSpansArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceListSpansArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceListSpansArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListSpansArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *ListSpansRequest
+	if src.Req != nil {
+		_req = &ListSpansRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceListSpansResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceListSpansResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListSpansResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceListSpansResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceListSpansResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceListSpansResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceListSpansResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceListSpansResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceListSpansResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListSpansResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListSpansResponse
+	if src.Success != nil {
+		_success = &ListSpansResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceGetTraceArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceGetTraceArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetTraceRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceGetTraceArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceGetTraceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceGetTraceArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceGetTraceArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceGetTraceArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceGetTraceArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTraceArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *GetTraceRequest
+	if src.Req != nil {
+		_req = &GetTraceRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceGetTraceResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceGetTraceResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetTraceResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceGetTraceResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceGetTraceResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceGetTraceResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceGetTraceResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceGetTraceResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceGetTraceResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTraceResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *GetTraceResponse
+	if src.Success != nil {
+		_success = &GetTraceResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetTracesAdvanceInfoRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceBatchGetTracesAdvanceInfoArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *BatchGetTracesAdvanceInfoRequest
+	if src.Req != nil {
+		_req = &BatchGetTracesAdvanceInfoRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetTracesAdvanceInfoResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceBatchGetTracesAdvanceInfoResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *BatchGetTracesAdvanceInfoResponse
+	if src.Success != nil {
+		_success = &BatchGetTracesAdvanceInfoResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewIngestTracesRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceIngestTracesInnerArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *IngestTracesRequest
+	if src.Req != nil {
+		_req = &IngestTracesRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceIngestTracesInnerResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceIngestTracesInnerResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewIngestTracesResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceIngestTracesInnerResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceIngestTracesInnerResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceIngestTracesInnerResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceIngestTracesInnerResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceIngestTracesInnerResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceIngestTracesInnerResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceIngestTracesInnerResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *IngestTracesResponse
+	if src.Success != nil {
+		_success = &IngestTracesResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListSpansArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceGetTracesMetaInfoArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListSpansRequest()
+	_field := NewGetTracesMetaInfoRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -10884,11 +13675,11 @@ func (p *TraceServiceListSpansArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListSpansArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceGetTracesMetaInfoArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListSpansArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTracesMetaInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -10897,7 +13688,7 @@ func (p *TraceServiceListSpansArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *TraceServiceListSpansArgs) BLength() int {
+func (p *TraceServiceGetTracesMetaInfoArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -10906,29 +13697,29 @@ func (p *TraceServiceListSpansArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListSpansArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTracesMetaInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListSpansArgs) field1Length() int {
+func (p *TraceServiceGetTracesMetaInfoArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListSpansArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListSpansArgs)
+func (p *TraceServiceGetTracesMetaInfoArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTracesMetaInfoArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListSpansRequest
+	var _req *GetTracesMetaInfoRequest
 	if src.Req != nil {
-		_req = &ListSpansRequest{}
+		_req = &GetTracesMetaInfoRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -10938,7 +13729,7 @@ func (p *TraceServiceListSpansArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListSpansResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetTracesMetaInfoResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -10982,14 +13773,14 @@ func (p *TraceServiceListSpansResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListSpansResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceGetTracesMetaInfoResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListSpansResponse()
+	_field := NewGetTracesMetaInfoResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -10999,11 +13790,11 @@ func (p *TraceServiceListSpansResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListSpansResult) FastWrite(buf []byte) int {
+func (p *TraceServiceGetTracesMetaInfoResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListSpansResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTracesMetaInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -11012,7 +13803,7 @@ func (p *TraceServiceListSpansResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListSpansResult) BLength() int {
+func (p *TraceServiceGetTracesMetaInfoResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -11021,7 +13812,7 @@ func (p *TraceServiceListSpansResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListSpansResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTracesMetaInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -11030,7 +13821,7 @@ func (p *TraceServiceListSpansResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListSpansResult) field0Length() int {
+func (p *TraceServiceGetTracesMetaInfoResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -11039,15 +13830,15 @@ func (p *TraceServiceListSpansResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListSpansResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListSpansResult)
+func (p *TraceServiceGetTracesMetaInfoResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTracesMetaInfoResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListSpansResponse
+	var _success *GetTracesMetaInfoResponse
 	if src.Success != nil {
-		_success = &ListSpansResponse{}
+		_success = &GetTracesMetaInfoResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -11057,7 +13848,7 @@ func (p *TraceServiceListSpansResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTraceArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateViewArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11101,14 +13892,14 @@ func (p *TraceServiceGetTraceArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTraceArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceCreateViewArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTraceRequest()
+	_field := NewCreateViewRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -11118,11 +13909,11 @@ func (p *TraceServiceGetTraceArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceGetTraceArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateViewArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTraceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -11131,7 +13922,7 @@ func (p *TraceServiceGetTraceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *TraceServiceGetTraceArgs) BLength() int {
+func (p *TraceServiceCreateViewArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -11140,29 +13931,29 @@ func (p *TraceServiceGetTraceArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTraceArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceGetTraceArgs) field1Length() int {
+func (p *TraceServiceCreateViewArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceGetTraceArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTraceArgs)
+func (p *TraceServiceCreateViewArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateViewArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetTraceRequest
+	var _req *CreateViewRequest
 	if src.Req != nil {
-		_req = &GetTraceRequest{}
+		_req = &CreateViewRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -11172,7 +13963,7 @@ func (p *TraceServiceGetTraceArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTraceResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateViewResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11216,14 +14007,14 @@ func (p *TraceServiceGetTraceResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTraceResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceCreateViewResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTraceResponse()
+	_field := NewCreateViewResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -11233,11 +14024,11 @@ func (p *TraceServiceGetTraceResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceGetTraceResult) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateViewResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTraceResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -11246,7 +14037,7 @@ func (p *TraceServiceGetTraceResult) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceGetTraceResult) BLength() int {
+func (p *TraceServiceCreateViewResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -11255,7 +14046,7 @@ func (p *TraceServiceGetTraceResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTraceResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -11264,7 +14055,7 @@ func (p *TraceServiceGetTraceResult) fastWriteField0(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceGetTraceResult) field0Length() int {
+func (p *TraceServiceCreateViewResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -11273,15 +14064,15 @@ func (p *TraceServiceGetTraceResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceGetTraceResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTraceResult)
+func (p *TraceServiceCreateViewResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateViewResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetTraceResponse
+	var _success *CreateViewResponse
 	if src.Success != nil {
-		_success = &GetTraceResponse{}
+		_success = &CreateViewResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -11291,7 +14082,7 @@ func (p *TraceServiceGetTraceResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateViewArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11335,14 +14126,14 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceUpdateViewArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetTracesAdvanceInfoRequest()
+	_field := NewUpdateViewRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -11352,11 +14143,11 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateViewArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -11365,7 +14156,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) BLength() int {
+func (p *TraceServiceUpdateViewArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -11374,29 +14165,29 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) field1Length() int {
+func (p *TraceServiceUpdateViewArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceBatchGetTracesAdvanceInfoArgs)
+func (p *TraceServiceUpdateViewArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateViewArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetTracesAdvanceInfoRequest
+	var _req *UpdateViewRequest
 	if src.Req != nil {
-		_req = &BatchGetTracesAdvanceInfoRequest{}
+		_req = &UpdateViewRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -11406,7 +14197,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateViewResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11450,14 +14241,14 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceUpdateViewResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetTracesAdvanceInfoResponse()
+	_field := NewUpdateViewResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -11467,11 +14258,11 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateViewResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -11480,7 +14271,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) BLength() int {
+func (p *TraceServiceUpdateViewResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -11489,7 +14280,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -11498,7 +14289,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) field0Length() int {
+func (p *TraceServiceUpdateViewResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -11507,15 +14298,15 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceBatchGetTracesAdvanceInfoResult)
+func (p *TraceServiceUpdateViewResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateViewResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetTracesAdvanceInfoResponse
+	var _success *UpdateViewResponse
 	if src.Success != nil {
-		_success = &BatchGetTracesAdvanceInfoResponse{}
+		_success = &UpdateViewResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -11525,7 +14316,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteViewArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11569,14 +14360,14 @@ func (p *TraceServiceIngestTracesInnerArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceDeleteViewArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIngestTracesRequest()
+	_field := NewDeleteViewRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -11586,11 +14377,11 @@ func (p *TraceServiceIngestTracesInnerArgs) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteViewArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -11599,7 +14390,7 @@ func (p *TraceServiceIngestTracesInnerArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) BLength() int {
+func (p *TraceServiceDeleteViewArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -11608,29 +14399,29 @@ func (p *TraceServiceIngestTracesInnerArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) field1Length() int {
+func (p *TraceServiceDeleteViewArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceIngestTracesInnerArgs)
+func (p *TraceServiceDeleteViewArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteViewArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *IngestTracesRequest
+	var _req *DeleteViewRequest
 	if src.Req != nil {
-		_req = &IngestTracesRequest{}
+		_req = &DeleteViewRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -11640,7 +14431,7 @@ func (p *TraceServiceIngestTracesInnerArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceIngestTracesInnerResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteViewResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11684,14 +14475,14 @@ func (p *TraceServiceIngestTracesInnerResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceIngestTracesInnerResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceDeleteViewResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIngestTracesResponse()
+	_field := NewDeleteViewResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -11701,11 +14492,11 @@ func (p *TraceServiceIngestTracesInnerResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *TraceServiceIngestTracesInnerResult) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteViewResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceIngestTracesInnerResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -11714,7 +14505,7 @@ func (p *TraceServiceIngestTracesInnerResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceIngestTracesInnerResult) BLength() int {
+func (p *TraceServiceDeleteViewResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -11723,7 +14514,7 @@ func (p *TraceServiceIngestTracesInnerResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceIngestTracesInnerResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -11732,7 +14523,7 @@ func (p *TraceServiceIngestTracesInnerResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceIngestTracesInnerResult) field0Length() int {
+func (p *TraceServiceDeleteViewResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -11741,15 +14532,15 @@ func (p *TraceServiceIngestTracesInnerResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceIngestTracesInnerResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceIngestTracesInnerResult)
+func (p *TraceServiceDeleteViewResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteViewResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *IngestTracesResponse
+	var _success *DeleteViewResponse
 	if src.Success != nil {
-		_success = &IngestTracesResponse{}
+		_success = &DeleteViewResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -11759,7 +14550,7 @@ func (p *TraceServiceIngestTracesInnerResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListViewsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11803,14 +14594,14 @@ func (p *TraceServiceGetTracesMetaInfoArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceListViewsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTracesMetaInfoRequest()
+	_field := NewListViewsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -11820,11 +14611,11 @@ func (p *TraceServiceGetTracesMetaInfoArgs) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceListViewsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListViewsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -11833,7 +14624,7 @@ func (p *TraceServiceGetTracesMetaInfoArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) BLength() int {
+func (p *TraceServiceListViewsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -11842,29 +14633,29 @@ func (p *TraceServiceGetTracesMetaInfoArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListViewsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) field1Length() int {
+func (p *TraceServiceListViewsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTracesMetaInfoArgs)
+func (p *TraceServiceListViewsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListViewsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetTracesMetaInfoRequest
+	var _req *ListViewsRequest
 	if src.Req != nil {
-		_req = &GetTracesMetaInfoRequest{}
+		_req = &ListViewsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -11874,7 +14665,7 @@ func (p *TraceServiceGetTracesMetaInfoArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListViewsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11918,14 +14709,14 @@ func (p *TraceServiceGetTracesMetaInfoResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceListViewsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTracesMetaInfoResponse()
+	_field := NewListViewsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -11935,11 +14726,11 @@ func (p *TraceServiceGetTracesMetaInfoResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) FastWrite(buf []byte) int {
+func (p *TraceServiceListViewsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListViewsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -11948,7 +14739,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) BLength() int {
+func (p *TraceServiceListViewsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -11957,7 +14748,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListViewsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -11966,7 +14757,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) field0Length() int {
+func (p *TraceServiceListViewsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -11975,15 +14766,15 @@ func (p *TraceServiceGetTracesMetaInfoResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTracesMetaInfoResult)
+func (p *TraceServiceListViewsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListViewsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetTracesMetaInfoResponse
+	var _success *ListViewsResponse
 	if src.Success != nil {
-		_success = &GetTracesMetaInfoResponse{}
+		_success = &ListViewsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -11993,7 +14784,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceCreateViewArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateManualAnnotationArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12037,14 +14828,14 @@ func (p *TraceServiceCreateViewArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceCreateViewArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceCreateManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateViewRequest()
+	_field := NewCreateManualAnnotationRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12054,11 +14845,11 @@ func (p *TraceServiceCreateViewArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceCreateViewArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateManualAnnotationArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceCreateViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12067,7 +14858,7 @@ func (p *TraceServiceCreateViewArgs) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceCreateViewArgs) BLength() int {
+func (p *TraceServiceCreateManualAnnotationArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12076,29 +14867,29 @@ func (p *TraceServiceCreateViewArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceCreateViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceCreateViewArgs) field1Length() int {
+func (p *TraceServiceCreateManualAnnotationArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceCreateViewArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceCreateViewArgs)
+func (p *TraceServiceCreateManualAnnotationArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateManualAnnotationArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateViewRequest
+	var _req *CreateManualAnnotationRequest
 	if src.Req != nil {
-		_req = &CreateViewRequest{}
+		_req = &CreateManualAnnotationRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -12108,7 +14899,7 @@ func (p *TraceServiceCreateViewArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceCreateViewResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateManualAnnotationResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12152,14 +14943,14 @@ func (p *TraceServiceCreateViewResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceCreateViewResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceCreateManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateViewResponse()
+	_field := NewCreateManualAnnotationResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12169,11 +14960,11 @@ func (p *TraceServiceCreateViewResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceCreateViewResult) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateManualAnnotationResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceCreateViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -12182,7 +14973,7 @@ func (p *TraceServiceCreateViewResult) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceCreateViewResult) BLength() int {
+func (p *TraceServiceCreateManualAnnotationResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -12191,7 +14982,7 @@ func (p *TraceServiceCreateViewResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceCreateViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -12200,7 +14991,7 @@ func (p *TraceServiceCreateViewResult) fastWriteField0(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceCreateViewResult) field0Length() int {
+func (p *TraceServiceCreateManualAnnotationResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -12209,15 +15000,15 @@ func (p *TraceServiceCreateViewResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceCreateViewResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceCreateViewResult)
+func (p *TraceServiceCreateManualAnnotationResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateManualAnnotationResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateViewResponse
+	var _success *CreateManualAnnotationResponse
 	if src.Success != nil {
-		_success = &CreateViewResponse{}
+		_success = &CreateManualAnnotationResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -12227,7 +15018,7 @@ func (p *TraceServiceCreateViewResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceUpdateViewArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateManualAnnotationArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12271,14 +15062,14 @@ func (p *TraceServiceUpdateViewArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpdateViewArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceUpdateManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateViewRequest()
+	_field := NewUpdateManualAnnotationRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12288,11 +15079,11 @@ func (p *TraceServiceUpdateViewArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceUpdateViewArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateManualAnnotationArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpdateViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12301,7 +15092,7 @@ func (p *TraceServiceUpdateViewArgs) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceUpdateViewArgs) BLength() int {
+func (p *TraceServiceUpdateManualAnnotationArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12310,29 +15101,29 @@ func (p *TraceServiceUpdateViewArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpdateViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceUpdateViewArgs) field1Length() int {
+func (p *TraceServiceUpdateManualAnnotationArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceUpdateViewArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpdateViewArgs)
+func (p *TraceServiceUpdateManualAnnotationArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateManualAnnotationArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateViewRequest
+	var _req *UpdateManualAnnotationRequest
 	if src.Req != nil {
-		_req = &UpdateViewRequest{}
+		_req = &UpdateManualAnnotationRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -12342,7 +15133,7 @@ func (p *TraceServiceUpdateViewArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceUpdateViewResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateManualAnnotationResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12386,14 +15177,14 @@ func (p *TraceServiceUpdateViewResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpdateViewResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceUpdateManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateViewResponse()
+	_field := NewUpdateManualAnnotationResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12403,11 +15194,11 @@ func (p *TraceServiceUpdateViewResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceUpdateViewResult) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateManualAnnotationResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpdateViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -12416,7 +15207,7 @@ func (p *TraceServiceUpdateViewResult) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceUpdateViewResult) BLength() int {
+func (p *TraceServiceUpdateManualAnnotationResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -12425,7 +15216,7 @@ func (p *TraceServiceUpdateViewResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpdateViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -12434,7 +15225,7 @@ func (p *TraceServiceUpdateViewResult) fastWriteField0(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceUpdateViewResult) field0Length() int {
+func (p *TraceServiceUpdateManualAnnotationResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -12443,15 +15234,15 @@ func (p *TraceServiceUpdateViewResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceUpdateViewResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpdateViewResult)
+func (p *TraceServiceUpdateManualAnnotationResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateManualAnnotationResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateViewResponse
+	var _success *UpdateManualAnnotationResponse
 	if src.Success != nil {
-		_success = &UpdateViewResponse{}
+		_success = &UpdateManualAnnotationResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -12461,7 +15252,7 @@ func (p *TraceServiceUpdateViewResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceDeleteViewArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteManualAnnotationArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12505,14 +15296,14 @@ func (p *TraceServiceDeleteViewArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceDeleteViewArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceDeleteManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteViewRequest()
+	_field := NewDeleteManualAnnotationRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12522,11 +15313,11 @@ func (p *TraceServiceDeleteViewArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceDeleteViewArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteManualAnnotationArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceDeleteViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12535,7 +15326,7 @@ func (p *TraceServiceDeleteViewArgs) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceDeleteViewArgs) BLength() int {
+func (p *TraceServiceDeleteManualAnnotationArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12544,29 +15335,29 @@ func (p *TraceServiceDeleteViewArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceDeleteViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceDeleteViewArgs) field1Length() int {
+func (p *TraceServiceDeleteManualAnnotationArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceDeleteViewArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceDeleteViewArgs)
+func (p *TraceServiceDeleteManualAnnotationArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteManualAnnotationArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteViewRequest
+	var _req *DeleteManualAnnotationRequest
 	if src.Req != nil {
-		_req = &DeleteViewRequest{}
+		_req = &DeleteManualAnnotationRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -12576,7 +15367,7 @@ func (p *TraceServiceDeleteViewArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceDeleteViewResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteManualAnnotationResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12620,14 +15411,14 @@ func (p *TraceServiceDeleteViewResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceDeleteViewResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceDeleteManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteViewResponse()
+	_field := NewDeleteManualAnnotationResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12637,11 +15428,11 @@ func (p *TraceServiceDeleteViewResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceDeleteViewResult) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteManualAnnotationResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceDeleteViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -12650,7 +15441,7 @@ func (p *TraceServiceDeleteViewResult) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceDeleteViewResult) BLength() int {
+func (p *TraceServiceDeleteManualAnnotationResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -12659,7 +15450,7 @@ func (p *TraceServiceDeleteViewResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceDeleteViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -12668,7 +15459,7 @@ func (p *TraceServiceDeleteViewResult) fastWriteField0(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceDeleteViewResult) field0Length() int {
+func (p *TraceServiceDeleteManualAnnotationResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -12677,15 +15468,15 @@ func (p *TraceServiceDeleteViewResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceDeleteViewResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceDeleteViewResult)
+func (p *TraceServiceDeleteManualAnnotationResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteManualAnnotationResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteViewResponse
+	var _success *DeleteManualAnnotationResponse
 	if src.Success != nil {
-		_success = &DeleteViewResponse{}
+		_success = &DeleteManualAnnotationResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -12695,7 +15486,7 @@ func (p *TraceServiceDeleteViewResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListViewsArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12739,14 +15530,14 @@ func (p *TraceServiceListViewsArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListViewsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListViewsRequest()
+	_field := NewListAnnotationsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12756,11 +15547,11 @@ func (p *TraceServiceListViewsArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListViewsArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceListAnnotationsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListViewsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12769,7 +15560,7 @@ func (p *TraceServiceListViewsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *TraceServiceListViewsArgs) BLength() int {
+func (p *TraceServiceListAnnotationsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12778,29 +15569,29 @@ func (p *TraceServiceListViewsArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListViewsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListViewsArgs) field1Length() int {
+func (p *TraceServiceListAnnotationsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListViewsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListViewsArgs)
+func (p *TraceServiceListAnnotationsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListAnnotationsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListViewsRequest
+	var _req *ListAnnotationsRequest
 	if src.Req != nil {
-		_req = &ListViewsRequest{}
+		_req = &ListAnnotationsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -12810,7 +15601,7 @@ func (p *TraceServiceListViewsArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListViewsResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12854,14 +15645,14 @@ func (p *TraceServiceListViewsResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListViewsResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListViewsResponse()
+	_field := NewListAnnotationsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12871,11 +15662,11 @@ func (p *TraceServiceListViewsResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListViewsResult) FastWrite(buf []byte) int {
+func (p *TraceServiceListAnnotationsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListViewsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -12884,7 +15675,7 @@ func (p *TraceServiceListViewsResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListViewsResult) BLength() int {
+func (p *TraceServiceListAnnotationsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -12893,7 +15684,7 @@ func (p *TraceServiceListViewsResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListViewsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -12902,7 +15693,7 @@ func (p *TraceServiceListViewsResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListViewsResult) field0Length() int {
+func (p *TraceServiceListAnnotationsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -12911,15 +15702,15 @@ func (p *TraceServiceListViewsResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListViewsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListViewsResult)
+func (p *TraceServiceListAnnotationsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListAnnotationsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListViewsResponse
+	var _success *ListAnnotationsResponse
 	if src.Success != nil {
-		_success = &ListViewsResponse{}
+		_success = &ListAnnotationsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -12929,7 +15720,7 @@ func (p *TraceServiceListViewsResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToDatasetArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12973,14 +15764,14 @@ func (p *TraceServiceCreateManualAnnotationArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToDatasetArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateManualAnnotationRequest()
+	_field := NewExportTracesToDatasetRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12990,11 +15781,11 @@ func (p *TraceServiceCreateManualAnnotationArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceExportTracesToDatasetArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -13003,7 +15794,7 @@ func (p *TraceServiceCreateManualAnnotationArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) BLength() int {
+func (p *TraceServiceExportTracesToDatasetArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -13012,29 +15803,29 @@ func (p *TraceServiceCreateManualAnnotationArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToDatasetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) field1Length() int {
+func (p *TraceServiceExportTracesToDatasetArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceCreateManualAnnotationArgs)
+func (p *TraceServiceExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceExportTracesToDatasetArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateManualAnnotationRequest
+	var _req *ExportTracesToDatasetRequest
 	if src.Req != nil {
-		_req = &CreateManualAnnotationRequest{}
+		_req = &ExportTracesToDatasetRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -13044,7 +15835,7 @@ func (p *TraceServiceCreateManualAnnotationArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToDatasetResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13088,14 +15879,14 @@ func (p *TraceServiceCreateManualAnnotationResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToDatasetResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateManualAnnotationResponse()
+	_field := NewExportTracesToDatasetResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13105,11 +15896,11 @@ func (p *TraceServiceCreateManualAnnotationResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) FastWrite(buf []byte) int {
+func (p *TraceServiceExportTracesToDatasetResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -13118,7 +15909,7 @@ func (p *TraceServiceCreateManualAnnotationResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) BLength() int {
+func (p *TraceServiceExportTracesToDatasetResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -13127,7 +15918,7 @@ func (p *TraceServiceCreateManualAnnotationResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToDatasetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -13136,7 +15927,7 @@ func (p *TraceServiceCreateManualAnnotationResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) field0Length() int {
+func (p *TraceServiceExportTracesToDatasetResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -13145,15 +15936,15 @@ func (p *TraceServiceCreateManualAnnotationResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceCreateManualAnnotationResult)
+func (p *TraceServiceExportTracesToDatasetResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceExportTracesToDatasetResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateManualAnnotationResponse
+	var _success *ExportTracesToDatasetResponse
 	if src.Success != nil {
-		_success = &CreateManualAnnotationResponse{}
+		_success = &ExportTracesToDatasetResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -13163,7 +15954,7 @@ func (p *TraceServiceCreateManualAnnotationResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13207,14 +15998,14 @@ func (p *TraceServiceUpdateManualAnnotationArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateManualAnnotationRequest()
+	_field := NewPreviewExportTracesToDatasetRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13224,11 +16015,11 @@ func (p *TraceServiceUpdateManualAnnotationArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) FastWrite(buf []byte) int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -13237,7 +16028,7 @@ func (p *TraceServiceUpdateManualAnnotationArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) BLength() int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -13246,29 +16037,29 @@ func (p *TraceServiceUpdateManualAnnotationArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) field1Length() int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpdateManualAnnotationArgs)
+func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServicePreviewExportTracesToDatasetArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateManualAnnotationRequest
+	var _req *PreviewExportTracesToDatasetRequest
 	if src.Req != nil {
-		_req = &UpdateManualAnnotationRequest{}
+		_req = &PreviewExportTracesToDatasetRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -13278,7 +16069,7 @@ func (p *TraceServiceUpdateManualAnnotationArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServicePreviewExportTracesToDatasetResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13322,14 +16113,14 @@ func (p *TraceServiceUpdateManualAnnotationResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServicePreviewExportTracesToDatasetResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateManualAnnotationResponse()
+	_field := NewPreviewExportTracesToDatasetResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13339,11 +16130,11 @@ func (p *TraceServiceUpdateManualAnnotationResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) FastWrite(buf []byte) int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -13352,7 +16143,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) BLength() int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -13361,7 +16152,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -13370,7 +16161,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) field0Length() int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -13379,15 +16170,15 @@ func (p *TraceServiceUpdateManualAnnotationResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpdateManualAnnotationResult)
+func (p *TraceServicePreviewExportTracesToDatasetResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServicePreviewExportTracesToDatasetResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateManualAnnotationResponse
+	var _success *PreviewExportTracesToDatasetResponse
 	if src.Success != nil {
-		_success = &UpdateManualAnnotationResponse{}
+		_success = &PreviewExportTracesToDatasetResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -13397,7 +16188,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateOnlineEvalRuleArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13441,14 +16232,14 @@ func (p *TraceServiceDeleteManualAnnotationArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateOnlineEvalRuleArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceCreateOnlineEvalRuleArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteManualAnnotationRequest()
+	_field := NewCreateOnlineEvalRuleRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13458,11 +16249,11 @@ func (p *TraceServiceDeleteManualAnnotationArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateOnlineEvalRuleArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateOnlineEvalRuleArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -13471,7 +16262,7 @@ func (p *TraceServiceDeleteManualAnnotationArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) BLength() int {
+func (p *TraceServiceCreateOnlineEvalRuleArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -13480,29 +16271,29 @@ func (p *TraceServiceDeleteManualAnnotationArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateOnlineEvalRuleArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) field1Length() int {
+func (p *TraceServiceCreateOnlineEvalRuleArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceDeleteManualAnnotationArgs)
+func (p *TraceServiceCreateOnlineEvalRuleArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateOnlineEvalRuleArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteManualAnnotationRequest
+	var _req *CreateOnlineEvalRuleRequest
 	if src.Req != nil {
-		_req = &DeleteManualAnnotationRequest{}
+		_req = &CreateOnlineEvalRuleRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -13512,7 +16303,7 @@ func (p *TraceServiceDeleteManualAnnotationArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateOnlineEvalRuleResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13556,14 +16347,14 @@ func (p *TraceServiceDeleteManualAnnotationResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateOnlineEvalRuleResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceCreateOnlineEvalRuleResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteManualAnnotationResponse()
+	_field := NewCreateOnlineEvalRuleResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13573,11 +16364,11 @@ func (p *TraceServiceDeleteManualAnnotationResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateOnlineEvalRuleResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateOnlineEvalRuleResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -13586,7 +16377,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) BLength() int {
+func (p *TraceServiceCreateOnlineEvalRuleResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -13595,7 +16386,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateOnlineEvalRuleResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -13604,7 +16395,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) field0Length() int {
+func (p *TraceServiceCreateOnlineEvalRuleResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -13613,15 +16404,15 @@ func (p *TraceServiceDeleteManualAnnotationResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceDeleteManualAnnotationResult)
+func (p *TraceServiceCreateOnlineEvalRuleResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateOnlineEvalRuleResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteManualAnnotationResponse
+	var _success *CreateOnlineEvalRuleResponse
 	if src.Success != nil {
-		_success = &DeleteManualAnnotationResponse{}
+		_success = &CreateOnlineEvalRuleResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -13631,7 +16422,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServiceListAnnotationsArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13675,14 +16466,14 @@ func (p *TraceServiceListAnnotationsArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateOnlineEvalRuleArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListAnnotationsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListAnnotationsRequest()
+	_field := NewUpdateOnlineEvalRuleRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13692,11 +16483,11 @@ func (p *TraceServiceListAnnotationsArgs) FastReadField1(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *TraceServiceListAnnotationsArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListAnnotationsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -13705,7 +16496,7 @@ func (p *TraceServiceListAnnotationsArgs) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *TraceServiceListAnnotationsArgs) BLength() int {
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -13714,29 +16505,29 @@ func (p *TraceServiceListAnnotationsArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListAnnotationsArgs) field1Length() int {
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListAnnotationsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListAnnotationsArgs)
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateOnlineEvalRuleArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListAnnotationsRequest
+	var _req *UpdateOnlineEvalRuleRequest
 	if src.Req != nil {
-		_req = &ListAnnotationsRequest{}
+		_req = &UpdateOnlineEvalRuleRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -13746,7 +16537,7 @@ func (p *TraceServiceListAnnotationsArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListAnnotationsResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateOnlineEvalRuleResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13790,14 +16581,14 @@ func (p *TraceServiceListAnnotationsResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateOnlineEvalRuleResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListAnnotationsResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceUpdateOnlineEvalRuleResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListAnnotationsResponse()
+	_field := NewUpdateOnlineEvalRuleResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13807,11 +16598,11 @@ func (p *TraceServiceListAnnotationsResult) FastReadField0(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *TraceServiceListAnnotationsResult) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateOnlineEvalRuleResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListAnnotationsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateOnlineEvalRuleResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -13820,7 +16611,7 @@ func (p *TraceServiceListAnnotationsResult) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceListAnnotationsResult) BLength() int {
+func (p *TraceServiceUpdateOnlineEvalRuleResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -13829,7 +16620,7 @@ func (p *TraceServiceListAnnotationsResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateOnlineEvalRuleResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -13838,7 +16629,7 @@ func (p *TraceServiceListAnnotationsResult) fastWriteField0(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceListAnnotationsResult) field0Length() int {
+func (p *TraceServiceUpdateOnlineEvalRuleResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -13847,15 +16638,15 @@ func (p *TraceServiceListAnnotationsResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListAnnotationsResult)
+func (p *TraceServiceUpdateOnlineEvalRuleResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateOnlineEvalRuleResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListAnnotationsResponse
+	var _success *UpdateOnlineEvalRuleResponse
 	if src.Success != nil {
-		_success = &ListAnnotationsResponse{}
+		_success = &UpdateOnlineEvalRuleResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -13865,7 +16656,7 @@ func (p *TraceServiceListAnnotationsResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13909,14 +16700,14 @@ func (p *TraceServiceExportTracesToDatasetArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteOnlineEvalRuleArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExportTracesToDatasetRequest()
+	_field := NewDeleteOnlineEvalRuleRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13926,11 +16717,11 @@ func (p *TraceServiceExportTracesToDatasetArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -13939,7 +16730,7 @@ func (p *TraceServiceExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) BLength() int {
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -13948,29 +16739,29 @@ func (p *TraceServiceExportTracesToDatasetArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) field1Length() int {
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceExportTracesToDatasetArgs)
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteOnlineEvalRuleArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ExportTracesToDatasetRequest
+	var _req *DeleteOnlineEvalRuleRequest
 	if src.Req != nil {
-		_req = &ExportTracesToDatasetRequest{}
+		_req = &DeleteOnlineEvalRuleRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -13980,7 +16771,7 @@ func (p *TraceServiceExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteOnlineEvalRuleResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -14024,14 +16815,14 @@ func (p *TraceServiceExportTracesToDatasetResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteOnlineEvalRuleResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceDeleteOnlineEvalRuleResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExportTracesToDatasetResponse()
+	_field := NewDeleteOnlineEvalRuleResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -14041,11 +16832,11 @@ func (p *TraceServiceExportTracesToDatasetResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteOnlineEvalRuleResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteOnlineEvalRuleResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -14054,7 +16845,7 @@ func (p *TraceServiceExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) BLength() int {
+func (p *TraceServiceDeleteOnlineEvalRuleResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -14063,7 +16854,7 @@ func (p *TraceServiceExportTracesToDatasetResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteOnlineEvalRuleResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -14072,7 +16863,7 @@ func (p *TraceServiceExportTracesToDatasetResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) field0Length() int {
+func (p *TraceServiceDeleteOnlineEvalRuleResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -14081,15 +16872,15 @@ func (p *TraceServiceExportTracesToDatasetResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceExportTracesToDatasetResult)
+func (p *TraceServiceDeleteOnlineEvalRuleResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteOnlineEvalRuleResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ExportTracesToDatasetResponse
+	var _success *DeleteOnlineEvalRuleResponse
 	if src.Success != nil {
-		_success = &ExportTracesToDatasetResponse{}
+		_success = &DeleteOnlineEvalRuleResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -14099,7 +16890,7 @@ func (p *TraceServiceExportTracesToDatasetResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListOnlineEvalRulesArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -14143,14 +16934,14 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) FastRead(buf []byte) (int
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListOnlineEvalRulesArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceListOnlineEvalRulesArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPreviewExportTracesToDatasetRequest()
+	_field := NewListOnlineEvalRulesRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -14160,11 +16951,11 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) FastReadField1(buf []byte
 	return offset, nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceListOnlineEvalRulesArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListOnlineEvalRulesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -14173,7 +16964,7 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWriteNocopy(buf []byt
 	return offset
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) BLength() int {
+func (p *TraceServiceListOnlineEvalRulesArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -14182,29 +16973,29 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListOnlineEvalRulesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) field1Length() int {
+func (p *TraceServiceListOnlineEvalRulesArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServicePreviewExportTracesToDatasetArgs)
+func (p *TraceServiceListOnlineEvalRulesArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListOnlineEvalRulesArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *PreviewExportTracesToDatasetRequest
+	var _req *ListOnlineEvalRulesRequest
 	if src.Req != nil {
-		_req = &PreviewExportTracesToDatasetRequest{}
+		_req = &ListOnlineEvalRulesRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -14214,7 +17005,7 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepCopy(s interface{}) e
 	return nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListOnlineEvalRulesResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -14258,14 +17049,14 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) FastRead(buf []byte) (i
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListOnlineEvalRulesResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceListOnlineEvalRulesResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPreviewExportTracesToDatasetResponse()
+	_field := NewListOnlineEvalRulesResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -14275,11 +17066,11 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) FastReadField0(buf []by
 	return offset, nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) FastWrite(buf []byte) int {
+func (p *TraceServiceListOnlineEvalRulesResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListOnlineEvalRulesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -14288,7 +17079,7 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) FastWriteNocopy(buf []b
 	return offset
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) BLength() int {
+func (p *TraceServiceListOnlineEvalRulesResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -14297,7 +17088,7 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) BLength() int {
 	return l
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListOnlineEvalRulesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -14306,7 +17097,7 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) fastWriteField0(buf []b
 	return offset
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) field0Length() int {
+func (p *TraceServiceListOnlineEvalRulesResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -14315,15 +17106,15 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServicePreviewExportTracesToDatasetResult)
+func (p *TraceServiceListOnlineEvalRulesResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListOnlineEvalRulesResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *PreviewExportTracesToDatasetResponse
+	var _success *ListOnlineEvalRulesResponse
 	if src.Success != nil {
-		_success = &PreviewExportTracesToDatasetResponse{}
+		_success = &ListOnlineEvalRulesResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -14452,3 +17243,35 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) GetFirstArgument() interf
 func (p *TraceServicePreviewExportTracesToDatasetResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *TraceServiceCreateOnlineEvalRuleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceCreateOnlineEvalRuleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceUpdateOnlineEvalRuleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceUpdateOnlineEvalRuleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceDeleteOnlineEvalRuleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceDeleteOnlineEvalRuleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceListOnlineEvalRulesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceListOnlineEvalRulesResult) GetResult() interface{} {
+	return p.Success
+}
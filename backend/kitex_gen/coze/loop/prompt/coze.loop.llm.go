@@ -5,6 +5,7 @@ package prompt
 import (
 	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime"
 )
 
@@ -60,6 +61,32 @@ func NewLLMRuntimeServiceClient(c thrift.TClient) *LLMRuntimeServiceClient {
 	}
 }
 
+type LLMOpenAPIService interface {
+	openapi.LLMOpenAPIService
+}
+
+type LLMOpenAPIServiceClient struct {
+	*openapi.LLMOpenAPIServiceClient
+}
+
+func NewLLMOpenAPIServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *LLMOpenAPIServiceClient {
+	return &LLMOpenAPIServiceClient{
+		LLMOpenAPIServiceClient: openapi.NewLLMOpenAPIServiceClientFactory(t, f),
+	}
+}
+
+func NewLLMOpenAPIServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *LLMOpenAPIServiceClient {
+	return &LLMOpenAPIServiceClient{
+		LLMOpenAPIServiceClient: openapi.NewLLMOpenAPIServiceClientProtocol(t, iprot, oprot),
+	}
+}
+
+func NewLLMOpenAPIServiceClient(c thrift.TClient) *LLMOpenAPIServiceClient {
+	return &LLMOpenAPIServiceClient{
+		LLMOpenAPIServiceClient: openapi.NewLLMOpenAPIServiceClient(c),
+	}
+}
+
 type LLMManageServiceProcessor struct {
 	*manage.LLMManageServiceProcessor
 }
@@ -77,3 +104,12 @@ func NewLLMRuntimeServiceProcessor(handler LLMRuntimeService) *LLMRuntimeService
 	self := &LLMRuntimeServiceProcessor{runtime.NewLLMRuntimeServiceProcessor(handler)}
 	return self
 }
+
+type LLMOpenAPIServiceProcessor struct {
+	*openapi.LLMOpenAPIServiceProcessor
+}
+
+func NewLLMOpenAPIServiceProcessor(handler LLMOpenAPIService) *LLMOpenAPIServiceProcessor {
+	self := &LLMOpenAPIServiceProcessor{openapi.NewLLMOpenAPIServiceProcessor(handler)}
+	return self
+}
@@ -541,6 +541,20 @@ func (p *PromptBasic) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -671,6 +685,20 @@ func (p *PromptBasic) FastReadField8(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *PromptBasic) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *PromptType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptType = _field
+	return offset, nil
+}
+
 func (p *PromptBasic) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -686,6 +714,7 @@ func (p *PromptBasic) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -702,6 +731,7 @@ func (p *PromptBasic) BLength() int {
 		l += p.field6Length()
 		l += p.field7Length()
 		l += p.field8Length()
+		l += p.field9Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -779,6 +809,15 @@ func (p *PromptBasic) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *PromptBasic) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 9)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptType)
+	}
+	return offset
+}
+
 func (p *PromptBasic) field1Length() int {
 	l := 0
 	if p.IsSetDisplayName() {
@@ -851,6 +890,15 @@ func (p *PromptBasic) field8Length() int {
 	return l
 }
 
+func (p *PromptBasic) field9Length() int {
+	l := 0
+	if p.IsSetPromptType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PromptType)
+	}
+	return l
+}
+
 func (p *PromptBasic) DeepCopy(s interface{}) error {
 	src, ok := s.(*PromptBasic)
 	if !ok {
@@ -912,6 +960,11 @@ func (p *PromptBasic) DeepCopy(s interface{}) error {
 		p.LatestCommittedAt = &tmp
 	}
 
+	if src.PromptType != nil {
+		tmp := *src.PromptType
+		p.PromptType = &tmp
+	}
+
 	return nil
 }
 
@@ -1430,7 +1483,7 @@ func (p *CommitInfo) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptDraft) FastRead(buf []byte) (int, error) {
+func (p *PromptLabel) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -1448,7 +1501,7 @@ func (p *PromptDraft) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -1462,7 +1515,7 @@ func (p *PromptDraft) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -1475,155 +1528,9 @@ func (p *PromptDraft) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
-		}
-	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDraft[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-}
-
-func (p *PromptDraft) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-	_field := NewPromptDetail()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Detail = _field
-	return offset, nil
-}
-
-func (p *PromptDraft) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-	_field := NewDraftInfo()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.DraftInfo = _field
-	return offset, nil
-}
-
-func (p *PromptDraft) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *PromptDraft) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
-
-func (p *PromptDraft) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *PromptDraft) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetDetail() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.Detail.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *PromptDraft) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetDraftInfo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.DraftInfo.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *PromptDraft) field1Length() int {
-	l := 0
-	if p.IsSetDetail() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Detail.BLength()
-	}
-	return l
-}
-
-func (p *PromptDraft) field2Length() int {
-	l := 0
-	if p.IsSetDraftInfo() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.DraftInfo.BLength()
-	}
-	return l
-}
-
-func (p *PromptDraft) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptDraft)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	var _detail *PromptDetail
-	if src.Detail != nil {
-		_detail = &PromptDetail{}
-		if err := _detail.DeepCopy(src.Detail); err != nil {
-			return err
-		}
-	}
-	p.Detail = _detail
-
-	var _draftInfo *DraftInfo
-	if src.DraftInfo != nil {
-		_draftInfo = &DraftInfo{}
-		if err := _draftInfo.DeepCopy(src.DraftInfo); err != nil {
-			return err
-		}
-	}
-	p.DraftInfo = _draftInfo
-
-	return nil
-}
-
-func (p *DraftInfo) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
+		case 11:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField1(buf[offset:])
+				l, err = p.FastReadField11(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -1635,23 +1542,9 @@ func (p *DraftInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
+		case 12:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField3(buf[offset:])
+				l, err = p.FastReadField12(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -1663,9 +1556,9 @@ func (p *DraftInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 11:
+		case 13:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField11(buf[offset:])
+				l, err = p.FastReadField13(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -1677,9 +1570,9 @@ func (p *DraftInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 12:
+		case 14:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField12(buf[offset:])
+				l, err = p.FastReadField14(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -1704,12 +1597,12 @@ func (p *DraftInfo) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DraftInfo[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptLabel[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *DraftInfo) FastReadField1(buf []byte) (int, error) {
+func (p *PromptLabel) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -1719,11 +1612,11 @@ func (p *DraftInfo) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.UserID = _field
+	p.LabelKey = _field
 	return offset, nil
 }
 
-func (p *DraftInfo) FastReadField2(buf []byte) (int, error) {
+func (p *PromptLabel) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -1733,25 +1626,39 @@ func (p *DraftInfo) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.BaseVersion = _field
+	p.CommitVersion = _field
 	return offset, nil
 }
 
-func (p *DraftInfo) FastReadField3(buf []byte) (int, error) {
+func (p *PromptLabel) FastReadField11(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.IsModified = _field
+	p.CreatedBy = _field
 	return offset, nil
 }
 
-func (p *DraftInfo) FastReadField11(buf []byte) (int, error) {
+func (p *PromptLabel) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.UpdatedBy = _field
+	return offset, nil
+}
+
+func (p *PromptLabel) FastReadField13(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -1765,7 +1672,7 @@ func (p *DraftInfo) FastReadField11(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *DraftInfo) FastReadField12(buf []byte) (int, error) {
+func (p *PromptLabel) FastReadField14(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -1779,109 +1686,129 @@ func (p *DraftInfo) FastReadField12(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *DraftInfo) FastWrite(buf []byte) int {
+func (p *PromptLabel) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *DraftInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabel) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField11(buf[offset:], w)
-		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField13(buf[offset:], w)
+		offset += p.fastWriteField14(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-	}
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *DraftInfo) BLength() int {
+func (p *PromptLabel) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
 		l += p.field11Length()
 		l += p.field12Length()
+		l += p.field13Length()
+		l += p.field14Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *DraftInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabel) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetUserID() {
+	if p.IsSetLabelKey() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.UserID)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LabelKey)
 	}
 	return offset
 }
 
-func (p *DraftInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabel) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBaseVersion() {
+	if p.IsSetCommitVersion() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BaseVersion)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.CommitVersion)
 	}
 	return offset
 }
 
-func (p *DraftInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabel) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetIsModified() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 3)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.IsModified)
+	if p.IsSetCreatedBy() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 11)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.CreatedBy)
 	}
 	return offset
 }
 
-func (p *DraftInfo) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabel) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUpdatedBy() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 12)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.UpdatedBy)
+	}
+	return offset
+}
+
+func (p *PromptLabel) fastWriteField13(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetCreatedAt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 11)
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 13)
 		offset += thrift.Binary.WriteI64(buf[offset:], *p.CreatedAt)
 	}
 	return offset
 }
 
-func (p *DraftInfo) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabel) fastWriteField14(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetUpdatedAt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 12)
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 14)
 		offset += thrift.Binary.WriteI64(buf[offset:], *p.UpdatedAt)
 	}
 	return offset
 }
 
-func (p *DraftInfo) field1Length() int {
+func (p *PromptLabel) field1Length() int {
 	l := 0
-	if p.IsSetUserID() {
+	if p.IsSetLabelKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.UserID)
+		l += thrift.Binary.StringLengthNocopy(*p.LabelKey)
 	}
 	return l
 }
 
-func (p *DraftInfo) field2Length() int {
+func (p *PromptLabel) field2Length() int {
 	l := 0
-	if p.IsSetBaseVersion() {
+	if p.IsSetCommitVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.BaseVersion)
+		l += thrift.Binary.StringLengthNocopy(*p.CommitVersion)
 	}
 	return l
 }
 
-func (p *DraftInfo) field3Length() int {
+func (p *PromptLabel) field11Length() int {
 	l := 0
-	if p.IsSetIsModified() {
+	if p.IsSetCreatedBy() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
+		l += thrift.Binary.StringLengthNocopy(*p.CreatedBy)
 	}
 	return l
 }
 
-func (p *DraftInfo) field11Length() int {
+func (p *PromptLabel) field12Length() int {
+	l := 0
+	if p.IsSetUpdatedBy() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.UpdatedBy)
+	}
+	return l
+}
+
+func (p *PromptLabel) field13Length() int {
 	l := 0
 	if p.IsSetCreatedAt() {
 		l += thrift.Binary.FieldBeginLength()
@@ -1890,7 +1817,7 @@ func (p *DraftInfo) field11Length() int {
 	return l
 }
 
-func (p *DraftInfo) field12Length() int {
+func (p *PromptLabel) field14Length() int {
 	l := 0
 	if p.IsSetUpdatedAt() {
 		l += thrift.Binary.FieldBeginLength()
@@ -1899,31 +1826,42 @@ func (p *DraftInfo) field12Length() int {
 	return l
 }
 
-func (p *DraftInfo) DeepCopy(s interface{}) error {
-	src, ok := s.(*DraftInfo)
+func (p *PromptLabel) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptLabel)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.UserID != nil {
+	if src.LabelKey != nil {
 		var tmp string
-		if *src.UserID != "" {
-			tmp = kutils.StringDeepCopy(*src.UserID)
+		if *src.LabelKey != "" {
+			tmp = kutils.StringDeepCopy(*src.LabelKey)
 		}
-		p.UserID = &tmp
+		p.LabelKey = &tmp
 	}
 
-	if src.BaseVersion != nil {
+	if src.CommitVersion != nil {
 		var tmp string
-		if *src.BaseVersion != "" {
-			tmp = kutils.StringDeepCopy(*src.BaseVersion)
+		if *src.CommitVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.CommitVersion)
 		}
-		p.BaseVersion = &tmp
+		p.CommitVersion = &tmp
 	}
 
-	if src.IsModified != nil {
-		tmp := *src.IsModified
-		p.IsModified = &tmp
+	if src.CreatedBy != nil {
+		var tmp string
+		if *src.CreatedBy != "" {
+			tmp = kutils.StringDeepCopy(*src.CreatedBy)
+		}
+		p.CreatedBy = &tmp
+	}
+
+	if src.UpdatedBy != nil {
+		var tmp string
+		if *src.UpdatedBy != "" {
+			tmp = kutils.StringDeepCopy(*src.UpdatedBy)
+		}
+		p.UpdatedBy = &tmp
 	}
 
 	if src.CreatedAt != nil {
@@ -1939,7 +1877,7 @@ func (p *DraftInfo) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptDetail) FastRead(buf []byte) (int, error) {
+func (p *PromptLabelHistory) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -1957,7 +1895,7 @@ func (p *PromptDetail) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -1971,7 +1909,7 @@ func (p *PromptDetail) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -1985,7 +1923,7 @@ func (p *PromptDetail) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -1999,7 +1937,7 @@ func (p *PromptDetail) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2012,9 +1950,37 @@ func (p *PromptDetail) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 255:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField255(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField7(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -2039,318 +2005,326 @@ func (p *PromptDetail) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDetail[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptLabelHistory[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptDetail) FastReadField1(buf []byte) (int, error) {
+func (p *PromptLabelHistory) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPromptTemplate()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.PromptTemplate = _field
+	p.ID = _field
 	return offset, nil
 }
 
-func (p *PromptDetail) FastReadField2(buf []byte) (int, error) {
+func (p *PromptLabelHistory) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*Tool, 0, size)
-	values := make([]Tool, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.LabelKey = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *PromptLabelHistory) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.Tools = _field
+	p.CommitVersion = _field
 	return offset, nil
 }
 
-func (p *PromptDetail) FastReadField3(buf []byte) (int, error) {
+func (p *PromptLabelHistory) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	_field := NewToolCallConfig()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.ToolCallConfig = _field
+	p.PreviousCommitVersion = _field
 	return offset, nil
 }
 
-func (p *PromptDetail) FastReadField4(buf []byte) (int, error) {
+func (p *PromptLabelHistory) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	_field := NewModelConfig()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *PromptLabelOperation
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.ModelConfig = _field
+	p.Operation = _field
 	return offset, nil
 }
 
-func (p *PromptDetail) FastReadField255(buf []byte) (int, error) {
+func (p *PromptLabelHistory) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
+	p.OperatedBy = _field
+	return offset, nil
+}
 
-		var _val string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_val = v
-		}
+func (p *PromptLabelHistory) FastReadField7(buf []byte) (int, error) {
+	offset := 0
 
-		_field[_key] = _val
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.ExtInfos = _field
+	p.OperatedAt = _field
 	return offset, nil
 }
 
-func (p *PromptDetail) FastWrite(buf []byte) int {
+func (p *PromptLabelHistory) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptDetail) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabelHistory) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *PromptDetail) BLength() int {
+func (p *PromptLabelHistory) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
-		l += p.field255Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *PromptDetail) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabelHistory) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPromptTemplate() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.PromptTemplate.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ID)
 	}
 	return offset
 }
 
-func (p *PromptDetail) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabelHistory) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTools() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Tools {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetLabelKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LabelKey)
 	}
 	return offset
 }
 
-func (p *PromptDetail) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabelHistory) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetToolCallConfig() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-		offset += p.ToolCallConfig.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetCommitVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.CommitVersion)
 	}
 	return offset
 }
 
-func (p *PromptDetail) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabelHistory) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetModelConfig() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
-		offset += p.ModelConfig.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetPreviousCommitVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PreviousCommitVersion)
 	}
 	return offset
 }
 
-func (p *PromptDetail) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptLabelHistory) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExtInfos() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 255)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.ExtInfos {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	if p.IsSetOperation() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Operation)
 	}
 	return offset
 }
 
-func (p *PromptDetail) field1Length() int {
+func (p *PromptLabelHistory) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOperatedBy() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.OperatedBy)
+	}
+	return offset
+}
+
+func (p *PromptLabelHistory) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOperatedAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 7)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.OperatedAt)
+	}
+	return offset
+}
+
+func (p *PromptLabelHistory) field1Length() int {
 	l := 0
-	if p.IsSetPromptTemplate() {
+	if p.IsSetID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.PromptTemplate.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *PromptDetail) field2Length() int {
+func (p *PromptLabelHistory) field2Length() int {
 	l := 0
-	if p.IsSetTools() {
+	if p.IsSetLabelKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Tools {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.LabelKey)
 	}
 	return l
 }
 
-func (p *PromptDetail) field3Length() int {
+func (p *PromptLabelHistory) field3Length() int {
 	l := 0
-	if p.IsSetToolCallConfig() {
+	if p.IsSetCommitVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.ToolCallConfig.BLength()
+		l += thrift.Binary.StringLengthNocopy(*p.CommitVersion)
 	}
 	return l
 }
 
-func (p *PromptDetail) field4Length() int {
+func (p *PromptLabelHistory) field4Length() int {
 	l := 0
-	if p.IsSetModelConfig() {
+	if p.IsSetPreviousCommitVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.ModelConfig.BLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PreviousCommitVersion)
 	}
 	return l
 }
 
-func (p *PromptDetail) field255Length() int {
+func (p *PromptLabelHistory) field5Length() int {
 	l := 0
-	if p.IsSetExtInfos() {
+	if p.IsSetOperation() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.ExtInfos {
-			_, _ = k, v
+		l += thrift.Binary.StringLengthNocopy(*p.Operation)
+	}
+	return l
+}
 
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += thrift.Binary.StringLengthNocopy(v)
-		}
+func (p *PromptLabelHistory) field6Length() int {
+	l := 0
+	if p.IsSetOperatedBy() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.OperatedBy)
 	}
 	return l
 }
 
-func (p *PromptDetail) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptDetail)
+func (p *PromptLabelHistory) field7Length() int {
+	l := 0
+	if p.IsSetOperatedAt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *PromptLabelHistory) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptLabelHistory)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _promptTemplate *PromptTemplate
-	if src.PromptTemplate != nil {
-		_promptTemplate = &PromptTemplate{}
-		if err := _promptTemplate.DeepCopy(src.PromptTemplate); err != nil {
-			return err
-		}
+	if src.ID != nil {
+		tmp := *src.ID
+		p.ID = &tmp
 	}
-	p.PromptTemplate = _promptTemplate
-
-	if src.Tools != nil {
-		p.Tools = make([]*Tool, 0, len(src.Tools))
-		for _, elem := range src.Tools {
-			var _elem *Tool
-			if elem != nil {
-				_elem = &Tool{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
 
-			p.Tools = append(p.Tools, _elem)
+	if src.LabelKey != nil {
+		var tmp string
+		if *src.LabelKey != "" {
+			tmp = kutils.StringDeepCopy(*src.LabelKey)
 		}
+		p.LabelKey = &tmp
 	}
 
-	var _toolCallConfig *ToolCallConfig
-	if src.ToolCallConfig != nil {
-		_toolCallConfig = &ToolCallConfig{}
-		if err := _toolCallConfig.DeepCopy(src.ToolCallConfig); err != nil {
-			return err
+	if src.CommitVersion != nil {
+		var tmp string
+		if *src.CommitVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.CommitVersion)
 		}
+		p.CommitVersion = &tmp
 	}
-	p.ToolCallConfig = _toolCallConfig
 
-	var _modelConfig *ModelConfig
-	if src.ModelConfig != nil {
-		_modelConfig = &ModelConfig{}
-		if err := _modelConfig.DeepCopy(src.ModelConfig); err != nil {
-			return err
+	if src.PreviousCommitVersion != nil {
+		var tmp string
+		if *src.PreviousCommitVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.PreviousCommitVersion)
 		}
+		p.PreviousCommitVersion = &tmp
 	}
-	p.ModelConfig = _modelConfig
-
-	if src.ExtInfos != nil {
-		p.ExtInfos = make(map[string]string, len(src.ExtInfos))
-		for key, val := range src.ExtInfos {
-			var _key string
-			if key != "" {
-				_key = kutils.StringDeepCopy(key)
-			}
 
-			var _val string
-			if val != "" {
-				_val = kutils.StringDeepCopy(val)
-			}
+	if src.Operation != nil {
+		tmp := *src.Operation
+		p.Operation = &tmp
+	}
 
-			p.ExtInfos[_key] = _val
+	if src.OperatedBy != nil {
+		var tmp string
+		if *src.OperatedBy != "" {
+			tmp = kutils.StringDeepCopy(*src.OperatedBy)
 		}
+		p.OperatedBy = &tmp
+	}
+
+	if src.OperatedAt != nil {
+		tmp := *src.OperatedAt
+		p.OperatedAt = &tmp
 	}
 
 	return nil
 }
 
-func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
+func (p *SnippetReference) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2368,7 +2342,7 @@ func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2382,7 +2356,7 @@ func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2396,7 +2370,7 @@ func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2409,6 +2383,34 @@ func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2422,222 +2424,245 @@ func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptTemplate[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SnippetReference[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptTemplate) FastReadField1(buf []byte) (int, error) {
+func (p *SnippetReference) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *TemplateType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.TemplateType = _field
+	p.PromptID = _field
 	return offset, nil
 }
 
-func (p *PromptTemplate) FastReadField2(buf []byte) (int, error) {
+func (p *SnippetReference) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*Message, 0, size)
-	values := make([]Message, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.PromptKey = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *SnippetReference) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.Messages = _field
+	p.CommitVersion = _field
 	return offset, nil
 }
 
-func (p *PromptTemplate) FastReadField3(buf []byte) (int, error) {
+func (p *SnippetReference) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*VariableDef, 0, size)
-	values := make([]VariableDef, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.SnippetVersion = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *SnippetReference) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.VariableDefs = _field
+	p.CreatedAt = _field
 	return offset, nil
 }
 
-func (p *PromptTemplate) FastWrite(buf []byte) int {
+func (p *SnippetReference) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptTemplate) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *SnippetReference) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *PromptTemplate) BLength() int {
+func (p *SnippetReference) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *PromptTemplate) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *SnippetReference) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTemplateType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TemplateType)
+	if p.IsSetPromptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.PromptID)
 	}
 	return offset
 }
 
-func (p *PromptTemplate) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *SnippetReference) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetMessages() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Messages {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetPromptKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptKey)
 	}
 	return offset
 }
 
-func (p *PromptTemplate) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *SnippetReference) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVariableDefs() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.VariableDefs {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetCommitVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.CommitVersion)
 	}
 	return offset
 }
 
-func (p *PromptTemplate) field1Length() int {
+func (p *SnippetReference) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSnippetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.SnippetVersion)
+	}
+	return offset
+}
+
+func (p *SnippetReference) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCreatedAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.CreatedAt)
+	}
+	return offset
+}
+
+func (p *SnippetReference) field1Length() int {
 	l := 0
-	if p.IsSetTemplateType() {
+	if p.IsSetPromptID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.TemplateType)
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *PromptTemplate) field2Length() int {
+func (p *SnippetReference) field2Length() int {
 	l := 0
-	if p.IsSetMessages() {
+	if p.IsSetPromptKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Messages {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.PromptKey)
 	}
 	return l
 }
 
-func (p *PromptTemplate) field3Length() int {
+func (p *SnippetReference) field3Length() int {
 	l := 0
-	if p.IsSetVariableDefs() {
+	if p.IsSetCommitVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.VariableDefs {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.CommitVersion)
 	}
 	return l
 }
 
-func (p *PromptTemplate) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptTemplate)
+func (p *SnippetReference) field4Length() int {
+	l := 0
+	if p.IsSetSnippetVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.SnippetVersion)
+	}
+	return l
+}
+
+func (p *SnippetReference) field5Length() int {
+	l := 0
+	if p.IsSetCreatedAt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *SnippetReference) DeepCopy(s interface{}) error {
+	src, ok := s.(*SnippetReference)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.TemplateType != nil {
-		tmp := *src.TemplateType
-		p.TemplateType = &tmp
+	if src.PromptID != nil {
+		tmp := *src.PromptID
+		p.PromptID = &tmp
 	}
 
-	if src.Messages != nil {
-		p.Messages = make([]*Message, 0, len(src.Messages))
-		for _, elem := range src.Messages {
-			var _elem *Message
-			if elem != nil {
-				_elem = &Message{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Messages = append(p.Messages, _elem)
+	if src.PromptKey != nil {
+		var tmp string
+		if *src.PromptKey != "" {
+			tmp = kutils.StringDeepCopy(*src.PromptKey)
 		}
+		p.PromptKey = &tmp
 	}
 
-	if src.VariableDefs != nil {
-		p.VariableDefs = make([]*VariableDef, 0, len(src.VariableDefs))
-		for _, elem := range src.VariableDefs {
-			var _elem *VariableDef
-			if elem != nil {
-				_elem = &VariableDef{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	if src.CommitVersion != nil {
+		var tmp string
+		if *src.CommitVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.CommitVersion)
+		}
+		p.CommitVersion = &tmp
+	}
 
-			p.VariableDefs = append(p.VariableDefs, _elem)
+	if src.SnippetVersion != nil {
+		var tmp string
+		if *src.SnippetVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.SnippetVersion)
 		}
+		p.SnippetVersion = &tmp
+	}
+
+	if src.CreatedAt != nil {
+		tmp := *src.CreatedAt
+		p.CreatedAt = &tmp
 	}
 
 	return nil
 }
 
-func (p *Tool) FastRead(buf []byte) (int, error) {
+func (p *PromptDraft) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2655,7 +2680,7 @@ func (p *Tool) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2695,42 +2720,40 @@ func (p *Tool) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Tool[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDraft[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Tool) FastReadField1(buf []byte) (int, error) {
+func (p *PromptDraft) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *ToolType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewPromptDetail()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Type = _field
+	p.Detail = _field
 	return offset, nil
 }
 
-func (p *Tool) FastReadField2(buf []byte) (int, error) {
+func (p *PromptDraft) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFunction()
+	_field := NewDraftInfo()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Function = _field
+	p.DraftInfo = _field
 	return offset, nil
 }
 
-func (p *Tool) FastWrite(buf []byte) int {
+func (p *PromptDraft) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *Tool) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptDraft) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2740,7 +2763,7 @@ func (p *Tool) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
-func (p *Tool) BLength() int {
+func (p *PromptDraft) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2750,66 +2773,70 @@ func (p *Tool) BLength() int {
 	return l
 }
 
-func (p *Tool) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptDraft) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	if p.IsSetDetail() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Detail.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *Tool) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptDraft) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFunction() {
+	if p.IsSetDraftInfo() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.Function.FastWriteNocopy(buf[offset:], w)
+		offset += p.DraftInfo.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *Tool) field1Length() int {
+func (p *PromptDraft) field1Length() int {
 	l := 0
-	if p.IsSetType() {
+	if p.IsSetDetail() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Type)
+		l += p.Detail.BLength()
 	}
 	return l
 }
 
-func (p *Tool) field2Length() int {
+func (p *PromptDraft) field2Length() int {
 	l := 0
-	if p.IsSetFunction() {
+	if p.IsSetDraftInfo() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Function.BLength()
+		l += p.DraftInfo.BLength()
 	}
 	return l
 }
 
-func (p *Tool) DeepCopy(s interface{}) error {
-	src, ok := s.(*Tool)
+func (p *PromptDraft) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptDraft)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Type != nil {
-		tmp := *src.Type
-		p.Type = &tmp
+	var _detail *PromptDetail
+	if src.Detail != nil {
+		_detail = &PromptDetail{}
+		if err := _detail.DeepCopy(src.Detail); err != nil {
+			return err
+		}
 	}
+	p.Detail = _detail
 
-	var _function *Function
-	if src.Function != nil {
-		_function = &Function{}
-		if err := _function.DeepCopy(src.Function); err != nil {
+	var _draftInfo *DraftInfo
+	if src.DraftInfo != nil {
+		_draftInfo = &DraftInfo{}
+		if err := _draftInfo.DeepCopy(src.DraftInfo); err != nil {
 			return err
 		}
 	}
-	p.Function = _function
+	p.DraftInfo = _draftInfo
 
 	return nil
 }
 
-func (p *Function) FastRead(buf []byte) (int, error) {
+func (p *DraftInfo) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2855,7 +2882,7 @@ func (p *Function) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.BOOL {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2868,6 +2895,34 @@ func (p *Function) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2881,12 +2936,12 @@ func (p *Function) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Function[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DraftInfo[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Function) FastReadField1(buf []byte) (int, error) {
+func (p *DraftInfo) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -2896,11 +2951,11 @@ func (p *Function) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Name = _field
+	p.UserID = _field
 	return offset, nil
 }
 
-func (p *Function) FastReadField2(buf []byte) (int, error) {
+func (p *DraftInfo) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -2910,138 +2965,213 @@ func (p *Function) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Description = _field
+	p.BaseVersion = _field
 	return offset, nil
 }
 
-func (p *Function) FastReadField3(buf []byte) (int, error) {
+func (p *DraftInfo) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Parameters = _field
+	p.IsModified = _field
 	return offset, nil
 }
 
-func (p *Function) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *Function) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *DraftInfo) FastReadField11(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
+	p.CreatedAt = _field
+	return offset, nil
 }
 
-func (p *Function) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
+func (p *DraftInfo) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.UpdatedAt = _field
+	return offset, nil
+}
+
+func (p *DraftInfo) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DraftInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DraftInfo) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field11Length()
+		l += p.field12Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *Function) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *DraftInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
+	if p.IsSetUserID() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.UserID)
 	}
 	return offset
 }
 
-func (p *Function) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *DraftInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDescription() {
+	if p.IsSetBaseVersion() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BaseVersion)
 	}
 	return offset
 }
 
-func (p *Function) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *DraftInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetParameters() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Parameters)
+	if p.IsSetIsModified() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 3)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.IsModified)
 	}
 	return offset
 }
 
-func (p *Function) field1Length() int {
+func (p *DraftInfo) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCreatedAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 11)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.CreatedAt)
+	}
+	return offset
+}
+
+func (p *DraftInfo) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUpdatedAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 12)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.UpdatedAt)
+	}
+	return offset
+}
+
+func (p *DraftInfo) field1Length() int {
 	l := 0
-	if p.IsSetName() {
+	if p.IsSetUserID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+		l += thrift.Binary.StringLengthNocopy(*p.UserID)
 	}
 	return l
 }
 
-func (p *Function) field2Length() int {
+func (p *DraftInfo) field2Length() int {
 	l := 0
-	if p.IsSetDescription() {
+	if p.IsSetBaseVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Description)
+		l += thrift.Binary.StringLengthNocopy(*p.BaseVersion)
 	}
 	return l
 }
 
-func (p *Function) field3Length() int {
+func (p *DraftInfo) field3Length() int {
 	l := 0
-	if p.IsSetParameters() {
+	if p.IsSetIsModified() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Parameters)
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *Function) DeepCopy(s interface{}) error {
-	src, ok := s.(*Function)
+func (p *DraftInfo) field11Length() int {
+	l := 0
+	if p.IsSetCreatedAt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *DraftInfo) field12Length() int {
+	l := 0
+	if p.IsSetUpdatedAt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *DraftInfo) DeepCopy(s interface{}) error {
+	src, ok := s.(*DraftInfo)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Name != nil {
+	if src.UserID != nil {
 		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
+		if *src.UserID != "" {
+			tmp = kutils.StringDeepCopy(*src.UserID)
 		}
-		p.Name = &tmp
+		p.UserID = &tmp
 	}
 
-	if src.Description != nil {
+	if src.BaseVersion != nil {
 		var tmp string
-		if *src.Description != "" {
-			tmp = kutils.StringDeepCopy(*src.Description)
+		if *src.BaseVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.BaseVersion)
 		}
-		p.Description = &tmp
+		p.BaseVersion = &tmp
 	}
 
-	if src.Parameters != nil {
-		var tmp string
-		if *src.Parameters != "" {
-			tmp = kutils.StringDeepCopy(*src.Parameters)
-		}
-		p.Parameters = &tmp
+	if src.IsModified != nil {
+		tmp := *src.IsModified
+		p.IsModified = &tmp
+	}
+
+	if src.CreatedAt != nil {
+		tmp := *src.CreatedAt
+		p.CreatedAt = &tmp
+	}
+
+	if src.UpdatedAt != nil {
+		tmp := *src.UpdatedAt
+		p.UpdatedAt = &tmp
 	}
 
 	return nil
 }
 
-func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
+func (p *PromptDetail) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3059,7 +3189,7 @@ func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3072,6 +3202,62 @@ func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -3085,80 +3271,2930 @@ func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCallConfig[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDetail[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ToolCallConfig) FastReadField1(buf []byte) (int, error) {
+func (p *PromptDetail) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *ToolChoiceType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewPromptTemplate()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.ToolChoice = _field
+	p.PromptTemplate = _field
 	return offset, nil
 }
 
-func (p *ToolCallConfig) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *ToolCallConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptDetail) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
 
-func (p *ToolCallConfig) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
+	_field := make([]*Tool, 0, size)
+	values := make([]Tool, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Tools = _field
+	return offset, nil
+}
+
+func (p *PromptDetail) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := NewToolCallConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ToolCallConfig = _field
+	return offset, nil
+}
+
+func (p *PromptDetail) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewModelConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ModelConfig = _field
+	return offset, nil
+}
+
+func (p *PromptDetail) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.ExtInfos = _field
+	return offset, nil
+}
+
+func (p *PromptDetail) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptDetail) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptDetail) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptDetail) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptTemplate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.PromptTemplate.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptDetail) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTools() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Tools {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *PromptDetail) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetToolCallConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.ToolCallConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptDetail) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetModelConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.ModelConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptDetail) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExtInfos() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 255)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.ExtInfos {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *PromptDetail) field1Length() int {
+	l := 0
+	if p.IsSetPromptTemplate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.PromptTemplate.BLength()
+	}
+	return l
+}
+
+func (p *PromptDetail) field2Length() int {
+	l := 0
+	if p.IsSetTools() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Tools {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *PromptDetail) field3Length() int {
+	l := 0
+	if p.IsSetToolCallConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ToolCallConfig.BLength()
+	}
+	return l
+}
+
+func (p *PromptDetail) field4Length() int {
+	l := 0
+	if p.IsSetModelConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ModelConfig.BLength()
+	}
+	return l
+}
+
+func (p *PromptDetail) field255Length() int {
+	l := 0
+	if p.IsSetExtInfos() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.ExtInfos {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *PromptDetail) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptDetail)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _promptTemplate *PromptTemplate
+	if src.PromptTemplate != nil {
+		_promptTemplate = &PromptTemplate{}
+		if err := _promptTemplate.DeepCopy(src.PromptTemplate); err != nil {
+			return err
+		}
+	}
+	p.PromptTemplate = _promptTemplate
+
+	if src.Tools != nil {
+		p.Tools = make([]*Tool, 0, len(src.Tools))
+		for _, elem := range src.Tools {
+			var _elem *Tool
+			if elem != nil {
+				_elem = &Tool{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Tools = append(p.Tools, _elem)
+		}
+	}
+
+	var _toolCallConfig *ToolCallConfig
+	if src.ToolCallConfig != nil {
+		_toolCallConfig = &ToolCallConfig{}
+		if err := _toolCallConfig.DeepCopy(src.ToolCallConfig); err != nil {
+			return err
+		}
+	}
+	p.ToolCallConfig = _toolCallConfig
+
+	var _modelConfig *ModelConfig
+	if src.ModelConfig != nil {
+		_modelConfig = &ModelConfig{}
+		if err := _modelConfig.DeepCopy(src.ModelConfig); err != nil {
+			return err
+		}
+	}
+	p.ModelConfig = _modelConfig
+
+	if src.ExtInfos != nil {
+		p.ExtInfos = make(map[string]string, len(src.ExtInfos))
+		for key, val := range src.ExtInfos {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val string
+			if val != "" {
+				_val = kutils.StringDeepCopy(val)
+			}
+
+			p.ExtInfos[_key] = _val
+		}
+	}
+
+	return nil
+}
+
+func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptTemplate[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptTemplate) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *TemplateType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TemplateType = _field
+	return offset, nil
+}
+
+func (p *PromptTemplate) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*Message, 0, size)
+	values := make([]Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Messages = _field
+	return offset, nil
+}
+
+func (p *PromptTemplate) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*VariableDef, 0, size)
+	values := make([]VariableDef, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.VariableDefs = _field
+	return offset, nil
+}
+
+func (p *PromptTemplate) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptTemplate) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptTemplate) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptTemplate) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTemplateType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TemplateType)
+	}
+	return offset
+}
+
+func (p *PromptTemplate) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMessages() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Messages {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *PromptTemplate) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVariableDefs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.VariableDefs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *PromptTemplate) field1Length() int {
+	l := 0
+	if p.IsSetTemplateType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.TemplateType)
+	}
+	return l
+}
+
+func (p *PromptTemplate) field2Length() int {
+	l := 0
+	if p.IsSetMessages() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Messages {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *PromptTemplate) field3Length() int {
+	l := 0
+	if p.IsSetVariableDefs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.VariableDefs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *PromptTemplate) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptTemplate)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.TemplateType != nil {
+		tmp := *src.TemplateType
+		p.TemplateType = &tmp
+	}
+
+	if src.Messages != nil {
+		p.Messages = make([]*Message, 0, len(src.Messages))
+		for _, elem := range src.Messages {
+			var _elem *Message
+			if elem != nil {
+				_elem = &Message{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Messages = append(p.Messages, _elem)
+		}
+	}
+
+	if src.VariableDefs != nil {
+		p.VariableDefs = make([]*VariableDef, 0, len(src.VariableDefs))
+		for _, elem := range src.VariableDefs {
+			var _elem *VariableDef
+			if elem != nil {
+				_elem = &VariableDef{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.VariableDefs = append(p.VariableDefs, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *Tool) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Tool[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *Tool) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ToolType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Type = _field
+	return offset, nil
+}
+
+func (p *Tool) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewFunction()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Function = _field
+	return offset, nil
+}
+
+func (p *Tool) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *Tool) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *Tool) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *Tool) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	}
+	return offset
+}
+
+func (p *Tool) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFunction() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.Function.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Tool) field1Length() int {
+	l := 0
+	if p.IsSetType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Type)
+	}
+	return l
+}
+
+func (p *Tool) field2Length() int {
+	l := 0
+	if p.IsSetFunction() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Function.BLength()
+	}
+	return l
+}
+
+func (p *Tool) DeepCopy(s interface{}) error {
+	src, ok := s.(*Tool)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Type != nil {
+		tmp := *src.Type
+		p.Type = &tmp
+	}
+
+	var _function *Function
+	if src.Function != nil {
+		_function = &Function{}
+		if err := _function.DeepCopy(src.Function); err != nil {
+			return err
+		}
+	}
+	p.Function = _function
+
+	return nil
+}
+
+func (p *Function) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Function[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *Function) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *Function) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Description = _field
+	return offset, nil
+}
+
+func (p *Function) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Parameters = _field
+	return offset, nil
+}
+
+func (p *Function) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *Function) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *Function) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *Function) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *Function) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	}
+	return offset
+}
+
+func (p *Function) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetParameters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Parameters)
+	}
+	return offset
+}
+
+func (p *Function) field1Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
+	}
+	return l
+}
+
+func (p *Function) field2Length() int {
+	l := 0
+	if p.IsSetDescription() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Description)
+	}
+	return l
+}
+
+func (p *Function) field3Length() int {
+	l := 0
+	if p.IsSetParameters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Parameters)
+	}
+	return l
+}
+
+func (p *Function) DeepCopy(s interface{}) error {
+	src, ok := s.(*Function)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	if src.Description != nil {
+		var tmp string
+		if *src.Description != "" {
+			tmp = kutils.StringDeepCopy(*src.Description)
+		}
+		p.Description = &tmp
+	}
+
+	if src.Parameters != nil {
+		var tmp string
+		if *src.Parameters != "" {
+			tmp = kutils.StringDeepCopy(*src.Parameters)
+		}
+		p.Parameters = &tmp
+	}
+
+	return nil
+}
+
+func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCallConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ToolCallConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ToolChoiceType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ToolChoice = _field
+	return offset, nil
+}
+
+func (p *ToolCallConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ToolCallConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ToolCallConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
 
 func (p *ToolCallConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetToolChoice() {
+	if p.IsSetToolChoice() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ToolChoice)
+	}
+	return offset
+}
+
+func (p *ToolCallConfig) field1Length() int {
+	l := 0
+	if p.IsSetToolChoice() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ToolChoice)
+	}
+	return l
+}
+
+func (p *ToolCallConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*ToolCallConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ToolChoice != nil {
+		tmp := *src.ToolChoice
+		p.ToolChoice = &tmp
+	}
+
+	return nil
+}
+
+func (p *ModelConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModelConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ModelConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ModelID = _field
+	return offset, nil
+}
+
+func (p *ModelConfig) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.MaxTokens = _field
+	return offset, nil
+}
+
+func (p *ModelConfig) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Temperature = _field
+	return offset, nil
+}
+
+func (p *ModelConfig) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TopK = _field
+	return offset, nil
+}
+
+func (p *ModelConfig) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TopP = _field
+	return offset, nil
+}
+
+func (p *ModelConfig) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PresencePenalty = _field
+	return offset, nil
+}
+
+func (p *ModelConfig) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FrequencyPenalty = _field
+	return offset, nil
+}
+
+func (p *ModelConfig) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.JSONMode = _field
+	return offset, nil
+}
+
+func (p *ModelConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ModelConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ModelConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ModelConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetModelID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ModelID)
+	}
+	return offset
+}
+
+func (p *ModelConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxTokens)
+	}
+	return offset
+}
+
+func (p *ModelConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTemperature() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Temperature)
+	}
+	return offset
+}
+
+func (p *ModelConfig) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTopK() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.TopK)
+	}
+	return offset
+}
+
+func (p *ModelConfig) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTopP() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.TopP)
+	}
+	return offset
+}
+
+func (p *ModelConfig) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPresencePenalty() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.PresencePenalty)
+	}
+	return offset
+}
+
+func (p *ModelConfig) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFrequencyPenalty() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 7)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.FrequencyPenalty)
+	}
+	return offset
+}
+
+func (p *ModelConfig) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetJSONMode() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 8)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.JSONMode)
+	}
+	return offset
+}
+
+func (p *ModelConfig) field1Length() int {
+	l := 0
+	if p.IsSetModelID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ModelConfig) field2Length() int {
+	l := 0
+	if p.IsSetMaxTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ModelConfig) field3Length() int {
+	l := 0
+	if p.IsSetTemperature() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *ModelConfig) field4Length() int {
+	l := 0
+	if p.IsSetTopK() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ModelConfig) field5Length() int {
+	l := 0
+	if p.IsSetTopP() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *ModelConfig) field6Length() int {
+	l := 0
+	if p.IsSetPresencePenalty() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *ModelConfig) field7Length() int {
+	l := 0
+	if p.IsSetFrequencyPenalty() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *ModelConfig) field8Length() int {
+	l := 0
+	if p.IsSetJSONMode() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *ModelConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*ModelConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ModelID != nil {
+		tmp := *src.ModelID
+		p.ModelID = &tmp
+	}
+
+	if src.MaxTokens != nil {
+		tmp := *src.MaxTokens
+		p.MaxTokens = &tmp
+	}
+
+	if src.Temperature != nil {
+		tmp := *src.Temperature
+		p.Temperature = &tmp
+	}
+
+	if src.TopK != nil {
+		tmp := *src.TopK
+		p.TopK = &tmp
+	}
+
+	if src.TopP != nil {
+		tmp := *src.TopP
+		p.TopP = &tmp
+	}
+
+	if src.PresencePenalty != nil {
+		tmp := *src.PresencePenalty
+		p.PresencePenalty = &tmp
+	}
+
+	if src.FrequencyPenalty != nil {
+		tmp := *src.FrequencyPenalty
+		p.FrequencyPenalty = &tmp
+	}
+
+	if src.JSONMode != nil {
+		tmp := *src.JSONMode
+		p.JSONMode = &tmp
+	}
+
+	return nil
+}
+
+func (p *Message) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Message[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *Message) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *Role
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Role = _field
+	return offset, nil
+}
+
+func (p *Message) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ReasoningContent = _field
+	return offset, nil
+}
+
+func (p *Message) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Content = _field
+	return offset, nil
+}
+
+func (p *Message) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*ContentPart, 0, size)
+	values := make([]ContentPart, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Parts = _field
+	return offset, nil
+}
+
+func (p *Message) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ToolCallID = _field
+	return offset, nil
+}
+
+func (p *Message) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*ToolCall, 0, size)
+	values := make([]ToolCall, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ToolCalls = _field
+	return offset, nil
+}
+
+func (p *Message) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *Message) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *Message) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *Message) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRole() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Role)
+	}
+	return offset
+}
+
+func (p *Message) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetReasoningContent() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ReasoningContent)
+	}
+	return offset
+}
+
+func (p *Message) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetContent() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Content)
+	}
+	return offset
+}
+
+func (p *Message) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetParts() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Parts {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *Message) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetToolCallID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ToolCallID)
+	}
+	return offset
+}
+
+func (p *Message) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetToolCalls() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ToolCalls {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *Message) field1Length() int {
+	l := 0
+	if p.IsSetRole() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Role)
+	}
+	return l
+}
+
+func (p *Message) field2Length() int {
+	l := 0
+	if p.IsSetReasoningContent() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ReasoningContent)
+	}
+	return l
+}
+
+func (p *Message) field3Length() int {
+	l := 0
+	if p.IsSetContent() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Content)
+	}
+	return l
+}
+
+func (p *Message) field4Length() int {
+	l := 0
+	if p.IsSetParts() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Parts {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *Message) field5Length() int {
+	l := 0
+	if p.IsSetToolCallID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ToolCallID)
+	}
+	return l
+}
+
+func (p *Message) field6Length() int {
+	l := 0
+	if p.IsSetToolCalls() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ToolCalls {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *Message) DeepCopy(s interface{}) error {
+	src, ok := s.(*Message)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Role != nil {
+		tmp := *src.Role
+		p.Role = &tmp
+	}
+
+	if src.ReasoningContent != nil {
+		var tmp string
+		if *src.ReasoningContent != "" {
+			tmp = kutils.StringDeepCopy(*src.ReasoningContent)
+		}
+		p.ReasoningContent = &tmp
+	}
+
+	if src.Content != nil {
+		var tmp string
+		if *src.Content != "" {
+			tmp = kutils.StringDeepCopy(*src.Content)
+		}
+		p.Content = &tmp
+	}
+
+	if src.Parts != nil {
+		p.Parts = make([]*ContentPart, 0, len(src.Parts))
+		for _, elem := range src.Parts {
+			var _elem *ContentPart
+			if elem != nil {
+				_elem = &ContentPart{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Parts = append(p.Parts, _elem)
+		}
+	}
+
+	if src.ToolCallID != nil {
+		var tmp string
+		if *src.ToolCallID != "" {
+			tmp = kutils.StringDeepCopy(*src.ToolCallID)
+		}
+		p.ToolCallID = &tmp
+	}
+
+	if src.ToolCalls != nil {
+		p.ToolCalls = make([]*ToolCall, 0, len(src.ToolCalls))
+		for _, elem := range src.ToolCalls {
+			var _elem *ToolCall
+			if elem != nil {
+				_elem = &ToolCall{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ToolCalls = append(p.ToolCalls, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *ContentPart) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentPart[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ContentPart) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ContentType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Type = _field
+	return offset, nil
+}
+
+func (p *ContentPart) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Text = _field
+	return offset, nil
+}
+
+func (p *ContentPart) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := NewImageURL()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ImageURL = _field
+	return offset, nil
+}
+
+func (p *ContentPart) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ContentPart) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ContentPart) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ContentPart) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	}
+	return offset
+}
+
+func (p *ContentPart) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetText() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Text)
+	}
+	return offset
+}
+
+func (p *ContentPart) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetImageURL() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.ImageURL.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ContentPart) field1Length() int {
+	l := 0
+	if p.IsSetType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Type)
+	}
+	return l
+}
+
+func (p *ContentPart) field2Length() int {
+	l := 0
+	if p.IsSetText() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Text)
+	}
+	return l
+}
+
+func (p *ContentPart) field3Length() int {
+	l := 0
+	if p.IsSetImageURL() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ImageURL.BLength()
+	}
+	return l
+}
+
+func (p *ContentPart) DeepCopy(s interface{}) error {
+	src, ok := s.(*ContentPart)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Type != nil {
+		tmp := *src.Type
+		p.Type = &tmp
+	}
+
+	if src.Text != nil {
+		var tmp string
+		if *src.Text != "" {
+			tmp = kutils.StringDeepCopy(*src.Text)
+		}
+		p.Text = &tmp
+	}
+
+	var _imageURL *ImageURL
+	if src.ImageURL != nil {
+		_imageURL = &ImageURL{}
+		if err := _imageURL.DeepCopy(src.ImageURL); err != nil {
+			return err
+		}
+	}
+	p.ImageURL = _imageURL
+
+	return nil
+}
+
+func (p *ImageURL) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageURL[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ImageURL) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.URI = _field
+	return offset, nil
+}
+
+func (p *ImageURL) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.URL = _field
+	return offset, nil
+}
+
+func (p *ImageURL) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ImageURL) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ImageURL) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ImageURL) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetURI() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URI)
+	}
+	return offset
+}
+
+func (p *ImageURL) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetURL() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URL)
+	}
+	return offset
+}
+
+func (p *ImageURL) field1Length() int {
+	l := 0
+	if p.IsSetURI() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.URI)
+	}
+	return l
+}
+
+func (p *ImageURL) field2Length() int {
+	l := 0
+	if p.IsSetURL() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.URL)
+	}
+	return l
+}
+
+func (p *ImageURL) DeepCopy(s interface{}) error {
+	src, ok := s.(*ImageURL)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.URI != nil {
+		var tmp string
+		if *src.URI != "" {
+			tmp = kutils.StringDeepCopy(*src.URI)
+		}
+		p.URI = &tmp
+	}
+
+	if src.URL != nil {
+		var tmp string
+		if *src.URL != "" {
+			tmp = kutils.StringDeepCopy(*src.URL)
+		}
+		p.URL = &tmp
+	}
+
+	return nil
+}
+
+func (p *ToolCall) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCall[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ToolCall) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Index = _field
+	return offset, nil
+}
+
+func (p *ToolCall) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ID = _field
+	return offset, nil
+}
+
+func (p *ToolCall) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ToolType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Type = _field
+	return offset, nil
+}
+
+func (p *ToolCall) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewFunctionCall()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.FunctionCall = _field
+	return offset, nil
+}
+
+func (p *ToolCall) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ToolCall) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ToolCall) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ToolCall) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetIndex() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Index)
+	}
+	return offset
+}
+
+func (p *ToolCall) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ID)
+	}
+	return offset
+}
+
+func (p *ToolCall) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	}
+	return offset
+}
+
+func (p *ToolCall) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFunctionCall() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.FunctionCall.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ToolCall) field1Length() int {
+	l := 0
+	if p.IsSetIndex() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ToolCall) field2Length() int {
+	l := 0
+	if p.IsSetID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ID)
+	}
+	return l
+}
+
+func (p *ToolCall) field3Length() int {
+	l := 0
+	if p.IsSetType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Type)
+	}
+	return l
+}
+
+func (p *ToolCall) field4Length() int {
+	l := 0
+	if p.IsSetFunctionCall() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.FunctionCall.BLength()
+	}
+	return l
+}
+
+func (p *ToolCall) DeepCopy(s interface{}) error {
+	src, ok := s.(*ToolCall)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Index != nil {
+		tmp := *src.Index
+		p.Index = &tmp
+	}
+
+	if src.ID != nil {
+		var tmp string
+		if *src.ID != "" {
+			tmp = kutils.StringDeepCopy(*src.ID)
+		}
+		p.ID = &tmp
+	}
+
+	if src.Type != nil {
+		tmp := *src.Type
+		p.Type = &tmp
+	}
+
+	var _functionCall *FunctionCall
+	if src.FunctionCall != nil {
+		_functionCall = &FunctionCall{}
+		if err := _functionCall.DeepCopy(src.FunctionCall); err != nil {
+			return err
+		}
+	}
+	p.FunctionCall = _functionCall
+
+	return nil
+}
+
+func (p *FunctionCall) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FunctionCall[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *FunctionCall) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *FunctionCall) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Arguments = _field
+	return offset, nil
+}
+
+func (p *FunctionCall) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FunctionCall) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FunctionCall) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FunctionCall) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ToolChoice)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
 	}
 	return offset
 }
 
-func (p *ToolCallConfig) field1Length() int {
+func (p *FunctionCall) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetArguments() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Arguments)
+	}
+	return offset
+}
+
+func (p *FunctionCall) field1Length() int {
 	l := 0
-	if p.IsSetToolChoice() {
+	if p.IsSetName() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ToolChoice)
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
 	return l
 }
 
-func (p *ToolCallConfig) DeepCopy(s interface{}) error {
-	src, ok := s.(*ToolCallConfig)
+func (p *FunctionCall) field2Length() int {
+	l := 0
+	if p.IsSetArguments() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Arguments)
+	}
+	return l
+}
+
+func (p *FunctionCall) DeepCopy(s interface{}) error {
+	src, ok := s.(*FunctionCall)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ToolChoice != nil {
-		tmp := *src.ToolChoice
-		p.ToolChoice = &tmp
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	if src.Arguments != nil {
+		var tmp string
+		if *src.Arguments != "" {
+			tmp = kutils.StringDeepCopy(*src.Arguments)
+		}
+		p.Arguments = &tmp
 	}
 
 	return nil
 }
 
-func (p *ModelConfig) FastRead(buf []byte) (int, error) {
+func (p *VariableDef) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3176,7 +6212,7 @@ func (p *ModelConfig) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3190,7 +6226,7 @@ func (p *ModelConfig) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3204,7 +6240,7 @@ func (p *ModelConfig) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3218,7 +6254,7 @@ func (p *ModelConfig) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3231,62 +6267,6 @@ func (p *ModelConfig) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField7(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 8:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField8(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -3300,353 +6280,230 @@ func (p *ModelConfig) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModelConfig[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableDef[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ModelConfig) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ModelID = _field
-	return offset, nil
-}
-
-func (p *ModelConfig) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.MaxTokens = _field
-	return offset, nil
-}
-
-func (p *ModelConfig) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Temperature = _field
-	return offset, nil
-}
-
-func (p *ModelConfig) FastReadField4(buf []byte) (int, error) {
+func (p *VariableDef) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.TopK = _field
+	p.Key = _field
 	return offset, nil
 }
 
-func (p *ModelConfig) FastReadField5(buf []byte) (int, error) {
+func (p *VariableDef) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.TopP = _field
+	p.Desc = _field
 	return offset, nil
 }
 
-func (p *ModelConfig) FastReadField6(buf []byte) (int, error) {
+func (p *VariableDef) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	var _field *VariableType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.PresencePenalty = _field
+	p.Type = _field
 	return offset, nil
 }
 
-func (p *ModelConfig) FastReadField7(buf []byte) (int, error) {
+func (p *VariableDef) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.FrequencyPenalty = _field
-	return offset, nil
-}
-
-func (p *ModelConfig) FastReadField8(buf []byte) (int, error) {
-	offset := 0
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
 
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.JSONMode = _field
+	p.TypeTags = _field
 	return offset, nil
 }
 
-func (p *ModelConfig) FastWrite(buf []byte) int {
+func (p *VariableDef) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ModelConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableDef) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
-		offset += p.fastWriteField8(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ModelConfig) BLength() int {
+func (p *VariableDef) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
-		l += p.field8Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *ModelConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetModelID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ModelID)
-	}
-	return offset
-}
-
-func (p *ModelConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetMaxTokens() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxTokens)
-	}
-	return offset
-}
-
-func (p *ModelConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTemperature() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Temperature)
-	}
-	return offset
-}
-
-func (p *ModelConfig) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTopK() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.TopK)
-	}
-	return offset
-}
-
-func (p *ModelConfig) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTopP() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.TopP)
-	}
-	return offset
-}
-
-func (p *ModelConfig) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPresencePenalty() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.PresencePenalty)
-	}
-	return offset
-}
-
-func (p *ModelConfig) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetFrequencyPenalty() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 7)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.FrequencyPenalty)
-	}
-	return offset
-}
-
-func (p *ModelConfig) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetJSONMode() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 8)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.JSONMode)
+		l += p.field4Length()
 	}
-	return offset
+	l += thrift.Binary.FieldStopLength()
+	return l
 }
 
-func (p *ModelConfig) field1Length() int {
-	l := 0
-	if p.IsSetModelID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+func (p *VariableDef) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
 	}
-	return l
+	return offset
 }
 
-func (p *ModelConfig) field2Length() int {
-	l := 0
-	if p.IsSetMaxTokens() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+func (p *VariableDef) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDesc() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Desc)
 	}
-	return l
+	return offset
 }
 
-func (p *ModelConfig) field3Length() int {
-	l := 0
-	if p.IsSetTemperature() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+func (p *VariableDef) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
 	}
-	return l
+	return offset
 }
 
-func (p *ModelConfig) field4Length() int {
-	l := 0
-	if p.IsSetTopK() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+func (p *VariableDef) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTypeTags() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.TypeTags {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
 	}
-	return l
+	return offset
 }
 
-func (p *ModelConfig) field5Length() int {
+func (p *VariableDef) field1Length() int {
 	l := 0
-	if p.IsSetTopP() {
+	if p.IsSetKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Key)
 	}
 	return l
 }
 
-func (p *ModelConfig) field6Length() int {
+func (p *VariableDef) field2Length() int {
 	l := 0
-	if p.IsSetPresencePenalty() {
+	if p.IsSetDesc() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Desc)
 	}
 	return l
 }
 
-func (p *ModelConfig) field7Length() int {
+func (p *VariableDef) field3Length() int {
 	l := 0
-	if p.IsSetFrequencyPenalty() {
+	if p.IsSetType() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Type)
 	}
 	return l
 }
 
-func (p *ModelConfig) field8Length() int {
+func (p *VariableDef) field4Length() int {
 	l := 0
-	if p.IsSetJSONMode() {
+	if p.IsSetTypeTags() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.TypeTags {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
 	}
 	return l
 }
 
-func (p *ModelConfig) DeepCopy(s interface{}) error {
-	src, ok := s.(*ModelConfig)
+func (p *VariableDef) DeepCopy(s interface{}) error {
+	src, ok := s.(*VariableDef)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ModelID != nil {
-		tmp := *src.ModelID
-		p.ModelID = &tmp
-	}
-
-	if src.MaxTokens != nil {
-		tmp := *src.MaxTokens
-		p.MaxTokens = &tmp
-	}
-
-	if src.Temperature != nil {
-		tmp := *src.Temperature
-		p.Temperature = &tmp
-	}
-
-	if src.TopK != nil {
-		tmp := *src.TopK
-		p.TopK = &tmp
-	}
-
-	if src.TopP != nil {
-		tmp := *src.TopP
-		p.TopP = &tmp
+	if src.Key != nil {
+		var tmp string
+		if *src.Key != "" {
+			tmp = kutils.StringDeepCopy(*src.Key)
+		}
+		p.Key = &tmp
 	}
 
-	if src.PresencePenalty != nil {
-		tmp := *src.PresencePenalty
-		p.PresencePenalty = &tmp
+	if src.Desc != nil {
+		var tmp string
+		if *src.Desc != "" {
+			tmp = kutils.StringDeepCopy(*src.Desc)
+		}
+		p.Desc = &tmp
 	}
 
-	if src.FrequencyPenalty != nil {
-		tmp := *src.FrequencyPenalty
-		p.FrequencyPenalty = &tmp
+	if src.Type != nil {
+		tmp := *src.Type
+		p.Type = &tmp
 	}
 
-	if src.JSONMode != nil {
-		tmp := *src.JSONMode
-		p.JSONMode = &tmp
+	if src.TypeTags != nil {
+		p.TypeTags = make([]string, 0, len(src.TypeTags))
+		for _, elem := range src.TypeTags {
+			var _elem string
+			if elem != "" {
+				_elem = kutils.StringDeepCopy(elem)
+			}
+			p.TypeTags = append(p.TypeTags, _elem)
+		}
 	}
 
 	return nil
 }
 
-func (p *Message) FastRead(buf []byte) (int, error) {
+func (p *VariableVal) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3692,50 +6549,8 @@ func (p *Message) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
 			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField6(buf[offset:])
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -3760,79 +6575,26 @@ func (p *Message) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Message[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableVal[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Message) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *Role
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Role = _field
-	return offset, nil
-}
-
-func (p *Message) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ReasoningContent = _field
-	return offset, nil
-}
-
-func (p *Message) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Content = _field
-	return offset, nil
-}
-
-func (p *Message) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*ContentPart, 0, size)
-	values := make([]ContentPart, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+func (p *VariableVal) FastReadField1(buf []byte) (int, error) {
+	offset := 0
 
-		_field = append(_field, _elem)
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.Parts = _field
+	p.Key = _field
 	return offset, nil
 }
 
-func (p *Message) FastReadField5(buf []byte) (int, error) {
+func (p *VariableVal) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -3842,11 +6604,11 @@ func (p *Message) FastReadField5(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.ToolCallID = _field
+	p.Value = _field
 	return offset, nil
 }
 
-func (p *Message) FastReadField6(buf []byte) (int, error) {
+func (p *VariableVal) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -3854,8 +6616,8 @@ func (p *Message) FastReadField6(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*ToolCall, 0, size)
-	values := make([]ToolCall, size)
+	_field := make([]*Message, 0, size)
+	values := make([]Message, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -3867,102 +6629,62 @@ func (p *Message) FastReadField6(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.ToolCalls = _field
+	p.PlaceholderMessages = _field
 	return offset, nil
 }
 
-func (p *Message) FastWrite(buf []byte) int {
+func (p *VariableVal) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *Message) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableVal) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *Message) BLength() int {
+func (p *VariableVal) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *Message) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableVal) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetRole() {
+	if p.IsSetKey() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Role)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
 	}
 	return offset
 }
 
-func (p *Message) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableVal) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetReasoningContent() {
+	if p.IsSetValue() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ReasoningContent)
-	}
-	return offset
-}
-
-func (p *Message) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetContent() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Content)
-	}
-	return offset
-}
-
-func (p *Message) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetParts() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Parts {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *Message) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetToolCallID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ToolCallID)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Value)
 	}
 	return offset
 }
 
-func (p *Message) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableVal) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetToolCalls() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
+	if p.IsSetPlaceholderMessages() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.ToolCalls {
+		for _, v := range p.PlaceholderMessages {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -3971,61 +6693,30 @@ func (p *Message) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
-func (p *Message) field1Length() int {
-	l := 0
-	if p.IsSetRole() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Role)
-	}
-	return l
-}
-
-func (p *Message) field2Length() int {
-	l := 0
-	if p.IsSetReasoningContent() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ReasoningContent)
-	}
-	return l
-}
-
-func (p *Message) field3Length() int {
-	l := 0
-	if p.IsSetContent() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Content)
-	}
-	return l
-}
-
-func (p *Message) field4Length() int {
+func (p *VariableVal) field1Length() int {
 	l := 0
-	if p.IsSetParts() {
+	if p.IsSetKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Parts {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.Key)
 	}
 	return l
 }
 
-func (p *Message) field5Length() int {
+func (p *VariableVal) field2Length() int {
 	l := 0
-	if p.IsSetToolCallID() {
+	if p.IsSetValue() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ToolCallID)
+		l += thrift.Binary.StringLengthNocopy(*p.Value)
 	}
 	return l
 }
 
-func (p *Message) field6Length() int {
+func (p *VariableVal) field3Length() int {
 	l := 0
-	if p.IsSetToolCalls() {
+	if p.IsSetPlaceholderMessages() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ToolCalls {
+		for _, v := range p.PlaceholderMessages {
 			_ = v
 			l += v.BLength()
 		}
@@ -4033,75 +6724,47 @@ func (p *Message) field6Length() int {
 	return l
 }
 
-func (p *Message) DeepCopy(s interface{}) error {
-	src, ok := s.(*Message)
+func (p *VariableVal) DeepCopy(s interface{}) error {
+	src, ok := s.(*VariableVal)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Role != nil {
-		tmp := *src.Role
-		p.Role = &tmp
-	}
-
-	if src.ReasoningContent != nil {
-		var tmp string
-		if *src.ReasoningContent != "" {
-			tmp = kutils.StringDeepCopy(*src.ReasoningContent)
-		}
-		p.ReasoningContent = &tmp
-	}
-
-	if src.Content != nil {
+	if src.Key != nil {
 		var tmp string
-		if *src.Content != "" {
-			tmp = kutils.StringDeepCopy(*src.Content)
-		}
-		p.Content = &tmp
-	}
-
-	if src.Parts != nil {
-		p.Parts = make([]*ContentPart, 0, len(src.Parts))
-		for _, elem := range src.Parts {
-			var _elem *ContentPart
-			if elem != nil {
-				_elem = &ContentPart{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Parts = append(p.Parts, _elem)
+		if *src.Key != "" {
+			tmp = kutils.StringDeepCopy(*src.Key)
 		}
+		p.Key = &tmp
 	}
 
-	if src.ToolCallID != nil {
+	if src.Value != nil {
 		var tmp string
-		if *src.ToolCallID != "" {
-			tmp = kutils.StringDeepCopy(*src.ToolCallID)
+		if *src.Value != "" {
+			tmp = kutils.StringDeepCopy(*src.Value)
 		}
-		p.ToolCallID = &tmp
+		p.Value = &tmp
 	}
 
-	if src.ToolCalls != nil {
-		p.ToolCalls = make([]*ToolCall, 0, len(src.ToolCalls))
-		for _, elem := range src.ToolCalls {
-			var _elem *ToolCall
+	if src.PlaceholderMessages != nil {
+		p.PlaceholderMessages = make([]*Message, 0, len(src.PlaceholderMessages))
+		for _, elem := range src.PlaceholderMessages {
+			var _elem *Message
 			if elem != nil {
-				_elem = &ToolCall{}
+				_elem = &Message{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.ToolCalls = append(p.ToolCalls, _elem)
+			p.PlaceholderMessages = append(p.PlaceholderMessages, _elem)
 		}
 	}
 
 	return nil
 }
 
-func (p *ContentPart) FastRead(buf []byte) (int, error) {
+func (p *PromptDetailDiff) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4119,7 +6782,7 @@ func (p *ContentPart) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4133,7 +6796,7 @@ func (p *ContentPart) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4147,7 +6810,7 @@ func (p *ContentPart) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4160,176 +6823,437 @@ func (p *ContentPart) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDetailDiff[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptDetailDiff) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*FieldDiff, 0, size)
+	values := make([]FieldDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
 		}
+
+		_field = append(_field, _elem)
+	}
+	p.FieldDiffs = _field
+	return offset, nil
+}
+
+func (p *PromptDetailDiff) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
 	}
+	_field := make([]*MessageDiff, 0, size)
+	values := make([]MessageDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
 
+		_field = append(_field, _elem)
+	}
+	p.MessageDiffs = _field
 	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentPart[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ContentPart) FastReadField1(buf []byte) (int, error) {
+func (p *PromptDetailDiff) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *ContentType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.Type = _field
+	_field := make([]*VariableDefDiff, 0, size)
+	values := make([]VariableDefDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.VariableDefDiffs = _field
 	return offset, nil
 }
 
-func (p *ContentPart) FastReadField2(buf []byte) (int, error) {
+func (p *PromptDetailDiff) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.Text = _field
+	_field := make([]*ToolDiff, 0, size)
+	values := make([]ToolDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ToolDiffs = _field
 	return offset, nil
 }
 
-func (p *ContentPart) FastReadField3(buf []byte) (int, error) {
+func (p *PromptDetailDiff) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	_field := NewImageURL()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
 	}
-	p.ImageURL = _field
+	_field := make([]*FieldDiff, 0, size)
+	values := make([]FieldDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ModelConfigDiffs = _field
 	return offset, nil
 }
 
-func (p *ContentPart) FastWrite(buf []byte) int {
+func (p *PromptDetailDiff) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ContentPart) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptDetailDiff) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ContentPart) BLength() int {
+func (p *PromptDetailDiff) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ContentPart) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptDetailDiff) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	if p.IsSetFieldDiffs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FieldDiffs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ContentPart) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptDetailDiff) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetText() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Text)
+	if p.IsSetMessageDiffs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.MessageDiffs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ContentPart) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptDetailDiff) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetImageURL() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-		offset += p.ImageURL.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetVariableDefDiffs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.VariableDefDiffs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ContentPart) field1Length() int {
+func (p *PromptDetailDiff) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetToolDiffs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ToolDiffs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *PromptDetailDiff) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetModelConfigDiffs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ModelConfigDiffs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *PromptDetailDiff) field1Length() int {
 	l := 0
-	if p.IsSetType() {
+	if p.IsSetFieldDiffs() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Type)
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FieldDiffs {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ContentPart) field2Length() int {
+func (p *PromptDetailDiff) field2Length() int {
 	l := 0
-	if p.IsSetText() {
+	if p.IsSetMessageDiffs() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Text)
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.MessageDiffs {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ContentPart) field3Length() int {
+func (p *PromptDetailDiff) field3Length() int {
 	l := 0
-	if p.IsSetImageURL() {
+	if p.IsSetVariableDefDiffs() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.ImageURL.BLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.VariableDefDiffs {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ContentPart) DeepCopy(s interface{}) error {
-	src, ok := s.(*ContentPart)
+func (p *PromptDetailDiff) field4Length() int {
+	l := 0
+	if p.IsSetToolDiffs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ToolDiffs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *PromptDetailDiff) field5Length() int {
+	l := 0
+	if p.IsSetModelConfigDiffs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ModelConfigDiffs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *PromptDetailDiff) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptDetailDiff)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Type != nil {
-		tmp := *src.Type
-		p.Type = &tmp
+	if src.FieldDiffs != nil {
+		p.FieldDiffs = make([]*FieldDiff, 0, len(src.FieldDiffs))
+		for _, elem := range src.FieldDiffs {
+			var _elem *FieldDiff
+			if elem != nil {
+				_elem = &FieldDiff{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FieldDiffs = append(p.FieldDiffs, _elem)
+		}
 	}
 
-	if src.Text != nil {
-		var tmp string
-		if *src.Text != "" {
-			tmp = kutils.StringDeepCopy(*src.Text)
+	if src.MessageDiffs != nil {
+		p.MessageDiffs = make([]*MessageDiff, 0, len(src.MessageDiffs))
+		for _, elem := range src.MessageDiffs {
+			var _elem *MessageDiff
+			if elem != nil {
+				_elem = &MessageDiff{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.MessageDiffs = append(p.MessageDiffs, _elem)
 		}
-		p.Text = &tmp
 	}
 
-	var _imageURL *ImageURL
-	if src.ImageURL != nil {
-		_imageURL = &ImageURL{}
-		if err := _imageURL.DeepCopy(src.ImageURL); err != nil {
-			return err
+	if src.VariableDefDiffs != nil {
+		p.VariableDefDiffs = make([]*VariableDefDiff, 0, len(src.VariableDefDiffs))
+		for _, elem := range src.VariableDefDiffs {
+			var _elem *VariableDefDiff
+			if elem != nil {
+				_elem = &VariableDefDiff{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.VariableDefDiffs = append(p.VariableDefDiffs, _elem)
+		}
+	}
+
+	if src.ToolDiffs != nil {
+		p.ToolDiffs = make([]*ToolDiff, 0, len(src.ToolDiffs))
+		for _, elem := range src.ToolDiffs {
+			var _elem *ToolDiff
+			if elem != nil {
+				_elem = &ToolDiff{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ToolDiffs = append(p.ToolDiffs, _elem)
+		}
+	}
+
+	if src.ModelConfigDiffs != nil {
+		p.ModelConfigDiffs = make([]*FieldDiff, 0, len(src.ModelConfigDiffs))
+		for _, elem := range src.ModelConfigDiffs {
+			var _elem *FieldDiff
+			if elem != nil {
+				_elem = &FieldDiff{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ModelConfigDiffs = append(p.ModelConfigDiffs, _elem)
 		}
 	}
-	p.ImageURL = _imageURL
 
 	return nil
 }
 
-func (p *ImageURL) FastRead(buf []byte) (int, error) {
+func (p *MessageDiff) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4361,7 +7285,7 @@ func (p *ImageURL) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4374,6 +7298,62 @@ func (p *ImageURL) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -4387,125 +7367,311 @@ func (p *ImageURL) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageURL[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageDiff[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ImageURL) FastReadField1(buf []byte) (int, error) {
+func (p *MessageDiff) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
+	var _field *DiffType
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.URI = _field
+	p.DiffType = _field
 	return offset, nil
 }
 
-func (p *ImageURL) FastReadField2(buf []byte) (int, error) {
+func (p *MessageDiff) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.URL = _field
+	p.BaseIndex = _field
 	return offset, nil
 }
 
-func (p *ImageURL) FastWrite(buf []byte) int {
+func (p *MessageDiff) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TargetIndex = _field
+	return offset, nil
+}
+
+func (p *MessageDiff) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewMessage()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseMessage = _field
+	return offset, nil
+}
+
+func (p *MessageDiff) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+	_field := NewMessage()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.TargetMessage = _field
+	return offset, nil
+}
+
+func (p *MessageDiff) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*TextDiffLine, 0, size)
+	values := make([]TextDiffLine, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ContentDiff = _field
+	return offset, nil
+}
+
+func (p *MessageDiff) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ImageURL) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageDiff) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ImageURL) BLength() int {
+func (p *MessageDiff) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ImageURL) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageDiff) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetURI() {
+	if p.IsSetDiffType() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URI)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.DiffType)
 	}
 	return offset
 }
 
-func (p *ImageURL) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageDiff) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetURL() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URL)
+	if p.IsSetBaseIndex() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.BaseIndex)
 	}
 	return offset
 }
 
-func (p *ImageURL) field1Length() int {
+func (p *MessageDiff) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetIndex() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.TargetIndex)
+	}
+	return offset
+}
+
+func (p *MessageDiff) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseMessage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.BaseMessage.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *MessageDiff) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetMessage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
+		offset += p.TargetMessage.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *MessageDiff) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetContentDiff() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ContentDiff {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *MessageDiff) field1Length() int {
 	l := 0
-	if p.IsSetURI() {
+	if p.IsSetDiffType() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.URI)
+		l += thrift.Binary.StringLengthNocopy(*p.DiffType)
 	}
 	return l
 }
 
-func (p *ImageURL) field2Length() int {
+func (p *MessageDiff) field2Length() int {
 	l := 0
-	if p.IsSetURL() {
+	if p.IsSetBaseIndex() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.URL)
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *ImageURL) DeepCopy(s interface{}) error {
-	src, ok := s.(*ImageURL)
+func (p *MessageDiff) field3Length() int {
+	l := 0
+	if p.IsSetTargetIndex() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *MessageDiff) field4Length() int {
+	l := 0
+	if p.IsSetBaseMessage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseMessage.BLength()
+	}
+	return l
+}
+
+func (p *MessageDiff) field5Length() int {
+	l := 0
+	if p.IsSetTargetMessage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.TargetMessage.BLength()
+	}
+	return l
+}
+
+func (p *MessageDiff) field6Length() int {
+	l := 0
+	if p.IsSetContentDiff() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ContentDiff {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *MessageDiff) DeepCopy(s interface{}) error {
+	src, ok := s.(*MessageDiff)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.URI != nil {
-		var tmp string
-		if *src.URI != "" {
-			tmp = kutils.StringDeepCopy(*src.URI)
+	if src.DiffType != nil {
+		tmp := *src.DiffType
+		p.DiffType = &tmp
+	}
+
+	if src.BaseIndex != nil {
+		tmp := *src.BaseIndex
+		p.BaseIndex = &tmp
+	}
+
+	if src.TargetIndex != nil {
+		tmp := *src.TargetIndex
+		p.TargetIndex = &tmp
+	}
+
+	var _baseMessage *Message
+	if src.BaseMessage != nil {
+		_baseMessage = &Message{}
+		if err := _baseMessage.DeepCopy(src.BaseMessage); err != nil {
+			return err
 		}
-		p.URI = &tmp
 	}
+	p.BaseMessage = _baseMessage
 
-	if src.URL != nil {
-		var tmp string
-		if *src.URL != "" {
-			tmp = kutils.StringDeepCopy(*src.URL)
+	var _targetMessage *Message
+	if src.TargetMessage != nil {
+		_targetMessage = &Message{}
+		if err := _targetMessage.DeepCopy(src.TargetMessage); err != nil {
+			return err
+		}
+	}
+	p.TargetMessage = _targetMessage
+
+	if src.ContentDiff != nil {
+		p.ContentDiff = make([]*TextDiffLine, 0, len(src.ContentDiff))
+		for _, elem := range src.ContentDiff {
+			var _elem *TextDiffLine
+			if elem != nil {
+				_elem = &TextDiffLine{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ContentDiff = append(p.ContentDiff, _elem)
 		}
-		p.URL = &tmp
 	}
 
 	return nil
 }
 
-func (p *ToolCall) FastRead(buf []byte) (int, error) {
+func (p *TextDiffLine) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4522,23 +7688,9 @@ func (p *ToolCall) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
+		case 1:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField2(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -4550,23 +7702,9 @@ func (p *ToolCall) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
+		case 2:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField4(buf[offset:])
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -4591,202 +7729,122 @@ func (p *ToolCall) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCall[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TextDiffLine[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ToolCall) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Index = _field
-	return offset, nil
-}
-
-func (p *ToolCall) FastReadField2(buf []byte) (int, error) {
+func (p *TextDiffLine) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
+	var _field *TextDiffOperation
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.ID = _field
+	p.Operation = _field
 	return offset, nil
 }
 
-func (p *ToolCall) FastReadField3(buf []byte) (int, error) {
+func (p *TextDiffLine) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *ToolType
+	var _field *string
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Type = _field
-	return offset, nil
-}
-
-func (p *ToolCall) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-	_field := NewFunctionCall()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.FunctionCall = _field
+	p.Text = _field
 	return offset, nil
 }
 
-func (p *ToolCall) FastWrite(buf []byte) int {
+func (p *TextDiffLine) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ToolCall) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TextDiffLine) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ToolCall) BLength() int {
+func (p *TextDiffLine) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ToolCall) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TextDiffLine) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetIndex() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.Index)
+	if p.IsSetOperation() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Operation)
 	}
 	return offset
 }
 
-func (p *ToolCall) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *TextDiffLine) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetID() {
+	if p.IsSetText() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ID)
-	}
-	return offset
-}
-
-func (p *ToolCall) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
-	}
-	return offset
-}
-
-func (p *ToolCall) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetFunctionCall() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
-		offset += p.FunctionCall.FastWriteNocopy(buf[offset:], w)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Text)
 	}
 	return offset
 }
 
-func (p *ToolCall) field1Length() int {
-	l := 0
-	if p.IsSetIndex() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *ToolCall) field2Length() int {
-	l := 0
-	if p.IsSetID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ID)
-	}
-	return l
-}
-
-func (p *ToolCall) field3Length() int {
+func (p *TextDiffLine) field1Length() int {
 	l := 0
-	if p.IsSetType() {
+	if p.IsSetOperation() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Type)
+		l += thrift.Binary.StringLengthNocopy(*p.Operation)
 	}
 	return l
 }
 
-func (p *ToolCall) field4Length() int {
+func (p *TextDiffLine) field2Length() int {
 	l := 0
-	if p.IsSetFunctionCall() {
+	if p.IsSetText() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.FunctionCall.BLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Text)
 	}
 	return l
 }
 
-func (p *ToolCall) DeepCopy(s interface{}) error {
-	src, ok := s.(*ToolCall)
+func (p *TextDiffLine) DeepCopy(s interface{}) error {
+	src, ok := s.(*TextDiffLine)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Index != nil {
-		tmp := *src.Index
-		p.Index = &tmp
+	if src.Operation != nil {
+		tmp := *src.Operation
+		p.Operation = &tmp
 	}
 
-	if src.ID != nil {
+	if src.Text != nil {
 		var tmp string
-		if *src.ID != "" {
-			tmp = kutils.StringDeepCopy(*src.ID)
-		}
-		p.ID = &tmp
-	}
-
-	if src.Type != nil {
-		tmp := *src.Type
-		p.Type = &tmp
-	}
-
-	var _functionCall *FunctionCall
-	if src.FunctionCall != nil {
-		_functionCall = &FunctionCall{}
-		if err := _functionCall.DeepCopy(src.FunctionCall); err != nil {
-			return err
+		if *src.Text != "" {
+			tmp = kutils.StringDeepCopy(*src.Text)
 		}
+		p.Text = &tmp
 	}
-	p.FunctionCall = _functionCall
 
 	return nil
 }
 
-func (p *FunctionCall) FastRead(buf []byte) (int, error) {
+func (p *VariableDefDiff) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4831,6 +7889,34 @@ func (p *FunctionCall) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -4844,26 +7930,26 @@ func (p *FunctionCall) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FunctionCall[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableDefDiff[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *FunctionCall) FastReadField1(buf []byte) (int, error) {
+func (p *VariableDefDiff) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
+	var _field *DiffType
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Name = _field
+	p.DiffType = _field
 	return offset, nil
 }
 
-func (p *FunctionCall) FastReadField2(buf []byte) (int, error) {
+func (p *VariableDefDiff) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -4873,96 +7959,175 @@ func (p *FunctionCall) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Arguments = _field
+	p.Key = _field
 	return offset, nil
 }
 
-func (p *FunctionCall) FastWrite(buf []byte) int {
+func (p *VariableDefDiff) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := NewVariableDef()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseDef = _field
+	return offset, nil
+}
+
+func (p *VariableDefDiff) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewVariableDef()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.TargetDef = _field
+	return offset, nil
+}
+
+func (p *VariableDefDiff) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *FunctionCall) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableDefDiff) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *FunctionCall) BLength() int {
+func (p *VariableDefDiff) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *FunctionCall) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableDefDiff) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
+	if p.IsSetDiffType() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.DiffType)
 	}
 	return offset
 }
 
-func (p *FunctionCall) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableDefDiff) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetArguments() {
+	if p.IsSetKey() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Arguments)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
 	}
 	return offset
 }
 
-func (p *FunctionCall) field1Length() int {
+func (p *VariableDefDiff) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseDef() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.BaseDef.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *VariableDefDiff) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetDef() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.TargetDef.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *VariableDefDiff) field1Length() int {
 	l := 0
-	if p.IsSetName() {
+	if p.IsSetDiffType() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+		l += thrift.Binary.StringLengthNocopy(*p.DiffType)
 	}
 	return l
 }
 
-func (p *FunctionCall) field2Length() int {
+func (p *VariableDefDiff) field2Length() int {
 	l := 0
-	if p.IsSetArguments() {
+	if p.IsSetKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Arguments)
+		l += thrift.Binary.StringLengthNocopy(*p.Key)
 	}
 	return l
 }
 
-func (p *FunctionCall) DeepCopy(s interface{}) error {
-	src, ok := s.(*FunctionCall)
+func (p *VariableDefDiff) field3Length() int {
+	l := 0
+	if p.IsSetBaseDef() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseDef.BLength()
+	}
+	return l
+}
+
+func (p *VariableDefDiff) field4Length() int {
+	l := 0
+	if p.IsSetTargetDef() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.TargetDef.BLength()
+	}
+	return l
+}
+
+func (p *VariableDefDiff) DeepCopy(s interface{}) error {
+	src, ok := s.(*VariableDefDiff)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Name != nil {
-		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
+	if src.DiffType != nil {
+		tmp := *src.DiffType
+		p.DiffType = &tmp
+	}
+
+	if src.Key != nil {
+		var tmp string
+		if *src.Key != "" {
+			tmp = kutils.StringDeepCopy(*src.Key)
+		}
+		p.Key = &tmp
+	}
+
+	var _baseDef *VariableDef
+	if src.BaseDef != nil {
+		_baseDef = &VariableDef{}
+		if err := _baseDef.DeepCopy(src.BaseDef); err != nil {
+			return err
 		}
-		p.Name = &tmp
 	}
+	p.BaseDef = _baseDef
 
-	if src.Arguments != nil {
-		var tmp string
-		if *src.Arguments != "" {
-			tmp = kutils.StringDeepCopy(*src.Arguments)
+	var _targetDef *VariableDef
+	if src.TargetDef != nil {
+		_targetDef = &VariableDef{}
+		if err := _targetDef.DeepCopy(src.TargetDef); err != nil {
+			return err
 		}
-		p.Arguments = &tmp
 	}
+	p.TargetDef = _targetDef
 
 	return nil
 }
 
-func (p *VariableDef) FastRead(buf []byte) (int, error) {
+func (p *ToolDiff) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5008,7 +8173,7 @@ func (p *VariableDef) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5022,7 +8187,7 @@ func (p *VariableDef) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5048,26 +8213,26 @@ func (p *VariableDef) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableDef[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolDiff[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VariableDef) FastReadField1(buf []byte) (int, error) {
+func (p *ToolDiff) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
+	var _field *DiffType
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Key = _field
+	p.DiffType = _field
 	return offset, nil
 }
 
-func (p *VariableDef) FastReadField2(buf []byte) (int, error) {
+func (p *ToolDiff) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -5077,53 +8242,39 @@ func (p *VariableDef) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Desc = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *VariableDef) FastReadField3(buf []byte) (int, error) {
+func (p *ToolDiff) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *VariableType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewTool()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Type = _field
+	p.BaseTool = _field
 	return offset, nil
 }
 
-func (p *VariableDef) FastReadField4(buf []byte) (int, error) {
+func (p *ToolDiff) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	_field := NewTool()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
 	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.TypeTags = _field
+	p.TargetTool = _field
 	return offset, nil
 }
 
-func (p *VariableDef) FastWrite(buf []byte) int {
+func (p *ToolDiff) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VariableDef) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ToolDiff) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5135,7 +8286,7 @@ func (p *VariableDef) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
-func (p *VariableDef) BLength() int {
+func (p *ToolDiff) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5147,131 +8298,119 @@ func (p *VariableDef) BLength() int {
 	return l
 }
 
-func (p *VariableDef) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ToolDiff) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetKey() {
+	if p.IsSetDiffType() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.DiffType)
 	}
 	return offset
 }
 
-func (p *VariableDef) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ToolDiff) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDesc() {
+	if p.IsSetName() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Desc)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
 	}
 	return offset
 }
 
-func (p *VariableDef) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ToolDiff) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	if p.IsSetBaseTool() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.BaseTool.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *VariableDef) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ToolDiff) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTypeTags() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.TypeTags {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	if p.IsSetTargetTool() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.TargetTool.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *VariableDef) field1Length() int {
+func (p *ToolDiff) field1Length() int {
 	l := 0
-	if p.IsSetKey() {
+	if p.IsSetDiffType() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Key)
+		l += thrift.Binary.StringLengthNocopy(*p.DiffType)
 	}
 	return l
 }
 
-func (p *VariableDef) field2Length() int {
+func (p *ToolDiff) field2Length() int {
 	l := 0
-	if p.IsSetDesc() {
+	if p.IsSetName() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Desc)
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
 	return l
 }
 
-func (p *VariableDef) field3Length() int {
+func (p *ToolDiff) field3Length() int {
 	l := 0
-	if p.IsSetType() {
+	if p.IsSetBaseTool() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Type)
+		l += p.BaseTool.BLength()
 	}
 	return l
 }
 
-func (p *VariableDef) field4Length() int {
+func (p *ToolDiff) field4Length() int {
 	l := 0
-	if p.IsSetTypeTags() {
+	if p.IsSetTargetTool() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.TypeTags {
-			_ = v
-			l += thrift.Binary.StringLengthNocopy(v)
-		}
+		l += p.TargetTool.BLength()
 	}
 	return l
 }
 
-func (p *VariableDef) DeepCopy(s interface{}) error {
-	src, ok := s.(*VariableDef)
+func (p *ToolDiff) DeepCopy(s interface{}) error {
+	src, ok := s.(*ToolDiff)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Key != nil {
-		var tmp string
-		if *src.Key != "" {
-			tmp = kutils.StringDeepCopy(*src.Key)
-		}
-		p.Key = &tmp
+	if src.DiffType != nil {
+		tmp := *src.DiffType
+		p.DiffType = &tmp
 	}
 
-	if src.Desc != nil {
+	if src.Name != nil {
 		var tmp string
-		if *src.Desc != "" {
-			tmp = kutils.StringDeepCopy(*src.Desc)
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
 		}
-		p.Desc = &tmp
+		p.Name = &tmp
 	}
 
-	if src.Type != nil {
-		tmp := *src.Type
-		p.Type = &tmp
+	var _baseTool *Tool
+	if src.BaseTool != nil {
+		_baseTool = &Tool{}
+		if err := _baseTool.DeepCopy(src.BaseTool); err != nil {
+			return err
+		}
 	}
+	p.BaseTool = _baseTool
 
-	if src.TypeTags != nil {
-		p.TypeTags = make([]string, 0, len(src.TypeTags))
-		for _, elem := range src.TypeTags {
-			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
-			p.TypeTags = append(p.TypeTags, _elem)
+	var _targetTool *Tool
+	if src.TargetTool != nil {
+		_targetTool = &Tool{}
+		if err := _targetTool.DeepCopy(src.TargetTool); err != nil {
+			return err
 		}
 	}
+	p.TargetTool = _targetTool
 
 	return nil
 }
 
-func (p *VariableVal) FastRead(buf []byte) (int, error) {
+func (p *FieldDiff) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5317,7 +8456,7 @@ func (p *VariableVal) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5330,6 +8469,20 @@ func (p *VariableVal) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5343,26 +8496,26 @@ func (p *VariableVal) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableVal[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FieldDiff[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VariableVal) FastReadField1(buf []byte) (int, error) {
+func (p *FieldDiff) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
+	var _field *DiffType
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Key = _field
+	p.DiffType = _field
 	return offset, nil
 }
 
-func (p *VariableVal) FastReadField2(buf []byte) (int, error) {
+func (p *FieldDiff) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -5372,161 +8525,171 @@ func (p *VariableVal) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Value = _field
+	p.Field = _field
 	return offset, nil
 }
 
-func (p *VariableVal) FastReadField3(buf []byte) (int, error) {
+func (p *FieldDiff) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*Message, 0, size)
-	values := make([]Message, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.BaseValue = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *FieldDiff) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.PlaceholderMessages = _field
+	p.TargetValue = _field
 	return offset, nil
 }
 
-func (p *VariableVal) FastWrite(buf []byte) int {
+func (p *FieldDiff) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VariableVal) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *FieldDiff) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *VariableVal) BLength() int {
+func (p *FieldDiff) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VariableVal) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *FieldDiff) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetKey() {
+	if p.IsSetDiffType() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.DiffType)
 	}
 	return offset
 }
 
-func (p *VariableVal) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *FieldDiff) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetValue() {
+	if p.IsSetField() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Value)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Field)
 	}
 	return offset
 }
 
-func (p *VariableVal) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *FieldDiff) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPlaceholderMessages() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.PlaceholderMessages {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetBaseValue() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BaseValue)
 	}
 	return offset
 }
 
-func (p *VariableVal) field1Length() int {
+func (p *FieldDiff) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetValue() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TargetValue)
+	}
+	return offset
+}
+
+func (p *FieldDiff) field1Length() int {
 	l := 0
-	if p.IsSetKey() {
+	if p.IsSetDiffType() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Key)
+		l += thrift.Binary.StringLengthNocopy(*p.DiffType)
 	}
 	return l
 }
 
-func (p *VariableVal) field2Length() int {
+func (p *FieldDiff) field2Length() int {
 	l := 0
-	if p.IsSetValue() {
+	if p.IsSetField() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Value)
+		l += thrift.Binary.StringLengthNocopy(*p.Field)
 	}
 	return l
 }
 
-func (p *VariableVal) field3Length() int {
+func (p *FieldDiff) field3Length() int {
 	l := 0
-	if p.IsSetPlaceholderMessages() {
+	if p.IsSetBaseValue() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.PlaceholderMessages {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.BaseValue)
 	}
 	return l
 }
 
-func (p *VariableVal) DeepCopy(s interface{}) error {
-	src, ok := s.(*VariableVal)
+func (p *FieldDiff) field4Length() int {
+	l := 0
+	if p.IsSetTargetValue() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.TargetValue)
+	}
+	return l
+}
+
+func (p *FieldDiff) DeepCopy(s interface{}) error {
+	src, ok := s.(*FieldDiff)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Key != nil {
+	if src.DiffType != nil {
+		tmp := *src.DiffType
+		p.DiffType = &tmp
+	}
+
+	if src.Field != nil {
 		var tmp string
-		if *src.Key != "" {
-			tmp = kutils.StringDeepCopy(*src.Key)
+		if *src.Field != "" {
+			tmp = kutils.StringDeepCopy(*src.Field)
 		}
-		p.Key = &tmp
+		p.Field = &tmp
 	}
 
-	if src.Value != nil {
+	if src.BaseValue != nil {
 		var tmp string
-		if *src.Value != "" {
-			tmp = kutils.StringDeepCopy(*src.Value)
+		if *src.BaseValue != "" {
+			tmp = kutils.StringDeepCopy(*src.BaseValue)
 		}
-		p.Value = &tmp
+		p.BaseValue = &tmp
 	}
 
-	if src.PlaceholderMessages != nil {
-		p.PlaceholderMessages = make([]*Message, 0, len(src.PlaceholderMessages))
-		for _, elem := range src.PlaceholderMessages {
-			var _elem *Message
-			if elem != nil {
-				_elem = &Message{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.PlaceholderMessages = append(p.PlaceholderMessages, _elem)
+	if src.TargetValue != nil {
+		var tmp string
+		if *src.TargetValue != "" {
+			tmp = kutils.StringDeepCopy(*src.TargetValue)
 		}
+		p.TargetValue = &tmp
 	}
 
 	return nil
@@ -9,6 +9,14 @@ import (
 )
 
 const (
+	PromptTypeNormal = "normal"
+
+	PromptTypeSnippet = "snippet"
+
+	PromptLabelOperationSet = "set"
+
+	PromptLabelOperationRollback = "rollback"
+
 	TemplateTypeNormal = "normal"
 
 	TemplateTypeJinja2 = "jinja2"
@@ -55,11 +63,27 @@ const (
 
 	VariableTypePlaceholder = "placeholder"
 
+	DiffTypeAdded = "added"
+
+	DiffTypeRemoved = "removed"
+
+	DiffTypeModified = "modified"
+
+	TextDiffOperationEqual = "equal"
+
+	TextDiffOperationInsert = "insert"
+
+	TextDiffOperationDelete = "delete"
+
 	ScenarioDefault = "default"
 
 	ScenarioEvalTarget = "eval_target"
 )
 
+type PromptType = string
+
+type PromptLabelOperation = string
+
 type TemplateType = string
 
 type ToolType = string
@@ -72,6 +96,10 @@ type ContentType = string
 
 type VariableType = string
 
+type DiffType = string
+
+type TextDiffOperation = string
+
 type Scenario = string
 
 type Prompt struct {
@@ -617,14 +645,15 @@ func (p *Prompt) Field6DeepEqual(src *PromptCommit) bool {
 }
 
 type PromptBasic struct {
-	DisplayName       *string `thrift:"display_name,1,optional" frugal:"1,optional,string" form:"display_name" json:"display_name,omitempty" query:"display_name"`
-	Description       *string `thrift:"description,2,optional" frugal:"2,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	LatestVersion     *string `thrift:"latest_version,3,optional" frugal:"3,optional,string" form:"latest_version" json:"latest_version,omitempty" query:"latest_version"`
-	CreatedBy         *string `thrift:"created_by,4,optional" frugal:"4,optional,string" form:"created_by" json:"created_by,omitempty" query:"created_by"`
-	UpdatedBy         *string `thrift:"updated_by,5,optional" frugal:"5,optional,string" form:"updated_by" json:"updated_by,omitempty" query:"updated_by"`
-	CreatedAt         *int64  `thrift:"created_at,6,optional" frugal:"6,optional,i64" json:"created_at" form:"created_at" query:"created_at"`
-	UpdatedAt         *int64  `thrift:"updated_at,7,optional" frugal:"7,optional,i64" json:"updated_at" form:"updated_at" query:"updated_at"`
-	LatestCommittedAt *int64  `thrift:"latest_committed_at,8,optional" frugal:"8,optional,i64" json:"latest_committed_at" form:"latest_committed_at" query:"latest_committed_at"`
+	DisplayName       *string     `thrift:"display_name,1,optional" frugal:"1,optional,string" form:"display_name" json:"display_name,omitempty" query:"display_name"`
+	Description       *string     `thrift:"description,2,optional" frugal:"2,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	LatestVersion     *string     `thrift:"latest_version,3,optional" frugal:"3,optional,string" form:"latest_version" json:"latest_version,omitempty" query:"latest_version"`
+	CreatedBy         *string     `thrift:"created_by,4,optional" frugal:"4,optional,string" form:"created_by" json:"created_by,omitempty" query:"created_by"`
+	UpdatedBy         *string     `thrift:"updated_by,5,optional" frugal:"5,optional,string" form:"updated_by" json:"updated_by,omitempty" query:"updated_by"`
+	CreatedAt         *int64      `thrift:"created_at,6,optional" frugal:"6,optional,i64" json:"created_at" form:"created_at" query:"created_at"`
+	UpdatedAt         *int64      `thrift:"updated_at,7,optional" frugal:"7,optional,i64" json:"updated_at" form:"updated_at" query:"updated_at"`
+	LatestCommittedAt *int64      `thrift:"latest_committed_at,8,optional" frugal:"8,optional,i64" json:"latest_committed_at" form:"latest_committed_at" query:"latest_committed_at"`
+	PromptType        *PromptType `thrift:"prompt_type,9,optional" frugal:"9,optional,string" form:"prompt_type" json:"prompt_type,omitempty" query:"prompt_type"`
 }
 
 func NewPromptBasic() *PromptBasic {
@@ -729,6 +758,18 @@ func (p *PromptBasic) GetLatestCommittedAt() (v int64) {
 	}
 	return *p.LatestCommittedAt
 }
+
+var PromptBasic_PromptType_DEFAULT PromptType
+
+func (p *PromptBasic) GetPromptType() (v PromptType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptType() {
+		return PromptBasic_PromptType_DEFAULT
+	}
+	return *p.PromptType
+}
 func (p *PromptBasic) SetDisplayName(val *string) {
 	p.DisplayName = val
 }
@@ -753,6 +794,9 @@ func (p *PromptBasic) SetUpdatedAt(val *int64) {
 func (p *PromptBasic) SetLatestCommittedAt(val *int64) {
 	p.LatestCommittedAt = val
 }
+func (p *PromptBasic) SetPromptType(val *PromptType) {
+	p.PromptType = val
+}
 
 var fieldIDToName_PromptBasic = map[int16]string{
 	1: "display_name",
@@ -763,6 +807,7 @@ var fieldIDToName_PromptBasic = map[int16]string{
 	6: "created_at",
 	7: "updated_at",
 	8: "latest_committed_at",
+	9: "prompt_type",
 }
 
 func (p *PromptBasic) IsSetDisplayName() bool {
@@ -797,6 +842,10 @@ func (p *PromptBasic) IsSetLatestCommittedAt() bool {
 	return p.LatestCommittedAt != nil
 }
 
+func (p *PromptBasic) IsSetPromptType() bool {
+	return p.PromptType != nil
+}
+
 func (p *PromptBasic) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -879,6 +928,14 @@ func (p *PromptBasic) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -996,6 +1053,17 @@ func (p *PromptBasic) ReadField8(iprot thrift.TProtocol) error {
 	p.LatestCommittedAt = _field
 	return nil
 }
+func (p *PromptBasic) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *PromptType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PromptType = _field
+	return nil
+}
 
 func (p *PromptBasic) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1035,6 +1103,10 @@ func (p *PromptBasic) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 8
 			goto WriteFieldError
 		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1197,6 +1269,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
+func (p *PromptBasic) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptType() {
+		if err = oprot.WriteFieldBegin("prompt_type", thrift.STRING, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PromptType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
 
 func (p *PromptBasic) String() string {
 	if p == nil {
@@ -1236,6 +1326,9 @@ func (p *PromptBasic) DeepEqual(ano *PromptBasic) bool {
 	if !p.Field8DeepEqual(ano.LatestCommittedAt) {
 		return false
 	}
+	if !p.Field9DeepEqual(ano.PromptType) {
+		return false
+	}
 	return true
 }
 
@@ -1335,6 +1428,18 @@ func (p *PromptBasic) Field8DeepEqual(src *int64) bool {
 	}
 	return true
 }
+func (p *PromptBasic) Field9DeepEqual(src *PromptType) bool {
+
+	if p.PromptType == src {
+		return true
+	} else if p.PromptType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PromptType, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type PromptCommit struct {
 	Detail     *PromptDetail `thrift:"detail,1,optional" frugal:"1,optional,PromptDetail" form:"detail" json:"detail,omitempty" query:"detail"`
@@ -2067,62 +2172,146 @@ func (p *CommitInfo) Field5DeepEqual(src *int64) bool {
 	return true
 }
 
-type PromptDraft struct {
-	Detail    *PromptDetail `thrift:"detail,1,optional" frugal:"1,optional,PromptDetail" form:"detail" json:"detail,omitempty" query:"detail"`
-	DraftInfo *DraftInfo    `thrift:"draft_info,2,optional" frugal:"2,optional,DraftInfo" form:"draft_info" json:"draft_info,omitempty" query:"draft_info"`
+type PromptLabel struct {
+	LabelKey      *string `thrift:"label_key,1,optional" frugal:"1,optional,string" form:"label_key" json:"label_key,omitempty" query:"label_key"`
+	CommitVersion *string `thrift:"commit_version,2,optional" frugal:"2,optional,string" form:"commit_version" json:"commit_version,omitempty" query:"commit_version"`
+	CreatedBy     *string `thrift:"created_by,11,optional" frugal:"11,optional,string" form:"created_by" json:"created_by,omitempty" query:"created_by"`
+	UpdatedBy     *string `thrift:"updated_by,12,optional" frugal:"12,optional,string" form:"updated_by" json:"updated_by,omitempty" query:"updated_by"`
+	CreatedAt     *int64  `thrift:"created_at,13,optional" frugal:"13,optional,i64" json:"created_at" form:"created_at" query:"created_at"`
+	UpdatedAt     *int64  `thrift:"updated_at,14,optional" frugal:"14,optional,i64" json:"updated_at" form:"updated_at" query:"updated_at"`
 }
 
-func NewPromptDraft() *PromptDraft {
-	return &PromptDraft{}
+func NewPromptLabel() *PromptLabel {
+	return &PromptLabel{}
 }
 
-func (p *PromptDraft) InitDefault() {
+func (p *PromptLabel) InitDefault() {
 }
 
-var PromptDraft_Detail_DEFAULT *PromptDetail
+var PromptLabel_LabelKey_DEFAULT string
 
-func (p *PromptDraft) GetDetail() (v *PromptDetail) {
+func (p *PromptLabel) GetLabelKey() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDetail() {
-		return PromptDraft_Detail_DEFAULT
+	if !p.IsSetLabelKey() {
+		return PromptLabel_LabelKey_DEFAULT
 	}
-	return p.Detail
+	return *p.LabelKey
 }
 
-var PromptDraft_DraftInfo_DEFAULT *DraftInfo
+var PromptLabel_CommitVersion_DEFAULT string
 
-func (p *PromptDraft) GetDraftInfo() (v *DraftInfo) {
+func (p *PromptLabel) GetCommitVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDraftInfo() {
-		return PromptDraft_DraftInfo_DEFAULT
+	if !p.IsSetCommitVersion() {
+		return PromptLabel_CommitVersion_DEFAULT
 	}
-	return p.DraftInfo
+	return *p.CommitVersion
 }
-func (p *PromptDraft) SetDetail(val *PromptDetail) {
-	p.Detail = val
+
+var PromptLabel_CreatedBy_DEFAULT string
+
+func (p *PromptLabel) GetCreatedBy() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCreatedBy() {
+		return PromptLabel_CreatedBy_DEFAULT
+	}
+	return *p.CreatedBy
 }
-func (p *PromptDraft) SetDraftInfo(val *DraftInfo) {
-	p.DraftInfo = val
+
+var PromptLabel_UpdatedBy_DEFAULT string
+
+func (p *PromptLabel) GetUpdatedBy() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUpdatedBy() {
+		return PromptLabel_UpdatedBy_DEFAULT
+	}
+	return *p.UpdatedBy
 }
 
-var fieldIDToName_PromptDraft = map[int16]string{
-	1: "detail",
-	2: "draft_info",
+var PromptLabel_CreatedAt_DEFAULT int64
+
+func (p *PromptLabel) GetCreatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCreatedAt() {
+		return PromptLabel_CreatedAt_DEFAULT
+	}
+	return *p.CreatedAt
 }
 
-func (p *PromptDraft) IsSetDetail() bool {
-	return p.Detail != nil
+var PromptLabel_UpdatedAt_DEFAULT int64
+
+func (p *PromptLabel) GetUpdatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUpdatedAt() {
+		return PromptLabel_UpdatedAt_DEFAULT
+	}
+	return *p.UpdatedAt
+}
+func (p *PromptLabel) SetLabelKey(val *string) {
+	p.LabelKey = val
+}
+func (p *PromptLabel) SetCommitVersion(val *string) {
+	p.CommitVersion = val
+}
+func (p *PromptLabel) SetCreatedBy(val *string) {
+	p.CreatedBy = val
+}
+func (p *PromptLabel) SetUpdatedBy(val *string) {
+	p.UpdatedBy = val
+}
+func (p *PromptLabel) SetCreatedAt(val *int64) {
+	p.CreatedAt = val
+}
+func (p *PromptLabel) SetUpdatedAt(val *int64) {
+	p.UpdatedAt = val
 }
 
-func (p *PromptDraft) IsSetDraftInfo() bool {
-	return p.DraftInfo != nil
+var fieldIDToName_PromptLabel = map[int16]string{
+	1:  "label_key",
+	2:  "commit_version",
+	11: "created_by",
+	12: "updated_by",
+	13: "created_at",
+	14: "updated_at",
 }
 
-func (p *PromptDraft) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptLabel) IsSetLabelKey() bool {
+	return p.LabelKey != nil
+}
+
+func (p *PromptLabel) IsSetCommitVersion() bool {
+	return p.CommitVersion != nil
+}
+
+func (p *PromptLabel) IsSetCreatedBy() bool {
+	return p.CreatedBy != nil
+}
+
+func (p *PromptLabel) IsSetUpdatedBy() bool {
+	return p.UpdatedBy != nil
+}
+
+func (p *PromptLabel) IsSetCreatedAt() bool {
+	return p.CreatedAt != nil
+}
+
+func (p *PromptLabel) IsSetUpdatedAt() bool {
+	return p.UpdatedAt != nil
+}
+
+func (p *PromptLabel) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2141,7 +2330,7 @@ func (p *PromptDraft) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2149,13 +2338,45 @@ func (p *PromptDraft) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2175,7 +2396,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDraft[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptLabel[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2185,26 +2406,76 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptDraft) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPromptDetail()
-	if err := _field.Read(iprot); err != nil {
+func (p *PromptLabel) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Detail = _field
+	p.LabelKey = _field
 	return nil
 }
-func (p *PromptDraft) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewDraftInfo()
-	if err := _field.Read(iprot); err != nil {
+func (p *PromptLabel) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.DraftInfo = _field
+	p.CommitVersion = _field
 	return nil
 }
+func (p *PromptLabel) ReadField11(iprot thrift.TProtocol) error {
 
-func (p *PromptDraft) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreatedBy = _field
+	return nil
+}
+func (p *PromptLabel) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UpdatedBy = _field
+	return nil
+}
+func (p *PromptLabel) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *PromptLabel) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *PromptLabel) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PromptDraft"); err != nil {
+	if err = oprot.WriteStructBegin("PromptLabel"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2216,13 +2487,29 @@ func (p *PromptDraft) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -2234,12 +2521,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptDraft) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDetail() {
-		if err = oprot.WriteFieldBegin("detail", thrift.STRUCT, 1); err != nil {
+func (p *PromptLabel) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabelKey() {
+		if err = oprot.WriteFieldBegin("label_key", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Detail.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.LabelKey); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2252,12 +2539,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *PromptDraft) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDraftInfo() {
-		if err = oprot.WriteFieldBegin("draft_info", thrift.STRUCT, 2); err != nil {
+func (p *PromptLabel) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCommitVersion() {
+		if err = oprot.WriteFieldBegin("commit_version", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.DraftInfo.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.CommitVersion); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2270,164 +2557,348 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *PromptLabel) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedBy() {
+		if err = oprot.WriteFieldBegin("created_by", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CreatedBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *PromptLabel) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedBy() {
+		if err = oprot.WriteFieldBegin("updated_by", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UpdatedBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *PromptLabel) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedAt() {
+		if err = oprot.WriteFieldBegin("created_at", thrift.I64, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *PromptLabel) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedAt() {
+		if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
 
-func (p *PromptDraft) String() string {
+func (p *PromptLabel) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptDraft(%+v)", *p)
+	return fmt.Sprintf("PromptLabel(%+v)", *p)
 
 }
 
-func (p *PromptDraft) DeepEqual(ano *PromptDraft) bool {
+func (p *PromptLabel) DeepEqual(ano *PromptLabel) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Detail) {
+	if !p.Field1DeepEqual(ano.LabelKey) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.DraftInfo) {
+	if !p.Field2DeepEqual(ano.CommitVersion) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.CreatedBy) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.UpdatedBy) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.UpdatedAt) {
 		return false
 	}
 	return true
 }
 
-func (p *PromptDraft) Field1DeepEqual(src *PromptDetail) bool {
+func (p *PromptLabel) Field1DeepEqual(src *string) bool {
 
-	if !p.Detail.DeepEqual(src) {
+	if p.LabelKey == src {
+		return true
+	} else if p.LabelKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LabelKey, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *PromptDraft) Field2DeepEqual(src *DraftInfo) bool {
+func (p *PromptLabel) Field2DeepEqual(src *string) bool {
 
-	if !p.DraftInfo.DeepEqual(src) {
+	if p.CommitVersion == src {
+		return true
+	} else if p.CommitVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CommitVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *PromptLabel) Field11DeepEqual(src *string) bool {
 
-type DraftInfo struct {
-	UserID      *string `thrift:"user_id,1,optional" frugal:"1,optional,string" form:"user_id" json:"user_id,omitempty" query:"user_id"`
-	BaseVersion *string `thrift:"base_version,2,optional" frugal:"2,optional,string" form:"base_version" json:"base_version,omitempty" query:"base_version"`
-	IsModified  *bool   `thrift:"is_modified,3,optional" frugal:"3,optional,bool" form:"is_modified" json:"is_modified,omitempty" query:"is_modified"`
-	CreatedAt   *int64  `thrift:"created_at,11,optional" frugal:"11,optional,i64" json:"created_at" form:"created_at" query:"created_at"`
-	UpdatedAt   *int64  `thrift:"updated_at,12,optional" frugal:"12,optional,i64" json:"updated_at" form:"updated_at" query:"updated_at"`
+	if p.CreatedBy == src {
+		return true
+	} else if p.CreatedBy == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CreatedBy, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *PromptLabel) Field12DeepEqual(src *string) bool {
 
-func NewDraftInfo() *DraftInfo {
-	return &DraftInfo{}
+	if p.UpdatedBy == src {
+		return true
+	} else if p.UpdatedBy == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.UpdatedBy, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *PromptLabel) Field13DeepEqual(src *int64) bool {
 
-func (p *DraftInfo) InitDefault() {
+	if p.CreatedAt == src {
+		return true
+	} else if p.CreatedAt == nil || src == nil {
+		return false
+	}
+	if *p.CreatedAt != *src {
+		return false
+	}
+	return true
 }
+func (p *PromptLabel) Field14DeepEqual(src *int64) bool {
 
-var DraftInfo_UserID_DEFAULT string
+	if p.UpdatedAt == src {
+		return true
+	} else if p.UpdatedAt == nil || src == nil {
+		return false
+	}
+	if *p.UpdatedAt != *src {
+		return false
+	}
+	return true
+}
 
-func (p *DraftInfo) GetUserID() (v string) {
+type PromptLabelHistory struct {
+	ID                    *int64                `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
+	LabelKey              *string               `thrift:"label_key,2,optional" frugal:"2,optional,string" form:"label_key" json:"label_key,omitempty" query:"label_key"`
+	CommitVersion         *string               `thrift:"commit_version,3,optional" frugal:"3,optional,string" form:"commit_version" json:"commit_version,omitempty" query:"commit_version"`
+	PreviousCommitVersion *string               `thrift:"previous_commit_version,4,optional" frugal:"4,optional,string" form:"previous_commit_version" json:"previous_commit_version,omitempty" query:"previous_commit_version"`
+	Operation             *PromptLabelOperation `thrift:"operation,5,optional" frugal:"5,optional,string" form:"operation" json:"operation,omitempty" query:"operation"`
+	OperatedBy            *string               `thrift:"operated_by,6,optional" frugal:"6,optional,string" form:"operated_by" json:"operated_by,omitempty" query:"operated_by"`
+	OperatedAt            *int64                `thrift:"operated_at,7,optional" frugal:"7,optional,i64" json:"operated_at" form:"operated_at" query:"operated_at"`
+}
+
+func NewPromptLabelHistory() *PromptLabelHistory {
+	return &PromptLabelHistory{}
+}
+
+func (p *PromptLabelHistory) InitDefault() {
+}
+
+var PromptLabelHistory_ID_DEFAULT int64
+
+func (p *PromptLabelHistory) GetID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetUserID() {
-		return DraftInfo_UserID_DEFAULT
+	if !p.IsSetID() {
+		return PromptLabelHistory_ID_DEFAULT
 	}
-	return *p.UserID
+	return *p.ID
 }
 
-var DraftInfo_BaseVersion_DEFAULT string
+var PromptLabelHistory_LabelKey_DEFAULT string
 
-func (p *DraftInfo) GetBaseVersion() (v string) {
+func (p *PromptLabelHistory) GetLabelKey() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBaseVersion() {
-		return DraftInfo_BaseVersion_DEFAULT
+	if !p.IsSetLabelKey() {
+		return PromptLabelHistory_LabelKey_DEFAULT
 	}
-	return *p.BaseVersion
+	return *p.LabelKey
 }
 
-var DraftInfo_IsModified_DEFAULT bool
+var PromptLabelHistory_CommitVersion_DEFAULT string
 
-func (p *DraftInfo) GetIsModified() (v bool) {
+func (p *PromptLabelHistory) GetCommitVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetIsModified() {
-		return DraftInfo_IsModified_DEFAULT
+	if !p.IsSetCommitVersion() {
+		return PromptLabelHistory_CommitVersion_DEFAULT
 	}
-	return *p.IsModified
+	return *p.CommitVersion
 }
 
-var DraftInfo_CreatedAt_DEFAULT int64
+var PromptLabelHistory_PreviousCommitVersion_DEFAULT string
 
-func (p *DraftInfo) GetCreatedAt() (v int64) {
+func (p *PromptLabelHistory) GetPreviousCommitVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCreatedAt() {
-		return DraftInfo_CreatedAt_DEFAULT
+	if !p.IsSetPreviousCommitVersion() {
+		return PromptLabelHistory_PreviousCommitVersion_DEFAULT
 	}
-	return *p.CreatedAt
+	return *p.PreviousCommitVersion
 }
 
-var DraftInfo_UpdatedAt_DEFAULT int64
+var PromptLabelHistory_Operation_DEFAULT PromptLabelOperation
 
-func (p *DraftInfo) GetUpdatedAt() (v int64) {
+func (p *PromptLabelHistory) GetOperation() (v PromptLabelOperation) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetUpdatedAt() {
-		return DraftInfo_UpdatedAt_DEFAULT
+	if !p.IsSetOperation() {
+		return PromptLabelHistory_Operation_DEFAULT
 	}
-	return *p.UpdatedAt
+	return *p.Operation
 }
-func (p *DraftInfo) SetUserID(val *string) {
-	p.UserID = val
+
+var PromptLabelHistory_OperatedBy_DEFAULT string
+
+func (p *PromptLabelHistory) GetOperatedBy() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOperatedBy() {
+		return PromptLabelHistory_OperatedBy_DEFAULT
+	}
+	return *p.OperatedBy
 }
-func (p *DraftInfo) SetBaseVersion(val *string) {
-	p.BaseVersion = val
+
+var PromptLabelHistory_OperatedAt_DEFAULT int64
+
+func (p *PromptLabelHistory) GetOperatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOperatedAt() {
+		return PromptLabelHistory_OperatedAt_DEFAULT
+	}
+	return *p.OperatedAt
 }
-func (p *DraftInfo) SetIsModified(val *bool) {
-	p.IsModified = val
+func (p *PromptLabelHistory) SetID(val *int64) {
+	p.ID = val
 }
-func (p *DraftInfo) SetCreatedAt(val *int64) {
-	p.CreatedAt = val
+func (p *PromptLabelHistory) SetLabelKey(val *string) {
+	p.LabelKey = val
 }
-func (p *DraftInfo) SetUpdatedAt(val *int64) {
-	p.UpdatedAt = val
+func (p *PromptLabelHistory) SetCommitVersion(val *string) {
+	p.CommitVersion = val
+}
+func (p *PromptLabelHistory) SetPreviousCommitVersion(val *string) {
+	p.PreviousCommitVersion = val
+}
+func (p *PromptLabelHistory) SetOperation(val *PromptLabelOperation) {
+	p.Operation = val
+}
+func (p *PromptLabelHistory) SetOperatedBy(val *string) {
+	p.OperatedBy = val
+}
+func (p *PromptLabelHistory) SetOperatedAt(val *int64) {
+	p.OperatedAt = val
 }
 
-var fieldIDToName_DraftInfo = map[int16]string{
-	1:  "user_id",
-	2:  "base_version",
-	3:  "is_modified",
-	11: "created_at",
-	12: "updated_at",
+var fieldIDToName_PromptLabelHistory = map[int16]string{
+	1: "id",
+	2: "label_key",
+	3: "commit_version",
+	4: "previous_commit_version",
+	5: "operation",
+	6: "operated_by",
+	7: "operated_at",
 }
 
-func (p *DraftInfo) IsSetUserID() bool {
-	return p.UserID != nil
+func (p *PromptLabelHistory) IsSetID() bool {
+	return p.ID != nil
 }
 
-func (p *DraftInfo) IsSetBaseVersion() bool {
-	return p.BaseVersion != nil
+func (p *PromptLabelHistory) IsSetLabelKey() bool {
+	return p.LabelKey != nil
 }
 
-func (p *DraftInfo) IsSetIsModified() bool {
-	return p.IsModified != nil
+func (p *PromptLabelHistory) IsSetCommitVersion() bool {
+	return p.CommitVersion != nil
 }
 
-func (p *DraftInfo) IsSetCreatedAt() bool {
-	return p.CreatedAt != nil
+func (p *PromptLabelHistory) IsSetPreviousCommitVersion() bool {
+	return p.PreviousCommitVersion != nil
 }
 
-func (p *DraftInfo) IsSetUpdatedAt() bool {
-	return p.UpdatedAt != nil
+func (p *PromptLabelHistory) IsSetOperation() bool {
+	return p.Operation != nil
 }
 
-func (p *DraftInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptLabelHistory) IsSetOperatedBy() bool {
+	return p.OperatedBy != nil
+}
+
+func (p *PromptLabelHistory) IsSetOperatedAt() bool {
+	return p.OperatedAt != nil
+}
+
+func (p *PromptLabelHistory) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2446,7 +2917,7 @@ func (p *DraftInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2462,24 +2933,40 @@ func (p *DraftInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 12:
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField12(iprot); err != nil {
+				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -2504,7 +2991,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DraftInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptLabelHistory[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2514,7 +3001,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DraftInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *PromptLabelHistory) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *PromptLabelHistory) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2522,10 +3020,10 @@ func (p *DraftInfo) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.UserID = _field
+	p.LabelKey = _field
 	return nil
 }
-func (p *DraftInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *PromptLabelHistory) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2533,32 +3031,43 @@ func (p *DraftInfo) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.BaseVersion = _field
+	p.CommitVersion = _field
 	return nil
 }
-func (p *DraftInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *PromptLabelHistory) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.IsModified = _field
+	p.PreviousCommitVersion = _field
 	return nil
 }
-func (p *DraftInfo) ReadField11(iprot thrift.TProtocol) error {
+func (p *PromptLabelHistory) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *PromptLabelOperation
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.CreatedAt = _field
+	p.Operation = _field
 	return nil
 }
-func (p *DraftInfo) ReadField12(iprot thrift.TProtocol) error {
+func (p *PromptLabelHistory) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OperatedBy = _field
+	return nil
+}
+func (p *PromptLabelHistory) ReadField7(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2566,13 +3075,13 @@ func (p *DraftInfo) ReadField12(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.UpdatedAt = _field
+	p.OperatedAt = _field
 	return nil
 }
 
-func (p *DraftInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptLabelHistory) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DraftInfo"); err != nil {
+	if err = oprot.WriteStructBegin("PromptLabelHistory"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2588,12 +3097,20 @@ func (p *DraftInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
 			goto WriteFieldError
 		}
 	}
@@ -2614,12 +3131,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DraftInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserID() {
-		if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+func (p *PromptLabelHistory) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.UserID); err != nil {
+		if err := oprot.WriteI64(*p.ID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2632,12 +3149,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *DraftInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseVersion() {
-		if err = oprot.WriteFieldBegin("base_version", thrift.STRING, 2); err != nil {
+func (p *PromptLabelHistory) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabelKey() {
+		if err = oprot.WriteFieldBegin("label_key", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.BaseVersion); err != nil {
+		if err := oprot.WriteString(*p.LabelKey); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2650,12 +3167,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *DraftInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsModified() {
-		if err = oprot.WriteFieldBegin("is_modified", thrift.BOOL, 3); err != nil {
+func (p *PromptLabelHistory) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCommitVersion() {
+		if err = oprot.WriteFieldBegin("commit_version", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.IsModified); err != nil {
+		if err := oprot.WriteString(*p.CommitVersion); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2668,12 +3185,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *DraftInfo) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCreatedAt() {
-		if err = oprot.WriteFieldBegin("created_at", thrift.I64, 11); err != nil {
+func (p *PromptLabelHistory) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreviousCommitVersion() {
+		if err = oprot.WriteFieldBegin("previous_commit_version", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
+		if err := oprot.WriteString(*p.PreviousCommitVersion); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2682,16 +3199,16 @@ func (p *DraftInfo) writeField11(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *DraftInfo) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUpdatedAt() {
-		if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 12); err != nil {
+func (p *PromptLabelHistory) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOperation() {
+		if err = oprot.WriteFieldBegin("operation", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
+		if err := oprot.WriteString(*p.Operation); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2700,223 +3217,290 @@ func (p *DraftInfo) writeField12(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *PromptLabelHistory) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOperatedBy() {
+		if err = oprot.WriteFieldBegin("operated_by", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OperatedBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *PromptLabelHistory) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOperatedAt() {
+		if err = oprot.WriteFieldBegin("operated_at", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.OperatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *DraftInfo) String() string {
+func (p *PromptLabelHistory) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DraftInfo(%+v)", *p)
+	return fmt.Sprintf("PromptLabelHistory(%+v)", *p)
 
 }
 
-func (p *DraftInfo) DeepEqual(ano *DraftInfo) bool {
+func (p *PromptLabelHistory) DeepEqual(ano *PromptLabelHistory) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserID) {
+	if !p.Field1DeepEqual(ano.ID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.BaseVersion) {
+	if !p.Field2DeepEqual(ano.LabelKey) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.IsModified) {
+	if !p.Field3DeepEqual(ano.CommitVersion) {
 		return false
 	}
-	if !p.Field11DeepEqual(ano.CreatedAt) {
+	if !p.Field4DeepEqual(ano.PreviousCommitVersion) {
 		return false
 	}
-	if !p.Field12DeepEqual(ano.UpdatedAt) {
+	if !p.Field5DeepEqual(ano.Operation) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.OperatedBy) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.OperatedAt) {
 		return false
 	}
 	return true
 }
 
-func (p *DraftInfo) Field1DeepEqual(src *string) bool {
+func (p *PromptLabelHistory) Field1DeepEqual(src *int64) bool {
 
-	if p.UserID == src {
+	if p.ID == src {
 		return true
-	} else if p.UserID == nil || src == nil {
+	} else if p.ID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.UserID, *src) != 0 {
+	if *p.ID != *src {
 		return false
 	}
 	return true
 }
-func (p *DraftInfo) Field2DeepEqual(src *string) bool {
+func (p *PromptLabelHistory) Field2DeepEqual(src *string) bool {
 
-	if p.BaseVersion == src {
+	if p.LabelKey == src {
 		return true
-	} else if p.BaseVersion == nil || src == nil {
+	} else if p.LabelKey == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.BaseVersion, *src) != 0 {
+	if strings.Compare(*p.LabelKey, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *DraftInfo) Field3DeepEqual(src *bool) bool {
+func (p *PromptLabelHistory) Field3DeepEqual(src *string) bool {
 
-	if p.IsModified == src {
+	if p.CommitVersion == src {
 		return true
-	} else if p.IsModified == nil || src == nil {
+	} else if p.CommitVersion == nil || src == nil {
 		return false
 	}
-	if *p.IsModified != *src {
+	if strings.Compare(*p.CommitVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *DraftInfo) Field11DeepEqual(src *int64) bool {
+func (p *PromptLabelHistory) Field4DeepEqual(src *string) bool {
 
-	if p.CreatedAt == src {
+	if p.PreviousCommitVersion == src {
 		return true
-	} else if p.CreatedAt == nil || src == nil {
+	} else if p.PreviousCommitVersion == nil || src == nil {
 		return false
 	}
-	if *p.CreatedAt != *src {
+	if strings.Compare(*p.PreviousCommitVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *DraftInfo) Field12DeepEqual(src *int64) bool {
+func (p *PromptLabelHistory) Field5DeepEqual(src *PromptLabelOperation) bool {
 
-	if p.UpdatedAt == src {
+	if p.Operation == src {
 		return true
-	} else if p.UpdatedAt == nil || src == nil {
+	} else if p.Operation == nil || src == nil {
 		return false
 	}
-	if *p.UpdatedAt != *src {
+	if strings.Compare(*p.Operation, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *PromptLabelHistory) Field6DeepEqual(src *string) bool {
 
-type PromptDetail struct {
-	PromptTemplate *PromptTemplate   `thrift:"prompt_template,1,optional" frugal:"1,optional,PromptTemplate" form:"prompt_template" json:"prompt_template,omitempty" query:"prompt_template"`
-	Tools          []*Tool           `thrift:"tools,2,optional" frugal:"2,optional,list<Tool>" form:"tools" json:"tools,omitempty" query:"tools"`
-	ToolCallConfig *ToolCallConfig   `thrift:"tool_call_config,3,optional" frugal:"3,optional,ToolCallConfig" form:"tool_call_config" json:"tool_call_config,omitempty" query:"tool_call_config"`
-	ModelConfig    *ModelConfig      `thrift:"model_config,4,optional" frugal:"4,optional,ModelConfig" form:"model_config" json:"model_config,omitempty" query:"model_config"`
-	ExtInfos       map[string]string `thrift:"ext_infos,255,optional" frugal:"255,optional,map<string:string>" form:"ext_infos" json:"ext_infos,omitempty" query:"ext_infos"`
+	if p.OperatedBy == src {
+		return true
+	} else if p.OperatedBy == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.OperatedBy, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *PromptLabelHistory) Field7DeepEqual(src *int64) bool {
 
-func NewPromptDetail() *PromptDetail {
-	return &PromptDetail{}
+	if p.OperatedAt == src {
+		return true
+	} else if p.OperatedAt == nil || src == nil {
+		return false
+	}
+	if *p.OperatedAt != *src {
+		return false
+	}
+	return true
 }
 
-func (p *PromptDetail) InitDefault() {
+// 片段被其他Prompt提交版本引用的记录
+type SnippetReference struct {
+	PromptID       *int64  `thrift:"prompt_id,1,optional" frugal:"1,optional,i64" json:"prompt_id" form:"prompt_id" query:"prompt_id"`
+	PromptKey      *string `thrift:"prompt_key,2,optional" frugal:"2,optional,string" form:"prompt_key" json:"prompt_key,omitempty" query:"prompt_key"`
+	CommitVersion  *string `thrift:"commit_version,3,optional" frugal:"3,optional,string" form:"commit_version" json:"commit_version,omitempty" query:"commit_version"`
+	SnippetVersion *string `thrift:"snippet_version,4,optional" frugal:"4,optional,string" form:"snippet_version" json:"snippet_version,omitempty" query:"snippet_version"`
+	CreatedAt      *int64  `thrift:"created_at,5,optional" frugal:"5,optional,i64" json:"created_at" form:"created_at" query:"created_at"`
 }
 
-var PromptDetail_PromptTemplate_DEFAULT *PromptTemplate
+func NewSnippetReference() *SnippetReference {
+	return &SnippetReference{}
+}
 
-func (p *PromptDetail) GetPromptTemplate() (v *PromptTemplate) {
+func (p *SnippetReference) InitDefault() {
+}
+
+var SnippetReference_PromptID_DEFAULT int64
+
+func (p *SnippetReference) GetPromptID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPromptTemplate() {
-		return PromptDetail_PromptTemplate_DEFAULT
+	if !p.IsSetPromptID() {
+		return SnippetReference_PromptID_DEFAULT
 	}
-	return p.PromptTemplate
+	return *p.PromptID
 }
 
-var PromptDetail_Tools_DEFAULT []*Tool
+var SnippetReference_PromptKey_DEFAULT string
 
-func (p *PromptDetail) GetTools() (v []*Tool) {
+func (p *SnippetReference) GetPromptKey() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTools() {
-		return PromptDetail_Tools_DEFAULT
+	if !p.IsSetPromptKey() {
+		return SnippetReference_PromptKey_DEFAULT
 	}
-	return p.Tools
+	return *p.PromptKey
 }
 
-var PromptDetail_ToolCallConfig_DEFAULT *ToolCallConfig
+var SnippetReference_CommitVersion_DEFAULT string
 
-func (p *PromptDetail) GetToolCallConfig() (v *ToolCallConfig) {
+func (p *SnippetReference) GetCommitVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetToolCallConfig() {
-		return PromptDetail_ToolCallConfig_DEFAULT
+	if !p.IsSetCommitVersion() {
+		return SnippetReference_CommitVersion_DEFAULT
 	}
-	return p.ToolCallConfig
+	return *p.CommitVersion
 }
 
-var PromptDetail_ModelConfig_DEFAULT *ModelConfig
+var SnippetReference_SnippetVersion_DEFAULT string
 
-func (p *PromptDetail) GetModelConfig() (v *ModelConfig) {
+func (p *SnippetReference) GetSnippetVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetModelConfig() {
-		return PromptDetail_ModelConfig_DEFAULT
+	if !p.IsSetSnippetVersion() {
+		return SnippetReference_SnippetVersion_DEFAULT
 	}
-	return p.ModelConfig
+	return *p.SnippetVersion
 }
 
-var PromptDetail_ExtInfos_DEFAULT map[string]string
+var SnippetReference_CreatedAt_DEFAULT int64
 
-func (p *PromptDetail) GetExtInfos() (v map[string]string) {
+func (p *SnippetReference) GetCreatedAt() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExtInfos() {
-		return PromptDetail_ExtInfos_DEFAULT
+	if !p.IsSetCreatedAt() {
+		return SnippetReference_CreatedAt_DEFAULT
 	}
-	return p.ExtInfos
+	return *p.CreatedAt
 }
-func (p *PromptDetail) SetPromptTemplate(val *PromptTemplate) {
-	p.PromptTemplate = val
+func (p *SnippetReference) SetPromptID(val *int64) {
+	p.PromptID = val
 }
-func (p *PromptDetail) SetTools(val []*Tool) {
-	p.Tools = val
+func (p *SnippetReference) SetPromptKey(val *string) {
+	p.PromptKey = val
 }
-func (p *PromptDetail) SetToolCallConfig(val *ToolCallConfig) {
-	p.ToolCallConfig = val
+func (p *SnippetReference) SetCommitVersion(val *string) {
+	p.CommitVersion = val
 }
-func (p *PromptDetail) SetModelConfig(val *ModelConfig) {
-	p.ModelConfig = val
+func (p *SnippetReference) SetSnippetVersion(val *string) {
+	p.SnippetVersion = val
 }
-func (p *PromptDetail) SetExtInfos(val map[string]string) {
-	p.ExtInfos = val
+func (p *SnippetReference) SetCreatedAt(val *int64) {
+	p.CreatedAt = val
 }
 
-var fieldIDToName_PromptDetail = map[int16]string{
-	1:   "prompt_template",
-	2:   "tools",
-	3:   "tool_call_config",
-	4:   "model_config",
-	255: "ext_infos",
+var fieldIDToName_SnippetReference = map[int16]string{
+	1: "prompt_id",
+	2: "prompt_key",
+	3: "commit_version",
+	4: "snippet_version",
+	5: "created_at",
 }
 
-func (p *PromptDetail) IsSetPromptTemplate() bool {
-	return p.PromptTemplate != nil
+func (p *SnippetReference) IsSetPromptID() bool {
+	return p.PromptID != nil
 }
 
-func (p *PromptDetail) IsSetTools() bool {
-	return p.Tools != nil
+func (p *SnippetReference) IsSetPromptKey() bool {
+	return p.PromptKey != nil
 }
 
-func (p *PromptDetail) IsSetToolCallConfig() bool {
-	return p.ToolCallConfig != nil
+func (p *SnippetReference) IsSetCommitVersion() bool {
+	return p.CommitVersion != nil
 }
 
-func (p *PromptDetail) IsSetModelConfig() bool {
-	return p.ModelConfig != nil
+func (p *SnippetReference) IsSetSnippetVersion() bool {
+	return p.SnippetVersion != nil
 }
 
-func (p *PromptDetail) IsSetExtInfos() bool {
-	return p.ExtInfos != nil
+func (p *SnippetReference) IsSetCreatedAt() bool {
+	return p.CreatedAt != nil
 }
 
-func (p *PromptDetail) Read(iprot thrift.TProtocol) (err error) {
+func (p *SnippetReference) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2935,7 +3519,7 @@ func (p *PromptDetail) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2943,7 +3527,7 @@ func (p *PromptDetail) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2951,7 +3535,7 @@ func (p *PromptDetail) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2959,16 +3543,16 @@ func (p *PromptDetail) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 255:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField255(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -2993,7 +3577,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDetail[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SnippetReference[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3003,86 +3587,65 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptDetail) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPromptTemplate()
-	if err := _field.Read(iprot); err != nil {
+func (p *SnippetReference) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.PromptTemplate = _field
+	p.PromptID = _field
 	return nil
 }
-func (p *PromptDetail) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*Tool, 0, size)
-	values := make([]Tool, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *SnippetReference) ReadField2(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Tools = _field
+	p.PromptKey = _field
 	return nil
 }
-func (p *PromptDetail) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewToolCallConfig()
-	if err := _field.Read(iprot); err != nil {
+func (p *SnippetReference) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.ToolCallConfig = _field
+	p.CommitVersion = _field
 	return nil
 }
-func (p *PromptDetail) ReadField4(iprot thrift.TProtocol) error {
-	_field := NewModelConfig()
-	if err := _field.Read(iprot); err != nil {
+func (p *SnippetReference) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.ModelConfig = _field
+	p.SnippetVersion = _field
 	return nil
 }
-func (p *PromptDetail) ReadField255(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
+func (p *SnippetReference) ReadField5(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.ExtInfos = _field
+	p.CreatedAt = _field
 	return nil
 }
 
-func (p *PromptDetail) Write(oprot thrift.TProtocol) (err error) {
+func (p *SnippetReference) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PromptDetail"); err != nil {
+	if err = oprot.WriteStructBegin("SnippetReference"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3102,8 +3665,8 @@ func (p *PromptDetail) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
 			goto WriteFieldError
 		}
 	}
@@ -3124,12 +3687,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptDetail) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPromptTemplate() {
-		if err = oprot.WriteFieldBegin("prompt_template", thrift.STRUCT, 1); err != nil {
+func (p *SnippetReference) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptID() {
+		if err = oprot.WriteFieldBegin("prompt_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.PromptTemplate.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.PromptID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3142,20 +3705,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *PromptDetail) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTools() {
-		if err = oprot.WriteFieldBegin("tools", thrift.LIST, 2); err != nil {
+func (p *SnippetReference) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptKey() {
+		if err = oprot.WriteFieldBegin("prompt_key", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Tools)); err != nil {
-			return err
-		}
-		for _, v := range p.Tools {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.PromptKey); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3168,12 +3723,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *PromptDetail) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetToolCallConfig() {
-		if err = oprot.WriteFieldBegin("tool_call_config", thrift.STRUCT, 3); err != nil {
+func (p *SnippetReference) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCommitVersion() {
+		if err = oprot.WriteFieldBegin("commit_version", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ToolCallConfig.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.CommitVersion); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3186,12 +3741,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *PromptDetail) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetModelConfig() {
-		if err = oprot.WriteFieldBegin("model_config", thrift.STRUCT, 4); err != nil {
+func (p *SnippetReference) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSnippetVersion() {
+		if err = oprot.WriteFieldBegin("snippet_version", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ModelConfig.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.SnippetVersion); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3204,23 +3759,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *PromptDetail) writeField255(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExtInfos() {
-		if err = oprot.WriteFieldBegin("ext_infos", thrift.MAP, 255); err != nil {
+func (p *SnippetReference) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedAt() {
+		if err = oprot.WriteFieldBegin("created_at", thrift.I64, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.ExtInfos)); err != nil {
-			return err
-		}
-		for k, v := range p.ExtInfos {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3229,168 +3773,160 @@ func (p *PromptDetail) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *PromptDetail) String() string {
+func (p *SnippetReference) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptDetail(%+v)", *p)
+	return fmt.Sprintf("SnippetReference(%+v)", *p)
 
 }
 
-func (p *PromptDetail) DeepEqual(ano *PromptDetail) bool {
+func (p *SnippetReference) DeepEqual(ano *SnippetReference) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.PromptTemplate) {
+	if !p.Field1DeepEqual(ano.PromptID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Tools) {
+	if !p.Field2DeepEqual(ano.PromptKey) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ToolCallConfig) {
+	if !p.Field3DeepEqual(ano.CommitVersion) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.ModelConfig) {
+	if !p.Field4DeepEqual(ano.SnippetVersion) {
 		return false
 	}
-	if !p.Field255DeepEqual(ano.ExtInfos) {
+	if !p.Field5DeepEqual(ano.CreatedAt) {
 		return false
 	}
 	return true
 }
 
-func (p *PromptDetail) Field1DeepEqual(src *PromptTemplate) bool {
+func (p *SnippetReference) Field1DeepEqual(src *int64) bool {
 
-	if !p.PromptTemplate.DeepEqual(src) {
+	if p.PromptID == src {
+		return true
+	} else if p.PromptID == nil || src == nil {
+		return false
+	}
+	if *p.PromptID != *src {
 		return false
 	}
 	return true
 }
-func (p *PromptDetail) Field2DeepEqual(src []*Tool) bool {
+func (p *SnippetReference) Field2DeepEqual(src *string) bool {
 
-	if len(p.Tools) != len(src) {
+	if p.PromptKey == src {
+		return true
+	} else if p.PromptKey == nil || src == nil {
 		return false
 	}
-	for i, v := range p.Tools {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.PromptKey, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *PromptDetail) Field3DeepEqual(src *ToolCallConfig) bool {
+func (p *SnippetReference) Field3DeepEqual(src *string) bool {
 
-	if !p.ToolCallConfig.DeepEqual(src) {
+	if p.CommitVersion == src {
+		return true
+	} else if p.CommitVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CommitVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *PromptDetail) Field4DeepEqual(src *ModelConfig) bool {
+func (p *SnippetReference) Field4DeepEqual(src *string) bool {
 
-	if !p.ModelConfig.DeepEqual(src) {
+	if p.SnippetVersion == src {
+		return true
+	} else if p.SnippetVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SnippetVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *PromptDetail) Field255DeepEqual(src map[string]string) bool {
+func (p *SnippetReference) Field5DeepEqual(src *int64) bool {
 
-	if len(p.ExtInfos) != len(src) {
+	if p.CreatedAt == src {
+		return true
+	} else if p.CreatedAt == nil || src == nil {
 		return false
 	}
-	for k, v := range p.ExtInfos {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
+	if *p.CreatedAt != *src {
+		return false
 	}
 	return true
 }
 
-type PromptTemplate struct {
-	TemplateType *TemplateType  `thrift:"template_type,1,optional" frugal:"1,optional,string" form:"template_type" json:"template_type,omitempty" query:"template_type"`
-	Messages     []*Message     `thrift:"messages,2,optional" frugal:"2,optional,list<Message>" form:"messages" json:"messages,omitempty" query:"messages"`
-	VariableDefs []*VariableDef `thrift:"variable_defs,3,optional" frugal:"3,optional,list<VariableDef>" form:"variable_defs" json:"variable_defs,omitempty" query:"variable_defs"`
+type PromptDraft struct {
+	Detail    *PromptDetail `thrift:"detail,1,optional" frugal:"1,optional,PromptDetail" form:"detail" json:"detail,omitempty" query:"detail"`
+	DraftInfo *DraftInfo    `thrift:"draft_info,2,optional" frugal:"2,optional,DraftInfo" form:"draft_info" json:"draft_info,omitempty" query:"draft_info"`
 }
 
-func NewPromptTemplate() *PromptTemplate {
-	return &PromptTemplate{}
+func NewPromptDraft() *PromptDraft {
+	return &PromptDraft{}
 }
 
-func (p *PromptTemplate) InitDefault() {
+func (p *PromptDraft) InitDefault() {
 }
 
-var PromptTemplate_TemplateType_DEFAULT TemplateType
+var PromptDraft_Detail_DEFAULT *PromptDetail
 
-func (p *PromptTemplate) GetTemplateType() (v TemplateType) {
+func (p *PromptDraft) GetDetail() (v *PromptDetail) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTemplateType() {
-		return PromptTemplate_TemplateType_DEFAULT
+	if !p.IsSetDetail() {
+		return PromptDraft_Detail_DEFAULT
 	}
-	return *p.TemplateType
+	return p.Detail
 }
 
-var PromptTemplate_Messages_DEFAULT []*Message
+var PromptDraft_DraftInfo_DEFAULT *DraftInfo
 
-func (p *PromptTemplate) GetMessages() (v []*Message) {
+func (p *PromptDraft) GetDraftInfo() (v *DraftInfo) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetMessages() {
-		return PromptTemplate_Messages_DEFAULT
+	if !p.IsSetDraftInfo() {
+		return PromptDraft_DraftInfo_DEFAULT
 	}
-	return p.Messages
+	return p.DraftInfo
+}
+func (p *PromptDraft) SetDetail(val *PromptDetail) {
+	p.Detail = val
+}
+func (p *PromptDraft) SetDraftInfo(val *DraftInfo) {
+	p.DraftInfo = val
 }
 
-var PromptTemplate_VariableDefs_DEFAULT []*VariableDef
+var fieldIDToName_PromptDraft = map[int16]string{
+	1: "detail",
+	2: "draft_info",
+}
 
-func (p *PromptTemplate) GetVariableDefs() (v []*VariableDef) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetVariableDefs() {
-		return PromptTemplate_VariableDefs_DEFAULT
-	}
-	return p.VariableDefs
-}
-func (p *PromptTemplate) SetTemplateType(val *TemplateType) {
-	p.TemplateType = val
-}
-func (p *PromptTemplate) SetMessages(val []*Message) {
-	p.Messages = val
-}
-func (p *PromptTemplate) SetVariableDefs(val []*VariableDef) {
-	p.VariableDefs = val
-}
-
-var fieldIDToName_PromptTemplate = map[int16]string{
-	1: "template_type",
-	2: "messages",
-	3: "variable_defs",
-}
-
-func (p *PromptTemplate) IsSetTemplateType() bool {
-	return p.TemplateType != nil
-}
-
-func (p *PromptTemplate) IsSetMessages() bool {
-	return p.Messages != nil
+func (p *PromptDraft) IsSetDetail() bool {
+	return p.Detail != nil
 }
 
-func (p *PromptTemplate) IsSetVariableDefs() bool {
-	return p.VariableDefs != nil
+func (p *PromptDraft) IsSetDraftInfo() bool {
+	return p.DraftInfo != nil
 }
 
-func (p *PromptTemplate) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptDraft) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3409,7 +3945,7 @@ func (p *PromptTemplate) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3417,21 +3953,13 @@ func (p *PromptTemplate) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3451,7 +3979,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptTemplate[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDraft[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3461,67 +3989,26 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptTemplate) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *TemplateType
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.TemplateType = _field
-	return nil
-}
-func (p *PromptTemplate) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*Message, 0, size)
-	values := make([]Message, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *PromptDraft) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPromptDetail()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Messages = _field
+	p.Detail = _field
 	return nil
 }
-func (p *PromptTemplate) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*VariableDef, 0, size)
-	values := make([]VariableDef, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *PromptDraft) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewDraftInfo()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.VariableDefs = _field
+	p.DraftInfo = _field
 	return nil
 }
 
-func (p *PromptTemplate) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptDraft) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PromptTemplate"); err != nil {
+	if err = oprot.WriteStructBegin("PromptDraft"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3533,10 +4020,6 @@ func (p *PromptTemplate) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3555,12 +4038,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptTemplate) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTemplateType() {
-		if err = oprot.WriteFieldBegin("template_type", thrift.STRING, 1); err != nil {
+func (p *PromptDraft) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDetail() {
+		if err = oprot.WriteFieldBegin("detail", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.TemplateType); err != nil {
+		if err := p.Detail.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3573,20 +4056,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *PromptTemplate) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMessages() {
-		if err = oprot.WriteFieldBegin("messages", thrift.LIST, 2); err != nil {
+func (p *PromptDraft) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDraftInfo() {
+		if err = oprot.WriteFieldBegin("draft_info", thrift.STRUCT, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Messages)); err != nil {
-			return err
-		}
-		for _, v := range p.Messages {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := p.DraftInfo.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3599,154 +4074,164 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *PromptTemplate) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVariableDefs() {
-		if err = oprot.WriteFieldBegin("variable_defs", thrift.LIST, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VariableDefs)); err != nil {
-			return err
-		}
-		for _, v := range p.VariableDefs {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
 
-func (p *PromptTemplate) String() string {
+func (p *PromptDraft) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptTemplate(%+v)", *p)
+	return fmt.Sprintf("PromptDraft(%+v)", *p)
 
 }
 
-func (p *PromptTemplate) DeepEqual(ano *PromptTemplate) bool {
+func (p *PromptDraft) DeepEqual(ano *PromptDraft) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TemplateType) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Messages) {
+	if !p.Field1DeepEqual(ano.Detail) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.VariableDefs) {
+	if !p.Field2DeepEqual(ano.DraftInfo) {
 		return false
 	}
 	return true
 }
 
-func (p *PromptTemplate) Field1DeepEqual(src *TemplateType) bool {
-
-	if p.TemplateType == src {
-		return true
-	} else if p.TemplateType == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.TemplateType, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *PromptTemplate) Field2DeepEqual(src []*Message) bool {
+func (p *PromptDraft) Field1DeepEqual(src *PromptDetail) bool {
 
-	if len(p.Messages) != len(src) {
+	if !p.Detail.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.Messages {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *PromptTemplate) Field3DeepEqual(src []*VariableDef) bool {
+func (p *PromptDraft) Field2DeepEqual(src *DraftInfo) bool {
 
-	if len(p.VariableDefs) != len(src) {
+	if !p.DraftInfo.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.VariableDefs {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
 
-type Tool struct {
-	Type     *ToolType `thrift:"type,1,optional" frugal:"1,optional,string" form:"type" json:"type,omitempty" query:"type"`
-	Function *Function `thrift:"function,2,optional" frugal:"2,optional,Function" form:"function" json:"function,omitempty" query:"function"`
+type DraftInfo struct {
+	UserID      *string `thrift:"user_id,1,optional" frugal:"1,optional,string" form:"user_id" json:"user_id,omitempty" query:"user_id"`
+	BaseVersion *string `thrift:"base_version,2,optional" frugal:"2,optional,string" form:"base_version" json:"base_version,omitempty" query:"base_version"`
+	IsModified  *bool   `thrift:"is_modified,3,optional" frugal:"3,optional,bool" form:"is_modified" json:"is_modified,omitempty" query:"is_modified"`
+	CreatedAt   *int64  `thrift:"created_at,11,optional" frugal:"11,optional,i64" json:"created_at" form:"created_at" query:"created_at"`
+	UpdatedAt   *int64  `thrift:"updated_at,12,optional" frugal:"12,optional,i64" json:"updated_at" form:"updated_at" query:"updated_at"`
 }
 
-func NewTool() *Tool {
-	return &Tool{}
+func NewDraftInfo() *DraftInfo {
+	return &DraftInfo{}
 }
 
-func (p *Tool) InitDefault() {
+func (p *DraftInfo) InitDefault() {
 }
 
-var Tool_Type_DEFAULT ToolType
+var DraftInfo_UserID_DEFAULT string
 
-func (p *Tool) GetType() (v ToolType) {
+func (p *DraftInfo) GetUserID() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetType() {
-		return Tool_Type_DEFAULT
+	if !p.IsSetUserID() {
+		return DraftInfo_UserID_DEFAULT
 	}
-	return *p.Type
+	return *p.UserID
 }
 
-var Tool_Function_DEFAULT *Function
+var DraftInfo_BaseVersion_DEFAULT string
 
-func (p *Tool) GetFunction() (v *Function) {
+func (p *DraftInfo) GetBaseVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFunction() {
-		return Tool_Function_DEFAULT
+	if !p.IsSetBaseVersion() {
+		return DraftInfo_BaseVersion_DEFAULT
 	}
-	return p.Function
-}
-func (p *Tool) SetType(val *ToolType) {
-	p.Type = val
-}
-func (p *Tool) SetFunction(val *Function) {
-	p.Function = val
+	return *p.BaseVersion
 }
 
-var fieldIDToName_Tool = map[int16]string{
-	1: "type",
-	2: "function",
-}
+var DraftInfo_IsModified_DEFAULT bool
 
-func (p *Tool) IsSetType() bool {
-	return p.Type != nil
+func (p *DraftInfo) GetIsModified() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetIsModified() {
+		return DraftInfo_IsModified_DEFAULT
+	}
+	return *p.IsModified
 }
 
-func (p *Tool) IsSetFunction() bool {
-	return p.Function != nil
+var DraftInfo_CreatedAt_DEFAULT int64
+
+func (p *DraftInfo) GetCreatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCreatedAt() {
+		return DraftInfo_CreatedAt_DEFAULT
+	}
+	return *p.CreatedAt
 }
 
-func (p *Tool) Read(iprot thrift.TProtocol) (err error) {
+var DraftInfo_UpdatedAt_DEFAULT int64
+
+func (p *DraftInfo) GetUpdatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUpdatedAt() {
+		return DraftInfo_UpdatedAt_DEFAULT
+	}
+	return *p.UpdatedAt
+}
+func (p *DraftInfo) SetUserID(val *string) {
+	p.UserID = val
+}
+func (p *DraftInfo) SetBaseVersion(val *string) {
+	p.BaseVersion = val
+}
+func (p *DraftInfo) SetIsModified(val *bool) {
+	p.IsModified = val
+}
+func (p *DraftInfo) SetCreatedAt(val *int64) {
+	p.CreatedAt = val
+}
+func (p *DraftInfo) SetUpdatedAt(val *int64) {
+	p.UpdatedAt = val
+}
+
+var fieldIDToName_DraftInfo = map[int16]string{
+	1:  "user_id",
+	2:  "base_version",
+	3:  "is_modified",
+	11: "created_at",
+	12: "updated_at",
+}
+
+func (p *DraftInfo) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *DraftInfo) IsSetBaseVersion() bool {
+	return p.BaseVersion != nil
+}
+
+func (p *DraftInfo) IsSetIsModified() bool {
+	return p.IsModified != nil
+}
+
+func (p *DraftInfo) IsSetCreatedAt() bool {
+	return p.CreatedAt != nil
+}
+
+func (p *DraftInfo) IsSetUpdatedAt() bool {
+	return p.UpdatedAt != nil
+}
+
+func (p *DraftInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3773,13 +4258,37 @@ func (p *Tool) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3799,7 +4308,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Tool[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DraftInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3809,29 +4318,65 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *Tool) ReadField1(iprot thrift.TProtocol) error {
+func (p *DraftInfo) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *ToolType
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Type = _field
+	p.UserID = _field
 	return nil
 }
-func (p *Tool) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewFunction()
-	if err := _field.Read(iprot); err != nil {
+func (p *DraftInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Function = _field
+	p.BaseVersion = _field
 	return nil
 }
+func (p *DraftInfo) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *Tool) Write(oprot thrift.TProtocol) (err error) {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsModified = _field
+	return nil
+}
+func (p *DraftInfo) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *DraftInfo) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *DraftInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Tool"); err != nil {
+	if err = oprot.WriteStructBegin("DraftInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3843,6 +4388,18 @@ func (p *Tool) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3861,12 +4418,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Tool) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err = oprot.WriteFieldBegin("type", thrift.STRING, 1); err != nil {
+func (p *DraftInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Type); err != nil {
+		if err := oprot.WriteString(*p.UserID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3879,12 +4436,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Tool) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFunction() {
-		if err = oprot.WriteFieldBegin("function", thrift.STRUCT, 2); err != nil {
+func (p *DraftInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseVersion() {
+		if err = oprot.WriteFieldBegin("base_version", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Function.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.BaseVersion); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3897,127 +4454,273 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *DraftInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsModified() {
+		if err = oprot.WriteFieldBegin("is_modified", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsModified); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *DraftInfo) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedAt() {
+		if err = oprot.WriteFieldBegin("created_at", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *DraftInfo) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedAt() {
+		if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
 
-func (p *Tool) String() string {
+func (p *DraftInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Tool(%+v)", *p)
+	return fmt.Sprintf("DraftInfo(%+v)", *p)
 
 }
 
-func (p *Tool) DeepEqual(ano *Tool) bool {
+func (p *DraftInfo) DeepEqual(ano *DraftInfo) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Type) {
+	if !p.Field1DeepEqual(ano.UserID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Function) {
+	if !p.Field2DeepEqual(ano.BaseVersion) {
 		return false
 	}
-	return true
-}
-
-func (p *Tool) Field1DeepEqual(src *ToolType) bool {
-
-	if p.Type == src {
+	if !p.Field3DeepEqual(ano.IsModified) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.UpdatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *DraftInfo) Field1DeepEqual(src *string) bool {
+
+	if p.UserID == src {
 		return true
-	} else if p.Type == nil || src == nil {
+	} else if p.UserID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Type, *src) != 0 {
+	if strings.Compare(*p.UserID, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *Tool) Field2DeepEqual(src *Function) bool {
+func (p *DraftInfo) Field2DeepEqual(src *string) bool {
 
-	if !p.Function.DeepEqual(src) {
+	if p.BaseVersion == src {
+		return true
+	} else if p.BaseVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.BaseVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *DraftInfo) Field3DeepEqual(src *bool) bool {
 
-type Function struct {
-	Name        *string `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Description *string `thrift:"description,2,optional" frugal:"2,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	Parameters  *string `thrift:"parameters,3,optional" frugal:"3,optional,string" form:"parameters" json:"parameters,omitempty" query:"parameters"`
+	if p.IsModified == src {
+		return true
+	} else if p.IsModified == nil || src == nil {
+		return false
+	}
+	if *p.IsModified != *src {
+		return false
+	}
+	return true
 }
+func (p *DraftInfo) Field11DeepEqual(src *int64) bool {
 
-func NewFunction() *Function {
-	return &Function{}
+	if p.CreatedAt == src {
+		return true
+	} else if p.CreatedAt == nil || src == nil {
+		return false
+	}
+	if *p.CreatedAt != *src {
+		return false
+	}
+	return true
 }
+func (p *DraftInfo) Field12DeepEqual(src *int64) bool {
 
-func (p *Function) InitDefault() {
+	if p.UpdatedAt == src {
+		return true
+	} else if p.UpdatedAt == nil || src == nil {
+		return false
+	}
+	if *p.UpdatedAt != *src {
+		return false
+	}
+	return true
 }
 
-var Function_Name_DEFAULT string
+type PromptDetail struct {
+	PromptTemplate *PromptTemplate   `thrift:"prompt_template,1,optional" frugal:"1,optional,PromptTemplate" form:"prompt_template" json:"prompt_template,omitempty" query:"prompt_template"`
+	Tools          []*Tool           `thrift:"tools,2,optional" frugal:"2,optional,list<Tool>" form:"tools" json:"tools,omitempty" query:"tools"`
+	ToolCallConfig *ToolCallConfig   `thrift:"tool_call_config,3,optional" frugal:"3,optional,ToolCallConfig" form:"tool_call_config" json:"tool_call_config,omitempty" query:"tool_call_config"`
+	ModelConfig    *ModelConfig      `thrift:"model_config,4,optional" frugal:"4,optional,ModelConfig" form:"model_config" json:"model_config,omitempty" query:"model_config"`
+	ExtInfos       map[string]string `thrift:"ext_infos,255,optional" frugal:"255,optional,map<string:string>" form:"ext_infos" json:"ext_infos,omitempty" query:"ext_infos"`
+}
 
-func (p *Function) GetName() (v string) {
+func NewPromptDetail() *PromptDetail {
+	return &PromptDetail{}
+}
+
+func (p *PromptDetail) InitDefault() {
+}
+
+var PromptDetail_PromptTemplate_DEFAULT *PromptTemplate
+
+func (p *PromptDetail) GetPromptTemplate() (v *PromptTemplate) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetName() {
-		return Function_Name_DEFAULT
+	if !p.IsSetPromptTemplate() {
+		return PromptDetail_PromptTemplate_DEFAULT
 	}
-	return *p.Name
+	return p.PromptTemplate
 }
 
-var Function_Description_DEFAULT string
+var PromptDetail_Tools_DEFAULT []*Tool
 
-func (p *Function) GetDescription() (v string) {
+func (p *PromptDetail) GetTools() (v []*Tool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDescription() {
-		return Function_Description_DEFAULT
+	if !p.IsSetTools() {
+		return PromptDetail_Tools_DEFAULT
 	}
-	return *p.Description
+	return p.Tools
 }
 
-var Function_Parameters_DEFAULT string
+var PromptDetail_ToolCallConfig_DEFAULT *ToolCallConfig
 
-func (p *Function) GetParameters() (v string) {
+func (p *PromptDetail) GetToolCallConfig() (v *ToolCallConfig) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetParameters() {
-		return Function_Parameters_DEFAULT
+	if !p.IsSetToolCallConfig() {
+		return PromptDetail_ToolCallConfig_DEFAULT
 	}
-	return *p.Parameters
+	return p.ToolCallConfig
 }
-func (p *Function) SetName(val *string) {
-	p.Name = val
+
+var PromptDetail_ModelConfig_DEFAULT *ModelConfig
+
+func (p *PromptDetail) GetModelConfig() (v *ModelConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelConfig() {
+		return PromptDetail_ModelConfig_DEFAULT
+	}
+	return p.ModelConfig
 }
-func (p *Function) SetDescription(val *string) {
-	p.Description = val
+
+var PromptDetail_ExtInfos_DEFAULT map[string]string
+
+func (p *PromptDetail) GetExtInfos() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExtInfos() {
+		return PromptDetail_ExtInfos_DEFAULT
+	}
+	return p.ExtInfos
 }
-func (p *Function) SetParameters(val *string) {
-	p.Parameters = val
+func (p *PromptDetail) SetPromptTemplate(val *PromptTemplate) {
+	p.PromptTemplate = val
+}
+func (p *PromptDetail) SetTools(val []*Tool) {
+	p.Tools = val
+}
+func (p *PromptDetail) SetToolCallConfig(val *ToolCallConfig) {
+	p.ToolCallConfig = val
+}
+func (p *PromptDetail) SetModelConfig(val *ModelConfig) {
+	p.ModelConfig = val
+}
+func (p *PromptDetail) SetExtInfos(val map[string]string) {
+	p.ExtInfos = val
 }
 
-var fieldIDToName_Function = map[int16]string{
-	1: "name",
-	2: "description",
-	3: "parameters",
+var fieldIDToName_PromptDetail = map[int16]string{
+	1:   "prompt_template",
+	2:   "tools",
+	3:   "tool_call_config",
+	4:   "model_config",
+	255: "ext_infos",
 }
 
-func (p *Function) IsSetName() bool {
-	return p.Name != nil
+func (p *PromptDetail) IsSetPromptTemplate() bool {
+	return p.PromptTemplate != nil
 }
 
-func (p *Function) IsSetDescription() bool {
-	return p.Description != nil
+func (p *PromptDetail) IsSetTools() bool {
+	return p.Tools != nil
 }
 
-func (p *Function) IsSetParameters() bool {
-	return p.Parameters != nil
+func (p *PromptDetail) IsSetToolCallConfig() bool {
+	return p.ToolCallConfig != nil
 }
 
-func (p *Function) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptDetail) IsSetModelConfig() bool {
+	return p.ModelConfig != nil
+}
+
+func (p *PromptDetail) IsSetExtInfos() bool {
+	return p.ExtInfos != nil
+}
+
+func (p *PromptDetail) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4036,7 +4739,7 @@ func (p *Function) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4044,7 +4747,7 @@ func (p *Function) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4052,13 +4755,29 @@ func (p *Function) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4078,7 +4797,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Function[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDetail[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4088,43 +4807,86 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *Function) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *PromptDetail) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPromptTemplate()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Name = _field
+	p.PromptTemplate = _field
 	return nil
 }
-func (p *Function) ReadField2(iprot thrift.TProtocol) error {
+func (p *PromptDetail) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Tool, 0, size)
+	values := make([]Tool, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Description = _field
+	p.Tools = _field
 	return nil
 }
-func (p *Function) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *PromptDetail) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewToolCallConfig()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Parameters = _field
+	p.ToolCallConfig = _field
 	return nil
 }
-
-func (p *Function) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptDetail) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewModelConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ModelConfig = _field
+	return nil
+}
+func (p *PromptDetail) ReadField255(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.ExtInfos = _field
+	return nil
+}
+
+func (p *PromptDetail) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Function"); err != nil {
+	if err = oprot.WriteStructBegin("PromptDetail"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4140,6 +4902,14 @@ func (p *Function) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4158,12 +4928,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Function) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+func (p *PromptDetail) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptTemplate() {
+		if err = oprot.WriteFieldBegin("prompt_template", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := p.PromptTemplate.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4176,12 +4946,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Function) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 2); err != nil {
+func (p *PromptDetail) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTools() {
+		if err = oprot.WriteFieldBegin("tools", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Description); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Tools)); err != nil {
+			return err
+		}
+		for _, v := range p.Tools {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4194,12 +4972,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *Function) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetParameters() {
-		if err = oprot.WriteFieldBegin("parameters", thrift.STRING, 3); err != nil {
+func (p *PromptDetail) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolCallConfig() {
+		if err = oprot.WriteFieldBegin("tool_call_config", thrift.STRUCT, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Parameters); err != nil {
+		if err := p.ToolCallConfig.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4212,116 +4990,222 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *PromptDetail) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelConfig() {
+		if err = oprot.WriteFieldBegin("model_config", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ModelConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *PromptDetail) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExtInfos() {
+		if err = oprot.WriteFieldBegin("ext_infos", thrift.MAP, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.ExtInfos)); err != nil {
+			return err
+		}
+		for k, v := range p.ExtInfos {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
 
-func (p *Function) String() string {
+func (p *PromptDetail) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Function(%+v)", *p)
+	return fmt.Sprintf("PromptDetail(%+v)", *p)
 
 }
 
-func (p *Function) DeepEqual(ano *Function) bool {
+func (p *PromptDetail) DeepEqual(ano *PromptDetail) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Name) {
+	if !p.Field1DeepEqual(ano.PromptTemplate) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Description) {
+	if !p.Field2DeepEqual(ano.Tools) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Parameters) {
+	if !p.Field3DeepEqual(ano.ToolCallConfig) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ModelConfig) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.ExtInfos) {
 		return false
 	}
 	return true
 }
 
-func (p *Function) Field1DeepEqual(src *string) bool {
+func (p *PromptDetail) Field1DeepEqual(src *PromptTemplate) bool {
 
-	if p.Name == src {
-		return true
-	} else if p.Name == nil || src == nil {
+	if !p.PromptTemplate.DeepEqual(src) {
 		return false
 	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	return true
+}
+func (p *PromptDetail) Field2DeepEqual(src []*Tool) bool {
+
+	if len(p.Tools) != len(src) {
 		return false
 	}
+	for i, v := range p.Tools {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *Function) Field2DeepEqual(src *string) bool {
+func (p *PromptDetail) Field3DeepEqual(src *ToolCallConfig) bool {
 
-	if p.Description == src {
-		return true
-	} else if p.Description == nil || src == nil {
+	if !p.ToolCallConfig.DeepEqual(src) {
 		return false
 	}
-	if strings.Compare(*p.Description, *src) != 0 {
+	return true
+}
+func (p *PromptDetail) Field4DeepEqual(src *ModelConfig) bool {
+
+	if !p.ModelConfig.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *Function) Field3DeepEqual(src *string) bool {
+func (p *PromptDetail) Field255DeepEqual(src map[string]string) bool {
 
-	if p.Parameters == src {
-		return true
-	} else if p.Parameters == nil || src == nil {
+	if len(p.ExtInfos) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.Parameters, *src) != 0 {
-		return false
+	for k, v := range p.ExtInfos {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
 	}
 	return true
 }
 
-type ToolCallConfig struct {
-	ToolChoice *ToolChoiceType `thrift:"tool_choice,1,optional" frugal:"1,optional,string" form:"tool_choice" json:"tool_choice,omitempty" query:"tool_choice"`
+type PromptTemplate struct {
+	TemplateType *TemplateType  `thrift:"template_type,1,optional" frugal:"1,optional,string" form:"template_type" json:"template_type,omitempty" query:"template_type"`
+	Messages     []*Message     `thrift:"messages,2,optional" frugal:"2,optional,list<Message>" form:"messages" json:"messages,omitempty" query:"messages"`
+	VariableDefs []*VariableDef `thrift:"variable_defs,3,optional" frugal:"3,optional,list<VariableDef>" form:"variable_defs" json:"variable_defs,omitempty" query:"variable_defs"`
 }
 
-func NewToolCallConfig() *ToolCallConfig {
-	return &ToolCallConfig{}
+func NewPromptTemplate() *PromptTemplate {
+	return &PromptTemplate{}
 }
 
-func (p *ToolCallConfig) InitDefault() {
+func (p *PromptTemplate) InitDefault() {
 }
 
-var ToolCallConfig_ToolChoice_DEFAULT ToolChoiceType
+var PromptTemplate_TemplateType_DEFAULT TemplateType
 
-func (p *ToolCallConfig) GetToolChoice() (v ToolChoiceType) {
+func (p *PromptTemplate) GetTemplateType() (v TemplateType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetToolChoice() {
-		return ToolCallConfig_ToolChoice_DEFAULT
+	if !p.IsSetTemplateType() {
+		return PromptTemplate_TemplateType_DEFAULT
 	}
-	return *p.ToolChoice
-}
-func (p *ToolCallConfig) SetToolChoice(val *ToolChoiceType) {
-	p.ToolChoice = val
+	return *p.TemplateType
 }
 
-var fieldIDToName_ToolCallConfig = map[int16]string{
-	1: "tool_choice",
-}
+var PromptTemplate_Messages_DEFAULT []*Message
 
-func (p *ToolCallConfig) IsSetToolChoice() bool {
-	return p.ToolChoice != nil
+func (p *PromptTemplate) GetMessages() (v []*Message) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMessages() {
+		return PromptTemplate_Messages_DEFAULT
+	}
+	return p.Messages
 }
 
-func (p *ToolCallConfig) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
+var PromptTemplate_VariableDefs_DEFAULT []*VariableDef
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *PromptTemplate) GetVariableDefs() (v []*VariableDef) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetVariableDefs() {
+		return PromptTemplate_VariableDefs_DEFAULT
 	}
+	return p.VariableDefs
+}
+func (p *PromptTemplate) SetTemplateType(val *TemplateType) {
+	p.TemplateType = val
+}
+func (p *PromptTemplate) SetMessages(val []*Message) {
+	p.Messages = val
+}
+func (p *PromptTemplate) SetVariableDefs(val []*VariableDef) {
+	p.VariableDefs = val
+}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
+var fieldIDToName_PromptTemplate = map[int16]string{
+	1: "template_type",
+	2: "messages",
+	3: "variable_defs",
+}
+
+func (p *PromptTemplate) IsSetTemplateType() bool {
+	return p.TemplateType != nil
+}
+
+func (p *PromptTemplate) IsSetMessages() bool {
+	return p.Messages != nil
+}
+
+func (p *PromptTemplate) IsSetVariableDefs() bool {
+	return p.VariableDefs != nil
+}
+
+func (p *PromptTemplate) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
 		}
 		if fieldTypeId == thrift.STOP {
 			break
@@ -4336,6 +5220,22 @@ func (p *ToolCallConfig) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4355,7 +5255,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCallConfig[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptTemplate[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4365,21 +5265,67 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ToolCallConfig) ReadField1(iprot thrift.TProtocol) error {
+func (p *PromptTemplate) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *ToolChoiceType
+	var _field *TemplateType
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ToolChoice = _field
+	p.TemplateType = _field
+	return nil
+}
+func (p *PromptTemplate) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Message, 0, size)
+	values := make([]Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Messages = _field
 	return nil
 }
+func (p *PromptTemplate) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*VariableDef, 0, size)
+	values := make([]VariableDef, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *ToolCallConfig) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VariableDefs = _field
+	return nil
+}
+
+func (p *PromptTemplate) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ToolCallConfig"); err != nil {
+	if err = oprot.WriteStructBegin("PromptTemplate"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4387,6 +5333,14 @@ func (p *ToolCallConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4405,12 +5359,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ToolCallConfig) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetToolChoice() {
-		if err = oprot.WriteFieldBegin("tool_choice", thrift.STRING, 1); err != nil {
+func (p *PromptTemplate) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTemplateType() {
+		if err = oprot.WriteFieldBegin("template_type", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ToolChoice); err != nil {
+		if err := oprot.WriteString(*p.TemplateType); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4423,222 +5377,3563 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *PromptTemplate) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessages() {
+		if err = oprot.WriteFieldBegin("messages", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Messages)); err != nil {
+			return err
+		}
+		for _, v := range p.Messages {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *PromptTemplate) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVariableDefs() {
+		if err = oprot.WriteFieldBegin("variable_defs", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VariableDefs)); err != nil {
+			return err
+		}
+		for _, v := range p.VariableDefs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
-func (p *ToolCallConfig) String() string {
+func (p *PromptTemplate) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ToolCallConfig(%+v)", *p)
+	return fmt.Sprintf("PromptTemplate(%+v)", *p)
 
 }
 
-func (p *ToolCallConfig) DeepEqual(ano *ToolCallConfig) bool {
+func (p *PromptTemplate) DeepEqual(ano *PromptTemplate) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ToolChoice) {
+	if !p.Field1DeepEqual(ano.TemplateType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Messages) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VariableDefs) {
 		return false
 	}
 	return true
 }
 
-func (p *ToolCallConfig) Field1DeepEqual(src *ToolChoiceType) bool {
+func (p *PromptTemplate) Field1DeepEqual(src *TemplateType) bool {
 
-	if p.ToolChoice == src {
+	if p.TemplateType == src {
 		return true
-	} else if p.ToolChoice == nil || src == nil {
+	} else if p.TemplateType == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.ToolChoice, *src) != 0 {
+	if strings.Compare(*p.TemplateType, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *PromptTemplate) Field2DeepEqual(src []*Message) bool {
 
-type ModelConfig struct {
-	ModelID          *int64   `thrift:"model_id,1,optional" frugal:"1,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
-	MaxTokens        *int32   `thrift:"max_tokens,2,optional" frugal:"2,optional,i32" form:"max_tokens" json:"max_tokens,omitempty" query:"max_tokens"`
-	Temperature      *float64 `thrift:"temperature,3,optional" frugal:"3,optional,double" form:"temperature" json:"temperature,omitempty" query:"temperature"`
-	TopK             *int32   `thrift:"top_k,4,optional" frugal:"4,optional,i32" form:"top_k" json:"top_k,omitempty" query:"top_k"`
-	TopP             *float64 `thrift:"top_p,5,optional" frugal:"5,optional,double" form:"top_p" json:"top_p,omitempty" query:"top_p"`
-	PresencePenalty  *float64 `thrift:"presence_penalty,6,optional" frugal:"6,optional,double" form:"presence_penalty" json:"presence_penalty,omitempty" query:"presence_penalty"`
-	FrequencyPenalty *float64 `thrift:"frequency_penalty,7,optional" frugal:"7,optional,double" form:"frequency_penalty" json:"frequency_penalty,omitempty" query:"frequency_penalty"`
-	JSONMode         *bool    `thrift:"json_mode,8,optional" frugal:"8,optional,bool" form:"json_mode" json:"json_mode,omitempty" query:"json_mode"`
+	if len(p.Messages) != len(src) {
+		return false
+	}
+	for i, v := range p.Messages {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
+func (p *PromptTemplate) Field3DeepEqual(src []*VariableDef) bool {
 
-func NewModelConfig() *ModelConfig {
-	return &ModelConfig{}
+	if len(p.VariableDefs) != len(src) {
+		return false
+	}
+	for i, v := range p.VariableDefs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
 
-func (p *ModelConfig) InitDefault() {
+type Tool struct {
+	Type     *ToolType `thrift:"type,1,optional" frugal:"1,optional,string" form:"type" json:"type,omitempty" query:"type"`
+	Function *Function `thrift:"function,2,optional" frugal:"2,optional,Function" form:"function" json:"function,omitempty" query:"function"`
 }
 
-var ModelConfig_ModelID_DEFAULT int64
+func NewTool() *Tool {
+	return &Tool{}
+}
 
-func (p *ModelConfig) GetModelID() (v int64) {
+func (p *Tool) InitDefault() {
+}
+
+var Tool_Type_DEFAULT ToolType
+
+func (p *Tool) GetType() (v ToolType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetModelID() {
-		return ModelConfig_ModelID_DEFAULT
+	if !p.IsSetType() {
+		return Tool_Type_DEFAULT
 	}
-	return *p.ModelID
+	return *p.Type
 }
 
-var ModelConfig_MaxTokens_DEFAULT int32
+var Tool_Function_DEFAULT *Function
 
-func (p *ModelConfig) GetMaxTokens() (v int32) {
+func (p *Tool) GetFunction() (v *Function) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetMaxTokens() {
-		return ModelConfig_MaxTokens_DEFAULT
+	if !p.IsSetFunction() {
+		return Tool_Function_DEFAULT
+	}
+	return p.Function
+}
+func (p *Tool) SetType(val *ToolType) {
+	p.Type = val
+}
+func (p *Tool) SetFunction(val *Function) {
+	p.Function = val
+}
+
+var fieldIDToName_Tool = map[int16]string{
+	1: "type",
+	2: "function",
+}
+
+func (p *Tool) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *Tool) IsSetFunction() bool {
+	return p.Function != nil
+}
+
+func (p *Tool) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Tool[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Tool) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *ToolType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *Tool) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewFunction()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Function = _field
+	return nil
+}
+
+func (p *Tool) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Tool"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Tool) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Type); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Tool) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFunction() {
+		if err = oprot.WriteFieldBegin("function", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Function.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *Tool) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Tool(%+v)", *p)
+
+}
+
+func (p *Tool) DeepEqual(ano *Tool) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Function) {
+		return false
+	}
+	return true
+}
+
+func (p *Tool) Field1DeepEqual(src *ToolType) bool {
+
+	if p.Type == src {
+		return true
+	} else if p.Type == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Type, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Tool) Field2DeepEqual(src *Function) bool {
+
+	if !p.Function.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type Function struct {
+	Name        *string `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Description *string `thrift:"description,2,optional" frugal:"2,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	Parameters  *string `thrift:"parameters,3,optional" frugal:"3,optional,string" form:"parameters" json:"parameters,omitempty" query:"parameters"`
+}
+
+func NewFunction() *Function {
+	return &Function{}
+}
+
+func (p *Function) InitDefault() {
+}
+
+var Function_Name_DEFAULT string
+
+func (p *Function) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return Function_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var Function_Description_DEFAULT string
+
+func (p *Function) GetDescription() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDescription() {
+		return Function_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var Function_Parameters_DEFAULT string
+
+func (p *Function) GetParameters() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetParameters() {
+		return Function_Parameters_DEFAULT
+	}
+	return *p.Parameters
+}
+func (p *Function) SetName(val *string) {
+	p.Name = val
+}
+func (p *Function) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *Function) SetParameters(val *string) {
+	p.Parameters = val
+}
+
+var fieldIDToName_Function = map[int16]string{
+	1: "name",
+	2: "description",
+	3: "parameters",
+}
+
+func (p *Function) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *Function) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *Function) IsSetParameters() bool {
+	return p.Parameters != nil
+}
+
+func (p *Function) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Function[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Function) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *Function) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *Function) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Parameters = _field
+	return nil
+}
+
+func (p *Function) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Function"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Function) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Function) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Function) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParameters() {
+		if err = oprot.WriteFieldBegin("parameters", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Parameters); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *Function) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Function(%+v)", *p)
+
+}
+
+func (p *Function) DeepEqual(ano *Function) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Parameters) {
+		return false
+	}
+	return true
+}
+
+func (p *Function) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Function) Field2DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Function) Field3DeepEqual(src *string) bool {
+
+	if p.Parameters == src {
+		return true
+	} else if p.Parameters == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Parameters, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type ToolCallConfig struct {
+	ToolChoice *ToolChoiceType `thrift:"tool_choice,1,optional" frugal:"1,optional,string" form:"tool_choice" json:"tool_choice,omitempty" query:"tool_choice"`
+}
+
+func NewToolCallConfig() *ToolCallConfig {
+	return &ToolCallConfig{}
+}
+
+func (p *ToolCallConfig) InitDefault() {
+}
+
+var ToolCallConfig_ToolChoice_DEFAULT ToolChoiceType
+
+func (p *ToolCallConfig) GetToolChoice() (v ToolChoiceType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetToolChoice() {
+		return ToolCallConfig_ToolChoice_DEFAULT
+	}
+	return *p.ToolChoice
+}
+func (p *ToolCallConfig) SetToolChoice(val *ToolChoiceType) {
+	p.ToolChoice = val
+}
+
+var fieldIDToName_ToolCallConfig = map[int16]string{
+	1: "tool_choice",
+}
+
+func (p *ToolCallConfig) IsSetToolChoice() bool {
+	return p.ToolChoice != nil
+}
+
+func (p *ToolCallConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCallConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ToolCallConfig) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *ToolChoiceType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ToolChoice = _field
+	return nil
+}
+
+func (p *ToolCallConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ToolCallConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ToolCallConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolChoice() {
+		if err = oprot.WriteFieldBegin("tool_choice", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ToolChoice); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ToolCallConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ToolCallConfig(%+v)", *p)
+
+}
+
+func (p *ToolCallConfig) DeepEqual(ano *ToolCallConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ToolChoice) {
+		return false
+	}
+	return true
+}
+
+func (p *ToolCallConfig) Field1DeepEqual(src *ToolChoiceType) bool {
+
+	if p.ToolChoice == src {
+		return true
+	} else if p.ToolChoice == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ToolChoice, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type ModelConfig struct {
+	ModelID          *int64   `thrift:"model_id,1,optional" frugal:"1,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	MaxTokens        *int32   `thrift:"max_tokens,2,optional" frugal:"2,optional,i32" form:"max_tokens" json:"max_tokens,omitempty" query:"max_tokens"`
+	Temperature      *float64 `thrift:"temperature,3,optional" frugal:"3,optional,double" form:"temperature" json:"temperature,omitempty" query:"temperature"`
+	TopK             *int32   `thrift:"top_k,4,optional" frugal:"4,optional,i32" form:"top_k" json:"top_k,omitempty" query:"top_k"`
+	TopP             *float64 `thrift:"top_p,5,optional" frugal:"5,optional,double" form:"top_p" json:"top_p,omitempty" query:"top_p"`
+	PresencePenalty  *float64 `thrift:"presence_penalty,6,optional" frugal:"6,optional,double" form:"presence_penalty" json:"presence_penalty,omitempty" query:"presence_penalty"`
+	FrequencyPenalty *float64 `thrift:"frequency_penalty,7,optional" frugal:"7,optional,double" form:"frequency_penalty" json:"frequency_penalty,omitempty" query:"frequency_penalty"`
+	JSONMode         *bool    `thrift:"json_mode,8,optional" frugal:"8,optional,bool" form:"json_mode" json:"json_mode,omitempty" query:"json_mode"`
+}
+
+func NewModelConfig() *ModelConfig {
+	return &ModelConfig{}
+}
+
+func (p *ModelConfig) InitDefault() {
+}
+
+var ModelConfig_ModelID_DEFAULT int64
+
+func (p *ModelConfig) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return ModelConfig_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var ModelConfig_MaxTokens_DEFAULT int32
+
+func (p *ModelConfig) GetMaxTokens() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxTokens() {
+		return ModelConfig_MaxTokens_DEFAULT
 	}
 	return *p.MaxTokens
 }
 
-var ModelConfig_Temperature_DEFAULT float64
+var ModelConfig_Temperature_DEFAULT float64
+
+func (p *ModelConfig) GetTemperature() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTemperature() {
+		return ModelConfig_Temperature_DEFAULT
+	}
+	return *p.Temperature
+}
+
+var ModelConfig_TopK_DEFAULT int32
+
+func (p *ModelConfig) GetTopK() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTopK() {
+		return ModelConfig_TopK_DEFAULT
+	}
+	return *p.TopK
+}
+
+var ModelConfig_TopP_DEFAULT float64
+
+func (p *ModelConfig) GetTopP() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTopP() {
+		return ModelConfig_TopP_DEFAULT
+	}
+	return *p.TopP
+}
+
+var ModelConfig_PresencePenalty_DEFAULT float64
+
+func (p *ModelConfig) GetPresencePenalty() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPresencePenalty() {
+		return ModelConfig_PresencePenalty_DEFAULT
+	}
+	return *p.PresencePenalty
+}
+
+var ModelConfig_FrequencyPenalty_DEFAULT float64
+
+func (p *ModelConfig) GetFrequencyPenalty() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFrequencyPenalty() {
+		return ModelConfig_FrequencyPenalty_DEFAULT
+	}
+	return *p.FrequencyPenalty
+}
+
+var ModelConfig_JSONMode_DEFAULT bool
+
+func (p *ModelConfig) GetJSONMode() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetJSONMode() {
+		return ModelConfig_JSONMode_DEFAULT
+	}
+	return *p.JSONMode
+}
+func (p *ModelConfig) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *ModelConfig) SetMaxTokens(val *int32) {
+	p.MaxTokens = val
+}
+func (p *ModelConfig) SetTemperature(val *float64) {
+	p.Temperature = val
+}
+func (p *ModelConfig) SetTopK(val *int32) {
+	p.TopK = val
+}
+func (p *ModelConfig) SetTopP(val *float64) {
+	p.TopP = val
+}
+func (p *ModelConfig) SetPresencePenalty(val *float64) {
+	p.PresencePenalty = val
+}
+func (p *ModelConfig) SetFrequencyPenalty(val *float64) {
+	p.FrequencyPenalty = val
+}
+func (p *ModelConfig) SetJSONMode(val *bool) {
+	p.JSONMode = val
+}
+
+var fieldIDToName_ModelConfig = map[int16]string{
+	1: "model_id",
+	2: "max_tokens",
+	3: "temperature",
+	4: "top_k",
+	5: "top_p",
+	6: "presence_penalty",
+	7: "frequency_penalty",
+	8: "json_mode",
+}
+
+func (p *ModelConfig) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *ModelConfig) IsSetMaxTokens() bool {
+	return p.MaxTokens != nil
+}
+
+func (p *ModelConfig) IsSetTemperature() bool {
+	return p.Temperature != nil
+}
+
+func (p *ModelConfig) IsSetTopK() bool {
+	return p.TopK != nil
+}
+
+func (p *ModelConfig) IsSetTopP() bool {
+	return p.TopP != nil
+}
+
+func (p *ModelConfig) IsSetPresencePenalty() bool {
+	return p.PresencePenalty != nil
+}
+
+func (p *ModelConfig) IsSetFrequencyPenalty() bool {
+	return p.FrequencyPenalty != nil
+}
+
+func (p *ModelConfig) IsSetJSONMode() bool {
+	return p.JSONMode != nil
+}
+
+func (p *ModelConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModelConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ModelConfig) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *ModelConfig) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxTokens = _field
+	return nil
+}
+func (p *ModelConfig) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Temperature = _field
+	return nil
+}
+func (p *ModelConfig) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TopK = _field
+	return nil
+}
+func (p *ModelConfig) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TopP = _field
+	return nil
+}
+func (p *ModelConfig) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PresencePenalty = _field
+	return nil
+}
+func (p *ModelConfig) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FrequencyPenalty = _field
+	return nil
+}
+func (p *ModelConfig) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.JSONMode = _field
+	return nil
+}
+
+func (p *ModelConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ModelConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModelConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ModelConfig) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxTokens() {
+		if err = oprot.WriteFieldBegin("max_tokens", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ModelConfig) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTemperature() {
+		if err = oprot.WriteFieldBegin("temperature", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Temperature); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ModelConfig) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTopK() {
+		if err = oprot.WriteFieldBegin("top_k", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.TopK); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ModelConfig) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTopP() {
+		if err = oprot.WriteFieldBegin("top_p", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TopP); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ModelConfig) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPresencePenalty() {
+		if err = oprot.WriteFieldBegin("presence_penalty", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PresencePenalty); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ModelConfig) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFrequencyPenalty() {
+		if err = oprot.WriteFieldBegin("frequency_penalty", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.FrequencyPenalty); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ModelConfig) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJSONMode() {
+		if err = oprot.WriteFieldBegin("json_mode", thrift.BOOL, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.JSONMode); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ModelConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModelConfig(%+v)", *p)
+
+}
+
+func (p *ModelConfig) DeepEqual(ano *ModelConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.MaxTokens) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Temperature) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TopK) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.TopP) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.PresencePenalty) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.FrequencyPenalty) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.JSONMode) {
+		return false
+	}
+	return true
+}
+
+func (p *ModelConfig) Field1DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelConfig) Field2DeepEqual(src *int32) bool {
+
+	if p.MaxTokens == src {
+		return true
+	} else if p.MaxTokens == nil || src == nil {
+		return false
+	}
+	if *p.MaxTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelConfig) Field3DeepEqual(src *float64) bool {
+
+	if p.Temperature == src {
+		return true
+	} else if p.Temperature == nil || src == nil {
+		return false
+	}
+	if *p.Temperature != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelConfig) Field4DeepEqual(src *int32) bool {
+
+	if p.TopK == src {
+		return true
+	} else if p.TopK == nil || src == nil {
+		return false
+	}
+	if *p.TopK != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelConfig) Field5DeepEqual(src *float64) bool {
+
+	if p.TopP == src {
+		return true
+	} else if p.TopP == nil || src == nil {
+		return false
+	}
+	if *p.TopP != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelConfig) Field6DeepEqual(src *float64) bool {
+
+	if p.PresencePenalty == src {
+		return true
+	} else if p.PresencePenalty == nil || src == nil {
+		return false
+	}
+	if *p.PresencePenalty != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelConfig) Field7DeepEqual(src *float64) bool {
+
+	if p.FrequencyPenalty == src {
+		return true
+	} else if p.FrequencyPenalty == nil || src == nil {
+		return false
+	}
+	if *p.FrequencyPenalty != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelConfig) Field8DeepEqual(src *bool) bool {
+
+	if p.JSONMode == src {
+		return true
+	} else if p.JSONMode == nil || src == nil {
+		return false
+	}
+	if *p.JSONMode != *src {
+		return false
+	}
+	return true
+}
+
+type Message struct {
+	Role             *Role          `thrift:"role,1,optional" frugal:"1,optional,string" form:"role" json:"role,omitempty" query:"role"`
+	ReasoningContent *string        `thrift:"reasoning_content,2,optional" frugal:"2,optional,string" form:"reasoning_content" json:"reasoning_content,omitempty" query:"reasoning_content"`
+	Content          *string        `thrift:"content,3,optional" frugal:"3,optional,string" form:"content" json:"content,omitempty" query:"content"`
+	Parts            []*ContentPart `thrift:"parts,4,optional" frugal:"4,optional,list<ContentPart>" form:"parts" json:"parts,omitempty" query:"parts"`
+	ToolCallID       *string        `thrift:"tool_call_id,5,optional" frugal:"5,optional,string" form:"tool_call_id" json:"tool_call_id,omitempty" query:"tool_call_id"`
+	ToolCalls        []*ToolCall    `thrift:"tool_calls,6,optional" frugal:"6,optional,list<ToolCall>" form:"tool_calls" json:"tool_calls,omitempty" query:"tool_calls"`
+}
+
+func NewMessage() *Message {
+	return &Message{}
+}
+
+func (p *Message) InitDefault() {
+}
+
+var Message_Role_DEFAULT Role
+
+func (p *Message) GetRole() (v Role) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRole() {
+		return Message_Role_DEFAULT
+	}
+	return *p.Role
+}
+
+var Message_ReasoningContent_DEFAULT string
+
+func (p *Message) GetReasoningContent() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReasoningContent() {
+		return Message_ReasoningContent_DEFAULT
+	}
+	return *p.ReasoningContent
+}
+
+var Message_Content_DEFAULT string
+
+func (p *Message) GetContent() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetContent() {
+		return Message_Content_DEFAULT
+	}
+	return *p.Content
+}
+
+var Message_Parts_DEFAULT []*ContentPart
+
+func (p *Message) GetParts() (v []*ContentPart) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetParts() {
+		return Message_Parts_DEFAULT
+	}
+	return p.Parts
+}
+
+var Message_ToolCallID_DEFAULT string
+
+func (p *Message) GetToolCallID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetToolCallID() {
+		return Message_ToolCallID_DEFAULT
+	}
+	return *p.ToolCallID
+}
+
+var Message_ToolCalls_DEFAULT []*ToolCall
+
+func (p *Message) GetToolCalls() (v []*ToolCall) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetToolCalls() {
+		return Message_ToolCalls_DEFAULT
+	}
+	return p.ToolCalls
+}
+func (p *Message) SetRole(val *Role) {
+	p.Role = val
+}
+func (p *Message) SetReasoningContent(val *string) {
+	p.ReasoningContent = val
+}
+func (p *Message) SetContent(val *string) {
+	p.Content = val
+}
+func (p *Message) SetParts(val []*ContentPart) {
+	p.Parts = val
+}
+func (p *Message) SetToolCallID(val *string) {
+	p.ToolCallID = val
+}
+func (p *Message) SetToolCalls(val []*ToolCall) {
+	p.ToolCalls = val
+}
+
+var fieldIDToName_Message = map[int16]string{
+	1: "role",
+	2: "reasoning_content",
+	3: "content",
+	4: "parts",
+	5: "tool_call_id",
+	6: "tool_calls",
+}
+
+func (p *Message) IsSetRole() bool {
+	return p.Role != nil
+}
+
+func (p *Message) IsSetReasoningContent() bool {
+	return p.ReasoningContent != nil
+}
+
+func (p *Message) IsSetContent() bool {
+	return p.Content != nil
+}
+
+func (p *Message) IsSetParts() bool {
+	return p.Parts != nil
+}
+
+func (p *Message) IsSetToolCallID() bool {
+	return p.ToolCallID != nil
+}
+
+func (p *Message) IsSetToolCalls() bool {
+	return p.ToolCalls != nil
+}
+
+func (p *Message) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Message[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Message) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *Role
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Role = _field
+	return nil
+}
+func (p *Message) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReasoningContent = _field
+	return nil
+}
+func (p *Message) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Content = _field
+	return nil
+}
+func (p *Message) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ContentPart, 0, size)
+	values := make([]ContentPart, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Parts = _field
+	return nil
+}
+func (p *Message) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ToolCallID = _field
+	return nil
+}
+func (p *Message) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ToolCall, 0, size)
+	values := make([]ToolCall, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ToolCalls = _field
+	return nil
+}
+
+func (p *Message) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Message"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Message) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRole() {
+		if err = oprot.WriteFieldBegin("role", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Role); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Message) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReasoningContent() {
+		if err = oprot.WriteFieldBegin("reasoning_content", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ReasoningContent); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Message) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContent() {
+		if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Content); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Message) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParts() {
+		if err = oprot.WriteFieldBegin("parts", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Parts)); err != nil {
+			return err
+		}
+		for _, v := range p.Parts {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Message) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolCallID() {
+		if err = oprot.WriteFieldBegin("tool_call_id", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ToolCallID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Message) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolCalls() {
+		if err = oprot.WriteFieldBegin("tool_calls", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ToolCalls)); err != nil {
+			return err
+		}
+		for _, v := range p.ToolCalls {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *Message) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Message(%+v)", *p)
+
+}
+
+func (p *Message) DeepEqual(ano *Message) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Role) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ReasoningContent) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Content) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Parts) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ToolCallID) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ToolCalls) {
+		return false
+	}
+	return true
+}
+
+func (p *Message) Field1DeepEqual(src *Role) bool {
+
+	if p.Role == src {
+		return true
+	} else if p.Role == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Role, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Message) Field2DeepEqual(src *string) bool {
+
+	if p.ReasoningContent == src {
+		return true
+	} else if p.ReasoningContent == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ReasoningContent, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Message) Field3DeepEqual(src *string) bool {
+
+	if p.Content == src {
+		return true
+	} else if p.Content == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Content, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Message) Field4DeepEqual(src []*ContentPart) bool {
+
+	if len(p.Parts) != len(src) {
+		return false
+	}
+	for i, v := range p.Parts {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *Message) Field5DeepEqual(src *string) bool {
+
+	if p.ToolCallID == src {
+		return true
+	} else if p.ToolCallID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ToolCallID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Message) Field6DeepEqual(src []*ToolCall) bool {
+
+	if len(p.ToolCalls) != len(src) {
+		return false
+	}
+	for i, v := range p.ToolCalls {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ContentPart struct {
+	Type     *ContentType `thrift:"type,1,optional" frugal:"1,optional,string" form:"type" json:"type,omitempty" query:"type"`
+	Text     *string      `thrift:"text,2,optional" frugal:"2,optional,string" form:"text" json:"text,omitempty" query:"text"`
+	ImageURL *ImageURL    `thrift:"image_url,3,optional" frugal:"3,optional,ImageURL" form:"image_url" json:"image_url,omitempty" query:"image_url"`
+}
+
+func NewContentPart() *ContentPart {
+	return &ContentPart{}
+}
+
+func (p *ContentPart) InitDefault() {
+}
+
+var ContentPart_Type_DEFAULT ContentType
+
+func (p *ContentPart) GetType() (v ContentType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetType() {
+		return ContentPart_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var ContentPart_Text_DEFAULT string
+
+func (p *ContentPart) GetText() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetText() {
+		return ContentPart_Text_DEFAULT
+	}
+	return *p.Text
+}
+
+var ContentPart_ImageURL_DEFAULT *ImageURL
+
+func (p *ContentPart) GetImageURL() (v *ImageURL) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetImageURL() {
+		return ContentPart_ImageURL_DEFAULT
+	}
+	return p.ImageURL
+}
+func (p *ContentPart) SetType(val *ContentType) {
+	p.Type = val
+}
+func (p *ContentPart) SetText(val *string) {
+	p.Text = val
+}
+func (p *ContentPart) SetImageURL(val *ImageURL) {
+	p.ImageURL = val
+}
+
+var fieldIDToName_ContentPart = map[int16]string{
+	1: "type",
+	2: "text",
+	3: "image_url",
+}
+
+func (p *ContentPart) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *ContentPart) IsSetText() bool {
+	return p.Text != nil
+}
+
+func (p *ContentPart) IsSetImageURL() bool {
+	return p.ImageURL != nil
+}
+
+func (p *ContentPart) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentPart[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContentPart) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *ContentType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *ContentPart) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Text = _field
+	return nil
+}
+func (p *ContentPart) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewImageURL()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ImageURL = _field
+	return nil
+}
+
+func (p *ContentPart) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContentPart"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContentPart) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Type); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ContentPart) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetText() {
+		if err = oprot.WriteFieldBegin("text", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Text); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ContentPart) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImageURL() {
+		if err = oprot.WriteFieldBegin("image_url", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ImageURL.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ContentPart) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContentPart(%+v)", *p)
+
+}
+
+func (p *ContentPart) DeepEqual(ano *ContentPart) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Text) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ImageURL) {
+		return false
+	}
+	return true
+}
+
+func (p *ContentPart) Field1DeepEqual(src *ContentType) bool {
+
+	if p.Type == src {
+		return true
+	} else if p.Type == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Type, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ContentPart) Field2DeepEqual(src *string) bool {
+
+	if p.Text == src {
+		return true
+	} else if p.Text == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Text, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ContentPart) Field3DeepEqual(src *ImageURL) bool {
+
+	if !p.ImageURL.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ImageURL struct {
+	URI *string `thrift:"uri,1,optional" frugal:"1,optional,string" form:"uri" json:"uri,omitempty" query:"uri"`
+	URL *string `thrift:"url,2,optional" frugal:"2,optional,string" form:"url" json:"url,omitempty" query:"url"`
+}
+
+func NewImageURL() *ImageURL {
+	return &ImageURL{}
+}
+
+func (p *ImageURL) InitDefault() {
+}
+
+var ImageURL_URI_DEFAULT string
+
+func (p *ImageURL) GetURI() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURI() {
+		return ImageURL_URI_DEFAULT
+	}
+	return *p.URI
+}
+
+var ImageURL_URL_DEFAULT string
+
+func (p *ImageURL) GetURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURL() {
+		return ImageURL_URL_DEFAULT
+	}
+	return *p.URL
+}
+func (p *ImageURL) SetURI(val *string) {
+	p.URI = val
+}
+func (p *ImageURL) SetURL(val *string) {
+	p.URL = val
+}
+
+var fieldIDToName_ImageURL = map[int16]string{
+	1: "uri",
+	2: "url",
+}
+
+func (p *ImageURL) IsSetURI() bool {
+	return p.URI != nil
+}
+
+func (p *ImageURL) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *ImageURL) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageURL[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ImageURL) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URI = _field
+	return nil
+}
+func (p *ImageURL) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URL = _field
+	return nil
+}
+
+func (p *ImageURL) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ImageURL"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ImageURL) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURI() {
+		if err = oprot.WriteFieldBegin("uri", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URI); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ImageURL) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL() {
+		if err = oprot.WriteFieldBegin("url", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ImageURL) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImageURL(%+v)", *p)
+
+}
+
+func (p *ImageURL) DeepEqual(ano *ImageURL) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.URI) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.URL) {
+		return false
+	}
+	return true
+}
+
+func (p *ImageURL) Field1DeepEqual(src *string) bool {
+
+	if p.URI == src {
+		return true
+	} else if p.URI == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URI, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ImageURL) Field2DeepEqual(src *string) bool {
+
+	if p.URL == src {
+		return true
+	} else if p.URL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URL, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type ToolCall struct {
+	Index        *int64        `thrift:"index,1,optional" frugal:"1,optional,i64" json:"index" form:"index" query:"index"`
+	ID           *string       `thrift:"id,2,optional" frugal:"2,optional,string" form:"id" json:"id,omitempty" query:"id"`
+	Type         *ToolType     `thrift:"type,3,optional" frugal:"3,optional,string" form:"type" json:"type,omitempty" query:"type"`
+	FunctionCall *FunctionCall `thrift:"function_call,4,optional" frugal:"4,optional,FunctionCall" form:"function_call" json:"function_call,omitempty" query:"function_call"`
+}
+
+func NewToolCall() *ToolCall {
+	return &ToolCall{}
+}
+
+func (p *ToolCall) InitDefault() {
+}
+
+var ToolCall_Index_DEFAULT int64
+
+func (p *ToolCall) GetIndex() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetIndex() {
+		return ToolCall_Index_DEFAULT
+	}
+	return *p.Index
+}
+
+var ToolCall_ID_DEFAULT string
+
+func (p *ToolCall) GetID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return ToolCall_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var ToolCall_Type_DEFAULT ToolType
+
+func (p *ToolCall) GetType() (v ToolType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetType() {
+		return ToolCall_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var ToolCall_FunctionCall_DEFAULT *FunctionCall
+
+func (p *ToolCall) GetFunctionCall() (v *FunctionCall) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFunctionCall() {
+		return ToolCall_FunctionCall_DEFAULT
+	}
+	return p.FunctionCall
+}
+func (p *ToolCall) SetIndex(val *int64) {
+	p.Index = val
+}
+func (p *ToolCall) SetID(val *string) {
+	p.ID = val
+}
+func (p *ToolCall) SetType(val *ToolType) {
+	p.Type = val
+}
+func (p *ToolCall) SetFunctionCall(val *FunctionCall) {
+	p.FunctionCall = val
+}
+
+var fieldIDToName_ToolCall = map[int16]string{
+	1: "index",
+	2: "id",
+	3: "type",
+	4: "function_call",
+}
+
+func (p *ToolCall) IsSetIndex() bool {
+	return p.Index != nil
+}
+
+func (p *ToolCall) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *ToolCall) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *ToolCall) IsSetFunctionCall() bool {
+	return p.FunctionCall != nil
+}
+
+func (p *ToolCall) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCall[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ToolCall) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Index = _field
+	return nil
+}
+func (p *ToolCall) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ToolCall) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *ToolType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *ToolCall) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewFunctionCall()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.FunctionCall = _field
+	return nil
+}
+
+func (p *ToolCall) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ToolCall"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ToolCall) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIndex() {
+		if err = oprot.WriteFieldBegin("index", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Index); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ToolCall) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ToolCall) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Type); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ToolCall) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFunctionCall() {
+		if err = oprot.WriteFieldBegin("function_call", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.FunctionCall.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ToolCall) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ToolCall(%+v)", *p)
+
+}
+
+func (p *ToolCall) DeepEqual(ano *ToolCall) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Index) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.FunctionCall) {
+		return false
+	}
+	return true
+}
+
+func (p *ToolCall) Field1DeepEqual(src *int64) bool {
+
+	if p.Index == src {
+		return true
+	} else if p.Index == nil || src == nil {
+		return false
+	}
+	if *p.Index != *src {
+		return false
+	}
+	return true
+}
+func (p *ToolCall) Field2DeepEqual(src *string) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ToolCall) Field3DeepEqual(src *ToolType) bool {
+
+	if p.Type == src {
+		return true
+	} else if p.Type == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Type, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ToolCall) Field4DeepEqual(src *FunctionCall) bool {
+
+	if !p.FunctionCall.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FunctionCall struct {
+	Name      *string `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Arguments *string `thrift:"arguments,2,optional" frugal:"2,optional,string" form:"arguments" json:"arguments,omitempty" query:"arguments"`
+}
+
+func NewFunctionCall() *FunctionCall {
+	return &FunctionCall{}
+}
+
+func (p *FunctionCall) InitDefault() {
+}
+
+var FunctionCall_Name_DEFAULT string
+
+func (p *FunctionCall) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return FunctionCall_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var FunctionCall_Arguments_DEFAULT string
+
+func (p *FunctionCall) GetArguments() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetArguments() {
+		return FunctionCall_Arguments_DEFAULT
+	}
+	return *p.Arguments
+}
+func (p *FunctionCall) SetName(val *string) {
+	p.Name = val
+}
+func (p *FunctionCall) SetArguments(val *string) {
+	p.Arguments = val
+}
+
+var fieldIDToName_FunctionCall = map[int16]string{
+	1: "name",
+	2: "arguments",
+}
+
+func (p *FunctionCall) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *FunctionCall) IsSetArguments() bool {
+	return p.Arguments != nil
+}
+
+func (p *FunctionCall) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FunctionCall[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FunctionCall) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *FunctionCall) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Arguments = _field
+	return nil
+}
+
+func (p *FunctionCall) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FunctionCall"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FunctionCall) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *FunctionCall) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetArguments() {
+		if err = oprot.WriteFieldBegin("arguments", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Arguments); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *FunctionCall) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FunctionCall(%+v)", *p)
+
+}
+
+func (p *FunctionCall) DeepEqual(ano *FunctionCall) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Arguments) {
+		return false
+	}
+	return true
+}
+
+func (p *FunctionCall) Field1DeepEqual(src *string) bool {
 
-func (p *ModelConfig) GetTemperature() (v float64) {
-	if p == nil {
-		return
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
 	}
-	if !p.IsSetTemperature() {
-		return ModelConfig_Temperature_DEFAULT
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
 	}
-	return *p.Temperature
+	return true
 }
+func (p *FunctionCall) Field2DeepEqual(src *string) bool {
 
-var ModelConfig_TopK_DEFAULT int32
-
-func (p *ModelConfig) GetTopK() (v int32) {
-	if p == nil {
-		return
+	if p.Arguments == src {
+		return true
+	} else if p.Arguments == nil || src == nil {
+		return false
 	}
-	if !p.IsSetTopK() {
-		return ModelConfig_TopK_DEFAULT
+	if strings.Compare(*p.Arguments, *src) != 0 {
+		return false
 	}
-	return *p.TopK
+	return true
 }
 
-var ModelConfig_TopP_DEFAULT float64
+type VariableDef struct {
+	Key      *string       `thrift:"key,1,optional" frugal:"1,optional,string" form:"key" json:"key,omitempty" query:"key"`
+	Desc     *string       `thrift:"desc,2,optional" frugal:"2,optional,string" form:"desc" json:"desc,omitempty" query:"desc"`
+	Type     *VariableType `thrift:"type,3,optional" frugal:"3,optional,string" form:"type" json:"type,omitempty" query:"type"`
+	TypeTags []string      `thrift:"type_tags,4,optional" frugal:"4,optional,list<string>" form:"type_tags" json:"type_tags,omitempty" query:"type_tags"`
+}
 
-func (p *ModelConfig) GetTopP() (v float64) {
+func NewVariableDef() *VariableDef {
+	return &VariableDef{}
+}
+
+func (p *VariableDef) InitDefault() {
+}
+
+var VariableDef_Key_DEFAULT string
+
+func (p *VariableDef) GetKey() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTopP() {
-		return ModelConfig_TopP_DEFAULT
+	if !p.IsSetKey() {
+		return VariableDef_Key_DEFAULT
 	}
-	return *p.TopP
+	return *p.Key
 }
 
-var ModelConfig_PresencePenalty_DEFAULT float64
+var VariableDef_Desc_DEFAULT string
 
-func (p *ModelConfig) GetPresencePenalty() (v float64) {
+func (p *VariableDef) GetDesc() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPresencePenalty() {
-		return ModelConfig_PresencePenalty_DEFAULT
+	if !p.IsSetDesc() {
+		return VariableDef_Desc_DEFAULT
 	}
-	return *p.PresencePenalty
+	return *p.Desc
 }
 
-var ModelConfig_FrequencyPenalty_DEFAULT float64
+var VariableDef_Type_DEFAULT VariableType
 
-func (p *ModelConfig) GetFrequencyPenalty() (v float64) {
+func (p *VariableDef) GetType() (v VariableType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFrequencyPenalty() {
-		return ModelConfig_FrequencyPenalty_DEFAULT
+	if !p.IsSetType() {
+		return VariableDef_Type_DEFAULT
 	}
-	return *p.FrequencyPenalty
+	return *p.Type
 }
 
-var ModelConfig_JSONMode_DEFAULT bool
+var VariableDef_TypeTags_DEFAULT []string
 
-func (p *ModelConfig) GetJSONMode() (v bool) {
+func (p *VariableDef) GetTypeTags() (v []string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetJSONMode() {
-		return ModelConfig_JSONMode_DEFAULT
+	if !p.IsSetTypeTags() {
+		return VariableDef_TypeTags_DEFAULT
 	}
-	return *p.JSONMode
-}
-func (p *ModelConfig) SetModelID(val *int64) {
-	p.ModelID = val
-}
-func (p *ModelConfig) SetMaxTokens(val *int32) {
-	p.MaxTokens = val
-}
-func (p *ModelConfig) SetTemperature(val *float64) {
-	p.Temperature = val
-}
-func (p *ModelConfig) SetTopK(val *int32) {
-	p.TopK = val
-}
-func (p *ModelConfig) SetTopP(val *float64) {
-	p.TopP = val
-}
-func (p *ModelConfig) SetPresencePenalty(val *float64) {
-	p.PresencePenalty = val
-}
-func (p *ModelConfig) SetFrequencyPenalty(val *float64) {
-	p.FrequencyPenalty = val
-}
-func (p *ModelConfig) SetJSONMode(val *bool) {
-	p.JSONMode = val
+	return p.TypeTags
 }
-
-var fieldIDToName_ModelConfig = map[int16]string{
-	1: "model_id",
-	2: "max_tokens",
-	3: "temperature",
-	4: "top_k",
-	5: "top_p",
-	6: "presence_penalty",
-	7: "frequency_penalty",
-	8: "json_mode",
+func (p *VariableDef) SetKey(val *string) {
+	p.Key = val
 }
-
-func (p *ModelConfig) IsSetModelID() bool {
-	return p.ModelID != nil
+func (p *VariableDef) SetDesc(val *string) {
+	p.Desc = val
 }
-
-func (p *ModelConfig) IsSetMaxTokens() bool {
-	return p.MaxTokens != nil
+func (p *VariableDef) SetType(val *VariableType) {
+	p.Type = val
 }
-
-func (p *ModelConfig) IsSetTemperature() bool {
-	return p.Temperature != nil
+func (p *VariableDef) SetTypeTags(val []string) {
+	p.TypeTags = val
 }
 
-func (p *ModelConfig) IsSetTopK() bool {
-	return p.TopK != nil
+var fieldIDToName_VariableDef = map[int16]string{
+	1: "key",
+	2: "desc",
+	3: "type",
+	4: "type_tags",
 }
 
-func (p *ModelConfig) IsSetTopP() bool {
-	return p.TopP != nil
+func (p *VariableDef) IsSetKey() bool {
+	return p.Key != nil
 }
 
-func (p *ModelConfig) IsSetPresencePenalty() bool {
-	return p.PresencePenalty != nil
+func (p *VariableDef) IsSetDesc() bool {
+	return p.Desc != nil
 }
 
-func (p *ModelConfig) IsSetFrequencyPenalty() bool {
-	return p.FrequencyPenalty != nil
+func (p *VariableDef) IsSetType() bool {
+	return p.Type != nil
 }
 
-func (p *ModelConfig) IsSetJSONMode() bool {
-	return p.JSONMode != nil
+func (p *VariableDef) IsSetTypeTags() bool {
+	return p.TypeTags != nil
 }
 
-func (p *ModelConfig) Read(iprot thrift.TProtocol) (err error) {
+func (p *VariableDef) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4657,7 +8952,7 @@ func (p *ModelConfig) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4665,7 +8960,7 @@ func (p *ModelConfig) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4673,7 +8968,7 @@ func (p *ModelConfig) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4681,45 +8976,13 @@ func (p *ModelConfig) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4739,108 +9002,76 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModelConfig[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableDef[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ModelConfig) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ModelID = _field
-	return nil
-}
-func (p *ModelConfig) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.MaxTokens = _field
-	return nil
-}
-func (p *ModelConfig) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Temperature = _field
-	return nil
-}
-func (p *ModelConfig) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.TopK = _field
-	return nil
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *ModelConfig) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+func (p *VariableDef) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TopP = _field
+	p.Key = _field
 	return nil
 }
-func (p *ModelConfig) ReadField6(iprot thrift.TProtocol) error {
+func (p *VariableDef) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.PresencePenalty = _field
+	p.Desc = _field
 	return nil
 }
-func (p *ModelConfig) ReadField7(iprot thrift.TProtocol) error {
+func (p *VariableDef) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *VariableType
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.FrequencyPenalty = _field
+	p.Type = _field
 	return nil
 }
-func (p *ModelConfig) ReadField8(iprot thrift.TProtocol) error {
+func (p *VariableDef) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.JSONMode = _field
+	p.TypeTags = _field
 	return nil
 }
 
-func (p *ModelConfig) Write(oprot thrift.TProtocol) (err error) {
+func (p *VariableDef) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ModelConfig"); err != nil {
+	if err = oprot.WriteStructBegin("VariableDef"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4860,22 +9091,6 @@ func (p *ModelConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4894,12 +9109,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ModelConfig) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetModelID() {
-		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 1); err != nil {
+func (p *VariableDef) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKey() {
+		if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ModelID); err != nil {
+		if err := oprot.WriteString(*p.Key); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4912,12 +9127,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ModelConfig) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMaxTokens() {
-		if err = oprot.WriteFieldBegin("max_tokens", thrift.I32, 2); err != nil {
+func (p *VariableDef) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDesc() {
+		if err = oprot.WriteFieldBegin("desc", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.MaxTokens); err != nil {
+		if err := oprot.WriteString(*p.Desc); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4930,12 +9145,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ModelConfig) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTemperature() {
-		if err = oprot.WriteFieldBegin("temperature", thrift.DOUBLE, 3); err != nil {
+func (p *VariableDef) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.Temperature); err != nil {
+		if err := oprot.WriteString(*p.Type); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4948,84 +9163,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ModelConfig) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTopK() {
-		if err = oprot.WriteFieldBegin("top_k", thrift.I32, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.TopK); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ModelConfig) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTopP() {
-		if err = oprot.WriteFieldBegin("top_p", thrift.DOUBLE, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteDouble(*p.TopP); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *ModelConfig) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPresencePenalty() {
-		if err = oprot.WriteFieldBegin("presence_penalty", thrift.DOUBLE, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteDouble(*p.PresencePenalty); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-func (p *ModelConfig) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFrequencyPenalty() {
-		if err = oprot.WriteFieldBegin("frequency_penalty", thrift.DOUBLE, 7); err != nil {
+func (p *VariableDef) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypeTags() {
+		if err = oprot.WriteFieldBegin("type_tags", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.FrequencyPenalty); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.TypeTags)); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-func (p *ModelConfig) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetJSONMode() {
-		if err = oprot.WriteFieldBegin("json_mode", thrift.BOOL, 8); err != nil {
-			goto WriteFieldBeginError
+		for _, v := range p.TypeTags {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
 		}
-		if err := oprot.WriteBool(*p.JSONMode); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5034,289 +9185,167 @@ func (p *ModelConfig) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ModelConfig) String() string {
+func (p *VariableDef) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ModelConfig(%+v)", *p)
-
-}
-
-func (p *ModelConfig) DeepEqual(ano *ModelConfig) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.ModelID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.MaxTokens) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Temperature) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.TopK) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.TopP) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.PresencePenalty) {
-		return false
-	}
-	if !p.Field7DeepEqual(ano.FrequencyPenalty) {
-		return false
-	}
-	if !p.Field8DeepEqual(ano.JSONMode) {
-		return false
-	}
-	return true
-}
-
-func (p *ModelConfig) Field1DeepEqual(src *int64) bool {
-
-	if p.ModelID == src {
-		return true
-	} else if p.ModelID == nil || src == nil {
-		return false
-	}
-	if *p.ModelID != *src {
-		return false
-	}
-	return true
-}
-func (p *ModelConfig) Field2DeepEqual(src *int32) bool {
-
-	if p.MaxTokens == src {
-		return true
-	} else if p.MaxTokens == nil || src == nil {
-		return false
-	}
-	if *p.MaxTokens != *src {
-		return false
-	}
-	return true
-}
-func (p *ModelConfig) Field3DeepEqual(src *float64) bool {
+	return fmt.Sprintf("VariableDef(%+v)", *p)
 
-	if p.Temperature == src {
-		return true
-	} else if p.Temperature == nil || src == nil {
-		return false
-	}
-	if *p.Temperature != *src {
-		return false
-	}
-	return true
 }
-func (p *ModelConfig) Field4DeepEqual(src *int32) bool {
 
-	if p.TopK == src {
+func (p *VariableDef) DeepEqual(ano *VariableDef) bool {
+	if p == ano {
 		return true
-	} else if p.TopK == nil || src == nil {
+	} else if p == nil || ano == nil {
 		return false
 	}
-	if *p.TopK != *src {
+	if !p.Field1DeepEqual(ano.Key) {
 		return false
 	}
-	return true
-}
-func (p *ModelConfig) Field5DeepEqual(src *float64) bool {
-
-	if p.TopP == src {
-		return true
-	} else if p.TopP == nil || src == nil {
+	if !p.Field2DeepEqual(ano.Desc) {
 		return false
 	}
-	if *p.TopP != *src {
+	if !p.Field3DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TypeTags) {
 		return false
 	}
 	return true
 }
-func (p *ModelConfig) Field6DeepEqual(src *float64) bool {
 
-	if p.PresencePenalty == src {
+func (p *VariableDef) Field1DeepEqual(src *string) bool {
+
+	if p.Key == src {
 		return true
-	} else if p.PresencePenalty == nil || src == nil {
+	} else if p.Key == nil || src == nil {
 		return false
 	}
-	if *p.PresencePenalty != *src {
+	if strings.Compare(*p.Key, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ModelConfig) Field7DeepEqual(src *float64) bool {
+func (p *VariableDef) Field2DeepEqual(src *string) bool {
 
-	if p.FrequencyPenalty == src {
+	if p.Desc == src {
 		return true
-	} else if p.FrequencyPenalty == nil || src == nil {
+	} else if p.Desc == nil || src == nil {
 		return false
 	}
-	if *p.FrequencyPenalty != *src {
+	if strings.Compare(*p.Desc, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ModelConfig) Field8DeepEqual(src *bool) bool {
+func (p *VariableDef) Field3DeepEqual(src *VariableType) bool {
 
-	if p.JSONMode == src {
+	if p.Type == src {
 		return true
-	} else if p.JSONMode == nil || src == nil {
+	} else if p.Type == nil || src == nil {
 		return false
 	}
-	if *p.JSONMode != *src {
+	if strings.Compare(*p.Type, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *VariableDef) Field4DeepEqual(src []string) bool {
 
-type Message struct {
-	Role             *Role          `thrift:"role,1,optional" frugal:"1,optional,string" form:"role" json:"role,omitempty" query:"role"`
-	ReasoningContent *string        `thrift:"reasoning_content,2,optional" frugal:"2,optional,string" form:"reasoning_content" json:"reasoning_content,omitempty" query:"reasoning_content"`
-	Content          *string        `thrift:"content,3,optional" frugal:"3,optional,string" form:"content" json:"content,omitempty" query:"content"`
-	Parts            []*ContentPart `thrift:"parts,4,optional" frugal:"4,optional,list<ContentPart>" form:"parts" json:"parts,omitempty" query:"parts"`
-	ToolCallID       *string        `thrift:"tool_call_id,5,optional" frugal:"5,optional,string" form:"tool_call_id" json:"tool_call_id,omitempty" query:"tool_call_id"`
-	ToolCalls        []*ToolCall    `thrift:"tool_calls,6,optional" frugal:"6,optional,list<ToolCall>" form:"tool_calls" json:"tool_calls,omitempty" query:"tool_calls"`
-}
-
-func NewMessage() *Message {
-	return &Message{}
-}
-
-func (p *Message) InitDefault() {
-}
-
-var Message_Role_DEFAULT Role
-
-func (p *Message) GetRole() (v Role) {
-	if p == nil {
-		return
+	if len(p.TypeTags) != len(src) {
+		return false
 	}
-	if !p.IsSetRole() {
-		return Message_Role_DEFAULT
+	for i, v := range p.TypeTags {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
 	}
-	return *p.Role
+	return true
 }
 
-var Message_ReasoningContent_DEFAULT string
-
-func (p *Message) GetReasoningContent() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetReasoningContent() {
-		return Message_ReasoningContent_DEFAULT
-	}
-	return *p.ReasoningContent
+type VariableVal struct {
+	Key                 *string    `thrift:"key,1,optional" frugal:"1,optional,string" form:"key" json:"key,omitempty" query:"key"`
+	Value               *string    `thrift:"value,2,optional" frugal:"2,optional,string" form:"value" json:"value,omitempty" query:"value"`
+	PlaceholderMessages []*Message `thrift:"placeholder_messages,3,optional" frugal:"3,optional,list<Message>" form:"placeholder_messages" json:"placeholder_messages,omitempty" query:"placeholder_messages"`
 }
 
-var Message_Content_DEFAULT string
+func NewVariableVal() *VariableVal {
+	return &VariableVal{}
+}
 
-func (p *Message) GetContent() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetContent() {
-		return Message_Content_DEFAULT
-	}
-	return *p.Content
+func (p *VariableVal) InitDefault() {
 }
 
-var Message_Parts_DEFAULT []*ContentPart
+var VariableVal_Key_DEFAULT string
 
-func (p *Message) GetParts() (v []*ContentPart) {
+func (p *VariableVal) GetKey() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetParts() {
-		return Message_Parts_DEFAULT
+	if !p.IsSetKey() {
+		return VariableVal_Key_DEFAULT
 	}
-	return p.Parts
+	return *p.Key
 }
 
-var Message_ToolCallID_DEFAULT string
+var VariableVal_Value_DEFAULT string
 
-func (p *Message) GetToolCallID() (v string) {
+func (p *VariableVal) GetValue() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetToolCallID() {
-		return Message_ToolCallID_DEFAULT
+	if !p.IsSetValue() {
+		return VariableVal_Value_DEFAULT
 	}
-	return *p.ToolCallID
+	return *p.Value
 }
 
-var Message_ToolCalls_DEFAULT []*ToolCall
+var VariableVal_PlaceholderMessages_DEFAULT []*Message
 
-func (p *Message) GetToolCalls() (v []*ToolCall) {
+func (p *VariableVal) GetPlaceholderMessages() (v []*Message) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetToolCalls() {
-		return Message_ToolCalls_DEFAULT
+	if !p.IsSetPlaceholderMessages() {
+		return VariableVal_PlaceholderMessages_DEFAULT
 	}
-	return p.ToolCalls
-}
-func (p *Message) SetRole(val *Role) {
-	p.Role = val
-}
-func (p *Message) SetReasoningContent(val *string) {
-	p.ReasoningContent = val
-}
-func (p *Message) SetContent(val *string) {
-	p.Content = val
-}
-func (p *Message) SetParts(val []*ContentPart) {
-	p.Parts = val
-}
-func (p *Message) SetToolCallID(val *string) {
-	p.ToolCallID = val
-}
-func (p *Message) SetToolCalls(val []*ToolCall) {
-	p.ToolCalls = val
+	return p.PlaceholderMessages
 }
-
-var fieldIDToName_Message = map[int16]string{
-	1: "role",
-	2: "reasoning_content",
-	3: "content",
-	4: "parts",
-	5: "tool_call_id",
-	6: "tool_calls",
+func (p *VariableVal) SetKey(val *string) {
+	p.Key = val
 }
-
-func (p *Message) IsSetRole() bool {
-	return p.Role != nil
+func (p *VariableVal) SetValue(val *string) {
+	p.Value = val
 }
-
-func (p *Message) IsSetReasoningContent() bool {
-	return p.ReasoningContent != nil
+func (p *VariableVal) SetPlaceholderMessages(val []*Message) {
+	p.PlaceholderMessages = val
 }
 
-func (p *Message) IsSetContent() bool {
-	return p.Content != nil
+var fieldIDToName_VariableVal = map[int16]string{
+	1: "key",
+	2: "value",
+	3: "placeholder_messages",
 }
 
-func (p *Message) IsSetParts() bool {
-	return p.Parts != nil
+func (p *VariableVal) IsSetKey() bool {
+	return p.Key != nil
 }
 
-func (p *Message) IsSetToolCallID() bool {
-	return p.ToolCallID != nil
+func (p *VariableVal) IsSetValue() bool {
+	return p.Value != nil
 }
 
-func (p *Message) IsSetToolCalls() bool {
-	return p.ToolCalls != nil
+func (p *VariableVal) IsSetPlaceholderMessages() bool {
+	return p.PlaceholderMessages != nil
 }
 
-func (p *Message) Read(iprot thrift.TProtocol) (err error) {
+func (p *VariableVal) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5351,32 +9380,8 @@ func (p *Message) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField6(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -5401,7 +9406,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Message[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableVal[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5411,63 +9416,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *Message) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *Role
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Role = _field
-	return nil
-}
-func (p *Message) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ReasoningContent = _field
-	return nil
-}
-func (p *Message) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Content = _field
-	return nil
-}
-func (p *Message) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*ContentPart, 0, size)
-	values := make([]ContentPart, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *VariableVal) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Parts = _field
+	p.Key = _field
 	return nil
 }
-func (p *Message) ReadField5(iprot thrift.TProtocol) error {
+func (p *VariableVal) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5475,16 +9435,16 @@ func (p *Message) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ToolCallID = _field
+	p.Value = _field
 	return nil
 }
-func (p *Message) ReadField6(iprot thrift.TProtocol) error {
+func (p *VariableVal) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*ToolCall, 0, size)
-	values := make([]ToolCall, size)
+	_field := make([]*Message, 0, size)
+	values := make([]Message, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -5498,13 +9458,13 @@ func (p *Message) ReadField6(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.ToolCalls = _field
+	p.PlaceholderMessages = _field
 	return nil
 }
 
-func (p *Message) Write(oprot thrift.TProtocol) (err error) {
+func (p *VariableVal) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Message"); err != nil {
+	if err = oprot.WriteStructBegin("VariableVal"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5520,18 +9480,6 @@ func (p *Message) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5550,12 +9498,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Message) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRole() {
-		if err = oprot.WriteFieldBegin("role", thrift.STRING, 1); err != nil {
+func (p *VariableVal) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKey() {
+		if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Role); err != nil {
+		if err := oprot.WriteString(*p.Key); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5568,12 +9516,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Message) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReasoningContent() {
-		if err = oprot.WriteFieldBegin("reasoning_content", thrift.STRING, 2); err != nil {
+func (p *VariableVal) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err = oprot.WriteFieldBegin("value", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ReasoningContent); err != nil {
+		if err := oprot.WriteString(*p.Value); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5586,77 +9534,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *Message) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetContent() {
-		if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Content); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *Message) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetParts() {
-		if err = oprot.WriteFieldBegin("parts", thrift.LIST, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Parts)); err != nil {
-			return err
-		}
-		for _, v := range p.Parts {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *Message) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetToolCallID() {
-		if err = oprot.WriteFieldBegin("tool_call_id", thrift.STRING, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.ToolCallID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *Message) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetToolCalls() {
-		if err = oprot.WriteFieldBegin("tool_calls", thrift.LIST, 6); err != nil {
+func (p *VariableVal) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlaceholderMessages() {
+		if err = oprot.WriteFieldBegin("placeholder_messages", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ToolCalls)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PlaceholderMessages)); err != nil {
 			return err
 		}
-		for _, v := range p.ToolCalls {
+		for _, v := range p.PlaceholderMessages {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -5670,88 +9556,67 @@ func (p *Message) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *Message) String() string {
+func (p *VariableVal) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Message(%+v)", *p)
+	return fmt.Sprintf("VariableVal(%+v)", *p)
 
 }
 
-func (p *Message) DeepEqual(ano *Message) bool {
+func (p *VariableVal) DeepEqual(ano *VariableVal) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Role) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.ReasoningContent) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Content) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.Parts) {
+	if !p.Field1DeepEqual(ano.Key) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.ToolCallID) {
+	if !p.Field2DeepEqual(ano.Value) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.ToolCalls) {
+	if !p.Field3DeepEqual(ano.PlaceholderMessages) {
 		return false
 	}
 	return true
 }
 
-func (p *Message) Field1DeepEqual(src *Role) bool {
-
-	if p.Role == src {
-		return true
-	} else if p.Role == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Role, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *Message) Field2DeepEqual(src *string) bool {
+func (p *VariableVal) Field1DeepEqual(src *string) bool {
 
-	if p.ReasoningContent == src {
+	if p.Key == src {
 		return true
-	} else if p.ReasoningContent == nil || src == nil {
+	} else if p.Key == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.ReasoningContent, *src) != 0 {
+	if strings.Compare(*p.Key, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *Message) Field3DeepEqual(src *string) bool {
+func (p *VariableVal) Field2DeepEqual(src *string) bool {
 
-	if p.Content == src {
+	if p.Value == src {
 		return true
-	} else if p.Content == nil || src == nil {
+	} else if p.Value == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Content, *src) != 0 {
+	if strings.Compare(*p.Value, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *Message) Field4DeepEqual(src []*ContentPart) bool {
+func (p *VariableVal) Field3DeepEqual(src []*Message) bool {
 
-	if len(p.Parts) != len(src) {
+	if len(p.PlaceholderMessages) != len(src) {
 		return false
 	}
-	for i, v := range p.Parts {
+	for i, v := range p.PlaceholderMessages {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -5759,109 +9624,128 @@ func (p *Message) Field4DeepEqual(src []*ContentPart) bool {
 	}
 	return true
 }
-func (p *Message) Field5DeepEqual(src *string) bool {
 
-	if p.ToolCallID == src {
-		return true
-	} else if p.ToolCallID == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.ToolCallID, *src) != 0 {
-		return false
-	}
-	return true
+// 两个版本PromptDetail之间的结构化差异，只包含有变化的部分
+type PromptDetailDiff struct {
+	// 模板类型、工具调用配置等字段差异
+	FieldDiffs       []*FieldDiff       `thrift:"field_diffs,1,optional" frugal:"1,optional,list<FieldDiff>" form:"field_diffs" json:"field_diffs,omitempty" query:"field_diffs"`
+	MessageDiffs     []*MessageDiff     `thrift:"message_diffs,2,optional" frugal:"2,optional,list<MessageDiff>" form:"message_diffs" json:"message_diffs,omitempty" query:"message_diffs"`
+	VariableDefDiffs []*VariableDefDiff `thrift:"variable_def_diffs,3,optional" frugal:"3,optional,list<VariableDefDiff>" form:"variable_def_diffs" json:"variable_def_diffs,omitempty" query:"variable_def_diffs"`
+	ToolDiffs        []*ToolDiff        `thrift:"tool_diffs,4,optional" frugal:"4,optional,list<ToolDiff>" form:"tool_diffs" json:"tool_diffs,omitempty" query:"tool_diffs"`
+	ModelConfigDiffs []*FieldDiff       `thrift:"model_config_diffs,5,optional" frugal:"5,optional,list<FieldDiff>" form:"model_config_diffs" json:"model_config_diffs,omitempty" query:"model_config_diffs"`
 }
-func (p *Message) Field6DeepEqual(src []*ToolCall) bool {
 
-	if len(p.ToolCalls) != len(src) {
-		return false
-	}
-	for i, v := range p.ToolCalls {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
+func NewPromptDetailDiff() *PromptDetailDiff {
+	return &PromptDetailDiff{}
 }
 
-type ContentPart struct {
-	Type     *ContentType `thrift:"type,1,optional" frugal:"1,optional,string" form:"type" json:"type,omitempty" query:"type"`
-	Text     *string      `thrift:"text,2,optional" frugal:"2,optional,string" form:"text" json:"text,omitempty" query:"text"`
-	ImageURL *ImageURL    `thrift:"image_url,3,optional" frugal:"3,optional,ImageURL" form:"image_url" json:"image_url,omitempty" query:"image_url"`
+func (p *PromptDetailDiff) InitDefault() {
 }
 
-func NewContentPart() *ContentPart {
-	return &ContentPart{}
+var PromptDetailDiff_FieldDiffs_DEFAULT []*FieldDiff
+
+func (p *PromptDetailDiff) GetFieldDiffs() (v []*FieldDiff) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldDiffs() {
+		return PromptDetailDiff_FieldDiffs_DEFAULT
+	}
+	return p.FieldDiffs
 }
 
-func (p *ContentPart) InitDefault() {
+var PromptDetailDiff_MessageDiffs_DEFAULT []*MessageDiff
+
+func (p *PromptDetailDiff) GetMessageDiffs() (v []*MessageDiff) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMessageDiffs() {
+		return PromptDetailDiff_MessageDiffs_DEFAULT
+	}
+	return p.MessageDiffs
 }
 
-var ContentPart_Type_DEFAULT ContentType
+var PromptDetailDiff_VariableDefDiffs_DEFAULT []*VariableDefDiff
 
-func (p *ContentPart) GetType() (v ContentType) {
+func (p *PromptDetailDiff) GetVariableDefDiffs() (v []*VariableDefDiff) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetType() {
-		return ContentPart_Type_DEFAULT
+	if !p.IsSetVariableDefDiffs() {
+		return PromptDetailDiff_VariableDefDiffs_DEFAULT
 	}
-	return *p.Type
+	return p.VariableDefDiffs
 }
 
-var ContentPart_Text_DEFAULT string
+var PromptDetailDiff_ToolDiffs_DEFAULT []*ToolDiff
 
-func (p *ContentPart) GetText() (v string) {
+func (p *PromptDetailDiff) GetToolDiffs() (v []*ToolDiff) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetText() {
-		return ContentPart_Text_DEFAULT
+	if !p.IsSetToolDiffs() {
+		return PromptDetailDiff_ToolDiffs_DEFAULT
 	}
-	return *p.Text
+	return p.ToolDiffs
 }
 
-var ContentPart_ImageURL_DEFAULT *ImageURL
+var PromptDetailDiff_ModelConfigDiffs_DEFAULT []*FieldDiff
 
-func (p *ContentPart) GetImageURL() (v *ImageURL) {
+func (p *PromptDetailDiff) GetModelConfigDiffs() (v []*FieldDiff) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetImageURL() {
-		return ContentPart_ImageURL_DEFAULT
+	if !p.IsSetModelConfigDiffs() {
+		return PromptDetailDiff_ModelConfigDiffs_DEFAULT
 	}
-	return p.ImageURL
+	return p.ModelConfigDiffs
 }
-func (p *ContentPart) SetType(val *ContentType) {
-	p.Type = val
+func (p *PromptDetailDiff) SetFieldDiffs(val []*FieldDiff) {
+	p.FieldDiffs = val
+}
+func (p *PromptDetailDiff) SetMessageDiffs(val []*MessageDiff) {
+	p.MessageDiffs = val
+}
+func (p *PromptDetailDiff) SetVariableDefDiffs(val []*VariableDefDiff) {
+	p.VariableDefDiffs = val
+}
+func (p *PromptDetailDiff) SetToolDiffs(val []*ToolDiff) {
+	p.ToolDiffs = val
 }
-func (p *ContentPart) SetText(val *string) {
-	p.Text = val
+func (p *PromptDetailDiff) SetModelConfigDiffs(val []*FieldDiff) {
+	p.ModelConfigDiffs = val
 }
-func (p *ContentPart) SetImageURL(val *ImageURL) {
-	p.ImageURL = val
+
+var fieldIDToName_PromptDetailDiff = map[int16]string{
+	1: "field_diffs",
+	2: "message_diffs",
+	3: "variable_def_diffs",
+	4: "tool_diffs",
+	5: "model_config_diffs",
 }
 
-var fieldIDToName_ContentPart = map[int16]string{
-	1: "type",
-	2: "text",
-	3: "image_url",
+func (p *PromptDetailDiff) IsSetFieldDiffs() bool {
+	return p.FieldDiffs != nil
 }
 
-func (p *ContentPart) IsSetType() bool {
-	return p.Type != nil
+func (p *PromptDetailDiff) IsSetMessageDiffs() bool {
+	return p.MessageDiffs != nil
 }
 
-func (p *ContentPart) IsSetText() bool {
-	return p.Text != nil
+func (p *PromptDetailDiff) IsSetVariableDefDiffs() bool {
+	return p.VariableDefDiffs != nil
 }
 
-func (p *ContentPart) IsSetImageURL() bool {
-	return p.ImageURL != nil
+func (p *PromptDetailDiff) IsSetToolDiffs() bool {
+	return p.ToolDiffs != nil
 }
 
-func (p *ContentPart) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptDetailDiff) IsSetModelConfigDiffs() bool {
+	return p.ModelConfigDiffs != nil
+}
+
+func (p *PromptDetailDiff) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5880,7 +9764,7 @@ func (p *ContentPart) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5888,7 +9772,7 @@ func (p *ContentPart) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5896,13 +9780,29 @@ func (p *ContentPart) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5922,7 +9822,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentPart[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptDetailDiff[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5932,40 +9832,125 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContentPart) ReadField1(iprot thrift.TProtocol) error {
+func (p *PromptDetailDiff) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldDiff, 0, size)
+	values := make([]FieldDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *ContentType
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Type = _field
+	p.FieldDiffs = _field
 	return nil
 }
-func (p *ContentPart) ReadField2(iprot thrift.TProtocol) error {
+func (p *PromptDetailDiff) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MessageDiff, 0, size)
+	values := make([]MessageDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Text = _field
+	p.MessageDiffs = _field
 	return nil
 }
-func (p *ContentPart) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewImageURL()
-	if err := _field.Read(iprot); err != nil {
+func (p *PromptDetailDiff) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.ImageURL = _field
+	_field := make([]*VariableDefDiff, 0, size)
+	values := make([]VariableDefDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VariableDefDiffs = _field
 	return nil
 }
+func (p *PromptDetailDiff) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ToolDiff, 0, size)
+	values := make([]ToolDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *ContentPart) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ToolDiffs = _field
+	return nil
+}
+func (p *PromptDetailDiff) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldDiff, 0, size)
+	values := make([]FieldDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ModelConfigDiffs = _field
+	return nil
+}
+
+func (p *PromptDetailDiff) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContentPart"); err != nil {
+	if err = oprot.WriteStructBegin("PromptDetailDiff"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5981,6 +9966,14 @@ func (p *ContentPart) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5999,12 +9992,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContentPart) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err = oprot.WriteFieldBegin("type", thrift.STRING, 1); err != nil {
+func (p *PromptDetailDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldDiffs() {
+		if err = oprot.WriteFieldBegin("field_diffs", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Type); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldDiffs)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldDiffs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6017,12 +10018,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ContentPart) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetText() {
-		if err = oprot.WriteFieldBegin("text", thrift.STRING, 2); err != nil {
+func (p *PromptDetailDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessageDiffs() {
+		if err = oprot.WriteFieldBegin("message_diffs", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Text); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.MessageDiffs)); err != nil {
+			return err
+		}
+		for _, v := range p.MessageDiffs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6035,12 +10044,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ContentPart) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetImageURL() {
-		if err = oprot.WriteFieldBegin("image_url", thrift.STRUCT, 3); err != nil {
+func (p *PromptDetailDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVariableDefDiffs() {
+		if err = oprot.WriteFieldBegin("variable_def_diffs", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ImageURL.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VariableDefDiffs)); err != nil {
+			return err
+		}
+		for _, v := range p.VariableDefDiffs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6053,121 +10070,300 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *PromptDetailDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolDiffs() {
+		if err = oprot.WriteFieldBegin("tool_diffs", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ToolDiffs)); err != nil {
+			return err
+		}
+		for _, v := range p.ToolDiffs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *PromptDetailDiff) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelConfigDiffs() {
+		if err = oprot.WriteFieldBegin("model_config_diffs", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ModelConfigDiffs)); err != nil {
+			return err
+		}
+		for _, v := range p.ModelConfigDiffs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
-func (p *ContentPart) String() string {
+func (p *PromptDetailDiff) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContentPart(%+v)", *p)
+	return fmt.Sprintf("PromptDetailDiff(%+v)", *p)
 
 }
 
-func (p *ContentPart) DeepEqual(ano *ContentPart) bool {
+func (p *PromptDetailDiff) DeepEqual(ano *PromptDetailDiff) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Type) {
+	if !p.Field1DeepEqual(ano.FieldDiffs) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.MessageDiffs) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VariableDefDiffs) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ToolDiffs) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ModelConfigDiffs) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptDetailDiff) Field1DeepEqual(src []*FieldDiff) bool {
+
+	if len(p.FieldDiffs) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldDiffs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *PromptDetailDiff) Field2DeepEqual(src []*MessageDiff) bool {
+
+	if len(p.MessageDiffs) != len(src) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Text) {
+	for i, v := range p.MessageDiffs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *PromptDetailDiff) Field3DeepEqual(src []*VariableDefDiff) bool {
+
+	if len(p.VariableDefDiffs) != len(src) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ImageURL) {
-		return false
+	for i, v := range p.VariableDefDiffs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
+func (p *PromptDetailDiff) Field4DeepEqual(src []*ToolDiff) bool {
 
-func (p *ContentPart) Field1DeepEqual(src *ContentType) bool {
-
-	if p.Type == src {
-		return true
-	} else if p.Type == nil || src == nil {
+	if len(p.ToolDiffs) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.Type, *src) != 0 {
-		return false
+	for i, v := range p.ToolDiffs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *ContentPart) Field2DeepEqual(src *string) bool {
+func (p *PromptDetailDiff) Field5DeepEqual(src []*FieldDiff) bool {
 
-	if p.Text == src {
-		return true
-	} else if p.Text == nil || src == nil {
+	if len(p.ModelConfigDiffs) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.Text, *src) != 0 {
-		return false
+	for i, v := range p.ModelConfigDiffs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *ContentPart) Field3DeepEqual(src *ImageURL) bool {
 
-	if !p.ImageURL.DeepEqual(src) {
-		return false
+type MessageDiff struct {
+	DiffType *DiffType `thrift:"diff_type,1,optional" frugal:"1,optional,string" form:"diff_type" json:"diff_type,omitempty" query:"diff_type"`
+	// 消息在基准版本中的位置，新增消息为空
+	BaseIndex *int32 `thrift:"base_index,2,optional" frugal:"2,optional,i32" form:"base_index" json:"base_index,omitempty" query:"base_index"`
+	// 消息在目标版本中的位置，删除消息为空
+	TargetIndex   *int32   `thrift:"target_index,3,optional" frugal:"3,optional,i32" form:"target_index" json:"target_index,omitempty" query:"target_index"`
+	BaseMessage   *Message `thrift:"base_message,4,optional" frugal:"4,optional,Message" form:"base_message" json:"base_message,omitempty" query:"base_message"`
+	TargetMessage *Message `thrift:"target_message,5,optional" frugal:"5,optional,Message" form:"target_message" json:"target_message,omitempty" query:"target_message"`
+	// 修改消息的文本逐行差异
+	ContentDiff []*TextDiffLine `thrift:"content_diff,6,optional" frugal:"6,optional,list<TextDiffLine>" form:"content_diff" json:"content_diff,omitempty" query:"content_diff"`
+}
+
+func NewMessageDiff() *MessageDiff {
+	return &MessageDiff{}
+}
+
+func (p *MessageDiff) InitDefault() {
+}
+
+var MessageDiff_DiffType_DEFAULT DiffType
+
+func (p *MessageDiff) GetDiffType() (v DiffType) {
+	if p == nil {
+		return
 	}
-	return true
+	if !p.IsSetDiffType() {
+		return MessageDiff_DiffType_DEFAULT
+	}
+	return *p.DiffType
 }
 
-type ImageURL struct {
-	URI *string `thrift:"uri,1,optional" frugal:"1,optional,string" form:"uri" json:"uri,omitempty" query:"uri"`
-	URL *string `thrift:"url,2,optional" frugal:"2,optional,string" form:"url" json:"url,omitempty" query:"url"`
+var MessageDiff_BaseIndex_DEFAULT int32
+
+func (p *MessageDiff) GetBaseIndex() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseIndex() {
+		return MessageDiff_BaseIndex_DEFAULT
+	}
+	return *p.BaseIndex
 }
 
-func NewImageURL() *ImageURL {
-	return &ImageURL{}
+var MessageDiff_TargetIndex_DEFAULT int32
+
+func (p *MessageDiff) GetTargetIndex() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetIndex() {
+		return MessageDiff_TargetIndex_DEFAULT
+	}
+	return *p.TargetIndex
 }
 
-func (p *ImageURL) InitDefault() {
+var MessageDiff_BaseMessage_DEFAULT *Message
+
+func (p *MessageDiff) GetBaseMessage() (v *Message) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseMessage() {
+		return MessageDiff_BaseMessage_DEFAULT
+	}
+	return p.BaseMessage
 }
 
-var ImageURL_URI_DEFAULT string
+var MessageDiff_TargetMessage_DEFAULT *Message
 
-func (p *ImageURL) GetURI() (v string) {
+func (p *MessageDiff) GetTargetMessage() (v *Message) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetURI() {
-		return ImageURL_URI_DEFAULT
+	if !p.IsSetTargetMessage() {
+		return MessageDiff_TargetMessage_DEFAULT
 	}
-	return *p.URI
+	return p.TargetMessage
 }
 
-var ImageURL_URL_DEFAULT string
+var MessageDiff_ContentDiff_DEFAULT []*TextDiffLine
 
-func (p *ImageURL) GetURL() (v string) {
+func (p *MessageDiff) GetContentDiff() (v []*TextDiffLine) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetURL() {
-		return ImageURL_URL_DEFAULT
+	if !p.IsSetContentDiff() {
+		return MessageDiff_ContentDiff_DEFAULT
 	}
-	return *p.URL
+	return p.ContentDiff
 }
-func (p *ImageURL) SetURI(val *string) {
-	p.URI = val
+func (p *MessageDiff) SetDiffType(val *DiffType) {
+	p.DiffType = val
 }
-func (p *ImageURL) SetURL(val *string) {
-	p.URL = val
+func (p *MessageDiff) SetBaseIndex(val *int32) {
+	p.BaseIndex = val
+}
+func (p *MessageDiff) SetTargetIndex(val *int32) {
+	p.TargetIndex = val
+}
+func (p *MessageDiff) SetBaseMessage(val *Message) {
+	p.BaseMessage = val
+}
+func (p *MessageDiff) SetTargetMessage(val *Message) {
+	p.TargetMessage = val
+}
+func (p *MessageDiff) SetContentDiff(val []*TextDiffLine) {
+	p.ContentDiff = val
 }
 
-var fieldIDToName_ImageURL = map[int16]string{
-	1: "uri",
-	2: "url",
+var fieldIDToName_MessageDiff = map[int16]string{
+	1: "diff_type",
+	2: "base_index",
+	3: "target_index",
+	4: "base_message",
+	5: "target_message",
+	6: "content_diff",
 }
 
-func (p *ImageURL) IsSetURI() bool {
-	return p.URI != nil
+func (p *MessageDiff) IsSetDiffType() bool {
+	return p.DiffType != nil
 }
 
-func (p *ImageURL) IsSetURL() bool {
-	return p.URL != nil
+func (p *MessageDiff) IsSetBaseIndex() bool {
+	return p.BaseIndex != nil
 }
 
-func (p *ImageURL) Read(iprot thrift.TProtocol) (err error) {
+func (p *MessageDiff) IsSetTargetIndex() bool {
+	return p.TargetIndex != nil
+}
+
+func (p *MessageDiff) IsSetBaseMessage() bool {
+	return p.BaseMessage != nil
+}
+
+func (p *MessageDiff) IsSetTargetMessage() bool {
+	return p.TargetMessage != nil
+}
+
+func (p *MessageDiff) IsSetContentDiff() bool {
+	return p.ContentDiff != nil
+}
+
+func (p *MessageDiff) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6194,13 +10390,45 @@ func (p *ImageURL) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6220,7 +10448,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageURL[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageDiff[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6230,32 +10458,82 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ImageURL) ReadField1(iprot thrift.TProtocol) error {
+func (p *MessageDiff) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *DiffType
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.URI = _field
+	p.DiffType = _field
 	return nil
 }
-func (p *ImageURL) ReadField2(iprot thrift.TProtocol) error {
+func (p *MessageDiff) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.URL = _field
+	p.BaseIndex = _field
 	return nil
 }
+func (p *MessageDiff) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *ImageURL) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetIndex = _field
+	return nil
+}
+func (p *MessageDiff) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewMessage()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseMessage = _field
+	return nil
+}
+func (p *MessageDiff) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewMessage()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TargetMessage = _field
+	return nil
+}
+func (p *MessageDiff) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TextDiffLine, 0, size)
+	values := make([]TextDiffLine, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ContentDiff = _field
+	return nil
+}
+
+func (p *MessageDiff) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImageURL"); err != nil {
+	if err = oprot.WriteStructBegin("MessageDiff"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6267,6 +10545,22 @@ func (p *ImageURL) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6275,22 +10569,94 @@ func (p *ImageURL) Write(oprot thrift.TProtocol) (err error) {
 		goto WriteStructEndError
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MessageDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiffType() {
+		if err = oprot.WriteFieldBegin("diff_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DiffType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *MessageDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseIndex() {
+		if err = oprot.WriteFieldBegin("base_index", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.BaseIndex); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *MessageDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetIndex() {
+		if err = oprot.WriteFieldBegin("target_index", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.TargetIndex); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *MessageDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseMessage() {
+		if err = oprot.WriteFieldBegin("base_message", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseMessage.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-
-func (p *ImageURL) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetURI() {
-		if err = oprot.WriteFieldBegin("uri", thrift.STRING, 1); err != nil {
+func (p *MessageDiff) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetMessage() {
+		if err = oprot.WriteFieldBegin("target_message", thrift.STRUCT, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.URI); err != nil {
+		if err := p.TargetMessage.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6299,16 +10665,24 @@ func (p *ImageURL) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *ImageURL) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetURL() {
-		if err = oprot.WriteFieldBegin("url", thrift.STRING, 2); err != nil {
+func (p *MessageDiff) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContentDiff() {
+		if err = oprot.WriteFieldBegin("content_diff", thrift.LIST, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.URL); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ContentDiff)); err != nil {
+			return err
+		}
+		for _, v := range p.ContentDiff {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6317,157 +10691,166 @@ func (p *ImageURL) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *ImageURL) String() string {
+func (p *MessageDiff) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ImageURL(%+v)", *p)
+	return fmt.Sprintf("MessageDiff(%+v)", *p)
 
 }
 
-func (p *ImageURL) DeepEqual(ano *ImageURL) bool {
+func (p *MessageDiff) DeepEqual(ano *MessageDiff) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.URI) {
+	if !p.Field1DeepEqual(ano.DiffType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.URL) {
+	if !p.Field2DeepEqual(ano.BaseIndex) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TargetIndex) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.BaseMessage) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.TargetMessage) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ContentDiff) {
 		return false
 	}
 	return true
 }
 
-func (p *ImageURL) Field1DeepEqual(src *string) bool {
+func (p *MessageDiff) Field1DeepEqual(src *DiffType) bool {
 
-	if p.URI == src {
+	if p.DiffType == src {
 		return true
-	} else if p.URI == nil || src == nil {
+	} else if p.DiffType == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.URI, *src) != 0 {
+	if strings.Compare(*p.DiffType, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ImageURL) Field2DeepEqual(src *string) bool {
+func (p *MessageDiff) Field2DeepEqual(src *int32) bool {
 
-	if p.URL == src {
+	if p.BaseIndex == src {
 		return true
-	} else if p.URL == nil || src == nil {
+	} else if p.BaseIndex == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.URL, *src) != 0 {
+	if *p.BaseIndex != *src {
 		return false
 	}
 	return true
 }
+func (p *MessageDiff) Field3DeepEqual(src *int32) bool {
 
-type ToolCall struct {
-	Index        *int64        `thrift:"index,1,optional" frugal:"1,optional,i64" json:"index" form:"index" query:"index"`
-	ID           *string       `thrift:"id,2,optional" frugal:"2,optional,string" form:"id" json:"id,omitempty" query:"id"`
-	Type         *ToolType     `thrift:"type,3,optional" frugal:"3,optional,string" form:"type" json:"type,omitempty" query:"type"`
-	FunctionCall *FunctionCall `thrift:"function_call,4,optional" frugal:"4,optional,FunctionCall" form:"function_call" json:"function_call,omitempty" query:"function_call"`
+	if p.TargetIndex == src {
+		return true
+	} else if p.TargetIndex == nil || src == nil {
+		return false
+	}
+	if *p.TargetIndex != *src {
+		return false
+	}
+	return true
 }
+func (p *MessageDiff) Field4DeepEqual(src *Message) bool {
 
-func NewToolCall() *ToolCall {
-	return &ToolCall{}
+	if !p.BaseMessage.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *MessageDiff) Field5DeepEqual(src *Message) bool {
 
-func (p *ToolCall) InitDefault() {
+	if !p.TargetMessage.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *MessageDiff) Field6DeepEqual(src []*TextDiffLine) bool {
 
-var ToolCall_Index_DEFAULT int64
-
-func (p *ToolCall) GetIndex() (v int64) {
-	if p == nil {
-		return
+	if len(p.ContentDiff) != len(src) {
+		return false
 	}
-	if !p.IsSetIndex() {
-		return ToolCall_Index_DEFAULT
+	for i, v := range p.ContentDiff {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
-	return *p.Index
+	return true
 }
 
-var ToolCall_ID_DEFAULT string
+type TextDiffLine struct {
+	Operation *TextDiffOperation `thrift:"operation,1,optional" frugal:"1,optional,string" form:"operation" json:"operation,omitempty" query:"operation"`
+	Text      *string            `thrift:"text,2,optional" frugal:"2,optional,string" form:"text" json:"text,omitempty" query:"text"`
+}
 
-func (p *ToolCall) GetID() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetID() {
-		return ToolCall_ID_DEFAULT
-	}
-	return *p.ID
+func NewTextDiffLine() *TextDiffLine {
+	return &TextDiffLine{}
 }
 
-var ToolCall_Type_DEFAULT ToolType
+func (p *TextDiffLine) InitDefault() {
+}
 
-func (p *ToolCall) GetType() (v ToolType) {
+var TextDiffLine_Operation_DEFAULT TextDiffOperation
+
+func (p *TextDiffLine) GetOperation() (v TextDiffOperation) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetType() {
-		return ToolCall_Type_DEFAULT
+	if !p.IsSetOperation() {
+		return TextDiffLine_Operation_DEFAULT
 	}
-	return *p.Type
+	return *p.Operation
 }
 
-var ToolCall_FunctionCall_DEFAULT *FunctionCall
+var TextDiffLine_Text_DEFAULT string
 
-func (p *ToolCall) GetFunctionCall() (v *FunctionCall) {
+func (p *TextDiffLine) GetText() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFunctionCall() {
-		return ToolCall_FunctionCall_DEFAULT
+	if !p.IsSetText() {
+		return TextDiffLine_Text_DEFAULT
 	}
-	return p.FunctionCall
-}
-func (p *ToolCall) SetIndex(val *int64) {
-	p.Index = val
-}
-func (p *ToolCall) SetID(val *string) {
-	p.ID = val
-}
-func (p *ToolCall) SetType(val *ToolType) {
-	p.Type = val
-}
-func (p *ToolCall) SetFunctionCall(val *FunctionCall) {
-	p.FunctionCall = val
+	return *p.Text
 }
-
-var fieldIDToName_ToolCall = map[int16]string{
-	1: "index",
-	2: "id",
-	3: "type",
-	4: "function_call",
+func (p *TextDiffLine) SetOperation(val *TextDiffOperation) {
+	p.Operation = val
 }
-
-func (p *ToolCall) IsSetIndex() bool {
-	return p.Index != nil
+func (p *TextDiffLine) SetText(val *string) {
+	p.Text = val
 }
 
-func (p *ToolCall) IsSetID() bool {
-	return p.ID != nil
+var fieldIDToName_TextDiffLine = map[int16]string{
+	1: "operation",
+	2: "text",
 }
 
-func (p *ToolCall) IsSetType() bool {
-	return p.Type != nil
+func (p *TextDiffLine) IsSetOperation() bool {
+	return p.Operation != nil
 }
 
-func (p *ToolCall) IsSetFunctionCall() bool {
-	return p.FunctionCall != nil
+func (p *TextDiffLine) IsSetText() bool {
+	return p.Text != nil
 }
 
-func (p *ToolCall) Read(iprot thrift.TProtocol) (err error) {
+func (p *TextDiffLine) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6486,7 +10869,7 @@ func (p *ToolCall) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6501,22 +10884,6 @@ func (p *ToolCall) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6536,7 +10903,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCall[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TextDiffLine[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6546,51 +10913,32 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ToolCall) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Index = _field
-	return nil
-}
-func (p *ToolCall) ReadField2(iprot thrift.TProtocol) error {
+func (p *TextDiffLine) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *TextDiffOperation
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ID = _field
+	p.Operation = _field
 	return nil
 }
-func (p *ToolCall) ReadField3(iprot thrift.TProtocol) error {
+func (p *TextDiffLine) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *ToolType
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Type = _field
-	return nil
-}
-func (p *ToolCall) ReadField4(iprot thrift.TProtocol) error {
-	_field := NewFunctionCall()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.FunctionCall = _field
+	p.Text = _field
 	return nil
 }
 
-func (p *ToolCall) Write(oprot thrift.TProtocol) (err error) {
+func (p *TextDiffLine) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ToolCall"); err != nil {
+	if err = oprot.WriteStructBegin("TextDiffLine"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6602,14 +10950,6 @@ func (p *ToolCall) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6628,12 +10968,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ToolCall) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIndex() {
-		if err = oprot.WriteFieldBegin("index", thrift.I64, 1); err != nil {
+func (p *TextDiffLine) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOperation() {
+		if err = oprot.WriteFieldBegin("operation", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.Index); err != nil {
+		if err := oprot.WriteString(*p.Operation); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6646,12 +10986,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ToolCall) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.STRING, 2); err != nil {
+func (p *TextDiffLine) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetText() {
+		if err = oprot.WriteFieldBegin("text", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ID); err != nil {
+		if err := oprot.WriteString(*p.Text); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6664,172 +11004,153 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ToolCall) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err = oprot.WriteFieldBegin("type", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Type); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ToolCall) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFunctionCall() {
-		if err = oprot.WriteFieldBegin("function_call", thrift.STRUCT, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.FunctionCall.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
 
-func (p *ToolCall) String() string {
+func (p *TextDiffLine) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ToolCall(%+v)", *p)
+	return fmt.Sprintf("TextDiffLine(%+v)", *p)
 
 }
 
-func (p *ToolCall) DeepEqual(ano *ToolCall) bool {
+func (p *TextDiffLine) DeepEqual(ano *TextDiffLine) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Index) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.ID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Type) {
+	if !p.Field1DeepEqual(ano.Operation) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.FunctionCall) {
+	if !p.Field2DeepEqual(ano.Text) {
 		return false
 	}
 	return true
 }
 
-func (p *ToolCall) Field1DeepEqual(src *int64) bool {
+func (p *TextDiffLine) Field1DeepEqual(src *TextDiffOperation) bool {
 
-	if p.Index == src {
+	if p.Operation == src {
 		return true
-	} else if p.Index == nil || src == nil {
+	} else if p.Operation == nil || src == nil {
 		return false
 	}
-	if *p.Index != *src {
+	if strings.Compare(*p.Operation, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ToolCall) Field2DeepEqual(src *string) bool {
+func (p *TextDiffLine) Field2DeepEqual(src *string) bool {
 
-	if p.ID == src {
+	if p.Text == src {
 		return true
-	} else if p.ID == nil || src == nil {
+	} else if p.Text == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.ID, *src) != 0 {
+	if strings.Compare(*p.Text, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ToolCall) Field3DeepEqual(src *ToolType) bool {
 
-	if p.Type == src {
-		return true
-	} else if p.Type == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Type, *src) != 0 {
-		return false
-	}
-	return true
+type VariableDefDiff struct {
+	DiffType  *DiffType    `thrift:"diff_type,1,optional" frugal:"1,optional,string" form:"diff_type" json:"diff_type,omitempty" query:"diff_type"`
+	Key       *string      `thrift:"key,2,optional" frugal:"2,optional,string" form:"key" json:"key,omitempty" query:"key"`
+	BaseDef   *VariableDef `thrift:"base_def,3,optional" frugal:"3,optional,VariableDef" form:"base_def" json:"base_def,omitempty" query:"base_def"`
+	TargetDef *VariableDef `thrift:"target_def,4,optional" frugal:"4,optional,VariableDef" form:"target_def" json:"target_def,omitempty" query:"target_def"`
 }
-func (p *ToolCall) Field4DeepEqual(src *FunctionCall) bool {
 
-	if !p.FunctionCall.DeepEqual(src) {
-		return false
-	}
-	return true
+func NewVariableDefDiff() *VariableDefDiff {
+	return &VariableDefDiff{}
 }
 
-type FunctionCall struct {
-	Name      *string `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Arguments *string `thrift:"arguments,2,optional" frugal:"2,optional,string" form:"arguments" json:"arguments,omitempty" query:"arguments"`
+func (p *VariableDefDiff) InitDefault() {
 }
 
-func NewFunctionCall() *FunctionCall {
-	return &FunctionCall{}
+var VariableDefDiff_DiffType_DEFAULT DiffType
+
+func (p *VariableDefDiff) GetDiffType() (v DiffType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDiffType() {
+		return VariableDefDiff_DiffType_DEFAULT
+	}
+	return *p.DiffType
 }
 
-func (p *FunctionCall) InitDefault() {
+var VariableDefDiff_Key_DEFAULT string
+
+func (p *VariableDefDiff) GetKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKey() {
+		return VariableDefDiff_Key_DEFAULT
+	}
+	return *p.Key
 }
 
-var FunctionCall_Name_DEFAULT string
+var VariableDefDiff_BaseDef_DEFAULT *VariableDef
 
-func (p *FunctionCall) GetName() (v string) {
+func (p *VariableDefDiff) GetBaseDef() (v *VariableDef) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetName() {
-		return FunctionCall_Name_DEFAULT
+	if !p.IsSetBaseDef() {
+		return VariableDefDiff_BaseDef_DEFAULT
 	}
-	return *p.Name
+	return p.BaseDef
 }
 
-var FunctionCall_Arguments_DEFAULT string
+var VariableDefDiff_TargetDef_DEFAULT *VariableDef
 
-func (p *FunctionCall) GetArguments() (v string) {
+func (p *VariableDefDiff) GetTargetDef() (v *VariableDef) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetArguments() {
-		return FunctionCall_Arguments_DEFAULT
+	if !p.IsSetTargetDef() {
+		return VariableDefDiff_TargetDef_DEFAULT
 	}
-	return *p.Arguments
+	return p.TargetDef
 }
-func (p *FunctionCall) SetName(val *string) {
-	p.Name = val
+func (p *VariableDefDiff) SetDiffType(val *DiffType) {
+	p.DiffType = val
 }
-func (p *FunctionCall) SetArguments(val *string) {
-	p.Arguments = val
+func (p *VariableDefDiff) SetKey(val *string) {
+	p.Key = val
+}
+func (p *VariableDefDiff) SetBaseDef(val *VariableDef) {
+	p.BaseDef = val
+}
+func (p *VariableDefDiff) SetTargetDef(val *VariableDef) {
+	p.TargetDef = val
 }
 
-var fieldIDToName_FunctionCall = map[int16]string{
-	1: "name",
-	2: "arguments",
+var fieldIDToName_VariableDefDiff = map[int16]string{
+	1: "diff_type",
+	2: "key",
+	3: "base_def",
+	4: "target_def",
 }
 
-func (p *FunctionCall) IsSetName() bool {
-	return p.Name != nil
+func (p *VariableDefDiff) IsSetDiffType() bool {
+	return p.DiffType != nil
 }
 
-func (p *FunctionCall) IsSetArguments() bool {
-	return p.Arguments != nil
+func (p *VariableDefDiff) IsSetKey() bool {
+	return p.Key != nil
 }
 
-func (p *FunctionCall) Read(iprot thrift.TProtocol) (err error) {
+func (p *VariableDefDiff) IsSetBaseDef() bool {
+	return p.BaseDef != nil
+}
+
+func (p *VariableDefDiff) IsSetTargetDef() bool {
+	return p.TargetDef != nil
+}
+
+func (p *VariableDefDiff) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6863,6 +11184,22 @@ func (p *FunctionCall) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6882,7 +11219,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FunctionCall[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableDefDiff[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6892,18 +11229,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FunctionCall) ReadField1(iprot thrift.TProtocol) error {
+func (p *VariableDefDiff) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *DiffType
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Name = _field
+	p.DiffType = _field
 	return nil
 }
-func (p *FunctionCall) ReadField2(iprot thrift.TProtocol) error {
+func (p *VariableDefDiff) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6911,13 +11248,29 @@ func (p *FunctionCall) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Arguments = _field
+	p.Key = _field
+	return nil
+}
+func (p *VariableDefDiff) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewVariableDef()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseDef = _field
+	return nil
+}
+func (p *VariableDefDiff) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewVariableDef()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TargetDef = _field
 	return nil
 }
 
-func (p *FunctionCall) Write(oprot thrift.TProtocol) (err error) {
+func (p *VariableDefDiff) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FunctionCall"); err != nil {
+	if err = oprot.WriteStructBegin("VariableDefDiff"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6929,6 +11282,14 @@ func (p *FunctionCall) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6947,12 +11308,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FunctionCall) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+func (p *VariableDefDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiffType() {
+		if err = oprot.WriteFieldBegin("diff_type", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := oprot.WriteString(*p.DiffType); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6965,12 +11326,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *FunctionCall) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetArguments() {
-		if err = oprot.WriteFieldBegin("arguments", thrift.STRING, 2); err != nil {
+func (p *VariableDefDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKey() {
+		if err = oprot.WriteFieldBegin("key", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Arguments); err != nil {
+		if err := oprot.WriteString(*p.Key); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6983,153 +11344,209 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *VariableDefDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseDef() {
+		if err = oprot.WriteFieldBegin("base_def", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseDef.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *VariableDefDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetDef() {
+		if err = oprot.WriteFieldBegin("target_def", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TargetDef.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
-func (p *FunctionCall) String() string {
+func (p *VariableDefDiff) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FunctionCall(%+v)", *p)
+	return fmt.Sprintf("VariableDefDiff(%+v)", *p)
 
 }
 
-func (p *FunctionCall) DeepEqual(ano *FunctionCall) bool {
+func (p *VariableDefDiff) DeepEqual(ano *VariableDefDiff) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Name) {
+	if !p.Field1DeepEqual(ano.DiffType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Arguments) {
+	if !p.Field2DeepEqual(ano.Key) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.BaseDef) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TargetDef) {
 		return false
 	}
 	return true
 }
 
-func (p *FunctionCall) Field1DeepEqual(src *string) bool {
+func (p *VariableDefDiff) Field1DeepEqual(src *DiffType) bool {
 
-	if p.Name == src {
+	if p.DiffType == src {
 		return true
-	} else if p.Name == nil || src == nil {
+	} else if p.DiffType == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	if strings.Compare(*p.DiffType, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *FunctionCall) Field2DeepEqual(src *string) bool {
+func (p *VariableDefDiff) Field2DeepEqual(src *string) bool {
 
-	if p.Arguments == src {
+	if p.Key == src {
 		return true
-	} else if p.Arguments == nil || src == nil {
+	} else if p.Key == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Arguments, *src) != 0 {
+	if strings.Compare(*p.Key, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *VariableDefDiff) Field3DeepEqual(src *VariableDef) bool {
 
-type VariableDef struct {
-	Key      *string       `thrift:"key,1,optional" frugal:"1,optional,string" form:"key" json:"key,omitempty" query:"key"`
-	Desc     *string       `thrift:"desc,2,optional" frugal:"2,optional,string" form:"desc" json:"desc,omitempty" query:"desc"`
-	Type     *VariableType `thrift:"type,3,optional" frugal:"3,optional,string" form:"type" json:"type,omitempty" query:"type"`
-	TypeTags []string      `thrift:"type_tags,4,optional" frugal:"4,optional,list<string>" form:"type_tags" json:"type_tags,omitempty" query:"type_tags"`
+	if !p.BaseDef.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *VariableDefDiff) Field4DeepEqual(src *VariableDef) bool {
 
-func NewVariableDef() *VariableDef {
-	return &VariableDef{}
+	if !p.TargetDef.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *VariableDef) InitDefault() {
+type ToolDiff struct {
+	DiffType   *DiffType `thrift:"diff_type,1,optional" frugal:"1,optional,string" form:"diff_type" json:"diff_type,omitempty" query:"diff_type"`
+	Name       *string   `thrift:"name,2,optional" frugal:"2,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	BaseTool   *Tool     `thrift:"base_tool,3,optional" frugal:"3,optional,Tool" form:"base_tool" json:"base_tool,omitempty" query:"base_tool"`
+	TargetTool *Tool     `thrift:"target_tool,4,optional" frugal:"4,optional,Tool" form:"target_tool" json:"target_tool,omitempty" query:"target_tool"`
 }
 
-var VariableDef_Key_DEFAULT string
+func NewToolDiff() *ToolDiff {
+	return &ToolDiff{}
+}
 
-func (p *VariableDef) GetKey() (v string) {
+func (p *ToolDiff) InitDefault() {
+}
+
+var ToolDiff_DiffType_DEFAULT DiffType
+
+func (p *ToolDiff) GetDiffType() (v DiffType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetKey() {
-		return VariableDef_Key_DEFAULT
+	if !p.IsSetDiffType() {
+		return ToolDiff_DiffType_DEFAULT
 	}
-	return *p.Key
+	return *p.DiffType
 }
 
-var VariableDef_Desc_DEFAULT string
+var ToolDiff_Name_DEFAULT string
 
-func (p *VariableDef) GetDesc() (v string) {
+func (p *ToolDiff) GetName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDesc() {
-		return VariableDef_Desc_DEFAULT
+	if !p.IsSetName() {
+		return ToolDiff_Name_DEFAULT
 	}
-	return *p.Desc
+	return *p.Name
 }
 
-var VariableDef_Type_DEFAULT VariableType
+var ToolDiff_BaseTool_DEFAULT *Tool
 
-func (p *VariableDef) GetType() (v VariableType) {
+func (p *ToolDiff) GetBaseTool() (v *Tool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetType() {
-		return VariableDef_Type_DEFAULT
+	if !p.IsSetBaseTool() {
+		return ToolDiff_BaseTool_DEFAULT
 	}
-	return *p.Type
+	return p.BaseTool
 }
 
-var VariableDef_TypeTags_DEFAULT []string
+var ToolDiff_TargetTool_DEFAULT *Tool
 
-func (p *VariableDef) GetTypeTags() (v []string) {
+func (p *ToolDiff) GetTargetTool() (v *Tool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTypeTags() {
-		return VariableDef_TypeTags_DEFAULT
+	if !p.IsSetTargetTool() {
+		return ToolDiff_TargetTool_DEFAULT
 	}
-	return p.TypeTags
+	return p.TargetTool
 }
-func (p *VariableDef) SetKey(val *string) {
-	p.Key = val
+func (p *ToolDiff) SetDiffType(val *DiffType) {
+	p.DiffType = val
 }
-func (p *VariableDef) SetDesc(val *string) {
-	p.Desc = val
+func (p *ToolDiff) SetName(val *string) {
+	p.Name = val
 }
-func (p *VariableDef) SetType(val *VariableType) {
-	p.Type = val
+func (p *ToolDiff) SetBaseTool(val *Tool) {
+	p.BaseTool = val
 }
-func (p *VariableDef) SetTypeTags(val []string) {
-	p.TypeTags = val
+func (p *ToolDiff) SetTargetTool(val *Tool) {
+	p.TargetTool = val
 }
 
-var fieldIDToName_VariableDef = map[int16]string{
-	1: "key",
-	2: "desc",
-	3: "type",
-	4: "type_tags",
+var fieldIDToName_ToolDiff = map[int16]string{
+	1: "diff_type",
+	2: "name",
+	3: "base_tool",
+	4: "target_tool",
 }
 
-func (p *VariableDef) IsSetKey() bool {
-	return p.Key != nil
+func (p *ToolDiff) IsSetDiffType() bool {
+	return p.DiffType != nil
 }
 
-func (p *VariableDef) IsSetDesc() bool {
-	return p.Desc != nil
+func (p *ToolDiff) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *VariableDef) IsSetType() bool {
-	return p.Type != nil
+func (p *ToolDiff) IsSetBaseTool() bool {
+	return p.BaseTool != nil
 }
 
-func (p *VariableDef) IsSetTypeTags() bool {
-	return p.TypeTags != nil
+func (p *ToolDiff) IsSetTargetTool() bool {
+	return p.TargetTool != nil
 }
 
-func (p *VariableDef) Read(iprot thrift.TProtocol) (err error) {
+func (p *ToolDiff) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7164,7 +11581,7 @@ func (p *VariableDef) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7172,7 +11589,7 @@ func (p *VariableDef) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7198,7 +11615,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableDef[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolDiff[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7208,18 +11625,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VariableDef) ReadField1(iprot thrift.TProtocol) error {
+func (p *ToolDiff) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *DiffType
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Key = _field
+	p.DiffType = _field
 	return nil
 }
-func (p *VariableDef) ReadField2(iprot thrift.TProtocol) error {
+func (p *ToolDiff) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -7227,47 +11644,29 @@ func (p *VariableDef) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Desc = _field
+	p.Name = _field
 	return nil
 }
-func (p *VariableDef) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *VariableType
-	if v, err := iprot.ReadString(); err != nil {
+func (p *ToolDiff) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewTool()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Type = _field
+	p.BaseTool = _field
 	return nil
 }
-func (p *VariableDef) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *ToolDiff) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewTool()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.TypeTags = _field
+	p.TargetTool = _field
 	return nil
 }
 
-func (p *VariableDef) Write(oprot thrift.TProtocol) (err error) {
+func (p *ToolDiff) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VariableDef"); err != nil {
+	if err = oprot.WriteStructBegin("ToolDiff"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7305,12 +11704,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VariableDef) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKey() {
-		if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
+func (p *ToolDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiffType() {
+		if err = oprot.WriteFieldBegin("diff_type", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Key); err != nil {
+		if err := oprot.WriteString(*p.DiffType); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7323,12 +11722,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *VariableDef) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDesc() {
-		if err = oprot.WriteFieldBegin("desc", thrift.STRING, 2); err != nil {
+func (p *ToolDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Desc); err != nil {
+		if err := oprot.WriteString(*p.Name); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7341,12 +11740,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *VariableDef) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err = oprot.WriteFieldBegin("type", thrift.STRING, 3); err != nil {
+func (p *ToolDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseTool() {
+		if err = oprot.WriteFieldBegin("base_tool", thrift.STRUCT, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Type); err != nil {
+		if err := p.BaseTool.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7359,20 +11758,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *VariableDef) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTypeTags() {
-		if err = oprot.WriteFieldBegin("type_tags", thrift.LIST, 4); err != nil {
+func (p *ToolDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetTool() {
+		if err = oprot.WriteFieldBegin("target_tool", thrift.STRUCT, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.TypeTags)); err != nil {
-			return err
-		}
-		for _, v := range p.TypeTags {
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := p.TargetTool.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7386,162 +11777,172 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *VariableDef) String() string {
+func (p *ToolDiff) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VariableDef(%+v)", *p)
+	return fmt.Sprintf("ToolDiff(%+v)", *p)
 
 }
 
-func (p *VariableDef) DeepEqual(ano *VariableDef) bool {
+func (p *ToolDiff) DeepEqual(ano *ToolDiff) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Key) {
+	if !p.Field1DeepEqual(ano.DiffType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Desc) {
+	if !p.Field2DeepEqual(ano.Name) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Type) {
+	if !p.Field3DeepEqual(ano.BaseTool) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.TypeTags) {
+	if !p.Field4DeepEqual(ano.TargetTool) {
 		return false
 	}
 	return true
 }
 
-func (p *VariableDef) Field1DeepEqual(src *string) bool {
+func (p *ToolDiff) Field1DeepEqual(src *DiffType) bool {
 
-	if p.Key == src {
+	if p.DiffType == src {
 		return true
-	} else if p.Key == nil || src == nil {
+	} else if p.DiffType == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Key, *src) != 0 {
+	if strings.Compare(*p.DiffType, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *VariableDef) Field2DeepEqual(src *string) bool {
+func (p *ToolDiff) Field2DeepEqual(src *string) bool {
 
-	if p.Desc == src {
+	if p.Name == src {
 		return true
-	} else if p.Desc == nil || src == nil {
+	} else if p.Name == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Desc, *src) != 0 {
+	if strings.Compare(*p.Name, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *VariableDef) Field3DeepEqual(src *VariableType) bool {
+func (p *ToolDiff) Field3DeepEqual(src *Tool) bool {
 
-	if p.Type == src {
-		return true
-	} else if p.Type == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Type, *src) != 0 {
+	if !p.BaseTool.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *VariableDef) Field4DeepEqual(src []string) bool {
+func (p *ToolDiff) Field4DeepEqual(src *Tool) bool {
 
-	if len(p.TypeTags) != len(src) {
+	if !p.TargetTool.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.TypeTags {
-		_src := src[i]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
-	}
 	return true
 }
 
-type VariableVal struct {
-	Key                 *string    `thrift:"key,1,optional" frugal:"1,optional,string" form:"key" json:"key,omitempty" query:"key"`
-	Value               *string    `thrift:"value,2,optional" frugal:"2,optional,string" form:"value" json:"value,omitempty" query:"value"`
-	PlaceholderMessages []*Message `thrift:"placeholder_messages,3,optional" frugal:"3,optional,list<Message>" form:"placeholder_messages" json:"placeholder_messages,omitempty" query:"placeholder_messages"`
+type FieldDiff struct {
+	DiffType    *DiffType `thrift:"diff_type,1,optional" frugal:"1,optional,string" form:"diff_type" json:"diff_type,omitempty" query:"diff_type"`
+	Field       *string   `thrift:"field,2,optional" frugal:"2,optional,string" form:"field" json:"field,omitempty" query:"field"`
+	BaseValue   *string   `thrift:"base_value,3,optional" frugal:"3,optional,string" form:"base_value" json:"base_value,omitempty" query:"base_value"`
+	TargetValue *string   `thrift:"target_value,4,optional" frugal:"4,optional,string" form:"target_value" json:"target_value,omitempty" query:"target_value"`
 }
 
-func NewVariableVal() *VariableVal {
-	return &VariableVal{}
+func NewFieldDiff() *FieldDiff {
+	return &FieldDiff{}
 }
 
-func (p *VariableVal) InitDefault() {
+func (p *FieldDiff) InitDefault() {
 }
 
-var VariableVal_Key_DEFAULT string
+var FieldDiff_DiffType_DEFAULT DiffType
 
-func (p *VariableVal) GetKey() (v string) {
+func (p *FieldDiff) GetDiffType() (v DiffType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetKey() {
-		return VariableVal_Key_DEFAULT
+	if !p.IsSetDiffType() {
+		return FieldDiff_DiffType_DEFAULT
 	}
-	return *p.Key
+	return *p.DiffType
 }
 
-var VariableVal_Value_DEFAULT string
+var FieldDiff_Field_DEFAULT string
 
-func (p *VariableVal) GetValue() (v string) {
+func (p *FieldDiff) GetField() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetValue() {
-		return VariableVal_Value_DEFAULT
+	if !p.IsSetField() {
+		return FieldDiff_Field_DEFAULT
 	}
-	return *p.Value
+	return *p.Field
 }
 
-var VariableVal_PlaceholderMessages_DEFAULT []*Message
+var FieldDiff_BaseValue_DEFAULT string
 
-func (p *VariableVal) GetPlaceholderMessages() (v []*Message) {
+func (p *FieldDiff) GetBaseValue() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPlaceholderMessages() {
-		return VariableVal_PlaceholderMessages_DEFAULT
+	if !p.IsSetBaseValue() {
+		return FieldDiff_BaseValue_DEFAULT
 	}
-	return p.PlaceholderMessages
+	return *p.BaseValue
 }
-func (p *VariableVal) SetKey(val *string) {
-	p.Key = val
+
+var FieldDiff_TargetValue_DEFAULT string
+
+func (p *FieldDiff) GetTargetValue() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetValue() {
+		return FieldDiff_TargetValue_DEFAULT
+	}
+	return *p.TargetValue
 }
-func (p *VariableVal) SetValue(val *string) {
-	p.Value = val
+func (p *FieldDiff) SetDiffType(val *DiffType) {
+	p.DiffType = val
 }
-func (p *VariableVal) SetPlaceholderMessages(val []*Message) {
-	p.PlaceholderMessages = val
+func (p *FieldDiff) SetField(val *string) {
+	p.Field = val
+}
+func (p *FieldDiff) SetBaseValue(val *string) {
+	p.BaseValue = val
+}
+func (p *FieldDiff) SetTargetValue(val *string) {
+	p.TargetValue = val
 }
 
-var fieldIDToName_VariableVal = map[int16]string{
-	1: "key",
-	2: "value",
-	3: "placeholder_messages",
+var fieldIDToName_FieldDiff = map[int16]string{
+	1: "diff_type",
+	2: "field",
+	3: "base_value",
+	4: "target_value",
 }
 
-func (p *VariableVal) IsSetKey() bool {
-	return p.Key != nil
+func (p *FieldDiff) IsSetDiffType() bool {
+	return p.DiffType != nil
 }
 
-func (p *VariableVal) IsSetValue() bool {
-	return p.Value != nil
+func (p *FieldDiff) IsSetField() bool {
+	return p.Field != nil
 }
 
-func (p *VariableVal) IsSetPlaceholderMessages() bool {
-	return p.PlaceholderMessages != nil
+func (p *FieldDiff) IsSetBaseValue() bool {
+	return p.BaseValue != nil
 }
 
-func (p *VariableVal) Read(iprot thrift.TProtocol) (err error) {
+func (p *FieldDiff) IsSetTargetValue() bool {
+	return p.TargetValue != nil
+}
+
+func (p *FieldDiff) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7576,13 +11977,21 @@ func (p *VariableVal) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7602,7 +12011,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableVal[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FieldDiff[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7612,18 +12021,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VariableVal) ReadField1(iprot thrift.TProtocol) error {
+func (p *FieldDiff) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *DiffType
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Key = _field
+	p.DiffType = _field
 	return nil
 }
-func (p *VariableVal) ReadField2(iprot thrift.TProtocol) error {
+func (p *FieldDiff) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -7631,36 +12040,35 @@ func (p *VariableVal) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Value = _field
+	p.Field = _field
 	return nil
 }
-func (p *VariableVal) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *FieldDiff) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*Message, 0, size)
-	values := make([]Message, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.BaseValue = _field
+	return nil
+}
+func (p *FieldDiff) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.PlaceholderMessages = _field
+	p.TargetValue = _field
 	return nil
 }
 
-func (p *VariableVal) Write(oprot thrift.TProtocol) (err error) {
+func (p *FieldDiff) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VariableVal"); err != nil {
+	if err = oprot.WriteStructBegin("FieldDiff"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7676,6 +12084,10 @@ func (p *VariableVal) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7694,12 +12106,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VariableVal) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKey() {
-		if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
+func (p *FieldDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiffType() {
+		if err = oprot.WriteFieldBegin("diff_type", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Key); err != nil {
+		if err := oprot.WriteString(*p.DiffType); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7712,12 +12124,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *VariableVal) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetValue() {
-		if err = oprot.WriteFieldBegin("value", thrift.STRING, 2); err != nil {
+func (p *FieldDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetField() {
+		if err = oprot.WriteFieldBegin("field", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Value); err != nil {
+		if err := oprot.WriteString(*p.Field); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7730,20 +12142,30 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *VariableVal) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPlaceholderMessages() {
-		if err = oprot.WriteFieldBegin("placeholder_messages", thrift.LIST, 3); err != nil {
+func (p *FieldDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseValue() {
+		if err = oprot.WriteFieldBegin("base_value", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PlaceholderMessages)); err != nil {
+		if err := oprot.WriteString(*p.BaseValue); err != nil {
 			return err
 		}
-		for _, v := range p.PlaceholderMessages {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *FieldDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetValue() {
+		if err = oprot.WriteFieldBegin("target_value", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetValue); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7752,71 +12174,85 @@ func (p *VariableVal) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *VariableVal) String() string {
+func (p *FieldDiff) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VariableVal(%+v)", *p)
+	return fmt.Sprintf("FieldDiff(%+v)", *p)
 
 }
 
-func (p *VariableVal) DeepEqual(ano *VariableVal) bool {
+func (p *FieldDiff) DeepEqual(ano *FieldDiff) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Key) {
+	if !p.Field1DeepEqual(ano.DiffType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Value) {
+	if !p.Field2DeepEqual(ano.Field) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.PlaceholderMessages) {
+	if !p.Field3DeepEqual(ano.BaseValue) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TargetValue) {
 		return false
 	}
 	return true
 }
 
-func (p *VariableVal) Field1DeepEqual(src *string) bool {
+func (p *FieldDiff) Field1DeepEqual(src *DiffType) bool {
 
-	if p.Key == src {
+	if p.DiffType == src {
 		return true
-	} else if p.Key == nil || src == nil {
+	} else if p.DiffType == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Key, *src) != 0 {
+	if strings.Compare(*p.DiffType, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *VariableVal) Field2DeepEqual(src *string) bool {
+func (p *FieldDiff) Field2DeepEqual(src *string) bool {
 
-	if p.Value == src {
+	if p.Field == src {
 		return true
-	} else if p.Value == nil || src == nil {
+	} else if p.Field == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Value, *src) != 0 {
+	if strings.Compare(*p.Field, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *VariableVal) Field3DeepEqual(src []*Message) bool {
+func (p *FieldDiff) Field3DeepEqual(src *string) bool {
 
-	if len(p.PlaceholderMessages) != len(src) {
+	if p.BaseValue == src {
+		return true
+	} else if p.BaseValue == nil || src == nil {
 		return false
 	}
-	for i, v := range p.PlaceholderMessages {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.BaseValue, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *FieldDiff) Field4DeepEqual(src *string) bool {
+
+	if p.TargetValue == src {
+		return true
+	} else if p.TargetValue == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TargetValue, *src) != 0 {
+		return false
 	}
 	return true
 }
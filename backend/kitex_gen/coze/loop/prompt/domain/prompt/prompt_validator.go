@@ -58,6 +58,15 @@ func (p *PromptCommit) IsValid() error {
 func (p *CommitInfo) IsValid() error {
 	return nil
 }
+func (p *PromptLabel) IsValid() error {
+	return nil
+}
+func (p *PromptLabelHistory) IsValid() error {
+	return nil
+}
+func (p *SnippetReference) IsValid() error {
+	return nil
+}
 func (p *PromptDraft) IsValid() error {
 	if p.Detail != nil {
 		if err := p.Detail.IsValid(); err != nil {
@@ -143,6 +152,54 @@ func (p *VariableDef) IsValid() error {
 func (p *VariableVal) IsValid() error {
 	return nil
 }
+func (p *PromptDetailDiff) IsValid() error {
+	return nil
+}
+func (p *MessageDiff) IsValid() error {
+	if p.BaseMessage != nil {
+		if err := p.BaseMessage.IsValid(); err != nil {
+			return fmt.Errorf("field BaseMessage not valid, %w", err)
+		}
+	}
+	if p.TargetMessage != nil {
+		if err := p.TargetMessage.IsValid(); err != nil {
+			return fmt.Errorf("field TargetMessage not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *TextDiffLine) IsValid() error {
+	return nil
+}
+func (p *VariableDefDiff) IsValid() error {
+	if p.BaseDef != nil {
+		if err := p.BaseDef.IsValid(); err != nil {
+			return fmt.Errorf("field BaseDef not valid, %w", err)
+		}
+	}
+	if p.TargetDef != nil {
+		if err := p.TargetDef.IsValid(); err != nil {
+			return fmt.Errorf("field TargetDef not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ToolDiff) IsValid() error {
+	if p.BaseTool != nil {
+		if err := p.BaseTool.IsValid(); err != nil {
+			return fmt.Errorf("field BaseTool not valid, %w", err)
+		}
+	}
+	if p.TargetTool != nil {
+		if err := p.TargetTool.IsValid(); err != nil {
+			return fmt.Errorf("field TargetTool not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *FieldDiff) IsValid() error {
+	return nil
+}
 func (p *TokenUsage) IsValid() error {
 	return nil
 }
@@ -11,11 +11,13 @@ import (
 	"github.com/cloudwego/gopkg/protocol/thrift"
 
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime"
 )
 
 var (
 	_ = manage.KitexUnusedProtection
+	_ = openapi.KitexUnusedProtection
 	_ = runtime.KitexUnusedProtection
 )
 
@@ -13,6 +13,9 @@ import (
 type Client interface {
 	ListModels(ctx context.Context, req *manage.ListModelsRequest, callOptions ...callopt.Option) (r *manage.ListModelsResponse, err error)
 	GetModel(ctx context.Context, req *manage.GetModelRequest, callOptions ...callopt.Option) (r *manage.GetModelResponse, err error)
+	CreateModel(ctx context.Context, req *manage.CreateModelRequest, callOptions ...callopt.Option) (r *manage.CreateModelResponse, err error)
+	UpdateModel(ctx context.Context, req *manage.UpdateModelRequest, callOptions ...callopt.Option) (r *manage.UpdateModelResponse, err error)
+	DeleteModel(ctx context.Context, req *manage.DeleteModelRequest, callOptions ...callopt.Option) (r *manage.DeleteModelResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -53,3 +56,18 @@ func (p *kLLMManageServiceClient) GetModel(ctx context.Context, req *manage.GetM
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetModel(ctx, req)
 }
+
+func (p *kLLMManageServiceClient) CreateModel(ctx context.Context, req *manage.CreateModelRequest, callOptions ...callopt.Option) (r *manage.CreateModelResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateModel(ctx, req)
+}
+
+func (p *kLLMManageServiceClient) UpdateModel(ctx context.Context, req *manage.UpdateModelRequest, callOptions ...callopt.Option) (r *manage.UpdateModelResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateModel(ctx, req)
+}
+
+func (p *kLLMManageServiceClient) DeleteModel(ctx context.Context, req *manage.DeleteModelRequest, callOptions ...callopt.Option) (r *manage.DeleteModelResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteModel(ctx, req)
+}
@@ -29,6 +29,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingServer),
 	),
+	"Embed": kitex.NewMethodInfo(
+		embedHandler,
+		newLLMRuntimeServiceEmbedArgs,
+		newLLMRuntimeServiceEmbedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -102,6 +109,25 @@ func newLLMRuntimeServiceChatStreamResult() interface{} {
 	return runtime.NewLLMRuntimeServiceChatStreamResult()
 }
 
+func embedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*runtime.LLMRuntimeServiceEmbedArgs)
+	realResult := result.(*runtime.LLMRuntimeServiceEmbedResult)
+	success, err := handler.(runtime.LLMRuntimeService).Embed(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMRuntimeServiceEmbedArgs() interface{} {
+	return runtime.NewLLMRuntimeServiceEmbedArgs()
+}
+
+func newLLMRuntimeServiceEmbedResult() interface{} {
+	return runtime.NewLLMRuntimeServiceEmbedResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -138,3 +164,13 @@ func (p *kClient) ChatStream(ctx context.Context, req *runtime.ChatRequest) (LLM
 	}
 	return stream, nil
 }
+
+func (p *kClient) Embed(ctx context.Context, req *runtime.EmbedRequest) (r *runtime.EmbedResponse, err error) {
+	var _args runtime.LLMRuntimeServiceEmbedArgs
+	_args.Req = req
+	var _result runtime.LLMRuntimeServiceEmbedResult
+	if err = p.c.Call(ctx, "Embed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
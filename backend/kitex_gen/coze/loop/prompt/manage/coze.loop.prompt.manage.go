@@ -26,6 +26,7 @@ type CreatePromptRequest struct {
 	PromptName        *string              `thrift:"prompt_name,11,optional" frugal:"11,optional,string" form:"prompt_name" json:"prompt_name,omitempty" query:"prompt_name"`
 	PromptKey         *string              `thrift:"prompt_key,12,optional" frugal:"12,optional,string" form:"prompt_key" json:"prompt_key,omitempty" query:"prompt_key"`
 	PromptDescription *string              `thrift:"prompt_description,13,optional" frugal:"13,optional,string" form:"prompt_description" json:"prompt_description,omitempty" query:"prompt_description"`
+	PromptType        *prompt.PromptType   `thrift:"prompt_type,14,optional" frugal:"14,optional,string" form:"prompt_type" json:"prompt_type,omitempty" query:"prompt_type"`
 	DraftDetail       *prompt.PromptDetail `thrift:"draft_detail,21,optional" frugal:"21,optional,prompt.PromptDetail" form:"draft_detail" json:"draft_detail,omitempty" query:"draft_detail"`
 	Base              *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
@@ -85,6 +86,18 @@ func (p *CreatePromptRequest) GetPromptDescription() (v string) {
 	return *p.PromptDescription
 }
 
+var CreatePromptRequest_PromptType_DEFAULT prompt.PromptType
+
+func (p *CreatePromptRequest) GetPromptType() (v prompt.PromptType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptType() {
+		return CreatePromptRequest_PromptType_DEFAULT
+	}
+	return *p.PromptType
+}
+
 var CreatePromptRequest_DraftDetail_DEFAULT *prompt.PromptDetail
 
 func (p *CreatePromptRequest) GetDraftDetail() (v *prompt.PromptDetail) {
@@ -120,6 +133,9 @@ func (p *CreatePromptRequest) SetPromptKey(val *string) {
 func (p *CreatePromptRequest) SetPromptDescription(val *string) {
 	p.PromptDescription = val
 }
+func (p *CreatePromptRequest) SetPromptType(val *prompt.PromptType) {
+	p.PromptType = val
+}
 func (p *CreatePromptRequest) SetDraftDetail(val *prompt.PromptDetail) {
 	p.DraftDetail = val
 }
@@ -132,6 +148,7 @@ var fieldIDToName_CreatePromptRequest = map[int16]string{
 	11:  "prompt_name",
 	12:  "prompt_key",
 	13:  "prompt_description",
+	14:  "prompt_type",
 	21:  "draft_detail",
 	255: "Base",
 }
@@ -152,6 +169,10 @@ func (p *CreatePromptRequest) IsSetPromptDescription() bool {
 	return p.PromptDescription != nil
 }
 
+func (p *CreatePromptRequest) IsSetPromptType() bool {
+	return p.PromptType != nil
+}
+
 func (p *CreatePromptRequest) IsSetDraftDetail() bool {
 	return p.DraftDetail != nil
 }
@@ -210,6 +231,14 @@ func (p *CreatePromptRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 21:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField21(iprot); err != nil {
@@ -299,6 +328,17 @@ func (p *CreatePromptRequest) ReadField13(iprot thrift.TProtocol) error {
 	p.PromptDescription = _field
 	return nil
 }
+func (p *CreatePromptRequest) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *prompt.PromptType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PromptType = _field
+	return nil
+}
 func (p *CreatePromptRequest) ReadField21(iprot thrift.TProtocol) error {
 	_field := prompt.NewPromptDetail()
 	if err := _field.Read(iprot); err != nil {
@@ -338,6 +378,10 @@ func (p *CreatePromptRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 13
 			goto WriteFieldError
 		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
 		if err = p.writeField21(oprot); err != nil {
 			fieldId = 21
 			goto WriteFieldError
@@ -436,6 +480,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
+func (p *CreatePromptRequest) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptType() {
+		if err = oprot.WriteFieldBegin("prompt_type", thrift.STRING, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PromptType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
 func (p *CreatePromptRequest) writeField21(oprot thrift.TProtocol) (err error) {
 	if p.IsSetDraftDetail() {
 		if err = oprot.WriteFieldBegin("draft_detail", thrift.STRUCT, 21); err != nil {
@@ -499,6 +561,9 @@ func (p *CreatePromptRequest) DeepEqual(ano *CreatePromptRequest) bool {
 	if !p.Field13DeepEqual(ano.PromptDescription) {
 		return false
 	}
+	if !p.Field14DeepEqual(ano.PromptType) {
+		return false
+	}
 	if !p.Field21DeepEqual(ano.DraftDetail) {
 		return false
 	}
@@ -556,6 +621,18 @@ func (p *CreatePromptRequest) Field13DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *CreatePromptRequest) Field14DeepEqual(src *prompt.PromptType) bool {
+
+	if p.PromptType == src {
+		return true
+	} else if p.PromptType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PromptType, *src) != 0 {
+		return false
+	}
+	return true
+}
 func (p *CreatePromptRequest) Field21DeepEqual(src *prompt.PromptDetail) bool {
 
 	if !p.DraftDetail.DeepEqual(src) {
@@ -3002,6 +3079,8 @@ type PromptQuery struct {
 	PromptID      *int64  `thrift:"prompt_id,1,optional" frugal:"1,optional,i64" json:"prompt_id" form:"prompt_id" query:"prompt_id"`
 	WithCommit    *bool   `thrift:"with_commit,11,optional" frugal:"11,optional,bool" form:"with_commit" json:"with_commit,omitempty" query:"with_commit"`
 	CommitVersion *string `thrift:"commit_version,12,optional" frugal:"12,optional,string" form:"commit_version" json:"commit_version,omitempty" query:"commit_version"`
+	// 发布标签，commit_version为空时按标签解析提交版本
+	Label *string `thrift:"label,13,optional" frugal:"13,optional,string" form:"label" json:"label,omitempty" query:"label"`
 }
 
 func NewPromptQuery() *PromptQuery {
@@ -3046,6 +3125,18 @@ func (p *PromptQuery) GetCommitVersion() (v string) {
 	}
 	return *p.CommitVersion
 }
+
+var PromptQuery_Label_DEFAULT string
+
+func (p *PromptQuery) GetLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return PromptQuery_Label_DEFAULT
+	}
+	return *p.Label
+}
 func (p *PromptQuery) SetPromptID(val *int64) {
 	p.PromptID = val
 }
@@ -3055,11 +3146,15 @@ func (p *PromptQuery) SetWithCommit(val *bool) {
 func (p *PromptQuery) SetCommitVersion(val *string) {
 	p.CommitVersion = val
 }
+func (p *PromptQuery) SetLabel(val *string) {
+	p.Label = val
+}
 
 var fieldIDToName_PromptQuery = map[int16]string{
 	1:  "prompt_id",
 	11: "with_commit",
 	12: "commit_version",
+	13: "label",
 }
 
 func (p *PromptQuery) IsSetPromptID() bool {
@@ -3074,6 +3169,10 @@ func (p *PromptQuery) IsSetCommitVersion() bool {
 	return p.CommitVersion != nil
 }
 
+func (p *PromptQuery) IsSetLabel() bool {
+	return p.Label != nil
+}
+
 func (p *PromptQuery) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3116,6 +3215,14 @@ func (p *PromptQuery) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 13:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3178,6 +3285,17 @@ func (p *PromptQuery) ReadField12(iprot thrift.TProtocol) error {
 	p.CommitVersion = _field
 	return nil
 }
+func (p *PromptQuery) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Label = _field
+	return nil
+}
 
 func (p *PromptQuery) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -3197,6 +3315,10 @@ func (p *PromptQuery) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 12
 			goto WriteFieldError
 		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3269,6 +3391,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
+func (p *PromptQuery) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
 
 func (p *PromptQuery) String() string {
 	if p == nil {
@@ -3293,6 +3433,9 @@ func (p *PromptQuery) DeepEqual(ano *PromptQuery) bool {
 	if !p.Field12DeepEqual(ano.CommitVersion) {
 		return false
 	}
+	if !p.Field13DeepEqual(ano.Label) {
+		return false
+	}
 	return true
 }
 
@@ -3332,6 +3475,18 @@ func (p *PromptQuery) Field12DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *PromptQuery) Field13DeepEqual(src *string) bool {
+
+	if p.Label == src {
+		return true
+	} else if p.Label == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Label, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type BatchGetPromptRequest struct {
 	Queries []*PromptQuery `thrift:"queries,1,optional" frugal:"1,optional,list<PromptQuery>" form:"queries" json:"queries,omitempty" query:"queries"`
@@ -4118,15 +4273,16 @@ func (p *PromptResult_) Field2DeepEqual(src *prompt.Prompt) bool {
 }
 
 type ListPromptRequest struct {
-	WorkspaceID   *int64             `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	KeyWord       *string            `thrift:"key_word,11,optional" frugal:"11,optional,string" form:"key_word" json:"key_word,omitempty" query:"key_word"`
-	CreatedBys    []string           `thrift:"created_bys,12,optional" frugal:"12,optional,list<string>" form:"created_bys" json:"created_bys,omitempty" query:"created_bys"`
-	CommittedOnly *bool              `thrift:"committed_only,13,optional" frugal:"13,optional,bool" form:"committed_only" json:"committed_only,omitempty" query:"committed_only"`
-	PageNum       *int32             `thrift:"page_num,127,optional" frugal:"127,optional,i32" form:"page_num" json:"page_num,omitempty" query:"page_num"`
-	PageSize      *int32             `thrift:"page_size,128,optional" frugal:"128,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
-	OrderBy       *ListPromptOrderBy `thrift:"order_by,129,optional" frugal:"129,optional,string" form:"order_by" json:"order_by,omitempty" query:"order_by"`
-	Asc           *bool              `thrift:"asc,130,optional" frugal:"130,optional,bool" form:"asc" json:"asc,omitempty" query:"asc"`
-	Base          *base.Base         `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	WorkspaceID   *int64              `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	KeyWord       *string             `thrift:"key_word,11,optional" frugal:"11,optional,string" form:"key_word" json:"key_word,omitempty" query:"key_word"`
+	CreatedBys    []string            `thrift:"created_bys,12,optional" frugal:"12,optional,list<string>" form:"created_bys" json:"created_bys,omitempty" query:"created_bys"`
+	CommittedOnly *bool               `thrift:"committed_only,13,optional" frugal:"13,optional,bool" form:"committed_only" json:"committed_only,omitempty" query:"committed_only"`
+	PromptTypes   []prompt.PromptType `thrift:"prompt_types,14,optional" frugal:"14,optional,list<string>" form:"prompt_types" json:"prompt_types,omitempty" query:"prompt_types"`
+	PageNum       *int32              `thrift:"page_num,127,optional" frugal:"127,optional,i32" form:"page_num" json:"page_num,omitempty" query:"page_num"`
+	PageSize      *int32              `thrift:"page_size,128,optional" frugal:"128,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
+	OrderBy       *ListPromptOrderBy  `thrift:"order_by,129,optional" frugal:"129,optional,string" form:"order_by" json:"order_by,omitempty" query:"order_by"`
+	Asc           *bool               `thrift:"asc,130,optional" frugal:"130,optional,bool" form:"asc" json:"asc,omitempty" query:"asc"`
+	Base          *base.Base          `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewListPromptRequest() *ListPromptRequest {
@@ -4184,6 +4340,18 @@ func (p *ListPromptRequest) GetCommittedOnly() (v bool) {
 	return *p.CommittedOnly
 }
 
+var ListPromptRequest_PromptTypes_DEFAULT []prompt.PromptType
+
+func (p *ListPromptRequest) GetPromptTypes() (v []prompt.PromptType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptTypes() {
+		return ListPromptRequest_PromptTypes_DEFAULT
+	}
+	return p.PromptTypes
+}
+
 var ListPromptRequest_PageNum_DEFAULT int32
 
 func (p *ListPromptRequest) GetPageNum() (v int32) {
@@ -4255,6 +4423,9 @@ func (p *ListPromptRequest) SetCreatedBys(val []string) {
 func (p *ListPromptRequest) SetCommittedOnly(val *bool) {
 	p.CommittedOnly = val
 }
+func (p *ListPromptRequest) SetPromptTypes(val []prompt.PromptType) {
+	p.PromptTypes = val
+}
 func (p *ListPromptRequest) SetPageNum(val *int32) {
 	p.PageNum = val
 }
@@ -4276,6 +4447,7 @@ var fieldIDToName_ListPromptRequest = map[int16]string{
 	11:  "key_word",
 	12:  "created_bys",
 	13:  "committed_only",
+	14:  "prompt_types",
 	127: "page_num",
 	128: "page_size",
 	129: "order_by",
@@ -4299,6 +4471,10 @@ func (p *ListPromptRequest) IsSetCommittedOnly() bool {
 	return p.CommittedOnly != nil
 }
 
+func (p *ListPromptRequest) IsSetPromptTypes() bool {
+	return p.PromptTypes != nil
+}
+
 func (p *ListPromptRequest) IsSetPageNum() bool {
 	return p.PageNum != nil
 }
@@ -4369,6 +4545,14 @@ func (p *ListPromptRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 14:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 127:
 			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField127(iprot); err != nil {
@@ -4494,6 +4678,29 @@ func (p *ListPromptRequest) ReadField13(iprot thrift.TProtocol) error {
 	p.CommittedOnly = _field
 	return nil
 }
+func (p *ListPromptRequest) ReadField14(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]prompt.PromptType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem prompt.PromptType
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PromptTypes = _field
+	return nil
+}
 func (p *ListPromptRequest) ReadField127(iprot thrift.TProtocol) error {
 
 	var _field *int32
@@ -4569,6 +4776,10 @@ func (p *ListPromptRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 13
 			goto WriteFieldError
 		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
 		if err = p.writeField127(oprot); err != nil {
 			fieldId = 127
 			goto WriteFieldError
@@ -4687,6 +4898,32 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
+func (p *ListPromptRequest) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptTypes() {
+		if err = oprot.WriteFieldBegin("prompt_types", thrift.LIST, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.PromptTypes)); err != nil {
+			return err
+		}
+		for _, v := range p.PromptTypes {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
 func (p *ListPromptRequest) writeField127(oprot thrift.TProtocol) (err error) {
 	if p.IsSetPageNum() {
 		if err = oprot.WriteFieldBegin("page_num", thrift.I32, 127); err != nil {
@@ -4804,6 +5041,9 @@ func (p *ListPromptRequest) DeepEqual(ano *ListPromptRequest) bool {
 	if !p.Field13DeepEqual(ano.CommittedOnly) {
 		return false
 	}
+	if !p.Field14DeepEqual(ano.PromptTypes) {
+		return false
+	}
 	if !p.Field127DeepEqual(ano.PageNum) {
 		return false
 	}
@@ -4871,6 +5111,19 @@ func (p *ListPromptRequest) Field13DeepEqual(src *bool) bool {
 	}
 	return true
 }
+func (p *ListPromptRequest) Field14DeepEqual(src []prompt.PromptType) bool {
+
+	if len(p.PromptTypes) != len(src) {
+		return false
+	}
+	for i, v := range p.PromptTypes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
 func (p *ListPromptRequest) Field127DeepEqual(src *int32) bool {
 
 	if p.PageNum == src {
@@ -8593,772 +8846,7598 @@ func (p *RevertDraftFromCommitResponse) Field255DeepEqual(src *base.BaseResp) bo
 	return true
 }
 
-type PromptManageService interface {
-	// --------------- Prompt管理 --------------- //
-	// 增
-	CreatePrompt(ctx context.Context, request *CreatePromptRequest) (r *CreatePromptResponse, err error)
-
-	ClonePrompt(ctx context.Context, request *ClonePromptRequest) (r *ClonePromptResponse, err error)
-	// 删
-	DeletePrompt(ctx context.Context, request *DeletePromptRequest) (r *DeletePromptResponse, err error)
-	// 查
-	GetPrompt(ctx context.Context, request *GetPromptRequest) (r *GetPromptResponse, err error)
-
-	BatchGetPrompt(ctx context.Context, request *BatchGetPromptRequest) (r *BatchGetPromptResponse, err error)
-
-	ListPrompt(ctx context.Context, request *ListPromptRequest) (r *ListPromptResponse, err error)
-	// 改
-	UpdatePrompt(ctx context.Context, request *UpdatePromptRequest) (r *UpdatePromptResponse, err error)
-
-	SaveDraft(ctx context.Context, request *SaveDraftRequest) (r *SaveDraftResponse, err error)
-	// --------------- Prompt版本管理 --------------- //
-	ListCommit(ctx context.Context, request *ListCommitRequest) (r *ListCommitResponse, err error)
-
-	CommitDraft(ctx context.Context, request *CommitDraftRequest) (r *CommitDraftResponse, err error)
-
-	RevertDraftFromCommit(ctx context.Context, request *RevertDraftFromCommitRequest) (r *RevertDraftFromCommitResponse, err error)
+// 对比两个提交版本的差异
+type DiffCommitRequest struct {
+	PromptID            *int64     `thrift:"prompt_id,1,optional" frugal:"1,optional,i64" json:"prompt_id" path:"prompt_id" `
+	BaseCommitVersion   *string    `thrift:"base_commit_version,2,optional" frugal:"2,optional,string" form:"base_commit_version" json:"base_commit_version,omitempty" query:"base_commit_version"`
+	TargetCommitVersion *string    `thrift:"target_commit_version,3,optional" frugal:"3,optional,string" form:"target_commit_version" json:"target_commit_version,omitempty" query:"target_commit_version"`
+	Base                *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-type PromptManageServiceClient struct {
-	c thrift.TClient
+func NewDiffCommitRequest() *DiffCommitRequest {
+	return &DiffCommitRequest{}
 }
 
-func NewPromptManageServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *PromptManageServiceClient {
-	return &PromptManageServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *DiffCommitRequest) InitDefault() {
 }
 
-func NewPromptManageServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *PromptManageServiceClient {
-	return &PromptManageServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
-}
+var DiffCommitRequest_PromptID_DEFAULT int64
 
-func NewPromptManageServiceClient(c thrift.TClient) *PromptManageServiceClient {
-	return &PromptManageServiceClient{
-		c: c,
+func (p *DiffCommitRequest) GetPromptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptID() {
+		return DiffCommitRequest_PromptID_DEFAULT
 	}
+	return *p.PromptID
 }
 
-func (p *PromptManageServiceClient) Client_() thrift.TClient {
-	return p.c
-}
+var DiffCommitRequest_BaseCommitVersion_DEFAULT string
 
-func (p *PromptManageServiceClient) CreatePrompt(ctx context.Context, request *CreatePromptRequest) (r *CreatePromptResponse, err error) {
-	var _args PromptManageServiceCreatePromptArgs
-	_args.Request = request
-	var _result PromptManageServiceCreatePromptResult
-	if err = p.Client_().Call(ctx, "CreatePrompt", &_args, &_result); err != nil {
+func (p *DiffCommitRequest) GetBaseCommitVersion() (v string) {
+	if p == nil {
 		return
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *PromptManageServiceClient) ClonePrompt(ctx context.Context, request *ClonePromptRequest) (r *ClonePromptResponse, err error) {
-	var _args PromptManageServiceClonePromptArgs
-	_args.Request = request
-	var _result PromptManageServiceClonePromptResult
-	if err = p.Client_().Call(ctx, "ClonePrompt", &_args, &_result); err != nil {
-		return
+	if !p.IsSetBaseCommitVersion() {
+		return DiffCommitRequest_BaseCommitVersion_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.BaseCommitVersion
 }
-func (p *PromptManageServiceClient) DeletePrompt(ctx context.Context, request *DeletePromptRequest) (r *DeletePromptResponse, err error) {
-	var _args PromptManageServiceDeletePromptArgs
-	_args.Request = request
-	var _result PromptManageServiceDeletePromptResult
-	if err = p.Client_().Call(ctx, "DeletePrompt", &_args, &_result); err != nil {
+
+var DiffCommitRequest_TargetCommitVersion_DEFAULT string
+
+func (p *DiffCommitRequest) GetTargetCommitVersion() (v string) {
+	if p == nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	if !p.IsSetTargetCommitVersion() {
+		return DiffCommitRequest_TargetCommitVersion_DEFAULT
+	}
+	return *p.TargetCommitVersion
 }
-func (p *PromptManageServiceClient) GetPrompt(ctx context.Context, request *GetPromptRequest) (r *GetPromptResponse, err error) {
-	var _args PromptManageServiceGetPromptArgs
-	_args.Request = request
-	var _result PromptManageServiceGetPromptResult
-	if err = p.Client_().Call(ctx, "GetPrompt", &_args, &_result); err != nil {
+
+var DiffCommitRequest_Base_DEFAULT *base.Base
+
+func (p *DiffCommitRequest) GetBase() (v *base.Base) {
+	if p == nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	if !p.IsSetBase() {
+		return DiffCommitRequest_Base_DEFAULT
+	}
+	return p.Base
 }
-func (p *PromptManageServiceClient) BatchGetPrompt(ctx context.Context, request *BatchGetPromptRequest) (r *BatchGetPromptResponse, err error) {
-	var _args PromptManageServiceBatchGetPromptArgs
-	_args.Request = request
-	var _result PromptManageServiceBatchGetPromptResult
-	if err = p.Client_().Call(ctx, "BatchGetPrompt", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *PromptManageServiceClient) ListPrompt(ctx context.Context, request *ListPromptRequest) (r *ListPromptResponse, err error) {
-	var _args PromptManageServiceListPromptArgs
-	_args.Request = request
-	var _result PromptManageServiceListPromptResult
-	if err = p.Client_().Call(ctx, "ListPrompt", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *PromptManageServiceClient) UpdatePrompt(ctx context.Context, request *UpdatePromptRequest) (r *UpdatePromptResponse, err error) {
-	var _args PromptManageServiceUpdatePromptArgs
-	_args.Request = request
-	var _result PromptManageServiceUpdatePromptResult
-	if err = p.Client_().Call(ctx, "UpdatePrompt", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *PromptManageServiceClient) SaveDraft(ctx context.Context, request *SaveDraftRequest) (r *SaveDraftResponse, err error) {
-	var _args PromptManageServiceSaveDraftArgs
-	_args.Request = request
-	var _result PromptManageServiceSaveDraftResult
-	if err = p.Client_().Call(ctx, "SaveDraft", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *DiffCommitRequest) SetPromptID(val *int64) {
+	p.PromptID = val
 }
-func (p *PromptManageServiceClient) ListCommit(ctx context.Context, request *ListCommitRequest) (r *ListCommitResponse, err error) {
-	var _args PromptManageServiceListCommitArgs
-	_args.Request = request
-	var _result PromptManageServiceListCommitResult
-	if err = p.Client_().Call(ctx, "ListCommit", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *DiffCommitRequest) SetBaseCommitVersion(val *string) {
+	p.BaseCommitVersion = val
 }
-func (p *PromptManageServiceClient) CommitDraft(ctx context.Context, request *CommitDraftRequest) (r *CommitDraftResponse, err error) {
-	var _args PromptManageServiceCommitDraftArgs
-	_args.Request = request
-	var _result PromptManageServiceCommitDraftResult
-	if err = p.Client_().Call(ctx, "CommitDraft", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *DiffCommitRequest) SetTargetCommitVersion(val *string) {
+	p.TargetCommitVersion = val
 }
-func (p *PromptManageServiceClient) RevertDraftFromCommit(ctx context.Context, request *RevertDraftFromCommitRequest) (r *RevertDraftFromCommitResponse, err error) {
-	var _args PromptManageServiceRevertDraftFromCommitArgs
-	_args.Request = request
-	var _result PromptManageServiceRevertDraftFromCommitResult
-	if err = p.Client_().Call(ctx, "RevertDraftFromCommit", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *DiffCommitRequest) SetBase(val *base.Base) {
+	p.Base = val
 }
 
-type PromptManageServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      PromptManageService
+var fieldIDToName_DiffCommitRequest = map[int16]string{
+	1:   "prompt_id",
+	2:   "base_commit_version",
+	3:   "target_commit_version",
+	255: "Base",
 }
 
-func (p *PromptManageServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *DiffCommitRequest) IsSetPromptID() bool {
+	return p.PromptID != nil
 }
 
-func (p *PromptManageServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *DiffCommitRequest) IsSetBaseCommitVersion() bool {
+	return p.BaseCommitVersion != nil
 }
 
-func (p *PromptManageServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *DiffCommitRequest) IsSetTargetCommitVersion() bool {
+	return p.TargetCommitVersion != nil
 }
 
-func NewPromptManageServiceProcessor(handler PromptManageService) *PromptManageServiceProcessor {
-	self := &PromptManageServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("CreatePrompt", &promptManageServiceProcessorCreatePrompt{handler: handler})
-	self.AddToProcessorMap("ClonePrompt", &promptManageServiceProcessorClonePrompt{handler: handler})
-	self.AddToProcessorMap("DeletePrompt", &promptManageServiceProcessorDeletePrompt{handler: handler})
-	self.AddToProcessorMap("GetPrompt", &promptManageServiceProcessorGetPrompt{handler: handler})
-	self.AddToProcessorMap("BatchGetPrompt", &promptManageServiceProcessorBatchGetPrompt{handler: handler})
-	self.AddToProcessorMap("ListPrompt", &promptManageServiceProcessorListPrompt{handler: handler})
-	self.AddToProcessorMap("UpdatePrompt", &promptManageServiceProcessorUpdatePrompt{handler: handler})
-	self.AddToProcessorMap("SaveDraft", &promptManageServiceProcessorSaveDraft{handler: handler})
-	self.AddToProcessorMap("ListCommit", &promptManageServiceProcessorListCommit{handler: handler})
-	self.AddToProcessorMap("CommitDraft", &promptManageServiceProcessorCommitDraft{handler: handler})
-	self.AddToProcessorMap("RevertDraftFromCommit", &promptManageServiceProcessorRevertDraftFromCommit{handler: handler})
-	return self
+func (p *DiffCommitRequest) IsSetBase() bool {
+	return p.Base != nil
 }
-func (p *PromptManageServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+func (p *DiffCommitRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type promptManageServiceProcessorCreatePrompt struct {
-	handler PromptManageService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffCommitRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *promptManageServiceProcessorCreatePrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceCreatePromptArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreatePrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *DiffCommitRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceCreatePromptResult{}
-	var retval *CreatePromptResponse
-	if retval, err2 = p.handler.CreatePrompt(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreatePrompt: "+err2.Error())
-		oprot.WriteMessageBegin("CreatePrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreatePrompt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+		_field = &v
 	}
-	return true, err
+	p.PromptID = _field
+	return nil
 }
+func (p *DiffCommitRequest) ReadField2(iprot thrift.TProtocol) error {
 
-type promptManageServiceProcessorClonePrompt struct {
-	handler PromptManageService
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseCommitVersion = _field
+	return nil
 }
+func (p *DiffCommitRequest) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *promptManageServiceProcessorClonePrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceClonePromptArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ClonePrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetCommitVersion = _field
+	return nil
+}
+func (p *DiffCommitRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Base = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceClonePromptResult{}
-	var retval *ClonePromptResponse
-	if retval, err2 = p.handler.ClonePrompt(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ClonePrompt: "+err2.Error())
-		oprot.WriteMessageBegin("ClonePrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *DiffCommitRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DiffCommitRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("ClonePrompt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DiffCommitRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptID() {
+		if err = oprot.WriteFieldBegin("prompt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PromptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DiffCommitRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseCommitVersion() {
+		if err = oprot.WriteFieldBegin("base_commit_version", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.BaseCommitVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-type promptManageServiceProcessorDeletePrompt struct {
-	handler PromptManageService
+func (p *DiffCommitRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetCommitVersion() {
+		if err = oprot.WriteFieldBegin("target_commit_version", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetCommitVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *promptManageServiceProcessorDeletePrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceDeletePromptArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeletePrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DiffCommitRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceDeletePromptResult{}
-	var retval *DeletePromptResponse
-	if retval, err2 = p.handler.DeletePrompt(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeletePrompt: "+err2.Error())
-		oprot.WriteMessageBegin("DeletePrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *DiffCommitRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageBegin("DeletePrompt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return fmt.Sprintf("DiffCommitRequest(%+v)", *p)
+
+}
+
+func (p *DiffCommitRequest) DeepEqual(ano *DiffCommitRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.PromptID) {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field2DeepEqual(ano.BaseCommitVersion) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field3DeepEqual(ano.TargetCommitVersion) {
+		return false
 	}
-	if err != nil {
-		return
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type promptManageServiceProcessorGetPrompt struct {
-	handler PromptManageService
-}
+func (p *DiffCommitRequest) Field1DeepEqual(src *int64) bool {
 
-func (p *promptManageServiceProcessorGetPrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceGetPromptArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetPrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	if p.PromptID == src {
+		return true
+	} else if p.PromptID == nil || src == nil {
+		return false
+	}
+	if *p.PromptID != *src {
+		return false
 	}
+	return true
+}
+func (p *DiffCommitRequest) Field2DeepEqual(src *string) bool {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceGetPromptResult{}
-	var retval *GetPromptResponse
-	if retval, err2 = p.handler.GetPrompt(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPrompt: "+err2.Error())
-		oprot.WriteMessageBegin("GetPrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if p.BaseCommitVersion == src {
+		return true
+	} else if p.BaseCommitVersion == nil || src == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageBegin("GetPrompt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if strings.Compare(*p.BaseCommitVersion, *src) != 0 {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+func (p *DiffCommitRequest) Field3DeepEqual(src *string) bool {
+
+	if p.TargetCommitVersion == src {
+		return true
+	} else if p.TargetCommitVersion == nil || src == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if strings.Compare(*p.TargetCommitVersion, *src) != 0 {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+func (p *DiffCommitRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
 	}
-	if err != nil {
+	return true
+}
+
+type DiffCommitResponse struct {
+	Diff     *prompt.PromptDetailDiff `thrift:"diff,1,optional" frugal:"1,optional,prompt.PromptDetailDiff" form:"diff" json:"diff,omitempty" query:"diff"`
+	BaseResp *base.BaseResp           `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewDiffCommitResponse() *DiffCommitResponse {
+	return &DiffCommitResponse{}
+}
+
+func (p *DiffCommitResponse) InitDefault() {
+}
+
+var DiffCommitResponse_Diff_DEFAULT *prompt.PromptDetailDiff
+
+func (p *DiffCommitResponse) GetDiff() (v *prompt.PromptDetailDiff) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetDiff() {
+		return DiffCommitResponse_Diff_DEFAULT
+	}
+	return p.Diff
 }
 
-type promptManageServiceProcessorBatchGetPrompt struct {
-	handler PromptManageService
-}
+var DiffCommitResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *promptManageServiceProcessorBatchGetPrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceBatchGetPromptArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchGetPrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DiffCommitResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
 	}
+	if !p.IsSetBaseResp() {
+		return DiffCommitResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DiffCommitResponse) SetDiff(val *prompt.PromptDetailDiff) {
+	p.Diff = val
+}
+func (p *DiffCommitResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceBatchGetPromptResult{}
-	var retval *BatchGetPromptResponse
-	if retval, err2 = p.handler.BatchGetPrompt(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetPrompt: "+err2.Error())
-		oprot.WriteMessageBegin("BatchGetPrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("BatchGetPrompt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+var fieldIDToName_DiffCommitResponse = map[int16]string{
+	1:   "diff",
+	255: "BaseResp",
 }
 
-type promptManageServiceProcessorListPrompt struct {
-	handler PromptManageService
+func (p *DiffCommitResponse) IsSetDiff() bool {
+	return p.Diff != nil
 }
 
-func (p *promptManageServiceProcessorListPrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceListPromptArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListPrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DiffCommitResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DiffCommitResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceListPromptResult{}
-	var retval *ListPromptResponse
-	if retval, err2 = p.handler.ListPrompt(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListPrompt: "+err2.Error())
-		oprot.WriteMessageBegin("ListPrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("ListPrompt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffCommitResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DiffCommitResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := prompt.NewPromptDetailDiff()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	p.Diff = _field
+	return nil
+}
+func (p *DiffCommitResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *DiffCommitResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DiffCommitResponse"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	return true, err
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type promptManageServiceProcessorUpdatePrompt struct {
-	handler PromptManageService
+func (p *DiffCommitResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiff() {
+		if err = oprot.WriteFieldBegin("diff", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Diff.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
-func (p *promptManageServiceProcessorUpdatePrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceUpdatePromptArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdatePrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DiffCommitResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceUpdatePromptResult{}
-	var retval *UpdatePromptResponse
-	if retval, err2 = p.handler.UpdatePrompt(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdatePrompt: "+err2.Error())
-		oprot.WriteMessageBegin("UpdatePrompt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *DiffCommitResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageBegin("UpdatePrompt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return fmt.Sprintf("DiffCommitResponse(%+v)", *p)
+
+}
+
+func (p *DiffCommitResponse) DeepEqual(ano *DiffCommitResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Diff) {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+func (p *DiffCommitResponse) Field1DeepEqual(src *prompt.PromptDetailDiff) bool {
+
+	if !p.Diff.DeepEqual(src) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+func (p *DiffCommitResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type promptManageServiceProcessorSaveDraft struct {
-	handler PromptManageService
+// --------------- Prompt发布标签 --------------- //
+// 创建标签或将标签移动到指定提交版本
+type SetPromptLabelRequest struct {
+	PromptID      *int64     `thrift:"prompt_id,1,optional" frugal:"1,optional,i64" json:"prompt_id" path:"prompt_id" `
+	LabelKey      *string    `thrift:"label_key,11,optional" frugal:"11,optional,string" form:"label_key" json:"label_key,omitempty" query:"label_key"`
+	CommitVersion *string    `thrift:"commit_version,12,optional" frugal:"12,optional,string" form:"commit_version" json:"commit_version,omitempty" query:"commit_version"`
+	Base          *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func (p *promptManageServiceProcessorSaveDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceSaveDraftArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SaveDraft", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewSetPromptLabelRequest() *SetPromptLabelRequest {
+	return &SetPromptLabelRequest{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceSaveDraftResult{}
-	var retval *SaveDraftResponse
-	if retval, err2 = p.handler.SaveDraft(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SaveDraft: "+err2.Error())
-		oprot.WriteMessageBegin("SaveDraft", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *SetPromptLabelRequest) InitDefault() {
+}
+
+var SetPromptLabelRequest_PromptID_DEFAULT int64
+
+func (p *SetPromptLabelRequest) GetPromptID() (v int64) {
+	if p == nil {
+		return
 	}
-	if err2 = oprot.WriteMessageBegin("SaveDraft", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if !p.IsSetPromptID() {
+		return SetPromptLabelRequest_PromptID_DEFAULT
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return *p.PromptID
+}
+
+var SetPromptLabelRequest_LabelKey_DEFAULT string
+
+func (p *SetPromptLabelRequest) GetLabelKey() (v string) {
+	if p == nil {
+		return
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.IsSetLabelKey() {
+		return SetPromptLabelRequest_LabelKey_DEFAULT
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return *p.LabelKey
+}
+
+var SetPromptLabelRequest_CommitVersion_DEFAULT string
+
+func (p *SetPromptLabelRequest) GetCommitVersion() (v string) {
+	if p == nil {
+		return
 	}
-	if err != nil {
+	if !p.IsSetCommitVersion() {
+		return SetPromptLabelRequest_CommitVersion_DEFAULT
+	}
+	return *p.CommitVersion
+}
+
+var SetPromptLabelRequest_Base_DEFAULT *base.Base
+
+func (p *SetPromptLabelRequest) GetBase() (v *base.Base) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetBase() {
+		return SetPromptLabelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *SetPromptLabelRequest) SetPromptID(val *int64) {
+	p.PromptID = val
+}
+func (p *SetPromptLabelRequest) SetLabelKey(val *string) {
+	p.LabelKey = val
+}
+func (p *SetPromptLabelRequest) SetCommitVersion(val *string) {
+	p.CommitVersion = val
+}
+func (p *SetPromptLabelRequest) SetBase(val *base.Base) {
+	p.Base = val
 }
 
-type promptManageServiceProcessorListCommit struct {
-	handler PromptManageService
+var fieldIDToName_SetPromptLabelRequest = map[int16]string{
+	1:   "prompt_id",
+	11:  "label_key",
+	12:  "commit_version",
+	255: "Base",
 }
 
-func (p *promptManageServiceProcessorListCommit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceListCommitArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListCommit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *SetPromptLabelRequest) IsSetPromptID() bool {
+	return p.PromptID != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceListCommitResult{}
-	var retval *ListCommitResponse
-	if retval, err2 = p.handler.ListCommit(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListCommit: "+err2.Error())
-		oprot.WriteMessageBegin("ListCommit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListCommit", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func (p *SetPromptLabelRequest) IsSetLabelKey() bool {
+	return p.LabelKey != nil
 }
 
-type promptManageServiceProcessorCommitDraft struct {
-	handler PromptManageService
+func (p *SetPromptLabelRequest) IsSetCommitVersion() bool {
+	return p.CommitVersion != nil
 }
 
-func (p *promptManageServiceProcessorCommitDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceCommitDraftArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CommitDraft", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *SetPromptLabelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceCommitDraftResult{}
-	var retval *CommitDraftResponse
-	if retval, err2 = p.handler.CommitDraft(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommitDraft: "+err2.Error())
-		oprot.WriteMessageBegin("CommitDraft", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CommitDraft", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *SetPromptLabelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type promptManageServiceProcessorRevertDraftFromCommit struct {
-	handler PromptManageService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SetPromptLabelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *promptManageServiceProcessorRevertDraftFromCommit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PromptManageServiceRevertDraftFromCommitArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RevertDraftFromCommit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *SetPromptLabelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.PromptID = _field
+	return nil
+}
+func (p *SetPromptLabelRequest) ReadField11(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PromptManageServiceRevertDraftFromCommitResult{}
-	var retval *RevertDraftFromCommitResponse
-	if retval, err2 = p.handler.RevertDraftFromCommit(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RevertDraftFromCommit: "+err2.Error())
-		oprot.WriteMessageBegin("RevertDraftFromCommit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = &v
 	}
-	if err2 = oprot.WriteMessageBegin("RevertDraftFromCommit", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.LabelKey = _field
+	return nil
+}
+func (p *SetPromptLabelRequest) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CommitVersion = _field
+	return nil
+}
+func (p *SetPromptLabelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *SetPromptLabelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SetPromptLabelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SetPromptLabelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptID() {
+		if err = oprot.WriteFieldBegin("prompt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PromptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SetPromptLabelRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabelKey() {
+		if err = oprot.WriteFieldBegin("label_key", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LabelKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *SetPromptLabelRequest) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCommitVersion() {
+		if err = oprot.WriteFieldBegin("commit_version", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CommitVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *SetPromptLabelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *SetPromptLabelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetPromptLabelRequest(%+v)", *p)
+
+}
+
+func (p *SetPromptLabelRequest) DeepEqual(ano *SetPromptLabelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PromptID) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.LabelKey) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.CommitVersion) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *SetPromptLabelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.PromptID == src {
+		return true
+	} else if p.PromptID == nil || src == nil {
+		return false
+	}
+	if *p.PromptID != *src {
+		return false
+	}
+	return true
+}
+func (p *SetPromptLabelRequest) Field11DeepEqual(src *string) bool {
+
+	if p.LabelKey == src {
+		return true
+	} else if p.LabelKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LabelKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SetPromptLabelRequest) Field12DeepEqual(src *string) bool {
+
+	if p.CommitVersion == src {
+		return true
+	} else if p.CommitVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CommitVersion, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SetPromptLabelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type SetPromptLabelResponse struct {
+	Label    *prompt.PromptLabel `thrift:"label,1,optional" frugal:"1,optional,prompt.PromptLabel" form:"label" json:"label,omitempty" query:"label"`
+	BaseResp *base.BaseResp      `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewSetPromptLabelResponse() *SetPromptLabelResponse {
+	return &SetPromptLabelResponse{}
+}
+
+func (p *SetPromptLabelResponse) InitDefault() {
+}
+
+var SetPromptLabelResponse_Label_DEFAULT *prompt.PromptLabel
+
+func (p *SetPromptLabelResponse) GetLabel() (v *prompt.PromptLabel) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return SetPromptLabelResponse_Label_DEFAULT
+	}
+	return p.Label
+}
+
+var SetPromptLabelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *SetPromptLabelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return SetPromptLabelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *SetPromptLabelResponse) SetLabel(val *prompt.PromptLabel) {
+	p.Label = val
+}
+func (p *SetPromptLabelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_SetPromptLabelResponse = map[int16]string{
+	1:   "label",
+	255: "BaseResp",
+}
+
+func (p *SetPromptLabelResponse) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *SetPromptLabelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SetPromptLabelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SetPromptLabelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SetPromptLabelResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := prompt.NewPromptLabel()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Label = _field
+	return nil
+}
+func (p *SetPromptLabelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *SetPromptLabelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SetPromptLabelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SetPromptLabelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Label.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SetPromptLabelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *SetPromptLabelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetPromptLabelResponse(%+v)", *p)
+
+}
+
+func (p *SetPromptLabelResponse) DeepEqual(ano *SetPromptLabelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Label) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *SetPromptLabelResponse) Field1DeepEqual(src *prompt.PromptLabel) bool {
+
+	if !p.Label.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *SetPromptLabelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListPromptLabelRequest struct {
+	PromptID *int64     `thrift:"prompt_id,1,optional" frugal:"1,optional,i64" json:"prompt_id" path:"prompt_id" `
+	Base     *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewListPromptLabelRequest() *ListPromptLabelRequest {
+	return &ListPromptLabelRequest{}
+}
+
+func (p *ListPromptLabelRequest) InitDefault() {
+}
+
+var ListPromptLabelRequest_PromptID_DEFAULT int64
+
+func (p *ListPromptLabelRequest) GetPromptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptID() {
+		return ListPromptLabelRequest_PromptID_DEFAULT
+	}
+	return *p.PromptID
+}
+
+var ListPromptLabelRequest_Base_DEFAULT *base.Base
+
+func (p *ListPromptLabelRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ListPromptLabelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ListPromptLabelRequest) SetPromptID(val *int64) {
+	p.PromptID = val
+}
+func (p *ListPromptLabelRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ListPromptLabelRequest = map[int16]string{
+	1:   "prompt_id",
+	255: "Base",
+}
+
+func (p *ListPromptLabelRequest) IsSetPromptID() bool {
+	return p.PromptID != nil
+}
+
+func (p *ListPromptLabelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListPromptLabelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPromptLabelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListPromptLabelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PromptID = _field
+	return nil
+}
+func (p *ListPromptLabelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ListPromptLabelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPromptLabelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListPromptLabelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptID() {
+		if err = oprot.WriteFieldBegin("prompt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PromptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListPromptLabelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListPromptLabelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListPromptLabelRequest(%+v)", *p)
+
+}
+
+func (p *ListPromptLabelRequest) DeepEqual(ano *ListPromptLabelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PromptID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ListPromptLabelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.PromptID == src {
+		return true
+	} else if p.PromptID == nil || src == nil {
+		return false
+	}
+	if *p.PromptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ListPromptLabelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListPromptLabelResponse struct {
+	Labels   []*prompt.PromptLabel  `thrift:"labels,1,optional" frugal:"1,optional,list<prompt.PromptLabel>" form:"labels" json:"labels,omitempty" query:"labels"`
+	Users    []*user.UserInfoDetail `thrift:"users,11,optional" frugal:"11,optional,list<user.UserInfoDetail>" form:"users" json:"users,omitempty" query:"users"`
+	BaseResp *base.BaseResp         `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewListPromptLabelResponse() *ListPromptLabelResponse {
+	return &ListPromptLabelResponse{}
+}
+
+func (p *ListPromptLabelResponse) InitDefault() {
+}
+
+var ListPromptLabelResponse_Labels_DEFAULT []*prompt.PromptLabel
+
+func (p *ListPromptLabelResponse) GetLabels() (v []*prompt.PromptLabel) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabels() {
+		return ListPromptLabelResponse_Labels_DEFAULT
+	}
+	return p.Labels
+}
+
+var ListPromptLabelResponse_Users_DEFAULT []*user.UserInfoDetail
+
+func (p *ListPromptLabelResponse) GetUsers() (v []*user.UserInfoDetail) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUsers() {
+		return ListPromptLabelResponse_Users_DEFAULT
+	}
+	return p.Users
+}
+
+var ListPromptLabelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListPromptLabelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ListPromptLabelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListPromptLabelResponse) SetLabels(val []*prompt.PromptLabel) {
+	p.Labels = val
+}
+func (p *ListPromptLabelResponse) SetUsers(val []*user.UserInfoDetail) {
+	p.Users = val
+}
+func (p *ListPromptLabelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ListPromptLabelResponse = map[int16]string{
+	1:   "labels",
+	11:  "users",
+	255: "BaseResp",
+}
+
+func (p *ListPromptLabelResponse) IsSetLabels() bool {
+	return p.Labels != nil
+}
+
+func (p *ListPromptLabelResponse) IsSetUsers() bool {
+	return p.Users != nil
+}
+
+func (p *ListPromptLabelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListPromptLabelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPromptLabelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListPromptLabelResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*prompt.PromptLabel, 0, size)
+	values := make([]prompt.PromptLabel, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Labels = _field
+	return nil
+}
+func (p *ListPromptLabelResponse) ReadField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*user.UserInfoDetail, 0, size)
+	values := make([]user.UserInfoDetail, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Users = _field
+	return nil
+}
+func (p *ListPromptLabelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ListPromptLabelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPromptLabelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListPromptLabelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabels() {
+		if err = oprot.WriteFieldBegin("labels", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Labels)); err != nil {
+			return err
+		}
+		for _, v := range p.Labels {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListPromptLabelResponse) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUsers() {
+		if err = oprot.WriteFieldBegin("users", thrift.LIST, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Users)); err != nil {
+			return err
+		}
+		for _, v := range p.Users {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ListPromptLabelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListPromptLabelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListPromptLabelResponse(%+v)", *p)
+
+}
+
+func (p *ListPromptLabelResponse) DeepEqual(ano *ListPromptLabelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Labels) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Users) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ListPromptLabelResponse) Field1DeepEqual(src []*prompt.PromptLabel) bool {
+
+	if len(p.Labels) != len(src) {
+		return false
+	}
+	for i, v := range p.Labels {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListPromptLabelResponse) Field11DeepEqual(src []*user.UserInfoDetail) bool {
+
+	if len(p.Users) != len(src) {
+		return false
+	}
+	for i, v := range p.Users {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListPromptLabelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListPromptLabelHistoryRequest struct {
+	PromptID  *int64     `thrift:"prompt_id,1,optional" frugal:"1,optional,i64" json:"prompt_id" path:"prompt_id" `
+	LabelKey  *string    `thrift:"label_key,11,optional" frugal:"11,optional,string" form:"label_key" json:"label_key,omitempty" query:"label_key"`
+	PageSize  *int32     `thrift:"page_size,127,optional" frugal:"127,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
+	PageToken *string    `thrift:"page_token,128,optional" frugal:"128,optional,string" form:"page_token" json:"page_token,omitempty" query:"page_token"`
+	Base      *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewListPromptLabelHistoryRequest() *ListPromptLabelHistoryRequest {
+	return &ListPromptLabelHistoryRequest{}
+}
+
+func (p *ListPromptLabelHistoryRequest) InitDefault() {
+}
+
+var ListPromptLabelHistoryRequest_PromptID_DEFAULT int64
+
+func (p *ListPromptLabelHistoryRequest) GetPromptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptID() {
+		return ListPromptLabelHistoryRequest_PromptID_DEFAULT
+	}
+	return *p.PromptID
+}
+
+var ListPromptLabelHistoryRequest_LabelKey_DEFAULT string
+
+func (p *ListPromptLabelHistoryRequest) GetLabelKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabelKey() {
+		return ListPromptLabelHistoryRequest_LabelKey_DEFAULT
+	}
+	return *p.LabelKey
+}
+
+var ListPromptLabelHistoryRequest_PageSize_DEFAULT int32
+
+func (p *ListPromptLabelHistoryRequest) GetPageSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageSize() {
+		return ListPromptLabelHistoryRequest_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var ListPromptLabelHistoryRequest_PageToken_DEFAULT string
+
+func (p *ListPromptLabelHistoryRequest) GetPageToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageToken() {
+		return ListPromptLabelHistoryRequest_PageToken_DEFAULT
+	}
+	return *p.PageToken
+}
+
+var ListPromptLabelHistoryRequest_Base_DEFAULT *base.Base
+
+func (p *ListPromptLabelHistoryRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ListPromptLabelHistoryRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ListPromptLabelHistoryRequest) SetPromptID(val *int64) {
+	p.PromptID = val
+}
+func (p *ListPromptLabelHistoryRequest) SetLabelKey(val *string) {
+	p.LabelKey = val
+}
+func (p *ListPromptLabelHistoryRequest) SetPageSize(val *int32) {
+	p.PageSize = val
+}
+func (p *ListPromptLabelHistoryRequest) SetPageToken(val *string) {
+	p.PageToken = val
+}
+func (p *ListPromptLabelHistoryRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ListPromptLabelHistoryRequest = map[int16]string{
+	1:   "prompt_id",
+	11:  "label_key",
+	127: "page_size",
+	128: "page_token",
+	255: "Base",
+}
+
+func (p *ListPromptLabelHistoryRequest) IsSetPromptID() bool {
+	return p.PromptID != nil
+}
+
+func (p *ListPromptLabelHistoryRequest) IsSetLabelKey() bool {
+	return p.LabelKey != nil
+}
+
+func (p *ListPromptLabelHistoryRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListPromptLabelHistoryRequest) IsSetPageToken() bool {
+	return p.PageToken != nil
+}
+
+func (p *ListPromptLabelHistoryRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListPromptLabelHistoryRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 127:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField127(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 128:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField128(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPromptLabelHistoryRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListPromptLabelHistoryRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PromptID = _field
+	return nil
+}
+func (p *ListPromptLabelHistoryRequest) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LabelKey = _field
+	return nil
+}
+func (p *ListPromptLabelHistoryRequest) ReadField127(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *ListPromptLabelHistoryRequest) ReadField128(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageToken = _field
+	return nil
+}
+func (p *ListPromptLabelHistoryRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ListPromptLabelHistoryRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPromptLabelHistoryRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField127(oprot); err != nil {
+			fieldId = 127
+			goto WriteFieldError
+		}
+		if err = p.writeField128(oprot); err != nil {
+			fieldId = 128
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListPromptLabelHistoryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptID() {
+		if err = oprot.WriteFieldBegin("prompt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PromptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListPromptLabelHistoryRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabelKey() {
+		if err = oprot.WriteFieldBegin("label_key", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LabelKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ListPromptLabelHistoryRequest) writeField127(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 127); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 127 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 127 end error: ", p), err)
+}
+func (p *ListPromptLabelHistoryRequest) writeField128(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageToken() {
+		if err = oprot.WriteFieldBegin("page_token", thrift.STRING, 128); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PageToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 128 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 128 end error: ", p), err)
+}
+func (p *ListPromptLabelHistoryRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListPromptLabelHistoryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListPromptLabelHistoryRequest(%+v)", *p)
+
+}
+
+func (p *ListPromptLabelHistoryRequest) DeepEqual(ano *ListPromptLabelHistoryRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PromptID) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.LabelKey) {
+		return false
+	}
+	if !p.Field127DeepEqual(ano.PageSize) {
+		return false
+	}
+	if !p.Field128DeepEqual(ano.PageToken) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ListPromptLabelHistoryRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.PromptID == src {
+		return true
+	} else if p.PromptID == nil || src == nil {
+		return false
+	}
+	if *p.PromptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ListPromptLabelHistoryRequest) Field11DeepEqual(src *string) bool {
+
+	if p.LabelKey == src {
+		return true
+	} else if p.LabelKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LabelKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListPromptLabelHistoryRequest) Field127DeepEqual(src *int32) bool {
+
+	if p.PageSize == src {
+		return true
+	} else if p.PageSize == nil || src == nil {
+		return false
+	}
+	if *p.PageSize != *src {
+		return false
+	}
+	return true
+}
+func (p *ListPromptLabelHistoryRequest) Field128DeepEqual(src *string) bool {
+
+	if p.PageToken == src {
+		return true
+	} else if p.PageToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PageToken, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListPromptLabelHistoryRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListPromptLabelHistoryResponse struct {
+	Histories     []*prompt.PromptLabelHistory `thrift:"histories,1,optional" frugal:"1,optional,list<prompt.PromptLabelHistory>" form:"histories" json:"histories,omitempty" query:"histories"`
+	Users         []*user.UserInfoDetail       `thrift:"users,11,optional" frugal:"11,optional,list<user.UserInfoDetail>" form:"users" json:"users,omitempty" query:"users"`
+	HasMore       *bool                        `thrift:"has_more,127,optional" frugal:"127,optional,bool" form:"has_more" json:"has_more,omitempty" query:"has_more"`
+	NextPageToken *string                      `thrift:"next_page_token,128,optional" frugal:"128,optional,string" form:"next_page_token" json:"next_page_token,omitempty" query:"next_page_token"`
+	BaseResp      *base.BaseResp               `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewListPromptLabelHistoryResponse() *ListPromptLabelHistoryResponse {
+	return &ListPromptLabelHistoryResponse{}
+}
+
+func (p *ListPromptLabelHistoryResponse) InitDefault() {
+}
+
+var ListPromptLabelHistoryResponse_Histories_DEFAULT []*prompt.PromptLabelHistory
+
+func (p *ListPromptLabelHistoryResponse) GetHistories() (v []*prompt.PromptLabelHistory) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHistories() {
+		return ListPromptLabelHistoryResponse_Histories_DEFAULT
+	}
+	return p.Histories
+}
+
+var ListPromptLabelHistoryResponse_Users_DEFAULT []*user.UserInfoDetail
+
+func (p *ListPromptLabelHistoryResponse) GetUsers() (v []*user.UserInfoDetail) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUsers() {
+		return ListPromptLabelHistoryResponse_Users_DEFAULT
+	}
+	return p.Users
+}
+
+var ListPromptLabelHistoryResponse_HasMore_DEFAULT bool
+
+func (p *ListPromptLabelHistoryResponse) GetHasMore() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHasMore() {
+		return ListPromptLabelHistoryResponse_HasMore_DEFAULT
+	}
+	return *p.HasMore
+}
+
+var ListPromptLabelHistoryResponse_NextPageToken_DEFAULT string
+
+func (p *ListPromptLabelHistoryResponse) GetNextPageToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetNextPageToken() {
+		return ListPromptLabelHistoryResponse_NextPageToken_DEFAULT
+	}
+	return *p.NextPageToken
+}
+
+var ListPromptLabelHistoryResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListPromptLabelHistoryResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ListPromptLabelHistoryResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListPromptLabelHistoryResponse) SetHistories(val []*prompt.PromptLabelHistory) {
+	p.Histories = val
+}
+func (p *ListPromptLabelHistoryResponse) SetUsers(val []*user.UserInfoDetail) {
+	p.Users = val
+}
+func (p *ListPromptLabelHistoryResponse) SetHasMore(val *bool) {
+	p.HasMore = val
+}
+func (p *ListPromptLabelHistoryResponse) SetNextPageToken(val *string) {
+	p.NextPageToken = val
+}
+func (p *ListPromptLabelHistoryResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ListPromptLabelHistoryResponse = map[int16]string{
+	1:   "histories",
+	11:  "users",
+	127: "has_more",
+	128: "next_page_token",
+	255: "BaseResp",
+}
+
+func (p *ListPromptLabelHistoryResponse) IsSetHistories() bool {
+	return p.Histories != nil
+}
+
+func (p *ListPromptLabelHistoryResponse) IsSetUsers() bool {
+	return p.Users != nil
+}
+
+func (p *ListPromptLabelHistoryResponse) IsSetHasMore() bool {
+	return p.HasMore != nil
+}
+
+func (p *ListPromptLabelHistoryResponse) IsSetNextPageToken() bool {
+	return p.NextPageToken != nil
+}
+
+func (p *ListPromptLabelHistoryResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListPromptLabelHistoryResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 127:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField127(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 128:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField128(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPromptLabelHistoryResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListPromptLabelHistoryResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*prompt.PromptLabelHistory, 0, size)
+	values := make([]prompt.PromptLabelHistory, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Histories = _field
+	return nil
+}
+func (p *ListPromptLabelHistoryResponse) ReadField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*user.UserInfoDetail, 0, size)
+	values := make([]user.UserInfoDetail, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Users = _field
+	return nil
+}
+func (p *ListPromptLabelHistoryResponse) ReadField127(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.HasMore = _field
+	return nil
+}
+func (p *ListPromptLabelHistoryResponse) ReadField128(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextPageToken = _field
+	return nil
+}
+func (p *ListPromptLabelHistoryResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ListPromptLabelHistoryResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPromptLabelHistoryResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField127(oprot); err != nil {
+			fieldId = 127
+			goto WriteFieldError
+		}
+		if err = p.writeField128(oprot); err != nil {
+			fieldId = 128
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListPromptLabelHistoryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHistories() {
+		if err = oprot.WriteFieldBegin("histories", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Histories)); err != nil {
+			return err
+		}
+		for _, v := range p.Histories {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListPromptLabelHistoryResponse) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUsers() {
+		if err = oprot.WriteFieldBegin("users", thrift.LIST, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Users)); err != nil {
+			return err
+		}
+		for _, v := range p.Users {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ListPromptLabelHistoryResponse) writeField127(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHasMore() {
+		if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 127); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.HasMore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 127 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 127 end error: ", p), err)
+}
+func (p *ListPromptLabelHistoryResponse) writeField128(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextPageToken() {
+		if err = oprot.WriteFieldBegin("next_page_token", thrift.STRING, 128); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextPageToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 128 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 128 end error: ", p), err)
+}
+func (p *ListPromptLabelHistoryResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListPromptLabelHistoryResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListPromptLabelHistoryResponse(%+v)", *p)
+
+}
+
+func (p *ListPromptLabelHistoryResponse) DeepEqual(ano *ListPromptLabelHistoryResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Histories) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Users) {
+		return false
+	}
+	if !p.Field127DeepEqual(ano.HasMore) {
+		return false
+	}
+	if !p.Field128DeepEqual(ano.NextPageToken) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ListPromptLabelHistoryResponse) Field1DeepEqual(src []*prompt.PromptLabelHistory) bool {
+
+	if len(p.Histories) != len(src) {
+		return false
+	}
+	for i, v := range p.Histories {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListPromptLabelHistoryResponse) Field11DeepEqual(src []*user.UserInfoDetail) bool {
+
+	if len(p.Users) != len(src) {
+		return false
+	}
+	for i, v := range p.Users {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListPromptLabelHistoryResponse) Field127DeepEqual(src *bool) bool {
+
+	if p.HasMore == src {
+		return true
+	} else if p.HasMore == nil || src == nil {
+		return false
+	}
+	if *p.HasMore != *src {
+		return false
+	}
+	return true
+}
+func (p *ListPromptLabelHistoryResponse) Field128DeepEqual(src *string) bool {
+
+	if p.NextPageToken == src {
+		return true
+	} else if p.NextPageToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextPageToken, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListPromptLabelHistoryResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 将标签回滚到某条变更历史中的提交版本
+type RollbackPromptLabelRequest struct {
+	PromptID  *int64     `thrift:"prompt_id,1,optional" frugal:"1,optional,i64" json:"prompt_id" path:"prompt_id" `
+	LabelKey  *string    `thrift:"label_key,11,optional" frugal:"11,optional,string" form:"label_key" json:"label_key,omitempty" query:"label_key"`
+	HistoryID *int64     `thrift:"history_id,12,optional" frugal:"12,optional,i64" json:"history_id" form:"history_id" query:"history_id"`
+	Base      *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewRollbackPromptLabelRequest() *RollbackPromptLabelRequest {
+	return &RollbackPromptLabelRequest{}
+}
+
+func (p *RollbackPromptLabelRequest) InitDefault() {
+}
+
+var RollbackPromptLabelRequest_PromptID_DEFAULT int64
+
+func (p *RollbackPromptLabelRequest) GetPromptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptID() {
+		return RollbackPromptLabelRequest_PromptID_DEFAULT
+	}
+	return *p.PromptID
+}
+
+var RollbackPromptLabelRequest_LabelKey_DEFAULT string
+
+func (p *RollbackPromptLabelRequest) GetLabelKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabelKey() {
+		return RollbackPromptLabelRequest_LabelKey_DEFAULT
+	}
+	return *p.LabelKey
+}
+
+var RollbackPromptLabelRequest_HistoryID_DEFAULT int64
+
+func (p *RollbackPromptLabelRequest) GetHistoryID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHistoryID() {
+		return RollbackPromptLabelRequest_HistoryID_DEFAULT
+	}
+	return *p.HistoryID
+}
+
+var RollbackPromptLabelRequest_Base_DEFAULT *base.Base
+
+func (p *RollbackPromptLabelRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return RollbackPromptLabelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RollbackPromptLabelRequest) SetPromptID(val *int64) {
+	p.PromptID = val
+}
+func (p *RollbackPromptLabelRequest) SetLabelKey(val *string) {
+	p.LabelKey = val
+}
+func (p *RollbackPromptLabelRequest) SetHistoryID(val *int64) {
+	p.HistoryID = val
+}
+func (p *RollbackPromptLabelRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_RollbackPromptLabelRequest = map[int16]string{
+	1:   "prompt_id",
+	11:  "label_key",
+	12:  "history_id",
+	255: "Base",
+}
+
+func (p *RollbackPromptLabelRequest) IsSetPromptID() bool {
+	return p.PromptID != nil
+}
+
+func (p *RollbackPromptLabelRequest) IsSetLabelKey() bool {
+	return p.LabelKey != nil
+}
+
+func (p *RollbackPromptLabelRequest) IsSetHistoryID() bool {
+	return p.HistoryID != nil
+}
+
+func (p *RollbackPromptLabelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RollbackPromptLabelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RollbackPromptLabelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RollbackPromptLabelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PromptID = _field
+	return nil
+}
+func (p *RollbackPromptLabelRequest) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LabelKey = _field
+	return nil
+}
+func (p *RollbackPromptLabelRequest) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.HistoryID = _field
+	return nil
+}
+func (p *RollbackPromptLabelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *RollbackPromptLabelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RollbackPromptLabelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RollbackPromptLabelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptID() {
+		if err = oprot.WriteFieldBegin("prompt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PromptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RollbackPromptLabelRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabelKey() {
+		if err = oprot.WriteFieldBegin("label_key", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LabelKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *RollbackPromptLabelRequest) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHistoryID() {
+		if err = oprot.WriteFieldBegin("history_id", thrift.I64, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.HistoryID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *RollbackPromptLabelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RollbackPromptLabelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RollbackPromptLabelRequest(%+v)", *p)
+
+}
+
+func (p *RollbackPromptLabelRequest) DeepEqual(ano *RollbackPromptLabelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PromptID) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.LabelKey) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.HistoryID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RollbackPromptLabelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.PromptID == src {
+		return true
+	} else if p.PromptID == nil || src == nil {
+		return false
+	}
+	if *p.PromptID != *src {
+		return false
+	}
+	return true
+}
+func (p *RollbackPromptLabelRequest) Field11DeepEqual(src *string) bool {
+
+	if p.LabelKey == src {
+		return true
+	} else if p.LabelKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LabelKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RollbackPromptLabelRequest) Field12DeepEqual(src *int64) bool {
+
+	if p.HistoryID == src {
+		return true
+	} else if p.HistoryID == nil || src == nil {
+		return false
+	}
+	if *p.HistoryID != *src {
+		return false
+	}
+	return true
+}
+func (p *RollbackPromptLabelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RollbackPromptLabelResponse struct {
+	Label    *prompt.PromptLabel `thrift:"label,1,optional" frugal:"1,optional,prompt.PromptLabel" form:"label" json:"label,omitempty" query:"label"`
+	BaseResp *base.BaseResp      `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewRollbackPromptLabelResponse() *RollbackPromptLabelResponse {
+	return &RollbackPromptLabelResponse{}
+}
+
+func (p *RollbackPromptLabelResponse) InitDefault() {
+}
+
+var RollbackPromptLabelResponse_Label_DEFAULT *prompt.PromptLabel
+
+func (p *RollbackPromptLabelResponse) GetLabel() (v *prompt.PromptLabel) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return RollbackPromptLabelResponse_Label_DEFAULT
+	}
+	return p.Label
+}
+
+var RollbackPromptLabelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *RollbackPromptLabelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return RollbackPromptLabelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RollbackPromptLabelResponse) SetLabel(val *prompt.PromptLabel) {
+	p.Label = val
+}
+func (p *RollbackPromptLabelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_RollbackPromptLabelResponse = map[int16]string{
+	1:   "label",
+	255: "BaseResp",
+}
+
+func (p *RollbackPromptLabelResponse) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *RollbackPromptLabelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RollbackPromptLabelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RollbackPromptLabelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RollbackPromptLabelResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := prompt.NewPromptLabel()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Label = _field
+	return nil
+}
+func (p *RollbackPromptLabelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RollbackPromptLabelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RollbackPromptLabelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RollbackPromptLabelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Label.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RollbackPromptLabelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RollbackPromptLabelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RollbackPromptLabelResponse(%+v)", *p)
+
+}
+
+func (p *RollbackPromptLabelResponse) DeepEqual(ano *RollbackPromptLabelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Label) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *RollbackPromptLabelResponse) Field1DeepEqual(src *prompt.PromptLabel) bool {
+
+	if !p.Label.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *RollbackPromptLabelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// --------------- Prompt片段 --------------- //
+// 查询引用了该片段的Prompt提交版本
+type ListSnippetReferenceRequest struct {
+	PromptID  *int64     `thrift:"prompt_id,1,optional" frugal:"1,optional,i64" json:"prompt_id" path:"prompt_id" `
+	PageSize  *int32     `thrift:"page_size,127,optional" frugal:"127,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
+	PageToken *string    `thrift:"page_token,128,optional" frugal:"128,optional,string" form:"page_token" json:"page_token,omitempty" query:"page_token"`
+	Base      *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewListSnippetReferenceRequest() *ListSnippetReferenceRequest {
+	return &ListSnippetReferenceRequest{}
+}
+
+func (p *ListSnippetReferenceRequest) InitDefault() {
+}
+
+var ListSnippetReferenceRequest_PromptID_DEFAULT int64
+
+func (p *ListSnippetReferenceRequest) GetPromptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptID() {
+		return ListSnippetReferenceRequest_PromptID_DEFAULT
+	}
+	return *p.PromptID
+}
+
+var ListSnippetReferenceRequest_PageSize_DEFAULT int32
+
+func (p *ListSnippetReferenceRequest) GetPageSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageSize() {
+		return ListSnippetReferenceRequest_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var ListSnippetReferenceRequest_PageToken_DEFAULT string
+
+func (p *ListSnippetReferenceRequest) GetPageToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageToken() {
+		return ListSnippetReferenceRequest_PageToken_DEFAULT
+	}
+	return *p.PageToken
+}
+
+var ListSnippetReferenceRequest_Base_DEFAULT *base.Base
+
+func (p *ListSnippetReferenceRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ListSnippetReferenceRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ListSnippetReferenceRequest) SetPromptID(val *int64) {
+	p.PromptID = val
+}
+func (p *ListSnippetReferenceRequest) SetPageSize(val *int32) {
+	p.PageSize = val
+}
+func (p *ListSnippetReferenceRequest) SetPageToken(val *string) {
+	p.PageToken = val
+}
+func (p *ListSnippetReferenceRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ListSnippetReferenceRequest = map[int16]string{
+	1:   "prompt_id",
+	127: "page_size",
+	128: "page_token",
+	255: "Base",
+}
+
+func (p *ListSnippetReferenceRequest) IsSetPromptID() bool {
+	return p.PromptID != nil
+}
+
+func (p *ListSnippetReferenceRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListSnippetReferenceRequest) IsSetPageToken() bool {
+	return p.PageToken != nil
+}
+
+func (p *ListSnippetReferenceRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListSnippetReferenceRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 127:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField127(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 128:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField128(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSnippetReferenceRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListSnippetReferenceRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PromptID = _field
+	return nil
+}
+func (p *ListSnippetReferenceRequest) ReadField127(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *ListSnippetReferenceRequest) ReadField128(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageToken = _field
+	return nil
+}
+func (p *ListSnippetReferenceRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ListSnippetReferenceRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSnippetReferenceRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField127(oprot); err != nil {
+			fieldId = 127
+			goto WriteFieldError
+		}
+		if err = p.writeField128(oprot); err != nil {
+			fieldId = 128
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSnippetReferenceRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptID() {
+		if err = oprot.WriteFieldBegin("prompt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PromptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListSnippetReferenceRequest) writeField127(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 127); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 127 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 127 end error: ", p), err)
+}
+func (p *ListSnippetReferenceRequest) writeField128(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageToken() {
+		if err = oprot.WriteFieldBegin("page_token", thrift.STRING, 128); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PageToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 128 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 128 end error: ", p), err)
+}
+func (p *ListSnippetReferenceRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListSnippetReferenceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSnippetReferenceRequest(%+v)", *p)
+
+}
+
+func (p *ListSnippetReferenceRequest) DeepEqual(ano *ListSnippetReferenceRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PromptID) {
+		return false
+	}
+	if !p.Field127DeepEqual(ano.PageSize) {
+		return false
+	}
+	if !p.Field128DeepEqual(ano.PageToken) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ListSnippetReferenceRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.PromptID == src {
+		return true
+	} else if p.PromptID == nil || src == nil {
+		return false
+	}
+	if *p.PromptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ListSnippetReferenceRequest) Field127DeepEqual(src *int32) bool {
+
+	if p.PageSize == src {
+		return true
+	} else if p.PageSize == nil || src == nil {
+		return false
+	}
+	if *p.PageSize != *src {
+		return false
+	}
+	return true
+}
+func (p *ListSnippetReferenceRequest) Field128DeepEqual(src *string) bool {
+
+	if p.PageToken == src {
+		return true
+	} else if p.PageToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PageToken, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListSnippetReferenceRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListSnippetReferenceResponse struct {
+	References    []*prompt.SnippetReference `thrift:"references,1,optional" frugal:"1,optional,list<prompt.SnippetReference>" form:"references" json:"references,omitempty" query:"references"`
+	HasMore       *bool                      `thrift:"has_more,127,optional" frugal:"127,optional,bool" form:"has_more" json:"has_more,omitempty" query:"has_more"`
+	NextPageToken *string                    `thrift:"next_page_token,128,optional" frugal:"128,optional,string" form:"next_page_token" json:"next_page_token,omitempty" query:"next_page_token"`
+	BaseResp      *base.BaseResp             `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewListSnippetReferenceResponse() *ListSnippetReferenceResponse {
+	return &ListSnippetReferenceResponse{}
+}
+
+func (p *ListSnippetReferenceResponse) InitDefault() {
+}
+
+var ListSnippetReferenceResponse_References_DEFAULT []*prompt.SnippetReference
+
+func (p *ListSnippetReferenceResponse) GetReferences() (v []*prompt.SnippetReference) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReferences() {
+		return ListSnippetReferenceResponse_References_DEFAULT
+	}
+	return p.References
+}
+
+var ListSnippetReferenceResponse_HasMore_DEFAULT bool
+
+func (p *ListSnippetReferenceResponse) GetHasMore() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHasMore() {
+		return ListSnippetReferenceResponse_HasMore_DEFAULT
+	}
+	return *p.HasMore
+}
+
+var ListSnippetReferenceResponse_NextPageToken_DEFAULT string
+
+func (p *ListSnippetReferenceResponse) GetNextPageToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetNextPageToken() {
+		return ListSnippetReferenceResponse_NextPageToken_DEFAULT
+	}
+	return *p.NextPageToken
+}
+
+var ListSnippetReferenceResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListSnippetReferenceResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ListSnippetReferenceResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListSnippetReferenceResponse) SetReferences(val []*prompt.SnippetReference) {
+	p.References = val
+}
+func (p *ListSnippetReferenceResponse) SetHasMore(val *bool) {
+	p.HasMore = val
+}
+func (p *ListSnippetReferenceResponse) SetNextPageToken(val *string) {
+	p.NextPageToken = val
+}
+func (p *ListSnippetReferenceResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ListSnippetReferenceResponse = map[int16]string{
+	1:   "references",
+	127: "has_more",
+	128: "next_page_token",
+	255: "BaseResp",
+}
+
+func (p *ListSnippetReferenceResponse) IsSetReferences() bool {
+	return p.References != nil
+}
+
+func (p *ListSnippetReferenceResponse) IsSetHasMore() bool {
+	return p.HasMore != nil
+}
+
+func (p *ListSnippetReferenceResponse) IsSetNextPageToken() bool {
+	return p.NextPageToken != nil
+}
+
+func (p *ListSnippetReferenceResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListSnippetReferenceResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 127:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField127(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 128:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField128(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSnippetReferenceResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListSnippetReferenceResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*prompt.SnippetReference, 0, size)
+	values := make([]prompt.SnippetReference, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.References = _field
+	return nil
+}
+func (p *ListSnippetReferenceResponse) ReadField127(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.HasMore = _field
+	return nil
+}
+func (p *ListSnippetReferenceResponse) ReadField128(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextPageToken = _field
+	return nil
+}
+func (p *ListSnippetReferenceResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ListSnippetReferenceResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSnippetReferenceResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField127(oprot); err != nil {
+			fieldId = 127
+			goto WriteFieldError
+		}
+		if err = p.writeField128(oprot); err != nil {
+			fieldId = 128
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSnippetReferenceResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReferences() {
+		if err = oprot.WriteFieldBegin("references", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.References)); err != nil {
+			return err
+		}
+		for _, v := range p.References {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListSnippetReferenceResponse) writeField127(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHasMore() {
+		if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 127); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.HasMore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 127 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 127 end error: ", p), err)
+}
+func (p *ListSnippetReferenceResponse) writeField128(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextPageToken() {
+		if err = oprot.WriteFieldBegin("next_page_token", thrift.STRING, 128); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextPageToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 128 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 128 end error: ", p), err)
+}
+func (p *ListSnippetReferenceResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListSnippetReferenceResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSnippetReferenceResponse(%+v)", *p)
+
+}
+
+func (p *ListSnippetReferenceResponse) DeepEqual(ano *ListSnippetReferenceResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.References) {
+		return false
+	}
+	if !p.Field127DeepEqual(ano.HasMore) {
+		return false
+	}
+	if !p.Field128DeepEqual(ano.NextPageToken) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ListSnippetReferenceResponse) Field1DeepEqual(src []*prompt.SnippetReference) bool {
+
+	if len(p.References) != len(src) {
+		return false
+	}
+	for i, v := range p.References {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListSnippetReferenceResponse) Field127DeepEqual(src *bool) bool {
+
+	if p.HasMore == src {
+		return true
+	} else if p.HasMore == nil || src == nil {
+		return false
+	}
+	if *p.HasMore != *src {
+		return false
+	}
+	return true
+}
+func (p *ListSnippetReferenceResponse) Field128DeepEqual(src *string) bool {
+
+	if p.NextPageToken == src {
+		return true
+	} else if p.NextPageToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextPageToken, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListSnippetReferenceResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageService interface {
+	// --------------- Prompt管理 --------------- //
+	// 增
+	CreatePrompt(ctx context.Context, request *CreatePromptRequest) (r *CreatePromptResponse, err error)
+
+	ClonePrompt(ctx context.Context, request *ClonePromptRequest) (r *ClonePromptResponse, err error)
+
+	// 删
+	DeletePrompt(ctx context.Context, request *DeletePromptRequest) (r *DeletePromptResponse, err error)
+
+	// 查
+	GetPrompt(ctx context.Context, request *GetPromptRequest) (r *GetPromptResponse, err error)
+
+	BatchGetPrompt(ctx context.Context, request *BatchGetPromptRequest) (r *BatchGetPromptResponse, err error)
+
+	ListPrompt(ctx context.Context, request *ListPromptRequest) (r *ListPromptResponse, err error)
+
+	// 改
+	UpdatePrompt(ctx context.Context, request *UpdatePromptRequest) (r *UpdatePromptResponse, err error)
+
+	SaveDraft(ctx context.Context, request *SaveDraftRequest) (r *SaveDraftResponse, err error)
+
+	// --------------- Prompt版本管理 --------------- //
+	ListCommit(ctx context.Context, request *ListCommitRequest) (r *ListCommitResponse, err error)
+
+	CommitDraft(ctx context.Context, request *CommitDraftRequest) (r *CommitDraftResponse, err error)
+
+	RevertDraftFromCommit(ctx context.Context, request *RevertDraftFromCommitRequest) (r *RevertDraftFromCommitResponse, err error)
+
+	DiffCommit(ctx context.Context, request *DiffCommitRequest) (r *DiffCommitResponse, err error)
+
+	// --------------- Prompt发布标签 --------------- //
+	SetPromptLabel(ctx context.Context, request *SetPromptLabelRequest) (r *SetPromptLabelResponse, err error)
+
+	ListPromptLabel(ctx context.Context, request *ListPromptLabelRequest) (r *ListPromptLabelResponse, err error)
+
+	ListPromptLabelHistory(ctx context.Context, request *ListPromptLabelHistoryRequest) (r *ListPromptLabelHistoryResponse, err error)
+
+	RollbackPromptLabel(ctx context.Context, request *RollbackPromptLabelRequest) (r *RollbackPromptLabelResponse, err error)
+
+	// --------------- Prompt片段 --------------- //
+	ListSnippetReference(ctx context.Context, request *ListSnippetReferenceRequest) (r *ListSnippetReferenceResponse, err error)
+}
+
+type PromptManageServiceClient struct {
+	c thrift.TClient
+}
+
+func NewPromptManageServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *PromptManageServiceClient {
+	return &PromptManageServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewPromptManageServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *PromptManageServiceClient {
+	return &PromptManageServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewPromptManageServiceClient(c thrift.TClient) *PromptManageServiceClient {
+	return &PromptManageServiceClient{
+		c: c,
+	}
+}
+
+func (p *PromptManageServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *PromptManageServiceClient) CreatePrompt(ctx context.Context, request *CreatePromptRequest) (r *CreatePromptResponse, err error) {
+	var _args PromptManageServiceCreatePromptArgs
+	_args.Request = request
+	var _result PromptManageServiceCreatePromptResult
+	if err = p.Client_().Call(ctx, "CreatePrompt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) ClonePrompt(ctx context.Context, request *ClonePromptRequest) (r *ClonePromptResponse, err error) {
+	var _args PromptManageServiceClonePromptArgs
+	_args.Request = request
+	var _result PromptManageServiceClonePromptResult
+	if err = p.Client_().Call(ctx, "ClonePrompt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) DeletePrompt(ctx context.Context, request *DeletePromptRequest) (r *DeletePromptResponse, err error) {
+	var _args PromptManageServiceDeletePromptArgs
+	_args.Request = request
+	var _result PromptManageServiceDeletePromptResult
+	if err = p.Client_().Call(ctx, "DeletePrompt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) GetPrompt(ctx context.Context, request *GetPromptRequest) (r *GetPromptResponse, err error) {
+	var _args PromptManageServiceGetPromptArgs
+	_args.Request = request
+	var _result PromptManageServiceGetPromptResult
+	if err = p.Client_().Call(ctx, "GetPrompt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) BatchGetPrompt(ctx context.Context, request *BatchGetPromptRequest) (r *BatchGetPromptResponse, err error) {
+	var _args PromptManageServiceBatchGetPromptArgs
+	_args.Request = request
+	var _result PromptManageServiceBatchGetPromptResult
+	if err = p.Client_().Call(ctx, "BatchGetPrompt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) ListPrompt(ctx context.Context, request *ListPromptRequest) (r *ListPromptResponse, err error) {
+	var _args PromptManageServiceListPromptArgs
+	_args.Request = request
+	var _result PromptManageServiceListPromptResult
+	if err = p.Client_().Call(ctx, "ListPrompt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) UpdatePrompt(ctx context.Context, request *UpdatePromptRequest) (r *UpdatePromptResponse, err error) {
+	var _args PromptManageServiceUpdatePromptArgs
+	_args.Request = request
+	var _result PromptManageServiceUpdatePromptResult
+	if err = p.Client_().Call(ctx, "UpdatePrompt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) SaveDraft(ctx context.Context, request *SaveDraftRequest) (r *SaveDraftResponse, err error) {
+	var _args PromptManageServiceSaveDraftArgs
+	_args.Request = request
+	var _result PromptManageServiceSaveDraftResult
+	if err = p.Client_().Call(ctx, "SaveDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) ListCommit(ctx context.Context, request *ListCommitRequest) (r *ListCommitResponse, err error) {
+	var _args PromptManageServiceListCommitArgs
+	_args.Request = request
+	var _result PromptManageServiceListCommitResult
+	if err = p.Client_().Call(ctx, "ListCommit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) CommitDraft(ctx context.Context, request *CommitDraftRequest) (r *CommitDraftResponse, err error) {
+	var _args PromptManageServiceCommitDraftArgs
+	_args.Request = request
+	var _result PromptManageServiceCommitDraftResult
+	if err = p.Client_().Call(ctx, "CommitDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) RevertDraftFromCommit(ctx context.Context, request *RevertDraftFromCommitRequest) (r *RevertDraftFromCommitResponse, err error) {
+	var _args PromptManageServiceRevertDraftFromCommitArgs
+	_args.Request = request
+	var _result PromptManageServiceRevertDraftFromCommitResult
+	if err = p.Client_().Call(ctx, "RevertDraftFromCommit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) DiffCommit(ctx context.Context, request *DiffCommitRequest) (r *DiffCommitResponse, err error) {
+	var _args PromptManageServiceDiffCommitArgs
+	_args.Request = request
+	var _result PromptManageServiceDiffCommitResult
+	if err = p.Client_().Call(ctx, "DiffCommit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) SetPromptLabel(ctx context.Context, request *SetPromptLabelRequest) (r *SetPromptLabelResponse, err error) {
+	var _args PromptManageServiceSetPromptLabelArgs
+	_args.Request = request
+	var _result PromptManageServiceSetPromptLabelResult
+	if err = p.Client_().Call(ctx, "SetPromptLabel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) ListPromptLabel(ctx context.Context, request *ListPromptLabelRequest) (r *ListPromptLabelResponse, err error) {
+	var _args PromptManageServiceListPromptLabelArgs
+	_args.Request = request
+	var _result PromptManageServiceListPromptLabelResult
+	if err = p.Client_().Call(ctx, "ListPromptLabel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) ListPromptLabelHistory(ctx context.Context, request *ListPromptLabelHistoryRequest) (r *ListPromptLabelHistoryResponse, err error) {
+	var _args PromptManageServiceListPromptLabelHistoryArgs
+	_args.Request = request
+	var _result PromptManageServiceListPromptLabelHistoryResult
+	if err = p.Client_().Call(ctx, "ListPromptLabelHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) RollbackPromptLabel(ctx context.Context, request *RollbackPromptLabelRequest) (r *RollbackPromptLabelResponse, err error) {
+	var _args PromptManageServiceRollbackPromptLabelArgs
+	_args.Request = request
+	var _result PromptManageServiceRollbackPromptLabelResult
+	if err = p.Client_().Call(ctx, "RollbackPromptLabel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PromptManageServiceClient) ListSnippetReference(ctx context.Context, request *ListSnippetReferenceRequest) (r *ListSnippetReferenceResponse, err error) {
+	var _args PromptManageServiceListSnippetReferenceArgs
+	_args.Request = request
+	var _result PromptManageServiceListSnippetReferenceResult
+	if err = p.Client_().Call(ctx, "ListSnippetReference", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type PromptManageServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      PromptManageService
+}
+
+func (p *PromptManageServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *PromptManageServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *PromptManageServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewPromptManageServiceProcessor(handler PromptManageService) *PromptManageServiceProcessor {
+	self := &PromptManageServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("CreatePrompt", &promptManageServiceProcessorCreatePrompt{handler: handler})
+	self.AddToProcessorMap("ClonePrompt", &promptManageServiceProcessorClonePrompt{handler: handler})
+	self.AddToProcessorMap("DeletePrompt", &promptManageServiceProcessorDeletePrompt{handler: handler})
+	self.AddToProcessorMap("GetPrompt", &promptManageServiceProcessorGetPrompt{handler: handler})
+	self.AddToProcessorMap("BatchGetPrompt", &promptManageServiceProcessorBatchGetPrompt{handler: handler})
+	self.AddToProcessorMap("ListPrompt", &promptManageServiceProcessorListPrompt{handler: handler})
+	self.AddToProcessorMap("UpdatePrompt", &promptManageServiceProcessorUpdatePrompt{handler: handler})
+	self.AddToProcessorMap("SaveDraft", &promptManageServiceProcessorSaveDraft{handler: handler})
+	self.AddToProcessorMap("ListCommit", &promptManageServiceProcessorListCommit{handler: handler})
+	self.AddToProcessorMap("CommitDraft", &promptManageServiceProcessorCommitDraft{handler: handler})
+	self.AddToProcessorMap("RevertDraftFromCommit", &promptManageServiceProcessorRevertDraftFromCommit{handler: handler})
+	self.AddToProcessorMap("DiffCommit", &promptManageServiceProcessorDiffCommit{handler: handler})
+	self.AddToProcessorMap("SetPromptLabel", &promptManageServiceProcessorSetPromptLabel{handler: handler})
+	self.AddToProcessorMap("ListPromptLabel", &promptManageServiceProcessorListPromptLabel{handler: handler})
+	self.AddToProcessorMap("ListPromptLabelHistory", &promptManageServiceProcessorListPromptLabelHistory{handler: handler})
+	self.AddToProcessorMap("RollbackPromptLabel", &promptManageServiceProcessorRollbackPromptLabel{handler: handler})
+	self.AddToProcessorMap("ListSnippetReference", &promptManageServiceProcessorListSnippetReference{handler: handler})
+	return self
+}
+func (p *PromptManageServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type promptManageServiceProcessorCreatePrompt struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorCreatePrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceCreatePromptArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreatePrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceCreatePromptResult{}
+	var retval *CreatePromptResponse
+	if retval, err2 = p.handler.CreatePrompt(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreatePrompt: "+err2.Error())
+		oprot.WriteMessageBegin("CreatePrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreatePrompt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorClonePrompt struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorClonePrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceClonePromptArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ClonePrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceClonePromptResult{}
+	var retval *ClonePromptResponse
+	if retval, err2 = p.handler.ClonePrompt(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ClonePrompt: "+err2.Error())
+		oprot.WriteMessageBegin("ClonePrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ClonePrompt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorDeletePrompt struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorDeletePrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceDeletePromptArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeletePrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceDeletePromptResult{}
+	var retval *DeletePromptResponse
+	if retval, err2 = p.handler.DeletePrompt(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeletePrompt: "+err2.Error())
+		oprot.WriteMessageBegin("DeletePrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeletePrompt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorGetPrompt struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorGetPrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceGetPromptArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceGetPromptResult{}
+	var retval *GetPromptResponse
+	if retval, err2 = p.handler.GetPrompt(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPrompt: "+err2.Error())
+		oprot.WriteMessageBegin("GetPrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPrompt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorBatchGetPrompt struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorBatchGetPrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceBatchGetPromptArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchGetPrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceBatchGetPromptResult{}
+	var retval *BatchGetPromptResponse
+	if retval, err2 = p.handler.BatchGetPrompt(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetPrompt: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetPrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchGetPrompt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorListPrompt struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorListPrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceListPromptArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListPrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceListPromptResult{}
+	var retval *ListPromptResponse
+	if retval, err2 = p.handler.ListPrompt(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListPrompt: "+err2.Error())
+		oprot.WriteMessageBegin("ListPrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListPrompt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorUpdatePrompt struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorUpdatePrompt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceUpdatePromptArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdatePrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceUpdatePromptResult{}
+	var retval *UpdatePromptResponse
+	if retval, err2 = p.handler.UpdatePrompt(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdatePrompt: "+err2.Error())
+		oprot.WriteMessageBegin("UpdatePrompt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdatePrompt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorSaveDraft struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorSaveDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceSaveDraftArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SaveDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceSaveDraftResult{}
+	var retval *SaveDraftResponse
+	if retval, err2 = p.handler.SaveDraft(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SaveDraft: "+err2.Error())
+		oprot.WriteMessageBegin("SaveDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SaveDraft", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorListCommit struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorListCommit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceListCommitArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListCommit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceListCommitResult{}
+	var retval *ListCommitResponse
+	if retval, err2 = p.handler.ListCommit(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListCommit: "+err2.Error())
+		oprot.WriteMessageBegin("ListCommit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListCommit", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorCommitDraft struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorCommitDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceCommitDraftArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CommitDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceCommitDraftResult{}
+	var retval *CommitDraftResponse
+	if retval, err2 = p.handler.CommitDraft(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommitDraft: "+err2.Error())
+		oprot.WriteMessageBegin("CommitDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CommitDraft", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorRevertDraftFromCommit struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorRevertDraftFromCommit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceRevertDraftFromCommitArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RevertDraftFromCommit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceRevertDraftFromCommitResult{}
+	var retval *RevertDraftFromCommitResponse
+	if retval, err2 = p.handler.RevertDraftFromCommit(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RevertDraftFromCommit: "+err2.Error())
+		oprot.WriteMessageBegin("RevertDraftFromCommit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RevertDraftFromCommit", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorDiffCommit struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorDiffCommit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceDiffCommitArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DiffCommit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceDiffCommitResult{}
+	var retval *DiffCommitResponse
+	if retval, err2 = p.handler.DiffCommit(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DiffCommit: "+err2.Error())
+		oprot.WriteMessageBegin("DiffCommit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DiffCommit", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorSetPromptLabel struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorSetPromptLabel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceSetPromptLabelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SetPromptLabel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceSetPromptLabelResult{}
+	var retval *SetPromptLabelResponse
+	if retval, err2 = p.handler.SetPromptLabel(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetPromptLabel: "+err2.Error())
+		oprot.WriteMessageBegin("SetPromptLabel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SetPromptLabel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorListPromptLabel struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorListPromptLabel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceListPromptLabelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListPromptLabel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceListPromptLabelResult{}
+	var retval *ListPromptLabelResponse
+	if retval, err2 = p.handler.ListPromptLabel(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListPromptLabel: "+err2.Error())
+		oprot.WriteMessageBegin("ListPromptLabel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListPromptLabel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorListPromptLabelHistory struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorListPromptLabelHistory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceListPromptLabelHistoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListPromptLabelHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceListPromptLabelHistoryResult{}
+	var retval *ListPromptLabelHistoryResponse
+	if retval, err2 = p.handler.ListPromptLabelHistory(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListPromptLabelHistory: "+err2.Error())
+		oprot.WriteMessageBegin("ListPromptLabelHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListPromptLabelHistory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorRollbackPromptLabel struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorRollbackPromptLabel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceRollbackPromptLabelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RollbackPromptLabel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceRollbackPromptLabelResult{}
+	var retval *RollbackPromptLabelResponse
+	if retval, err2 = p.handler.RollbackPromptLabel(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RollbackPromptLabel: "+err2.Error())
+		oprot.WriteMessageBegin("RollbackPromptLabel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RollbackPromptLabel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type promptManageServiceProcessorListSnippetReference struct {
+	handler PromptManageService
+}
+
+func (p *promptManageServiceProcessorListSnippetReference) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PromptManageServiceListSnippetReferenceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListSnippetReference", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PromptManageServiceListSnippetReferenceResult{}
+	var retval *ListSnippetReferenceResponse
+	if retval, err2 = p.handler.ListSnippetReference(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSnippetReference: "+err2.Error())
+		oprot.WriteMessageBegin("ListSnippetReference", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListSnippetReference", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type PromptManageServiceCreatePromptArgs struct {
+	Request *CreatePromptRequest `thrift:"request,1" frugal:"1,default,CreatePromptRequest"`
+}
+
+func NewPromptManageServiceCreatePromptArgs() *PromptManageServiceCreatePromptArgs {
+	return &PromptManageServiceCreatePromptArgs{}
+}
+
+func (p *PromptManageServiceCreatePromptArgs) InitDefault() {
+}
+
+var PromptManageServiceCreatePromptArgs_Request_DEFAULT *CreatePromptRequest
+
+func (p *PromptManageServiceCreatePromptArgs) GetRequest() (v *CreatePromptRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return PromptManageServiceCreatePromptArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *PromptManageServiceCreatePromptArgs) SetRequest(val *CreatePromptRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_PromptManageServiceCreatePromptArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PromptManageServiceCreatePromptArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PromptManageServiceCreatePromptArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCreatePromptArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceCreatePromptArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreatePromptRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PromptManageServiceCreatePromptArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreatePrompt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceCreatePromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PromptManageServiceCreatePromptArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceCreatePromptArgs(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceCreatePromptArgs) DeepEqual(ano *PromptManageServiceCreatePromptArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceCreatePromptArgs) Field1DeepEqual(src *CreatePromptRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceCreatePromptResult struct {
+	Success *CreatePromptResponse `thrift:"success,0,optional" frugal:"0,optional,CreatePromptResponse"`
+}
+
+func NewPromptManageServiceCreatePromptResult() *PromptManageServiceCreatePromptResult {
+	return &PromptManageServiceCreatePromptResult{}
+}
+
+func (p *PromptManageServiceCreatePromptResult) InitDefault() {
+}
+
+var PromptManageServiceCreatePromptResult_Success_DEFAULT *CreatePromptResponse
+
+func (p *PromptManageServiceCreatePromptResult) GetSuccess() (v *CreatePromptResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return PromptManageServiceCreatePromptResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *PromptManageServiceCreatePromptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreatePromptResponse)
+}
+
+var fieldIDToName_PromptManageServiceCreatePromptResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PromptManageServiceCreatePromptResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PromptManageServiceCreatePromptResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCreatePromptResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceCreatePromptResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreatePromptResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PromptManageServiceCreatePromptResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreatePrompt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceCreatePromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PromptManageServiceCreatePromptResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceCreatePromptResult(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceCreatePromptResult) DeepEqual(ano *PromptManageServiceCreatePromptResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceCreatePromptResult) Field0DeepEqual(src *CreatePromptResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceClonePromptArgs struct {
+	Request *ClonePromptRequest `thrift:"request,1" frugal:"1,default,ClonePromptRequest"`
+}
+
+func NewPromptManageServiceClonePromptArgs() *PromptManageServiceClonePromptArgs {
+	return &PromptManageServiceClonePromptArgs{}
+}
+
+func (p *PromptManageServiceClonePromptArgs) InitDefault() {
+}
+
+var PromptManageServiceClonePromptArgs_Request_DEFAULT *ClonePromptRequest
+
+func (p *PromptManageServiceClonePromptArgs) GetRequest() (v *ClonePromptRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return PromptManageServiceClonePromptArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *PromptManageServiceClonePromptArgs) SetRequest(val *ClonePromptRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_PromptManageServiceClonePromptArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PromptManageServiceClonePromptArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PromptManageServiceClonePromptArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceClonePromptArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceClonePromptArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewClonePromptRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PromptManageServiceClonePromptArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClonePrompt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceClonePromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PromptManageServiceClonePromptArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceClonePromptArgs(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceClonePromptArgs) DeepEqual(ano *PromptManageServiceClonePromptArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceClonePromptArgs) Field1DeepEqual(src *ClonePromptRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceClonePromptResult struct {
+	Success *ClonePromptResponse `thrift:"success,0,optional" frugal:"0,optional,ClonePromptResponse"`
+}
+
+func NewPromptManageServiceClonePromptResult() *PromptManageServiceClonePromptResult {
+	return &PromptManageServiceClonePromptResult{}
+}
+
+func (p *PromptManageServiceClonePromptResult) InitDefault() {
+}
+
+var PromptManageServiceClonePromptResult_Success_DEFAULT *ClonePromptResponse
+
+func (p *PromptManageServiceClonePromptResult) GetSuccess() (v *ClonePromptResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return PromptManageServiceClonePromptResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *PromptManageServiceClonePromptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ClonePromptResponse)
+}
+
+var fieldIDToName_PromptManageServiceClonePromptResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PromptManageServiceClonePromptResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PromptManageServiceClonePromptResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceClonePromptResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceClonePromptResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewClonePromptResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PromptManageServiceClonePromptResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClonePrompt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceClonePromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PromptManageServiceClonePromptResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceClonePromptResult(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceClonePromptResult) DeepEqual(ano *PromptManageServiceClonePromptResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceClonePromptResult) Field0DeepEqual(src *ClonePromptResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceDeletePromptArgs struct {
+	Request *DeletePromptRequest `thrift:"request,1" frugal:"1,default,DeletePromptRequest"`
+}
+
+func NewPromptManageServiceDeletePromptArgs() *PromptManageServiceDeletePromptArgs {
+	return &PromptManageServiceDeletePromptArgs{}
+}
+
+func (p *PromptManageServiceDeletePromptArgs) InitDefault() {
+}
+
+var PromptManageServiceDeletePromptArgs_Request_DEFAULT *DeletePromptRequest
+
+func (p *PromptManageServiceDeletePromptArgs) GetRequest() (v *DeletePromptRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return PromptManageServiceDeletePromptArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *PromptManageServiceDeletePromptArgs) SetRequest(val *DeletePromptRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_PromptManageServiceDeletePromptArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PromptManageServiceDeletePromptArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PromptManageServiceDeletePromptArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDeletePromptArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceDeletePromptArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeletePromptRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PromptManageServiceDeletePromptArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeletePrompt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceDeletePromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PromptManageServiceDeletePromptArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceDeletePromptArgs(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceDeletePromptArgs) DeepEqual(ano *PromptManageServiceDeletePromptArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceDeletePromptArgs) Field1DeepEqual(src *DeletePromptRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceDeletePromptResult struct {
+	Success *DeletePromptResponse `thrift:"success,0,optional" frugal:"0,optional,DeletePromptResponse"`
+}
+
+func NewPromptManageServiceDeletePromptResult() *PromptManageServiceDeletePromptResult {
+	return &PromptManageServiceDeletePromptResult{}
+}
+
+func (p *PromptManageServiceDeletePromptResult) InitDefault() {
+}
+
+var PromptManageServiceDeletePromptResult_Success_DEFAULT *DeletePromptResponse
+
+func (p *PromptManageServiceDeletePromptResult) GetSuccess() (v *DeletePromptResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return PromptManageServiceDeletePromptResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *PromptManageServiceDeletePromptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeletePromptResponse)
+}
+
+var fieldIDToName_PromptManageServiceDeletePromptResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PromptManageServiceDeletePromptResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PromptManageServiceDeletePromptResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDeletePromptResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceDeletePromptResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeletePromptResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PromptManageServiceDeletePromptResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeletePrompt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceDeletePromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PromptManageServiceDeletePromptResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceDeletePromptResult(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceDeletePromptResult) DeepEqual(ano *PromptManageServiceDeletePromptResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceDeletePromptResult) Field0DeepEqual(src *DeletePromptResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceGetPromptArgs struct {
+	Request *GetPromptRequest `thrift:"request,1" frugal:"1,default,GetPromptRequest"`
+}
+
+func NewPromptManageServiceGetPromptArgs() *PromptManageServiceGetPromptArgs {
+	return &PromptManageServiceGetPromptArgs{}
+}
+
+func (p *PromptManageServiceGetPromptArgs) InitDefault() {
+}
+
+var PromptManageServiceGetPromptArgs_Request_DEFAULT *GetPromptRequest
+
+func (p *PromptManageServiceGetPromptArgs) GetRequest() (v *GetPromptRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return PromptManageServiceGetPromptArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *PromptManageServiceGetPromptArgs) SetRequest(val *GetPromptRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_PromptManageServiceGetPromptArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PromptManageServiceGetPromptArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PromptManageServiceGetPromptArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceGetPromptArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceGetPromptArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetPromptRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PromptManageServiceGetPromptArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPrompt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceGetPromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PromptManageServiceGetPromptArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceGetPromptArgs(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceGetPromptArgs) DeepEqual(ano *PromptManageServiceGetPromptArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceGetPromptArgs) Field1DeepEqual(src *GetPromptRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceGetPromptResult struct {
+	Success *GetPromptResponse `thrift:"success,0,optional" frugal:"0,optional,GetPromptResponse"`
+}
+
+func NewPromptManageServiceGetPromptResult() *PromptManageServiceGetPromptResult {
+	return &PromptManageServiceGetPromptResult{}
+}
+
+func (p *PromptManageServiceGetPromptResult) InitDefault() {
+}
+
+var PromptManageServiceGetPromptResult_Success_DEFAULT *GetPromptResponse
+
+func (p *PromptManageServiceGetPromptResult) GetSuccess() (v *GetPromptResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return PromptManageServiceGetPromptResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *PromptManageServiceGetPromptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetPromptResponse)
+}
+
+var fieldIDToName_PromptManageServiceGetPromptResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PromptManageServiceGetPromptResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PromptManageServiceGetPromptResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceGetPromptResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceGetPromptResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetPromptResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PromptManageServiceGetPromptResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPrompt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceGetPromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PromptManageServiceGetPromptResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceGetPromptResult(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceGetPromptResult) DeepEqual(ano *PromptManageServiceGetPromptResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceGetPromptResult) Field0DeepEqual(src *GetPromptResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceBatchGetPromptArgs struct {
+	Request *BatchGetPromptRequest `thrift:"request,1" frugal:"1,default,BatchGetPromptRequest"`
+}
+
+func NewPromptManageServiceBatchGetPromptArgs() *PromptManageServiceBatchGetPromptArgs {
+	return &PromptManageServiceBatchGetPromptArgs{}
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) InitDefault() {
+}
+
+var PromptManageServiceBatchGetPromptArgs_Request_DEFAULT *BatchGetPromptRequest
+
+func (p *PromptManageServiceBatchGetPromptArgs) GetRequest() (v *BatchGetPromptRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return PromptManageServiceBatchGetPromptArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *PromptManageServiceBatchGetPromptArgs) SetRequest(val *BatchGetPromptRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_PromptManageServiceBatchGetPromptArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceBatchGetPromptArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetPromptRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetPrompt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceBatchGetPromptArgs(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) DeepEqual(ano *PromptManageServiceBatchGetPromptArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) Field1DeepEqual(src *BatchGetPromptRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceBatchGetPromptResult struct {
+	Success *BatchGetPromptResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetPromptResponse"`
+}
+
+func NewPromptManageServiceBatchGetPromptResult() *PromptManageServiceBatchGetPromptResult {
+	return &PromptManageServiceBatchGetPromptResult{}
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) InitDefault() {
+}
+
+var PromptManageServiceBatchGetPromptResult_Success_DEFAULT *BatchGetPromptResponse
+
+func (p *PromptManageServiceBatchGetPromptResult) GetSuccess() (v *BatchGetPromptResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return PromptManageServiceBatchGetPromptResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *PromptManageServiceBatchGetPromptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetPromptResponse)
+}
+
+var fieldIDToName_PromptManageServiceBatchGetPromptResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceBatchGetPromptResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetPromptResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetPrompt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceBatchGetPromptResult(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) DeepEqual(ano *PromptManageServiceBatchGetPromptResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) Field0DeepEqual(src *BatchGetPromptResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceListPromptArgs struct {
+	Request *ListPromptRequest `thrift:"request,1" frugal:"1,default,ListPromptRequest"`
+}
+
+func NewPromptManageServiceListPromptArgs() *PromptManageServiceListPromptArgs {
+	return &PromptManageServiceListPromptArgs{}
+}
+
+func (p *PromptManageServiceListPromptArgs) InitDefault() {
+}
+
+var PromptManageServiceListPromptArgs_Request_DEFAULT *ListPromptRequest
+
+func (p *PromptManageServiceListPromptArgs) GetRequest() (v *ListPromptRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return PromptManageServiceListPromptArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *PromptManageServiceListPromptArgs) SetRequest(val *ListPromptRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_PromptManageServiceListPromptArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PromptManageServiceListPromptArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PromptManageServiceListPromptArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceListPromptArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListPromptRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PromptManageServiceListPromptArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPrompt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceListPromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PromptManageServiceListPromptArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PromptManageServiceListPromptArgs(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceListPromptArgs) DeepEqual(ano *PromptManageServiceListPromptArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *PromptManageServiceListPromptArgs) Field1DeepEqual(src *ListPromptRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PromptManageServiceListPromptResult struct {
+	Success *ListPromptResponse `thrift:"success,0,optional" frugal:"0,optional,ListPromptResponse"`
+}
+
+func NewPromptManageServiceListPromptResult() *PromptManageServiceListPromptResult {
+	return &PromptManageServiceListPromptResult{}
+}
+
+func (p *PromptManageServiceListPromptResult) InitDefault() {
+}
+
+var PromptManageServiceListPromptResult_Success_DEFAULT *ListPromptResponse
+
+func (p *PromptManageServiceListPromptResult) GetSuccess() (v *ListPromptResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return PromptManageServiceListPromptResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *PromptManageServiceListPromptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListPromptResponse)
+}
+
+var fieldIDToName_PromptManageServiceListPromptResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PromptManageServiceListPromptResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PromptManageServiceListPromptResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceListPromptResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListPromptResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PromptManageServiceListPromptResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPrompt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PromptManageServiceListPromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PromptManageServiceListPromptResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("PromptManageServiceListPromptResult(%+v)", *p)
+
+}
+
+func (p *PromptManageServiceListPromptResult) DeepEqual(ano *PromptManageServiceListPromptResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *PromptManageServiceListPromptResult) Field0DeepEqual(src *ListPromptResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type PromptManageServiceCreatePromptArgs struct {
-	Request *CreatePromptRequest `thrift:"request,1" frugal:"1,default,CreatePromptRequest"`
+type PromptManageServiceUpdatePromptArgs struct {
+	Request *UpdatePromptRequest `thrift:"request,1" frugal:"1,default,UpdatePromptRequest"`
 }
 
-func NewPromptManageServiceCreatePromptArgs() *PromptManageServiceCreatePromptArgs {
-	return &PromptManageServiceCreatePromptArgs{}
+func NewPromptManageServiceUpdatePromptArgs() *PromptManageServiceUpdatePromptArgs {
+	return &PromptManageServiceUpdatePromptArgs{}
 }
 
-func (p *PromptManageServiceCreatePromptArgs) InitDefault() {
+func (p *PromptManageServiceUpdatePromptArgs) InitDefault() {
 }
 
-var PromptManageServiceCreatePromptArgs_Request_DEFAULT *CreatePromptRequest
+var PromptManageServiceUpdatePromptArgs_Request_DEFAULT *UpdatePromptRequest
 
-func (p *PromptManageServiceCreatePromptArgs) GetRequest() (v *CreatePromptRequest) {
+func (p *PromptManageServiceUpdatePromptArgs) GetRequest() (v *UpdatePromptRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceCreatePromptArgs_Request_DEFAULT
+		return PromptManageServiceUpdatePromptArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceCreatePromptArgs) SetRequest(val *CreatePromptRequest) {
+func (p *PromptManageServiceUpdatePromptArgs) SetRequest(val *UpdatePromptRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceCreatePromptArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceUpdatePromptArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceCreatePromptArgs) IsSetRequest() bool {
+func (p *PromptManageServiceUpdatePromptArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceCreatePromptArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceUpdatePromptArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9403,7 +16482,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCreatePromptArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceUpdatePromptArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9413,8 +16492,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceCreatePromptArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreatePromptRequest()
+func (p *PromptManageServiceUpdatePromptArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdatePromptRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9422,9 +16501,9 @@ func (p *PromptManageServiceCreatePromptArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceCreatePromptArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceUpdatePromptArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreatePrompt_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdatePrompt_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9450,7 +16529,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceCreatePromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceUpdatePromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9467,15 +16546,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceCreatePromptArgs) String() string {
+func (p *PromptManageServiceUpdatePromptArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceCreatePromptArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceUpdatePromptArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceCreatePromptArgs) DeepEqual(ano *PromptManageServiceCreatePromptArgs) bool {
+func (p *PromptManageServiceUpdatePromptArgs) DeepEqual(ano *PromptManageServiceUpdatePromptArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -9487,7 +16566,7 @@ func (p *PromptManageServiceCreatePromptArgs) DeepEqual(ano *PromptManageService
 	return true
 }
 
-func (p *PromptManageServiceCreatePromptArgs) Field1DeepEqual(src *CreatePromptRequest) bool {
+func (p *PromptManageServiceUpdatePromptArgs) Field1DeepEqual(src *UpdatePromptRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -9495,41 +16574,41 @@ func (p *PromptManageServiceCreatePromptArgs) Field1DeepEqual(src *CreatePromptR
 	return true
 }
 
-type PromptManageServiceCreatePromptResult struct {
-	Success *CreatePromptResponse `thrift:"success,0,optional" frugal:"0,optional,CreatePromptResponse"`
+type PromptManageServiceUpdatePromptResult struct {
+	Success *UpdatePromptResponse `thrift:"success,0,optional" frugal:"0,optional,UpdatePromptResponse"`
 }
 
-func NewPromptManageServiceCreatePromptResult() *PromptManageServiceCreatePromptResult {
-	return &PromptManageServiceCreatePromptResult{}
+func NewPromptManageServiceUpdatePromptResult() *PromptManageServiceUpdatePromptResult {
+	return &PromptManageServiceUpdatePromptResult{}
 }
 
-func (p *PromptManageServiceCreatePromptResult) InitDefault() {
+func (p *PromptManageServiceUpdatePromptResult) InitDefault() {
 }
 
-var PromptManageServiceCreatePromptResult_Success_DEFAULT *CreatePromptResponse
+var PromptManageServiceUpdatePromptResult_Success_DEFAULT *UpdatePromptResponse
 
-func (p *PromptManageServiceCreatePromptResult) GetSuccess() (v *CreatePromptResponse) {
+func (p *PromptManageServiceUpdatePromptResult) GetSuccess() (v *UpdatePromptResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceCreatePromptResult_Success_DEFAULT
+		return PromptManageServiceUpdatePromptResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceCreatePromptResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreatePromptResponse)
+func (p *PromptManageServiceUpdatePromptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdatePromptResponse)
 }
 
-var fieldIDToName_PromptManageServiceCreatePromptResult = map[int16]string{
+var fieldIDToName_PromptManageServiceUpdatePromptResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceCreatePromptResult) IsSetSuccess() bool {
+func (p *PromptManageServiceUpdatePromptResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceCreatePromptResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceUpdatePromptResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9574,7 +16653,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCreatePromptResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceUpdatePromptResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9584,8 +16663,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceCreatePromptResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreatePromptResponse()
+func (p *PromptManageServiceUpdatePromptResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdatePromptResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9593,9 +16672,9 @@ func (p *PromptManageServiceCreatePromptResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *PromptManageServiceCreatePromptResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceUpdatePromptResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreatePrompt_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdatePrompt_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9621,7 +16700,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceCreatePromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceUpdatePromptResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9640,15 +16719,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceCreatePromptResult) String() string {
+func (p *PromptManageServiceUpdatePromptResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceCreatePromptResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceUpdatePromptResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceCreatePromptResult) DeepEqual(ano *PromptManageServiceCreatePromptResult) bool {
+func (p *PromptManageServiceUpdatePromptResult) DeepEqual(ano *PromptManageServiceUpdatePromptResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -9660,7 +16739,7 @@ func (p *PromptManageServiceCreatePromptResult) DeepEqual(ano *PromptManageServi
 	return true
 }
 
-func (p *PromptManageServiceCreatePromptResult) Field0DeepEqual(src *CreatePromptResponse) bool {
+func (p *PromptManageServiceUpdatePromptResult) Field0DeepEqual(src *UpdatePromptResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -9668,41 +16747,41 @@ func (p *PromptManageServiceCreatePromptResult) Field0DeepEqual(src *CreatePromp
 	return true
 }
 
-type PromptManageServiceClonePromptArgs struct {
-	Request *ClonePromptRequest `thrift:"request,1" frugal:"1,default,ClonePromptRequest"`
+type PromptManageServiceSaveDraftArgs struct {
+	Request *SaveDraftRequest `thrift:"request,1" frugal:"1,default,SaveDraftRequest"`
 }
 
-func NewPromptManageServiceClonePromptArgs() *PromptManageServiceClonePromptArgs {
-	return &PromptManageServiceClonePromptArgs{}
+func NewPromptManageServiceSaveDraftArgs() *PromptManageServiceSaveDraftArgs {
+	return &PromptManageServiceSaveDraftArgs{}
 }
 
-func (p *PromptManageServiceClonePromptArgs) InitDefault() {
+func (p *PromptManageServiceSaveDraftArgs) InitDefault() {
 }
 
-var PromptManageServiceClonePromptArgs_Request_DEFAULT *ClonePromptRequest
+var PromptManageServiceSaveDraftArgs_Request_DEFAULT *SaveDraftRequest
 
-func (p *PromptManageServiceClonePromptArgs) GetRequest() (v *ClonePromptRequest) {
+func (p *PromptManageServiceSaveDraftArgs) GetRequest() (v *SaveDraftRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceClonePromptArgs_Request_DEFAULT
+		return PromptManageServiceSaveDraftArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceClonePromptArgs) SetRequest(val *ClonePromptRequest) {
+func (p *PromptManageServiceSaveDraftArgs) SetRequest(val *SaveDraftRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceClonePromptArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceSaveDraftArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceClonePromptArgs) IsSetRequest() bool {
+func (p *PromptManageServiceSaveDraftArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceClonePromptArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSaveDraftArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9747,7 +16826,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceClonePromptArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSaveDraftArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9757,8 +16836,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceClonePromptArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewClonePromptRequest()
+func (p *PromptManageServiceSaveDraftArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSaveDraftRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9766,9 +16845,9 @@ func (p *PromptManageServiceClonePromptArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceClonePromptArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSaveDraftArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ClonePrompt_args"); err != nil {
+	if err = oprot.WriteStructBegin("SaveDraft_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9794,7 +16873,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceClonePromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSaveDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9811,15 +16890,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceClonePromptArgs) String() string {
+func (p *PromptManageServiceSaveDraftArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceClonePromptArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceSaveDraftArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceClonePromptArgs) DeepEqual(ano *PromptManageServiceClonePromptArgs) bool {
+func (p *PromptManageServiceSaveDraftArgs) DeepEqual(ano *PromptManageServiceSaveDraftArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -9831,7 +16910,7 @@ func (p *PromptManageServiceClonePromptArgs) DeepEqual(ano *PromptManageServiceC
 	return true
 }
 
-func (p *PromptManageServiceClonePromptArgs) Field1DeepEqual(src *ClonePromptRequest) bool {
+func (p *PromptManageServiceSaveDraftArgs) Field1DeepEqual(src *SaveDraftRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -9839,41 +16918,41 @@ func (p *PromptManageServiceClonePromptArgs) Field1DeepEqual(src *ClonePromptReq
 	return true
 }
 
-type PromptManageServiceClonePromptResult struct {
-	Success *ClonePromptResponse `thrift:"success,0,optional" frugal:"0,optional,ClonePromptResponse"`
+type PromptManageServiceSaveDraftResult struct {
+	Success *SaveDraftResponse `thrift:"success,0,optional" frugal:"0,optional,SaveDraftResponse"`
 }
 
-func NewPromptManageServiceClonePromptResult() *PromptManageServiceClonePromptResult {
-	return &PromptManageServiceClonePromptResult{}
+func NewPromptManageServiceSaveDraftResult() *PromptManageServiceSaveDraftResult {
+	return &PromptManageServiceSaveDraftResult{}
 }
 
-func (p *PromptManageServiceClonePromptResult) InitDefault() {
+func (p *PromptManageServiceSaveDraftResult) InitDefault() {
 }
 
-var PromptManageServiceClonePromptResult_Success_DEFAULT *ClonePromptResponse
+var PromptManageServiceSaveDraftResult_Success_DEFAULT *SaveDraftResponse
 
-func (p *PromptManageServiceClonePromptResult) GetSuccess() (v *ClonePromptResponse) {
+func (p *PromptManageServiceSaveDraftResult) GetSuccess() (v *SaveDraftResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceClonePromptResult_Success_DEFAULT
+		return PromptManageServiceSaveDraftResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceClonePromptResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ClonePromptResponse)
+func (p *PromptManageServiceSaveDraftResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SaveDraftResponse)
 }
 
-var fieldIDToName_PromptManageServiceClonePromptResult = map[int16]string{
+var fieldIDToName_PromptManageServiceSaveDraftResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceClonePromptResult) IsSetSuccess() bool {
+func (p *PromptManageServiceSaveDraftResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceClonePromptResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSaveDraftResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9918,7 +16997,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceClonePromptResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSaveDraftResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9928,8 +17007,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceClonePromptResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewClonePromptResponse()
+func (p *PromptManageServiceSaveDraftResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSaveDraftResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9937,9 +17016,9 @@ func (p *PromptManageServiceClonePromptResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *PromptManageServiceClonePromptResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSaveDraftResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ClonePrompt_result"); err != nil {
+	if err = oprot.WriteStructBegin("SaveDraft_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9965,7 +17044,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceClonePromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSaveDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9984,15 +17063,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceClonePromptResult) String() string {
+func (p *PromptManageServiceSaveDraftResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceClonePromptResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceSaveDraftResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceClonePromptResult) DeepEqual(ano *PromptManageServiceClonePromptResult) bool {
+func (p *PromptManageServiceSaveDraftResult) DeepEqual(ano *PromptManageServiceSaveDraftResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10004,7 +17083,7 @@ func (p *PromptManageServiceClonePromptResult) DeepEqual(ano *PromptManageServic
 	return true
 }
 
-func (p *PromptManageServiceClonePromptResult) Field0DeepEqual(src *ClonePromptResponse) bool {
+func (p *PromptManageServiceSaveDraftResult) Field0DeepEqual(src *SaveDraftResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -10012,41 +17091,41 @@ func (p *PromptManageServiceClonePromptResult) Field0DeepEqual(src *ClonePromptR
 	return true
 }
 
-type PromptManageServiceDeletePromptArgs struct {
-	Request *DeletePromptRequest `thrift:"request,1" frugal:"1,default,DeletePromptRequest"`
+type PromptManageServiceListCommitArgs struct {
+	Request *ListCommitRequest `thrift:"request,1" frugal:"1,default,ListCommitRequest"`
 }
 
-func NewPromptManageServiceDeletePromptArgs() *PromptManageServiceDeletePromptArgs {
-	return &PromptManageServiceDeletePromptArgs{}
+func NewPromptManageServiceListCommitArgs() *PromptManageServiceListCommitArgs {
+	return &PromptManageServiceListCommitArgs{}
 }
 
-func (p *PromptManageServiceDeletePromptArgs) InitDefault() {
+func (p *PromptManageServiceListCommitArgs) InitDefault() {
 }
 
-var PromptManageServiceDeletePromptArgs_Request_DEFAULT *DeletePromptRequest
+var PromptManageServiceListCommitArgs_Request_DEFAULT *ListCommitRequest
 
-func (p *PromptManageServiceDeletePromptArgs) GetRequest() (v *DeletePromptRequest) {
+func (p *PromptManageServiceListCommitArgs) GetRequest() (v *ListCommitRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceDeletePromptArgs_Request_DEFAULT
+		return PromptManageServiceListCommitArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceDeletePromptArgs) SetRequest(val *DeletePromptRequest) {
+func (p *PromptManageServiceListCommitArgs) SetRequest(val *ListCommitRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceDeletePromptArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceListCommitArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceDeletePromptArgs) IsSetRequest() bool {
+func (p *PromptManageServiceListCommitArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceDeletePromptArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListCommitArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10091,7 +17170,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDeletePromptArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListCommitArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10101,8 +17180,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceDeletePromptArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeletePromptRequest()
+func (p *PromptManageServiceListCommitArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListCommitRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10110,9 +17189,9 @@ func (p *PromptManageServiceDeletePromptArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceDeletePromptArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListCommitArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeletePrompt_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListCommit_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10138,7 +17217,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceDeletePromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListCommitArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10155,15 +17234,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceDeletePromptArgs) String() string {
+func (p *PromptManageServiceListCommitArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceDeletePromptArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceListCommitArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceDeletePromptArgs) DeepEqual(ano *PromptManageServiceDeletePromptArgs) bool {
+func (p *PromptManageServiceListCommitArgs) DeepEqual(ano *PromptManageServiceListCommitArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10175,7 +17254,7 @@ func (p *PromptManageServiceDeletePromptArgs) DeepEqual(ano *PromptManageService
 	return true
 }
 
-func (p *PromptManageServiceDeletePromptArgs) Field1DeepEqual(src *DeletePromptRequest) bool {
+func (p *PromptManageServiceListCommitArgs) Field1DeepEqual(src *ListCommitRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -10183,41 +17262,41 @@ func (p *PromptManageServiceDeletePromptArgs) Field1DeepEqual(src *DeletePromptR
 	return true
 }
 
-type PromptManageServiceDeletePromptResult struct {
-	Success *DeletePromptResponse `thrift:"success,0,optional" frugal:"0,optional,DeletePromptResponse"`
+type PromptManageServiceListCommitResult struct {
+	Success *ListCommitResponse `thrift:"success,0,optional" frugal:"0,optional,ListCommitResponse"`
 }
 
-func NewPromptManageServiceDeletePromptResult() *PromptManageServiceDeletePromptResult {
-	return &PromptManageServiceDeletePromptResult{}
+func NewPromptManageServiceListCommitResult() *PromptManageServiceListCommitResult {
+	return &PromptManageServiceListCommitResult{}
 }
 
-func (p *PromptManageServiceDeletePromptResult) InitDefault() {
+func (p *PromptManageServiceListCommitResult) InitDefault() {
 }
 
-var PromptManageServiceDeletePromptResult_Success_DEFAULT *DeletePromptResponse
+var PromptManageServiceListCommitResult_Success_DEFAULT *ListCommitResponse
 
-func (p *PromptManageServiceDeletePromptResult) GetSuccess() (v *DeletePromptResponse) {
+func (p *PromptManageServiceListCommitResult) GetSuccess() (v *ListCommitResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceDeletePromptResult_Success_DEFAULT
+		return PromptManageServiceListCommitResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceDeletePromptResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeletePromptResponse)
+func (p *PromptManageServiceListCommitResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCommitResponse)
 }
 
-var fieldIDToName_PromptManageServiceDeletePromptResult = map[int16]string{
+var fieldIDToName_PromptManageServiceListCommitResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceDeletePromptResult) IsSetSuccess() bool {
+func (p *PromptManageServiceListCommitResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceDeletePromptResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListCommitResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10262,7 +17341,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDeletePromptResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListCommitResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10272,8 +17351,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceDeletePromptResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeletePromptResponse()
+func (p *PromptManageServiceListCommitResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListCommitResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10281,9 +17360,9 @@ func (p *PromptManageServiceDeletePromptResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *PromptManageServiceDeletePromptResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListCommitResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeletePrompt_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListCommit_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10309,7 +17388,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceDeletePromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListCommitResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10328,15 +17407,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceDeletePromptResult) String() string {
+func (p *PromptManageServiceListCommitResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceDeletePromptResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceListCommitResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceDeletePromptResult) DeepEqual(ano *PromptManageServiceDeletePromptResult) bool {
+func (p *PromptManageServiceListCommitResult) DeepEqual(ano *PromptManageServiceListCommitResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10348,7 +17427,7 @@ func (p *PromptManageServiceDeletePromptResult) DeepEqual(ano *PromptManageServi
 	return true
 }
 
-func (p *PromptManageServiceDeletePromptResult) Field0DeepEqual(src *DeletePromptResponse) bool {
+func (p *PromptManageServiceListCommitResult) Field0DeepEqual(src *ListCommitResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -10356,41 +17435,41 @@ func (p *PromptManageServiceDeletePromptResult) Field0DeepEqual(src *DeletePromp
 	return true
 }
 
-type PromptManageServiceGetPromptArgs struct {
-	Request *GetPromptRequest `thrift:"request,1" frugal:"1,default,GetPromptRequest"`
+type PromptManageServiceCommitDraftArgs struct {
+	Request *CommitDraftRequest `thrift:"request,1" frugal:"1,default,CommitDraftRequest"`
 }
 
-func NewPromptManageServiceGetPromptArgs() *PromptManageServiceGetPromptArgs {
-	return &PromptManageServiceGetPromptArgs{}
+func NewPromptManageServiceCommitDraftArgs() *PromptManageServiceCommitDraftArgs {
+	return &PromptManageServiceCommitDraftArgs{}
 }
 
-func (p *PromptManageServiceGetPromptArgs) InitDefault() {
+func (p *PromptManageServiceCommitDraftArgs) InitDefault() {
 }
 
-var PromptManageServiceGetPromptArgs_Request_DEFAULT *GetPromptRequest
+var PromptManageServiceCommitDraftArgs_Request_DEFAULT *CommitDraftRequest
 
-func (p *PromptManageServiceGetPromptArgs) GetRequest() (v *GetPromptRequest) {
+func (p *PromptManageServiceCommitDraftArgs) GetRequest() (v *CommitDraftRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceGetPromptArgs_Request_DEFAULT
+		return PromptManageServiceCommitDraftArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceGetPromptArgs) SetRequest(val *GetPromptRequest) {
+func (p *PromptManageServiceCommitDraftArgs) SetRequest(val *CommitDraftRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceGetPromptArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceCommitDraftArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceGetPromptArgs) IsSetRequest() bool {
+func (p *PromptManageServiceCommitDraftArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceGetPromptArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceCommitDraftArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10435,7 +17514,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceGetPromptArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCommitDraftArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10445,8 +17524,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceGetPromptArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetPromptRequest()
+func (p *PromptManageServiceCommitDraftArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCommitDraftRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10454,9 +17533,9 @@ func (p *PromptManageServiceGetPromptArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *PromptManageServiceGetPromptArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceCommitDraftArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPrompt_args"); err != nil {
+	if err = oprot.WriteStructBegin("CommitDraft_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10482,7 +17561,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceGetPromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceCommitDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10499,15 +17578,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceGetPromptArgs) String() string {
+func (p *PromptManageServiceCommitDraftArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceGetPromptArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceCommitDraftArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceGetPromptArgs) DeepEqual(ano *PromptManageServiceGetPromptArgs) bool {
+func (p *PromptManageServiceCommitDraftArgs) DeepEqual(ano *PromptManageServiceCommitDraftArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10519,7 +17598,7 @@ func (p *PromptManageServiceGetPromptArgs) DeepEqual(ano *PromptManageServiceGet
 	return true
 }
 
-func (p *PromptManageServiceGetPromptArgs) Field1DeepEqual(src *GetPromptRequest) bool {
+func (p *PromptManageServiceCommitDraftArgs) Field1DeepEqual(src *CommitDraftRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -10527,41 +17606,41 @@ func (p *PromptManageServiceGetPromptArgs) Field1DeepEqual(src *GetPromptRequest
 	return true
 }
 
-type PromptManageServiceGetPromptResult struct {
-	Success *GetPromptResponse `thrift:"success,0,optional" frugal:"0,optional,GetPromptResponse"`
+type PromptManageServiceCommitDraftResult struct {
+	Success *CommitDraftResponse `thrift:"success,0,optional" frugal:"0,optional,CommitDraftResponse"`
 }
 
-func NewPromptManageServiceGetPromptResult() *PromptManageServiceGetPromptResult {
-	return &PromptManageServiceGetPromptResult{}
+func NewPromptManageServiceCommitDraftResult() *PromptManageServiceCommitDraftResult {
+	return &PromptManageServiceCommitDraftResult{}
 }
 
-func (p *PromptManageServiceGetPromptResult) InitDefault() {
+func (p *PromptManageServiceCommitDraftResult) InitDefault() {
 }
 
-var PromptManageServiceGetPromptResult_Success_DEFAULT *GetPromptResponse
+var PromptManageServiceCommitDraftResult_Success_DEFAULT *CommitDraftResponse
 
-func (p *PromptManageServiceGetPromptResult) GetSuccess() (v *GetPromptResponse) {
+func (p *PromptManageServiceCommitDraftResult) GetSuccess() (v *CommitDraftResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceGetPromptResult_Success_DEFAULT
+		return PromptManageServiceCommitDraftResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceGetPromptResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetPromptResponse)
+func (p *PromptManageServiceCommitDraftResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommitDraftResponse)
 }
 
-var fieldIDToName_PromptManageServiceGetPromptResult = map[int16]string{
+var fieldIDToName_PromptManageServiceCommitDraftResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceGetPromptResult) IsSetSuccess() bool {
+func (p *PromptManageServiceCommitDraftResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceGetPromptResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceCommitDraftResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10606,7 +17685,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceGetPromptResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCommitDraftResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10616,8 +17695,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceGetPromptResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetPromptResponse()
+func (p *PromptManageServiceCommitDraftResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCommitDraftResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10625,9 +17704,9 @@ func (p *PromptManageServiceGetPromptResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceGetPromptResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceCommitDraftResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPrompt_result"); err != nil {
+	if err = oprot.WriteStructBegin("CommitDraft_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10653,7 +17732,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceGetPromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceCommitDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10672,15 +17751,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceGetPromptResult) String() string {
+func (p *PromptManageServiceCommitDraftResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceGetPromptResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceCommitDraftResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceGetPromptResult) DeepEqual(ano *PromptManageServiceGetPromptResult) bool {
+func (p *PromptManageServiceCommitDraftResult) DeepEqual(ano *PromptManageServiceCommitDraftResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10692,7 +17771,7 @@ func (p *PromptManageServiceGetPromptResult) DeepEqual(ano *PromptManageServiceG
 	return true
 }
 
-func (p *PromptManageServiceGetPromptResult) Field0DeepEqual(src *GetPromptResponse) bool {
+func (p *PromptManageServiceCommitDraftResult) Field0DeepEqual(src *CommitDraftResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -10700,41 +17779,41 @@ func (p *PromptManageServiceGetPromptResult) Field0DeepEqual(src *GetPromptRespo
 	return true
 }
 
-type PromptManageServiceBatchGetPromptArgs struct {
-	Request *BatchGetPromptRequest `thrift:"request,1" frugal:"1,default,BatchGetPromptRequest"`
+type PromptManageServiceRevertDraftFromCommitArgs struct {
+	Request *RevertDraftFromCommitRequest `thrift:"request,1" frugal:"1,default,RevertDraftFromCommitRequest"`
 }
 
-func NewPromptManageServiceBatchGetPromptArgs() *PromptManageServiceBatchGetPromptArgs {
-	return &PromptManageServiceBatchGetPromptArgs{}
+func NewPromptManageServiceRevertDraftFromCommitArgs() *PromptManageServiceRevertDraftFromCommitArgs {
+	return &PromptManageServiceRevertDraftFromCommitArgs{}
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) InitDefault() {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) InitDefault() {
 }
 
-var PromptManageServiceBatchGetPromptArgs_Request_DEFAULT *BatchGetPromptRequest
+var PromptManageServiceRevertDraftFromCommitArgs_Request_DEFAULT *RevertDraftFromCommitRequest
 
-func (p *PromptManageServiceBatchGetPromptArgs) GetRequest() (v *BatchGetPromptRequest) {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) GetRequest() (v *RevertDraftFromCommitRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceBatchGetPromptArgs_Request_DEFAULT
+		return PromptManageServiceRevertDraftFromCommitArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceBatchGetPromptArgs) SetRequest(val *BatchGetPromptRequest) {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) SetRequest(val *RevertDraftFromCommitRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceBatchGetPromptArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceRevertDraftFromCommitArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) IsSetRequest() bool {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10779,7 +17858,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceBatchGetPromptArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRevertDraftFromCommitArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10789,8 +17868,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetPromptRequest()
+func (p *PromptManageServiceRevertDraftFromCommitArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevertDraftFromCommitRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10798,9 +17877,9 @@ func (p *PromptManageServiceBatchGetPromptArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetPrompt_args"); err != nil {
+	if err = oprot.WriteStructBegin("RevertDraftFromCommit_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10826,7 +17905,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10843,15 +17922,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) String() string {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceBatchGetPromptArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceRevertDraftFromCommitArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) DeepEqual(ano *PromptManageServiceBatchGetPromptArgs) bool {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) DeepEqual(ano *PromptManageServiceRevertDraftFromCommitArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10863,7 +17942,7 @@ func (p *PromptManageServiceBatchGetPromptArgs) DeepEqual(ano *PromptManageServi
 	return true
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) Field1DeepEqual(src *BatchGetPromptRequest) bool {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) Field1DeepEqual(src *RevertDraftFromCommitRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -10871,41 +17950,41 @@ func (p *PromptManageServiceBatchGetPromptArgs) Field1DeepEqual(src *BatchGetPro
 	return true
 }
 
-type PromptManageServiceBatchGetPromptResult struct {
-	Success *BatchGetPromptResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetPromptResponse"`
+type PromptManageServiceRevertDraftFromCommitResult struct {
+	Success *RevertDraftFromCommitResponse `thrift:"success,0,optional" frugal:"0,optional,RevertDraftFromCommitResponse"`
 }
 
-func NewPromptManageServiceBatchGetPromptResult() *PromptManageServiceBatchGetPromptResult {
-	return &PromptManageServiceBatchGetPromptResult{}
+func NewPromptManageServiceRevertDraftFromCommitResult() *PromptManageServiceRevertDraftFromCommitResult {
+	return &PromptManageServiceRevertDraftFromCommitResult{}
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) InitDefault() {
+func (p *PromptManageServiceRevertDraftFromCommitResult) InitDefault() {
 }
 
-var PromptManageServiceBatchGetPromptResult_Success_DEFAULT *BatchGetPromptResponse
+var PromptManageServiceRevertDraftFromCommitResult_Success_DEFAULT *RevertDraftFromCommitResponse
 
-func (p *PromptManageServiceBatchGetPromptResult) GetSuccess() (v *BatchGetPromptResponse) {
+func (p *PromptManageServiceRevertDraftFromCommitResult) GetSuccess() (v *RevertDraftFromCommitResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceBatchGetPromptResult_Success_DEFAULT
+		return PromptManageServiceRevertDraftFromCommitResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceBatchGetPromptResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetPromptResponse)
+func (p *PromptManageServiceRevertDraftFromCommitResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RevertDraftFromCommitResponse)
 }
 
-var fieldIDToName_PromptManageServiceBatchGetPromptResult = map[int16]string{
+var fieldIDToName_PromptManageServiceRevertDraftFromCommitResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) IsSetSuccess() bool {
+func (p *PromptManageServiceRevertDraftFromCommitResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRevertDraftFromCommitResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10950,7 +18029,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceBatchGetPromptResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRevertDraftFromCommitResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10960,8 +18039,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetPromptResponse()
+func (p *PromptManageServiceRevertDraftFromCommitResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRevertDraftFromCommitResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10969,9 +18048,9 @@ func (p *PromptManageServiceBatchGetPromptResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRevertDraftFromCommitResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetPrompt_result"); err != nil {
+	if err = oprot.WriteStructBegin("RevertDraftFromCommit_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10997,7 +18076,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRevertDraftFromCommitResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11016,15 +18095,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) String() string {
+func (p *PromptManageServiceRevertDraftFromCommitResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceBatchGetPromptResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceRevertDraftFromCommitResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) DeepEqual(ano *PromptManageServiceBatchGetPromptResult) bool {
+func (p *PromptManageServiceRevertDraftFromCommitResult) DeepEqual(ano *PromptManageServiceRevertDraftFromCommitResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11036,7 +18115,7 @@ func (p *PromptManageServiceBatchGetPromptResult) DeepEqual(ano *PromptManageSer
 	return true
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) Field0DeepEqual(src *BatchGetPromptResponse) bool {
+func (p *PromptManageServiceRevertDraftFromCommitResult) Field0DeepEqual(src *RevertDraftFromCommitResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -11044,41 +18123,41 @@ func (p *PromptManageServiceBatchGetPromptResult) Field0DeepEqual(src *BatchGetP
 	return true
 }
 
-type PromptManageServiceListPromptArgs struct {
-	Request *ListPromptRequest `thrift:"request,1" frugal:"1,default,ListPromptRequest"`
+type PromptManageServiceDiffCommitArgs struct {
+	Request *DiffCommitRequest `thrift:"request,1" frugal:"1,default,DiffCommitRequest"`
 }
 
-func NewPromptManageServiceListPromptArgs() *PromptManageServiceListPromptArgs {
-	return &PromptManageServiceListPromptArgs{}
+func NewPromptManageServiceDiffCommitArgs() *PromptManageServiceDiffCommitArgs {
+	return &PromptManageServiceDiffCommitArgs{}
 }
 
-func (p *PromptManageServiceListPromptArgs) InitDefault() {
+func (p *PromptManageServiceDiffCommitArgs) InitDefault() {
 }
 
-var PromptManageServiceListPromptArgs_Request_DEFAULT *ListPromptRequest
+var PromptManageServiceDiffCommitArgs_Request_DEFAULT *DiffCommitRequest
 
-func (p *PromptManageServiceListPromptArgs) GetRequest() (v *ListPromptRequest) {
+func (p *PromptManageServiceDiffCommitArgs) GetRequest() (v *DiffCommitRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceListPromptArgs_Request_DEFAULT
+		return PromptManageServiceDiffCommitArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceListPromptArgs) SetRequest(val *ListPromptRequest) {
+func (p *PromptManageServiceDiffCommitArgs) SetRequest(val *DiffCommitRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceListPromptArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceDiffCommitArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceListPromptArgs) IsSetRequest() bool {
+func (p *PromptManageServiceDiffCommitArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceListPromptArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceDiffCommitArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11123,7 +18202,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDiffCommitArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11132,9 +18211,9 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-
-func (p *PromptManageServiceListPromptArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListPromptRequest()
+
+func (p *PromptManageServiceDiffCommitArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDiffCommitRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11142,9 +18221,9 @@ func (p *PromptManageServiceListPromptArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *PromptManageServiceListPromptArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceDiffCommitArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListPrompt_args"); err != nil {
+	if err = oprot.WriteStructBegin("DiffCommit_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11170,7 +18249,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceListPromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceDiffCommitArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11187,15 +18266,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceListPromptArgs) String() string {
+func (p *PromptManageServiceDiffCommitArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceListPromptArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceDiffCommitArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceListPromptArgs) DeepEqual(ano *PromptManageServiceListPromptArgs) bool {
+func (p *PromptManageServiceDiffCommitArgs) DeepEqual(ano *PromptManageServiceDiffCommitArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11207,7 +18286,7 @@ func (p *PromptManageServiceListPromptArgs) DeepEqual(ano *PromptManageServiceLi
 	return true
 }
 
-func (p *PromptManageServiceListPromptArgs) Field1DeepEqual(src *ListPromptRequest) bool {
+func (p *PromptManageServiceDiffCommitArgs) Field1DeepEqual(src *DiffCommitRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -11215,41 +18294,41 @@ func (p *PromptManageServiceListPromptArgs) Field1DeepEqual(src *ListPromptReque
 	return true
 }
 
-type PromptManageServiceListPromptResult struct {
-	Success *ListPromptResponse `thrift:"success,0,optional" frugal:"0,optional,ListPromptResponse"`
+type PromptManageServiceDiffCommitResult struct {
+	Success *DiffCommitResponse `thrift:"success,0,optional" frugal:"0,optional,DiffCommitResponse"`
 }
 
-func NewPromptManageServiceListPromptResult() *PromptManageServiceListPromptResult {
-	return &PromptManageServiceListPromptResult{}
+func NewPromptManageServiceDiffCommitResult() *PromptManageServiceDiffCommitResult {
+	return &PromptManageServiceDiffCommitResult{}
 }
 
-func (p *PromptManageServiceListPromptResult) InitDefault() {
+func (p *PromptManageServiceDiffCommitResult) InitDefault() {
 }
 
-var PromptManageServiceListPromptResult_Success_DEFAULT *ListPromptResponse
+var PromptManageServiceDiffCommitResult_Success_DEFAULT *DiffCommitResponse
 
-func (p *PromptManageServiceListPromptResult) GetSuccess() (v *ListPromptResponse) {
+func (p *PromptManageServiceDiffCommitResult) GetSuccess() (v *DiffCommitResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceListPromptResult_Success_DEFAULT
+		return PromptManageServiceDiffCommitResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceListPromptResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListPromptResponse)
+func (p *PromptManageServiceDiffCommitResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DiffCommitResponse)
 }
 
-var fieldIDToName_PromptManageServiceListPromptResult = map[int16]string{
+var fieldIDToName_PromptManageServiceDiffCommitResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceListPromptResult) IsSetSuccess() bool {
+func (p *PromptManageServiceDiffCommitResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceListPromptResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceDiffCommitResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11294,7 +18373,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDiffCommitResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11304,8 +18383,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceListPromptResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListPromptResponse()
+func (p *PromptManageServiceDiffCommitResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDiffCommitResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11313,9 +18392,9 @@ func (p *PromptManageServiceListPromptResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceListPromptResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceDiffCommitResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListPrompt_result"); err != nil {
+	if err = oprot.WriteStructBegin("DiffCommit_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11341,7 +18420,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceListPromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceDiffCommitResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11360,15 +18439,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceListPromptResult) String() string {
+func (p *PromptManageServiceDiffCommitResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceListPromptResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceDiffCommitResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceListPromptResult) DeepEqual(ano *PromptManageServiceListPromptResult) bool {
+func (p *PromptManageServiceDiffCommitResult) DeepEqual(ano *PromptManageServiceDiffCommitResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11380,7 +18459,7 @@ func (p *PromptManageServiceListPromptResult) DeepEqual(ano *PromptManageService
 	return true
 }
 
-func (p *PromptManageServiceListPromptResult) Field0DeepEqual(src *ListPromptResponse) bool {
+func (p *PromptManageServiceDiffCommitResult) Field0DeepEqual(src *DiffCommitResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -11388,41 +18467,41 @@ func (p *PromptManageServiceListPromptResult) Field0DeepEqual(src *ListPromptRes
 	return true
 }
 
-type PromptManageServiceUpdatePromptArgs struct {
-	Request *UpdatePromptRequest `thrift:"request,1" frugal:"1,default,UpdatePromptRequest"`
+type PromptManageServiceSetPromptLabelArgs struct {
+	Request *SetPromptLabelRequest `thrift:"request,1" frugal:"1,default,SetPromptLabelRequest"`
 }
 
-func NewPromptManageServiceUpdatePromptArgs() *PromptManageServiceUpdatePromptArgs {
-	return &PromptManageServiceUpdatePromptArgs{}
+func NewPromptManageServiceSetPromptLabelArgs() *PromptManageServiceSetPromptLabelArgs {
+	return &PromptManageServiceSetPromptLabelArgs{}
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) InitDefault() {
+func (p *PromptManageServiceSetPromptLabelArgs) InitDefault() {
 }
 
-var PromptManageServiceUpdatePromptArgs_Request_DEFAULT *UpdatePromptRequest
+var PromptManageServiceSetPromptLabelArgs_Request_DEFAULT *SetPromptLabelRequest
 
-func (p *PromptManageServiceUpdatePromptArgs) GetRequest() (v *UpdatePromptRequest) {
+func (p *PromptManageServiceSetPromptLabelArgs) GetRequest() (v *SetPromptLabelRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceUpdatePromptArgs_Request_DEFAULT
+		return PromptManageServiceSetPromptLabelArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceUpdatePromptArgs) SetRequest(val *UpdatePromptRequest) {
+func (p *PromptManageServiceSetPromptLabelArgs) SetRequest(val *SetPromptLabelRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceUpdatePromptArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceSetPromptLabelArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) IsSetRequest() bool {
+func (p *PromptManageServiceSetPromptLabelArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSetPromptLabelArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11467,7 +18546,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceUpdatePromptArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSetPromptLabelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11477,8 +18556,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdatePromptRequest()
+func (p *PromptManageServiceSetPromptLabelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSetPromptLabelRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11486,9 +18565,9 @@ func (p *PromptManageServiceUpdatePromptArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSetPromptLabelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdatePrompt_args"); err != nil {
+	if err = oprot.WriteStructBegin("SetPromptLabel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11514,7 +18593,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSetPromptLabelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11531,15 +18610,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) String() string {
+func (p *PromptManageServiceSetPromptLabelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceUpdatePromptArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceSetPromptLabelArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) DeepEqual(ano *PromptManageServiceUpdatePromptArgs) bool {
+func (p *PromptManageServiceSetPromptLabelArgs) DeepEqual(ano *PromptManageServiceSetPromptLabelArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11551,7 +18630,7 @@ func (p *PromptManageServiceUpdatePromptArgs) DeepEqual(ano *PromptManageService
 	return true
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) Field1DeepEqual(src *UpdatePromptRequest) bool {
+func (p *PromptManageServiceSetPromptLabelArgs) Field1DeepEqual(src *SetPromptLabelRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -11559,41 +18638,41 @@ func (p *PromptManageServiceUpdatePromptArgs) Field1DeepEqual(src *UpdatePromptR
 	return true
 }
 
-type PromptManageServiceUpdatePromptResult struct {
-	Success *UpdatePromptResponse `thrift:"success,0,optional" frugal:"0,optional,UpdatePromptResponse"`
+type PromptManageServiceSetPromptLabelResult struct {
+	Success *SetPromptLabelResponse `thrift:"success,0,optional" frugal:"0,optional,SetPromptLabelResponse"`
 }
 
-func NewPromptManageServiceUpdatePromptResult() *PromptManageServiceUpdatePromptResult {
-	return &PromptManageServiceUpdatePromptResult{}
+func NewPromptManageServiceSetPromptLabelResult() *PromptManageServiceSetPromptLabelResult {
+	return &PromptManageServiceSetPromptLabelResult{}
 }
 
-func (p *PromptManageServiceUpdatePromptResult) InitDefault() {
+func (p *PromptManageServiceSetPromptLabelResult) InitDefault() {
 }
 
-var PromptManageServiceUpdatePromptResult_Success_DEFAULT *UpdatePromptResponse
+var PromptManageServiceSetPromptLabelResult_Success_DEFAULT *SetPromptLabelResponse
 
-func (p *PromptManageServiceUpdatePromptResult) GetSuccess() (v *UpdatePromptResponse) {
+func (p *PromptManageServiceSetPromptLabelResult) GetSuccess() (v *SetPromptLabelResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceUpdatePromptResult_Success_DEFAULT
+		return PromptManageServiceSetPromptLabelResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceUpdatePromptResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdatePromptResponse)
+func (p *PromptManageServiceSetPromptLabelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetPromptLabelResponse)
 }
 
-var fieldIDToName_PromptManageServiceUpdatePromptResult = map[int16]string{
+var fieldIDToName_PromptManageServiceSetPromptLabelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceUpdatePromptResult) IsSetSuccess() bool {
+func (p *PromptManageServiceSetPromptLabelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceUpdatePromptResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSetPromptLabelResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11638,7 +18717,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceUpdatePromptResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSetPromptLabelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11648,8 +18727,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceUpdatePromptResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdatePromptResponse()
+func (p *PromptManageServiceSetPromptLabelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSetPromptLabelResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11657,9 +18736,9 @@ func (p *PromptManageServiceUpdatePromptResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *PromptManageServiceUpdatePromptResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSetPromptLabelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdatePrompt_result"); err != nil {
+	if err = oprot.WriteStructBegin("SetPromptLabel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11685,7 +18764,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceUpdatePromptResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceSetPromptLabelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11704,15 +18783,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceUpdatePromptResult) String() string {
+func (p *PromptManageServiceSetPromptLabelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceUpdatePromptResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceSetPromptLabelResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceUpdatePromptResult) DeepEqual(ano *PromptManageServiceUpdatePromptResult) bool {
+func (p *PromptManageServiceSetPromptLabelResult) DeepEqual(ano *PromptManageServiceSetPromptLabelResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11724,7 +18803,7 @@ func (p *PromptManageServiceUpdatePromptResult) DeepEqual(ano *PromptManageServi
 	return true
 }
 
-func (p *PromptManageServiceUpdatePromptResult) Field0DeepEqual(src *UpdatePromptResponse) bool {
+func (p *PromptManageServiceSetPromptLabelResult) Field0DeepEqual(src *SetPromptLabelResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -11732,41 +18811,41 @@ func (p *PromptManageServiceUpdatePromptResult) Field0DeepEqual(src *UpdatePromp
 	return true
 }
 
-type PromptManageServiceSaveDraftArgs struct {
-	Request *SaveDraftRequest `thrift:"request,1" frugal:"1,default,SaveDraftRequest"`
+type PromptManageServiceListPromptLabelArgs struct {
+	Request *ListPromptLabelRequest `thrift:"request,1" frugal:"1,default,ListPromptLabelRequest"`
 }
 
-func NewPromptManageServiceSaveDraftArgs() *PromptManageServiceSaveDraftArgs {
-	return &PromptManageServiceSaveDraftArgs{}
+func NewPromptManageServiceListPromptLabelArgs() *PromptManageServiceListPromptLabelArgs {
+	return &PromptManageServiceListPromptLabelArgs{}
 }
 
-func (p *PromptManageServiceSaveDraftArgs) InitDefault() {
+func (p *PromptManageServiceListPromptLabelArgs) InitDefault() {
 }
 
-var PromptManageServiceSaveDraftArgs_Request_DEFAULT *SaveDraftRequest
+var PromptManageServiceListPromptLabelArgs_Request_DEFAULT *ListPromptLabelRequest
 
-func (p *PromptManageServiceSaveDraftArgs) GetRequest() (v *SaveDraftRequest) {
+func (p *PromptManageServiceListPromptLabelArgs) GetRequest() (v *ListPromptLabelRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceSaveDraftArgs_Request_DEFAULT
+		return PromptManageServiceListPromptLabelArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceSaveDraftArgs) SetRequest(val *SaveDraftRequest) {
+func (p *PromptManageServiceListPromptLabelArgs) SetRequest(val *ListPromptLabelRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceSaveDraftArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceListPromptLabelArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceSaveDraftArgs) IsSetRequest() bool {
+func (p *PromptManageServiceListPromptLabelArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceSaveDraftArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11811,7 +18890,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSaveDraftArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptLabelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11821,8 +18900,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceSaveDraftArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSaveDraftRequest()
+func (p *PromptManageServiceListPromptLabelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListPromptLabelRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11830,9 +18909,9 @@ func (p *PromptManageServiceSaveDraftArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *PromptManageServiceSaveDraftArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SaveDraft_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListPromptLabel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11858,7 +18937,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceSaveDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11875,15 +18954,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceSaveDraftArgs) String() string {
+func (p *PromptManageServiceListPromptLabelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceSaveDraftArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceListPromptLabelArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceSaveDraftArgs) DeepEqual(ano *PromptManageServiceSaveDraftArgs) bool {
+func (p *PromptManageServiceListPromptLabelArgs) DeepEqual(ano *PromptManageServiceListPromptLabelArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11895,7 +18974,7 @@ func (p *PromptManageServiceSaveDraftArgs) DeepEqual(ano *PromptManageServiceSav
 	return true
 }
 
-func (p *PromptManageServiceSaveDraftArgs) Field1DeepEqual(src *SaveDraftRequest) bool {
+func (p *PromptManageServiceListPromptLabelArgs) Field1DeepEqual(src *ListPromptLabelRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -11903,41 +18982,41 @@ func (p *PromptManageServiceSaveDraftArgs) Field1DeepEqual(src *SaveDraftRequest
 	return true
 }
 
-type PromptManageServiceSaveDraftResult struct {
-	Success *SaveDraftResponse `thrift:"success,0,optional" frugal:"0,optional,SaveDraftResponse"`
+type PromptManageServiceListPromptLabelResult struct {
+	Success *ListPromptLabelResponse `thrift:"success,0,optional" frugal:"0,optional,ListPromptLabelResponse"`
 }
 
-func NewPromptManageServiceSaveDraftResult() *PromptManageServiceSaveDraftResult {
-	return &PromptManageServiceSaveDraftResult{}
+func NewPromptManageServiceListPromptLabelResult() *PromptManageServiceListPromptLabelResult {
+	return &PromptManageServiceListPromptLabelResult{}
 }
 
-func (p *PromptManageServiceSaveDraftResult) InitDefault() {
+func (p *PromptManageServiceListPromptLabelResult) InitDefault() {
 }
 
-var PromptManageServiceSaveDraftResult_Success_DEFAULT *SaveDraftResponse
+var PromptManageServiceListPromptLabelResult_Success_DEFAULT *ListPromptLabelResponse
 
-func (p *PromptManageServiceSaveDraftResult) GetSuccess() (v *SaveDraftResponse) {
+func (p *PromptManageServiceListPromptLabelResult) GetSuccess() (v *ListPromptLabelResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceSaveDraftResult_Success_DEFAULT
+		return PromptManageServiceListPromptLabelResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceSaveDraftResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SaveDraftResponse)
+func (p *PromptManageServiceListPromptLabelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListPromptLabelResponse)
 }
 
-var fieldIDToName_PromptManageServiceSaveDraftResult = map[int16]string{
+var fieldIDToName_PromptManageServiceListPromptLabelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceSaveDraftResult) IsSetSuccess() bool {
+func (p *PromptManageServiceListPromptLabelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceSaveDraftResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11982,7 +19061,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSaveDraftResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptLabelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11992,8 +19071,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceSaveDraftResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSaveDraftResponse()
+func (p *PromptManageServiceListPromptLabelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListPromptLabelResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12001,9 +19080,9 @@ func (p *PromptManageServiceSaveDraftResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceSaveDraftResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SaveDraft_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListPromptLabel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12029,7 +19108,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceSaveDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12048,15 +19127,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceSaveDraftResult) String() string {
+func (p *PromptManageServiceListPromptLabelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceSaveDraftResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceListPromptLabelResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceSaveDraftResult) DeepEqual(ano *PromptManageServiceSaveDraftResult) bool {
+func (p *PromptManageServiceListPromptLabelResult) DeepEqual(ano *PromptManageServiceListPromptLabelResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12068,7 +19147,7 @@ func (p *PromptManageServiceSaveDraftResult) DeepEqual(ano *PromptManageServiceS
 	return true
 }
 
-func (p *PromptManageServiceSaveDraftResult) Field0DeepEqual(src *SaveDraftResponse) bool {
+func (p *PromptManageServiceListPromptLabelResult) Field0DeepEqual(src *ListPromptLabelResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -12076,41 +19155,41 @@ func (p *PromptManageServiceSaveDraftResult) Field0DeepEqual(src *SaveDraftRespo
 	return true
 }
 
-type PromptManageServiceListCommitArgs struct {
-	Request *ListCommitRequest `thrift:"request,1" frugal:"1,default,ListCommitRequest"`
+type PromptManageServiceListPromptLabelHistoryArgs struct {
+	Request *ListPromptLabelHistoryRequest `thrift:"request,1" frugal:"1,default,ListPromptLabelHistoryRequest"`
 }
 
-func NewPromptManageServiceListCommitArgs() *PromptManageServiceListCommitArgs {
-	return &PromptManageServiceListCommitArgs{}
+func NewPromptManageServiceListPromptLabelHistoryArgs() *PromptManageServiceListPromptLabelHistoryArgs {
+	return &PromptManageServiceListPromptLabelHistoryArgs{}
 }
 
-func (p *PromptManageServiceListCommitArgs) InitDefault() {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) InitDefault() {
 }
 
-var PromptManageServiceListCommitArgs_Request_DEFAULT *ListCommitRequest
+var PromptManageServiceListPromptLabelHistoryArgs_Request_DEFAULT *ListPromptLabelHistoryRequest
 
-func (p *PromptManageServiceListCommitArgs) GetRequest() (v *ListCommitRequest) {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) GetRequest() (v *ListPromptLabelHistoryRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceListCommitArgs_Request_DEFAULT
+		return PromptManageServiceListPromptLabelHistoryArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceListCommitArgs) SetRequest(val *ListCommitRequest) {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) SetRequest(val *ListPromptLabelHistoryRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceListCommitArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceListPromptLabelHistoryArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceListCommitArgs) IsSetRequest() bool {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceListCommitArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12155,7 +19234,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListCommitArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptLabelHistoryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12165,8 +19244,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceListCommitArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListCommitRequest()
+func (p *PromptManageServiceListPromptLabelHistoryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListPromptLabelHistoryRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12174,9 +19253,9 @@ func (p *PromptManageServiceListCommitArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *PromptManageServiceListCommitArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListCommit_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListPromptLabelHistory_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12202,7 +19281,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceListCommitArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12219,15 +19298,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceListCommitArgs) String() string {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceListCommitArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceListPromptLabelHistoryArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceListCommitArgs) DeepEqual(ano *PromptManageServiceListCommitArgs) bool {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) DeepEqual(ano *PromptManageServiceListPromptLabelHistoryArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12239,7 +19318,7 @@ func (p *PromptManageServiceListCommitArgs) DeepEqual(ano *PromptManageServiceLi
 	return true
 }
 
-func (p *PromptManageServiceListCommitArgs) Field1DeepEqual(src *ListCommitRequest) bool {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) Field1DeepEqual(src *ListPromptLabelHistoryRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -12247,41 +19326,41 @@ func (p *PromptManageServiceListCommitArgs) Field1DeepEqual(src *ListCommitReque
 	return true
 }
 
-type PromptManageServiceListCommitResult struct {
-	Success *ListCommitResponse `thrift:"success,0,optional" frugal:"0,optional,ListCommitResponse"`
+type PromptManageServiceListPromptLabelHistoryResult struct {
+	Success *ListPromptLabelHistoryResponse `thrift:"success,0,optional" frugal:"0,optional,ListPromptLabelHistoryResponse"`
 }
 
-func NewPromptManageServiceListCommitResult() *PromptManageServiceListCommitResult {
-	return &PromptManageServiceListCommitResult{}
+func NewPromptManageServiceListPromptLabelHistoryResult() *PromptManageServiceListPromptLabelHistoryResult {
+	return &PromptManageServiceListPromptLabelHistoryResult{}
 }
 
-func (p *PromptManageServiceListCommitResult) InitDefault() {
+func (p *PromptManageServiceListPromptLabelHistoryResult) InitDefault() {
 }
 
-var PromptManageServiceListCommitResult_Success_DEFAULT *ListCommitResponse
+var PromptManageServiceListPromptLabelHistoryResult_Success_DEFAULT *ListPromptLabelHistoryResponse
 
-func (p *PromptManageServiceListCommitResult) GetSuccess() (v *ListCommitResponse) {
+func (p *PromptManageServiceListPromptLabelHistoryResult) GetSuccess() (v *ListPromptLabelHistoryResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceListCommitResult_Success_DEFAULT
+		return PromptManageServiceListPromptLabelHistoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceListCommitResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListCommitResponse)
+func (p *PromptManageServiceListPromptLabelHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListPromptLabelHistoryResponse)
 }
 
-var fieldIDToName_PromptManageServiceListCommitResult = map[int16]string{
+var fieldIDToName_PromptManageServiceListPromptLabelHistoryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceListCommitResult) IsSetSuccess() bool {
+func (p *PromptManageServiceListPromptLabelHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceListCommitResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelHistoryResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12326,7 +19405,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListCommitResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptLabelHistoryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12336,8 +19415,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceListCommitResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListCommitResponse()
+func (p *PromptManageServiceListPromptLabelHistoryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListPromptLabelHistoryResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12345,9 +19424,9 @@ func (p *PromptManageServiceListCommitResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceListCommitResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelHistoryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListCommit_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListPromptLabelHistory_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12373,7 +19452,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceListCommitResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListPromptLabelHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12392,15 +19471,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceListCommitResult) String() string {
+func (p *PromptManageServiceListPromptLabelHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceListCommitResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceListPromptLabelHistoryResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceListCommitResult) DeepEqual(ano *PromptManageServiceListCommitResult) bool {
+func (p *PromptManageServiceListPromptLabelHistoryResult) DeepEqual(ano *PromptManageServiceListPromptLabelHistoryResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12412,7 +19491,7 @@ func (p *PromptManageServiceListCommitResult) DeepEqual(ano *PromptManageService
 	return true
 }
 
-func (p *PromptManageServiceListCommitResult) Field0DeepEqual(src *ListCommitResponse) bool {
+func (p *PromptManageServiceListPromptLabelHistoryResult) Field0DeepEqual(src *ListPromptLabelHistoryResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -12420,41 +19499,41 @@ func (p *PromptManageServiceListCommitResult) Field0DeepEqual(src *ListCommitRes
 	return true
 }
 
-type PromptManageServiceCommitDraftArgs struct {
-	Request *CommitDraftRequest `thrift:"request,1" frugal:"1,default,CommitDraftRequest"`
+type PromptManageServiceRollbackPromptLabelArgs struct {
+	Request *RollbackPromptLabelRequest `thrift:"request,1" frugal:"1,default,RollbackPromptLabelRequest"`
 }
 
-func NewPromptManageServiceCommitDraftArgs() *PromptManageServiceCommitDraftArgs {
-	return &PromptManageServiceCommitDraftArgs{}
+func NewPromptManageServiceRollbackPromptLabelArgs() *PromptManageServiceRollbackPromptLabelArgs {
+	return &PromptManageServiceRollbackPromptLabelArgs{}
 }
 
-func (p *PromptManageServiceCommitDraftArgs) InitDefault() {
+func (p *PromptManageServiceRollbackPromptLabelArgs) InitDefault() {
 }
 
-var PromptManageServiceCommitDraftArgs_Request_DEFAULT *CommitDraftRequest
+var PromptManageServiceRollbackPromptLabelArgs_Request_DEFAULT *RollbackPromptLabelRequest
 
-func (p *PromptManageServiceCommitDraftArgs) GetRequest() (v *CommitDraftRequest) {
+func (p *PromptManageServiceRollbackPromptLabelArgs) GetRequest() (v *RollbackPromptLabelRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceCommitDraftArgs_Request_DEFAULT
+		return PromptManageServiceRollbackPromptLabelArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceCommitDraftArgs) SetRequest(val *CommitDraftRequest) {
+func (p *PromptManageServiceRollbackPromptLabelArgs) SetRequest(val *RollbackPromptLabelRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceCommitDraftArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceRollbackPromptLabelArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceCommitDraftArgs) IsSetRequest() bool {
+func (p *PromptManageServiceRollbackPromptLabelArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceCommitDraftArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRollbackPromptLabelArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12499,7 +19578,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCommitDraftArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRollbackPromptLabelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12509,8 +19588,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceCommitDraftArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCommitDraftRequest()
+func (p *PromptManageServiceRollbackPromptLabelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRollbackPromptLabelRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12518,9 +19597,9 @@ func (p *PromptManageServiceCommitDraftArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PromptManageServiceCommitDraftArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRollbackPromptLabelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommitDraft_args"); err != nil {
+	if err = oprot.WriteStructBegin("RollbackPromptLabel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12546,7 +19625,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceCommitDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRollbackPromptLabelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12563,15 +19642,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceCommitDraftArgs) String() string {
+func (p *PromptManageServiceRollbackPromptLabelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceCommitDraftArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceRollbackPromptLabelArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceCommitDraftArgs) DeepEqual(ano *PromptManageServiceCommitDraftArgs) bool {
+func (p *PromptManageServiceRollbackPromptLabelArgs) DeepEqual(ano *PromptManageServiceRollbackPromptLabelArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12583,7 +19662,7 @@ func (p *PromptManageServiceCommitDraftArgs) DeepEqual(ano *PromptManageServiceC
 	return true
 }
 
-func (p *PromptManageServiceCommitDraftArgs) Field1DeepEqual(src *CommitDraftRequest) bool {
+func (p *PromptManageServiceRollbackPromptLabelArgs) Field1DeepEqual(src *RollbackPromptLabelRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -12591,41 +19670,41 @@ func (p *PromptManageServiceCommitDraftArgs) Field1DeepEqual(src *CommitDraftReq
 	return true
 }
 
-type PromptManageServiceCommitDraftResult struct {
-	Success *CommitDraftResponse `thrift:"success,0,optional" frugal:"0,optional,CommitDraftResponse"`
+type PromptManageServiceRollbackPromptLabelResult struct {
+	Success *RollbackPromptLabelResponse `thrift:"success,0,optional" frugal:"0,optional,RollbackPromptLabelResponse"`
 }
 
-func NewPromptManageServiceCommitDraftResult() *PromptManageServiceCommitDraftResult {
-	return &PromptManageServiceCommitDraftResult{}
+func NewPromptManageServiceRollbackPromptLabelResult() *PromptManageServiceRollbackPromptLabelResult {
+	return &PromptManageServiceRollbackPromptLabelResult{}
 }
 
-func (p *PromptManageServiceCommitDraftResult) InitDefault() {
+func (p *PromptManageServiceRollbackPromptLabelResult) InitDefault() {
 }
 
-var PromptManageServiceCommitDraftResult_Success_DEFAULT *CommitDraftResponse
+var PromptManageServiceRollbackPromptLabelResult_Success_DEFAULT *RollbackPromptLabelResponse
 
-func (p *PromptManageServiceCommitDraftResult) GetSuccess() (v *CommitDraftResponse) {
+func (p *PromptManageServiceRollbackPromptLabelResult) GetSuccess() (v *RollbackPromptLabelResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceCommitDraftResult_Success_DEFAULT
+		return PromptManageServiceRollbackPromptLabelResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceCommitDraftResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CommitDraftResponse)
+func (p *PromptManageServiceRollbackPromptLabelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RollbackPromptLabelResponse)
 }
 
-var fieldIDToName_PromptManageServiceCommitDraftResult = map[int16]string{
+var fieldIDToName_PromptManageServiceRollbackPromptLabelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceCommitDraftResult) IsSetSuccess() bool {
+func (p *PromptManageServiceRollbackPromptLabelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceCommitDraftResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRollbackPromptLabelResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12670,7 +19749,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCommitDraftResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRollbackPromptLabelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12680,8 +19759,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceCommitDraftResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCommitDraftResponse()
+func (p *PromptManageServiceRollbackPromptLabelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRollbackPromptLabelResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12689,9 +19768,9 @@ func (p *PromptManageServiceCommitDraftResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *PromptManageServiceCommitDraftResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRollbackPromptLabelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommitDraft_result"); err != nil {
+	if err = oprot.WriteStructBegin("RollbackPromptLabel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12717,7 +19796,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceCommitDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceRollbackPromptLabelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12736,15 +19815,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceCommitDraftResult) String() string {
+func (p *PromptManageServiceRollbackPromptLabelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceCommitDraftResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceRollbackPromptLabelResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceCommitDraftResult) DeepEqual(ano *PromptManageServiceCommitDraftResult) bool {
+func (p *PromptManageServiceRollbackPromptLabelResult) DeepEqual(ano *PromptManageServiceRollbackPromptLabelResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12756,7 +19835,7 @@ func (p *PromptManageServiceCommitDraftResult) DeepEqual(ano *PromptManageServic
 	return true
 }
 
-func (p *PromptManageServiceCommitDraftResult) Field0DeepEqual(src *CommitDraftResponse) bool {
+func (p *PromptManageServiceRollbackPromptLabelResult) Field0DeepEqual(src *RollbackPromptLabelResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -12764,41 +19843,41 @@ func (p *PromptManageServiceCommitDraftResult) Field0DeepEqual(src *CommitDraftR
 	return true
 }
 
-type PromptManageServiceRevertDraftFromCommitArgs struct {
-	Request *RevertDraftFromCommitRequest `thrift:"request,1" frugal:"1,default,RevertDraftFromCommitRequest"`
+type PromptManageServiceListSnippetReferenceArgs struct {
+	Request *ListSnippetReferenceRequest `thrift:"request,1" frugal:"1,default,ListSnippetReferenceRequest"`
 }
 
-func NewPromptManageServiceRevertDraftFromCommitArgs() *PromptManageServiceRevertDraftFromCommitArgs {
-	return &PromptManageServiceRevertDraftFromCommitArgs{}
+func NewPromptManageServiceListSnippetReferenceArgs() *PromptManageServiceListSnippetReferenceArgs {
+	return &PromptManageServiceListSnippetReferenceArgs{}
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) InitDefault() {
+func (p *PromptManageServiceListSnippetReferenceArgs) InitDefault() {
 }
 
-var PromptManageServiceRevertDraftFromCommitArgs_Request_DEFAULT *RevertDraftFromCommitRequest
+var PromptManageServiceListSnippetReferenceArgs_Request_DEFAULT *ListSnippetReferenceRequest
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) GetRequest() (v *RevertDraftFromCommitRequest) {
+func (p *PromptManageServiceListSnippetReferenceArgs) GetRequest() (v *ListSnippetReferenceRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return PromptManageServiceRevertDraftFromCommitArgs_Request_DEFAULT
+		return PromptManageServiceListSnippetReferenceArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *PromptManageServiceRevertDraftFromCommitArgs) SetRequest(val *RevertDraftFromCommitRequest) {
+func (p *PromptManageServiceListSnippetReferenceArgs) SetRequest(val *ListSnippetReferenceRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_PromptManageServiceRevertDraftFromCommitArgs = map[int16]string{
+var fieldIDToName_PromptManageServiceListSnippetReferenceArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) IsSetRequest() bool {
+func (p *PromptManageServiceListSnippetReferenceArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListSnippetReferenceArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12843,7 +19922,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRevertDraftFromCommitArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListSnippetReferenceArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12853,8 +19932,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRevertDraftFromCommitRequest()
+func (p *PromptManageServiceListSnippetReferenceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListSnippetReferenceRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12862,9 +19941,9 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) ReadField1(iprot thrift.T
 	return nil
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListSnippetReferenceArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RevertDraftFromCommit_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListSnippetReference_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12890,7 +19969,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListSnippetReferenceArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12907,15 +19986,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) String() string {
+func (p *PromptManageServiceListSnippetReferenceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceRevertDraftFromCommitArgs(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceListSnippetReferenceArgs(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) DeepEqual(ano *PromptManageServiceRevertDraftFromCommitArgs) bool {
+func (p *PromptManageServiceListSnippetReferenceArgs) DeepEqual(ano *PromptManageServiceListSnippetReferenceArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12927,7 +20006,7 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) DeepEqual(ano *PromptMana
 	return true
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) Field1DeepEqual(src *RevertDraftFromCommitRequest) bool {
+func (p *PromptManageServiceListSnippetReferenceArgs) Field1DeepEqual(src *ListSnippetReferenceRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -12935,41 +20014,41 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) Field1DeepEqual(src *Reve
 	return true
 }
 
-type PromptManageServiceRevertDraftFromCommitResult struct {
-	Success *RevertDraftFromCommitResponse `thrift:"success,0,optional" frugal:"0,optional,RevertDraftFromCommitResponse"`
+type PromptManageServiceListSnippetReferenceResult struct {
+	Success *ListSnippetReferenceResponse `thrift:"success,0,optional" frugal:"0,optional,ListSnippetReferenceResponse"`
 }
 
-func NewPromptManageServiceRevertDraftFromCommitResult() *PromptManageServiceRevertDraftFromCommitResult {
-	return &PromptManageServiceRevertDraftFromCommitResult{}
+func NewPromptManageServiceListSnippetReferenceResult() *PromptManageServiceListSnippetReferenceResult {
+	return &PromptManageServiceListSnippetReferenceResult{}
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) InitDefault() {
+func (p *PromptManageServiceListSnippetReferenceResult) InitDefault() {
 }
 
-var PromptManageServiceRevertDraftFromCommitResult_Success_DEFAULT *RevertDraftFromCommitResponse
+var PromptManageServiceListSnippetReferenceResult_Success_DEFAULT *ListSnippetReferenceResponse
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) GetSuccess() (v *RevertDraftFromCommitResponse) {
+func (p *PromptManageServiceListSnippetReferenceResult) GetSuccess() (v *ListSnippetReferenceResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return PromptManageServiceRevertDraftFromCommitResult_Success_DEFAULT
+		return PromptManageServiceListSnippetReferenceResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *PromptManageServiceRevertDraftFromCommitResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RevertDraftFromCommitResponse)
+func (p *PromptManageServiceListSnippetReferenceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListSnippetReferenceResponse)
 }
 
-var fieldIDToName_PromptManageServiceRevertDraftFromCommitResult = map[int16]string{
+var fieldIDToName_PromptManageServiceListSnippetReferenceResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) IsSetSuccess() bool {
+func (p *PromptManageServiceListSnippetReferenceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListSnippetReferenceResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -13014,7 +20093,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRevertDraftFromCommitResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListSnippetReferenceResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13024,8 +20103,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRevertDraftFromCommitResponse()
+func (p *PromptManageServiceListSnippetReferenceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListSnippetReferenceResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13033,9 +20112,9 @@ func (p *PromptManageServiceRevertDraftFromCommitResult) ReadField0(iprot thrift
 	return nil
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListSnippetReferenceResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RevertDraftFromCommit_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListSnippetReference_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13061,7 +20140,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PromptManageServiceListSnippetReferenceResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13080,15 +20159,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) String() string {
+func (p *PromptManageServiceListSnippetReferenceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PromptManageServiceRevertDraftFromCommitResult(%+v)", *p)
+	return fmt.Sprintf("PromptManageServiceListSnippetReferenceResult(%+v)", *p)
 
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) DeepEqual(ano *PromptManageServiceRevertDraftFromCommitResult) bool {
+func (p *PromptManageServiceListSnippetReferenceResult) DeepEqual(ano *PromptManageServiceListSnippetReferenceResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -13100,7 +20179,7 @@ func (p *PromptManageServiceRevertDraftFromCommitResult) DeepEqual(ano *PromptMa
 	return true
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) Field0DeepEqual(src *RevertDraftFromCommitResponse) bool {
+func (p *PromptManageServiceListSnippetReferenceResult) Field0DeepEqual(src *ListSnippetReferenceResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
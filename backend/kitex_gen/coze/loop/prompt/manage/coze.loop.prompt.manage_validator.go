@@ -371,3 +371,204 @@ func (p *RevertDraftFromCommitResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *DiffCommitRequest) IsValid() error {
+	if p.PromptID == nil {
+		return fmt.Errorf("field PromptID not_nil rule failed")
+	}
+	if *p.PromptID <= int64(0) {
+		return fmt.Errorf("field PromptID gt rule failed, current value: %v", *p.PromptID)
+	}
+	if p.BaseCommitVersion == nil {
+		return fmt.Errorf("field BaseCommitVersion not_nil rule failed")
+	}
+	if len(*p.BaseCommitVersion) < int(1) {
+		return fmt.Errorf("field BaseCommitVersion min_len rule failed, current value: %d", len(*p.BaseCommitVersion))
+	}
+	if p.TargetCommitVersion == nil {
+		return fmt.Errorf("field TargetCommitVersion not_nil rule failed")
+	}
+	if len(*p.TargetCommitVersion) < int(1) {
+		return fmt.Errorf("field TargetCommitVersion min_len rule failed, current value: %d", len(*p.TargetCommitVersion))
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *DiffCommitResponse) IsValid() error {
+	if p.Diff != nil {
+		if err := p.Diff.IsValid(); err != nil {
+			return fmt.Errorf("field Diff not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *SetPromptLabelRequest) IsValid() error {
+	if p.PromptID == nil {
+		return fmt.Errorf("field PromptID not_nil rule failed")
+	}
+	if *p.PromptID <= int64(0) {
+		return fmt.Errorf("field PromptID gt rule failed, current value: %v", *p.PromptID)
+	}
+	if p.LabelKey == nil {
+		return fmt.Errorf("field LabelKey not_nil rule failed")
+	}
+	if len(*p.LabelKey) < int(1) {
+		return fmt.Errorf("field LabelKey min_len rule failed, current value: %d", len(*p.LabelKey))
+	}
+	if p.CommitVersion == nil {
+		return fmt.Errorf("field CommitVersion not_nil rule failed")
+	}
+	if len(*p.CommitVersion) < int(1) {
+		return fmt.Errorf("field CommitVersion min_len rule failed, current value: %d", len(*p.CommitVersion))
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *SetPromptLabelResponse) IsValid() error {
+	if p.Label != nil {
+		if err := p.Label.IsValid(); err != nil {
+			return fmt.Errorf("field Label not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListPromptLabelRequest) IsValid() error {
+	if p.PromptID == nil {
+		return fmt.Errorf("field PromptID not_nil rule failed")
+	}
+	if *p.PromptID <= int64(0) {
+		return fmt.Errorf("field PromptID gt rule failed, current value: %v", *p.PromptID)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListPromptLabelResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListPromptLabelHistoryRequest) IsValid() error {
+	if p.PromptID == nil {
+		return fmt.Errorf("field PromptID not_nil rule failed")
+	}
+	if *p.PromptID <= int64(0) {
+		return fmt.Errorf("field PromptID gt rule failed, current value: %v", *p.PromptID)
+	}
+	if p.PageSize == nil {
+		return fmt.Errorf("field PageSize not_nil rule failed")
+	}
+	if *p.PageSize <= int32(0) {
+		return fmt.Errorf("field PageSize gt rule failed, current value: %v", *p.PageSize)
+	}
+	if *p.PageSize > int32(100) {
+		return fmt.Errorf("field PageSize le rule failed, current value: %v", *p.PageSize)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListPromptLabelHistoryResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *RollbackPromptLabelRequest) IsValid() error {
+	if p.PromptID == nil {
+		return fmt.Errorf("field PromptID not_nil rule failed")
+	}
+	if *p.PromptID <= int64(0) {
+		return fmt.Errorf("field PromptID gt rule failed, current value: %v", *p.PromptID)
+	}
+	if p.LabelKey == nil {
+		return fmt.Errorf("field LabelKey not_nil rule failed")
+	}
+	if len(*p.LabelKey) < int(1) {
+		return fmt.Errorf("field LabelKey min_len rule failed, current value: %d", len(*p.LabelKey))
+	}
+	if p.HistoryID == nil {
+		return fmt.Errorf("field HistoryID not_nil rule failed")
+	}
+	if *p.HistoryID <= int64(0) {
+		return fmt.Errorf("field HistoryID gt rule failed, current value: %v", *p.HistoryID)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *RollbackPromptLabelResponse) IsValid() error {
+	if p.Label != nil {
+		if err := p.Label.IsValid(); err != nil {
+			return fmt.Errorf("field Label not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListSnippetReferenceRequest) IsValid() error {
+	if p.PromptID == nil {
+		return fmt.Errorf("field PromptID not_nil rule failed")
+	}
+	if *p.PromptID <= int64(0) {
+		return fmt.Errorf("field PromptID gt rule failed, current value: %v", *p.PromptID)
+	}
+	if p.PageSize == nil {
+		return fmt.Errorf("field PageSize not_nil rule failed")
+	}
+	if *p.PageSize <= int32(0) {
+		return fmt.Errorf("field PageSize gt rule failed, current value: %v", *p.PageSize)
+	}
+	if *p.PageSize > int32(100) {
+		return fmt.Errorf("field PageSize le rule failed, current value: %v", *p.PageSize)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListSnippetReferenceResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
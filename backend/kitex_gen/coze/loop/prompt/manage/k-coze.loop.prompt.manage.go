@@ -104,6 +104,20 @@ func (p *CreatePromptRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField14(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 21:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField21(buf[offset:])
@@ -206,6 +220,20 @@ func (p *CreatePromptRequest) FastReadField13(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *CreatePromptRequest) FastReadField14(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *prompt.PromptType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptType = _field
+	return offset, nil
+}
+
 func (p *CreatePromptRequest) FastReadField21(buf []byte) (int, error) {
 	offset := 0
 	_field := prompt.NewPromptDetail()
@@ -241,6 +269,7 @@ func (p *CreatePromptRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter)
 		offset += p.fastWriteField11(buf[offset:], w)
 		offset += p.fastWriteField12(buf[offset:], w)
 		offset += p.fastWriteField13(buf[offset:], w)
+		offset += p.fastWriteField14(buf[offset:], w)
 		offset += p.fastWriteField21(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -255,6 +284,7 @@ func (p *CreatePromptRequest) BLength() int {
 		l += p.field11Length()
 		l += p.field12Length()
 		l += p.field13Length()
+		l += p.field14Length()
 		l += p.field21Length()
 		l += p.field255Length()
 	}
@@ -298,6 +328,15 @@ func (p *CreatePromptRequest) fastWriteField13(buf []byte, w thrift.NocopyWriter
 	return offset
 }
 
+func (p *CreatePromptRequest) fastWriteField14(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 14)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptType)
+	}
+	return offset
+}
+
 func (p *CreatePromptRequest) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetDraftDetail() {
@@ -352,6 +391,15 @@ func (p *CreatePromptRequest) field13Length() int {
 	return l
 }
 
+func (p *CreatePromptRequest) field14Length() int {
+	l := 0
+	if p.IsSetPromptType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PromptType)
+	}
+	return l
+}
+
 func (p *CreatePromptRequest) field21Length() int {
 	l := 0
 	if p.IsSetDraftDetail() {
@@ -405,6 +453,11 @@ func (p *CreatePromptRequest) DeepCopy(s interface{}) error {
 		p.PromptDescription = &tmp
 	}
 
+	if src.PromptType != nil {
+		tmp := *src.PromptType
+		p.PromptType = &tmp
+	}
+
 	var _draftDetail *prompt.PromptDetail
 	if src.DraftDetail != nil {
 		_draftDetail = &prompt.PromptDetail{}
@@ -2185,6 +2238,20 @@ func (p *PromptQuery) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 13:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField13(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2245,6 +2312,20 @@ func (p *PromptQuery) FastReadField12(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *PromptQuery) FastReadField13(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Label = _field
+	return offset, nil
+}
+
 func (p *PromptQuery) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -2255,6 +2336,7 @@ func (p *PromptQuery) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField11(buf[offset:], w)
 		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField13(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -2266,6 +2348,7 @@ func (p *PromptQuery) BLength() int {
 		l += p.field1Length()
 		l += p.field11Length()
 		l += p.field12Length()
+		l += p.field13Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -2298,6 +2381,15 @@ func (p *PromptQuery) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *PromptQuery) fastWriteField13(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 13)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Label)
+	}
+	return offset
+}
+
 func (p *PromptQuery) field1Length() int {
 	l := 0
 	if p.IsSetPromptID() {
@@ -2325,6 +2417,15 @@ func (p *PromptQuery) field12Length() int {
 	return l
 }
 
+func (p *PromptQuery) field13Length() int {
+	l := 0
+	if p.IsSetLabel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Label)
+	}
+	return l
+}
+
 func (p *PromptQuery) DeepCopy(s interface{}) error {
 	src, ok := s.(*PromptQuery)
 	if !ok {
@@ -2349,6 +2450,14 @@ func (p *PromptQuery) DeepCopy(s interface{}) error {
 		p.CommitVersion = &tmp
 	}
 
+	if src.Label != nil {
+		var tmp string
+		if *src.Label != "" {
+			tmp = kutils.StringDeepCopy(*src.Label)
+		}
+		p.Label = &tmp
+	}
+
 	return nil
 }
 
@@ -3007,6 +3116,20 @@ func (p *ListPromptRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 14:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField14(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 127:
 			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField127(buf[offset:])
@@ -3161,6 +3284,30 @@ func (p *ListPromptRequest) FastReadField13(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *ListPromptRequest) FastReadField14(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]prompt.PromptType, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem prompt.PromptType
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.PromptTypes = _field
+	return offset, nil
+}
+
 func (p *ListPromptRequest) FastReadField127(buf []byte) (int, error) {
 	offset := 0
 
@@ -3243,6 +3390,7 @@ func (p *ListPromptRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) i
 		offset += p.fastWriteField130(buf[offset:], w)
 		offset += p.fastWriteField11(buf[offset:], w)
 		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField14(buf[offset:], w)
 		offset += p.fastWriteField129(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -3257,6 +3405,7 @@ func (p *ListPromptRequest) BLength() int {
 		l += p.field11Length()
 		l += p.field12Length()
 		l += p.field13Length()
+		l += p.field14Length()
 		l += p.field127Length()
 		l += p.field128Length()
 		l += p.field129Length()
@@ -3310,6 +3459,22 @@ func (p *ListPromptRequest) fastWriteField13(buf []byte, w thrift.NocopyWriter)
 	return offset
 }
 
+func (p *ListPromptRequest) fastWriteField14(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptTypes() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 14)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.PromptTypes {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	}
+	return offset
+}
+
 func (p *ListPromptRequest) fastWriteField127(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetPageNum() {
@@ -3395,6 +3560,19 @@ func (p *ListPromptRequest) field13Length() int {
 	return l
 }
 
+func (p *ListPromptRequest) field14Length() int {
+	l := 0
+	if p.IsSetPromptTypes() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.PromptTypes {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
 func (p *ListPromptRequest) field127Length() int {
 	l := 0
 	if p.IsSetPageNum() {
@@ -3475,6 +3653,15 @@ func (p *ListPromptRequest) DeepCopy(s interface{}) error {
 		p.CommittedOnly = &tmp
 	}
 
+	if src.PromptTypes != nil {
+		p.PromptTypes = make([]prompt.PromptType, 0, len(src.PromptTypes))
+		for _, elem := range src.PromptTypes {
+			var _elem prompt.PromptType
+			_elem = elem
+			p.PromptTypes = append(p.PromptTypes, _elem)
+		}
+	}
+
 	if src.PageNum != nil {
 		tmp := *src.PageNum
 		p.PageNum = &tmp
@@ -6135,7 +6322,7 @@ func (p *RevertDraftFromCommitResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceCreatePromptArgs) FastRead(buf []byte) (int, error) {
+func (p *DiffCommitRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6153,7 +6340,7 @@ func (p *PromptManageServiceCreatePromptArgs) FastRead(buf []byte) (int, error)
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -6166,6 +6353,48 @@ func (p *PromptManageServiceCreatePromptArgs) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -6179,68 +6408,4591 @@ func (p *PromptManageServiceCreatePromptArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCreatePromptArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffCommitRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceCreatePromptArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DiffCommitRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreatePromptRequest()
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptID = _field
+	return offset, nil
+}
+
+func (p *DiffCommitRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.BaseCommitVersion = _field
+	return offset, nil
+}
+
+func (p *DiffCommitRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TargetCommitVersion = _field
+	return offset, nil
+}
+
+func (p *DiffCommitRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Request = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *PromptManageServiceCreatePromptArgs) FastWrite(buf []byte) int {
+func (p *DiffCommitRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceCreatePromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffCommitRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *PromptManageServiceCreatePromptArgs) BLength() int {
+func (p *DiffCommitRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *PromptManageServiceCreatePromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffCommitRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetPromptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.PromptID)
+	}
 	return offset
 }
 
-func (p *PromptManageServiceCreatePromptArgs) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.Request.BLength()
-	return l
+func (p *DiffCommitRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseCommitVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BaseCommitVersion)
+	}
+	return offset
 }
 
-func (p *PromptManageServiceCreatePromptArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceCreatePromptArgs)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *DiffCommitRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetCommitVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TargetCommitVersion)
 	}
+	return offset
+}
 
-	var _request *CreatePromptRequest
-	if src.Request != nil {
-		_request = &CreatePromptRequest{}
+func (p *DiffCommitRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DiffCommitRequest) field1Length() int {
+	l := 0
+	if p.IsSetPromptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *DiffCommitRequest) field2Length() int {
+	l := 0
+	if p.IsSetBaseCommitVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.BaseCommitVersion)
+	}
+	return l
+}
+
+func (p *DiffCommitRequest) field3Length() int {
+	l := 0
+	if p.IsSetTargetCommitVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.TargetCommitVersion)
+	}
+	return l
+}
+
+func (p *DiffCommitRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *DiffCommitRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*DiffCommitRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.PromptID != nil {
+		tmp := *src.PromptID
+		p.PromptID = &tmp
+	}
+
+	if src.BaseCommitVersion != nil {
+		var tmp string
+		if *src.BaseCommitVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.BaseCommitVersion)
+		}
+		p.BaseCommitVersion = &tmp
+	}
+
+	if src.TargetCommitVersion != nil {
+		var tmp string
+		if *src.TargetCommitVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.TargetCommitVersion)
+		}
+		p.TargetCommitVersion = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *DiffCommitResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffCommitResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *DiffCommitResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := prompt.NewPromptDetailDiff()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Diff = _field
+	return offset, nil
+}
+
+func (p *DiffCommitResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *DiffCommitResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DiffCommitResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DiffCommitResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DiffCommitResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDiff() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Diff.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DiffCommitResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DiffCommitResponse) field1Length() int {
+	l := 0
+	if p.IsSetDiff() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Diff.BLength()
+	}
+	return l
+}
+
+func (p *DiffCommitResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *DiffCommitResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*DiffCommitResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _diff *prompt.PromptDetailDiff
+	if src.Diff != nil {
+		_diff = &prompt.PromptDetailDiff{}
+		if err := _diff.DeepCopy(src.Diff); err != nil {
+			return err
+		}
+	}
+	p.Diff = _diff
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *SetPromptLabelRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SetPromptLabelRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *SetPromptLabelRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptID = _field
+	return offset, nil
+}
+
+func (p *SetPromptLabelRequest) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.LabelKey = _field
+	return offset, nil
+}
+
+func (p *SetPromptLabelRequest) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.CommitVersion = _field
+	return offset, nil
+}
+
+func (p *SetPromptLabelRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *SetPromptLabelRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *SetPromptLabelRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *SetPromptLabelRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field11Length()
+		l += p.field12Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *SetPromptLabelRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.PromptID)
+	}
+	return offset
+}
+
+func (p *SetPromptLabelRequest) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabelKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 11)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LabelKey)
+	}
+	return offset
+}
+
+func (p *SetPromptLabelRequest) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCommitVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 12)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.CommitVersion)
+	}
+	return offset
+}
+
+func (p *SetPromptLabelRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *SetPromptLabelRequest) field1Length() int {
+	l := 0
+	if p.IsSetPromptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *SetPromptLabelRequest) field11Length() int {
+	l := 0
+	if p.IsSetLabelKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.LabelKey)
+	}
+	return l
+}
+
+func (p *SetPromptLabelRequest) field12Length() int {
+	l := 0
+	if p.IsSetCommitVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.CommitVersion)
+	}
+	return l
+}
+
+func (p *SetPromptLabelRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *SetPromptLabelRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*SetPromptLabelRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.PromptID != nil {
+		tmp := *src.PromptID
+		p.PromptID = &tmp
+	}
+
+	if src.LabelKey != nil {
+		var tmp string
+		if *src.LabelKey != "" {
+			tmp = kutils.StringDeepCopy(*src.LabelKey)
+		}
+		p.LabelKey = &tmp
+	}
+
+	if src.CommitVersion != nil {
+		var tmp string
+		if *src.CommitVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.CommitVersion)
+		}
+		p.CommitVersion = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *SetPromptLabelResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SetPromptLabelResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *SetPromptLabelResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := prompt.NewPromptLabel()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Label = _field
+	return offset, nil
+}
+
+func (p *SetPromptLabelResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *SetPromptLabelResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *SetPromptLabelResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *SetPromptLabelResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *SetPromptLabelResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Label.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *SetPromptLabelResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *SetPromptLabelResponse) field1Length() int {
+	l := 0
+	if p.IsSetLabel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Label.BLength()
+	}
+	return l
+}
+
+func (p *SetPromptLabelResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *SetPromptLabelResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*SetPromptLabelResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _label *prompt.PromptLabel
+	if src.Label != nil {
+		_label = &prompt.PromptLabel{}
+		if err := _label.DeepCopy(src.Label); err != nil {
+			return err
+		}
+	}
+	p.Label = _label
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListPromptLabelRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPromptLabelRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListPromptLabelRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptID = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListPromptLabelRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListPromptLabelRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListPromptLabelRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.PromptID)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelRequest) field1Length() int {
+	l := 0
+	if p.IsSetPromptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListPromptLabelRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListPromptLabelRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListPromptLabelRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.PromptID != nil {
+		tmp := *src.PromptID
+		p.PromptID = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListPromptLabelResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPromptLabelResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListPromptLabelResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*prompt.PromptLabel, 0, size)
+	values := make([]prompt.PromptLabel, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Labels = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelResponse) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*user.UserInfoDetail, 0, size)
+	values := make([]user.UserInfoDetail, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Users = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListPromptLabelResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListPromptLabelResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field11Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListPromptLabelResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabels() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Labels {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelResponse) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUsers() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 11)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Users {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelResponse) field1Length() int {
+	l := 0
+	if p.IsSetLabels() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Labels {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListPromptLabelResponse) field11Length() int {
+	l := 0
+	if p.IsSetUsers() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Users {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListPromptLabelResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *ListPromptLabelResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListPromptLabelResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Labels != nil {
+		p.Labels = make([]*prompt.PromptLabel, 0, len(src.Labels))
+		for _, elem := range src.Labels {
+			var _elem *prompt.PromptLabel
+			if elem != nil {
+				_elem = &prompt.PromptLabel{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Labels = append(p.Labels, _elem)
+		}
+	}
+
+	if src.Users != nil {
+		p.Users = make([]*user.UserInfoDetail, 0, len(src.Users))
+		for _, elem := range src.Users {
+			var _elem *user.UserInfoDetail
+			if elem != nil {
+				_elem = &user.UserInfoDetail{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Users = append(p.Users, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListPromptLabelHistoryRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 127:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField127(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 128:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField128(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPromptLabelHistoryRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListPromptLabelHistoryRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptID = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryRequest) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.LabelKey = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryRequest) FastReadField127(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryRequest) FastReadField128(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageToken = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListPromptLabelHistoryRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField127(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField128(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListPromptLabelHistoryRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field11Length()
+		l += p.field127Length()
+		l += p.field128Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListPromptLabelHistoryRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.PromptID)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryRequest) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabelKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 11)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LabelKey)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryRequest) fastWriteField127(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 127)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryRequest) fastWriteField128(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 128)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PageToken)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryRequest) field1Length() int {
+	l := 0
+	if p.IsSetPromptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryRequest) field11Length() int {
+	l := 0
+	if p.IsSetLabelKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.LabelKey)
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryRequest) field127Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryRequest) field128Length() int {
+	l := 0
+	if p.IsSetPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PageToken)
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListPromptLabelHistoryRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.PromptID != nil {
+		tmp := *src.PromptID
+		p.PromptID = &tmp
+	}
+
+	if src.LabelKey != nil {
+		var tmp string
+		if *src.LabelKey != "" {
+			tmp = kutils.StringDeepCopy(*src.LabelKey)
+		}
+		p.LabelKey = &tmp
+	}
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	if src.PageToken != nil {
+		var tmp string
+		if *src.PageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.PageToken)
+		}
+		p.PageToken = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListPromptLabelHistoryResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 127:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField127(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 128:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField128(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPromptLabelHistoryResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListPromptLabelHistoryResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*prompt.PromptLabelHistory, 0, size)
+	values := make([]prompt.PromptLabelHistory, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Histories = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryResponse) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*user.UserInfoDetail, 0, size)
+	values := make([]user.UserInfoDetail, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Users = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryResponse) FastReadField127(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.HasMore = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryResponse) FastReadField128(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NextPageToken = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListPromptLabelHistoryResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListPromptLabelHistoryResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField127(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField128(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListPromptLabelHistoryResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field11Length()
+		l += p.field127Length()
+		l += p.field128Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListPromptLabelHistoryResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHistories() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Histories {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryResponse) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUsers() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 11)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Users {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryResponse) fastWriteField127(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHasMore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 127)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.HasMore)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryResponse) fastWriteField128(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 128)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextPageToken)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListPromptLabelHistoryResponse) field1Length() int {
+	l := 0
+	if p.IsSetHistories() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Histories {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryResponse) field11Length() int {
+	l := 0
+	if p.IsSetUsers() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Users {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryResponse) field127Length() int {
+	l := 0
+	if p.IsSetHasMore() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryResponse) field128Length() int {
+	l := 0
+	if p.IsSetNextPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.NextPageToken)
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *ListPromptLabelHistoryResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListPromptLabelHistoryResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Histories != nil {
+		p.Histories = make([]*prompt.PromptLabelHistory, 0, len(src.Histories))
+		for _, elem := range src.Histories {
+			var _elem *prompt.PromptLabelHistory
+			if elem != nil {
+				_elem = &prompt.PromptLabelHistory{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Histories = append(p.Histories, _elem)
+		}
+	}
+
+	if src.Users != nil {
+		p.Users = make([]*user.UserInfoDetail, 0, len(src.Users))
+		for _, elem := range src.Users {
+			var _elem *user.UserInfoDetail
+			if elem != nil {
+				_elem = &user.UserInfoDetail{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Users = append(p.Users, _elem)
+		}
+	}
+
+	if src.HasMore != nil {
+		tmp := *src.HasMore
+		p.HasMore = &tmp
+	}
+
+	if src.NextPageToken != nil {
+		var tmp string
+		if *src.NextPageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.NextPageToken)
+		}
+		p.NextPageToken = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *RollbackPromptLabelRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RollbackPromptLabelRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *RollbackPromptLabelRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptID = _field
+	return offset, nil
+}
+
+func (p *RollbackPromptLabelRequest) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.LabelKey = _field
+	return offset, nil
+}
+
+func (p *RollbackPromptLabelRequest) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.HistoryID = _field
+	return offset, nil
+}
+
+func (p *RollbackPromptLabelRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *RollbackPromptLabelRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *RollbackPromptLabelRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *RollbackPromptLabelRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field11Length()
+		l += p.field12Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *RollbackPromptLabelRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.PromptID)
+	}
+	return offset
+}
+
+func (p *RollbackPromptLabelRequest) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabelKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 11)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LabelKey)
+	}
+	return offset
+}
+
+func (p *RollbackPromptLabelRequest) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHistoryID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 12)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.HistoryID)
+	}
+	return offset
+}
+
+func (p *RollbackPromptLabelRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *RollbackPromptLabelRequest) field1Length() int {
+	l := 0
+	if p.IsSetPromptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *RollbackPromptLabelRequest) field11Length() int {
+	l := 0
+	if p.IsSetLabelKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.LabelKey)
+	}
+	return l
+}
+
+func (p *RollbackPromptLabelRequest) field12Length() int {
+	l := 0
+	if p.IsSetHistoryID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *RollbackPromptLabelRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *RollbackPromptLabelRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*RollbackPromptLabelRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.PromptID != nil {
+		tmp := *src.PromptID
+		p.PromptID = &tmp
+	}
+
+	if src.LabelKey != nil {
+		var tmp string
+		if *src.LabelKey != "" {
+			tmp = kutils.StringDeepCopy(*src.LabelKey)
+		}
+		p.LabelKey = &tmp
+	}
+
+	if src.HistoryID != nil {
+		tmp := *src.HistoryID
+		p.HistoryID = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *RollbackPromptLabelResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RollbackPromptLabelResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *RollbackPromptLabelResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := prompt.NewPromptLabel()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Label = _field
+	return offset, nil
+}
+
+func (p *RollbackPromptLabelResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *RollbackPromptLabelResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *RollbackPromptLabelResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *RollbackPromptLabelResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *RollbackPromptLabelResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Label.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *RollbackPromptLabelResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *RollbackPromptLabelResponse) field1Length() int {
+	l := 0
+	if p.IsSetLabel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Label.BLength()
+	}
+	return l
+}
+
+func (p *RollbackPromptLabelResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *RollbackPromptLabelResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*RollbackPromptLabelResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _label *prompt.PromptLabel
+	if src.Label != nil {
+		_label = &prompt.PromptLabel{}
+		if err := _label.DeepCopy(src.Label); err != nil {
+			return err
+		}
+	}
+	p.Label = _label
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListSnippetReferenceRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 127:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField127(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 128:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField128(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSnippetReferenceRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListSnippetReferenceRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptID = _field
+	return offset, nil
+}
+
+func (p *ListSnippetReferenceRequest) FastReadField127(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListSnippetReferenceRequest) FastReadField128(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageToken = _field
+	return offset, nil
+}
+
+func (p *ListSnippetReferenceRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListSnippetReferenceRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListSnippetReferenceRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField127(buf[offset:], w)
+		offset += p.fastWriteField128(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListSnippetReferenceRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field127Length()
+		l += p.field128Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListSnippetReferenceRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.PromptID)
+	}
+	return offset
+}
+
+func (p *ListSnippetReferenceRequest) fastWriteField127(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 127)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListSnippetReferenceRequest) fastWriteField128(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 128)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PageToken)
+	}
+	return offset
+}
+
+func (p *ListSnippetReferenceRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListSnippetReferenceRequest) field1Length() int {
+	l := 0
+	if p.IsSetPromptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListSnippetReferenceRequest) field127Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListSnippetReferenceRequest) field128Length() int {
+	l := 0
+	if p.IsSetPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PageToken)
+	}
+	return l
+}
+
+func (p *ListSnippetReferenceRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListSnippetReferenceRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListSnippetReferenceRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.PromptID != nil {
+		tmp := *src.PromptID
+		p.PromptID = &tmp
+	}
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	if src.PageToken != nil {
+		var tmp string
+		if *src.PageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.PageToken)
+		}
+		p.PageToken = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListSnippetReferenceResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 127:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField127(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 128:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField128(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSnippetReferenceResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListSnippetReferenceResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*prompt.SnippetReference, 0, size)
+	values := make([]prompt.SnippetReference, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.References = _field
+	return offset, nil
+}
+
+func (p *ListSnippetReferenceResponse) FastReadField127(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.HasMore = _field
+	return offset, nil
+}
+
+func (p *ListSnippetReferenceResponse) FastReadField128(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NextPageToken = _field
+	return offset, nil
+}
+
+func (p *ListSnippetReferenceResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListSnippetReferenceResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListSnippetReferenceResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField127(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField128(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListSnippetReferenceResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field127Length()
+		l += p.field128Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListSnippetReferenceResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetReferences() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.References {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListSnippetReferenceResponse) fastWriteField127(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHasMore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 127)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.HasMore)
+	}
+	return offset
+}
+
+func (p *ListSnippetReferenceResponse) fastWriteField128(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 128)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextPageToken)
+	}
+	return offset
+}
+
+func (p *ListSnippetReferenceResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListSnippetReferenceResponse) field1Length() int {
+	l := 0
+	if p.IsSetReferences() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.References {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListSnippetReferenceResponse) field127Length() int {
+	l := 0
+	if p.IsSetHasMore() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *ListSnippetReferenceResponse) field128Length() int {
+	l := 0
+	if p.IsSetNextPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.NextPageToken)
+	}
+	return l
+}
+
+func (p *ListSnippetReferenceResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *ListSnippetReferenceResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListSnippetReferenceResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.References != nil {
+		p.References = make([]*prompt.SnippetReference, 0, len(src.References))
+		for _, elem := range src.References {
+			var _elem *prompt.SnippetReference
+			if elem != nil {
+				_elem = &prompt.SnippetReference{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.References = append(p.References, _elem)
+		}
+	}
+
+	if src.HasMore != nil {
+		tmp := *src.HasMore
+		p.HasMore = &tmp
+	}
+
+	if src.NextPageToken != nil {
+		var tmp string
+		if *src.NextPageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.NextPageToken)
+		}
+		p.NextPageToken = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *PromptManageServiceCreatePromptArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCreatePromptArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceCreatePromptArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreatePromptRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceCreatePromptArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceCreatePromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceCreatePromptArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceCreatePromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PromptManageServiceCreatePromptArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PromptManageServiceCreatePromptArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceCreatePromptArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *CreatePromptRequest
+	if src.Request != nil {
+		_request = &CreatePromptRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *PromptManageServiceCreatePromptResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCreatePromptResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceCreatePromptResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreatePromptResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceCreatePromptResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceCreatePromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceCreatePromptResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceCreatePromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptManageServiceCreatePromptResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PromptManageServiceCreatePromptResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceCreatePromptResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *CreatePromptResponse
+	if src.Success != nil {
+		_success = &CreatePromptResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *PromptManageServiceClonePromptArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceClonePromptArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceClonePromptArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewClonePromptRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceClonePromptArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceClonePromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceClonePromptArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceClonePromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PromptManageServiceClonePromptArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PromptManageServiceClonePromptArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceClonePromptArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *ClonePromptRequest
+	if src.Request != nil {
+		_request = &ClonePromptRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *PromptManageServiceClonePromptResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceClonePromptResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceClonePromptResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewClonePromptResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceClonePromptResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceClonePromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceClonePromptResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceClonePromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptManageServiceClonePromptResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PromptManageServiceClonePromptResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceClonePromptResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ClonePromptResponse
+	if src.Success != nil {
+		_success = &ClonePromptResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *PromptManageServiceDeletePromptArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDeletePromptArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceDeletePromptArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeletePromptRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceDeletePromptArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceDeletePromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceDeletePromptArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceDeletePromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PromptManageServiceDeletePromptArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PromptManageServiceDeletePromptArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceDeletePromptArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *DeletePromptRequest
+	if src.Request != nil {
+		_request = &DeletePromptRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *PromptManageServiceDeletePromptResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDeletePromptResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceDeletePromptResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeletePromptResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceDeletePromptResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceDeletePromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceDeletePromptResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceDeletePromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptManageServiceDeletePromptResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PromptManageServiceDeletePromptResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceDeletePromptResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *DeletePromptResponse
+	if src.Success != nil {
+		_success = &DeletePromptResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *PromptManageServiceGetPromptArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceGetPromptArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceGetPromptArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetPromptRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceGetPromptArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceGetPromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceGetPromptArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceGetPromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PromptManageServiceGetPromptArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PromptManageServiceGetPromptArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceGetPromptArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *GetPromptRequest
+	if src.Request != nil {
+		_request = &GetPromptRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *PromptManageServiceGetPromptResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceGetPromptResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceGetPromptResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetPromptResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceGetPromptResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceGetPromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceGetPromptResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceGetPromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptManageServiceGetPromptResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PromptManageServiceGetPromptResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceGetPromptResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *GetPromptResponse
+	if src.Success != nil {
+		_success = &GetPromptResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceBatchGetPromptArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetPromptRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PromptManageServiceBatchGetPromptArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceBatchGetPromptArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *BatchGetPromptRequest
+	if src.Request != nil {
+		_request = &BatchGetPromptRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceBatchGetPromptResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetPromptResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PromptManageServiceBatchGetPromptResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceBatchGetPromptResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *BatchGetPromptResponse
+	if src.Success != nil {
+		_success = &BatchGetPromptResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *PromptManageServiceListPromptArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceListPromptArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListPromptRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceListPromptArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceListPromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceListPromptArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceListPromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PromptManageServiceListPromptArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PromptManageServiceListPromptArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListPromptArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *ListPromptRequest
+	if src.Request != nil {
+		_request = &ListPromptRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *PromptManageServiceListPromptResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceListPromptResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListPromptResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceListPromptResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceListPromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceListPromptResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceListPromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptManageServiceListPromptResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PromptManageServiceListPromptResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListPromptResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListPromptResponse
+	if src.Success != nil {
+		_success = &ListPromptResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *PromptManageServiceUpdatePromptArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceUpdatePromptArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptManageServiceUpdatePromptArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdatePromptRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PromptManageServiceUpdatePromptArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptManageServiceUpdatePromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptManageServiceUpdatePromptArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptManageServiceUpdatePromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PromptManageServiceUpdatePromptArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PromptManageServiceUpdatePromptArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceUpdatePromptArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *UpdatePromptRequest
+	if src.Request != nil {
+		_request = &UpdatePromptRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -6250,7 +11002,7 @@ func (p *PromptManageServiceCreatePromptArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceCreatePromptResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceUpdatePromptResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6294,14 +11046,14 @@ func (p *PromptManageServiceCreatePromptResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCreatePromptResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceUpdatePromptResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceCreatePromptResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceUpdatePromptResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreatePromptResponse()
+	_field := NewUpdatePromptResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6311,11 +11063,11 @@ func (p *PromptManageServiceCreatePromptResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *PromptManageServiceCreatePromptResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceUpdatePromptResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceCreatePromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceUpdatePromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -6324,7 +11076,7 @@ func (p *PromptManageServiceCreatePromptResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *PromptManageServiceCreatePromptResult) BLength() int {
+func (p *PromptManageServiceUpdatePromptResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -6333,7 +11085,7 @@ func (p *PromptManageServiceCreatePromptResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceCreatePromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceUpdatePromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -6342,7 +11094,7 @@ func (p *PromptManageServiceCreatePromptResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *PromptManageServiceCreatePromptResult) field0Length() int {
+func (p *PromptManageServiceUpdatePromptResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -6351,15 +11103,15 @@ func (p *PromptManageServiceCreatePromptResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceCreatePromptResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceCreatePromptResult)
+func (p *PromptManageServiceUpdatePromptResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceUpdatePromptResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreatePromptResponse
+	var _success *UpdatePromptResponse
 	if src.Success != nil {
-		_success = &CreatePromptResponse{}
+		_success = &UpdatePromptResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -6369,7 +11121,7 @@ func (p *PromptManageServiceCreatePromptResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceClonePromptArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceSaveDraftArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6413,14 +11165,14 @@ func (p *PromptManageServiceClonePromptArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceClonePromptArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSaveDraftArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceClonePromptArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceSaveDraftArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewClonePromptRequest()
+	_field := NewSaveDraftRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6430,11 +11182,11 @@ func (p *PromptManageServiceClonePromptArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *PromptManageServiceClonePromptArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceSaveDraftArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceClonePromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceSaveDraftArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6443,7 +11195,7 @@ func (p *PromptManageServiceClonePromptArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *PromptManageServiceClonePromptArgs) BLength() int {
+func (p *PromptManageServiceSaveDraftArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6452,29 +11204,29 @@ func (p *PromptManageServiceClonePromptArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceClonePromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceSaveDraftArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceClonePromptArgs) field1Length() int {
+func (p *PromptManageServiceSaveDraftArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceClonePromptArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceClonePromptArgs)
+func (p *PromptManageServiceSaveDraftArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceSaveDraftArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *ClonePromptRequest
+	var _request *SaveDraftRequest
 	if src.Request != nil {
-		_request = &ClonePromptRequest{}
+		_request = &SaveDraftRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -6484,7 +11236,7 @@ func (p *PromptManageServiceClonePromptArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceClonePromptResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceSaveDraftResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6528,14 +11280,14 @@ func (p *PromptManageServiceClonePromptResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceClonePromptResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSaveDraftResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceClonePromptResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceSaveDraftResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewClonePromptResponse()
+	_field := NewSaveDraftResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6545,11 +11297,11 @@ func (p *PromptManageServiceClonePromptResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *PromptManageServiceClonePromptResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceSaveDraftResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceClonePromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceSaveDraftResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -6558,7 +11310,7 @@ func (p *PromptManageServiceClonePromptResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *PromptManageServiceClonePromptResult) BLength() int {
+func (p *PromptManageServiceSaveDraftResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -6567,7 +11319,7 @@ func (p *PromptManageServiceClonePromptResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceClonePromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceSaveDraftResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -6576,7 +11328,7 @@ func (p *PromptManageServiceClonePromptResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *PromptManageServiceClonePromptResult) field0Length() int {
+func (p *PromptManageServiceSaveDraftResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -6585,15 +11337,15 @@ func (p *PromptManageServiceClonePromptResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceClonePromptResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceClonePromptResult)
+func (p *PromptManageServiceSaveDraftResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceSaveDraftResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ClonePromptResponse
+	var _success *SaveDraftResponse
 	if src.Success != nil {
-		_success = &ClonePromptResponse{}
+		_success = &SaveDraftResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -6603,7 +11355,7 @@ func (p *PromptManageServiceClonePromptResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceDeletePromptArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceListCommitArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6647,14 +11399,14 @@ func (p *PromptManageServiceDeletePromptArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDeletePromptArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListCommitArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceDeletePromptArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceListCommitArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeletePromptRequest()
+	_field := NewListCommitRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6664,11 +11416,11 @@ func (p *PromptManageServiceDeletePromptArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *PromptManageServiceDeletePromptArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceListCommitArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceDeletePromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListCommitArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6677,7 +11429,7 @@ func (p *PromptManageServiceDeletePromptArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *PromptManageServiceDeletePromptArgs) BLength() int {
+func (p *PromptManageServiceListCommitArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6686,29 +11438,29 @@ func (p *PromptManageServiceDeletePromptArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceDeletePromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListCommitArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceDeletePromptArgs) field1Length() int {
+func (p *PromptManageServiceListCommitArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceDeletePromptArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceDeletePromptArgs)
+func (p *PromptManageServiceListCommitArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListCommitArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *DeletePromptRequest
+	var _request *ListCommitRequest
 	if src.Request != nil {
-		_request = &DeletePromptRequest{}
+		_request = &ListCommitRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -6718,7 +11470,7 @@ func (p *PromptManageServiceDeletePromptArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceDeletePromptResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceListCommitResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6762,14 +11514,14 @@ func (p *PromptManageServiceDeletePromptResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDeletePromptResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListCommitResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceDeletePromptResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceListCommitResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeletePromptResponse()
+	_field := NewListCommitResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6779,11 +11531,11 @@ func (p *PromptManageServiceDeletePromptResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *PromptManageServiceDeletePromptResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceListCommitResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceDeletePromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListCommitResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -6792,7 +11544,7 @@ func (p *PromptManageServiceDeletePromptResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *PromptManageServiceDeletePromptResult) BLength() int {
+func (p *PromptManageServiceListCommitResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -6801,7 +11553,7 @@ func (p *PromptManageServiceDeletePromptResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceDeletePromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListCommitResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -6810,7 +11562,7 @@ func (p *PromptManageServiceDeletePromptResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *PromptManageServiceDeletePromptResult) field0Length() int {
+func (p *PromptManageServiceListCommitResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -6819,15 +11571,15 @@ func (p *PromptManageServiceDeletePromptResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceDeletePromptResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceDeletePromptResult)
+func (p *PromptManageServiceListCommitResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListCommitResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeletePromptResponse
+	var _success *ListCommitResponse
 	if src.Success != nil {
-		_success = &DeletePromptResponse{}
+		_success = &ListCommitResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -6837,7 +11589,7 @@ func (p *PromptManageServiceDeletePromptResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceGetPromptArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceCommitDraftArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6881,14 +11633,14 @@ func (p *PromptManageServiceGetPromptArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceGetPromptArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCommitDraftArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceGetPromptArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceCommitDraftArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetPromptRequest()
+	_field := NewCommitDraftRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6898,11 +11650,11 @@ func (p *PromptManageServiceGetPromptArgs) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *PromptManageServiceGetPromptArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceCommitDraftArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceGetPromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceCommitDraftArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6911,7 +11663,7 @@ func (p *PromptManageServiceGetPromptArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *PromptManageServiceGetPromptArgs) BLength() int {
+func (p *PromptManageServiceCommitDraftArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6920,29 +11672,29 @@ func (p *PromptManageServiceGetPromptArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceGetPromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceCommitDraftArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceGetPromptArgs) field1Length() int {
+func (p *PromptManageServiceCommitDraftArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceGetPromptArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceGetPromptArgs)
+func (p *PromptManageServiceCommitDraftArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceCommitDraftArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *GetPromptRequest
+	var _request *CommitDraftRequest
 	if src.Request != nil {
-		_request = &GetPromptRequest{}
+		_request = &CommitDraftRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -6952,7 +11704,7 @@ func (p *PromptManageServiceGetPromptArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceGetPromptResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceCommitDraftResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6996,14 +11748,14 @@ func (p *PromptManageServiceGetPromptResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceGetPromptResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCommitDraftResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceGetPromptResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceCommitDraftResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetPromptResponse()
+	_field := NewCommitDraftResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7013,11 +11765,11 @@ func (p *PromptManageServiceGetPromptResult) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *PromptManageServiceGetPromptResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceCommitDraftResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceGetPromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceCommitDraftResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -7026,7 +11778,7 @@ func (p *PromptManageServiceGetPromptResult) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *PromptManageServiceGetPromptResult) BLength() int {
+func (p *PromptManageServiceCommitDraftResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -7035,7 +11787,7 @@ func (p *PromptManageServiceGetPromptResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceGetPromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceCommitDraftResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -7044,7 +11796,7 @@ func (p *PromptManageServiceGetPromptResult) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *PromptManageServiceGetPromptResult) field0Length() int {
+func (p *PromptManageServiceCommitDraftResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7053,15 +11805,15 @@ func (p *PromptManageServiceGetPromptResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceGetPromptResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceGetPromptResult)
+func (p *PromptManageServiceCommitDraftResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceCommitDraftResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetPromptResponse
+	var _success *CommitDraftResponse
 	if src.Success != nil {
-		_success = &GetPromptResponse{}
+		_success = &CommitDraftResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -7071,7 +11823,7 @@ func (p *PromptManageServiceGetPromptResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7115,14 +11867,14 @@ func (p *PromptManageServiceBatchGetPromptArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceBatchGetPromptArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRevertDraftFromCommitArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetPromptRequest()
+	_field := NewRevertDraftFromCommitRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7132,11 +11884,11 @@ func (p *PromptManageServiceBatchGetPromptArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -7145,7 +11897,7 @@ func (p *PromptManageServiceBatchGetPromptArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) BLength() int {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -7154,29 +11906,29 @@ func (p *PromptManageServiceBatchGetPromptArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) field1Length() int {
+func (p *PromptManageServiceRevertDraftFromCommitArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceBatchGetPromptArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceBatchGetPromptArgs)
+func (p *PromptManageServiceRevertDraftFromCommitArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceRevertDraftFromCommitArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *BatchGetPromptRequest
+	var _request *RevertDraftFromCommitRequest
 	if src.Request != nil {
-		_request = &BatchGetPromptRequest{}
+		_request = &RevertDraftFromCommitRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -7186,7 +11938,7 @@ func (p *PromptManageServiceBatchGetPromptArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceRevertDraftFromCommitResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7230,14 +11982,14 @@ func (p *PromptManageServiceBatchGetPromptResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceBatchGetPromptResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRevertDraftFromCommitResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceRevertDraftFromCommitResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetPromptResponse()
+	_field := NewRevertDraftFromCommitResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7247,11 +11999,11 @@ func (p *PromptManageServiceBatchGetPromptResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceRevertDraftFromCommitResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceRevertDraftFromCommitResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -7260,7 +12012,7 @@ func (p *PromptManageServiceBatchGetPromptResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) BLength() int {
+func (p *PromptManageServiceRevertDraftFromCommitResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -7269,7 +12021,7 @@ func (p *PromptManageServiceBatchGetPromptResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceRevertDraftFromCommitResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -7278,7 +12030,7 @@ func (p *PromptManageServiceBatchGetPromptResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) field0Length() int {
+func (p *PromptManageServiceRevertDraftFromCommitResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7287,15 +12039,15 @@ func (p *PromptManageServiceBatchGetPromptResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceBatchGetPromptResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceBatchGetPromptResult)
+func (p *PromptManageServiceRevertDraftFromCommitResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceRevertDraftFromCommitResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetPromptResponse
+	var _success *RevertDraftFromCommitResponse
 	if src.Success != nil {
-		_success = &BatchGetPromptResponse{}
+		_success = &RevertDraftFromCommitResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -7305,7 +12057,7 @@ func (p *PromptManageServiceBatchGetPromptResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *PromptManageServiceListPromptArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceDiffCommitArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7349,14 +12101,14 @@ func (p *PromptManageServiceListPromptArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDiffCommitArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceListPromptArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceDiffCommitArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListPromptRequest()
+	_field := NewDiffCommitRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7366,11 +12118,11 @@ func (p *PromptManageServiceListPromptArgs) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *PromptManageServiceListPromptArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceDiffCommitArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceListPromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceDiffCommitArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -7379,7 +12131,7 @@ func (p *PromptManageServiceListPromptArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *PromptManageServiceListPromptArgs) BLength() int {
+func (p *PromptManageServiceDiffCommitArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -7388,29 +12140,29 @@ func (p *PromptManageServiceListPromptArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceListPromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceDiffCommitArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceListPromptArgs) field1Length() int {
+func (p *PromptManageServiceDiffCommitArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceListPromptArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceListPromptArgs)
+func (p *PromptManageServiceDiffCommitArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceDiffCommitArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *ListPromptRequest
+	var _request *DiffCommitRequest
 	if src.Request != nil {
-		_request = &ListPromptRequest{}
+		_request = &DiffCommitRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -7420,7 +12172,7 @@ func (p *PromptManageServiceListPromptArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceListPromptResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceDiffCommitResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7464,14 +12216,14 @@ func (p *PromptManageServiceListPromptResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceDiffCommitResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceListPromptResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceDiffCommitResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListPromptResponse()
+	_field := NewDiffCommitResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7481,11 +12233,11 @@ func (p *PromptManageServiceListPromptResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *PromptManageServiceListPromptResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceDiffCommitResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceListPromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceDiffCommitResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -7494,7 +12246,7 @@ func (p *PromptManageServiceListPromptResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *PromptManageServiceListPromptResult) BLength() int {
+func (p *PromptManageServiceDiffCommitResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -7503,7 +12255,7 @@ func (p *PromptManageServiceListPromptResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceListPromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceDiffCommitResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -7512,7 +12264,7 @@ func (p *PromptManageServiceListPromptResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *PromptManageServiceListPromptResult) field0Length() int {
+func (p *PromptManageServiceDiffCommitResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7521,15 +12273,15 @@ func (p *PromptManageServiceListPromptResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceListPromptResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceListPromptResult)
+func (p *PromptManageServiceDiffCommitResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceDiffCommitResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListPromptResponse
+	var _success *DiffCommitResponse
 	if src.Success != nil {
-		_success = &ListPromptResponse{}
+		_success = &DiffCommitResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -7539,7 +12291,7 @@ func (p *PromptManageServiceListPromptResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceSetPromptLabelArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7583,14 +12335,14 @@ func (p *PromptManageServiceUpdatePromptArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceUpdatePromptArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSetPromptLabelArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceSetPromptLabelArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdatePromptRequest()
+	_field := NewSetPromptLabelRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7600,11 +12352,11 @@ func (p *PromptManageServiceUpdatePromptArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceSetPromptLabelArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceSetPromptLabelArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -7613,7 +12365,7 @@ func (p *PromptManageServiceUpdatePromptArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) BLength() int {
+func (p *PromptManageServiceSetPromptLabelArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -7622,29 +12374,29 @@ func (p *PromptManageServiceUpdatePromptArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceSetPromptLabelArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) field1Length() int {
+func (p *PromptManageServiceSetPromptLabelArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceUpdatePromptArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceUpdatePromptArgs)
+func (p *PromptManageServiceSetPromptLabelArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceSetPromptLabelArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *UpdatePromptRequest
+	var _request *SetPromptLabelRequest
 	if src.Request != nil {
-		_request = &UpdatePromptRequest{}
+		_request = &SetPromptLabelRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -7654,7 +12406,7 @@ func (p *PromptManageServiceUpdatePromptArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceUpdatePromptResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceSetPromptLabelResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7698,14 +12450,14 @@ func (p *PromptManageServiceUpdatePromptResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceUpdatePromptResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSetPromptLabelResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceUpdatePromptResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceSetPromptLabelResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdatePromptResponse()
+	_field := NewSetPromptLabelResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7715,11 +12467,11 @@ func (p *PromptManageServiceUpdatePromptResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *PromptManageServiceUpdatePromptResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceSetPromptLabelResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceUpdatePromptResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceSetPromptLabelResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -7728,7 +12480,7 @@ func (p *PromptManageServiceUpdatePromptResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *PromptManageServiceUpdatePromptResult) BLength() int {
+func (p *PromptManageServiceSetPromptLabelResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -7737,7 +12489,7 @@ func (p *PromptManageServiceUpdatePromptResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceUpdatePromptResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceSetPromptLabelResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -7746,7 +12498,7 @@ func (p *PromptManageServiceUpdatePromptResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *PromptManageServiceUpdatePromptResult) field0Length() int {
+func (p *PromptManageServiceSetPromptLabelResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7755,15 +12507,15 @@ func (p *PromptManageServiceUpdatePromptResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceUpdatePromptResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceUpdatePromptResult)
+func (p *PromptManageServiceSetPromptLabelResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceSetPromptLabelResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdatePromptResponse
+	var _success *SetPromptLabelResponse
 	if src.Success != nil {
-		_success = &UpdatePromptResponse{}
+		_success = &SetPromptLabelResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -7773,7 +12525,7 @@ func (p *PromptManageServiceUpdatePromptResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceSaveDraftArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceListPromptLabelArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7817,14 +12569,14 @@ func (p *PromptManageServiceSaveDraftArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSaveDraftArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptLabelArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceSaveDraftArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceListPromptLabelArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSaveDraftRequest()
+	_field := NewListPromptLabelRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7834,11 +12586,11 @@ func (p *PromptManageServiceSaveDraftArgs) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *PromptManageServiceSaveDraftArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceListPromptLabelArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceSaveDraftArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListPromptLabelArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -7847,7 +12599,7 @@ func (p *PromptManageServiceSaveDraftArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *PromptManageServiceSaveDraftArgs) BLength() int {
+func (p *PromptManageServiceListPromptLabelArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -7856,29 +12608,29 @@ func (p *PromptManageServiceSaveDraftArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceSaveDraftArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListPromptLabelArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceSaveDraftArgs) field1Length() int {
+func (p *PromptManageServiceListPromptLabelArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceSaveDraftArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceSaveDraftArgs)
+func (p *PromptManageServiceListPromptLabelArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListPromptLabelArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *SaveDraftRequest
+	var _request *ListPromptLabelRequest
 	if src.Request != nil {
-		_request = &SaveDraftRequest{}
+		_request = &ListPromptLabelRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -7888,7 +12640,7 @@ func (p *PromptManageServiceSaveDraftArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceSaveDraftResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceListPromptLabelResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7932,14 +12684,14 @@ func (p *PromptManageServiceSaveDraftResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceSaveDraftResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptLabelResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceSaveDraftResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceListPromptLabelResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSaveDraftResponse()
+	_field := NewListPromptLabelResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7949,11 +12701,11 @@ func (p *PromptManageServiceSaveDraftResult) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *PromptManageServiceSaveDraftResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceListPromptLabelResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceSaveDraftResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListPromptLabelResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -7962,7 +12714,7 @@ func (p *PromptManageServiceSaveDraftResult) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *PromptManageServiceSaveDraftResult) BLength() int {
+func (p *PromptManageServiceListPromptLabelResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -7971,7 +12723,7 @@ func (p *PromptManageServiceSaveDraftResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceSaveDraftResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListPromptLabelResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -7980,7 +12732,7 @@ func (p *PromptManageServiceSaveDraftResult) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *PromptManageServiceSaveDraftResult) field0Length() int {
+func (p *PromptManageServiceListPromptLabelResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7989,15 +12741,15 @@ func (p *PromptManageServiceSaveDraftResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceSaveDraftResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceSaveDraftResult)
+func (p *PromptManageServiceListPromptLabelResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListPromptLabelResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *SaveDraftResponse
+	var _success *ListPromptLabelResponse
 	if src.Success != nil {
-		_success = &SaveDraftResponse{}
+		_success = &ListPromptLabelResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -8007,7 +12759,7 @@ func (p *PromptManageServiceSaveDraftResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceListCommitArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8051,14 +12803,14 @@ func (p *PromptManageServiceListCommitArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListCommitArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptLabelHistoryArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceListCommitArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListCommitRequest()
+	_field := NewListPromptLabelHistoryRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8068,11 +12820,11 @@ func (p *PromptManageServiceListCommitArgs) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *PromptManageServiceListCommitArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceListCommitArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -8081,7 +12833,7 @@ func (p *PromptManageServiceListCommitArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *PromptManageServiceListCommitArgs) BLength() int {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -8090,29 +12842,29 @@ func (p *PromptManageServiceListCommitArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceListCommitArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceListCommitArgs) field1Length() int {
+func (p *PromptManageServiceListPromptLabelHistoryArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceListCommitArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceListCommitArgs)
+func (p *PromptManageServiceListPromptLabelHistoryArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListPromptLabelHistoryArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *ListCommitRequest
+	var _request *ListPromptLabelHistoryRequest
 	if src.Request != nil {
-		_request = &ListCommitRequest{}
+		_request = &ListPromptLabelHistoryRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -8122,7 +12874,7 @@ func (p *PromptManageServiceListCommitArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceListCommitResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceListPromptLabelHistoryResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8166,14 +12918,14 @@ func (p *PromptManageServiceListCommitResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListCommitResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListPromptLabelHistoryResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceListCommitResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceListPromptLabelHistoryResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListCommitResponse()
+	_field := NewListPromptLabelHistoryResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8183,11 +12935,11 @@ func (p *PromptManageServiceListCommitResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *PromptManageServiceListCommitResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceListPromptLabelHistoryResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceListCommitResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListPromptLabelHistoryResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -8196,7 +12948,7 @@ func (p *PromptManageServiceListCommitResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *PromptManageServiceListCommitResult) BLength() int {
+func (p *PromptManageServiceListPromptLabelHistoryResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -8205,7 +12957,7 @@ func (p *PromptManageServiceListCommitResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceListCommitResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListPromptLabelHistoryResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -8214,7 +12966,7 @@ func (p *PromptManageServiceListCommitResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *PromptManageServiceListCommitResult) field0Length() int {
+func (p *PromptManageServiceListPromptLabelHistoryResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -8223,15 +12975,15 @@ func (p *PromptManageServiceListCommitResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceListCommitResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceListCommitResult)
+func (p *PromptManageServiceListPromptLabelHistoryResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListPromptLabelHistoryResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListCommitResponse
+	var _success *ListPromptLabelHistoryResponse
 	if src.Success != nil {
-		_success = &ListCommitResponse{}
+		_success = &ListPromptLabelHistoryResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -8241,7 +12993,7 @@ func (p *PromptManageServiceListCommitResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceCommitDraftArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceRollbackPromptLabelArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8285,14 +13037,14 @@ func (p *PromptManageServiceCommitDraftArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCommitDraftArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRollbackPromptLabelArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceCommitDraftArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceRollbackPromptLabelArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCommitDraftRequest()
+	_field := NewRollbackPromptLabelRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8302,11 +13054,11 @@ func (p *PromptManageServiceCommitDraftArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *PromptManageServiceCommitDraftArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceRollbackPromptLabelArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceCommitDraftArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceRollbackPromptLabelArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -8315,7 +13067,7 @@ func (p *PromptManageServiceCommitDraftArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *PromptManageServiceCommitDraftArgs) BLength() int {
+func (p *PromptManageServiceRollbackPromptLabelArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -8324,29 +13076,29 @@ func (p *PromptManageServiceCommitDraftArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceCommitDraftArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceRollbackPromptLabelArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceCommitDraftArgs) field1Length() int {
+func (p *PromptManageServiceRollbackPromptLabelArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceCommitDraftArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceCommitDraftArgs)
+func (p *PromptManageServiceRollbackPromptLabelArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceRollbackPromptLabelArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *CommitDraftRequest
+	var _request *RollbackPromptLabelRequest
 	if src.Request != nil {
-		_request = &CommitDraftRequest{}
+		_request = &RollbackPromptLabelRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -8356,7 +13108,7 @@ func (p *PromptManageServiceCommitDraftArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceCommitDraftResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceRollbackPromptLabelResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8400,14 +13152,14 @@ func (p *PromptManageServiceCommitDraftResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceCommitDraftResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRollbackPromptLabelResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceCommitDraftResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceRollbackPromptLabelResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCommitDraftResponse()
+	_field := NewRollbackPromptLabelResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8417,11 +13169,11 @@ func (p *PromptManageServiceCommitDraftResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *PromptManageServiceCommitDraftResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceRollbackPromptLabelResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceCommitDraftResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceRollbackPromptLabelResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -8430,7 +13182,7 @@ func (p *PromptManageServiceCommitDraftResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *PromptManageServiceCommitDraftResult) BLength() int {
+func (p *PromptManageServiceRollbackPromptLabelResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -8439,7 +13191,7 @@ func (p *PromptManageServiceCommitDraftResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceCommitDraftResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceRollbackPromptLabelResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -8448,7 +13200,7 @@ func (p *PromptManageServiceCommitDraftResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *PromptManageServiceCommitDraftResult) field0Length() int {
+func (p *PromptManageServiceRollbackPromptLabelResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -8457,15 +13209,15 @@ func (p *PromptManageServiceCommitDraftResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceCommitDraftResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceCommitDraftResult)
+func (p *PromptManageServiceRollbackPromptLabelResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceRollbackPromptLabelResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CommitDraftResponse
+	var _success *RollbackPromptLabelResponse
 	if src.Success != nil {
-		_success = &CommitDraftResponse{}
+		_success = &RollbackPromptLabelResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -8475,7 +13227,7 @@ func (p *PromptManageServiceCommitDraftResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceListSnippetReferenceArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8519,14 +13271,14 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) FastRead(buf []byte) (int
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRevertDraftFromCommitArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListSnippetReferenceArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptManageServiceListSnippetReferenceArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRevertDraftFromCommitRequest()
+	_field := NewListSnippetReferenceRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8536,11 +13288,11 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) FastReadField1(buf []byte
 	return offset, nil
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) FastWrite(buf []byte) int {
+func (p *PromptManageServiceListSnippetReferenceArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListSnippetReferenceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -8549,7 +13301,7 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) FastWriteNocopy(buf []byt
 	return offset
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) BLength() int {
+func (p *PromptManageServiceListSnippetReferenceArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -8558,29 +13310,29 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListSnippetReferenceArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) field1Length() int {
+func (p *PromptManageServiceListSnippetReferenceArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceRevertDraftFromCommitArgs)
+func (p *PromptManageServiceListSnippetReferenceArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListSnippetReferenceArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *RevertDraftFromCommitRequest
+	var _request *ListSnippetReferenceRequest
 	if src.Request != nil {
-		_request = &RevertDraftFromCommitRequest{}
+		_request = &ListSnippetReferenceRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -8590,7 +13342,7 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) DeepCopy(s interface{}) e
 	return nil
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) FastRead(buf []byte) (int, error) {
+func (p *PromptManageServiceListSnippetReferenceResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8634,14 +13386,14 @@ func (p *PromptManageServiceRevertDraftFromCommitResult) FastRead(buf []byte) (i
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceRevertDraftFromCommitResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptManageServiceListSnippetReferenceResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptManageServiceListSnippetReferenceResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRevertDraftFromCommitResponse()
+	_field := NewListSnippetReferenceResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8651,11 +13403,11 @@ func (p *PromptManageServiceRevertDraftFromCommitResult) FastReadField0(buf []by
 	return offset, nil
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) FastWrite(buf []byte) int {
+func (p *PromptManageServiceListSnippetReferenceResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListSnippetReferenceResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -8664,7 +13416,7 @@ func (p *PromptManageServiceRevertDraftFromCommitResult) FastWriteNocopy(buf []b
 	return offset
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) BLength() int {
+func (p *PromptManageServiceListSnippetReferenceResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -8673,7 +13425,7 @@ func (p *PromptManageServiceRevertDraftFromCommitResult) BLength() int {
 	return l
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptManageServiceListSnippetReferenceResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -8682,7 +13434,7 @@ func (p *PromptManageServiceRevertDraftFromCommitResult) fastWriteField0(buf []b
 	return offset
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) field0Length() int {
+func (p *PromptManageServiceListSnippetReferenceResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -8691,15 +13443,15 @@ func (p *PromptManageServiceRevertDraftFromCommitResult) field0Length() int {
 	return l
 }
 
-func (p *PromptManageServiceRevertDraftFromCommitResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptManageServiceRevertDraftFromCommitResult)
+func (p *PromptManageServiceListSnippetReferenceResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptManageServiceListSnippetReferenceResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *RevertDraftFromCommitResponse
+	var _success *ListSnippetReferenceResponse
 	if src.Success != nil {
-		_success = &RevertDraftFromCommitResponse{}
+		_success = &ListSnippetReferenceResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -8796,3 +13548,51 @@ func (p *PromptManageServiceRevertDraftFromCommitArgs) GetFirstArgument() interf
 func (p *PromptManageServiceRevertDraftFromCommitResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *PromptManageServiceDiffCommitArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+func (p *PromptManageServiceDiffCommitResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *PromptManageServiceSetPromptLabelArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+func (p *PromptManageServiceSetPromptLabelResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *PromptManageServiceListPromptLabelArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+func (p *PromptManageServiceListPromptLabelResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *PromptManageServiceListPromptLabelHistoryArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+func (p *PromptManageServiceListPromptLabelHistoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *PromptManageServiceRollbackPromptLabelArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+func (p *PromptManageServiceRollbackPromptLabelResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *PromptManageServiceListSnippetReferenceArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+func (p *PromptManageServiceListSnippetReferenceResult) GetResult() interface{} {
+	return p.Success
+}
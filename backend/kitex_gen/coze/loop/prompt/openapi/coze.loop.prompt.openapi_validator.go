@@ -42,6 +42,58 @@ func (p *BatchGetPromptByPromptKeyResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *ExecuteRequest) IsValid() error {
+	if p.PromptIdentifier != nil {
+		if err := p.PromptIdentifier.IsValid(); err != nil {
+			return fmt.Errorf("field PromptIdentifier not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExecuteResponse) IsValid() error {
+	if p.Data != nil {
+		if err := p.Data.IsValid(); err != nil {
+			return fmt.Errorf("field Data not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExecuteStreamingResponse) IsValid() error {
+	if p.Data != nil {
+		if err := p.Data.IsValid(); err != nil {
+			return fmt.Errorf("field Data not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExecuteData) IsValid() error {
+	if p.Message != nil {
+		if err := p.Message.IsValid(); err != nil {
+			return fmt.Errorf("field Message not valid, %w", err)
+		}
+	}
+	if p.Usage != nil {
+		if err := p.Usage.IsValid(); err != nil {
+			return fmt.Errorf("field Usage not valid, %w", err)
+		}
+	}
+	return nil
+}
 func (p *PromptResultData) IsValid() error {
 	return nil
 }
@@ -88,6 +140,12 @@ func (p *ToolCallConfig) IsValid() error {
 func (p *Message) IsValid() error {
 	return nil
 }
+func (p *VariableVal) IsValid() error {
+	return nil
+}
+func (p *TokenUsage) IsValid() error {
+	return nil
+}
 func (p *VariableDef) IsValid() error {
 	return nil
 }
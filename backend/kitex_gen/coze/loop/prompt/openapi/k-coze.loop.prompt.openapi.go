@@ -569,7 +569,7 @@ func (p *BatchGetPromptByPromptKeyResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *PromptResultData) FastRead(buf []byte) (int, error) {
+func (p *ExecuteRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -587,7 +587,7 @@ func (p *PromptResultData) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -600,6 +600,62 @@ func (p *PromptResultData) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -613,12 +669,38 @@ func (p *PromptResultData) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptResultData[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExecuteRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptResultData) FastReadField1(buf []byte) (int, error) {
+func (p *ExecuteRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ExecuteRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPromptQuery()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.PromptIdentifier = _field
+	return offset, nil
+}
+
+func (p *ExecuteRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -626,8 +708,8 @@ func (p *PromptResultData) FastReadField1(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*PromptResult_, 0, size)
-	values := make([]PromptResult_, size)
+	_field := make([]*VariableVal, 0, size)
+	values := make([]VariableVal, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -639,40 +721,103 @@ func (p *PromptResultData) FastReadField1(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.Items = _field
+	p.VariableVals = _field
 	return offset, nil
 }
 
-func (p *PromptResultData) FastWrite(buf []byte) int {
+func (p *ExecuteRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*Message, 0, size)
+	values := make([]Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Messages = _field
+	return offset, nil
+}
+
+func (p *ExecuteRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ExecuteRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptResultData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *PromptResultData) BLength() int {
+func (p *ExecuteRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *PromptResultData) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	}
+	return offset
+}
+
+func (p *ExecuteRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptIdentifier() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.PromptIdentifier.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExecuteRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVariableVals() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.Items {
+		for _, v := range p.VariableVals {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -681,12 +826,55 @@ func (p *PromptResultData) fastWriteField1(buf []byte, w thrift.NocopyWriter) in
 	return offset
 }
 
-func (p *PromptResultData) field1Length() int {
+func (p *ExecuteRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMessages() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Messages {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ExecuteRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExecuteRequest) field1Length() int {
 	l := 0
-	if p.IsSetItems() {
+	if p.IsSetWorkspaceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ExecuteRequest) field2Length() int {
+	l := 0
+	if p.IsSetPromptIdentifier() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.PromptIdentifier.BLength()
+	}
+	return l
+}
+
+func (p *ExecuteRequest) field3Length() int {
+	l := 0
+	if p.IsSetVariableVals() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Items {
+		for _, v := range p.VariableVals {
 			_ = v
 			l += v.BLength()
 		}
@@ -694,31 +882,91 @@ func (p *PromptResultData) field1Length() int {
 	return l
 }
 
-func (p *PromptResultData) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptResultData)
+func (p *ExecuteRequest) field4Length() int {
+	l := 0
+	if p.IsSetMessages() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Messages {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ExecuteRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ExecuteRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExecuteRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Items != nil {
-		p.Items = make([]*PromptResult_, 0, len(src.Items))
-		for _, elem := range src.Items {
-			var _elem *PromptResult_
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	var _promptIdentifier *PromptQuery
+	if src.PromptIdentifier != nil {
+		_promptIdentifier = &PromptQuery{}
+		if err := _promptIdentifier.DeepCopy(src.PromptIdentifier); err != nil {
+			return err
+		}
+	}
+	p.PromptIdentifier = _promptIdentifier
+
+	if src.VariableVals != nil {
+		p.VariableVals = make([]*VariableVal, 0, len(src.VariableVals))
+		for _, elem := range src.VariableVals {
+			var _elem *VariableVal
 			if elem != nil {
-				_elem = &PromptResult_{}
+				_elem = &VariableVal{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.Items = append(p.Items, _elem)
+			p.VariableVals = append(p.VariableVals, _elem)
+		}
+	}
+
+	if src.Messages != nil {
+		p.Messages = make([]*Message, 0, len(src.Messages))
+		for _, elem := range src.Messages {
+			var _elem *Message
+			if elem != nil {
+				_elem = &Message{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Messages = append(p.Messages, _elem)
+		}
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
 		}
 	}
+	p.Base = _base
 
 	return nil
 }
 
-func (p *PromptQuery) FastRead(buf []byte) (int, error) {
+func (p *ExecuteResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -736,7 +984,7 @@ func (p *PromptQuery) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -763,7 +1011,35 @@ func (p *PromptQuery) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		default:
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
 			if err != nil {
@@ -776,26 +1052,26 @@ func (p *PromptQuery) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptQuery[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExecuteResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptQuery) FastReadField1(buf []byte) (int, error) {
+func (p *ExecuteResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.PromptKey = _field
+	p.Code = _field
 	return offset, nil
 }
 
-func (p *PromptQuery) FastReadField2(buf []byte) (int, error) {
+func (p *ExecuteResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -805,96 +1081,175 @@ func (p *PromptQuery) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Version = _field
+	p.Msg = _field
 	return offset, nil
 }
 
-func (p *PromptQuery) FastWrite(buf []byte) int {
+func (p *ExecuteResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := NewExecuteData()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Data = _field
+	return offset, nil
+}
+
+func (p *ExecuteResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ExecuteResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptQuery) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *PromptQuery) BLength() int {
+func (p *ExecuteResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *PromptQuery) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPromptKey() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptKey)
+	if p.IsSetCode() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.Code)
 	}
 	return offset
 }
 
-func (p *PromptQuery) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVersion() {
+	if p.IsSetMsg() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Msg)
 	}
 	return offset
 }
 
-func (p *PromptQuery) field1Length() int {
+func (p *ExecuteResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetData() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.Data.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExecuteResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExecuteResponse) field1Length() int {
 	l := 0
-	if p.IsSetPromptKey() {
+	if p.IsSetCode() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.PromptKey)
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *PromptQuery) field2Length() int {
+func (p *ExecuteResponse) field2Length() int {
 	l := 0
-	if p.IsSetVersion() {
+	if p.IsSetMsg() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Version)
+		l += thrift.Binary.StringLengthNocopy(*p.Msg)
 	}
 	return l
 }
 
-func (p *PromptQuery) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptQuery)
+func (p *ExecuteResponse) field3Length() int {
+	l := 0
+	if p.IsSetData() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Data.BLength()
+	}
+	return l
+}
+
+func (p *ExecuteResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *ExecuteResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExecuteResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.PromptKey != nil {
+	if src.Code != nil {
+		tmp := *src.Code
+		p.Code = &tmp
+	}
+
+	if src.Msg != nil {
 		var tmp string
-		if *src.PromptKey != "" {
-			tmp = kutils.StringDeepCopy(*src.PromptKey)
+		if *src.Msg != "" {
+			tmp = kutils.StringDeepCopy(*src.Msg)
 		}
-		p.PromptKey = &tmp
+		p.Msg = &tmp
 	}
 
-	if src.Version != nil {
-		var tmp string
-		if *src.Version != "" {
-			tmp = kutils.StringDeepCopy(*src.Version)
+	var _data *ExecuteData
+	if src.Data != nil {
+		_data = &ExecuteData{}
+		if err := _data.DeepCopy(src.Data); err != nil {
+			return err
+		}
+	}
+	p.Data = _data
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
 		}
-		p.Version = &tmp
 	}
+	p.BaseResp = _baseResp
 
 	return nil
 }
 
-func (p *PromptResult_) FastRead(buf []byte) (int, error) {
+func (p *ExecuteStreamingResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -925,9 +1280,9 @@ func (p *PromptResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
+		case 255:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField2(buf[offset:])
+				l, err = p.FastReadField255(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -952,123 +1307,123 @@ func (p *PromptResult_) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExecuteStreamingResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptResult_) FastReadField1(buf []byte) (int, error) {
+func (p *ExecuteStreamingResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPromptQuery()
+	_field := NewExecuteData()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Query = _field
+	p.Data = _field
 	return offset, nil
 }
 
-func (p *PromptResult_) FastReadField2(buf []byte) (int, error) {
+func (p *ExecuteStreamingResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPrompt()
+	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Prompt = _field
+	p.BaseResp = _field
 	return offset, nil
 }
 
-func (p *PromptResult_) FastWrite(buf []byte) int {
+func (p *ExecuteStreamingResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteStreamingResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *PromptResult_) BLength() int {
+func (p *ExecuteStreamingResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
+		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *PromptResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteStreamingResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetQuery() {
+	if p.IsSetData() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.Query.FastWriteNocopy(buf[offset:], w)
+		offset += p.Data.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *PromptResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteStreamingResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPrompt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.Prompt.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *PromptResult_) field1Length() int {
+func (p *ExecuteStreamingResponse) field1Length() int {
 	l := 0
-	if p.IsSetQuery() {
+	if p.IsSetData() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Query.BLength()
+		l += p.Data.BLength()
 	}
 	return l
 }
 
-func (p *PromptResult_) field2Length() int {
+func (p *ExecuteStreamingResponse) field255Length() int {
 	l := 0
-	if p.IsSetPrompt() {
+	if p.IsSetBaseResp() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Prompt.BLength()
+		l += p.BaseResp.BLength()
 	}
 	return l
 }
 
-func (p *PromptResult_) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptResult_)
+func (p *ExecuteStreamingResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExecuteStreamingResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _query *PromptQuery
-	if src.Query != nil {
-		_query = &PromptQuery{}
-		if err := _query.DeepCopy(src.Query); err != nil {
+	var _data *ExecuteData
+	if src.Data != nil {
+		_data = &ExecuteData{}
+		if err := _data.DeepCopy(src.Data); err != nil {
 			return err
 		}
 	}
-	p.Query = _query
+	p.Data = _data
 
-	var _prompt *Prompt
-	if src.Prompt != nil {
-		_prompt = &Prompt{}
-		if err := _prompt.DeepCopy(src.Prompt); err != nil {
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
 			return err
 		}
 	}
-	p.Prompt = _prompt
+	p.BaseResp = _baseResp
 
 	return nil
 }
 
-func (p *Prompt) FastRead(buf []byte) (int, error) {
+func (p *ExecuteData) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -1086,7 +1441,7 @@ func (p *Prompt) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -1114,22 +1469,8 @@ func (p *Prompt) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField4(buf[offset:])
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -1141,53 +1482,11 @@ func (p *Prompt) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField7(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
 		}
 	}
@@ -1196,40 +1495,24 @@ func (p *Prompt) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Prompt[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExecuteData[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Prompt) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.WorkspaceID = _field
-	return offset, nil
-}
-
-func (p *Prompt) FastReadField2(buf []byte) (int, error) {
+func (p *ExecuteData) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewMessage()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.PromptKey = _field
+	p.Message = _field
 	return offset, nil
 }
 
-func (p *Prompt) FastReadField3(buf []byte) (int, error) {
+func (p *ExecuteData) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -1239,315 +1522,138 @@ func (p *Prompt) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Version = _field
-	return offset, nil
-}
-
-func (p *Prompt) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-	_field := NewPromptTemplate()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.PromptTemplate = _field
-	return offset, nil
-}
-
-func (p *Prompt) FastReadField5(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*Tool, 0, size)
-	values := make([]Tool, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.Tools = _field
-	return offset, nil
-}
-
-func (p *Prompt) FastReadField6(buf []byte) (int, error) {
-	offset := 0
-	_field := NewToolCallConfig()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.ToolCallConfig = _field
+	p.FinishReason = _field
 	return offset, nil
 }
 
-func (p *Prompt) FastReadField7(buf []byte) (int, error) {
+func (p *ExecuteData) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-	_field := NewLLMConfig()
+	_field := NewTokenUsage()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.LlmConfig = _field
+	p.Usage = _field
 	return offset, nil
 }
 
-func (p *Prompt) FastWrite(buf []byte) int {
+func (p *ExecuteData) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *Prompt) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *Prompt) BLength() int {
+func (p *ExecuteData) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *Prompt) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteData) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetWorkspaceID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	if p.IsSetMessage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Message.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *Prompt) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteData) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPromptKey() {
+	if p.IsSetFinishReason() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptKey)
-	}
-	return offset
-}
-
-func (p *Prompt) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
-	}
-	return offset
-}
-
-func (p *Prompt) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPromptTemplate() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
-		offset += p.PromptTemplate.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *Prompt) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTools() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Tools {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *Prompt) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetToolCallConfig() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 6)
-		offset += p.ToolCallConfig.FastWriteNocopy(buf[offset:], w)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FinishReason)
 	}
 	return offset
 }
 
-func (p *Prompt) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExecuteData) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetLlmConfig() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 7)
-		offset += p.LlmConfig.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetUsage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.Usage.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *Prompt) field1Length() int {
-	l := 0
-	if p.IsSetWorkspaceID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *Prompt) field2Length() int {
-	l := 0
-	if p.IsSetPromptKey() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.PromptKey)
-	}
-	return l
-}
-
-func (p *Prompt) field3Length() int {
-	l := 0
-	if p.IsSetVersion() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Version)
-	}
-	return l
-}
-
-func (p *Prompt) field4Length() int {
-	l := 0
-	if p.IsSetPromptTemplate() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.PromptTemplate.BLength()
-	}
-	return l
-}
-
-func (p *Prompt) field5Length() int {
+func (p *ExecuteData) field1Length() int {
 	l := 0
-	if p.IsSetTools() {
+	if p.IsSetMessage() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Tools {
-			_ = v
-			l += v.BLength()
-		}
+		l += p.Message.BLength()
 	}
 	return l
 }
 
-func (p *Prompt) field6Length() int {
+func (p *ExecuteData) field2Length() int {
 	l := 0
-	if p.IsSetToolCallConfig() {
+	if p.IsSetFinishReason() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.ToolCallConfig.BLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FinishReason)
 	}
 	return l
 }
 
-func (p *Prompt) field7Length() int {
+func (p *ExecuteData) field3Length() int {
 	l := 0
-	if p.IsSetLlmConfig() {
+	if p.IsSetUsage() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.LlmConfig.BLength()
+		l += p.Usage.BLength()
 	}
 	return l
 }
 
-func (p *Prompt) DeepCopy(s interface{}) error {
-	src, ok := s.(*Prompt)
+func (p *ExecuteData) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExecuteData)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.WorkspaceID != nil {
-		tmp := *src.WorkspaceID
-		p.WorkspaceID = &tmp
-	}
-
-	if src.PromptKey != nil {
-		var tmp string
-		if *src.PromptKey != "" {
-			tmp = kutils.StringDeepCopy(*src.PromptKey)
+	var _message *Message
+	if src.Message != nil {
+		_message = &Message{}
+		if err := _message.DeepCopy(src.Message); err != nil {
+			return err
 		}
-		p.PromptKey = &tmp
 	}
+	p.Message = _message
 
-	if src.Version != nil {
+	if src.FinishReason != nil {
 		var tmp string
-		if *src.Version != "" {
-			tmp = kutils.StringDeepCopy(*src.Version)
+		if *src.FinishReason != "" {
+			tmp = kutils.StringDeepCopy(*src.FinishReason)
 		}
-		p.Version = &tmp
+		p.FinishReason = &tmp
 	}
 
-	var _promptTemplate *PromptTemplate
-	if src.PromptTemplate != nil {
-		_promptTemplate = &PromptTemplate{}
-		if err := _promptTemplate.DeepCopy(src.PromptTemplate); err != nil {
+	var _usage *TokenUsage
+	if src.Usage != nil {
+		_usage = &TokenUsage{}
+		if err := _usage.DeepCopy(src.Usage); err != nil {
 			return err
 		}
 	}
-	p.PromptTemplate = _promptTemplate
-
-	if src.Tools != nil {
-		p.Tools = make([]*Tool, 0, len(src.Tools))
-		for _, elem := range src.Tools {
-			var _elem *Tool
-			if elem != nil {
-				_elem = &Tool{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Tools = append(p.Tools, _elem)
-		}
-	}
-
-	var _toolCallConfig *ToolCallConfig
-	if src.ToolCallConfig != nil {
-		_toolCallConfig = &ToolCallConfig{}
-		if err := _toolCallConfig.DeepCopy(src.ToolCallConfig); err != nil {
-			return err
-		}
-	}
-	p.ToolCallConfig = _toolCallConfig
-
-	var _llmConfig *LLMConfig
-	if src.LlmConfig != nil {
-		_llmConfig = &LLMConfig{}
-		if err := _llmConfig.DeepCopy(src.LlmConfig); err != nil {
-			return err
-		}
-	}
-	p.LlmConfig = _llmConfig
+	p.Usage = _usage
 
 	return nil
 }
 
-func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
+func (p *PromptResultData) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -1565,36 +1671,8 @@ func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
 			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField3(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -1619,51 +1697,12 @@ func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptTemplate[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptResultData[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptTemplate) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *TemplateType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.TemplateType = _field
-	return offset, nil
-}
-
-func (p *PromptTemplate) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*Message, 0, size)
-	values := make([]Message, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.Messages = _field
-	return offset, nil
-}
-
-func (p *PromptTemplate) FastReadField3(buf []byte) (int, error) {
+func (p *PromptResultData) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -1671,8 +1710,8 @@ func (p *PromptTemplate) FastReadField3(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*VariableDef, 0, size)
-	values := make([]VariableDef, size)
+	_field := make([]*PromptResult_, 0, size)
+	values := make([]PromptResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -1684,69 +1723,40 @@ func (p *PromptTemplate) FastReadField3(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.VariableDefs = _field
+	p.Items = _field
 	return offset, nil
 }
 
-func (p *PromptTemplate) FastWrite(buf []byte) int {
+func (p *PromptResultData) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptTemplate) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptResultData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *PromptTemplate) BLength() int {
+func (p *PromptResultData) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *PromptTemplate) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTemplateType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TemplateType)
-	}
-	return offset
-}
-
-func (p *PromptTemplate) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetMessages() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Messages {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *PromptTemplate) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptResultData) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVariableDefs() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	if p.IsSetItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.VariableDefs {
+		for _, v := range p.Items {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -1755,34 +1765,12 @@ func (p *PromptTemplate) fastWriteField3(buf []byte, w thrift.NocopyWriter) int
 	return offset
 }
 
-func (p *PromptTemplate) field1Length() int {
-	l := 0
-	if p.IsSetTemplateType() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.TemplateType)
-	}
-	return l
-}
-
-func (p *PromptTemplate) field2Length() int {
-	l := 0
-	if p.IsSetMessages() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Messages {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *PromptTemplate) field3Length() int {
+func (p *PromptResultData) field1Length() int {
 	l := 0
-	if p.IsSetVariableDefs() {
+	if p.IsSetItems() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.VariableDefs {
+		for _, v := range p.Items {
 			_ = v
 			l += v.BLength()
 		}
@@ -1790,51 +1778,31 @@ func (p *PromptTemplate) field3Length() int {
 	return l
 }
 
-func (p *PromptTemplate) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptTemplate)
+func (p *PromptResultData) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptResultData)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.TemplateType != nil {
-		tmp := *src.TemplateType
-		p.TemplateType = &tmp
-	}
-
-	if src.Messages != nil {
-		p.Messages = make([]*Message, 0, len(src.Messages))
-		for _, elem := range src.Messages {
-			var _elem *Message
-			if elem != nil {
-				_elem = &Message{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Messages = append(p.Messages, _elem)
-		}
-	}
-
-	if src.VariableDefs != nil {
-		p.VariableDefs = make([]*VariableDef, 0, len(src.VariableDefs))
-		for _, elem := range src.VariableDefs {
-			var _elem *VariableDef
+	if src.Items != nil {
+		p.Items = make([]*PromptResult_, 0, len(src.Items))
+		for _, elem := range src.Items {
+			var _elem *PromptResult_
 			if elem != nil {
-				_elem = &VariableDef{}
+				_elem = &PromptResult_{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.VariableDefs = append(p.VariableDefs, _elem)
+			p.Items = append(p.Items, _elem)
 		}
 	}
 
 	return nil
 }
 
-func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
+func (p *PromptQuery) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -1865,6 +1833,34 @@ func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1878,80 +1874,167 @@ func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCallConfig[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptQuery[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ToolCallConfig) FastReadField1(buf []byte) (int, error) {
+func (p *PromptQuery) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *ToolChoiceType
+	var _field *string
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.ToolChoice = _field
+	p.PromptKey = _field
 	return offset, nil
 }
 
-func (p *ToolCallConfig) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
+func (p *PromptQuery) FastReadField2(buf []byte) (int, error) {
+	offset := 0
 
-func (p *ToolCallConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Version = _field
+	return offset, nil
+}
+
+func (p *PromptQuery) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Label = _field
+	return offset, nil
+}
+
+func (p *PromptQuery) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptQuery) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ToolCallConfig) BLength() int {
+func (p *PromptQuery) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ToolCallConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptQuery) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetToolChoice() {
+	if p.IsSetPromptKey() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ToolChoice)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptKey)
 	}
 	return offset
 }
 
-func (p *ToolCallConfig) field1Length() int {
+func (p *PromptQuery) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
+	}
+	return offset
+}
+
+func (p *PromptQuery) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Label)
+	}
+	return offset
+}
+
+func (p *PromptQuery) field1Length() int {
 	l := 0
-	if p.IsSetToolChoice() {
+	if p.IsSetPromptKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ToolChoice)
+		l += thrift.Binary.StringLengthNocopy(*p.PromptKey)
 	}
 	return l
 }
 
-func (p *ToolCallConfig) DeepCopy(s interface{}) error {
-	src, ok := s.(*ToolCallConfig)
+func (p *PromptQuery) field2Length() int {
+	l := 0
+	if p.IsSetVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Version)
+	}
+	return l
+}
+
+func (p *PromptQuery) field3Length() int {
+	l := 0
+	if p.IsSetLabel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Label)
+	}
+	return l
+}
+
+func (p *PromptQuery) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptQuery)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ToolChoice != nil {
-		tmp := *src.ToolChoice
-		p.ToolChoice = &tmp
+	if src.PromptKey != nil {
+		var tmp string
+		if *src.PromptKey != "" {
+			tmp = kutils.StringDeepCopy(*src.PromptKey)
+		}
+		p.PromptKey = &tmp
+	}
+
+	if src.Version != nil {
+		var tmp string
+		if *src.Version != "" {
+			tmp = kutils.StringDeepCopy(*src.Version)
+		}
+		p.Version = &tmp
+	}
+
+	if src.Label != nil {
+		var tmp string
+		if *src.Label != "" {
+			tmp = kutils.StringDeepCopy(*src.Label)
+		}
+		p.Label = &tmp
 	}
 
 	return nil
 }
 
-func (p *Message) FastRead(buf []byte) (int, error) {
+func (p *PromptResult_) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -1969,7 +2052,7 @@ func (p *Message) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -1983,7 +2066,7 @@ func (p *Message) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2009,44 +2092,40 @@ func (p *Message) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Message[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptResult_[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Message) FastReadField1(buf []byte) (int, error) {
+func (p *PromptResult_) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *Role
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewPromptQuery()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Role = _field
+	p.Query = _field
 	return offset, nil
 }
 
-func (p *Message) FastReadField2(buf []byte) (int, error) {
+func (p *PromptResult_) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewPrompt()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Content = _field
+	p.Prompt = _field
 	return offset, nil
 }
 
-func (p *Message) FastWrite(buf []byte) int {
+func (p *PromptResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *Message) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2056,7 +2135,7 @@ func (p *Message) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
-func (p *Message) BLength() int {
+func (p *PromptResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2066,65 +2145,70 @@ func (p *Message) BLength() int {
 	return l
 }
 
-func (p *Message) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetRole() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Role)
+	if p.IsSetQuery() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Query.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *Message) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetContent() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Content)
+	if p.IsSetPrompt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.Prompt.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *Message) field1Length() int {
+func (p *PromptResult_) field1Length() int {
 	l := 0
-	if p.IsSetRole() {
+	if p.IsSetQuery() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Role)
+		l += p.Query.BLength()
 	}
 	return l
 }
 
-func (p *Message) field2Length() int {
+func (p *PromptResult_) field2Length() int {
 	l := 0
-	if p.IsSetContent() {
+	if p.IsSetPrompt() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Content)
+		l += p.Prompt.BLength()
 	}
 	return l
 }
 
-func (p *Message) DeepCopy(s interface{}) error {
-	src, ok := s.(*Message)
+func (p *PromptResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptResult_)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Role != nil {
-		tmp := *src.Role
-		p.Role = &tmp
+	var _query *PromptQuery
+	if src.Query != nil {
+		_query = &PromptQuery{}
+		if err := _query.DeepCopy(src.Query); err != nil {
+			return err
+		}
 	}
+	p.Query = _query
 
-	if src.Content != nil {
-		var tmp string
-		if *src.Content != "" {
-			tmp = kutils.StringDeepCopy(*src.Content)
+	var _prompt *Prompt
+	if src.Prompt != nil {
+		_prompt = &Prompt{}
+		if err := _prompt.DeepCopy(src.Prompt); err != nil {
+			return err
 		}
-		p.Content = &tmp
 	}
+	p.Prompt = _prompt
 
 	return nil
 }
 
-func (p *VariableDef) FastRead(buf []byte) (int, error) {
+func (p *Prompt) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2142,7 +2226,7 @@ func (p *VariableDef) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2183,6 +2267,62 @@ func (p *VariableDef) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2196,26 +2336,26 @@ func (p *VariableDef) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableDef[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Prompt[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VariableDef) FastReadField1(buf []byte) (int, error) {
+func (p *Prompt) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Key = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *VariableDef) FastReadField2(buf []byte) (int, error) {
+func (p *Prompt) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -2225,141 +2365,335 @@ func (p *VariableDef) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Desc = _field
+	p.PromptKey = _field
 	return offset, nil
 }
 
-func (p *VariableDef) FastReadField3(buf []byte) (int, error) {
+func (p *Prompt) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *VariableType
+	var _field *string
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Type = _field
+	p.Version = _field
 	return offset, nil
 }
 
-func (p *VariableDef) FastWrite(buf []byte) int {
+func (p *Prompt) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPromptTemplate()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.PromptTemplate = _field
+	return offset, nil
+}
+
+func (p *Prompt) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*Tool, 0, size)
+	values := make([]Tool, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Tools = _field
+	return offset, nil
+}
+
+func (p *Prompt) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+	_field := NewToolCallConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ToolCallConfig = _field
+	return offset, nil
+}
+
+func (p *Prompt) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+	_field := NewLLMConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.LlmConfig = _field
+	return offset, nil
+}
+
+func (p *Prompt) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VariableDef) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *Prompt) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *VariableDef) BLength() int {
+func (p *Prompt) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VariableDef) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *Prompt) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetKey() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
 	}
 	return offset
 }
 
-func (p *VariableDef) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *Prompt) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDesc() {
+	if p.IsSetPromptKey() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Desc)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptKey)
 	}
 	return offset
 }
 
-func (p *VariableDef) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *Prompt) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetType() {
+	if p.IsSetVersion() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
 	}
 	return offset
 }
 
-func (p *VariableDef) field1Length() int {
+func (p *Prompt) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptTemplate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.PromptTemplate.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Prompt) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTools() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Tools {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *Prompt) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetToolCallConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 6)
+		offset += p.ToolCallConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Prompt) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLlmConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 7)
+		offset += p.LlmConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Prompt) field1Length() int {
 	l := 0
-	if p.IsSetKey() {
+	if p.IsSetWorkspaceID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Key)
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *VariableDef) field2Length() int {
+func (p *Prompt) field2Length() int {
 	l := 0
-	if p.IsSetDesc() {
+	if p.IsSetPromptKey() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Desc)
+		l += thrift.Binary.StringLengthNocopy(*p.PromptKey)
 	}
 	return l
 }
 
-func (p *VariableDef) field3Length() int {
+func (p *Prompt) field3Length() int {
 	l := 0
-	if p.IsSetType() {
+	if p.IsSetVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Type)
+		l += thrift.Binary.StringLengthNocopy(*p.Version)
 	}
 	return l
 }
 
-func (p *VariableDef) DeepCopy(s interface{}) error {
-	src, ok := s.(*VariableDef)
+func (p *Prompt) field4Length() int {
+	l := 0
+	if p.IsSetPromptTemplate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.PromptTemplate.BLength()
+	}
+	return l
+}
+
+func (p *Prompt) field5Length() int {
+	l := 0
+	if p.IsSetTools() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Tools {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *Prompt) field6Length() int {
+	l := 0
+	if p.IsSetToolCallConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ToolCallConfig.BLength()
+	}
+	return l
+}
+
+func (p *Prompt) field7Length() int {
+	l := 0
+	if p.IsSetLlmConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.LlmConfig.BLength()
+	}
+	return l
+}
+
+func (p *Prompt) DeepCopy(s interface{}) error {
+	src, ok := s.(*Prompt)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Key != nil {
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	if src.PromptKey != nil {
 		var tmp string
-		if *src.Key != "" {
-			tmp = kutils.StringDeepCopy(*src.Key)
+		if *src.PromptKey != "" {
+			tmp = kutils.StringDeepCopy(*src.PromptKey)
 		}
-		p.Key = &tmp
+		p.PromptKey = &tmp
 	}
 
-	if src.Desc != nil {
+	if src.Version != nil {
 		var tmp string
-		if *src.Desc != "" {
-			tmp = kutils.StringDeepCopy(*src.Desc)
+		if *src.Version != "" {
+			tmp = kutils.StringDeepCopy(*src.Version)
 		}
-		p.Desc = &tmp
+		p.Version = &tmp
 	}
 
-	if src.Type != nil {
-		tmp := *src.Type
-		p.Type = &tmp
+	var _promptTemplate *PromptTemplate
+	if src.PromptTemplate != nil {
+		_promptTemplate = &PromptTemplate{}
+		if err := _promptTemplate.DeepCopy(src.PromptTemplate); err != nil {
+			return err
+		}
 	}
+	p.PromptTemplate = _promptTemplate
 
-	return nil
-}
-
-func (p *Tool) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
+	if src.Tools != nil {
+		p.Tools = make([]*Tool, 0, len(src.Tools))
+		for _, elem := range src.Tools {
+			var _elem *Tool
+			if elem != nil {
+				_elem = &Tool{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Tools = append(p.Tools, _elem)
+		}
+	}
+
+	var _toolCallConfig *ToolCallConfig
+	if src.ToolCallConfig != nil {
+		_toolCallConfig = &ToolCallConfig{}
+		if err := _toolCallConfig.DeepCopy(src.ToolCallConfig); err != nil {
+			return err
+		}
+	}
+	p.ToolCallConfig = _toolCallConfig
+
+	var _llmConfig *LLMConfig
+	if src.LlmConfig != nil {
+		_llmConfig = &LLMConfig{}
+		if err := _llmConfig.DeepCopy(src.LlmConfig); err != nil {
+			return err
+		}
+	}
+	p.LlmConfig = _llmConfig
+
+	return nil
+}
+
+func (p *PromptTemplate) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -2385,7 +2719,7 @@ func (p *Tool) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2398,6 +2732,20 @@ func (p *Tool) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2411,121 +2759,222 @@ func (p *Tool) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Tool[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptTemplate[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Tool) FastReadField1(buf []byte) (int, error) {
+func (p *PromptTemplate) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *ToolType
+	var _field *TemplateType
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Type = _field
+	p.TemplateType = _field
 	return offset, nil
 }
 
-func (p *Tool) FastReadField2(buf []byte) (int, error) {
+func (p *PromptTemplate) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFunction()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
 	}
-	p.Function = _field
+	_field := make([]*Message, 0, size)
+	values := make([]Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Messages = _field
 	return offset, nil
 }
 
-func (p *Tool) FastWrite(buf []byte) int {
+func (p *PromptTemplate) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*VariableDef, 0, size)
+	values := make([]VariableDef, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.VariableDefs = _field
+	return offset, nil
+}
+
+func (p *PromptTemplate) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *Tool) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptTemplate) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *Tool) BLength() int {
+func (p *PromptTemplate) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *Tool) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptTemplate) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetType() {
+	if p.IsSetTemplateType() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TemplateType)
 	}
 	return offset
 }
 
-func (p *Tool) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptTemplate) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFunction() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.Function.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetMessages() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Messages {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *Tool) field1Length() int {
+func (p *PromptTemplate) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVariableDefs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.VariableDefs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *PromptTemplate) field1Length() int {
 	l := 0
-	if p.IsSetType() {
+	if p.IsSetTemplateType() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Type)
+		l += thrift.Binary.StringLengthNocopy(*p.TemplateType)
 	}
 	return l
 }
 
-func (p *Tool) field2Length() int {
+func (p *PromptTemplate) field2Length() int {
 	l := 0
-	if p.IsSetFunction() {
+	if p.IsSetMessages() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Function.BLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Messages {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *Tool) DeepCopy(s interface{}) error {
-	src, ok := s.(*Tool)
+func (p *PromptTemplate) field3Length() int {
+	l := 0
+	if p.IsSetVariableDefs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.VariableDefs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *PromptTemplate) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptTemplate)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Type != nil {
-		tmp := *src.Type
-		p.Type = &tmp
+	if src.TemplateType != nil {
+		tmp := *src.TemplateType
+		p.TemplateType = &tmp
 	}
 
-	var _function *Function
-	if src.Function != nil {
-		_function = &Function{}
-		if err := _function.DeepCopy(src.Function); err != nil {
-			return err
+	if src.Messages != nil {
+		p.Messages = make([]*Message, 0, len(src.Messages))
+		for _, elem := range src.Messages {
+			var _elem *Message
+			if elem != nil {
+				_elem = &Message{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Messages = append(p.Messages, _elem)
+		}
+	}
+
+	if src.VariableDefs != nil {
+		p.VariableDefs = make([]*VariableDef, 0, len(src.VariableDefs))
+		for _, elem := range src.VariableDefs {
+			var _elem *VariableDef
+			if elem != nil {
+				_elem = &VariableDef{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.VariableDefs = append(p.VariableDefs, _elem)
 		}
 	}
-	p.Function = _function
 
 	return nil
 }
 
-func (p *Function) FastRead(buf []byte) (int, error) {
+func (p *ToolCallConfig) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2556,23 +3005,126 @@ func (p *Function) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolCallConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ToolCallConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ToolChoiceType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ToolChoice = _field
+	return offset, nil
+}
+
+func (p *ToolCallConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ToolCallConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ToolCallConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ToolCallConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetToolChoice() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ToolChoice)
+	}
+	return offset
+}
+
+func (p *ToolCallConfig) field1Length() int {
+	l := 0
+	if p.IsSetToolChoice() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ToolChoice)
+	}
+	return l
+}
+
+func (p *ToolCallConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*ToolCallConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ToolChoice != nil {
+		tmp := *src.ToolChoice
+		p.ToolChoice = &tmp
+	}
+
+	return nil
+}
+
+func (p *Message) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -2597,40 +3149,26 @@ func (p *Function) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Function[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Message[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Function) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Name = _field
-	return offset, nil
-}
-
-func (p *Function) FastReadField2(buf []byte) (int, error) {
+func (p *Message) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
+	var _field *Role
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Description = _field
+	p.Role = _field
 	return offset, nil
 }
 
-func (p *Function) FastReadField3(buf []byte) (int, error) {
+func (p *Message) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -2640,124 +3178,93 @@ func (p *Function) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Parameters = _field
+	p.Content = _field
 	return offset, nil
 }
 
-func (p *Function) FastWrite(buf []byte) int {
+func (p *Message) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *Function) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *Message) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *Function) BLength() int {
+func (p *Message) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *Function) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *Message) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
+	if p.IsSetRole() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Role)
 	}
 	return offset
 }
 
-func (p *Function) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *Message) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDescription() {
+	if p.IsSetContent() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
-	}
-	return offset
-}
-
-func (p *Function) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetParameters() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Parameters)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Content)
 	}
 	return offset
 }
 
-func (p *Function) field1Length() int {
-	l := 0
-	if p.IsSetName() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
-	}
-	return l
-}
-
-func (p *Function) field2Length() int {
+func (p *Message) field1Length() int {
 	l := 0
-	if p.IsSetDescription() {
+	if p.IsSetRole() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Description)
+		l += thrift.Binary.StringLengthNocopy(*p.Role)
 	}
 	return l
 }
 
-func (p *Function) field3Length() int {
+func (p *Message) field2Length() int {
 	l := 0
-	if p.IsSetParameters() {
+	if p.IsSetContent() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Parameters)
+		l += thrift.Binary.StringLengthNocopy(*p.Content)
 	}
 	return l
 }
 
-func (p *Function) DeepCopy(s interface{}) error {
-	src, ok := s.(*Function)
+func (p *Message) DeepCopy(s interface{}) error {
+	src, ok := s.(*Message)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Name != nil {
-		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
-		}
-		p.Name = &tmp
-	}
-
-	if src.Description != nil {
-		var tmp string
-		if *src.Description != "" {
-			tmp = kutils.StringDeepCopy(*src.Description)
-		}
-		p.Description = &tmp
+	if src.Role != nil {
+		tmp := *src.Role
+		p.Role = &tmp
 	}
 
-	if src.Parameters != nil {
+	if src.Content != nil {
 		var tmp string
-		if *src.Parameters != "" {
-			tmp = kutils.StringDeepCopy(*src.Parameters)
+		if *src.Content != "" {
+			tmp = kutils.StringDeepCopy(*src.Content)
 		}
-		p.Parameters = &tmp
+		p.Content = &tmp
 	}
 
 	return nil
 }
 
-func (p *LLMConfig) FastRead(buf []byte) (int, error) {
+func (p *VariableVal) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2775,7 +3282,7 @@ func (p *LLMConfig) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2789,7 +3296,7 @@ func (p *LLMConfig) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2803,7 +3310,7 @@ func (p *LLMConfig) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2816,37 +3323,228 @@ func (p *LLMConfig) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableVal[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VariableVal) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Key = _field
+	return offset, nil
+}
+
+func (p *VariableVal) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Value = _field
+	return offset, nil
+}
+
+func (p *VariableVal) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*Message, 0, size)
+	values := make([]Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.PlaceholderMessages = _field
+	return offset, nil
+}
+
+func (p *VariableVal) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VariableVal) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VariableVal) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VariableVal) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
+	}
+	return offset
+}
+
+func (p *VariableVal) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetValue() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Value)
+	}
+	return offset
+}
+
+func (p *VariableVal) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPlaceholderMessages() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.PlaceholderMessages {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *VariableVal) field1Length() int {
+	l := 0
+	if p.IsSetKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Key)
+	}
+	return l
+}
+
+func (p *VariableVal) field2Length() int {
+	l := 0
+	if p.IsSetValue() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Value)
+	}
+	return l
+}
+
+func (p *VariableVal) field3Length() int {
+	l := 0
+	if p.IsSetPlaceholderMessages() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.PlaceholderMessages {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *VariableVal) DeepCopy(s interface{}) error {
+	src, ok := s.(*VariableVal)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Key != nil {
+		var tmp string
+		if *src.Key != "" {
+			tmp = kutils.StringDeepCopy(*src.Key)
+		}
+		p.Key = &tmp
+	}
+
+	if src.Value != nil {
+		var tmp string
+		if *src.Value != "" {
+			tmp = kutils.StringDeepCopy(*src.Value)
+		}
+		p.Value = &tmp
+	}
+
+	if src.PlaceholderMessages != nil {
+		p.PlaceholderMessages = make([]*Message, 0, len(src.PlaceholderMessages))
+		for _, elem := range src.PlaceholderMessages {
+			var _elem *Message
+			if elem != nil {
+				_elem = &Message{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField6(buf[offset:])
+
+			p.PlaceholderMessages = append(p.PlaceholderMessages, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *TokenUsage) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -2858,9 +3556,9 @@ func (p *LLMConfig) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 7:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField7(buf[offset:])
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -2885,314 +3583,1655 @@ func (p *LLMConfig) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMConfig[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TokenUsage[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *LLMConfig) FastReadField1(buf []byte) (int, error) {
+func (p *TokenUsage) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Temperature = _field
+	p.InputTokens = _field
 	return offset, nil
 }
 
-func (p *LLMConfig) FastReadField2(buf []byte) (int, error) {
+func (p *TokenUsage) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.MaxTokens = _field
+	p.OutputTokens = _field
 	return offset, nil
 }
 
-func (p *LLMConfig) FastReadField3(buf []byte) (int, error) {
+func (p *TokenUsage) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TokenUsage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+func (p *TokenUsage) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
 	}
-	p.TopK = _field
-	return offset, nil
+	l += thrift.Binary.FieldStopLength()
+	return l
 }
 
-func (p *LLMConfig) FastReadField4(buf []byte) (int, error) {
+func (p *TokenUsage) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+	if p.IsSetInputTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.InputTokens)
 	}
-	p.TopP = _field
-	return offset, nil
+	return offset
 }
 
-func (p *LLMConfig) FastReadField5(buf []byte) (int, error) {
+func (p *TokenUsage) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p.IsSetOutputTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.OutputTokens)
+	}
+	return offset
+}
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+func (p *TokenUsage) field1Length() int {
+	l := 0
+	if p.IsSetInputTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
 	}
-	p.PresencePenalty = _field
-	return offset, nil
+	return l
 }
 
-func (p *LLMConfig) FastReadField6(buf []byte) (int, error) {
+func (p *TokenUsage) field2Length() int {
+	l := 0
+	if p.IsSetOutputTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *TokenUsage) DeepCopy(s interface{}) error {
+	src, ok := s.(*TokenUsage)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.InputTokens != nil {
+		tmp := *src.InputTokens
+		p.InputTokens = &tmp
+	}
+
+	if src.OutputTokens != nil {
+		tmp := *src.OutputTokens
+		p.OutputTokens = &tmp
+	}
+
+	return nil
+}
+
+func (p *VariableDef) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VariableDef[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VariableDef) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.FrequencyPenalty = _field
+	p.Key = _field
 	return offset, nil
 }
 
-func (p *LLMConfig) FastReadField7(buf []byte) (int, error) {
+func (p *VariableDef) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.JSONMode = _field
+	p.Desc = _field
 	return offset, nil
 }
 
-func (p *LLMConfig) FastWrite(buf []byte) int {
+func (p *VariableDef) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *VariableType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Type = _field
+	return offset, nil
+}
+
+func (p *VariableDef) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *LLMConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VariableDef) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *LLMConfig) BLength() int {
+func (p *VariableDef) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VariableDef) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
+	}
+	return offset
+}
+
+func (p *VariableDef) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDesc() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Desc)
+	}
+	return offset
+}
+
+func (p *VariableDef) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	}
+	return offset
+}
+
+func (p *VariableDef) field1Length() int {
+	l := 0
+	if p.IsSetKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Key)
+	}
+	return l
+}
+
+func (p *VariableDef) field2Length() int {
+	l := 0
+	if p.IsSetDesc() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Desc)
+	}
+	return l
+}
+
+func (p *VariableDef) field3Length() int {
+	l := 0
+	if p.IsSetType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Type)
+	}
+	return l
+}
+
+func (p *VariableDef) DeepCopy(s interface{}) error {
+	src, ok := s.(*VariableDef)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Key != nil {
+		var tmp string
+		if *src.Key != "" {
+			tmp = kutils.StringDeepCopy(*src.Key)
+		}
+		p.Key = &tmp
+	}
+
+	if src.Desc != nil {
+		var tmp string
+		if *src.Desc != "" {
+			tmp = kutils.StringDeepCopy(*src.Desc)
+		}
+		p.Desc = &tmp
+	}
+
+	if src.Type != nil {
+		tmp := *src.Type
+		p.Type = &tmp
+	}
+
+	return nil
+}
+
+func (p *Tool) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Tool[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *Tool) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ToolType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Type = _field
+	return offset, nil
+}
+
+func (p *Tool) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewFunction()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Function = _field
+	return offset, nil
+}
+
+func (p *Tool) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *Tool) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *Tool) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *Tool) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	}
+	return offset
+}
+
+func (p *Tool) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFunction() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.Function.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Tool) field1Length() int {
+	l := 0
+	if p.IsSetType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Type)
+	}
+	return l
+}
+
+func (p *Tool) field2Length() int {
+	l := 0
+	if p.IsSetFunction() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Function.BLength()
+	}
+	return l
+}
+
+func (p *Tool) DeepCopy(s interface{}) error {
+	src, ok := s.(*Tool)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Type != nil {
+		tmp := *src.Type
+		p.Type = &tmp
+	}
+
+	var _function *Function
+	if src.Function != nil {
+		_function = &Function{}
+		if err := _function.DeepCopy(src.Function); err != nil {
+			return err
+		}
+	}
+	p.Function = _function
+
+	return nil
+}
+
+func (p *Function) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Function[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *Function) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *Function) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Description = _field
+	return offset, nil
+}
+
+func (p *Function) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Parameters = _field
+	return offset, nil
+}
+
+func (p *Function) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *Function) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *Function) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *Function) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *Function) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	}
+	return offset
+}
+
+func (p *Function) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetParameters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Parameters)
+	}
+	return offset
+}
+
+func (p *Function) field1Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
+	}
+	return l
+}
+
+func (p *Function) field2Length() int {
+	l := 0
+	if p.IsSetDescription() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Description)
+	}
+	return l
+}
+
+func (p *Function) field3Length() int {
+	l := 0
+	if p.IsSetParameters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Parameters)
+	}
+	return l
+}
+
+func (p *Function) DeepCopy(s interface{}) error {
+	src, ok := s.(*Function)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	if src.Description != nil {
+		var tmp string
+		if *src.Description != "" {
+			tmp = kutils.StringDeepCopy(*src.Description)
+		}
+		p.Description = &tmp
+	}
+
+	if src.Parameters != nil {
+		var tmp string
+		if *src.Parameters != "" {
+			tmp = kutils.StringDeepCopy(*src.Parameters)
+		}
+		p.Parameters = &tmp
+	}
+
+	return nil
+}
+
+func (p *LLMConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *LLMConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Temperature = _field
+	return offset, nil
+}
+
+func (p *LLMConfig) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.MaxTokens = _field
+	return offset, nil
+}
+
+func (p *LLMConfig) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TopK = _field
+	return offset, nil
+}
+
+func (p *LLMConfig) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TopP = _field
+	return offset, nil
+}
+
+func (p *LLMConfig) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PresencePenalty = _field
+	return offset, nil
+}
+
+func (p *LLMConfig) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FrequencyPenalty = _field
+	return offset, nil
+}
+
+func (p *LLMConfig) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.JSONMode = _field
+	return offset, nil
+}
+
+func (p *LLMConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *LLMConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *LLMConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *LLMConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTemperature() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 1)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Temperature)
+	}
+	return offset
+}
+
+func (p *LLMConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxTokens)
+	}
+	return offset
+}
+
+func (p *LLMConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTopK() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.TopK)
+	}
+	return offset
+}
+
+func (p *LLMConfig) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTopP() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.TopP)
+	}
+	return offset
+}
+
+func (p *LLMConfig) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPresencePenalty() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.PresencePenalty)
+	}
+	return offset
+}
+
+func (p *LLMConfig) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFrequencyPenalty() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.FrequencyPenalty)
+	}
+	return offset
+}
+
+func (p *LLMConfig) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetJSONMode() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.JSONMode)
+	}
+	return offset
+}
+
+func (p *LLMConfig) field1Length() int {
+	l := 0
+	if p.IsSetTemperature() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *LLMConfig) field2Length() int {
+	l := 0
+	if p.IsSetMaxTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *LLMConfig) field3Length() int {
+	l := 0
+	if p.IsSetTopK() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *LLMConfig) field4Length() int {
+	l := 0
+	if p.IsSetTopP() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *LLMConfig) field5Length() int {
+	l := 0
+	if p.IsSetPresencePenalty() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *LLMConfig) field6Length() int {
+	l := 0
+	if p.IsSetFrequencyPenalty() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *LLMConfig) field7Length() int {
+	l := 0
+	if p.IsSetJSONMode() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *LLMConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*LLMConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Temperature != nil {
+		tmp := *src.Temperature
+		p.Temperature = &tmp
+	}
+
+	if src.MaxTokens != nil {
+		tmp := *src.MaxTokens
+		p.MaxTokens = &tmp
+	}
+
+	if src.TopK != nil {
+		tmp := *src.TopK
+		p.TopK = &tmp
+	}
+
+	if src.TopP != nil {
+		tmp := *src.TopP
+		p.TopP = &tmp
+	}
+
+	if src.PresencePenalty != nil {
+		tmp := *src.PresencePenalty
+		p.PresencePenalty = &tmp
+	}
+
+	if src.FrequencyPenalty != nil {
+		tmp := *src.FrequencyPenalty
+		p.FrequencyPenalty = &tmp
+	}
+
+	if src.JSONMode != nil {
+		tmp := *src.JSONMode
+		p.JSONMode = &tmp
+	}
+
+	return nil
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetPromptByPromptKeyRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *BatchGetPromptByPromptKeyRequest
+	if src.Req != nil {
+		_req = &BatchGetPromptByPromptKeyRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptOpenAPIServiceBatchGetPromptByPromptKeyResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetPromptByPromptKeyResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptOpenAPIServiceBatchGetPromptByPromptKeyResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *BatchGetPromptByPromptKeyResponse
+	if src.Success != nil {
+		_success = &BatchGetPromptByPromptKeyResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *PromptOpenAPIServiceExecuteArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptOpenAPIServiceExecuteArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PromptOpenAPIServiceExecuteArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewExecuteRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *PromptOpenAPIServiceExecuteArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PromptOpenAPIServiceExecuteArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PromptOpenAPIServiceExecuteArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *LLMConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptOpenAPIServiceExecuteArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTemperature() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 1)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Temperature)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *LLMConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetMaxTokens() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxTokens)
-	}
-	return offset
+func (p *PromptOpenAPIServiceExecuteArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
 }
 
-func (p *LLMConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTopK() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.TopK)
+func (p *PromptOpenAPIServiceExecuteArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptOpenAPIServiceExecuteArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	return offset
-}
 
-func (p *LLMConfig) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTopP() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.TopP)
+	var _req *ExecuteRequest
+	if src.Req != nil {
+		_req = &ExecuteRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
 	}
-	return offset
-}
+	p.Req = _req
 
-func (p *LLMConfig) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPresencePenalty() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.PresencePenalty)
-	}
-	return offset
+	return nil
 }
 
-func (p *LLMConfig) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetFrequencyPenalty() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.FrequencyPenalty)
-	}
-	return offset
-}
+func (p *PromptOpenAPIServiceExecuteResult) FastRead(buf []byte) (int, error) {
 
-func (p *LLMConfig) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetJSONMode() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.JSONMode)
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	return offset
-}
 
-func (p *LLMConfig) field1Length() int {
-	l := 0
-	if p.IsSetTemperature() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
-	}
-	return l
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptOpenAPIServiceExecuteResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *LLMConfig) field2Length() int {
-	l := 0
-	if p.IsSetMaxTokens() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+func (p *PromptOpenAPIServiceExecuteResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewExecuteResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	return l
+	p.Success = _field
+	return offset, nil
 }
 
-func (p *LLMConfig) field3Length() int {
-	l := 0
-	if p.IsSetTopK() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
-	return l
+func (p *PromptOpenAPIServiceExecuteResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *LLMConfig) field4Length() int {
-	l := 0
-	if p.IsSetTopP() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+func (p *PromptOpenAPIServiceExecuteResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
-	return l
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
 }
 
-func (p *LLMConfig) field5Length() int {
+func (p *PromptOpenAPIServiceExecuteResult) BLength() int {
 	l := 0
-	if p.IsSetPresencePenalty() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+	if p != nil {
+		l += p.field0Length()
 	}
+	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *LLMConfig) field6Length() int {
-	l := 0
-	if p.IsSetFrequencyPenalty() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+func (p *PromptOpenAPIServiceExecuteResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
-	return l
+	return offset
 }
 
-func (p *LLMConfig) field7Length() int {
+func (p *PromptOpenAPIServiceExecuteResult) field0Length() int {
 	l := 0
-	if p.IsSetJSONMode() {
+	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *LLMConfig) DeepCopy(s interface{}) error {
-	src, ok := s.(*LLMConfig)
+func (p *PromptOpenAPIServiceExecuteResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptOpenAPIServiceExecuteResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Temperature != nil {
-		tmp := *src.Temperature
-		p.Temperature = &tmp
-	}
-
-	if src.MaxTokens != nil {
-		tmp := *src.MaxTokens
-		p.MaxTokens = &tmp
-	}
-
-	if src.TopK != nil {
-		tmp := *src.TopK
-		p.TopK = &tmp
-	}
-
-	if src.TopP != nil {
-		tmp := *src.TopP
-		p.TopP = &tmp
-	}
-
-	if src.PresencePenalty != nil {
-		tmp := *src.PresencePenalty
-		p.PresencePenalty = &tmp
-	}
-
-	if src.FrequencyPenalty != nil {
-		tmp := *src.FrequencyPenalty
-		p.FrequencyPenalty = &tmp
-	}
-
-	if src.JSONMode != nil {
-		tmp := *src.JSONMode
-		p.JSONMode = &tmp
+	var _success *ExecuteResponse
+	if src.Success != nil {
+		_success = &ExecuteResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
 	}
+	p.Success = _success
 
 	return nil
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastRead(buf []byte) (int, error) {
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3236,14 +5275,14 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptOpenAPIServiceExecuteStreamingArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetPromptByPromptKeyRequest()
+	_field := NewExecuteRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3253,11 +5292,11 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastReadField1(buf [
 	return offset, nil
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastWrite(buf []byte) int {
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3266,7 +5305,7 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) BLength() int {
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3275,29 +5314,29 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) BLength() int {
 	return l
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) field1Length() int {
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs)
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptOpenAPIServiceExecuteStreamingArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetPromptByPromptKeyRequest
+	var _req *ExecuteRequest
 	if src.Req != nil {
-		_req = &BatchGetPromptByPromptKeyRequest{}
+		_req = &ExecuteRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -3307,7 +5346,7 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) DeepCopy(s interface
 	return nil
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastRead(buf []byte) (int, error) {
+func (p *PromptOpenAPIServiceExecuteStreamingResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3351,14 +5390,14 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastRead(buf []byt
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptOpenAPIServiceBatchGetPromptByPromptKeyResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PromptOpenAPIServiceExecuteStreamingResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastReadField0(buf []byte) (int, error) {
+func (p *PromptOpenAPIServiceExecuteStreamingResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetPromptByPromptKeyResponse()
+	_field := NewExecuteStreamingResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3368,11 +5407,11 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastReadField0(buf
 	return offset, nil
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastWrite(buf []byte) int {
+func (p *PromptOpenAPIServiceExecuteStreamingResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptOpenAPIServiceExecuteStreamingResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3381,7 +5420,7 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) FastWriteNocopy(bu
 	return offset
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) BLength() int {
+func (p *PromptOpenAPIServiceExecuteStreamingResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3390,7 +5429,7 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) BLength() int {
 	return l
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PromptOpenAPIServiceExecuteStreamingResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3399,7 +5438,7 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) fastWriteField0(bu
 	return offset
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) field0Length() int {
+func (p *PromptOpenAPIServiceExecuteStreamingResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3408,15 +5447,15 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) field0Length() int
 	return l
 }
 
-func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*PromptOpenAPIServiceBatchGetPromptByPromptKeyResult)
+func (p *PromptOpenAPIServiceExecuteStreamingResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*PromptOpenAPIServiceExecuteStreamingResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetPromptByPromptKeyResponse
+	var _success *ExecuteStreamingResponse
 	if src.Success != nil {
-		_success = &BatchGetPromptByPromptKeyResponse{}
+		_success = &ExecuteStreamingResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -3433,3 +5472,19 @@ func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyArgs) GetFirstArgument() i
 func (p *PromptOpenAPIServiceBatchGetPromptByPromptKeyResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *PromptOpenAPIServiceExecuteArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *PromptOpenAPIServiceExecuteResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *PromptOpenAPIServiceExecuteStreamingArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *PromptOpenAPIServiceExecuteStreamingResult) GetResult() interface{} {
+	return p.Success
+}
@@ -22,6 +22,12 @@ type Client interface {
 	ListCommit(ctx context.Context, request *manage.ListCommitRequest, callOptions ...callopt.Option) (r *manage.ListCommitResponse, err error)
 	CommitDraft(ctx context.Context, request *manage.CommitDraftRequest, callOptions ...callopt.Option) (r *manage.CommitDraftResponse, err error)
 	RevertDraftFromCommit(ctx context.Context, request *manage.RevertDraftFromCommitRequest, callOptions ...callopt.Option) (r *manage.RevertDraftFromCommitResponse, err error)
+	DiffCommit(ctx context.Context, request *manage.DiffCommitRequest, callOptions ...callopt.Option) (r *manage.DiffCommitResponse, err error)
+	SetPromptLabel(ctx context.Context, request *manage.SetPromptLabelRequest, callOptions ...callopt.Option) (r *manage.SetPromptLabelResponse, err error)
+	ListPromptLabel(ctx context.Context, request *manage.ListPromptLabelRequest, callOptions ...callopt.Option) (r *manage.ListPromptLabelResponse, err error)
+	ListPromptLabelHistory(ctx context.Context, request *manage.ListPromptLabelHistoryRequest, callOptions ...callopt.Option) (r *manage.ListPromptLabelHistoryResponse, err error)
+	RollbackPromptLabel(ctx context.Context, request *manage.RollbackPromptLabelRequest, callOptions ...callopt.Option) (r *manage.RollbackPromptLabelResponse, err error)
+	ListSnippetReference(ctx context.Context, request *manage.ListSnippetReferenceRequest, callOptions ...callopt.Option) (r *manage.ListSnippetReferenceResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -107,3 +113,33 @@ func (p *kPromptManageServiceClient) RevertDraftFromCommit(ctx context.Context,
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.RevertDraftFromCommit(ctx, request)
 }
+
+func (p *kPromptManageServiceClient) DiffCommit(ctx context.Context, request *manage.DiffCommitRequest, callOptions ...callopt.Option) (r *manage.DiffCommitResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DiffCommit(ctx, request)
+}
+
+func (p *kPromptManageServiceClient) SetPromptLabel(ctx context.Context, request *manage.SetPromptLabelRequest, callOptions ...callopt.Option) (r *manage.SetPromptLabelResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetPromptLabel(ctx, request)
+}
+
+func (p *kPromptManageServiceClient) ListPromptLabel(ctx context.Context, request *manage.ListPromptLabelRequest, callOptions ...callopt.Option) (r *manage.ListPromptLabelResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPromptLabel(ctx, request)
+}
+
+func (p *kPromptManageServiceClient) ListPromptLabelHistory(ctx context.Context, request *manage.ListPromptLabelHistoryRequest, callOptions ...callopt.Option) (r *manage.ListPromptLabelHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPromptLabelHistory(ctx, request)
+}
+
+func (p *kPromptManageServiceClient) RollbackPromptLabel(ctx context.Context, request *manage.RollbackPromptLabelRequest, callOptions ...callopt.Option) (r *manage.RollbackPromptLabelResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RollbackPromptLabel(ctx, request)
+}
+
+func (p *kPromptManageServiceClient) ListSnippetReference(ctx context.Context, request *manage.ListSnippetReferenceRequest, callOptions ...callopt.Option) (r *manage.ListSnippetReferenceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSnippetReference(ctx, request)
+}
@@ -280,6 +280,52 @@ func (l *LocalExperimentService) KillExperiment(ctx context.Context, req *expt.K
 	return result.GetSuccess(), nil
 }
 
+// PauseExperiment
+// PauseExperiment 暂停实验，已提交的数据继续执行，不再提交新的数据
+func (l *LocalExperimentService) PauseExperiment(ctx context.Context, req *expt.PauseExperimentRequest, callOptions ...callopt.Option) (*expt.PauseExperimentResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServicePauseExperimentArgs)
+		result := out.(*expt.ExperimentServicePauseExperimentResult)
+		resp, err := l.impl.PauseExperiment(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServicePauseExperimentArgs{Req: req}
+	result := &expt.ExperimentServicePauseExperimentResult{}
+	ctx = l.injectRPCInfo(ctx, "PauseExperiment")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// ResumeExperiment
+// ResumeExperiment 恢复已暂停的实验，继续执行未完成的数据
+func (l *LocalExperimentService) ResumeExperiment(ctx context.Context, req *expt.ResumeExperimentRequest, callOptions ...callopt.Option) (*expt.ResumeExperimentResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceResumeExperimentArgs)
+		result := out.(*expt.ExperimentServiceResumeExperimentResult)
+		resp, err := l.impl.ResumeExperiment(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceResumeExperimentArgs{Req: req}
+	result := &expt.ExperimentServiceResumeExperimentResult{}
+	ctx = l.injectRPCInfo(ctx, "ResumeExperiment")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 // BatchGetExperimentResult_
 // MGetExperimentResult 获取实验结果
 func (l *LocalExperimentService) BatchGetExperimentResult_(ctx context.Context, req *expt.BatchGetExperimentResultRequest, callOptions ...callopt.Option) (*expt.BatchGetExperimentResultResponse, error) {
@@ -324,6 +370,27 @@ func (l *LocalExperimentService) BatchGetExperimentAggrResult_(ctx context.Conte
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalExperimentService) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest, callOptions ...callopt.Option) (*expt.CompareExperimentsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceCompareExperimentsArgs)
+		result := out.(*expt.ExperimentServiceCompareExperimentsResult)
+		resp, err := l.impl.CompareExperiments(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceCompareExperimentsArgs{Req: req}
+	result := &expt.ExperimentServiceCompareExperimentsResult{}
+	ctx = l.injectRPCInfo(ctx, "CompareExperiments")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 // InvokeExperiment
 // 在线实验
 func (l *LocalExperimentService) InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest, callOptions ...callopt.Option) (*expt.InvokeExperimentResponse, error) {
@@ -563,6 +630,113 @@ func (l *LocalExperimentService) GetExptResultExportRecord(ctx context.Context,
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalExperimentService) CreateExptSchedule(ctx context.Context, req *expt.CreateExptScheduleRequest, callOptions ...callopt.Option) (*expt.CreateExptScheduleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceCreateExptScheduleArgs)
+		result := out.(*expt.ExperimentServiceCreateExptScheduleResult)
+		resp, err := l.impl.CreateExptSchedule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceCreateExptScheduleArgs{Req: req}
+	result := &expt.ExperimentServiceCreateExptScheduleResult{}
+	ctx = l.injectRPCInfo(ctx, "CreateExptSchedule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalExperimentService) UpdateExptSchedule(ctx context.Context, req *expt.UpdateExptScheduleRequest, callOptions ...callopt.Option) (*expt.UpdateExptScheduleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceUpdateExptScheduleArgs)
+		result := out.(*expt.ExperimentServiceUpdateExptScheduleResult)
+		resp, err := l.impl.UpdateExptSchedule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceUpdateExptScheduleArgs{Req: req}
+	result := &expt.ExperimentServiceUpdateExptScheduleResult{}
+	ctx = l.injectRPCInfo(ctx, "UpdateExptSchedule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalExperimentService) DeleteExptSchedule(ctx context.Context, req *expt.DeleteExptScheduleRequest, callOptions ...callopt.Option) (*expt.DeleteExptScheduleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceDeleteExptScheduleArgs)
+		result := out.(*expt.ExperimentServiceDeleteExptScheduleResult)
+		resp, err := l.impl.DeleteExptSchedule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceDeleteExptScheduleArgs{Req: req}
+	result := &expt.ExperimentServiceDeleteExptScheduleResult{}
+	ctx = l.injectRPCInfo(ctx, "DeleteExptSchedule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalExperimentService) ListExptSchedules(ctx context.Context, req *expt.ListExptSchedulesRequest, callOptions ...callopt.Option) (*expt.ListExptSchedulesResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceListExptSchedulesArgs)
+		result := out.(*expt.ExperimentServiceListExptSchedulesResult)
+		resp, err := l.impl.ListExptSchedules(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceListExptSchedulesArgs{Req: req}
+	result := &expt.ExperimentServiceListExptSchedulesResult{}
+	ctx = l.injectRPCInfo(ctx, "ListExptSchedules")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// ListExptScheduleRuns
+// ListExptScheduleRuns 按触发时间倒序返回定时实验的历史触发记录
+func (l *LocalExperimentService) ListExptScheduleRuns(ctx context.Context, req *expt.ListExptScheduleRunsRequest, callOptions ...callopt.Option) (*expt.ListExptScheduleRunsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceListExptScheduleRunsArgs)
+		result := out.(*expt.ExperimentServiceListExptScheduleRunsResult)
+		resp, err := l.impl.ListExptScheduleRuns(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceListExptScheduleRunsArgs{Req: req}
+	result := &expt.ExperimentServiceListExptScheduleRunsResult{}
+	ctx = l.injectRPCInfo(ctx, "ListExptScheduleRuns")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalExperimentService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
@@ -64,6 +64,115 @@ func (l *LocalLLMManageService) GetModel(ctx context.Context, req *manage.GetMod
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalLLMManageService) CreateModel(ctx context.Context, req *manage.CreateModelRequest, callOptions ...callopt.Option) (*manage.CreateModelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceCreateModelArgs)
+		result := out.(*manage.LLMManageServiceCreateModelResult)
+		resp, err := l.impl.CreateModel(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceCreateModelArgs{Req: req}
+	result := &manage.LLMManageServiceCreateModelResult{}
+	ctx = l.injectRPCInfo(ctx, "CreateModel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalLLMManageService) UpdateModel(ctx context.Context, req *manage.UpdateModelRequest, callOptions ...callopt.Option) (*manage.UpdateModelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceUpdateModelArgs)
+		result := out.(*manage.LLMManageServiceUpdateModelResult)
+		resp, err := l.impl.UpdateModel(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceUpdateModelArgs{Req: req}
+	result := &manage.LLMManageServiceUpdateModelResult{}
+	ctx = l.injectRPCInfo(ctx, "UpdateModel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalLLMManageService) DeleteModel(ctx context.Context, req *manage.DeleteModelRequest, callOptions ...callopt.Option) (*manage.DeleteModelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceDeleteModelArgs)
+		result := out.(*manage.LLMManageServiceDeleteModelResult)
+		resp, err := l.impl.DeleteModel(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceDeleteModelArgs{Req: req}
+	result := &manage.LLMManageServiceDeleteModelResult{}
+	ctx = l.injectRPCInfo(ctx, "DeleteModel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// QueryModelUsage
+// 按空间、用户、模型、场景及时间窗口聚合模型调用的 token、成本、错误率与耗时
+func (l *LocalLLMManageService) QueryModelUsage(ctx context.Context, req *manage.QueryModelUsageRequest, callOptions ...callopt.Option) (*manage.QueryModelUsageResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceQueryModelUsageArgs)
+		result := out.(*manage.LLMManageServiceQueryModelUsageResult)
+		resp, err := l.impl.QueryModelUsage(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceQueryModelUsageArgs{Req: req}
+	result := &manage.LLMManageServiceQueryModelUsageResult{}
+	ctx = l.injectRPCInfo(ctx, "QueryModelUsage")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// GetQuotaStatus
+// 查询空间在当前日/月周期内的 token 与成本额度使用情况
+func (l *LocalLLMManageService) GetQuotaStatus(ctx context.Context, req *manage.GetQuotaStatusRequest, callOptions ...callopt.Option) (*manage.GetQuotaStatusResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceGetQuotaStatusArgs)
+		result := out.(*manage.LLMManageServiceGetQuotaStatusResult)
+		resp, err := l.impl.GetQuotaStatus(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceGetQuotaStatusArgs{Req: req}
+	result := &manage.LLMManageServiceGetQuotaStatusResult{}
+	ctx = l.injectRPCInfo(ctx, "GetQuotaStatus")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalLLMManageService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
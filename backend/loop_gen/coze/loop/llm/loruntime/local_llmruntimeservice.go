@@ -73,6 +73,29 @@ func (l *LocalLLMRuntimeService) ChatStream(ctx context.Context, req *runtime.Ch
 	return ls, nil
 }
 
+// Embed
+// 批量向量化接口
+func (l *LocalLLMRuntimeService) Embed(ctx context.Context, req *runtime.EmbedRequest, callOptions ...callopt.Option) (*runtime.EmbedResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*runtime.LLMRuntimeServiceEmbedArgs)
+		result := out.(*runtime.LLMRuntimeServiceEmbedResult)
+		resp, err := l.impl.Embed(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &runtime.LLMRuntimeServiceEmbedArgs{Req: req}
+	result := &runtime.LLMRuntimeServiceEmbedResult{}
+	ctx = l.injectRPCInfo(ctx, "Embed")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalLLMRuntimeService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
@@ -337,6 +337,90 @@ func (l *LocalTraceService) PreviewExportTracesToDataset(ctx context.Context, Re
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalTraceService) CreateOnlineEvalRule(ctx context.Context, req *trace.CreateOnlineEvalRuleRequest, callOptions ...callopt.Option) (*trace.CreateOnlineEvalRuleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceCreateOnlineEvalRuleArgs)
+		result := out.(*trace.TraceServiceCreateOnlineEvalRuleResult)
+		resp, err := l.impl.CreateOnlineEvalRule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceCreateOnlineEvalRuleArgs{Req: req}
+	result := &trace.TraceServiceCreateOnlineEvalRuleResult{}
+	ctx = l.injectRPCInfo(ctx, "CreateOnlineEvalRule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) UpdateOnlineEvalRule(ctx context.Context, req *trace.UpdateOnlineEvalRuleRequest, callOptions ...callopt.Option) (*trace.UpdateOnlineEvalRuleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceUpdateOnlineEvalRuleArgs)
+		result := out.(*trace.TraceServiceUpdateOnlineEvalRuleResult)
+		resp, err := l.impl.UpdateOnlineEvalRule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceUpdateOnlineEvalRuleArgs{Req: req}
+	result := &trace.TraceServiceUpdateOnlineEvalRuleResult{}
+	ctx = l.injectRPCInfo(ctx, "UpdateOnlineEvalRule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) DeleteOnlineEvalRule(ctx context.Context, req *trace.DeleteOnlineEvalRuleRequest, callOptions ...callopt.Option) (*trace.DeleteOnlineEvalRuleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceDeleteOnlineEvalRuleArgs)
+		result := out.(*trace.TraceServiceDeleteOnlineEvalRuleResult)
+		resp, err := l.impl.DeleteOnlineEvalRule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceDeleteOnlineEvalRuleArgs{Req: req}
+	result := &trace.TraceServiceDeleteOnlineEvalRuleResult{}
+	ctx = l.injectRPCInfo(ctx, "DeleteOnlineEvalRule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) ListOnlineEvalRules(ctx context.Context, req *trace.ListOnlineEvalRulesRequest, callOptions ...callopt.Option) (*trace.ListOnlineEvalRulesResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceListOnlineEvalRulesArgs)
+		result := out.(*trace.TraceServiceListOnlineEvalRulesResult)
+		resp, err := l.impl.ListOnlineEvalRules(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceListOnlineEvalRulesArgs{Req: req}
+	result := &trace.TraceServiceListOnlineEvalRulesResult{}
+	ctx = l.injectRPCInfo(ctx, "ListOnlineEvalRules")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalTraceService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
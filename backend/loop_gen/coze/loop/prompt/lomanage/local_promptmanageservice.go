@@ -264,6 +264,136 @@ func (l *LocalPromptManageService) RevertDraftFromCommit(ctx context.Context, re
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalPromptManageService) DiffCommit(ctx context.Context, request *manage.DiffCommitRequest, callOptions ...callopt.Option) (*manage.DiffCommitResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.PromptManageServiceDiffCommitArgs)
+		result := out.(*manage.PromptManageServiceDiffCommitResult)
+		resp, err := l.impl.DiffCommit(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.PromptManageServiceDiffCommitArgs{Request: request}
+	result := &manage.PromptManageServiceDiffCommitResult{}
+	ctx = l.injectRPCInfo(ctx, "DiffCommit")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// SetPromptLabel
+// --------------- Prompt发布标签 --------------- //
+func (l *LocalPromptManageService) SetPromptLabel(ctx context.Context, request *manage.SetPromptLabelRequest, callOptions ...callopt.Option) (*manage.SetPromptLabelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.PromptManageServiceSetPromptLabelArgs)
+		result := out.(*manage.PromptManageServiceSetPromptLabelResult)
+		resp, err := l.impl.SetPromptLabel(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.PromptManageServiceSetPromptLabelArgs{Request: request}
+	result := &manage.PromptManageServiceSetPromptLabelResult{}
+	ctx = l.injectRPCInfo(ctx, "SetPromptLabel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalPromptManageService) ListPromptLabel(ctx context.Context, request *manage.ListPromptLabelRequest, callOptions ...callopt.Option) (*manage.ListPromptLabelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.PromptManageServiceListPromptLabelArgs)
+		result := out.(*manage.PromptManageServiceListPromptLabelResult)
+		resp, err := l.impl.ListPromptLabel(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.PromptManageServiceListPromptLabelArgs{Request: request}
+	result := &manage.PromptManageServiceListPromptLabelResult{}
+	ctx = l.injectRPCInfo(ctx, "ListPromptLabel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalPromptManageService) ListPromptLabelHistory(ctx context.Context, request *manage.ListPromptLabelHistoryRequest, callOptions ...callopt.Option) (*manage.ListPromptLabelHistoryResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.PromptManageServiceListPromptLabelHistoryArgs)
+		result := out.(*manage.PromptManageServiceListPromptLabelHistoryResult)
+		resp, err := l.impl.ListPromptLabelHistory(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.PromptManageServiceListPromptLabelHistoryArgs{Request: request}
+	result := &manage.PromptManageServiceListPromptLabelHistoryResult{}
+	ctx = l.injectRPCInfo(ctx, "ListPromptLabelHistory")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalPromptManageService) RollbackPromptLabel(ctx context.Context, request *manage.RollbackPromptLabelRequest, callOptions ...callopt.Option) (*manage.RollbackPromptLabelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.PromptManageServiceRollbackPromptLabelArgs)
+		result := out.(*manage.PromptManageServiceRollbackPromptLabelResult)
+		resp, err := l.impl.RollbackPromptLabel(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.PromptManageServiceRollbackPromptLabelArgs{Request: request}
+	result := &manage.PromptManageServiceRollbackPromptLabelResult{}
+	ctx = l.injectRPCInfo(ctx, "RollbackPromptLabel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// ListSnippetReference
+// --------------- Prompt片段 --------------- //
+func (l *LocalPromptManageService) ListSnippetReference(ctx context.Context, request *manage.ListSnippetReferenceRequest, callOptions ...callopt.Option) (*manage.ListSnippetReferenceResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.PromptManageServiceListSnippetReferenceArgs)
+		result := out.(*manage.PromptManageServiceListSnippetReferenceResult)
+		resp, err := l.impl.ListSnippetReference(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.PromptManageServiceListSnippetReferenceArgs{Request: request}
+	result := &manage.PromptManageServiceListSnippetReferenceResult{}
+	ctx = l.injectRPCInfo(ctx, "ListSnippetReference")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalPromptManageService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
@@ -4,6 +4,7 @@
 package vfs
 
 import (
+	"io"
 	"io/fs"
 
 	"github.com/parquet-go/parquet-go"
@@ -29,3 +30,110 @@ type pReader struct {
 func (r *pReader) Size() int64 {
 	return r.info.Size()
 }
+
+// ParquetFieldType value type of a flat parquet column.
+type ParquetFieldType int
+
+const (
+	ParquetFieldTypeString ParquetFieldType = iota
+	ParquetFieldTypeDouble
+	ParquetFieldTypeInt64
+	ParquetFieldTypeBool
+)
+
+// ParquetField a flat, optional parquet column.
+type ParquetField struct {
+	Name string
+	Type ParquetFieldType
+}
+
+// ParquetWriter writes rows keyed by field name into a flat parquet file.
+// Missing or nil values are written as null.
+type ParquetWriter struct {
+	w      *parquet.Writer
+	fields []ParquetField
+	// columnIdx parquet sorts group fields by name, so leaf column indexes differ from the field order.
+	columnIdx []int
+}
+
+func NewWriter(w io.Writer, fields []ParquetField) (*ParquetWriter, error) {
+	group := make(parquet.Group, len(fields))
+	for _, f := range fields {
+		if _, ok := group[f.Name]; ok {
+			return nil, errors.Errorf("duplicate parquet field %s", f.Name)
+		}
+		var node parquet.Node
+		switch f.Type {
+		case ParquetFieldTypeString:
+			node = parquet.String()
+		case ParquetFieldTypeDouble:
+			node = parquet.Leaf(parquet.DoubleType)
+		case ParquetFieldTypeInt64:
+			node = parquet.Int(64)
+		case ParquetFieldTypeBool:
+			node = parquet.Leaf(parquet.BooleanType)
+		default:
+			return nil, errors.Errorf("unsupported parquet field type %d", f.Type)
+		}
+		group[f.Name] = parquet.Optional(node)
+	}
+	schema := parquet.NewSchema("row", group)
+	columnIdx := make([]int, len(fields))
+	for i, f := range fields {
+		leaf, ok := schema.Lookup(f.Name)
+		if !ok {
+			return nil, errors.Errorf("parquet field %s not found in schema", f.Name)
+		}
+		columnIdx[i] = leaf.ColumnIndex
+	}
+	return &ParquetWriter{w: parquet.NewWriter(w, schema), fields: fields, columnIdx: columnIdx}, nil
+}
+
+func (w *ParquetWriter) Write(rows ...map[string]any) error {
+	pRows := make([]parquet.Row, 0, len(rows))
+	for _, row := range rows {
+		pRow := make(parquet.Row, len(w.fields))
+		for i, f := range w.fields {
+			v, err := parquetValueOf(f, row[f.Name])
+			if err != nil {
+				return err
+			}
+			pRow[w.columnIdx[i]] = v.Level(0, parquetDefinitionLevel(v), w.columnIdx[i])
+		}
+		pRows = append(pRows, pRow)
+	}
+	_, err := w.w.WriteRows(pRows)
+	return err
+}
+
+func (w *ParquetWriter) Close() error {
+	return w.w.Close()
+}
+
+func parquetDefinitionLevel(v parquet.Value) int {
+	if v.IsNull() {
+		return 0
+	}
+	return 1
+}
+
+func parquetValueOf(f ParquetField, v any) (parquet.Value, error) {
+	if v == nil {
+		return parquet.NullValue(), nil
+	}
+	var ok bool
+	switch f.Type {
+	case ParquetFieldTypeString:
+		_, ok = v.(string)
+	case ParquetFieldTypeDouble:
+		_, ok = v.(float64)
+	case ParquetFieldTypeInt64:
+		_, ok = v.(int64)
+	case ParquetFieldTypeBool:
+		_, ok = v.(bool)
+	}
+	if !ok {
+		return parquet.Value{}, errors.Errorf("parquet field %s got unexpected value type %T", f.Name, v)
+	}
+	return parquet.ValueOf(v), nil
+}
@@ -4,6 +4,7 @@
 package vfs
 
 import (
+	"io"
 	"os"
 	"testing"
 
@@ -98,3 +99,55 @@ func TestNewReader(t *testing.T) {
 		})
 	}
 }
+
+func TestParquetWriter(t *testing.T) {
+	tmpfile, err := os.CreateTemp("", "parquet-writer-*.parquet")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		_ = tmpfile.Close()
+		_ = os.Remove(tmpfile.Name())
+	}()
+
+	fields := []ParquetField{
+		{Name: "name", Type: ParquetFieldTypeString},
+		{Name: "score", Type: ParquetFieldTypeDouble},
+		{Name: "age", Type: ParquetFieldTypeInt64},
+		{Name: "passed", Type: ParquetFieldTypeBool},
+	}
+	w, err := NewWriter(tmpfile, fields)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, w.Write(
+		map[string]any{"name": "Alice", "score": 0.5, "age": int64(25), "passed": true},
+		map[string]any{"name": "Bob", "score": nil},
+	))
+	assert.Error(t, w.Write(map[string]any{"score": "1"}))
+	assert.NoError(t, w.Close())
+
+	_, err = tmpfile.Seek(0, io.SeekStart)
+	if !assert.NoError(t, err) {
+		return
+	}
+	pr := parquet.NewReader(tmpfile)
+	defer func() { _ = pr.Close() }()
+	assert.Equal(t, int64(2), pr.NumRows())
+	rows := make([]parquet.Row, 2)
+	n, _ := pr.ReadRows(rows)
+	assert.Equal(t, 2, n)
+	valueOf := func(row parquet.Row, name string) parquet.Value {
+		leaf, _ := pr.Schema().Lookup(name)
+		return row[leaf.ColumnIndex]
+	}
+	assert.Equal(t, "Alice", valueOf(rows[0], "name").String())
+	assert.Equal(t, 0.5, valueOf(rows[0], "score").Double())
+	assert.Equal(t, int64(25), valueOf(rows[0], "age").Int64())
+	assert.True(t, valueOf(rows[0], "passed").Boolean())
+	assert.Equal(t, "Bob", valueOf(rows[1], "name").String())
+	assert.True(t, valueOf(rows[1], "score").IsNull())
+
+	_, err = NewWriter(tmpfile, []ParquetField{{Name: "a"}, {Name: "a"}})
+	assert.Error(t, err)
+}
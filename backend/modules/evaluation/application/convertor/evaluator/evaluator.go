@@ -29,6 +29,12 @@ func ConvertEvaluatorDTO2DO(evaluatorDTO *evaluatordto.Evaluator) *evaluatordo.E
 		switch evaluatorDTO.GetEvaluatorType() {
 		case evaluatordto.EvaluatorType_Prompt:
 			evaluatorDO.PromptEvaluatorVersion = ConvertPromptEvaluatorVersionDTO2DO(evaluatorDO.ID, evaluatorDO.SpaceID, evaluatorDTO.GetCurrentVersion())
+		case evaluatordto.EvaluatorType_Code:
+			evaluatorDO.CodeEvaluatorVersion = ConvertCodeEvaluatorVersionDTO2DO(evaluatorDO.ID, evaluatorDO.SpaceID, evaluatorDTO.GetCurrentVersion())
+		case evaluatordto.EvaluatorType_Builtin:
+			evaluatorDO.BuiltinEvaluatorVersion = ConvertBuiltinEvaluatorVersionDTO2DO(evaluatorDO.ID, evaluatorDO.SpaceID, evaluatorDTO.GetCurrentVersion())
+		case evaluatordto.EvaluatorType_Pairwise:
+			evaluatorDO.PairwiseEvaluatorVersion = ConvertPairwiseEvaluatorVersionDTO2DO(evaluatorDO.ID, evaluatorDO.SpaceID, evaluatorDTO.GetCurrentVersion())
 		}
 	}
 	return evaluatorDO
@@ -64,6 +70,18 @@ func ConvertEvaluatorDO2DTO(do *evaluatordo.Evaluator) *evaluatordto.Evaluator {
 			versionDTO := ConvertPromptEvaluatorVersionDO2DTO(do.PromptEvaluatorVersion)
 			dto.CurrentVersion = versionDTO
 		}
+	case evaluatordo.EvaluatorTypeCode:
+		if do.CodeEvaluatorVersion != nil {
+			dto.CurrentVersion = ConvertCodeEvaluatorVersionDO2DTO(do.CodeEvaluatorVersion)
+		}
+	case evaluatordo.EvaluatorTypeBuiltin:
+		if do.BuiltinEvaluatorVersion != nil {
+			dto.CurrentVersion = ConvertBuiltinEvaluatorVersionDO2DTO(do.BuiltinEvaluatorVersion)
+		}
+	case evaluatordo.EvaluatorTypePairwise:
+		if do.PairwiseEvaluatorVersion != nil {
+			dto.CurrentVersion = ConvertPairwiseEvaluatorVersionDO2DTO(do.PairwiseEvaluatorVersion)
+		}
 	}
 	return dto
 }
@@ -147,3 +165,183 @@ func ConvertPromptEvaluatorVersionDO2DTO(do *evaluatordo.PromptEvaluatorVersion)
 
 	return dto
 }
+
+func ConvertCodeEvaluatorVersionDTO2DO(evaluatorID, spaceID int64, dto *evaluatordto.EvaluatorVersion) *evaluatordo.CodeEvaluatorVersion {
+	codeEvaluatorVersion := &evaluatordo.CodeEvaluatorVersion{
+		ID:            dto.GetID(),
+		SpaceID:       spaceID,
+		EvaluatorType: evaluatordo.EvaluatorTypeCode,
+		EvaluatorID:   evaluatorID,
+		Description:   dto.GetDescription(),
+		Version:       dto.GetVersion(),
+		BaseInfo:      commonconvertor.ConvertBaseInfoDTO2DO(dto.GetBaseInfo()),
+	}
+	if dto.EvaluatorContent != nil {
+		if len(dto.EvaluatorContent.InputSchemas) > 0 {
+			codeEvaluatorVersion.InputSchemas = make([]*evaluatordo.ArgsSchema, 0, len(dto.EvaluatorContent.InputSchemas))
+			for _, v := range dto.EvaluatorContent.InputSchemas {
+				codeEvaluatorVersion.InputSchemas = append(codeEvaluatorVersion.InputSchemas, commonconvertor.ConvertArgsSchemaDTO2DO(v))
+			}
+		}
+		if dto.EvaluatorContent.CodeEvaluator != nil {
+			codeEvaluatorVersion.LanguageType = evaluatordo.LanguageType(dto.EvaluatorContent.CodeEvaluator.GetLanguageType())
+			codeEvaluatorVersion.CodeContent = dto.EvaluatorContent.CodeEvaluator.GetCode()
+		}
+	}
+	return codeEvaluatorVersion
+}
+
+// ConvertCodeEvaluatorVersionDO2DTO 将 CodeEvaluatorVersion 转换为 evaluatordto.EvaluatorVersion
+func ConvertCodeEvaluatorVersionDO2DTO(do *evaluatordo.CodeEvaluatorVersion) *evaluatordto.EvaluatorVersion {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.EvaluatorVersion{
+		ID:          gptr.Of(do.ID),
+		Version:     gptr.Of(do.Version),
+		Description: gptr.Of(do.Description),
+		BaseInfo:    commonconvertor.ConvertBaseInfoDO2DTO(do.BaseInfo),
+		EvaluatorContent: &evaluatordto.EvaluatorContent{
+			CodeEvaluator: &evaluatordto.CodeEvaluator{
+				LanguageType: evaluatordto.LanguageTypePtr(evaluatordto.LanguageType(do.LanguageType)),
+				Code:         gptr.Of(do.CodeContent),
+			},
+		},
+	}
+	if len(do.InputSchemas) > 0 {
+		dto.EvaluatorContent.InputSchemas = make([]*commondto.ArgsSchema, 0, len(do.InputSchemas))
+		for _, v := range do.InputSchemas {
+			dto.EvaluatorContent.InputSchemas = append(dto.EvaluatorContent.InputSchemas, commonconvertor.ConvertArgsSchemaDO2DTO(v))
+		}
+	}
+	return dto
+}
+
+func ConvertBuiltinEvaluatorVersionDTO2DO(evaluatorID, spaceID int64, dto *evaluatordto.EvaluatorVersion) *evaluatordo.BuiltinEvaluatorVersion {
+	builtinEvaluatorVersion := &evaluatordo.BuiltinEvaluatorVersion{
+		ID:            dto.GetID(),
+		SpaceID:       spaceID,
+		EvaluatorType: evaluatordo.EvaluatorTypeBuiltin,
+		EvaluatorID:   evaluatorID,
+		Description:   dto.GetDescription(),
+		Version:       dto.GetVersion(),
+		BaseInfo:      commonconvertor.ConvertBaseInfoDTO2DO(dto.GetBaseInfo()),
+	}
+	if dto.EvaluatorContent != nil && dto.EvaluatorContent.BuiltinEvaluator != nil {
+		builtinDTO := dto.EvaluatorContent.BuiltinEvaluator
+		builtinEvaluatorVersion.BuiltinType = evaluatordo.BuiltinEvaluatorType(builtinDTO.GetBuiltinEvaluatorType())
+		if builtinDTO.Config != nil {
+			builtinEvaluatorVersion.Config = &evaluatordo.BuiltinEvaluatorConfig{
+				CaseSensitive:     builtinDTO.Config.GetCaseSensitive(),
+				IgnoreWhitespace:  builtinDTO.Config.GetIgnoreWhitespace(),
+				Threshold:         builtinDTO.Config.Threshold,
+				Pattern:           builtinDTO.Config.GetPattern(),
+				FullMatch:         builtinDTO.Config.GetFullMatch(),
+				JSONSchema:        builtinDTO.Config.GetJSONSchema(),
+				Tolerance:         builtinDTO.Config.GetTolerance(),
+				RelativeTolerance: builtinDTO.Config.GetRelativeTolerance(),
+				MaxNGram:          builtinDTO.Config.GetMaxNgram(),
+			}
+		}
+	}
+	// 内置评估器的输入字段固定，不使用请求中的 schema
+	builtinEvaluatorVersion.InputSchemas = builtinEvaluatorVersion.BuiltinType.DefaultInputSchemas()
+	return builtinEvaluatorVersion
+}
+
+// ConvertBuiltinEvaluatorVersionDO2DTO 将 BuiltinEvaluatorVersion 转换为 evaluatordto.EvaluatorVersion
+func ConvertBuiltinEvaluatorVersionDO2DTO(do *evaluatordo.BuiltinEvaluatorVersion) *evaluatordto.EvaluatorVersion {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.EvaluatorVersion{
+		ID:          gptr.Of(do.ID),
+		Version:     gptr.Of(do.Version),
+		Description: gptr.Of(do.Description),
+		BaseInfo:    commonconvertor.ConvertBaseInfoDO2DTO(do.BaseInfo),
+		EvaluatorContent: &evaluatordto.EvaluatorContent{
+			BuiltinEvaluator: &evaluatordto.BuiltinEvaluator{
+				BuiltinEvaluatorType: evaluatordto.BuiltinEvaluatorTypePtr(evaluatordto.BuiltinEvaluatorType(do.BuiltinType)),
+			},
+		},
+	}
+	if do.Config != nil {
+		dto.EvaluatorContent.BuiltinEvaluator.Config = &evaluatordto.BuiltinEvaluatorConfig{
+			CaseSensitive:     gptr.Of(do.Config.CaseSensitive),
+			IgnoreWhitespace:  gptr.Of(do.Config.IgnoreWhitespace),
+			Threshold:         do.Config.Threshold,
+			Pattern:           gptr.Of(do.Config.Pattern),
+			FullMatch:         gptr.Of(do.Config.FullMatch),
+			JSONSchema:        gptr.Of(do.Config.JSONSchema),
+			Tolerance:         gptr.Of(do.Config.Tolerance),
+			RelativeTolerance: gptr.Of(do.Config.RelativeTolerance),
+			MaxNgram:          gptr.Of(do.Config.MaxNGram),
+		}
+	}
+	if len(do.InputSchemas) > 0 {
+		dto.EvaluatorContent.InputSchemas = make([]*commondto.ArgsSchema, 0, len(do.InputSchemas))
+		for _, v := range do.InputSchemas {
+			dto.EvaluatorContent.InputSchemas = append(dto.EvaluatorContent.InputSchemas, commonconvertor.ConvertArgsSchemaDO2DTO(v))
+		}
+	}
+	return dto
+}
+
+func ConvertPairwiseEvaluatorVersionDTO2DO(evaluatorID, spaceID int64, dto *evaluatordto.EvaluatorVersion) *evaluatordo.PairwiseEvaluatorVersion {
+	pairwiseEvaluatorVersion := &evaluatordo.PairwiseEvaluatorVersion{
+		ID:            dto.GetID(),
+		SpaceID:       spaceID,
+		EvaluatorType: evaluatordo.EvaluatorTypePairwise,
+		EvaluatorID:   evaluatorID,
+		Description:   dto.GetDescription(),
+		Version:       dto.GetVersion(),
+		BaseInfo:      commonconvertor.ConvertBaseInfoDTO2DO(dto.GetBaseInfo()),
+	}
+	if dto.EvaluatorContent != nil {
+		for _, v := range dto.EvaluatorContent.InputSchemas {
+			pairwiseEvaluatorVersion.InputSchemas = append(pairwiseEvaluatorVersion.InputSchemas, commonconvertor.ConvertArgsSchemaDTO2DO(v))
+		}
+		if pairwiseDTO := dto.EvaluatorContent.PairwiseEvaluator; pairwiseDTO != nil {
+			pairwiseEvaluatorVersion.MessageList = make([]*evaluatordo.Message, 0, len(pairwiseDTO.GetMessageList()))
+			for _, originMessage := range pairwiseDTO.GetMessageList() {
+				pairwiseEvaluatorVersion.MessageList = append(pairwiseEvaluatorVersion.MessageList, commonconvertor.ConvertMessageDTO2DO(originMessage))
+			}
+			pairwiseEvaluatorVersion.ModelConfig = commonconvertor.ConvertModelConfigDTO2DO(pairwiseDTO.ModelConfig)
+			pairwiseEvaluatorVersion.SwapCheck = pairwiseDTO.GetSwapCheck()
+		}
+	}
+	pairwiseEvaluatorVersion.InputSchemas = evaluatordo.EnsurePairwiseInputSchemas(pairwiseEvaluatorVersion.InputSchemas)
+	return pairwiseEvaluatorVersion
+}
+
+// ConvertPairwiseEvaluatorVersionDO2DTO 将 PairwiseEvaluatorVersion 转换为 evaluatordto.EvaluatorVersion
+func ConvertPairwiseEvaluatorVersionDO2DTO(do *evaluatordo.PairwiseEvaluatorVersion) *evaluatordto.EvaluatorVersion {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.EvaluatorVersion{
+		ID:          gptr.Of(do.ID),
+		Version:     gptr.Of(do.Version),
+		Description: gptr.Of(do.Description),
+		BaseInfo:    commonconvertor.ConvertBaseInfoDO2DTO(do.BaseInfo),
+		EvaluatorContent: &evaluatordto.EvaluatorContent{
+			PairwiseEvaluator: &evaluatordto.PairwiseEvaluator{
+				ModelConfig: commonconvertor.ConvertModelConfigDO2DTO(do.ModelConfig),
+				SwapCheck:   gptr.Of(do.SwapCheck),
+			},
+		},
+	}
+	if len(do.InputSchemas) > 0 {
+		dto.EvaluatorContent.InputSchemas = make([]*commondto.ArgsSchema, 0, len(do.InputSchemas))
+		for _, v := range do.InputSchemas {
+			dto.EvaluatorContent.InputSchemas = append(dto.EvaluatorContent.InputSchemas, commonconvertor.ConvertArgsSchemaDO2DTO(v))
+		}
+	}
+	if len(do.MessageList) > 0 {
+		dto.EvaluatorContent.PairwiseEvaluator.MessageList = make([]*commondto.Message, 0, len(do.MessageList))
+		for _, v := range do.MessageList {
+			dto.EvaluatorContent.PairwiseEvaluator.MessageList = append(dto.EvaluatorContent.PairwiseEvaluator.MessageList, commonconvertor.ConvertMessageDO2DTO(v))
+		}
+	}
+	return dto
+}
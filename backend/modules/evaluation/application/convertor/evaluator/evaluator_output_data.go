@@ -65,11 +65,15 @@ func ConvertEvaluatorResultDTO2DO(dto *evaluatordto.EvaluatorResult_) *evaluator
 	if dto == nil {
 		return nil
 	}
-	return &evaluatorentity.EvaluatorResult{
+	result := &evaluatorentity.EvaluatorResult{
 		Score:      dto.Score,
 		Correction: ConvertCorrectionDTO2DO(dto.Correction),
 		Reasoning:  dto.GetReasoning(),
 	}
+	if dto.Preference != nil {
+		result.Preference = gptr.Of(evaluatorentity.PairwisePreference(*dto.Preference))
+	}
+	return result
 }
 
 // ConvertEvaluatorResultDO2DTO 将 evaluatorentity.EvaluatorResult 结构体转换为 DTO
@@ -77,11 +81,15 @@ func ConvertEvaluatorResultDO2DTO(do *evaluatorentity.EvaluatorResult) *evaluato
 	if do == nil {
 		return nil
 	}
-	return &evaluatordto.EvaluatorResult_{
+	result := &evaluatordto.EvaluatorResult_{
 		Score:      do.Score,
 		Correction: ConvertCorrectionDO2DTO(do.Correction),
 		Reasoning:  gptr.Of(do.Reasoning),
 	}
+	if do.Preference != nil {
+		result.Preference = evaluatordto.PairwisePreferencePtr(evaluatordto.PairwisePreference(*do.Preference))
+	}
+	return result
 }
 
 // ConvertEvaluatorUsageDTO2DO 将 DTO 转换为 evaluatorentity.EvaluatorUsage 结构体
@@ -31,6 +31,19 @@ func ExptAggregateResultDOToDTO(data *entity.ExptAggregateResult) *domain_expt.E
 		EvaluatorResults:  evaluatorResults,
 		Status:            domain_expt.ExptAggregateCalculateStatusPtr(domain_expt.ExptAggregateCalculateStatus(data.Status)),
 		AnnotationResults: annotationResults,
+		TargetResult_:     TargetAggregateResultDOToDTO(data.TargetResult),
+	}
+}
+
+func TargetAggregateResultDOToDTO(result *entity.TargetAggregateResult) *domain_expt.TargetAggregateResult_ {
+	if result == nil {
+		return nil
+	}
+
+	return &domain_expt.TargetAggregateResult_{
+		LatencyMs:    AggregatorResultDOsToDTOs(result.LatencyMS),
+		InputTokens:  AggregatorResultDOsToDTOs(result.InputTokens),
+		OutputTokens: AggregatorResultDOsToDTOs(result.OutputTokens),
 	}
 }
 
@@ -30,6 +30,7 @@ type EvalConfConvert struct{}
 func (e *EvalConfConvert) ConvertToEntity(cer *expt.CreateExperimentRequest) (*entity.EvaluationConfiguration, error) {
 	ec := &entity.EvaluationConfiguration{
 		ItemConcurNum: ptr.ConvIntPtr[int32, int](cer.ItemConcurNum),
+		Budget:        ToExptBudgetDO(cer.GetBudget()),
 	}
 	if cer.GetTargetFieldMapping() != nil && cer.GetTargetFieldMapping().GetFromEvalSet() != nil {
 		ec.ConnectorConf.TargetConf = &entity.TargetConf{
@@ -98,13 +99,30 @@ func toEvaluatorFieldMappingDo(mapping []*domain_expt.EvaluatorFieldMapping) []*
 				Value:     ft.GetConstValue(),
 			})
 		}
-		ec = append(ec, &entity.EvaluatorConf{
+		conf := &entity.EvaluatorConf{
 			EvaluatorVersionID: fm.GetEvaluatorVersionID(),
 			IngressConf: &entity.EvaluatorIngressConf{
 				EvalSetAdapter: &entity.FieldAdapter{FieldConfs: esf},
 				TargetAdapter:  &entity.FieldAdapter{FieldConfs: tf},
 			},
-		})
+			BaselineExptID: fm.GetBaselineExptID(),
+			PassThreshold:  fm.PassThreshold,
+		}
+		for _, t := range fm.GetAggregatorTypes() {
+			conf.AggregatorTypes = append(conf.AggregatorTypes, entity.AggregatorType(t))
+		}
+		if len(fm.GetFromBaselineTarget()) > 0 {
+			btf := make([]*entity.FieldConf, 0, len(fm.GetFromBaselineTarget()))
+			for _, fbt := range fm.GetFromBaselineTarget() {
+				btf = append(btf, &entity.FieldConf{
+					FieldName: fbt.GetFieldName(),
+					FromField: fbt.GetFromFieldName(),
+					Value:     fbt.GetConstValue(),
+				})
+			}
+			conf.IngressConf.BaselineTargetAdapter = &entity.FieldAdapter{FieldConfs: btf}
+		}
+		ec = append(ec, conf)
 	}
 	return ec
 }
@@ -141,6 +159,22 @@ func (e *EvalConfConvert) ConvertEntityToDTO(ec *entity.EvaluationConfiguration)
 					})
 				}
 			}
+			if evaluatorConf.IngressConf.BaselineTargetAdapter != nil {
+				for _, fc := range evaluatorConf.IngressConf.BaselineTargetAdapter.FieldConfs {
+					m.FromBaselineTarget = append(m.FromBaselineTarget, &domain_expt.FieldMapping{
+						FieldName:     gptr.Of(fc.FieldName),
+						FromFieldName: gptr.Of(fc.FromField),
+						ConstValue:    gptr.Of(fc.Value),
+					})
+				}
+			}
+			if evaluatorConf.BaselineExptID > 0 {
+				m.BaselineExptID = gptr.Of(evaluatorConf.BaselineExptID)
+			}
+			for _, t := range evaluatorConf.AggregatorTypes {
+				m.AggregatorTypes = append(m.AggregatorTypes, domain_expt.AggregatorType(t))
+			}
+			m.PassThreshold = evaluatorConf.PassThreshold
 			evaluatorMappings = append(evaluatorMappings, m)
 		}
 	}
@@ -180,6 +214,24 @@ func ToExptStatsInfoDTO(experiment *entity.Experiment, stats *entity.ExptStats)
 	}
 }
 
+func ToExptBudgetDO(budget *domain_expt.ExptBudget) *entity.ExptBudget {
+	if budget == nil {
+		return nil
+	}
+	return &entity.ExptBudget{
+		MaxTokens: budget.MaxTokens,
+	}
+}
+
+func ToExptBudgetDTO(budget *entity.ExptBudget) *domain_expt.ExptBudget {
+	if budget == nil {
+		return nil
+	}
+	return &domain_expt.ExptBudget{
+		MaxTokens: budget.MaxTokens,
+	}
+}
+
 func ToExptDTOs(experiments []*entity.Experiment) []*domain_expt.Experiment {
 	dtos := make([]*domain_expt.Experiment, 0, len(experiments))
 	for _, experiment := range experiments {
@@ -228,6 +280,9 @@ func ToExptDTO(experiment *entity.Experiment) *domain_expt.Experiment {
 	if experiment.EvalConf != nil && experiment.EvalConf.ItemConcurNum != nil {
 		res.ItemConcurNum = gptr.Of(int32(gptr.Indirect(experiment.EvalConf.ItemConcurNum)))
 	}
+	if experiment.EvalConf != nil {
+		res.Budget = ToExptBudgetDTO(experiment.EvalConf.Budget)
+	}
 
 	res.EvalTarget = target.EvalTargetDO2DTO(experiment.Target)
 	if experiment.ExptType != entity.ExptType_Online {
@@ -276,6 +331,8 @@ func CreateEvalTargetParamDTO2DO(param *eval_target.CreateEvalTargetParam) *enti
 		SourceTargetID:      param.SourceTargetID,
 		SourceTargetVersion: param.SourceTargetVersion,
 		BotPublishVersion:   param.BotPublishVersion,
+		HTTPTarget:          target.HTTPTargetDTO2DO(param.HTTPTarget),
+		PromptLabel:         param.PromptLabel,
 	}
 	if param.EvalTargetType != nil {
 		res.EvalTargetType = gptr.Of(entity.EvalTargetType(*param.EvalTargetType))
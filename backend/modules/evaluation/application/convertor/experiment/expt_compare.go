@@ -0,0 +1,81 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func ConvertCompareReq(req *expt.CompareExperimentsRequest) *entity.ExptCompareParam {
+	return &entity.ExptCompareParam{
+		SpaceID:             req.GetWorkspaceID(),
+		ExptID:              req.GetExptID(),
+		BaselineExptID:      req.GetBaselineExptID(),
+		EvaluatorVersionIDs: req.GetEvaluatorVersionIds(),
+		ConfidenceLevel:     req.GetConfidenceLevel(),
+		BootstrapIterations: int(req.GetBootstrapIterations()),
+		RegressionThreshold: req.GetRegressionThreshold(),
+	}
+}
+
+func EvaluatorCompareResultDOsToDTOs(results []*entity.EvaluatorCompareResult) []*domain_expt.EvaluatorCompareResult_ {
+	dtos := make([]*domain_expt.EvaluatorCompareResult_, 0, len(results))
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		dtos = append(dtos, EvaluatorCompareResultDOToDTO(result))
+	}
+	return dtos
+}
+
+func EvaluatorCompareResultDOToDTO(result *entity.EvaluatorCompareResult) *domain_expt.EvaluatorCompareResult_ {
+	if result == nil {
+		return nil
+	}
+	regressions := make([]*domain_expt.ItemScoreDiff, 0, len(result.Regressions))
+	for _, diff := range result.Regressions {
+		regressions = append(regressions, &domain_expt.ItemScoreDiff{
+			ItemID:        diff.ItemID,
+			TurnID:        gptr.Of(diff.TurnID),
+			BaselineScore: gptr.Of(diff.BaselineScore),
+			Score:         gptr.Of(diff.Score),
+			Diff:          gptr.Of(diff.Diff),
+		})
+	}
+	dto := &domain_expt.EvaluatorCompareResult_{
+		EvaluatorVersionID:          result.EvaluatorVersionID,
+		PairedCount:                 gptr.Of(int32(result.PairedCount)),
+		BaselineMean:                gptr.Of(result.BaselineMean),
+		Mean:                        gptr.Of(result.Mean),
+		MeanDiff:                    gptr.Of(result.MeanDiff),
+		BootstrapConfidenceInterval: ConfidenceIntervalDOToDTO(result.BootstrapConfidenceInterval),
+		Significant:                 gptr.Of(result.Significant),
+		Regressions:                 regressions,
+	}
+	if result.TTest != nil {
+		dto.TTest = &domain_expt.PairedTTestResult_{
+			TStatistic:         result.TTest.TStatistic,
+			PValue:             gptr.Of(result.TTest.PValue),
+			DegreesOfFreedom:   gptr.Of(int32(result.TTest.DegreesOfFreedom)),
+			ConfidenceInterval: ConfidenceIntervalDOToDTO(result.TTest.ConfidenceInterval),
+		}
+	}
+	return dto
+}
+
+func ConfidenceIntervalDOToDTO(ci *entity.ConfidenceInterval) *domain_expt.ConfidenceInterval {
+	if ci == nil {
+		return nil
+	}
+	return &domain_expt.ConfidenceInterval{
+		Lower:           gptr.Of(ci.Lower),
+		Upper:           gptr.Of(ci.Upper),
+		ConfidenceLevel: gptr.Of(ci.ConfidenceLevel),
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestConvertCompareReq(t *testing.T) {
+	param := ConvertCompareReq(&expt.CompareExperimentsRequest{
+		WorkspaceID:         1,
+		ExptID:              2,
+		BaselineExptID:      3,
+		EvaluatorVersionIds: []int64{4},
+		BootstrapIterations: gptr.Of(int32(500)),
+	})
+	assert.Equal(t, &entity.ExptCompareParam{
+		SpaceID:             1,
+		ExptID:              2,
+		BaselineExptID:      3,
+		EvaluatorVersionIDs: []int64{4},
+		BootstrapIterations: 500,
+	}, param)
+}
+
+func TestEvaluatorCompareResultDOsToDTOs(t *testing.T) {
+	dtos := EvaluatorCompareResultDOsToDTOs([]*entity.EvaluatorCompareResult{
+		{
+			EvaluatorVersionID: 1,
+			PairedCount:        2,
+			MeanDiff:           -0.3,
+			TTest: &entity.PairedTTestResult{
+				PValue:             0.2,
+				DegreesOfFreedom:   1,
+				ConfidenceInterval: &entity.ConfidenceInterval{Lower: -1, Upper: 0.4, ConfidenceLevel: 0.95},
+			},
+			Regressions: []*entity.ItemScoreDiff{{ItemID: 10, TurnID: 1, BaselineScore: 1, Score: 0.4, Diff: -0.6}},
+		},
+		nil,
+		{EvaluatorVersionID: 2},
+	})
+	assert.Len(t, dtos, 2)
+	assert.Equal(t, int32(2), dtos[0].GetPairedCount())
+	assert.Nil(t, dtos[0].TTest.TStatistic)
+	assert.Equal(t, int32(1), dtos[0].TTest.GetDegreesOfFreedom())
+	assert.Equal(t, -1.0, dtos[0].TTest.ConfidenceInterval.GetLower())
+	assert.Nil(t, dtos[0].BootstrapConfidenceInterval)
+	assert.Equal(t, int64(10), dtos[0].Regressions[0].ItemID)
+	assert.Equal(t, -0.6, dtos[0].Regressions[0].GetDiff())
+	assert.Nil(t, dtos[1].TTest)
+}
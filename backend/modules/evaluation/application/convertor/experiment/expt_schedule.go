@@ -0,0 +1,132 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_common "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func ConvertCreateExptScheduleReq(req *expt.CreateExptScheduleRequest) *entity.ExptSchedule {
+	return &entity.ExptSchedule{
+		SpaceID:                 req.GetWorkspaceID(),
+		Name:                    req.GetName(),
+		Description:             req.GetDesc(),
+		TemplateExptID:          req.GetTemplateExptID(),
+		CronExpr:                req.GetCronExpr(),
+		Timezone:                req.GetTimezone(),
+		UseLatestTargetVersion:  req.GetUseLatestTargetVersion(),
+		UseLatestEvalSetVersion: req.GetUseLatestEvalSetVersion(),
+		Enabled:                 !req.IsSetEnabled() || req.GetEnabled(),
+	}
+}
+
+// ApplyUpdateExptScheduleReq 仅覆盖请求中显式设置的字段
+func ApplyUpdateExptScheduleReq(schedule *entity.ExptSchedule, req *expt.UpdateExptScheduleRequest) {
+	if req.IsSetName() {
+		schedule.Name = req.GetName()
+	}
+	if req.IsSetDesc() {
+		schedule.Description = req.GetDesc()
+	}
+	if req.IsSetCronExpr() {
+		schedule.CronExpr = req.GetCronExpr()
+	}
+	if req.IsSetTimezone() {
+		schedule.Timezone = req.GetTimezone()
+	}
+	if req.IsSetUseLatestTargetVersion() {
+		schedule.UseLatestTargetVersion = req.GetUseLatestTargetVersion()
+	}
+	if req.IsSetUseLatestEvalSetVersion() {
+		schedule.UseLatestEvalSetVersion = req.GetUseLatestEvalSetVersion()
+	}
+	if req.IsSetEnabled() {
+		schedule.Enabled = req.GetEnabled()
+	}
+}
+
+func ExptScheduleDO2DTO(from *entity.ExptSchedule) *domain_expt.ExptSchedule {
+	if from == nil {
+		return nil
+	}
+	dto := &domain_expt.ExptSchedule{
+		ID:                      gptr.Of(from.ID),
+		WorkspaceID:             gptr.Of(from.SpaceID),
+		Name:                    gptr.Of(from.Name),
+		Desc:                    gptr.Of(from.Description),
+		TemplateExptID:          gptr.Of(from.TemplateExptID),
+		CronExpr:                gptr.Of(from.CronExpr),
+		Timezone:                gptr.Of(from.Timezone),
+		UseLatestTargetVersion:  gptr.Of(from.UseLatestTargetVersion),
+		UseLatestEvalSetVersion: gptr.Of(from.UseLatestEvalSetVersion),
+		Enabled:                 gptr.Of(from.Enabled),
+		BaseInfo: &domain_common.BaseInfo{
+			CreatedBy: &domain_common.UserInfo{
+				UserID: gptr.Of(from.CreatedBy),
+			},
+			UpdatedBy: &domain_common.UserInfo{
+				UserID: gptr.Of(from.UpdatedBy),
+			},
+			CreatedAt: gptr.Of(from.CreatedAt.UnixMilli()),
+			UpdatedAt: gptr.Of(from.UpdatedAt.UnixMilli()),
+		},
+	}
+	if from.NextTriggerAt != nil {
+		dto.NextTriggerTime = gptr.Of(from.NextTriggerAt.Unix())
+	}
+	if from.LastTriggerAt != nil {
+		dto.LastTriggerTime = gptr.Of(from.LastTriggerAt.Unix())
+	}
+	return dto
+}
+
+func ExptScheduleDOs2DTOs(from []*entity.ExptSchedule) []*domain_expt.ExptSchedule {
+	dtos := make([]*domain_expt.ExptSchedule, 0, len(from))
+	for _, schedule := range from {
+		dtos = append(dtos, ExptScheduleDO2DTO(schedule))
+	}
+	return dtos
+}
+
+func ExptScheduleRunStatusDO2DTO(status entity.ExptScheduleRunStatus) domain_expt.ExptScheduleRunStatus {
+	switch status {
+	case entity.ExptScheduleRunStatus_Success:
+		return domain_expt.ExptScheduleRunStatusSuccess
+	case entity.ExptScheduleRunStatus_Failed:
+		return domain_expt.ExptScheduleRunStatusFailed
+	default:
+		return domain_expt.ExptScheduleRunStatusUnknown
+	}
+}
+
+func ExptScheduleRunDO2DTO(from *entity.ExptScheduleRun) *domain_expt.ExptScheduleRun {
+	if from == nil {
+		return nil
+	}
+	dto := &domain_expt.ExptScheduleRun{
+		ID:          gptr.Of(from.ID),
+		ScheduleID:  gptr.Of(from.ScheduleID),
+		ExptID:      gptr.Of(from.ExptID),
+		RunID:       gptr.Of(from.RunID),
+		Status:      gptr.Of(ExptScheduleRunStatusDO2DTO(from.Status)),
+		TriggerTime: gptr.Of(from.TriggerAt.Unix()),
+	}
+	if len(from.ErrMsg) > 0 {
+		dto.ErrorMessage = gptr.Of(from.ErrMsg)
+	}
+	return dto
+}
+
+func ExptScheduleRunDOs2DTOs(from []*entity.ExptScheduleRun) []*domain_expt.ExptScheduleRun {
+	dtos := make([]*domain_expt.ExptScheduleRun, 0, len(from))
+	for _, run := range from {
+		dtos = append(dtos, ExptScheduleRunDO2DTO(run))
+	}
+	return dtos
+}
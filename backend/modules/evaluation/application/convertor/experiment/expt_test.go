@@ -692,3 +692,17 @@ func TestToExptDTO_RuntimeParam(t *testing.T) {
 		})
 	}
 }
+
+func TestExptBudget_Convert(t *testing.T) {
+	conf, err := NewEvalConfConvert().ConvertToEntity(&expt.CreateExperimentRequest{
+		Budget: &domain_expt.ExptBudget{MaxTokens: gptr.Of(int64(1000))},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), gptr.Indirect(conf.Budget.MaxTokens))
+
+	dto := ToExptDTO(&entity.Experiment{ID: 1, EvalConf: conf})
+	assert.Equal(t, int64(1000), dto.GetBudget().GetMaxTokens())
+
+	assert.Nil(t, ToExptBudgetDO(nil))
+	assert.Nil(t, ToExptDTO(&entity.Experiment{ID: 1, EvalConf: &entity.EvaluationConfiguration{}}).Budget)
+}
@@ -64,12 +64,14 @@ func EvalTargetVersionDTO2DO(targetVersionDTO *dto.EvalTargetVersion) (targetVer
 			targetVersionDO.Prompt = &do.LoopPrompt{
 				PromptID:     targetVersionDTO.GetEvalTargetContent().GetPrompt().GetPromptID(),
 				Version:      targetVersionDTO.GetEvalTargetContent().GetPrompt().GetVersion(),
+				Label:        targetVersionDTO.GetEvalTargetContent().GetPrompt().GetLabel(),
 				PromptKey:    targetVersionDTO.GetEvalTargetContent().GetPrompt().GetPromptKey(),
 				Name:         targetVersionDTO.GetEvalTargetContent().GetPrompt().GetName(),
 				SubmitStatus: do.SubmitStatus(targetVersionDTO.GetEvalTargetContent().GetPrompt().GetSubmitStatus()),
 				Description:  targetVersionDTO.GetEvalTargetContent().GetPrompt().GetDescription(),
 			}
 		}
+		targetVersionDO.HTTPTarget = HTTPTargetDTO2DO(targetVersionDTO.GetEvalTargetContent().GetHTTPTarget())
 		targetVersionDO.RuntimeParamDemo = gptr.Of(targetVersionDTO.GetEvalTargetContent().GetRuntimeParamJSONDemo())
 	}
 
@@ -148,6 +150,7 @@ func EvalTargetVersionDO2DTO(targetVersionDO *do.EvalTargetVersion) (targetVersi
 				Name:         &targetVersionDO.Prompt.Name,
 				SubmitStatus: gptr.Of(dto.SubmitStatus(targetVersionDO.Prompt.SubmitStatus)),
 				Description:  &targetVersionDO.Prompt.Description,
+				Label:        gptr.OfNotZero(targetVersionDO.Prompt.Label),
 			}
 		}
 	case do.EvalTargetTypeCozeWorkflow:
@@ -165,6 +168,12 @@ func EvalTargetVersionDO2DTO(targetVersionDO *do.EvalTargetVersion) (targetVersi
 				BaseInfo:    commonconvertor.ConvertBaseInfoDO2DTO(targetVersionDO.CozeWorkflow.BaseInfo),
 			}
 		}
+	case do.EvalTargetTypeHTTP:
+		targetVersionDTO.EvalTargetContent = &dto.EvalTargetContent{
+			InputSchemas:  make([]*commondto.ArgsSchema, 0),
+			OutputSchemas: make([]*commondto.ArgsSchema, 0),
+			HTTPTarget:    HTTPTargetDO2DTO(targetVersionDO.HTTPTarget),
+		}
 	default:
 		targetVersionDTO.EvalTargetContent = &dto.EvalTargetContent{
 			InputSchemas:  make([]*commondto.ArgsSchema, 0),
@@ -181,3 +190,67 @@ func EvalTargetVersionDO2DTO(targetVersionDO *do.EvalTargetVersion) (targetVersi
 
 	return targetVersionDTO
 }
+
+func HTTPTargetDTO2DO(httpTarget *dto.HTTPTarget) *do.HTTPTarget {
+	if httpTarget == nil {
+		return nil
+	}
+	res := &do.HTTPTarget{
+		URL:             httpTarget.GetURL(),
+		Method:          httpTarget.GetMethod(),
+		Headers:         httpTarget.GetHeaders(),
+		BodyTemplate:    httpTarget.GetBodyTemplate(),
+		TimeoutMS:       httpTarget.GetTimeoutMs(),
+		RetryTimes:      httpTarget.GetRetryTimes(),
+		RetryIntervalMS: httpTarget.GetRetryIntervalMs(),
+	}
+	if auth := httpTarget.GetAuth(); auth != nil {
+		res.Auth = &do.HTTPAuth{
+			AuthType:   do.HTTPAuthType(auth.GetAuthType()),
+			Token:      auth.GetToken(),
+			Username:   auth.GetUsername(),
+			Password:   auth.GetPassword(),
+			HeaderName: auth.GetHeaderName(),
+		}
+	}
+	for _, m := range httpTarget.GetOutputMappings() {
+		if m == nil {
+			continue
+		}
+		res.OutputMappings = append(res.OutputMappings, &do.HTTPOutputMapping{
+			FieldKey: m.GetFieldKey(),
+			JSONPath: m.GetJSONPath(),
+		})
+	}
+	return res
+}
+
+func HTTPTargetDO2DTO(httpTarget *do.HTTPTarget) *dto.HTTPTarget {
+	if httpTarget == nil {
+		return nil
+	}
+	res := &dto.HTTPTarget{
+		URL:             gptr.Of(httpTarget.URL),
+		Method:          gptr.Of(httpTarget.GetMethod()),
+		Headers:         httpTarget.Headers,
+		BodyTemplate:    gptr.Of(httpTarget.BodyTemplate),
+		TimeoutMs:       gptr.Of(httpTarget.TimeoutMS),
+		RetryTimes:      gptr.Of(httpTarget.RetryTimes),
+		RetryIntervalMs: gptr.Of(httpTarget.RetryIntervalMS),
+	}
+	// token 与密码只写不读，不在接口中返回
+	if httpTarget.Auth != nil {
+		res.Auth = &dto.HTTPAuth{
+			AuthType:   gptr.Of(dto.HTTPAuthType(httpTarget.Auth.AuthType)),
+			Username:   gptr.Of(httpTarget.Auth.Username),
+			HeaderName: gptr.Of(httpTarget.Auth.HeaderName),
+		}
+	}
+	for _, m := range httpTarget.OutputMappings {
+		res.OutputMappings = append(res.OutputMappings, &dto.HTTPOutputMapping{
+			FieldKey: gptr.Of(m.FieldKey),
+			JSONPath: gptr.Of(m.JSONPath),
+		})
+	}
+	return res
+}
@@ -100,10 +100,11 @@ func OutputDO2DTO(src *entity.EvalTargetOutputData) *eval_target.EvalTargetOutpu
 		return nil
 	}
 	return &eval_target.EvalTargetOutputData{
-		OutputFields:       ContentDOToDTOs(src.OutputFields),
-		EvalTargetUsage:    UsageDO2DTO(src.EvalTargetUsage),
-		EvalTargetRunError: RunErrorDO2DTO(src.EvalTargetRunError),
-		TimeConsumingMs:    src.TimeConsumingMS,
+		OutputFields:                ContentDOToDTOs(src.OutputFields),
+		EvalTargetUsage:             UsageDO2DTO(src.EvalTargetUsage),
+		EvalTargetRunError:          RunErrorDO2DTO(src.EvalTargetRunError),
+		TimeConsumingMs:             src.TimeConsumingMS,
+		ResolvedSourceTargetVersion: src.ResolvedSourceTargetVersion,
 	}
 }
 
@@ -124,10 +125,11 @@ func OutputDTO2ToDO(src *eval_target.EvalTargetOutputData) *entity.EvalTargetOut
 		return nil
 	}
 	return &entity.EvalTargetOutputData{
-		OutputFields:       ContentDTO2DOs(src.OutputFields),
-		EvalTargetUsage:    UsageDTO2DO(src.EvalTargetUsage),
-		EvalTargetRunError: RunErrorDTO2DO(src.EvalTargetRunError),
-		TimeConsumingMS:    src.TimeConsumingMs,
+		OutputFields:                ContentDTO2DOs(src.OutputFields),
+		EvalTargetUsage:             UsageDTO2DO(src.EvalTargetUsage),
+		EvalTargetRunError:          RunErrorDTO2DO(src.EvalTargetRunError),
+		TimeConsumingMS:             src.TimeConsumingMs,
+		ResolvedSourceTargetVersion: src.ResolvedSourceTargetVersion,
 	}
 }
 
@@ -59,7 +59,7 @@ func (e EvalTargetApplicationImpl) CreateEvalTarget(ctx context.Context, request
 	if request.Param.SourceTargetID == nil {
 		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("source target id is nil"))
 	}
-	if request.Param.SourceTargetVersion == nil {
+	if request.Param.SourceTargetVersion == nil && request.Param.GetPromptLabel() == "" {
 		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("source target version is nil"))
 	}
 	if request.Param.EvalTargetType == nil {
@@ -77,7 +77,9 @@ func (e EvalTargetApplicationImpl) CreateEvalTarget(ctx context.Context, request
 	id, versionID, err := e.evalTargetService.CreateEvalTarget(ctx, request.WorkspaceID, request.Param.GetSourceTargetID(), request.Param.GetSourceTargetVersion(),
 		entity.EvalTargetType(request.Param.GetEvalTargetType()),
 		entity.WithCozeBotPublishVersion(request.Param.BotPublishVersion),
-		entity.WithCozeBotInfoType(entity.CozeBotInfoType(request.Param.GetBotInfoType())))
+		entity.WithCozeBotInfoType(entity.CozeBotInfoType(request.Param.GetBotInfoType())),
+		entity.WithHTTPTarget(target.HTTPTargetDTO2DO(request.Param.GetHTTPTarget())),
+		entity.WithPromptLabel(request.Param.PromptLabel))
 	if err != nil {
 		return nil, err
 	}
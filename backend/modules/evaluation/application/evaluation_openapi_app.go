@@ -0,0 +1,156 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/eval_set"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/openapi"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// EvaluationOpenAPIApplication 面向 CI 等外部调用方的评测 OpenAPI，
+// 鉴权由 PAT 中间件完成，业务逻辑复用实验与评测集的应用层实现
+type EvaluationOpenAPIApplication struct {
+	exptApp    IExperimentApplication
+	evalSetApp evaluation.EvaluationSetService
+}
+
+func NewEvaluationOpenAPIApplication(
+	exptApp IExperimentApplication,
+	evalSetApp evaluation.EvaluationSetService,
+) evaluation.EvaluationOpenAPIService {
+	return &EvaluationOpenAPIApplication{
+		exptApp:    exptApp,
+		evalSetApp: evalSetApp,
+	}
+}
+
+func (e *EvaluationOpenAPIApplication) SubmitExperimentOApi(ctx context.Context, req *openapi.SubmitExperimentOApiRequest) (r *openapi.SubmitExperimentOApiResponse, err error) {
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	resp, err := e.exptApp.SubmitExperiment(ctx, &expt.SubmitExperimentRequest{
+		WorkspaceID:           req.GetWorkspaceID(),
+		EvalSetVersionID:      req.EvalSetVersionID,
+		TargetVersionID:       req.TargetVersionID,
+		EvaluatorVersionIds:   req.EvaluatorVersionIds,
+		Name:                  req.Name,
+		Desc:                  req.Desc,
+		EvalSetID:             req.EvalSetID,
+		TargetID:              req.TargetID,
+		TargetFieldMapping:    req.TargetFieldMapping,
+		EvaluatorFieldMapping: req.EvaluatorFieldMapping,
+		ItemConcurNum:         req.ItemConcurNum,
+		EvaluatorsConcurNum:   req.EvaluatorsConcurNum,
+		CreateEvalTargetParam: req.CreateEvalTargetParam,
+		TargetRuntimeParam:    req.TargetRuntimeParam,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &openapi.SubmitExperimentOApiResponse{
+		Code: gptr.Of(int32(0)),
+		Data: &openapi.SubmitExperimentOApiData{
+			Experiment: resp.GetExperiment(),
+			RunID:      resp.RunID,
+		},
+	}, nil
+}
+
+func (e *EvaluationOpenAPIApplication) GetExperimentOApi(ctx context.Context, req *openapi.GetExperimentOApiRequest) (r *openapi.GetExperimentOApiResponse, err error) {
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	resp, err := e.exptApp.BatchGetExperiments(ctx, &expt.BatchGetExperimentsRequest{
+		WorkspaceID: req.GetWorkspaceID(),
+		ExptIds:     []int64{req.GetExptID()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetExperiments()) == 0 {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg("experiment not found"))
+	}
+	return &openapi.GetExperimentOApiResponse{
+		Code: gptr.Of(int32(0)),
+		Data: &openapi.GetExperimentOApiData{
+			Experiment: resp.GetExperiments()[0],
+		},
+	}, nil
+}
+
+func (e *EvaluationOpenAPIApplication) GetExperimentAggrResultOApi(ctx context.Context, req *openapi.GetExperimentAggrResultOApiRequest) (r *openapi.GetExperimentAggrResultOApiResponse, err error) {
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	resp, err := e.exptApp.BatchGetExperimentAggrResult_(ctx, &expt.BatchGetExperimentAggrResultRequest{
+		WorkspaceID:   req.GetWorkspaceID(),
+		ExperimentIds: []int64{req.GetExptID()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	data := &openapi.GetExperimentAggrResultOApiData{}
+	if len(resp.GetExptAggregateResults()) > 0 {
+		data.ExptAggregateResult_ = resp.GetExptAggregateResults()[0]
+	}
+	return &openapi.GetExperimentAggrResultOApiResponse{
+		Code: gptr.Of(int32(0)),
+		Data: data,
+	}, nil
+}
+
+func (e *EvaluationOpenAPIApplication) ListExperimentResultOApi(ctx context.Context, req *openapi.ListExperimentResultOApiRequest) (r *openapi.ListExperimentResultOApiResponse, err error) {
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	resp, err := e.exptApp.BatchGetExperimentResult_(ctx, &expt.BatchGetExperimentResultRequest{
+		WorkspaceID:   req.GetWorkspaceID(),
+		ExperimentIds: []int64{req.GetExptID()},
+		PageNumber:    req.PageNumber,
+		PageSize:      req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &openapi.ListExperimentResultOApiResponse{
+		Code: gptr.Of(int32(0)),
+		Data: &openapi.ListExperimentResultOApiData{
+			ColumnEvalSetFields: resp.GetColumnEvalSetFields(),
+			ColumnEvaluators:    resp.GetColumnEvaluators(),
+			ItemResults:         resp.GetItemResults(),
+			Total:               resp.Total,
+		},
+	}, nil
+}
+
+func (e *EvaluationOpenAPIApplication) BatchCreateEvaluationSetItemsOApi(ctx context.Context, req *openapi.BatchCreateEvaluationSetItemsOApiRequest) (r *openapi.BatchCreateEvaluationSetItemsOApiResponse, err error) {
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	resp, err := e.evalSetApp.BatchCreateEvaluationSetItems(ctx, &eval_set.BatchCreateEvaluationSetItemsRequest{
+		WorkspaceID:      req.GetWorkspaceID(),
+		EvaluationSetID:  req.GetEvaluationSetID(),
+		Items:            req.GetItems(),
+		SkipInvalidItems: req.SkipInvalidItems,
+		AllowPartialAdd:  req.AllowPartialAdd,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &openapi.BatchCreateEvaluationSetItemsOApiResponse{
+		Code: gptr.Of(int32(0)),
+		Data: &openapi.BatchCreateEvaluationSetItemsOApiData{
+			AddedItems: resp.GetAddedItems(),
+			Errors:     resp.GetErrors(),
+		},
+	}, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset"
+	domain_eval_set "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/eval_set"
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/eval_set"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/openapi"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/application/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+//go:generate mockgen -destination=mocks/experiment_app.go -package=mocks github.com/coze-dev/coze-loop/backend/modules/evaluation/application IExperimentApplication
+//go:generate mockgen -destination=mocks/evaluation_set_app.go -package=mocks github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation EvaluationSetService
+
+func newTestEvaluationOpenAPIApp(ctrl *gomock.Controller) (*EvaluationOpenAPIApplication, *mocks.MockIExperimentApplication, *mocks.MockEvaluationSetService) {
+	exptApp := mocks.NewMockIExperimentApplication(ctrl)
+	evalSetApp := mocks.NewMockEvaluationSetService(ctrl)
+	return &EvaluationOpenAPIApplication{exptApp: exptApp, evalSetApp: evalSetApp}, exptApp, evalSetApp
+}
+
+func TestEvaluationOpenAPIApplication_SubmitExperimentOApi(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	app, exptApp, _ := newTestEvaluationOpenAPIApp(ctrl)
+	ctx := context.Background()
+
+	_, err := app.SubmitExperimentOApi(ctx, nil)
+	assert.Error(t, err)
+
+	exptApp.EXPECT().SubmitExperiment(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *expt.SubmitExperimentRequest) (*expt.SubmitExperimentResponse, error) {
+		assert.Equal(t, int64(100), req.GetWorkspaceID())
+		assert.Equal(t, int64(10), req.GetEvalSetVersionID())
+		assert.Equal(t, []int64{20, 21}, req.GetEvaluatorVersionIds())
+		assert.Equal(t, "ci", req.GetName())
+		return &expt.SubmitExperimentResponse{
+			Experiment: &domain_expt.Experiment{ID: gptr.Of(int64(1))},
+			RunID:      gptr.Of(int64(2)),
+		}, nil
+	})
+	resp, err := app.SubmitExperimentOApi(ctx, &openapi.SubmitExperimentOApiRequest{
+		WorkspaceID:         100,
+		EvalSetVersionID:    gptr.Of(int64(10)),
+		EvaluatorVersionIds: []int64{20, 21},
+		Name:                gptr.Of("ci"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.GetCode())
+	assert.Equal(t, int64(1), resp.GetData().GetExperiment().GetID())
+	assert.Equal(t, int64(2), resp.GetData().GetRunID())
+
+	exptApp.EXPECT().SubmitExperiment(gomock.Any(), gomock.Any()).Return(nil, errors.New("submit error"))
+	_, err = app.SubmitExperimentOApi(ctx, &openapi.SubmitExperimentOApiRequest{WorkspaceID: 100})
+	assert.Error(t, err)
+}
+
+func TestEvaluationOpenAPIApplication_GetExperimentOApi(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	app, exptApp, _ := newTestEvaluationOpenAPIApp(ctrl)
+	ctx := context.Background()
+
+	exptApp.EXPECT().BatchGetExperiments(gomock.Any(), &expt.BatchGetExperimentsRequest{WorkspaceID: 100, ExptIds: []int64{1}}).Return(&expt.BatchGetExperimentsResponse{
+		Experiments: []*domain_expt.Experiment{{ID: gptr.Of(int64(1)), Status: gptr.Of(domain_expt.ExptStatus_Success)}},
+	}, nil)
+	resp, err := app.GetExperimentOApi(ctx, &openapi.GetExperimentOApiRequest{WorkspaceID: 100, ExptID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, domain_expt.ExptStatus_Success, resp.GetData().GetExperiment().GetStatus())
+
+	exptApp.EXPECT().BatchGetExperiments(gomock.Any(), gomock.Any()).Return(&expt.BatchGetExperimentsResponse{}, nil)
+	_, err = app.GetExperimentOApi(ctx, &openapi.GetExperimentOApiRequest{WorkspaceID: 100, ExptID: 2})
+	statusErr, ok := errorx.FromStatusError(err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(errno.ResourceNotFoundCode), statusErr.Code())
+
+	exptApp.EXPECT().BatchGetExperiments(gomock.Any(), gomock.Any()).Return(nil, errors.New("get error"))
+	_, err = app.GetExperimentOApi(ctx, &openapi.GetExperimentOApiRequest{WorkspaceID: 100, ExptID: 3})
+	assert.Error(t, err)
+}
+
+func TestEvaluationOpenAPIApplication_GetExperimentAggrResultOApi(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	app, exptApp, _ := newTestEvaluationOpenAPIApp(ctrl)
+	ctx := context.Background()
+
+	exptApp.EXPECT().BatchGetExperimentAggrResult_(gomock.Any(), &expt.BatchGetExperimentAggrResultRequest{WorkspaceID: 100, ExperimentIds: []int64{1}}).Return(&expt.BatchGetExperimentAggrResultResponse{
+		ExptAggregateResults: []*domain_expt.ExptAggregateResult_{{ExperimentID: 1}},
+	}, nil)
+	resp, err := app.GetExperimentAggrResultOApi(ctx, &openapi.GetExperimentAggrResultOApiRequest{WorkspaceID: 100, ExptID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resp.GetData().GetExptAggregateResult_().GetExperimentID())
+
+	exptApp.EXPECT().BatchGetExperimentAggrResult_(gomock.Any(), gomock.Any()).Return(&expt.BatchGetExperimentAggrResultResponse{}, nil)
+	resp, err = app.GetExperimentAggrResultOApi(ctx, &openapi.GetExperimentAggrResultOApiRequest{WorkspaceID: 100, ExptID: 2})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.GetData().GetExptAggregateResult_())
+
+	exptApp.EXPECT().BatchGetExperimentAggrResult_(gomock.Any(), gomock.Any()).Return(nil, errors.New("aggr error"))
+	_, err = app.GetExperimentAggrResultOApi(ctx, &openapi.GetExperimentAggrResultOApiRequest{WorkspaceID: 100, ExptID: 3})
+	assert.Error(t, err)
+}
+
+func TestEvaluationOpenAPIApplication_ListExperimentResultOApi(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	app, exptApp, _ := newTestEvaluationOpenAPIApp(ctrl)
+	ctx := context.Background()
+
+	exptApp.EXPECT().BatchGetExperimentResult_(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *expt.BatchGetExperimentResultRequest) (*expt.BatchGetExperimentResultResponse, error) {
+		assert.Equal(t, []int64{1}, req.GetExperimentIds())
+		assert.Equal(t, int32(2), req.GetPageNumber())
+		assert.Equal(t, int32(50), req.GetPageSize())
+		return &expt.BatchGetExperimentResultResponse{
+			ItemResults: []*domain_expt.ItemResult_{{ItemID: 11}, {ItemID: 12}},
+			Total:       gptr.Of(int64(52)),
+		}, nil
+	})
+	resp, err := app.ListExperimentResultOApi(ctx, &openapi.ListExperimentResultOApiRequest{
+		WorkspaceID: 100,
+		ExptID:      1,
+		PageNumber:  gptr.Of(int32(2)),
+		PageSize:    gptr.Of(int32(50)),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetData().GetItemResults(), 2)
+	assert.Equal(t, int64(52), resp.GetData().GetTotal())
+
+	exptApp.EXPECT().BatchGetExperimentResult_(gomock.Any(), gomock.Any()).Return(nil, errors.New("result error"))
+	_, err = app.ListExperimentResultOApi(ctx, &openapi.ListExperimentResultOApiRequest{WorkspaceID: 100, ExptID: 1})
+	assert.Error(t, err)
+}
+
+func TestEvaluationOpenAPIApplication_BatchCreateEvaluationSetItemsOApi(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	app, _, evalSetApp := newTestEvaluationOpenAPIApp(ctrl)
+	ctx := context.Background()
+
+	evalSetApp.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *eval_set.BatchCreateEvaluationSetItemsRequest) (*eval_set.BatchCreateEvaluationSetItemsResponse, error) {
+		assert.Equal(t, int64(5), req.GetEvaluationSetID())
+		assert.Len(t, req.GetItems(), 2)
+		assert.True(t, req.GetSkipInvalidItems())
+		return &eval_set.BatchCreateEvaluationSetItemsResponse{
+			AddedItems: map[int64]int64{0: 1001},
+			Errors:     []*dataset.ItemErrorGroup{{Summary: gptr.Of("invalid item")}},
+		}, nil
+	})
+	resp, err := app.BatchCreateEvaluationSetItemsOApi(ctx, &openapi.BatchCreateEvaluationSetItemsOApiRequest{
+		WorkspaceID:      100,
+		EvaluationSetID:  5,
+		Items:            []*domain_eval_set.EvaluationSetItem{{}, {}},
+		SkipInvalidItems: gptr.Of(true),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int64{0: 1001}, resp.GetData().GetAddedItems())
+	assert.Len(t, resp.GetData().GetErrors(), 1)
+
+	evalSetApp.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.Any()).Return(nil, errors.New("create error"))
+	_, err = app.BatchCreateEvaluationSetItemsOApi(ctx, &openapi.BatchCreateEvaluationSetItemsOApiRequest{WorkspaceID: 100, EvaluationSetID: 5})
+	assert.Error(t, err)
+}
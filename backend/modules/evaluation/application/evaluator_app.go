@@ -244,6 +244,21 @@ func (e *EvaluatorHandlerImpl) checkCreateEvaluatorRequest(ctx context.Context,
 			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("prompt evaluator_version is nil"))
 		}
 	}
+	if request.Evaluator.GetEvaluatorType() == evaluatordto.EvaluatorType_Code {
+		if request.Evaluator.CurrentVersion.EvaluatorContent.CodeEvaluator == nil {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("code evaluator_version is nil"))
+		}
+	}
+	if request.Evaluator.GetEvaluatorType() == evaluatordto.EvaluatorType_Builtin {
+		if request.Evaluator.CurrentVersion.EvaluatorContent.BuiltinEvaluator == nil {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("builtin evaluator_version is nil"))
+		}
+	}
+	if request.Evaluator.GetEvaluatorType() == evaluatordto.EvaluatorType_Pairwise {
+		if request.Evaluator.CurrentVersion.EvaluatorContent.PairwiseEvaluator == nil {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("pairwise evaluator_version is nil"))
+		}
+	}
 	if utf8.RuneCountInString(request.Evaluator.GetName()) > consts.MaxEvaluatorNameLength {
 		return errorx.NewByCode(errno.EvaluatorNameExceedMaxLengthCode, errorx.WithExtraMsg("name is too long"))
 	}
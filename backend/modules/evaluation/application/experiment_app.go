@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/bytedance/gg/gptr"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/maps"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/slices"
@@ -40,6 +42,9 @@ type IExperimentApplication interface {
 	service.ExptItemEvalEvent
 	service.ExptAggrResultService
 	service.IExptResultExportService
+
+	// StartExptScheduleTrigger 启动定时实验的后台触发循环, ctx 结束时退出
+	StartExptScheduleTrigger(ctx context.Context)
 }
 
 type experimentApplication struct {
@@ -60,6 +65,8 @@ type experimentApplication struct {
 	evalTargetService        service.IEvalTargetService
 	evaluationSetItemService service.EvaluationSetItemService
 	annotateService          service.IExptAnnotateService
+	compareService           service.IExptCompareService
+	scheduleService          service.IExptScheduleService
 }
 
 func NewExperimentApplication(
@@ -78,6 +85,8 @@ func NewExperimentApplication(
 	annotateService service.IExptAnnotateService,
 	tagRPCAdapter rpc.ITagRPCAdapter,
 	exptResultExportService service.IExptResultExportService,
+	compareService service.IExptCompareService,
+	scheduleService service.IExptScheduleService,
 ) IExperimentApplication {
 	return &experimentApplication{
 		resultSvc: resultSvc,
@@ -95,6 +104,8 @@ func NewExperimentApplication(
 		annotateService:          annotateService,
 		tagRPCAdapter:            tagRPCAdapter,
 		IExptResultExportService: exptResultExportService,
+		compareService:           compareService,
+		scheduleService:          scheduleService,
 	}
 }
 
@@ -145,6 +156,7 @@ func (e *experimentApplication) SubmitExperiment(ctx context.Context, req *expt.
 		SourceType:            req.SourceType,
 		SourceID:              req.SourceID,
 		TargetRuntimeParam:    req.TargetRuntimeParam,
+		Budget:                req.Budget,
 		Session:               req.Session,
 	})
 	if err != nil {
@@ -535,6 +547,58 @@ func (e *experimentApplication) KillExperiment(ctx context.Context, req *expt.Ki
 	return &expt.KillExperimentResponse{BaseResp: base.NewBaseResp()}, nil
 }
 
+func (e *experimentApplication) PauseExperiment(ctx context.Context, req *expt.PauseExperimentRequest) (r *expt.PauseExperimentResponse, err error) {
+	session := entity.NewSession(ctx)
+
+	got, err := e.manager.Get(ctx, req.GetExptID(), req.GetWorkspaceID(), session)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.auth.AuthorizationWithoutSPI(ctx, &rpc.AuthorizationWithoutSPIParam{
+		ObjectID:        strconv.FormatInt(req.GetExptID(), 10),
+		SpaceID:         req.GetWorkspaceID(),
+		ActionObjects:   []*rpc.ActionObject{{Action: gptr.Of(consts.Run), EntityType: gptr.Of(rpc.AuthEntityType_EvaluationExperiment)}},
+		OwnerID:         gptr.Of(got.CreatedBy),
+		ResourceSpaceID: req.GetWorkspaceID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.manager.Pause(ctx, req.GetExptID(), req.GetWorkspaceID(), session); err != nil {
+		return nil, err
+	}
+
+	return &expt.PauseExperimentResponse{BaseResp: base.NewBaseResp()}, nil
+}
+
+func (e *experimentApplication) ResumeExperiment(ctx context.Context, req *expt.ResumeExperimentRequest) (r *expt.ResumeExperimentResponse, err error) {
+	session := entity.NewSession(ctx)
+
+	got, err := e.manager.Get(ctx, req.GetExptID(), req.GetWorkspaceID(), session)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.auth.AuthorizationWithoutSPI(ctx, &rpc.AuthorizationWithoutSPIParam{
+		ObjectID:        strconv.FormatInt(req.GetExptID(), 10),
+		SpaceID:         req.GetWorkspaceID(),
+		ActionObjects:   []*rpc.ActionObject{{Action: gptr.Of(consts.Run), EntityType: gptr.Of(rpc.AuthEntityType_EvaluationExperiment)}},
+		OwnerID:         gptr.Of(got.CreatedBy),
+		ResourceSpaceID: req.GetWorkspaceID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.manager.Resume(ctx, req.GetExptID(), req.GetWorkspaceID(), session); err != nil {
+		return nil, err
+	}
+
+	return &expt.ResumeExperimentResponse{BaseResp: base.NewBaseResp()}, nil
+}
+
 func (e *experimentApplication) BatchGetExperimentResult_(ctx context.Context, req *expt.BatchGetExperimentResultRequest) (r *expt.BatchGetExperimentResultResponse, err error) {
 	// 1. 如果指定了 BaselineExperimentID，先查出其真实的 SpaceID
 	var actualSpaceID int64
@@ -640,6 +704,27 @@ func (e *experimentApplication) BatchGetExperimentAggrResult_(ctx context.Contex
 	}, nil
 }
 
+func (e *experimentApplication) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest) (r *expt.CompareExperimentsResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.WorkspaceID, 10),
+		SpaceID:       req.WorkspaceID,
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := e.compareService.CompareExperiments(ctx, experiment.ConvertCompareReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &expt.CompareExperimentsResponse{
+		EvaluatorCompareResults: experiment.EvaluatorCompareResultDOsToDTOs(results),
+		BaseResp:                base.NewBaseResp(),
+	}, nil
+}
+
 func (e *experimentApplication) mPackUserInfo(ctx context.Context, expts []*domain_expt.Experiment) ([]*domain_expt.Experiment, error) {
 	if len(expts) == 0 {
 		return expts, nil
@@ -995,7 +1080,7 @@ func (e *experimentApplication) ExportExptResult_(ctx context.Context, req *expt
 		}
 	}
 
-	exportID, err := e.ExportCSV(ctx, req.GetWorkspaceID(), req.GetExptID(), session)
+	exportID, err := e.ExportCSV(ctx, req.GetWorkspaceID(), req.GetExptID(), session, entity.ExptResultExportFormat(req.GetExportType()))
 	if err != nil {
 		return nil, err
 	}
@@ -1077,3 +1162,145 @@ func (e *experimentApplication) GetExptResultExportRecord(ctx context.Context, r
 		BaseResp:               base.NewBaseResp(),
 	}, nil
 }
+
+func (e *experimentApplication) CreateExptSchedule(ctx context.Context, req *expt.CreateExptScheduleRequest) (r *expt.CreateExptScheduleResponse, err error) {
+	session := entity.NewSession(ctx)
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionCreateExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := experiment.ConvertCreateExptScheduleReq(req)
+	if err := e.scheduleService.Create(ctx, schedule, session); err != nil {
+		return nil, err
+	}
+
+	return &expt.CreateExptScheduleResponse{
+		Schedule: experiment.ExptScheduleDO2DTO(schedule),
+		BaseResp: base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) UpdateExptSchedule(ctx context.Context, req *expt.UpdateExptScheduleRequest) (r *expt.UpdateExptScheduleResponse, err error) {
+	session := entity.NewSession(ctx)
+	schedule, err := e.scheduleService.Get(ctx, req.GetScheduleID(), req.GetWorkspaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.auth.AuthorizationWithoutSPI(ctx, &rpc.AuthorizationWithoutSPIParam{
+		ObjectID:        strconv.FormatInt(schedule.TemplateExptID, 10),
+		SpaceID:         req.GetWorkspaceID(),
+		ActionObjects:   []*rpc.ActionObject{{Action: gptr.Of(consts.Edit), EntityType: gptr.Of(rpc.AuthEntityType_EvaluationExperiment)}},
+		OwnerID:         gptr.Of(schedule.CreatedBy),
+		ResourceSpaceID: req.GetWorkspaceID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	experiment.ApplyUpdateExptScheduleReq(schedule, req)
+	if err := e.scheduleService.Update(ctx, schedule, session); err != nil {
+		return nil, err
+	}
+
+	return &expt.UpdateExptScheduleResponse{
+		Schedule: experiment.ExptScheduleDO2DTO(schedule),
+		BaseResp: base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) DeleteExptSchedule(ctx context.Context, req *expt.DeleteExptScheduleRequest) (r *expt.DeleteExptScheduleResponse, err error) {
+	session := entity.NewSession(ctx)
+	schedule, err := e.scheduleService.Get(ctx, req.GetScheduleID(), req.GetWorkspaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.auth.AuthorizationWithoutSPI(ctx, &rpc.AuthorizationWithoutSPIParam{
+		ObjectID:        strconv.FormatInt(schedule.TemplateExptID, 10),
+		SpaceID:         req.GetWorkspaceID(),
+		ActionObjects:   []*rpc.ActionObject{{Action: gptr.Of(consts.Edit), EntityType: gptr.Of(rpc.AuthEntityType_EvaluationExperiment)}},
+		OwnerID:         gptr.Of(schedule.CreatedBy),
+		ResourceSpaceID: req.GetWorkspaceID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.scheduleService.Delete(ctx, req.GetScheduleID(), req.GetWorkspaceID(), session); err != nil {
+		return nil, err
+	}
+
+	return &expt.DeleteExptScheduleResponse{BaseResp: base.NewBaseResp()}, nil
+}
+
+func (e *experimentApplication) ListExptSchedules(ctx context.Context, req *expt.ListExptSchedulesRequest) (r *expt.ListExptSchedulesResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &entity.ExptScheduleListFilter{TemplateExptID: req.TemplateExptID}
+	page := entity.NewPage(int(req.GetPageNumber()), int(req.GetPageSize()))
+	schedules, total, err := e.scheduleService.List(ctx, req.GetWorkspaceID(), filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &expt.ListExptSchedulesResponse{
+		Schedules: experiment.ExptScheduleDOs2DTOs(schedules),
+		Total:     gptr.Of(total),
+		BaseResp:  base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) ListExptScheduleRuns(ctx context.Context, req *expt.ListExptScheduleRunsRequest) (r *expt.ListExptScheduleRunsResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := entity.NewPage(int(req.GetPageNumber()), int(req.GetPageSize()))
+	runs, total, err := e.scheduleService.ListRuns(ctx, req.GetWorkspaceID(), req.GetScheduleID(), page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &expt.ListExptScheduleRunsResponse{
+		Runs:     experiment.ExptScheduleRunDOs2DTOs(runs),
+		Total:    gptr.Of(total),
+		BaseResp: base.NewBaseResp(),
+	}, nil
+}
+
+const exptScheduleTriggerInterval = 30 * time.Second
+
+func (e *experimentApplication) StartExptScheduleTrigger(ctx context.Context) {
+	goroutine.Go(ctx, func() {
+		ticker := time.NewTicker(exptScheduleTriggerInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if err := e.scheduleService.TriggerDue(ctx, now); err != nil {
+					logs.CtxError(ctx, "trigger due expt schedules fail, err=%v", err)
+				}
+			}
+		}
+	})
+}
@@ -1785,6 +1785,8 @@ func TestExperimentApplication_RetryExperiment(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			// 执行测试
@@ -1898,6 +1900,8 @@ func TestExperimentApplication_KillExperiment(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			// 执行测试
@@ -1914,6 +1918,52 @@ func TestExperimentApplication_KillExperiment(t *testing.T) {
 	}
 }
 
+func TestExperimentApplication_PauseAndResumeExperiment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := servicemocks.NewMockIExptManager(ctrl)
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	app := &experimentApplication{
+		manager: mockManager,
+		auth:    mockAuth,
+	}
+	got := &entity.Experiment{ID: 456, SpaceID: 123, CreatedBy: "789"}
+
+	t.Run("暂停成功", func(t *testing.T) {
+		mockManager.EXPECT().Get(gomock.Any(), int64(456), int64(123), gomock.Any()).Return(got, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+		mockManager.EXPECT().Pause(gomock.Any(), int64(456), int64(123), gomock.Any()).Return(nil)
+		resp, err := app.PauseExperiment(context.Background(), &exptpb.PauseExperimentRequest{WorkspaceID: gptr.Of(int64(123)), ExptID: gptr.Of(int64(456))})
+		assert.NoError(t, err)
+		assert.Equal(t, base.NewBaseResp(), resp.BaseResp)
+	})
+
+	t.Run("状态不允许暂停", func(t *testing.T) {
+		mockManager.EXPECT().Get(gomock.Any(), int64(456), int64(123), gomock.Any()).Return(got, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+		mockManager.EXPECT().Pause(gomock.Any(), int64(456), int64(123), gomock.Any()).Return(errorx.NewByCode(errno.ExperimentStatusNotAllowedCode))
+		_, err := app.PauseExperiment(context.Background(), &exptpb.PauseExperimentRequest{WorkspaceID: gptr.Of(int64(123)), ExptID: gptr.Of(int64(456))})
+		assert.Error(t, err)
+	})
+
+	t.Run("恢复成功", func(t *testing.T) {
+		mockManager.EXPECT().Get(gomock.Any(), int64(456), int64(123), gomock.Any()).Return(got, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+		mockManager.EXPECT().Resume(gomock.Any(), int64(456), int64(123), gomock.Any()).Return(nil)
+		resp, err := app.ResumeExperiment(context.Background(), &exptpb.ResumeExperimentRequest{WorkspaceID: gptr.Of(int64(123)), ExptID: gptr.Of(int64(456))})
+		assert.NoError(t, err)
+		assert.Equal(t, base.NewBaseResp(), resp.BaseResp)
+	})
+
+	t.Run("恢复鉴权失败", func(t *testing.T) {
+		mockManager.EXPECT().Get(gomock.Any(), int64(456), int64(123), gomock.Any()).Return(got, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(errorx.NewByCode(errno.CommonNoPermissionCode))
+		_, err := app.ResumeExperiment(context.Background(), &exptpb.ResumeExperimentRequest{WorkspaceID: gptr.Of(int64(123)), ExptID: gptr.Of(int64(456))})
+		assert.Error(t, err)
+	})
+}
+
 func TestExperimentApplication_BatchGetExperimentResult_(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -3089,7 +3139,7 @@ func TestExperimentApplication_ExportExptResult_(t *testing.T) {
 
 			// 模拟导出实验结果
 			mockExptResultExportService.EXPECT().
-				ExportCSV(gomock.Any(), validWorkspaceID, validExptID, gomock.Any()).
+				ExportCSV(gomock.Any(), validWorkspaceID, validExptID, gomock.Any(), gomock.Any()).
 				Return(validExportID, nil)
 			mockManager.EXPECT().
 				Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
@@ -3562,3 +3612,186 @@ func TestExperimentApplication_AssociateAnnotationTag(t *testing.T) {
 		})
 	}
 }
+
+func TestExperimentApplication_CompareExperiments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockCompareSvc := servicemocks.NewMockIExptCompareService(ctrl)
+	app := &experimentApplication{
+		auth:           mockAuth,
+		compareService: mockCompareSvc,
+	}
+	req := &exptpb.CompareExperimentsRequest{
+		WorkspaceID:     1,
+		ExptID:          2,
+		BaselineExptID:  3,
+		ConfidenceLevel: gptr.Of(0.9),
+	}
+
+	t.Run("对比成功", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockCompareSvc.EXPECT().CompareExperiments(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ExptCompareParam) ([]*entity.EvaluatorCompareResult, error) {
+				assert.Equal(t, int64(2), param.ExptID)
+				assert.Equal(t, int64(3), param.BaselineExptID)
+				assert.Equal(t, 0.9, param.ConfidenceLevel)
+				return []*entity.EvaluatorCompareResult{{EvaluatorVersionID: 4, PairedCount: 10, Significant: true}}, nil
+			})
+		resp, err := app.CompareExperiments(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Len(t, resp.EvaluatorCompareResults, 1)
+		assert.Equal(t, int64(4), resp.EvaluatorCompareResults[0].EvaluatorVersionID)
+		assert.True(t, resp.EvaluatorCompareResults[0].GetSignificant())
+	})
+
+	t.Run("鉴权失败", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errorx.NewByCode(errno.CommonNoPermissionCode))
+		_, err := app.CompareExperiments(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("实验不可对比", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockCompareSvc.EXPECT().CompareExperiments(gomock.Any(), gomock.Any()).Return(nil, errorx.NewByCode(errno.ExperimentCompareMismatchCode))
+		_, err := app.CompareExperiments(context.Background(), req)
+		assert.Error(t, err)
+	})
+}
+
+func TestExperimentApplication_CreateExptSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockScheduleSvc := servicemocks.NewMockIExptScheduleService(ctrl)
+	app := &experimentApplication{
+		auth:            mockAuth,
+		scheduleService: mockScheduleSvc,
+	}
+	req := &exptpb.CreateExptScheduleRequest{
+		WorkspaceID:            1,
+		Name:                   "nightly",
+		TemplateExptID:         2,
+		CronExpr:               "0 2 * * *",
+		UseLatestTargetVersion: gptr.Of(true),
+	}
+
+	t.Run("创建成功，默认启用", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockScheduleSvc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, schedule *entity.ExptSchedule, _ *entity.Session) error {
+				assert.Equal(t, int64(2), schedule.TemplateExptID)
+				assert.True(t, schedule.Enabled)
+				assert.True(t, schedule.UseLatestTargetVersion)
+				assert.False(t, schedule.UseLatestEvalSetVersion)
+				schedule.ID = 10
+				return nil
+			})
+		resp, err := app.CreateExptSchedule(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), resp.GetSchedule().GetID())
+		assert.Equal(t, "0 2 * * *", resp.GetSchedule().GetCronExpr())
+	})
+
+	t.Run("鉴权失败", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errorx.NewByCode(errno.CommonNoPermissionCode))
+		_, err := app.CreateExptSchedule(context.Background(), req)
+		assert.Error(t, err)
+	})
+}
+
+func TestExperimentApplication_UpdateExptSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockScheduleSvc := servicemocks.NewMockIExptScheduleService(ctrl)
+	app := &experimentApplication{
+		auth:            mockAuth,
+		scheduleService: mockScheduleSvc,
+	}
+	req := &exptpb.UpdateExptScheduleRequest{
+		WorkspaceID: 1,
+		ScheduleID:  10,
+		Enabled:     gptr.Of(false),
+	}
+
+	t.Run("仅更新设置的字段", func(t *testing.T) {
+		mockScheduleSvc.EXPECT().Get(gomock.Any(), int64(10), int64(1)).Return(&entity.ExptSchedule{
+			ID: 10, SpaceID: 1, Name: "nightly", CronExpr: "0 2 * * *", Enabled: true, CreatedBy: "u1",
+		}, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+		mockScheduleSvc.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, schedule *entity.ExptSchedule, _ *entity.Session) error {
+				assert.False(t, schedule.Enabled)
+				assert.Equal(t, "nightly", schedule.Name)
+				assert.Equal(t, "0 2 * * *", schedule.CronExpr)
+				return nil
+			})
+		resp, err := app.UpdateExptSchedule(context.Background(), req)
+		assert.NoError(t, err)
+		assert.False(t, resp.GetSchedule().GetEnabled())
+	})
+
+	t.Run("定时实验不存在", func(t *testing.T) {
+		mockScheduleSvc.EXPECT().Get(gomock.Any(), int64(10), int64(1)).Return(nil, errorx.NewByCode(errno.ResourceNotFoundCode))
+		_, err := app.UpdateExptSchedule(context.Background(), req)
+		assert.Error(t, err)
+	})
+}
+
+func TestExperimentApplication_DeleteExptSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockScheduleSvc := servicemocks.NewMockIExptScheduleService(ctrl)
+	app := &experimentApplication{
+		auth:            mockAuth,
+		scheduleService: mockScheduleSvc,
+	}
+	req := &exptpb.DeleteExptScheduleRequest{WorkspaceID: 1, ScheduleID: 10}
+
+	mockScheduleSvc.EXPECT().Get(gomock.Any(), int64(10), int64(1)).Return(&entity.ExptSchedule{ID: 10, SpaceID: 1, CreatedBy: "u1"}, nil)
+	mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+	mockScheduleSvc.EXPECT().Delete(gomock.Any(), int64(10), int64(1), gomock.Any()).Return(nil)
+	_, err := app.DeleteExptSchedule(context.Background(), req)
+	assert.NoError(t, err)
+}
+
+func TestExperimentApplication_ListExptScheduleRuns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockScheduleSvc := servicemocks.NewMockIExptScheduleService(ctrl)
+	app := &experimentApplication{
+		auth:            mockAuth,
+		scheduleService: mockScheduleSvc,
+	}
+	req := &exptpb.ListExptScheduleRunsRequest{WorkspaceID: 1, ScheduleID: 10, PageNumber: gptr.Of(int32(1)), PageSize: gptr.Of(int32(20))}
+
+	t.Run("成功", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockScheduleSvc.EXPECT().ListRuns(gomock.Any(), int64(1), int64(10), entity.NewPage(1, 20)).Return([]*entity.ExptScheduleRun{
+			{ID: 1, ScheduleID: 10, ExptID: 100, RunID: 200, Status: entity.ExptScheduleRunStatus_Success},
+			{ID: 2, ScheduleID: 10, Status: entity.ExptScheduleRunStatus_Failed, ErrMsg: "template not found"},
+		}, int64(2), nil)
+		resp, err := app.ListExptScheduleRuns(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), resp.GetTotal())
+		assert.Equal(t, expt.ExptScheduleRunStatusSuccess, resp.GetRuns()[0].GetStatus())
+		assert.Equal(t, int64(100), resp.GetRuns()[0].GetExptID())
+		assert.Equal(t, expt.ExptScheduleRunStatusFailed, resp.GetRuns()[1].GetStatus())
+		assert.Equal(t, "template not found", resp.GetRuns()[1].GetErrorMessage())
+	})
+
+	t.Run("查询失败", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockScheduleSvc.EXPECT().ListRuns(gomock.Any(), int64(1), int64(10), gomock.Any()).Return(nil, int64(0), errors.New("db error"))
+		_, err := app.ListExptScheduleRuns(context.Background(), req)
+		assert.Error(t, err)
+	})
+}
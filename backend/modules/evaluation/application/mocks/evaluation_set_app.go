@@ -0,0 +1,281 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation (interfaces: EvaluationSetService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/evaluation_set_app.go -package=mocks github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation EvaluationSetService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	eval_set "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/eval_set"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEvaluationSetService is a mock of EvaluationSetService interface.
+type MockEvaluationSetService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluationSetServiceMockRecorder
+}
+
+// MockEvaluationSetServiceMockRecorder is the mock recorder for MockEvaluationSetService.
+type MockEvaluationSetServiceMockRecorder struct {
+	mock *MockEvaluationSetService
+}
+
+// NewMockEvaluationSetService creates a new mock instance.
+func NewMockEvaluationSetService(ctrl *gomock.Controller) *MockEvaluationSetService {
+	mock := &MockEvaluationSetService{ctrl: ctrl}
+	mock.recorder = &MockEvaluationSetServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluationSetService) EXPECT() *MockEvaluationSetServiceMockRecorder {
+	return m.recorder
+}
+
+// BatchCreateEvaluationSetItems mocks base method.
+func (m *MockEvaluationSetService) BatchCreateEvaluationSetItems(arg0 context.Context, arg1 *eval_set.BatchCreateEvaluationSetItemsRequest) (*eval_set.BatchCreateEvaluationSetItemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreateEvaluationSetItems", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.BatchCreateEvaluationSetItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCreateEvaluationSetItems indicates an expected call of BatchCreateEvaluationSetItems.
+func (mr *MockEvaluationSetServiceMockRecorder) BatchCreateEvaluationSetItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreateEvaluationSetItems", reflect.TypeOf((*MockEvaluationSetService)(nil).BatchCreateEvaluationSetItems), arg0, arg1)
+}
+
+// BatchDeleteEvaluationSetItems mocks base method.
+func (m *MockEvaluationSetService) BatchDeleteEvaluationSetItems(arg0 context.Context, arg1 *eval_set.BatchDeleteEvaluationSetItemsRequest) (*eval_set.BatchDeleteEvaluationSetItemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteEvaluationSetItems", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.BatchDeleteEvaluationSetItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDeleteEvaluationSetItems indicates an expected call of BatchDeleteEvaluationSetItems.
+func (mr *MockEvaluationSetServiceMockRecorder) BatchDeleteEvaluationSetItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteEvaluationSetItems", reflect.TypeOf((*MockEvaluationSetService)(nil).BatchDeleteEvaluationSetItems), arg0, arg1)
+}
+
+// BatchGetEvaluationSetItems mocks base method.
+func (m *MockEvaluationSetService) BatchGetEvaluationSetItems(arg0 context.Context, arg1 *eval_set.BatchGetEvaluationSetItemsRequest) (*eval_set.BatchGetEvaluationSetItemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetEvaluationSetItems", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.BatchGetEvaluationSetItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetEvaluationSetItems indicates an expected call of BatchGetEvaluationSetItems.
+func (mr *MockEvaluationSetServiceMockRecorder) BatchGetEvaluationSetItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetEvaluationSetItems", reflect.TypeOf((*MockEvaluationSetService)(nil).BatchGetEvaluationSetItems), arg0, arg1)
+}
+
+// BatchGetEvaluationSetVersions mocks base method.
+func (m *MockEvaluationSetService) BatchGetEvaluationSetVersions(arg0 context.Context, arg1 *eval_set.BatchGetEvaluationSetVersionsRequest) (*eval_set.BatchGetEvaluationSetVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetEvaluationSetVersions", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.BatchGetEvaluationSetVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetEvaluationSetVersions indicates an expected call of BatchGetEvaluationSetVersions.
+func (mr *MockEvaluationSetServiceMockRecorder) BatchGetEvaluationSetVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetEvaluationSetVersions", reflect.TypeOf((*MockEvaluationSetService)(nil).BatchGetEvaluationSetVersions), arg0, arg1)
+}
+
+// ClearEvaluationSetDraftItem mocks base method.
+func (m *MockEvaluationSetService) ClearEvaluationSetDraftItem(arg0 context.Context, arg1 *eval_set.ClearEvaluationSetDraftItemRequest) (*eval_set.ClearEvaluationSetDraftItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearEvaluationSetDraftItem", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.ClearEvaluationSetDraftItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearEvaluationSetDraftItem indicates an expected call of ClearEvaluationSetDraftItem.
+func (mr *MockEvaluationSetServiceMockRecorder) ClearEvaluationSetDraftItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearEvaluationSetDraftItem", reflect.TypeOf((*MockEvaluationSetService)(nil).ClearEvaluationSetDraftItem), arg0, arg1)
+}
+
+// CreateEvaluationSet mocks base method.
+func (m *MockEvaluationSetService) CreateEvaluationSet(arg0 context.Context, arg1 *eval_set.CreateEvaluationSetRequest) (*eval_set.CreateEvaluationSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvaluationSet", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.CreateEvaluationSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvaluationSet indicates an expected call of CreateEvaluationSet.
+func (mr *MockEvaluationSetServiceMockRecorder) CreateEvaluationSet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvaluationSet", reflect.TypeOf((*MockEvaluationSetService)(nil).CreateEvaluationSet), arg0, arg1)
+}
+
+// CreateEvaluationSetVersion mocks base method.
+func (m *MockEvaluationSetService) CreateEvaluationSetVersion(arg0 context.Context, arg1 *eval_set.CreateEvaluationSetVersionRequest) (*eval_set.CreateEvaluationSetVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvaluationSetVersion", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.CreateEvaluationSetVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvaluationSetVersion indicates an expected call of CreateEvaluationSetVersion.
+func (mr *MockEvaluationSetServiceMockRecorder) CreateEvaluationSetVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvaluationSetVersion", reflect.TypeOf((*MockEvaluationSetService)(nil).CreateEvaluationSetVersion), arg0, arg1)
+}
+
+// DeleteEvaluationSet mocks base method.
+func (m *MockEvaluationSetService) DeleteEvaluationSet(arg0 context.Context, arg1 *eval_set.DeleteEvaluationSetRequest) (*eval_set.DeleteEvaluationSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvaluationSet", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.DeleteEvaluationSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvaluationSet indicates an expected call of DeleteEvaluationSet.
+func (mr *MockEvaluationSetServiceMockRecorder) DeleteEvaluationSet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvaluationSet", reflect.TypeOf((*MockEvaluationSetService)(nil).DeleteEvaluationSet), arg0, arg1)
+}
+
+// GetEvaluationSet mocks base method.
+func (m *MockEvaluationSetService) GetEvaluationSet(arg0 context.Context, arg1 *eval_set.GetEvaluationSetRequest) (*eval_set.GetEvaluationSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvaluationSet", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.GetEvaluationSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvaluationSet indicates an expected call of GetEvaluationSet.
+func (mr *MockEvaluationSetServiceMockRecorder) GetEvaluationSet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationSet", reflect.TypeOf((*MockEvaluationSetService)(nil).GetEvaluationSet), arg0, arg1)
+}
+
+// GetEvaluationSetVersion mocks base method.
+func (m *MockEvaluationSetService) GetEvaluationSetVersion(arg0 context.Context, arg1 *eval_set.GetEvaluationSetVersionRequest) (*eval_set.GetEvaluationSetVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvaluationSetVersion", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.GetEvaluationSetVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvaluationSetVersion indicates an expected call of GetEvaluationSetVersion.
+func (mr *MockEvaluationSetServiceMockRecorder) GetEvaluationSetVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationSetVersion", reflect.TypeOf((*MockEvaluationSetService)(nil).GetEvaluationSetVersion), arg0, arg1)
+}
+
+// ListEvaluationSetItems mocks base method.
+func (m *MockEvaluationSetService) ListEvaluationSetItems(arg0 context.Context, arg1 *eval_set.ListEvaluationSetItemsRequest) (*eval_set.ListEvaluationSetItemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvaluationSetItems", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.ListEvaluationSetItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvaluationSetItems indicates an expected call of ListEvaluationSetItems.
+func (mr *MockEvaluationSetServiceMockRecorder) ListEvaluationSetItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationSetItems", reflect.TypeOf((*MockEvaluationSetService)(nil).ListEvaluationSetItems), arg0, arg1)
+}
+
+// ListEvaluationSetVersions mocks base method.
+func (m *MockEvaluationSetService) ListEvaluationSetVersions(arg0 context.Context, arg1 *eval_set.ListEvaluationSetVersionsRequest) (*eval_set.ListEvaluationSetVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvaluationSetVersions", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.ListEvaluationSetVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvaluationSetVersions indicates an expected call of ListEvaluationSetVersions.
+func (mr *MockEvaluationSetServiceMockRecorder) ListEvaluationSetVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationSetVersions", reflect.TypeOf((*MockEvaluationSetService)(nil).ListEvaluationSetVersions), arg0, arg1)
+}
+
+// ListEvaluationSets mocks base method.
+func (m *MockEvaluationSetService) ListEvaluationSets(arg0 context.Context, arg1 *eval_set.ListEvaluationSetsRequest) (*eval_set.ListEvaluationSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvaluationSets", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.ListEvaluationSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvaluationSets indicates an expected call of ListEvaluationSets.
+func (mr *MockEvaluationSetServiceMockRecorder) ListEvaluationSets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationSets", reflect.TypeOf((*MockEvaluationSetService)(nil).ListEvaluationSets), arg0, arg1)
+}
+
+// UpdateEvaluationSet mocks base method.
+func (m *MockEvaluationSetService) UpdateEvaluationSet(arg0 context.Context, arg1 *eval_set.UpdateEvaluationSetRequest) (*eval_set.UpdateEvaluationSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvaluationSet", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.UpdateEvaluationSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEvaluationSet indicates an expected call of UpdateEvaluationSet.
+func (mr *MockEvaluationSetServiceMockRecorder) UpdateEvaluationSet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvaluationSet", reflect.TypeOf((*MockEvaluationSetService)(nil).UpdateEvaluationSet), arg0, arg1)
+}
+
+// UpdateEvaluationSetItem mocks base method.
+func (m *MockEvaluationSetService) UpdateEvaluationSetItem(arg0 context.Context, arg1 *eval_set.UpdateEvaluationSetItemRequest) (*eval_set.UpdateEvaluationSetItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvaluationSetItem", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.UpdateEvaluationSetItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEvaluationSetItem indicates an expected call of UpdateEvaluationSetItem.
+func (mr *MockEvaluationSetServiceMockRecorder) UpdateEvaluationSetItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvaluationSetItem", reflect.TypeOf((*MockEvaluationSetService)(nil).UpdateEvaluationSetItem), arg0, arg1)
+}
+
+// UpdateEvaluationSetSchema mocks base method.
+func (m *MockEvaluationSetService) UpdateEvaluationSetSchema(arg0 context.Context, arg1 *eval_set.UpdateEvaluationSetSchemaRequest) (*eval_set.UpdateEvaluationSetSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvaluationSetSchema", arg0, arg1)
+	ret0, _ := ret[0].(*eval_set.UpdateEvaluationSetSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEvaluationSetSchema indicates an expected call of UpdateEvaluationSetSchema.
+func (mr *MockEvaluationSetServiceMockRecorder) UpdateEvaluationSetSchema(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvaluationSetSchema", reflect.TypeOf((*MockEvaluationSetService)(nil).UpdateEvaluationSetSchema), arg0, arg1)
+}
@@ -0,0 +1,722 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/application (interfaces: IExperimentApplication)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/experiment_app.go -package=mocks github.com/coze-dev/coze-loop/backend/modules/evaluation/application IExperimentApplication
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIExperimentApplication is a mock of IExperimentApplication interface.
+type MockIExperimentApplication struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExperimentApplicationMockRecorder
+}
+
+// MockIExperimentApplicationMockRecorder is the mock recorder for MockIExperimentApplication.
+type MockIExperimentApplicationMockRecorder struct {
+	mock *MockIExperimentApplication
+}
+
+// NewMockIExperimentApplication creates a new mock instance.
+func NewMockIExperimentApplication(ctrl *gomock.Controller) *MockIExperimentApplication {
+	mock := &MockIExperimentApplication{ctrl: ctrl}
+	mock.recorder = &MockIExperimentApplicationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExperimentApplication) EXPECT() *MockIExperimentApplicationMockRecorder {
+	return m.recorder
+}
+
+// AssociateAnnotationTag mocks base method.
+func (m *MockIExperimentApplication) AssociateAnnotationTag(arg0 context.Context, arg1 *expt.AssociateAnnotationTagReq) (*expt.AssociateAnnotationTagResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateAnnotationTag", arg0, arg1)
+	ret0, _ := ret[0].(*expt.AssociateAnnotationTagResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateAnnotationTag indicates an expected call of AssociateAnnotationTag.
+func (mr *MockIExperimentApplicationMockRecorder) AssociateAnnotationTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateAnnotationTag", reflect.TypeOf((*MockIExperimentApplication)(nil).AssociateAnnotationTag), arg0, arg1)
+}
+
+// BatchDeleteExperiments mocks base method.
+func (m *MockIExperimentApplication) BatchDeleteExperiments(arg0 context.Context, arg1 *expt.BatchDeleteExperimentsRequest) (*expt.BatchDeleteExperimentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteExperiments", arg0, arg1)
+	ret0, _ := ret[0].(*expt.BatchDeleteExperimentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDeleteExperiments indicates an expected call of BatchDeleteExperiments.
+func (mr *MockIExperimentApplicationMockRecorder) BatchDeleteExperiments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteExperiments", reflect.TypeOf((*MockIExperimentApplication)(nil).BatchDeleteExperiments), arg0, arg1)
+}
+
+// BatchGetExperimentAggrResult_ mocks base method.
+func (m *MockIExperimentApplication) BatchGetExperimentAggrResult_(arg0 context.Context, arg1 *expt.BatchGetExperimentAggrResultRequest) (*expt.BatchGetExperimentAggrResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetExperimentAggrResult_", arg0, arg1)
+	ret0, _ := ret[0].(*expt.BatchGetExperimentAggrResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetExperimentAggrResult_ indicates an expected call of BatchGetExperimentAggrResult_.
+func (mr *MockIExperimentApplicationMockRecorder) BatchGetExperimentAggrResult_(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetExperimentAggrResult_", reflect.TypeOf((*MockIExperimentApplication)(nil).BatchGetExperimentAggrResult_), arg0, arg1)
+}
+
+// BatchGetExperimentResult_ mocks base method.
+func (m *MockIExperimentApplication) BatchGetExperimentResult_(arg0 context.Context, arg1 *expt.BatchGetExperimentResultRequest) (*expt.BatchGetExperimentResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetExperimentResult_", arg0, arg1)
+	ret0, _ := ret[0].(*expt.BatchGetExperimentResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetExperimentResult_ indicates an expected call of BatchGetExperimentResult_.
+func (mr *MockIExperimentApplicationMockRecorder) BatchGetExperimentResult_(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetExperimentResult_", reflect.TypeOf((*MockIExperimentApplication)(nil).BatchGetExperimentResult_), arg0, arg1)
+}
+
+// BatchGetExperiments mocks base method.
+func (m *MockIExperimentApplication) BatchGetExperiments(arg0 context.Context, arg1 *expt.BatchGetExperimentsRequest) (*expt.BatchGetExperimentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetExperiments", arg0, arg1)
+	ret0, _ := ret[0].(*expt.BatchGetExperimentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetExperiments indicates an expected call of BatchGetExperiments.
+func (mr *MockIExperimentApplicationMockRecorder) BatchGetExperiments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetExperiments", reflect.TypeOf((*MockIExperimentApplication)(nil).BatchGetExperiments), arg0, arg1)
+}
+
+// BatchGetExptAggrResultByExperimentIDs mocks base method.
+func (m *MockIExperimentApplication) BatchGetExptAggrResultByExperimentIDs(arg0 context.Context, arg1 int64, arg2 []int64) ([]*entity.ExptAggregateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetExptAggrResultByExperimentIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.ExptAggregateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetExptAggrResultByExperimentIDs indicates an expected call of BatchGetExptAggrResultByExperimentIDs.
+func (mr *MockIExperimentApplicationMockRecorder) BatchGetExptAggrResultByExperimentIDs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetExptAggrResultByExperimentIDs", reflect.TypeOf((*MockIExperimentApplication)(nil).BatchGetExptAggrResultByExperimentIDs), arg0, arg1, arg2)
+}
+
+// CheckExperimentName mocks base method.
+func (m *MockIExperimentApplication) CheckExperimentName(arg0 context.Context, arg1 *expt.CheckExperimentNameRequest) (*expt.CheckExperimentNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckExperimentName", arg0, arg1)
+	ret0, _ := ret[0].(*expt.CheckExperimentNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckExperimentName indicates an expected call of CheckExperimentName.
+func (mr *MockIExperimentApplicationMockRecorder) CheckExperimentName(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckExperimentName", reflect.TypeOf((*MockIExperimentApplication)(nil).CheckExperimentName), arg0, arg1)
+}
+
+// CloneExperiment mocks base method.
+func (m *MockIExperimentApplication) CloneExperiment(arg0 context.Context, arg1 *expt.CloneExperimentRequest) (*expt.CloneExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.CloneExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneExperiment indicates an expected call of CloneExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) CloneExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).CloneExperiment), arg0, arg1)
+}
+
+// CompareExperiments mocks base method.
+func (m *MockIExperimentApplication) CompareExperiments(arg0 context.Context, arg1 *expt.CompareExperimentsRequest) (*expt.CompareExperimentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareExperiments", arg0, arg1)
+	ret0, _ := ret[0].(*expt.CompareExperimentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareExperiments indicates an expected call of CompareExperiments.
+func (mr *MockIExperimentApplicationMockRecorder) CompareExperiments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareExperiments", reflect.TypeOf((*MockIExperimentApplication)(nil).CompareExperiments), arg0, arg1)
+}
+
+// CreateAnnotateRecord mocks base method.
+func (m *MockIExperimentApplication) CreateAnnotateRecord(arg0 context.Context, arg1 *expt.CreateAnnotateRecordReq) (*expt.CreateAnnotateRecordResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnnotateRecord", arg0, arg1)
+	ret0, _ := ret[0].(*expt.CreateAnnotateRecordResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAnnotateRecord indicates an expected call of CreateAnnotateRecord.
+func (mr *MockIExperimentApplicationMockRecorder) CreateAnnotateRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnnotateRecord", reflect.TypeOf((*MockIExperimentApplication)(nil).CreateAnnotateRecord), arg0, arg1)
+}
+
+// CreateAnnotationAggrResult mocks base method.
+func (m *MockIExperimentApplication) CreateAnnotationAggrResult(arg0 context.Context, arg1 *entity.CreateSpecificFieldAggrResultParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnnotationAggrResult", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAnnotationAggrResult indicates an expected call of CreateAnnotationAggrResult.
+func (mr *MockIExperimentApplicationMockRecorder) CreateAnnotationAggrResult(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnnotationAggrResult", reflect.TypeOf((*MockIExperimentApplication)(nil).CreateAnnotationAggrResult), arg0, arg1)
+}
+
+// CreateExperiment mocks base method.
+func (m *MockIExperimentApplication) CreateExperiment(arg0 context.Context, arg1 *expt.CreateExperimentRequest) (*expt.CreateExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.CreateExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExperiment indicates an expected call of CreateExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) CreateExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).CreateExperiment), arg0, arg1)
+}
+
+// CreateExptAggrResult mocks base method.
+func (m *MockIExperimentApplication) CreateExptAggrResult(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExptAggrResult", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateExptAggrResult indicates an expected call of CreateExptAggrResult.
+func (mr *MockIExperimentApplicationMockRecorder) CreateExptAggrResult(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExptAggrResult", reflect.TypeOf((*MockIExperimentApplication)(nil).CreateExptAggrResult), arg0, arg1, arg2)
+}
+
+// CreateExptSchedule mocks base method.
+func (m *MockIExperimentApplication) CreateExptSchedule(arg0 context.Context, arg1 *expt.CreateExptScheduleRequest) (*expt.CreateExptScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExptSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*expt.CreateExptScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExptSchedule indicates an expected call of CreateExptSchedule.
+func (mr *MockIExperimentApplicationMockRecorder) CreateExptSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExptSchedule", reflect.TypeOf((*MockIExperimentApplication)(nil).CreateExptSchedule), arg0, arg1)
+}
+
+// DeleteAnnotationTag mocks base method.
+func (m *MockIExperimentApplication) DeleteAnnotationTag(arg0 context.Context, arg1 *expt.DeleteAnnotationTagReq) (*expt.DeleteAnnotationTagResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAnnotationTag", arg0, arg1)
+	ret0, _ := ret[0].(*expt.DeleteAnnotationTagResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAnnotationTag indicates an expected call of DeleteAnnotationTag.
+func (mr *MockIExperimentApplicationMockRecorder) DeleteAnnotationTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAnnotationTag", reflect.TypeOf((*MockIExperimentApplication)(nil).DeleteAnnotationTag), arg0, arg1)
+}
+
+// DeleteExperiment mocks base method.
+func (m *MockIExperimentApplication) DeleteExperiment(arg0 context.Context, arg1 *expt.DeleteExperimentRequest) (*expt.DeleteExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.DeleteExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExperiment indicates an expected call of DeleteExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) DeleteExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).DeleteExperiment), arg0, arg1)
+}
+
+// DeleteExptSchedule mocks base method.
+func (m *MockIExperimentApplication) DeleteExptSchedule(arg0 context.Context, arg1 *expt.DeleteExptScheduleRequest) (*expt.DeleteExptScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExptSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*expt.DeleteExptScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExptSchedule indicates an expected call of DeleteExptSchedule.
+func (mr *MockIExperimentApplicationMockRecorder) DeleteExptSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExptSchedule", reflect.TypeOf((*MockIExperimentApplication)(nil).DeleteExptSchedule), arg0, arg1)
+}
+
+// DoExport mocks base method.
+func (m *MockIExperimentApplication) DoExport(arg0 context.Context, arg1, arg2, arg3 int64, arg4 entity.ExptResultExportFormat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoExport", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DoExport indicates an expected call of DoExport.
+func (mr *MockIExperimentApplicationMockRecorder) DoExport(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoExport", reflect.TypeOf((*MockIExperimentApplication)(nil).DoExport), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Eval mocks base method.
+func (m *MockIExperimentApplication) Eval(arg0 context.Context, arg1 *entity.ExptItemEvalEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Eval indicates an expected call of Eval.
+func (mr *MockIExperimentApplicationMockRecorder) Eval(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eval", reflect.TypeOf((*MockIExperimentApplication)(nil).Eval), arg0, arg1)
+}
+
+// ExportCSV mocks base method.
+func (m *MockIExperimentApplication) ExportCSV(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session, arg4 entity.ExptResultExportFormat) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCSV", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCSV indicates an expected call of ExportCSV.
+func (mr *MockIExperimentApplicationMockRecorder) ExportCSV(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSV", reflect.TypeOf((*MockIExperimentApplication)(nil).ExportCSV), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ExportExptResult_ mocks base method.
+func (m *MockIExperimentApplication) ExportExptResult_(arg0 context.Context, arg1 *expt.ExportExptResultRequest) (*expt.ExportExptResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportExptResult_", arg0, arg1)
+	ret0, _ := ret[0].(*expt.ExportExptResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportExptResult_ indicates an expected call of ExportExptResult_.
+func (mr *MockIExperimentApplicationMockRecorder) ExportExptResult_(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportExptResult_", reflect.TypeOf((*MockIExperimentApplication)(nil).ExportExptResult_), arg0, arg1)
+}
+
+// FinishExperiment mocks base method.
+func (m *MockIExperimentApplication) FinishExperiment(arg0 context.Context, arg1 *expt.FinishExperimentRequest) (*expt.FinishExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.FinishExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishExperiment indicates an expected call of FinishExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) FinishExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).FinishExperiment), arg0, arg1)
+}
+
+// GetExptExportRecord mocks base method.
+func (m *MockIExperimentApplication) GetExptExportRecord(arg0 context.Context, arg1, arg2 int64) (*entity.ExptResultExportRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExptExportRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.ExptResultExportRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExptExportRecord indicates an expected call of GetExptExportRecord.
+func (mr *MockIExperimentApplicationMockRecorder) GetExptExportRecord(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptExportRecord", reflect.TypeOf((*MockIExperimentApplication)(nil).GetExptExportRecord), arg0, arg1, arg2)
+}
+
+// GetExptResultExportRecord mocks base method.
+func (m *MockIExperimentApplication) GetExptResultExportRecord(arg0 context.Context, arg1 *expt.GetExptResultExportRecordRequest) (*expt.GetExptResultExportRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExptResultExportRecord", arg0, arg1)
+	ret0, _ := ret[0].(*expt.GetExptResultExportRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExptResultExportRecord indicates an expected call of GetExptResultExportRecord.
+func (mr *MockIExperimentApplicationMockRecorder) GetExptResultExportRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptResultExportRecord", reflect.TypeOf((*MockIExperimentApplication)(nil).GetExptResultExportRecord), arg0, arg1)
+}
+
+// InvokeExperiment mocks base method.
+func (m *MockIExperimentApplication) InvokeExperiment(arg0 context.Context, arg1 *expt.InvokeExperimentRequest) (*expt.InvokeExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvokeExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.InvokeExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeExperiment indicates an expected call of InvokeExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) InvokeExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).InvokeExperiment), arg0, arg1)
+}
+
+// KillExperiment mocks base method.
+func (m *MockIExperimentApplication) KillExperiment(arg0 context.Context, arg1 *expt.KillExperimentRequest) (*expt.KillExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KillExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.KillExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KillExperiment indicates an expected call of KillExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) KillExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).KillExperiment), arg0, arg1)
+}
+
+// ListExperimentStats mocks base method.
+func (m *MockIExperimentApplication) ListExperimentStats(arg0 context.Context, arg1 *expt.ListExperimentStatsRequest) (*expt.ListExperimentStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExperimentStats", arg0, arg1)
+	ret0, _ := ret[0].(*expt.ListExperimentStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperimentStats indicates an expected call of ListExperimentStats.
+func (mr *MockIExperimentApplicationMockRecorder) ListExperimentStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperimentStats", reflect.TypeOf((*MockIExperimentApplication)(nil).ListExperimentStats), arg0, arg1)
+}
+
+// ListExperiments mocks base method.
+func (m *MockIExperimentApplication) ListExperiments(arg0 context.Context, arg1 *expt.ListExperimentsRequest) (*expt.ListExperimentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExperiments", arg0, arg1)
+	ret0, _ := ret[0].(*expt.ListExperimentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperiments indicates an expected call of ListExperiments.
+func (mr *MockIExperimentApplicationMockRecorder) ListExperiments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperiments", reflect.TypeOf((*MockIExperimentApplication)(nil).ListExperiments), arg0, arg1)
+}
+
+// ListExportRecord mocks base method.
+func (m *MockIExperimentApplication) ListExportRecord(arg0 context.Context, arg1, arg2 int64, arg3 entity.Page) ([]*entity.ExptResultExportRecord, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExportRecord", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.ExptResultExportRecord)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListExportRecord indicates an expected call of ListExportRecord.
+func (mr *MockIExperimentApplicationMockRecorder) ListExportRecord(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExportRecord", reflect.TypeOf((*MockIExperimentApplication)(nil).ListExportRecord), arg0, arg1, arg2, arg3)
+}
+
+// ListExptResultExportRecord mocks base method.
+func (m *MockIExperimentApplication) ListExptResultExportRecord(arg0 context.Context, arg1 *expt.ListExptResultExportRecordRequest) (*expt.ListExptResultExportRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExptResultExportRecord", arg0, arg1)
+	ret0, _ := ret[0].(*expt.ListExptResultExportRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExptResultExportRecord indicates an expected call of ListExptResultExportRecord.
+func (mr *MockIExperimentApplicationMockRecorder) ListExptResultExportRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExptResultExportRecord", reflect.TypeOf((*MockIExperimentApplication)(nil).ListExptResultExportRecord), arg0, arg1)
+}
+
+// ListExptScheduleRuns mocks base method.
+func (m *MockIExperimentApplication) ListExptScheduleRuns(arg0 context.Context, arg1 *expt.ListExptScheduleRunsRequest) (*expt.ListExptScheduleRunsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExptScheduleRuns", arg0, arg1)
+	ret0, _ := ret[0].(*expt.ListExptScheduleRunsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExptScheduleRuns indicates an expected call of ListExptScheduleRuns.
+func (mr *MockIExperimentApplicationMockRecorder) ListExptScheduleRuns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExptScheduleRuns", reflect.TypeOf((*MockIExperimentApplication)(nil).ListExptScheduleRuns), arg0, arg1)
+}
+
+// ListExptSchedules mocks base method.
+func (m *MockIExperimentApplication) ListExptSchedules(arg0 context.Context, arg1 *expt.ListExptSchedulesRequest) (*expt.ListExptSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExptSchedules", arg0, arg1)
+	ret0, _ := ret[0].(*expt.ListExptSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExptSchedules indicates an expected call of ListExptSchedules.
+func (mr *MockIExperimentApplicationMockRecorder) ListExptSchedules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExptSchedules", reflect.TypeOf((*MockIExperimentApplication)(nil).ListExptSchedules), arg0, arg1)
+}
+
+// PauseExperiment mocks base method.
+func (m *MockIExperimentApplication) PauseExperiment(arg0 context.Context, arg1 *expt.PauseExperimentRequest) (*expt.PauseExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.PauseExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseExperiment indicates an expected call of PauseExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) PauseExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).PauseExperiment), arg0, arg1)
+}
+
+// ResumeExperiment mocks base method.
+func (m *MockIExperimentApplication) ResumeExperiment(arg0 context.Context, arg1 *expt.ResumeExperimentRequest) (*expt.ResumeExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.ResumeExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeExperiment indicates an expected call of ResumeExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) ResumeExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).ResumeExperiment), arg0, arg1)
+}
+
+// RetryExperiment mocks base method.
+func (m *MockIExperimentApplication) RetryExperiment(arg0 context.Context, arg1 *expt.RetryExperimentRequest) (*expt.RetryExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.RetryExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryExperiment indicates an expected call of RetryExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) RetryExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).RetryExperiment), arg0, arg1)
+}
+
+// RunExperiment mocks base method.
+func (m *MockIExperimentApplication) RunExperiment(arg0 context.Context, arg1 *expt.RunExperimentRequest) (*expt.RunExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.RunExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunExperiment indicates an expected call of RunExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) RunExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).RunExperiment), arg0, arg1)
+}
+
+// Schedule mocks base method.
+func (m *MockIExperimentApplication) Schedule(arg0 context.Context, arg1 *entity.ExptScheduleEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockIExperimentApplicationMockRecorder) Schedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockIExperimentApplication)(nil).Schedule), arg0, arg1)
+}
+
+// StartExptScheduleTrigger mocks base method.
+func (m *MockIExperimentApplication) StartExptScheduleTrigger(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartExptScheduleTrigger", arg0)
+}
+
+// StartExptScheduleTrigger indicates an expected call of StartExptScheduleTrigger.
+func (mr *MockIExperimentApplicationMockRecorder) StartExptScheduleTrigger(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExptScheduleTrigger", reflect.TypeOf((*MockIExperimentApplication)(nil).StartExptScheduleTrigger), arg0)
+}
+
+// SubmitExperiment mocks base method.
+func (m *MockIExperimentApplication) SubmitExperiment(arg0 context.Context, arg1 *expt.SubmitExperimentRequest) (*expt.SubmitExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.SubmitExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitExperiment indicates an expected call of SubmitExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) SubmitExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).SubmitExperiment), arg0, arg1)
+}
+
+// UpdateAnnotateRecord mocks base method.
+func (m *MockIExperimentApplication) UpdateAnnotateRecord(arg0 context.Context, arg1 *expt.UpdateAnnotateRecordReq) (*expt.UpdateAnnotateRecordResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnnotateRecord", arg0, arg1)
+	ret0, _ := ret[0].(*expt.UpdateAnnotateRecordResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAnnotateRecord indicates an expected call of UpdateAnnotateRecord.
+func (mr *MockIExperimentApplicationMockRecorder) UpdateAnnotateRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotateRecord", reflect.TypeOf((*MockIExperimentApplication)(nil).UpdateAnnotateRecord), arg0, arg1)
+}
+
+// UpdateAnnotationAggrResult mocks base method.
+func (m *MockIExperimentApplication) UpdateAnnotationAggrResult(arg0 context.Context, arg1 *entity.UpdateExptAggrResultParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnnotationAggrResult", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAnnotationAggrResult indicates an expected call of UpdateAnnotationAggrResult.
+func (mr *MockIExperimentApplicationMockRecorder) UpdateAnnotationAggrResult(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotationAggrResult", reflect.TypeOf((*MockIExperimentApplication)(nil).UpdateAnnotationAggrResult), arg0, arg1)
+}
+
+// UpdateExperiment mocks base method.
+func (m *MockIExperimentApplication) UpdateExperiment(arg0 context.Context, arg1 *expt.UpdateExperimentRequest) (*expt.UpdateExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExperiment", arg0, arg1)
+	ret0, _ := ret[0].(*expt.UpdateExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExperiment indicates an expected call of UpdateExperiment.
+func (mr *MockIExperimentApplicationMockRecorder) UpdateExperiment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExperiment", reflect.TypeOf((*MockIExperimentApplication)(nil).UpdateExperiment), arg0, arg1)
+}
+
+// UpdateExportRecord mocks base method.
+func (m *MockIExperimentApplication) UpdateExportRecord(arg0 context.Context, arg1 *entity.ExptResultExportRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExportRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExportRecord indicates an expected call of UpdateExportRecord.
+func (mr *MockIExperimentApplicationMockRecorder) UpdateExportRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExportRecord", reflect.TypeOf((*MockIExperimentApplication)(nil).UpdateExportRecord), arg0, arg1)
+}
+
+// UpdateExptAggrResult mocks base method.
+func (m *MockIExperimentApplication) UpdateExptAggrResult(arg0 context.Context, arg1 *entity.UpdateExptAggrResultParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExptAggrResult", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExptAggrResult indicates an expected call of UpdateExptAggrResult.
+func (mr *MockIExperimentApplicationMockRecorder) UpdateExptAggrResult(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExptAggrResult", reflect.TypeOf((*MockIExperimentApplication)(nil).UpdateExptAggrResult), arg0, arg1)
+}
+
+// UpdateExptSchedule mocks base method.
+func (m *MockIExperimentApplication) UpdateExptSchedule(arg0 context.Context, arg1 *expt.UpdateExptScheduleRequest) (*expt.UpdateExptScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExptSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*expt.UpdateExptScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExptSchedule indicates an expected call of UpdateExptSchedule.
+func (mr *MockIExperimentApplicationMockRecorder) UpdateExptSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExptSchedule", reflect.TypeOf((*MockIExperimentApplication)(nil).UpdateExptSchedule), arg0, arg1)
+}
+
+// UpsertExptTurnResultFilter mocks base method.
+func (m *MockIExperimentApplication) UpsertExptTurnResultFilter(arg0 context.Context, arg1 *expt.UpsertExptTurnResultFilterRequest) (*expt.UpsertExptTurnResultFilterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertExptTurnResultFilter", arg0, arg1)
+	ret0, _ := ret[0].(*expt.UpsertExptTurnResultFilterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertExptTurnResultFilter indicates an expected call of UpsertExptTurnResultFilter.
+func (mr *MockIExperimentApplicationMockRecorder) UpsertExptTurnResultFilter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertExptTurnResultFilter", reflect.TypeOf((*MockIExperimentApplication)(nil).UpsertExptTurnResultFilter), arg0, arg1)
+}
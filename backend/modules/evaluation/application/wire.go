@@ -13,6 +13,7 @@ import (
 
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -32,7 +33,11 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/file/fileservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/user/userservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime/llmruntimeservice"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/observabilitytraceservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/promptmanageservice"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/coderuntime"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/httpclient"
 	mtr "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
 	componentrpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
@@ -40,6 +45,8 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service"
 	domainservice "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service"
+	coderuntimeimpl "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/coderuntime"
+	httpclientimpl "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/httpclient"
 	evaltargetmtr "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/metrics/eval_target"
 	evalsetmtr "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/metrics/evaluation_set"
 	evaluatormtr "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/metrics/evaluator"
@@ -55,11 +62,13 @@ import (
 	iredis "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/idem/redis"
 	targetrepo "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/target"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/target/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/coze"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/data"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/foundation"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/llm"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/prompt"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/tag"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/trace"
 	evalconf "github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/conf"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 )
@@ -78,6 +87,8 @@ var (
 		domainservice.NewExptRecordEvalService,
 		domainservice.NewExptAnnotateService,
 		domainservice.NewExptResultExportService,
+		domainservice.NewExptCompareService,
+		domainservice.NewExptScheduleService,
 		domainservice.NewSchedulerModeFactory,
 		experiment.NewExptRepo,
 		experiment.NewExptStatsRepo,
@@ -88,6 +99,7 @@ var (
 		experiment.NewExptTurnResultFilterRepo,
 		experiment.NewExptAnnotateRepo,
 		experiment.NewExptResultExportRecordRepo,
+		experiment.NewExptScheduleRepo,
 		experiment.NewQuotaService,
 		idem.NewIdempotentService,
 		exptmysql.NewExptDAO,
@@ -103,6 +115,8 @@ var (
 		exptmysql.NewAnnotateRecordDAO,
 		exptmysql.NewExptTurnResultTagRefDAO,
 		exptmysql.NewExptResultExportRecordDAO,
+		exptmysql.NewExptScheduleDAO,
+		exptmysql.NewExptScheduleRunDAO,
 		exptredis.NewQuotaDAO,
 		iredis.NewIdemDAO,
 		exptck.NewExptTurnResultFilterDAO,
@@ -125,6 +139,7 @@ var (
 		domainservice.NewEvaluatorServiceImpl,
 		domainservice.NewEvaluatorRecordServiceImpl,
 		NewEvaluatorSourceServices,
+		NewCodeRuntimes,
 		llm.NewLLMRPCProvider,
 		evaluatorrepo.NewEvaluatorRepo,
 		evaluatorrepo.NewEvaluatorRecordRepo,
@@ -174,6 +189,9 @@ var (
 		domainservice.NewEvalTargetServiceImpl,
 		NewSourceTargetOperators,
 		prompt.NewPromptRPCAdapter,
+		coze.NewCozeRPCAdapter,
+		trace.NewTraceRPCAdapter,
+		httpclientimpl.NewHTTPClient,
 		targetrepo.NewEvalTargetRepo,
 		mysql.NewEvalTargetDAO,
 		mysql.NewEvalTargetRecordDAO,
@@ -182,6 +200,7 @@ var (
 
 	evalTargetSet = wire.NewSet(
 		NewEvalTargetHandlerImpl,
+		evalconf.NewExptConfiger,
 		evaltargetmtr.NewEvalTargetMetrics,
 		foundation.NewAuthRPCProvider,
 		targetDomainService,
@@ -189,9 +208,13 @@ var (
 	)
 )
 
-func NewSourceTargetOperators(adapter rpc.IPromptRPCAdapter) map[entity.EvalTargetType]service.ISourceEvalTargetOperateService {
+func NewSourceTargetOperators(adapter rpc.IPromptRPCAdapter, cozeAdapter rpc.ICozeRPCAdapter, traceAdapter rpc.ITraceRPCAdapter, httpClient httpclient.IHTTPClient, configer component.IConfiger) map[entity.EvalTargetType]service.ISourceEvalTargetOperateService {
 	return map[entity.EvalTargetType]service.ISourceEvalTargetOperateService{
-		entity.EvalTargetTypeLoopPrompt: service.NewPromptSourceEvalTargetServiceImpl(adapter),
+		entity.EvalTargetTypeLoopPrompt:   service.NewPromptSourceEvalTargetServiceImpl(adapter),
+		entity.EvalTargetTypeCozeBot:      service.NewCozeBotSourceEvalTargetServiceImpl(cozeAdapter),
+		entity.EvalTargetTypeCozeWorkflow: service.NewCozeWorkflowSourceEvalTargetServiceImpl(cozeAdapter),
+		entity.EvalTargetTypeLoopTrace:    service.NewLoopTraceSourceEvalTargetServiceImpl(traceAdapter),
+		entity.EvalTargetTypeHTTP:         service.NewHTTPSourceEvalTargetServiceImpl(httpClient, configer),
 	}
 }
 
@@ -222,6 +245,8 @@ func InitExperimentApplication(
 	ckDb ck.Provider,
 	tagClient tagservice.Client,
 	objectStorage fileserver.ObjectStorage,
+	traceClient observabilitytraceservice.Client,
+	kms dkms.IDKMS,
 ) (IExperimentApplication, error) {
 	wire.Build(
 		experimentSet,
@@ -269,15 +294,27 @@ func InitEvalTargetApplication(ctx context.Context,
 	executeClient promptexecuteservice.Client,
 	authClient authservice.Client,
 	cmdable redis.Cmdable,
-	meter metrics.Meter) evaluation.EvalTargetService {
+	configFactory conf.IConfigLoaderFactory,
+	meter metrics.Meter,
+	traceClient observabilitytraceservice.Client,
+	kms dkms.IDKMS) (evaluation.EvalTargetService, error) {
 	wire.Build(
 		evalTargetSet,
 	)
-	return nil
+	return nil, nil
 }
 
-func NewEvaluatorSourceServices(llmProvider componentrpc.ILLMProvider, metric mtr.EvaluatorExecMetrics, config evalconf.IConfiger) []domainservice.EvaluatorSourceService {
+func NewEvaluatorSourceServices(llmProvider componentrpc.ILLMProvider, runtimes []coderuntime.ICodeRuntime, metric mtr.EvaluatorExecMetrics, config evalconf.IConfiger) []domainservice.EvaluatorSourceService {
 	return []domainservice.EvaluatorSourceService{
 		domainservice.NewEvaluatorSourcePromptServiceImpl(llmProvider, metric, config),
+		domainservice.NewEvaluatorSourceCodeServiceImpl(runtimes, metric, config),
+		domainservice.NewEvaluatorSourceBuiltinServiceImpl(metric),
+		domainservice.NewEvaluatorSourcePairwiseServiceImpl(llmProvider, metric),
+	}
+}
+
+func NewCodeRuntimes() []coderuntime.ICodeRuntime {
+	return []coderuntime.ICodeRuntime{
+		coderuntimeimpl.NewJSRuntime(),
 	}
 }
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/coderuntime (interfaces: ICodeRuntime)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/code_runtime.go -package=mocks . ICodeRuntime
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICodeRuntime is a mock of ICodeRuntime interface.
+type MockICodeRuntime struct {
+	ctrl     *gomock.Controller
+	recorder *MockICodeRuntimeMockRecorder
+}
+
+// MockICodeRuntimeMockRecorder is the mock recorder for MockICodeRuntime.
+type MockICodeRuntimeMockRecorder struct {
+	mock *MockICodeRuntime
+}
+
+// NewMockICodeRuntime creates a new mock instance.
+func NewMockICodeRuntime(ctrl *gomock.Controller) *MockICodeRuntime {
+	mock := &MockICodeRuntime{ctrl: ctrl}
+	mock.recorder = &MockICodeRuntimeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICodeRuntime) EXPECT() *MockICodeRuntimeMockRecorder {
+	return m.recorder
+}
+
+// LanguageType mocks base method.
+func (m *MockICodeRuntime) LanguageType() entity.LanguageType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LanguageType")
+	ret0, _ := ret[0].(entity.LanguageType)
+	return ret0
+}
+
+// LanguageType indicates an expected call of LanguageType.
+func (mr *MockICodeRuntimeMockRecorder) LanguageType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LanguageType", reflect.TypeOf((*MockICodeRuntime)(nil).LanguageType))
+}
+
+// Run mocks base method.
+func (m *MockICodeRuntime) Run(arg0 context.Context, arg1 *entity.CodeRunParam) (*entity.CodeRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0, arg1)
+	ret0, _ := ret[0].(*entity.CodeRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockICodeRuntimeMockRecorder) Run(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockICodeRuntime)(nil).Run), arg0, arg1)
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package coderuntime
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// ICodeRuntime 执行用户代码的沙箱运行时，每种语言一个实现
+//
+//go:generate mockgen -destination=mocks/code_runtime.go -package=mocks . ICodeRuntime
+type ICodeRuntime interface {
+	LanguageType() entity.LanguageType
+	Run(ctx context.Context, param *entity.CodeRunParam) (*entity.CodeRunResult, error)
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpclient
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// IHTTPClient 调用评测对象 HTTP 服务的客户端，非 2xx 响应不视为错误，由调用方按状态码处理
+//
+//go:generate mockgen -destination=mocks/http_client.go -package=mocks . IHTTPClient
+type IHTTPClient interface {
+	Do(ctx context.Context, req *entity.HTTPRequest) (*entity.HTTPResponse, error)
+}
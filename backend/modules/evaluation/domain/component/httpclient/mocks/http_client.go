@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/httpclient (interfaces: IHTTPClient)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/http_client.go -package=mocks . IHTTPClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIHTTPClient is a mock of IHTTPClient interface.
+type MockIHTTPClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHTTPClientMockRecorder
+}
+
+// MockIHTTPClientMockRecorder is the mock recorder for MockIHTTPClient.
+type MockIHTTPClientMockRecorder struct {
+	mock *MockIHTTPClient
+}
+
+// NewMockIHTTPClient creates a new mock instance.
+func NewMockIHTTPClient(ctrl *gomock.Controller) *MockIHTTPClient {
+	mock := &MockIHTTPClient{ctrl: ctrl}
+	mock.recorder = &MockIHTTPClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHTTPClient) EXPECT() *MockIHTTPClientMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockIHTTPClient) Do(arg0 context.Context, arg1 *entity.HTTPRequest) (*entity.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", arg0, arg1)
+	ret0, _ := ret[0].(*entity.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIHTTPClientMockRecorder) Do(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIHTTPClient)(nil).Do), arg0, arg1)
+}
@@ -132,3 +132,17 @@ func (mr *MockIConfigerMockRecorder) GetExptTurnResultFilterBmqProducerCfg(arg0
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptTurnResultFilterBmqProducerCfg", reflect.TypeOf((*MockIConfiger)(nil).GetExptTurnResultFilterBmqProducerCfg), arg0)
 }
+
+// GetHTTPTargetNetworkConf mocks base method.
+func (m *MockIConfiger) GetHTTPTargetNetworkConf(arg0 context.Context) *entity.HTTPTargetNetworkConf {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHTTPTargetNetworkConf", arg0)
+	ret0, _ := ret[0].(*entity.HTTPTargetNetworkConf)
+	return ret0
+}
+
+// GetHTTPTargetNetworkConf indicates an expected call of GetHTTPTargetNetworkConf.
+func (mr *MockIConfigerMockRecorder) GetHTTPTargetNetworkConf(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHTTPTargetNetworkConf", reflect.TypeOf((*MockIConfiger)(nil).GetHTTPTargetNetworkConf), arg0)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rpc
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// ICozeRPCAdapter 访问 Coze 智能体与工作流的适配器，由部署环境提供具体实现
+//
+//go:generate mockgen -destination=mocks/coze.go -package=mocks . ICozeRPCAdapter
+type ICozeRPCAdapter interface {
+	ListBot(ctx context.Context, param *ListCozeSourceParam) (bots []*CozeBotInfo, nextCursor string, hasMore bool, err error)
+	ListBotVersion(ctx context.Context, param *ListCozeSourceVersionParam) (bots []*CozeBotInfo, nextCursor string, hasMore bool, err error)
+	MGetBot(ctx context.Context, spaceID int64, queries []*MGetCozeBotQuery) (bots []*CozeBotInfo, err error)
+	// StreamChatBot 与智能体对话，以流式返回回复内容
+	StreamChatBot(ctx context.Context, spaceID int64, param *ChatCozeBotParam) (reader CozeStreamReader, err error)
+
+	ListWorkflow(ctx context.Context, param *ListCozeSourceParam) (workflows []*CozeWorkflowInfo, nextCursor string, hasMore bool, err error)
+	ListWorkflowVersion(ctx context.Context, param *ListCozeSourceVersionParam) (workflows []*CozeWorkflowInfo, nextCursor string, hasMore bool, err error)
+	MGetWorkflow(ctx context.Context, spaceID int64, queries []*MGetCozeWorkflowQuery) (workflows []*CozeWorkflowInfo, err error)
+	// StreamRunWorkflow 运行工作流，以流式返回结束节点的输出
+	StreamRunWorkflow(ctx context.Context, spaceID int64, param *RunCozeWorkflowParam) (reader CozeStreamReader, err error)
+}
+
+// CozeStreamReader 流式输出读取器，读取完毕时 Recv 返回 io.EOF
+type CozeStreamReader interface {
+	Recv() (*CozeStreamChunk, error)
+	Close() error
+}
+
+type CozeStreamChunk struct {
+	// 增量输出内容
+	Content string
+	// 截至当前分片的累计 token 用量，通常只在最后一个分片中返回
+	TokenUsage *entity.TokenUsage
+}
+
+type ListCozeSourceParam struct {
+	SpaceID     int64
+	PageSize    int32
+	Cursor      *string
+	KeyWord     *string
+	BotInfoType entity.CozeBotInfoType
+}
+
+type ListCozeSourceVersionParam struct {
+	SpaceID  int64
+	SourceID string
+	PageSize int32
+	Cursor   *string
+}
+
+type MGetCozeBotQuery struct {
+	BotID int64
+	// 为空时查询最新版本
+	Version     *string
+	BotInfoType entity.CozeBotInfoType
+}
+
+type MGetCozeWorkflowQuery struct {
+	WorkflowID string
+	// 为空时查询最新版本
+	Version *string
+}
+
+type CozeBotInfo struct {
+	BotID          int64
+	Version        string
+	BotInfoType    entity.CozeBotInfoType
+	PublishVersion *string
+	Name           string
+	AvatarURL      string
+	Description    string
+}
+
+type CozeWorkflowInfo struct {
+	ID          string
+	Version     string
+	Name        string
+	AvatarURL   string
+	Description string
+	// 开始节点的输入参数
+	InputParams []*CozeWorkflowParam
+}
+
+type CozeWorkflowParam struct {
+	Name     string
+	Type     VariableType
+	Required bool
+}
+
+type ChatCozeBotParam struct {
+	BotID          int64
+	BotVersion     string
+	BotInfoType    entity.CozeBotInfoType
+	PublishVersion *string
+	Query          string
+	History        []*entity.Message
+	UserID         string
+}
+
+type RunCozeWorkflowParam struct {
+	WorkflowID string
+	Version    string
+	Parameters map[string]string
+	UserID     string
+}
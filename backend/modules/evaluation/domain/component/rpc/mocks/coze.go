@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc (interfaces: ICozeRPCAdapter)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/coze.go -package=mocks . ICozeRPCAdapter
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	rpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICozeRPCAdapter is a mock of ICozeRPCAdapter interface.
+type MockICozeRPCAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockICozeRPCAdapterMockRecorder
+}
+
+// MockICozeRPCAdapterMockRecorder is the mock recorder for MockICozeRPCAdapter.
+type MockICozeRPCAdapterMockRecorder struct {
+	mock *MockICozeRPCAdapter
+}
+
+// NewMockICozeRPCAdapter creates a new mock instance.
+func NewMockICozeRPCAdapter(ctrl *gomock.Controller) *MockICozeRPCAdapter {
+	mock := &MockICozeRPCAdapter{ctrl: ctrl}
+	mock.recorder = &MockICozeRPCAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICozeRPCAdapter) EXPECT() *MockICozeRPCAdapterMockRecorder {
+	return m.recorder
+}
+
+// ListBot mocks base method.
+func (m *MockICozeRPCAdapter) ListBot(arg0 context.Context, arg1 *rpc.ListCozeSourceParam) ([]*rpc.CozeBotInfo, string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBot", arg0, arg1)
+	ret0, _ := ret[0].([]*rpc.CozeBotInfo)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ListBot indicates an expected call of ListBot.
+func (mr *MockICozeRPCAdapterMockRecorder) ListBot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBot", reflect.TypeOf((*MockICozeRPCAdapter)(nil).ListBot), arg0, arg1)
+}
+
+// ListBotVersion mocks base method.
+func (m *MockICozeRPCAdapter) ListBotVersion(arg0 context.Context, arg1 *rpc.ListCozeSourceVersionParam) ([]*rpc.CozeBotInfo, string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBotVersion", arg0, arg1)
+	ret0, _ := ret[0].([]*rpc.CozeBotInfo)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ListBotVersion indicates an expected call of ListBotVersion.
+func (mr *MockICozeRPCAdapterMockRecorder) ListBotVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBotVersion", reflect.TypeOf((*MockICozeRPCAdapter)(nil).ListBotVersion), arg0, arg1)
+}
+
+// ListWorkflow mocks base method.
+func (m *MockICozeRPCAdapter) ListWorkflow(arg0 context.Context, arg1 *rpc.ListCozeSourceParam) ([]*rpc.CozeWorkflowInfo, string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflow", arg0, arg1)
+	ret0, _ := ret[0].([]*rpc.CozeWorkflowInfo)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ListWorkflow indicates an expected call of ListWorkflow.
+func (mr *MockICozeRPCAdapterMockRecorder) ListWorkflow(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflow", reflect.TypeOf((*MockICozeRPCAdapter)(nil).ListWorkflow), arg0, arg1)
+}
+
+// ListWorkflowVersion mocks base method.
+func (m *MockICozeRPCAdapter) ListWorkflowVersion(arg0 context.Context, arg1 *rpc.ListCozeSourceVersionParam) ([]*rpc.CozeWorkflowInfo, string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowVersion", arg0, arg1)
+	ret0, _ := ret[0].([]*rpc.CozeWorkflowInfo)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ListWorkflowVersion indicates an expected call of ListWorkflowVersion.
+func (mr *MockICozeRPCAdapterMockRecorder) ListWorkflowVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowVersion", reflect.TypeOf((*MockICozeRPCAdapter)(nil).ListWorkflowVersion), arg0, arg1)
+}
+
+// MGetBot mocks base method.
+func (m *MockICozeRPCAdapter) MGetBot(arg0 context.Context, arg1 int64, arg2 []*rpc.MGetCozeBotQuery) ([]*rpc.CozeBotInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MGetBot", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*rpc.CozeBotInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MGetBot indicates an expected call of MGetBot.
+func (mr *MockICozeRPCAdapterMockRecorder) MGetBot(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetBot", reflect.TypeOf((*MockICozeRPCAdapter)(nil).MGetBot), arg0, arg1, arg2)
+}
+
+// MGetWorkflow mocks base method.
+func (m *MockICozeRPCAdapter) MGetWorkflow(arg0 context.Context, arg1 int64, arg2 []*rpc.MGetCozeWorkflowQuery) ([]*rpc.CozeWorkflowInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MGetWorkflow", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*rpc.CozeWorkflowInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MGetWorkflow indicates an expected call of MGetWorkflow.
+func (mr *MockICozeRPCAdapterMockRecorder) MGetWorkflow(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetWorkflow", reflect.TypeOf((*MockICozeRPCAdapter)(nil).MGetWorkflow), arg0, arg1, arg2)
+}
+
+// StreamChatBot mocks base method.
+func (m *MockICozeRPCAdapter) StreamChatBot(arg0 context.Context, arg1 int64, arg2 *rpc.ChatCozeBotParam) (rpc.CozeStreamReader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamChatBot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(rpc.CozeStreamReader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamChatBot indicates an expected call of StreamChatBot.
+func (mr *MockICozeRPCAdapterMockRecorder) StreamChatBot(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamChatBot", reflect.TypeOf((*MockICozeRPCAdapter)(nil).StreamChatBot), arg0, arg1, arg2)
+}
+
+// StreamRunWorkflow mocks base method.
+func (m *MockICozeRPCAdapter) StreamRunWorkflow(arg0 context.Context, arg1 int64, arg2 *rpc.RunCozeWorkflowParam) (rpc.CozeStreamReader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamRunWorkflow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(rpc.CozeStreamReader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamRunWorkflow indicates an expected call of StreamRunWorkflow.
+func (mr *MockICozeRPCAdapterMockRecorder) StreamRunWorkflow(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamRunWorkflow", reflect.TypeOf((*MockICozeRPCAdapter)(nil).StreamRunWorkflow), arg0, arg1, arg2)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc (interfaces: ITraceRPCAdapter)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/trace.go -package=mocks . ITraceRPCAdapter
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	rpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITraceRPCAdapter is a mock of ITraceRPCAdapter interface.
+type MockITraceRPCAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockITraceRPCAdapterMockRecorder
+}
+
+// MockITraceRPCAdapterMockRecorder is the mock recorder for MockITraceRPCAdapter.
+type MockITraceRPCAdapterMockRecorder struct {
+	mock *MockITraceRPCAdapter
+}
+
+// NewMockITraceRPCAdapter creates a new mock instance.
+func NewMockITraceRPCAdapter(ctrl *gomock.Controller) *MockITraceRPCAdapter {
+	mock := &MockITraceRPCAdapter{ctrl: ctrl}
+	mock.recorder = &MockITraceRPCAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITraceRPCAdapter) EXPECT() *MockITraceRPCAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetTraceSpans mocks base method.
+func (m *MockITraceRPCAdapter) GetTraceSpans(arg0 context.Context, arg1 *rpc.GetTraceSpansParam) ([]*rpc.TraceSpan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraceSpans", arg0, arg1)
+	ret0, _ := ret[0].([]*rpc.TraceSpan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTraceSpans indicates an expected call of GetTraceSpans.
+func (mr *MockITraceRPCAdapterMockRecorder) GetTraceSpans(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraceSpans", reflect.TypeOf((*MockITraceRPCAdapter)(nil).GetTraceSpans), arg0, arg1)
+}
@@ -106,6 +106,8 @@ const (
 type MGetPromptQuery struct {
 	PromptID int64
 	Version  *string
+	// Label 发布标签，Version 为空时按标签解析提交版本
+	Label *string
 }
 
 type PromptPublishInfo struct {
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rpc
+
+import (
+	"context"
+)
+
+// ITraceRPCAdapter 读取观测模块中已上报的 trace 数据
+//
+//go:generate mockgen -destination=mocks/trace.go -package=mocks . ITraceRPCAdapter
+type ITraceRPCAdapter interface {
+	GetTraceSpans(ctx context.Context, param *GetTraceSpansParam) (spans []*TraceSpan, err error)
+}
+
+type GetTraceSpansParam struct {
+	SpaceID int64
+	TraceID string
+	// 为空时返回 trace 下的全部 span
+	SpanIDs []string
+	// 查询时间范围，单位 ms
+	StartTime int64
+	EndTime   int64
+}
+
+type TraceSpan struct {
+	TraceID  string
+	SpanID   string
+	ParentID string
+	SpanName string
+	SpanType string
+	// 单位 ms
+	StartedAt  int64
+	DurationMS int64
+	StatusCode int32
+	Input      string
+	Output     string
+	CustomTags map[string]string
+	SystemTags map[string]string
+}
+
+// IsRoot 是否为 trace 的根节点
+func (s *TraceSpan) IsRoot() bool {
+	return s.ParentID == "" || s.ParentID == "0"
+}
@@ -3,6 +3,17 @@
 
 package entity
 
+import (
+	"fmt"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
 type Evaluator struct {
 	ID             int64
 	SpaceID        int64
@@ -13,25 +24,47 @@ type Evaluator struct {
 	LatestVersion  string
 	BaseInfo       *BaseInfo
 
-	PromptEvaluatorVersion *PromptEvaluatorVersion
+	PromptEvaluatorVersion   *PromptEvaluatorVersion
+	CodeEvaluatorVersion     *CodeEvaluatorVersion
+	BuiltinEvaluatorVersion  *BuiltinEvaluatorVersion
+	PairwiseEvaluatorVersion *PairwiseEvaluatorVersion
 }
 
 type EvaluatorType int64
 
 const (
-	EvaluatorTypePrompt EvaluatorType = 1
-	EvaluatorTypeCode   EvaluatorType = 2
+	EvaluatorTypePrompt   EvaluatorType = 1
+	EvaluatorTypeCode     EvaluatorType = 2
+	EvaluatorTypeBuiltin  EvaluatorType = 3
+	EvaluatorTypePairwise EvaluatorType = 4
 )
 
 var EvaluatorTypeSet = map[EvaluatorType]struct{}{
-	EvaluatorTypePrompt: {},
-	EvaluatorTypeCode:   {},
+	EvaluatorTypePrompt:   {},
+	EvaluatorTypeCode:     {},
+	EvaluatorTypeBuiltin:  {},
+	EvaluatorTypePairwise: {},
 }
 
 func (e *Evaluator) GetEvaluatorVersion() IEvaluatorVersion {
 	switch e.EvaluatorType {
 	case EvaluatorTypePrompt:
 		return e.PromptEvaluatorVersion
+	case EvaluatorTypeCode:
+		if e.CodeEvaluatorVersion == nil {
+			return nil
+		}
+		return e.CodeEvaluatorVersion
+	case EvaluatorTypeBuiltin:
+		if e.BuiltinEvaluatorVersion == nil {
+			return nil
+		}
+		return e.BuiltinEvaluatorVersion
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion == nil {
+			return nil
+		}
+		return e.PairwiseEvaluatorVersion
 	default:
 		return nil
 	}
@@ -41,7 +74,42 @@ func (e *Evaluator) SetEvaluatorVersion(version *Evaluator) {
 	switch e.EvaluatorType {
 	case EvaluatorTypePrompt:
 		e.PromptEvaluatorVersion = version.PromptEvaluatorVersion
+	case EvaluatorTypeCode:
+		e.CodeEvaluatorVersion = version.CodeEvaluatorVersion
+	case EvaluatorTypeBuiltin:
+		e.BuiltinEvaluatorVersion = version.BuiltinEvaluatorVersion
+	case EvaluatorTypePairwise:
+		e.PairwiseEvaluatorVersion = version.PairwiseEvaluatorVersion
 	default:
 		return
 	}
 }
+
+// validateInputBySchemas 按输入 schema 校验字段的内容类型与 json schema
+func validateInputBySchemas(inputSchemas []*ArgsSchema, input *EvaluatorInputData) error {
+	if input == nil {
+		return nil
+	}
+	inputSchemaMap := make(map[string]*ArgsSchema)
+	for _, argsSchema := range inputSchemas {
+		inputSchemaMap[gptr.Indirect(argsSchema.Key)] = argsSchema
+	}
+	for fieldKey, content := range input.InputFields {
+		if content == nil {
+			continue
+		}
+		// schema中不存在的字段无需校验
+		if argsSchema, ok := inputSchemaMap[fieldKey]; ok {
+			if !gslice.Contains(argsSchema.SupportContentTypes, gptr.Indirect(content.ContentType)) {
+				return errorx.NewByCode(errno.ContentTypeNotSupportedCode, errorx.WithExtraMsg(fmt.Sprintf("content type %v not supported", content.ContentType)))
+			}
+			if gptr.Indirect(content.ContentType) == ContentTypeText {
+				valid, err := json.ValidateJSONSchema(gptr.Indirect(argsSchema.JsonSchema), gptr.Indirect(content.Text))
+				if err != nil || !valid {
+					return errorx.NewByCode(errno.ContentSchemaInvalidCode, errorx.WithExtraMsg(fmt.Sprintf("content %v does not validate with expected schema: %v", content.Text, argsSchema.JsonSchema)))
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -43,6 +43,8 @@ type EvaluatorResult struct {
 	Score      *float64    `json:"score,omitempty"`
 	Correction *Correction `json:"correction,omitempty"`
 	Reasoning  string      `json:"reasoning,omitempty"`
+	// Preference 仅对比评估器返回
+	Preference *PairwisePreference `json:"preference,omitempty"`
 }
 
 type EvaluatorUsage struct {
@@ -3,7 +3,9 @@
 package entity
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bytedance/gg/gptr"
 	"github.com/stretchr/testify/assert"
@@ -19,7 +21,7 @@ func TestEvaluator_GetSetEvaluatorVersion(t *testing.T) {
 	ver := promptEval.GetEvaluatorVersion()
 	assert.Equal(t, promptVer, ver)
 
-	// 非Prompt类型
+	// Code类型未设置版本
 	codeEval := &Evaluator{EvaluatorType: EvaluatorTypeCode}
 	assert.Nil(t, codeEval.GetEvaluatorVersion())
 
@@ -31,6 +33,66 @@ func TestEvaluator_GetSetEvaluatorVersion(t *testing.T) {
 	// SetEvaluatorVersion 非Prompt类型
 	codeEval.SetEvaluatorVersion(newPromptVer)
 	assert.Nil(t, codeEval.PromptEvaluatorVersion)
+
+	// Code类型
+	codeVer := &CodeEvaluatorVersion{Version: "v3"}
+	codeEval.SetEvaluatorVersion(&Evaluator{EvaluatorType: EvaluatorTypeCode, CodeEvaluatorVersion: codeVer})
+	assert.Equal(t, codeVer, codeEval.GetEvaluatorVersion())
+
+	// Builtin类型
+	builtinEval := &Evaluator{EvaluatorType: EvaluatorTypeBuiltin}
+	assert.Nil(t, builtinEval.GetEvaluatorVersion())
+	builtinVer := &BuiltinEvaluatorVersion{Version: "v4"}
+	builtinEval.SetEvaluatorVersion(&Evaluator{EvaluatorType: EvaluatorTypeBuiltin, BuiltinEvaluatorVersion: builtinVer})
+	assert.Equal(t, builtinVer, builtinEval.GetEvaluatorVersion())
+
+	// Pairwise类型
+	pairwiseEval := &Evaluator{EvaluatorType: EvaluatorTypePairwise}
+	assert.Nil(t, pairwiseEval.GetEvaluatorVersion())
+	pairwiseVer := &PairwiseEvaluatorVersion{Version: "v5"}
+	pairwiseEval.SetEvaluatorVersion(&Evaluator{EvaluatorType: EvaluatorTypePairwise, PairwiseEvaluatorVersion: pairwiseVer})
+	assert.Equal(t, pairwiseVer, pairwiseEval.GetEvaluatorVersion())
+
+	// 未知类型
+	unknownEval := &Evaluator{EvaluatorType: EvaluatorType(99)}
+	assert.Nil(t, unknownEval.GetEvaluatorVersion())
+}
+
+func TestCodeEvaluatorVersion_ValidateBaseInfo(t *testing.T) {
+	var nilVer *CodeEvaluatorVersion
+	assert.Error(t, nilVer.ValidateBaseInfo())
+
+	assert.Error(t, (&CodeEvaluatorVersion{LanguageType: LanguageType(0), CodeContent: "x"}).ValidateBaseInfo())
+	assert.Error(t, (&CodeEvaluatorVersion{LanguageType: LanguageTypeJS, CodeContent: "  "}).ValidateBaseInfo())
+	assert.Error(t, (&CodeEvaluatorVersion{LanguageType: LanguageTypeJS, CodeContent: strings.Repeat("a", CodeEvaluatorMaxCodeLength+1)}).ValidateBaseInfo())
+	assert.NoError(t, (&CodeEvaluatorVersion{LanguageType: LanguageTypeJS, CodeContent: "function exec_evaluation(input) { return 1 }"}).ValidateBaseInfo())
+}
+
+func TestCodeEvaluatorVersion_ValidateInput(t *testing.T) {
+	ver := &CodeEvaluatorVersion{
+		InputSchemas: []*ArgsSchema{
+			{Key: gptr.Of("output"), SupportContentTypes: []ContentType{ContentTypeText}, JsonSchema: gptr.Of(`{"type": "string"}`)},
+		},
+	}
+	assert.NoError(t, ver.ValidateInput(&EvaluatorInputData{
+		InputFields: map[string]*Content{"output": {ContentType: gptr.Of(ContentTypeText), Text: gptr.Of("hello")}},
+	}))
+	assert.Error(t, ver.ValidateInput(&EvaluatorInputData{
+		InputFields: map[string]*Content{"output": {ContentType: gptr.Of(ContentTypeImage)}},
+	}))
+}
+
+func TestCodeEvaluatorRuntimeConf_Getters(t *testing.T) {
+	var nilConf *CodeEvaluatorRuntimeConf
+	def := DefaultCodeEvaluatorRuntimeConf()
+	assert.Equal(t, time.Duration(def.TimeoutMS)*time.Millisecond, nilConf.GetTimeout())
+	assert.Equal(t, def.MaxCallStackSize, nilConf.GetMaxCallStackSize())
+	assert.Equal(t, def.MaxOutputBytes, nilConf.GetMaxOutputBytes())
+
+	c := &CodeEvaluatorRuntimeConf{TimeoutMS: 100, MaxCallStackSize: 10, MaxOutputBytes: 20}
+	assert.Equal(t, 100*time.Millisecond, c.GetTimeout())
+	assert.Equal(t, 10, c.GetMaxCallStackSize())
+	assert.Equal(t, 20, c.GetMaxOutputBytes())
 }
 
 func TestEvaluatorRecord_GetSetBaseInfo(t *testing.T) {
@@ -162,3 +224,108 @@ func TestPromptEvaluatorVersion_ValidateBaseInfo(t *testing.T) {
 	ver = &PromptEvaluatorVersion{MessageList: []*Message{{Role: RoleUser}}, ModelConfig: &ModelConfig{ModelID: 1}}
 	assert.NoError(t, ver.ValidateBaseInfo())
 }
+
+func TestBuiltinEvaluatorVersion_ValidateBaseInfo(t *testing.T) {
+	var nilVer *BuiltinEvaluatorVersion
+	assert.Error(t, nilVer.ValidateBaseInfo())
+
+	tests := []struct {
+		name    string
+		ver     *BuiltinEvaluatorVersion
+		wantErr bool
+	}{
+		{name: "unknown type", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorType(99)}, wantErr: true},
+		{name: "exact match without config", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeExactMatch}},
+		{name: "fuzzy threshold out of range", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeFuzzyMatch, Config: &BuiltinEvaluatorConfig{Threshold: gptr.Of(1.5)}}, wantErr: true},
+		{name: "regex without pattern", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeRegex}, wantErr: true},
+		{name: "regex invalid pattern", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeRegex, Config: &BuiltinEvaluatorConfig{Pattern: "("}}, wantErr: true},
+		{name: "regex ok", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeRegex, Config: &BuiltinEvaluatorConfig{Pattern: `^\d+$`}}},
+		{name: "negative tolerance", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeNumericTolerance, Config: &BuiltinEvaluatorConfig{Tolerance: -1}}, wantErr: true},
+		{name: "negative max ngram", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeBLEU, Config: &BuiltinEvaluatorConfig{MaxNGram: -1}}, wantErr: true},
+		{name: "max ngram too large", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeBLEU, Config: &BuiltinEvaluatorConfig{MaxNGram: BuiltinEvaluatorMaxMaxNGram + 1}}, wantErr: true},
+		{name: "default max ngram", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeBLEU, Config: &BuiltinEvaluatorConfig{MaxNGram: 0}}},
+		{name: "max ngram upper bound", ver: &BuiltinEvaluatorVersion{BuiltinType: BuiltinEvaluatorTypeBLEU, Config: &BuiltinEvaluatorConfig{MaxNGram: BuiltinEvaluatorMaxMaxNGram}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ver.ValidateBaseInfo()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuiltinEvaluatorType_DefaultInputSchemas(t *testing.T) {
+	schemas := BuiltinEvaluatorTypeExactMatch.DefaultInputSchemas()
+	assert.Len(t, schemas, 2)
+	assert.Equal(t, BuiltinEvaluatorOutputKey, gptr.Indirect(schemas[0].Key))
+	assert.Equal(t, BuiltinEvaluatorReferenceKey, gptr.Indirect(schemas[1].Key))
+	assert.Equal(t, []ContentType{ContentTypeText}, schemas[1].SupportContentTypes)
+
+	schemas = BuiltinEvaluatorTypeRegex.DefaultInputSchemas()
+	assert.Len(t, schemas, 1)
+	assert.Equal(t, BuiltinEvaluatorOutputKey, gptr.Indirect(schemas[0].Key))
+
+	var nilConf *BuiltinEvaluatorConfig
+	assert.Equal(t, BuiltinEvaluatorDefaultMaxNGram, nilConf.GetMaxNGram())
+	assert.Equal(t, 2, (&BuiltinEvaluatorConfig{MaxNGram: 2}).GetMaxNGram())
+}
+
+func TestPairwisePreference_ScoreAndSwap(t *testing.T) {
+	assert.Equal(t, PairwiseScoreWin, PairwisePreferenceB.Score())
+	assert.Equal(t, PairwiseScoreLoss, PairwisePreferenceA.Score())
+	assert.Equal(t, PairwiseScoreTie, PairwisePreferenceTie.Score())
+
+	assert.Equal(t, PairwisePreferenceB, PairwisePreferenceA.Swap())
+	assert.Equal(t, PairwisePreferenceA, PairwisePreferenceB.Swap())
+	assert.Equal(t, PairwisePreferenceTie, PairwisePreferenceTie.Swap())
+}
+
+func TestEnsurePairwiseInputSchemas(t *testing.T) {
+	schemas := EnsurePairwiseInputSchemas([]*ArgsSchema{{Key: gptr.Of("input")}, {Key: gptr.Of(PairwiseEvaluatorOutputAKey)}})
+	assert.Len(t, schemas, 3)
+	assert.Equal(t, PairwiseEvaluatorOutputBKey, gptr.Indirect(schemas[2].Key))
+	assert.Equal(t, []ContentType{ContentTypeText}, schemas[2].SupportContentTypes)
+
+	assert.Len(t, EnsurePairwiseInputSchemas(schemas), 3)
+}
+
+func TestPairwiseEvaluatorVersion_ToPromptEvaluatorVersion(t *testing.T) {
+	ver := &PairwiseEvaluatorVersion{
+		ID:          1,
+		EvaluatorID: 2,
+		MessageList: []*Message{{Role: RoleSystem, Content: &Content{ContentType: gptr.Of(ContentTypeText), Text: gptr.Of("{{output_a}}")}}, nil},
+		ModelConfig: &ModelConfig{ModelID: 1},
+	}
+	promptVer := ver.ToPromptEvaluatorVersion()
+	assert.Equal(t, EvaluatorTypePairwise, promptVer.EvaluatorType)
+	assert.Equal(t, ParseTypeContent, promptVer.ParseType)
+	assert.Len(t, promptVer.MessageList, 1)
+
+	promptVer.MessageList[0].Content.Text = gptr.Of("rendered")
+	assert.Equal(t, "{{output_a}}", gptr.Indirect(ver.MessageList[0].Content.Text))
+}
+
+func TestPairwiseEvaluatorVersion_ValidateInput(t *testing.T) {
+	ver := &PairwiseEvaluatorVersion{InputSchemas: EnsurePairwiseInputSchemas(nil)}
+	text := func(s string) *Content { return &Content{ContentType: gptr.Of(ContentTypeText), Text: gptr.Of(s)} }
+
+	assert.Error(t, ver.ValidateInput(nil))
+	assert.Error(t, ver.ValidateInput(&EvaluatorInputData{InputFields: map[string]*Content{PairwiseEvaluatorOutputBKey: text("b")}}))
+	assert.NoError(t, ver.ValidateInput(&EvaluatorInputData{InputFields: map[string]*Content{
+		PairwiseEvaluatorOutputAKey: text("a"),
+		PairwiseEvaluatorOutputBKey: text("b"),
+	}}))
+}
+
+func TestPairwiseEvaluatorVersion_ValidateBaseInfo(t *testing.T) {
+	var nilVer *PairwiseEvaluatorVersion
+	assert.Error(t, nilVer.ValidateBaseInfo())
+	assert.Error(t, (&PairwiseEvaluatorVersion{ModelConfig: &ModelConfig{ModelID: 1}}).ValidateBaseInfo())
+	assert.Error(t, (&PairwiseEvaluatorVersion{MessageList: []*Message{{Role: RoleUser}}}).ValidateBaseInfo())
+	assert.Error(t, (&PairwiseEvaluatorVersion{MessageList: []*Message{{Role: RoleUser}}, ModelConfig: &ModelConfig{}}).ValidateBaseInfo())
+	assert.NoError(t, (&PairwiseEvaluatorVersion{MessageList: []*Message{{Role: RoleUser}}, ModelConfig: &ModelConfig{ModelID: 1}}).ValidateBaseInfo())
+}
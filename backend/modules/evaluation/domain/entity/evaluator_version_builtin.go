@@ -0,0 +1,211 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+type BuiltinEvaluatorVersion struct {
+	ID            int64                   `json:"id"`
+	SpaceID       int64                   `json:"space_id"`
+	EvaluatorType EvaluatorType           `json:"evaluator_type"`
+	EvaluatorID   int64                   `json:"evaluator_id"`
+	Description   string                  `json:"description"`
+	Version       string                  `json:"version"`
+	InputSchemas  []*ArgsSchema           `json:"input_schemas"`
+	BuiltinType   BuiltinEvaluatorType    `json:"builtin_type"`
+	Config        *BuiltinEvaluatorConfig `json:"config"`
+	BaseInfo      *BaseInfo               `json:"base_info"`
+}
+
+type BuiltinEvaluatorType int64
+
+const (
+	BuiltinEvaluatorTypeExactMatch       BuiltinEvaluatorType = 1
+	BuiltinEvaluatorTypeFuzzyMatch       BuiltinEvaluatorType = 2
+	BuiltinEvaluatorTypeRougeL           BuiltinEvaluatorType = 3
+	BuiltinEvaluatorTypeBLEU             BuiltinEvaluatorType = 4
+	BuiltinEvaluatorTypeEditDistance     BuiltinEvaluatorType = 5
+	BuiltinEvaluatorTypeJSONSchema       BuiltinEvaluatorType = 6
+	BuiltinEvaluatorTypeRegex            BuiltinEvaluatorType = 7
+	BuiltinEvaluatorTypeNumericTolerance BuiltinEvaluatorType = 8
+)
+
+// 内置评估器的固定输入字段，与内置 prompt 模板的字段名保持一致
+const (
+	BuiltinEvaluatorOutputKey    = "output"
+	BuiltinEvaluatorReferenceKey = "reference_output"
+)
+
+// BuiltinEvaluatorMaxTextLength 输出与参考答案的最大字符数，编辑距离、ROUGE-L 等算法的耗时与两者长度之积成正比
+const BuiltinEvaluatorMaxTextLength = 10000
+
+// BuiltinEvaluatorDefaultMaxNGram BLEU 默认计算到 4-gram
+const BuiltinEvaluatorDefaultMaxNGram = 4
+
+// BuiltinEvaluatorMaxMaxNGram BLEU 允许的最大 n-gram 阶数，阶数过大时短文本的高阶精度恒为 0，分数失去意义
+const BuiltinEvaluatorMaxMaxNGram = 8
+
+// NeedReference 是否需要参考答案字段
+func (t BuiltinEvaluatorType) NeedReference() bool {
+	switch t {
+	case BuiltinEvaluatorTypeJSONSchema, BuiltinEvaluatorTypeRegex:
+		return false
+	default:
+		return true
+	}
+}
+
+// DefaultInputSchemas 内置评估器的输入 schema，均为文本字段
+func (t BuiltinEvaluatorType) DefaultInputSchemas() []*ArgsSchema {
+	keys := []string{BuiltinEvaluatorOutputKey}
+	if t.NeedReference() {
+		keys = append(keys, BuiltinEvaluatorReferenceKey)
+	}
+	schemas := make([]*ArgsSchema, 0, len(keys))
+	for _, key := range keys {
+		schemas = append(schemas, &ArgsSchema{
+			Key:                 gptr.Of(key),
+			SupportContentTypes: []ContentType{ContentTypeText},
+			JsonSchema:          gptr.Of(consts.StringJsonSchema),
+		})
+	}
+	return schemas
+}
+
+type BuiltinEvaluatorConfig struct {
+	// CaseSensitive 字符串比较是否区分大小写
+	CaseSensitive bool `json:"case_sensitive"`
+	// IgnoreWhitespace 比较前去除首尾空白并合并连续空白
+	IgnoreWhitespace bool `json:"ignore_whitespace"`
+	// Threshold 模糊匹配阈值，设置后分数为 0/1
+	Threshold *float64 `json:"threshold,omitempty"`
+	// Pattern 正则表达式，使用 RE2 语法，匹配耗时与输入长度线性相关
+	Pattern   string `json:"pattern"`
+	FullMatch bool   `json:"full_match"`
+	// JSONSchema 为空时只校验输出是否为合法 JSON
+	JSONSchema string `json:"json_schema"`
+	// Tolerance 数值容忍误差，RelativeTolerance 为 true 时按参考值的比例计算
+	Tolerance         float64 `json:"tolerance"`
+	RelativeTolerance bool    `json:"relative_tolerance"`
+	// MaxNGram BLEU 的最大 n-gram 阶数，取值 [1, 8]，0 表示使用默认值 4
+	MaxNGram int32 `json:"max_ngram"`
+}
+
+func (c *BuiltinEvaluatorConfig) GetMaxNGram() int {
+	if c == nil || c.MaxNGram <= 0 {
+		return BuiltinEvaluatorDefaultMaxNGram
+	}
+	return int(c.MaxNGram)
+}
+
+func (do *BuiltinEvaluatorVersion) SetID(id int64) {
+	do.ID = id
+}
+
+func (do *BuiltinEvaluatorVersion) GetID() int64 {
+	return do.ID
+}
+
+func (do *BuiltinEvaluatorVersion) SetEvaluatorID(evaluatorID int64) {
+	do.EvaluatorID = evaluatorID
+}
+
+func (do *BuiltinEvaluatorVersion) GetEvaluatorID() int64 {
+	return do.EvaluatorID
+}
+
+func (do *BuiltinEvaluatorVersion) SetSpaceID(spaceID int64) {
+	do.SpaceID = spaceID
+}
+
+func (do *BuiltinEvaluatorVersion) GetSpaceID() int64 {
+	return do.SpaceID
+}
+
+func (do *BuiltinEvaluatorVersion) GetVersion() string {
+	return do.Version
+}
+
+func (do *BuiltinEvaluatorVersion) SetVersion(version string) {
+	do.Version = version
+}
+
+func (do *BuiltinEvaluatorVersion) SetDescription(description string) {
+	do.Description = description
+}
+
+func (do *BuiltinEvaluatorVersion) GetDescription() string {
+	return do.Description
+}
+
+func (do *BuiltinEvaluatorVersion) SetBaseInfo(baseInfo *BaseInfo) {
+	do.BaseInfo = baseInfo
+}
+
+func (do *BuiltinEvaluatorVersion) GetBaseInfo() *BaseInfo {
+	return do.BaseInfo
+}
+
+// SetTools 内置评估器不使用工具
+func (do *BuiltinEvaluatorVersion) SetTools(tools []*Tool) {}
+
+func (do *BuiltinEvaluatorVersion) GetPromptTemplateKey() string {
+	return ""
+}
+
+// SetPromptSuffix 内置评估器不使用 prompt 后缀
+func (do *BuiltinEvaluatorVersion) SetPromptSuffix(promptSuffix string) {}
+
+func (do *BuiltinEvaluatorVersion) GetModelConfig() *ModelConfig {
+	return nil
+}
+
+// SetParseType 内置评估器的分数由规则直接计算
+func (do *BuiltinEvaluatorVersion) SetParseType(parseType ParseType) {}
+
+// ValidateInput 验证输入数据
+func (do *BuiltinEvaluatorVersion) ValidateInput(input *EvaluatorInputData) error {
+	return validateInputBySchemas(do.InputSchemas, input)
+}
+
+// ValidateBaseInfo 校验内置评估器类型及配置
+func (do *BuiltinEvaluatorVersion) ValidateBaseInfo() error {
+	if do == nil {
+		return errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+	}
+	if do.BuiltinType < BuiltinEvaluatorTypeExactMatch || do.BuiltinType > BuiltinEvaluatorTypeNumericTolerance {
+		return errorx.NewByCode(errno.BuiltinEvaluatorTypeNotSupportedCode, errorx.WithExtraMsg(fmt.Sprintf("builtin evaluator type %v", do.BuiltinType)))
+	}
+	switch do.BuiltinType {
+	case BuiltinEvaluatorTypeFuzzyMatch:
+		if do.Config != nil && do.Config.Threshold != nil && (*do.Config.Threshold < 0 || *do.Config.Threshold > 1) {
+			return errorx.NewByCode(errno.InvalidBuiltinEvaluatorConfigCode, errorx.WithExtraMsg("threshold must be in [0, 1]"))
+		}
+	case BuiltinEvaluatorTypeRegex:
+		if do.Config == nil || do.Config.Pattern == "" {
+			return errorx.NewByCode(errno.InvalidBuiltinEvaluatorConfigCode, errorx.WithExtraMsg("pattern is empty"))
+		}
+		if _, err := regexp.Compile(do.Config.Pattern); err != nil {
+			return errorx.NewByCode(errno.InvalidBuiltinEvaluatorConfigCode, errorx.WithExtraMsg(fmt.Sprintf("invalid pattern: %v", err)))
+		}
+	case BuiltinEvaluatorTypeNumericTolerance:
+		if do.Config != nil && do.Config.Tolerance < 0 {
+			return errorx.NewByCode(errno.InvalidBuiltinEvaluatorConfigCode, errorx.WithExtraMsg("tolerance must not be negative"))
+		}
+	case BuiltinEvaluatorTypeBLEU:
+		if do.Config != nil && (do.Config.MaxNGram < 0 || do.Config.MaxNGram > BuiltinEvaluatorMaxMaxNGram) {
+			return errorx.NewByCode(errno.InvalidBuiltinEvaluatorConfigCode, errorx.WithExtraMsg(fmt.Sprintf("max_ngram must be in [1, %d], or 0 for default", BuiltinEvaluatorMaxMaxNGram)))
+		}
+	}
+	return nil
+}
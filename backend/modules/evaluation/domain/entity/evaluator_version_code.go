@@ -0,0 +1,192 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+type CodeEvaluatorVersion struct {
+	ID            int64         `json:"id"`
+	SpaceID       int64         `json:"space_id"`
+	EvaluatorType EvaluatorType `json:"evaluator_type"`
+	EvaluatorID   int64         `json:"evaluator_id"`
+	Description   string        `json:"description"`
+	Version       string        `json:"version"`
+	InputSchemas  []*ArgsSchema `json:"input_schemas"`
+	LanguageType  LanguageType  `json:"language_type"`
+	CodeContent   string        `json:"code_content"`
+	BaseInfo      *BaseInfo     `json:"base_info"`
+}
+
+type LanguageType int64
+
+const (
+	LanguageTypePython LanguageType = 1
+	LanguageTypeJS     LanguageType = 2
+)
+
+// CodeEvaluatorEntryFunction 用户代码中必须定义的入口函数名
+const CodeEvaluatorEntryFunction = "exec_evaluation"
+
+// CodeEvaluatorMaxCodeLength 用户代码的最大长度
+const CodeEvaluatorMaxCodeLength = 64 * 1024
+
+func (do *CodeEvaluatorVersion) SetID(id int64) {
+	do.ID = id
+}
+
+func (do *CodeEvaluatorVersion) GetID() int64 {
+	return do.ID
+}
+
+func (do *CodeEvaluatorVersion) SetEvaluatorID(evaluatorID int64) {
+	do.EvaluatorID = evaluatorID
+}
+
+func (do *CodeEvaluatorVersion) GetEvaluatorID() int64 {
+	return do.EvaluatorID
+}
+
+func (do *CodeEvaluatorVersion) SetSpaceID(spaceID int64) {
+	do.SpaceID = spaceID
+}
+
+func (do *CodeEvaluatorVersion) GetSpaceID() int64 {
+	return do.SpaceID
+}
+
+func (do *CodeEvaluatorVersion) GetVersion() string {
+	return do.Version
+}
+
+func (do *CodeEvaluatorVersion) SetVersion(version string) {
+	do.Version = version
+}
+
+func (do *CodeEvaluatorVersion) SetDescription(description string) {
+	do.Description = description
+}
+
+func (do *CodeEvaluatorVersion) GetDescription() string {
+	return do.Description
+}
+
+func (do *CodeEvaluatorVersion) SetBaseInfo(baseInfo *BaseInfo) {
+	do.BaseInfo = baseInfo
+}
+
+func (do *CodeEvaluatorVersion) GetBaseInfo() *BaseInfo {
+	return do.BaseInfo
+}
+
+// SetTools 代码评估器不使用工具
+func (do *CodeEvaluatorVersion) SetTools(tools []*Tool) {}
+
+func (do *CodeEvaluatorVersion) GetPromptTemplateKey() string {
+	return ""
+}
+
+// SetPromptSuffix 代码评估器不使用 prompt 后缀
+func (do *CodeEvaluatorVersion) SetPromptSuffix(promptSuffix string) {}
+
+func (do *CodeEvaluatorVersion) GetModelConfig() *ModelConfig {
+	return nil
+}
+
+// SetParseType 代码评估器的输出由运行时直接解析
+func (do *CodeEvaluatorVersion) SetParseType(parseType ParseType) {}
+
+// ValidateInput 验证输入数据
+func (do *CodeEvaluatorVersion) ValidateInput(input *EvaluatorInputData) error {
+	return validateInputBySchemas(do.InputSchemas, input)
+}
+
+// ValidateBaseInfo 校验评估器基本信息
+func (do *CodeEvaluatorVersion) ValidateBaseInfo() error {
+	if do == nil {
+		return errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+	}
+	if do.LanguageType != LanguageTypePython && do.LanguageType != LanguageTypeJS {
+		return errorx.NewByCode(errno.CodeLanguageNotSupportedCode)
+	}
+	if strings.TrimSpace(do.CodeContent) == "" {
+		return errorx.NewByCode(errno.InvalidCodeContentCode, errorx.WithExtraMsg("code is empty"))
+	}
+	if len(do.CodeContent) > CodeEvaluatorMaxCodeLength {
+		return errorx.NewByCode(errno.InvalidCodeContentCode, errorx.WithExtraMsg("code exceeds max length"))
+	}
+	return nil
+}
+
+// CodeRunParam 代码运行时的执行参数
+type CodeRunParam struct {
+	LanguageType LanguageType
+	Code         string
+	// Input 入口函数的参数，key 为输入字段名
+	Input map[string]any
+
+	Timeout time.Duration
+	// MaxCallStackSize 调用栈深度上限，防止无限递归耗尽内存
+	MaxCallStackSize int
+	// MaxOutputBytes 返回值与日志序列化后的大小上限
+	MaxOutputBytes int
+	// MaxMemoryBytes 单次执行可额外申请的内存上限，配置后代码在独立子进程中执行，超过后子进程退出
+	MaxMemoryBytes int64
+}
+
+// CodeRunResult 代码运行时的执行结果
+type CodeRunResult struct {
+	// Output 入口函数的返回值，已转换为 Go 原生类型
+	Output any
+	Logs   []string
+}
+
+type CodeEvaluatorRuntimeConf struct {
+	TimeoutMS        int64 `json:"timeout_ms" mapstructure:"timeout_ms"`
+	MaxCallStackSize int   `json:"max_call_stack_size" mapstructure:"max_call_stack_size"`
+	MaxOutputBytes   int   `json:"max_output_bytes" mapstructure:"max_output_bytes"`
+	MaxMemoryBytes   int64 `json:"max_memory_bytes" mapstructure:"max_memory_bytes"`
+}
+
+func DefaultCodeEvaluatorRuntimeConf() *CodeEvaluatorRuntimeConf {
+	return &CodeEvaluatorRuntimeConf{
+		TimeoutMS:        5000,
+		MaxCallStackSize: 1024,
+		MaxOutputBytes:   1024 * 1024,
+		MaxMemoryBytes:   256 * 1024 * 1024,
+	}
+}
+
+func (c *CodeEvaluatorRuntimeConf) GetTimeout() time.Duration {
+	if c == nil || c.TimeoutMS <= 0 {
+		return time.Duration(DefaultCodeEvaluatorRuntimeConf().TimeoutMS) * time.Millisecond
+	}
+	return time.Duration(c.TimeoutMS) * time.Millisecond
+}
+
+func (c *CodeEvaluatorRuntimeConf) GetMaxCallStackSize() int {
+	if c == nil || c.MaxCallStackSize <= 0 {
+		return DefaultCodeEvaluatorRuntimeConf().MaxCallStackSize
+	}
+	return c.MaxCallStackSize
+}
+
+func (c *CodeEvaluatorRuntimeConf) GetMaxOutputBytes() int {
+	if c == nil || c.MaxOutputBytes <= 0 {
+		return DefaultCodeEvaluatorRuntimeConf().MaxOutputBytes
+	}
+	return c.MaxOutputBytes
+}
+
+func (c *CodeEvaluatorRuntimeConf) GetMaxMemoryBytes() int64 {
+	if c == nil || c.MaxMemoryBytes <= 0 {
+		return DefaultCodeEvaluatorRuntimeConf().MaxMemoryBytes
+	}
+	return c.MaxMemoryBytes
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// PairwiseEvaluatorVersion 对比评估器，由 LLM 比较基准实验与当前实验在同一评测集数据上的输出
+type PairwiseEvaluatorVersion struct {
+	ID            int64         `json:"id"`
+	SpaceID       int64         `json:"space_id"`
+	EvaluatorType EvaluatorType `json:"evaluator_type"`
+	EvaluatorID   int64         `json:"evaluator_id"`
+	Description   string        `json:"description"`
+	Version       string        `json:"version"`
+	InputSchemas  []*ArgsSchema `json:"input_schemas"`
+	MessageList   []*Message    `json:"message_list"`
+	ModelConfig   *ModelConfig  `json:"model_config"`
+	// SwapCheck 交换 A、B 位置再评估一次，两次结论不一致时判为平局
+	SwapCheck bool      `json:"swap_check"`
+	BaseInfo  *BaseInfo `json:"base_info"`
+}
+
+// 对比评估器的固定输入字段，output_a 为基准实验的输出，output_b 为当前实验的输出
+const (
+	PairwiseEvaluatorOutputAKey = "output_a"
+	PairwiseEvaluatorOutputBKey = "output_b"
+)
+
+type PairwisePreference int64
+
+const (
+	PairwisePreferenceA   PairwisePreference = 1
+	PairwisePreferenceB   PairwisePreference = 2
+	PairwisePreferenceTie PairwisePreference = 3
+)
+
+// 对比评估器的分数从当前实验（B）的视角计算，便于沿用分数聚合与人工修正
+const (
+	PairwiseScoreWin  = 1.0
+	PairwiseScoreTie  = 0.5
+	PairwiseScoreLoss = 0.0
+)
+
+// Score 偏好对应的分数
+func (p PairwisePreference) Score() float64 {
+	switch p {
+	case PairwisePreferenceB:
+		return PairwiseScoreWin
+	case PairwisePreferenceA:
+		return PairwiseScoreLoss
+	default:
+		return PairwiseScoreTie
+	}
+}
+
+// Swap 交换 A、B 位置后的偏好
+func (p PairwisePreference) Swap() PairwisePreference {
+	switch p {
+	case PairwisePreferenceA:
+		return PairwisePreferenceB
+	case PairwisePreferenceB:
+		return PairwisePreferenceA
+	default:
+		return p
+	}
+}
+
+// EnsurePairwiseInputSchemas 补齐 output_a、output_b 两个固定输入字段
+func EnsurePairwiseInputSchemas(schemas []*ArgsSchema) []*ArgsSchema {
+	exists := make(map[string]bool, len(schemas))
+	for _, schema := range schemas {
+		exists[gptr.Indirect(schema.Key)] = true
+	}
+	for _, key := range []string{PairwiseEvaluatorOutputAKey, PairwiseEvaluatorOutputBKey} {
+		if exists[key] {
+			continue
+		}
+		schemas = append(schemas, &ArgsSchema{
+			Key:                 gptr.Of(key),
+			SupportContentTypes: []ContentType{ContentTypeText},
+			JsonSchema:          gptr.Of(consts.StringJsonSchema),
+		})
+	}
+	return schemas
+}
+
+// ToPromptEvaluatorVersion 转换为 prompt 评估器版本以复用模板渲染与模型调用，消息列表为深拷贝
+func (do *PairwiseEvaluatorVersion) ToPromptEvaluatorVersion() *PromptEvaluatorVersion {
+	messages := make([]*Message, 0, len(do.MessageList))
+	for _, message := range do.MessageList {
+		if message == nil {
+			continue
+		}
+		cloned := *message
+		cloned.Content = cloneContent(message.Content)
+		messages = append(messages, &cloned)
+	}
+	return &PromptEvaluatorVersion{
+		ID:            do.ID,
+		SpaceID:       do.SpaceID,
+		EvaluatorType: EvaluatorTypePairwise,
+		EvaluatorID:   do.EvaluatorID,
+		Version:       do.Version,
+		InputSchemas:  do.InputSchemas,
+		MessageList:   messages,
+		ModelConfig:   do.ModelConfig,
+		ParseType:     ParseTypeContent,
+		BaseInfo:      do.BaseInfo,
+	}
+}
+
+func cloneContent(content *Content) *Content {
+	if content == nil {
+		return nil
+	}
+	cloned := *content
+	if content.Text != nil {
+		cloned.Text = gptr.Of(*content.Text)
+	}
+	if len(content.MultiPart) > 0 {
+		cloned.MultiPart = make([]*Content, 0, len(content.MultiPart))
+		for _, part := range content.MultiPart {
+			cloned.MultiPart = append(cloned.MultiPart, cloneContent(part))
+		}
+	}
+	return &cloned
+}
+
+func (do *PairwiseEvaluatorVersion) SetID(id int64) {
+	do.ID = id
+}
+
+func (do *PairwiseEvaluatorVersion) GetID() int64 {
+	return do.ID
+}
+
+func (do *PairwiseEvaluatorVersion) SetEvaluatorID(evaluatorID int64) {
+	do.EvaluatorID = evaluatorID
+}
+
+func (do *PairwiseEvaluatorVersion) GetEvaluatorID() int64 {
+	return do.EvaluatorID
+}
+
+func (do *PairwiseEvaluatorVersion) SetSpaceID(spaceID int64) {
+	do.SpaceID = spaceID
+}
+
+func (do *PairwiseEvaluatorVersion) GetSpaceID() int64 {
+	return do.SpaceID
+}
+
+func (do *PairwiseEvaluatorVersion) GetVersion() string {
+	return do.Version
+}
+
+func (do *PairwiseEvaluatorVersion) SetVersion(version string) {
+	do.Version = version
+}
+
+func (do *PairwiseEvaluatorVersion) SetDescription(description string) {
+	do.Description = description
+}
+
+func (do *PairwiseEvaluatorVersion) GetDescription() string {
+	return do.Description
+}
+
+func (do *PairwiseEvaluatorVersion) SetBaseInfo(baseInfo *BaseInfo) {
+	do.BaseInfo = baseInfo
+}
+
+func (do *PairwiseEvaluatorVersion) GetBaseInfo() *BaseInfo {
+	return do.BaseInfo
+}
+
+// SetTools 对比评估器从回复内容中解析偏好，不使用工具
+func (do *PairwiseEvaluatorVersion) SetTools(tools []*Tool) {}
+
+func (do *PairwiseEvaluatorVersion) GetPromptTemplateKey() string {
+	return ""
+}
+
+// SetPromptSuffix 对比评估器使用固定的输出格式说明
+func (do *PairwiseEvaluatorVersion) SetPromptSuffix(promptSuffix string) {}
+
+func (do *PairwiseEvaluatorVersion) GetModelConfig() *ModelConfig {
+	return do.ModelConfig
+}
+
+// SetParseType 对比评估器固定解析回复内容
+func (do *PairwiseEvaluatorVersion) SetParseType(parseType ParseType) {}
+
+// ValidateInput 验证输入数据，待对比的两个输出均不能为空
+func (do *PairwiseEvaluatorVersion) ValidateInput(input *EvaluatorInputData) error {
+	if err := validateInputBySchemas(do.InputSchemas, input); err != nil {
+		return err
+	}
+	for _, key := range []string{PairwiseEvaluatorOutputAKey, PairwiseEvaluatorOutputBKey} {
+		if input == nil || input.InputFields[key] == nil {
+			return errorx.NewByCode(errno.PairwiseOutputMissingCode, errorx.WithExtraMsg(key+" is empty"))
+		}
+	}
+	return nil
+}
+
+// ValidateBaseInfo 校验评估器基本信息
+func (do *PairwiseEvaluatorVersion) ValidateBaseInfo() error {
+	if do == nil {
+		return errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+	}
+	if len(do.MessageList) == 0 {
+		return errorx.NewByCode(errno.InvalidMessageListCode, errorx.WithExtraMsg("message list is empty"))
+	}
+	if do.ModelConfig == nil {
+		return errorx.NewByCode(errno.InvalidModelConfigCode, errorx.WithExtraMsg("model config is nil"))
+	}
+	if do.ModelConfig.ModelID == 0 && do.ModelConfig.ProviderModelID == nil {
+		return errorx.NewByCode(errno.InvalidModelConfigCode, errorx.WithExtraMsg("model id is empty"))
+	}
+	return nil
+}
@@ -4,13 +4,7 @@
 package entity
 
 import (
-	"fmt"
-
-	"github.com/bytedance/gg/gptr"
-	"github.com/bytedance/gg/gslice"
-
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
-	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/json"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 )
 
@@ -111,29 +105,7 @@ func (do *PromptEvaluatorVersion) GetModelConfig() *ModelConfig {
 
 // ValidateInput 验证输入数据
 func (do *PromptEvaluatorVersion) ValidateInput(input *EvaluatorInputData) error {
-	// 实现验证逻辑，
-	inputSchemaMap := make(map[string]*ArgsSchema)
-	for _, argsSchema := range do.InputSchemas {
-		inputSchemaMap[gptr.Indirect(argsSchema.Key)] = argsSchema
-	}
-	for fieldKey, content := range input.InputFields {
-		if content == nil {
-			continue
-		}
-		// schema中不存在的字段无需校验
-		if argsSchema, ok := inputSchemaMap[fieldKey]; ok {
-			if !gslice.Contains(argsSchema.SupportContentTypes, gptr.Indirect(content.ContentType)) {
-				return errorx.NewByCode(errno.ContentTypeNotSupportedCode, errorx.WithExtraMsg(fmt.Sprintf("content type %v not supported", content.ContentType)))
-			}
-			if gptr.Indirect(content.ContentType) == ContentTypeText {
-				valid, err := json.ValidateJSONSchema(gptr.Indirect(argsSchema.JsonSchema), gptr.Indirect(content.Text))
-				if err != nil || !valid {
-					return errorx.NewByCode(errno.ContentSchemaInvalidCode, errorx.WithExtraMsg(fmt.Sprintf("content %v does not validate with expected schema: %v", content.Text, argsSchema.JsonSchema)))
-				}
-			}
-		}
-	}
-	return nil
+	return validateInputBySchemas(do.InputSchemas, input)
 }
 
 // ValidateBaseInfo 校验评估器基本信息
@@ -26,6 +26,8 @@ const (
 	ExptStatus_Pending ExptStatus = 2
 	// In progress
 	ExptStatus_Processing ExptStatus = 3
+	// Paused, no new items are submitted
+	ExptStatus_Paused ExptStatus = 4
 	// Execution succeeded
 	ExptStatus_Success ExptStatus = 11
 	// Execution failed
@@ -137,6 +139,68 @@ func (e *ExptEvaluatorVersionRef) String() string {
 type EvaluationConfiguration struct {
 	ConnectorConf Connector
 	ItemConcurNum *int
+	Budget        *ExptBudget
+}
+
+func (e *EvaluationConfiguration) GetBudget() *ExptBudget {
+	if e == nil {
+		return nil
+	}
+	return e.Budget
+}
+
+// ExptBudget 实验资源预算，字段为空表示不限制，超出后实验被系统终止
+type ExptBudget struct {
+	// MaxTokens 评测对象与评估器的 input + output token 总量上限
+	MaxTokens *int64 `json:"max_tokens,omitempty" mapstructure:"max_tokens"`
+}
+
+func (b *ExptBudget) IsEmpty() bool {
+	return b == nil || b.MaxTokens == nil
+}
+
+func (b *ExptBudget) Valid() error {
+	if b == nil {
+		return nil
+	}
+	if b.MaxTokens != nil && *b.MaxTokens <= 0 {
+		return fmt.Errorf("budget max tokens must be positive, got %d", *b.MaxTokens)
+	}
+	return nil
+}
+
+// Merge 合并两个预算，同一项取更严格的限制
+func (b *ExptBudget) Merge(other *ExptBudget) *ExptBudget {
+	if b.IsEmpty() {
+		return other
+	}
+	if other.IsEmpty() {
+		return b
+	}
+	return &ExptBudget{
+		MaxTokens: minPtr(b.MaxTokens, other.MaxTokens),
+	}
+}
+
+func minPtr(a, b *int64) *int64 {
+	if a == nil {
+		return b
+	}
+	if b == nil || *a <= *b {
+		return a
+	}
+	return b
+}
+
+// Exceeded 返回超出预算的原因，未超出时返回空字符串
+func (b *ExptBudget) Exceeded(stats *ExptStats) string {
+	if b.IsEmpty() || stats == nil {
+		return ""
+	}
+	if used := stats.InputTokenCost + stats.OutputTokenCost; used >= *b.MaxTokens {
+		return fmt.Sprintf("experiment terminated by system: token usage %d reached budget %d", used, *b.MaxTokens)
+	}
+	return ""
 }
 
 type Connector struct {
@@ -204,6 +268,12 @@ func (e *EvaluatorsConf) GetEvaluatorConcurNum() int {
 type EvaluatorConf struct {
 	EvaluatorVersionID int64
 	IngressConf        *EvaluatorIngressConf
+	// BaselineExptID 对比评估器的基准实验
+	BaselineExptID int64
+	// AggregatorTypes 额外启用的聚合器，平均分等基础聚合器始终计算
+	AggregatorTypes []AggregatorType
+	// PassThreshold 得分不低于该值视为通过，启用 PassRate 时必填
+	PassThreshold *float64
 }
 
 func (e *EvaluatorConf) Valid(ctx context.Context) error {
@@ -211,6 +281,14 @@ func (e *EvaluatorConf) Valid(ctx context.Context) error {
 		(e.IngressConf.TargetAdapter == nil && e.IngressConf.EvalSetAdapter == nil) {
 		return fmt.Errorf("invalid EvaluatorConf: %v", json.Jsonify(e))
 	}
+	for _, t := range e.AggregatorTypes {
+		if !IsOptionalAggregatorType(t) {
+			return fmt.Errorf("invalid EvaluatorConf, unsupported aggregator type %d", t)
+		}
+		if t == PassRate && e.PassThreshold == nil {
+			return fmt.Errorf("invalid EvaluatorConf, pass threshold required for pass rate aggregator")
+		}
+	}
 	return nil
 }
 
@@ -218,6 +296,8 @@ type EvaluatorIngressConf struct {
 	EvalSetAdapter *FieldAdapter
 	TargetAdapter  *FieldAdapter
 	CustomConf     *FieldAdapter
+	// BaselineTargetAdapter 对比评估器从基准实验的评测对象输出中取值
+	BaselineTargetAdapter *FieldAdapter
 }
 
 type FieldAdapter struct {
@@ -260,6 +340,9 @@ type ItemTurnID struct {
 
 type StatsCntArithOp struct {
 	OpStatusCnt map[ItemRunState]int
+	// OpInputTokenCost OpOutputTokenCost 累加的 token 消耗
+	OpInputTokenCost  int64
+	OpOutputTokenCost int64
 }
 
 type TupleExpt struct {
@@ -295,6 +378,8 @@ type CreateEvalTargetParam struct {
 	EvalTargetType      *EvalTargetType
 	BotInfoType         *CozeBotInfoType
 	BotPublishVersion   *string
+	HTTPTarget          *HTTPTarget
+	PromptLabel         *string
 }
 
 func (c *CreateEvalTargetParam) IsNull() bool {
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+const (
+	DefaultCompareConfidenceLevel     = 0.95
+	DefaultCompareBootstrapIterations = 1000
+	MaxCompareBootstrapIterations     = 10000
+)
+
+// ExptCompareParam 实验对比参数，差值均为当前实验减去基准实验
+type ExptCompareParam struct {
+	SpaceID        int64
+	ExptID         int64
+	BaselineExptID int64
+	// EvaluatorVersionIDs 为空时对比两个实验共有的全部评估器
+	EvaluatorVersionIDs []int64
+	ConfidenceLevel     float64
+	BootstrapIterations int
+	// RegressionThreshold 得分下降超过该值视为退化
+	RegressionThreshold float64
+}
+
+// Normalize 补齐默认值
+func (p *ExptCompareParam) Normalize() {
+	if p.ConfidenceLevel <= 0 || p.ConfidenceLevel >= 1 {
+		p.ConfidenceLevel = DefaultCompareConfidenceLevel
+	}
+	if p.BootstrapIterations <= 0 {
+		p.BootstrapIterations = DefaultCompareBootstrapIterations
+	}
+	if p.BootstrapIterations > MaxCompareBootstrapIterations {
+		p.BootstrapIterations = MaxCompareBootstrapIterations
+	}
+	if p.RegressionThreshold < 0 {
+		p.RegressionThreshold = 0
+	}
+}
+
+type ConfidenceInterval struct {
+	Lower           float64
+	Upper           float64
+	ConfidenceLevel float64
+}
+
+type PairedTTestResult struct {
+	// TStatistic 得分差的标准差为 0 时 t 统计量无定义，为 nil
+	TStatistic         *float64
+	PValue             float64
+	DegreesOfFreedom   int
+	ConfidenceInterval *ConfidenceInterval
+}
+
+// ItemScoreDiff 单条数据上的得分变化
+type ItemScoreDiff struct {
+	ItemID        int64
+	TurnID        int64
+	BaselineScore float64
+	Score         float64
+	Diff          float64
+}
+
+// EvaluatorCompareResult 评估器版本粒度的实验对比结果
+type EvaluatorCompareResult struct {
+	EvaluatorVersionID int64
+	PairedCount        int
+	BaselineMean       float64
+	Mean               float64
+	MeanDiff           float64
+	// TTest 配对数据少于 2 条时为 nil
+	TTest                       *PairedTTestResult
+	BootstrapConfidenceInterval *ConfidenceInterval
+	Significant                 bool
+	Regressions                 []*ItemScoreDiff
+}
@@ -33,6 +33,40 @@ const (
 	CSVExportStatus_Failed  CSVExportStatus = 3
 )
 
+// ExptResultExportFormat 实验结果导出文件格式
+type ExptResultExportFormat string
+
+const (
+	ExptResultExportFormatCSV     ExptResultExportFormat = "CSV"
+	ExptResultExportFormatJSONL   ExptResultExportFormat = "JSONL"
+	ExptResultExportFormatParquet ExptResultExportFormat = "Parquet"
+	ExptResultExportFormatXLSX    ExptResultExportFormat = "XLSX"
+)
+
+// IsValid 空值视为 CSV
+func (f ExptResultExportFormat) IsValid() bool {
+	switch f {
+	case "", ExptResultExportFormatCSV, ExptResultExportFormatJSONL, ExptResultExportFormatParquet, ExptResultExportFormatXLSX:
+		return true
+	default:
+		return false
+	}
+}
+
+// FileExt 导出文件扩展名，未指定格式时兼容历史的 CSV 导出
+func (f ExptResultExportFormat) FileExt() string {
+	switch f {
+	case ExptResultExportFormatJSONL:
+		return "jsonl"
+	case ExptResultExportFormatParquet:
+		return "parquet"
+	case ExptResultExportFormatXLSX:
+		return "xlsx"
+	default:
+		return "csv"
+	}
+}
+
 func DefaultExptExportWhiteList() *ExptExportWhiteList {
 	return &ExptExportWhiteList{}
 }
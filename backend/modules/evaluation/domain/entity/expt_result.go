@@ -33,6 +33,15 @@ const (
 
 	// 标注项, FieldKey为TagKeyID
 	FieldType_Annotation FieldType = 23
+	// 评测对象运行指标, FieldKey为指标名
+	FieldType_TargetMetric FieldType = 24
+)
+
+// 评测对象运行指标名
+const (
+	TargetMetricKeyLatencyMS    = "latency_ms"
+	TargetMetricKeyInputTokens  = "input_tokens"
+	TargetMetricKeyOutputTokens = "output_tokens"
 )
 
 // aggregate result
@@ -59,8 +68,33 @@ const (
 	Max          AggregatorType = 3
 	Min          AggregatorType = 4
 	Distribution AggregatorType = 5 // 得分的分布情况
+	WinRate      AggregatorType = 6 // 对比评估器中当前实验胜出的比例
+	TieRate      AggregatorType = 7 // 对比评估器中平局的比例
+	LossRate     AggregatorType = 8 // 对比评估器中基准实验胜出的比例
+	P50          AggregatorType = 9
+	P90          AggregatorType = 10
+	P99          AggregatorType = 11
+	StdDev       AggregatorType = 12 // 样本标准差
+	PassRate     AggregatorType = 13 // 得分不低于通过阈值的比例
 )
 
+// PercentileOfAggregator 分位数聚合器对应的分位点
+var PercentileOfAggregator = map[AggregatorType]float64{
+	P50: 0.5,
+	P90: 0.9,
+	P99: 0.99,
+}
+
+// IsOptionalAggregatorType 可按评估器额外启用的聚合器
+func IsOptionalAggregatorType(t AggregatorType) bool {
+	switch t {
+	case P50, P90, P99, StdDev, PassRate:
+		return true
+	default:
+		return false
+	}
+}
+
 type AggrResultDataType int
 
 const (
@@ -142,6 +176,14 @@ type ExptAggregateResult struct {
 	EvaluatorResults  map[int64]*EvaluatorAggregateResult
 	Status            int64
 	AnnotationResults map[int64]*AnnotationAggregateResult
+	TargetResult      *TargetAggregateResult
+}
+
+// TargetAggregateResult 评测对象运行耗时与 token 消耗的聚合结果
+type TargetAggregateResult struct {
+	LatencyMS    []*AggregatorResult
+	InputTokens  []*AggregatorResult
+	OutputTokens []*AggregatorResult
 }
 
 type EvaluatorAggregateResult struct {
@@ -117,6 +117,15 @@ type ExptExecConf struct {
 	SpaceExptConcurLimit int `json:"space_expt_concur_limit" mapstructure:"space_expt_concur_limit"`
 
 	ExptItemEvalConf *ExptItemEvalConf `json:"expt_item_eval_conf" mapstructure:"expt_item_eval_conf"`
+	// ExptBudget 空间内每个实验的预算上限，与实验自身配置的预算取更严格的限制
+	ExptBudget *ExptBudget `json:"expt_budget" mapstructure:"expt_budget"`
+}
+
+func (e *ExptExecConf) GetExptBudget() *ExptBudget {
+	if e != nil {
+		return e.ExptBudget
+	}
+	return nil
 }
 
 func (e *ExptExecConf) GetSpaceExptConcurLimit() int {
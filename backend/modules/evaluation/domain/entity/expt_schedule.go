@@ -0,0 +1,94 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ExptSchedule 定时实验, 按 cron 表达式以模板实验为蓝本周期性创建并运行新实验
+type ExptSchedule struct {
+	ID          int64
+	SpaceID     int64
+	Name        string
+	Description string
+
+	// TemplateExptID 模板实验, 新实验沿用其评测集、评测对象、评估器与字段映射配置
+	TemplateExptID int64
+	// CronExpr 标准 5 段 cron 表达式 (分 时 日 月 周), 也支持 @daily 等描述符
+	CronExpr string
+	// Timezone cron 表达式所在时区, 为空时使用 UTC
+	Timezone string
+	// UseLatestTargetVersion 每次触发时使用评测对象的最新提交版本, 仅对 Prompt 类型评测对象生效
+	UseLatestTargetVersion bool
+	// UseLatestEvalSetVersion 每次触发时使用评测集的最新版本
+	UseLatestEvalSetVersion bool
+	Enabled                 bool
+
+	NextTriggerAt *time.Time
+	LastTriggerAt *time.Time
+
+	CreatedBy string
+	// UpdatedBy 最后修改人, 定时触发时以其身份创建并运行实验
+	UpdatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NextTriggerTime 计算 from 之后的下一次触发时间
+func (e *ExptSchedule) NextTriggerTime(from time.Time) (time.Time, error) {
+	sched, err := cron.ParseStandard(e.CronExpr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expr %q: %w", e.CronExpr, err)
+	}
+	loc := time.UTC
+	if e.Timezone != "" {
+		if loc, err = time.LoadLocation(e.Timezone); err != nil {
+			return time.Time{}, fmt.Errorf("invalid timezone %q: %w", e.Timezone, err)
+		}
+	}
+	next := sched.Next(from.In(loc))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expr %q never triggers", e.CronExpr)
+	}
+	return next, nil
+}
+
+// RunAsUserID 定时触发时的执行人, 历史数据无最后修改人时回退为创建人
+func (e *ExptSchedule) RunAsUserID() string {
+	if e.UpdatedBy != "" {
+		return e.UpdatedBy
+	}
+	return e.CreatedBy
+}
+
+type ExptScheduleRunStatus int32
+
+const (
+	ExptScheduleRunStatus_Unknown ExptScheduleRunStatus = 0
+	// 实验已创建并提交运行
+	ExptScheduleRunStatus_Success ExptScheduleRunStatus = 1
+	// 实验创建或提交失败
+	ExptScheduleRunStatus_Failed ExptScheduleRunStatus = 2
+)
+
+// ExptScheduleRun 定时实验的一次触发记录
+type ExptScheduleRun struct {
+	ID         int64
+	SpaceID    int64
+	ScheduleID int64
+	ExptID     int64
+	RunID      int64
+	Status     ExptScheduleRunStatus
+	ErrMsg     string
+	TriggerAt  time.Time
+}
+
+type ExptScheduleListFilter struct {
+	TemplateExptID *int64
+	Enabled        *bool
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExptSchedule_NextTriggerTime(t *testing.T) {
+	from := time.Date(2025, 6, 1, 23, 30, 0, 0, time.UTC)
+
+	next, err := (&ExptSchedule{CronExpr: "0 2 * * *"}).NextTriggerTime(from)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 6, 2, 2, 0, 0, 0, time.UTC), next.UTC())
+
+	next, err = (&ExptSchedule{CronExpr: "0 2 * * *", Timezone: "Asia/Shanghai"}).NextTriggerTime(from)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 6, 2, 18, 0, 0, 0, time.UTC), next.UTC())
+
+	_, err = (&ExptSchedule{CronExpr: "0 2 * *"}).NextTriggerTime(from)
+	assert.Error(t, err)
+}
+
+func TestExptSchedule_RunAsUserID(t *testing.T) {
+	assert.Equal(t, "u2", (&ExptSchedule{CreatedBy: "u1", UpdatedBy: "u2"}).RunAsUserID())
+	assert.Equal(t, "u1", (&ExptSchedule{CreatedBy: "u1"}).RunAsUserID())
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/bytedance/gg/gptr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -77,6 +78,16 @@ func TestEvaluatorConf_Valid(t *testing.T) {
 	assert.NoError(t, conf.Valid(ctx))
 	conf = &EvaluatorConf{}
 	assert.Error(t, conf.Valid(ctx))
+
+	// 额外聚合器
+	conf = &EvaluatorConf{EvaluatorVersionID: 1, IngressConf: &EvaluatorIngressConf{TargetAdapter: &FieldAdapter{}}, AggregatorTypes: []AggregatorType{P90, StdDev}}
+	assert.NoError(t, conf.Valid(ctx))
+	conf.AggregatorTypes = append(conf.AggregatorTypes, PassRate)
+	assert.Error(t, conf.Valid(ctx))
+	conf.PassThreshold = gptr.Of(0.6)
+	assert.NoError(t, conf.Valid(ctx))
+	conf.AggregatorTypes = []AggregatorType{Average}
+	assert.Error(t, conf.Valid(ctx))
 }
 
 func TestExptUpdateFields_ToFieldMap(t *testing.T) {
@@ -121,3 +132,29 @@ func TestQuotaSpaceExpt_Serialize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, b)
 }
+
+func TestExptBudget_Valid(t *testing.T) {
+	assert.NoError(t, (*ExptBudget)(nil).Valid())
+	assert.NoError(t, (&ExptBudget{MaxTokens: gptr.Of(int64(1))}).Valid())
+	assert.Error(t, (&ExptBudget{MaxTokens: gptr.Of(int64(0))}).Valid())
+}
+
+func TestExptBudget_Merge(t *testing.T) {
+	space := &ExptBudget{MaxTokens: gptr.Of(int64(1000))}
+	assert.Equal(t, space, (*ExptBudget)(nil).Merge(space))
+	assert.Equal(t, space, space.Merge(&ExptBudget{}))
+
+	merged := (&ExptBudget{MaxTokens: gptr.Of(int64(100))}).Merge(space)
+	assert.Equal(t, int64(100), gptr.Indirect(merged.MaxTokens))
+
+	merged = (&ExptBudget{MaxTokens: gptr.Of(int64(2000))}).Merge(space)
+	assert.Equal(t, int64(1000), gptr.Indirect(merged.MaxTokens))
+}
+
+func TestExptBudget_Exceeded(t *testing.T) {
+	budget := &ExptBudget{MaxTokens: gptr.Of(int64(100))}
+	assert.Empty(t, budget.Exceeded(nil))
+	assert.Empty(t, (*ExptBudget)(nil).Exceeded(&ExptStats{InputTokenCost: 1000}))
+	assert.Empty(t, budget.Exceeded(&ExptStats{InputTokenCost: 40, OutputTokenCost: 59}))
+	assert.Contains(t, budget.Exceeded(&ExptStats{InputTokenCost: 40, OutputTokenCost: 60}), "token usage 100 reached budget 100")
+}
@@ -87,6 +87,8 @@ type Option func(option *Opt)
 type Opt struct {
 	PublishVersion *string
 	BotInfoType    CozeBotInfoType
+	HTTPTarget     *HTTPTarget
+	PromptLabel    *string
 }
 
 func WithCozeBotPublishVersion(publishVersion *string) Option {
@@ -101,6 +103,18 @@ func WithCozeBotInfoType(botInfoType CozeBotInfoType) Option {
 	}
 }
 
+func WithHTTPTarget(httpTarget *HTTPTarget) Option {
+	return func(option *Opt) {
+		option.HTTPTarget = httpTarget
+	}
+}
+
+func WithPromptLabel(promptLabel *string) Option {
+	return func(option *Opt) {
+		option.PromptLabel = promptLabel
+	}
+}
+
 type ExecuteEvalTargetParam struct {
 	TargetID            int64
 	VersionID           int64
@@ -108,6 +122,8 @@ type ExecuteEvalTargetParam struct {
 	SourceTargetVersion string
 	Input               *EvalTargetInputData
 	TargetType          EvalTargetType
+	// 评测对象版本详情，配置不在外部系统中的评测对象（如 HTTP）从这里读取
+	EvalTargetVersion *EvalTargetVersion
 }
 
 type ListEvaluatorRequest struct {
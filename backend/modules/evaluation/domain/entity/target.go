@@ -28,6 +28,7 @@ type EvalTargetVersion struct {
 	CozeBot      *CozeBot
 	Prompt       *LoopPrompt
 	CozeWorkflow *CozeWorkflow
+	HTTPTarget   *HTTPTarget
 
 	InputSchema      []*ArgsSchema
 	OutputSchema     []*ArgsSchema
@@ -47,6 +48,8 @@ const (
 	EvalTargetTypeLoopTrace EvalTargetType = 3
 	// CozeWorkflow
 	EvalTargetTypeCozeWorkflow EvalTargetType = 4
+	// 自定义 HTTP 服务
+	EvalTargetTypeHTTP EvalTargetType = 5
 )
 
 func (p EvalTargetType) String() string {
@@ -59,6 +62,8 @@ func (p EvalTargetType) String() string {
 		return "LoopTrace"
 	case EvalTargetTypeCozeWorkflow:
 		return "CozeWorkflow"
+	case EvalTargetTypeHTTP:
+		return "HTTP"
 	}
 	return "<UNSET>"
 }
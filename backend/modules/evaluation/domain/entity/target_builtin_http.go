@@ -0,0 +1,295 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/ohler55/ojg/jp"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+)
+
+const (
+	HTTPTargetDefaultTimeout = 60 * time.Second
+	HTTPTargetMaxTimeout     = 10 * time.Minute
+	HTTPTargetMaxRetryTimes  = 5
+)
+
+// HTTPTargetVariableRegexp 匹配模板中的 {{key}} 变量
+var HTTPTargetVariableRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z_][\w.\-]*)\s*\}\}`)
+
+// HTTPTarget 自定义 HTTP 服务评测对象，整体序列化后存入 target_meta
+type HTTPTarget struct {
+	URL             string
+	Method          string
+	Headers         map[string]string
+	Auth            *HTTPAuth
+	BodyTemplate    string
+	OutputMappings  []*HTTPOutputMapping
+	TimeoutMS       int64
+	RetryTimes      int32
+	RetryIntervalMS int64
+}
+
+type HTTPAuthType int64
+
+const (
+	HTTPAuthTypeBearer HTTPAuthType = 1
+	HTTPAuthTypeBasic  HTTPAuthType = 2
+	HTTPAuthTypeAPIKey HTTPAuthType = 3
+)
+
+type HTTPAuth struct {
+	AuthType   HTTPAuthType
+	Token      string
+	Username   string
+	Password   string
+	HeaderName string
+}
+
+type HTTPOutputMapping struct {
+	FieldKey string
+	JSONPath string
+}
+
+func (h *HTTPTarget) GetMethod() string {
+	if h.Method == "" {
+		return http.MethodPost
+	}
+	return strings.ToUpper(h.Method)
+}
+
+func (h *HTTPTarget) GetTimeout() time.Duration {
+	if h.TimeoutMS <= 0 {
+		return HTTPTargetDefaultTimeout
+	}
+	return min(time.Duration(h.TimeoutMS)*time.Millisecond, HTTPTargetMaxTimeout)
+}
+
+// Variables 返回 URL 与请求体模板中引用的变量，按字典序去重
+func (h *HTTPTarget) Variables() []string {
+	set := make(map[string]struct{})
+	for _, tpl := range []string{h.URL, h.BodyTemplate} {
+		for _, match := range HTTPTargetVariableRegexp.FindAllStringSubmatch(tpl, -1) {
+			set[match[1]] = struct{}{}
+		}
+	}
+	vars := make([]string, 0, len(set))
+	for k := range set {
+		vars = append(vars, k)
+	}
+	sort.Strings(vars)
+	return vars
+}
+
+// InputSchemas 每个模板变量对应一个文本输入
+func (h *HTTPTarget) InputSchemas() []*ArgsSchema {
+	schemas := make([]*ArgsSchema, 0)
+	for _, key := range h.Variables() {
+		schemas = append(schemas, &ArgsSchema{
+			Key:                 gptr.Of(key),
+			SupportContentTypes: []ContentType{ContentTypeText},
+			JsonSchema:          gptr.Of(consts.StringJsonSchema),
+		})
+	}
+	return schemas
+}
+
+// OutputSchemas 每个响应映射对应一个文本输出，未配置映射时输出整个响应体
+func (h *HTTPTarget) OutputSchemas() []*ArgsSchema {
+	schemas := make([]*ArgsSchema, 0)
+	for _, m := range h.GetOutputMappings() {
+		schemas = append(schemas, &ArgsSchema{
+			Key:                 gptr.Of(m.FieldKey),
+			SupportContentTypes: []ContentType{ContentTypeText},
+			JsonSchema:          gptr.Of(consts.StringJsonSchema),
+		})
+	}
+	return schemas
+}
+
+func (h *HTTPTarget) GetOutputMappings() []*HTTPOutputMapping {
+	if len(h.OutputMappings) == 0 {
+		return []*HTTPOutputMapping{{FieldKey: consts.OutputSchemaKey}}
+	}
+	return h.OutputMappings
+}
+
+// Validate 校验调用配置，netConf 为评测对象可访问的内网范围，为空时禁止访问所有内网地址
+func (h *HTTPTarget) Validate(netConf *HTTPTargetNetworkConf) error {
+	if h.URL == "" {
+		return fmt.Errorf("url is empty")
+	}
+	u, err := url.Parse(HTTPTargetVariableRegexp.ReplaceAllString(h.URL, "x"))
+	if err != nil {
+		return fmt.Errorf("url is invalid: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url scheme must be http or https")
+	}
+	if netConf.IsForbiddenHost(u.Hostname()) {
+		return fmt.Errorf("url host %s is not allowed", u.Hostname())
+	}
+	switch h.GetMethod() {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return fmt.Errorf("method %s is not supported", h.Method)
+	}
+	if h.TimeoutMS < 0 || h.RetryIntervalMS < 0 {
+		return fmt.Errorf("timeout and retry interval must not be negative")
+	}
+	if h.RetryTimes < 0 || h.RetryTimes > HTTPTargetMaxRetryTimes {
+		return fmt.Errorf("retry times must be in [0, %d]", HTTPTargetMaxRetryTimes)
+	}
+	keys := make(map[string]struct{}, len(h.OutputMappings))
+	for _, m := range h.OutputMappings {
+		if m == nil || m.FieldKey == "" {
+			return fmt.Errorf("output mapping field key is empty")
+		}
+		if _, ok := keys[m.FieldKey]; ok {
+			return fmt.Errorf("output mapping field key %s is duplicated", m.FieldKey)
+		}
+		keys[m.FieldKey] = struct{}{}
+		if m.JSONPath != "" {
+			if _, err := jp.ParseString(m.JSONPath); err != nil {
+				return fmt.Errorf("output mapping json path %s is invalid: %w", m.JSONPath, err)
+			}
+		}
+	}
+	if h.Auth != nil {
+		return h.Auth.Validate()
+	}
+	return nil
+}
+
+func (a *HTTPAuth) Validate() error {
+	switch a.AuthType {
+	case HTTPAuthTypeBearer:
+		if a.Token == "" {
+			return fmt.Errorf("bearer token is empty")
+		}
+	case HTTPAuthTypeBasic:
+		if a.Username == "" {
+			return fmt.Errorf("basic auth username is empty")
+		}
+	case HTTPAuthTypeAPIKey:
+		if a.Token == "" || a.HeaderName == "" {
+			return fmt.Errorf("api key header name and token are required")
+		}
+	default:
+		return fmt.Errorf("auth type %d is not supported", a.AuthType)
+	}
+	return nil
+}
+
+// httpTargetSharedAddressSpace 运营商级 NAT 地址段，部分云厂商的元数据服务位于该网段
+var httpTargetSharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// httpTargetMetadataIPs 不在链路本地网段内的云厂商元数据服务地址
+var httpTargetMetadataIPs = []net.IP{
+	net.IPv4(100, 100, 100, 200), // 阿里云
+	net.ParseIP("fd00:ec2::254"), // AWS IPv6
+}
+
+// HTTPTargetNetworkConf 评测对象可访问的内网范围。回环、链路本地及云厂商元数据地址始终禁止访问，
+// 其余内网地址默认禁止，可通过 AllowedCIDRs 或 AllowedHosts 放开，避免借评测对象探测内部服务
+type HTTPTargetNetworkConf struct {
+	// AllowedCIDRs 允许访问的网段，如 10.0.0.0/8
+	AllowedCIDRs []string `json:"allowed_cidrs" mapstructure:"allowed_cidrs"`
+	// AllowedHosts 允许访问的域名，解析到内网地址时同样放行；以 "." 开头时匹配其所有子域名，如 .svc.cluster.local
+	AllowedHosts []string `json:"allowed_hosts" mapstructure:"allowed_hosts"`
+}
+
+func DefaultHTTPTargetNetworkConf() *HTTPTargetNetworkConf {
+	return &HTTPTargetNetworkConf{}
+}
+
+// IsForbiddenIP hostAllowed 表示该地址由 AllowedHosts 中的域名解析得到。
+// 创建时只能校验字面量地址，域名解析后的地址由 HTTP 客户端在建立连接时校验
+func (c *HTTPTargetNetworkConf) IsForbiddenIP(ip net.IP, hostAllowed bool) bool {
+	if isHTTPTargetDeniedIP(ip) {
+		return true
+	}
+	if !ip.IsPrivate() && !httpTargetSharedAddressSpace.Contains(ip) {
+		return false
+	}
+	return !hostAllowed && !c.isAllowedIP(ip)
+}
+
+// IsForbiddenHost 校验 URL 中的 host，host 为域名时只拦截 localhost
+func (c *HTTPTargetNetworkConf) IsForbiddenHost(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return c.IsForbiddenIP(ip, false)
+	}
+	host = normalizeHTTPTargetHost(host)
+	return host == "localhost" || strings.HasSuffix(host, ".localhost")
+}
+
+// IsAllowedHost host 是否在 AllowedHosts 中
+func (c *HTTPTargetNetworkConf) IsAllowedHost(host string) bool {
+	if c == nil || host == "" {
+		return false
+	}
+	host = normalizeHTTPTargetHost(host)
+	for _, allowed := range c.AllowedHosts {
+		allowed = normalizeHTTPTargetHost(allowed)
+		if allowed == host || (strings.HasPrefix(allowed, ".") && strings.HasSuffix(host, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *HTTPTargetNetworkConf) isAllowedIP(ip net.IP) bool {
+	if c == nil {
+		return false
+	}
+	for _, cidr := range c.AllowedCIDRs {
+		if _, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr)); err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHTTPTargetDeniedIP 回环、链路本地及元数据地址，配置白名单也不允许访问
+func isHTTPTargetDeniedIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return true
+	}
+	for _, metadata := range httpTargetMetadataIPs {
+		if metadata.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeHTTPTargetHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
+
+// HTTPRequest 发往评测对象 HTTP 服务的请求
+type HTTPRequest struct {
+	Method  string
+	URL     string
+	Headers map[string]string
+	Body    []byte
+	Timeout time.Duration
+}
+
+type HTTPResponse struct {
+	StatusCode int
+	Body       []byte
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+)
+
+func TestHTTPTarget_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  *HTTPTarget
+		wantErr bool
+	}{
+		{name: "valid", target: &HTTPTarget{URL: "https://example.com/{{id}}?q={{q}}", OutputMappings: []*HTTPOutputMapping{{FieldKey: "a", JSONPath: "$.a"}}}},
+		{name: "empty url", target: &HTTPTarget{}, wantErr: true},
+		{name: "bad scheme", target: &HTTPTarget{URL: "ftp://example.com"}, wantErr: true},
+		{name: "loopback host", target: &HTTPTarget{URL: "http://127.0.0.1:8080/api"}, wantErr: true},
+		{name: "localhost", target: &HTTPTarget{URL: "http://localhost/api"}, wantErr: true},
+		{name: "private host", target: &HTTPTarget{URL: "http://10.0.0.8/api"}, wantErr: true},
+		{name: "link local host", target: &HTTPTarget{URL: "http://169.254.169.254/latest/meta-data"}, wantErr: true},
+		{name: "ipv6 loopback host", target: &HTTPTarget{URL: "http://[::1]/api"}, wantErr: true},
+		{name: "public ip host", target: &HTTPTarget{URL: "http://8.8.8.8/api"}},
+		{name: "bad method", target: &HTTPTarget{URL: "https://example.com", Method: "DELETE"}, wantErr: true},
+		{name: "negative timeout", target: &HTTPTarget{URL: "https://example.com", TimeoutMS: -1}, wantErr: true},
+		{name: "too many retries", target: &HTTPTarget{URL: "https://example.com", RetryTimes: HTTPTargetMaxRetryTimes + 1}, wantErr: true},
+		{name: "empty field key", target: &HTTPTarget{URL: "https://example.com", OutputMappings: []*HTTPOutputMapping{{JSONPath: "$.a"}}}, wantErr: true},
+		{name: "duplicated field key", target: &HTTPTarget{URL: "https://example.com", OutputMappings: []*HTTPOutputMapping{{FieldKey: "a"}, {FieldKey: "a"}}}, wantErr: true},
+		{name: "bad json path", target: &HTTPTarget{URL: "https://example.com", OutputMappings: []*HTTPOutputMapping{{FieldKey: "a", JSONPath: "$.a[("}}}, wantErr: true},
+		{name: "bearer without token", target: &HTTPTarget{URL: "https://example.com", Auth: &HTTPAuth{AuthType: HTTPAuthTypeBearer}}, wantErr: true},
+		{name: "basic without username", target: &HTTPTarget{URL: "https://example.com", Auth: &HTTPAuth{AuthType: HTTPAuthTypeBasic}}, wantErr: true},
+		{name: "api key without header", target: &HTTPTarget{URL: "https://example.com", Auth: &HTTPAuth{AuthType: HTTPAuthTypeAPIKey, Token: "t"}}, wantErr: true},
+		{name: "unknown auth", target: &HTTPTarget{URL: "https://example.com", Auth: &HTTPAuth{}}, wantErr: true},
+		{name: "api key", target: &HTTPTarget{URL: "https://example.com", Auth: &HTTPAuth{AuthType: HTTPAuthTypeAPIKey, Token: "t", HeaderName: "X-Key"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.target.Validate(nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHTTPTargetNetworkConf(t *testing.T) {
+	conf := &HTTPTargetNetworkConf{
+		AllowedCIDRs: []string{"10.0.0.0/8", "169.254.0.0/16", "bad cidr"},
+		AllowedHosts: []string{"api.internal", ".svc.cluster.local"},
+	}
+	tests := []struct {
+		name          string
+		conf          *HTTPTargetNetworkConf
+		ip            string
+		hostAllowed   bool
+		wantForbidden bool
+	}{
+		{name: "public ip", conf: nil, ip: "8.8.8.8"},
+		{name: "private ip without allowlist", conf: nil, ip: "10.0.0.8", wantForbidden: true},
+		{name: "private ip in allowed cidr", conf: conf, ip: "10.0.0.8"},
+		{name: "private ip out of allowed cidr", conf: conf, ip: "192.168.1.1", wantForbidden: true},
+		{name: "private ip from allowed host", conf: conf, ip: "192.168.1.1", hostAllowed: true},
+		{name: "shared address space", conf: conf, ip: "100.64.0.1", wantForbidden: true},
+		{name: "loopback is always denied", conf: conf, ip: "127.0.0.1", hostAllowed: true, wantForbidden: true},
+		{name: "link local metadata is always denied", conf: conf, ip: "169.254.169.254", wantForbidden: true},
+		{name: "aliyun metadata is always denied", conf: &HTTPTargetNetworkConf{AllowedCIDRs: []string{"100.64.0.0/10"}}, ip: "100.100.100.200", wantForbidden: true},
+		{name: "aws ipv6 metadata is always denied", conf: &HTTPTargetNetworkConf{AllowedCIDRs: []string{"fd00::/8"}}, ip: "fd00:ec2::254", wantForbidden: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantForbidden, tt.conf.IsForbiddenIP(net.ParseIP(tt.ip), tt.hostAllowed))
+		})
+	}
+
+	assert.True(t, conf.IsAllowedHost("API.internal."))
+	assert.True(t, conf.IsAllowedHost("svc-a.ns.svc.cluster.local"))
+	assert.False(t, conf.IsAllowedHost("evil-api.internal"))
+	assert.False(t, conf.IsAllowedHost(""))
+	assert.False(t, (*HTTPTargetNetworkConf)(nil).IsAllowedHost("api.internal"))
+
+	assert.NoError(t, (&HTTPTarget{URL: "http://10.0.0.8/api"}).Validate(conf))
+	assert.Error(t, (&HTTPTarget{URL: "http://127.0.0.1/api"}).Validate(conf))
+	assert.Error(t, (&HTTPTarget{URL: "http://localhost/api"}).Validate(&HTTPTargetNetworkConf{AllowedHosts: []string{"localhost"}}))
+}
+
+func TestHTTPTarget_Defaults(t *testing.T) {
+	target := &HTTPTarget{}
+	assert.Equal(t, "POST", target.GetMethod())
+	assert.Equal(t, HTTPTargetDefaultTimeout, target.GetTimeout())
+
+	target = &HTTPTarget{Method: "get", TimeoutMS: 1500}
+	assert.Equal(t, "GET", target.GetMethod())
+	assert.Equal(t, 1500*time.Millisecond, target.GetTimeout())
+
+	target.TimeoutMS = int64(HTTPTargetMaxTimeout/time.Millisecond) + 1
+	assert.Equal(t, HTTPTargetMaxTimeout, target.GetTimeout())
+}
+
+func TestHTTPTarget_Schemas(t *testing.T) {
+	target := &HTTPTarget{
+		URL:          "https://example.com/{{ user_id }}?q={{query}}",
+		BodyTemplate: `{"query":"{{query}}","ctx":"{{context}}"}`,
+	}
+	assert.Equal(t, []string{"context", "query", "user_id"}, target.Variables())
+
+	inputs := target.InputSchemas()
+	assert.Len(t, inputs, 3)
+	assert.Equal(t, gptr.Of("context"), inputs[0].Key)
+	assert.Equal(t, []ContentType{ContentTypeText}, inputs[0].SupportContentTypes)
+
+	outputs := target.OutputSchemas()
+	assert.Len(t, outputs, 1)
+	assert.Equal(t, gptr.Of(consts.OutputSchemaKey), outputs[0].Key)
+
+	target.OutputMappings = []*HTTPOutputMapping{{FieldKey: "answer", JSONPath: "$.answer"}, {FieldKey: "score", JSONPath: "$.score"}}
+	outputs = target.OutputSchemas()
+	assert.Len(t, outputs, 2)
+	assert.Equal(t, gptr.Of("score"), outputs[1].Key)
+}
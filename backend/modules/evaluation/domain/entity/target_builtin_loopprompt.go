@@ -4,8 +4,10 @@
 package entity
 
 type LoopPrompt struct {
-	PromptID     int64
-	Version      string
+	PromptID int64
+	Version  string
+	// Label 发布标签，非空时每次执行按标签解析最新指向的提交版本，Version 为空
+	Label        string       `json:",omitempty"`
 	PromptKey    string       `json:"-"`
 	Name         string       `json:"-"`
 	SubmitStatus SubmitStatus `json:"-"`
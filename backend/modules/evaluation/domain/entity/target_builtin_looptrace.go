@@ -0,0 +1,16 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// trace 回放评测对象不调用线上服务，而是按评测集中记录的 trace_id/span_id 读取已上报 span 的输入输出
+const (
+	LoopTraceInputKeyTraceID = "trace_id"
+	// 为空时取 trace 的根节点
+	LoopTraceInputKeySpanID = "span_id"
+	// span 开始时间，单位 ms，用于缩小查询范围
+	LoopTraceInputKeyStartTime = "start_time"
+
+	LoopTraceOutputKeySpanInput = "span_input"
+	LoopTraceOutputKeySpanTags  = "span_tags"
+)
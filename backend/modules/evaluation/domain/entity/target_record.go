@@ -91,6 +91,8 @@ type EvalTargetOutputData struct {
 	EvalTargetRunError *EvalTargetRunError
 	// 运行耗时
 	TimeConsumingMS *int64
+	// 按发布标签执行时实际运行的提交版本
+	ResolvedSourceTargetVersion *string
 }
 
 type EvalTargetUsage struct {
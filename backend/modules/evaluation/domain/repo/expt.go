@@ -5,12 +5,13 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/coze-dev/coze-loop/backend/infra/db"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 )
 
-//go:generate  mockgen -destination  ./mocks/expt.go  --package mocks . IExperimentRepo,IExptStatsRepo,IExptItemResultRepo,IExptTurnResultRepo,IExptRunLogRepo,IExptAggrResultRepo,QuotaRepo,IExptTurnResultFilterRepo,IExptAnnotateRepo,IExptResultExportRecordRepo
+//go:generate  mockgen -destination  ./mocks/expt.go  --package mocks . IExperimentRepo,IExptStatsRepo,IExptItemResultRepo,IExptTurnResultRepo,IExptRunLogRepo,IExptAggrResultRepo,QuotaRepo,IExptTurnResultFilterRepo,IExptAnnotateRepo,IExptResultExportRecordRepo,IExptScheduleRepo
 type IExperimentRepo interface {
 	Create(ctx context.Context, expt *entity.Experiment, exptEvaluatorRefs []*entity.ExptEvaluatorRef) error
 	Update(ctx context.Context, expt *entity.Experiment) error
@@ -134,3 +135,16 @@ type IExptResultExportRecordRepo interface {
 	List(ctx context.Context, spaceID, exptID int64, page entity.Page, csvExportStatus *int32) ([]*entity.ExptResultExportRecord, int64, error)
 	Get(ctx context.Context, spaceID, exportID int64) (*entity.ExptResultExportRecord, error)
 }
+
+type IExptScheduleRepo interface {
+	Create(ctx context.Context, schedule *entity.ExptSchedule) error
+	Update(ctx context.Context, schedule *entity.ExptSchedule) error
+	Delete(ctx context.Context, id, spaceID int64) error
+	Get(ctx context.Context, id, spaceID int64) (*entity.ExptSchedule, error)
+	List(ctx context.Context, spaceID int64, filter *entity.ExptScheduleListFilter, page entity.Page) ([]*entity.ExptSchedule, int64, error)
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.ExptSchedule, error)
+	UpdateTriggerTime(ctx context.Context, id int64, prevNextTriggerAt, nextTriggerAt, lastTriggerAt time.Time) (bool, error)
+
+	CreateRun(ctx context.Context, run *entity.ExptScheduleRun) error
+	ListRuns(ctx context.Context, spaceID, scheduleID int64, page entity.Page) ([]*entity.ExptScheduleRun, int64, error)
+}
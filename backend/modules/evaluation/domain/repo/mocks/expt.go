@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo (interfaces: IExperimentRepo,IExptStatsRepo,IExptItemResultRepo,IExptTurnResultRepo,IExptRunLogRepo,IExptAggrResultRepo,QuotaRepo,IExptTurnResultFilterRepo,IExptAnnotateRepo,IExptResultExportRecordRepo)
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo (interfaces: IExperimentRepo,IExptStatsRepo,IExptItemResultRepo,IExptTurnResultRepo,IExptRunLogRepo,IExptAggrResultRepo,QuotaRepo,IExptTurnResultFilterRepo,IExptAnnotateRepo,IExptResultExportRecordRepo,IExptScheduleRepo)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 
@@ -1591,3 +1592,159 @@ func (mr *MockIExptResultExportRecordRepoMockRecorder) Update(arg0, arg1 interfa
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIExptResultExportRecordRepo)(nil).Update), varargs...)
 }
+
+// MockIExptScheduleRepo is a mock of IExptScheduleRepo interface.
+type MockIExptScheduleRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExptScheduleRepoMockRecorder
+}
+
+// MockIExptScheduleRepoMockRecorder is the mock recorder for MockIExptScheduleRepo.
+type MockIExptScheduleRepoMockRecorder struct {
+	mock *MockIExptScheduleRepo
+}
+
+// NewMockIExptScheduleRepo creates a new mock instance.
+func NewMockIExptScheduleRepo(ctrl *gomock.Controller) *MockIExptScheduleRepo {
+	mock := &MockIExptScheduleRepo{ctrl: ctrl}
+	mock.recorder = &MockIExptScheduleRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExptScheduleRepo) EXPECT() *MockIExptScheduleRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIExptScheduleRepo) Create(arg0 context.Context, arg1 *entity.ExptSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIExptScheduleRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIExptScheduleRepo)(nil).Create), arg0, arg1)
+}
+
+// CreateRun mocks base method.
+func (m *MockIExptScheduleRepo) CreateRun(arg0 context.Context, arg1 *entity.ExptScheduleRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRun", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRun indicates an expected call of CreateRun.
+func (mr *MockIExptScheduleRepoMockRecorder) CreateRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRun", reflect.TypeOf((*MockIExptScheduleRepo)(nil).CreateRun), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockIExptScheduleRepo) Delete(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIExptScheduleRepoMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIExptScheduleRepo)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockIExptScheduleRepo) Get(arg0 context.Context, arg1, arg2 int64) (*entity.ExptSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.ExptSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIExptScheduleRepoMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIExptScheduleRepo)(nil).Get), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockIExptScheduleRepo) List(arg0 context.Context, arg1 int64, arg2 *entity.ExptScheduleListFilter, arg3 entity.Page) ([]*entity.ExptSchedule, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.ExptSchedule)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockIExptScheduleRepoMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIExptScheduleRepo)(nil).List), arg0, arg1, arg2, arg3)
+}
+
+// ListDue mocks base method.
+func (m *MockIExptScheduleRepo) ListDue(arg0 context.Context, arg1 time.Time, arg2 int) ([]*entity.ExptSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.ExptSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue.
+func (mr *MockIExptScheduleRepoMockRecorder) ListDue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*MockIExptScheduleRepo)(nil).ListDue), arg0, arg1, arg2)
+}
+
+// ListRuns mocks base method.
+func (m *MockIExptScheduleRepo) ListRuns(arg0 context.Context, arg1, arg2 int64, arg3 entity.Page) ([]*entity.ExptScheduleRun, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.ExptScheduleRun)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockIExptScheduleRepoMockRecorder) ListRuns(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockIExptScheduleRepo)(nil).ListRuns), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockIExptScheduleRepo) Update(arg0 context.Context, arg1 *entity.ExptSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIExptScheduleRepoMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIExptScheduleRepo)(nil).Update), arg0, arg1)
+}
+
+// UpdateTriggerTime mocks base method.
+func (m *MockIExptScheduleRepo) UpdateTriggerTime(arg0 context.Context, arg1 int64, arg2, arg3, arg4 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTriggerTime", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTriggerTime indicates an expected call of UpdateTriggerTime.
+func (mr *MockIExptScheduleRepoMockRecorder) UpdateTriggerTime(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerTime", reflect.TypeOf((*MockIExptScheduleRepo)(nil).UpdateTriggerTime), arg0, arg1, arg2, arg3, arg4)
+}
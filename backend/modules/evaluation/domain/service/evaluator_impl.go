@@ -211,6 +211,9 @@ func (e *EvaluatorServiceImpl) validateCreateEvaluatorRequest(ctx context.Contex
 	if evaluator.SpaceID == 0 {
 		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("space id is nil"))
 	}
+	if err := validateBuiltinEvaluatorConfig(evaluator); err != nil {
+		return err
+	}
 	// 校验评估器名称是否已存在
 	if evaluator.Name != "" {
 		exist, err := e.evaluatorRepo.CheckNameExist(ctx, evaluator.SpaceID, consts.EvaluatorEmptyID, evaluator.Name)
@@ -254,6 +257,9 @@ func (e *EvaluatorServiceImpl) validateUpdateEvaluatorMetaRequest(ctx context.Co
 
 // UpdateEvaluatorDraft 修改 evaluator_version
 func (e *EvaluatorServiceImpl) UpdateEvaluatorDraft(ctx context.Context, versionDO *entity.Evaluator) error {
+	if err := validateBuiltinEvaluatorConfig(versionDO); err != nil {
+		return err
+	}
 	versionDO.BaseInfo.SetUpdatedAt(gptr.Of(time.Now().UnixMilli()))
 	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
 	versionDO.BaseInfo.SetUpdatedBy(&entity.UserInfo{
@@ -262,6 +268,14 @@ func (e *EvaluatorServiceImpl) UpdateEvaluatorDraft(ctx context.Context, version
 	return e.evaluatorRepo.UpdateEvaluatorDraft(ctx, versionDO)
 }
 
+// validateBuiltinEvaluatorConfig 内置评估器在保存草稿时即校验配置，避免非法配置直到提交或运行时才暴露
+func validateBuiltinEvaluatorConfig(evaluator *entity.Evaluator) error {
+	if evaluator.EvaluatorType != entity.EvaluatorTypeBuiltin {
+		return nil
+	}
+	return evaluator.BuiltinEvaluatorVersion.ValidateBaseInfo()
+}
+
 // DeleteEvaluator 删除 evaluator_version
 func (e *EvaluatorServiceImpl) DeleteEvaluator(ctx context.Context, evaluatorIDs []int64, userID string) error {
 	return e.evaluatorRepo.BatchDeleteEvaluator(ctx, evaluatorIDs, userID)
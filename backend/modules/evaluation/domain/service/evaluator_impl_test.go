@@ -573,6 +573,25 @@ func TestEvaluatorServiceImpl_CreateEvaluator(t *testing.T) {
 			expectedID:  int64(0),
 			expectedErr: errors.New("db create error"),
 		},
+		{
+			name: "失败 - 内置评估器配置非法",
+			evaluatorDO: &entity.Evaluator{
+				SpaceID:       int64(1),
+				Name:          "Test Evaluator",
+				EvaluatorType: entity.EvaluatorTypeBuiltin,
+				BuiltinEvaluatorVersion: &entity.BuiltinEvaluatorVersion{
+					BuiltinType: entity.BuiltinEvaluatorTypeBLEU,
+					Config:      &entity.BuiltinEvaluatorConfig{MaxNGram: entity.BuiltinEvaluatorMaxMaxNGram + 1},
+				},
+			},
+			cid: "invalid_builtin_cid",
+			setupMock: func(evaluatorDO *entity.Evaluator, cid string, mockIdem *idemmocks.MockIdempotentService, mockRepo *repomocks.MockIEvaluatorRepo) {
+				mockIdem.EXPECT().Set(gomock.Any(), "create_evaluator_idem"+cid, time.Second*10).Return(nil)
+			},
+			expectedID:      0,
+			expectedErr:     errors.New("invalid builtin config"),
+			expectedErrCode: errno.InvalidBuiltinEvaluatorConfigCode,
+		},
 		{
 			name:        "成功 - 创建 Evaluator",
 			evaluatorDO: baseEvaluatorDO(),
@@ -618,6 +637,11 @@ func TestEvaluatorServiceImpl_CreateEvaluator(t *testing.T) {
 
 			if tc.expectedErr != nil {
 				assert.Error(t, err)
+				if tc.expectedErrCode != 0 {
+					statusErr, ok := errorx.FromStatusError(err)
+					assert.True(t, ok)
+					assert.Equal(t, tc.expectedErrCode, statusErr.Code())
+				}
 			} else {
 				assert.NoError(t, err)
 			}
@@ -778,11 +802,27 @@ func TestEvaluatorServiceImpl_UpdateEvaluatorDraft(t *testing.T) {
 	}
 
 	tests := []struct {
-		name          string
-		evaluatorDO   *entity.Evaluator
-		setupMock     func(mockRepo *repomocks.MockIEvaluatorRepo)
-		expectedError error
+		name            string
+		evaluatorDO     *entity.Evaluator
+		setupMock       func(mockRepo *repomocks.MockIEvaluatorRepo)
+		expectedError   error
+		expectedErrCode int32
 	}{
+		{
+			name: "内置评估器配置非法",
+			evaluatorDO: &entity.Evaluator{
+				ID:            2,
+				SpaceID:       100,
+				EvaluatorType: entity.EvaluatorTypeBuiltin,
+				BuiltinEvaluatorVersion: &entity.BuiltinEvaluatorVersion{
+					BuiltinType: entity.BuiltinEvaluatorTypeBLEU,
+					Config:      &entity.BuiltinEvaluatorConfig{MaxNGram: -1},
+				},
+				BaseInfo: &entity.BaseInfo{},
+			},
+			setupMock:       func(mockRepo *repomocks.MockIEvaluatorRepo) {},
+			expectedErrCode: errno.InvalidBuiltinEvaluatorConfigCode,
+		},
 		{
 			name:        "成功更新评估器草稿",
 			evaluatorDO: testEvaluator,
@@ -806,7 +846,11 @@ func TestEvaluatorServiceImpl_UpdateEvaluatorDraft(t *testing.T) {
 			tt.setupMock(mockEvaluatorRepo)
 			err := s.UpdateEvaluatorDraft(ctx, tt.evaluatorDO)
 
-			if tt.expectedError != nil {
+			if tt.expectedErrCode != 0 {
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedErrCode, statusErr.Code())
+			} else if tt.expectedError != nil {
 				assert.Error(t, err)
 				assert.Equal(t, tt.expectedError, err)
 			} else {
@@ -0,0 +1,263 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/samber/lo"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	evaljson "github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/textmetric"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+var (
+	evaluatorSourceBuiltinServiceOnce      = sync.Once{}
+	singletonEvaluatorSourceBuiltinService EvaluatorSourceService
+)
+
+func NewEvaluatorSourceBuiltinServiceImpl(metric metrics.EvaluatorExecMetrics) EvaluatorSourceService {
+	evaluatorSourceBuiltinServiceOnce.Do(func() {
+		singletonEvaluatorSourceBuiltinService = &EvaluatorSourceBuiltinServiceImpl{
+			metric: metric,
+		}
+	})
+	return singletonEvaluatorSourceBuiltinService
+}
+
+// EvaluatorSourceBuiltinServiceImpl 内置评估器，纯规则计算，结果确定且不依赖 LLM
+type EvaluatorSourceBuiltinServiceImpl struct {
+	metric metrics.EvaluatorExecMetrics
+}
+
+func (b *EvaluatorSourceBuiltinServiceImpl) EvaluatorType() entity.EvaluatorType {
+	return entity.EvaluatorTypeBuiltin
+}
+
+func (b *EvaluatorSourceBuiltinServiceImpl) Run(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData) (output *entity.EvaluatorOutputData, runStatus entity.EvaluatorRunStatus, traceID string) {
+	var err error
+	startTime := time.Now()
+	rootSpan, ctx := newEvaluatorSpan(ctx, evaluator.Name, "LoopEvaluation", strconv.FormatInt(evaluator.SpaceID, 10), false)
+	traceID = rootSpan.GetTraceID()
+	defer func() {
+		if output == nil {
+			output = &entity.EvaluatorOutputData{
+				EvaluatorRunError: &entity.EvaluatorRunError{},
+			}
+		}
+		var errInfo error
+		if err != nil {
+			if output.EvaluatorRunError == nil {
+				output.EvaluatorRunError = &entity.EvaluatorRunError{}
+			}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				output.EvaluatorRunError.Code = statusErr.Code()
+				output.EvaluatorRunError.Message = statusErr.Error()
+				errInfo = statusErr
+			} else {
+				output.EvaluatorRunError.Code = errno.RunEvaluatorFailCode
+				output.EvaluatorRunError.Message = err.Error()
+				errInfo = err
+			}
+		}
+		output.TimeConsumingMS = time.Since(startTime).Milliseconds()
+		rootSpan.reportRootSpan(ctx, &ReportRootSpanRequest{
+			input:            input,
+			output:           output,
+			runStatus:        runStatus,
+			evaluatorVersion: evaluator.GetEvaluatorVersion(),
+			errInfo:          errInfo,
+		})
+	}()
+
+	if evaluator.BuiltinEvaluatorVersion == nil {
+		err = errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	err = evaluator.BuiltinEvaluatorVersion.ValidateBaseInfo()
+	if err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateBaseInfo fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	// 校验输入数据
+	err = evaluator.BuiltinEvaluatorVersion.ValidateInput(input)
+	if err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateInput fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	defer func() {
+		b.metric.EmitRun(evaluator.SpaceID, err, startTime, "")
+	}()
+
+	output, err = evaluateBuiltin(evaluator.BuiltinEvaluatorVersion, input)
+	if err != nil {
+		logs.CtxWarn(ctx, "[RunEvaluator] evaluate builtin fail, evaluator_version_id: %v, err: %v", evaluator.BuiltinEvaluatorVersion.ID, err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	return output, entity.EvaluatorRunStatusSuccess, traceID
+}
+
+func (b *EvaluatorSourceBuiltinServiceImpl) Debug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData) (output *entity.EvaluatorOutputData, err error) {
+	output, _, _ = b.Run(ctx, evaluator, input)
+	if output != nil && output.EvaluatorRunError != nil {
+		return nil, errorx.NewByCode(output.EvaluatorRunError.Code, errorx.WithExtraMsg(output.EvaluatorRunError.Message))
+	}
+	return output, nil
+}
+
+func (b *EvaluatorSourceBuiltinServiceImpl) PreHandle(ctx context.Context, evaluator *entity.Evaluator) error {
+	return nil
+}
+
+// evaluateBuiltin 按内置评估器类型计算分数与理由
+func evaluateBuiltin(version *entity.BuiltinEvaluatorVersion, input *entity.EvaluatorInputData) (*entity.EvaluatorOutputData, error) {
+	conf := version.Config
+	if conf == nil {
+		conf = &entity.BuiltinEvaluatorConfig{}
+	}
+	actual, _ := builtinInputText(input, entity.BuiltinEvaluatorOutputKey)
+	reference, ok := builtinInputText(input, entity.BuiltinEvaluatorReferenceKey)
+	if version.BuiltinType.NeedReference() && !ok {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("input field %s is required", entity.BuiltinEvaluatorReferenceKey)))
+	}
+	if utf8.RuneCountInString(actual) > entity.BuiltinEvaluatorMaxTextLength || utf8.RuneCountInString(reference) > entity.BuiltinEvaluatorMaxTextLength {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("input text exceeds %d characters", entity.BuiltinEvaluatorMaxTextLength)))
+	}
+
+	var (
+		score  float64
+		reason string
+	)
+	switch version.BuiltinType {
+	case entity.BuiltinEvaluatorTypeExactMatch:
+		matched := normalizeText(actual, conf) == normalizeText(reference, conf)
+		score, reason = boolScore(matched), lo.Ternary(matched, "output matches reference", "output does not match reference")
+	case entity.BuiltinEvaluatorTypeFuzzyMatch:
+		similarity := textmetric.LevenshteinSimilarity(normalizeText(actual, conf), normalizeText(reference, conf))
+		score, reason = similarity, fmt.Sprintf("similarity: %.4f", similarity)
+		if conf.Threshold != nil {
+			score = boolScore(similarity >= *conf.Threshold)
+			reason = fmt.Sprintf("similarity: %.4f, threshold: %.4f", similarity, *conf.Threshold)
+		}
+	case entity.BuiltinEvaluatorTypeRougeL:
+		score = textmetric.RougeL(textmetric.Tokenize(normalizeText(actual, conf)), textmetric.Tokenize(normalizeText(reference, conf)))
+		reason = fmt.Sprintf("rouge-l f1: %.4f", score)
+	case entity.BuiltinEvaluatorTypeBLEU:
+		maxN := conf.GetMaxNGram()
+		score = textmetric.BLEU(textmetric.Tokenize(normalizeText(actual, conf)), textmetric.Tokenize(normalizeText(reference, conf)), maxN)
+		reason = fmt.Sprintf("bleu-%d: %.4f", maxN, score)
+	case entity.BuiltinEvaluatorTypeEditDistance:
+		a, b := normalizeText(actual, conf), normalizeText(reference, conf)
+		distance := textmetric.Levenshtein(a, b)
+		score = textmetric.LevenshteinSimilarity(a, b)
+		reason = fmt.Sprintf("edit distance: %d, score: %.4f", distance, score)
+	case entity.BuiltinEvaluatorTypeJSONSchema:
+		score, reason = evaluateJSONSchema(actual, conf.JSONSchema)
+	case entity.BuiltinEvaluatorTypeRegex:
+		pattern := conf.Pattern
+		if conf.FullMatch {
+			pattern = `^(?:` + pattern + `)$`
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errorx.NewByCode(errno.InvalidBuiltinEvaluatorConfigCode, errorx.WithExtraMsg(err.Error()))
+		}
+		matched := re.MatchString(actual)
+		score, reason = boolScore(matched), lo.Ternary(matched, "output matches pattern", "output does not match pattern")
+	case entity.BuiltinEvaluatorTypeNumericTolerance:
+		var err error
+		score, reason, err = evaluateNumericTolerance(actual, reference, conf)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorx.NewByCode(errno.BuiltinEvaluatorTypeNotSupportedCode, errorx.WithExtraMsg(fmt.Sprintf("builtin evaluator type %v", version.BuiltinType)))
+	}
+
+	return &entity.EvaluatorOutputData{
+		EvaluatorResult: &entity.EvaluatorResult{
+			Score:     gptr.Of(score),
+			Reasoning: reason,
+		},
+		EvaluatorUsage: &entity.EvaluatorUsage{},
+	}, nil
+}
+
+func builtinInputText(input *entity.EvaluatorInputData, key string) (string, bool) {
+	if input == nil {
+		return "", false
+	}
+	content, ok := input.InputFields[key]
+	if !ok || content == nil {
+		return "", false
+	}
+	return gptr.Indirect(content.Text), true
+}
+
+var whitespaceRegexp = regexp.MustCompile(`\s+`)
+
+func normalizeText(s string, conf *entity.BuiltinEvaluatorConfig) string {
+	if conf.IgnoreWhitespace {
+		s = whitespaceRegexp.ReplaceAllString(strings.TrimSpace(s), " ")
+	}
+	if !conf.CaseSensitive {
+		s = strings.ToLower(s)
+	}
+	return s
+}
+
+func evaluateJSONSchema(actual, schema string) (float64, string) {
+	if !json.Valid([]byte(actual)) {
+		return 0, "output is not valid json"
+	}
+	if schema == "" {
+		return 1, "output is valid json"
+	}
+	valid, err := evaljson.ValidateJSONSchema(schema, actual)
+	if err != nil || !valid {
+		return 0, fmt.Sprintf("output does not match json schema: %v", err)
+	}
+	return 1, "output matches json schema"
+}
+
+func evaluateNumericTolerance(actual, reference string, conf *entity.BuiltinEvaluatorConfig) (float64, string, error) {
+	actualNum, err := strconv.ParseFloat(strings.TrimSpace(actual), 64)
+	if err != nil {
+		return 0, fmt.Sprintf("output %q is not a number", actual), nil
+	}
+	referenceNum, err := strconv.ParseFloat(strings.TrimSpace(reference), 64)
+	if err != nil {
+		return 0, "", errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("reference %q is not a number", reference)))
+	}
+	diff := math.Abs(actualNum - referenceNum)
+	tolerance := conf.Tolerance
+	if conf.RelativeTolerance {
+		tolerance *= math.Abs(referenceNum)
+	}
+	matched := diff <= tolerance
+	return boolScore(matched), fmt.Sprintf("abs diff: %g, tolerance: %g", diff, tolerance), nil
+}
+
+func boolScore(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
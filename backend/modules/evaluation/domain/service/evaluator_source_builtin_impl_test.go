@@ -0,0 +1,148 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func newBuiltinInput(output string, reference *string) *entity.EvaluatorInputData {
+	fields := map[string]*entity.Content{
+		entity.BuiltinEvaluatorOutputKey: {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(output)},
+	}
+	if reference != nil {
+		fields[entity.BuiltinEvaluatorReferenceKey] = &entity.Content{ContentType: gptr.Of(entity.ContentTypeText), Text: reference}
+	}
+	return &entity.EvaluatorInputData{InputFields: fields}
+}
+
+func TestEvaluateBuiltin(t *testing.T) {
+	tests := []struct {
+		name        string
+		builtinType entity.BuiltinEvaluatorType
+		config      *entity.BuiltinEvaluatorConfig
+		output      string
+		reference   *string
+		wantScore   float64
+		wantCode    int32
+	}{
+		{name: "exact match ignore case", builtinType: entity.BuiltinEvaluatorTypeExactMatch, output: "Paris", reference: gptr.Of("paris"), wantScore: 1},
+		{name: "exact match case sensitive", builtinType: entity.BuiltinEvaluatorTypeExactMatch, config: &entity.BuiltinEvaluatorConfig{CaseSensitive: true}, output: "Paris", reference: gptr.Of("paris"), wantScore: 0},
+		{name: "exact match ignore whitespace", builtinType: entity.BuiltinEvaluatorTypeExactMatch, config: &entity.BuiltinEvaluatorConfig{IgnoreWhitespace: true}, output: " a  b\n", reference: gptr.Of("a b"), wantScore: 1},
+		{name: "exact match missing reference", builtinType: entity.BuiltinEvaluatorTypeExactMatch, output: "a", wantCode: errno.CommonInvalidParamCode},
+		{name: "fuzzy match similarity", builtinType: entity.BuiltinEvaluatorTypeFuzzyMatch, output: "abcd", reference: gptr.Of("abce"), wantScore: 0.75},
+		{name: "fuzzy match threshold", builtinType: entity.BuiltinEvaluatorTypeFuzzyMatch, config: &entity.BuiltinEvaluatorConfig{Threshold: gptr.Of(0.7)}, output: "abcd", reference: gptr.Of("abce"), wantScore: 1},
+		{name: "rouge-l identical", builtinType: entity.BuiltinEvaluatorTypeRougeL, output: "the cat sat", reference: gptr.Of("The cat sat"), wantScore: 1},
+		{name: "bleu identical", builtinType: entity.BuiltinEvaluatorTypeBLEU, output: "the quick brown fox jumps", reference: gptr.Of("the quick brown fox jumps"), wantScore: 1},
+		{name: "edit distance", builtinType: entity.BuiltinEvaluatorTypeEditDistance, output: "kitten", reference: gptr.Of("sitting"), wantScore: 1 - 3.0/7},
+		{name: "edit distance both empty", builtinType: entity.BuiltinEvaluatorTypeEditDistance, output: "", reference: gptr.Of(""), wantScore: 1},
+		{name: "edit distance input too long", builtinType: entity.BuiltinEvaluatorTypeEditDistance, output: strings.Repeat("a", entity.BuiltinEvaluatorMaxTextLength+1), reference: gptr.Of("a"), wantCode: errno.CommonInvalidParamCode},
+		{name: "json valid", builtinType: entity.BuiltinEvaluatorTypeJSONSchema, output: `{"a": 1}`, wantScore: 1},
+		{name: "json invalid", builtinType: entity.BuiltinEvaluatorTypeJSONSchema, output: `{"a": `, wantScore: 0},
+		{name: "json schema mismatch", builtinType: entity.BuiltinEvaluatorTypeJSONSchema, config: &entity.BuiltinEvaluatorConfig{JSONSchema: `{"type":"object","required":["b"]}`}, output: `{"a": 1}`, wantScore: 0},
+		{name: "json schema match", builtinType: entity.BuiltinEvaluatorTypeJSONSchema, config: &entity.BuiltinEvaluatorConfig{JSONSchema: `{"type":"object","required":["a"]}`}, output: `{"a": 1}`, wantScore: 1},
+		{name: "regex partial", builtinType: entity.BuiltinEvaluatorTypeRegex, config: &entity.BuiltinEvaluatorConfig{Pattern: `\d+`}, output: "order 123", wantScore: 1},
+		{name: "regex full match", builtinType: entity.BuiltinEvaluatorTypeRegex, config: &entity.BuiltinEvaluatorConfig{Pattern: `\d+`, FullMatch: true}, output: "order 123", wantScore: 0},
+		{name: "regex full match alternation", builtinType: entity.BuiltinEvaluatorTypeRegex, config: &entity.BuiltinEvaluatorConfig{Pattern: `a|ab`, FullMatch: true}, output: "ab", wantScore: 1},
+		{name: "numeric absolute", builtinType: entity.BuiltinEvaluatorTypeNumericTolerance, config: &entity.BuiltinEvaluatorConfig{Tolerance: 0.1}, output: "3.14", reference: gptr.Of("3.2"), wantScore: 1},
+		{name: "numeric relative", builtinType: entity.BuiltinEvaluatorTypeNumericTolerance, config: &entity.BuiltinEvaluatorConfig{Tolerance: 0.01, RelativeTolerance: true}, output: "105", reference: gptr.Of("100"), wantScore: 0},
+		{name: "numeric output not number", builtinType: entity.BuiltinEvaluatorTypeNumericTolerance, output: "abc", reference: gptr.Of("1"), wantScore: 0},
+		{name: "numeric reference not number", builtinType: entity.BuiltinEvaluatorTypeNumericTolerance, output: "1", reference: gptr.Of("abc"), wantCode: errno.CommonInvalidParamCode},
+		{name: "unknown type", builtinType: entity.BuiltinEvaluatorType(99), output: "1", reference: gptr.Of("1"), wantCode: errno.BuiltinEvaluatorTypeNotSupportedCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := evaluateBuiltin(&entity.BuiltinEvaluatorVersion{BuiltinType: tt.builtinType, Config: tt.config}, newBuiltinInput(tt.output, tt.reference))
+			if tt.wantCode != 0 {
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantCode, statusErr.Code())
+				return
+			}
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.wantScore, gptr.Indirect(output.EvaluatorResult.Score), 1e-9)
+			assert.NotEmpty(t, output.EvaluatorResult.Reasoning)
+		})
+	}
+}
+
+func TestEvaluatorSourceBuiltinServiceImpl_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	svc := &EvaluatorSourceBuiltinServiceImpl{metric: mockMetric}
+	assert.Equal(t, entity.EvaluatorTypeBuiltin, svc.EvaluatorType())
+
+	evaluator := &entity.Evaluator{
+		ID:            1,
+		SpaceID:       2,
+		Name:          "exact match",
+		EvaluatorType: entity.EvaluatorTypeBuiltin,
+		BuiltinEvaluatorVersion: &entity.BuiltinEvaluatorVersion{
+			ID:           3,
+			BuiltinType:  entity.BuiltinEvaluatorTypeExactMatch,
+			InputSchemas: entity.BuiltinEvaluatorTypeExactMatch.DefaultInputSchemas(),
+		},
+	}
+
+	mockMetric.EXPECT().EmitRun(int64(2), nil, gomock.Any(), "")
+	output, status, _ := svc.Run(context.Background(), evaluator, newBuiltinInput("yes", gptr.Of("yes")))
+	assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+	assert.Equal(t, gptr.Of(1.0), output.EvaluatorResult.Score)
+	assert.Nil(t, output.EvaluatorRunError)
+
+	// 输入内容类型不符合 schema
+	output, status, _ = svc.Run(context.Background(), evaluator, &entity.EvaluatorInputData{
+		InputFields: map[string]*entity.Content{
+			entity.BuiltinEvaluatorOutputKey: {ContentType: gptr.Of(entity.ContentTypeImage)},
+		},
+	})
+	assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+	assert.Equal(t, int32(errno.ContentTypeNotSupportedCode), output.EvaluatorRunError.Code)
+
+	// 版本缺失
+	output, status, _ = svc.Run(context.Background(), &entity.Evaluator{EvaluatorType: entity.EvaluatorTypeBuiltin}, nil)
+	assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+	assert.Equal(t, int32(errno.EvaluatorNotExistCode), output.EvaluatorRunError.Code)
+}
+
+func TestEvaluatorSourceBuiltinServiceImpl_Debug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	svc := &EvaluatorSourceBuiltinServiceImpl{metric: mockMetric}
+	mockMetric.EXPECT().EmitRun(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+
+	evaluator := &entity.Evaluator{
+		EvaluatorType: entity.EvaluatorTypeBuiltin,
+		BuiltinEvaluatorVersion: &entity.BuiltinEvaluatorVersion{
+			BuiltinType: entity.BuiltinEvaluatorTypeRegex,
+			Config:      &entity.BuiltinEvaluatorConfig{Pattern: `^ok$`},
+		},
+	}
+	output, err := svc.Debug(context.Background(), evaluator, newBuiltinInput("ok", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, gptr.Of(1.0), output.EvaluatorResult.Score)
+
+	evaluator.BuiltinEvaluatorVersion.BuiltinType = entity.BuiltinEvaluatorTypeExactMatch
+	_, err = svc.Debug(context.Background(), evaluator, newBuiltinInput("ok", nil))
+	statusErr, ok := errorx.FromStatusError(err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(errno.CommonInvalidParamCode), statusErr.Code())
+
+	assert.NoError(t, svc.PreHandle(context.Background(), evaluator))
+}
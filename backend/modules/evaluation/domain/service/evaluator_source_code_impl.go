@@ -0,0 +1,260 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/coderuntime"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/tracer"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/conf"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+var (
+	evaluatorSourceCodeServiceOnce      = sync.Once{}
+	singletonEvaluatorSourceCodeService EvaluatorSourceService
+)
+
+func NewEvaluatorSourceCodeServiceImpl(
+	runtimes []coderuntime.ICodeRuntime,
+	metric metrics.EvaluatorExecMetrics,
+	configer conf.IConfiger,
+) EvaluatorSourceService {
+	evaluatorSourceCodeServiceOnce.Do(func() {
+		singletonEvaluatorSourceCodeService = &EvaluatorSourceCodeServiceImpl{
+			runtimes: gslice.ToMap(runtimes, func(t coderuntime.ICodeRuntime) (entity.LanguageType, coderuntime.ICodeRuntime) {
+				return t.LanguageType(), t
+			}),
+			metric:   metric,
+			configer: configer,
+		}
+	})
+	return singletonEvaluatorSourceCodeService
+}
+
+type EvaluatorSourceCodeServiceImpl struct {
+	runtimes map[entity.LanguageType]coderuntime.ICodeRuntime
+	metric   metrics.EvaluatorExecMetrics
+	configer conf.IConfiger
+}
+
+func (c *EvaluatorSourceCodeServiceImpl) EvaluatorType() entity.EvaluatorType {
+	return entity.EvaluatorTypeCode
+}
+
+func (c *EvaluatorSourceCodeServiceImpl) Run(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData) (output *entity.EvaluatorOutputData, runStatus entity.EvaluatorRunStatus, traceID string) {
+	var err error
+	startTime := time.Now()
+	rootSpan, ctx := newEvaluatorSpan(ctx, evaluator.Name, "LoopEvaluation", strconv.FormatInt(evaluator.SpaceID, 10), false)
+	traceID = rootSpan.GetTraceID()
+	defer func() {
+		if output == nil {
+			output = &entity.EvaluatorOutputData{
+				EvaluatorRunError: &entity.EvaluatorRunError{},
+			}
+		}
+		var errInfo error
+		if err != nil {
+			if output.EvaluatorRunError == nil {
+				output.EvaluatorRunError = &entity.EvaluatorRunError{}
+			}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				output.EvaluatorRunError.Code = statusErr.Code()
+				output.EvaluatorRunError.Message = statusErr.Error()
+				errInfo = statusErr
+			} else {
+				output.EvaluatorRunError.Code = errno.RunEvaluatorFailCode
+				output.EvaluatorRunError.Message = err.Error()
+				errInfo = err
+			}
+		}
+		output.TimeConsumingMS = time.Since(startTime).Milliseconds()
+		rootSpan.reportRootSpan(ctx, &ReportRootSpanRequest{
+			input:            input,
+			output:           output,
+			runStatus:        runStatus,
+			evaluatorVersion: evaluator.GetEvaluatorVersion(),
+			errInfo:          errInfo,
+		})
+	}()
+
+	if evaluator.CodeEvaluatorVersion == nil {
+		err = errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	err = evaluator.CodeEvaluatorVersion.ValidateBaseInfo()
+	if err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateBaseInfo fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	// 校验输入数据
+	err = evaluator.CodeEvaluatorVersion.ValidateInput(input)
+	if err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateInput fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	defer func() {
+		c.metric.EmitRun(evaluator.SpaceID, err, startTime, "")
+	}()
+
+	runResult, err := c.execute(ctx, evaluator.CodeEvaluatorVersion, input)
+	if err != nil {
+		logs.CtxWarn(ctx, "[RunEvaluator] execute code fail, evaluator_version_id: %v, err: %v", evaluator.CodeEvaluatorVersion.ID, err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	output, err = parseCodeOutput(runResult.Output)
+	if err != nil {
+		logs.CtxWarn(ctx, "[RunEvaluator] parseCodeOutput fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	return output, entity.EvaluatorRunStatusSuccess, traceID
+}
+
+func (c *EvaluatorSourceCodeServiceImpl) execute(ctx context.Context, evaluatorVersion *entity.CodeEvaluatorVersion, input *entity.EvaluatorInputData) (result *entity.CodeRunResult, err error) {
+	codeSpan, ctx := newEvaluatorSpan(ctx, "ExecuteCode", "code", strconv.FormatInt(evaluatorVersion.SpaceID, 10), true)
+	param := &entity.CodeRunParam{
+		LanguageType: evaluatorVersion.LanguageType,
+		Code:         evaluatorVersion.CodeContent,
+		Input:        buildCodeInput(input),
+	}
+	defer func() {
+		codeSpan.reportCodeSpan(ctx, param, result, err)
+	}()
+
+	runtime, ok := c.runtimes[evaluatorVersion.LanguageType]
+	if !ok {
+		return nil, errorx.NewByCode(errno.CodeLanguageNotSupportedCode, errorx.WithExtraMsg(fmt.Sprintf("language type %v", evaluatorVersion.LanguageType)))
+	}
+	runtimeConf := c.configer.GetCodeEvaluatorRuntimeConf(ctx)
+	param.Timeout = runtimeConf.GetTimeout()
+	param.MaxCallStackSize = runtimeConf.GetMaxCallStackSize()
+	param.MaxOutputBytes = runtimeConf.GetMaxOutputBytes()
+	param.MaxMemoryBytes = runtimeConf.GetMaxMemoryBytes()
+
+	return runtime.Run(ctx, param)
+}
+
+func (e *evaluatorSpan) reportCodeSpan(ctx context.Context, param *entity.CodeRunParam, result *entity.CodeRunResult, errInfo error) {
+	e.SetInput(ctx, tracer.Convert2TraceString(param.Input))
+	tags := map[string]interface{}{
+		"language_type": param.LanguageType,
+		"code":          param.Code,
+	}
+	if result != nil {
+		e.SetOutput(ctx, tracer.Convert2TraceString(result.Output))
+		if len(result.Logs) > 0 {
+			tags["logs"] = tracer.Convert2TraceString(result.Logs)
+		}
+	}
+	if errInfo != nil {
+		e.SetStatusCode(ctx, int(entity.EvaluatorRunStatusFail))
+		e.SetError(ctx, errInfo)
+	} else {
+		e.SetStatusCode(ctx, 0)
+	}
+	e.SetCallType("Evaluator")
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	if userIDInContext != "" {
+		e.SetUserID(ctx, userIDInContext)
+	}
+	e.SetTags(ctx, tags)
+	e.Finish(ctx)
+}
+
+// buildCodeInput 将评估器输入转换为入口函数参数，文本字段直接传字符串，其余类型传结构化对象
+func buildCodeInput(input *entity.EvaluatorInputData) map[string]any {
+	res := make(map[string]any)
+	if input == nil {
+		return res
+	}
+	for key, content := range input.InputFields {
+		if content == nil {
+			res[key] = nil
+			continue
+		}
+		if gptr.Indirect(content.ContentType) == entity.ContentTypeText {
+			res[key] = gptr.Indirect(content.Text)
+			continue
+		}
+		var value map[string]any
+		if bytes, err := json.Marshal(content); err == nil && json.Unmarshal(bytes, &value) == nil {
+			res[key] = value
+		}
+	}
+	return res
+}
+
+// parseCodeOutput 解析入口函数返回值，支持 number、boolean 以及 {score, reason} 对象
+func parseCodeOutput(ret any) (*entity.EvaluatorOutputData, error) {
+	output := &entity.EvaluatorOutputData{
+		EvaluatorResult: &entity.EvaluatorResult{},
+		EvaluatorUsage:  &entity.EvaluatorUsage{},
+	}
+	if m, ok := ret.(map[string]any); ok {
+		score, ok := toScore(m["score"])
+		if !ok {
+			return nil, errorx.NewByCode(errno.CodeExecuteFailCode, errorx.WithExtraMsg(fmt.Sprintf("score must be number or boolean, got %v", m["score"])))
+		}
+		output.EvaluatorResult.Score = gptr.Of(score)
+		if reason, ok := m["reason"]; ok && reason != nil {
+			if s, ok := reason.(string); ok {
+				output.EvaluatorResult.Reasoning = s
+			} else {
+				output.EvaluatorResult.Reasoning = fmt.Sprintf("%v", reason)
+			}
+		}
+		return output, nil
+	}
+	score, ok := toScore(ret)
+	if !ok {
+		return nil, errorx.NewByCode(errno.CodeExecuteFailCode, errorx.WithExtraMsg(fmt.Sprintf("unsupported return value: %v", ret)))
+	}
+	output.EvaluatorResult.Score = gptr.Of(score)
+	return output, nil
+}
+
+func toScore(v any) (float64, bool) {
+	switch s := v.(type) {
+	case float64:
+		return s, true
+	case int64:
+		return float64(s), true
+	case int:
+		return float64(s), true
+	case bool:
+		if s {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+func (c *EvaluatorSourceCodeServiceImpl) Debug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData) (output *entity.EvaluatorOutputData, err error) {
+	output, _, _ = c.Run(ctx, evaluator, input)
+	if output != nil && output.EvaluatorRunError != nil {
+		return nil, errorx.NewByCode(output.EvaluatorRunError.Code, errorx.WithExtraMsg(output.EvaluatorRunError.Message))
+	}
+	return output, nil
+}
+
+func (c *EvaluatorSourceCodeServiceImpl) PreHandle(ctx context.Context, evaluator *entity.Evaluator) error {
+	return nil
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/coderuntime"
+	coderuntimemocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/coderuntime/mocks"
+	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	configmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/conf/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func TestEvaluatorSourceCodeServiceImpl_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRuntime := coderuntimemocks.NewMockICodeRuntime(ctrl)
+	mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	mockConfiger := configmocks.NewMockIConfiger(ctrl)
+
+	svc := &EvaluatorSourceCodeServiceImpl{
+		runtimes: map[entity.LanguageType]coderuntime.ICodeRuntime{entity.LanguageTypeJS: mockRuntime},
+		metric:   mockMetric,
+		configer: mockConfiger,
+	}
+	assert.Equal(t, entity.EvaluatorTypeCode, svc.EvaluatorType())
+
+	newEvaluator := func(languageType entity.LanguageType, code string) *entity.Evaluator {
+		return &entity.Evaluator{
+			ID:            1,
+			SpaceID:       2,
+			Name:          "code evaluator",
+			EvaluatorType: entity.EvaluatorTypeCode,
+			CodeEvaluatorVersion: &entity.CodeEvaluatorVersion{
+				ID:           3,
+				EvaluatorID:  1,
+				SpaceID:      2,
+				LanguageType: languageType,
+				CodeContent:  code,
+			},
+		}
+	}
+	input := &entity.EvaluatorInputData{
+		InputFields: map[string]*entity.Content{
+			"output": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("hello")},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		evaluator  *entity.Evaluator
+		setup      func()
+		wantStatus entity.EvaluatorRunStatus
+		wantScore  *float64
+		wantReason string
+		wantCode   int32
+	}{
+		{
+			name:      "success with score and reason",
+			evaluator: newEvaluator(entity.LanguageTypeJS, "function exec_evaluation(input) {}"),
+			setup: func() {
+				mockConfiger.EXPECT().GetCodeEvaluatorRuntimeConf(gomock.Any()).Return(entity.DefaultCodeEvaluatorRuntimeConf())
+				mockRuntime.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, param *entity.CodeRunParam) (*entity.CodeRunResult, error) {
+					assert.Equal(t, "hello", param.Input["output"])
+					assert.Equal(t, entity.DefaultCodeEvaluatorRuntimeConf().GetTimeout(), param.Timeout)
+					return &entity.CodeRunResult{Output: map[string]any{"score": 0.5, "reason": "half"}}, nil
+				})
+				mockMetric.EXPECT().EmitRun(int64(2), nil, gomock.Any(), "")
+			},
+			wantStatus: entity.EvaluatorRunStatusSuccess,
+			wantScore:  gptr.Of(0.5),
+			wantReason: "half",
+		},
+		{
+			name:      "runtime error",
+			evaluator: newEvaluator(entity.LanguageTypeJS, "function exec_evaluation(input) {}"),
+			setup: func() {
+				mockConfiger.EXPECT().GetCodeEvaluatorRuntimeConf(gomock.Any()).Return(nil)
+				mockRuntime.EXPECT().Run(gomock.Any(), gomock.Any()).Return(nil, errorx.NewByCode(errno.CodeExecuteTimeoutCode))
+				mockMetric.EXPECT().EmitRun(int64(2), gomock.Any(), gomock.Any(), "")
+			},
+			wantStatus: entity.EvaluatorRunStatusFail,
+			wantCode:   errno.CodeExecuteTimeoutCode,
+		},
+		{
+			name:      "language without runtime",
+			evaluator: newEvaluator(entity.LanguageTypePython, "def exec_evaluation(input): pass"),
+			setup: func() {
+				mockMetric.EXPECT().EmitRun(int64(2), gomock.Any(), gomock.Any(), "")
+			},
+			wantStatus: entity.EvaluatorRunStatusFail,
+			wantCode:   errno.CodeLanguageNotSupportedCode,
+		},
+		{
+			name:       "empty code",
+			evaluator:  newEvaluator(entity.LanguageTypeJS, ""),
+			setup:      func() {},
+			wantStatus: entity.EvaluatorRunStatusFail,
+			wantCode:   errno.InvalidCodeContentCode,
+		},
+		{
+			name:       "missing version",
+			evaluator:  &entity.Evaluator{EvaluatorType: entity.EvaluatorTypeCode},
+			setup:      func() {},
+			wantStatus: entity.EvaluatorRunStatusFail,
+			wantCode:   errno.EvaluatorNotExistCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			output, status, _ := svc.Run(context.Background(), tt.evaluator, input)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.NotNil(t, output)
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, output.EvaluatorRunError.Code)
+				return
+			}
+			assert.Equal(t, tt.wantScore, output.EvaluatorResult.Score)
+			assert.Equal(t, tt.wantReason, output.EvaluatorResult.Reasoning)
+		})
+	}
+}
+
+func TestEvaluatorSourceCodeServiceImpl_Debug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRuntime := coderuntimemocks.NewMockICodeRuntime(ctrl)
+	mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	mockConfiger := configmocks.NewMockIConfiger(ctrl)
+	svc := &EvaluatorSourceCodeServiceImpl{
+		runtimes: map[entity.LanguageType]coderuntime.ICodeRuntime{entity.LanguageTypeJS: mockRuntime},
+		metric:   mockMetric,
+		configer: mockConfiger,
+	}
+	evaluator := &entity.Evaluator{
+		EvaluatorType: entity.EvaluatorTypeCode,
+		CodeEvaluatorVersion: &entity.CodeEvaluatorVersion{
+			LanguageType: entity.LanguageTypeJS,
+			CodeContent:  "function exec_evaluation(input) { return true }",
+		},
+	}
+
+	mockConfiger.EXPECT().GetCodeEvaluatorRuntimeConf(gomock.Any()).Return(nil).Times(2)
+	mockMetric.EXPECT().EmitRun(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+
+	mockRuntime.EXPECT().Run(gomock.Any(), gomock.Any()).Return(&entity.CodeRunResult{Output: true}, nil)
+	output, err := svc.Debug(context.Background(), evaluator, &entity.EvaluatorInputData{})
+	assert.NoError(t, err)
+	assert.Equal(t, gptr.Of(1.0), output.EvaluatorResult.Score)
+
+	mockRuntime.EXPECT().Run(gomock.Any(), gomock.Any()).Return(&entity.CodeRunResult{Output: "not a score"}, nil)
+	_, err = svc.Debug(context.Background(), evaluator, &entity.EvaluatorInputData{})
+	statusErr, ok := errorx.FromStatusError(err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(errno.CodeExecuteFailCode), statusErr.Code())
+
+	assert.NoError(t, svc.PreHandle(context.Background(), evaluator))
+}
+
+func TestParseCodeOutput(t *testing.T) {
+	tests := []struct {
+		name       string
+		ret        any
+		wantScore  *float64
+		wantReason string
+		wantErr    bool
+	}{
+		{name: "float", ret: 0.8, wantScore: gptr.Of(0.8)},
+		{name: "int64", ret: int64(2), wantScore: gptr.Of(2.0)},
+		{name: "bool false", ret: false, wantScore: gptr.Of(0.0)},
+		{name: "object", ret: map[string]any{"score": true, "reason": "ok"}, wantScore: gptr.Of(1.0), wantReason: "ok"},
+		{name: "object with non string reason", ret: map[string]any{"score": int64(1), "reason": int64(3)}, wantScore: gptr.Of(1.0), wantReason: "3"},
+		{name: "object without score", ret: map[string]any{"reason": "ok"}, wantErr: true},
+		{name: "string", ret: "1", wantErr: true},
+		{name: "nil", ret: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := parseCodeOutput(tt.ret)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantScore, output.EvaluatorResult.Score)
+			assert.Equal(t, tt.wantReason, output.EvaluatorResult.Reasoning)
+		})
+	}
+}
+
+func TestBuildCodeInput(t *testing.T) {
+	input := &entity.EvaluatorInputData{
+		InputFields: map[string]*entity.Content{
+			"text":  {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("hello")},
+			"image": {ContentType: gptr.Of(entity.ContentTypeImage), Image: &entity.Image{URL: gptr.Of("http://a/b.png")}},
+			"empty": nil,
+		},
+	}
+	res := buildCodeInput(input)
+	assert.Equal(t, "hello", res["text"])
+	assert.Nil(t, res["empty"])
+	image, ok := res["image"].(map[string]any)
+	assert.True(t, ok)
+	assert.Equal(t, "Image", image["content_type"])
+	assert.Empty(t, buildCodeInput(nil))
+}
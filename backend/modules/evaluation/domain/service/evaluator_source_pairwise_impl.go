@@ -0,0 +1,286 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/sonic"
+	"github.com/kaptinlin/jsonrepair"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// pairwisePromptSuffix 对比评估器固定的输出格式说明，追加在第一条消息之后
+const pairwisePromptSuffix = `
+
+请比较输出 A（output_a）与输出 B（output_b）的质量，仅返回如下 JSON，不要输出其他内容：
+{"preference": "A" | "B" | "tie", "reason": "判断理由"}`
+
+var (
+	evaluatorSourcePairwiseServiceOnce      = sync.Once{}
+	singletonEvaluatorSourcePairwiseService EvaluatorSourceService
+)
+
+func NewEvaluatorSourcePairwiseServiceImpl(
+	llmProvider rpc.ILLMProvider,
+	metric metrics.EvaluatorExecMetrics,
+) EvaluatorSourceService {
+	evaluatorSourcePairwiseServiceOnce.Do(func() {
+		singletonEvaluatorSourcePairwiseService = &EvaluatorSourcePairwiseServiceImpl{
+			judge:  &EvaluatorSourcePromptServiceImpl{llmProvider: llmProvider},
+			metric: metric,
+		}
+	})
+	return singletonEvaluatorSourcePairwiseService
+}
+
+// EvaluatorSourcePairwiseServiceImpl 对比评估器，复用 prompt 评估器的模板渲染与模型调用，从回复中解析偏好
+type EvaluatorSourcePairwiseServiceImpl struct {
+	judge  *EvaluatorSourcePromptServiceImpl
+	metric metrics.EvaluatorExecMetrics
+}
+
+// pairwiseJudgement 一次模型评判的结果
+type pairwiseJudgement struct {
+	preference entity.PairwisePreference
+	reason     string
+	usage      *entity.TokenUsage
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) EvaluatorType() entity.EvaluatorType {
+	return entity.EvaluatorTypePairwise
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) Run(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData) (output *entity.EvaluatorOutputData, runStatus entity.EvaluatorRunStatus, traceID string) {
+	var err error
+	startTime := time.Now()
+	rootSpan, ctx := newEvaluatorSpan(ctx, evaluator.Name, "LoopEvaluation", strconv.FormatInt(evaluator.SpaceID, 10), false)
+	traceID = rootSpan.GetTraceID()
+	defer func() {
+		if output == nil {
+			output = &entity.EvaluatorOutputData{
+				EvaluatorRunError: &entity.EvaluatorRunError{},
+			}
+		}
+		var errInfo error
+		if err != nil {
+			if output.EvaluatorRunError == nil {
+				output.EvaluatorRunError = &entity.EvaluatorRunError{}
+			}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				output.EvaluatorRunError.Code = statusErr.Code()
+				output.EvaluatorRunError.Message = statusErr.Error()
+				errInfo = statusErr
+			} else {
+				output.EvaluatorRunError.Code = errno.RunEvaluatorFailCode
+				output.EvaluatorRunError.Message = err.Error()
+				errInfo = err
+			}
+		}
+		output.TimeConsumingMS = time.Since(startTime).Milliseconds()
+		rootSpan.reportRootSpan(ctx, &ReportRootSpanRequest{
+			input:            input,
+			output:           output,
+			runStatus:        runStatus,
+			evaluatorVersion: evaluator.GetEvaluatorVersion(),
+			errInfo:          errInfo,
+		})
+	}()
+
+	version := evaluator.PairwiseEvaluatorVersion
+	if version == nil {
+		err = errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	err = version.ValidateBaseInfo()
+	if err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateBaseInfo fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	// 校验输入数据
+	err = version.ValidateInput(input)
+	if err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateInput fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	defer func() {
+		modelID := strconv.FormatInt(version.ModelConfig.ModelID, 10)
+		if version.ModelConfig.ModelID == 0 {
+			modelID = ptr.From(version.ModelConfig.ProviderModelID)
+		}
+		p.metric.EmitRun(evaluator.SpaceID, err, startTime, modelID)
+	}()
+
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	judgement, err := p.compare(ctx, version, input, userIDInContext)
+	if err != nil {
+		logs.CtxWarn(ctx, "[RunEvaluator] pairwise compare fail, evaluator_version_id: %v, err: %v", version.ID, err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	usage := &entity.EvaluatorUsage{}
+	addPairwiseUsage(usage, judgement.usage)
+	if version.SwapCheck {
+		// 交换位置后的结论需要换回原始视角再与正序结论比较
+		var swapped *pairwiseJudgement
+		swapped, err = p.compare(ctx, version, swapPairwiseInput(input), userIDInContext)
+		if err != nil {
+			logs.CtxWarn(ctx, "[RunEvaluator] pairwise swap compare fail, evaluator_version_id: %v, err: %v", version.ID, err)
+			return nil, entity.EvaluatorRunStatusFail, traceID
+		}
+		addPairwiseUsage(usage, swapped.usage)
+		judgement = mergeSwappedJudgement(judgement, swapped)
+	}
+
+	return &entity.EvaluatorOutputData{
+		EvaluatorResult: &entity.EvaluatorResult{
+			Score:      gptr.Of(judgement.preference.Score()),
+			Reasoning:  judgement.reason,
+			Preference: gptr.Of(judgement.preference),
+		},
+		EvaluatorUsage: usage,
+	}, entity.EvaluatorRunStatusSuccess, traceID
+}
+
+// compare 渲染模板并调用模型给出一次偏好判断
+func (p *EvaluatorSourcePairwiseServiceImpl) compare(ctx context.Context, version *entity.PairwiseEvaluatorVersion, input *entity.EvaluatorInputData, userIDInContext string) (*pairwiseJudgement, error) {
+	promptVersion := version.ToPromptEvaluatorVersion()
+	promptVersion.PromptSuffix = pairwisePromptSuffix
+	if err := renderTemplate(ctx, promptVersion, input); err != nil {
+		return nil, err
+	}
+	reply, err := p.judge.chat(ctx, promptVersion, userIDInContext)
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, errorx.NewByCode(errno.LLMOutputEmptyCode, errorx.WithExtraMsg(" resp is nil"))
+	}
+	preference, reason, err := parsePairwiseContent(gptr.Indirect(reply.Content))
+	if err != nil {
+		return nil, err
+	}
+	return &pairwiseJudgement{
+		preference: preference,
+		reason:     reason,
+		usage:      reply.TokenUsage,
+	}, nil
+}
+
+// mergeSwappedJudgement 合并正序与交换位置后的结论，两次不一致时判为平局
+func mergeSwappedJudgement(origin, swapped *pairwiseJudgement) *pairwiseJudgement {
+	swappedPreference := swapped.preference.Swap()
+	if origin.preference == swappedPreference {
+		return origin
+	}
+	return &pairwiseJudgement{
+		preference: entity.PairwisePreferenceTie,
+		reason: fmt.Sprintf("交换 A、B 位置后结论不一致，判为平局。正序结论: %s，%s；交换后结论: %s，%s",
+			pairwisePreferenceName(origin.preference), origin.reason, pairwisePreferenceName(swappedPreference), swapped.reason),
+	}
+}
+
+func swapPairwiseInput(input *entity.EvaluatorInputData) *entity.EvaluatorInputData {
+	fields := make(map[string]*entity.Content, len(input.InputFields))
+	for k, v := range input.InputFields {
+		fields[k] = v
+	}
+	fields[entity.PairwiseEvaluatorOutputAKey] = input.InputFields[entity.PairwiseEvaluatorOutputBKey]
+	fields[entity.PairwiseEvaluatorOutputBKey] = input.InputFields[entity.PairwiseEvaluatorOutputAKey]
+	return &entity.EvaluatorInputData{
+		HistoryMessages: input.HistoryMessages,
+		InputFields:     fields,
+	}
+}
+
+func addPairwiseUsage(usage *entity.EvaluatorUsage, tokenUsage *entity.TokenUsage) {
+	if tokenUsage == nil {
+		return
+	}
+	usage.InputTokens += tokenUsage.InputTokens
+	usage.OutputTokens += tokenUsage.OutputTokens
+}
+
+type pairwiseOutputMsgFormat struct {
+	Preference string `json:"preference"`
+	Reason     string `json:"reason"`
+}
+
+var pairwiseJSONRe = regexp.MustCompile(`\{[^{}]*"preference"[^{}]*}`)
+
+// parsePairwiseContent 从模型回复中解析偏好与理由，依次尝试整体解析、修复后解析和提取 JSON 片段
+func parsePairwiseContent(content string) (entity.PairwisePreference, string, error) {
+	candidates := []string{content}
+	if repaired, err := jsonrepair.JSONRepair(content); err == nil {
+		candidates = append(candidates, repaired)
+	}
+	for _, fragment := range pairwiseJSONRe.FindAllString(content, -1) {
+		candidates = append(candidates, fragment)
+		if repaired, err := jsonrepair.JSONRepair(fragment); err == nil {
+			candidates = append(candidates, repaired)
+		}
+	}
+	for _, candidate := range candidates {
+		var outputMsg pairwiseOutputMsgFormat
+		if err := sonic.UnmarshalString(candidate, &outputMsg); err != nil {
+			continue
+		}
+		if preference, ok := parsePairwisePreference(outputMsg.Preference); ok {
+			return preference, outputMsg.Reason, nil
+		}
+	}
+	err := fmt.Errorf("[parsePairwiseContent] parse failed, content does not contain a valid preference: %s", content)
+	return 0, "", errorx.WrapByCode(err, errno.InvalidOutputFromModelCode)
+}
+
+func parsePairwisePreference(s string) (entity.PairwisePreference, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "a":
+		return entity.PairwisePreferenceA, true
+	case "b":
+		return entity.PairwisePreferenceB, true
+	case "tie", "draw", "equal":
+		return entity.PairwisePreferenceTie, true
+	default:
+		return 0, false
+	}
+}
+
+func pairwisePreferenceName(p entity.PairwisePreference) string {
+	switch p {
+	case entity.PairwisePreferenceA:
+		return "A"
+	case entity.PairwisePreferenceB:
+		return "B"
+	default:
+		return "tie"
+	}
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) Debug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData) (output *entity.EvaluatorOutputData, err error) {
+	output, _, _ = p.Run(ctx, evaluator, input)
+	if output != nil && output.EvaluatorRunError != nil {
+		return nil, errorx.NewByCode(output.EvaluatorRunError.Code, errorx.WithExtraMsg(output.EvaluatorRunError.Message))
+	}
+	return output, nil
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) PreHandle(ctx context.Context, evaluator *entity.Evaluator) error {
+	return nil
+}
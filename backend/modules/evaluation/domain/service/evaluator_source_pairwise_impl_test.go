@@ -0,0 +1,205 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+)
+
+func newPairwiseTestEvaluator(swapCheck bool) *entity.Evaluator {
+	return &entity.Evaluator{
+		ID:            200,
+		SpaceID:       1,
+		Name:          "pairwise",
+		EvaluatorType: entity.EvaluatorTypePairwise,
+		PairwiseEvaluatorVersion: &entity.PairwiseEvaluatorVersion{
+			ID:            200,
+			EvaluatorID:   200,
+			SpaceID:       1,
+			EvaluatorType: entity.EvaluatorTypePairwise,
+			ModelConfig:   &entity.ModelConfig{ModelID: 1},
+			MessageList: []*entity.Message{
+				{
+					Role: entity.RoleSystem,
+					Content: &entity.Content{
+						ContentType: gptr.Of(entity.ContentTypeText),
+						Text:        gptr.Of("A: {{output_a}}\nB: {{output_b}}"),
+					},
+				},
+			},
+			InputSchemas: entity.EnsurePairwiseInputSchemas(nil),
+			SwapCheck:    swapCheck,
+		},
+	}
+}
+
+func newPairwiseTestInput() *entity.EvaluatorInputData {
+	return &entity.EvaluatorInputData{
+		InputFields: map[string]*entity.Content{
+			entity.PairwiseEvaluatorOutputAKey: {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("baseline")},
+			entity.PairwiseEvaluatorOutputBKey: {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("candidate")},
+		},
+	}
+}
+
+func pairwiseReply(content string) *entity.ReplyItem {
+	return &entity.ReplyItem{
+		Content:    gptr.Of(content),
+		TokenUsage: &entity.TokenUsage{InputTokens: 10, OutputTokens: 5},
+	}
+}
+
+func TestEvaluatorSourcePairwiseServiceImpl_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLLMProvider := rpcmocks.NewMockILLMProvider(ctrl)
+	mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	svc := &EvaluatorSourcePairwiseServiceImpl{
+		judge:  &EvaluatorSourcePromptServiceImpl{llmProvider: mockLLMProvider},
+		metric: mockMetric,
+	}
+	ctx := context.Background()
+
+	t.Run("当前实验胜出", func(t *testing.T) {
+		var rendered string
+		mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.LLMCallParam) (*entity.ReplyItem, error) {
+				rendered = gptr.Indirect(param.Messages[0].Content.Text)
+				return pairwiseReply(`{"preference": "B", "reason": "B 更准确"}`), nil
+			})
+		mockMetric.EXPECT().EmitRun(int64(1), nil, gomock.Any(), gomock.Any())
+
+		evaluator := newPairwiseTestEvaluator(false)
+		output, status, _ := svc.Run(ctx, evaluator, newPairwiseTestInput())
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, entity.PairwiseScoreWin, gptr.Indirect(output.EvaluatorResult.Score))
+		assert.Equal(t, entity.PairwisePreferenceB, gptr.Indirect(output.EvaluatorResult.Preference))
+		assert.Equal(t, "B 更准确", output.EvaluatorResult.Reasoning)
+		assert.Equal(t, int64(10), output.EvaluatorUsage.InputTokens)
+		assert.True(t, strings.HasPrefix(rendered, "A: baseline\nB: candidate"))
+		assert.Contains(t, rendered, `"preference"`)
+		// 渲染不应修改评估器版本上的模板
+		assert.Equal(t, "A: {{output_a}}\nB: {{output_b}}", gptr.Indirect(evaluator.PairwiseEvaluatorVersion.MessageList[0].Content.Text))
+	})
+
+	t.Run("交换位置后结论一致", func(t *testing.T) {
+		gomock.InOrder(
+			mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(pairwiseReply(`{"preference": "A", "reason": "A 更好"}`), nil),
+			mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, param *entity.LLMCallParam) (*entity.ReplyItem, error) {
+					assert.True(t, strings.HasPrefix(gptr.Indirect(param.Messages[0].Content.Text), "A: candidate\nB: baseline"))
+					return pairwiseReply(`{"preference": "B", "reason": "B 更好"}`), nil
+				}),
+		)
+		mockMetric.EXPECT().EmitRun(int64(1), nil, gomock.Any(), gomock.Any())
+
+		output, status, _ := svc.Run(ctx, newPairwiseTestEvaluator(true), newPairwiseTestInput())
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, entity.PairwisePreferenceA, gptr.Indirect(output.EvaluatorResult.Preference))
+		assert.Equal(t, entity.PairwiseScoreLoss, gptr.Indirect(output.EvaluatorResult.Score))
+		assert.Equal(t, int64(20), output.EvaluatorUsage.InputTokens)
+		assert.Equal(t, int64(10), output.EvaluatorUsage.OutputTokens)
+	})
+
+	t.Run("交换位置后结论不一致判为平局", func(t *testing.T) {
+		mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(pairwiseReply(`{"preference": "B", "reason": "偏好后者"}`), nil).Times(2)
+		mockMetric.EXPECT().EmitRun(int64(1), nil, gomock.Any(), gomock.Any())
+
+		output, status, _ := svc.Run(ctx, newPairwiseTestEvaluator(true), newPairwiseTestInput())
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, entity.PairwisePreferenceTie, gptr.Indirect(output.EvaluatorResult.Preference))
+		assert.Equal(t, entity.PairwiseScoreTie, gptr.Indirect(output.EvaluatorResult.Score))
+	})
+
+	t.Run("缺少基准输出", func(t *testing.T) {
+		input := newPairwiseTestInput()
+		delete(input.InputFields, entity.PairwiseEvaluatorOutputAKey)
+		output, status, _ := svc.Run(ctx, newPairwiseTestEvaluator(false), input)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.Equal(t, int32(errno.PairwiseOutputMissingCode), output.EvaluatorRunError.Code)
+	})
+
+	t.Run("模型调用失败", func(t *testing.T) {
+		llmErr := errors.New("llm call failed")
+		mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(nil, llmErr)
+		mockMetric.EXPECT().EmitRun(int64(1), llmErr, gomock.Any(), gomock.Any())
+
+		output, status, _ := svc.Run(ctx, newPairwiseTestEvaluator(false), newPairwiseTestInput())
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.Contains(t, output.EvaluatorRunError.Message, "llm call failed")
+	})
+
+	t.Run("模型输出无法解析", func(t *testing.T) {
+		mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(pairwiseReply("两者差不多"), nil)
+		mockMetric.EXPECT().EmitRun(int64(1), gomock.Any(), gomock.Any(), gomock.Any())
+
+		output, status, _ := svc.Run(ctx, newPairwiseTestEvaluator(false), newPairwiseTestInput())
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.Equal(t, int32(errno.InvalidOutputFromModelCode), output.EvaluatorRunError.Code)
+	})
+}
+
+func TestEvaluatorSourcePairwiseServiceImpl_Debug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLLMProvider := rpcmocks.NewMockILLMProvider(ctrl)
+	mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	svc := &EvaluatorSourcePairwiseServiceImpl{
+		judge:  &EvaluatorSourcePromptServiceImpl{llmProvider: mockLLMProvider},
+		metric: mockMetric,
+	}
+
+	mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(pairwiseReply(`{"preference": "tie", "reason": "相当"}`), nil)
+	mockMetric.EXPECT().EmitRun(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+	output, err := svc.Debug(context.Background(), newPairwiseTestEvaluator(false), newPairwiseTestInput())
+	assert.NoError(t, err)
+	assert.Equal(t, entity.PairwisePreferenceTie, gptr.Indirect(output.EvaluatorResult.Preference))
+
+	_, err = svc.Debug(context.Background(), newPairwiseTestEvaluator(false), &entity.EvaluatorInputData{})
+	assert.Error(t, err)
+}
+
+func Test_parsePairwiseContent(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		want       entity.PairwisePreference
+		wantReason string
+		wantErr    bool
+	}{
+		{name: "标准 JSON", content: `{"preference": "A", "reason": "r"}`, want: entity.PairwisePreferenceA, wantReason: "r"},
+		{name: "大小写不敏感", content: `{"preference": "b", "reason": "r"}`, want: entity.PairwisePreferenceB, wantReason: "r"},
+		{name: "平局同义词", content: `{"preference": "Equal"}`, want: entity.PairwisePreferenceTie},
+		{name: "包裹在代码块中", content: "结论如下：\n```json\n{\"preference\": \"B\", \"reason\": \"r\"}\n```", want: entity.PairwisePreferenceB, wantReason: "r"},
+		{name: "需修复的 JSON", content: `{'preference': 'A', 'reason': 'r',}`, want: entity.PairwisePreferenceA, wantReason: "r"},
+		{name: "未知偏好", content: `{"preference": "C"}`, wantErr: true},
+		{name: "非 JSON", content: "A 更好", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason, err := parsePairwiseContent(tt.content)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
@@ -212,7 +212,7 @@ type ReportRootSpanRequest struct {
 	input            *entity.EvaluatorInputData
 	output           *entity.EvaluatorOutputData
 	runStatus        entity.EvaluatorRunStatus
-	evaluatorVersion *entity.PromptEvaluatorVersion
+	evaluatorVersion entity.IEvaluatorVersion
 	errInfo          error
 }
 
@@ -231,8 +231,10 @@ func (e *evaluatorSpan) reportRootSpan(ctx context.Context, reportRootSpanReques
 		e.SetStatusCode(ctx, 0) // 默认为成功
 	}
 	tags := make(map[string]interface{}, 0)
-	tags["evaluator_id"] = reportRootSpanRequest.evaluatorVersion.EvaluatorID
-	tags["evaluator_version"] = reportRootSpanRequest.evaluatorVersion.Version
+	if reportRootSpanRequest.evaluatorVersion != nil {
+		tags["evaluator_id"] = reportRootSpanRequest.evaluatorVersion.GetEvaluatorID()
+		tags["evaluator_version"] = reportRootSpanRequest.evaluatorVersion.GetVersion()
+	}
 	e.SetCallType("Evaluator")
 	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
 	if userIDInContext != "" {
@@ -0,0 +1,27 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+)
+
+// checkExptBudget 合并实验自身预算与空间级预算上限，返回超出预算的原因，未超出时返回空字符串
+func checkExptBudget(ctx context.Context, configer component.IConfiger, statsRepo repo.IExptStatsRepo, expt *entity.Experiment) (string, error) {
+	budget := expt.EvalConf.GetBudget().Merge(configer.GetExptExecConf(ctx, expt.SpaceID).GetExptBudget())
+	if budget.IsEmpty() {
+		return "", nil
+	}
+
+	stats, err := statsRepo.Get(ctx, expt.ID, expt.SpaceID)
+	if err != nil {
+		return "", err
+	}
+
+	return budget.Exceeded(stats), nil
+}
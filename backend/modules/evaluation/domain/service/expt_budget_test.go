@@ -0,0 +1,86 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	configmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	mock_repo "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func Test_checkExptBudget(t *testing.T) {
+	tests := []struct {
+		name       string
+		expt       *entity.Experiment
+		spaceConf  *entity.ExptExecConf
+		stats      *entity.ExptStats
+		statsErr   error
+		wantGet    bool
+		wantReason string
+		wantErr    bool
+	}{
+		{
+			name:      "未配置预算",
+			expt:      &entity.Experiment{ID: 1, SpaceID: 2, EvalConf: &entity.EvaluationConfiguration{}},
+			spaceConf: &entity.ExptExecConf{},
+		},
+		{
+			name:      "实验预算未超出",
+			expt:      &entity.Experiment{ID: 1, SpaceID: 2, EvalConf: &entity.EvaluationConfiguration{Budget: &entity.ExptBudget{MaxTokens: ptr.Of(int64(100))}}},
+			spaceConf: &entity.ExptExecConf{},
+			stats:     &entity.ExptStats{InputTokenCost: 10, OutputTokenCost: 20},
+			wantGet:   true,
+		},
+		{
+			name:       "空间预算更严格",
+			expt:       &entity.Experiment{ID: 1, SpaceID: 2, EvalConf: &entity.EvaluationConfiguration{Budget: &entity.ExptBudget{MaxTokens: ptr.Of(int64(100))}}},
+			spaceConf:  &entity.ExptExecConf{ExptBudget: &entity.ExptBudget{MaxTokens: ptr.Of(int64(20))}},
+			stats:      &entity.ExptStats{InputTokenCost: 10, OutputTokenCost: 20},
+			wantGet:    true,
+			wantReason: "token usage 30 reached budget 20",
+		},
+		{
+			name:      "查询统计失败",
+			expt:      &entity.Experiment{ID: 1, SpaceID: 2},
+			spaceConf: &entity.ExptExecConf{ExptBudget: &entity.ExptBudget{MaxTokens: ptr.Of(int64(1))}},
+			statsErr:  errors.New("db error"),
+			wantGet:   true,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			configer := configmocks.NewMockIConfiger(ctrl)
+			configer.EXPECT().GetExptExecConf(gomock.Any(), tt.expt.SpaceID).Return(tt.spaceConf)
+			statsRepo := mock_repo.NewMockIExptStatsRepo(ctrl)
+			if tt.wantGet {
+				statsRepo.EXPECT().Get(gomock.Any(), tt.expt.ID, tt.expt.SpaceID).Return(tt.stats, tt.statsErr)
+			}
+
+			reason, err := checkExptBudget(context.Background(), configer, statsRepo, tt.expt)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.wantReason == "" {
+				assert.Empty(t, reason)
+			} else {
+				assert.Contains(t, reason, tt.wantReason)
+			}
+		})
+	}
+}
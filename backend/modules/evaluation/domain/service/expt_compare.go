@@ -0,0 +1,16 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate  mockgen -destination  ./mocks/expt_compare.go  --package mocks . IExptCompareService
+type IExptCompareService interface {
+	// CompareExperiments 将两个实验在同一评测集版本上的评估器得分逐条配对，计算配对 t 检验、bootstrap 置信区间与退化数据
+	CompareExperiments(ctx context.Context, param *entity.ExptCompareParam) ([]*entity.EvaluatorCompareResult, error)
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+	"gonum.org/v1/gonum/mathext"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+type ExptCompareServiceImpl struct {
+	exptRepo               repo.IExperimentRepo
+	exptTurnResultRepo     repo.IExptTurnResultRepo
+	evaluatorRecordService EvaluatorRecordService
+}
+
+func NewExptCompareService(
+	exptRepo repo.IExperimentRepo,
+	exptTurnResultRepo repo.IExptTurnResultRepo,
+	evaluatorRecordService EvaluatorRecordService,
+) IExptCompareService {
+	return &ExptCompareServiceImpl{
+		exptRepo:               exptRepo,
+		exptTurnResultRepo:     exptTurnResultRepo,
+		evaluatorRecordService: evaluatorRecordService,
+	}
+}
+
+// itemTurnKey 配对两个实验得分的键
+type itemTurnKey struct {
+	ItemID int64
+	TurnID int64
+}
+
+func (e *ExptCompareServiceImpl) CompareExperiments(ctx context.Context, param *entity.ExptCompareParam) ([]*entity.EvaluatorCompareResult, error) {
+	if param.ExptID == param.BaselineExptID {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("cannot compare an experiment with itself"))
+	}
+	param.Normalize()
+
+	expts, err := e.exptRepo.MGetByID(ctx, []int64{param.ExptID, param.BaselineExptID}, param.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	exptMap := gslice.ToMap(expts, func(t *entity.Experiment) (int64, *entity.Experiment) { return t.ID, t })
+	expt, baseline := exptMap[param.ExptID], exptMap[param.BaselineExptID]
+	if expt == nil || baseline == nil || expt.SpaceID != param.SpaceID || baseline.SpaceID != param.SpaceID {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg("experiment not found"))
+	}
+	if expt.EvalSetVersionID != baseline.EvalSetVersionID {
+		return nil, errorx.NewByCode(errno.ExperimentCompareMismatchCode,
+			errorx.WithExtraMsg(fmt.Sprintf("eval set version %d != %d", expt.EvalSetVersionID, baseline.EvalSetVersionID)))
+	}
+
+	scores, err := e.loadScores(ctx, param.SpaceID, param.ExptID, param.EvaluatorVersionIDs)
+	if err != nil {
+		return nil, err
+	}
+	baselineScores, err := e.loadScores(ctx, param.SpaceID, param.BaselineExptID, param.EvaluatorVersionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	versionIDs := make([]int64, 0, len(scores))
+	for versionID := range scores {
+		if _, ok := baselineScores[versionID]; ok {
+			versionIDs = append(versionIDs, versionID)
+		}
+	}
+	sort.Slice(versionIDs, func(i, j int) bool { return versionIDs[i] < versionIDs[j] })
+
+	// 固定随机种子，同一对实验多次对比的 bootstrap 结果一致
+	rng := rand.New(rand.NewSource(param.ExptID ^ param.BaselineExptID))
+	results := make([]*entity.EvaluatorCompareResult, 0, len(versionIDs))
+	for _, versionID := range versionIDs {
+		results = append(results, compareEvaluatorScores(versionID, baselineScores[versionID], scores[versionID], param, rng))
+	}
+	return results, nil
+}
+
+// loadScores 读取实验的评估器得分，人工修正过的以修正分为准
+func (e *ExptCompareServiceImpl) loadScores(ctx context.Context, spaceID, exptID int64, evaluatorVersionIDs []int64) (map[int64]map[itemTurnKey]float64, error) {
+	refs, err := e.exptTurnResultRepo.GetTurnEvaluatorResultRefByExptID(ctx, spaceID, exptID)
+	if err != nil {
+		return nil, err
+	}
+	versionFilter := gslice.ToMap(evaluatorVersionIDs, func(t int64) (int64, bool) { return t, true })
+	recordIDs := make([]int64, 0, len(refs))
+	for _, ref := range refs {
+		if len(versionFilter) > 0 && !versionFilter[ref.EvaluatorVersionID] {
+			continue
+		}
+		recordIDs = append(recordIDs, ref.EvaluatorResultID)
+	}
+
+	scores := make(map[int64]map[itemTurnKey]float64)
+	if len(recordIDs) == 0 {
+		return scores, nil
+	}
+	records, err := e.evaluatorRecordService.BatchGetEvaluatorRecord(ctx, recordIDs, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		if record == nil || record.Status != entity.EvaluatorRunStatusSuccess ||
+			record.EvaluatorOutputData == nil || record.EvaluatorOutputData.EvaluatorResult == nil {
+			continue
+		}
+		result := record.EvaluatorOutputData.EvaluatorResult
+		score := result.Score
+		if result.Correction != nil && result.Correction.Score != nil {
+			score = result.Correction.Score
+		}
+		if score == nil {
+			continue
+		}
+		if scores[record.EvaluatorVersionID] == nil {
+			scores[record.EvaluatorVersionID] = make(map[itemTurnKey]float64)
+		}
+		scores[record.EvaluatorVersionID][itemTurnKey{ItemID: record.ItemID, TurnID: record.TurnID}] = gptr.Indirect(score)
+	}
+	return scores, nil
+}
+
+func compareEvaluatorScores(versionID int64, baselineScores, scores map[itemTurnKey]float64, param *entity.ExptCompareParam, rng *rand.Rand) *entity.EvaluatorCompareResult {
+	keys := make([]itemTurnKey, 0, len(scores))
+	for key := range scores {
+		if _, ok := baselineScores[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ItemID != keys[j].ItemID {
+			return keys[i].ItemID < keys[j].ItemID
+		}
+		return keys[i].TurnID < keys[j].TurnID
+	})
+
+	res := &entity.EvaluatorCompareResult{
+		EvaluatorVersionID: versionID,
+		PairedCount:        len(keys),
+		Regressions:        make([]*entity.ItemScoreDiff, 0),
+	}
+	if len(keys) == 0 {
+		return res
+	}
+
+	diffs := make([]float64, 0, len(keys))
+	var baselineSum, sum float64
+	for _, key := range keys {
+		baselineScore, score := baselineScores[key], scores[key]
+		diff := score - baselineScore
+		baselineSum += baselineScore
+		sum += score
+		diffs = append(diffs, diff)
+		if diff < -param.RegressionThreshold {
+			res.Regressions = append(res.Regressions, &entity.ItemScoreDiff{
+				ItemID:        key.ItemID,
+				TurnID:        key.TurnID,
+				BaselineScore: baselineScore,
+				Score:         score,
+				Diff:          diff,
+			})
+		}
+	}
+	// 下降幅度大的排在前面
+	sort.SliceStable(res.Regressions, func(i, j int) bool { return res.Regressions[i].Diff < res.Regressions[j].Diff })
+
+	n := float64(len(keys))
+	res.BaselineMean = baselineSum / n
+	res.Mean = sum / n
+	res.MeanDiff = res.Mean - res.BaselineMean
+	res.TTest = pairedTTest(diffs, param.ConfidenceLevel)
+	res.BootstrapConfidenceInterval = bootstrapMeanCI(diffs, param.ConfidenceLevel, param.BootstrapIterations, rng)
+	res.Significant = res.TTest != nil && res.TTest.PValue < 1-param.ConfidenceLevel
+	return res
+}
+
+// pairedTTest 对得分差做双侧单样本 t 检验，样本少于 2 条时返回 nil
+func pairedTTest(diffs []float64, confidenceLevel float64) *entity.PairedTTestResult {
+	n := len(diffs)
+	if n < 2 {
+		return nil
+	}
+	mean, sd := meanAndStdDev(diffs)
+	df := n - 1
+	res := &entity.PairedTTestResult{DegreesOfFreedom: df}
+	if sd == 0 {
+		// 所有得分差相同，均值差为 0 时无差异，否则差异确定存在
+		res.PValue = 1
+		if mean != 0 {
+			res.PValue = 0
+		}
+		res.ConfidenceInterval = &entity.ConfidenceInterval{Lower: mean, Upper: mean, ConfidenceLevel: confidenceLevel}
+		return res
+	}
+
+	se := sd / math.Sqrt(float64(n))
+	t := mean / se
+	res.TStatistic = gptr.Of(t)
+	res.PValue = studentTTwoTailedP(t, float64(df))
+	margin := studentTQuantile(1-confidenceLevel, float64(df)) * se
+	res.ConfidenceInterval = &entity.ConfidenceInterval{Lower: mean - margin, Upper: mean + margin, ConfidenceLevel: confidenceLevel}
+	return res
+}
+
+// bootstrapMeanCI 有放回重采样得分差，取均值分布的百分位区间
+func bootstrapMeanCI(diffs []float64, confidenceLevel float64, iterations int, rng *rand.Rand) *entity.ConfidenceInterval {
+	n := len(diffs)
+	if n == 0 || iterations <= 0 {
+		return nil
+	}
+	means := make([]float64, iterations)
+	for i := range means {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += diffs[rng.Intn(n)]
+		}
+		means[i] = sum / float64(n)
+	}
+	sort.Float64s(means)
+	alpha := 1 - confidenceLevel
+	return &entity.ConfidenceInterval{
+		Lower:           percentile(means, alpha/2),
+		Upper:           percentile(means, 1-alpha/2),
+		ConfidenceLevel: confidenceLevel,
+	}
+}
+
+// percentile 已排序数据的线性插值分位数
+func percentile(sorted []float64, q float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	if lower == upper {
+		return sorted[lower]
+	}
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
+
+func meanAndStdDev(values []float64) (mean, sd float64) {
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	var ss float64
+	for _, v := range values {
+		ss += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(ss / float64(len(values)-1))
+}
+
+// studentTTwoTailedP t 分布的双侧 p 值
+func studentTTwoTailedP(t, df float64) float64 {
+	return mathext.RegIncBeta(df/2, 0.5, df/(df+t*t))
+}
+
+// studentTQuantile 双侧显著性水平 alpha 对应的 t 分布临界值
+func studentTQuantile(alpha, df float64) float64 {
+	x := mathext.InvRegIncBeta(df/2, 0.5, alpha)
+	return math.Sqrt(df * (1 - x) / x)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func newCompareRecord(id, versionID, itemID int64, score float64) *entity.EvaluatorRecord {
+	return &entity.EvaluatorRecord{
+		ID:                 id,
+		EvaluatorVersionID: versionID,
+		ItemID:             itemID,
+		TurnID:             1,
+		Status:             entity.EvaluatorRunStatusSuccess,
+		EvaluatorOutputData: &entity.EvaluatorOutputData{
+			EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(score)},
+		},
+	}
+}
+
+func TestExptCompareServiceImpl_CompareExperiments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExptRepo := repomocks.NewMockIExperimentRepo(ctrl)
+	mockTurnResultRepo := repomocks.NewMockIExptTurnResultRepo(ctrl)
+	mockRecordService := svcmocks.NewMockEvaluatorRecordService(ctrl)
+	svc := NewExptCompareService(mockExptRepo, mockTurnResultRepo, mockRecordService)
+	ctx := context.Background()
+
+	const spaceID, exptID, baselineID = int64(1), int64(10), int64(20)
+	expts := []*entity.Experiment{
+		{ID: exptID, SpaceID: spaceID, EvalSetVersionID: 100},
+		{ID: baselineID, SpaceID: spaceID, EvalSetVersionID: 100},
+	}
+
+	t.Run("配对得分并计算显著性", func(t *testing.T) {
+		mockExptRepo.EXPECT().MGetByID(gomock.Any(), []int64{exptID, baselineID}, spaceID).Return(expts, nil)
+		mockTurnResultRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, exptID).Return([]*entity.ExptTurnEvaluatorResultRef{
+			{EvaluatorVersionID: 7, EvaluatorResultID: 1},
+			{EvaluatorVersionID: 7, EvaluatorResultID: 2},
+			{EvaluatorVersionID: 7, EvaluatorResultID: 3},
+			{EvaluatorVersionID: 7, EvaluatorResultID: 4},
+			{EvaluatorVersionID: 7, EvaluatorResultID: 5},
+			{EvaluatorVersionID: 8, EvaluatorResultID: 6},
+		}, nil)
+		mockTurnResultRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, baselineID).Return([]*entity.ExptTurnEvaluatorResultRef{
+			{EvaluatorVersionID: 7, EvaluatorResultID: 11},
+			{EvaluatorVersionID: 7, EvaluatorResultID: 12},
+			{EvaluatorVersionID: 7, EvaluatorResultID: 13},
+			{EvaluatorVersionID: 7, EvaluatorResultID: 14},
+		}, nil)
+		corrected := newCompareRecord(4, 7, 104, 0.1)
+		corrected.EvaluatorOutputData.EvaluatorResult.Correction = &entity.Correction{Score: gptr.Of(0.9)}
+		failed := newCompareRecord(5, 7, 105, 0)
+		failed.Status = entity.EvaluatorRunStatusFail
+		mockRecordService.EXPECT().BatchGetEvaluatorRecord(gomock.Any(), []int64{1, 2, 3, 4, 5, 6}, false).Return([]*entity.EvaluatorRecord{
+			newCompareRecord(1, 7, 101, 0.6),
+			newCompareRecord(2, 7, 102, 0.7),
+			newCompareRecord(3, 7, 103, 0.8),
+			corrected,
+			failed,
+			newCompareRecord(6, 8, 101, 1),
+		}, nil)
+		mockRecordService.EXPECT().BatchGetEvaluatorRecord(gomock.Any(), []int64{11, 12, 13, 14}, false).Return([]*entity.EvaluatorRecord{
+			newCompareRecord(11, 7, 101, 0.5),
+			newCompareRecord(12, 7, 102, 0.5),
+			newCompareRecord(13, 7, 103, 0.5),
+			newCompareRecord(14, 7, 104, 0.5),
+		}, nil)
+
+		results, err := svc.CompareExperiments(ctx, &entity.ExptCompareParam{SpaceID: spaceID, ExptID: exptID, BaselineExptID: baselineID})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		res := results[0]
+		assert.Equal(t, int64(7), res.EvaluatorVersionID)
+		assert.Equal(t, 4, res.PairedCount)
+		assert.InDelta(t, 0.5, res.BaselineMean, 1e-9)
+		assert.InDelta(t, 0.75, res.Mean, 1e-9)
+		assert.InDelta(t, 0.25, res.MeanDiff, 1e-9)
+		require.NotNil(t, res.TTest)
+		assert.Equal(t, 3, res.TTest.DegreesOfFreedom)
+		assert.InDelta(t, 3.873, gptr.Indirect(res.TTest.TStatistic), 1e-3)
+		assert.InDelta(t, 0.0305, res.TTest.PValue, 1e-3)
+		assert.InDelta(t, 0.25-3.1824*0.0645497, res.TTest.ConfidenceInterval.Lower, 1e-3)
+		assert.True(t, res.Significant)
+		require.NotNil(t, res.BootstrapConfidenceInterval)
+		assert.GreaterOrEqual(t, res.BootstrapConfidenceInterval.Lower, 0.1)
+		assert.LessOrEqual(t, res.BootstrapConfidenceInterval.Upper, 0.4)
+		assert.Empty(t, res.Regressions)
+	})
+
+	t.Run("评测集版本不一致", func(t *testing.T) {
+		mockExptRepo.EXPECT().MGetByID(gomock.Any(), gomock.Any(), spaceID).Return([]*entity.Experiment{
+			{ID: exptID, SpaceID: spaceID, EvalSetVersionID: 100},
+			{ID: baselineID, SpaceID: spaceID, EvalSetVersionID: 101},
+		}, nil)
+		_, err := svc.CompareExperiments(ctx, &entity.ExptCompareParam{SpaceID: spaceID, ExptID: exptID, BaselineExptID: baselineID})
+		statusErr, ok := errorx.FromStatusError(err)
+		require.True(t, ok)
+		assert.Equal(t, int32(errno.ExperimentCompareMismatchCode), statusErr.Code())
+	})
+
+	t.Run("基准实验不存在", func(t *testing.T) {
+		mockExptRepo.EXPECT().MGetByID(gomock.Any(), gomock.Any(), spaceID).Return(expts[:1], nil)
+		_, err := svc.CompareExperiments(ctx, &entity.ExptCompareParam{SpaceID: spaceID, ExptID: exptID, BaselineExptID: baselineID})
+		assert.Error(t, err)
+	})
+
+	t.Run("与自身对比", func(t *testing.T) {
+		_, err := svc.CompareExperiments(ctx, &entity.ExptCompareParam{SpaceID: spaceID, ExptID: exptID, BaselineExptID: exptID})
+		assert.Error(t, err)
+	})
+
+	t.Run("读取评估结果失败", func(t *testing.T) {
+		mockExptRepo.EXPECT().MGetByID(gomock.Any(), gomock.Any(), spaceID).Return(expts, nil)
+		mockTurnResultRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, exptID).Return(nil, errors.New("db error"))
+		_, err := svc.CompareExperiments(ctx, &entity.ExptCompareParam{SpaceID: spaceID, ExptID: exptID, BaselineExptID: baselineID})
+		assert.Error(t, err)
+	})
+
+	t.Run("按评估器过滤", func(t *testing.T) {
+		mockExptRepo.EXPECT().MGetByID(gomock.Any(), gomock.Any(), spaceID).Return(expts, nil)
+		mockTurnResultRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, gomock.Any()).Return([]*entity.ExptTurnEvaluatorResultRef{
+			{EvaluatorVersionID: 8, EvaluatorResultID: 6},
+		}, nil).Times(2)
+		results, err := svc.CompareExperiments(ctx, &entity.ExptCompareParam{SpaceID: spaceID, ExptID: exptID, BaselineExptID: baselineID, EvaluatorVersionIDs: []int64{7}})
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
+
+func Test_compareEvaluatorScores(t *testing.T) {
+	param := &entity.ExptCompareParam{RegressionThreshold: 0.1}
+	param.Normalize()
+	baseline := map[itemTurnKey]float64{
+		{ItemID: 1, TurnID: 1}: 0.9,
+		{ItemID: 2, TurnID: 1}: 0.9,
+		{ItemID: 3, TurnID: 1}: 0.9,
+		{ItemID: 4, TurnID: 1}: 0.9,
+	}
+	current := map[itemTurnKey]float64{
+		{ItemID: 1, TurnID: 1}: 0.2,
+		{ItemID: 2, TurnID: 1}: 0.85,
+		{ItemID: 3, TurnID: 1}: 0.5,
+		{ItemID: 5, TurnID: 1}: 1,
+	}
+	res := compareEvaluatorScores(1, baseline, current, param, rand.New(rand.NewSource(1)))
+	assert.Equal(t, 3, res.PairedCount)
+	require.Len(t, res.Regressions, 2)
+	assert.Equal(t, int64(1), res.Regressions[0].ItemID)
+	assert.InDelta(t, -0.7, res.Regressions[0].Diff, 1e-9)
+	assert.Equal(t, int64(3), res.Regressions[1].ItemID)
+
+	// 单条配对数据无法做 t 检验
+	res = compareEvaluatorScores(1, baseline, map[itemTurnKey]float64{{ItemID: 1, TurnID: 1}: 1}, param, rand.New(rand.NewSource(1)))
+	assert.Nil(t, res.TTest)
+	assert.False(t, res.Significant)
+	assert.InDelta(t, 0.1, res.BootstrapConfidenceInterval.Lower, 1e-9)
+
+	// 无配对数据
+	res = compareEvaluatorScores(1, baseline, map[itemTurnKey]float64{}, param, rand.New(rand.NewSource(1)))
+	assert.Equal(t, 0, res.PairedCount)
+	assert.Nil(t, res.BootstrapConfidenceInterval)
+}
+
+func Test_pairedTTest(t *testing.T) {
+	// 得分差完全一致
+	res := pairedTTest([]float64{0.5, 0.5, 0.5}, 0.95)
+	assert.Nil(t, res.TStatistic)
+	assert.Equal(t, 0.0, res.PValue)
+	res = pairedTTest([]float64{0, 0}, 0.95)
+	assert.Equal(t, 1.0, res.PValue)
+
+	// 无显著差异
+	res = pairedTTest([]float64{0.1, -0.1, 0.2, -0.2}, 0.95)
+	assert.InDelta(t, 0.0, gptr.Indirect(res.TStatistic), 1e-9)
+	assert.InDelta(t, 1.0, res.PValue, 1e-9)
+	assert.Less(t, res.ConfidenceInterval.Lower, 0.0)
+	assert.Greater(t, res.ConfidenceInterval.Upper, 0.0)
+}
+
+func TestExptCompareParam_Normalize(t *testing.T) {
+	p := &entity.ExptCompareParam{ConfidenceLevel: 1.2, BootstrapIterations: entity.MaxCompareBootstrapIterations + 1, RegressionThreshold: -1}
+	p.Normalize()
+	assert.Equal(t, entity.DefaultCompareConfidenceLevel, p.ConfidenceLevel)
+	assert.Equal(t, entity.MaxCompareBootstrapIterations, p.BootstrapIterations)
+	assert.Equal(t, 0.0, p.RegressionThreshold)
+}
@@ -11,8 +11,9 @@ import (
 
 //go:generate  mockgen -destination  ./mocks/expt_export.go  --package mocks . IExptResultExportService
 type IExptResultExportService interface {
-	ExportCSV(ctx context.Context, spaceID, exptID int64, session *entity.Session) (int64, error)
-	DoExportCSV(ctx context.Context, spaceID, exptID, exportID int64) error
+	// ExportCSV 创建导出任务，format 为空时导出 CSV
+	ExportCSV(ctx context.Context, spaceID, exptID int64, session *entity.Session, format entity.ExptResultExportFormat) (int64, error)
+	DoExport(ctx context.Context, spaceID, exptID, exportID int64, format entity.ExptResultExportFormat) error
 	UpdateExportRecord(ctx context.Context, exportRecord *entity.ExptResultExportRecord) error
 	ListExportRecord(ctx context.Context, spaceID, exptID int64, page entity.Page) ([]*entity.ExptResultExportRecord, int64, error)
 	GetExptExportRecord(ctx context.Context, spaceID, exportID int64) (*entity.ExptResultExportRecord, error)
@@ -0,0 +1,424 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/component/vfs"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// exportTurnRow 单个轮次的结构化导出数据，保留多模态内容原始结构
+type exportTurnRow struct {
+	ItemID int64  `json:"item_id"`
+	TurnID int64  `json:"turn_id"`
+	Status string `json:"status"`
+	// EvalSetFields key 为评测集字段名
+	EvalSetFields map[string]*entity.Content `json:"eval_set_fields"`
+	ActualOutput  *entity.Content            `json:"actual_output,omitempty"`
+	Evaluators    []*exportEvaluatorResult   `json:"evaluators"`
+	// Annotations key 为标签名
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type exportEvaluatorResult struct {
+	EvaluatorVersionID int64    `json:"evaluator_version_id"`
+	Name               string   `json:"name"`
+	Version            string   `json:"version"`
+	Score              *float64 `json:"score,omitempty"`
+	Reason             string   `json:"reason,omitempty"`
+}
+
+// buildTurnRows 按轮次组装结构化导出数据，过滤规则与 CSV 导出一致
+func (e *exportCSVHelper) buildTurnRows(ctx context.Context) ([]*exportTurnRow, error) {
+	rows := make([]*exportTurnRow, 0)
+	for _, itemResult := range e.allItemResults {
+		if itemResult == nil {
+			logs.CtxWarn(ctx, "itemResult is nil")
+			continue
+		}
+
+		for _, turnResult := range itemResult.TurnResults {
+			if turnResult == nil {
+				logs.CtxWarn(ctx, "turnResult is nil")
+				continue
+			}
+			if len(turnResult.ExperimentResults) == 0 || turnResult.ExperimentResults[0] == nil {
+				logs.CtxWarn(ctx, "turnResult.ExperimentResults is nil")
+				continue
+			}
+			payload := turnResult.ExperimentResults[0].Payload
+			if payload == nil ||
+				payload.EvalSet == nil ||
+				payload.EvalSet.Turn == nil ||
+				payload.EvalSet.Turn.FieldDataList == nil {
+				return nil, fmt.Errorf("FieldDataList is nil")
+			}
+
+			row := &exportTurnRow{
+				ItemID:        itemResult.ItemID,
+				TurnID:        turnResult.TurnID,
+				EvalSetFields: make(map[string]*entity.Content, len(e.colEvalSetFields)),
+				Evaluators:    make([]*exportEvaluatorResult, 0, len(e.colEvaluators)),
+			}
+			if itemResult.SystemInfo != nil {
+				row.Status = itemRunStateToString(itemResult.SystemInfo.RunState)
+			}
+
+			fieldDataMap := make(map[string]*entity.FieldData, len(payload.EvalSet.Turn.FieldDataList))
+			for _, fieldData := range payload.EvalSet.Turn.FieldDataList {
+				if fieldData != nil {
+					fieldDataMap[fieldData.Key] = fieldData
+				}
+			}
+			for _, colEvalSetField := range e.colEvalSetFields {
+				if colEvalSetField == nil {
+					continue
+				}
+				var content *entity.Content
+				if fieldData, ok := fieldDataMap[ptr.From(colEvalSetField.Key)]; ok {
+					content = fieldData.Content
+				}
+				row.EvalSetFields[ptr.From(colEvalSetField.Name)] = content
+			}
+
+			if payload.TargetOutput != nil &&
+				payload.TargetOutput.EvalTargetRecord != nil &&
+				payload.TargetOutput.EvalTargetRecord.EvalTargetOutputData != nil {
+				row.ActualOutput = payload.TargetOutput.EvalTargetRecord.EvalTargetOutputData.OutputFields[consts.OutputSchemaKey]
+			}
+
+			var evaluatorRecords map[int64]*entity.EvaluatorRecord
+			if payload.EvaluatorOutput != nil {
+				evaluatorRecords = payload.EvaluatorOutput.EvaluatorRecords
+			}
+			for _, colEvaluator := range e.colEvaluators {
+				if colEvaluator == nil {
+					continue
+				}
+				record := evaluatorRecords[colEvaluator.EvaluatorVersionID]
+				row.Evaluators = append(row.Evaluators, &exportEvaluatorResult{
+					EvaluatorVersionID: colEvaluator.EvaluatorVersionID,
+					Name:               ptr.From(colEvaluator.Name),
+					Version:            ptr.From(colEvaluator.Version),
+					Score:              getEvaluatorScoreValue(record),
+					Reason:             getEvaluatorReason(record),
+				})
+			}
+
+			if payload.AnnotateResult != nil && payload.AnnotateResult.AnnotateRecords != nil {
+				row.Annotations = make(map[string]string, len(e.colAnnotations))
+				for _, colAnnotation := range e.colAnnotations {
+					if colAnnotation == nil {
+						continue
+					}
+					row.Annotations[colAnnotation.TagName] = getAnnotationData(payload.AnnotateResult.AnnotateRecords[colAnnotation.TagKeyID], colAnnotation)
+				}
+			}
+
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// getEvaluatorScoreValue 人工修正过的以修正分为准
+func getEvaluatorScoreValue(record *entity.EvaluatorRecord) *float64 {
+	if record == nil || record.EvaluatorOutputData == nil || record.EvaluatorOutputData.EvaluatorResult == nil {
+		return nil
+	}
+	result := record.EvaluatorOutputData.EvaluatorResult
+	if result.Correction != nil && result.Correction.Score != nil {
+		return result.Correction.Score
+	}
+	return result.Score
+}
+
+// exportJSONL 每个轮次一行 JSON
+func (e *exportCSVHelper) exportJSONL(ctx context.Context) error {
+	rows, err := e.buildTurnRows(ctx)
+	if err != nil {
+		return err
+	}
+	return e.createAndUploadFile(ctx, e.fileName, func(ctx context.Context) error {
+		return e.createFile(ctx, e.fileName, func(w io.Writer) error {
+			encoder := json.NewEncoder(w)
+			encoder.SetEscapeHTML(false)
+			for _, row := range rows {
+				if err := encoder.Encode(row); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+const (
+	parquetFieldItemID = "item_id"
+	parquetFieldTurnID = "turn_id"
+	parquetFieldStatus = "status"
+
+	// 评测集字段、评估器与标签列名来自用户配置，加前缀避免与固定列重名
+	parquetColumnPrefixEvalSetField = "eval_set."
+	parquetColumnPrefixEvaluator    = "evaluator."
+	parquetColumnPrefixAnnotation   = "annotation."
+)
+
+// parquetColumnNames parquet 列名不可重复，同类列之间仍重名时追加序号
+type parquetColumnNames map[string]struct{}
+
+func (p parquetColumnNames) add(name string) string {
+	column := name
+	for i := 2; ; i++ {
+		if _, ok := p[column]; !ok {
+			break
+		}
+		column = fmt.Sprintf("%s_%d", name, i)
+	}
+	p[column] = struct{}{}
+	return column
+}
+
+// exportParquet 每个轮次一行，评估器得分为 double 列，非文本内容以 JSON 保留原始结构
+func (e *exportCSVHelper) exportParquet(ctx context.Context) error {
+	rows, err := e.buildTurnRows(ctx)
+	if err != nil {
+		return err
+	}
+
+	columnNames := make(parquetColumnNames)
+	fields := make([]vfs.ParquetField, 0)
+	addField := func(name string, typ vfs.ParquetFieldType) string {
+		column := columnNames.add(name)
+		fields = append(fields, vfs.ParquetField{Name: column, Type: typ})
+		return column
+	}
+	addField(parquetFieldItemID, vfs.ParquetFieldTypeInt64)
+	addField(parquetFieldTurnID, vfs.ParquetFieldTypeInt64)
+	addField(parquetFieldStatus, vfs.ParquetFieldTypeString)
+	addField(consts.OutputSchemaKey, vfs.ParquetFieldTypeString)
+
+	evalSetFieldColumns := make(map[string]string, len(e.colEvalSetFields))
+	for _, colEvalSetField := range e.colEvalSetFields {
+		if colEvalSetField == nil {
+			continue
+		}
+		name := ptr.From(colEvalSetField.Name)
+		if _, ok := evalSetFieldColumns[name]; ok {
+			continue
+		}
+		evalSetFieldColumns[name] = addField(parquetColumnPrefixEvalSetField+name, vfs.ParquetFieldTypeString)
+	}
+	// evaluatorColumns key 为评估器版本 ID，value 依次为得分列与理由列
+	evaluatorColumns := make(map[int64][2]string, len(e.colEvaluators))
+	for _, colEvaluator := range e.colEvaluators {
+		if colEvaluator == nil {
+			continue
+		}
+		if _, ok := evaluatorColumns[colEvaluator.EvaluatorVersionID]; ok {
+			continue
+		}
+		name, version := ptr.From(colEvaluator.Name), ptr.From(colEvaluator.Version)
+		evaluatorColumns[colEvaluator.EvaluatorVersionID] = [2]string{
+			addField(parquetColumnPrefixEvaluator+getColumnNameEvaluator(name, version), vfs.ParquetFieldTypeDouble),
+			addField(parquetColumnPrefixEvaluator+getColumnNameEvaluatorReason(name, version), vfs.ParquetFieldTypeString),
+		}
+	}
+	annotationColumns := make(map[string]string, len(e.colAnnotations))
+	for _, colAnnotation := range e.colAnnotations {
+		if colAnnotation == nil {
+			continue
+		}
+		if _, ok := annotationColumns[colAnnotation.TagName]; ok {
+			continue
+		}
+		annotationColumns[colAnnotation.TagName] = addField(parquetColumnPrefixAnnotation+colAnnotation.TagName, vfs.ParquetFieldTypeString)
+	}
+
+	records := make([]map[string]any, 0, len(rows))
+	for _, row := range rows {
+		record := map[string]any{
+			parquetFieldItemID:     row.ItemID,
+			parquetFieldTurnID:     row.TurnID,
+			parquetFieldStatus:     row.Status,
+			consts.OutputSchemaKey: contentToExportCell(row.ActualOutput),
+		}
+		for name, content := range row.EvalSetFields {
+			if column, ok := evalSetFieldColumns[name]; ok {
+				record[column] = contentToExportCell(content)
+			}
+		}
+		for _, evaluator := range row.Evaluators {
+			columns, ok := evaluatorColumns[evaluator.EvaluatorVersionID]
+			if !ok {
+				continue
+			}
+			if evaluator.Score != nil {
+				record[columns[0]] = *evaluator.Score
+			}
+			record[columns[1]] = evaluator.Reason
+		}
+		for tagName, value := range row.Annotations {
+			if column, ok := annotationColumns[tagName]; ok {
+				record[column] = value
+			}
+		}
+		records = append(records, record)
+	}
+
+	return e.createAndUploadFile(ctx, e.fileName, func(ctx context.Context) error {
+		return e.createFile(ctx, e.fileName, func(w io.Writer) error {
+			pw, err := vfs.NewWriter(w, fields)
+			if err != nil {
+				return err
+			}
+			if err := pw.Write(records...); err != nil {
+				return err
+			}
+			return pw.Close()
+		})
+	})
+}
+
+// contentToExportCell 文本内容直接导出，其余类型序列化为 JSON
+func contentToExportCell(content *entity.Content) any {
+	if content == nil {
+		return nil
+	}
+	if content.GetContentType() == entity.ContentTypeText {
+		return content.GetText()
+	}
+	bytes, err := json.Marshal(content)
+	if err != nil {
+		return nil
+	}
+	return string(bytes)
+}
+
+// exportXLSX 与 CSV 导出相同的表格内容
+func (e *exportCSVHelper) exportXLSX(ctx context.Context) error {
+	columns, err := e.buildColumns(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := e.buildRows(ctx)
+	if err != nil {
+		return err
+	}
+	fileData := append([][]string{columns}, rows...)
+	return e.createAndUploadFile(ctx, e.fileName, func(ctx context.Context) error {
+		return e.createFile(ctx, e.fileName, func(w io.Writer) error {
+			return writeXLSX(w, fileData)
+		})
+	})
+}
+
+// xlsxMaxCellChars Excel 单元格最大字符数
+const xlsxMaxCellChars = 32767
+
+var xlsxStaticParts = []struct {
+	name    string
+	content string
+}{
+	{
+		name: "[Content_Types].xml",
+		content: `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`,
+	},
+	{
+		name: "_rels/.rels",
+		content: `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		name: "xl/workbook.xml",
+		content: `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="results" sheetId="1" r:id="rId1"/></sheets>` +
+			`</workbook>`,
+	},
+	{
+		name: "xl/_rels/workbook.xml.rels",
+		content: `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`</Relationships>`,
+	},
+}
+
+// writeXLSX 写入只有一个 sheet 的 xlsx 文件，单元格均为内联字符串
+func writeXLSX(w io.Writer, rows [][]string) error {
+	zw := zip.NewWriter(w)
+	for _, part := range xlsxStaticParts {
+		pw, err := zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(pw, part.content); err != nil {
+			return err
+		}
+	}
+
+	sw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	var builder strings.Builder
+	builder.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		rowNum := strconv.Itoa(i + 1)
+		builder.WriteString(`<row r="` + rowNum + `">`)
+		for j, cell := range row {
+			builder.WriteString(`<c r="` + xlsxColumnName(j) + rowNum + `" t="inlineStr"><is><t xml:space="preserve">`)
+			if err := xml.EscapeText(&builder, []byte(truncateXLSXCell(cell))); err != nil {
+				return err
+			}
+			builder.WriteString(`</t></is></c>`)
+		}
+		builder.WriteString(`</row>`)
+	}
+	builder.WriteString(`</sheetData></worksheet>`)
+	if _, err := io.WriteString(sw, builder.String()); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// xlsxColumnName 0 -> A, 25 -> Z, 26 -> AA
+func xlsxColumnName(idx int) string {
+	name := ""
+	for idx >= 0 {
+		name = string(rune('A'+idx%26)) + name
+		idx = idx/26 - 1
+	}
+	return name
+}
+
+func truncateXLSXCell(cell string) string {
+	if utf8.RuneCountInString(cell) <= xlsxMaxCellChars {
+		return cell
+	}
+	return string([]rune(cell)[:xlsxMaxCellChars])
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
+	fileserverMocks "github.com/coze-dev/coze-loop/backend/infra/fileserver/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func newTestExportHelper(t *testing.T, format entity.ExptResultExportFormat, uploaded *bytes.Buffer) *exportCSVHelper {
+	ctrl := gomock.NewController(t)
+	fileClient := fileserverMocks.NewMockObjectStorage(ctrl)
+	fileClient.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name string, r io.Reader, opts ...fileserver.UploadOpt) error {
+			_, err := io.Copy(uploaded, r)
+			return err
+		})
+
+	multipart := &entity.Content{
+		ContentType: ptr.Of(entity.ContentTypeMultipart),
+		MultiPart: []*entity.Content{
+			{ContentType: ptr.Of(entity.ContentTypeText), Text: ptr.Of("描述这张图")},
+			{ContentType: ptr.Of(entity.ContentTypeImage), Image: &entity.Image{URL: ptr.Of("https://example.com/a.png")}},
+		},
+	}
+	return &exportCSVHelper{
+		fileName:   filepath.Join(t.TempDir(), "export."+format.FileExt()),
+		format:     format,
+		fileClient: fileClient,
+		colEvaluators: []*entity.ColumnEvaluator{
+			{EvaluatorVersionID: 1, Name: ptr.Of("accuracy"), Version: ptr.Of("v1")},
+			{EvaluatorVersionID: 2, Name: ptr.Of("fluency"), Version: ptr.Of("v1")},
+		},
+		colEvalSetFields: []*entity.ColumnEvalSetField{{Key: ptr.Of("input"), Name: ptr.Of("input")}},
+		colAnnotations:   []*entity.ColumnAnnotation{{TagKeyID: 1, TagName: "comment"}},
+		allItemResults: []*entity.ItemResult{{
+			ItemID:     10,
+			SystemInfo: &entity.ItemSystemInfo{RunState: entity.ItemRunState_Success},
+			TurnResults: []*entity.TurnResult{{
+				TurnID: 2,
+				ExperimentResults: []*entity.ExperimentResult{{
+					Payload: &entity.ExperimentTurnPayload{
+						EvalSet: &entity.TurnEvalSet{Turn: &entity.Turn{FieldDataList: []*entity.FieldData{
+							{Key: "input", Name: "input", Content: multipart},
+						}}},
+						TargetOutput: &entity.TurnTargetOutput{EvalTargetRecord: &entity.EvalTargetRecord{
+							EvalTargetOutputData: &entity.EvalTargetOutputData{OutputFields: map[string]*entity.Content{
+								consts.OutputSchemaKey: {ContentType: ptr.Of(entity.ContentTypeText), Text: ptr.Of("一只猫")},
+							}},
+						}},
+						EvaluatorOutput: &entity.TurnEvaluatorOutput{EvaluatorRecords: map[int64]*entity.EvaluatorRecord{
+							1: {EvaluatorOutputData: &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{
+								Score:      ptr.Of(0.2),
+								Reasoning:  "不准确",
+								Correction: &entity.Correction{Score: ptr.Of(0.8), Explain: "人工修正"},
+							}}},
+						}},
+						AnnotateResult: &entity.TurnAnnotateResult{AnnotateRecords: map[int64]*entity.AnnotateRecord{
+							1: {AnnotateData: &entity.AnnotateData{TagContentType: entity.TagContentTypeFreeText, TextValue: ptr.Of("ok")}},
+						}},
+					},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestExportCSVHelper_exportJSONL(t *testing.T) {
+	uploaded := &bytes.Buffer{}
+	helper := newTestExportHelper(t, entity.ExptResultExportFormatJSONL, uploaded)
+	require.NoError(t, helper.export(context.Background()))
+
+	scanner := bufio.NewScanner(uploaded)
+	require.True(t, scanner.Scan())
+	row := &exportTurnRow{}
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), row))
+	assert.False(t, scanner.Scan())
+
+	assert.Equal(t, int64(10), row.ItemID)
+	assert.Equal(t, int64(2), row.TurnID)
+	assert.Equal(t, "success", row.Status)
+	input := row.EvalSetFields["input"]
+	require.NotNil(t, input)
+	require.Len(t, input.MultiPart, 2)
+	assert.Equal(t, "https://example.com/a.png", ptr.From(input.MultiPart[1].Image.URL))
+	assert.Equal(t, "一只猫", row.ActualOutput.GetText())
+	require.Len(t, row.Evaluators, 2)
+	assert.Equal(t, 0.8, ptr.From(row.Evaluators[0].Score))
+	assert.Equal(t, "人工修正", row.Evaluators[0].Reason)
+	assert.Nil(t, row.Evaluators[1].Score)
+	assert.Equal(t, "ok", row.Annotations["comment"])
+}
+
+func TestExportCSVHelper_exportParquet(t *testing.T) {
+	uploaded := &bytes.Buffer{}
+	helper := newTestExportHelper(t, entity.ExptResultExportFormatParquet, uploaded)
+	require.NoError(t, helper.export(context.Background()))
+
+	pr := parquet.NewReader(bytes.NewReader(uploaded.Bytes()))
+	defer func() { _ = pr.Close() }()
+	require.Equal(t, int64(1), pr.NumRows())
+	rows := make([]parquet.Row, 1)
+	n, _ := pr.ReadRows(rows)
+	require.Equal(t, 1, n)
+	valueOf := func(name string) parquet.Value {
+		leaf, ok := pr.Schema().Lookup(name)
+		require.True(t, ok, name)
+		return rows[0][leaf.ColumnIndex]
+	}
+
+	assert.Equal(t, int64(10), valueOf(parquetFieldItemID).Int64())
+	assert.Equal(t, int64(2), valueOf(parquetFieldTurnID).Int64())
+	assert.Equal(t, "一只猫", valueOf(consts.OutputSchemaKey).String())
+	assert.Equal(t, 0.8, valueOf("evaluator.accuracy<v1>").Double())
+	assert.True(t, valueOf("evaluator.fluency<v1>").IsNull())
+	assert.Equal(t, "ok", valueOf("annotation.comment").String())
+
+	input := &entity.Content{}
+	require.NoError(t, json.Unmarshal(valueOf("eval_set.input").ByteArray(), input))
+	assert.Len(t, input.MultiPart, 2)
+}
+
+func TestParquetColumnNames_add(t *testing.T) {
+	names := make(parquetColumnNames)
+	assert.Equal(t, parquetFieldItemID, names.add(parquetFieldItemID))
+	assert.Equal(t, parquetColumnPrefixEvalSetField+parquetFieldItemID, names.add(parquetColumnPrefixEvalSetField+parquetFieldItemID))
+	assert.Equal(t, "annotation.tag", names.add("annotation.tag"))
+	assert.Equal(t, "annotation.tag_2", names.add("annotation.tag"))
+	assert.Equal(t, "annotation.tag_3", names.add("annotation.tag"))
+}
+
+func TestExportCSVHelper_exportXLSX(t *testing.T) {
+	uploaded := &bytes.Buffer{}
+	helper := newTestExportHelper(t, entity.ExptResultExportFormatXLSX, uploaded)
+	require.NoError(t, helper.export(context.Background()))
+
+	zr, err := zip.NewReader(bytes.NewReader(uploaded.Bytes()), int64(uploaded.Len()))
+	require.NoError(t, err)
+	var sheet string
+	for _, f := range zr.File {
+		if f.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		_ = rc.Close()
+		sheet = string(content)
+	}
+	assert.Contains(t, sheet, `<c r="A1" t="inlineStr"><is><t xml:space="preserve">ID</t></is></c>`)
+	assert.Contains(t, sheet, "accuracy&lt;v1&gt;")
+	assert.Contains(t, sheet, "一只猫")
+	assert.Contains(t, sheet, `<row r="2">`)
+}
+
+func Test_xlsxColumnName(t *testing.T) {
+	assert.Equal(t, "A", xlsxColumnName(0))
+	assert.Equal(t, "Z", xlsxColumnName(25))
+	assert.Equal(t, "AA", xlsxColumnName(26))
+	assert.Equal(t, "AZ", xlsxColumnName(51))
+	assert.Equal(t, "BA", xlsxColumnName(52))
+}
+
+func Test_truncateXLSXCell(t *testing.T) {
+	assert.Equal(t, "abc", truncateXLSXCell("abc"))
+	assert.Equal(t, xlsxMaxCellChars, len([]rune(truncateXLSXCell(strings.Repeat("中", xlsxMaxCellChars+1)))))
+}
+
+func TestExptResultExportFormat(t *testing.T) {
+	assert.True(t, entity.ExptResultExportFormat("").IsValid())
+	assert.True(t, entity.ExptResultExportFormatXLSX.IsValid())
+	assert.False(t, entity.ExptResultExportFormat("PDF").IsValid())
+	assert.Equal(t, "csv", entity.ExptResultExportFormat("").FileExt())
+	assert.Equal(t, "jsonl", entity.ExptResultExportFormatJSONL.FileExt())
+	assert.Equal(t, "parquet", entity.ExptResultExportFormatParquet.FileExt())
+}
@@ -68,7 +68,10 @@ func NewExptResultExportService(
 	}
 }
 
-func (e ExptResultExportService) ExportCSV(ctx context.Context, spaceID, exptID int64, session *entity.Session) (int64, error) {
+func (e ExptResultExportService) ExportCSV(ctx context.Context, spaceID, exptID int64, session *entity.Session, format entity.ExptResultExportFormat) (int64, error) {
+	if !format.IsValid() {
+		return 0, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("unsupported export format %s", format)))
+	}
 	// 检查实验是否完成
 	expt, err := e.exptRepo.GetByID(ctx, exptID, spaceID)
 	if err != nil {
@@ -120,6 +123,7 @@ func (e ExptResultExportService) ExportCSV(ctx context.Context, spaceID, exptID
 		ExportID:     exportID,
 		ExperimentID: exptID,
 		SpaceID:      spaceID,
+		Format:       format,
 	}
 	err = e.exptPublisher.PublishExptExportCSVEvent(ctx, exportEvent, nil)
 	if err != nil {
@@ -186,7 +190,7 @@ func (e ExptResultExportService) ListExportRecord(ctx context.Context, spaceID,
 	return records, total, nil
 }
 
-func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptID, exportID int64) (err error) {
+func (e ExptResultExportService) DoExport(ctx context.Context, spaceID, exptID, exportID int64, format entity.ExptResultExportFormat) (err error) {
 	var fileName string
 	defer func() {
 		record := &entity.ExptResultExportRecord{
@@ -200,7 +204,7 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 
 		if err != nil {
 			errMsg := e.configer.GetErrCtrl(ctx).ConvertErrMsg(err.Error())
-			logs.CtxWarn(ctx, "[DoExportCSV] store export err, before: %v, after: %v", err, errMsg)
+			logs.CtxWarn(ctx, "[DoExport] store export err, before: %v, after: %v", err, errMsg)
 
 			ei, ok := errno.ParseErrImpl(err)
 			if !ok {
@@ -270,7 +274,7 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 	if err != nil {
 		return err
 	}
-	fileName, err = e.getFileName(ctx, expt.Name, exportID)
+	fileName, err = e.getFileName(ctx, expt.Name, exportID, format)
 	if err != nil {
 		return err
 	}
@@ -285,6 +289,7 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 		exptResultService:  e.exptResultService,
 		fileClient:         e.fileClient,
 		fileName:           fileName,
+		format:             format,
 
 		colEvaluators:    colEvaluators,
 		colAnnotations:   colAnnotation,
@@ -292,7 +297,7 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 		allItemResults:   allItemResults,
 	}
 
-	err = exportHelper.exportCSV(ctx)
+	err = exportHelper.export(ctx)
 	if err != nil {
 		return err
 	}
@@ -305,6 +310,7 @@ type exportCSVHelper struct {
 	spaceID  int64
 	exptID   int64
 	fileName string
+	format   entity.ExptResultExportFormat
 
 	colEvaluators    []*entity.ColumnEvaluator
 	colEvalSetFields []*entity.ColumnEvalSetField
@@ -318,6 +324,19 @@ type exportCSVHelper struct {
 	fileClient         fileserver.ObjectStorage
 }
 
+func (e *exportCSVHelper) export(ctx context.Context) error {
+	switch e.format {
+	case entity.ExptResultExportFormatJSONL:
+		return e.exportJSONL(ctx)
+	case entity.ExptResultExportFormatParquet:
+		return e.exportParquet(ctx)
+	case entity.ExptResultExportFormatXLSX:
+		return e.exportXLSX(ctx)
+	default:
+		return e.exportCSV(ctx)
+	}
+}
+
 func (e *exportCSVHelper) exportCSV(ctx context.Context) error {
 	// 表头信息
 	columns, err := e.buildColumns(ctx)
@@ -610,36 +629,43 @@ func getAnnotationData(record *entity.AnnotateRecord, columnAnnotation *entity.C
 	}
 }
 
-func (e *ExptResultExportService) getFileName(ctx context.Context, exptName string, exportID int64) (string, error) {
+func (e *ExptResultExportService) getFileName(ctx context.Context, exptName string, exportID int64, format entity.ExptResultExportFormat) (string, error) {
 	t := time.Now().Format("20060102")
-	// 文件名为：{对应实验名}_实验报告_{导出任务ID}_{下载时间}.csv
-	fileName := fmt.Sprintf("%s_实验报告_%d_%s.csv", exptName, exportID, t)
+	// 文件名为：{对应实验名}_实验报告_{导出任务ID}_{下载时间}.{格式扩展名}
+	fileName := fmt.Sprintf("%s_实验报告_%d_%s.%s", exptName, exportID, t, format.FileExt())
 	return fileName, nil
 }
 
 func (e *exportCSVHelper) createAndUploadCSV(ctx context.Context, fileName string, fileData [][]string) error {
-	err := e.createCSV(ctx, fileName, fileData)
+	return e.createAndUploadFile(ctx, fileName, func(ctx context.Context) error {
+		return e.createCSV(ctx, fileName, fileData)
+	})
+}
+
+// createAndUploadFile 在本地生成文件后上传，上传成功后删除本地文件
+func (e *exportCSVHelper) createAndUploadFile(ctx context.Context, fileName string, create func(ctx context.Context) error) error {
+	err := create(ctx)
 	if err != nil {
 		return err
 	}
 	// 上传文件
 
-	csvFile, err := os.Open(fileName)
+	file, err := os.Open(fileName)
 	if err != nil {
 		return err
 	}
 	defer func() {
-		_ = csvFile.Close()
+		_ = file.Close()
 	}()
 
-	fileReader := bufio.NewReader(csvFile)
+	fileReader := bufio.NewReader(file)
 
 	err = e.uploadCSVFile(ctx, fileName, fileReader)
 	if err != nil {
 		return fmt.Errorf("uploadFile error: %v", err)
 	}
 
-	// 删除CSV文件
+	// 删除本地文件
 	err = os.Remove(fileName)
 	if err != nil {
 		return err
@@ -677,6 +703,28 @@ func (e *exportCSVHelper) createCSV(ctx context.Context, fileName string, fileDa
 	return nil
 }
 
+// createFile 创建本地文件并写入内容
+func (e *exportCSVHelper) createFile(ctx context.Context, fileName string, write func(w io.Writer) error) error {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	writer := bufio.NewWriter(file)
+	if err := write(writer); err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	logs.CtxInfo(ctx, "export file successfully created, file = %v", file.Name())
+	return nil
+}
+
 func (e *exportCSVHelper) uploadCSVFile(ctx context.Context, fileName string, reader io.Reader) (err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = context.WithTimeout(ctx, 60*time.Second)
@@ -50,6 +50,7 @@ func TestExptResultExportService_ExportCSV(t *testing.T) {
 		spaceID   int64
 		exptID    int64
 		session   *entity.Session
+		format    entity.ExptResultExportFormat
 		setup     func(svc *ExptResultExportService)
 		want      int64
 		wantErr   bool
@@ -60,6 +61,7 @@ func TestExptResultExportService_ExportCSV(t *testing.T) {
 			spaceID: 1,
 			exptID:  123,
 			session: &entity.Session{UserID: "test"},
+			format:  entity.ExptResultExportFormatJSONL,
 			setup: func(svc *ExptResultExportService) {
 				// 实验已完成
 				svc.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().
@@ -82,7 +84,10 @@ func TestExptResultExportService_ExportCSV(t *testing.T) {
 				// 发布导出事件
 				svc.exptPublisher.(*eventsMocks.MockExptEventPublisher).EXPECT().
 					PublishExptExportCSVEvent(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil).
+					DoAndReturn(func(ctx context.Context, event *entity.ExportCSVEvent, duration *time.Duration) error {
+						assert.Equal(t, entity.ExptResultExportFormatJSONL, event.Format)
+						return nil
+					}).
 					Times(1)
 				svc.benefitService.(*benefitMocks.MockIBenefitService).EXPECT().BatchCheckEnableTypeBenefit(gomock.Any(), gomock.Any()).
 					Return(&benefit.BatchCheckEnableTypeBenefitResult{Results: map[string]bool{"exp_download_report_enabled": true}}, nil)
@@ -204,6 +209,17 @@ func TestExptResultExportService_ExportCSV(t *testing.T) {
 			want:    0,
 			wantErr: true,
 		},
+		{
+			name:      "不支持的导出格式",
+			spaceID:   1,
+			exptID:    123,
+			session:   &entity.Session{UserID: "test"},
+			format:    entity.ExptResultExportFormat("PDF"),
+			setup:     func(svc *ExptResultExportService) {},
+			want:      0,
+			wantErr:   true,
+			errorCode: errno.CommonInvalidParamCode,
+		},
 	}
 
 	for _, tt := range tests {
@@ -211,7 +227,7 @@ func TestExptResultExportService_ExportCSV(t *testing.T) {
 			svc := newTestExptResultExportService(ctrl)
 			tt.setup(svc)
 
-			got, err := svc.ExportCSV(context.Background(), tt.spaceID, tt.exptID, tt.session)
+			got, err := svc.ExportCSV(context.Background(), tt.spaceID, tt.exptID, tt.session, tt.format)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ExportCSV() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -423,7 +439,7 @@ func TestExptResultExportService_ListExportRecord(t *testing.T) {
 	}
 }
 
-func TestExptResultExportService_DoExportCSV(t *testing.T) {
+func TestExptResultExportService_DoExport(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -618,9 +634,9 @@ func TestExptResultExportService_DoExportCSV(t *testing.T) {
 			svc := newTestExptResultExportService(ctrl)
 			tt.setup(svc)
 
-			err := svc.DoExportCSV(context.Background(), tt.spaceID, tt.exptID, tt.exportID)
+			err := svc.DoExport(context.Background(), tt.spaceID, tt.exptID, tt.exportID, "")
 			if (err != nil) != tt.wantErr {
-				t.Errorf("DoExportCSV() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("DoExport() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
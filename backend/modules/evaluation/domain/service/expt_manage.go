@@ -41,6 +41,10 @@ type IExptExecutionManager interface {
 	CheckRun(ctx context.Context, expt *entity.Experiment, spaceID int64, session *entity.Session, opts ...entity.ExptRunCheckOptionFn) error
 	Run(ctx context.Context, exptID, runID, spaceID int64, session *entity.Session, runMode entity.ExptRunMode, ext map[string]string) error
 	RetryUnSuccess(ctx context.Context, exptID, runID, spaceID int64, session *entity.Session, ext map[string]string) error
+	// Pause 暂停实验，不再提交新的数据，已提交的数据继续执行
+	Pause(ctx context.Context, exptID, spaceID int64, session *entity.Session) error
+	// Resume 恢复已暂停的实验，继续提交未执行的数据
+	Resume(ctx context.Context, exptID, spaceID int64, session *entity.Session) error
 
 	Invoke(ctx context.Context, invokeExptReq *entity.InvokeExptReq) error
 	Finish(ctx context.Context, exptID *entity.Experiment, exptRunID int64, session *entity.Session) error
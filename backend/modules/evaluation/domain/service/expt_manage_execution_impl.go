@@ -101,6 +101,9 @@ func (e *ExptMangerImpl) CheckExpt(ctx context.Context, expt *entity.Experiment,
 	if gptr.Indirect(expt.EvalConf.ItemConcurNum) > consts.MaxItemConcurrentNum {
 		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("item concurrent num must not be greater than %d", consts.MaxEvalSetItemLimit)))
 	}
+	if err := expt.EvalConf.Budget.Valid(); err != nil {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(err.Error()))
+	}
 
 	return nil
 }
@@ -214,6 +217,12 @@ func (e *ExptMangerImpl) checkEvaluatorsConnector(ctx context.Context, expt *ent
 	})
 
 	evalSetFieldSchema := gslice.ToMap(expt.EvalSet.EvaluationSetVersion.EvaluationSetSchema.FieldSchemas, func(t *entity.FieldSchema) (string, *entity.FieldSchema) { return t.Name, t })
+	pairwiseVersionIDs := make(map[int64]bool)
+	for _, ev := range expt.Evaluators {
+		if ev != nil && ev.EvaluatorType == entity.EvaluatorTypePairwise && ev.PairwiseEvaluatorVersion != nil {
+			pairwiseVersionIDs[ev.PairwiseEvaluatorVersion.ID] = true
+		}
+	}
 	for _, evaluatorConf := range connectorConf.EvaluatorsConf.EvaluatorConf {
 		for _, fc := range evaluatorConf.IngressConf.EvalSetAdapter.FieldConfs {
 			firstField, err := json.GetFirstJSONPathField(fc.FromField)
@@ -235,11 +244,34 @@ func (e *ExptMangerImpl) checkEvaluatorsConnector(ctx context.Context, expt *ent
 				}
 			}
 		}
+		if pairwiseVersionIDs[evaluatorConf.EvaluatorVersionID] {
+			if err := e.checkPairwiseBaseline(ctx, expt, evaluatorConf); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// checkPairwiseBaseline 对比评估器的基准实验须为同一空间下、使用同一评测集版本的其他实验
+func (e *ExptMangerImpl) checkPairwiseBaseline(ctx context.Context, expt *entity.Experiment, evaluatorConf *entity.EvaluatorConf) error {
+	if evaluatorConf.BaselineExptID == 0 || evaluatorConf.BaselineExptID == expt.ID {
+		return errorx.NewByCode(errno.InvalidPairwiseBaselineCode, errorx.WithExtraMsg(fmt.Sprintf("evaluator %v with invalid baseline experiment %v", evaluatorConf.EvaluatorVersionID, evaluatorConf.BaselineExptID)))
+	}
+	baselines, err := e.exptRepo.MGetByID(ctx, []int64{evaluatorConf.BaselineExptID}, expt.SpaceID)
+	if err != nil {
+		return err
+	}
+	if len(baselines) == 0 || baselines[0].SpaceID != expt.SpaceID {
+		return errorx.NewByCode(errno.InvalidPairwiseBaselineCode, errorx.WithExtraMsg(fmt.Sprintf("baseline experiment %v not found", evaluatorConf.BaselineExptID)))
+	}
+	if baselines[0].EvalSetID != expt.EvalSetID || baselines[0].EvalSetVersionID != expt.EvalSetVersionID {
+		return errorx.NewByCode(errno.InvalidPairwiseBaselineCode, errorx.WithExtraMsg(fmt.Sprintf("baseline experiment %v uses a different evaluation set version", evaluatorConf.BaselineExptID)))
+	}
+	return nil
+}
+
 func (e *ExptMangerImpl) CheckBenefit(ctx context.Context, expt *entity.Experiment, session *entity.Session) error {
 	if expt.CreditCost == entity.CreditCostFree {
 		logs.CtxInfo(ctx, "CheckBenefit with credit cost already freed, expt_id: %v", expt.ID)
@@ -327,6 +359,66 @@ func (e *ExptMangerImpl) RetryUnSuccess(ctx context.Context, exptID, runID, spac
 	return nil
 }
 
+func (e *ExptMangerImpl) Pause(ctx context.Context, exptID, spaceID int64, session *entity.Session) error {
+	got, err := e.exptRepo.GetByID(ctx, exptID, spaceID)
+	if err != nil {
+		return err
+	}
+
+	// 与调度器持有同一把锁，避免与实验完成的状态更新并发
+	if got.LatestRunID > 0 {
+		lockKey := makeExptRunExecLockKey(exptID, got.LatestRunID)
+		locked, err := e.mutex.LockBackoff(ctx, lockKey, time.Second*20, time.Second*30)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			return errorx.NewByCode(errno.ExperimentStatusNotAllowedCode, errorx.WithExtraMsg("experiment is being scheduled, please retry later"))
+		}
+		defer func() {
+			if _, err := e.mutex.Unlock(lockKey); err != nil {
+				logs.CtxWarn(ctx, "unlock expt run exec lock fail, key: %v, err: %v", lockKey, err)
+			}
+		}()
+
+		if got, err = e.exptRepo.GetByID(ctx, exptID, spaceID); err != nil {
+			return err
+		}
+	}
+
+	if got.ExptType == entity.ExptType_Online || got.Status != entity.ExptStatus_Processing {
+		return errorx.NewByCode(errno.ExperimentStatusNotAllowedCode, errorx.WithExtraMsg(fmt.Sprintf("only processing offline experiment can be paused, status: %d", got.Status)))
+	}
+
+	logs.CtxInfo(ctx, "[ExptEval] Pause, expt_id: %v, expt_run_id: %v, user_id: %v", exptID, got.LatestRunID, session.UserID)
+
+	return e.exptRepo.Update(ctx, &entity.Experiment{
+		ID:      exptID,
+		SpaceID: spaceID,
+		Status:  entity.ExptStatus_Paused,
+	})
+}
+
+func (e *ExptMangerImpl) Resume(ctx context.Context, exptID, spaceID int64, session *entity.Session) error {
+	got, err := e.exptRepo.GetByID(ctx, exptID, spaceID)
+	if err != nil {
+		return err
+	}
+
+	if got.Status != entity.ExptStatus_Paused {
+		return errorx.NewByCode(errno.ExperimentStatusNotAllowedCode, errorx.WithExtraMsg(fmt.Sprintf("only paused experiment can be resumed, status: %d", got.Status)))
+	}
+
+	logs.CtxInfo(ctx, "[ExptEval] Resume, expt_id: %v, expt_run_id: %v, user_id: %v", exptID, got.LatestRunID, session.UserID)
+
+	// 暂停期间调度器仍在轮询，恢复状态后下一次调度即继续提交待执行的数据
+	return e.exptRepo.Update(ctx, &entity.Experiment{
+		ID:      exptID,
+		SpaceID: spaceID,
+		Status:  entity.ExptStatus_Processing,
+	})
+}
+
 func (e *ExptMangerImpl) CompleteRun(ctx context.Context, exptID, exptRunID int64, mode entity.ExptRunMode, spaceID int64, session *entity.Session, opts ...entity.CompleteExptOptionFn) error {
 	const idemKeyPrefix = "CompleteRun:"
 
@@ -516,7 +608,7 @@ func (e *ExptMangerImpl) CompleteExpt(ctx context.Context, exptID, spaceID int64
 		}
 	}
 
-	if status == entity.ExptStatus_Terminated {
+	if status == entity.ExptStatus_Terminated || status == entity.ExptStatus_SystemTerminated {
 		for _, chunk := range gslice.Chunk(stats.IncompleteTurnIDs, 30) {
 			if err := e.terminateItemTurns(ctx, exptID, chunk, spaceID, session); err != nil {
 				logs.CtxWarn(ctx, "terminateItemTurns fail, err: %v", err)
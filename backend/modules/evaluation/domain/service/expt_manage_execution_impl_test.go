@@ -599,6 +599,115 @@ func TestExptMangerImpl_RetryUnSuccess(t *testing.T) {
 	}
 }
 
+func TestExptMangerImpl_Pause(t *testing.T) {
+	ctx := context.Background()
+	session := &entity.Session{UserID: "test_user"}
+
+	tests := []struct {
+		name    string
+		setup   func(mgr *ExptMangerImpl)
+		wantErr bool
+	}{
+		{
+			name: "successful_pause",
+			setup: func(mgr *ExptMangerImpl) {
+				expt := &entity.Experiment{ID: 123, SpaceID: 789, Status: entity.ExptStatus_Processing, ExptType: entity.ExptType_Offline, LatestRunID: 456}
+				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().GetByID(ctx, int64(123), int64(789)).Return(expt, nil).Times(2)
+				mgr.mutex.(*lockMocks.MockILocker).EXPECT().LockBackoff(ctx, makeExptRunExecLockKey(123, 456), time.Second*20, time.Second*30).Return(true, nil)
+				mgr.mutex.(*lockMocks.MockILocker).EXPECT().Unlock(makeExptRunExecLockKey(123, 456)).Return(true, nil)
+				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().Update(ctx, &entity.Experiment{ID: 123, SpaceID: 789, Status: entity.ExptStatus_Paused}).Return(nil)
+			},
+		},
+		{
+			name: "locked_by_scheduler",
+			setup: func(mgr *ExptMangerImpl) {
+				expt := &entity.Experiment{ID: 123, SpaceID: 789, Status: entity.ExptStatus_Processing, LatestRunID: 456}
+				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().GetByID(ctx, int64(123), int64(789)).Return(expt, nil)
+				mgr.mutex.(*lockMocks.MockILocker).EXPECT().LockBackoff(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "finished_while_waiting_lock",
+			setup: func(mgr *ExptMangerImpl) {
+				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().GetByID(ctx, int64(123), int64(789)).
+					Return(&entity.Experiment{ID: 123, SpaceID: 789, Status: entity.ExptStatus_Processing, LatestRunID: 456}, nil)
+				mgr.mutex.(*lockMocks.MockILocker).EXPECT().LockBackoff(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				mgr.mutex.(*lockMocks.MockILocker).EXPECT().Unlock(gomock.Any()).Return(true, nil)
+				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().GetByID(ctx, int64(123), int64(789)).
+					Return(&entity.Experiment{ID: 123, SpaceID: 789, Status: entity.ExptStatus_Success, LatestRunID: 456}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "online_expt_not_allowed",
+			setup: func(mgr *ExptMangerImpl) {
+				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().GetByID(ctx, int64(123), int64(789)).
+					Return(&entity.Experiment{ID: 123, SpaceID: 789, Status: entity.ExptStatus_Processing, ExptType: entity.ExptType_Online}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mgr := newTestExptManager(ctrl)
+			tt.setup(mgr)
+			err := mgr.Pause(ctx, 123, 789, session)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestExptMangerImpl_Resume(t *testing.T) {
+	ctx := context.Background()
+	session := &entity.Session{UserID: "test_user"}
+
+	tests := []struct {
+		name    string
+		status  entity.ExptStatus
+		setup   func(mgr *ExptMangerImpl)
+		wantErr bool
+	}{
+		{
+			name:   "successful_resume",
+			status: entity.ExptStatus_Paused,
+			setup: func(mgr *ExptMangerImpl) {
+				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().Update(ctx, &entity.Experiment{ID: 123, SpaceID: 789, Status: entity.ExptStatus_Processing}).Return(nil)
+			},
+		},
+		{
+			name:    "not_paused",
+			status:  entity.ExptStatus_Processing,
+			setup:   func(mgr *ExptMangerImpl) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mgr := newTestExptManager(ctrl)
+			mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().GetByID(ctx, int64(123), int64(789)).
+				Return(&entity.Experiment{ID: 123, SpaceID: 789, Status: tt.status, LatestRunID: 456}, nil)
+			tt.setup(mgr)
+			err := mgr.Resume(ctx, 123, 789, session)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestExptMangerImpl_LogRun(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1477,3 +1586,74 @@ func TestExptMangerImpl_CheckConnector(t *testing.T) {
 		})
 	}
 }
+
+func TestExptMangerImpl_checkPairwiseBaseline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mgr := newTestExptManager(ctrl)
+	ctx := context.Background()
+	mockExptRepo := mgr.exptRepo.(*repoMocks.MockIExperimentRepo)
+
+	expt := &entity.Experiment{ID: 1, SpaceID: 100, EvalSetID: 10, EvalSetVersionID: 20}
+	tests := []struct {
+		name    string
+		conf    *entity.EvaluatorConf
+		setup   func()
+		wantErr bool
+	}{
+		{
+			name:    "未配置基准实验",
+			conf:    &entity.EvaluatorConf{EvaluatorVersionID: 1},
+			setup:   func() {},
+			wantErr: true,
+		},
+		{
+			name:    "基准实验为自身",
+			conf:    &entity.EvaluatorConf{EvaluatorVersionID: 1, BaselineExptID: 1},
+			setup:   func() {},
+			wantErr: true,
+		},
+		{
+			name: "基准实验不存在",
+			conf: &entity.EvaluatorConf{EvaluatorVersionID: 1, BaselineExptID: 2},
+			setup: func() {
+				mockExptRepo.EXPECT().MGetByID(gomock.Any(), []int64{2}, int64(100)).Return(nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "评测集不一致",
+			conf: &entity.EvaluatorConf{EvaluatorVersionID: 1, BaselineExptID: 2},
+			setup: func() {
+				mockExptRepo.EXPECT().MGetByID(gomock.Any(), []int64{2}, int64(100)).Return([]*entity.Experiment{{ID: 2, SpaceID: 100, EvalSetID: 11, EvalSetVersionID: 20}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "评测集版本不一致",
+			conf: &entity.EvaluatorConf{EvaluatorVersionID: 1, BaselineExptID: 2},
+			setup: func() {
+				mockExptRepo.EXPECT().MGetByID(gomock.Any(), []int64{2}, int64(100)).Return([]*entity.Experiment{{ID: 2, SpaceID: 100, EvalSetID: 10, EvalSetVersionID: 21}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "合法的基准实验",
+			conf: &entity.EvaluatorConf{EvaluatorVersionID: 1, BaselineExptID: 2},
+			setup: func() {
+				mockExptRepo.EXPECT().MGetByID(gomock.Any(), []int64{2}, int64(100)).Return([]*entity.Experiment{{ID: 2, SpaceID: 100, EvalSetID: 10, EvalSetVersionID: 20}}, nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			err := mgr.checkPairwiseBaseline(ctx, expt, tt.conf)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkPairwiseBaseline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -146,7 +146,7 @@ func TestExptMangerImpl_CreateExpt(t *testing.T) {
 
 	mgr.evalTargetService.(*svcMocks.MockIEvalTargetService).
 		EXPECT().
-		CreateEvalTarget(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		CreateEvalTarget(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(int64(100), int64(101), nil).AnyTimes()
 	mgr.evalTargetService.(*svcMocks.MockIEvalTargetService).
 		EXPECT().
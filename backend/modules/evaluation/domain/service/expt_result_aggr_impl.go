@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"time"
@@ -34,6 +35,7 @@ type ExptAggrResultServiceImpl struct {
 
 	evaluatorService       EvaluatorService
 	evaluatorRecordService EvaluatorRecordService
+	evalTargetService      IEvalTargetService
 	tagRPCAdapter          rpc.ITagRPCAdapter
 }
 
@@ -45,6 +47,7 @@ func NewExptAggrResultService(
 	evaluatorRecordService EvaluatorRecordService,
 	tagRPCAdapter rpc.ITagRPCAdapter,
 	exptAnnotateRepo repo.IExptAnnotateRepo,
+	evalTargetService IEvalTargetService,
 ) ExptAggrResultService {
 	return &ExptAggrResultServiceImpl{
 		exptTurnResultRepo:     exptTurnResultRepo,
@@ -55,6 +58,7 @@ func NewExptAggrResultService(
 		evaluatorRecordService: evaluatorRecordService,
 		tagRPCAdapter:          tagRPCAdapter,
 		exptAnnotateRepo:       exptAnnotateRepo,
+		evalTargetService:      evalTargetService,
 	}
 }
 
@@ -69,11 +73,33 @@ func (e *ExptAggrResultServiceImpl) CreateExptAggrResult(ctx context.Context, sp
 		return err
 	}
 
-	if len(turnEvaluatorResultRefs) == 0 {
-		logs.CtxInfo(ctx, "no evaluator result found, skip create expt aggr result")
+	evaluatorVersionID2AggregatorGroup, err := e.buildEvaluatorAggregatorGroups(ctx, spaceID, experimentID, turnEvaluatorResultRefs)
+	if err != nil {
+		return err
+	}
+	targetMetric2AggregatorGroup, err := e.buildTargetMetricAggregatorGroups(ctx, spaceID, experimentID)
+	if err != nil {
+		return err
+	}
+
+	if len(evaluatorVersionID2AggregatorGroup) == 0 && len(targetMetric2AggregatorGroup) == 0 {
+		logs.CtxInfo(ctx, "no evaluator or target result found, skip create expt aggr result")
 		return nil
 	}
 
+	return e.createExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, targetMetric2AggregatorGroup)
+}
+
+func (e *ExptAggrResultServiceImpl) buildEvaluatorAggregatorGroups(ctx context.Context, spaceID, experimentID int64, turnEvaluatorResultRefs []*entity.ExptTurnEvaluatorResultRef) (map[int64]*AggregatorGroup, error) {
+	if len(turnEvaluatorResultRefs) == 0 {
+		return nil, nil
+	}
+
+	evaluatorsConf, err := e.getEvaluatorsConf(ctx, spaceID, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
 	evaluatorResultIDs := make([]int64, 0)
 	evaluatorVersionID2ResultIDs := make(map[int64][]int64)
 	for _, turnEvaluatorResultRef := range turnEvaluatorResultRefs {
@@ -87,7 +113,7 @@ func (e *ExptAggrResultServiceImpl) CreateExptAggrResult(ctx context.Context, sp
 	evaluatorRecords, err := e.evaluatorRecordService.BatchGetEvaluatorRecord(ctx, evaluatorResultIDs, false)
 	// evalResults, err := e.evalCall.BatchGetEvaluatorRecord(ctx, spaceID, evaluatorResultIDs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	recordMap := make(map[int64]*entity.EvaluatorRecord)
 	for _, record := range evaluatorRecords {
@@ -96,13 +122,15 @@ func (e *ExptAggrResultServiceImpl) CreateExptAggrResult(ctx context.Context, sp
 
 	evaluatorVersionID2AggregatorGroup := make(map[int64]*AggregatorGroup)
 	for evaluatorVersionID, resultIDs := range evaluatorVersionID2ResultIDs {
-		aggregatorGroup := NewAggregatorGroup(WithScoreDistributionAggregator())
-		evaluatorVersionID2AggregatorGroup[evaluatorVersionID] = aggregatorGroup
+		records := make([]*entity.EvaluatorRecord, 0, len(resultIDs))
 		for _, resultID := range resultIDs {
-			evalResult, ok := recordMap[resultID]
-			if !ok || evalResult == nil {
-				continue
+			if evalResult, ok := recordMap[resultID]; ok && evalResult != nil {
+				records = append(records, evalResult)
 			}
+		}
+		aggregatorGroup := NewAggregatorGroup(evaluatorScoreAggregatorOptions(records, evaluatorsConf.GetEvaluatorConf(evaluatorVersionID))...)
+		evaluatorVersionID2AggregatorGroup[evaluatorVersionID] = aggregatorGroup
+		for _, evalResult := range records {
 			if evalResult.EvaluatorOutputData == nil ||
 				evalResult.EvaluatorOutputData.EvaluatorResult == nil ||
 				evalResult.EvaluatorOutputData.EvaluatorResult.Score == nil {
@@ -114,10 +142,81 @@ func (e *ExptAggrResultServiceImpl) CreateExptAggrResult(ctx context.Context, sp
 
 	}
 
-	return e.createExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup)
+	return evaluatorVersionID2AggregatorGroup, nil
 }
 
-func (e *ExptAggrResultServiceImpl) createExptAggrResult(ctx context.Context, spaceID, experimentID int64, evaluatorVersionID2AggregatorGroup map[int64]*AggregatorGroup) error {
+// getEvaluatorsConf 读取实验的评估器配置，用于确定各评估器额外启用的聚合器
+func (e *ExptAggrResultServiceImpl) getEvaluatorsConf(ctx context.Context, spaceID, experimentID int64) (*entity.EvaluatorsConf, error) {
+	experiment, err := e.experimentRepo.GetByID(ctx, experimentID, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	if experiment == nil || experiment.EvalConf == nil || experiment.EvalConf.ConnectorConf.EvaluatorsConf == nil {
+		return &entity.EvaluatorsConf{}, nil
+	}
+	return experiment.EvalConf.ConnectorConf.EvaluatorsConf, nil
+}
+
+// buildTargetMetricAggregatorGroups 按评测对象运行记录统计耗时与 token 消耗，仅统计运行成功的记录
+func (e *ExptAggrResultServiceImpl) buildTargetMetricAggregatorGroups(ctx context.Context, spaceID, experimentID int64) (map[string]*AggregatorGroup, error) {
+	const (
+		maxLoop = 10000
+		limit   = int64(100)
+	)
+
+	groups := make(map[string]*AggregatorGroup)
+	appendMetric := func(key string, value float64) {
+		if _, ok := groups[key]; !ok {
+			groups[key] = NewAggregatorGroup(targetMetricAggregatorOptions()...)
+		}
+		groups[key].Append(value)
+	}
+
+	cursor := int64(0)
+	for i := 0; i < maxLoop; i++ {
+		turnResults, ncursor, err := e.exptTurnResultRepo.ScanTurnResults(ctx, experimentID, nil, cursor, limit, spaceID)
+		if err != nil {
+			return nil, err
+		}
+		if len(turnResults) == 0 {
+			break
+		}
+		cursor = ncursor
+
+		recordIDs := make([]int64, 0, len(turnResults))
+		for _, turnResult := range turnResults {
+			if turnResult.TargetResultID > 0 {
+				recordIDs = append(recordIDs, turnResult.TargetResultID)
+			}
+		}
+		if len(recordIDs) > 0 {
+			records, err := e.evalTargetService.BatchGetRecordByIDs(ctx, spaceID, recordIDs)
+			if err != nil {
+				return nil, err
+			}
+			for _, record := range records {
+				if record == nil || gptr.Indirect(record.Status) != entity.EvalTargetRunStatusSuccess || record.EvalTargetOutputData == nil {
+					continue
+				}
+				if record.EvalTargetOutputData.TimeConsumingMS != nil {
+					appendMetric(entity.TargetMetricKeyLatencyMS, float64(gptr.Indirect(record.EvalTargetOutputData.TimeConsumingMS)))
+				}
+				if usage := record.EvalTargetOutputData.EvalTargetUsage; usage != nil {
+					appendMetric(entity.TargetMetricKeyInputTokens, float64(usage.InputTokens))
+					appendMetric(entity.TargetMetricKeyOutputTokens, float64(usage.OutputTokens))
+				}
+			}
+		}
+
+		if int64(len(turnResults)) < limit {
+			break
+		}
+	}
+
+	return groups, nil
+}
+
+func (e *ExptAggrResultServiceImpl) createExptAggrResult(ctx context.Context, spaceID, experimentID int64, evaluatorVersionID2AggregatorGroup map[int64]*AggregatorGroup, targetMetric2AggregatorGroup map[string]*AggregatorGroup) error {
 	exptAggrResults := make([]*entity.ExptAggrResult, 0)
 	for evaluatorVersionID, aggregatorGroup := range evaluatorVersionID2AggregatorGroup {
 		aggrResult := aggregatorGroup.Result()
@@ -142,7 +241,33 @@ func (e *ExptAggrResultServiceImpl) createExptAggrResult(ctx context.Context, sp
 			Version:      0,
 		})
 	}
+	for metricKey, aggregatorGroup := range targetMetric2AggregatorGroup {
+		aggrResult := aggregatorGroup.Result()
+		var averageValue float64
+		for _, aggregatorResult := range aggrResult.AggregatorResults {
+			if aggregatorResult.AggregatorType == entity.Average {
+				averageValue = aggregatorResult.GetScore()
+				break
+			}
+		}
+		aggrResultBytes, err := json.Marshal(aggrResult)
+		if err != nil {
+			return err
+		}
+		exptAggrResults = append(exptAggrResults, &entity.ExptAggrResult{
+			SpaceID:      spaceID,
+			ExperimentID: experimentID,
+			FieldType:    int32(entity.FieldType_TargetMetric),
+			FieldKey:     metricKey,
+			Score:        averageValue,
+			AggrResult:   aggrResultBytes,
+			Version:      0,
+		})
+	}
 
+	if len(exptAggrResults) == 0 {
+		return nil
+	}
 	err := e.exptAggrResultRepo.BatchCreateExptAggrResult(ctx, exptAggrResults)
 	if err != nil {
 		return err
@@ -208,7 +333,11 @@ func (e *ExptAggrResultServiceImpl) UpdateExptAggrResult(ctx context.Context, pa
 		recordMap[record.ID] = record
 	}
 
-	aggregatorGroup := NewAggregatorGroup(WithScoreDistributionAggregator())
+	evaluatorsConf, err := e.getEvaluatorsConf(ctx, param.SpaceID, param.ExperimentID)
+	if err != nil {
+		return err
+	}
+	aggregatorGroup := NewAggregatorGroup(evaluatorScoreAggregatorOptions(evaluatorRecords, evaluatorsConf.GetEvaluatorConf(evaluatorVersionID))...)
 	for _, evalResult := range recordMap {
 		if evalResult.EvaluatorOutputData == nil || evalResult.EvaluatorOutputData.EvaluatorResult == nil {
 			continue
@@ -220,7 +349,16 @@ func (e *ExptAggrResultServiceImpl) UpdateExptAggrResult(ctx context.Context, pa
 		aggregatorGroup.Append(score)
 	}
 
-	return e.updateExptAggrResult(ctx, param, evaluatorVersionID, aggregatorGroup, version)
+	if err := e.updateExptAggrResult(ctx, param, evaluatorVersionID, aggregatorGroup, version); err != nil {
+		return err
+	}
+
+	// 失败重试等场景下评测对象的执行记录也会变化，一并刷新耗时与 token 指标
+	targetMetric2AggregatorGroup, err := e.buildTargetMetricAggregatorGroups(ctx, param.SpaceID, param.ExperimentID)
+	if err != nil {
+		return err
+	}
+	return e.createExptAggrResult(ctx, param.SpaceID, param.ExperimentID, nil, targetMetric2AggregatorGroup)
 }
 
 func (e *ExptAggrResultServiceImpl) updateExptAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam, evaluatorVersionID int64, aggregatorGroup *AggregatorGroup, version int64) error {
@@ -308,6 +446,7 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 	for exptID, exptResult := range expt2AggrResults {
 		evaluatorResults := make(map[int64]*entity.EvaluatorAggregateResult)
 		annotationResults := make(map[int64]*entity.AnnotationAggregateResult)
+		var targetResult *entity.TargetAggregateResult
 
 		for _, fieldResult := range exptResult {
 			if fieldResult.FieldType == int32(entity.FieldType_Annotation) {
@@ -333,6 +472,27 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 				annotationResults[tagKeyID] = annotationResult
 			}
 
+			if fieldResult.FieldType == int32(entity.FieldType_TargetMetric) {
+				aggregateResultDO := entity.AggregateResult{}
+				err = json.Unmarshal(fieldResult.AggrResult, &aggregateResultDO)
+				if err != nil {
+					return nil, fmt.Errorf("json.Unmarshal(%s) failed, err: %v", fieldResult.AggrResult, err)
+				}
+				if targetResult == nil {
+					targetResult = &entity.TargetAggregateResult{}
+				}
+				switch fieldResult.FieldKey {
+				case entity.TargetMetricKeyLatencyMS:
+					targetResult.LatencyMS = aggregateResultDO.AggregatorResults
+				case entity.TargetMetricKeyInputTokens:
+					targetResult.InputTokens = aggregateResultDO.AggregatorResults
+				case entity.TargetMetricKeyOutputTokens:
+					targetResult.OutputTokens = aggregateResultDO.AggregatorResults
+				default:
+				}
+				continue
+			}
+
 			if fieldResult.FieldType != int32(entity.FieldType_EvaluatorScore) {
 				continue
 			}
@@ -353,7 +513,7 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 				return nil, fmt.Errorf("failed to get evaluator by version_id %d", evaluatorVersionID)
 			}
 
-			evaluatorVersion := evaluator.PromptEvaluatorVersion
+			evaluatorVersion := evaluator.GetEvaluatorVersion()
 			if evaluatorVersion == nil {
 				return nil, fmt.Errorf("failed to get evaluator version by version_id %d", evaluatorVersionID)
 			}
@@ -362,7 +522,7 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 				EvaluatorVersionID: evaluatorVersionID,
 				AggregatorResults:  aggregateResultDO.AggregatorResults,
 				Name:               gptr.Of(evaluator.Name),
-				Version:            gptr.Of(evaluatorVersion.Version),
+				Version:            gptr.Of(evaluatorVersion.GetVersion()),
 			}
 			evaluatorResults[evaluatorVersionID] = &evaluatorAggrResult
 
@@ -371,6 +531,7 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 			ExperimentID:      exptID,
 			EvaluatorResults:  evaluatorResults,
 			AnnotationResults: annotationResults,
+			TargetResult:      targetResult,
 		})
 	}
 
@@ -751,6 +912,80 @@ func WithScoreDistributionAggregator() NewAggregatorGroupOption {
 	}
 }
 
+// WithWinRateAggregator 统计对比评估器的胜率、平局率与负率
+func WithWinRateAggregator() NewAggregatorGroupOption {
+	return func(aggregatorGroup *AggregatorGroup) {
+		aggregatorGroup.Aggregators = append(aggregatorGroup.Aggregators, &WinRateAggregator{})
+	}
+}
+
+// WithPercentileAggregator 统计指定的分位数，aggregatorTypes 取值为 P50/P90/P99
+func WithPercentileAggregator(aggregatorTypes ...entity.AggregatorType) NewAggregatorGroupOption {
+	return func(aggregatorGroup *AggregatorGroup) {
+		aggregatorGroup.Aggregators = append(aggregatorGroup.Aggregators, &PercentileAggregator{AggregatorTypes: aggregatorTypes})
+	}
+}
+
+func WithStdDevAggregator() NewAggregatorGroupOption {
+	return func(aggregatorGroup *AggregatorGroup) {
+		aggregatorGroup.Aggregators = append(aggregatorGroup.Aggregators, &StdDevAggregator{})
+	}
+}
+
+// WithPassRateAggregator 统计不低于 threshold 的比例
+func WithPassRateAggregator(threshold float64) NewAggregatorGroupOption {
+	return func(aggregatorGroup *AggregatorGroup) {
+		aggregatorGroup.Aggregators = append(aggregatorGroup.Aggregators, &PassRateAggregator{Threshold: threshold})
+	}
+}
+
+// evaluatorScoreAggregatorOptions 评估器得分的聚合器，对比评估器的结果额外统计胜率，其余按评估器配置额外启用
+func evaluatorScoreAggregatorOptions(records []*entity.EvaluatorRecord, conf *entity.EvaluatorConf) []NewAggregatorGroupOption {
+	opts := []NewAggregatorGroupOption{WithScoreDistributionAggregator()}
+	for _, record := range records {
+		if record == nil || record.EvaluatorOutputData == nil || record.EvaluatorOutputData.EvaluatorResult == nil {
+			continue
+		}
+		if record.EvaluatorOutputData.EvaluatorResult.Preference != nil {
+			opts = append(opts, WithWinRateAggregator())
+			break
+		}
+	}
+	if conf == nil {
+		return opts
+	}
+
+	var percentileTypes []entity.AggregatorType
+	for _, t := range conf.AggregatorTypes {
+		switch t {
+		case entity.P50, entity.P90, entity.P99:
+			if !gslice.Contains(percentileTypes, t) {
+				percentileTypes = append(percentileTypes, t)
+			}
+		case entity.StdDev:
+			opts = append(opts, WithStdDevAggregator())
+		case entity.PassRate:
+			// 创建实验时已校验启用通过率必须配置阈值，未配置阈值时不计算通过率，聚合结果中不包含该项
+			if conf.PassThreshold != nil {
+				opts = append(opts, WithPassRateAggregator(gptr.Indirect(conf.PassThreshold)))
+			}
+		default:
+		}
+	}
+	if len(percentileTypes) > 0 {
+		opts = append(opts, WithPercentileAggregator(percentileTypes...))
+	}
+	return opts
+}
+
+// targetMetricAggregatorOptions 评测对象耗时与 token 消耗的聚合器
+func targetMetricAggregatorOptions() []NewAggregatorGroupOption {
+	return []NewAggregatorGroupOption{
+		WithPercentileAggregator(entity.P50, entity.P90, entity.P99),
+		WithStdDevAggregator(),
+	}
+}
+
 func (a *AggregatorGroup) Append(score float64) {
 	for _, aggregator := range a.Aggregators {
 		aggregator.Append(score)
@@ -879,6 +1114,119 @@ func (a *ScoreDistributionAggregator) Result() map[entity.AggregatorType]*entity
 	}
 }
 
+// WinRateAggregator 胜率聚合器，对比评估器的得分从当前实验视角计算，高于平局分为胜、低于平局分为负
+type WinRateAggregator struct {
+	Win   int64
+	Tie   int64
+	Loss  int64
+	Total int64
+}
+
+func (a *WinRateAggregator) Append(score float64) {
+	switch {
+	case score > entity.PairwiseScoreTie:
+		a.Win++
+	case score < entity.PairwiseScoreTie:
+		a.Loss++
+	default:
+		a.Tie++
+	}
+	a.Total++
+}
+
+func (a *WinRateAggregator) Result() map[entity.AggregatorType]*entity.AggregateData {
+	rate := func(count int64) *float64 {
+		r := 0.0
+		if a.Total != 0 {
+			r = float64(count) / float64(a.Total)
+		}
+		return &r
+	}
+	return map[entity.AggregatorType]*entity.AggregateData{
+		entity.WinRate:  {DataType: entity.Double, Value: rate(a.Win)},
+		entity.TieRate:  {DataType: entity.Double, Value: rate(a.Tie)},
+		entity.LossRate: {DataType: entity.Double, Value: rate(a.Loss)},
+	}
+}
+
+// PercentileAggregator 分位数聚合器，保留全部数据，取结果时排序后线性插值
+type PercentileAggregator struct {
+	AggregatorTypes []entity.AggregatorType
+	Values          []float64
+}
+
+func (a *PercentileAggregator) Append(score float64) {
+	a.Values = append(a.Values, score)
+}
+
+func (a *PercentileAggregator) Result() map[entity.AggregatorType]*entity.AggregateData {
+	sorted := make([]float64, len(a.Values))
+	copy(sorted, a.Values)
+	sort.Float64s(sorted)
+
+	res := make(map[entity.AggregatorType]*entity.AggregateData, len(a.AggregatorTypes))
+	for _, t := range a.AggregatorTypes {
+		q, ok := entity.PercentileOfAggregator[t]
+		if !ok {
+			continue
+		}
+		v := 0.0
+		if len(sorted) > 0 {
+			v = percentile(sorted, q)
+		}
+		res[t] = &entity.AggregateData{DataType: entity.Double, Value: gptr.Of(v)}
+	}
+	return res
+}
+
+// StdDevAggregator 样本标准差聚合器，使用 Welford 算法逐条累计
+type StdDevAggregator struct {
+	Count int64
+	Mean  float64
+	M2    float64
+}
+
+func (a *StdDevAggregator) Append(score float64) {
+	a.Count++
+	delta := score - a.Mean
+	a.Mean += delta / float64(a.Count)
+	a.M2 += delta * (score - a.Mean)
+}
+
+func (a *StdDevAggregator) Result() map[entity.AggregatorType]*entity.AggregateData {
+	sd := 0.0
+	if a.Count > 1 {
+		sd = math.Sqrt(a.M2 / float64(a.Count-1))
+	}
+	return map[entity.AggregatorType]*entity.AggregateData{
+		entity.StdDev: {DataType: entity.Double, Value: gptr.Of(sd)},
+	}
+}
+
+// PassRateAggregator 通过率聚合器，得分不低于阈值视为通过
+type PassRateAggregator struct {
+	Threshold float64
+	Pass      int64
+	Total     int64
+}
+
+func (a *PassRateAggregator) Append(score float64) {
+	if score >= a.Threshold {
+		a.Pass++
+	}
+	a.Total++
+}
+
+func (a *PassRateAggregator) Result() map[entity.AggregatorType]*entity.AggregateData {
+	rate := 0.0
+	if a.Total != 0 {
+		rate = float64(a.Pass) / float64(a.Total)
+	}
+	return map[entity.AggregatorType]*entity.AggregateData{
+		entity.PassRate: {DataType: entity.Double, Value: gptr.Of(rate)},
+	}
+}
+
 type ScoreCount struct {
 	Score string
 	Count int64
@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/bytedance/gg/gptr"
@@ -24,10 +25,11 @@ import (
 
 func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 	tests := []struct {
-		name      string
-		spaceID   int64
-		exptID    int64
-		setup     func(mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric)
+		name    string
+		spaceID int64
+		exptID  int64
+		setup   func(mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric,
+			mockExperimentRepo *repoMocks.MockIExperimentRepo, mockEvalTargetService *svcMocks.MockIEvalTargetService)
 		wantErr   bool
 		checkFunc func(t *testing.T, err error)
 	}{
@@ -35,7 +37,8 @@ func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 			name:    "正常创建聚合结果",
 			spaceID: 100,
 			exptID:  1,
-			setup: func(mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric) {
+			setup: func(mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric,
+				mockExperimentRepo *repoMocks.MockIExperimentRepo, mockEvalTargetService *svcMocks.MockIEvalTargetService) {
 				// 设置获取评估器结果引用的mock
 				mockExptTurnResultRepo.EXPECT().
 					GetTurnEvaluatorResultRefByExptID(gomock.Any(), int64(100), int64(1)).
@@ -46,6 +49,21 @@ func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 						},
 					}, nil)
 
+				// 设置获取实验评估器配置的mock
+				mockExperimentRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1), int64(100)).
+					Return(&entity.Experiment{
+						EvalConf: &entity.EvaluationConfiguration{
+							ConnectorConf: entity.Connector{
+								EvaluatorsConf: &entity.EvaluatorsConf{
+									EvaluatorConf: []*entity.EvaluatorConf{
+										{EvaluatorVersionID: 1, AggregatorTypes: []entity.AggregatorType{entity.P90, entity.PassRate}, PassThreshold: gptr.Of(0.6)},
+									},
+								},
+							},
+						},
+					}, nil)
+
 				// 设置获取评估器记录的mock
 				mockEvaluatorRecordService.EXPECT().
 					BatchGetEvaluatorRecord(gomock.Any(), []int64{1}, false).
@@ -60,10 +78,52 @@ func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 						},
 					}, nil)
 
+				// 设置扫描评测对象运行记录的mock
+				mockExptTurnResultRepo.EXPECT().
+					ScanTurnResults(gomock.Any(), int64(1), gomock.Nil(), int64(0), gomock.Any(), int64(100)).
+					Return([]*entity.ExptTurnResult{{ID: 1, TargetResultID: 10}, {ID: 2}}, int64(2), nil)
+				mockEvalTargetService.EXPECT().
+					BatchGetRecordByIDs(gomock.Any(), int64(100), []int64{10}).
+					Return([]*entity.EvalTargetRecord{
+						{
+							ID:     10,
+							Status: gptr.Of(entity.EvalTargetRunStatusSuccess),
+							EvalTargetOutputData: &entity.EvalTargetOutputData{
+								TimeConsumingMS: gptr.Of(int64(1200)),
+								EvalTargetUsage: &entity.EvalTargetUsage{InputTokens: 30, OutputTokens: 12},
+							},
+						},
+					}, nil)
+
 				// 设置创建聚合结果的mock
 				mockExptAggrResultRepo.EXPECT().
 					BatchCreateExptAggrResult(gomock.Any(), gomock.Any()).
-					Return(nil)
+					DoAndReturn(func(_ context.Context, results []*entity.ExptAggrResult) error {
+						assert.Len(t, results, 4)
+						for _, result := range results {
+							aggrResult := entity.AggregateResult{}
+							assert.NoError(t, json.Unmarshal(result.AggrResult, &aggrResult))
+							types := make(map[entity.AggregatorType]float64)
+							for _, r := range aggrResult.AggregatorResults {
+								types[r.AggregatorType] = r.GetScore()
+							}
+							switch entity.FieldType(result.FieldType) {
+							case entity.FieldType_EvaluatorScore:
+								assert.Equal(t, 0.8, types[entity.P90])
+								assert.Equal(t, 1.0, types[entity.PassRate])
+								assert.NotContains(t, types, entity.StdDev)
+							case entity.FieldType_TargetMetric:
+								assert.Contains(t, types, entity.P99)
+								assert.Contains(t, types, entity.StdDev)
+								if result.FieldKey == entity.TargetMetricKeyLatencyMS {
+									assert.Equal(t, 1200.0, result.Score)
+								}
+							default:
+								t.Errorf("unexpected field type %d", result.FieldType)
+							}
+						}
+						return nil
+					})
 
 				// 设置指标统计的mock
 				mockMetric.EXPECT().
@@ -76,10 +136,14 @@ func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 			name:    "没有评估器结果时跳过创建",
 			spaceID: 100,
 			exptID:  1,
-			setup: func(mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric) {
+			setup: func(mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric,
+				mockExperimentRepo *repoMocks.MockIExperimentRepo, mockEvalTargetService *svcMocks.MockIEvalTargetService) {
 				mockExptTurnResultRepo.EXPECT().
 					GetTurnEvaluatorResultRefByExptID(gomock.Any(), int64(100), int64(1)).
 					Return([]*entity.ExptTurnEvaluatorResultRef{}, nil)
+				mockExptTurnResultRepo.EXPECT().
+					ScanTurnResults(gomock.Any(), int64(1), gomock.Nil(), int64(0), gomock.Any(), int64(100)).
+					Return(nil, int64(0), nil)
 
 				// 设置指标统计的mock
 				mockMetric.EXPECT().
@@ -92,7 +156,8 @@ func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 			name:    "获取评估器结果引用失败",
 			spaceID: 100,
 			exptID:  1,
-			setup: func(mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric) {
+			setup: func(mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric,
+				mockExperimentRepo *repoMocks.MockIExperimentRepo, mockEvalTargetService *svcMocks.MockIEvalTargetService) {
 				mockExptTurnResultRepo.EXPECT().
 					GetTurnEvaluatorResultRefByExptID(gomock.Any(), int64(100), int64(1)).
 					Return(nil, errorx.NewByCode(500, errorx.WithExtraMsg("db error")))
@@ -121,15 +186,19 @@ func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 			mockExptAggrResultRepo := repoMocks.NewMockIExptAggrResultRepo(ctrl)
 			mockEvaluatorRecordService := svcMocks.NewMockEvaluatorRecordService(ctrl)
 			mockMetric := metricsMocks.NewMockExptMetric(ctrl)
+			mockExperimentRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+			mockEvalTargetService := svcMocks.NewMockIEvalTargetService(ctrl)
 
 			svc := &ExptAggrResultServiceImpl{
 				exptTurnResultRepo:     mockExptTurnResultRepo,
 				exptAggrResultRepo:     mockExptAggrResultRepo,
 				evaluatorRecordService: mockEvaluatorRecordService,
 				metric:                 mockMetric,
+				experimentRepo:         mockExperimentRepo,
+				evalTargetService:      mockEvalTargetService,
 			}
 
-			tt.setup(mockExptTurnResultRepo, mockExptAggrResultRepo, mockEvaluatorRecordService, mockMetric)
+			tt.setup(mockExptTurnResultRepo, mockExptAggrResultRepo, mockEvaluatorRecordService, mockMetric, mockExperimentRepo, mockEvalTargetService)
 
 			err := svc.CreateExptAggrResult(context.Background(), tt.spaceID, tt.exptID)
 			if tt.wantErr {
@@ -146,9 +215,10 @@ func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 
 func TestExptAggrResultServiceImpl_UpdateExptAggrResult(t *testing.T) {
 	tests := []struct {
-		name      string
-		param     *entity.UpdateExptAggrResultParam
-		setup     func(mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric)
+		name  string
+		param *entity.UpdateExptAggrResultParam
+		setup func(mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric,
+			mockExperimentRepo *repoMocks.MockIExperimentRepo, mockEvalTargetService *svcMocks.MockIEvalTargetService)
 		wantErr   bool
 		checkFunc func(t *testing.T, err error)
 	}{
@@ -160,7 +230,8 @@ func TestExptAggrResultServiceImpl_UpdateExptAggrResult(t *testing.T) {
 				FieldType:    entity.FieldType_EvaluatorScore,
 				FieldKey:     "1",
 			},
-			setup: func(mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric) {
+			setup: func(mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric,
+				mockExperimentRepo *repoMocks.MockIExperimentRepo, mockEvalTargetService *svcMocks.MockIEvalTargetService) {
 				// 设置获取现有聚合结果的mock
 				mockExptAggrResultRepo.EXPECT().
 					GetExptAggrResult(gomock.Any(), int64(1), int32(entity.FieldType_EvaluatorScore), "1").
@@ -194,6 +265,11 @@ func TestExptAggrResultServiceImpl_UpdateExptAggrResult(t *testing.T) {
 						},
 					}, nil)
 
+				// 设置获取实验评估器配置的mock
+				mockExperimentRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1), int64(100)).
+					Return(&entity.Experiment{}, nil)
+
 				// 设置更新聚合结果的mock
 				mockExptAggrResultRepo.EXPECT().
 					UpdateExptAggrResultByVersion(gomock.Any(), gomock.Any(), int64(1)).
@@ -203,6 +279,30 @@ func TestExptAggrResultServiceImpl_UpdateExptAggrResult(t *testing.T) {
 				mockMetric.EXPECT().
 					EmitCalculateExptAggrResult(int64(100), int64(entity.UpdateSpecificField), false, gomock.Any()).
 					Return()
+
+				// 设置刷新评测对象指标的mock
+				mockExptTurnResultRepo.EXPECT().
+					ScanTurnResults(gomock.Any(), int64(1), gomock.Nil(), int64(0), gomock.Any(), int64(100)).
+					Return([]*entity.ExptTurnResult{{ID: 1, TargetResultID: 10}}, int64(1), nil)
+				mockEvalTargetService.EXPECT().
+					BatchGetRecordByIDs(gomock.Any(), int64(100), []int64{10}).
+					Return([]*entity.EvalTargetRecord{
+						{
+							ID:     10,
+							Status: gptr.Of(entity.EvalTargetRunStatusSuccess),
+							EvalTargetOutputData: &entity.EvalTargetOutputData{
+								TimeConsumingMS: gptr.Of(int64(800)),
+							},
+						},
+					}, nil)
+				mockExptAggrResultRepo.EXPECT().
+					BatchCreateExptAggrResult(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, results []*entity.ExptAggrResult) error {
+						assert.Len(t, results, 1)
+						assert.Equal(t, int32(entity.FieldType_TargetMetric), results[0].FieldType)
+						assert.Equal(t, entity.TargetMetricKeyLatencyMS, results[0].FieldKey)
+						return nil
+					})
 			},
 			wantErr: false,
 		},
@@ -214,7 +314,8 @@ func TestExptAggrResultServiceImpl_UpdateExptAggrResult(t *testing.T) {
 				FieldType:    entity.FieldType_Unknown,
 				FieldKey:     "1",
 			},
-			setup: func(mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric) {
+			setup: func(mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric,
+				mockExperimentRepo *repoMocks.MockIExperimentRepo, mockEvalTargetService *svcMocks.MockIEvalTargetService) {
 				// 设置指标统计的mock
 				mockMetric.EXPECT().
 					EmitCalculateExptAggrResult(int64(100), int64(entity.UpdateSpecificField), true, gomock.Any()).
@@ -236,7 +337,8 @@ func TestExptAggrResultServiceImpl_UpdateExptAggrResult(t *testing.T) {
 				FieldType:    entity.FieldType_EvaluatorScore,
 				FieldKey:     "1",
 			},
-			setup: func(mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric) {
+			setup: func(mockExptAggrResultRepo *repoMocks.MockIExptAggrResultRepo, mockExptTurnResultRepo *repoMocks.MockIExptTurnResultRepo, mockEvaluatorRecordService *svcMocks.MockEvaluatorRecordService, mockMetric *metricsMocks.MockExptMetric,
+				mockExperimentRepo *repoMocks.MockIExperimentRepo, mockEvalTargetService *svcMocks.MockIEvalTargetService) {
 				// 设置获取现有聚合结果的mock
 				mockExptAggrResultRepo.EXPECT().
 					GetExptAggrResult(gomock.Any(), int64(1), int32(entity.FieldType_EvaluatorScore), "1").
@@ -266,15 +368,19 @@ func TestExptAggrResultServiceImpl_UpdateExptAggrResult(t *testing.T) {
 			mockExptTurnResultRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
 			mockEvaluatorRecordService := svcMocks.NewMockEvaluatorRecordService(ctrl)
 			mockMetric := metricsMocks.NewMockExptMetric(ctrl)
+			mockExperimentRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+			mockEvalTargetService := svcMocks.NewMockIEvalTargetService(ctrl)
 
 			svc := &ExptAggrResultServiceImpl{
 				exptAggrResultRepo:     mockExptAggrResultRepo,
 				exptTurnResultRepo:     mockExptTurnResultRepo,
 				evaluatorRecordService: mockEvaluatorRecordService,
 				metric:                 mockMetric,
+				experimentRepo:         mockExperimentRepo,
+				evalTargetService:      mockEvalTargetService,
 			}
 
-			tt.setup(mockExptAggrResultRepo, mockExptTurnResultRepo, mockEvaluatorRecordService, mockMetric)
+			tt.setup(mockExptAggrResultRepo, mockExptTurnResultRepo, mockEvaluatorRecordService, mockMetric, mockExperimentRepo, mockEvalTargetService)
 
 			err := svc.UpdateExptAggrResult(context.Background(), tt.param)
 			if tt.wantErr {
@@ -832,3 +938,67 @@ func TestExptAggrResultServiceImpl_UpdateAnnotationAggrResult(t *testing.T) {
 		})
 	}
 }
+
+func TestWinRateAggregator(t *testing.T) {
+	group := NewAggregatorGroup(WithWinRateAggregator())
+	for _, score := range []float64{entity.PairwiseScoreWin, entity.PairwiseScoreWin, entity.PairwiseScoreTie, entity.PairwiseScoreLoss} {
+		group.Append(score)
+	}
+	results := make(map[entity.AggregatorType]float64)
+	for _, r := range group.Result().AggregatorResults {
+		results[r.AggregatorType] = r.GetScore()
+	}
+	assert.Equal(t, 0.5, results[entity.WinRate])
+	assert.Equal(t, 0.25, results[entity.TieRate])
+	assert.Equal(t, 0.25, results[entity.LossRate])
+	assert.Equal(t, 0.625, results[entity.Average])
+
+	empty := (&WinRateAggregator{}).Result()
+	assert.Equal(t, 0.0, gptr.Indirect(empty[entity.WinRate].Value))
+}
+
+func Test_evaluatorScoreAggregatorOptions(t *testing.T) {
+	scoreRecord := &entity.EvaluatorRecord{EvaluatorOutputData: &entity.EvaluatorOutputData{
+		EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(0.8)},
+	}}
+	pairwiseRecord := &entity.EvaluatorRecord{EvaluatorOutputData: &entity.EvaluatorOutputData{
+		EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(1.0), Preference: gptr.Of(entity.PairwisePreferenceB)},
+	}}
+	failedRecord := &entity.EvaluatorRecord{EvaluatorOutputData: &entity.EvaluatorOutputData{}}
+
+	assert.Len(t, evaluatorScoreAggregatorOptions([]*entity.EvaluatorRecord{scoreRecord, failedRecord}, nil), 1)
+	assert.Len(t, evaluatorScoreAggregatorOptions([]*entity.EvaluatorRecord{failedRecord, nil, pairwiseRecord}, nil), 2)
+
+	// 分位数合并为一个聚合器，未配置阈值的通过率忽略
+	conf := &entity.EvaluatorConf{AggregatorTypes: []entity.AggregatorType{entity.P50, entity.P99, entity.P50, entity.StdDev, entity.PassRate}}
+	assert.Len(t, evaluatorScoreAggregatorOptions([]*entity.EvaluatorRecord{scoreRecord}, conf), 3)
+	conf.PassThreshold = gptr.Of(0.5)
+	assert.Len(t, evaluatorScoreAggregatorOptions([]*entity.EvaluatorRecord{scoreRecord}, conf), 4)
+}
+
+func TestPercentileStdDevPassRateAggregator(t *testing.T) {
+	group := NewAggregatorGroup(
+		WithPercentileAggregator(entity.P50, entity.P90, entity.P99),
+		WithStdDevAggregator(),
+		WithPassRateAggregator(0.6),
+	)
+	for _, score := range []float64{0.9, 0.1, 0.5, 0.7, 0.3} {
+		group.Append(score)
+	}
+	results := make(map[entity.AggregatorType]float64)
+	for _, r := range group.Result().AggregatorResults {
+		results[r.AggregatorType] = r.GetScore()
+	}
+	assert.InDelta(t, 0.5, results[entity.P50], 1e-9)
+	assert.InDelta(t, 0.82, results[entity.P90], 1e-9)
+	assert.InDelta(t, 0.892, results[entity.P99], 1e-9)
+	assert.InDelta(t, math.Sqrt(0.1), results[entity.StdDev], 1e-9)
+	assert.InDelta(t, 0.4, results[entity.PassRate], 1e-9)
+
+	// 无数据与单条数据
+	assert.Equal(t, 0.0, gptr.Indirect((&PercentileAggregator{AggregatorTypes: []entity.AggregatorType{entity.P50}}).Result()[entity.P50].Value))
+	single := &StdDevAggregator{}
+	single.Append(3)
+	assert.Equal(t, 0.0, gptr.Indirect(single.Result()[entity.StdDev].Value))
+	assert.Equal(t, 0.0, gptr.Indirect((&PassRateAggregator{}).Result()[entity.PassRate].Value))
+}
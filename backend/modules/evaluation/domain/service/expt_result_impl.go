@@ -160,6 +160,9 @@ func (e ExptResultServiceImpl) RecordItemRunLogs(ctx context.Context, exptID, ex
 	for _, trl := range turnRunLogs {
 		turn2RunLog[trl.TurnID] = trl
 	}
+	if statsCntOp.OpInputTokenCost, statsCntOp.OpOutputTokenCost, err = e.sumTurnTokenCost(ctx, spaceID, turnRunLogs); err != nil {
+		return nil, err
+	}
 
 	logs.CtxInfo(ctx, "[ExptEval] expt item result with recording run_log, expt_id=%v, expt_run_id=%v, item_id=%v, cnt_op: %v", exptID, exptRunID, itemID, json.Jsonify(statsCntOp))
 
@@ -223,6 +226,51 @@ func (e ExptResultServiceImpl) RecordItemRunLogs(ctx context.Context, exptID, ex
 	return turnEvaluatorRefs, nil
 }
 
+// sumTurnTokenCost 汇总轮次运行中评测对象与评估器的 token 消耗，用于累计实验预算
+func (e ExptResultServiceImpl) sumTurnTokenCost(ctx context.Context, spaceID int64, turnRunLogs []*entity.ExptTurnResultRunLog) (inputTokens, outputTokens int64, err error) {
+	var targetRecordIDs, evaluatorRecordIDs []int64
+	for _, trl := range turnRunLogs {
+		if trl.TargetResultID > 0 {
+			targetRecordIDs = append(targetRecordIDs, trl.TargetResultID)
+		}
+		if trl.EvaluatorResultIds != nil {
+			for _, resID := range trl.EvaluatorResultIds.EvalVerIDToResID {
+				evaluatorRecordIDs = append(evaluatorRecordIDs, resID)
+			}
+		}
+	}
+
+	if len(targetRecordIDs) > 0 {
+		targetRecords, err := e.evalTargetService.BatchGetRecordByIDs(ctx, spaceID, targetRecordIDs)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, record := range targetRecords {
+			if record == nil || record.EvalTargetOutputData == nil || record.EvalTargetOutputData.EvalTargetUsage == nil {
+				continue
+			}
+			inputTokens += record.EvalTargetOutputData.EvalTargetUsage.InputTokens
+			outputTokens += record.EvalTargetOutputData.EvalTargetUsage.OutputTokens
+		}
+	}
+
+	if len(evaluatorRecordIDs) > 0 {
+		evaluatorRecords, err := e.evaluatorRecordService.BatchGetEvaluatorRecord(ctx, evaluatorRecordIDs, false)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, record := range evaluatorRecords {
+			if record == nil || record.EvaluatorOutputData == nil || record.EvaluatorOutputData.EvaluatorUsage == nil {
+				continue
+			}
+			inputTokens += record.EvaluatorOutputData.EvaluatorUsage.InputTokens
+			outputTokens += record.EvaluatorOutputData.EvaluatorUsage.OutputTokens
+		}
+	}
+
+	return inputTokens, outputTokens, nil
+}
+
 func NewTurnEvaluatorResultRefs(id, exptID, turnResultID, spaceID int64, evaluatorResults *entity.EvaluatorResults) []*entity.ExptTurnEvaluatorResultRef {
 	if evaluatorResults == nil {
 		return nil
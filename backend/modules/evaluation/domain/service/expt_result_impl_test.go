@@ -1265,6 +1265,7 @@ func TestExptResultServiceImpl_RecordItemRunLogs(t *testing.T) {
 				mockExptTurnResultRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
 				mockExptStatsRepo := repoMocks.NewMockIExptStatsRepo(ctrl)
 				mockEvaluatorRecordService := svcMocks.NewMockEvaluatorRecordService(ctrl)
+				mockEvalTargetService := svcMocks.NewMockIEvalTargetService(ctrl)
 				mockPublisher := eventsMocks.NewMockExptEventPublisher(ctrl)
 				mockIdgen := idgenMocks.NewMockIIDGenerator(ctrl)
 
@@ -1286,9 +1287,22 @@ func TestExptResultServiceImpl_RecordItemRunLogs(t *testing.T) {
 					Return([]*entity.ExptTurnResultRunLog{{
 						TurnID:             1,
 						Status:             entity.TurnRunState_Success,
+						TargetResultID:     11,
 						EvaluatorResultIds: &entity.EvaluatorResults{EvalVerIDToResID: map[int64]int64{1: 1}},
 					}}, nil)
 
+				// token 消耗统计 mock
+				mockEvalTargetService.EXPECT().
+					BatchGetRecordByIDs(gomock.Any(), int64(100), []int64{11}).
+					Return([]*entity.EvalTargetRecord{{EvalTargetOutputData: &entity.EvalTargetOutputData{
+						EvalTargetUsage: &entity.EvalTargetUsage{InputTokens: 10, OutputTokens: 20},
+					}}}, nil)
+				mockEvaluatorRecordService.EXPECT().
+					BatchGetEvaluatorRecord(gomock.Any(), []int64{1}, false).
+					Return([]*entity.EvaluatorRecord{{EvaluatorOutputData: &entity.EvaluatorOutputData{
+						EvaluatorUsage: &entity.EvaluatorUsage{InputTokens: 3, OutputTokens: 4},
+					}}}, nil)
+
 				// GetItemTurnResults mock
 				mockExptItemResultRepo.EXPECT().
 					GetItemTurnResults(gomock.Any(), int64(100), int64(1), int64(1)).
@@ -1326,12 +1340,17 @@ func TestExptResultServiceImpl_RecordItemRunLogs(t *testing.T) {
 				// ArithOperateCount mock
 				mockExptStatsRepo.EXPECT().
 					ArithOperateCount(gomock.Any(), int64(1), int64(100), gomock.Any()).
-					Return(nil)
+					DoAndReturn(func(ctx context.Context, exptID, spaceID int64, op *entity.StatsCntArithOp) error {
+						assert.Equal(t, int64(13), op.OpInputTokenCost)
+						assert.Equal(t, int64(24), op.OpOutputTokenCost)
+						return nil
+					})
 
 				return ExptResultServiceImpl{
 					ExptItemResultRepo:     mockExptItemResultRepo,
 					ExptTurnResultRepo:     mockExptTurnResultRepo,
 					ExptStatsRepo:          mockExptStatsRepo,
+					evalTargetService:      mockEvalTargetService,
 					evaluatorRecordService: mockEvaluatorRecordService,
 					publisher:              mockPublisher,
 					idgen:                  mockIdgen,
@@ -234,6 +234,15 @@ func (e *ExptItemEventEvalServiceImpl) eval(ctx context.Context, event *entity.E
 
 	ctx = e.WithCtx(ctx, eiec)
 
+	reason, err := checkExptBudget(ctx, e.configer, e.exptStatsRepo, eiec.Expt)
+	if err != nil {
+		return err
+	}
+	if len(reason) > 0 {
+		logs.CtxWarn(ctx, "[ExptEval] skip item eval with budget exceeded, expt_id: %v, item_id: %v, reason: %v", event.ExptID, event.EvalSetItemID, reason)
+		return nil
+	}
+
 	mode, err := NewRecordEvalMode(
 		eiec.Event,
 		e.exptItemResultRepo,
@@ -15,9 +15,11 @@ import (
 	"github.com/bytedance/gg/gslice"
 
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/json"
@@ -35,12 +37,14 @@ func NewExptTurnEvaluation(metric metrics.ExptMetric,
 	evalTargetService IEvalTargetService,
 	evaluatorService EvaluatorService,
 	benefitService benefit.IBenefitService,
+	turnResultRepo repo.IExptTurnResultRepo,
 ) ExptItemTurnEvaluation {
 	return &DefaultExptTurnEvaluationImpl{
 		metric:            metric,
 		evalTargetService: evalTargetService,
 		evaluatorService:  evaluatorService,
 		benefitService:    benefitService,
+		turnResultRepo:    turnResultRepo,
 	}
 }
 
@@ -49,6 +53,7 @@ type DefaultExptTurnEvaluationImpl struct {
 	evalTargetService IEvalTargetService
 	evaluatorService  EvaluatorService
 	benefitService    benefit.IBenefitService
+	turnResultRepo    repo.IExptTurnResultRepo
 }
 
 func (e *DefaultExptTurnEvaluationImpl) Eval(ctx context.Context, etec *entity.ExptTurnEvalCtx) (trr *entity.ExptTurnRunResult) {
@@ -187,6 +192,11 @@ func (e *DefaultExptTurnEvaluationImpl) callTarget(ctx context.Context, etec *en
 		}
 	}
 
+	// 异步执行时上下文中没有登录用户，评测对象访问需要鉴权的服务（如 trace 回放）时以实验发起人的身份调用
+	if _, ok := session.UserIDInCtx(ctx); !ok && etec.Event.Session != nil && etec.Event.Session.UserID != "" {
+		ctx = session.WithCtxUser(ctx, &session.User{ID: etec.Event.Session.UserID, AppID: etec.Event.Session.AppID})
+	}
+
 	targetRecord, err := e.evalTargetService.ExecuteTarget(ctx, spaceID, etec.Expt.Target.ID, etec.Expt.Target.EvalTargetVersion.ID, &entity.ExecuteTargetCtx{
 		ExperimentRunID: gptr.Of(etec.Event.ExptRunID),
 		ItemID:          etec.EvalSetItem.ItemID,
@@ -285,36 +295,16 @@ func (e *DefaultExptTurnEvaluationImpl) callEvaluators(ctx context.Context, exec
 		}
 
 		curFields := make(map[string]*entity.Content)
-
-		for _, fc := range ec.IngressConf.TargetAdapter.FieldConfs {
-			firstField, err := json.GetFirstJSONPathField(fc.FromField)
-			if err != nil {
-				return nil, err
-			}
-			if firstField == fc.FromField { // 没有下钻字段
-				curFields[fc.FieldName] = targetFields[fc.FromField]
-				continue
-			}
-			content, err := e.getContentByJsonPath(targetFields[firstField], fc.FromField)
-			if err != nil {
-				return nil, err
-			}
-			curFields[fc.FieldName] = content
+		if err := e.fillFieldsByAdapter(ec.IngressConf.TargetAdapter, targetFields, curFields); err != nil {
+			return nil, err
 		}
-		for _, fc := range ec.IngressConf.EvalSetAdapter.FieldConfs {
-			firstField, err := json.GetFirstJSONPathField(fc.FromField)
-			if err != nil {
-				return nil, err
-			}
-			if firstField == fc.FromField { // 没有下钻字段
-				curFields[fc.FieldName] = turnFields[fc.FromField]
-				continue
-			}
-			content, err := e.getContentByJsonPath(turnFields[firstField], fc.FromField)
-			if err != nil {
+		if err := e.fillFieldsByAdapter(ec.IngressConf.EvalSetAdapter, turnFields, curFields); err != nil {
+			return nil, err
+		}
+		if ev.EvaluatorType == entity.EvaluatorTypePairwise {
+			if err := e.fillPairwiseFields(ctx, spaceID, ec, item.ItemID, turn.ID, targetFields, curFields); err != nil {
 				return nil, err
 			}
-			curFields[fc.FieldName] = content
 		}
 
 		pool.Add(func() error {
@@ -360,6 +350,70 @@ func (e *DefaultExptTurnEvaluationImpl) callEvaluators(ctx context.Context, exec
 	return records, err
 }
 
+// fillFieldsByAdapter 按字段映射配置从 fields 中取值写入 curFields，FromField 支持 jsonpath 下钻
+func (e *DefaultExptTurnEvaluationImpl) fillFieldsByAdapter(adapter *entity.FieldAdapter, fields, curFields map[string]*entity.Content) error {
+	if adapter == nil {
+		return nil
+	}
+	for _, fc := range adapter.FieldConfs {
+		firstField, err := json.GetFirstJSONPathField(fc.FromField)
+		if err != nil {
+			return err
+		}
+		if firstField == fc.FromField { // 没有下钻字段
+			curFields[fc.FieldName] = fields[fc.FromField]
+			continue
+		}
+		content, err := e.getContentByJsonPath(fields[firstField], fc.FromField)
+		if err != nil {
+			return err
+		}
+		curFields[fc.FieldName] = content
+	}
+	return nil
+}
+
+// fillPairwiseFields 为对比评估器补齐 output_a、output_b。
+// output_a 取基准实验同一条数据的评测对象输出，未配置映射时取 actual_output；基准结果缺失时不填充，由评估器校验输入时报错
+func (e *DefaultExptTurnEvaluationImpl) fillPairwiseFields(ctx context.Context, spaceID int64, ec *entity.EvaluatorConf, itemID, turnID int64,
+	targetFields, curFields map[string]*entity.Content,
+) error {
+	if curFields[entity.PairwiseEvaluatorOutputBKey] == nil {
+		curFields[entity.PairwiseEvaluatorOutputBKey] = targetFields[consts.OutputSchemaKey]
+	}
+	if ec.BaselineExptID == 0 {
+		return errorx.NewByCode(errno.InvalidPairwiseBaselineCode, errorx.WithExtraMsg("baseline experiment is not set"))
+	}
+
+	turnResults, err := e.turnResultRepo.BatchGet(ctx, spaceID, ec.BaselineExptID, []int64{itemID})
+	if err != nil {
+		return err
+	}
+	turnResult, ok := gslice.Find(turnResults, func(tr *entity.ExptTurnResult) bool { return tr.TurnID == turnID }).Get()
+	if !ok || turnResult.TargetResultID == 0 {
+		logs.CtxWarn(ctx, "[ExptTurnEval] baseline target result not found, baseline_expt_id: %v, item_id: %v, turn_id: %v", ec.BaselineExptID, itemID, turnID)
+		return nil
+	}
+	record, err := e.evalTargetService.GetRecordByID(ctx, spaceID, turnResult.TargetResultID)
+	if err != nil {
+		return err
+	}
+	if record == nil || record.EvalTargetOutputData == nil {
+		logs.CtxWarn(ctx, "[ExptTurnEval] baseline target record is empty, baseline_expt_id: %v, target_result_id: %v", ec.BaselineExptID, turnResult.TargetResultID)
+		return nil
+	}
+
+	baselineFields := record.EvalTargetOutputData.OutputFields
+	adapter := ec.IngressConf.BaselineTargetAdapter
+	if adapter == nil || len(adapter.FieldConfs) == 0 {
+		adapter = &entity.FieldAdapter{FieldConfs: []*entity.FieldConf{{
+			FieldName: entity.PairwiseEvaluatorOutputAKey,
+			FromField: consts.OutputSchemaKey,
+		}}}
+	}
+	return e.fillFieldsByAdapter(adapter, baselineFields, curFields)
+}
+
 // 注意此函数有特化逻辑不可直接服用, 删除了jsonpath的第一级
 func (e *DefaultExptTurnEvaluationImpl) getContentByJsonPath(content *entity.Content, jsonPath string) (*entity.Content, error) {
 	logs.CtxInfo(context.Background(), "getContentByJsonPath, content: %v, jsonPath: %v", json.Jsonify(content), jsonPath)
@@ -16,6 +16,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
 	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
 	svcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
 )
 
@@ -29,8 +30,9 @@ func TestNewExptTurnEvaluation(t *testing.T) {
 	mockEvalTargetService := svcmocks.NewMockIEvalTargetService(ctrl)
 	mockEvaluatorService := svcmocks.NewMockEvaluatorService(ctrl)
 	mockBenefitService := benefitmocks.NewMockIBenefitService(ctrl)
+	mockTurnResultRepo := repomocks.NewMockIExptTurnResultRepo(ctrl)
 
-	eval := NewExptTurnEvaluation(mockMetric, mockEvalTargetService, mockEvaluatorService, mockBenefitService)
+	eval := NewExptTurnEvaluation(mockMetric, mockEvalTargetService, mockEvaluatorService, mockBenefitService, mockTurnResultRepo)
 	assert.NotNil(t, eval)
 }
 
@@ -629,6 +631,110 @@ func TestDefaultExptTurnEvaluationImpl_CallEvaluators(t *testing.T) {
 	}
 }
 
+func TestDefaultExptTurnEvaluationImpl_fillPairwiseFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEvalTargetService := svcmocks.NewMockIEvalTargetService(ctrl)
+	mockTurnResultRepo := repomocks.NewMockIExptTurnResultRepo(ctrl)
+	service := &DefaultExptTurnEvaluationImpl{
+		evalTargetService: mockEvalTargetService,
+		turnResultRepo:    mockTurnResultRepo,
+	}
+
+	candidate := &entity.Content{Text: gptr.Of("candidate")}
+	baseline := &entity.Content{Text: gptr.Of("baseline")}
+	targetFields := map[string]*entity.Content{consts.OutputSchemaKey: candidate}
+	baselineRecord := &entity.EvalTargetRecord{
+		EvalTargetOutputData: &entity.EvalTargetOutputData{
+			OutputFields: map[string]*entity.Content{
+				consts.OutputSchemaKey: baseline,
+				"json":                 {Text: gptr.Of(`{"answer": "from json"}`), ContentType: gptr.Of(entity.ContentTypeText)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		ec      *entity.EvaluatorConf
+		prepare func()
+		check   func(t *testing.T, fields map[string]*entity.Content)
+		wantErr bool
+	}{
+		{
+			name: "默认映射基准实验的 actual_output",
+			ec:   &entity.EvaluatorConf{BaselineExptID: 10, IngressConf: &entity.EvaluatorIngressConf{}},
+			prepare: func() {
+				mockTurnResultRepo.EXPECT().BatchGet(gomock.Any(), int64(1), int64(10), []int64{2}).Return([]*entity.ExptTurnResult{
+					{TurnID: 4, TargetResultID: 99},
+					{TurnID: 3, TargetResultID: 100},
+				}, nil)
+				mockEvalTargetService.EXPECT().GetRecordByID(gomock.Any(), int64(1), int64(100)).Return(baselineRecord, nil)
+			},
+			check: func(t *testing.T, fields map[string]*entity.Content) {
+				assert.Equal(t, baseline, fields[entity.PairwiseEvaluatorOutputAKey])
+				assert.Equal(t, candidate, fields[entity.PairwiseEvaluatorOutputBKey])
+			},
+		},
+		{
+			name: "按配置映射基准实验输出",
+			ec: &entity.EvaluatorConf{BaselineExptID: 10, IngressConf: &entity.EvaluatorIngressConf{
+				BaselineTargetAdapter: &entity.FieldAdapter{FieldConfs: []*entity.FieldConf{
+					{FieldName: entity.PairwiseEvaluatorOutputAKey, FromField: "json.answer"},
+				}},
+			}},
+			prepare: func() {
+				mockTurnResultRepo.EXPECT().BatchGet(gomock.Any(), int64(1), int64(10), []int64{2}).Return([]*entity.ExptTurnResult{
+					{TurnID: 3, TargetResultID: 100},
+				}, nil)
+				mockEvalTargetService.EXPECT().GetRecordByID(gomock.Any(), int64(1), int64(100)).Return(baselineRecord, nil)
+			},
+			check: func(t *testing.T, fields map[string]*entity.Content) {
+				assert.Equal(t, "from json", gptr.Indirect(fields[entity.PairwiseEvaluatorOutputAKey].Text))
+			},
+		},
+		{
+			name: "基准实验缺少该条数据的结果",
+			ec:   &entity.EvaluatorConf{BaselineExptID: 10, IngressConf: &entity.EvaluatorIngressConf{}},
+			prepare: func() {
+				mockTurnResultRepo.EXPECT().BatchGet(gomock.Any(), int64(1), int64(10), []int64{2}).Return(nil, nil)
+			},
+			check: func(t *testing.T, fields map[string]*entity.Content) {
+				assert.Nil(t, fields[entity.PairwiseEvaluatorOutputAKey])
+				assert.Equal(t, candidate, fields[entity.PairwiseEvaluatorOutputBKey])
+			},
+		},
+		{
+			name:    "未配置基准实验",
+			ec:      &entity.EvaluatorConf{IngressConf: &entity.EvaluatorIngressConf{}},
+			prepare: func() {},
+			wantErr: true,
+		},
+		{
+			name: "查询基准结果失败",
+			ec:   &entity.EvaluatorConf{BaselineExptID: 10, IngressConf: &entity.EvaluatorIngressConf{}},
+			prepare: func() {
+				mockTurnResultRepo.EXPECT().BatchGet(gomock.Any(), int64(1), int64(10), []int64{2}).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			fields := make(map[string]*entity.Content)
+			err := service.fillPairwiseFields(context.Background(), 1, tt.ec, 2, 3, targetFields, fields)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, fields)
+		})
+	}
+}
+
 func TestDefaultExptTurnEvaluationImpl_getContentByJsonPath(t *testing.T) {
 	s := &DefaultExptTurnEvaluationImpl{}
 
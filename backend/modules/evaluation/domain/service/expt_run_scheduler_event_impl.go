@@ -151,13 +151,13 @@ func (e *ExptSchedulerImpl) HandleEventCheck(next SchedulerEndPoint) SchedulerEn
 	}
 }
 
-func (e *ExptSchedulerImpl) makeExptRunExecLockKey(exptID, exptRunID int64) string {
+func makeExptRunExecLockKey(exptID, exptRunID int64) string {
 	return fmt.Sprintf("expt_run_exec_lock:%d:%d", exptID, exptRunID)
 }
 
 func (e *ExptSchedulerImpl) HandleEventLock(next SchedulerEndPoint) SchedulerEndPoint {
 	return func(ctx context.Context, event *entity.ExptScheduleEvent) error {
-		locked, ctx, unlock, err := e.Mutex.LockWithRenew(ctx, e.makeExptRunExecLockKey(event.ExptID, event.ExptRunID), time.Second*20, time.Second*60*5)
+		locked, ctx, unlock, err := e.Mutex.LockWithRenew(ctx, makeExptRunExecLockKey(event.ExptID, event.ExptRunID), time.Second*20, time.Second*60*5)
 		if err != nil {
 			return err
 		}
@@ -224,14 +224,17 @@ func (e *ExptSchedulerImpl) schedule(ctx context.Context, event *entity.ExptSche
 		return err
 	}
 
-	err = mode.ExptStart(ctx, event, exptDetail)
-	if err != nil {
-		return err
-	}
+	paused := exptDetail.Status == entity.ExptStatus_Paused
+	if !paused {
+		err = mode.ExptStart(ctx, event, exptDetail)
+		if err != nil {
+			return err
+		}
 
-	err = mode.ScheduleStart(ctx, event, exptDetail)
-	if err != nil {
-		return err
+		err = mode.ScheduleStart(ctx, event, exptDetail)
+		if err != nil {
+			return err
+		}
 	}
 
 	toSubmit, incomplete, complete, err := mode.ScanEvalItems(ctx, event, exptDetail)
@@ -249,6 +252,18 @@ func (e *ExptSchedulerImpl) schedule(ctx context.Context, event *entity.ExptSche
 		return err
 	}
 
+	reason, err := checkExptBudget(ctx, e.Configer, e.ExptStatsRepo, exptDetail)
+	if err != nil {
+		return err
+	}
+	if len(reason) > 0 {
+		return e.terminateByBudget(ctx, event, reason)
+	}
+
+	if paused {
+		return e.tickPaused(ctx, event, mode, len(incomplete))
+	}
+
 	err = mode.ScheduleEnd(ctx, event, exptDetail, len(toSubmit), len(incomplete))
 	if err != nil {
 		return err
@@ -268,6 +283,31 @@ func (e *ExptSchedulerImpl) schedule(ctx context.Context, event *entity.ExptSche
 	return mode.NextTick(ctx, event, nextTick)
 }
 
+// tickPaused 暂停期间不提交新数据，仅继续跟进已提交数据的执行结果，并刷新事件时间避免被判定为超时
+func (e *ExptSchedulerImpl) tickPaused(ctx context.Context, event *entity.ExptScheduleEvent, mode entity.ExptSchedulerMode, incomplete int) error {
+	logs.CtxInfo(ctx, "[ExptEval] expt paused, skip submitting items, expt_id: %v, expt_run_id: %v, incomplete: %v", event.ExptID, event.ExptRunID, incomplete)
+
+	event.CreatedAt = time.Now().Unix()
+	return mode.NextTick(ctx, event, true)
+}
+
+func (e *ExptSchedulerImpl) terminateByBudget(ctx context.Context, event *entity.ExptScheduleEvent, reason string) error {
+	logs.CtxWarn(ctx, "[ExptEval] expt exceeded budget, expt_id: %v, expt_run_id: %v, reason: %v", event.ExptID, event.ExptRunID, reason)
+
+	completeCID := fmt.Sprintf("terminate:budget:%d", event.ExptRunID)
+
+	if err := e.Manager.CompleteRun(ctx, event.ExptID, event.ExptRunID, event.ExptRunMode, event.SpaceID, event.Session, entity.WithCID(completeCID)); err != nil {
+		return errorx.Wrapf(err, "terminate expt run fail, expt_id: %v, expt_run_id: %v", event.ExptID, event.ExptRunID)
+	}
+
+	if err := e.Manager.CompleteExpt(ctx, event.ExptID, event.SpaceID, event.Session, entity.WithStatus(entity.ExptStatus_SystemTerminated),
+		entity.WithStatusMessage(reason), entity.WithCID(completeCID)); err != nil {
+		return errorx.Wrapf(err, "complete expt fail, expt_id: %v, expt_run_id: %v", event.ExptID, event.ExptRunID)
+	}
+
+	return nil
+}
+
 func (e *ExptSchedulerImpl) recordEvalItemRunLogs(ctx context.Context, event *entity.ExptScheduleEvent, completeItems []*entity.ExptEvalItem, mode entity.ExptSchedulerMode) error {
 	time.Sleep(time.Millisecond * 1000) // avoid master-slave delay caused by asynchronous and other factors
 	for _, item := range completeItems {
@@ -180,6 +180,91 @@ func TestExptSchedulerImpl_Schedule(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "超出预算-系统终止实验",
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: testUserID}),
+				event: &entity.ExptScheduleEvent{
+					ExptID:      1,
+					ExptRunID:   2,
+					SpaceID:     3,
+					ExptRunMode: 1,
+					Session:     &entity.Session{UserID: testUserID},
+				},
+			},
+			prepareMock: func(f *fields, ctrl *gomock.Controller, args args) {
+				f.manager.EXPECT().GetDetail(gomock.Any(), int64(1), int64(3), args.event.Session).Return(mockExpt, nil).Times(1)
+				f.manager.EXPECT().GetRunLog(gomock.Any(), int64(1), int64(2), int64(3), args.event.Session).Return(&entity.ExptRunLog{}, nil).Times(1)
+				f.mutex.EXPECT().LockWithRenew(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, args.ctx, func() {}, nil).Times(1)
+				f.configer.EXPECT().GetExptExecConf(gomock.Any(), int64(3)).Return(&entity.ExptExecConf{
+					ZombieIntervalSecond: math.MaxInt,
+					ExptBudget:           &entity.ExptBudget{MaxTokens: ptr.Of(int64(100))},
+				}).AnyTimes()
+				f.configer.EXPECT().GetConsumerConf(gomock.Any()).Return(&entity.ExptConsumerConf{}).AnyTimes()
+				f.resultSvc.EXPECT().UpsertExptTurnResultFilter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				f.exptStatsRepo.EXPECT().Get(gomock.Any(), int64(1), int64(3)).Return(&entity.ExptStats{InputTokenCost: 60, OutputTokenCost: 50}, nil).Times(1)
+				f.manager.EXPECT().CompleteRun(gomock.Any(), int64(1), int64(2), gomock.Any(), int64(3), args.event.Session, gomock.Any()).Return(nil).Times(1)
+				f.manager.EXPECT().CompleteExpt(gomock.Any(), int64(1), int64(3), args.event.Session, gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, exptID, spaceID int64, session *entity.Session, opts ...entity.CompleteExptOptionFn) error {
+						opt := &entity.CompleteExptOption{}
+						for _, fn := range opts {
+							fn(opt)
+						}
+						assert.Equal(t, entity.ExptStatus_SystemTerminated, opt.Status)
+						assert.Contains(t, opt.StatusMessage, "token usage 110 reached budget 100")
+						return nil
+					}).Times(1)
+
+				mode := entitymocks.NewMockExptSchedulerMode(ctrl)
+				mode.EXPECT().ExptStart(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mode.EXPECT().ScheduleStart(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mode.EXPECT().ScanEvalItems(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.ExptEvalItem{}, []*entity.ExptEvalItem{}, []*entity.ExptEvalItem{}, nil).Times(1)
+				f.schedulerModeFactory.EXPECT().NewSchedulerMode(gomock.Any()).Return(mode, nil).Times(1)
+			},
+			wantErr: false,
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "实验暂停-不提交新数据并继续轮询",
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: testUserID}),
+				event: &entity.ExptScheduleEvent{
+					ExptID:      1,
+					ExptRunID:   2,
+					SpaceID:     3,
+					ExptRunMode: 1,
+					CreatedAt:   time.Now().Add(-time.Hour).Unix(),
+					Session:     &entity.Session{UserID: testUserID},
+				},
+			},
+			prepareMock: func(f *fields, ctrl *gomock.Controller, args args) {
+				pausedExpt := *mockExpt
+				pausedExpt.Status = entity.ExptStatus_Paused
+				f.manager.EXPECT().GetDetail(gomock.Any(), int64(1), int64(3), args.event.Session).Return(&pausedExpt, nil).Times(1)
+				f.manager.EXPECT().GetRunLog(gomock.Any(), int64(1), int64(2), int64(3), args.event.Session).Return(&entity.ExptRunLog{}, nil).Times(1)
+				f.mutex.EXPECT().LockWithRenew(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, args.ctx, func() {}, nil).Times(1)
+				f.configer.EXPECT().GetExptExecConf(gomock.Any(), int64(3)).Return(&entity.ExptExecConf{ZombieIntervalSecond: math.MaxInt}).AnyTimes()
+				f.configer.EXPECT().GetConsumerConf(gomock.Any()).Return(&entity.ExptConsumerConf{}).AnyTimes()
+
+				mode := entitymocks.NewMockExptSchedulerMode(ctrl)
+				mode.EXPECT().ScanEvalItems(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					[]*entity.ExptEvalItem{{ItemID: 10, State: entity.ItemRunState_Queueing}},
+					[]*entity.ExptEvalItem{{ItemID: 11, State: entity.ItemRunState_Processing, UpdatedAt: ptr.Of(time.Now())}},
+					[]*entity.ExptEvalItem{}, nil).Times(1)
+				mode.EXPECT().NextTick(gomock.Any(), gomock.Any(), true).DoAndReturn(
+					func(ctx context.Context, event *entity.ExptScheduleEvent, nextTick bool) error {
+						assert.InDelta(t, time.Now().Unix(), event.CreatedAt, 5)
+						return nil
+					}).Times(1)
+				f.schedulerModeFactory.EXPECT().NewSchedulerMode(gomock.Any()).Return(mode, nil).Times(1)
+			},
+			wantErr: false,
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
 	}
 
 	for _, tt := range tests {
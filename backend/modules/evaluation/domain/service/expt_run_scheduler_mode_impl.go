@@ -251,9 +251,10 @@ func (e *ExptSubmitExec) ExptStart(ctx context.Context, event *entity.ExptSchedu
 	}
 
 	exptDo := &entity.Experiment{
-		Status:  entity.ExptStatus_Processing,
-		ID:      event.ExptID,
-		SpaceID: event.SpaceID,
+		Status:      entity.ExptStatus_Processing,
+		ID:          event.ExptID,
+		SpaceID:     event.SpaceID,
+		LatestRunID: event.ExptRunID,
 	}
 
 	if err := e.exptRepo.Update(ctx, exptDo); err != nil {
@@ -482,9 +483,10 @@ func (e *ExptFailRetryExec) ExptStart(ctx context.Context, event *entity.ExptSch
 	logs.CtxInfo(ctx, "ExptFailRetryExec.ExptStart reset pending_cnt: %v, expt_id: %v", pendingCnt, event.ExptID)
 
 	exptDo := &entity.Experiment{
-		Status:  entity.ExptStatus_Processing,
-		ID:      event.ExptID,
-		SpaceID: event.SpaceID,
+		Status:      entity.ExptStatus_Processing,
+		ID:          event.ExptID,
+		SpaceID:     event.SpaceID,
+		LatestRunID: event.ExptRunID,
 	}
 
 	if err := e.exptRepo.Update(ctx, exptDo); err != nil {
@@ -0,0 +1,25 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate  mockgen -destination  ./mocks/expt_schedule.go  --package mocks . IExptScheduleService
+type IExptScheduleService interface {
+	Create(ctx context.Context, schedule *entity.ExptSchedule, session *entity.Session) error
+	Update(ctx context.Context, schedule *entity.ExptSchedule, session *entity.Session) error
+	Delete(ctx context.Context, id, spaceID int64, session *entity.Session) error
+	Get(ctx context.Context, id, spaceID int64) (*entity.ExptSchedule, error)
+	List(ctx context.Context, spaceID int64, filter *entity.ExptScheduleListFilter, page entity.Page) ([]*entity.ExptSchedule, int64, error)
+	// ListRuns 按触发时间倒序返回定时实验的历史触发记录
+	ListRuns(ctx context.Context, spaceID, scheduleID int64, page entity.Page) ([]*entity.ExptScheduleRun, int64, error)
+
+	// TriggerDue 以模板实验为蓝本创建并运行所有到期定时实验的新实验, 多实例部署时同一次触发只会被一个实例执行
+	TriggerDue(ctx context.Context, now time.Time) error
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	exptScheduleTriggerBatchSize = 100
+	exptScheduleNameTimeLayout   = "20060102_1504"
+)
+
+type ExptScheduleServiceImpl struct {
+	scheduleRepo                repo.IExptScheduleRepo
+	manager                     IExptManager
+	evalTargetService           IEvalTargetService
+	evaluationSetVersionService EvaluationSetVersionService
+	promptRPCAdapter            rpc.IPromptRPCAdapter
+	idgen                       idgen.IIDGenerator
+}
+
+func NewExptScheduleService(
+	scheduleRepo repo.IExptScheduleRepo,
+	manager IExptManager,
+	evalTargetService IEvalTargetService,
+	evaluationSetVersionService EvaluationSetVersionService,
+	promptRPCAdapter rpc.IPromptRPCAdapter,
+	idgen idgen.IIDGenerator,
+) IExptScheduleService {
+	return &ExptScheduleServiceImpl{
+		scheduleRepo:                scheduleRepo,
+		manager:                     manager,
+		evalTargetService:           evalTargetService,
+		evaluationSetVersionService: evaluationSetVersionService,
+		promptRPCAdapter:            promptRPCAdapter,
+		idgen:                       idgen,
+	}
+}
+
+func (e *ExptScheduleServiceImpl) Create(ctx context.Context, schedule *entity.ExptSchedule, session *entity.Session) error {
+	if err := e.validate(ctx, schedule, session); err != nil {
+		return err
+	}
+	schedule.CreatedBy = session.UserID
+	schedule.UpdatedBy = session.UserID
+	return e.scheduleRepo.Create(ctx, schedule)
+}
+
+func (e *ExptScheduleServiceImpl) Update(ctx context.Context, schedule *entity.ExptSchedule, session *entity.Session) error {
+	if err := e.validate(ctx, schedule, session); err != nil {
+		return err
+	}
+	schedule.UpdatedBy = session.UserID
+	return e.scheduleRepo.Update(ctx, schedule)
+}
+
+func (e *ExptScheduleServiceImpl) Delete(ctx context.Context, id, spaceID int64, session *entity.Session) error {
+	logs.CtxInfo(ctx, "delete expt schedule, schedule_id: %v, user: %v", id, session.UserID)
+	return e.scheduleRepo.Delete(ctx, id, spaceID)
+}
+
+func (e *ExptScheduleServiceImpl) Get(ctx context.Context, id, spaceID int64) (*entity.ExptSchedule, error) {
+	return e.scheduleRepo.Get(ctx, id, spaceID)
+}
+
+func (e *ExptScheduleServiceImpl) List(ctx context.Context, spaceID int64, filter *entity.ExptScheduleListFilter, page entity.Page) ([]*entity.ExptSchedule, int64, error) {
+	return e.scheduleRepo.List(ctx, spaceID, filter, page)
+}
+
+func (e *ExptScheduleServiceImpl) ListRuns(ctx context.Context, spaceID, scheduleID int64, page entity.Page) ([]*entity.ExptScheduleRun, int64, error) {
+	return e.scheduleRepo.ListRuns(ctx, spaceID, scheduleID, page)
+}
+
+// validate 校验 cron 表达式与模板实验, 并按启用状态重新计算下次触发时间
+func (e *ExptScheduleServiceImpl) validate(ctx context.Context, schedule *entity.ExptSchedule, session *entity.Session) error {
+	if schedule.Name == "" {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("schedule name is empty"))
+	}
+	next, err := schedule.NextTriggerTime(time.Now())
+	if err != nil {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+	tmpl, err := e.manager.Get(ctx, schedule.TemplateExptID, schedule.SpaceID, session)
+	if err != nil {
+		return err
+	}
+	if tmpl.SpaceID != schedule.SpaceID {
+		return errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("experiment %d not found", schedule.TemplateExptID)))
+	}
+	if tmpl.ExptType == entity.ExptType_Online {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("online experiment cannot be used as schedule template"))
+	}
+	schedule.NextTriggerAt = nil
+	if schedule.Enabled {
+		schedule.NextTriggerAt = gptr.Of(next)
+	}
+	return nil
+}
+
+func (e *ExptScheduleServiceImpl) TriggerDue(ctx context.Context, now time.Time) error {
+	schedules, err := e.scheduleRepo.ListDue(ctx, now, exptScheduleTriggerBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, schedule := range schedules {
+		next, err := schedule.NextTriggerTime(now)
+		if err != nil {
+			logs.CtxError(ctx, "expt schedule %d compute next trigger time fail, err=%v", schedule.ID, err)
+			continue
+		}
+		claimed, err := e.scheduleRepo.UpdateTriggerTime(ctx, schedule.ID, gptr.Indirect(schedule.NextTriggerAt), next, now)
+		if err != nil {
+			logs.CtxError(ctx, "expt schedule %d update trigger time fail, err=%v", schedule.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		e.trigger(ctx, schedule, now)
+	}
+	return nil
+}
+
+// trigger 创建并运行一次实验, 无论成功与否都记录触发历史, 单个定时实验失败不影响其他定时实验
+func (e *ExptScheduleServiceImpl) trigger(ctx context.Context, schedule *entity.ExptSchedule, now time.Time) {
+	run := &entity.ExptScheduleRun{
+		SpaceID:    schedule.SpaceID,
+		ScheduleID: schedule.ID,
+		TriggerAt:  now,
+		Status:     entity.ExptScheduleRunStatus_Success,
+	}
+	exptID, runID, err := e.submit(ctx, schedule, now)
+	run.ExptID, run.RunID = exptID, runID
+	if err != nil {
+		logs.CtxError(ctx, "expt schedule %d trigger fail, err=%v", schedule.ID, err)
+		run.Status = entity.ExptScheduleRunStatus_Failed
+		run.ErrMsg = errorx.ErrorWithoutStack(err)
+	} else {
+		logs.CtxInfo(ctx, "expt schedule %d triggered, expt_id: %v, run_id: %v", schedule.ID, exptID, runID)
+	}
+	if err := e.scheduleRepo.CreateRun(ctx, run); err != nil {
+		logs.CtxError(ctx, "expt schedule %d create run record fail, err=%v", schedule.ID, err)
+	}
+}
+
+func (e *ExptScheduleServiceImpl) submit(ctx context.Context, schedule *entity.ExptSchedule, now time.Time) (exptID, runID int64, err error) {
+	// 以最后修改人身份运行, 修改人已在更新时通过模板实验的编辑鉴权
+	runAs := schedule.RunAsUserID()
+	ctx = session.WithCtxUser(ctx, &session.User{ID: runAs})
+	sess := &entity.Session{UserID: runAs}
+
+	tmpl, err := e.manager.Get(ctx, schedule.TemplateExptID, schedule.SpaceID, sess)
+	if err != nil {
+		return 0, 0, err
+	}
+	param, err := e.buildCreateExptParam(ctx, schedule, tmpl, now)
+	if err != nil {
+		return 0, 0, err
+	}
+	expt, err := e.manager.CreateExpt(ctx, param, sess)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	runID, err = e.idgen.GenID(ctx)
+	if err != nil {
+		return expt.ID, 0, err
+	}
+	if err := e.manager.LogRun(ctx, expt.ID, runID, entity.EvaluationModeSubmit, schedule.SpaceID, sess); err != nil {
+		return expt.ID, 0, err
+	}
+	if err := e.manager.Run(ctx, expt.ID, runID, schedule.SpaceID, sess, entity.EvaluationModeSubmit, nil); err != nil {
+		return expt.ID, runID, err
+	}
+	return expt.ID, runID, nil
+}
+
+func (e *ExptScheduleServiceImpl) buildCreateExptParam(ctx context.Context, schedule *entity.ExptSchedule, tmpl *entity.Experiment, now time.Time) (*entity.CreateExptParam, error) {
+	evaluatorVersionIDs := make([]int64, 0, len(tmpl.EvaluatorVersionRef))
+	for _, ref := range tmpl.EvaluatorVersionRef {
+		evaluatorVersionIDs = append(evaluatorVersionIDs, ref.EvaluatorVersionID)
+	}
+	param := &entity.CreateExptParam{
+		WorkspaceID:         schedule.SpaceID,
+		EvalSetID:           tmpl.EvalSetID,
+		EvalSetVersionID:    tmpl.EvalSetVersionID,
+		EvaluatorVersionIds: evaluatorVersionIDs,
+		Name:                fmt.Sprintf("%s_%s", schedule.Name, now.Format(exptScheduleNameTimeLayout)),
+		Desc:                schedule.Description,
+		ExptType:            tmpl.ExptType,
+		MaxAliveTime:        tmpl.MaxAliveTime,
+		SourceType:          tmpl.SourceType,
+		SourceID:            tmpl.SourceID,
+		ExptConf:            tmpl.EvalConf,
+	}
+
+	if schedule.UseLatestEvalSetVersion {
+		versions, _, _, err := e.evaluationSetVersionService.ListEvaluationSetVersions(ctx, &entity.ListEvaluationSetVersionsParam{
+			SpaceID:         schedule.SpaceID,
+			EvaluationSetID: tmpl.EvalSetID,
+			PageSize:        gptr.Of(int32(1)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(versions) > 0 {
+			param.EvalSetVersionID = versions[0].ID
+		}
+	}
+
+	if tmpl.TargetVersionID == 0 {
+		return param, nil
+	}
+	target, err := e.evalTargetService.GetEvalTargetVersion(ctx, schedule.SpaceID, tmpl.TargetVersionID, false)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil || target.EvalTargetVersion == nil {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("eval target version %d not found", tmpl.TargetVersionID)))
+	}
+	version := target.EvalTargetVersion
+	targetParam := &entity.CreateEvalTargetParam{
+		SourceTargetID:      gptr.Of(target.SourceTargetID),
+		SourceTargetVersion: gptr.Of(version.SourceTargetVersion),
+		EvalTargetType:      gptr.Of(target.EvalTargetType),
+		HTTPTarget:          version.HTTPTarget,
+	}
+	if version.CozeBot != nil {
+		targetParam.BotInfoType = gptr.Of(version.CozeBot.BotInfoType)
+		targetParam.BotPublishVersion = version.CozeBot.PublishVersion
+	}
+	if version.Prompt != nil && version.Prompt.Label != "" {
+		targetParam.PromptLabel = gptr.Of(version.Prompt.Label)
+	}
+	if schedule.UseLatestTargetVersion && target.EvalTargetType == entity.EvalTargetTypeLoopPrompt && targetParam.PromptLabel == nil {
+		latest, err := e.latestPromptVersion(ctx, schedule.SpaceID, target.SourceTargetID)
+		if err != nil {
+			return nil, err
+		}
+		if latest != "" {
+			targetParam.SourceTargetVersion = gptr.Of(latest)
+		}
+	}
+	param.CreateEvalTargetParam = targetParam
+	return param, nil
+}
+
+func (e *ExptScheduleServiceImpl) latestPromptVersion(ctx context.Context, spaceID int64, sourceTargetID string) (string, error) {
+	promptID, err := strconv.ParseInt(sourceTargetID, 10, 64)
+	if err != nil {
+		return "", errorx.Wrapf(err, "invalid prompt id %s", sourceTargetID)
+	}
+	prompt, err := e.promptRPCAdapter.GetPrompt(ctx, spaceID, promptID, rpc.GetPromptParams{})
+	if err != nil {
+		return "", err
+	}
+	if prompt == nil || prompt.PromptBasic == nil {
+		return "", nil
+	}
+	return gptr.Indirect(prompt.PromptBasic.LatestVersion), nil
+}
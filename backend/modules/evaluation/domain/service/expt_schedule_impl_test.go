@@ -0,0 +1,210 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	ctxsession "github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+type exptScheduleTestDeps struct {
+	repo       *repomocks.MockIExptScheduleRepo
+	manager    *svcmocks.MockIExptManager
+	target     *svcmocks.MockIEvalTargetService
+	setVersion *svcmocks.MockEvaluationSetVersionService
+	prompt     *rpcmocks.MockIPromptRPCAdapter
+	idgen      *idgenmocks.MockIIDGenerator
+}
+
+func newExptScheduleTestService(ctrl *gomock.Controller) (IExptScheduleService, *exptScheduleTestDeps) {
+	deps := &exptScheduleTestDeps{
+		repo:       repomocks.NewMockIExptScheduleRepo(ctrl),
+		manager:    svcmocks.NewMockIExptManager(ctrl),
+		target:     svcmocks.NewMockIEvalTargetService(ctrl),
+		setVersion: svcmocks.NewMockEvaluationSetVersionService(ctrl),
+		prompt:     rpcmocks.NewMockIPromptRPCAdapter(ctrl),
+		idgen:      idgenmocks.NewMockIIDGenerator(ctrl),
+	}
+	return NewExptScheduleService(deps.repo, deps.manager, deps.target, deps.setVersion, deps.prompt, deps.idgen), deps
+}
+
+func TestExptScheduleServiceImpl_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, deps := newExptScheduleTestService(ctrl)
+	ctx := context.Background()
+	session := &entity.Session{UserID: "u1"}
+
+	t.Run("启用时计算下次触发时间", func(t *testing.T) {
+		schedule := &entity.ExptSchedule{SpaceID: 1, Name: "nightly", TemplateExptID: 10, CronExpr: "0 2 * * *", Enabled: true}
+		deps.manager.EXPECT().Get(gomock.Any(), int64(10), int64(1), session).Return(&entity.Experiment{ID: 10, SpaceID: 1}, nil)
+		deps.repo.EXPECT().Create(gomock.Any(), schedule).Return(nil)
+
+		assert.NoError(t, svc.Create(ctx, schedule, session))
+		assert.Equal(t, "u1", schedule.CreatedBy)
+		assert.Equal(t, "u1", schedule.UpdatedBy)
+		if assert.NotNil(t, schedule.NextTriggerAt) {
+			assert.True(t, schedule.NextTriggerAt.After(time.Now()))
+			assert.Equal(t, 2, schedule.NextTriggerAt.Hour())
+			assert.Equal(t, 0, schedule.NextTriggerAt.Minute())
+		}
+	})
+
+	t.Run("未启用时不设置触发时间", func(t *testing.T) {
+		schedule := &entity.ExptSchedule{SpaceID: 1, Name: "nightly", TemplateExptID: 10, CronExpr: "@daily"}
+		deps.manager.EXPECT().Get(gomock.Any(), int64(10), int64(1), session).Return(&entity.Experiment{ID: 10, SpaceID: 1}, nil)
+		deps.repo.EXPECT().Create(gomock.Any(), schedule).Return(nil)
+
+		assert.NoError(t, svc.Create(ctx, schedule, session))
+		assert.Nil(t, schedule.NextTriggerAt)
+	})
+
+	t.Run("非法 cron 表达式", func(t *testing.T) {
+		err := svc.Create(ctx, &entity.ExptSchedule{SpaceID: 1, Name: "nightly", TemplateExptID: 10, CronExpr: "every night"}, session)
+		statusErr, ok := errorx.FromStatusError(err)
+		assert.True(t, ok)
+		assert.Equal(t, int32(errno.CommonInvalidParamCode), statusErr.Code())
+	})
+
+	t.Run("非法时区", func(t *testing.T) {
+		err := svc.Create(ctx, &entity.ExptSchedule{SpaceID: 1, Name: "nightly", TemplateExptID: 10, CronExpr: "@daily", Timezone: "Mars/Base"}, session)
+		assert.Error(t, err)
+	})
+
+	t.Run("在线实验不能作为模板", func(t *testing.T) {
+		deps.manager.EXPECT().Get(gomock.Any(), int64(11), int64(1), session).Return(&entity.Experiment{ID: 11, SpaceID: 1, ExptType: entity.ExptType_Online}, nil)
+		err := svc.Create(ctx, &entity.ExptSchedule{SpaceID: 1, Name: "nightly", TemplateExptID: 11, CronExpr: "@daily"}, session)
+		assert.Error(t, err)
+	})
+
+	t.Run("模板实验不在当前空间", func(t *testing.T) {
+		deps.manager.EXPECT().Get(gomock.Any(), int64(12), int64(1), session).Return(&entity.Experiment{ID: 12, SpaceID: 2}, nil)
+		err := svc.Create(ctx, &entity.ExptSchedule{SpaceID: 1, Name: "nightly", TemplateExptID: 12, CronExpr: "@daily"}, session)
+		statusErr, ok := errorx.FromStatusError(err)
+		assert.True(t, ok)
+		assert.Equal(t, int32(errno.ResourceNotFoundCode), statusErr.Code())
+	})
+}
+
+func TestExptScheduleServiceImpl_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, deps := newExptScheduleTestService(ctrl)
+	session := &entity.Session{UserID: "u2"}
+
+	schedule := &entity.ExptSchedule{ID: 1, SpaceID: 1, Name: "nightly", TemplateExptID: 10, CronExpr: "@daily", Enabled: true, CreatedBy: "u1", UpdatedBy: "u1"}
+	deps.manager.EXPECT().Get(gomock.Any(), int64(10), int64(1), session).Return(&entity.Experiment{ID: 10, SpaceID: 1}, nil)
+	deps.repo.EXPECT().Update(gomock.Any(), schedule).Return(nil)
+
+	assert.NoError(t, svc.Update(context.Background(), schedule, session))
+	assert.Equal(t, "u1", schedule.CreatedBy)
+	assert.Equal(t, "u2", schedule.UpdatedBy)
+	assert.Equal(t, "u2", schedule.RunAsUserID())
+}
+
+func TestExptScheduleServiceImpl_TriggerDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, deps := newExptScheduleTestService(ctrl)
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 2, 0, 30, 0, time.UTC)
+	due := now.Add(-30 * time.Second)
+
+	t.Run("以最后修改人身份使用最新版本创建并运行实验", func(t *testing.T) {
+		schedule := &entity.ExptSchedule{
+			ID: 1, SpaceID: 100, Name: "nightly", TemplateExptID: 10, CronExpr: "0 2 * * *", Enabled: true,
+			UseLatestTargetVersion: true, UseLatestEvalSetVersion: true, NextTriggerAt: gptr.Of(due), CreatedBy: "u1", UpdatedBy: "u2",
+		}
+		tmpl := &entity.Experiment{
+			ID: 10, SpaceID: 100, EvalSetID: 20, EvalSetVersionID: 21, TargetVersionID: 31,
+			EvaluatorVersionRef: []*entity.ExptEvaluatorVersionRef{{EvaluatorID: 1, EvaluatorVersionID: 41}},
+			ExptType:            entity.ExptType_Offline,
+			EvalConf:            &entity.EvaluationConfiguration{ItemConcurNum: gptr.Of(3)},
+		}
+		deps.repo.EXPECT().ListDue(gomock.Any(), now, exptScheduleTriggerBatchSize).Return([]*entity.ExptSchedule{schedule}, nil)
+		deps.repo.EXPECT().UpdateTriggerTime(gomock.Any(), int64(1), due, time.Date(2025, 6, 2, 2, 0, 0, 0, time.UTC), now).Return(true, nil)
+		deps.manager.EXPECT().Get(gomock.Any(), int64(10), int64(100), &entity.Session{UserID: "u2"}).Return(tmpl, nil)
+		deps.setVersion.EXPECT().ListEvaluationSetVersions(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *entity.ListEvaluationSetVersionsParam) ([]*entity.EvaluationSetVersion, *int64, *string, error) {
+				assert.Equal(t, int64(20), param.EvaluationSetID)
+				return []*entity.EvaluationSetVersion{{ID: 22}}, nil, nil, nil
+			})
+		deps.target.EXPECT().GetEvalTargetVersion(gomock.Any(), int64(100), int64(31), false).Return(&entity.EvalTarget{
+			SourceTargetID: "500",
+			EvalTargetType: entity.EvalTargetTypeLoopPrompt,
+			EvalTargetVersion: &entity.EvalTargetVersion{
+				ID:                  31,
+				SourceTargetVersion: "0.0.1",
+			},
+		}, nil)
+		deps.prompt.EXPECT().GetPrompt(gomock.Any(), int64(100), int64(500), rpc.GetPromptParams{}).Return(&rpc.LoopPrompt{
+			ID:          500,
+			PromptBasic: &rpc.PromptBasic{LatestVersion: gptr.Of("0.0.3")},
+		}, nil)
+		deps.manager.EXPECT().CreateExpt(gomock.Any(), gomock.Any(), &entity.Session{UserID: "u2"}).DoAndReturn(
+			func(ctx context.Context, param *entity.CreateExptParam, session *entity.Session) (*entity.Experiment, error) {
+				assert.Equal(t, "u2", ctxsession.UserIDInCtxOrEmpty(ctx))
+				assert.Equal(t, int64(22), param.EvalSetVersionID)
+				assert.Equal(t, []int64{41}, param.EvaluatorVersionIds)
+				assert.Equal(t, "nightly_20250601_0200", param.Name)
+				assert.Equal(t, "500", gptr.Indirect(param.CreateEvalTargetParam.SourceTargetID))
+				assert.Equal(t, "0.0.3", gptr.Indirect(param.CreateEvalTargetParam.SourceTargetVersion))
+				assert.Equal(t, tmpl.EvalConf, param.ExptConf)
+				return &entity.Experiment{ID: 50}, nil
+			})
+		deps.idgen.EXPECT().GenID(gomock.Any()).Return(int64(60), nil)
+		deps.manager.EXPECT().LogRun(gomock.Any(), int64(50), int64(60), entity.EvaluationModeSubmit, int64(100), gomock.Any()).Return(nil)
+		deps.manager.EXPECT().Run(gomock.Any(), int64(50), int64(60), int64(100), gomock.Any(), entity.EvaluationModeSubmit, gomock.Nil()).Return(nil)
+		deps.repo.EXPECT().CreateRun(gomock.Any(), &entity.ExptScheduleRun{
+			SpaceID: 100, ScheduleID: 1, ExptID: 50, RunID: 60, Status: entity.ExptScheduleRunStatus_Success, TriggerAt: now,
+		}).Return(nil)
+
+		assert.NoError(t, svc.TriggerDue(ctx, now))
+	})
+
+	t.Run("其他实例已抢占时跳过", func(t *testing.T) {
+		schedule := &entity.ExptSchedule{ID: 2, SpaceID: 100, CronExpr: "0 2 * * *", Enabled: true, NextTriggerAt: gptr.Of(due)}
+		deps.repo.EXPECT().ListDue(gomock.Any(), now, exptScheduleTriggerBatchSize).Return([]*entity.ExptSchedule{schedule}, nil)
+		deps.repo.EXPECT().UpdateTriggerTime(gomock.Any(), int64(2), due, gomock.Any(), now).Return(false, nil)
+
+		assert.NoError(t, svc.TriggerDue(ctx, now))
+	})
+
+	t.Run("创建失败时记录失败的触发", func(t *testing.T) {
+		schedule := &entity.ExptSchedule{ID: 3, SpaceID: 100, Name: "nightly", TemplateExptID: 10, CronExpr: "0 2 * * *", Enabled: true, NextTriggerAt: gptr.Of(due)}
+		deps.repo.EXPECT().ListDue(gomock.Any(), now, exptScheduleTriggerBatchSize).Return([]*entity.ExptSchedule{schedule}, nil)
+		deps.repo.EXPECT().UpdateTriggerTime(gomock.Any(), int64(3), due, gomock.Any(), now).Return(true, nil)
+		deps.manager.EXPECT().Get(gomock.Any(), int64(10), int64(100), gomock.Any()).Return(&entity.Experiment{ID: 10, SpaceID: 100, EvalSetID: 20, EvalSetVersionID: 21}, nil)
+		deps.manager.EXPECT().CreateExpt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("quota exceeded"))
+		deps.repo.EXPECT().CreateRun(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, run *entity.ExptScheduleRun) error {
+			assert.Equal(t, entity.ExptScheduleRunStatus_Failed, run.Status)
+			assert.Contains(t, run.ErrMsg, "quota exceeded")
+			assert.Equal(t, int64(0), run.ExptID)
+			return nil
+		})
+
+		assert.NoError(t, svc.TriggerDue(ctx, now))
+	})
+
+	t.Run("查询到期定时实验失败", func(t *testing.T) {
+		deps.repo.EXPECT().ListDue(gomock.Any(), now, exptScheduleTriggerBatchSize).Return(nil, errors.New("db error"))
+		assert.Error(t, svc.TriggerDue(ctx, now))
+	})
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+)
+
+// fakeCozeBotReplyFunc 根据对话参数生成智能体回复分片
+type fakeCozeBotReplyFunc func(param *rpc.ChatCozeBotParam) ([]*rpc.CozeStreamChunk, error)
+
+// fakeCozeWorkflowReplyFunc 根据运行参数生成工作流输出分片
+type fakeCozeWorkflowReplyFunc func(param *rpc.RunCozeWorkflowParam) ([]*rpc.CozeStreamChunk, error)
+
+// fakeCozeRPCAdapter 基于内存数据的 Coze 适配器，同一个 ID 可以注册多个版本，后注册的视为最新版本
+type fakeCozeRPCAdapter struct {
+	mu            sync.RWMutex
+	bots          map[int64][]*rpc.CozeBotInfo
+	workflows     map[string][]*rpc.CozeWorkflowInfo
+	botReply      fakeCozeBotReplyFunc
+	workflowReply fakeCozeWorkflowReplyFunc
+}
+
+func newFakeCozeRPCAdapter() *fakeCozeRPCAdapter {
+	return &fakeCozeRPCAdapter{
+		bots:      make(map[int64][]*rpc.CozeBotInfo),
+		workflows: make(map[string][]*rpc.CozeWorkflowInfo),
+	}
+}
+
+var _ rpc.ICozeRPCAdapter = (*fakeCozeRPCAdapter)(nil)
+
+func (a *fakeCozeRPCAdapter) AddBot(bots ...*rpc.CozeBotInfo) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, bot := range bots {
+		a.bots[bot.BotID] = append(a.bots[bot.BotID], bot)
+	}
+}
+
+func (a *fakeCozeRPCAdapter) AddWorkflow(workflows ...*rpc.CozeWorkflowInfo) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, workflow := range workflows {
+		a.workflows[workflow.ID] = append(a.workflows[workflow.ID], workflow)
+	}
+}
+
+func (a *fakeCozeRPCAdapter) SetBotReply(fn fakeCozeBotReplyFunc) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.botReply = fn
+}
+
+func (a *fakeCozeRPCAdapter) SetWorkflowReply(fn fakeCozeWorkflowReplyFunc) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.workflowReply = fn
+}
+
+func (a *fakeCozeRPCAdapter) ListBot(ctx context.Context, param *rpc.ListCozeSourceParam) (bots []*rpc.CozeBotInfo, nextCursor string, hasMore bool, err error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	ids := make([]int64, 0, len(a.bots))
+	for id := range a.bots {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	all := make([]*rpc.CozeBotInfo, 0, len(ids))
+	for _, id := range ids {
+		latest := a.bots[id][len(a.bots[id])-1]
+		if param.KeyWord != nil && !fakeContainsFold(latest.Name, *param.KeyWord) {
+			continue
+		}
+		all = append(all, latest)
+	}
+	return fakePaginate(all, param.Cursor, param.PageSize)
+}
+
+func (a *fakeCozeRPCAdapter) ListBotVersion(ctx context.Context, param *rpc.ListCozeSourceVersionParam) (bots []*rpc.CozeBotInfo, nextCursor string, hasMore bool, err error) {
+	botID, err := strconv.ParseInt(param.SourceID, 10, 64)
+	if err != nil {
+		return nil, "", false, err
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return fakePaginate(a.bots[botID], param.Cursor, param.PageSize)
+}
+
+func (a *fakeCozeRPCAdapter) MGetBot(ctx context.Context, spaceID int64, queries []*rpc.MGetCozeBotQuery) (bots []*rpc.CozeBotInfo, err error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, query := range queries {
+		versions := a.bots[query.BotID]
+		if len(versions) == 0 {
+			continue
+		}
+		if gptr.Indirect(query.Version) == "" {
+			bots = append(bots, versions[len(versions)-1])
+			continue
+		}
+		for _, bot := range versions {
+			if bot.Version == *query.Version {
+				bots = append(bots, bot)
+				break
+			}
+		}
+	}
+	return bots, nil
+}
+
+func (a *fakeCozeRPCAdapter) StreamChatBot(ctx context.Context, spaceID int64, param *rpc.ChatCozeBotParam) (reader rpc.CozeStreamReader, err error) {
+	a.mu.RLock()
+	_, ok := a.bots[param.BotID]
+	reply := a.botReply
+	a.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("bot %d not found", param.BotID)
+	}
+	if reply == nil {
+		return newFakeCozeStreamReader(&rpc.CozeStreamChunk{Content: param.Query}), nil
+	}
+	chunks, err := reply(param)
+	if err != nil {
+		return nil, err
+	}
+	return newFakeCozeStreamReader(chunks...), nil
+}
+
+func (a *fakeCozeRPCAdapter) ListWorkflow(ctx context.Context, param *rpc.ListCozeSourceParam) (workflows []*rpc.CozeWorkflowInfo, nextCursor string, hasMore bool, err error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	ids := make([]string, 0, len(a.workflows))
+	for id := range a.workflows {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	all := make([]*rpc.CozeWorkflowInfo, 0, len(ids))
+	for _, id := range ids {
+		latest := a.workflows[id][len(a.workflows[id])-1]
+		if param.KeyWord != nil && !fakeContainsFold(latest.Name, *param.KeyWord) {
+			continue
+		}
+		all = append(all, latest)
+	}
+	return fakePaginate(all, param.Cursor, param.PageSize)
+}
+
+func (a *fakeCozeRPCAdapter) ListWorkflowVersion(ctx context.Context, param *rpc.ListCozeSourceVersionParam) (workflows []*rpc.CozeWorkflowInfo, nextCursor string, hasMore bool, err error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return fakePaginate(a.workflows[param.SourceID], param.Cursor, param.PageSize)
+}
+
+func (a *fakeCozeRPCAdapter) MGetWorkflow(ctx context.Context, spaceID int64, queries []*rpc.MGetCozeWorkflowQuery) (workflows []*rpc.CozeWorkflowInfo, err error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, query := range queries {
+		versions := a.workflows[query.WorkflowID]
+		if len(versions) == 0 {
+			continue
+		}
+		if gptr.Indirect(query.Version) == "" {
+			workflows = append(workflows, versions[len(versions)-1])
+			continue
+		}
+		for _, workflow := range versions {
+			if workflow.Version == *query.Version {
+				workflows = append(workflows, workflow)
+				break
+			}
+		}
+	}
+	return workflows, nil
+}
+
+func (a *fakeCozeRPCAdapter) StreamRunWorkflow(ctx context.Context, spaceID int64, param *rpc.RunCozeWorkflowParam) (reader rpc.CozeStreamReader, err error) {
+	a.mu.RLock()
+	_, ok := a.workflows[param.WorkflowID]
+	reply := a.workflowReply
+	a.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("workflow %s not found", param.WorkflowID)
+	}
+	if reply == nil {
+		return newFakeCozeStreamReader(), nil
+	}
+	chunks, err := reply(param)
+	if err != nil {
+		return nil, err
+	}
+	return newFakeCozeStreamReader(chunks...), nil
+}
+
+// fakeCozeStreamReader 依次返回给定分片的流式读取器
+type fakeCozeStreamReader struct {
+	chunks []*rpc.CozeStreamChunk
+	idx    int
+	closed bool
+}
+
+func newFakeCozeStreamReader(chunks ...*rpc.CozeStreamChunk) *fakeCozeStreamReader {
+	return &fakeCozeStreamReader{chunks: chunks}
+}
+
+func (r *fakeCozeStreamReader) Recv() (*rpc.CozeStreamChunk, error) {
+	if r.closed || r.idx >= len(r.chunks) {
+		return nil, io.EOF
+	}
+	chunk := r.chunks[r.idx]
+	r.idx++
+	return chunk, nil
+}
+
+func (r *fakeCozeStreamReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+func (r *fakeCozeStreamReader) Closed() bool {
+	return r.closed
+}
+
+// fakePaginate 游标为下一页起始下标
+func fakePaginate[T any](items []T, cursor *string, pageSize int32) ([]T, string, bool, error) {
+	start := 0
+	if c := gptr.Indirect(cursor); c != "" {
+		var err error
+		start, err = strconv.Atoi(c)
+		if err != nil || start < 0 {
+			return nil, "", false, fmt.Errorf("invalid cursor %q", c)
+		}
+	}
+	if start >= len(items) {
+		return nil, "", false, nil
+	}
+	end := len(items)
+	if pageSize > 0 && start+int(pageSize) < end {
+		end = start + int(pageSize)
+	}
+	hasMore := end < len(items)
+	nextCursor := ""
+	if hasMore {
+		nextCursor = strconv.Itoa(end)
+	}
+	return items[start:end], nextCursor, hasMore, nil
+}
+
+func fakeContainsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
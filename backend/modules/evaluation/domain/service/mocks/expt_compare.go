@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service (interfaces: IExptCompareService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/expt_compare.go -package=mocks . IExptCompareService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIExptCompareService is a mock of IExptCompareService interface.
+type MockIExptCompareService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExptCompareServiceMockRecorder
+}
+
+// MockIExptCompareServiceMockRecorder is the mock recorder for MockIExptCompareService.
+type MockIExptCompareServiceMockRecorder struct {
+	mock *MockIExptCompareService
+}
+
+// NewMockIExptCompareService creates a new mock instance.
+func NewMockIExptCompareService(ctrl *gomock.Controller) *MockIExptCompareService {
+	mock := &MockIExptCompareService{ctrl: ctrl}
+	mock.recorder = &MockIExptCompareServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExptCompareService) EXPECT() *MockIExptCompareServiceMockRecorder {
+	return m.recorder
+}
+
+// CompareExperiments mocks base method.
+func (m *MockIExptCompareService) CompareExperiments(arg0 context.Context, arg1 *entity.ExptCompareParam) ([]*entity.EvaluatorCompareResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareExperiments", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.EvaluatorCompareResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareExperiments indicates an expected call of CompareExperiments.
+func (mr *MockIExptCompareServiceMockRecorder) CompareExperiments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareExperiments", reflect.TypeOf((*MockIExptCompareService)(nil).CompareExperiments), arg0, arg1)
+}
@@ -1,5 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service (interfaces: IExptResultExportService)
+//
+// Generated by this command:
+//
+//	mockgen -destination ./mocks/expt_export.go --package mocks . IExptResultExportService
+//
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -8,9 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 
-	gomock "go.uber.org/mock/gomock"
-
 	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	gomock "go.uber.org/mock/gomock"
 )
 
 // MockIExptResultExportService is a mock of IExptResultExportService interface.
@@ -36,33 +40,33 @@ func (m *MockIExptResultExportService) EXPECT() *MockIExptResultExportServiceMoc
 	return m.recorder
 }
 
-// DoExportCSV mocks base method.
-func (m *MockIExptResultExportService) DoExportCSV(arg0 context.Context, arg1, arg2, arg3 int64) error {
+// DoExport mocks base method.
+func (m *MockIExptResultExportService) DoExport(arg0 context.Context, arg1, arg2, arg3 int64, arg4 entity.ExptResultExportFormat) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DoExportCSV", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "DoExport", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DoExportCSV indicates an expected call of DoExportCSV.
-func (mr *MockIExptResultExportServiceMockRecorder) DoExportCSV(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// DoExport indicates an expected call of DoExport.
+func (mr *MockIExptResultExportServiceMockRecorder) DoExport(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoExportCSV", reflect.TypeOf((*MockIExptResultExportService)(nil).DoExportCSV), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoExport", reflect.TypeOf((*MockIExptResultExportService)(nil).DoExport), arg0, arg1, arg2, arg3, arg4)
 }
 
 // ExportCSV mocks base method.
-func (m *MockIExptResultExportService) ExportCSV(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session) (int64, error) {
+func (m *MockIExptResultExportService) ExportCSV(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session, arg4 entity.ExptResultExportFormat) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExportCSV", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "ExportCSV", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExportCSV indicates an expected call of ExportCSV.
-func (mr *MockIExptResultExportServiceMockRecorder) ExportCSV(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) ExportCSV(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSV", reflect.TypeOf((*MockIExptResultExportService)(nil).ExportCSV), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSV", reflect.TypeOf((*MockIExptResultExportService)(nil).ExportCSV), arg0, arg1, arg2, arg3, arg4)
 }
 
 // GetExptExportRecord mocks base method.
@@ -75,7 +79,7 @@ func (m *MockIExptResultExportService) GetExptExportRecord(arg0 context.Context,
 }
 
 // GetExptExportRecord indicates an expected call of GetExptExportRecord.
-func (mr *MockIExptResultExportServiceMockRecorder) GetExptExportRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) GetExptExportRecord(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptExportRecord", reflect.TypeOf((*MockIExptResultExportService)(nil).GetExptExportRecord), arg0, arg1, arg2)
 }
@@ -91,7 +95,7 @@ func (m *MockIExptResultExportService) ListExportRecord(arg0 context.Context, ar
 }
 
 // ListExportRecord indicates an expected call of ListExportRecord.
-func (mr *MockIExptResultExportServiceMockRecorder) ListExportRecord(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) ListExportRecord(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExportRecord", reflect.TypeOf((*MockIExptResultExportService)(nil).ListExportRecord), arg0, arg1, arg2, arg3)
 }
@@ -105,7 +109,7 @@ func (m *MockIExptResultExportService) UpdateExportRecord(arg0 context.Context,
 }
 
 // UpdateExportRecord indicates an expected call of UpdateExportRecord.
-func (mr *MockIExptResultExportServiceMockRecorder) UpdateExportRecord(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) UpdateExportRecord(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExportRecord", reflect.TypeOf((*MockIExptResultExportService)(nil).UpdateExportRecord), arg0, arg1)
 }
@@ -320,6 +320,20 @@ func (mr *MockIExptManagerMockRecorder) MGetDetail(arg0, arg1, arg2, arg3 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetDetail", reflect.TypeOf((*MockIExptManager)(nil).MGetDetail), arg0, arg1, arg2, arg3)
 }
 
+// Pause mocks base method.
+func (m *MockIExptManager) Pause(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockIExptManagerMockRecorder) Pause(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockIExptManager)(nil).Pause), arg0, arg1, arg2, arg3)
+}
+
 // PendExpt mocks base method.
 func (m *MockIExptManager) PendExpt(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session, arg4 ...entity.CompleteExptOptionFn) error {
 	m.ctrl.T.Helper()
@@ -353,6 +367,20 @@ func (mr *MockIExptManagerMockRecorder) PendRun(arg0, arg1, arg2, arg3, arg4 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendRun", reflect.TypeOf((*MockIExptManager)(nil).PendRun), arg0, arg1, arg2, arg3, arg4)
 }
 
+// Resume mocks base method.
+func (m *MockIExptManager) Resume(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockIExptManagerMockRecorder) Resume(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockIExptManager)(nil).Resume), arg0, arg1, arg2, arg3)
+}
+
 // RetryUnSuccess mocks base method.
 func (m *MockIExptManager) RetryUnSuccess(arg0 context.Context, arg1, arg2, arg3 int64, arg4 *entity.Session, arg5 map[string]string) error {
 	m.ctrl.T.Helper()
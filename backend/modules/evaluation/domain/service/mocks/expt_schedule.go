@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service (interfaces: IExptScheduleService)
+//
+// Generated by this command:
+//
+//	mockgen -destination ./mocks/expt_schedule.go --package mocks . IExptScheduleService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIExptScheduleService is a mock of IExptScheduleService interface.
+type MockIExptScheduleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExptScheduleServiceMockRecorder
+}
+
+// MockIExptScheduleServiceMockRecorder is the mock recorder for MockIExptScheduleService.
+type MockIExptScheduleServiceMockRecorder struct {
+	mock *MockIExptScheduleService
+}
+
+// NewMockIExptScheduleService creates a new mock instance.
+func NewMockIExptScheduleService(ctrl *gomock.Controller) *MockIExptScheduleService {
+	mock := &MockIExptScheduleService{ctrl: ctrl}
+	mock.recorder = &MockIExptScheduleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExptScheduleService) EXPECT() *MockIExptScheduleServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIExptScheduleService) Create(arg0 context.Context, arg1 *entity.ExptSchedule, arg2 *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIExptScheduleServiceMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIExptScheduleService)(nil).Create), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockIExptScheduleService) Delete(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIExptScheduleServiceMockRecorder) Delete(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIExptScheduleService)(nil).Delete), arg0, arg1, arg2, arg3)
+}
+
+// Get mocks base method.
+func (m *MockIExptScheduleService) Get(arg0 context.Context, arg1, arg2 int64) (*entity.ExptSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.ExptSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIExptScheduleServiceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIExptScheduleService)(nil).Get), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockIExptScheduleService) List(arg0 context.Context, arg1 int64, arg2 *entity.ExptScheduleListFilter, arg3 entity.Page) ([]*entity.ExptSchedule, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.ExptSchedule)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockIExptScheduleServiceMockRecorder) List(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIExptScheduleService)(nil).List), arg0, arg1, arg2, arg3)
+}
+
+// ListRuns mocks base method.
+func (m *MockIExptScheduleService) ListRuns(arg0 context.Context, arg1, arg2 int64, arg3 entity.Page) ([]*entity.ExptScheduleRun, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.ExptScheduleRun)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockIExptScheduleServiceMockRecorder) ListRuns(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockIExptScheduleService)(nil).ListRuns), arg0, arg1, arg2, arg3)
+}
+
+// TriggerDue mocks base method.
+func (m *MockIExptScheduleService) TriggerDue(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerDue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerDue indicates an expected call of TriggerDue.
+func (mr *MockIExptScheduleServiceMockRecorder) TriggerDue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerDue", reflect.TypeOf((*MockIExptScheduleService)(nil).TriggerDue), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockIExptScheduleService) Update(arg0 context.Context, arg1 *entity.ExptSchedule, arg2 *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIExptScheduleServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIExptScheduleService)(nil).Update), arg0, arg1, arg2)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// aggregateCozeStream 读取全部流式分片并拼接输出内容。token 用量是累计值，取最后一次返回的用量。
+func aggregateCozeStream(ctx context.Context, reader rpc.CozeStreamReader) (content string, usage *entity.TokenUsage, err error) {
+	if reader == nil {
+		return "", nil, errorx.NewByCode(errno.CallTargetFailCode, errorx.WithExtraMsg("stream reader is nil"))
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			logs.CtxWarn(ctx, "[CozeTarget] close stream reader fail, err=%v", closeErr)
+		}
+	}()
+
+	var sb strings.Builder
+	for {
+		if ctx.Err() != nil {
+			return "", nil, errorx.WrapByCode(ctx.Err(), errno.CallTargetFailCode)
+		}
+		chunk, err := reader.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if _, ok := errorx.FromStatusError(err); ok {
+				return "", nil, err
+			}
+			return "", nil, errorx.WrapByCode(err, errno.CallTargetFailCode)
+		}
+		if chunk == nil {
+			continue
+		}
+		sb.WriteString(chunk.Content)
+		if chunk.TokenUsage != nil {
+			usage = chunk.TokenUsage
+		}
+	}
+	return sb.String(), usage, nil
+}
+
+// cozeTargetOutput 将聚合后的输出转换为评测对象输出
+func cozeTargetOutput(outputData *entity.EvalTargetOutputData, content string, usage *entity.TokenUsage) {
+	outputData.OutputFields = map[string]*entity.Content{
+		consts.OutputSchemaKey: {
+			ContentType: gptr.Of(entity.ContentTypeText),
+			Format:      gptr.Of(entity.Markdown),
+			Text:        gptr.Of(content),
+		},
+	}
+	outputData.EvalTargetUsage = &entity.EvalTargetUsage{}
+	if usage != nil {
+		outputData.EvalTargetUsage.InputTokens = usage.InputTokens
+		outputData.EvalTargetUsage.OutputTokens = usage.OutputTokens
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+type erroredCozeStreamReader struct {
+	chunks []*rpc.CozeStreamChunk
+	err    error
+	closed bool
+}
+
+func (r *erroredCozeStreamReader) Recv() (*rpc.CozeStreamChunk, error) {
+	if len(r.chunks) == 0 {
+		return nil, r.err
+	}
+	chunk := r.chunks[0]
+	r.chunks = r.chunks[1:]
+	return chunk, nil
+}
+
+func (r *erroredCozeStreamReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+func Test_aggregateCozeStream(t *testing.T) {
+	t.Run("aggregate content and keep last usage", func(t *testing.T) {
+		reader := newFakeCozeStreamReader(
+			&rpc.CozeStreamChunk{Content: "a", TokenUsage: &entity.TokenUsage{InputTokens: 1, OutputTokens: 1}},
+			nil,
+			&rpc.CozeStreamChunk{Content: "b"},
+			&rpc.CozeStreamChunk{Content: "c", TokenUsage: &entity.TokenUsage{InputTokens: 1, OutputTokens: 3}},
+		)
+		content, usage, err := aggregateCozeStream(context.Background(), reader)
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", content)
+		assert.Equal(t, &entity.TokenUsage{InputTokens: 1, OutputTokens: 3}, usage)
+		assert.True(t, reader.Closed())
+	})
+
+	t.Run("nil reader", func(t *testing.T) {
+		_, _, err := aggregateCozeStream(context.Background(), nil)
+		statusErr, ok := errorx.FromStatusError(err)
+		assert.True(t, ok)
+		assert.Equal(t, int32(errno.CallTargetFailCode), statusErr.Code())
+	})
+
+	t.Run("status error passes through", func(t *testing.T) {
+		reader := &erroredCozeStreamReader{err: errorx.NewByCode(errno.CozeServiceNotConfiguredCode)}
+		_, _, err := aggregateCozeStream(context.Background(), reader)
+		statusErr, ok := errorx.FromStatusError(err)
+		assert.True(t, ok)
+		assert.Equal(t, int32(errno.CozeServiceNotConfiguredCode), statusErr.Code())
+		assert.True(t, reader.closed)
+	})
+
+	t.Run("raw error wrapped", func(t *testing.T) {
+		reader := &erroredCozeStreamReader{err: errors.New("broken pipe")}
+		_, _, err := aggregateCozeStream(context.Background(), reader)
+		statusErr, ok := errorx.FromStatusError(err)
+		assert.True(t, ok)
+		assert.Equal(t, int32(errno.CallTargetFailCode), statusErr.Code())
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		reader := &erroredCozeStreamReader{err: io.EOF}
+		_, _, err := aggregateCozeStream(ctx, reader)
+		assert.Error(t, err)
+		assert.True(t, reader.closed)
+	})
+}
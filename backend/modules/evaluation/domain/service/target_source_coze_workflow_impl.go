@@ -0,0 +1,331 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// cozeWorkflowEndTypeText 结束节点返回文本
+const cozeWorkflowEndTypeText int32 = 1
+
+func NewCozeWorkflowSourceEvalTargetServiceImpl(cozeRPCAdapter rpc.ICozeRPCAdapter) ISourceEvalTargetOperateService {
+	return &CozeWorkflowSourceEvalTargetServiceImpl{
+		cozeRPCAdapter: cozeRPCAdapter,
+	}
+}
+
+type CozeWorkflowSourceEvalTargetServiceImpl struct {
+	cozeRPCAdapter rpc.ICozeRPCAdapter
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) EvalType() entity.EvalTargetType {
+	return entity.EvalTargetTypeCozeWorkflow
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) RuntimeParam() entity.IRuntimeParam {
+	return entity.NewDummyRuntimeParam()
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) ValidateInput(ctx context.Context, spaceID int64, inputSchema []*entity.ArgsSchema, input *entity.EvalTargetInputData) error {
+	return input.ValidateInputSchema(inputSchema)
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) Execute(ctx context.Context, spaceID int64, param *entity.ExecuteEvalTargetParam) (outputData *entity.EvalTargetOutputData, status entity.EvalTargetRunStatus, err error) {
+	start := time.Now()
+
+	outputData = &entity.EvalTargetOutputData{}
+	defer func() {
+		outputData.TimeConsumingMS = gptr.Of(time.Since(start).Milliseconds())
+		if err != nil {
+			outputData.EvalTargetRunError = &entity.EvalTargetRunError{}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				outputData.EvalTargetRunError.Code = statusErr.Code()
+				outputData.EvalTargetRunError.Message = statusErr.Error()
+			} else {
+				outputData.EvalTargetRunError.Code = errno.CommonInternalErrorCode
+				outputData.EvalTargetRunError.Message = err.Error()
+			}
+		}
+	}()
+
+	if param.SourceTargetID == "" {
+		return outputData, entity.EvalTargetRunStatusFail, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("workflow id is empty"))
+	}
+	runParam := &rpc.RunCozeWorkflowParam{
+		WorkflowID: param.SourceTargetID,
+		Version:    param.SourceTargetVersion,
+		Parameters: make(map[string]string),
+		UserID:     session.UserIDInCtxOrEmpty(ctx),
+	}
+	if param.Input != nil {
+		for key, content := range param.Input.InputFields {
+			if content == nil {
+				continue
+			}
+			runParam.Parameters[key] = gptr.Indirect(content.Text)
+		}
+	}
+
+	reader, err := t.cozeRPCAdapter.StreamRunWorkflow(ctx, spaceID, runParam)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	content, usage, err := aggregateCozeStream(ctx, reader)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	cozeTargetOutput(outputData, content, usage)
+
+	return outputData, entity.EvalTargetRunStatusSuccess, nil
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, spaceID int64, sourceTargetID, sourceTargetVersion string, opts ...entity.Option) (*entity.EvalTarget, error) {
+	workflows, err := t.cozeRPCAdapter.MGetWorkflow(ctx, spaceID, []*rpc.MGetCozeWorkflowQuery{
+		{WorkflowID: sourceTargetID, Version: &sourceTargetVersion},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(workflows) == 0 {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode)
+	}
+	workflow := workflows[0]
+	inputSchema := make([]*entity.ArgsSchema, 0, len(workflow.InputParams))
+	for _, p := range workflow.InputParams {
+		if p == nil {
+			continue
+		}
+		inputSchema = append(inputSchema, &entity.ArgsSchema{
+			Key:                 gptr.Of(p.Name),
+			SupportContentTypes: []entity.ContentType{entity.ContentTypeText},
+			JsonSchema:          gptr.Of(jsonSchemaOfVariableType(p.Type)),
+		})
+	}
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	do := &entity.EvalTarget{
+		SpaceID:        spaceID,
+		SourceTargetID: sourceTargetID,
+		EvalTargetType: entity.EvalTargetTypeCozeWorkflow,
+		EvalTargetVersion: &entity.EvalTargetVersion{
+			SpaceID:             spaceID,
+			SourceTargetVersion: sourceTargetVersion,
+			EvalTargetType:      entity.EvalTargetTypeCozeWorkflow,
+			CozeWorkflow: &entity.CozeWorkflow{
+				ID:      sourceTargetID,
+				Version: sourceTargetVersion,
+				EndType: cozeWorkflowEndTypeText,
+			},
+			InputSchema: inputSchema,
+			OutputSchema: []*entity.ArgsSchema{
+				{
+					Key:                 gptr.Of(consts.OutputSchemaKey),
+					SupportContentTypes: []entity.ContentType{entity.ContentTypeText},
+					JsonSchema:          gptr.Of(consts.StringJsonSchema),
+				},
+			},
+			RuntimeParamDemo: gptr.Of(entity.NewDummyRuntimeParam().GetJSONDemo()),
+			BaseInfo: &entity.BaseInfo{
+				CreatedBy: &entity.UserInfo{
+					UserID: gptr.Of(userIDInContext),
+				},
+				UpdatedBy: &entity.UserInfo{
+					UserID: gptr.Of(userIDInContext),
+				},
+			},
+		},
+		BaseInfo: &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{
+				UserID: gptr.Of(userIDInContext),
+			},
+			UpdatedBy: &entity.UserInfo{
+				UserID: gptr.Of(userIDInContext),
+			},
+		},
+	}
+	return do, nil
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) ListSource(ctx context.Context, param *entity.ListSourceParam) (targets []*entity.EvalTarget, nextCursor string, hasMore bool, err error) {
+	workflows, nextCursor, hasMore, err := t.cozeRPCAdapter.ListWorkflow(ctx, &rpc.ListCozeSourceParam{
+		SpaceID:  gptr.Indirect(param.SpaceID),
+		PageSize: gptr.Indirect(param.PageSize),
+		Cursor:   param.Cursor,
+		KeyWord:  param.KeyWord,
+	})
+	if err != nil {
+		return nil, "", false, err
+	}
+	targets = make([]*entity.EvalTarget, 0, len(workflows))
+	for _, workflow := range workflows {
+		targets = append(targets, &entity.EvalTarget{
+			SpaceID:        gptr.Indirect(param.SpaceID),
+			SourceTargetID: workflow.ID,
+			EvalTargetType: entity.EvalTargetTypeCozeWorkflow,
+			EvalTargetVersion: &entity.EvalTargetVersion{
+				SpaceID:          gptr.Indirect(param.SpaceID),
+				EvalTargetType:   entity.EvalTargetTypeCozeWorkflow,
+				CozeWorkflow:     cozeWorkflowInfo2DO(workflow),
+				RuntimeParamDemo: gptr.Of(entity.NewDummyRuntimeParam().GetJSONDemo()),
+			},
+		})
+	}
+	return targets, nextCursor, hasMore, nil
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) ListSourceVersion(ctx context.Context, param *entity.ListSourceVersionParam) (versions []*entity.EvalTargetVersion, nextCursor string, hasMore bool, err error) {
+	workflows, nextCursor, hasMore, err := t.cozeRPCAdapter.ListWorkflowVersion(ctx, &rpc.ListCozeSourceVersionParam{
+		SpaceID:  gptr.Indirect(param.SpaceID),
+		SourceID: param.SourceTargetID,
+		PageSize: gptr.Indirect(param.PageSize),
+		Cursor:   param.Cursor,
+	})
+	if err != nil {
+		return nil, "", false, err
+	}
+	versions = make([]*entity.EvalTargetVersion, 0, len(workflows))
+	for _, workflow := range workflows {
+		versions = append(versions, &entity.EvalTargetVersion{
+			SpaceID:             gptr.Indirect(param.SpaceID),
+			SourceTargetVersion: workflow.Version,
+			EvalTargetType:      entity.EvalTargetTypeCozeWorkflow,
+			CozeWorkflow:        cozeWorkflowInfo2DO(workflow),
+			RuntimeParamDemo:    gptr.Of(entity.NewDummyRuntimeParam().GetJSONDemo()),
+		})
+	}
+	return versions, nextCursor, hasMore, nil
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) BatchGetSource(ctx context.Context, spaceID int64, ids []string) (targets []*entity.EvalTarget, err error) {
+	queries := make([]*rpc.MGetCozeWorkflowQuery, 0, len(ids))
+	for _, id := range ids {
+		queries = append(queries, &rpc.MGetCozeWorkflowQuery{WorkflowID: id})
+	}
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	workflows, err := t.cozeRPCAdapter.MGetWorkflow(ctx, spaceID, queries)
+	if err != nil {
+		return nil, err
+	}
+	targets = make([]*entity.EvalTarget, 0, len(workflows))
+	for _, workflow := range workflows {
+		targets = append(targets, &entity.EvalTarget{
+			SpaceID:        spaceID,
+			SourceTargetID: workflow.ID,
+			EvalTargetType: entity.EvalTargetTypeCozeWorkflow,
+			EvalTargetVersion: &entity.EvalTargetVersion{
+				SpaceID:        spaceID,
+				EvalTargetType: entity.EvalTargetTypeCozeWorkflow,
+				CozeWorkflow:   cozeWorkflowInfo2DO(workflow),
+			},
+		})
+	}
+	return targets, nil
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) PackSourceInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	queries := make([]*rpc.MGetCozeWorkflowQuery, 0)
+	for _, do := range dos {
+		if do.EvalTargetType != entity.EvalTargetTypeCozeWorkflow {
+			continue
+		}
+		queries = append(queries, &rpc.MGetCozeWorkflowQuery{WorkflowID: do.SourceTargetID})
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+	workflows, err := t.cozeRPCAdapter.MGetWorkflow(ctx, spaceID, queries)
+	if err != nil {
+		logs.CtxError(ctx, "packSourceInfo MGetWorkflow err=%v", err)
+	}
+	workflowMap := make(map[string]*rpc.CozeWorkflowInfo, len(workflows))
+	for _, workflow := range workflows {
+		workflowMap[workflow.ID] = workflow
+	}
+	for _, do := range dos {
+		if do.EvalTargetType != entity.EvalTargetTypeCozeWorkflow {
+			continue
+		}
+		if workflow, ok := workflowMap[do.SourceTargetID]; ok {
+			if do.EvalTargetVersion == nil {
+				do.EvalTargetVersion = &entity.EvalTargetVersion{}
+			}
+			do.EvalTargetVersion.CozeWorkflow = fillCozeWorkflowDisplayInfo(do.EvalTargetVersion.CozeWorkflow, workflow)
+		}
+	}
+	return nil
+}
+
+func (t *CozeWorkflowSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	queries := make([]*rpc.MGetCozeWorkflowQuery, 0)
+	for _, do := range dos {
+		if do.EvalTargetType != entity.EvalTargetTypeCozeWorkflow || do.EvalTargetVersion == nil {
+			continue
+		}
+		queries = append(queries, &rpc.MGetCozeWorkflowQuery{
+			WorkflowID: do.SourceTargetID,
+			Version:    gptr.Of(do.EvalTargetVersion.SourceTargetVersion),
+		})
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+	workflows, err := t.cozeRPCAdapter.MGetWorkflow(ctx, spaceID, queries)
+	if err != nil {
+		// 源信息只用于展示，查询失败时不影响主流程
+		logs.CtxError(ctx, "packSourceVersionInfo MGetWorkflow err=%v", err)
+		return nil
+	}
+	workflowMap := make(map[string]*rpc.CozeWorkflowInfo, len(workflows))
+	for _, workflow := range workflows {
+		workflowMap[fmt.Sprintf("%v_%v", workflow.ID, workflow.Version)] = workflow
+	}
+	for _, do := range dos {
+		if do.EvalTargetType != entity.EvalTargetTypeCozeWorkflow || do.EvalTargetVersion == nil {
+			continue
+		}
+		if workflow, ok := workflowMap[fmt.Sprintf("%v_%v", do.SourceTargetID, do.EvalTargetVersion.SourceTargetVersion)]; ok {
+			do.EvalTargetVersion.CozeWorkflow = fillCozeWorkflowDisplayInfo(do.EvalTargetVersion.CozeWorkflow, workflow)
+		} else if do.BaseInfo != nil {
+			do.BaseInfo.DeletedAt = gptr.Of(int64(1)) // 说明源数据已删除
+		}
+	}
+	return nil
+}
+
+func cozeWorkflowInfo2DO(workflow *rpc.CozeWorkflowInfo) *entity.CozeWorkflow {
+	return &entity.CozeWorkflow{
+		ID:          workflow.ID,
+		Version:     workflow.Version,
+		EndType:     cozeWorkflowEndTypeText,
+		Name:        workflow.Name,
+		AvatarURL:   workflow.AvatarURL,
+		Description: workflow.Description,
+	}
+}
+
+// fillCozeWorkflowDisplayInfo 只补充展示信息，保留评测对象版本中已保存的工作流配置
+func fillCozeWorkflowDisplayInfo(do *entity.CozeWorkflow, workflow *rpc.CozeWorkflowInfo) *entity.CozeWorkflow {
+	if do == nil {
+		return cozeWorkflowInfo2DO(workflow)
+	}
+	do.Name = workflow.Name
+	do.AvatarURL = workflow.AvatarURL
+	do.Description = workflow.Description
+	return do
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+)
+
+func newFakeCozeWorkflowAdapter() *fakeCozeRPCAdapter {
+	adapter := newFakeCozeRPCAdapter()
+	adapter.AddWorkflow(
+		&rpc.CozeWorkflowInfo{
+			ID: "wf1", Version: "v1", Name: "summary",
+			InputParams: []*rpc.CozeWorkflowParam{
+				{Name: "article", Type: rpc.VariableTypeString, Required: true},
+				{Name: "max_len", Type: rpc.VariableTypeInteger},
+			},
+		},
+		&rpc.CozeWorkflowInfo{ID: "wf1", Version: "v2", Name: "summary"},
+		&rpc.CozeWorkflowInfo{ID: "wf2", Version: "v1", Name: "classify"},
+	)
+	return adapter
+}
+
+func TestCozeWorkflowSourceEvalTargetServiceImpl_Execute(t *testing.T) {
+	adapter := newFakeCozeWorkflowAdapter()
+	adapter.SetWorkflowReply(func(param *rpc.RunCozeWorkflowParam) ([]*rpc.CozeStreamChunk, error) {
+		assert.Equal(t, "wf1", param.WorkflowID)
+		assert.Equal(t, "v1", param.Version)
+		assert.Equal(t, map[string]string{"article": "long text", "max_len": "10"}, param.Parameters)
+		return []*rpc.CozeStreamChunk{
+			{Content: "short"},
+			{Content: " text", TokenUsage: &entity.TokenUsage{InputTokens: 5, OutputTokens: 2}},
+		}, nil
+	})
+	svc := NewCozeWorkflowSourceEvalTargetServiceImpl(adapter)
+
+	output, status, err := svc.Execute(context.Background(), 100, &entity.ExecuteEvalTargetParam{
+		SourceTargetID:      "wf1",
+		SourceTargetVersion: "v1",
+		TargetType:          entity.EvalTargetTypeCozeWorkflow,
+		Input: &entity.EvalTargetInputData{
+			InputFields: map[string]*entity.Content{
+				"article": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("long text")},
+				"max_len": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("10")},
+				"ignored": nil,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvalTargetRunStatusSuccess, status)
+	assert.Equal(t, "short text", gptr.Indirect(output.OutputFields[consts.OutputSchemaKey].Text))
+	assert.Equal(t, int64(5), output.EvalTargetUsage.InputTokens)
+	assert.Equal(t, int64(2), output.EvalTargetUsage.OutputTokens)
+
+	output, status, err = svc.Execute(context.Background(), 100, &entity.ExecuteEvalTargetParam{})
+	assert.Error(t, err)
+	assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+	assert.Equal(t, int32(errno.CommonInvalidParamCode), output.EvalTargetRunError.Code)
+}
+
+func TestCozeWorkflowSourceEvalTargetServiceImpl_BuildBySource(t *testing.T) {
+	svc := NewCozeWorkflowSourceEvalTargetServiceImpl(newFakeCozeWorkflowAdapter())
+	assert.Equal(t, entity.EvalTargetTypeCozeWorkflow, svc.EvalType())
+
+	do, err := svc.BuildBySource(context.Background(), 100, "wf1", "v1")
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvalTargetTypeCozeWorkflow, do.EvalTargetType)
+	assert.Equal(t, "wf1", do.EvalTargetVersion.CozeWorkflow.ID)
+	assert.Equal(t, "v1", do.EvalTargetVersion.CozeWorkflow.Version)
+	assert.Len(t, do.EvalTargetVersion.InputSchema, 2)
+	assert.Equal(t, gptr.Of("article"), do.EvalTargetVersion.InputSchema[0].Key)
+	assert.Equal(t, gptr.Of(consts.IntegerJsonSchema), do.EvalTargetVersion.InputSchema[1].JsonSchema)
+	assert.Equal(t, gptr.Of(consts.OutputSchemaKey), do.EvalTargetVersion.OutputSchema[0].Key)
+
+	assert.Error(t, svc.ValidateInput(context.Background(), 100, do.EvalTargetVersion.InputSchema, &entity.EvalTargetInputData{
+		InputFields: map[string]*entity.Content{"max_len": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("abc")}},
+	}))
+
+	_, err = svc.BuildBySource(context.Background(), 100, "wf3", "v1")
+	assert.Error(t, err)
+}
+
+func TestCozeWorkflowSourceEvalTargetServiceImpl_ListAndPack(t *testing.T) {
+	svc := NewCozeWorkflowSourceEvalTargetServiceImpl(newFakeCozeWorkflowAdapter())
+
+	targets, _, hasMore, err := svc.ListSource(context.Background(), &entity.ListSourceParam{SpaceID: gptr.Of(int64(100))})
+	assert.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Len(t, targets, 2)
+	assert.Equal(t, "v2", targets[0].EvalTargetVersion.CozeWorkflow.Version)
+
+	versions, nextCursor, hasMore, err := svc.ListSourceVersion(context.Background(), &entity.ListSourceVersionParam{SpaceID: gptr.Of(int64(100)), SourceTargetID: "wf1", PageSize: gptr.Of(int32(1))})
+	assert.NoError(t, err)
+	assert.True(t, hasMore)
+	assert.Equal(t, "1", nextCursor)
+	assert.Equal(t, "v1", versions[0].SourceTargetVersion)
+
+	dos := []*entity.EvalTarget{
+		{
+			SourceTargetID:    "wf2",
+			EvalTargetType:    entity.EvalTargetTypeCozeWorkflow,
+			EvalTargetVersion: &entity.EvalTargetVersion{SourceTargetVersion: "v1", CozeWorkflow: &entity.CozeWorkflow{ID: "wf2", Version: "v1"}},
+			BaseInfo:          &entity.BaseInfo{},
+		},
+		{
+			SourceTargetID:    "wf9",
+			EvalTargetType:    entity.EvalTargetTypeCozeWorkflow,
+			EvalTargetVersion: &entity.EvalTargetVersion{SourceTargetVersion: "v1"},
+			BaseInfo:          &entity.BaseInfo{},
+		},
+	}
+	assert.NoError(t, svc.PackSourceInfo(context.Background(), 100, dos))
+	assert.Equal(t, "classify", dos[0].EvalTargetVersion.CozeWorkflow.Name)
+	assert.NoError(t, svc.PackSourceVersionInfo(context.Background(), 100, dos))
+	assert.Nil(t, dos[0].BaseInfo.DeletedAt)
+	assert.Equal(t, gptr.Of(int64(1)), dos[1].BaseInfo.DeletedAt)
+
+	batch, err := svc.BatchGetSource(context.Background(), 100, []string{"wf1", "wf9"})
+	assert.NoError(t, err)
+	assert.Len(t, batch, 1)
+	assert.Equal(t, "v2", batch[0].EvalTargetVersion.CozeWorkflow.Version)
+}
@@ -0,0 +1,352 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+func NewCozeBotSourceEvalTargetServiceImpl(cozeRPCAdapter rpc.ICozeRPCAdapter) ISourceEvalTargetOperateService {
+	return &CozeBotSourceEvalTargetServiceImpl{
+		cozeRPCAdapter: cozeRPCAdapter,
+	}
+}
+
+type CozeBotSourceEvalTargetServiceImpl struct {
+	cozeRPCAdapter rpc.ICozeRPCAdapter
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) EvalType() entity.EvalTargetType {
+	return entity.EvalTargetTypeCozeBot
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) RuntimeParam() entity.IRuntimeParam {
+	return entity.NewDummyRuntimeParam()
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) ValidateInput(ctx context.Context, spaceID int64, inputSchema []*entity.ArgsSchema, input *entity.EvalTargetInputData) error {
+	return input.ValidateInputSchema(inputSchema)
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) Execute(ctx context.Context, spaceID int64, param *entity.ExecuteEvalTargetParam) (outputData *entity.EvalTargetOutputData, status entity.EvalTargetRunStatus, err error) {
+	start := time.Now()
+
+	outputData = &entity.EvalTargetOutputData{}
+	defer func() {
+		outputData.TimeConsumingMS = gptr.Of(time.Since(start).Milliseconds())
+		if err != nil {
+			outputData.EvalTargetRunError = &entity.EvalTargetRunError{}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				outputData.EvalTargetRunError.Code = statusErr.Code()
+				outputData.EvalTargetRunError.Message = statusErr.Error()
+			} else {
+				outputData.EvalTargetRunError.Code = errno.CommonInternalErrorCode
+				outputData.EvalTargetRunError.Message = err.Error()
+			}
+		}
+	}()
+
+	botID, err := strconv.ParseInt(param.SourceTargetID, 10, 64)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, errorx.WrapByCode(err, errno.CommonInvalidParamCode)
+	}
+	chatParam := &rpc.ChatCozeBotParam{
+		BotID:      botID,
+		BotVersion: param.SourceTargetVersion,
+		UserID:     session.UserIDInCtxOrEmpty(ctx),
+	}
+	if param.Input != nil {
+		chatParam.History = param.Input.HistoryMessages
+		if content := param.Input.InputFields[consts.InputSchemaKey]; content != nil {
+			chatParam.Query = gptr.Indirect(content.Text)
+		}
+	}
+	if param.EvalTargetVersion != nil && param.EvalTargetVersion.CozeBot != nil {
+		chatParam.BotInfoType = param.EvalTargetVersion.CozeBot.BotInfoType
+		chatParam.PublishVersion = param.EvalTargetVersion.CozeBot.PublishVersion
+	}
+
+	reader, err := t.cozeRPCAdapter.StreamChatBot(ctx, spaceID, chatParam)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	content, usage, err := aggregateCozeStream(ctx, reader)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	cozeTargetOutput(outputData, content, usage)
+
+	return outputData, entity.EvalTargetRunStatusSuccess, nil
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, spaceID int64, sourceTargetID, sourceTargetVersion string, opts ...entity.Option) (*entity.EvalTarget, error) {
+	botID, err := strconv.ParseInt(sourceTargetID, 10, 64)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.CommonInvalidParamCode)
+	}
+	opt := &entity.Opt{}
+	for _, fn := range opts {
+		fn(opt)
+	}
+	bots, err := t.cozeRPCAdapter.MGetBot(ctx, spaceID, []*rpc.MGetCozeBotQuery{
+		{BotID: botID, Version: &sourceTargetVersion, BotInfoType: opt.BotInfoType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(bots) == 0 {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode)
+	}
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	do := &entity.EvalTarget{
+		SpaceID:        spaceID,
+		SourceTargetID: sourceTargetID,
+		EvalTargetType: entity.EvalTargetTypeCozeBot,
+		EvalTargetVersion: &entity.EvalTargetVersion{
+			SpaceID:             spaceID,
+			SourceTargetVersion: sourceTargetVersion,
+			EvalTargetType:      entity.EvalTargetTypeCozeBot,
+			CozeBot: &entity.CozeBot{
+				BotID:          botID,
+				BotVersion:     sourceTargetVersion,
+				BotInfoType:    opt.BotInfoType,
+				PublishVersion: opt.PublishVersion,
+			},
+			InputSchema: []*entity.ArgsSchema{
+				{
+					Key:                 gptr.Of(consts.InputSchemaKey),
+					SupportContentTypes: []entity.ContentType{entity.ContentTypeText},
+					JsonSchema:          gptr.Of(consts.StringJsonSchema),
+				},
+			},
+			OutputSchema: []*entity.ArgsSchema{
+				{
+					Key:                 gptr.Of(consts.OutputSchemaKey),
+					SupportContentTypes: []entity.ContentType{entity.ContentTypeText},
+					JsonSchema:          gptr.Of(consts.StringJsonSchema),
+				},
+			},
+			RuntimeParamDemo: gptr.Of(entity.NewDummyRuntimeParam().GetJSONDemo()),
+			BaseInfo: &entity.BaseInfo{
+				CreatedBy: &entity.UserInfo{
+					UserID: gptr.Of(userIDInContext),
+				},
+				UpdatedBy: &entity.UserInfo{
+					UserID: gptr.Of(userIDInContext),
+				},
+			},
+		},
+		BaseInfo: &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{
+				UserID: gptr.Of(userIDInContext),
+			},
+			UpdatedBy: &entity.UserInfo{
+				UserID: gptr.Of(userIDInContext),
+			},
+		},
+	}
+	return do, nil
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) ListSource(ctx context.Context, param *entity.ListSourceParam) (targets []*entity.EvalTarget, nextCursor string, hasMore bool, err error) {
+	bots, nextCursor, hasMore, err := t.cozeRPCAdapter.ListBot(ctx, &rpc.ListCozeSourceParam{
+		SpaceID:  gptr.Indirect(param.SpaceID),
+		PageSize: gptr.Indirect(param.PageSize),
+		Cursor:   param.Cursor,
+		KeyWord:  param.KeyWord,
+	})
+	if err != nil {
+		return nil, "", false, err
+	}
+	targets = make([]*entity.EvalTarget, 0, len(bots))
+	for _, bot := range bots {
+		targets = append(targets, &entity.EvalTarget{
+			SpaceID:        gptr.Indirect(param.SpaceID),
+			SourceTargetID: strconv.FormatInt(bot.BotID, 10),
+			EvalTargetType: entity.EvalTargetTypeCozeBot,
+			EvalTargetVersion: &entity.EvalTargetVersion{
+				SpaceID:          gptr.Indirect(param.SpaceID),
+				EvalTargetType:   entity.EvalTargetTypeCozeBot,
+				CozeBot:          cozeBotInfo2DO(bot),
+				RuntimeParamDemo: gptr.Of(entity.NewDummyRuntimeParam().GetJSONDemo()),
+			},
+		})
+	}
+	return targets, nextCursor, hasMore, nil
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) ListSourceVersion(ctx context.Context, param *entity.ListSourceVersionParam) (versions []*entity.EvalTargetVersion, nextCursor string, hasMore bool, err error) {
+	bots, nextCursor, hasMore, err := t.cozeRPCAdapter.ListBotVersion(ctx, &rpc.ListCozeSourceVersionParam{
+		SpaceID:  gptr.Indirect(param.SpaceID),
+		SourceID: param.SourceTargetID,
+		PageSize: gptr.Indirect(param.PageSize),
+		Cursor:   param.Cursor,
+	})
+	if err != nil {
+		return nil, "", false, err
+	}
+	versions = make([]*entity.EvalTargetVersion, 0, len(bots))
+	for _, bot := range bots {
+		versions = append(versions, &entity.EvalTargetVersion{
+			SpaceID:             gptr.Indirect(param.SpaceID),
+			SourceTargetVersion: bot.Version,
+			EvalTargetType:      entity.EvalTargetTypeCozeBot,
+			CozeBot:             cozeBotInfo2DO(bot),
+			RuntimeParamDemo:    gptr.Of(entity.NewDummyRuntimeParam().GetJSONDemo()),
+		})
+	}
+	return versions, nextCursor, hasMore, nil
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) BatchGetSource(ctx context.Context, spaceID int64, ids []string) (targets []*entity.EvalTarget, err error) {
+	queries := make([]*rpc.MGetCozeBotQuery, 0, len(ids))
+	for _, id := range ids {
+		botID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			logs.CtxError(ctx, "buildQueries ParseInt err=%v", err)
+			continue
+		}
+		queries = append(queries, &rpc.MGetCozeBotQuery{BotID: botID})
+	}
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	bots, err := t.cozeRPCAdapter.MGetBot(ctx, spaceID, queries)
+	if err != nil {
+		return nil, err
+	}
+	targets = make([]*entity.EvalTarget, 0, len(bots))
+	for _, bot := range bots {
+		targets = append(targets, &entity.EvalTarget{
+			SpaceID:        spaceID,
+			SourceTargetID: strconv.FormatInt(bot.BotID, 10),
+			EvalTargetType: entity.EvalTargetTypeCozeBot,
+			EvalTargetVersion: &entity.EvalTargetVersion{
+				SpaceID:        spaceID,
+				EvalTargetType: entity.EvalTargetTypeCozeBot,
+				CozeBot:        cozeBotInfo2DO(bot),
+			},
+		})
+	}
+	return targets, nil
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) PackSourceInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	queries := make([]*rpc.MGetCozeBotQuery, 0)
+	for _, do := range dos {
+		if do.EvalTargetType != entity.EvalTargetTypeCozeBot {
+			continue
+		}
+		botID, err := strconv.ParseInt(do.SourceTargetID, 10, 64)
+		if err != nil {
+			logs.CtxError(ctx, "buildQueries ParseInt err=%v", err)
+			continue
+		}
+		queries = append(queries, &rpc.MGetCozeBotQuery{BotID: botID})
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+	bots, err := t.cozeRPCAdapter.MGetBot(ctx, spaceID, queries)
+	if err != nil {
+		logs.CtxError(ctx, "packSourceInfo MGetBot err=%v", err)
+	}
+	botMap := make(map[string]*rpc.CozeBotInfo, len(bots))
+	for _, bot := range bots {
+		botMap[strconv.FormatInt(bot.BotID, 10)] = bot
+	}
+	for _, do := range dos {
+		if do.EvalTargetType != entity.EvalTargetTypeCozeBot {
+			continue
+		}
+		if bot, ok := botMap[do.SourceTargetID]; ok {
+			if do.EvalTargetVersion == nil {
+				do.EvalTargetVersion = &entity.EvalTargetVersion{}
+			}
+			do.EvalTargetVersion.CozeBot = fillCozeBotDisplayInfo(do.EvalTargetVersion.CozeBot, bot)
+		}
+	}
+	return nil
+}
+
+func (t *CozeBotSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	queries := make([]*rpc.MGetCozeBotQuery, 0)
+	for _, do := range dos {
+		if do.EvalTargetType != entity.EvalTargetTypeCozeBot {
+			continue
+		}
+		if do.EvalTargetVersion == nil || do.EvalTargetVersion.CozeBot == nil {
+			continue
+		}
+		queries = append(queries, &rpc.MGetCozeBotQuery{
+			BotID:       do.EvalTargetVersion.CozeBot.BotID,
+			Version:     gptr.Of(do.EvalTargetVersion.SourceTargetVersion),
+			BotInfoType: do.EvalTargetVersion.CozeBot.BotInfoType,
+		})
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+	bots, err := t.cozeRPCAdapter.MGetBot(ctx, spaceID, queries)
+	if err != nil {
+		// 源信息只用于展示，查询失败时不影响主流程
+		logs.CtxError(ctx, "packSourceVersionInfo MGetBot err=%v", err)
+		return nil
+	}
+	botMap := make(map[string]*rpc.CozeBotInfo, len(bots))
+	for _, bot := range bots {
+		botMap[fmt.Sprintf("%v_%v", bot.BotID, bot.Version)] = bot
+	}
+	for _, do := range dos {
+		if do.EvalTargetType != entity.EvalTargetTypeCozeBot {
+			continue
+		}
+		if do.EvalTargetVersion == nil || do.EvalTargetVersion.CozeBot == nil {
+			continue
+		}
+		if bot, ok := botMap[fmt.Sprintf("%v_%v", do.EvalTargetVersion.CozeBot.BotID, do.EvalTargetVersion.SourceTargetVersion)]; ok {
+			do.EvalTargetVersion.CozeBot = fillCozeBotDisplayInfo(do.EvalTargetVersion.CozeBot, bot)
+		} else if do.BaseInfo != nil {
+			do.BaseInfo.DeletedAt = gptr.Of(int64(1)) // 说明源数据已删除
+		}
+	}
+	return nil
+}
+
+func cozeBotInfo2DO(bot *rpc.CozeBotInfo) *entity.CozeBot {
+	return &entity.CozeBot{
+		BotID:          bot.BotID,
+		BotVersion:     bot.Version,
+		BotInfoType:    bot.BotInfoType,
+		PublishVersion: bot.PublishVersion,
+		BotName:        bot.Name,
+		AvatarURL:      bot.AvatarURL,
+		Description:    bot.Description,
+	}
+}
+
+// fillCozeBotDisplayInfo 只补充展示信息，保留评测对象版本中已保存的 bot 配置
+func fillCozeBotDisplayInfo(do *entity.CozeBot, bot *rpc.CozeBotInfo) *entity.CozeBot {
+	if do == nil {
+		return cozeBotInfo2DO(bot)
+	}
+	do.BotName = bot.Name
+	do.AvatarURL = bot.AvatarURL
+	do.Description = bot.Description
+	return do
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+)
+
+func newFakeCozeBotAdapter() *fakeCozeRPCAdapter {
+	adapter := newFakeCozeRPCAdapter()
+	adapter.AddBot(
+		&rpc.CozeBotInfo{BotID: 1, Version: "v1", BotInfoType: entity.CozeBotInfoTypeDraftBot, Name: "assistant", Description: "desc v1"},
+		&rpc.CozeBotInfo{BotID: 1, Version: "v2", BotInfoType: entity.CozeBotInfoTypeDraftBot, Name: "assistant", Description: "desc v2"},
+		&rpc.CozeBotInfo{BotID: 2, Version: "v1", BotInfoType: entity.CozeBotInfoTypeProductBot, PublishVersion: gptr.Of("p1"), Name: "translator"},
+	)
+	return adapter
+}
+
+func TestCozeBotSourceEvalTargetServiceImpl_Execute(t *testing.T) {
+	adapter := newFakeCozeBotAdapter()
+	adapter.SetBotReply(func(param *rpc.ChatCozeBotParam) ([]*rpc.CozeStreamChunk, error) {
+		assert.Equal(t, int64(2), param.BotID)
+		assert.Equal(t, "v1", param.BotVersion)
+		assert.Equal(t, entity.CozeBotInfoTypeProductBot, param.BotInfoType)
+		assert.Equal(t, gptr.Of("p1"), param.PublishVersion)
+		assert.Len(t, param.History, 1)
+		return []*rpc.CozeStreamChunk{
+			{Content: "你好，"},
+			{Content: param.Query, TokenUsage: &entity.TokenUsage{InputTokens: 3, OutputTokens: 1}},
+			{Content: "！", TokenUsage: &entity.TokenUsage{InputTokens: 3, OutputTokens: 4}},
+		}, nil
+	})
+	svc := NewCozeBotSourceEvalTargetServiceImpl(adapter)
+
+	param := &entity.ExecuteEvalTargetParam{
+		SourceTargetID:      "2",
+		SourceTargetVersion: "v1",
+		Input: &entity.EvalTargetInputData{
+			HistoryMessages: []*entity.Message{{Role: entity.RoleUser}},
+			InputFields: map[string]*entity.Content{
+				consts.InputSchemaKey: {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("世界")},
+			},
+		},
+		TargetType: entity.EvalTargetTypeCozeBot,
+		EvalTargetVersion: &entity.EvalTargetVersion{
+			CozeBot: &entity.CozeBot{BotID: 2, BotVersion: "v1", BotInfoType: entity.CozeBotInfoTypeProductBot, PublishVersion: gptr.Of("p1")},
+		},
+	}
+	output, status, err := svc.Execute(context.Background(), 100, param)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvalTargetRunStatusSuccess, status)
+	assert.Equal(t, "你好，世界！", gptr.Indirect(output.OutputFields[consts.OutputSchemaKey].Text))
+	assert.Equal(t, int64(3), output.EvalTargetUsage.InputTokens)
+	assert.Equal(t, int64(4), output.EvalTargetUsage.OutputTokens)
+	assert.NotNil(t, output.TimeConsumingMS)
+}
+
+func TestCozeBotSourceEvalTargetServiceImpl_Execute_Fail(t *testing.T) {
+	tests := []struct {
+		name           string
+		sourceTargetID string
+		mockSetup      func(adapter *mocks.MockICozeRPCAdapter)
+		wantCode       int32
+	}{
+		{
+			name:           "invalid bot id",
+			sourceTargetID: "abc",
+			mockSetup:      func(adapter *mocks.MockICozeRPCAdapter) {},
+			wantCode:       errno.CommonInvalidParamCode,
+		},
+		{
+			name:           "adapter error",
+			sourceTargetID: "1",
+			mockSetup: func(adapter *mocks.MockICozeRPCAdapter) {
+				adapter.EXPECT().StreamChatBot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error"))
+			},
+			wantCode: errno.CommonInternalErrorCode,
+		},
+		{
+			name:           "stream broken",
+			sourceTargetID: "1",
+			mockSetup: func(adapter *mocks.MockICozeRPCAdapter) {
+				reader := &erroredCozeStreamReader{chunks: []*rpc.CozeStreamChunk{{Content: "partial"}}, err: errors.New("stream broken")}
+				adapter.EXPECT().StreamChatBot(gomock.Any(), gomock.Any(), gomock.Any()).Return(reader, nil)
+			},
+			wantCode: errno.CallTargetFailCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			adapter := mocks.NewMockICozeRPCAdapter(ctrl)
+			tt.mockSetup(adapter)
+			svc := NewCozeBotSourceEvalTargetServiceImpl(adapter)
+
+			output, status, err := svc.Execute(context.Background(), 100, &entity.ExecuteEvalTargetParam{SourceTargetID: tt.sourceTargetID})
+			assert.Error(t, err)
+			assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+			assert.Equal(t, tt.wantCode, output.EvalTargetRunError.Code)
+			assert.NotNil(t, output.TimeConsumingMS)
+		})
+	}
+}
+
+func TestCozeBotSourceEvalTargetServiceImpl_BuildBySource(t *testing.T) {
+	svc := NewCozeBotSourceEvalTargetServiceImpl(newFakeCozeBotAdapter())
+	assert.Equal(t, entity.EvalTargetTypeCozeBot, svc.EvalType())
+	assert.Equal(t, "{}", svc.RuntimeParam().GetJSONDemo())
+
+	do, err := svc.BuildBySource(context.Background(), 100, "2", "v1",
+		entity.WithCozeBotInfoType(entity.CozeBotInfoTypeProductBot), entity.WithCozeBotPublishVersion(gptr.Of("p1")))
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvalTargetTypeCozeBot, do.EvalTargetType)
+	assert.Equal(t, "2", do.SourceTargetID)
+	assert.Equal(t, "v1", do.EvalTargetVersion.SourceTargetVersion)
+	assert.Equal(t, &entity.CozeBot{BotID: 2, BotVersion: "v1", BotInfoType: entity.CozeBotInfoTypeProductBot, PublishVersion: gptr.Of("p1")}, do.EvalTargetVersion.CozeBot)
+	assert.Equal(t, gptr.Of(consts.InputSchemaKey), do.EvalTargetVersion.InputSchema[0].Key)
+	assert.Equal(t, gptr.Of(consts.OutputSchemaKey), do.EvalTargetVersion.OutputSchema[0].Key)
+
+	assert.NoError(t, svc.ValidateInput(context.Background(), 100, do.EvalTargetVersion.InputSchema, &entity.EvalTargetInputData{
+		InputFields: map[string]*entity.Content{consts.InputSchemaKey: {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("hi")}},
+	}))
+
+	_, err = svc.BuildBySource(context.Background(), 100, "2", "v9")
+	assert.Error(t, err)
+	_, err = svc.BuildBySource(context.Background(), 100, "abc", "v1")
+	assert.Error(t, err)
+}
+
+func TestCozeBotSourceEvalTargetServiceImpl_ListSource(t *testing.T) {
+	svc := NewCozeBotSourceEvalTargetServiceImpl(newFakeCozeBotAdapter())
+
+	targets, nextCursor, hasMore, err := svc.ListSource(context.Background(), &entity.ListSourceParam{SpaceID: gptr.Of(int64(100)), PageSize: gptr.Of(int32(1))})
+	assert.NoError(t, err)
+	assert.True(t, hasMore)
+	assert.Len(t, targets, 1)
+	assert.Equal(t, "1", targets[0].SourceTargetID)
+	assert.Equal(t, "v2", targets[0].EvalTargetVersion.CozeBot.BotVersion)
+
+	targets, _, hasMore, err = svc.ListSource(context.Background(), &entity.ListSourceParam{SpaceID: gptr.Of(int64(100)), PageSize: gptr.Of(int32(1)), Cursor: gptr.Of(nextCursor)})
+	assert.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Equal(t, "translator", targets[0].EvalTargetVersion.CozeBot.BotName)
+
+	targets, _, _, err = svc.ListSource(context.Background(), &entity.ListSourceParam{SpaceID: gptr.Of(int64(100)), KeyWord: gptr.Of("TRANS")})
+	assert.NoError(t, err)
+	assert.Len(t, targets, 1)
+
+	versions, _, hasMore, err := svc.ListSourceVersion(context.Background(), &entity.ListSourceVersionParam{SpaceID: gptr.Of(int64(100)), SourceTargetID: "1"})
+	assert.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, "v1", versions[0].SourceTargetVersion)
+	assert.Equal(t, "desc v2", versions[1].CozeBot.Description)
+}
+
+func TestCozeBotSourceEvalTargetServiceImpl_PackSourceInfo(t *testing.T) {
+	svc := NewCozeBotSourceEvalTargetServiceImpl(newFakeCozeBotAdapter())
+
+	dos := []*entity.EvalTarget{
+		{SourceTargetID: "1", EvalTargetType: entity.EvalTargetTypeCozeBot},
+		{SourceTargetID: "7", EvalTargetType: entity.EvalTargetTypeLoopPrompt},
+	}
+	assert.NoError(t, svc.PackSourceInfo(context.Background(), 100, dos))
+	assert.Equal(t, "assistant", dos[0].EvalTargetVersion.CozeBot.BotName)
+	assert.Nil(t, dos[1].EvalTargetVersion)
+
+	versionDos := []*entity.EvalTarget{
+		{
+			SourceTargetID:    "1",
+			EvalTargetType:    entity.EvalTargetTypeCozeBot,
+			EvalTargetVersion: &entity.EvalTargetVersion{SourceTargetVersion: "v1", CozeBot: &entity.CozeBot{BotID: 1, BotVersion: "v1"}},
+			BaseInfo:          &entity.BaseInfo{},
+		},
+		{
+			SourceTargetID:    "1",
+			EvalTargetType:    entity.EvalTargetTypeCozeBot,
+			EvalTargetVersion: &entity.EvalTargetVersion{SourceTargetVersion: "v0", CozeBot: &entity.CozeBot{BotID: 1, BotVersion: "v0"}},
+			BaseInfo:          &entity.BaseInfo{},
+		},
+	}
+	assert.NoError(t, svc.PackSourceVersionInfo(context.Background(), 100, versionDos))
+	assert.Equal(t, "desc v1", versionDos[0].EvalTargetVersion.CozeBot.Description)
+	assert.Nil(t, versionDos[0].BaseInfo.DeletedAt)
+	assert.Equal(t, gptr.Of(int64(1)), versionDos[1].BaseInfo.DeletedAt)
+
+	targets, err := svc.BatchGetSource(context.Background(), 100, []string{"1", "2", "3", "abc"})
+	assert.NoError(t, err)
+	assert.Len(t, targets, 2)
+}
+
+func TestCozeBotSourceEvalTargetServiceImpl_PackSourceInfo_AdapterError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adapter := mocks.NewMockICozeRPCAdapter(ctrl)
+	adapter.EXPECT().MGetBot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error")).Times(2)
+	svc := NewCozeBotSourceEvalTargetServiceImpl(adapter)
+
+	dos := []*entity.EvalTarget{{
+		SourceTargetID:    "1",
+		EvalTargetType:    entity.EvalTargetTypeCozeBot,
+		EvalTargetVersion: &entity.EvalTargetVersion{CozeBot: &entity.CozeBot{BotID: 1}},
+	}}
+	assert.NoError(t, svc.PackSourceInfo(context.Background(), 100, dos))
+	assert.NoError(t, svc.PackSourceVersionInfo(context.Background(), 100, dos))
+}
@@ -0,0 +1,293 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/httpclient"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// httpTargetErrBodyLimit 请求失败时错误信息中保留的响应体长度
+const httpTargetErrBodyLimit = 512
+
+func NewHTTPSourceEvalTargetServiceImpl(client httpclient.IHTTPClient, configer component.IConfiger) ISourceEvalTargetOperateService {
+	return &HTTPSourceEvalTargetServiceImpl{
+		client:   client,
+		configer: configer,
+	}
+}
+
+// HTTPSourceEvalTargetServiceImpl 自定义 HTTP 服务评测对象。调用配置保存在评测对象版本中，
+// 没有外部的 source 系统，source_target_id 与 source_target_version 由用户自行命名。
+type HTTPSourceEvalTargetServiceImpl struct {
+	client   httpclient.IHTTPClient
+	configer component.IConfiger
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) EvalType() entity.EvalTargetType {
+	return entity.EvalTargetTypeHTTP
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) RuntimeParam() entity.IRuntimeParam {
+	return entity.NewDummyRuntimeParam()
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) ValidateInput(ctx context.Context, spaceID int64, inputSchema []*entity.ArgsSchema, input *entity.EvalTargetInputData) error {
+	return input.ValidateInputSchema(inputSchema)
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, spaceID int64, sourceTargetID, sourceTargetVersion string, opts ...entity.Option) (*entity.EvalTarget, error) {
+	opt := &entity.Opt{}
+	for _, fn := range opts {
+		fn(opt)
+	}
+	if opt.HTTPTarget == nil {
+		return nil, errorx.NewByCode(errno.InvalidEvalTargetConfigCode, errorx.WithExtraMsg("http target is nil"))
+	}
+	if err := opt.HTTPTarget.Validate(t.configer.GetHTTPTargetNetworkConf(ctx)); err != nil {
+		return nil, errorx.NewByCode(errno.InvalidEvalTargetConfigCode, errorx.WithExtraMsg(err.Error()))
+	}
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	do := &entity.EvalTarget{
+		SpaceID:        spaceID,
+		SourceTargetID: sourceTargetID,
+		EvalTargetType: entity.EvalTargetTypeHTTP,
+		EvalTargetVersion: &entity.EvalTargetVersion{
+			SpaceID:             spaceID,
+			SourceTargetVersion: sourceTargetVersion,
+			EvalTargetType:      entity.EvalTargetTypeHTTP,
+			HTTPTarget:          opt.HTTPTarget,
+			InputSchema:         opt.HTTPTarget.InputSchemas(),
+			OutputSchema:        opt.HTTPTarget.OutputSchemas(),
+			RuntimeParamDemo:    gptr.Of(entity.NewDummyRuntimeParam().GetJSONDemo()),
+			BaseInfo: &entity.BaseInfo{
+				CreatedBy: &entity.UserInfo{
+					UserID: gptr.Of(userIDInContext),
+				},
+				UpdatedBy: &entity.UserInfo{
+					UserID: gptr.Of(userIDInContext),
+				},
+			},
+		},
+		BaseInfo: &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{
+				UserID: gptr.Of(userIDInContext),
+			},
+			UpdatedBy: &entity.UserInfo{
+				UserID: gptr.Of(userIDInContext),
+			},
+		},
+	}
+	return do, nil
+}
+
+// ListSource HTTP 评测对象没有外部 source，不支持从 source 列表中选择
+func (t *HTTPSourceEvalTargetServiceImpl) ListSource(ctx context.Context, param *entity.ListSourceParam) (targets []*entity.EvalTarget, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) BatchGetSource(ctx context.Context, spaceID int64, ids []string) (targets []*entity.EvalTarget, err error) {
+	return nil, nil
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) ListSourceVersion(ctx context.Context, param *entity.ListSourceVersionParam) (versions []*entity.EvalTargetVersion, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) PackSourceInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	return nil
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	return nil
+}
+
+func (t *HTTPSourceEvalTargetServiceImpl) Execute(ctx context.Context, spaceID int64, param *entity.ExecuteEvalTargetParam) (outputData *entity.EvalTargetOutputData, status entity.EvalTargetRunStatus, err error) {
+	start := time.Now()
+
+	outputData = &entity.EvalTargetOutputData{}
+	defer func() {
+		outputData.TimeConsumingMS = gptr.Of(time.Since(start).Milliseconds())
+		if err != nil {
+			outputData.EvalTargetRunError = &entity.EvalTargetRunError{}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				outputData.EvalTargetRunError.Code = statusErr.Code()
+				outputData.EvalTargetRunError.Message = statusErr.Error()
+			} else {
+				outputData.EvalTargetRunError.Code = errno.CommonInternalErrorCode
+				outputData.EvalTargetRunError.Message = err.Error()
+			}
+		}
+	}()
+
+	if param.EvalTargetVersion == nil || param.EvalTargetVersion.HTTPTarget == nil {
+		return outputData, entity.EvalTargetRunStatusFail, errorx.NewByCode(errno.InvalidEvalTargetConfigCode, errorx.WithExtraMsg("http target is nil"))
+	}
+	conf := param.EvalTargetVersion.HTTPTarget
+
+	req, err := buildHTTPTargetRequest(conf, param.Input)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	resp, err := t.doWithRetry(ctx, conf, req)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	outputData.OutputFields, err = mapHTTPTargetResponse(conf, resp.Body)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	outputData.EvalTargetUsage = &entity.EvalTargetUsage{}
+
+	return outputData, entity.EvalTargetRunStatusSuccess, nil
+}
+
+// doWithRetry 网络错误、429 与 5xx 响应会按配置重试，其余非 2xx 响应直接失败
+func (t *HTTPSourceEvalTargetServiceImpl) doWithRetry(ctx context.Context, conf *entity.HTTPTarget, req *entity.HTTPRequest) (*entity.HTTPResponse, error) {
+	var lastErr error
+	for attempt := 0; attempt <= int(conf.RetryTimes); attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, errorx.WrapByCode(ctx.Err(), errno.CallTargetFailCode)
+			case <-time.After(time.Duration(conf.RetryIntervalMS) * time.Millisecond):
+			}
+		}
+		resp, err := t.client.Do(ctx, req)
+		if err != nil {
+			logs.CtxWarn(ctx, "[HTTPTarget] request fail, url=%s, attempt=%d, err=%v", req.URL, attempt, err)
+			lastErr = errorx.NewByCode(errno.CallTargetFailCode, errorx.WithExtraMsg(err.Error()))
+			continue
+		}
+		if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+			return resp, nil
+		}
+		lastErr = errorx.NewByCode(errno.CallTargetFailCode, errorx.WithExtraMsg(fmt.Sprintf("status code %d, body: %s", resp.StatusCode, truncateString(string(resp.Body), httpTargetErrBodyLimit))))
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < http.StatusInternalServerError {
+			return nil, lastErr
+		}
+		logs.CtxWarn(ctx, "[HTTPTarget] request fail, url=%s, attempt=%d, status_code=%d", req.URL, attempt, resp.StatusCode)
+	}
+	return nil, lastErr
+}
+
+func buildHTTPTargetRequest(conf *entity.HTTPTarget, input *entity.EvalTargetInputData) (*entity.HTTPRequest, error) {
+	vars := make(map[string]string)
+	if input != nil {
+		for key, content := range input.InputFields {
+			if content != nil {
+				vars[key] = gptr.Indirect(content.Text)
+			}
+		}
+	}
+
+	body, err := renderHTTPTargetTemplate(conf.BodyTemplate, vars, func(s string) (string, error) {
+		escaped, err := json.MarshalString(s)
+		if err != nil {
+			return "", err
+		}
+		return escaped[1 : len(escaped)-1], nil
+	})
+	if err != nil {
+		return nil, errorx.NewByCode(errno.InvalidEvalTargetConfigCode, errorx.WithExtraMsg(err.Error()))
+	}
+	reqURL, _ := renderHTTPTargetTemplate(conf.URL, vars, func(s string) (string, error) {
+		return url.QueryEscape(s), nil
+	})
+
+	headers := make(map[string]string, len(conf.Headers)+2)
+	for k, v := range conf.Headers {
+		headers[k] = v
+	}
+	if body != "" && !hasHeader(headers, "Content-Type") {
+		headers["Content-Type"] = "application/json"
+	}
+	if conf.Auth != nil {
+		switch conf.Auth.AuthType {
+		case entity.HTTPAuthTypeBearer:
+			headers["Authorization"] = "Bearer " + conf.Auth.Token
+		case entity.HTTPAuthTypeBasic:
+			headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(conf.Auth.Username+":"+conf.Auth.Password))
+		case entity.HTTPAuthTypeAPIKey:
+			headers[conf.Auth.HeaderName] = conf.Auth.Token
+		}
+	}
+
+	return &entity.HTTPRequest{
+		Method:  conf.GetMethod(),
+		URL:     reqURL,
+		Headers: headers,
+		Body:    []byte(body),
+		Timeout: conf.GetTimeout(),
+	}, nil
+}
+
+// renderHTTPTargetTemplate 替换模板中的 {{key}} 变量，缺失的变量替换为空字符串
+func renderHTTPTargetTemplate(tpl string, vars map[string]string, escape func(string) (string, error)) (string, error) {
+	var renderErr error
+	res := entity.HTTPTargetVariableRegexp.ReplaceAllStringFunc(tpl, func(match string) string {
+		key := entity.HTTPTargetVariableRegexp.FindStringSubmatch(match)[1]
+		escaped, err := escape(vars[key])
+		if err != nil {
+			renderErr = err
+		}
+		return escaped
+	})
+	return res, renderErr
+}
+
+func mapHTTPTargetResponse(conf *entity.HTTPTarget, body []byte) (map[string]*entity.Content, error) {
+	fields := make(map[string]*entity.Content)
+	for _, m := range conf.GetOutputMappings() {
+		var value string
+		if m.JSONPath == "" {
+			value = string(body)
+		} else {
+			var err error
+			value, err = json.GetStringByJSONPath(string(body), m.JSONPath)
+			if err != nil {
+				return nil, errorx.NewByCode(errno.ParseTargetResponseFailCode, errorx.WithExtraMsg(fmt.Sprintf("field %s: %v", m.FieldKey, err)))
+			}
+		}
+		fields[m.FieldKey] = &entity.Content{
+			ContentType: gptr.Of(entity.ContentTypeText),
+			Format:      gptr.Of(entity.PlainText),
+			Text:        gptr.Of(value),
+		}
+	}
+	return fields, nil
+}
+
+func hasHeader(headers map[string]string, key string) bool {
+	for k := range headers {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func truncateString(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	return s[:limit] + "..."
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/httpclient/mocks"
+	componentMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+)
+
+func newHTTPTargetParam(conf *entity.HTTPTarget, fields map[string]string) *entity.ExecuteEvalTargetParam {
+	input := &entity.EvalTargetInputData{InputFields: map[string]*entity.Content{}}
+	for k, v := range fields {
+		input.InputFields[k] = &entity.Content{ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(v)}
+	}
+	return &entity.ExecuteEvalTargetParam{
+		TargetType:        entity.EvalTargetTypeHTTP,
+		Input:             input,
+		EvalTargetVersion: &entity.EvalTargetVersion{HTTPTarget: conf},
+	}
+}
+
+func TestHTTPSourceEvalTargetServiceImpl_Execute(t *testing.T) {
+	conf := &entity.HTTPTarget{
+		URL:          "https://example.com/chat?user={{user}}",
+		Headers:      map[string]string{"X-Env": "test"},
+		Auth:         &entity.HTTPAuth{AuthType: entity.HTTPAuthTypeBearer, Token: "token"},
+		BodyTemplate: `{"query":"{{query}}"}`,
+		OutputMappings: []*entity.HTTPOutputMapping{
+			{FieldKey: consts.OutputSchemaKey, JSONPath: "$.data.answer"},
+			{FieldKey: "score", JSONPath: "$.data.score"},
+		},
+		RetryTimes: 2,
+	}
+
+	tests := []struct {
+		name       string
+		conf       *entity.HTTPTarget
+		mockSetup  func(client *mocks.MockIHTTPClient)
+		wantStatus entity.EvalTargetRunStatus
+		wantFields map[string]string
+		wantCode   int32
+	}{
+		{
+			name: "success",
+			conf: conf,
+			mockSetup: func(client *mocks.MockIHTTPClient) {
+				client.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *entity.HTTPRequest) (*entity.HTTPResponse, error) {
+					assert.Equal(t, http.MethodPost, req.Method)
+					assert.Equal(t, "https://example.com/chat?user=a+b", req.URL)
+					assert.Equal(t, `{"query":"say \"hi\"\n"}`, string(req.Body))
+					assert.Equal(t, "Bearer token", req.Headers["Authorization"])
+					assert.Equal(t, "application/json", req.Headers["Content-Type"])
+					assert.Equal(t, "test", req.Headers["X-Env"])
+					assert.Equal(t, entity.HTTPTargetDefaultTimeout, req.Timeout)
+					return &entity.HTTPResponse{StatusCode: http.StatusOK, Body: []byte(`{"data":{"answer":"hello","score":0.5}}`)}, nil
+				})
+			},
+			wantStatus: entity.EvalTargetRunStatusSuccess,
+			wantFields: map[string]string{consts.OutputSchemaKey: "hello", "score": "0.5"},
+		},
+		{
+			name: "retry on 5xx and network error",
+			conf: conf,
+			mockSetup: func(client *mocks.MockIHTTPClient) {
+				gomock.InOrder(
+					client.EXPECT().Do(gomock.Any(), gomock.Any()).Return(&entity.HTTPResponse{StatusCode: http.StatusBadGateway}, nil),
+					client.EXPECT().Do(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection reset")),
+					client.EXPECT().Do(gomock.Any(), gomock.Any()).Return(&entity.HTTPResponse{StatusCode: http.StatusOK, Body: []byte(`{"data":{"answer":"ok"}}`)}, nil),
+				)
+			},
+			wantStatus: entity.EvalTargetRunStatusSuccess,
+			wantFields: map[string]string{consts.OutputSchemaKey: "ok", "score": ""},
+		},
+		{
+			name: "retry exhausted",
+			conf: conf,
+			mockSetup: func(client *mocks.MockIHTTPClient) {
+				client.EXPECT().Do(gomock.Any(), gomock.Any()).Return(&entity.HTTPResponse{StatusCode: http.StatusTooManyRequests}, nil).Times(3)
+			},
+			wantStatus: entity.EvalTargetRunStatusFail,
+			wantCode:   errno.CallTargetFailCode,
+		},
+		{
+			name: "no retry on 4xx",
+			conf: conf,
+			mockSetup: func(client *mocks.MockIHTTPClient) {
+				client.EXPECT().Do(gomock.Any(), gomock.Any()).Return(&entity.HTTPResponse{StatusCode: http.StatusUnauthorized, Body: []byte("unauthorized")}, nil)
+			},
+			wantStatus: entity.EvalTargetRunStatusFail,
+			wantCode:   errno.CallTargetFailCode,
+		},
+		{
+			name: "response is not json",
+			conf: conf,
+			mockSetup: func(client *mocks.MockIHTTPClient) {
+				client.EXPECT().Do(gomock.Any(), gomock.Any()).Return(&entity.HTTPResponse{StatusCode: http.StatusOK, Body: []byte("plain")}, nil)
+			},
+			wantStatus: entity.EvalTargetRunStatusFail,
+			wantCode:   errno.ParseTargetResponseFailCode,
+		},
+		{
+			name: "whole body as output without mapping",
+			conf: &entity.HTTPTarget{URL: "https://example.com", Method: "get", Auth: &entity.HTTPAuth{AuthType: entity.HTTPAuthTypeAPIKey, HeaderName: "X-Key", Token: "k"}},
+			mockSetup: func(client *mocks.MockIHTTPClient) {
+				client.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *entity.HTTPRequest) (*entity.HTTPResponse, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Empty(t, req.Body)
+					assert.Equal(t, "k", req.Headers["X-Key"])
+					_, ok := req.Headers["Content-Type"]
+					assert.False(t, ok)
+					return &entity.HTTPResponse{StatusCode: http.StatusOK, Body: []byte("plain")}, nil
+				})
+			},
+			wantStatus: entity.EvalTargetRunStatusSuccess,
+			wantFields: map[string]string{consts.OutputSchemaKey: "plain"},
+		},
+		{
+			name:       "config missing",
+			mockSetup:  func(client *mocks.MockIHTTPClient) {},
+			wantStatus: entity.EvalTargetRunStatusFail,
+			wantCode:   errno.InvalidEvalTargetConfigCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			client := mocks.NewMockIHTTPClient(ctrl)
+			tt.mockSetup(client)
+			svc := NewHTTPSourceEvalTargetServiceImpl(client, nil)
+
+			output, status, err := svc.Execute(context.Background(), 1, newHTTPTargetParam(tt.conf, map[string]string{"user": "a b", "query": "say \"hi\"\n"}))
+			assert.Equal(t, tt.wantStatus, status)
+			assert.NotNil(t, output.TimeConsumingMS)
+			if tt.wantCode != 0 {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantCode, output.EvalTargetRunError.Code)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, output.OutputFields, len(tt.wantFields))
+			for k, v := range tt.wantFields {
+				assert.Equal(t, v, gptr.Indirect(output.OutputFields[k].Text))
+			}
+		})
+	}
+}
+
+func TestHTTPSourceEvalTargetServiceImpl_Execute_ContextCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockIHTTPClient(ctrl)
+	client.EXPECT().Do(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+	svc := NewHTTPSourceEvalTargetServiceImpl(client, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	conf := &entity.HTTPTarget{URL: "https://example.com", RetryTimes: 3, RetryIntervalMS: 1000}
+	output, status, err := svc.Execute(ctx, 1, newHTTPTargetParam(conf, nil))
+	assert.Error(t, err)
+	assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+	assert.Equal(t, int32(errno.CallTargetFailCode), output.EvalTargetRunError.Code)
+}
+
+func TestHTTPSourceEvalTargetServiceImpl_BuildBySource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configer := componentMocks.NewMockIConfiger(ctrl)
+	configer.EXPECT().GetHTTPTargetNetworkConf(gomock.Any()).Return(&entity.HTTPTargetNetworkConf{AllowedCIDRs: []string{"10.0.0.0/8"}}).AnyTimes()
+	svc := NewHTTPSourceEvalTargetServiceImpl(nil, configer)
+	assert.Equal(t, entity.EvalTargetTypeHTTP, svc.EvalType())
+	assert.Equal(t, "{}", svc.RuntimeParam().GetJSONDemo())
+
+	_, err := svc.BuildBySource(context.Background(), 1, "my-service", "v1")
+	assert.Error(t, err)
+
+	_, err = svc.BuildBySource(context.Background(), 1, "my-service", "v1", entity.WithHTTPTarget(&entity.HTTPTarget{URL: "ftp://x"}))
+	assert.Error(t, err)
+
+	_, err = svc.BuildBySource(context.Background(), 1, "my-service", "v1", entity.WithHTTPTarget(&entity.HTTPTarget{URL: "http://192.168.0.8/chat"}))
+	assert.Error(t, err)
+
+	_, err = svc.BuildBySource(context.Background(), 1, "my-service", "v1", entity.WithHTTPTarget(&entity.HTTPTarget{URL: "http://10.0.0.8/chat"}))
+	assert.NoError(t, err)
+
+	conf := &entity.HTTPTarget{
+		URL:            "https://example.com/chat",
+		BodyTemplate:   `{"q":"{{input}}"}`,
+		OutputMappings: []*entity.HTTPOutputMapping{{FieldKey: "answer", JSONPath: "$.answer"}},
+	}
+	do, err := svc.BuildBySource(context.Background(), 1, "my-service", "v1", entity.WithHTTPTarget(conf))
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvalTargetTypeHTTP, do.EvalTargetType)
+	assert.Equal(t, "my-service", do.SourceTargetID)
+	assert.Equal(t, "v1", do.EvalTargetVersion.SourceTargetVersion)
+	assert.Equal(t, conf, do.EvalTargetVersion.HTTPTarget)
+	assert.Equal(t, gptr.Of("input"), do.EvalTargetVersion.InputSchema[0].Key)
+	assert.Equal(t, gptr.Of("answer"), do.EvalTargetVersion.OutputSchema[0].Key)
+
+	targets, _, hasMore, err := svc.ListSource(context.Background(), &entity.ListSourceParam{})
+	assert.NoError(t, err)
+	assert.Empty(t, targets)
+	assert.False(t, hasMore)
+	assert.NoError(t, svc.PackSourceVersionInfo(context.Background(), 1, []*entity.EvalTarget{do}))
+}
@@ -69,9 +69,18 @@ func (t *PromptSourceEvalTargetServiceImpl) Execute(ctx context.Context, spaceID
 	if err != nil {
 		return evaluatorOutputData, entity.EvalTargetRunStatusFail, errorx.WrapByCode(err, errno.CommonInvalidParamCode)
 	}
+	promptVersion := param.SourceTargetVersion
+	if param.EvalTargetVersion != nil && param.EvalTargetVersion.Prompt != nil && param.EvalTargetVersion.Prompt.Label != "" {
+		// 按发布标签绑定的评测对象，每次执行时解析标签当前指向的提交版本，并记录在输出中以便追溯
+		promptVersion, err = t.resolveLabelVersion(ctx, spaceID, promptID, param.EvalTargetVersion.Prompt.Label)
+		if err != nil {
+			return evaluatorOutputData, entity.EvalTargetRunStatusFail, err
+		}
+		evaluatorOutputData.ResolvedSourceTargetVersion = gptr.Of(promptVersion)
+	}
 	exePromptParam := &rpc.ExecutePromptParam{
 		PromptID:      promptID,
-		PromptVersion: param.SourceTargetVersion,
+		PromptVersion: promptVersion,
 		Variables:     nil,
 		History:       param.Input.HistoryMessages,
 	}
@@ -143,16 +152,47 @@ func (t *PromptSourceEvalTargetServiceImpl) Execute(ctx context.Context, spaceID
 	return evaluatorOutputData, entity.EvalTargetRunStatusSuccess, nil
 }
 
+func (t *PromptSourceEvalTargetServiceImpl) resolveLabelVersion(ctx context.Context, spaceID, promptID int64, label string) (string, error) {
+	prompts, err := t.promptRPCAdapter.MGetPrompt(ctx, spaceID, []*rpc.MGetPromptQuery{{PromptID: promptID, Label: gptr.Of(label)}})
+	if err != nil {
+		return "", err
+	}
+	for _, p := range prompts {
+		if p != nil && p.ID == promptID && p.PromptCommit != nil && p.PromptCommit.CommitInfo != nil {
+			return gptr.Indirect(p.PromptCommit.CommitInfo.Version), nil
+		}
+	}
+	return "", errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("prompt %d label %s not found", promptID, label)))
+}
+
 func (t *PromptSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, spaceID int64, sourceTargetID, sourceTargetVersion string, opts ...entity.Option) (*entity.EvalTarget, error) {
+	opt := &entity.Opt{}
+	for _, fn := range opts {
+		fn(opt)
+	}
 	promptID, err := strconv.ParseInt(sourceTargetID, 10, 64)
 	if err != nil {
 		return nil, err
 	}
-	prompt, err := t.promptRPCAdapter.GetPrompt(ctx, spaceID, promptID, rpc.GetPromptParams{
-		CommitVersion: &sourceTargetVersion,
-	})
-	if err != nil {
-		return nil, err
+	label := gptr.Indirect(opt.PromptLabel)
+	var prompt *rpc.LoopPrompt
+	if label != "" {
+		// 按发布标签绑定时，版本号记录为标签名，输入结构取标签当前指向的提交版本
+		prompts, err := t.promptRPCAdapter.MGetPrompt(ctx, spaceID, []*rpc.MGetPromptQuery{{PromptID: promptID, Label: gptr.Of(label)}})
+		if err != nil {
+			return nil, err
+		}
+		if len(prompts) > 0 {
+			prompt = prompts[0]
+		}
+		sourceTargetVersion = label
+	} else {
+		prompt, err = t.promptRPCAdapter.GetPrompt(ctx, spaceID, promptID, rpc.GetPromptParams{
+			CommitVersion: &sourceTargetVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 	if prompt == nil {
 		return nil, errorx.NewByCode(errno.ResourceNotFoundCode)
@@ -161,35 +201,10 @@ func (t *PromptSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, s
 	if prompt.PromptCommit != nil && prompt.PromptCommit.Detail != nil && prompt.PromptCommit.Detail.PromptTemplate != nil {
 		inputSchema = make([]*entity.ArgsSchema, 0)
 		for _, p := range prompt.PromptCommit.Detail.PromptTemplate.VariableDefs {
-			var jsonschema string
-			switch gptr.Indirect(p.Type) {
-			case rpc.VariableTypeString:
-				jsonschema = consts.StringJsonSchema
-			case rpc.VariableTypeInteger:
-				jsonschema = consts.IntegerJsonSchema
-			case rpc.VariableTypeFloat:
-				jsonschema = consts.NumberJsonSchema
-			case rpc.VariableTypeBoolean:
-				jsonschema = consts.BooleanJsonSchema
-			case rpc.VariableTypeObject:
-				jsonschema = consts.ObjectJsonSchema
-			case rpc.VariableTypeArrayString:
-				jsonschema = consts.ArrayStringJsonSchema
-			case rpc.VariableTypeArrayInteger:
-				jsonschema = consts.ArrayIntegerJsonSchema
-			case rpc.VariableTypeArrayFloat:
-				jsonschema = consts.ArrayNumberJsonSchema
-			case rpc.VariableTypeArrayBoolean:
-				jsonschema = consts.ArrayBooleanJsonSchema
-			case rpc.VariableTypeArrayObject:
-				jsonschema = consts.ArrayObjectJsonSchema
-			default:
-				jsonschema = consts.StringJsonSchema // 默认是string，例如placeholder，评测不严格规定placeholder的类型
-			}
 			inputSchema = append(inputSchema, &entity.ArgsSchema{
 				Key:                 p.Key,
 				SupportContentTypes: []entity.ContentType{entity.ContentTypeText},
-				JsonSchema:          gptr.Of(jsonschema),
+				JsonSchema:          gptr.Of(jsonSchemaOfVariableType(gptr.Indirect(p.Type))),
 			})
 		}
 	}
@@ -204,7 +219,8 @@ func (t *PromptSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, s
 			EvalTargetType:      entity.EvalTargetTypeLoopPrompt,
 			Prompt: &entity.LoopPrompt{
 				PromptID: promptID,
-				Version:  sourceTargetVersion,
+				Version:  gcond.If(label == "", sourceTargetVersion, ""),
+				Label:    label,
 			},
 			InputSchema: inputSchema,
 			OutputSchema: []*entity.ArgsSchema{
@@ -237,6 +253,34 @@ func (t *PromptSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, s
 	return do, nil
 }
 
+// jsonSchemaOfVariableType 变量类型对应的 json schema，未知类型按 string 处理，例如 placeholder，评测不严格规定 placeholder 的类型
+func jsonSchemaOfVariableType(typ rpc.VariableType) string {
+	switch typ {
+	case rpc.VariableTypeString:
+		return consts.StringJsonSchema
+	case rpc.VariableTypeInteger:
+		return consts.IntegerJsonSchema
+	case rpc.VariableTypeFloat:
+		return consts.NumberJsonSchema
+	case rpc.VariableTypeBoolean:
+		return consts.BooleanJsonSchema
+	case rpc.VariableTypeObject:
+		return consts.ObjectJsonSchema
+	case rpc.VariableTypeArrayString:
+		return consts.ArrayStringJsonSchema
+	case rpc.VariableTypeArrayInteger:
+		return consts.ArrayIntegerJsonSchema
+	case rpc.VariableTypeArrayFloat:
+		return consts.ArrayNumberJsonSchema
+	case rpc.VariableTypeArrayBoolean:
+		return consts.ArrayBooleanJsonSchema
+	case rpc.VariableTypeArrayObject:
+		return consts.ArrayObjectJsonSchema
+	default:
+		return consts.StringJsonSchema
+	}
+}
+
 func (t *PromptSourceEvalTargetServiceImpl) ListSource(ctx context.Context, param *entity.ListSourceParam) (targets []*entity.EvalTarget, nextCursor string, hasMore bool, err error) {
 	// prompt没有滚动分页接口，需要自己适配一下
 	page, err := buildPageByCursor(param.Cursor)
@@ -387,9 +431,14 @@ func (t *PromptSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Co
 		if do.EvalTargetVersion == nil || do.EvalTargetVersion.Prompt == nil {
 			continue
 		}
+		// 按发布标签绑定的评测对象没有固定版本，只取基础信息
+		var version *string
+		if do.EvalTargetVersion.Prompt.Label == "" {
+			version = &do.EvalTargetVersion.SourceTargetVersion
+		}
 		promptQueries = append(promptQueries, &rpc.MGetPromptQuery{
 			PromptID: do.EvalTargetVersion.Prompt.PromptID,
-			Version:  &do.EvalTargetVersion.SourceTargetVersion,
+			Version:  version,
 		})
 	}
 	if len(promptQueries) == 0 {
@@ -399,8 +448,10 @@ func (t *PromptSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Co
 	if err != nil {
 		logs.CtxError(ctx, "packSourceInfoWithVersion MGetPrompt err=%v", err)
 	}
+	labelPromptMap := make(map[string]*rpc.LoopPrompt)
 	for _, p := range prompts {
 		if p.PromptCommit == nil || p.PromptCommit.CommitInfo == nil {
+			labelPromptMap[fmt.Sprintf("%v", p.ID)] = p
 			continue
 		}
 		sourcePromptMap[fmt.Sprintf("%v_%v", p.ID, gptr.Indirect(p.PromptCommit.CommitInfo.Version))] = p
@@ -413,6 +464,16 @@ func (t *PromptSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Co
 		if do.EvalTargetVersion == nil || do.EvalTargetVersion.Prompt == nil {
 			continue
 		}
+		if do.EvalTargetVersion.Prompt.Label != "" {
+			if p, ok := labelPromptMap[do.SourceTargetID]; ok {
+				if p.PromptBasic != nil {
+					do.EvalTargetVersion.Prompt.Name = gptr.Indirect(p.PromptBasic.DisplayName)
+				}
+			} else {
+				do.BaseInfo.DeletedAt = gptr.Of(int64(1)) // 说明源数据已删除
+			}
+			continue
+		}
 		if p, ok := sourcePromptMap[fmt.Sprintf("%v_%v", do.SourceTargetID, do.EvalTargetVersion.SourceTargetVersion)]; ok {
 			var name string
 			if p.PromptBasic != nil {
@@ -174,6 +174,69 @@ func TestPromptSourceEvalTargetServiceImpl_Execute(t *testing.T) {
 			wantStatus: entity.EvalTargetRunStatusSuccess,
 			wantErr:    false,
 		},
+		{
+			name:    "successful execution - resolve version by label",
+			spaceID: 123,
+			param: &entity.ExecuteEvalTargetParam{
+				TargetID:            1,
+				SourceTargetID:      "456",
+				SourceTargetVersion: "prod",
+				Input:               &entity.EvalTargetInputData{},
+				TargetType:          entity.EvalTargetTypeLoopPrompt,
+				EvalTargetVersion: &entity.EvalTargetVersion{
+					Prompt: &entity.LoopPrompt{PromptID: 456, Label: "prod"},
+				},
+			},
+			mockSetup: func() {
+				mockPromptRPCAdapter.EXPECT().
+					MGetPrompt(gomock.Any(), int64(123), []*rpc.MGetPromptQuery{{PromptID: 456, Label: gptr.Of("prod")}}).
+					Return([]*rpc.LoopPrompt{{
+						ID: 456,
+						PromptCommit: &rpc.PromptCommit{
+							CommitInfo: &rpc.CommitInfo{Version: gptr.Of("1.0.2")},
+						},
+					}}, nil)
+				mockPromptRPCAdapter.EXPECT().
+					ExecutePrompt(gomock.Any(), int64(123), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int64, param *rpc.ExecutePromptParam) (*rpc.ExecutePromptResult, error) {
+						assert.Equal(t, "1.0.2", param.PromptVersion)
+						return &rpc.ExecutePromptResult{Content: gptr.Of("test output")}, nil
+					})
+			},
+			wantOutputData: &entity.EvalTargetOutputData{
+				OutputFields: map[string]*entity.Content{
+					consts.OutputSchemaKey: {
+						ContentType: gptr.Of(entity.ContentTypeText),
+						Text:        gptr.Of("test output"),
+					},
+				},
+				ResolvedSourceTargetVersion: gptr.Of("1.0.2"),
+			},
+			wantStatus: entity.EvalTargetRunStatusSuccess,
+			wantErr:    false,
+		},
+		{
+			name:    "execution failed - label not found",
+			spaceID: 123,
+			param: &entity.ExecuteEvalTargetParam{
+				TargetID:            1,
+				SourceTargetID:      "456",
+				SourceTargetVersion: "prod",
+				Input:               &entity.EvalTargetInputData{},
+				TargetType:          entity.EvalTargetTypeLoopPrompt,
+				EvalTargetVersion: &entity.EvalTargetVersion{
+					Prompt: &entity.LoopPrompt{PromptID: 456, Label: "prod"},
+				},
+			},
+			mockSetup: func() {
+				mockPromptRPCAdapter.EXPECT().
+					MGetPrompt(gomock.Any(), int64(123), gomock.Any()).
+					Return(nil, nil)
+			},
+			wantStatus:  entity.EvalTargetRunStatusFail,
+			wantErr:     true,
+			wantErrCode: errno.ResourceNotFoundCode,
+		},
 	}
 
 	for _, tt := range tests {
@@ -203,6 +266,7 @@ func TestPromptSourceEvalTargetServiceImpl_Execute(t *testing.T) {
 					assert.Equal(t, tt.wantOutputData.EvalTargetUsage.InputTokens, gotOutputData.EvalTargetUsage.InputTokens)
 					assert.Equal(t, tt.wantOutputData.EvalTargetUsage.OutputTokens, gotOutputData.EvalTargetUsage.OutputTokens)
 				}
+				assert.Equal(t, tt.wantOutputData.ResolvedSourceTargetVersion, gotOutputData.ResolvedSourceTargetVersion)
 				// Validate execution time
 				assert.NotNil(t, gotOutputData.TimeConsumingMS)
 				assert.GreaterOrEqual(t, *gotOutputData.TimeConsumingMS, int64(0))
@@ -230,6 +294,7 @@ func TestPromptSourceEvalTargetServiceImpl_BuildBySource(t *testing.T) {
 		name                string
 		sourceTargetID      string
 		sourceTargetVersion string
+		opts                []entity.Option
 		mockSetup           func()
 		wantEvalTargetCheck func(t *testing.T, evalTarget *entity.EvalTarget)
 		wantErr             bool
@@ -455,13 +520,57 @@ func TestPromptSourceEvalTargetServiceImpl_BuildBySource(t *testing.T) {
 				assert.Equal(t, int32(errno.ResourceNotFoundCode), statusErr.Code())
 			},
 		},
+		{
+			name:           "success scenario - bind by label",
+			sourceTargetID: defaultSourceTargetIDStr,
+			opts:           []entity.Option{entity.WithPromptLabel(gptr.Of("prod"))},
+			mockSetup: func() {
+				mockPromptRPCAdapter.EXPECT().MGetPrompt(
+					ctx,
+					defaultSpaceID,
+					[]*rpc.MGetPromptQuery{{PromptID: defaultSourceTargetIDInt, Label: gptr.Of("prod")}},
+				).Return([]*rpc.LoopPrompt{{
+					ID: defaultSourceTargetIDInt,
+					PromptCommit: &rpc.PromptCommit{
+						Detail: &rpc.PromptDetail{
+							PromptTemplate: &rpc.PromptTemplate{
+								VariableDefs: []*rpc.VariableDef{
+									{Key: gptr.Of("var1"), Type: gptr.Of(rpc.VariableTypeString)},
+								},
+							},
+						},
+						CommitInfo: &rpc.CommitInfo{Version: gptr.Of(defaultSourceTargetVersion)},
+					},
+				}}, nil)
+			},
+			wantEvalTargetCheck: func(t *testing.T, evalTarget *entity.EvalTarget) {
+				assert.NotNil(t, evalTarget)
+				assert.Equal(t, "prod", evalTarget.EvalTargetVersion.SourceTargetVersion)
+				assert.Equal(t, "prod", evalTarget.EvalTargetVersion.Prompt.Label)
+				assert.Equal(t, "", evalTarget.EvalTargetVersion.Prompt.Version)
+				assert.Len(t, evalTarget.EvalTargetVersion.InputSchema, 1)
+			},
+			wantErr: false,
+		},
+		{
+			name:           "failure scenario - label not found",
+			sourceTargetID: defaultSourceTargetIDStr,
+			opts:           []entity.Option{entity.WithPromptLabel(gptr.Of("prod"))},
+			mockSetup: func() {
+				mockPromptRPCAdapter.EXPECT().MGetPrompt(ctx, defaultSpaceID, gomock.Any()).Return(nil, nil)
+			},
+			wantEvalTargetCheck: func(t *testing.T, evalTarget *entity.EvalTarget) {
+				assert.Nil(t, evalTarget)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockSetup()
 
-			evalTarget, err := service.BuildBySource(ctx, defaultSpaceID, tt.sourceTargetID, tt.sourceTargetVersion)
+			evalTarget, err := service.BuildBySource(ctx, defaultSpaceID, tt.sourceTargetID, tt.sourceTargetVersion, tt.opts...)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -1885,6 +1994,7 @@ func TestPromptSourceEvalTargetServiceImpl_Execute_WithRuntimeParam(t *testing.T
 					assert.Equal(t, tt.wantOutputData.EvalTargetUsage.InputTokens, gotOutputData.EvalTargetUsage.InputTokens)
 					assert.Equal(t, tt.wantOutputData.EvalTargetUsage.OutputTokens, gotOutputData.EvalTargetUsage.OutputTokens)
 				}
+				assert.Equal(t, tt.wantOutputData.ResolvedSourceTargetVersion, gotOutputData.ResolvedSourceTargetVersion)
 				// Validate execution time
 				assert.NotNil(t, gotOutputData.TimeConsumingMS)
 				assert.GreaterOrEqual(t, *gotOutputData.TimeConsumingMS, int64(0))
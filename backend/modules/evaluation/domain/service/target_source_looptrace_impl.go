@@ -0,0 +1,250 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+const (
+	// 未指定 span 开始时间时向前查询的范围，超出观测数据保留期的部分由观测服务裁剪
+	loopTraceDefaultLookback = 365 * 24 * time.Hour
+	// 指定 span 开始时间时的查询范围
+	loopTraceWindowBefore = time.Hour
+	loopTraceWindowAfter  = 24 * time.Hour
+
+	loopTraceTagInputTokens  = "input_tokens"
+	loopTraceTagOutputTokens = "output_tokens"
+)
+
+func NewLoopTraceSourceEvalTargetServiceImpl(traceRPCAdapter rpc.ITraceRPCAdapter) ISourceEvalTargetOperateService {
+	return &LoopTraceSourceEvalTargetServiceImpl{
+		traceRPCAdapter: traceRPCAdapter,
+	}
+}
+
+// LoopTraceSourceEvalTargetServiceImpl trace 回放评测对象，从观测数据中读取线上流量的实际输出用于离线评测
+type LoopTraceSourceEvalTargetServiceImpl struct {
+	traceRPCAdapter rpc.ITraceRPCAdapter
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) EvalType() entity.EvalTargetType {
+	return entity.EvalTargetTypeLoopTrace
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) RuntimeParam() entity.IRuntimeParam {
+	return entity.NewDummyRuntimeParam()
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) ValidateInput(ctx context.Context, spaceID int64, inputSchema []*entity.ArgsSchema, input *entity.EvalTargetInputData) error {
+	return input.ValidateInputSchema(inputSchema)
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) Execute(ctx context.Context, spaceID int64, param *entity.ExecuteEvalTargetParam) (outputData *entity.EvalTargetOutputData, status entity.EvalTargetRunStatus, err error) {
+	start := time.Now()
+
+	outputData = &entity.EvalTargetOutputData{}
+	defer func() {
+		// 回放成功时耗时取 span 记录的耗时
+		if outputData.TimeConsumingMS == nil {
+			outputData.TimeConsumingMS = gptr.Of(time.Since(start).Milliseconds())
+		}
+		if err != nil {
+			outputData.EvalTargetRunError = &entity.EvalTargetRunError{}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				outputData.EvalTargetRunError.Code = statusErr.Code()
+				outputData.EvalTargetRunError.Message = statusErr.Error()
+			} else {
+				outputData.EvalTargetRunError.Code = errno.CommonInternalErrorCode
+				outputData.EvalTargetRunError.Message = err.Error()
+			}
+		}
+	}()
+
+	spanParam, err := buildLoopTraceSpanParam(spaceID, param.Input, time.Now())
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	spans, err := t.traceRPCAdapter.GetTraceSpans(ctx, spanParam)
+	if err != nil {
+		return outputData, entity.EvalTargetRunStatusFail, err
+	}
+	span := pickLoopTraceSpan(spans, spanParam.SpanIDs)
+	if span == nil {
+		return outputData, entity.EvalTargetRunStatusFail, errorx.NewByCode(errno.ResourceNotFoundCode,
+			errorx.WithExtraMsg("span not found, trace_id="+spanParam.TraceID+", span_id="+strings.Join(spanParam.SpanIDs, ",")))
+	}
+	loopTraceSpanOutput(outputData, span)
+
+	return outputData, entity.EvalTargetRunStatusSuccess, nil
+}
+
+func buildLoopTraceSpanParam(spaceID int64, input *entity.EvalTargetInputData, now time.Time) (*rpc.GetTraceSpansParam, error) {
+	fieldText := func(key string) string {
+		if input == nil || input.InputFields[key] == nil {
+			return ""
+		}
+		return strings.TrimSpace(gptr.Indirect(input.InputFields[key].Text))
+	}
+	p := &rpc.GetTraceSpansParam{
+		SpaceID:   spaceID,
+		TraceID:   fieldText(entity.LoopTraceInputKeyTraceID),
+		StartTime: now.Add(-loopTraceDefaultLookback).UnixMilli(),
+		EndTime:   now.UnixMilli(),
+	}
+	if p.TraceID == "" {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("trace_id is empty"))
+	}
+	if spanID := fieldText(entity.LoopTraceInputKeySpanID); spanID != "" {
+		p.SpanIDs = []string{spanID}
+	}
+	if startTime := fieldText(entity.LoopTraceInputKeyStartTime); startTime != "" {
+		startedAt, err := strconv.ParseInt(startTime, 10, 64)
+		if err != nil || startedAt <= 0 {
+			return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid start_time: "+startTime))
+		}
+		p.StartTime = startedAt - loopTraceWindowBefore.Milliseconds()
+		p.EndTime = startedAt + loopTraceWindowAfter.Milliseconds()
+	}
+	return p, nil
+}
+
+// pickLoopTraceSpan 指定 span_id 时取对应 span，否则取根节点
+func pickLoopTraceSpan(spans []*rpc.TraceSpan, spanIDs []string) *rpc.TraceSpan {
+	for _, span := range spans {
+		if span == nil {
+			continue
+		}
+		if len(spanIDs) > 0 {
+			if span.SpanID == spanIDs[0] {
+				return span
+			}
+			continue
+		}
+		if span.IsRoot() {
+			return span
+		}
+	}
+	return nil
+}
+
+// loopTraceSpanOutput 将 span 的输入、输出和标签映射为评测对象输出
+func loopTraceSpanOutput(outputData *entity.EvalTargetOutputData, span *rpc.TraceSpan) {
+	tags := make(map[string]string, len(span.SystemTags)+len(span.CustomTags))
+	for k, v := range span.SystemTags {
+		tags[k] = v
+	}
+	for k, v := range span.CustomTags {
+		tags[k] = v
+	}
+	outputData.OutputFields = map[string]*entity.Content{
+		consts.OutputSchemaKey: {
+			ContentType: gptr.Of(entity.ContentTypeText),
+			Format:      gptr.Of(entity.Markdown),
+			Text:        gptr.Of(span.Output),
+		},
+		entity.LoopTraceOutputKeySpanInput: {
+			ContentType: gptr.Of(entity.ContentTypeText),
+			Format:      gptr.Of(entity.Markdown),
+			Text:        gptr.Of(span.Input),
+		},
+		entity.LoopTraceOutputKeySpanTags: {
+			ContentType: gptr.Of(entity.ContentTypeText),
+			Format:      gptr.Of(entity.PlainText),
+			Text:        gptr.Of(json.Jsonify(tags)),
+		},
+	}
+	outputData.EvalTargetUsage = &entity.EvalTargetUsage{}
+	if v, err := strconv.ParseInt(tags[loopTraceTagInputTokens], 10, 64); err == nil {
+		outputData.EvalTargetUsage.InputTokens = v
+	}
+	if v, err := strconv.ParseInt(tags[loopTraceTagOutputTokens], 10, 64); err == nil {
+		outputData.EvalTargetUsage.OutputTokens = v
+	}
+	outputData.TimeConsumingMS = gptr.Of(span.DurationMS)
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, spaceID int64, sourceTargetID, sourceTargetVersion string, opts ...entity.Option) (*entity.EvalTarget, error) {
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	textSchema := func(key, jsonSchema string) *entity.ArgsSchema {
+		return &entity.ArgsSchema{
+			Key:                 gptr.Of(key),
+			SupportContentTypes: []entity.ContentType{entity.ContentTypeText},
+			JsonSchema:          gptr.Of(jsonSchema),
+		}
+	}
+	do := &entity.EvalTarget{
+		SpaceID:        spaceID,
+		SourceTargetID: sourceTargetID,
+		EvalTargetType: entity.EvalTargetTypeLoopTrace,
+		EvalTargetVersion: &entity.EvalTargetVersion{
+			SpaceID:             spaceID,
+			SourceTargetVersion: sourceTargetVersion,
+			EvalTargetType:      entity.EvalTargetTypeLoopTrace,
+			InputSchema: []*entity.ArgsSchema{
+				textSchema(entity.LoopTraceInputKeyTraceID, consts.StringJsonSchema),
+				textSchema(entity.LoopTraceInputKeySpanID, consts.StringJsonSchema),
+				textSchema(entity.LoopTraceInputKeyStartTime, consts.IntegerJsonSchema),
+			},
+			OutputSchema: []*entity.ArgsSchema{
+				textSchema(consts.OutputSchemaKey, consts.StringJsonSchema),
+				textSchema(entity.LoopTraceOutputKeySpanInput, consts.StringJsonSchema),
+				textSchema(entity.LoopTraceOutputKeySpanTags, consts.MapStringJsonSchema),
+			},
+			RuntimeParamDemo: gptr.Of(entity.NewDummyRuntimeParam().GetJSONDemo()),
+			BaseInfo: &entity.BaseInfo{
+				CreatedBy: &entity.UserInfo{
+					UserID: gptr.Of(userIDInContext),
+				},
+				UpdatedBy: &entity.UserInfo{
+					UserID: gptr.Of(userIDInContext),
+				},
+			},
+		},
+		BaseInfo: &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{
+				UserID: gptr.Of(userIDInContext),
+			},
+			UpdatedBy: &entity.UserInfo{
+				UserID: gptr.Of(userIDInContext),
+			},
+		},
+	}
+	return do, nil
+}
+
+// ListSource trace 回放没有可供选择的源对象
+func (t *LoopTraceSourceEvalTargetServiceImpl) ListSource(ctx context.Context, param *entity.ListSourceParam) (targets []*entity.EvalTarget, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) ListSourceVersion(ctx context.Context, param *entity.ListSourceVersionParam) (versions []*entity.EvalTargetVersion, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) BatchGetSource(ctx context.Context, spaceID int64, ids []string) (targets []*entity.EvalTarget, err error) {
+	return nil, nil
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) PackSourceInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	return nil
+}
+
+func (t *LoopTraceSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+)
+
+func newLoopTraceParam(fields map[string]string) *entity.ExecuteEvalTargetParam {
+	input := &entity.EvalTargetInputData{InputFields: map[string]*entity.Content{}}
+	for k, v := range fields {
+		input.InputFields[k] = &entity.Content{ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(v)}
+	}
+	return &entity.ExecuteEvalTargetParam{
+		TargetType: entity.EvalTargetTypeLoopTrace,
+		Input:      input,
+	}
+}
+
+func TestLoopTraceSourceEvalTargetServiceImpl_Execute(t *testing.T) {
+	spans := []*rpc.TraceSpan{
+		{TraceID: "t1", SpanID: "s2", ParentID: "s1", Input: "child input", Output: "child output", DurationMS: 20},
+		{
+			TraceID: "t1", SpanID: "s1", ParentID: "0", Input: "root input", Output: "root output", DurationMS: 120,
+			CustomTags: map[string]string{"input_tokens": "11", "output_tokens": "7", "env": "prod"},
+			SystemTags: map[string]string{"env": "sys", "runtime": "go"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		fields     map[string]string
+		mockSetup  func(adapter *mocks.MockITraceRPCAdapter)
+		wantStatus entity.EvalTargetRunStatus
+		wantOutput string
+		wantCode   int32
+	}{
+		{
+			name:   "root span by default",
+			fields: map[string]string{entity.LoopTraceInputKeyTraceID: " t1 "},
+			mockSetup: func(adapter *mocks.MockITraceRPCAdapter) {
+				adapter.EXPECT().GetTraceSpans(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param *rpc.GetTraceSpansParam) ([]*rpc.TraceSpan, error) {
+					assert.Equal(t, int64(100), param.SpaceID)
+					assert.Equal(t, "t1", param.TraceID)
+					assert.Empty(t, param.SpanIDs)
+					assert.Less(t, param.StartTime, param.EndTime)
+					return spans, nil
+				})
+			},
+			wantStatus: entity.EvalTargetRunStatusSuccess,
+			wantOutput: "root output",
+		},
+		{
+			name:   "span id and start time",
+			fields: map[string]string{entity.LoopTraceInputKeyTraceID: "t1", entity.LoopTraceInputKeySpanID: "s2", entity.LoopTraceInputKeyStartTime: "1700000000000"},
+			mockSetup: func(adapter *mocks.MockITraceRPCAdapter) {
+				adapter.EXPECT().GetTraceSpans(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param *rpc.GetTraceSpansParam) ([]*rpc.TraceSpan, error) {
+					assert.Equal(t, []string{"s2"}, param.SpanIDs)
+					assert.Equal(t, int64(1700000000000)-time.Hour.Milliseconds(), param.StartTime)
+					assert.Equal(t, int64(1700000000000)+(24*time.Hour).Milliseconds(), param.EndTime)
+					return spans, nil
+				})
+			},
+			wantStatus: entity.EvalTargetRunStatusSuccess,
+			wantOutput: "child output",
+		},
+		{
+			name:       "trace id missing",
+			fields:     map[string]string{},
+			mockSetup:  func(adapter *mocks.MockITraceRPCAdapter) {},
+			wantStatus: entity.EvalTargetRunStatusFail,
+			wantCode:   errno.CommonInvalidParamCode,
+		},
+		{
+			name:       "invalid start time",
+			fields:     map[string]string{entity.LoopTraceInputKeyTraceID: "t1", entity.LoopTraceInputKeyStartTime: "yesterday"},
+			mockSetup:  func(adapter *mocks.MockITraceRPCAdapter) {},
+			wantStatus: entity.EvalTargetRunStatusFail,
+			wantCode:   errno.CommonInvalidParamCode,
+		},
+		{
+			name:   "span not found",
+			fields: map[string]string{entity.LoopTraceInputKeyTraceID: "t1", entity.LoopTraceInputKeySpanID: "s9"},
+			mockSetup: func(adapter *mocks.MockITraceRPCAdapter) {
+				adapter.EXPECT().GetTraceSpans(gomock.Any(), gomock.Any()).Return(spans, nil)
+			},
+			wantStatus: entity.EvalTargetRunStatusFail,
+			wantCode:   errno.ResourceNotFoundCode,
+		},
+		{
+			name:   "adapter error",
+			fields: map[string]string{entity.LoopTraceInputKeyTraceID: "t1"},
+			mockSetup: func(adapter *mocks.MockITraceRPCAdapter) {
+				adapter.EXPECT().GetTraceSpans(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error"))
+			},
+			wantStatus: entity.EvalTargetRunStatusFail,
+			wantCode:   errno.CommonInternalErrorCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			adapter := mocks.NewMockITraceRPCAdapter(ctrl)
+			tt.mockSetup(adapter)
+			svc := NewLoopTraceSourceEvalTargetServiceImpl(adapter)
+
+			output, status, err := svc.Execute(context.Background(), 100, newLoopTraceParam(tt.fields))
+			assert.Equal(t, tt.wantStatus, status)
+			assert.NotNil(t, output.TimeConsumingMS)
+			if tt.wantCode != 0 {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantCode, output.EvalTargetRunError.Code)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOutput, gptr.Indirect(output.OutputFields[consts.OutputSchemaKey].Text))
+		})
+	}
+}
+
+func Test_loopTraceSpanOutput(t *testing.T) {
+	outputData := &entity.EvalTargetOutputData{}
+	loopTraceSpanOutput(outputData, &rpc.TraceSpan{
+		Input:      "question",
+		Output:     "answer",
+		DurationMS: 120,
+		CustomTags: map[string]string{"input_tokens": "11", "output_tokens": "7", "env": "prod"},
+		SystemTags: map[string]string{"env": "sys", "runtime": "go"},
+	})
+	assert.Equal(t, "answer", gptr.Indirect(outputData.OutputFields[consts.OutputSchemaKey].Text))
+	assert.Equal(t, "question", gptr.Indirect(outputData.OutputFields[entity.LoopTraceOutputKeySpanInput].Text))
+	assert.JSONEq(t, `{"env":"prod","runtime":"go","input_tokens":"11","output_tokens":"7"}`,
+		gptr.Indirect(outputData.OutputFields[entity.LoopTraceOutputKeySpanTags].Text))
+	assert.Equal(t, int64(11), outputData.EvalTargetUsage.InputTokens)
+	assert.Equal(t, int64(7), outputData.EvalTargetUsage.OutputTokens)
+	assert.Equal(t, int64(120), gptr.Indirect(outputData.TimeConsumingMS))
+}
+
+func TestLoopTraceSourceEvalTargetServiceImpl_BuildBySource(t *testing.T) {
+	svc := NewLoopTraceSourceEvalTargetServiceImpl(nil)
+	assert.Equal(t, entity.EvalTargetTypeLoopTrace, svc.EvalType())
+	assert.Equal(t, "{}", svc.RuntimeParam().GetJSONDemo())
+
+	do, err := svc.BuildBySource(context.Background(), 100, "online_traffic", "v1")
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvalTargetTypeLoopTrace, do.EvalTargetType)
+	assert.Equal(t, "online_traffic", do.SourceTargetID)
+	assert.Equal(t, "v1", do.EvalTargetVersion.SourceTargetVersion)
+	assert.Len(t, do.EvalTargetVersion.InputSchema, 3)
+	assert.Equal(t, gptr.Of(entity.LoopTraceInputKeyTraceID), do.EvalTargetVersion.InputSchema[0].Key)
+	assert.Len(t, do.EvalTargetVersion.OutputSchema, 3)
+	assert.Equal(t, gptr.Of(consts.OutputSchemaKey), do.EvalTargetVersion.OutputSchema[0].Key)
+
+	assert.NoError(t, svc.ValidateInput(context.Background(), 100, do.EvalTargetVersion.InputSchema, newLoopTraceParam(map[string]string{
+		entity.LoopTraceInputKeyTraceID:   "t1",
+		entity.LoopTraceInputKeyStartTime: "1700000000000",
+	}).Input))
+	assert.Error(t, svc.ValidateInput(context.Background(), 100, do.EvalTargetVersion.InputSchema, newLoopTraceParam(map[string]string{
+		entity.LoopTraceInputKeyStartTime: "yesterday",
+	}).Input))
+
+	targets, _, hasMore, err := svc.ListSource(context.Background(), &entity.ListSourceParam{})
+	assert.NoError(t, err)
+	assert.Empty(t, targets)
+	assert.False(t, hasMore)
+	assert.NoError(t, svc.PackSourceInfo(context.Background(), 100, []*entity.EvalTarget{do}))
+	assert.NoError(t, svc.PackSourceVersionInfo(context.Background(), 100, []*entity.EvalTarget{do}))
+}
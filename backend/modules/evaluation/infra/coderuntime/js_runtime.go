@@ -0,0 +1,175 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package coderuntime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/coderuntime"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+)
+
+var (
+	errInterruptTimeout = errors.New("code execute timeout")
+	errMemoryLimit      = errors.New("code execute memory limit exceeded")
+)
+
+// jsRuntime 基于 goja 的 JavaScript 运行时。每次执行都使用独立的 VM，
+// VM 中只暴露 console 对象，不提供文件、网络、定时器等宿主能力。
+// 配置了内存上限时 VM 运行在独立的子进程中，由子进程的资源限制约束单次执行的内存，
+// 见 runInSandbox
+type jsRuntime struct{}
+
+func NewJSRuntime() coderuntime.ICodeRuntime {
+	return &jsRuntime{}
+}
+
+func (r *jsRuntime) LanguageType() entity.LanguageType {
+	return entity.LanguageTypeJS
+}
+
+func (r *jsRuntime) Run(ctx context.Context, param *entity.CodeRunParam) (result *entity.CodeRunResult, err error) {
+	if param.MaxMemoryBytes > 0 {
+		return runInSandbox(ctx, param)
+	}
+	defer goroutine.Recover(ctx, &err)
+	result, runErr := runVM(ctx, param)
+	if runErr != nil {
+		return nil, runErr.toStatusError()
+	}
+	return result, nil
+}
+
+// runError 执行失败的错误码与信息，在子进程中执行时随结果一起回传
+type runError struct {
+	Code int32  `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func newRunError(code int32, msg string) *runError {
+	return &runError{Code: code, Msg: msg}
+}
+
+func (e *runError) toStatusError() error {
+	return errorx.NewByCode(e.Code, errorx.WithExtraMsg(e.Msg))
+}
+
+// runVM 在当前进程内创建 VM 并执行入口函数，不限制内存
+func runVM(ctx context.Context, param *entity.CodeRunParam) (*entity.CodeRunResult, *runError) {
+	vm := goja.New()
+	vm.SetFieldNameMapper(goja.TagFieldNameMapper("json", true))
+	if param.MaxCallStackSize > 0 {
+		vm.SetMaxCallStackSize(param.MaxCallStackSize)
+	}
+
+	logs := &logCollector{limit: param.MaxOutputBytes}
+	console := vm.NewObject()
+	if err := console.Set("log", logs.log); err != nil {
+		return nil, newRunError(errno.CodeExecuteFailCode, err.Error())
+	}
+	if err := vm.Set("console", console); err != nil {
+		return nil, newRunError(errno.CodeExecuteFailCode, err.Error())
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	timer := time.AfterFunc(runTimeout(param), func() { vm.Interrupt(errInterruptTimeout) })
+	defer timer.Stop()
+	go watchContext(ctx, vm, done)
+
+	program, err := goja.Compile("", param.Code, false)
+	if err != nil {
+		return nil, newRunError(errno.InvalidCodeContentCode, err.Error())
+	}
+	if _, err := vm.RunProgram(program); err != nil {
+		return nil, convertJSError(err)
+	}
+	entry, ok := goja.AssertFunction(vm.Get(entity.CodeEvaluatorEntryFunction))
+	if !ok {
+		return nil, newRunError(errno.InvalidCodeContentCode, fmt.Sprintf("function %s is not defined", entity.CodeEvaluatorEntryFunction))
+	}
+	ret, err := entry(goja.Undefined(), vm.ToValue(param.Input))
+	if err != nil {
+		return nil, convertJSError(err)
+	}
+
+	result := &entity.CodeRunResult{
+		Logs: logs.lines,
+	}
+	if ret != nil && !goja.IsUndefined(ret) && !goja.IsNull(ret) {
+		result.Output = ret.Export()
+	}
+	if param.MaxOutputBytes > 0 {
+		bytes, err := json.Marshal(result.Output)
+		if err != nil {
+			return nil, newRunError(errno.CodeExecuteFailCode, fmt.Sprintf("output is not serializable: %v", err))
+		}
+		if len(bytes) > param.MaxOutputBytes {
+			return nil, newRunError(errno.CodeExecuteFailCode, fmt.Sprintf("output exceeds %d bytes", param.MaxOutputBytes))
+		}
+	}
+	return result, nil
+}
+
+func runTimeout(param *entity.CodeRunParam) time.Duration {
+	if param.Timeout <= 0 {
+		return entity.DefaultCodeEvaluatorRuntimeConf().GetTimeout()
+	}
+	return param.Timeout
+}
+
+// watchContext 在执行期间监听 ctx 取消并中断 VM
+func watchContext(ctx context.Context, vm *goja.Runtime, done <-chan struct{}) {
+	select {
+	case <-ctx.Done():
+		vm.Interrupt(ctx.Err())
+	case <-done:
+	}
+}
+
+func convertJSError(err error) *runError {
+	var interrupted *goja.InterruptedError
+	if errors.As(err, &interrupted) {
+		if interrupted.Value() == errInterruptTimeout {
+			return newRunError(errno.CodeExecuteTimeoutCode, "")
+		}
+		return newRunError(errno.CodeExecuteFailCode, fmt.Sprintf("interrupted: %v", interrupted.Value()))
+	}
+	var stackOverflow *goja.StackOverflowError
+	if errors.As(err, &stackOverflow) {
+		return newRunError(errno.CodeExecuteFailCode, "max call stack size exceeded")
+	}
+	return newRunError(errno.CodeExecuteFailCode, err.Error())
+}
+
+// logCollector 收集 console.log 输出，超过上限的部分直接丢弃
+type logCollector struct {
+	limit int
+	size  int
+	lines []string
+}
+
+func (l *logCollector) log(call goja.FunctionCall) goja.Value {
+	args := make([]string, 0, len(call.Arguments))
+	for _, arg := range call.Arguments {
+		args = append(args, arg.String())
+	}
+	line := strings.Join(args, " ")
+	if l.limit > 0 && l.size+len(line) > l.limit {
+		return goja.Undefined()
+	}
+	l.size += len(line)
+	l.lines = append(l.lines, line)
+	return goja.Undefined()
+}
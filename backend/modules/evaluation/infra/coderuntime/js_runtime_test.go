@@ -0,0 +1,212 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package coderuntime
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func TestJSRuntime_Run(t *testing.T) {
+	r := NewJSRuntime()
+	assert.Equal(t, entity.LanguageTypeJS, r.LanguageType())
+
+	tests := []struct {
+		name     string
+		param    *entity.CodeRunParam
+		wantOut  any
+		wantLogs []string
+		wantCode int32
+	}{
+		{
+			name: "exact match returns object",
+			param: &entity.CodeRunParam{
+				Code: `function exec_evaluation(input) {
+					console.log("output:", input.output)
+					const ok = input.output === input.reference
+					return {score: ok ? 1 : 0, reason: ok ? "match" : "mismatch"}
+				}`,
+				Input:   map[string]any{"output": "foo", "reference": "foo"},
+				Timeout: time.Second,
+			},
+			wantOut:  map[string]any{"score": int64(1), "reason": "match"},
+			wantLogs: []string{"output: foo"},
+		},
+		{
+			name: "regex returns boolean",
+			param: &entity.CodeRunParam{
+				Code:    `function exec_evaluation(input) { return /^\d+$/.test(input.output) }`,
+				Input:   map[string]any{"output": "12345"},
+				Timeout: time.Second,
+			},
+			wantOut: true,
+		},
+		{
+			name: "entry function missing",
+			param: &entity.CodeRunParam{
+				Code:    `var a = 1`,
+				Timeout: time.Second,
+			},
+			wantCode: errno.InvalidCodeContentCode,
+		},
+		{
+			name: "syntax error",
+			param: &entity.CodeRunParam{
+				Code:    `function exec_evaluation(input) {`,
+				Timeout: time.Second,
+			},
+			wantCode: errno.InvalidCodeContentCode,
+		},
+		{
+			name: "runtime exception",
+			param: &entity.CodeRunParam{
+				Code:    `function exec_evaluation(input) { throw new Error("boom") }`,
+				Timeout: time.Second,
+			},
+			wantCode: errno.CodeExecuteFailCode,
+		},
+		{
+			name: "infinite loop is interrupted",
+			param: &entity.CodeRunParam{
+				Code:    `function exec_evaluation(input) { while (true) {} }`,
+				Timeout: 50 * time.Millisecond,
+			},
+			wantCode: errno.CodeExecuteTimeoutCode,
+		},
+		{
+			name: "unbounded recursion hits call stack limit",
+			param: &entity.CodeRunParam{
+				Code:             `function f(n) { return f(n + 1) } function exec_evaluation(input) { return f(0) }`,
+				Timeout:          time.Second,
+				MaxCallStackSize: 64,
+			},
+			wantCode: errno.CodeExecuteFailCode,
+		},
+		{
+			name: "output exceeds limit",
+			param: &entity.CodeRunParam{
+				Code:           `function exec_evaluation(input) { return {score: 1, reason: "x".repeat(100)} }`,
+				Timeout:        time.Second,
+				MaxOutputBytes: 32,
+			},
+			wantCode: errno.CodeExecuteFailCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := r.Run(context.Background(), tt.param)
+			if tt.wantCode != 0 {
+				assert.Error(t, err)
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantCode, statusErr.Code())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, res.Output)
+			assert.Equal(t, tt.wantLogs, res.Logs)
+		})
+	}
+}
+
+func TestJSRuntime_Run_MemoryLimit(t *testing.T) {
+	start := time.Now()
+	_, err := NewJSRuntime().Run(context.Background(), &entity.CodeRunParam{
+		Code:           `function exec_evaluation(input) { let a = []; while (true) a.push('x'.repeat(1e6)) }`,
+		Timeout:        10 * time.Second,
+		MaxMemoryBytes: 64 * 1024 * 1024,
+	})
+	statusErr, ok := errorx.FromStatusError(err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(errno.CodeExecuteFailCode), statusErr.Code())
+	assert.Contains(t, statusErr.Error(), "memory limit exceeded")
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
+
+func TestJSRuntime_Run_Sandbox(t *testing.T) {
+	res, err := NewJSRuntime().Run(context.Background(), &entity.CodeRunParam{
+		Code: `function exec_evaluation(input) {
+			console.log("output:", input.output)
+			return {score: input.output === input.reference ? 1 : 0, reason: "done"}
+		}`,
+		Input:          map[string]any{"output": "foo", "reference": "foo"},
+		Timeout:        time.Second,
+		MaxMemoryBytes: 64 * 1024 * 1024,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"score": float64(1), "reason": "done"}, res.Output)
+	assert.Equal(t, []string{"output: foo"}, res.Logs)
+
+	_, err = NewJSRuntime().Run(context.Background(), &entity.CodeRunParam{
+		Code:           `function exec_evaluation(input) { while (true) {} }`,
+		Timeout:        50 * time.Millisecond,
+		MaxMemoryBytes: 64 * 1024 * 1024,
+	})
+	statusErr, ok := errorx.FromStatusError(err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(errno.CodeExecuteTimeoutCode), statusErr.Code())
+}
+
+// 内存上限只约束单次执行，同时运行的评估器互不影响
+func TestJSRuntime_Run_ConcurrentMemoryLimit(t *testing.T) {
+	r := NewJSRuntime()
+	var (
+		wg                sync.WaitGroup
+		hogErr, normalErr error
+		normalRes         *entity.CodeRunResult
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, hogErr = r.Run(context.Background(), &entity.CodeRunParam{
+			Code:           `function exec_evaluation(input) { let a = []; while (true) a.push('x'.repeat(1e6)) }`,
+			Timeout:        10 * time.Second,
+			MaxMemoryBytes: 64 * 1024 * 1024,
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		normalRes, normalErr = r.Run(context.Background(), &entity.CodeRunParam{
+			Code: `function exec_evaluation(input) {
+				let a = []
+				for (let i = 0; i < 16; i++) a.push(String(i).repeat(1e6))
+				return a.length
+			}`,
+			Timeout:        10 * time.Second,
+			MaxMemoryBytes: 64 * 1024 * 1024,
+		})
+	}()
+	wg.Wait()
+
+	statusErr, ok := errorx.FromStatusError(hogErr)
+	assert.True(t, ok)
+	assert.Contains(t, statusErr.Error(), "memory limit exceeded")
+	assert.NoError(t, normalErr)
+	if assert.NotNil(t, normalRes) {
+		assert.Equal(t, float64(16), normalRes.Output)
+	}
+}
+
+func TestJSRuntime_Run_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	_, err := NewJSRuntime().Run(ctx, &entity.CodeRunParam{
+		Code:    `function exec_evaluation(input) { while (true) {} }`,
+		Timeout: 10 * time.Second,
+	})
+	statusErr, ok := errorx.FromStatusError(err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(errno.CodeExecuteFailCode), statusErr.Code())
+}
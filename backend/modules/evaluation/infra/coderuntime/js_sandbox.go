@@ -0,0 +1,160 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package coderuntime
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+const (
+	// sandboxEnvKey 子进程通过该环境变量识别自己是代码执行沙箱
+	sandboxEnvKey = "COZE_LOOP_JS_SANDBOX"
+	// sandboxStartupGrace 子进程启动及回传结果的额外耗时，超过 Timeout 加该值后直接杀死子进程
+	sandboxStartupGrace = 2 * time.Second
+	// sandboxMaxStderrBytes 保留的子进程 stderr 上限，用于判断退出原因
+	sandboxMaxStderrBytes = 4 * 1024
+)
+
+// sandboxResult 子进程通过 fd 3 回传的执行结果
+type sandboxResult struct {
+	Output any       `json:"output"`
+	Logs   []string  `json:"logs"`
+	Error  *runError `json:"error,omitempty"`
+}
+
+func init() {
+	if os.Getenv(sandboxEnvKey) != "1" {
+		return
+	}
+	os.Exit(sandboxMain())
+}
+
+// runInSandbox 以当前可执行文件启动子进程执行代码。子进程只服务于一次执行，
+// 内存上限通过进程级资源限制实现，超限时子进程退出，不影响同时执行的其他评估器
+func runInSandbox(ctx context.Context, param *entity.CodeRunParam) (*entity.CodeRunResult, error) {
+	input, err := json.Marshal(param)
+	if err != nil {
+		return nil, errorx.NewByCode(errno.CodeExecuteFailCode, errorx.WithExtraMsg(fmt.Sprintf("input is not serializable: %v", err)))
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.CodeExecuteFailCode, errorx.WithExtraMsg("locate sandbox executable failed"))
+	}
+	resultReader, resultWriter, err := os.Pipe()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.CodeExecuteFailCode, errorx.WithExtraMsg("create sandbox pipe failed"))
+	}
+	defer resultReader.Close()
+
+	runCtx, cancel := context.WithTimeout(ctx, runTimeout(param)+sandboxStartupGrace)
+	defer cancel()
+	stderr := &limitedBuffer{limit: sandboxMaxStderrBytes}
+	cmd := exec.CommandContext(runCtx, exe)
+	cmd.Env = append(os.Environ(), sandboxEnvKey+"=1")
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = stderr
+	cmd.ExtraFiles = []*os.File{resultWriter}
+	err = cmd.Start()
+	_ = resultWriter.Close()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.CodeExecuteFailCode, errorx.WithExtraMsg("start sandbox failed"))
+	}
+	output, readErr := io.ReadAll(resultReader)
+	if err := cmd.Wait(); err != nil {
+		switch {
+		case ctx.Err() != nil:
+			return nil, errorx.NewByCode(errno.CodeExecuteFailCode, errorx.WithExtraMsg(fmt.Sprintf("interrupted: %v", ctx.Err())))
+		case runCtx.Err() != nil:
+			return nil, errorx.NewByCode(errno.CodeExecuteTimeoutCode)
+		case strings.Contains(stderr.String(), "out of memory"):
+			return nil, errorx.NewByCode(errno.CodeExecuteFailCode, errorx.WithExtraMsg(errMemoryLimit.Error()))
+		}
+		return nil, errorx.WrapByCode(err, errno.CodeExecuteFailCode, errorx.WithExtraMsg(fmt.Sprintf("sandbox exited abnormally: %s", strings.TrimSpace(stderr.String()))))
+	}
+	if readErr != nil {
+		return nil, errorx.WrapByCode(readErr, errno.CodeExecuteFailCode, errorx.WithExtraMsg("read sandbox result failed"))
+	}
+	res := &sandboxResult{}
+	if err := json.Unmarshal(output, res); err != nil {
+		return nil, errorx.WrapByCode(err, errno.CodeExecuteFailCode, errorx.WithExtraMsg("decode sandbox result failed"))
+	}
+	if res.Error != nil {
+		return nil, res.Error.toStatusError()
+	}
+	return &entity.CodeRunResult{Output: res.Output, Logs: res.Logs}, nil
+}
+
+// sandboxMain 子进程入口：从 stdin 读取执行参数，限制本进程内存后执行，结果写入 fd 3
+func sandboxMain() int {
+	out := os.NewFile(3, "sandbox-result")
+	if out == nil {
+		fmt.Fprintln(os.Stderr, "sandbox result fd is missing")
+		return 1
+	}
+	defer out.Close()
+
+	param := &entity.CodeRunParam{}
+	if err := json.Decode(os.Stdin, param); err != nil {
+		fmt.Fprintf(os.Stderr, "decode sandbox param failed: %v\n", err)
+		return 1
+	}
+	// JS 执行是单线程的，限制 P 的数量以减少运行时线程带来的额外内存
+	runtime.GOMAXPROCS(2)
+	debug.SetMemoryLimit(param.MaxMemoryBytes)
+	if err := limitProcessMemory(param.MaxMemoryBytes); err != nil {
+		fmt.Fprintf(os.Stderr, "limit sandbox memory failed: %v\n", err)
+		return 1
+	}
+
+	res := &sandboxResult{}
+	if result, runErr := runVM(context.Background(), param); runErr != nil {
+		res.Error = runErr
+	} else {
+		res.Output, res.Logs = result.Output, result.Logs
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		data, err = json.Marshal(&sandboxResult{Error: newRunError(errno.CodeExecuteFailCode, fmt.Sprintf("output is not serializable: %v", err))})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "encode sandbox result failed: %v\n", err)
+			return 1
+		}
+	}
+	if _, err := out.Write(data); err != nil {
+		fmt.Fprintf(os.Stderr, "write sandbox result failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// limitedBuffer 只保留前 limit 字节的输出，避免子进程大量输出占用父进程内存
+type limitedBuffer struct {
+	limit int
+	buf   bytes.Buffer
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remain := b.limit - b.buf.Len(); remain > 0 {
+		b.buf.Write(p[:min(len(p), remain)])
+	}
+	return len(p), nil
+}
+
+func (b *limitedBuffer) String() string {
+	return b.buf.String()
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package coderuntime
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// limitProcessMemory 以 RLIMIT_DATA 限制本进程后续可申请的数据段内存。
+// 上限在当前已占用的数据段之上累加，运行时已有的开销不计入单次执行的额度；
+// Go 运行时申请内存失败时会以 out of memory 退出进程
+func limitProcessMemory(maxBytes int64) error {
+	// 提前触发一次 GC，让 GC 相关的后台线程在计算基线前创建完成
+	runtime.GC()
+	used, err := dataSegmentBytes()
+	if err != nil {
+		return err
+	}
+	limit := used + uint64(maxBytes)
+	return syscall.Setrlimit(syscall.RLIMIT_DATA, &syscall.Rlimit{Cur: limit, Max: limit})
+}
+
+func dataSegmentBytes() (uint64, error) {
+	f, err := os.Open("/proc/self/status")
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// 格式如 "VmData:    12345 kB"
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "VmData:" {
+			continue
+		}
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		return kb * 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("VmData not found in /proc/self/status")
+}
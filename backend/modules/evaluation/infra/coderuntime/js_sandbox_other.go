@@ -0,0 +1,11 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package coderuntime
+
+// limitProcessMemory 非 Linux 平台没有可靠的数据段限制，仅依赖 debug.SetMemoryLimit 让 GC 尽量回收
+func limitProcessMemory(maxBytes int64) error {
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/httpclient"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// maxResponseBodyBytes 响应体大小上限，避免异常服务返回超大响应占满内存
+const maxResponseBodyBytes = 10 << 20
+
+type client struct {
+	cli *http.Client
+}
+
+// dialHostKey 建立连接前记录请求中的 host，用于在校验解析后的地址时匹配域名白名单
+type dialHostKey struct{}
+
+type dialControl func(ctx context.Context, network, address string, c syscall.RawConn) error
+
+// NewHTTPClient 建立连接时校验解析后的目标地址，拒绝访问内网、回环等地址，重定向与 DNS 重绑定同样会被拦截。
+// 可访问的内网范围由评测配置 http_target_network_conf 指定。不使用环境变量中的代理，避免经由代理绕过地址校验
+func NewHTTPClient(configer component.IConfiger) httpclient.IHTTPClient {
+	return newClient(func(ctx context.Context, network, address string, c syscall.RawConn) error {
+		return checkDialAddress(ctx, configer.GetHTTPTargetNetworkConf(ctx), address)
+	})
+}
+
+func newClient(control dialControl) *client {
+	dialer := &net.Dialer{
+		Timeout:        30 * time.Second,
+		KeepAlive:      30 * time.Second,
+		ControlContext: control,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			ctx = context.WithValue(ctx, dialHostKey{}, host)
+		}
+		return dialer.DialContext(ctx, network, address)
+	}
+	return &client{cli: &http.Client{Transport: transport}}
+}
+
+// checkDialAddress address 为解析后的地址，请求中的域名在白名单内时允许其解析到内网地址
+func checkDialAddress(ctx context.Context, netConf *entity.HTTPTargetNetworkConf, address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	dialHost, _ := ctx.Value(dialHostKey{}).(string)
+	if ip == nil || netConf.IsForbiddenIP(ip, netConf.IsAllowedHost(dialHost)) {
+		return fmt.Errorf("address %s is not allowed", host)
+	}
+	return nil
+}
+
+func (c *client) Do(ctx context.Context, req *entity.HTTPRequest) (*entity.HTTPResponse, error) {
+	if req.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, req.Timeout)
+		defer cancel()
+	}
+	var body io.Reader
+	if len(req.Body) > 0 {
+		body = bytes.NewReader(req.Body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, req.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range req.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	resp, err := c.cli.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(respBody) > maxResponseBodyBytes {
+		return nil, fmt.Errorf("response body exceeds %d bytes", maxResponseBodyBytes)
+	}
+	return &entity.HTTPResponse{
+		StatusCode: resp.StatusCode,
+		Body:       respBody,
+	}, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestClient_Do(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/echo":
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(r.Method + " " + r.Header.Get("X-Token") + " " + string(body)))
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		}
+	}))
+	defer srv.Close()
+
+	cli := newClient(nil)
+	resp, err := cli.Do(context.Background(), &entity.HTTPRequest{
+		Method:  http.MethodPost,
+		URL:     srv.URL + "/echo",
+		Headers: map[string]string{"X-Token": "t"},
+		Body:    []byte(`{"a":1}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, `POST t {"a":1}`, string(resp.Body))
+
+	_, err = cli.Do(context.Background(), &entity.HTTPRequest{
+		Method:  http.MethodGet,
+		URL:     srv.URL + "/slow",
+		Timeout: 20 * time.Millisecond,
+	})
+	assert.Error(t, err)
+
+	_, err = cli.Do(context.Background(), &entity.HTTPRequest{Method: "BAD METHOD", URL: srv.URL})
+	assert.Error(t, err)
+}
+
+func TestClient_Do_ForbiddenAddress(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configer := mocks.NewMockIConfiger(ctrl)
+	configer.EXPECT().GetHTTPTargetNetworkConf(gomock.Any()).Return(&entity.HTTPTargetNetworkConf{
+		AllowedCIDRs: []string{"127.0.0.0/8"},
+	}).AnyTimes()
+
+	// 回环地址即使在白名单中也不允许访问
+	_, err := NewHTTPClient(configer).Do(context.Background(), &entity.HTTPRequest{Method: http.MethodGet, URL: srv.URL})
+	assert.ErrorContains(t, err, "is not allowed")
+
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, srv.URL, http.StatusFound)
+	}))
+	defer redirect.Close()
+	_, err = newClient(func(ctx context.Context, network, address string, c syscall.RawConn) error {
+		if address == redirect.Listener.Addr().String() {
+			return nil
+		}
+		return checkDialAddress(ctx, nil, address)
+	}).Do(context.Background(), &entity.HTTPRequest{Method: http.MethodGet, URL: redirect.URL})
+	assert.ErrorContains(t, err, "is not allowed")
+}
+
+func TestCheckDialAddress(t *testing.T) {
+	conf := &entity.HTTPTargetNetworkConf{
+		AllowedCIDRs: []string{"10.0.0.0/8"},
+		AllowedHosts: []string{".svc.cluster.local"},
+	}
+	hostCtx := func(host string) context.Context {
+		return context.WithValue(context.Background(), dialHostKey{}, host)
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		conf    *entity.HTTPTargetNetworkConf
+		address string
+		wantErr bool
+	}{
+		{name: "public address", ctx: context.Background(), address: "8.8.8.8:443"},
+		{name: "private address without allowlist", ctx: context.Background(), address: "10.0.0.8:80", wantErr: true},
+		{name: "private address in allowed cidr", ctx: context.Background(), conf: conf, address: "10.0.0.8:80"},
+		{name: "private address resolved from allowed host", ctx: hostCtx("api.ns.svc.cluster.local"), conf: conf, address: "192.168.0.8:80"},
+		{name: "private address resolved from other host", ctx: hostCtx("api.example.com"), conf: conf, address: "192.168.0.8:80", wantErr: true},
+		{name: "metadata address resolved from allowed host", ctx: hostCtx("api.ns.svc.cluster.local"), conf: conf, address: "169.254.169.254:80", wantErr: true},
+		{name: "bad address", ctx: context.Background(), address: "8.8.8.8", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDialAddress(tt.ctx, tt.conf, tt.address)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -44,10 +44,10 @@ func (e *ExptExportConsumer) HandleMessage(ctx context.Context, ext *mq.MessageE
 }
 
 func (e *ExptExportConsumer) handleEvent(ctx context.Context, event *entity.ExportCSVEvent) (err error) {
-	err = e.exptResultExportService.DoExportCSV(ctx, event.SpaceID, event.ExperimentID, event.ExportID)
+	err = e.exptResultExportService.DoExport(ctx, event.SpaceID, event.ExperimentID, event.ExportID, event.Format)
 	if err != nil {
 		// 不进行重试
-		logs.CtxError(ctx, "ExptExportConsumer DoExportCSV fail, expt_id:%v, err: %v", event.ExperimentID, err)
+		logs.CtxError(ctx, "ExptExportConsumer DoExport fail, expt_id:%v, err: %v", event.ExperimentID, err)
 		return nil
 	}
 
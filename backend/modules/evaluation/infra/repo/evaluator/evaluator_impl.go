@@ -122,14 +122,14 @@ func (r *EvaluatorRepoImpl) BatchGetEvaluatorByVersionID(ctx context.Context, sp
 			continue
 		}
 		switch *evaluatorVersionPO.EvaluatorType {
-		case int32(entity.EvaluatorTypePrompt):
+		case int32(entity.EvaluatorTypePrompt), int32(entity.EvaluatorTypeCode), int32(entity.EvaluatorTypeBuiltin), int32(entity.EvaluatorTypePairwise):
 			evaluatorVersionDO, err := convertor.ConvertEvaluatorVersionPO2DO(evaluatorVersionPO)
 			if err != nil {
 				return nil, err
 			}
 			evaluatorDO := convertor.ConvertEvaluatorPO2DO(evaluatorMap[evaluatorVersionPO.EvaluatorID])
-			evaluatorDO.PromptEvaluatorVersion = evaluatorVersionDO.PromptEvaluatorVersion
-			evaluatorDO.EvaluatorType = entity.EvaluatorTypePrompt
+			evaluatorDO.EvaluatorType = entity.EvaluatorType(*evaluatorVersionPO.EvaluatorType)
+			evaluatorDO.SetEvaluatorVersion(evaluatorVersionDO)
 			evaluatorDOList = append(evaluatorDOList, evaluatorDO)
 		default:
 			continue
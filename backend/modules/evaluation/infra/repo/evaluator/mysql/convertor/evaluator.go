@@ -128,6 +128,51 @@ func ConvertEvaluatorVersionDO2PO(do *evaluatordo.Evaluator) (*model.EvaluatorVe
 		po.Metainfo = ptr.Of(metaInfoByte)
 		po.ReceiveChatHistory = do.PromptEvaluatorVersion.ReceiveChatHistory
 		po.ID = do.PromptEvaluatorVersion.ID
+	case evaluatordo.EvaluatorTypeCode:
+		// 序列化Metainfo（整个DO）
+		metaInfoByte, err := json.Marshal(do.CodeEvaluatorVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		// 序列化InputSchema
+		inputSchemaByte, err := json.Marshal(do.CodeEvaluatorVersion.InputSchemas)
+		if err != nil {
+			return nil, err
+		}
+		po.InputSchema = ptr.Of(inputSchemaByte)
+		po.Metainfo = ptr.Of(metaInfoByte)
+		po.ID = do.CodeEvaluatorVersion.ID
+	case evaluatordo.EvaluatorTypeBuiltin:
+		// 序列化Metainfo（整个DO）
+		metaInfoByte, err := json.Marshal(do.BuiltinEvaluatorVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		// 序列化InputSchema
+		inputSchemaByte, err := json.Marshal(do.BuiltinEvaluatorVersion.InputSchemas)
+		if err != nil {
+			return nil, err
+		}
+		po.InputSchema = ptr.Of(inputSchemaByte)
+		po.Metainfo = ptr.Of(metaInfoByte)
+		po.ID = do.BuiltinEvaluatorVersion.ID
+	case evaluatordo.EvaluatorTypePairwise:
+		// 序列化Metainfo（整个DO）
+		metaInfoByte, err := json.Marshal(do.PairwiseEvaluatorVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		// 序列化InputSchema
+		inputSchemaByte, err := json.Marshal(do.PairwiseEvaluatorVersion.InputSchemas)
+		if err != nil {
+			return nil, err
+		}
+		po.InputSchema = ptr.Of(inputSchemaByte)
+		po.Metainfo = ptr.Of(metaInfoByte)
+		po.ID = do.PairwiseEvaluatorVersion.ID
 	}
 	return po, nil
 }
@@ -168,6 +213,76 @@ func ConvertEvaluatorVersionPO2DO(po *model.EvaluatorVersion) (*evaluatordo.Eval
 				}
 			}
 		}
+	case evaluatordo.EvaluatorTypeCode:
+		do.CodeEvaluatorVersion = &evaluatordo.CodeEvaluatorVersion{
+			EvaluatorType: evaluatordo.EvaluatorTypeCode,
+		}
+		if po.Metainfo != nil {
+			var meta struct {
+				LanguageType evaluatordo.LanguageType `json:"language_type"`
+				CodeContent  string                   `json:"code_content"`
+			}
+			if err := js_conv.GetUnmarshaler()(*po.Metainfo, &meta); err == nil {
+				do.CodeEvaluatorVersion.LanguageType = meta.LanguageType
+				do.CodeEvaluatorVersion.CodeContent = meta.CodeContent
+			} else {
+				return nil, errorx.Wrapf(err, "evaluator version metainfo json unmarshal fail, evluator_version_id: %v", po.ID)
+			}
+		}
+		if po.InputSchema != nil {
+			var schema []*evaluatordo.ArgsSchema
+			if err := json.Unmarshal(*po.InputSchema, &schema); err == nil {
+				do.CodeEvaluatorVersion.InputSchemas = schema
+			}
+		}
+	case evaluatordo.EvaluatorTypeBuiltin:
+		do.BuiltinEvaluatorVersion = &evaluatordo.BuiltinEvaluatorVersion{
+			EvaluatorType: evaluatordo.EvaluatorTypeBuiltin,
+		}
+		if po.Metainfo != nil {
+			var meta struct {
+				BuiltinType evaluatordo.BuiltinEvaluatorType    `json:"builtin_type"`
+				Config      *evaluatordo.BuiltinEvaluatorConfig `json:"config"`
+			}
+			if err := js_conv.GetUnmarshaler()(*po.Metainfo, &meta); err == nil {
+				do.BuiltinEvaluatorVersion.BuiltinType = meta.BuiltinType
+				do.BuiltinEvaluatorVersion.Config = meta.Config
+			} else {
+				return nil, errorx.Wrapf(err, "evaluator version metainfo json unmarshal fail, evluator_version_id: %v", po.ID)
+			}
+		}
+		if po.InputSchema != nil {
+			var schema []*evaluatordo.ArgsSchema
+			if err := json.Unmarshal(*po.InputSchema, &schema); err == nil {
+				do.BuiltinEvaluatorVersion.InputSchemas = schema
+			}
+		}
+	case evaluatordo.EvaluatorTypePairwise:
+		do.PairwiseEvaluatorVersion = &evaluatordo.PairwiseEvaluatorVersion{
+			EvaluatorType: evaluatordo.EvaluatorTypePairwise,
+		}
+		if po.Metainfo != nil {
+			var meta struct {
+				MessageList []*evaluatordo.Message   `json:"message_list"`
+				ModelConfig *evaluatordo.ModelConfig `json:"model_config"`
+				SwapCheck   bool                     `json:"swap_check"`
+			}
+			if err := js_conv.GetUnmarshaler()(*po.Metainfo, &meta); err == nil {
+				do.PairwiseEvaluatorVersion.MessageList = meta.MessageList
+				do.PairwiseEvaluatorVersion.ModelConfig = meta.ModelConfig
+				do.PairwiseEvaluatorVersion.SwapCheck = meta.SwapCheck
+			} else {
+				return nil, errorx.Wrapf(err, "evaluator version metainfo json unmarshal fail, evluator_version_id: %v", po.ID)
+			}
+		}
+		if po.InputSchema != nil {
+			var schema []*evaluatordo.ArgsSchema
+			if err := json.Unmarshal(*po.InputSchema, &schema); err == nil {
+				do.PairwiseEvaluatorVersion.InputSchemas = schema
+			}
+		}
+	default:
+		return nil, errorx.New("unsupported evaluator type: %v, evluator_version_id: %v", do.EvaluatorType, po.ID)
 	}
 	do.GetEvaluatorVersion().SetID(po.ID)
 	do.GetEvaluatorVersion().SetVersion(po.Version)
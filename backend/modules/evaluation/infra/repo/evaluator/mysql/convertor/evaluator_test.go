@@ -442,3 +442,108 @@ func TestConvertEvaluatorVersionPO2DO(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertCodeEvaluatorVersion_RoundTrip(t *testing.T) {
+	now := time.Now().UnixMilli()
+	do := &evaluatordo.Evaluator{
+		ID:            10,
+		SpaceID:       20,
+		EvaluatorType: evaluatordo.EvaluatorTypeCode,
+		CodeEvaluatorVersion: &evaluatordo.CodeEvaluatorVersion{
+			ID:           30,
+			EvaluatorID:  10,
+			SpaceID:      20,
+			Version:      "0.0.1",
+			Description:  "exact match",
+			LanguageType: evaluatordo.LanguageTypeJS,
+			CodeContent:  "function exec_evaluation(input) { return input.a === input.b }",
+			InputSchemas: []*evaluatordo.ArgsSchema{{Key: ptr.Of("a")}},
+			BaseInfo: &evaluatordo.BaseInfo{
+				CreatedBy: &evaluatordo.UserInfo{UserID: ptr.Of("u1")},
+				UpdatedBy: &evaluatordo.UserInfo{UserID: ptr.Of("u1")},
+				CreatedAt: ptr.Of(now),
+				UpdatedAt: ptr.Of(now),
+			},
+		},
+	}
+	po, err := ConvertEvaluatorVersionDO2PO(do)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), po.ID)
+	assert.Equal(t, int32(evaluatordo.EvaluatorTypeCode), *po.EvaluatorType)
+	assert.NotNil(t, po.Metainfo)
+
+	got, err := ConvertEvaluatorVersionPO2DO(po)
+	require.NoError(t, err)
+	require.NotNil(t, got.CodeEvaluatorVersion)
+	assert.Equal(t, evaluatordo.LanguageTypeJS, got.CodeEvaluatorVersion.LanguageType)
+	assert.Equal(t, do.CodeEvaluatorVersion.CodeContent, got.CodeEvaluatorVersion.CodeContent)
+	assert.Equal(t, "0.0.1", got.CodeEvaluatorVersion.Version)
+	assert.Equal(t, int64(10), got.CodeEvaluatorVersion.EvaluatorID)
+	assert.Len(t, got.CodeEvaluatorVersion.InputSchemas, 1)
+
+	_, err = ConvertEvaluatorVersionPO2DO(&model.EvaluatorVersion{ID: 1, EvaluatorType: ptr.Of(int32(99))})
+	assert.Error(t, err)
+}
+
+func TestConvertBuiltinEvaluatorVersion_RoundTrip(t *testing.T) {
+	do := &evaluatordo.Evaluator{
+		ID:            10,
+		SpaceID:       20,
+		EvaluatorType: evaluatordo.EvaluatorTypeBuiltin,
+		BuiltinEvaluatorVersion: &evaluatordo.BuiltinEvaluatorVersion{
+			ID:           30,
+			EvaluatorID:  10,
+			SpaceID:      20,
+			Version:      "0.0.1",
+			BuiltinType:  evaluatordo.BuiltinEvaluatorTypeFuzzyMatch,
+			Config:       &evaluatordo.BuiltinEvaluatorConfig{Threshold: ptr.Of(0.8), IgnoreWhitespace: true},
+			InputSchemas: evaluatordo.BuiltinEvaluatorTypeFuzzyMatch.DefaultInputSchemas(),
+		},
+	}
+	po, err := ConvertEvaluatorVersionDO2PO(do)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), po.ID)
+	assert.Equal(t, int32(evaluatordo.EvaluatorTypeBuiltin), *po.EvaluatorType)
+
+	got, err := ConvertEvaluatorVersionPO2DO(po)
+	require.NoError(t, err)
+	require.NotNil(t, got.BuiltinEvaluatorVersion)
+	assert.Equal(t, evaluatordo.BuiltinEvaluatorTypeFuzzyMatch, got.BuiltinEvaluatorVersion.BuiltinType)
+	assert.Equal(t, do.BuiltinEvaluatorVersion.Config, got.BuiltinEvaluatorVersion.Config)
+	assert.Equal(t, "0.0.1", got.BuiltinEvaluatorVersion.Version)
+	assert.Len(t, got.BuiltinEvaluatorVersion.InputSchemas, 2)
+}
+
+func TestConvertPairwiseEvaluatorVersion_RoundTrip(t *testing.T) {
+	do := &evaluatordo.Evaluator{
+		ID:            10,
+		SpaceID:       20,
+		EvaluatorType: evaluatordo.EvaluatorTypePairwise,
+		PairwiseEvaluatorVersion: &evaluatordo.PairwiseEvaluatorVersion{
+			ID:          30,
+			EvaluatorID: 10,
+			SpaceID:     20,
+			Version:     "0.0.1",
+			MessageList: []*evaluatordo.Message{{
+				Role:    evaluatordo.RoleSystem,
+				Content: &evaluatordo.Content{ContentType: ptr.Of(evaluatordo.ContentTypeText), Text: ptr.Of("A: {{output_a}} B: {{output_b}}")},
+			}},
+			ModelConfig:  &evaluatordo.ModelConfig{ModelID: 1},
+			SwapCheck:    true,
+			InputSchemas: evaluatordo.EnsurePairwiseInputSchemas(nil),
+		},
+	}
+	po, err := ConvertEvaluatorVersionDO2PO(do)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), po.ID)
+	assert.Equal(t, int32(evaluatordo.EvaluatorTypePairwise), *po.EvaluatorType)
+
+	got, err := ConvertEvaluatorVersionPO2DO(po)
+	require.NoError(t, err)
+	require.NotNil(t, got.PairwiseEvaluatorVersion)
+	assert.True(t, got.PairwiseEvaluatorVersion.SwapCheck)
+	assert.Equal(t, do.PairwiseEvaluatorVersion.MessageList, got.PairwiseEvaluatorVersion.MessageList)
+	assert.Equal(t, int64(1), got.PairwiseEvaluatorVersion.ModelConfig.ModelID)
+	assert.Equal(t, "0.0.1", got.PairwiseEvaluatorVersion.Version)
+	assert.Len(t, got.PairwiseEvaluatorVersion.InputSchemas, 2)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/convert"
+)
+
+type ExptScheduleRepoImpl struct {
+	scheduleDAO    mysql.ExptScheduleDAO
+	scheduleRunDAO mysql.ExptScheduleRunDAO
+	idgenerator    idgen.IIDGenerator
+}
+
+func NewExptScheduleRepo(scheduleDAO mysql.ExptScheduleDAO, scheduleRunDAO mysql.ExptScheduleRunDAO, idgenerator idgen.IIDGenerator) repo.IExptScheduleRepo {
+	return &ExptScheduleRepoImpl{
+		scheduleDAO:    scheduleDAO,
+		scheduleRunDAO: scheduleRunDAO,
+		idgenerator:    idgenerator,
+	}
+}
+
+func (e *ExptScheduleRepoImpl) Create(ctx context.Context, schedule *entity.ExptSchedule) error {
+	id, err := e.idgenerator.GenID(ctx)
+	if err != nil {
+		return err
+	}
+	schedule.ID = id
+	return e.scheduleDAO.Create(ctx, convert.ExptScheduleDOToPO(schedule))
+}
+
+func (e *ExptScheduleRepoImpl) Update(ctx context.Context, schedule *entity.ExptSchedule) error {
+	return e.scheduleDAO.Update(ctx, convert.ExptScheduleDOToPO(schedule))
+}
+
+func (e *ExptScheduleRepoImpl) Delete(ctx context.Context, id, spaceID int64) error {
+	return e.scheduleDAO.Delete(ctx, id, spaceID)
+}
+
+func (e *ExptScheduleRepoImpl) Get(ctx context.Context, id, spaceID int64) (*entity.ExptSchedule, error) {
+	po, err := e.scheduleDAO.Get(ctx, id, spaceID, db.WithMaster())
+	if err != nil {
+		return nil, err
+	}
+	return convert.ExptSchedulePOToDO(po), nil
+}
+
+func (e *ExptScheduleRepoImpl) List(ctx context.Context, spaceID int64, filter *entity.ExptScheduleListFilter, page entity.Page) ([]*entity.ExptSchedule, int64, error) {
+	pos, total, err := e.scheduleDAO.List(ctx, spaceID, filter, page)
+	if err != nil {
+		return nil, 0, err
+	}
+	dos := make([]*entity.ExptSchedule, 0, len(pos))
+	for _, po := range pos {
+		dos = append(dos, convert.ExptSchedulePOToDO(po))
+	}
+	return dos, total, nil
+}
+
+func (e *ExptScheduleRepoImpl) ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.ExptSchedule, error) {
+	pos, err := e.scheduleDAO.ListDue(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	dos := make([]*entity.ExptSchedule, 0, len(pos))
+	for _, po := range pos {
+		dos = append(dos, convert.ExptSchedulePOToDO(po))
+	}
+	return dos, nil
+}
+
+func (e *ExptScheduleRepoImpl) UpdateTriggerTime(ctx context.Context, id int64, prevNextTriggerAt, nextTriggerAt, lastTriggerAt time.Time) (bool, error) {
+	return e.scheduleDAO.UpdateTriggerTime(ctx, id, prevNextTriggerAt, nextTriggerAt, lastTriggerAt)
+}
+
+func (e *ExptScheduleRepoImpl) CreateRun(ctx context.Context, run *entity.ExptScheduleRun) error {
+	id, err := e.idgenerator.GenID(ctx)
+	if err != nil {
+		return err
+	}
+	run.ID = id
+	return e.scheduleRunDAO.Create(ctx, convert.ExptScheduleRunDOToPO(run))
+}
+
+func (e *ExptScheduleRepoImpl) ListRuns(ctx context.Context, spaceID, scheduleID int64, page entity.Page) ([]*entity.ExptScheduleRun, int64, error) {
+	pos, total, err := e.scheduleRunDAO.List(ctx, spaceID, scheduleID, page)
+	if err != nil {
+		return nil, 0, err
+	}
+	dos := make([]*entity.ExptScheduleRun, 0, len(pos))
+	for _, po := range pos {
+		dos = append(dos, convert.ExptScheduleRunPOToDO(po))
+	}
+	return dos, total, nil
+}
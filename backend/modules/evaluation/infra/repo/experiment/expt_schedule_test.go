@@ -0,0 +1,111 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockidgen "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	mocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/mocks"
+)
+
+func newExptScheduleRepo(ctrl *gomock.Controller) (*ExptScheduleRepoImpl, *mocks.MockExptScheduleDAO, *mocks.MockExptScheduleRunDAO, *mockidgen.MockIIDGenerator) {
+	scheduleDAO := mocks.NewMockExptScheduleDAO(ctrl)
+	runDAO := mocks.NewMockExptScheduleRunDAO(ctrl)
+	idGen := mockidgen.NewMockIIDGenerator(ctrl)
+	return &ExptScheduleRepoImpl{
+		scheduleDAO:    scheduleDAO,
+		scheduleRunDAO: runDAO,
+		idgenerator:    idGen,
+	}, scheduleDAO, runDAO, idGen
+}
+
+func TestExptScheduleRepoImpl_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo, scheduleDAO, _, idGen := newExptScheduleRepo(ctrl)
+	next := time.Now()
+
+	idGen.EXPECT().GenID(gomock.Any()).Return(int64(123), nil)
+	scheduleDAO.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, po *model.ExptSchedule) error {
+		assert.Equal(t, int64(123), po.ID)
+		assert.Equal(t, "0 2 * * *", po.CronExpr)
+		assert.True(t, po.UseLatestTargetVersion)
+		assert.Equal(t, &next, po.NextTriggerAt)
+		return nil
+	})
+	schedule := &entity.ExptSchedule{SpaceID: 1, CronExpr: "0 2 * * *", UseLatestTargetVersion: true, NextTriggerAt: &next}
+	assert.NoError(t, repo.Create(context.Background(), schedule))
+	assert.Equal(t, int64(123), schedule.ID)
+
+	idGen.EXPECT().GenID(gomock.Any()).Return(int64(0), errors.New("idgen error"))
+	assert.Error(t, repo.Create(context.Background(), &entity.ExptSchedule{}))
+}
+
+func TestExptScheduleRepoImpl_GetAndList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo, scheduleDAO, _, _ := newExptScheduleRepo(ctrl)
+	ctx := context.Background()
+
+	scheduleDAO.EXPECT().Get(gomock.Any(), int64(1), int64(100), gomock.Any()).Return(&model.ExptSchedule{ID: 1, SpaceID: 100, Enabled: true}, nil)
+	got, err := repo.Get(ctx, 1, 100)
+	assert.NoError(t, err)
+	assert.True(t, got.Enabled)
+
+	scheduleDAO.EXPECT().Get(gomock.Any(), int64(2), int64(100), gomock.Any()).Return(nil, errors.New("not found"))
+	_, err = repo.Get(ctx, 2, 100)
+	assert.Error(t, err)
+
+	filter := &entity.ExptScheduleListFilter{TemplateExptID: gptr.Of(int64(10))}
+	scheduleDAO.EXPECT().List(gomock.Any(), int64(100), filter, gomock.Any()).Return([]*model.ExptSchedule{{ID: 1}, {ID: 2}}, int64(2), nil)
+	list, total, err := repo.List(ctx, 100, filter, entity.NewPage(1, 10))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, list, 2)
+
+	now := time.Now()
+	scheduleDAO.EXPECT().ListDue(gomock.Any(), now, 10).Return([]*model.ExptSchedule{{ID: 3}}, nil)
+	due, err := repo.ListDue(ctx, now, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), due[0].ID)
+}
+
+func TestExptScheduleRepoImpl_Runs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo, _, runDAO, idGen := newExptScheduleRepo(ctrl)
+	ctx := context.Background()
+
+	idGen.EXPECT().GenID(gomock.Any()).Return(int64(9), nil)
+	runDAO.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, po *model.ExptScheduleRun) error {
+		assert.Equal(t, int64(9), po.ID)
+		assert.Equal(t, int64(60), po.ExptRunID)
+		assert.Equal(t, "boom", string(*po.ErrMsg))
+		return nil
+	})
+	assert.NoError(t, repo.CreateRun(ctx, &entity.ExptScheduleRun{ScheduleID: 1, RunID: 60, Status: entity.ExptScheduleRunStatus_Failed, ErrMsg: "boom"}))
+
+	runDAO.EXPECT().List(gomock.Any(), int64(100), int64(1), gomock.Any()).Return([]*model.ExptScheduleRun{
+		{ID: 9, ExptID: 50, ExptRunID: 60, Status: 1},
+	}, int64(1), nil)
+	runs, total, err := repo.ListRuns(ctx, 100, 1, entity.NewPage(1, 10))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, entity.ExptScheduleRunStatus_Success, runs[0].Status)
+	assert.Equal(t, int64(60), runs[0].RunID)
+
+	runDAO.EXPECT().List(gomock.Any(), int64(100), int64(2), gomock.Any()).Return(nil, int64(0), errors.New("db error"))
+	_, _, err = repo.ListRuns(ctx, 100, 2, entity.NewPage(1, 10))
+	assert.Error(t, err)
+}
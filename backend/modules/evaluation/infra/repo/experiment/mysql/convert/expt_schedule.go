@@ -0,0 +1,90 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/conv"
+)
+
+func ExptScheduleDOToPO(do *entity.ExptSchedule) *model.ExptSchedule {
+	if do == nil {
+		return nil
+	}
+	return &model.ExptSchedule{
+		ID:                      do.ID,
+		SpaceID:                 do.SpaceID,
+		Name:                    do.Name,
+		Description:             do.Description,
+		TemplateExptID:          do.TemplateExptID,
+		CronExpr:                do.CronExpr,
+		Timezone:                do.Timezone,
+		UseLatestTargetVersion:  do.UseLatestTargetVersion,
+		UseLatestEvalSetVersion: do.UseLatestEvalSetVersion,
+		Enabled:                 do.Enabled,
+		NextTriggerAt:           do.NextTriggerAt,
+		LastTriggerAt:           do.LastTriggerAt,
+		CreatedBy:               do.CreatedBy,
+		UpdatedBy:               do.UpdatedBy,
+	}
+}
+
+func ExptSchedulePOToDO(po *model.ExptSchedule) *entity.ExptSchedule {
+	if po == nil {
+		return nil
+	}
+	return &entity.ExptSchedule{
+		ID:                      po.ID,
+		SpaceID:                 po.SpaceID,
+		Name:                    po.Name,
+		Description:             po.Description,
+		TemplateExptID:          po.TemplateExptID,
+		CronExpr:                po.CronExpr,
+		Timezone:                po.Timezone,
+		UseLatestTargetVersion:  po.UseLatestTargetVersion,
+		UseLatestEvalSetVersion: po.UseLatestEvalSetVersion,
+		Enabled:                 po.Enabled,
+		NextTriggerAt:           po.NextTriggerAt,
+		LastTriggerAt:           po.LastTriggerAt,
+		CreatedBy:               po.CreatedBy,
+		UpdatedBy:               po.UpdatedBy,
+		CreatedAt:               po.CreatedAt,
+		UpdatedAt:               po.UpdatedAt,
+	}
+}
+
+func ExptScheduleRunDOToPO(do *entity.ExptScheduleRun) *model.ExptScheduleRun {
+	if do == nil {
+		return nil
+	}
+	return &model.ExptScheduleRun{
+		ID:         do.ID,
+		SpaceID:    do.SpaceID,
+		ScheduleID: do.ScheduleID,
+		ExptID:     do.ExptID,
+		ExptRunID:  do.RunID,
+		Status:     int32(do.Status),
+		ErrMsg:     gptr.Of(conv.UnsafeStringToBytes(do.ErrMsg)),
+		TriggerAt:  do.TriggerAt,
+	}
+}
+
+func ExptScheduleRunPOToDO(po *model.ExptScheduleRun) *entity.ExptScheduleRun {
+	if po == nil {
+		return nil
+	}
+	return &entity.ExptScheduleRun{
+		ID:         po.ID,
+		SpaceID:    po.SpaceID,
+		ScheduleID: po.ScheduleID,
+		ExptID:     po.ExptID,
+		RunID:      po.ExptRunID,
+		Status:     entity.ExptScheduleRunStatus(po.Status),
+		ErrMsg:     conv.UnsafeBytesToString(gptr.Indirect(po.ErrMsg)),
+		TriggerAt:  po.TriggerAt,
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+//go:generate mockgen -destination=mocks/expt_schedule.go -package=mocks . ExptScheduleDAO
+type ExptScheduleDAO interface {
+	Create(ctx context.Context, schedule *model.ExptSchedule) error
+	Update(ctx context.Context, schedule *model.ExptSchedule) error
+	Delete(ctx context.Context, id, spaceID int64) error
+	Get(ctx context.Context, id, spaceID int64, opts ...db.Option) (*model.ExptSchedule, error)
+	List(ctx context.Context, spaceID int64, filter *entity.ExptScheduleListFilter, page entity.Page) ([]*model.ExptSchedule, int64, error)
+	// ListDue 查询已启用且到达触发时间的定时实验
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*model.ExptSchedule, error)
+	// UpdateTriggerTime 仅当下次触发时间仍为 prevNextTriggerAt 时更新, 用于多实例间抢占同一次触发
+	UpdateTriggerTime(ctx context.Context, id int64, prevNextTriggerAt, nextTriggerAt, lastTriggerAt time.Time) (bool, error)
+}
+
+func NewExptScheduleDAO(db db.Provider) ExptScheduleDAO {
+	return &exptScheduleDAO{db: db}
+}
+
+type exptScheduleDAO struct {
+	db db.Provider
+}
+
+func (e *exptScheduleDAO) Create(ctx context.Context, schedule *model.ExptSchedule) error {
+	if err := e.db.NewSession(ctx).Create(schedule).Error; err != nil {
+		return errorx.Wrapf(err, "create expt_schedule fail, model: %v", json.Jsonify(schedule))
+	}
+	return nil
+}
+
+func (e *exptScheduleDAO) Update(ctx context.Context, schedule *model.ExptSchedule) error {
+	err := e.db.NewSession(ctx).Model(&model.ExptSchedule{}).
+		Where("id = ? AND space_id = ?", schedule.ID, schedule.SpaceID).
+		Select("name", "description", "cron_expr", "timezone", "use_latest_target_version", "use_latest_eval_set_version", "enabled", "next_trigger_at", "updated_by").
+		Updates(schedule).Error
+	if err != nil {
+		return errorx.Wrapf(err, "update expt_schedule fail, model: %v", json.Jsonify(schedule))
+	}
+	return nil
+}
+
+func (e *exptScheduleDAO) Delete(ctx context.Context, id, spaceID int64) error {
+	if err := e.db.NewSession(ctx).Where("id = ? AND space_id = ?", id, spaceID).Delete(&model.ExptSchedule{}).Error; err != nil {
+		return errorx.Wrapf(err, "delete expt_schedule fail, id: %v", id)
+	}
+	return nil
+}
+
+func (e *exptScheduleDAO) Get(ctx context.Context, id, spaceID int64, opts ...db.Option) (*model.ExptSchedule, error) {
+	schedule := &model.ExptSchedule{}
+	err := e.db.NewSession(ctx, opts...).Where("id = ? AND space_id = ?", id, spaceID).First(schedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.WrapByCode(err, errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("expt schedule %d not found", id)))
+		}
+		return nil, errorx.Wrapf(err, "mysql get expt_schedule fail, id: %v", id)
+	}
+	return schedule, nil
+}
+
+func (e *exptScheduleDAO) List(ctx context.Context, spaceID int64, filter *entity.ExptScheduleListFilter, page entity.Page) ([]*model.ExptSchedule, int64, error) {
+	var (
+		finds []*model.ExptSchedule
+		total int64
+	)
+
+	db := e.db.NewSession(ctx).Model(&model.ExptSchedule{}).Where("space_id = ?", spaceID)
+	if filter != nil {
+		if filter.TemplateExptID != nil {
+			db = db.Where("template_expt_id = ?", *filter.TemplateExptID)
+		}
+		if filter.Enabled != nil {
+			db = db.Where("enabled = ?", *filter.Enabled)
+		}
+	}
+
+	db = db.Order("created_at desc")
+	db = db.Count(&total)
+	db = db.Offset(page.Offset()).Limit(page.Limit())
+	if err := db.Find(&finds).Error; err != nil {
+		return nil, 0, errorx.Wrapf(err, "mysql list expt_schedule fail, space_id: %v", spaceID)
+	}
+	return finds, total, nil
+}
+
+func (e *exptScheduleDAO) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.ExptSchedule, error) {
+	var finds []*model.ExptSchedule
+	err := e.db.NewSession(ctx, db.WithMaster()).
+		Where("enabled = ? AND next_trigger_at <= ?", true, now).
+		Order("next_trigger_at asc").
+		Limit(limit).
+		Find(&finds).Error
+	if err != nil {
+		return nil, errorx.Wrapf(err, "mysql list due expt_schedule fail")
+	}
+	return finds, nil
+}
+
+func (e *exptScheduleDAO) UpdateTriggerTime(ctx context.Context, id int64, prevNextTriggerAt, nextTriggerAt, lastTriggerAt time.Time) (bool, error) {
+	res := e.db.NewSession(ctx).Model(&model.ExptSchedule{}).
+		Where("id = ? AND next_trigger_at = ?", id, prevNextTriggerAt).
+		Updates(map[string]any{
+			"next_trigger_at": nextTriggerAt,
+			"last_trigger_at": lastTriggerAt,
+		})
+	if res.Error != nil {
+		return false, errorx.Wrapf(res.Error, "update expt_schedule trigger time fail, id: %v", id)
+	}
+	return res.RowsAffected > 0, nil
+}
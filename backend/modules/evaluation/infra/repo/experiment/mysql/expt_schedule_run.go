@@ -0,0 +1,51 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+//go:generate mockgen -destination=mocks/expt_schedule_run.go -package=mocks . ExptScheduleRunDAO
+type ExptScheduleRunDAO interface {
+	Create(ctx context.Context, run *model.ExptScheduleRun) error
+	List(ctx context.Context, spaceID, scheduleID int64, page entity.Page) ([]*model.ExptScheduleRun, int64, error)
+}
+
+func NewExptScheduleRunDAO(db db.Provider) ExptScheduleRunDAO {
+	return &exptScheduleRunDAO{db: db}
+}
+
+type exptScheduleRunDAO struct {
+	db db.Provider
+}
+
+func (e *exptScheduleRunDAO) Create(ctx context.Context, run *model.ExptScheduleRun) error {
+	if err := e.db.NewSession(ctx).Create(run).Error; err != nil {
+		return errorx.Wrapf(err, "create expt_schedule_run fail, model: %v", json.Jsonify(run))
+	}
+	return nil
+}
+
+func (e *exptScheduleRunDAO) List(ctx context.Context, spaceID, scheduleID int64, page entity.Page) ([]*model.ExptScheduleRun, int64, error) {
+	var (
+		finds []*model.ExptScheduleRun
+		total int64
+	)
+
+	db := e.db.NewSession(ctx).Model(&model.ExptScheduleRun{}).Where("space_id = ? AND schedule_id = ?", spaceID, scheduleID)
+	db = db.Order("trigger_at desc")
+	db = db.Count(&total)
+	db = db.Offset(page.Offset()).Limit(page.Limit())
+	if err := db.Find(&finds).Error; err != nil {
+		return nil, 0, errorx.Wrapf(err, "mysql list expt_schedule_run fail, schedule_id: %v", scheduleID)
+	}
+	return finds, total, nil
+}
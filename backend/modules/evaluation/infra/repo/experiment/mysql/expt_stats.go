@@ -96,7 +96,7 @@ func (e *exptStatsDAOImpl) ArithOperateCount(ctx context.Context, exptID, spaceI
 		return nil
 	}
 
-	if len(cntArithOp.OpStatusCnt) == 0 {
+	if len(cntArithOp.OpStatusCnt) == 0 && cntArithOp.OpInputTokenCost == 0 && cntArithOp.OpOutputTokenCost == 0 {
 		return nil
 	}
 
@@ -115,6 +115,18 @@ func (e *exptStatsDAOImpl) ArithOperateCount(ctx context.Context, exptID, spaceI
 		db.Update(col, gorm.Expr(col+" + ?", opCnt))
 	}
 
+	for col, opCost := range map[string]int64{
+		"input_token_cost":  cntArithOp.OpInputTokenCost,
+		"output_token_cost": cntArithOp.OpOutputTokenCost,
+	} {
+		if opCost == 0 {
+			continue
+		}
+
+		update = true
+		db.Update(col, gorm.Expr("IFNULL("+col+", 0) + ?", opCost))
+	}
+
 	if !update {
 		logs.CtxInfo(ctx, "ArithOperateCount without update, cntArithOp: %v", json.Jsonify(cntArithOp))
 		return nil
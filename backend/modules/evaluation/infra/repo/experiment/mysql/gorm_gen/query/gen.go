@@ -26,6 +26,8 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		ExptItemResultRunLog:           newExptItemResultRunLog(db, opts...),
 		ExptResultExportRecord:         newExptResultExportRecord(db, opts...),
 		ExptRunLog:                     newExptRunLog(db, opts...),
+		ExptSchedule:                   newExptSchedule(db, opts...),
+		ExptScheduleRun:                newExptScheduleRun(db, opts...),
 		ExptStats:                      newExptStats(db, opts...),
 		ExptTurnAnnotateRecordRef:      newExptTurnAnnotateRecordRef(db, opts...),
 		ExptTurnEvaluatorResultRef:     newExptTurnEvaluatorResultRef(db, opts...),
@@ -47,6 +49,8 @@ type Query struct {
 	ExptItemResultRunLog           exptItemResultRunLog
 	ExptResultExportRecord         exptResultExportRecord
 	ExptRunLog                     exptRunLog
+	ExptSchedule                   exptSchedule
+	ExptScheduleRun                exptScheduleRun
 	ExptStats                      exptStats
 	ExptTurnAnnotateRecordRef      exptTurnAnnotateRecordRef
 	ExptTurnEvaluatorResultRef     exptTurnEvaluatorResultRef
@@ -69,6 +73,8 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		ExptItemResultRunLog:           q.ExptItemResultRunLog.clone(db),
 		ExptResultExportRecord:         q.ExptResultExportRecord.clone(db),
 		ExptRunLog:                     q.ExptRunLog.clone(db),
+		ExptSchedule:                   q.ExptSchedule.clone(db),
+		ExptScheduleRun:                q.ExptScheduleRun.clone(db),
 		ExptStats:                      q.ExptStats.clone(db),
 		ExptTurnAnnotateRecordRef:      q.ExptTurnAnnotateRecordRef.clone(db),
 		ExptTurnEvaluatorResultRef:     q.ExptTurnEvaluatorResultRef.clone(db),
@@ -98,6 +104,8 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		ExptItemResultRunLog:           q.ExptItemResultRunLog.replaceDB(db),
 		ExptResultExportRecord:         q.ExptResultExportRecord.replaceDB(db),
 		ExptRunLog:                     q.ExptRunLog.replaceDB(db),
+		ExptSchedule:                   q.ExptSchedule.replaceDB(db),
+		ExptScheduleRun:                q.ExptScheduleRun.replaceDB(db),
 		ExptStats:                      q.ExptStats.replaceDB(db),
 		ExptTurnAnnotateRecordRef:      q.ExptTurnAnnotateRecordRef.replaceDB(db),
 		ExptTurnEvaluatorResultRef:     q.ExptTurnEvaluatorResultRef.replaceDB(db),
@@ -117,6 +125,8 @@ type queryCtx struct {
 	ExptItemResultRunLog           *exptItemResultRunLogDo
 	ExptResultExportRecord         *exptResultExportRecordDo
 	ExptRunLog                     *exptRunLogDo
+	ExptSchedule                   *exptScheduleDo
+	ExptScheduleRun                *exptScheduleRunDo
 	ExptStats                      *exptStatsDo
 	ExptTurnAnnotateRecordRef      *exptTurnAnnotateRecordRefDo
 	ExptTurnEvaluatorResultRef     *exptTurnEvaluatorResultRefDo
@@ -136,6 +146,8 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		ExptItemResultRunLog:           q.ExptItemResultRunLog.WithContext(ctx),
 		ExptResultExportRecord:         q.ExptResultExportRecord.WithContext(ctx),
 		ExptRunLog:                     q.ExptRunLog.WithContext(ctx),
+		ExptSchedule:                   q.ExptSchedule.WithContext(ctx),
+		ExptScheduleRun:                q.ExptScheduleRun.WithContext(ctx),
 		ExptStats:                      q.ExptStats.WithContext(ctx),
 		ExptTurnAnnotateRecordRef:      q.ExptTurnAnnotateRecordRef.WithContext(ctx),
 		ExptTurnEvaluatorResultRef:     q.ExptTurnEvaluatorResultRef.WithContext(ctx),
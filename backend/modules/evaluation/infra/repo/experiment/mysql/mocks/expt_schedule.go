@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql (interfaces: ExptScheduleDAO)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+
+	db "github.com/coze-dev/coze-loop/backend/infra/db"
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	model "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+)
+
+// MockExptScheduleDAO is a mock of ExptScheduleDAO interface.
+type MockExptScheduleDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockExptScheduleDAOMockRecorder
+}
+
+// MockExptScheduleDAOMockRecorder is the mock recorder for MockExptScheduleDAO.
+type MockExptScheduleDAOMockRecorder struct {
+	mock *MockExptScheduleDAO
+}
+
+// NewMockExptScheduleDAO creates a new mock instance.
+func NewMockExptScheduleDAO(ctrl *gomock.Controller) *MockExptScheduleDAO {
+	mock := &MockExptScheduleDAO{ctrl: ctrl}
+	mock.recorder = &MockExptScheduleDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExptScheduleDAO) EXPECT() *MockExptScheduleDAOMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExptScheduleDAO) Create(arg0 context.Context, arg1 *model.ExptSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExptScheduleDAOMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExptScheduleDAO)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockExptScheduleDAO) Delete(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExptScheduleDAOMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExptScheduleDAO)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockExptScheduleDAO) Get(arg0 context.Context, arg1, arg2 int64, arg3 ...db.Option) (*model.ExptSchedule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*model.ExptSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockExptScheduleDAOMockRecorder) Get(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExptScheduleDAO)(nil).Get), varargs...)
+}
+
+// List mocks base method.
+func (m *MockExptScheduleDAO) List(arg0 context.Context, arg1 int64, arg2 *entity.ExptScheduleListFilter, arg3 entity.Page) ([]*model.ExptSchedule, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.ExptSchedule)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockExptScheduleDAOMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExptScheduleDAO)(nil).List), arg0, arg1, arg2, arg3)
+}
+
+// ListDue mocks base method.
+func (m *MockExptScheduleDAO) ListDue(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.ExptSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.ExptSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue.
+func (mr *MockExptScheduleDAOMockRecorder) ListDue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*MockExptScheduleDAO)(nil).ListDue), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockExptScheduleDAO) Update(arg0 context.Context, arg1 *model.ExptSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExptScheduleDAOMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExptScheduleDAO)(nil).Update), arg0, arg1)
+}
+
+// UpdateTriggerTime mocks base method.
+func (m *MockExptScheduleDAO) UpdateTriggerTime(arg0 context.Context, arg1 int64, arg2, arg3, arg4 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTriggerTime", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTriggerTime indicates an expected call of UpdateTriggerTime.
+func (mr *MockExptScheduleDAOMockRecorder) UpdateTriggerTime(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerTime", reflect.TypeOf((*MockExptScheduleDAO)(nil).UpdateTriggerTime), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql (interfaces: ExptScheduleRunDAO)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	model "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+)
+
+// MockExptScheduleRunDAO is a mock of ExptScheduleRunDAO interface.
+type MockExptScheduleRunDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockExptScheduleRunDAOMockRecorder
+}
+
+// MockExptScheduleRunDAOMockRecorder is the mock recorder for MockExptScheduleRunDAO.
+type MockExptScheduleRunDAOMockRecorder struct {
+	mock *MockExptScheduleRunDAO
+}
+
+// NewMockExptScheduleRunDAO creates a new mock instance.
+func NewMockExptScheduleRunDAO(ctrl *gomock.Controller) *MockExptScheduleRunDAO {
+	mock := &MockExptScheduleRunDAO{ctrl: ctrl}
+	mock.recorder = &MockExptScheduleRunDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExptScheduleRunDAO) EXPECT() *MockExptScheduleRunDAOMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExptScheduleRunDAO) Create(arg0 context.Context, arg1 *model.ExptScheduleRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExptScheduleRunDAOMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExptScheduleRunDAO)(nil).Create), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockExptScheduleRunDAO) List(arg0 context.Context, arg1, arg2 int64, arg3 entity.Page) ([]*model.ExptScheduleRun, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.ExptScheduleRun)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockExptScheduleRunDAOMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExptScheduleRunDAO)(nil).List), arg0, arg1, arg2, arg3)
+}
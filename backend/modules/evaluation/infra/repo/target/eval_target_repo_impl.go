@@ -5,6 +5,7 @@ package target
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/coze-dev/coze-loop/backend/pkg/logs"
@@ -12,6 +13,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/platestwrite"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
@@ -21,6 +23,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/target/mysql/gorm_gen/model"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
 )
 
 var (
@@ -36,9 +39,10 @@ type EvalTargetRepoImpl struct {
 	idgen      idgen.IIDGenerator
 	dbProvider db.Provider
 	lwt        platestwrite.ILatestWriteTracker
+	kms        dkms.IDKMS
 }
 
-func NewEvalTargetRepo(idgen idgen.IIDGenerator, provider db.Provider, evalTargetDao mysql.EvalTargetDAO, evalTargetVersionDao mysql.EvalTargetVersionDAO, evalTargetRecordDao mysql.EvalTargetRecordDAO, lwt platestwrite.ILatestWriteTracker) repo.IEvalTargetRepo {
+func NewEvalTargetRepo(idgen idgen.IIDGenerator, provider db.Provider, evalTargetDao mysql.EvalTargetDAO, evalTargetVersionDao mysql.EvalTargetVersionDAO, evalTargetRecordDao mysql.EvalTargetRecordDAO, lwt platestwrite.ILatestWriteTracker, kms dkms.IDKMS) repo.IEvalTargetRepo {
 	evalTargetRepoOnce.Do(func() {
 		singletonEvalTargetRepo = &EvalTargetRepoImpl{
 			evalTargetDao:        evalTargetDao,
@@ -47,6 +51,7 @@ func NewEvalTargetRepo(idgen idgen.IIDGenerator, provider db.Provider, evalTarge
 			idgen:                idgen,
 			dbProvider:           provider,
 			lwt:                  lwt,
+			kms:                  kms,
 		}
 	})
 	return singletonEvalTargetRepo
@@ -92,7 +97,7 @@ func (e *EvalTargetRepoImpl) CreateEvalTarget(ctx context.Context, do *entity.Ev
 		if version == nil {
 			do.EvalTargetVersion.ID = versionID
 			do.EvalTargetVersion.TargetID = id
-			po, errTOPO := convertor.EvalTargetVersionDO2PO(do.EvalTargetVersion)
+			po, errTOPO := e.evalTargetVersionDO2PO(ctx, do.EvalTargetVersion)
 			if errTOPO != nil {
 				return errTOPO
 			}
@@ -101,10 +106,16 @@ func (e *EvalTargetRepoImpl) CreateEvalTarget(ctx context.Context, do *entity.Ev
 				return errCV
 			}
 		} else {
+			if errCheck := e.checkHTTPTargetUnchanged(ctx, version, do); errCheck != nil {
+				return errCheck
+			}
 			versionID = version.ID
 		}
 		return nil
 	})
+	if statusErr, ok := errorx.FromStatusError(err); ok && statusErr.Code() == errno.InvalidEvalTargetConfigCode {
+		return 0, 0, err
+	}
 	if err != nil {
 		return 0, 0, errorx.WrapByCode(err, errno.CommonRPCErrorCode)
 	}
@@ -158,7 +169,10 @@ func (e *EvalTargetRepoImpl) GetEvalTargetVersion(ctx context.Context, spaceID,
 		return nil, errorx.NewByCode(errno.ResourceNotFoundCode)
 	}
 	targetDO = convertor.EvalTargetPO2DO(targetPO)
-	versionDO := convertor.EvalTargetVersionPO2DO(versionPO, targetDO.EvalTargetType)
+	versionDO, err := e.evalTargetVersionPO2DO(ctx, versionPO, targetDO.EvalTargetType)
+	if err != nil {
+		return nil, err
+	}
 	targetDO.EvalTargetVersion = versionDO
 
 	return targetDO, nil
@@ -205,7 +219,10 @@ func (e *EvalTargetRepoImpl) BatchGetEvalTargetVersion(ctx context.Context, spac
 			continue
 		}
 		targetDO := convertor.EvalTargetPO2DO(target)
-		versionDO := convertor.EvalTargetVersionPO2DO(version, targetDO.EvalTargetType)
+		versionDO, err := e.evalTargetVersionPO2DO(ctx, version, targetDO.EvalTargetType)
+		if err != nil {
+			return nil, err
+		}
 		targetDO.EvalTargetVersion = versionDO
 		dos = append(dos, targetDO)
 	}
@@ -257,3 +274,87 @@ func (e *EvalTargetRepoImpl) ListEvalTargetRecordByIDsAndSpaceID(ctx context.Con
 
 	return res, nil
 }
+
+// checkHTTPTargetUnchanged HTTP 评测对象的配置保存在版本中，同名版本的配置不一致时拒绝创建，避免静默复用旧配置
+func (e *EvalTargetRepoImpl) checkHTTPTargetUnchanged(ctx context.Context, versionPO *model.TargetVersion, do *entity.EvalTarget) error {
+	if do.EvalTargetType != entity.EvalTargetTypeHTTP {
+		return nil
+	}
+	existing, err := e.evalTargetVersionPO2DO(ctx, versionPO, do.EvalTargetType)
+	if err != nil {
+		return err
+	}
+	existingConf, err := json.Marshal(existing.HTTPTarget)
+	if err != nil {
+		return err
+	}
+	conf, err := json.Marshal(do.EvalTargetVersion.HTTPTarget)
+	if err != nil {
+		return err
+	}
+	if string(existingConf) != string(conf) {
+		return errorx.NewByCode(errno.InvalidEvalTargetConfigCode, errorx.WithExtraMsg(fmt.Sprintf("source target version %s already exists with a different http config", do.EvalTargetVersion.SourceTargetVersion)))
+	}
+	return nil
+}
+
+// evalTargetVersionDO2PO HTTP 评测对象的鉴权信息加密后落库
+func (e *EvalTargetRepoImpl) evalTargetVersionDO2PO(ctx context.Context, do *entity.EvalTargetVersion) (*model.TargetVersion, error) {
+	if do.HTTPTarget == nil || do.HTTPTarget.Auth == nil {
+		return convertor.EvalTargetVersionDO2PO(do)
+	}
+	auth := *do.HTTPTarget.Auth
+	var err error
+	if auth.Token, err = e.encryptSecret(ctx, do.SpaceID, auth.Token); err != nil {
+		return nil, err
+	}
+	if auth.Password, err = e.encryptSecret(ctx, do.SpaceID, auth.Password); err != nil {
+		return nil, err
+	}
+	httpTarget := *do.HTTPTarget
+	httpTarget.Auth = &auth
+	version := *do
+	version.HTTPTarget = &httpTarget
+	return convertor.EvalTargetVersionDO2PO(&version)
+}
+
+func (e *EvalTargetRepoImpl) evalTargetVersionPO2DO(ctx context.Context, po *model.TargetVersion, targetType entity.EvalTargetType) (*entity.EvalTargetVersion, error) {
+	do := convertor.EvalTargetVersionPO2DO(po, targetType)
+	if do == nil || do.HTTPTarget == nil || do.HTTPTarget.Auth == nil {
+		return do, nil
+	}
+	var err error
+	if do.HTTPTarget.Auth.Token, err = e.decryptSecret(ctx, do.SpaceID, do.HTTPTarget.Auth.Token); err != nil {
+		return nil, err
+	}
+	if do.HTTPTarget.Auth.Password, err = e.decryptSecret(ctx, do.SpaceID, do.HTTPTarget.Auth.Password); err != nil {
+		return nil, err
+	}
+	return do, nil
+}
+
+func (e *EvalTargetRepoImpl) encryptSecret(ctx context.Context, spaceID int64, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	ciphertext, err := e.kms.Encrypt(ctx, httpTargetAuthDataKey(spaceID), plaintext)
+	if err != nil {
+		return "", errorx.WrapByCode(err, errno.CommonInternalErrorCode, errorx.WithExtraMsg("encrypt http target auth failed"))
+	}
+	return ciphertext, nil
+}
+
+func (e *EvalTargetRepoImpl) decryptSecret(ctx context.Context, spaceID int64, ciphertext string) (string, error) {
+	if ciphertext == "" {
+		return "", nil
+	}
+	plaintext, err := e.kms.Decrypt(ctx, httpTargetAuthDataKey(spaceID), ciphertext)
+	if err != nil {
+		return "", errorx.WrapByCode(err, errno.CommonInternalErrorCode, errorx.WithExtraMsg("decrypt http target auth failed"))
+	}
+	return plaintext, nil
+}
+
+func httpTargetAuthDataKey(spaceID int64) string {
+	return fmt.Sprintf("eval_target_http_auth_%d", spaceID)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/coze-dev/coze-loop/backend/infra/db"
 	dbmock "github.com/coze-dev/coze-loop/backend/infra/db/mocks"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	idgen "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
 	"github.com/coze-dev/coze-loop/backend/infra/platestwrite"
 	platestwrite_mocks "github.com/coze-dev/coze-loop/backend/infra/platestwrite/mocks"
@@ -862,3 +863,78 @@ func TestEvalTargetRepoImpl_GetEvalTargetRecordByIDAndSpaceID(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalTargetRepoImpl_CreateEvalTarget_HTTP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEvalTargetDao := mysqlmocks.NewMockEvalTargetDAO(ctrl)
+	mockEvalTargetVersionDao := mysqlmocks.NewMockEvalTargetVersionDAO(ctrl)
+	mockIDGen := idgen.NewMockIIDGenerator(ctrl)
+	mockDBProvider := dbmock.NewMockProvider(ctrl)
+	mockLWT := platestwrite_mocks.NewMockILatestWriteTracker(ctrl)
+	kms, err := dkms.NewAESDKMS("test-master-key")
+	assert.NoError(t, err)
+
+	repo := &EvalTargetRepoImpl{
+		evalTargetDao:        mockEvalTargetDao,
+		evalTargetVersionDao: mockEvalTargetVersionDao,
+		idgen:                mockIDGen,
+		dbProvider:           mockDBProvider,
+		lwt:                  mockLWT,
+		kms:                  kms,
+	}
+	newTarget := func(token string) *entity.EvalTarget {
+		return &entity.EvalTarget{
+			SpaceID:        1,
+			SourceTargetID: "svc",
+			EvalTargetType: entity.EvalTargetTypeHTTP,
+			EvalTargetVersion: &entity.EvalTargetVersion{
+				SpaceID:             1,
+				SourceTargetVersion: "v1",
+				EvalTargetType:      entity.EvalTargetTypeHTTP,
+				InputSchema:         []*entity.ArgsSchema{},
+				OutputSchema:        []*entity.ArgsSchema{},
+				HTTPTarget: &entity.HTTPTarget{
+					URL:  "https://example.com/chat",
+					Auth: &entity.HTTPAuth{AuthType: entity.HTTPAuthTypeBearer, Token: token},
+				},
+			},
+		}
+	}
+
+	mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{10, 11}, nil).AnyTimes()
+	mockDBProvider.EXPECT().Transaction(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fc func(*gorm.DB) error, opts ...db.Option) error {
+		return fc(nil)
+	}).AnyTimes()
+	mockLWT.EXPECT().SetWriteFlag(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	// 首次创建，鉴权信息加密落库
+	var versionPO *model.TargetVersion
+	mockEvalTargetDao.EXPECT().GetEvalTargetBySourceID(gomock.Any(), int64(1), "svc", int32(entity.EvalTargetTypeHTTP), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockEvalTargetDao.EXPECT().CreateEvalTarget(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockEvalTargetVersionDao.EXPECT().GetEvalTargetVersionByTarget(gomock.Any(), int64(1), int64(10), "v1", gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockEvalTargetVersionDao.EXPECT().CreateEvalTargetVersion(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, po *model.TargetVersion, opts ...db.Option) error {
+		versionPO = po
+		return nil
+	})
+	target := newTarget("secret-token")
+	_, versionID, err := repo.CreateEvalTarget(context.Background(), target)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), versionID)
+	assert.Contains(t, string(gptr.Indirect(versionPO.TargetMeta)), "example.com")
+	assert.NotContains(t, string(gptr.Indirect(versionPO.TargetMeta)), "secret-token")
+	assert.Equal(t, "secret-token", target.EvalTargetVersion.HTTPTarget.Auth.Token)
+
+	// 同名版本配置一致时复用，不一致时拒绝
+	mockEvalTargetDao.EXPECT().GetEvalTargetBySourceID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&model.Target{ID: 10}, nil).Times(2)
+	mockEvalTargetVersionDao.EXPECT().GetEvalTargetVersionByTarget(gomock.Any(), int64(1), int64(10), "v1", gomock.Any(), gomock.Any()).Return(versionPO, nil).Times(2)
+	_, versionID, err = repo.CreateEvalTarget(context.Background(), newTarget("secret-token"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), versionID)
+
+	_, _, err = repo.CreateEvalTarget(context.Background(), newTarget("another-token"))
+	statusErr, ok := errorx.FromStatusError(err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(errno.InvalidEvalTargetConfigCode), statusErr.Code())
+}
@@ -58,6 +58,11 @@ func EvalTargetVersionDO2PO(do *entity.EvalTargetVersion) (po *model.TargetVersi
 		if err != nil {
 			return nil, err
 		}
+	case entity.EvalTargetTypeHTTP:
+		meta, err = json.Marshal(do.HTTPTarget)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if do.InputSchema != nil {
 		inputSchema, err = json.Marshal(do.InputSchema)
@@ -190,6 +195,11 @@ func EvalTargetVersionPO2DO(targetVersionPO *model.TargetVersion, targetType ent
 			if err := json.Unmarshal(*targetVersionPO.TargetMeta, meta); err == nil {
 				targetVersionDO.CozeWorkflow = meta
 			}
+		case entity.EvalTargetTypeHTTP:
+			meta := &entity.HTTPTarget{}
+			if err := json.Unmarshal(*targetVersionPO.TargetMeta, meta); err == nil {
+				targetVersionDO.HTTPTarget = meta
+			}
 		default:
 			// todo
 		}
@@ -23,12 +23,14 @@ func TestEvalTargetVersionDO2PO(t *testing.T) {
 	validCozeBot := &entity.CozeBot{BotID: 123, BotVersion: "v1"}
 	validPrompt := &entity.LoopPrompt{PromptID: 456, Version: "v2"}
 	validWorkflow := &entity.CozeWorkflow{ID: "789", Version: "v3"}
+	validHTTPTarget := &entity.HTTPTarget{URL: "https://example.com/chat", BodyTemplate: `{"q":"{{input}}"}`}
 	validInputSchema := []*entity.ArgsSchema{{Key: gptr.Of("input")}}
 	validOutputSchema := []*entity.ArgsSchema{{Key: gptr.Of("output")}}
 
 	cozeBotJSON, _ := json.Marshal(validCozeBot)
 	promptJSON, _ := json.Marshal(validPrompt)
 	workflowJSON, _ := json.Marshal(validWorkflow)
+	httpTargetJSON, _ := json.Marshal(validHTTPTarget)
 	inputSchemaJSON, _ := json.Marshal(validInputSchema)
 	outputSchemaJSON, _ := json.Marshal(validOutputSchema)
 
@@ -117,6 +119,29 @@ func TestEvalTargetVersionDO2PO(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success - HTTP type",
+			do: &entity.EvalTargetVersion{
+				ID:                  4,
+				SpaceID:             40,
+				TargetID:            400,
+				SourceTargetVersion: "v4.0",
+				EvalTargetType:      entity.EvalTargetTypeHTTP,
+				HTTPTarget:          validHTTPTarget,
+				InputSchema:         validInputSchema,
+				OutputSchema:        validOutputSchema,
+			},
+			wantPO: &model.TargetVersion{
+				ID:                  4,
+				SpaceID:             40,
+				TargetID:            400,
+				SourceTargetVersion: "v4.0",
+				TargetMeta:          &httpTargetJSON,
+				InputSchema:         &inputSchemaJSON,
+				OutputSchema:        &outputSchemaJSON,
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -183,3 +208,26 @@ func TestEvalTargetPO2DOs(t *testing.T) {
 	assert.Equal(t, len(pos), len(dos))
 	assert.Equal(t, pos[0].ID, dos[0].ID)
 }
+
+func TestEvalTargetVersionHTTPRoundTrip(t *testing.T) {
+	do := &entity.EvalTargetVersion{
+		ID:             1,
+		EvalTargetType: entity.EvalTargetTypeHTTP,
+		HTTPTarget: &entity.HTTPTarget{
+			URL:            "https://example.com/chat",
+			Method:         "POST",
+			Headers:        map[string]string{"X-Env": "test"},
+			Auth:           &entity.HTTPAuth{AuthType: entity.HTTPAuthTypeBearer, Token: "t"},
+			BodyTemplate:   `{"q":"{{input}}"}`,
+			OutputMappings: []*entity.HTTPOutputMapping{{FieldKey: "actual_output", JSONPath: "$.answer"}},
+			TimeoutMS:      1000,
+			RetryTimes:     2,
+		},
+		InputSchema:  []*entity.ArgsSchema{{Key: gptr.Of("input")}},
+		OutputSchema: []*entity.ArgsSchema{{Key: gptr.Of("actual_output")}},
+	}
+	po, err := EvalTargetVersionDO2PO(do)
+	assert.NoError(t, err)
+	got := EvalTargetVersionPO2DO(po, entity.EvalTargetTypeHTTP)
+	assert.Equal(t, do.HTTPTarget, got.HTTPTarget)
+}
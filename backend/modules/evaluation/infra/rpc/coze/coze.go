@@ -0,0 +1,52 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package coze
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// UnconfiguredCozeRPCAdapter 开源部署默认不接入 Coze 服务，所有调用均返回未配置错误。
+// 接入 Coze 时替换为具体实现即可。
+type UnconfiguredCozeRPCAdapter struct{}
+
+func NewCozeRPCAdapter() rpc.ICozeRPCAdapter {
+	return &UnconfiguredCozeRPCAdapter{}
+}
+
+func (a *UnconfiguredCozeRPCAdapter) ListBot(ctx context.Context, param *rpc.ListCozeSourceParam) (bots []*rpc.CozeBotInfo, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, errorx.NewByCode(errno.CozeServiceNotConfiguredCode)
+}
+
+func (a *UnconfiguredCozeRPCAdapter) ListBotVersion(ctx context.Context, param *rpc.ListCozeSourceVersionParam) (bots []*rpc.CozeBotInfo, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, errorx.NewByCode(errno.CozeServiceNotConfiguredCode)
+}
+
+func (a *UnconfiguredCozeRPCAdapter) MGetBot(ctx context.Context, spaceID int64, queries []*rpc.MGetCozeBotQuery) (bots []*rpc.CozeBotInfo, err error) {
+	return nil, errorx.NewByCode(errno.CozeServiceNotConfiguredCode)
+}
+
+func (a *UnconfiguredCozeRPCAdapter) StreamChatBot(ctx context.Context, spaceID int64, param *rpc.ChatCozeBotParam) (reader rpc.CozeStreamReader, err error) {
+	return nil, errorx.NewByCode(errno.CozeServiceNotConfiguredCode)
+}
+
+func (a *UnconfiguredCozeRPCAdapter) ListWorkflow(ctx context.Context, param *rpc.ListCozeSourceParam) (workflows []*rpc.CozeWorkflowInfo, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, errorx.NewByCode(errno.CozeServiceNotConfiguredCode)
+}
+
+func (a *UnconfiguredCozeRPCAdapter) ListWorkflowVersion(ctx context.Context, param *rpc.ListCozeSourceVersionParam) (workflows []*rpc.CozeWorkflowInfo, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, errorx.NewByCode(errno.CozeServiceNotConfiguredCode)
+}
+
+func (a *UnconfiguredCozeRPCAdapter) MGetWorkflow(ctx context.Context, spaceID int64, queries []*rpc.MGetCozeWorkflowQuery) (workflows []*rpc.CozeWorkflowInfo, err error) {
+	return nil, errorx.NewByCode(errno.CozeServiceNotConfiguredCode)
+}
+
+func (a *UnconfiguredCozeRPCAdapter) StreamRunWorkflow(ctx context.Context, spaceID int64, param *rpc.RunCozeWorkflowParam) (reader rpc.CozeStreamReader, err error) {
+	return nil, errorx.NewByCode(errno.CozeServiceNotConfiguredCode)
+}
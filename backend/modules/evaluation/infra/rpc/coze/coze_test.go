@@ -0,0 +1,43 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package coze
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func TestUnconfiguredCozeRPCAdapter(t *testing.T) {
+	ctx := context.Background()
+	adapter := NewCozeRPCAdapter()
+
+	assertNotConfigured := func(err error) {
+		statusErr, ok := errorx.FromStatusError(err)
+		assert.True(t, ok)
+		assert.Equal(t, int32(errno.CozeServiceNotConfiguredCode), statusErr.Code())
+	}
+
+	_, _, _, err := adapter.ListBot(ctx, &rpc.ListCozeSourceParam{})
+	assertNotConfigured(err)
+	_, _, _, err = adapter.ListBotVersion(ctx, &rpc.ListCozeSourceVersionParam{})
+	assertNotConfigured(err)
+	_, err = adapter.MGetBot(ctx, 1, nil)
+	assertNotConfigured(err)
+	_, err = adapter.StreamChatBot(ctx, 1, &rpc.ChatCozeBotParam{})
+	assertNotConfigured(err)
+	_, _, _, err = adapter.ListWorkflow(ctx, &rpc.ListCozeSourceParam{})
+	assertNotConfigured(err)
+	_, _, _, err = adapter.ListWorkflowVersion(ctx, &rpc.ListCozeSourceVersionParam{})
+	assertNotConfigured(err)
+	_, err = adapter.MGetWorkflow(ctx, 1, nil)
+	assertNotConfigured(err)
+	_, err = adapter.StreamRunWorkflow(ctx, 1, &rpc.RunCozeWorkflowParam{})
+	assertNotConfigured(err)
+}
@@ -132,6 +132,9 @@ func (p PromptRPCAdapter) MGetPrompt(ctx context.Context, spaceID int64, promptQ
 		if query.Version != nil {
 			promptQuery.WithCommit = gptr.Of(true)
 			promptQuery.CommitVersion = query.Version
+		} else if query.Label != nil {
+			promptQuery.WithCommit = gptr.Of(true)
+			promptQuery.Label = query.Label
 		}
 		queries = append(queries, promptQuery)
 	}
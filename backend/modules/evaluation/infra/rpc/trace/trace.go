@@ -0,0 +1,59 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/observabilitytraceservice"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/trace"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+)
+
+type TraceRPCAdapter struct {
+	client observabilitytraceservice.Client
+}
+
+func NewTraceRPCAdapter(client observabilitytraceservice.Client) rpc.ITraceRPCAdapter {
+	return &TraceRPCAdapter{
+		client: client,
+	}
+}
+
+func (a *TraceRPCAdapter) GetTraceSpans(ctx context.Context, param *rpc.GetTraceSpansParam) (spans []*rpc.TraceSpan, err error) {
+	req := &trace.GetTraceRequest{
+		WorkspaceID: param.SpaceID,
+		TraceID:     param.TraceID,
+		StartTime:   param.StartTime,
+		EndTime:     param.EndTime,
+	}
+	if len(param.SpanIDs) > 0 {
+		req.SpanIds = param.SpanIDs
+	}
+	resp, err := a.client.GetTrace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	spans = make([]*rpc.TraceSpan, 0, len(resp.GetSpans()))
+	for _, s := range resp.GetSpans() {
+		if s == nil {
+			continue
+		}
+		spans = append(spans, &rpc.TraceSpan{
+			TraceID:    s.GetTraceID(),
+			SpanID:     s.GetSpanID(),
+			ParentID:   s.GetParentID(),
+			SpanName:   s.GetSpanName(),
+			SpanType:   s.GetSpanType(),
+			StartedAt:  s.GetStartedAt(),
+			DurationMS: s.GetDuration(),
+			StatusCode: s.GetStatusCode(),
+			Input:      s.GetInput(),
+			Output:     s.GetOutput(),
+			CustomTags: s.GetCustomTags(),
+			SystemTags: s.GetSystemTags(),
+		})
+	}
+	return spans, nil
+}
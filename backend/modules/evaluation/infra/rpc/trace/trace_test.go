@@ -0,0 +1,66 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/span"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/observabilitytraceservice"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/trace"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+)
+
+type stubTraceClient struct {
+	observabilitytraceservice.Client
+	getTrace func(ctx context.Context, req *trace.GetTraceRequest) (*trace.GetTraceResponse, error)
+}
+
+func (s *stubTraceClient) GetTrace(ctx context.Context, req *trace.GetTraceRequest, callOptions ...callopt.Option) (*trace.GetTraceResponse, error) {
+	return s.getTrace(ctx, req)
+}
+
+func TestTraceRPCAdapter_GetTraceSpans(t *testing.T) {
+	client := &stubTraceClient{getTrace: func(ctx context.Context, req *trace.GetTraceRequest) (*trace.GetTraceResponse, error) {
+		assert.Equal(t, int64(100), req.WorkspaceID)
+		assert.Equal(t, "t1", req.TraceID)
+		assert.Equal(t, int64(1), req.StartTime)
+		assert.Equal(t, int64(2), req.EndTime)
+		assert.Equal(t, []string{"s1"}, req.SpanIds)
+		return &trace.GetTraceResponse{Spans: []*span.OutputSpan{
+			nil,
+			{
+				TraceID: "t1", SpanID: "s1", ParentID: "0", SpanName: "chat", SpanType: "model",
+				StartedAt: 1, Duration: 30, StatusCode: 0, Input: "in", Output: "out",
+				CustomTags: map[string]string{"input_tokens": "3"},
+				SystemTags: map[string]string{"runtime": "go"},
+			},
+		}}, nil
+	}}
+	adapter := NewTraceRPCAdapter(client)
+
+	spans, err := adapter.GetTraceSpans(context.Background(), &rpc.GetTraceSpansParam{
+		SpaceID: 100, TraceID: "t1", SpanIDs: []string{"s1"}, StartTime: 1, EndTime: 2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*rpc.TraceSpan{{
+		TraceID: "t1", SpanID: "s1", ParentID: "0", SpanName: "chat", SpanType: "model",
+		StartedAt: 1, DurationMS: 30, Input: "in", Output: "out",
+		CustomTags: map[string]string{"input_tokens": "3"},
+		SystemTags: map[string]string{"runtime": "go"},
+	}}, spans)
+	assert.True(t, spans[0].IsRoot())
+
+	client.getTrace = func(ctx context.Context, req *trace.GetTraceRequest) (*trace.GetTraceResponse, error) {
+		assert.Nil(t, req.SpanIds)
+		return nil, errors.New("permission denied")
+	}
+	_, err = adapter.GetTraceSpans(context.Background(), &rpc.GetTraceSpansParam{SpaceID: 100, TraceID: "t1"})
+	assert.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
 	evaluatordto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/evaluator"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 	"github.com/coze-dev/coze-loop/backend/pkg/contexts"
 )
@@ -23,6 +24,7 @@ type IConfiger interface {
 	GetEvaluatorToolMapping(ctx context.Context) (etf map[string]string)            // prompt_template_key -> tool_key
 	GetEvaluatorPromptSuffix(ctx context.Context) (suffix map[string]string)        // suffix_key -> suffix
 	GetEvaluatorPromptSuffixMapping(ctx context.Context) (suffix map[string]string) // model_id -> suffix_key
+	GetCodeEvaluatorRuntimeConf(ctx context.Context) (crc *entity.CodeEvaluatorRuntimeConf)
 }
 
 func NewEvaluatorConfiger(configFactory conf.IConfigLoaderFactory) IConfiger {
@@ -109,3 +111,8 @@ func (c *configer) GetEvaluatorPromptSuffixMapping(ctx context.Context) (suffix
 func DefaultEvaluatorPromptMapping() map[string]string {
 	return make(map[string]string)
 }
+
+func (c *configer) GetCodeEvaluatorRuntimeConf(ctx context.Context) (crc *entity.CodeEvaluatorRuntimeConf) {
+	const key = "code_evaluator_runtime_conf"
+	return lo.Ternary(c.loader.UnmarshalKey(ctx, key, &crc) == nil && crc != nil, crc, entity.DefaultCodeEvaluatorRuntimeConf())
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	evaluatordto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/evaluator"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 	mock_conf "github.com/coze-dev/coze-loop/backend/pkg/conf/mocks"
 	"github.com/coze-dev/coze-loop/backend/pkg/contexts"
@@ -226,3 +227,25 @@ func TestConfiger_GetEvaluatorTemplateConf(t *testing.T) {
 		})
 	}
 }
+
+func TestConfiger_GetCodeEvaluatorRuntimeConf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLoader := mock_conf.NewMockIConfigLoader(ctrl)
+	c := &configer{loader: mockLoader}
+	ctx := context.Background()
+	const key = "code_evaluator_runtime_conf"
+
+	mockLoader.EXPECT().UnmarshalKey(ctx, key, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, out any, _ ...conf.DecodeOptionFn) error {
+			ptr := out.(**entity.CodeEvaluatorRuntimeConf)
+			*ptr = &entity.CodeEvaluatorRuntimeConf{TimeoutMS: 100}
+			return nil
+		},
+	)
+	assert.Equal(t, int64(100), c.GetCodeEvaluatorRuntimeConf(ctx).TimeoutMS)
+
+	mockLoader.EXPECT().UnmarshalKey(ctx, key, gomock.Any(), gomock.Any()).Return(errors.New("not found"))
+	assert.Equal(t, entity.DefaultCodeEvaluatorRuntimeConf(), c.GetCodeEvaluatorRuntimeConf(ctx))
+}
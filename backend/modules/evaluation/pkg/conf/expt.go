@@ -61,3 +61,8 @@ func (c *configer) GetExptExportWhiteList(ctx context.Context) (eec *entity.Expt
 	const key = "expt_export_white_list"
 	return lo.Ternary(c.loader.UnmarshalKey(ctx, key, &eec) == nil, eec, entity.DefaultExptExportWhiteList())
 }
+
+func (c *configer) GetHTTPTargetNetworkConf(ctx context.Context) (hnc *entity.HTTPTargetNetworkConf) {
+	const key = "http_target_network_conf"
+	return lo.Ternary(c.loader.UnmarshalKey(ctx, key, &hnc) == nil && hnc != nil, hnc, entity.DefaultHTTPTargetNetworkConf())
+}
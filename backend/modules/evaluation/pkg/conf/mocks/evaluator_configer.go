@@ -1,5 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/conf (interfaces: IConfiger)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/evaluator_configer.go -package=mocks . IConfiger
+//
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -10,6 +15,7 @@ import (
 
 	limiter "github.com/coze-dev/coze-loop/backend/infra/limiter"
 	evaluator "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/evaluator"
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -36,6 +42,20 @@ func (m *MockIConfiger) EXPECT() *MockIConfigerMockRecorder {
 	return m.recorder
 }
 
+// GetCodeEvaluatorRuntimeConf mocks base method.
+func (m *MockIConfiger) GetCodeEvaluatorRuntimeConf(arg0 context.Context) *entity.CodeEvaluatorRuntimeConf {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeEvaluatorRuntimeConf", arg0)
+	ret0, _ := ret[0].(*entity.CodeEvaluatorRuntimeConf)
+	return ret0
+}
+
+// GetCodeEvaluatorRuntimeConf indicates an expected call of GetCodeEvaluatorRuntimeConf.
+func (mr *MockIConfigerMockRecorder) GetCodeEvaluatorRuntimeConf(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeEvaluatorRuntimeConf", reflect.TypeOf((*MockIConfiger)(nil).GetCodeEvaluatorRuntimeConf), arg0)
+}
+
 // GetEvaluatorPromptSuffix mocks base method.
 func (m *MockIConfiger) GetEvaluatorPromptSuffix(arg0 context.Context) map[string]string {
 	m.ctrl.T.Helper()
@@ -45,7 +65,7 @@ func (m *MockIConfiger) GetEvaluatorPromptSuffix(arg0 context.Context) map[strin
 }
 
 // GetEvaluatorPromptSuffix indicates an expected call of GetEvaluatorPromptSuffix.
-func (mr *MockIConfigerMockRecorder) GetEvaluatorPromptSuffix(arg0 interface{}) *gomock.Call {
+func (mr *MockIConfigerMockRecorder) GetEvaluatorPromptSuffix(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluatorPromptSuffix", reflect.TypeOf((*MockIConfiger)(nil).GetEvaluatorPromptSuffix), arg0)
 }
@@ -59,7 +79,7 @@ func (m *MockIConfiger) GetEvaluatorPromptSuffixMapping(arg0 context.Context) ma
 }
 
 // GetEvaluatorPromptSuffixMapping indicates an expected call of GetEvaluatorPromptSuffixMapping.
-func (mr *MockIConfigerMockRecorder) GetEvaluatorPromptSuffixMapping(arg0 interface{}) *gomock.Call {
+func (mr *MockIConfigerMockRecorder) GetEvaluatorPromptSuffixMapping(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluatorPromptSuffixMapping", reflect.TypeOf((*MockIConfiger)(nil).GetEvaluatorPromptSuffixMapping), arg0)
 }
@@ -73,7 +93,7 @@ func (m *MockIConfiger) GetEvaluatorTemplateConf(arg0 context.Context) map[strin
 }
 
 // GetEvaluatorTemplateConf indicates an expected call of GetEvaluatorTemplateConf.
-func (mr *MockIConfigerMockRecorder) GetEvaluatorTemplateConf(arg0 interface{}) *gomock.Call {
+func (mr *MockIConfigerMockRecorder) GetEvaluatorTemplateConf(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluatorTemplateConf", reflect.TypeOf((*MockIConfiger)(nil).GetEvaluatorTemplateConf), arg0)
 }
@@ -87,7 +107,7 @@ func (m *MockIConfiger) GetEvaluatorToolConf(arg0 context.Context) map[string]*e
 }
 
 // GetEvaluatorToolConf indicates an expected call of GetEvaluatorToolConf.
-func (mr *MockIConfigerMockRecorder) GetEvaluatorToolConf(arg0 interface{}) *gomock.Call {
+func (mr *MockIConfigerMockRecorder) GetEvaluatorToolConf(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluatorToolConf", reflect.TypeOf((*MockIConfiger)(nil).GetEvaluatorToolConf), arg0)
 }
@@ -101,7 +121,7 @@ func (m *MockIConfiger) GetEvaluatorToolMapping(arg0 context.Context) map[string
 }
 
 // GetEvaluatorToolMapping indicates an expected call of GetEvaluatorToolMapping.
-func (mr *MockIConfigerMockRecorder) GetEvaluatorToolMapping(arg0 interface{}) *gomock.Call {
+func (mr *MockIConfigerMockRecorder) GetEvaluatorToolMapping(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluatorToolMapping", reflect.TypeOf((*MockIConfiger)(nil).GetEvaluatorToolMapping), arg0)
 }
@@ -115,7 +135,7 @@ func (m *MockIConfiger) GetRateLimiterConf(arg0 context.Context) []limiter.Rule
 }
 
 // GetRateLimiterConf indicates an expected call of GetRateLimiterConf.
-func (mr *MockIConfigerMockRecorder) GetRateLimiterConf(arg0 interface{}) *gomock.Call {
+func (mr *MockIConfigerMockRecorder) GetRateLimiterConf(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimiterConf", reflect.TypeOf((*MockIConfiger)(nil).GetRateLimiterConf), arg0)
 }
@@ -104,6 +104,14 @@ const (
 	exportRunningCountLimitMessage           = "number of export in running has reached the maximum limit"
 	exportRunningCountLimitNoAffectStability = true
 
+	ExperimentCompareMismatchCode              = 601204010 // experiments to compare must share the same evaluation set version
+	experimentCompareMismatchMessage           = "experiments to compare must share the same evaluation set version"
+	experimentCompareMismatchNoAffectStability = true
+
+	ExperimentStatusNotAllowedCode              = 601204011 // the operation is not allowed in current experiment status
+	experimentStatusNotAllowedMessage           = "the operation is not allowed in current experiment status"
+	experimentStatusNotAllowedNoAffectStability = true
+
 	ContentTypeNotSupportedCode              = 601205000 // content type is not supported
 	contentTypeNotSupportedMessage           = "content type is not supported"
 	contentTypeNotSupportedNoAffectStability = true
@@ -207,6 +215,50 @@ const (
 	EvaluatorBenefitDenyCode              = 601205026 // evaluator benefit deny
 	evaluatorBenefitDenyMessage           = "evaluator benefit deny"
 	evaluatorBenefitDenyNoAffectStability = true
+
+	CodeLanguageNotSupportedCode              = 601205027 // code language not supported
+	codeLanguageNotSupportedMessage           = "code language not supported"
+	codeLanguageNotSupportedNoAffectStability = true
+
+	InvalidCodeContentCode              = 601205028 // code content is invalid
+	invalidCodeContentMessage           = "code content is invalid"
+	invalidCodeContentNoAffectStability = true
+
+	CodeExecuteFailCode              = 601205029 // code execute fail
+	codeExecuteFailMessage           = "code execute fail"
+	codeExecuteFailNoAffectStability = true
+
+	CodeExecuteTimeoutCode              = 601205030 // code execute timeout
+	codeExecuteTimeoutMessage           = "code execute timeout"
+	codeExecuteTimeoutNoAffectStability = true
+
+	BuiltinEvaluatorTypeNotSupportedCode              = 601205031 // builtin evaluator type not supported
+	builtinEvaluatorTypeNotSupportedMessage           = "builtin evaluator type not supported"
+	builtinEvaluatorTypeNotSupportedNoAffectStability = true
+
+	InvalidBuiltinEvaluatorConfigCode              = 601205032 // builtin evaluator config is invalid
+	invalidBuiltinEvaluatorConfigMessage           = "builtin evaluator config is invalid"
+	invalidBuiltinEvaluatorConfigNoAffectStability = true
+
+	PairwiseOutputMissingCode              = 601205033 // pairwise evaluator requires both output_a and output_b
+	pairwiseOutputMissingMessage           = "output to compare is missing"
+	pairwiseOutputMissingNoAffectStability = true
+
+	InvalidPairwiseBaselineCode              = 601205034 // pairwise baseline experiment is invalid
+	invalidPairwiseBaselineMessage           = "pairwise baseline experiment is invalid"
+	invalidPairwiseBaselineNoAffectStability = true
+
+	InvalidEvalTargetConfigCode              = 601206001 // eval target config is invalid
+	invalidEvalTargetConfigMessage           = "eval target config is invalid"
+	invalidEvalTargetConfigNoAffectStability = true
+
+	ParseTargetResponseFailCode              = 601206002 // parse target response fail
+	parseTargetResponseFailMessage           = "parse target response fail"
+	parseTargetResponseFailNoAffectStability = true
+
+	CozeServiceNotConfiguredCode              = 601206003 // coze service is not configured
+	cozeServiceNotConfiguredMessage           = "coze service is not configured"
+	cozeServiceNotConfiguredNoAffectStability = true
 )
 
 func init() {
@@ -355,6 +407,18 @@ func init() {
 		code.WithAffectStability(!exportRunningCountLimitNoAffectStability),
 	)
 
+	code.Register(
+		ExperimentCompareMismatchCode,
+		experimentCompareMismatchMessage,
+		code.WithAffectStability(!experimentCompareMismatchNoAffectStability),
+	)
+
+	code.Register(
+		ExperimentStatusNotAllowedCode,
+		experimentStatusNotAllowedMessage,
+		code.WithAffectStability(!experimentStatusNotAllowedNoAffectStability),
+	)
+
 	code.Register(
 		ContentTypeNotSupportedCode,
 		contentTypeNotSupportedMessage,
@@ -511,4 +575,70 @@ func init() {
 		code.WithAffectStability(!evaluatorBenefitDenyNoAffectStability),
 	)
 
+	code.Register(
+		CodeLanguageNotSupportedCode,
+		codeLanguageNotSupportedMessage,
+		code.WithAffectStability(!codeLanguageNotSupportedNoAffectStability),
+	)
+
+	code.Register(
+		InvalidCodeContentCode,
+		invalidCodeContentMessage,
+		code.WithAffectStability(!invalidCodeContentNoAffectStability),
+	)
+
+	code.Register(
+		CodeExecuteFailCode,
+		codeExecuteFailMessage,
+		code.WithAffectStability(!codeExecuteFailNoAffectStability),
+	)
+
+	code.Register(
+		CodeExecuteTimeoutCode,
+		codeExecuteTimeoutMessage,
+		code.WithAffectStability(!codeExecuteTimeoutNoAffectStability),
+	)
+
+	code.Register(
+		BuiltinEvaluatorTypeNotSupportedCode,
+		builtinEvaluatorTypeNotSupportedMessage,
+		code.WithAffectStability(!builtinEvaluatorTypeNotSupportedNoAffectStability),
+	)
+
+	code.Register(
+		InvalidBuiltinEvaluatorConfigCode,
+		invalidBuiltinEvaluatorConfigMessage,
+		code.WithAffectStability(!invalidBuiltinEvaluatorConfigNoAffectStability),
+	)
+
+	code.Register(
+		PairwiseOutputMissingCode,
+		pairwiseOutputMissingMessage,
+		code.WithAffectStability(!pairwiseOutputMissingNoAffectStability),
+	)
+
+	code.Register(
+		InvalidPairwiseBaselineCode,
+		invalidPairwiseBaselineMessage,
+		code.WithAffectStability(!invalidPairwiseBaselineNoAffectStability),
+	)
+
+	code.Register(
+		InvalidEvalTargetConfigCode,
+		invalidEvalTargetConfigMessage,
+		code.WithAffectStability(!invalidEvalTargetConfigNoAffectStability),
+	)
+
+	code.Register(
+		ParseTargetResponseFailCode,
+		parseTargetResponseFailMessage,
+		code.WithAffectStability(!parseTargetResponseFailNoAffectStability),
+	)
+
+	code.Register(
+		CozeServiceNotConfiguredCode,
+		cozeServiceNotConfiguredMessage,
+		code.WithAffectStability(!cozeServiceNotConfiguredNoAffectStability),
+	)
+
 }
@@ -0,0 +1,158 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package textmetric 提供确定性的文本相似度指标，供内置评估器使用
+package textmetric
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Tokenize 将文本切分为词。连续的字母、数字组成一个词；中日韩文字按字切分；
+// 标点单独成词；空白字符丢弃。
+func Tokenize(s string) []string {
+	tokens := make([]string, 0)
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case unicode.IsSpace(r):
+			flush()
+		case isCJK(r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			word.WriteRune(r)
+		default:
+			flush()
+			tokens = append(tokens, string(r))
+		}
+	}
+	flush()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// Levenshtein 计算两个字符串按 rune 的编辑距离
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// LevenshteinSimilarity 归一化的编辑距离相似度，取值 [0, 1]，两者均为空时为 1
+func LevenshteinSimilarity(a, b string) float64 {
+	maxLen := max(len([]rune(a)), len([]rune(b)))
+	if maxLen == 0 {
+		return 1
+	}
+	return 1 - float64(Levenshtein(a, b))/float64(maxLen)
+}
+
+// RougeL 基于最长公共子序列的 ROUGE-L F1 值
+func RougeL(candidate, reference []string) float64 {
+	if len(candidate) == 0 || len(reference) == 0 {
+		if len(candidate) == 0 && len(reference) == 0 {
+			return 1
+		}
+		return 0
+	}
+	lcs := lcsLength(candidate, reference)
+	if lcs == 0 {
+		return 0
+	}
+	precision := float64(lcs) / float64(len(candidate))
+	recall := float64(lcs) / float64(len(reference))
+	return 2 * precision * recall / (precision + recall)
+}
+
+func lcsLength(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				cur[j] = prev[j-1] + 1
+			} else {
+				cur[j] = max(prev[j], cur[j-1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// BLEU 计算句子级 BLEU，n-gram 权重均等。单句场景下高阶 n-gram 极易为 0，
+// 因此对 n > 1 的精度做加一平滑（Lin & Och, 2004）。
+func BLEU(candidate, reference []string, maxN int) float64 {
+	if len(candidate) == 0 || len(reference) == 0 || maxN <= 0 {
+		return 0
+	}
+	logSum := 0.0
+	for n := 1; n <= maxN; n++ {
+		matched, total := ngramMatches(candidate, reference, n)
+		if n > 1 {
+			matched, total = matched+1, total+1
+		}
+		if matched == 0 || total == 0 {
+			return 0
+		}
+		logSum += math.Log(float64(matched) / float64(total))
+	}
+	bp := 1.0
+	if len(candidate) < len(reference) {
+		bp = math.Exp(1 - float64(len(reference))/float64(len(candidate)))
+	}
+	return bp * math.Exp(logSum/float64(maxN))
+}
+
+// ngramMatches 返回截断后的 n-gram 命中数与候选 n-gram 总数
+func ngramMatches(candidate, reference []string, n int) (matched, total int) {
+	if len(candidate) < n {
+		return 0, 0
+	}
+	refCounts := ngramCounts(reference, n)
+	for gram, count := range ngramCounts(candidate, n) {
+		matched += min(count, refCounts[gram])
+	}
+	return matched, len(candidate) - n + 1
+}
+
+func ngramCounts(tokens []string, n int) map[string]int {
+	counts := make(map[string]int)
+	for i := 0; i+n <= len(tokens); i++ {
+		counts[strings.Join(tokens[i:i+n], "\x00")]++
+	}
+	return counts
+}
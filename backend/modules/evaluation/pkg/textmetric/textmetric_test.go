@@ -0,0 +1,67 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package textmetric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{name: "empty", in: "", want: []string{}},
+		{name: "english with punctuation", in: "Hello, world!", want: []string{"Hello", ",", "world", "!"}},
+		{name: "chinese split by rune", in: "你好 世界", want: []string{"你", "好", "世", "界"}},
+		{name: "mixed", in: "GPT4是模型", want: []string{"GPT4", "是", "模", "型"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Tokenize(tt.in))
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, Levenshtein("", ""))
+	assert.Equal(t, 3, Levenshtein("", "abc"))
+	assert.Equal(t, 3, Levenshtein("kitten", "sitting"))
+	assert.Equal(t, 1, Levenshtein("你好", "您好"))
+
+	assert.Equal(t, 1.0, LevenshteinSimilarity("", ""))
+	assert.Equal(t, 1.0, LevenshteinSimilarity("abc", "abc"))
+	assert.InDelta(t, 1-3.0/7, LevenshteinSimilarity("kitten", "sitting"), 1e-9)
+}
+
+func TestRougeL(t *testing.T) {
+	assert.Equal(t, 1.0, RougeL(nil, nil))
+	assert.Equal(t, 0.0, RougeL(Tokenize("a"), nil))
+	assert.Equal(t, 1.0, RougeL(Tokenize("the cat sat"), Tokenize("the cat sat")))
+	assert.Equal(t, 0.0, RougeL(Tokenize("dog"), Tokenize("the cat")))
+	// lcs = "the cat on mat" = 4, precision 4/6, recall 4/5
+	p, r := 4.0/6, 4.0/5
+	assert.InDelta(t, 2*p*r/(p+r), RougeL(Tokenize("the cat sat on the mat"), Tokenize("the cat is on mat")), 1e-9)
+}
+
+func TestBLEU(t *testing.T) {
+	ref := Tokenize("the quick brown fox jumps over the lazy dog")
+	assert.InDelta(t, 1.0, BLEU(ref, ref, 4), 1e-9)
+	assert.Equal(t, 0.0, BLEU(nil, ref, 4))
+	assert.Equal(t, 0.0, BLEU(ref, ref, 0))
+	assert.Equal(t, 0.0, BLEU(Tokenize("hello"), ref, 4))
+
+	// 部分匹配分数介于 0 和 1 之间，且短候选受长度惩罚
+	partial := BLEU(Tokenize("the quick brown fox"), ref, 4)
+	assert.Greater(t, partial, 0.0)
+	assert.Less(t, partial, 1.0)
+	longer := BLEU(Tokenize("the quick brown fox jumps over a dog"), ref, 4)
+	assert.Greater(t, longer, partial)
+
+	// 1-gram 命中数按参考答案截断
+	assert.InDelta(t, 2.0/7, BLEU(Tokenize("the the the the the the the"), Tokenize("the cat is on the mat"), 1), 1e-9)
+}
@@ -35,6 +35,7 @@ func ModelDO2DTO(model *entity.Model, mask bool) *manage.Model {
 		ProtocolConfig:  pc,
 		ScenarioConfigs: ScenarioConfigMapDO2DTO(model.ScenarioConfigs),
 		ParamConfig:     ParamConfigDO2DTO(model.ParamConfig),
+		Price:           PriceDO2DTO(model.Price),
 	}
 }
 
@@ -50,6 +51,7 @@ func AbilityDO2DTO(a *entity.Ability) *manage.Ability {
 		JSONMode:          ptr.Of(a.JsonMode),
 		MultiModal:        ptr.Of(a.MultiModal),
 		AbilityMultiModal: AbilityMultiModalDO2DTO(a.AbilityMultiModal),
+		Embedding:         ptr.Of(a.Embedding),
 	}
 }
 
@@ -232,6 +234,7 @@ func ScenarioConfigDO2DTO(s *entity.ScenarioConfig) *manage.ScenarioConfig {
 		Scenario:    ptr.Of(ScenarioDO2DTO(s.Scenario)),
 		Quota:       QuotaDO2DTO(s.Quota),
 		Unavailable: ptr.Of(s.Unavailable),
+		Routing:     RoutingPolicyDO2DTO(s.Routing),
 	}
 }
 
@@ -245,6 +248,30 @@ func QuotaDO2DTO(q *entity.Quota) *manage.Quota {
 	}
 }
 
+func RoutingPolicyDO2DTO(p *entity.RoutingPolicy) *manage.RoutingPolicy {
+	if p == nil {
+		return nil
+	}
+	return &manage.RoutingPolicy{
+		Weight:    ptr.Of(p.Weight),
+		Balance:   slices.Transform(p.Balance, func(t *entity.RoutingTarget, _ int) *manage.RoutingTarget { return RoutingTargetDO2DTO(t) }),
+		Fallbacks: slices.Transform(p.Fallbacks, func(t *entity.RoutingTarget, _ int) *manage.RoutingTarget { return RoutingTargetDO2DTO(t) }),
+		FallbackOn: slices.Transform(p.FallbackOn, func(e entity.ModelErrorType, _ int) manage.ModelErrorType {
+			return manage.ModelErrorType(e)
+		}),
+	}
+}
+
+func RoutingTargetDO2DTO(t *entity.RoutingTarget) *manage.RoutingTarget {
+	if t == nil {
+		return nil
+	}
+	return &manage.RoutingTarget{
+		ModelID: ptr.Of(t.ModelID),
+		Weight:  ptr.Of(t.Weight),
+	}
+}
+
 func ParamConfigDO2DTO(p *entity.ParamConfig) *manage.ParamConfig {
 	if p == nil {
 		return nil
@@ -256,6 +283,16 @@ func ParamConfigDO2DTO(p *entity.ParamConfig) *manage.ParamConfig {
 	}
 }
 
+func PriceDO2DTO(p *entity.Price) *manage.Price {
+	if p == nil {
+		return nil
+	}
+	return &manage.Price{
+		InputPrice:  ptr.Of(p.InputPrice),
+		OutputPrice: ptr.Of(p.OutputPrice),
+	}
+}
+
 func ParamSchemaDO2DTO(ps *entity.ParamSchema) *manage.ParamSchema {
 	if ps == nil {
 		return nil
@@ -287,3 +324,299 @@ func ParamOptionDO2DTO(o *entity.ParamOption) *manage.ParamOption {
 		Label: ptr.Of(o.Label),
 	}
 }
+
+func ModelDTO2DO(dto *manage.Model) *entity.Model {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Model{
+		ID:              dto.GetModelID(),
+		WorkspaceID:     dto.GetWorkspaceID(),
+		Name:            dto.GetName(),
+		Desc:            dto.GetDesc(),
+		Ability:         AbilityDTO2DO(dto.Ability),
+		Protocol:        entity.Protocol(dto.GetProtocol()),
+		ProtocolConfig:  ProtocolConfigDTO2DO(dto.ProtocolConfig),
+		ScenarioConfigs: ScenarioConfigMapDTO2DO(dto.ScenarioConfigs),
+		ParamConfig:     ParamConfigDTO2DO(dto.ParamConfig),
+		Price:           PriceDTO2DO(dto.Price),
+	}
+}
+
+func AbilityDTO2DO(dto *manage.Ability) *entity.Ability {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Ability{
+		MaxContextTokens:  dto.MaxContextTokens,
+		MaxInputTokens:    dto.MaxInputTokens,
+		MaxOutputTokens:   dto.MaxOutputTokens,
+		FunctionCall:      dto.GetFunctionCall(),
+		JsonMode:          dto.GetJSONMode(),
+		MultiModal:        dto.GetMultiModal(),
+		AbilityMultiModal: AbilityMultiModalDTO2DO(dto.AbilityMultiModal),
+		Embedding:         dto.GetEmbedding(),
+	}
+}
+
+func AbilityMultiModalDTO2DO(dto *manage.AbilityMultiModal) *entity.AbilityMultiModal {
+	if dto == nil {
+		return nil
+	}
+	return &entity.AbilityMultiModal{
+		Image:        dto.GetImage(),
+		AbilityImage: AbilityImageDTO2DO(dto.AbilityImage),
+	}
+}
+
+func AbilityImageDTO2DO(dto *manage.AbilityImage) *entity.AbilityImage {
+	if dto == nil {
+		return nil
+	}
+	return &entity.AbilityImage{
+		URLEnabled:    dto.GetURLEnabled(),
+		BinaryEnabled: dto.GetBinaryEnabled(),
+		MaxImageSize:  dto.GetMaxImageSize(),
+		MaxImageCount: dto.GetMaxImageCount(),
+	}
+}
+
+func ProtocolConfigDTO2DO(dto *manage.ProtocolConfig) *entity.ProtocolConfig {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfig{
+		BaseURL:                dto.GetBaseURL(),
+		APIKey:                 dto.GetAPIKey(),
+		Model:                  dto.GetModel(),
+		ProtocolConfigArk:      ProtocolConfigArkDTO2DO(dto.ProtocolConfigArk),
+		ProtocolConfigOpenAI:   ProtocolConfigOpenaiDTO2DO(dto.ProtocolConfigOpenai),
+		ProtocolConfigClaude:   ProtocolConfigClaudeDTO2DO(dto.ProtocolConfigClaude),
+		ProtocolConfigDeepSeek: ProtocolConfigDeepSeekDTO2DO(dto.ProtocolConfigDeepseek),
+		ProtocolConfigGemini:   ProtocolConfigGeminiDTO2DO(dto.ProtocolConfigGemini),
+		ProtocolConfigOllama:   ProtocolConfigOllamaDTO2DO(dto.ProtocolConfigOllama),
+		ProtocolConfigQwen:     ProtocolConfigQwenDTO2DO(dto.ProtocolConfigQwen),
+		ProtocolConfigQianfan:  ProtocolConfigQianfanDTO2DO(dto.ProtocolConfigQianfan),
+		ProtocolConfigArkBot:   ProtocolConfigArkbotDTO2DO(dto.ProtocolConfigArkbot),
+	}
+}
+
+func ProtocolConfigArkDTO2DO(dto *manage.ProtocolConfigArk) *entity.ProtocolConfigArk {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigArk{
+		Region:        dto.GetRegion(),
+		AccessKey:     dto.GetAccessKey(),
+		SecretKey:     dto.GetSecretKey(),
+		RetryTimes:    dto.RetryTimes,
+		CustomHeaders: dto.CustomHeaders,
+	}
+}
+
+func ProtocolConfigOpenaiDTO2DO(dto *manage.ProtocolConfigOpenAI) *entity.ProtocolConfigOpenAI {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigOpenAI{
+		ByAzure:                  dto.GetByAzure(),
+		ApiVersion:               dto.GetAPIVersion(),
+		ResponseFormatType:       dto.GetResponseFormatType(),
+		ResponseFormatJsonSchema: dto.GetResponseFormatJSONSchema(),
+	}
+}
+
+func ProtocolConfigClaudeDTO2DO(dto *manage.ProtocolConfigClaude) *entity.ProtocolConfigClaude {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigClaude{
+		ByBedrock:       dto.GetByBedrock(),
+		AccessKey:       dto.GetAccessKey(),
+		SecretAccessKey: dto.GetSecretAccessKey(),
+		SessionToken:    dto.GetSessionToken(),
+		Region:          dto.GetRegion(),
+	}
+}
+
+func ProtocolConfigDeepSeekDTO2DO(dto *manage.ProtocolConfigDeepSeek) *entity.ProtocolConfigDeepSeek {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigDeepSeek{ResponseFormatType: dto.GetResponseFormatType()}
+}
+
+func ProtocolConfigGeminiDTO2DO(dto *manage.ProtocolConfigGemini) *entity.ProtocolConfigGemini {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigGemini{
+		ResponseSchema:      dto.ResponseSchema,
+		EnableCodeExecution: dto.GetEnableCodeExecution(),
+		SafetySettings: slices.Transform(dto.SafetySettings, func(s *manage.ProtocolConfigGeminiSafetySetting, _ int) entity.ProtocolConfigGeminiSafetySetting {
+			return entity.ProtocolConfigGeminiSafetySetting{
+				Category:  s.GetCategory(),
+				Threshold: s.GetThreshold(),
+			}
+		}),
+	}
+}
+
+func ProtocolConfigQwenDTO2DO(dto *manage.ProtocolConfigQwen) *entity.ProtocolConfigQwen {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigQwen{
+		ResponseFormatType:       dto.ResponseFormatType,
+		ResponseFormatJsonSchema: dto.ResponseFormatJSONSchema,
+	}
+}
+
+func ProtocolConfigQianfanDTO2DO(dto *manage.ProtocolConfigQianfan) *entity.ProtocolConfigQianfan {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigQianfan{
+		LLMRetryCount: ptr.PtrConvert(dto.LlmRetryCount, func(f int32) int {
+			return int(f)
+		}),
+		LLMRetryTimeout: ptr.PtrConvert(dto.LlmRetryTimeout, func(f float64) float32 {
+			return float32(f)
+		}),
+		LLMRetryBackoffFactor: ptr.PtrConvert(dto.LlmRetryBackoffFactor, func(f float64) float32 {
+			return float32(f)
+		}),
+		ParallelToolCalls:        dto.ParallelToolCalls,
+		ResponseFormatType:       dto.ResponseFormatType,
+		ResponseFormatJsonSchema: dto.ResponseFormatJSONSchema,
+	}
+}
+
+func ProtocolConfigOllamaDTO2DO(dto *manage.ProtocolConfigOllama) *entity.ProtocolConfigOllama {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigOllama{
+		Format:      dto.Format,
+		KeepAliveMs: dto.KeepAliveMs,
+	}
+}
+
+func ProtocolConfigArkbotDTO2DO(dto *manage.ProtocolConfigArkbot) *entity.ProtocolConfigArkBot {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigArkBot{
+		Region:        dto.GetRegion(),
+		AccessKey:     dto.GetAccessKey(),
+		SecretKey:     dto.GetSecretKey(),
+		RetryTimes:    dto.RetryTimes,
+		CustomHeaders: dto.CustomHeaders,
+	}
+}
+
+func ScenarioConfigMapDTO2DO(dtos map[common.Scenario]*manage.ScenarioConfig) map[entity.Scenario]*entity.ScenarioConfig {
+	if dtos == nil {
+		return nil
+	}
+	res := make(map[entity.Scenario]*entity.ScenarioConfig, len(dtos))
+	for k, v := range dtos {
+		res[entity.Scenario(k)] = ScenarioConfigDTO2DO(v)
+	}
+	return res
+}
+
+func ScenarioConfigDTO2DO(dto *manage.ScenarioConfig) *entity.ScenarioConfig {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ScenarioConfig{
+		Scenario:    entity.Scenario(dto.GetScenario()),
+		Quota:       QuotaDTO2DO(dto.Quota),
+		Unavailable: dto.GetUnavailable(),
+		Routing:     RoutingPolicyDTO2DO(dto.Routing),
+	}
+}
+
+func QuotaDTO2DO(dto *manage.Quota) *entity.Quota {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Quota{
+		Qpm: dto.GetQpm(),
+		Tpm: dto.GetTpm(),
+	}
+}
+
+func RoutingPolicyDTO2DO(dto *manage.RoutingPolicy) *entity.RoutingPolicy {
+	if dto == nil {
+		return nil
+	}
+	return &entity.RoutingPolicy{
+		Weight:    dto.GetWeight(),
+		Balance:   slices.Transform(dto.Balance, func(t *manage.RoutingTarget, _ int) *entity.RoutingTarget { return RoutingTargetDTO2DO(t) }),
+		Fallbacks: slices.Transform(dto.Fallbacks, func(t *manage.RoutingTarget, _ int) *entity.RoutingTarget { return RoutingTargetDTO2DO(t) }),
+		FallbackOn: slices.Transform(dto.FallbackOn, func(e manage.ModelErrorType, _ int) entity.ModelErrorType {
+			return entity.ModelErrorType(e)
+		}),
+	}
+}
+
+func RoutingTargetDTO2DO(dto *manage.RoutingTarget) *entity.RoutingTarget {
+	if dto == nil {
+		return nil
+	}
+	return &entity.RoutingTarget{
+		ModelID: dto.GetModelID(),
+		Weight:  dto.GetWeight(),
+	}
+}
+
+func ParamConfigDTO2DO(dto *manage.ParamConfig) *entity.ParamConfig {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ParamConfig{
+		ParamSchemas: slices.Transform(dto.ParamSchemas, func(s *manage.ParamSchema, _ int) *entity.ParamSchema {
+			return ParamSchemaDTO2DO(s)
+		}),
+	}
+}
+
+func PriceDTO2DO(dto *manage.Price) *entity.Price {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Price{
+		InputPrice:  dto.GetInputPrice(),
+		OutputPrice: dto.GetOutputPrice(),
+	}
+}
+
+func ParamSchemaDTO2DO(dto *manage.ParamSchema) *entity.ParamSchema {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ParamSchema{
+		Name:         dto.GetName(),
+		Label:        dto.GetLabel(),
+		Desc:         dto.GetDesc(),
+		Type:         entity.ParamType(dto.GetType()),
+		Min:          dto.GetMin(),
+		Max:          dto.GetMax(),
+		DefaultValue: dto.GetDefaultValue(),
+		Options: slices.Transform(dto.Options, func(o *manage.ParamOption, _ int) *entity.ParamOption {
+			return ParamOptionDTO2DO(o)
+		}),
+	}
+}
+
+func ParamOptionDTO2DO(dto *manage.ParamOption) *entity.ParamOption {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ParamOption{
+		Value: dto.GetValue(),
+		Label: dto.GetLabel(),
+	}
+}
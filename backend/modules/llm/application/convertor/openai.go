@@ -0,0 +1,204 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/pkg/errors"
+
+	druntime "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/runtime"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/pkg/openai"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+// OpenAIRequest2ModelConfig 转换 OpenAI 请求中的模型参数，不支持的参数返回错误
+func OpenAIRequest2ModelConfig(req *openai.ChatCompletionRequest, modelID int64) (*druntime.ModelConfig, error) {
+	if req.N != nil && *req.N != 1 {
+		return nil, errors.Errorf("n must be 1")
+	}
+	cfg := &druntime.ModelConfig{
+		ModelID:          modelID,
+		Temperature:      req.Temperature,
+		MaxTokens:        req.MaxTokens,
+		TopP:             req.TopP,
+		Stop:             req.Stop,
+		PresencePenalty:  req.PresencePenalty,
+		FrequencyPenalty: req.FrequencyPenalty,
+	}
+	if cfg.MaxTokens == nil {
+		cfg.MaxTokens = req.MaxCompletionTokens
+	}
+	if tc := req.ToolChoice; tc != nil {
+		switch {
+		case tc.Function != nil:
+			return nil, errors.Errorf("tool_choice with a specific function is not supported")
+		case tc.Mode == druntime.ToolChoiceAuto || tc.Mode == druntime.ToolChoiceRequired || tc.Mode == druntime.ToolChoiceNone:
+			cfg.ToolChoice = ptr.Of(tc.Mode)
+		default:
+			return nil, errors.Errorf("tool_choice %q is not supported", tc.Mode)
+		}
+	}
+	if rf := req.ResponseFormat; rf != nil {
+		switch rf.Type {
+		case openai.ResponseFormatTypeText:
+			cfg.ResponseFormat = &druntime.ResponseFormat{Type: ptr.Of(druntime.ResponseFormatText)}
+		case openai.ResponseFormatTypeJSONObject:
+			cfg.ResponseFormat = &druntime.ResponseFormat{Type: ptr.Of(druntime.ResponseFormatJSONObject)}
+		default:
+			return nil, errors.Errorf("response_format %q is not supported", rf.Type)
+		}
+	}
+	return cfg, nil
+}
+
+func OpenAIMessages2DTO(msgs []*openai.Message) ([]*druntime.Message, error) {
+	dtos := make([]*druntime.Message, 0, len(msgs))
+	for i, msg := range msgs {
+		if msg == nil {
+			return nil, errors.Errorf("messages[%d] is null", i)
+		}
+		dto, err := OpenAIMessage2DTO(msg)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "messages[%d]", i)
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+func OpenAIMessage2DTO(msg *openai.Message) (*druntime.Message, error) {
+	dto := &druntime.Message{}
+	switch msg.Role {
+	// developer 为新版 OpenAI 接口中 system 的别名
+	case druntime.RoleSystem, "developer":
+		dto.Role = druntime.RoleSystem
+	case druntime.RoleUser, druntime.RoleAssistant, druntime.RoleTool:
+		dto.Role = msg.Role
+	default:
+		return nil, errors.Errorf("role %q is not supported", msg.Role)
+	}
+	if msg.Content != nil {
+		if msg.Content.Parts == nil {
+			dto.Content = ptr.Of(msg.Content.Text)
+		}
+		for _, part := range msg.Content.Parts {
+			partDTO, err := openAIContentPart2DTO(part)
+			if err != nil {
+				return nil, err
+			}
+			dto.MultimodalContents = append(dto.MultimodalContents, partDTO)
+		}
+	}
+	if msg.ReasoningContent != "" {
+		dto.ReasoningContent = ptr.Of(msg.ReasoningContent)
+	}
+	for _, tc := range msg.ToolCalls {
+		if tc == nil || tc.Function == nil {
+			return nil, errors.Errorf("tool_calls.function is required")
+		}
+		dto.ToolCalls = append(dto.ToolCalls, &druntime.ToolCall{
+			Index: tc.Index,
+			ID:    ptr.Of(tc.ID),
+			Type:  ptr.Of(druntime.ToolTypeFunction),
+			FunctionCall: &druntime.FunctionCall{
+				Name:      ptr.Of(tc.Function.Name),
+				Arguments: ptr.Of(tc.Function.Arguments),
+			},
+		})
+	}
+	if msg.ToolCallID != "" {
+		dto.ToolCallID = ptr.Of(msg.ToolCallID)
+	}
+	return dto, nil
+}
+
+func openAIContentPart2DTO(part *openai.ContentPart) (*druntime.ChatMessagePart, error) {
+	if part == nil {
+		return nil, errors.Errorf("content part is null")
+	}
+	switch part.Type {
+	case openai.ContentPartTypeText:
+		return &druntime.ChatMessagePart{
+			Type: ptr.Of(druntime.ChatMessagePartTypeText),
+			Text: ptr.Of(part.Text),
+		}, nil
+	case openai.ContentPartTypeImageURL:
+		if part.ImageURL == nil || part.ImageURL.URL == "" {
+			return nil, errors.Errorf("image_url.url is required")
+		}
+		imageURL := &druntime.ChatMessageImageURL{URL: ptr.Of(part.ImageURL.URL)}
+		if part.ImageURL.Detail != "" {
+			imageURL.Detail = ptr.Of(part.ImageURL.Detail)
+		}
+		return &druntime.ChatMessagePart{
+			Type:     ptr.Of(druntime.ChatMessagePartTypeImageURL),
+			ImageURL: imageURL,
+		}, nil
+	default:
+		return nil, errors.Errorf("content part type %q is not supported", part.Type)
+	}
+}
+
+func OpenAITools2DTO(tools []*openai.Tool) ([]*druntime.Tool, error) {
+	dtos := make([]*druntime.Tool, 0, len(tools))
+	for i, tool := range tools {
+		if tool == nil || tool.Type != openai.ToolTypeFunction || tool.Function == nil {
+			return nil, errors.Errorf("tools[%d] must be a function", i)
+		}
+		dto := &druntime.Tool{
+			Name:    ptr.Of(tool.Function.Name),
+			Desc:    ptr.Of(tool.Function.Description),
+			DefType: ptr.Of(druntime.ToolDefTypeOpenAPIV3),
+		}
+		if tool.Function.Parameters != nil {
+			def, err := json.MarshalString(tool.Function.Parameters)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "tools[%d].function.parameters", i)
+			}
+			dto.Def = ptr.Of(def)
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+// MessageDTO2OpenAI 转换模型返回的消息，流式响应中作为 delta 使用
+func MessageDTO2OpenAI(dto *druntime.Message) *openai.Message {
+	if dto == nil {
+		return nil
+	}
+	msg := &openai.Message{
+		Role:             dto.GetRole(),
+		ReasoningContent: dto.GetReasoningContent(),
+	}
+	if dto.IsSetContent() || len(dto.GetToolCalls()) == 0 {
+		msg.Content = &openai.Content{Text: dto.GetContent()}
+	}
+	for _, tc := range dto.GetToolCalls() {
+		if tc == nil {
+			continue
+		}
+		msg.ToolCalls = append(msg.ToolCalls, &openai.ToolCall{
+			Index: tc.Index,
+			ID:    tc.GetID(),
+			Type:  tc.GetType(),
+			Function: &openai.FunctionCall{
+				Name:      tc.GetFunctionCall().GetName(),
+				Arguments: tc.GetFunctionCall().GetArguments(),
+			},
+		})
+	}
+	return msg
+}
+
+func UsageDTO2OpenAI(dto *druntime.TokenUsage) *openai.Usage {
+	if dto == nil {
+		return nil
+	}
+	return &openai.Usage{
+		PromptTokens:     dto.GetPromptTokens(),
+		CompletionTokens: dto.GetCompletionTokens(),
+		TotalTokens:      dto.GetTotalTokens(),
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/manage"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/slices"
+)
+
+func QuotaStatusesDO2DTO(statuses []*entity.QuotaStatus) []*manage.QuotaStatus {
+	return slices.Transform(statuses, func(s *entity.QuotaStatus, _ int) *manage.QuotaStatus {
+		return QuotaStatusDO2DTO(s)
+	})
+}
+
+func QuotaStatusDO2DTO(s *entity.QuotaStatus) *manage.QuotaStatus {
+	if s == nil {
+		return nil
+	}
+	return &manage.QuotaStatus{
+		WorkspaceID: ptr.Of(s.SpaceID),
+		ModelID:     ptr.Of(s.ModelID),
+		Period:      ptr.Of(manage.QuotaPeriod(s.Period)),
+		MaxTokens:   ptr.Of(s.MaxTokens),
+		UsedTokens:  ptr.Of(s.UsedTokens),
+		MaxCost:     ptr.Of(s.MaxCost),
+		UsedCost:    ptr.Of(s.UsedCost),
+		ResetAt:     ptr.Of(s.ResetAt.UnixMilli()),
+		Exceeded:    ptr.Of(s.TokenExceeded() || s.CostExceeded()),
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/manage"
+	llmmanage "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/slices"
+)
+
+func ModelUsageQueryDTO2DO(req *llmmanage.QueryModelUsageRequest) *entity.ModelUsageQuery {
+	if req == nil {
+		return nil
+	}
+	q := &entity.ModelUsageQuery{
+		SpaceIDs:  req.GetWorkspaceIds(),
+		StartTime: time.UnixMilli(req.GetStartTime()),
+		EndTime:   time.UnixMilli(req.GetEndTime()),
+		GroupBy: slices.Transform(req.GetGroupBy(), func(d manage.UsageDimension, _ int) entity.UsageDimension {
+			return entity.UsageDimension(d)
+		}),
+		UserID:   req.UserID,
+		ModelID:  req.ModelID,
+		Scenario: ScenarioPtrDTO2DTO(req.Scenario),
+	}
+	if req.Granularity != nil {
+		q.Granularity = ptr.Of(entity.UsageGranularity(req.GetGranularity()))
+	}
+	return q
+}
+
+func ModelUsageStatsDO2DTO(stats []*entity.ModelUsageStat) []*manage.ModelUsageStat {
+	return slices.Transform(stats, func(s *entity.ModelUsageStat, _ int) *manage.ModelUsageStat {
+		return ModelUsageStatDO2DTO(s)
+	})
+}
+
+func ModelUsageStatDO2DTO(s *entity.ModelUsageStat) *manage.ModelUsageStat {
+	if s == nil {
+		return nil
+	}
+	dto := &manage.ModelUsageStat{
+		WorkspaceID:   s.SpaceID,
+		UserID:        s.UserID,
+		ModelID:       s.ModelID,
+		ModelName:     s.ModelName,
+		RequestCount:  ptr.Of(s.RequestCount),
+		ErrorCount:    ptr.Of(s.ErrorCount),
+		ErrorRate:     ptr.Of(s.ErrorRate()),
+		CacheHitCount: ptr.Of(s.CacheHitCount),
+		InputTokens:   ptr.Of(s.InputToken),
+		OutputTokens:  ptr.Of(s.OutputToken),
+		Cost:          ptr.Of(s.Cost),
+		AvgLatencyMs:  ptr.Of(s.AvgLatencyMs),
+	}
+	if s.Scenario != nil {
+		dto.Scenario = ptr.Of(ScenarioDO2DTO(*s.Scenario))
+	}
+	if s.TimeBucket != nil {
+		dto.TimeBucket = ptr.Of(s.TimeBucket.UnixMilli())
+	}
+	return dto
+}
@@ -5,27 +5,37 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/application/convertor"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 )
 
 type manageApp struct {
 	manageSrv service.IManage
+	usageSrv  service.IUsage
+	quotaSrv  service.IQuota
 	auth      rpc.IAuthProvider
 }
 
 func NewManageApplication(
 	manageSrv service.IManage,
+	usageSrv service.IUsage,
+	quotaSrv service.IQuota,
 	auth rpc.IAuthProvider,
 ) manage.LLMManageService {
 	return &manageApp{
 		manageSrv: manageSrv,
+		usageSrv:  usageSrv,
+		quotaSrv:  quotaSrv,
 		auth:      auth,
 	}
 }
@@ -67,6 +77,94 @@ func (m *manageApp) GetModel(ctx context.Context, req *manage.GetModelRequest) (
 	if err != nil {
 		return r, err
 	}
+	if !model.VisibleIn(req.GetWorkspaceID()) {
+		return r, errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d not exist in space:%d", req.GetModelID(), req.GetWorkspaceID())))
+	}
 	r.SetModel(convertor.ModelDO2DTO(model, true))
 	return r, nil
 }
+
+func (m *manageApp) CreateModel(ctx context.Context, req *manage.CreateModelRequest) (r *manage.CreateModelResponse, err error) {
+	r = manage.NewCreateModelResponse()
+	userID, ok := session.UserIDInCtx(ctx)
+	if !ok || userID == "" {
+		return r, errorx.NewByCode(llm_errorx.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
+	}
+	if err := m.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), "createModel"); err != nil {
+		return r, err
+	}
+	model := convertor.ModelDTO2DO(req.GetModel())
+	if model == nil {
+		return r, errorx.NewByCode(llm_errorx.CommonInvalidParamCode, errorx.WithExtraMsg("model is empty"))
+	}
+	model.WorkspaceID = req.GetWorkspaceID()
+	modelID, err := m.manageSrv.CreateModel(ctx, model, userID)
+	if err != nil {
+		return r, err
+	}
+	r.SetModelID(ptr.Of(modelID))
+	return r, nil
+}
+
+func (m *manageApp) UpdateModel(ctx context.Context, req *manage.UpdateModelRequest) (r *manage.UpdateModelResponse, err error) {
+	r = manage.NewUpdateModelResponse()
+	userID, ok := session.UserIDInCtx(ctx)
+	if !ok || userID == "" {
+		return r, errorx.NewByCode(llm_errorx.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
+	}
+	if err := m.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), "updateModel"); err != nil {
+		return r, err
+	}
+	updateReq := entity.UpdateModelReq{
+		WorkspaceID:     req.GetWorkspaceID(),
+		ModelID:         req.GetModelID(),
+		UserID:          userID,
+		Name:            req.Name,
+		Desc:            req.Desc,
+		Ability:         convertor.AbilityDTO2DO(req.Ability),
+		ProtocolConfig:  convertor.ProtocolConfigDTO2DO(req.ProtocolConfig),
+		ScenarioConfigs: convertor.ScenarioConfigMapDTO2DO(req.ScenarioConfigs),
+		ParamConfig:     convertor.ParamConfigDTO2DO(req.ParamConfig),
+		Price:           convertor.PriceDTO2DO(req.Price),
+	}
+	if req.Protocol != nil {
+		updateReq.Protocol = ptr.Of(entity.Protocol(req.GetProtocol()))
+	}
+	return r, m.manageSrv.UpdateModel(ctx, updateReq)
+}
+
+func (m *manageApp) DeleteModel(ctx context.Context, req *manage.DeleteModelRequest) (r *manage.DeleteModelResponse, err error) {
+	r = manage.NewDeleteModelResponse()
+	if err := m.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), "deleteModel"); err != nil {
+		return r, err
+	}
+	return r, m.manageSrv.DeleteModel(ctx, req.GetWorkspaceID(), req.GetModelID())
+}
+
+func (m *manageApp) QueryModelUsage(ctx context.Context, req *manage.QueryModelUsageRequest) (r *manage.QueryModelUsageResponse, err error) {
+	r = manage.NewQueryModelUsageResponse()
+	for _, spaceID := range req.GetWorkspaceIds() {
+		if err := m.auth.CheckSpacePermission(ctx, spaceID, "queryModelUsage"); err != nil {
+			return r, err
+		}
+	}
+	stats, err := m.usageSrv.QueryModelUsage(ctx, convertor.ModelUsageQueryDTO2DO(req))
+	if err != nil {
+		return r, err
+	}
+	r.SetStats(convertor.ModelUsageStatsDO2DTO(stats))
+	return r, nil
+}
+
+func (m *manageApp) GetQuotaStatus(ctx context.Context, req *manage.GetQuotaStatusRequest) (r *manage.GetQuotaStatusResponse, err error) {
+	r = manage.NewGetQuotaStatusResponse()
+	if err := m.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), "getQuotaStatus"); err != nil {
+		return r, err
+	}
+	statuses, err := m.quotaSrv.GetQuotaStatus(ctx, req.GetWorkspaceID())
+	if err != nil {
+		return r, err
+	}
+	r.SetQuotas(convertor.QuotaStatusesDO2DTO(statuses))
+	return r, nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/common"
+	druntime "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/runtime"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime/llmruntimeservice"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/application/convertor"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	oai "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/openai"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+// openAIScenarioEntityID 通过 OpenAI 兼容接口发起的请求在请求记录中的场景实体id
+const openAIScenarioEntityID = "openai_chat_completions"
+
+const listModelsPageSize = 100
+
+// openAPIApp 将 OpenAI chat completions 格式的请求转换后交给运行时接口处理，限流、请求记录与上报 trace 均复用运行时接口
+type openAPIApp struct {
+	idGen     idgen.IIDGenerator
+	manageSrv service.IManage
+	runtime   llmruntimeservice.Client
+	auth      rpc.IAuthProvider
+}
+
+func NewOpenAPIApplication(
+	idGen idgen.IIDGenerator,
+	manageSrv service.IManage,
+	runtime llmruntimeservice.Client,
+	auth rpc.IAuthProvider,
+) openapi.LLMOpenAPIService {
+	return &openAPIApp{
+		idGen:     idGen,
+		manageSrv: manageSrv,
+		runtime:   runtime,
+		auth:      auth,
+	}
+}
+
+func (o *openAPIApp) ChatCompletions(ctx context.Context, req *openapi.ChatCompletionsRequest) (r *openapi.ChatCompletionsResponse, err error) {
+	r = openapi.NewChatCompletionsResponse()
+	oaiReq, chatReq, err := o.buildChatRequest(ctx, req)
+	if err != nil {
+		return r, err
+	}
+	resp, err := o.runtime.Chat(ctx, chatReq)
+	if err != nil {
+		return r, err
+	}
+	id, err := o.completionID(ctx)
+	if err != nil {
+		return r, err
+	}
+	msg := convertor.MessageDTO2OpenAI(resp.GetMessage())
+	if msg == nil {
+		msg = &oai.Message{Role: druntime.RoleAssistant, Content: &oai.Content{}}
+	}
+	finishReason := resp.GetMessage().GetResponseMeta().GetFinishReason()
+	if finishReason == "" {
+		finishReason = "stop"
+		if len(msg.ToolCalls) > 0 {
+			finishReason = "tool_calls"
+		}
+	}
+	body, err := json.Marshal(&oai.ChatCompletionResponse{
+		ID:      id,
+		Object:  oai.ObjectChatCompletion,
+		Created: time.Now().Unix(),
+		Model:   oaiReq.Model,
+		Choices: []*oai.Choice{{Index: 0, Message: msg, FinishReason: ptr.Of(finishReason)}},
+		Usage:   convertor.UsageDTO2OpenAI(resp.GetMessage().GetResponseMeta().GetUsage()),
+	})
+	if err != nil {
+		return r, errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode)
+	}
+	r.SetBody(body)
+	return r, nil
+}
+
+func (o *openAPIApp) ChatCompletionsStream(ctx context.Context, req *openapi.ChatCompletionsRequest, stream openapi.LLMOpenAPIService_ChatCompletionsStreamServer) (err error) {
+	oaiReq, chatReq, err := o.buildChatRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	id, err := o.completionID(ctx)
+	if err != nil {
+		return err
+	}
+	created := time.Now().Unix()
+	sendChunk := func(chunk *oai.ChatCompletionResponse) error {
+		chunk.ID, chunk.Object, chunk.Created, chunk.Model = id, oai.ObjectChatCompletionChunk, created, oaiReq.Model
+		data, err := json.Marshal(chunk)
+		if err != nil {
+			return errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode)
+		}
+		return stream.Send(ctx, &openapi.ChatCompletionsStreamResponse{Chunk: data})
+	}
+	sr, err := o.runtime.ChatStream(ctx, chatReq)
+	if err != nil {
+		return err
+	}
+	var usage *oai.Usage
+	var roleSent bool
+	for {
+		resp, err := sr.Recv(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		delta := convertor.MessageDTO2OpenAI(resp.GetMessage())
+		if delta == nil {
+			continue
+		}
+		// 仅首个分片携带 role
+		if roleSent {
+			delta.Role = ""
+		} else if delta.Role == "" {
+			delta.Role = druntime.RoleAssistant
+		}
+		roleSent = true
+		choice := &oai.Choice{Index: 0, Delta: delta}
+		if reason := resp.GetMessage().GetResponseMeta().GetFinishReason(); reason != "" {
+			choice.FinishReason = ptr.Of(reason)
+		}
+		if u := convertor.UsageDTO2OpenAI(resp.GetMessage().GetResponseMeta().GetUsage()); u != nil {
+			usage = u
+		}
+		if err := sendChunk(&oai.ChatCompletionResponse{Choices: []*oai.Choice{choice}}); err != nil {
+			return err
+		}
+	}
+	if oaiReq.StreamOptions != nil && oaiReq.StreamOptions.IncludeUsage {
+		if usage == nil {
+			usage = &oai.Usage{}
+		}
+		return sendChunk(&oai.ChatCompletionResponse{Choices: []*oai.Choice{}, Usage: usage})
+	}
+	return nil
+}
+
+// buildChatRequest 解析 OpenAI 格式的请求体，校验空间权限并解析模型后转换为运行时请求
+func (o *openAPIApp) buildChatRequest(ctx context.Context, req *openapi.ChatCompletionsRequest) (*oai.ChatCompletionRequest, *runtime.ChatRequest, error) {
+	oaiReq := &oai.ChatCompletionRequest{}
+	if err := json.Unmarshal(req.GetBody(), oaiReq); err != nil {
+		return nil, nil, errorx.NewByCode(llm_errorx.RequestNotValidCode, errorx.WithExtraMsg(fmt.Sprintf("invalid request body: %v", err)))
+	}
+	if oaiReq.Model == "" {
+		return nil, nil, errorx.NewByCode(llm_errorx.RequestNotValidCode, errorx.WithExtraMsg("model is required"))
+	}
+	if len(oaiReq.Messages) == 0 {
+		return nil, nil, errorx.NewByCode(llm_errorx.RequestNotValidCode, errorx.WithExtraMsg("messages is required"))
+	}
+	if err := o.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), "callModel"); err != nil {
+		return nil, nil, err
+	}
+	model, err := o.resolveModel(ctx, req.GetWorkspaceID(), oaiReq.Model)
+	if err != nil {
+		return nil, nil, err
+	}
+	modelCfg, err := convertor.OpenAIRequest2ModelConfig(oaiReq, model.ID)
+	if err != nil {
+		return nil, nil, errorx.NewByCode(llm_errorx.RequestNotValidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	msgs, err := convertor.OpenAIMessages2DTO(oaiReq.Messages)
+	if err != nil {
+		return nil, nil, errorx.NewByCode(llm_errorx.RequestNotValidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	tools, err := convertor.OpenAITools2DTO(oaiReq.Tools)
+	if err != nil {
+		return nil, nil, errorx.NewByCode(llm_errorx.RequestNotValidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return oaiReq, &runtime.ChatRequest{
+		ModelConfig: modelCfg,
+		Messages:    msgs,
+		Tools:       tools,
+		BizParam: &druntime.BizParam{
+			WorkspaceID:      ptr.Of(req.GetWorkspaceID()),
+			UserID:           ptr.Of(session.UserIDInCtxOrEmpty(ctx)),
+			Scenario:         ptr.Of(common.ScenarioDefault),
+			ScenarioEntityID: ptr.Of(openAIScenarioEntityID),
+		},
+	}, nil
+}
+
+// resolveModel model 可以是模型id，也可以是空间内可见的模型名称
+func (o *openAPIApp) resolveModel(ctx context.Context, spaceID int64, name string) (*entity.Model, error) {
+	if modelID, err := strconv.ParseInt(name, 10, 64); err == nil {
+		model, err := o.manageSrv.GetModelByID(ctx, modelID)
+		if err != nil {
+			return nil, err
+		}
+		if !model.VisibleIn(spaceID) || !model.Available(ptr.Of(entity.ScenarioDefault)) {
+			return nil, errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model %s not exist in space:%d", name, spaceID)))
+		}
+		return model, nil
+	}
+	req := entity.ListModelReq{
+		WorkspaceID: ptr.Of(spaceID),
+		Scenario:    ptr.Of(entity.ScenarioDefault),
+		PageSize:    listModelsPageSize,
+	}
+	for {
+		models, _, hasMore, nextPageToken, err := o.manageSrv.ListModels(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, model := range models {
+			if model.Name == name {
+				return model, nil
+			}
+		}
+		if !hasMore {
+			break
+		}
+		req.PageToken = nextPageToken
+	}
+	return nil, errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model %s not exist in space:%d", name, spaceID)))
+}
+
+func (o *openAPIApp) completionID(ctx context.Context) (string, error) {
+	id, err := o.idGen.GenID(ctx)
+	if err != nil {
+		return "", errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode)
+	}
+	return fmt.Sprintf("chatcmpl-%d", id), nil
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/cloudwego/kitex/client/callopt/streamcall"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	druntime "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/runtime"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime/llmruntimeservice"
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	llmservicemocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/mocks"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	oai "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/openai"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+type fakeRuntimeClient struct {
+	req  *runtime.ChatRequest
+	resp *runtime.ChatResponse
+}
+
+func (f *fakeRuntimeClient) Chat(ctx context.Context, req *runtime.ChatRequest, callOptions ...callopt.Option) (*runtime.ChatResponse, error) {
+	f.req = req
+	return f.resp, nil
+}
+
+func (f *fakeRuntimeClient) ChatStream(ctx context.Context, req *runtime.ChatRequest, callOptions ...streamcall.Option) (llmruntimeservice.LLMRuntimeService_ChatStreamClient, error) {
+	f.req = req
+	return nil, errorx.NewByCode(llm_errorx.CommonInternalErrorCode)
+}
+
+func (f *fakeRuntimeClient) Embed(ctx context.Context, req *runtime.EmbedRequest, callOptions ...callopt.Option) (*runtime.EmbedResponse, error) {
+	return nil, errorx.NewByCode(llm_errorx.CommonInternalErrorCode)
+}
+
+func Test_openAPIApp_ChatCompletions(t *testing.T) {
+	type fields struct {
+		idGen     *idgenmocks.MockIIDGenerator
+		manageSrv *llmservicemocks.MockIManage
+		auth      *rpcmocks.MockIAuthProvider
+	}
+	chatResp := &runtime.ChatResponse{
+		Message: &druntime.Message{
+			Role:    druntime.RoleAssistant,
+			Content: ptr.Of("hi"),
+			ResponseMeta: &druntime.ResponseMeta{
+				Usage: &druntime.TokenUsage{
+					PromptTokens:     ptr.Of(int64(3)),
+					CompletionTokens: ptr.Of(int64(1)),
+					TotalTokens:      ptr.Of(int64(4)),
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		body         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		wantResp     *oai.ChatCompletionResponse
+		wantReq      *runtime.ChatRequest
+		wantErrCode  int32
+	}{
+		{
+			name: "resolve model by name",
+			body: `{"model":"gpt-4o","messages":[{"role":"developer","content":"be brief"},{"role":"user","content":[{"type":"text","text":"hello"}]}],"max_completion_tokens":10,"stop":"\n"}`,
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				idGen := idgenmocks.NewMockIIDGenerator(ctrl)
+				idGen.EXPECT().GenID(gomock.Any()).Return(int64(100), nil)
+				manageSrv := llmservicemocks.NewMockIManage(ctrl)
+				manageSrv.EXPECT().ListModels(gomock.Any(), gomock.Any()).Return([]*entity.Model{{ID: 1, Name: "other"}}, int64(2), true, int64(1), nil)
+				manageSrv.EXPECT().ListModels(gomock.Any(), gomock.Any()).Return([]*entity.Model{{ID: 2, Name: "gpt-4o"}}, int64(2), false, int64(0), nil)
+				auth := rpcmocks.NewMockIAuthProvider(ctrl)
+				auth.EXPECT().CheckSpacePermission(gomock.Any(), int64(10), "callModel").Return(nil)
+				return fields{idGen: idGen, manageSrv: manageSrv, auth: auth}
+			},
+			wantReq: &runtime.ChatRequest{
+				ModelConfig: &druntime.ModelConfig{
+					ModelID:   2,
+					MaxTokens: ptr.Of(int64(10)),
+					Stop:      []string{"\n"},
+				},
+				Messages: []*druntime.Message{
+					{Role: druntime.RoleSystem, Content: ptr.Of("be brief")},
+					{Role: druntime.RoleUser, MultimodalContents: []*druntime.ChatMessagePart{
+						{Type: ptr.Of(druntime.ChatMessagePartTypeText), Text: ptr.Of("hello")},
+					}},
+				},
+				Tools: []*druntime.Tool{},
+			},
+			wantResp: &oai.ChatCompletionResponse{
+				ID:     "chatcmpl-100",
+				Object: oai.ObjectChatCompletion,
+				Model:  "gpt-4o",
+				Choices: []*oai.Choice{{
+					Message:      &oai.Message{Role: druntime.RoleAssistant, Content: &oai.Content{Text: "hi"}},
+					FinishReason: ptr.Of("stop"),
+				}},
+				Usage: &oai.Usage{PromptTokens: 3, CompletionTokens: 1, TotalTokens: 4},
+			},
+		},
+		{
+			name: "model id of another space",
+			body: `{"model":"3","messages":[{"role":"user","content":"hello"}]}`,
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				manageSrv := llmservicemocks.NewMockIManage(ctrl)
+				manageSrv.EXPECT().GetModelByID(gomock.Any(), int64(3)).Return(&entity.Model{ID: 3, WorkspaceID: 11}, nil)
+				auth := rpcmocks.NewMockIAuthProvider(ctrl)
+				auth.EXPECT().CheckSpacePermission(gomock.Any(), int64(10), "callModel").Return(nil)
+				return fields{manageSrv: manageSrv, auth: auth}
+			},
+			wantErrCode: llm_errorx.ResourceNotFoundCode,
+		},
+		{
+			name: "no permission",
+			body: `{"model":"gpt-4o","messages":[{"role":"user","content":"hello"}]}`,
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				auth := rpcmocks.NewMockIAuthProvider(ctrl)
+				auth.EXPECT().CheckSpacePermission(gomock.Any(), int64(10), "callModel").Return(errorx.NewByCode(llm_errorx.CommonNoPermissionCode))
+				return fields{auth: auth}
+			},
+			wantErrCode: llm_errorx.CommonNoPermissionCode,
+		},
+		{
+			name: "messages required",
+			body: `{"model":"gpt-4o"}`,
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			wantErrCode: llm_errorx.RequestNotValidCode,
+		},
+		{
+			name: "unsupported n",
+			body: `{"model":"1","messages":[{"role":"user","content":"hello"}],"n":2}`,
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				manageSrv := llmservicemocks.NewMockIManage(ctrl)
+				manageSrv.EXPECT().GetModelByID(gomock.Any(), int64(1)).Return(&entity.Model{ID: 1}, nil)
+				auth := rpcmocks.NewMockIAuthProvider(ctrl)
+				auth.EXPECT().CheckSpacePermission(gomock.Any(), int64(10), "callModel").Return(nil)
+				return fields{manageSrv: manageSrv, auth: auth}
+			},
+			wantErrCode: llm_errorx.RequestNotValidCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+			rt := &fakeRuntimeClient{resp: chatResp}
+			o := &openAPIApp{
+				idGen:     ttFields.idGen,
+				manageSrv: ttFields.manageSrv,
+				runtime:   rt,
+				auth:      ttFields.auth,
+			}
+			resp, err := o.ChatCompletions(context.Background(), &openapi.ChatCompletionsRequest{
+				Body:        []byte(tt.body),
+				WorkspaceID: 10,
+			})
+			if tt.wantErrCode != 0 {
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantErrCode, statusErr.Code())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantReq.ModelConfig, rt.req.ModelConfig)
+			assert.Equal(t, tt.wantReq.Messages, rt.req.Messages)
+			assert.Equal(t, tt.wantReq.Tools, rt.req.Tools)
+			assert.Equal(t, int64(10), rt.req.GetBizParam().GetWorkspaceID())
+			assert.Equal(t, openAIScenarioEntityID, rt.req.GetBizParam().GetScenarioEntityID())
+			got := &oai.ChatCompletionResponse{}
+			assert.Nil(t, json.Unmarshal(resp.GetBody(), got))
+			got.Created = 0
+			assert.Equal(t, tt.wantResp, got)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"strconv"
 	"time"
+	"unicode/utf8"
 
 	"github.com/coze-dev/cozeloop-go/spec/tracespec"
 	"github.com/pkg/errors"
@@ -32,9 +33,13 @@ import (
 	"github.com/coze-dev/coze-loop/backend/pkg/logs"
 )
 
+// maxEmbeddingTexts 单次向量化请求的最大文本条数，超出模型单批上限的请求会在模型调用时拆分
+const maxEmbeddingTexts = 2048
+
 type runtimeApp struct {
 	manageSrv   service.IManage
 	runtimeSrv  service.IRuntime
+	quotaSrv    service.IQuota
 	redis       redis.Cmdable
 	rateLimiter limiter.IRateLimiter
 }
@@ -42,12 +47,14 @@ type runtimeApp struct {
 func NewRuntimeApplication(
 	manageSrv service.IManage,
 	runtimeSrv service.IRuntime,
+	quotaSrv service.IQuota,
 	redis redis.Cmdable,
 	factory limiter.IRateLimiterFactory,
 ) runtime.LLMRuntimeService {
 	return &runtimeApp{
 		manageSrv:   manageSrv,
 		runtimeSrv:  runtimeSrv,
+		quotaSrv:    quotaSrv,
 		redis:       redis,
 		rateLimiter: factory.NewRateLimiter(),
 	}
@@ -63,12 +70,18 @@ func (r *runtimeApp) Chat(ctx context.Context, req *runtime.ChatRequest) (resp *
 	if err != nil {
 		return resp, err
 	}
+	if !model.VisibleIn(req.GetBizParam().GetWorkspaceID()) {
+		return resp, modelNotVisibleErr(model, req.GetBizParam().GetWorkspaceID())
+	}
 	// 2. model参数校验
 	if err = model.Valid(); err != nil {
 		return resp, errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
 	}
-	// 3. 限流
-	if err = r.rateLimitAllow(ctx, req, model); err != nil {
+	// 3. 限流与额度校验
+	if err = r.rateLimitAllow(ctx, getScenario(req.GetBizParam()), model, req.GetModelConfig().GetMaxTokens()); err != nil {
+		return resp, err
+	}
+	if err = r.quotaSrv.CheckQuota(ctx, req.GetBizParam().GetWorkspaceID(), model.ID); err != nil {
 		return resp, err
 	}
 	// 4. 格式转换
@@ -79,6 +92,9 @@ func (r *runtimeApp) Chat(ctx context.Context, req *runtime.ChatRequest) (resp *
 	}
 	options := convertor.ModelAndTools2OptionDOs(req.GetModelConfig(), req.GetTools())
 	var respMsg *entity.Message
+	// 经过路由后实际请求的模型
+	servedModel := model
+	beginTime := time.Now()
 	// 5. start span
 	var span looptracer.Span
 	ctx, span = looptracer.GetTracer().StartSpan(ctx, model.Name, tracespec.VModelSpanType, looptracer.WithSpanWorkspaceID(strconv.FormatInt(req.GetBizParam().GetWorkspaceID(), 10)))
@@ -86,25 +102,33 @@ func (r *runtimeApp) Chat(ctx context.Context, req *runtime.ChatRequest) (resp *
 	defer func() {
 		// 上报span
 		r.setAndFinishSpan(ctx, span, setSpanParam{
-			stream:     false,
-			inputMsgs:  msgs,
-			toolInfos:  convertor.ToolsDTO2DO(req.GetTools()),
-			toolChoice: convertor.ToolChoiceDTO2DO(req.GetModelConfig().ToolChoice),
-			options:    options,
-			model:      model,
-			err:        err,
-			respMsgs:   []*entity.Message{respMsg},
+			stream:      false,
+			inputMsgs:   msgs,
+			toolInfos:   convertor.ToolsDTO2DO(req.GetTools()),
+			toolChoice:  convertor.ToolChoiceDTO2DO(req.GetModelConfig().ToolChoice),
+			options:     options,
+			model:       servedModel,
+			originModel: model,
+			err:         err,
+			respMsgs:    []*entity.Message{respMsg},
 		})
 		// 异步记录本次模型请求
 		r.recordModelRequest(ctx, &recordModelRequestParam{
-			bizParam: req.BizParam,
-			model:    model,
-			input:    msgs,
-			lastMsg:  respMsg,
-			err:      err,
+			bizParam:    req.BizParam,
+			model:       servedModel,
+			originModel: model,
+			input:       msgs,
+			lastMsg:     respMsg,
+			latency:     time.Since(beginTime),
+			err:         err,
 		})
 	}()
-	respMsg, err = r.runtimeSrv.Generate(ctx, model, msgs, options...)
+	var routedModel *entity.Model
+	respMsg, routedModel, err = r.runtimeSrv.Generate(ctx, req.GetBizParam().GetWorkspaceID(), model, getScenario(req.GetBizParam()),
+		r.candidateAdmitter(req.GetBizParam(), req.GetModelConfig().GetMaxTokens()), msgs, options...)
+	if routedModel != nil {
+		servedModel = routedModel
+	}
 	if err != nil {
 		return resp, err
 	}
@@ -123,12 +147,18 @@ func (r *runtimeApp) ChatStream(ctx context.Context, req *runtime.ChatRequest, s
 	if err != nil {
 		return err
 	}
+	if !model.VisibleIn(req.GetBizParam().GetWorkspaceID()) {
+		return modelNotVisibleErr(model, req.GetBizParam().GetWorkspaceID())
+	}
 	// 对model参数做校验
 	if err = model.Valid(); err != nil {
 		return errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
 	}
-	// 2. 限流
-	if err = r.rateLimitAllow(ctx, req, model); err != nil {
+	// 2. 限流与额度校验
+	if err = r.rateLimitAllow(ctx, getScenario(req.GetBizParam()), model, req.GetModelConfig().GetMaxTokens()); err != nil {
+		return err
+	}
+	if err = r.quotaSrv.CheckQuota(ctx, req.GetBizParam().GetWorkspaceID(), model.ID); err != nil {
 		return err
 	}
 	// 3. 格式转换
@@ -143,6 +173,7 @@ func (r *runtimeApp) ChatStream(ctx context.Context, req *runtime.ChatRequest, s
 	ctx, span = looptracer.GetTracer().StartSpan(ctx, model.Name, tracespec.VModelSpanType, looptracer.WithSpanWorkspaceID(strconv.FormatInt(req.GetBizParam().GetWorkspaceID(), 10)))
 	// 5. 调用llm.generate or llm.stream方法, 并解析流式返回
 	var parseResult entity.StreamRespParseResult
+	servedModel := model
 	beginTime := time.Now()
 	defer func() {
 		// 上报span
@@ -153,21 +184,28 @@ func (r *runtimeApp) ChatStream(ctx context.Context, req *runtime.ChatRequest, s
 			toolChoice:        convertor.ToolChoiceDTO2DO(req.GetModelConfig().ToolChoice),
 			options:           options,
 			reasoningDuration: parseResult.ReasoningDuration,
-			model:             model,
+			model:             servedModel,
+			originModel:       model,
 			err:               err,
 			respMsgs:          parseResult.RespMsgs,
 			firstTokenLatency: parseResult.FirstTokenLatency,
 		})
 		// 异步记录本次模型请求
 		r.recordModelRequest(ctx, &recordModelRequestParam{
-			bizParam: req.BizParam,
-			model:    model,
-			input:    msgs,
-			lastMsg:  parseResult.LastRespMsg,
-			err:      err,
+			bizParam:    req.BizParam,
+			model:       servedModel,
+			originModel: model,
+			input:       msgs,
+			lastMsg:     parseResult.LastRespMsg,
+			latency:     time.Since(beginTime),
+			err:         err,
 		})
 	}()
-	sr, err := r.runtimeSrv.Stream(ctx, model, msgs, options...)
+	sr, routedModel, err := r.runtimeSrv.Stream(ctx, model, getScenario(req.GetBizParam()),
+		r.candidateAdmitter(req.GetBizParam(), req.GetModelConfig().GetMaxTokens()), msgs, options...)
+	if routedModel != nil {
+		servedModel = routedModel
+	}
 	if err != nil {
 		return err
 	}
@@ -177,6 +215,65 @@ func (r *runtimeApp) ChatStream(ctx context.Context, req *runtime.ChatRequest, s
 	return nil
 }
 
+func (r *runtimeApp) Embed(ctx context.Context, req *runtime.EmbedRequest) (resp *runtime.EmbedResponse, err error) {
+	resp = runtime.NewEmbedResponse()
+	if err = r.validateEmbedReq(ctx, req); err != nil {
+		return resp, errorx.NewByCode(llm_errorx.RequestNotValidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	// 1. 模型信息获取
+	model, err := r.manageSrv.GetModelByID(ctx, req.GetModelID())
+	if err != nil {
+		return resp, err
+	}
+	if !model.VisibleIn(req.GetBizParam().GetWorkspaceID()) {
+		return resp, modelNotVisibleErr(model, req.GetBizParam().GetWorkspaceID())
+	}
+	// 2. model参数校验
+	if err = model.Valid(); err != nil {
+		return resp, errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	// 3. 限流与额度校验，向量化请求没有输出，按输入字符数预估 token
+	var estimatedTokens int64
+	for _, text := range req.GetTexts() {
+		estimatedTokens += int64(utf8.RuneCountInString(text))
+	}
+	if err = r.rateLimitAllow(ctx, getScenario(req.GetBizParam()), model, estimatedTokens); err != nil {
+		return resp, err
+	}
+	if err = r.quotaSrv.CheckQuota(ctx, req.GetBizParam().GetWorkspaceID(), model.ID); err != nil {
+		return resp, err
+	}
+	var opts []entity.EmbeddingOption
+	if req.IsSetDimensions() {
+		opts = append(opts, entity.WithDimensions(int(req.GetDimensions())))
+	}
+	// 4. start span
+	var span looptracer.Span
+	ctx, span = looptracer.GetTracer().StartSpan(ctx, model.Name, consts.SpanTypeEmbedding, looptracer.WithSpanWorkspaceID(strconv.FormatInt(req.GetBizParam().GetWorkspaceID(), 10)))
+	var result *entity.EmbeddingResult
+	beginTime := time.Now()
+	defer func() {
+		r.setAndFinishEmbeddingSpan(ctx, span, model, req.GetTexts(), result, err)
+		// 异步记录本次模型请求，向量化请求只有输入 token
+		r.recordModelRequest(ctx, &recordModelRequestParam{
+			bizParam:    req.BizParam,
+			model:       model,
+			originModel: model,
+			lastMsg:     &entity.Message{ResponseMeta: &entity.ResponseMeta{Usage: result.GetUsage()}},
+			latency:     time.Since(beginTime),
+			err:         err,
+		})
+	}()
+	// 5. 调用模型
+	result, err = r.runtimeSrv.Embed(ctx, model, req.GetTexts(), opts...)
+	if err != nil {
+		return resp, err
+	}
+	resp.SetEmbeddings(result.Embeddings)
+	resp.SetUsage(convertor.TokenUsageDO2DTO(result.Usage))
+	return resp, nil
+}
+
 func (r *runtimeApp) parseChatStreamResp(ctx context.Context, streamDO entity.IStreamReader, streamDTO runtime.LLMRuntimeService_ChatStreamServer,
 	beginTime time.Time,
 ) (parseResult entity.StreamRespParseResult, err error) {
@@ -212,13 +309,30 @@ func (r *runtimeApp) parseChatStreamResp(ctx context.Context, streamDO entity.IS
 	return parseResult, nil
 }
 
-func (r *runtimeApp) rateLimitAllow(ctx context.Context, req *runtime.ChatRequest, model *entity.Model) error {
-	var scenario *entity.Scenario
-	if req.GetBizParam() != nil && req.GetBizParam().Scenario != nil {
-		scenario = convertor.ScenarioPtrDTO2DTO(req.GetBizParam().Scenario)
-	} else {
-		scenario = ptr.Of(entity.ScenarioDefault)
+// modelNotVisibleErr 其他空间的模型对当前空间按不存在处理，避免泄露模型信息
+func modelNotVisibleErr(model *entity.Model, spaceID int64) error {
+	return errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d not exist in space:%d", model.ID, spaceID)))
+}
+
+func getScenario(bizParam *druntime.BizParam) *entity.Scenario {
+	if bizParam != nil && bizParam.Scenario != nil {
+		return convertor.ScenarioPtrDTO2DTO(bizParam.Scenario)
 	}
+	return ptr.Of(entity.ScenarioDefault)
+}
+
+// candidateAdmitter 路由到的候选模型与主模型一样需通过自身在该场景下的限流与空间额度校验
+func (r *runtimeApp) candidateAdmitter(bizParam *druntime.BizParam, tokens int64) entity.CandidateAdmitter {
+	return func(ctx context.Context, candidate *entity.Model) error {
+		if err := r.rateLimitAllow(ctx, getScenario(bizParam), candidate, tokens); err != nil {
+			return err
+		}
+		return r.quotaSrv.CheckQuota(ctx, bizParam.GetWorkspaceID(), candidate.ID)
+	}
+}
+
+// rateLimitAllow tokens 为本次请求预估消耗的 token 数，用于 tpm 限流
+func (r *runtimeApp) rateLimitAllow(ctx context.Context, scenario *entity.Scenario, model *entity.Model, tokens int64) error {
 	// 获得模型在此场景下的qpm tpm
 	sceneCfg := model.GetScenarioConfig(scenario)
 	if sceneCfg == nil || sceneCfg.Quota == nil {
@@ -241,7 +355,7 @@ func (r *runtimeApp) rateLimitAllow(ctx context.Context, req *runtime.ChatReques
 	// tpm
 	if tpm >= 0 {
 		tpmKey := fmt.Sprintf("%s:%d:%s", "tpm", model.ID, *scenario)
-		result, err := r.rateLimiter.AllowN(ctx, tpmKey, int(req.GetModelConfig().GetMaxTokens()), limiter.WithLimit(&limiter.Limit{
+		result, err := r.rateLimiter.AllowN(ctx, tpmKey, int(tokens), limiter.WithLimit(&limiter.Limit{
 			Rate:   int(tpm),
 			Burst:  int(tpm),
 			Period: time.Minute,
@@ -254,11 +368,13 @@ func (r *runtimeApp) rateLimitAllow(ctx context.Context, req *runtime.ChatReques
 }
 
 type recordModelRequestParam struct {
-	bizParam *druntime.BizParam
-	model    *entity.Model
-	input    []*entity.Message
-	lastMsg  *entity.Message
-	err      error
+	bizParam    *druntime.BizParam
+	model       *entity.Model
+	originModel *entity.Model
+	input       []*entity.Message
+	lastMsg     *entity.Message
+	latency     time.Duration
+	err         error
 }
 
 func (r *runtimeApp) recordModelRequest(ctx context.Context, param *recordModelRequestParam) {
@@ -274,10 +390,17 @@ func (r *runtimeApp) recordModelRequest(ctx context.Context, param *recordModelR
 			ModelAk:             param.model.ProtocolConfig.APIKey,
 			ModelID:             strconv.FormatInt(param.model.ID, 10),
 			ModelName:           param.model.Name,
-			InputToken:          int64(param.lastMsg.GetInputToken()),
-			OutputToken:         int64(param.lastMsg.GetOutputToken()),
+			OriginModelID:       strconv.FormatInt(param.originModel.ID, 10),
+			CacheHit:            param.lastMsg.IsCacheHit(),
+			LatencyMs:           param.latency.Milliseconds(),
 			Logid:               logs.GetLogID(ctx),
 		}
+		// 命中响应缓存时没有实际调用模型，不计用量与成本
+		if !record.CacheHit {
+			record.InputToken = int64(param.lastMsg.GetInputToken())
+			record.OutputToken = int64(param.lastMsg.GetOutputToken())
+			record.Cost = param.model.Price.Cost(record.InputToken, record.OutputToken)
+		}
 		if param.err != nil {
 			record.ErrorCode = strconv.FormatInt(int64(traceutil.GetTraceStatusCode(param.err)), 10)
 			record.ErrorMsg = ptr.Of(param.err.Error())
@@ -285,17 +408,22 @@ func (r *runtimeApp) recordModelRequest(ctx context.Context, param *recordModelR
 		if err := r.runtimeSrv.CreateModelRequestRecord(ctx, record); err != nil {
 			logs.CtxWarn(ctx, "[recordModelRequest] failed, err:%v", err)
 		}
+		// 按本次请求的实际用量扣减额度
+		if err := r.quotaSrv.ConsumeQuota(ctx, record); err != nil {
+			logs.CtxWarn(ctx, "[recordModelRequest] consume quota failed, err:%v", err)
+		}
 	})
 }
 
 type setSpanParam struct {
-	stream     bool
-	inputMsgs  []*entity.Message
-	toolInfos  []*entity.ToolInfo
-	toolChoice *entity.ToolChoice
-	options    []entity.Option
-	model      *entity.Model
-	bizParam   *druntime.BizParam
+	stream      bool
+	inputMsgs   []*entity.Message
+	toolInfos   []*entity.ToolInfo
+	toolChoice  *entity.ToolChoice
+	options     []entity.Option
+	model       *entity.Model
+	originModel *entity.Model
+	bizParam    *druntime.BizParam
 
 	firstTokenLatency time.Duration
 	reasoningDuration time.Duration
@@ -319,6 +447,9 @@ func (r *runtimeApp) setAndFinishSpan(ctx context.Context, span looptracer.Span,
 	tags[consts.SpanTagModelID] = param.model.ID
 	tags[tracespec.ModelIdentification] = param.model.GetModel()
 	tags[tracespec.ModelName] = param.model.Name
+	if param.originModel != nil && param.originModel.ID != param.model.ID {
+		tags[consts.SpanTagOriginModelID] = param.originModel.ID
+	}
 	if param.bizParam.GetScenario() == common.ScenarioPromptDebug {
 		tags[tracespec.PromptKey] = param.bizParam.GetScenarioEntityID()
 		tags[tracespec.PromptVersion] = param.bizParam.GetScenarioEntityVersion()
@@ -332,6 +463,9 @@ func (r *runtimeApp) setAndFinishSpan(ctx context.Context, span looptracer.Span,
 		tags[tracespec.Output] = json.Jsonify(entity.StreamMsgsToTraceModelChoices(param.respMsgs))
 		// token usage
 		lastMsg := param.respMsgs[len(param.respMsgs)-1]
+		if lastMsg.IsCacheHit() {
+			tags[consts.SpanTagCacheHit] = true
+		}
 		if lastMsg != nil && lastMsg.ResponseMeta != nil && lastMsg.ResponseMeta.Usage != nil {
 			tags[tracespec.InputTokens] = lastMsg.ResponseMeta.Usage.PromptTokens
 			tags[tracespec.OutputTokens] = lastMsg.ResponseMeta.Usage.CompletionTokens
@@ -345,6 +479,29 @@ func (r *runtimeApp) setAndFinishSpan(ctx context.Context, span looptracer.Span,
 	span.Finish(ctx)
 }
 
+func (r *runtimeApp) setAndFinishEmbeddingSpan(ctx context.Context, span looptracer.Span, model *entity.Model, texts []string, result *entity.EmbeddingResult, err error) {
+	if span == nil {
+		return
+	}
+	tags := make(map[string]any)
+	if err != nil {
+		span.SetStatusCode(ctx, int(traceutil.GetTraceStatusCode(err)))
+		tags[tracespec.Error] = errorx.ErrorWithoutStack(err)
+	}
+	// 只上报输入文本与向量条数，向量本身体积过大不上报
+	tags[tracespec.Input] = json.Jsonify(texts)
+	tags[tracespec.Output] = len(result.GetEmbeddings())
+	tags[consts.SpanTagModelID] = model.ID
+	tags[tracespec.ModelIdentification] = model.GetModel()
+	tags[tracespec.ModelName] = model.Name
+	if usage := result.GetUsage(); usage != nil {
+		tags[tracespec.InputTokens] = usage.PromptTokens
+		tags[tracespec.Tokens] = usage.TotalTokens
+	}
+	span.SetTags(ctx, tags)
+	span.Finish(ctx)
+}
+
 func (r *runtimeApp) validateChatReq(ctx context.Context, req *runtime.ChatRequest) (err error) {
 	if req.GetModelConfig() == nil {
 		return errors.Errorf("model config is required")
@@ -369,3 +526,36 @@ func (r *runtimeApp) validateChatReq(ctx context.Context, req *runtime.ChatReque
 	}
 	return nil
 }
+
+func (r *runtimeApp) validateEmbedReq(ctx context.Context, req *runtime.EmbedRequest) (err error) {
+	if !req.IsSetModelID() {
+		return errors.Errorf("model_id is required")
+	}
+	if len(req.GetTexts()) == 0 {
+		return errors.Errorf("texts is required")
+	}
+	if len(req.GetTexts()) > maxEmbeddingTexts {
+		return errors.Errorf("texts exceeds the limit of %d", maxEmbeddingTexts)
+	}
+	for i, text := range req.GetTexts() {
+		if text == "" {
+			return errors.Errorf("texts[%d] is empty", i)
+		}
+	}
+	if req.IsSetDimensions() && req.GetDimensions() <= 0 {
+		return errors.Errorf("dimensions must be positive")
+	}
+	if req.GetBizParam() == nil {
+		return errors.Errorf("bizParam is required")
+	}
+	if !req.GetBizParam().IsSetScenario() {
+		return errors.Errorf("bizParam.scenario is required")
+	}
+	if !req.GetBizParam().IsSetScenarioEntityID() {
+		return errors.Errorf("bizParam.scenario_entity_id is required")
+	}
+	if !req.GetBizParam().IsSetWorkspaceID() {
+		return errors.Errorf("bizParam.workspace_id is required")
+	}
+	return nil
+}
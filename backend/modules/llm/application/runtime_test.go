@@ -20,6 +20,8 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service"
 	llmservicemocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/mocks"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-loop/backend/pkg/unittest"
 )
@@ -92,6 +94,7 @@ func Test_runtimeApp_Chat(t *testing.T) {
 	type fields struct {
 		manageSrv   service.IManage
 		runtimeSrv  service.IRuntime
+		quotaSrv    service.IQuota
 		redis       redis.Cmdable
 		rateLimiter limiter.IRateLimiter
 	}
@@ -112,6 +115,7 @@ func Test_runtimeApp_Chat(t *testing.T) {
 				mockManage := llmservicemocks.NewMockIManage(ctrl)
 				mockRuntime := llmservicemocks.NewMockIRuntime(ctrl)
 				mockLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockQuota := llmservicemocks.NewMockIQuota(ctrl)
 
 				model := &entity.Model{
 					ID:          1,
@@ -157,11 +161,14 @@ func Test_runtimeApp_Chat(t *testing.T) {
 					LimitKey:  "",
 				}, nil).AnyTimes()
 				mockRuntime.EXPECT().HandleMsgsPreCallModel(gomock.Any(), gomock.Any(), gomock.Any()).Return(convertor.MessagesDTO2DO(req.GetMessages()), nil)
-				mockRuntime.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(convertor.MessagesDTO2DO(req.GetMessages())[0], nil)
+				mockRuntime.EXPECT().Generate(gomock.Any(), int64(1), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(convertor.MessagesDTO2DO(req.GetMessages())[0], model, nil)
 				mockRuntime.EXPECT().CreateModelRequestRecord(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockQuota.EXPECT().CheckQuota(gomock.Any(), gomock.Any(), int64(1)).Return(nil)
+				mockQuota.EXPECT().ConsumeQuota(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				return fields{
 					manageSrv:   mockManage,
 					runtimeSrv:  mockRuntime,
+					quotaSrv:    mockQuota,
 					rateLimiter: mockLimiter,
 				}
 			},
@@ -174,6 +181,21 @@ func Test_runtimeApp_Chat(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "model not visible in space",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManage := llmservicemocks.NewMockIManage(ctrl)
+				mockManage.EXPECT().GetModelByID(gomock.Any(), gomock.Any()).Return(&entity.Model{ID: 1, WorkspaceID: 2}, nil)
+				return fields{
+					manageSrv: mockManage,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			wantErr: errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg("model id:1 not exist in space:1")),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -185,6 +207,7 @@ func Test_runtimeApp_Chat(t *testing.T) {
 			r := &runtimeApp{
 				manageSrv:   ttFields.manageSrv,
 				runtimeSrv:  ttFields.runtimeSrv,
+				quotaSrv:    ttFields.quotaSrv,
 				redis:       ttFields.redis,
 				rateLimiter: ttFields.rateLimiter,
 			}
@@ -197,3 +220,294 @@ func Test_runtimeApp_Chat(t *testing.T) {
 		})
 	}
 }
+
+func Test_runtimeApp_Embed(t *testing.T) {
+	model := &entity.Model{
+		ID:             1,
+		Name:           "your embedding model",
+		Ability:        &entity.Ability{Embedding: true},
+		Frame:          entity.FrameEino,
+		Protocol:       entity.ProtocolArk,
+		ProtocolConfig: &entity.ProtocolConfig{},
+		ScenarioConfigs: map[entity.Scenario]*entity.ScenarioConfig{
+			entity.ScenarioDefault: {
+				Scenario: entity.ScenarioDefault,
+				Quota:    &entity.Quota{Qpm: 10, Tpm: 1000},
+			},
+		},
+	}
+	bizParam := &druntime.BizParam{
+		WorkspaceID:      ptr.Of(int64(1)),
+		Scenario:         ptr.Of(common.ScenarioDefault),
+		ScenarioEntityID: ptr.Of("dataset dedup"),
+	}
+	tests := []struct {
+		name         string
+		req          *runtime.EmbedRequest
+		fieldsGetter func(ctrl *gomock.Controller) (service.IManage, service.IRuntime, service.IQuota, limiter.IRateLimiter)
+		wantResp     *runtime.EmbedResponse
+		wantErrCode  int32
+	}{
+		{
+			name: "success",
+			req: &runtime.EmbedRequest{
+				ModelID:    ptr.Of(int64(1)),
+				Texts:      []string{"hello", "world"},
+				Dimensions: ptr.Of(int32(2)),
+				BizParam:   bizParam,
+			},
+			fieldsGetter: func(ctrl *gomock.Controller) (service.IManage, service.IRuntime, service.IQuota, limiter.IRateLimiter) {
+				mockManage := llmservicemocks.NewMockIManage(ctrl)
+				mockRuntime := llmservicemocks.NewMockIRuntime(ctrl)
+				mockLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockManage.EXPECT().GetModelByID(gomock.Any(), int64(1)).Return(model, nil)
+				mockLimiter.EXPECT().AllowN(gomock.Any(), "qpm:1:default", 1, gomock.Any()).Return(&limiter.Result{Allowed: true}, nil)
+				mockLimiter.EXPECT().AllowN(gomock.Any(), "tpm:1:default", 10, gomock.Any()).Return(&limiter.Result{Allowed: true}, nil)
+				mockRuntime.EXPECT().Embed(gomock.Any(), model, []string{"hello", "world"}, gomock.Any()).Return(&entity.EmbeddingResult{
+					Embeddings: [][]float64{{0.1, 0.2}, {0.3, 0.4}},
+					Usage:      &entity.TokenUsage{PromptTokens: 2, TotalTokens: 2},
+				}, nil)
+				mockRuntime.EXPECT().CreateModelRequestRecord(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockQuota := llmservicemocks.NewMockIQuota(ctrl)
+				mockQuota.EXPECT().CheckQuota(gomock.Any(), int64(1), int64(1)).Return(nil)
+				mockQuota.EXPECT().ConsumeQuota(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				return mockManage, mockRuntime, mockQuota, mockLimiter
+			},
+			wantResp: &runtime.EmbedResponse{
+				Embeddings: [][]float64{{0.1, 0.2}, {0.3, 0.4}},
+				Usage: &druntime.TokenUsage{
+					PromptTokens:     ptr.Of(int64(2)),
+					CompletionTokens: ptr.Of(int64(0)),
+					TotalTokens:      ptr.Of(int64(2)),
+				},
+			},
+		},
+		{
+			name: "empty text",
+			req: &runtime.EmbedRequest{
+				ModelID:  ptr.Of(int64(1)),
+				Texts:    []string{"hello", ""},
+				BizParam: bizParam,
+			},
+			fieldsGetter: func(ctrl *gomock.Controller) (service.IManage, service.IRuntime, service.IQuota, limiter.IRateLimiter) {
+				return nil, nil, nil, nil
+			},
+			wantErrCode: llm_errorx.RequestNotValidCode,
+		},
+		{
+			name: "qpm limited",
+			req: &runtime.EmbedRequest{
+				ModelID:  ptr.Of(int64(1)),
+				Texts:    []string{"hello"},
+				BizParam: bizParam,
+			},
+			fieldsGetter: func(ctrl *gomock.Controller) (service.IManage, service.IRuntime, service.IQuota, limiter.IRateLimiter) {
+				mockManage := llmservicemocks.NewMockIManage(ctrl)
+				mockLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockManage.EXPECT().GetModelByID(gomock.Any(), int64(1)).Return(model, nil)
+				mockLimiter.EXPECT().AllowN(gomock.Any(), "qpm:1:default", 1, gomock.Any()).Return(&limiter.Result{Allowed: false}, nil)
+				return mockManage, nil, nil, mockLimiter
+			},
+			wantErrCode: llm_errorx.ModelQPMLimitCode,
+		},
+		{
+			name: "token quota exceeded",
+			req: &runtime.EmbedRequest{
+				ModelID:  ptr.Of(int64(1)),
+				Texts:    []string{"hello"},
+				BizParam: bizParam,
+			},
+			fieldsGetter: func(ctrl *gomock.Controller) (service.IManage, service.IRuntime, service.IQuota, limiter.IRateLimiter) {
+				mockManage := llmservicemocks.NewMockIManage(ctrl)
+				mockLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockQuota := llmservicemocks.NewMockIQuota(ctrl)
+				mockManage.EXPECT().GetModelByID(gomock.Any(), int64(1)).Return(model, nil)
+				mockLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{Allowed: true}, nil).Times(2)
+				mockQuota.EXPECT().CheckQuota(gomock.Any(), int64(1), int64(1)).Return(errorx.NewByCode(llm_errorx.ModelTokenQuotaExceededCode))
+				return mockManage, nil, mockQuota, mockLimiter
+			},
+			wantErrCode: llm_errorx.ModelTokenQuotaExceededCode,
+		},
+		{
+			name: "model not visible in space",
+			req: &runtime.EmbedRequest{
+				ModelID:  ptr.Of(int64(3)),
+				Texts:    []string{"hello"},
+				BizParam: bizParam,
+			},
+			fieldsGetter: func(ctrl *gomock.Controller) (service.IManage, service.IRuntime, service.IQuota, limiter.IRateLimiter) {
+				mockManage := llmservicemocks.NewMockIManage(ctrl)
+				mockManage.EXPECT().GetModelByID(gomock.Any(), int64(3)).Return(&entity.Model{ID: 3, WorkspaceID: 2, Ability: &entity.Ability{Embedding: true}}, nil)
+				return mockManage, nil, nil, nil
+			},
+			wantErrCode: llm_errorx.ResourceNotFoundCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			manageSrv, runtimeSrv, quotaSrv, rateLimiter := tt.fieldsGetter(ctrl)
+			r := &runtimeApp{
+				manageSrv:   manageSrv,
+				runtimeSrv:  runtimeSrv,
+				quotaSrv:    quotaSrv,
+				rateLimiter: rateLimiter,
+			}
+			gotResp, err := r.Embed(context.Background(), tt.req)
+			if tt.wantErrCode != 0 {
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantErrCode, statusErr.Code())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantResp.Embeddings, gotResp.Embeddings)
+			assert.Equal(t, tt.wantResp.Usage, gotResp.Usage)
+		})
+	}
+}
+
+func Test_runtimeApp_candidateAdmitter(t *testing.T) {
+	candidate := &entity.Model{
+		ID: 2,
+		ScenarioConfigs: map[entity.Scenario]*entity.ScenarioConfig{
+			entity.ScenarioEvalTarget: {
+				Scenario: entity.ScenarioEvalTarget,
+				Quota:    &entity.Quota{Qpm: 10, Tpm: 1000},
+			},
+		},
+	}
+	bizParam := &druntime.BizParam{
+		WorkspaceID: ptr.Of(int64(1)),
+		Scenario:    ptr.Of(common.ScenarioEvalTarget),
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) (service.IQuota, limiter.IRateLimiter)
+		wantErrCode  int32
+	}{
+		{
+			name: "admitted",
+			fieldsGetter: func(ctrl *gomock.Controller) (service.IQuota, limiter.IRateLimiter) {
+				mockLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockLimiter.EXPECT().AllowN(gomock.Any(), "qpm:2:eval_target", 1, gomock.Any()).Return(&limiter.Result{Allowed: true}, nil)
+				mockLimiter.EXPECT().AllowN(gomock.Any(), "tpm:2:eval_target", 100, gomock.Any()).Return(&limiter.Result{Allowed: true}, nil)
+				mockQuota := llmservicemocks.NewMockIQuota(ctrl)
+				mockQuota.EXPECT().CheckQuota(gomock.Any(), int64(1), int64(2)).Return(nil)
+				return mockQuota, mockLimiter
+			},
+		},
+		{
+			name: "candidate qpm limited",
+			fieldsGetter: func(ctrl *gomock.Controller) (service.IQuota, limiter.IRateLimiter) {
+				mockLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockLimiter.EXPECT().AllowN(gomock.Any(), "qpm:2:eval_target", 1, gomock.Any()).Return(&limiter.Result{Allowed: false}, nil)
+				return nil, mockLimiter
+			},
+			wantErrCode: llm_errorx.ModelQPMLimitCode,
+		},
+		{
+			name: "candidate quota exceeded",
+			fieldsGetter: func(ctrl *gomock.Controller) (service.IQuota, limiter.IRateLimiter) {
+				mockLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{Allowed: true}, nil).Times(2)
+				mockQuota := llmservicemocks.NewMockIQuota(ctrl)
+				mockQuota.EXPECT().CheckQuota(gomock.Any(), int64(1), int64(2)).Return(errorx.NewByCode(llm_errorx.ModelTokenQuotaExceededCode))
+				return mockQuota, mockLimiter
+			},
+			wantErrCode: llm_errorx.ModelTokenQuotaExceededCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			quotaSrv, rateLimiter := tt.fieldsGetter(ctrl)
+			r := &runtimeApp{
+				quotaSrv:    quotaSrv,
+				rateLimiter: rateLimiter,
+			}
+			err := r.candidateAdmitter(bizParam, 100)(context.Background(), candidate)
+			if tt.wantErrCode == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			statusErr, ok := errorx.FromStatusError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantErrCode, statusErr.Code())
+		})
+	}
+}
+
+func Test_runtimeApp_recordModelRequest(t *testing.T) {
+	model := &entity.Model{
+		ID:             1,
+		ProtocolConfig: &entity.ProtocolConfig{},
+		Price:          &entity.Price{InputPrice: 1, OutputPrice: 2},
+	}
+	fallback := &entity.Model{ID: 2, ProtocolConfig: &entity.ProtocolConfig{}}
+	tests := []struct {
+		name       string
+		model      *entity.Model
+		lastMsg    *entity.Message
+		wantRecord *entity.ModelRequestRecord
+	}{
+		{
+			name:  "model called",
+			model: model,
+			lastMsg: &entity.Message{ResponseMeta: &entity.ResponseMeta{
+				Usage: &entity.TokenUsage{PromptTokens: 1000, CompletionTokens: 500},
+			}},
+			wantRecord: &entity.ModelRequestRecord{ModelID: "1", OriginModelID: "1", InputToken: 1000, OutputToken: 500, Cost: 0.002},
+		},
+		{
+			name:  "response cache hit",
+			model: model,
+			lastMsg: &entity.Message{ResponseMeta: &entity.ResponseMeta{
+				CacheHit: true,
+				Usage:    &entity.TokenUsage{PromptTokens: 1000, CompletionTokens: 500},
+			}},
+			wantRecord: &entity.ModelRequestRecord{ModelID: "1", OriginModelID: "1", CacheHit: true},
+		},
+		{
+			name:  "served by fallback model",
+			model: fallback,
+			lastMsg: &entity.Message{ResponseMeta: &entity.ResponseMeta{
+				Usage: &entity.TokenUsage{PromptTokens: 1000, CompletionTokens: 500},
+			}},
+			wantRecord: &entity.ModelRequestRecord{ModelID: "2", OriginModelID: "1", InputToken: 1000, OutputToken: 500},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			done := make(chan *entity.ModelRequestRecord, 1)
+			mockRuntime := llmservicemocks.NewMockIRuntime(ctrl)
+			mockRuntime.EXPECT().CreateModelRequestRecord(gomock.Any(), gomock.Any()).Return(nil)
+			mockQuota := llmservicemocks.NewMockIQuota(ctrl)
+			mockQuota.EXPECT().ConsumeQuota(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, record *entity.ModelRequestRecord) error {
+				done <- record
+				return nil
+			})
+			r := &runtimeApp{
+				runtimeSrv: mockRuntime,
+				quotaSrv:   mockQuota,
+			}
+			r.recordModelRequest(context.Background(), &recordModelRequestParam{
+				bizParam:    &druntime.BizParam{WorkspaceID: ptr.Of(int64(1))},
+				model:       tt.model,
+				originModel: model,
+				lastMsg:     tt.lastMsg,
+			})
+			record := <-done
+			assert.Equal(t, tt.wantRecord.ModelID, record.ModelID)
+			assert.Equal(t, tt.wantRecord.OriginModelID, record.OriginModelID)
+			assert.Equal(t, tt.wantRecord.CacheHit, record.CacheHit)
+			assert.Equal(t, tt.wantRecord.InputToken, record.InputToken)
+			assert.Equal(t, tt.wantRecord.OutputToken, record.OutputToken)
+			assert.InDelta(t, tt.wantRecord.Cost, record.Cost, 1e-9)
+		})
+	}
+}
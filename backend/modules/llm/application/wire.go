@@ -12,12 +12,15 @@ import (
 	"github.com/google/wire"
 
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
 	"github.com/coze-dev/coze-loop/backend/infra/redis"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/auth/authservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime/llmruntimeservice"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llmfactory"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/config"
@@ -34,8 +37,15 @@ var (
 		config.NewRuntime,
 		service.NewRuntime,
 		service.NewManage,
+		service.NewUsage,
+		service.NewQuota,
 		repo.NewRuntimeRepo,
+		repo.NewManageRepo,
+		repo.NewQuotaRepo,
 		dao.NewModelRequestRecordDao,
+		dao.NewLlmModelDao,
+		dao.NewResponseCacheDao,
+		dao.NewQuotaUsageDao,
 		rpc.NewAuthRPCProvider,
 	)
 	runtimeSet = wire.NewSet(
@@ -46,6 +56,16 @@ var (
 		NewManageApplication,
 		llmDomainSet,
 	)
+	openAPISet = wire.NewSet(
+		NewOpenAPIApplication,
+		llmDomainSet,
+	)
+	quotaSet = wire.NewSet(
+		config.NewRuntime,
+		service.NewQuota,
+		repo.NewQuotaRepo,
+		dao.NewQuotaUsageDao,
+	)
 )
 
 func InitRuntimeApplication(
@@ -54,15 +74,41 @@ func InitRuntimeApplication(
 	configFactory conf.IConfigLoaderFactory,
 	db db.Provider,
 	redis redis.Cmdable,
-	factory limiter.IRateLimiterFactory) (runtime.LLMRuntimeService, error) {
+	factory limiter.IRateLimiterFactory,
+	kms dkms.IDKMS) (runtime.LLMRuntimeService, error) {
 	wire.Build(runtimeSet)
 	return nil, nil
 }
 
 func InitManageApplication(
 	ctx context.Context,
+	idGen idgen.IIDGenerator,
 	configFactory conf.IConfigLoaderFactory,
-	authClient authservice.Client) (manage.LLMManageService, error) {
+	db db.Provider,
+	redis redis.Cmdable,
+	authClient authservice.Client,
+	kms dkms.IDKMS) (manage.LLMManageService, error) {
 	wire.Build(manageSet)
 	return nil, nil
 }
+
+func InitOpenAPIApplication(
+	ctx context.Context,
+	idGen idgen.IIDGenerator,
+	configFactory conf.IConfigLoaderFactory,
+	db db.Provider,
+	authClient authservice.Client,
+	runtimeClient llmruntimeservice.Client,
+	kms dkms.IDKMS) (openapi.LLMOpenAPIService, error) {
+	wire.Build(openAPISet)
+	return nil, nil
+}
+
+// InitQuotaService 供其他模块在调用模型前校验空间的模型调用额度
+func InitQuotaService(
+	ctx context.Context,
+	configFactory conf.IConfigLoaderFactory,
+	redis redis.Cmdable) (service.IQuota, error) {
+	wire.Build(quotaSet)
+	return nil, nil
+}
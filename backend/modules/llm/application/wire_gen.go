@@ -7,13 +7,17 @@
 package application
 
 import (
+	"context"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
 	"github.com/coze-dev/coze-loop/backend/infra/redis"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/auth/authservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/openapi"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/runtime/llmruntimeservice"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llmfactory"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/config"
@@ -21,45 +25,88 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/rpc"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
-	"context"
 	"github.com/google/wire"
 )
 
 // Injectors from wire.go:
 
-func InitRuntimeApplication(ctx context.Context, idGen idgen.IIDGenerator, configFactory conf.IConfigLoaderFactory, db2 db.Provider, redis2 redis.Cmdable, factory limiter.IRateLimiterFactory) (runtime.LLMRuntimeService, error) {
+func InitRuntimeApplication(ctx context.Context, idGen idgen.IIDGenerator, configFactory conf.IConfigLoaderFactory, db2 db.Provider, redis2 redis.Cmdable, factory limiter.IRateLimiterFactory, kms dkms.IDKMS) (runtime.LLMRuntimeService, error) {
 	iConfigManage, err := config.NewManage(ctx, configFactory)
 	if err != nil {
 		return nil, err
 	}
-	iManage := service.NewManage(iConfigManage)
+	iLlmModelDao := dao.NewLlmModelDao(db2)
+	iManageRepo := repo.NewManageRepo(db2, iLlmModelDao, kms)
+	iManage := service.NewManage(iConfigManage, iManageRepo, idGen)
 	iFactory := llmfactory.NewFactory()
 	iModelRequestRecordDao := dao.NewModelRequestRecordDao(db2)
-	iRuntimeRepo := repo.NewRuntimeRepo(db2, iModelRequestRecordDao)
+	iResponseCacheDao := dao.NewResponseCacheDao(redis2)
+	iRuntimeRepo := repo.NewRuntimeRepo(db2, iModelRequestRecordDao, iResponseCacheDao)
 	iConfigRuntime, err := config.NewRuntime(ctx, configFactory)
 	if err != nil {
 		return nil, err
 	}
-	iRuntime := service.NewRuntime(iFactory, idGen, iRuntimeRepo, iConfigRuntime)
-	llmRuntimeService := NewRuntimeApplication(iManage, iRuntime, redis2, factory)
+	iRuntime := service.NewRuntime(iFactory, idGen, iRuntimeRepo, iConfigRuntime, iManage)
+	iQuotaUsageDao := dao.NewQuotaUsageDao(redis2)
+	iQuotaRepo := repo.NewQuotaRepo(iQuotaUsageDao)
+	iQuota := service.NewQuota(iQuotaRepo, iConfigRuntime)
+	llmRuntimeService := NewRuntimeApplication(iManage, iRuntime, iQuota, redis2, factory)
 	return llmRuntimeService, nil
 }
 
-func InitManageApplication(ctx context.Context, configFactory conf.IConfigLoaderFactory, authClient authservice.Client) (manage.LLMManageService, error) {
+func InitManageApplication(ctx context.Context, idGen idgen.IIDGenerator, configFactory conf.IConfigLoaderFactory, db2 db.Provider, redis2 redis.Cmdable, authClient authservice.Client, kms dkms.IDKMS) (manage.LLMManageService, error) {
 	iConfigManage, err := config.NewManage(ctx, configFactory)
 	if err != nil {
 		return nil, err
 	}
-	iManage := service.NewManage(iConfigManage)
+	iLlmModelDao := dao.NewLlmModelDao(db2)
+	iManageRepo := repo.NewManageRepo(db2, iLlmModelDao, kms)
+	iManage := service.NewManage(iConfigManage, iManageRepo, idGen)
+	iModelRequestRecordDao := dao.NewModelRequestRecordDao(db2)
+	iResponseCacheDao := dao.NewResponseCacheDao(redis2)
+	iRuntimeRepo := repo.NewRuntimeRepo(db2, iModelRequestRecordDao, iResponseCacheDao)
+	iUsage := service.NewUsage(iRuntimeRepo)
+	iQuotaUsageDao := dao.NewQuotaUsageDao(redis2)
+	iQuotaRepo := repo.NewQuotaRepo(iQuotaUsageDao)
+	iConfigRuntime, err := config.NewRuntime(ctx, configFactory)
+	if err != nil {
+		return nil, err
+	}
+	iQuota := service.NewQuota(iQuotaRepo, iConfigRuntime)
 	iAuthProvider := rpc.NewAuthRPCProvider(authClient)
-	llmManageService := NewManageApplication(iManage, iAuthProvider)
+	llmManageService := NewManageApplication(iManage, iUsage, iQuota, iAuthProvider)
 	return llmManageService, nil
 }
 
+func InitOpenAPIApplication(ctx context.Context, idGen idgen.IIDGenerator, configFactory conf.IConfigLoaderFactory, db2 db.Provider, authClient authservice.Client, runtimeClient llmruntimeservice.Client, kms dkms.IDKMS) (openapi.LLMOpenAPIService, error) {
+	iConfigManage, err := config.NewManage(ctx, configFactory)
+	if err != nil {
+		return nil, err
+	}
+	iLlmModelDao := dao.NewLlmModelDao(db2)
+	iManageRepo := repo.NewManageRepo(db2, iLlmModelDao, kms)
+	iManage := service.NewManage(iConfigManage, iManageRepo, idGen)
+	iAuthProvider := rpc.NewAuthRPCProvider(authClient)
+	llmOpenAPIService := NewOpenAPIApplication(idGen, iManage, runtimeClient, iAuthProvider)
+	return llmOpenAPIService, nil
+}
+
+// InitQuotaService 供其他模块在调用模型前校验空间的模型调用额度
+func InitQuotaService(ctx context.Context, configFactory conf.IConfigLoaderFactory, redis2 redis.Cmdable) (service.IQuota, error) {
+	iQuotaUsageDao := dao.NewQuotaUsageDao(redis2)
+	iQuotaRepo := repo.NewQuotaRepo(iQuotaUsageDao)
+	iConfigRuntime, err := config.NewRuntime(ctx, configFactory)
+	if err != nil {
+		return nil, err
+	}
+	iQuota := service.NewQuota(iQuotaRepo, iConfigRuntime)
+	return iQuota, nil
+}
+
 // wire.go:
 
 var (
-	llmDomainSet = wire.NewSet(llmfactory.NewFactory, config.NewManage, config.NewRuntime, service.NewRuntime, service.NewManage, repo.NewRuntimeRepo, dao.NewModelRequestRecordDao, rpc.NewAuthRPCProvider)
+	llmDomainSet = wire.NewSet(llmfactory.NewFactory, config.NewManage, config.NewRuntime, service.NewRuntime, service.NewManage, service.NewUsage, service.NewQuota, repo.NewRuntimeRepo, repo.NewManageRepo, repo.NewQuotaRepo, dao.NewModelRequestRecordDao, dao.NewLlmModelDao, dao.NewResponseCacheDao, dao.NewQuotaUsageDao, rpc.NewAuthRPCProvider)
 	runtimeSet   = wire.NewSet(
 		NewRuntimeApplication,
 		llmDomainSet,
@@ -68,4 +115,9 @@ var (
 		NewManageApplication,
 		llmDomainSet,
 	)
+	openAPISet = wire.NewSet(
+		NewOpenAPIApplication,
+		llmDomainSet,
+	)
+	quotaSet = wire.NewSet(config.NewRuntime, service.NewQuota, repo.NewQuotaRepo, dao.NewQuotaUsageDao)
 )
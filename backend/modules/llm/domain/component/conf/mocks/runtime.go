@@ -11,7 +11,9 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
+	entity "github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -39,6 +41,35 @@ func (m *MockIConfigRuntime) EXPECT() *MockIConfigRuntimeMockRecorder {
 	return m.recorder
 }
 
+// GetQuotaConfig mocks base method.
+func (m *MockIConfigRuntime) GetQuotaConfig() *entity.QuotaConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaConfig")
+	ret0, _ := ret[0].(*entity.QuotaConfig)
+	return ret0
+}
+
+// GetQuotaConfig indicates an expected call of GetQuotaConfig.
+func (mr *MockIConfigRuntimeMockRecorder) GetQuotaConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaConfig", reflect.TypeOf((*MockIConfigRuntime)(nil).GetQuotaConfig))
+}
+
+// GetResponseCacheTTL mocks base method.
+func (m *MockIConfigRuntime) GetResponseCacheTTL(scenario *entity.Scenario) (time.Duration, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResponseCacheTTL", scenario)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetResponseCacheTTL indicates an expected call of GetResponseCacheTTL.
+func (mr *MockIConfigRuntimeMockRecorder) GetResponseCacheTTL(scenario any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResponseCacheTTL", reflect.TypeOf((*MockIConfigRuntime)(nil).GetResponseCacheTTL), scenario)
+}
+
 // NeedCvtURLToBase64 mocks base method.
 func (m *MockIConfigRuntime) NeedCvtURLToBase64() bool {
 	m.ctrl.T.Helper()
@@ -3,7 +3,17 @@
 
 package conf
 
+import (
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+)
+
 //go:generate mockgen -destination=mocks/runtime.go -package=mocks . IConfigRuntime
 type IConfigRuntime interface {
 	NeedCvtURLToBase64() bool
+	// GetResponseCacheTTL 场景下未开启响应缓存时返回false
+	GetResponseCacheTTL(scenario *entity.Scenario) (time.Duration, bool)
+	// GetQuotaConfig 未配置额度时返回nil
+	GetQuotaConfig() *entity.QuotaConfig
 }
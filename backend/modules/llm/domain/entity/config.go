@@ -3,8 +3,43 @@
 
 package entity
 
+import "time"
+
 type RuntimeConfig struct {
-	NeedCvtURLToBase64 bool   `json:"need_cvt_url_to_base_64" yaml:"need_cvt_url_to_base_64" mapstructure:"need_cvt_url_to_base_64"`
-	QianfanAk          string `json:"qianfan_ak" yaml:"qianfan_ak" mapstructure:"qianfan_ak"`
-	QianfanSk          string `json:"qianfan_sk" yaml:"qianfan_sk" mapstructure:"qianfan_sk"`
+	NeedCvtURLToBase64 bool                 `json:"need_cvt_url_to_base_64" yaml:"need_cvt_url_to_base_64" mapstructure:"need_cvt_url_to_base_64"`
+	QianfanAk          string               `json:"qianfan_ak" yaml:"qianfan_ak" mapstructure:"qianfan_ak"`
+	QianfanSk          string               `json:"qianfan_sk" yaml:"qianfan_sk" mapstructure:"qianfan_sk"`
+	ResponseCache      *ResponseCacheConfig `json:"response_cache" yaml:"response_cache" mapstructure:"response_cache"`
+	Quota              *QuotaConfig         `json:"quota" yaml:"quota" mapstructure:"quota"`
+}
+
+const DefaultResponseCacheTTL = 24 * time.Hour
+
+// ResponseCacheConfig 非流式请求的模型响应缓存，默认关闭，按场景开启
+type ResponseCacheConfig struct {
+	Scenarios map[Scenario]*ResponseCacheScenarioConfig `json:"scenarios" yaml:"scenarios" mapstructure:"scenarios"`
+}
+
+type ResponseCacheScenarioConfig struct {
+	Enable     bool  `json:"enable" yaml:"enable" mapstructure:"enable"`
+	TTLSeconds int64 `json:"ttl_seconds" yaml:"ttl_seconds" mapstructure:"ttl_seconds"` // 小于等于0时使用 DefaultResponseCacheTTL
+}
+
+// GetTTL 返回场景下的缓存时长，未开启时返回false
+func (c *ResponseCacheConfig) GetTTL(scenario *Scenario) (time.Duration, bool) {
+	if c == nil || c.Scenarios == nil {
+		return 0, false
+	}
+	s := ScenarioDefault
+	if scenario != nil {
+		s = *scenario
+	}
+	cfg, ok := c.Scenarios[s]
+	if !ok || cfg == nil || !cfg.Enable {
+		return 0, false
+	}
+	if cfg.TTLSeconds <= 0 {
+		return DefaultResponseCacheTTL, true
+	}
+	return time.Duration(cfg.TTLSeconds) * time.Second, true
 }
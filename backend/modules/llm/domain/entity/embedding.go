@@ -0,0 +1,57 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+type EmbeddingOptions struct {
+	// Dimensions is the number of dimensions of the output embeddings, only supported by some models.
+	Dimensions *int
+}
+
+type EmbeddingOption func(opts *EmbeddingOptions)
+
+func ApplyEmbeddingOptions(base *EmbeddingOptions, opts ...EmbeddingOption) *EmbeddingOptions {
+	if base == nil {
+		base = &EmbeddingOptions{}
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(base)
+		}
+	}
+	return base
+}
+
+// WithDimensions is the option to set the dimensions of the output embeddings.
+func WithDimensions(d int) EmbeddingOption {
+	return func(opts *EmbeddingOptions) {
+		opts.Dimensions = &d
+	}
+}
+
+// EmbeddingResult 向量化结果，Embeddings 与输入文本一一对应
+type EmbeddingResult struct {
+	Embeddings [][]float64
+	Usage      *TokenUsage
+}
+
+func (e *EmbeddingResult) GetInputToken() int {
+	if e == nil || e.Usage == nil {
+		return 0
+	}
+	return e.Usage.PromptTokens
+}
+
+func (e *EmbeddingResult) GetEmbeddings() [][]float64 {
+	if e == nil {
+		return nil
+	}
+	return e.Embeddings
+}
+
+func (e *EmbeddingResult) GetUsage() *TokenUsage {
+	if e == nil {
+		return nil
+	}
+	return e.Usage
+}
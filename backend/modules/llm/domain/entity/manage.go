@@ -4,6 +4,8 @@
 package entity
 
 import (
+	"context"
+	"slices"
 	"strconv"
 
 	"github.com/bytedance/sonic"
@@ -25,6 +27,7 @@ type Model struct {
 	ProtocolConfig  *ProtocolConfig              `json:"protocol_config" yaml:"protocol_config" mapstructure:"protocol_config"`    // 该模型的协议配置
 	ScenarioConfigs map[Scenario]*ScenarioConfig `json:"scenario_configs" yaml:"scenario_configs" mapstructure:"scenario_configs"` // 该模型的场景配置
 	ParamConfig     *ParamConfig                 `json:"param_config" yaml:"param_config" mapstructure:"param_config"`             // 该模型的参数配置
+	Price           *Price                       `json:"price" yaml:"price" mapstructure:"price"`                                  // 该模型的单价，用于统计调用成本
 }
 
 func (m *Model) Valid() error {
@@ -43,6 +46,17 @@ func (m *Model) Valid() error {
 	if err := m.ProtocolConfig.ValidProtocolConfig(m.Protocol); err != nil {
 		return err
 	}
+	if err := m.Price.ValidPrice(); err != nil {
+		return err
+	}
+	for scenario, cfg := range m.ScenarioConfigs {
+		if cfg == nil {
+			continue
+		}
+		if err := cfg.Routing.ValidRoutingPolicy(m.ID); err != nil {
+			return errors.WithMessagef(err, "scenario %s", scenario)
+		}
+	}
 	return nil
 }
 
@@ -109,6 +123,13 @@ func (m *Model) SupportFunctionCall() bool {
 	return m.Ability.FunctionCall
 }
 
+func (m *Model) SupportEmbedding() bool {
+	if m == nil || m.Ability == nil {
+		return false
+	}
+	return m.Ability.Embedding
+}
+
 func (m *Model) Available(scenario *Scenario) bool {
 	// 默认都是available
 	if scenario == nil || m.ScenarioConfigs == nil {
@@ -121,6 +142,14 @@ func (m *Model) Available(scenario *Scenario) bool {
 	return !scenarioConfig.Unavailable
 }
 
+// VisibleIn 配置文件中的模型对所有空间可见，数据库中的模型仅对所属空间可见
+func (m *Model) VisibleIn(spaceID int64) bool {
+	if m == nil {
+		return false
+	}
+	return m.WorkspaceID == 0 || m.WorkspaceID == spaceID
+}
+
 func (m *Model) GetScenarioConfig(scenario *Scenario) *ScenarioConfig {
 	if m.ScenarioConfigs == nil {
 		return nil
@@ -135,6 +164,14 @@ func (m *Model) GetScenarioConfig(scenario *Scenario) *ScenarioConfig {
 	return m.ScenarioConfigs[ScenarioDefault]
 }
 
+func (m *Model) GetRoutingPolicy(scenario *Scenario) *RoutingPolicy {
+	cfg := m.GetScenarioConfig(scenario)
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Routing
+}
+
 type Ability struct {
 	MaxContextTokens  *int64             `json:"max_context_tokens" yaml:"max_context_tokens" mapstructure:"max_context_tokens"`
 	MaxInputTokens    *int64             `json:"max_input_tokens" yaml:"max_input_tokens" mapstructure:"max_input_tokens"`
@@ -143,6 +180,7 @@ type Ability struct {
 	JsonMode          bool               `json:"json_mode" yaml:"json_mode" mapstructure:"json_mode"`
 	MultiModal        bool               `json:"multi_modal" yaml:"multi_modal" mapstructure:"multi_modal"`
 	AbilityMultiModal *AbilityMultiModal `json:"ability_multi_modal" yaml:"ability_multi_modal" mapstructure:"ability_multi_modal"`
+	Embedding         bool               `json:"embedding" yaml:"embedding" mapstructure:"embedding"` // 是否为向量化模型
 }
 
 type AbilityMultiModal struct {
@@ -244,6 +282,8 @@ type ScenarioConfig struct {
 	Scenario    Scenario `json:"scenario" yaml:"scenario" mapstructure:"scenario"`
 	Quota       *Quota   `json:"quota" yaml:"quota" mapstructure:"quota"`
 	Unavailable bool     `json:"unavailable" yaml:"unavailable" mapstructure:"unavailable"`
+	// Routing 该场景下的路由策略，为空时只请求当前模型
+	Routing *RoutingPolicy `json:"routing" yaml:"routing" mapstructure:"routing"`
 }
 
 type Quota struct {
@@ -251,6 +291,79 @@ type Quota struct {
 	Tpm int64 `json:"tpm" yaml:"tpm" mapstructure:"tpm"`
 }
 
+// Price 每百万 token 的单价，所有模型使用同一币种
+type Price struct {
+	InputPrice  float64 `json:"input_price" yaml:"input_price" mapstructure:"input_price"`
+	OutputPrice float64 `json:"output_price" yaml:"output_price" mapstructure:"output_price"`
+}
+
+func (p *Price) ValidPrice() error {
+	if p == nil {
+		return nil
+	}
+	if p.InputPrice < 0 || p.OutputPrice < 0 {
+		return errors.Errorf("price must not be negative")
+	}
+	return nil
+}
+
+// Cost 计算一次调用的成本，未配置单价时为0
+func (p *Price) Cost(inputTokens, outputTokens int64) float64 {
+	if p == nil {
+		return 0
+	}
+	return (float64(inputTokens)*p.InputPrice + float64(outputTokens)*p.OutputPrice) / 1e6
+}
+
+// RoutingPolicy 主模型与 Balance 中的模型按权重分流，请求失败且错误类型命中 FallbackOn 时，
+// 依次尝试同组的其他模型，再按顺序尝试 Fallbacks
+type RoutingPolicy struct {
+	Weight     int64            `json:"weight" yaml:"weight" mapstructure:"weight"` // 主模型的权重，小于等于0时视为1
+	Balance    []*RoutingTarget `json:"balance" yaml:"balance" mapstructure:"balance"`
+	Fallbacks  []*RoutingTarget `json:"fallbacks" yaml:"fallbacks" mapstructure:"fallbacks"`
+	FallbackOn []ModelErrorType `json:"fallback_on" yaml:"fallback_on" mapstructure:"fallback_on"` // 为空时限流、超时、服务端错误均触发降级
+}
+
+// CandidateAdmitter 路由到主模型以外的候选模型前的准入校验，如候选模型自身的限流与额度，返回错误时跳过该候选模型
+type CandidateAdmitter func(ctx context.Context, candidate *Model) error
+
+type RoutingTarget struct {
+	ModelID int64 `json:"model_id" yaml:"model_id" mapstructure:"model_id"`
+	Weight  int64 `json:"weight" yaml:"weight" mapstructure:"weight"` // 仅对 Balance 生效，小于等于0时视为1
+}
+
+func (p *RoutingPolicy) ValidRoutingPolicy(modelID int64) error {
+	if p == nil {
+		return nil
+	}
+	for _, target := range append(slices.Clone(p.Balance), p.Fallbacks...) {
+		if target == nil || target.ModelID <= 0 {
+			return errors.Errorf("routing target model id is invalid")
+		}
+		if target.ModelID == modelID {
+			return errors.Errorf("routing target can not be the model itself")
+		}
+	}
+	for _, errType := range p.FallbackOn {
+		switch errType {
+		case ModelErrorTypeRateLimit, ModelErrorTypeTimeout, ModelErrorTypeServerError:
+		default:
+			return errors.Errorf("fallback error type %s is not supported", errType)
+		}
+	}
+	return nil
+}
+
+func (p *RoutingPolicy) ShouldFallback(errType ModelErrorType) bool {
+	if p == nil || errType == "" {
+		return false
+	}
+	if len(p.FallbackOn) == 0 {
+		return true
+	}
+	return slices.Contains(p.FallbackOn, errType)
+}
+
 type ParamConfig struct {
 	ParamSchemas []*ParamSchema `json:"param_schemas" yaml:"param_schemas" mapstructure:"param_schemas"`
 }
@@ -364,6 +477,15 @@ const (
 	ProtocolArkBot   Protocol = "arkbot"
 )
 
+// ModelErrorType 模型请求失败的错误类型，用于判断是否需要降级
+type ModelErrorType string
+
+const (
+	ModelErrorTypeRateLimit   ModelErrorType = "rate_limit"
+	ModelErrorTypeTimeout     ModelErrorType = "timeout"
+	ModelErrorTypeServerError ModelErrorType = "server_error"
+)
+
 type ListModelReq struct {
 	WorkspaceID *int64
 	Scenario    *Scenario
@@ -375,3 +497,18 @@ type GetModelReq struct {
 	WorkspaceID *int64
 	ModelID     int64
 }
+
+type UpdateModelReq struct {
+	WorkspaceID int64
+	ModelID     int64
+	UserID      string
+
+	Name            *string
+	Desc            *string
+	Ability         *Ability
+	Protocol        *Protocol
+	ProtocolConfig  *ProtocolConfig
+	ScenarioConfigs map[Scenario]*ScenarioConfig
+	ParamConfig     *ParamConfig
+	Price           *Price
+}
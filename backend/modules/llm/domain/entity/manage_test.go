@@ -225,6 +225,55 @@ func TestModel_Valid(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "routing to itself",
+			fields: fields{
+				model: &Model{
+					ID: 1, Name: "name",
+					Protocol:       ProtocolArk,
+					ProtocolConfig: &ProtocolConfig{},
+					ScenarioConfigs: map[Scenario]*ScenarioConfig{
+						ScenarioDefault: {Routing: &RoutingPolicy{Fallbacks: []*RoutingTarget{{ModelID: 1}}}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "routing with unknown error type",
+			fields: fields{
+				model: &Model{
+					ID: 1, Name: "name",
+					Protocol:       ProtocolArk,
+					ProtocolConfig: &ProtocolConfig{},
+					ScenarioConfigs: map[Scenario]*ScenarioConfig{
+						ScenarioDefault: {Routing: &RoutingPolicy{
+							Fallbacks:  []*RoutingTarget{{ModelID: 2}},
+							FallbackOn: []ModelErrorType{"unknown"},
+						}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "routing is valid",
+			fields: fields{
+				model: &Model{
+					ID: 1, Name: "name",
+					Protocol:       ProtocolArk,
+					ProtocolConfig: &ProtocolConfig{},
+					ScenarioConfigs: map[Scenario]*ScenarioConfig{
+						ScenarioDefault: {Routing: &RoutingPolicy{
+							Balance:    []*RoutingTarget{{ModelID: 2, Weight: 2}},
+							Fallbacks:  []*RoutingTarget{{ModelID: 3}},
+							FallbackOn: []ModelErrorType{ModelErrorTypeRateLimit},
+						}},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -233,6 +282,32 @@ func TestModel_Valid(t *testing.T) {
 	}
 }
 
+func TestPrice(t *testing.T) {
+	var nilPrice *Price
+	assert.Nil(t, nilPrice.ValidPrice())
+	assert.Equal(t, float64(0), nilPrice.Cost(1000, 1000))
+
+	price := &Price{InputPrice: 2, OutputPrice: 8}
+	assert.Nil(t, price.ValidPrice())
+	assert.InDelta(t, 0.01, price.Cost(1000, 1000), 1e-9)
+
+	assert.NotNil(t, (&Price{InputPrice: -1}).ValidPrice())
+}
+
+func TestRoutingPolicy_ShouldFallback(t *testing.T) {
+	var nilPolicy *RoutingPolicy
+	assert.False(t, nilPolicy.ShouldFallback(ModelErrorTypeRateLimit))
+
+	policy := &RoutingPolicy{}
+	assert.True(t, policy.ShouldFallback(ModelErrorTypeRateLimit))
+	assert.True(t, policy.ShouldFallback(ModelErrorTypeServerError))
+	assert.False(t, policy.ShouldFallback(""))
+
+	policy = &RoutingPolicy{FallbackOn: []ModelErrorType{ModelErrorTypeTimeout}}
+	assert.True(t, policy.ShouldFallback(ModelErrorTypeTimeout))
+	assert.False(t, policy.ShouldFallback(ModelErrorTypeRateLimit))
+}
+
 func TestGetModel(t *testing.T) {
 	type fields struct {
 		model *Model
@@ -17,8 +17,12 @@ type ModelRequestRecord struct {
 	ModelAk             string    `json:"model_ak"`
 	ModelID             string    `json:"model_id"`
 	ModelName           string    `json:"model_name"`
+	OriginModelID       string    `json:"origin_model_id"` // 路由前请求的模型，与 ModelID 不同时说明发生了降级或分流
+	CacheHit            bool      `json:"cache_hit"`       // 命中响应缓存时未实际请求模型
 	InputToken          int64     `json:"input_token"`
 	OutputToken         int64     `json:"output_token"`
+	Cost                float64   `json:"cost"`       // 按模型单价计算的成本，命中响应缓存时为0
+	LatencyMs           int64     `json:"latency_ms"` // 请求模型的耗时
 	Logid               string    `json:"logid"`
 	ErrorCode           string    `json:"error_code"`
 	ErrorMsg            *string   `json:"error_msg"`
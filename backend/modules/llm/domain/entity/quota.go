@@ -0,0 +1,146 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"time"
+)
+
+// QuotaPeriod 额度的统计周期，按服务所在时区的自然日、自然月重置
+type QuotaPeriod string
+
+const (
+	QuotaPeriodDay   QuotaPeriod = "day"
+	QuotaPeriodMonth QuotaPeriod = "month"
+)
+
+func (p QuotaPeriod) Valid() bool {
+	switch p {
+	case QuotaPeriodDay, QuotaPeriodMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+// Range 返回 t 所在周期的起止时间，左闭右开
+func (p QuotaPeriod) Range(t time.Time) (start, end time.Time) {
+	switch p {
+	case QuotaPeriodMonth:
+		start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		return start, start.AddDate(0, 1, 0)
+	default:
+		start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		return start, start.AddDate(0, 0, 1)
+	}
+}
+
+// QuotaConfig 空间与模型的 token、成本额度，未配置时不限制
+type QuotaConfig struct {
+	Rules []*QuotaRule `json:"rules" yaml:"rules" mapstructure:"rules"`
+}
+
+// QuotaRule 一条额度规则。WorkspaceID 为0时作为每个空间各自的默认额度，空间单独配置了相同模型与周期的额度时以空间的配置为准；
+// ModelID 为0时统计空间内所有模型的用量，否则只统计请求该模型的用量
+type QuotaRule struct {
+	WorkspaceID int64       `json:"workspace_id" yaml:"workspace_id" mapstructure:"workspace_id"`
+	ModelID     int64       `json:"model_id" yaml:"model_id" mapstructure:"model_id"`
+	Period      QuotaPeriod `json:"period" yaml:"period" mapstructure:"period"`
+	MaxTokens   int64       `json:"max_tokens" yaml:"max_tokens" mapstructure:"max_tokens"` // 输入与输出 token 之和，小于等于0时不限制
+	MaxCost     float64     `json:"max_cost" yaml:"max_cost" mapstructure:"max_cost"`       // 与模型单价使用同一币种，小于等于0时不限制
+}
+
+// GetModelRules 返回请求空间内某个模型时生效的额度，modelID 为0时只返回空间级额度
+func (c *QuotaConfig) GetModelRules(spaceID, modelID int64) []*QuotaRule {
+	return c.getRules(spaceID, func(r *QuotaRule) bool {
+		return r.ModelID == 0 || r.ModelID == modelID
+	})
+}
+
+// GetSpaceRules 返回空间内生效的所有额度
+func (c *QuotaConfig) GetSpaceRules(spaceID int64) []*QuotaRule {
+	return c.getRules(spaceID, func(r *QuotaRule) bool {
+		return true
+	})
+}
+
+func (c *QuotaConfig) getRules(spaceID int64, match func(r *QuotaRule) bool) []*QuotaRule {
+	if c == nil || spaceID <= 0 {
+		return nil
+	}
+	type scope struct {
+		modelID int64
+		period  QuotaPeriod
+	}
+	var scopes []scope
+	matched := make(map[scope]*QuotaRule)
+	for _, r := range c.Rules {
+		if r == nil || !r.Period.Valid() || !match(r) {
+			continue
+		}
+		if r.WorkspaceID != 0 && r.WorkspaceID != spaceID {
+			continue
+		}
+		s := scope{modelID: r.ModelID, period: r.Period}
+		exist, ok := matched[s]
+		if !ok {
+			scopes = append(scopes, s)
+			matched[s] = r
+			continue
+		}
+		if exist.WorkspaceID == 0 && r.WorkspaceID != 0 {
+			matched[s] = r
+		}
+	}
+	rules := make([]*QuotaRule, 0, len(scopes))
+	for _, s := range scopes {
+		rules = append(rules, matched[s])
+	}
+	return rules
+}
+
+// QuotaUsageKey 标识空间内某个模型在一个周期内的用量计数，ModelID 为0时表示空间内所有模型
+type QuotaUsageKey struct {
+	SpaceID     int64
+	ModelID     int64
+	Period      QuotaPeriod
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+}
+
+func NewQuotaUsageKey(spaceID, modelID int64, period QuotaPeriod, t time.Time) *QuotaUsageKey {
+	start, end := period.Range(t)
+	return &QuotaUsageKey{
+		SpaceID:     spaceID,
+		ModelID:     modelID,
+		Period:      period,
+		PeriodStart: start,
+		PeriodEnd:   end,
+	}
+}
+
+type QuotaUsage struct {
+	Tokens int64
+	Cost   float64
+}
+
+// QuotaStatus 一条额度规则在当前周期内的使用情况
+type QuotaStatus struct {
+	SpaceID    int64
+	ModelID    int64
+	Period     QuotaPeriod
+	MaxTokens  int64
+	UsedTokens int64
+	MaxCost    float64
+	UsedCost   float64
+	ResetAt    time.Time
+}
+
+func (s *QuotaStatus) TokenExceeded() bool {
+	return s.MaxTokens > 0 && s.UsedTokens >= s.MaxTokens
+}
+
+func (s *QuotaStatus) CostExceeded() bool {
+	return s.MaxCost > 0 && s.UsedCost >= s.MaxCost
+}
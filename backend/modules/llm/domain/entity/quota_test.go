@@ -0,0 +1,42 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaPeriod_Range(t *testing.T) {
+	now := time.Date(2025, 12, 31, 15, 4, 5, 0, time.Local)
+
+	start, end := QuotaPeriodDay.Range(now)
+	assert.Equal(t, time.Date(2025, 12, 31, 0, 0, 0, 0, time.Local), start)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local), end)
+
+	start, end = QuotaPeriodMonth.Range(now)
+	assert.Equal(t, time.Date(2025, 12, 1, 0, 0, 0, 0, time.Local), start)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local), end)
+}
+
+func TestQuotaConfig_GetRules(t *testing.T) {
+	defaultDaily := &QuotaRule{Period: QuotaPeriodDay, MaxTokens: 1000}
+	spaceDaily := &QuotaRule{WorkspaceID: 1, Period: QuotaPeriodDay, MaxTokens: 5000}
+	modelMonthly := &QuotaRule{ModelID: 10, Period: QuotaPeriodMonth, MaxCost: 100}
+	otherSpaceModel := &QuotaRule{WorkspaceID: 2, ModelID: 10, Period: QuotaPeriodDay, MaxTokens: 10}
+	invalid := &QuotaRule{Period: "week", MaxTokens: 1}
+	cfg := &QuotaConfig{Rules: []*QuotaRule{defaultDaily, modelMonthly, spaceDaily, otherSpaceModel, invalid}}
+
+	// 空间单独配置的额度覆盖默认额度
+	assert.Equal(t, []*QuotaRule{spaceDaily, modelMonthly}, cfg.GetModelRules(1, 10))
+	assert.Equal(t, []*QuotaRule{spaceDaily}, cfg.GetModelRules(1, 11))
+	assert.Equal(t, []*QuotaRule{spaceDaily}, cfg.GetModelRules(1, 0))
+	assert.Equal(t, []*QuotaRule{defaultDaily, modelMonthly, otherSpaceModel}, cfg.GetModelRules(2, 10))
+	assert.Equal(t, []*QuotaRule{defaultDaily, modelMonthly, otherSpaceModel}, cfg.GetSpaceRules(2))
+
+	var nilCfg *QuotaConfig
+	assert.Nil(t, nilCfg.GetModelRules(1, 10))
+}
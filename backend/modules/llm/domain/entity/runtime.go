@@ -39,6 +39,10 @@ func (m *Message) GetOutputToken() int {
 	return m.ResponseMeta.Usage.CompletionTokens
 }
 
+func (m *Message) IsCacheHit() bool {
+	return m != nil && m.ResponseMeta != nil && m.ResponseMeta.CacheHit
+}
+
 func (m *Message) HasMultiModalContent() bool {
 	if m == nil || len(m.MultiModalContent) == 0 {
 		return false
@@ -168,6 +172,8 @@ type ResponseMeta struct {
 	FinishReason string `json:"finish_reason,omitempty"`
 	// Usage is the token usage of the chat response, whether usage exists depends on whether the chat model implementation returns.
 	Usage *TokenUsage `json:"usage,omitempty"`
+	// CacheHit is true if the response is returned from the response cache rather than the model.
+	CacheHit bool `json:"cache_hit,omitempty"`
 }
 
 // TokenUsage Represents the token usage of chat model request.
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// UsageDimension 模型调用统计的分组维度
+type UsageDimension string
+
+const (
+	UsageDimensionWorkspace UsageDimension = "workspace"
+	UsageDimensionUser      UsageDimension = "user"
+	UsageDimensionModel     UsageDimension = "model"
+	UsageDimensionScenario  UsageDimension = "scenario"
+)
+
+func (d UsageDimension) Valid() bool {
+	switch d {
+	case UsageDimensionWorkspace, UsageDimensionUser, UsageDimensionModel, UsageDimensionScenario:
+		return true
+	default:
+		return false
+	}
+}
+
+// UsageGranularity 模型调用统计的时间分桶粒度
+type UsageGranularity string
+
+const (
+	UsageGranularityHour  UsageGranularity = "hour"
+	UsageGranularityDay   UsageGranularity = "day"
+	UsageGranularityMonth UsageGranularity = "month"
+)
+
+func (g UsageGranularity) Valid() bool {
+	switch g {
+	case UsageGranularityHour, UsageGranularityDay, UsageGranularityMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+type ModelUsageQuery struct {
+	SpaceIDs  []int64
+	StartTime time.Time // 包含
+	EndTime   time.Time // 不包含
+	GroupBy   []UsageDimension
+	// Granularity 为空时不按时间分桶
+	Granularity *UsageGranularity
+
+	UserID   *string
+	ModelID  *int64
+	Scenario *Scenario
+}
+
+func (q *ModelUsageQuery) GroupByDimension(d UsageDimension) bool {
+	return slices.Contains(q.GroupBy, d)
+}
+
+// ModelUsageStat 一组模型调用的聚合统计，未参与分组的维度为空
+type ModelUsageStat struct {
+	SpaceID    *int64
+	UserID     *string
+	ModelID    *int64
+	ModelName  *string
+	Scenario   *Scenario
+	TimeBucket *time.Time // 时间桶的起始时间
+
+	RequestCount  int64
+	ErrorCount    int64
+	CacheHitCount int64
+	InputToken    int64
+	OutputToken   int64
+	Cost          float64
+	AvgLatencyMs  float64
+}
+
+func (s *ModelUsageStat) ErrorRate() float64 {
+	if s == nil || s.RequestCount == 0 {
+		return 0
+	}
+	return float64(s.ErrorCount) / float64(s.RequestCount)
+}
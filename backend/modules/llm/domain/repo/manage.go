@@ -0,0 +1,20 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/manage.go -package=mocks . IManageRepo
+type IManageRepo interface {
+	CreateModel(ctx context.Context, model *entity.Model, userID string) (err error)
+	UpdateModel(ctx context.Context, model *entity.Model, userID string) (err error)
+	DeleteModel(ctx context.Context, modelID int64) (err error)
+	// GetModel 模型不存在时返回 nil
+	GetModel(ctx context.Context, modelID int64) (model *entity.Model, err error)
+	ListModels(ctx context.Context, spaceID int64) (models []*entity.Model, err error)
+}
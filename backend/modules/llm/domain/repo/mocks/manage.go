@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo (interfaces: IManageRepo)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/manage.go -package=mocks . IManageRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIManageRepo is a mock of IManageRepo interface.
+type MockIManageRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIManageRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIManageRepoMockRecorder is the mock recorder for MockIManageRepo.
+type MockIManageRepoMockRecorder struct {
+	mock *MockIManageRepo
+}
+
+// NewMockIManageRepo creates a new mock instance.
+func NewMockIManageRepo(ctrl *gomock.Controller) *MockIManageRepo {
+	mock := &MockIManageRepo{ctrl: ctrl}
+	mock.recorder = &MockIManageRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIManageRepo) EXPECT() *MockIManageRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateModel mocks base method.
+func (m *MockIManageRepo) CreateModel(ctx context.Context, model *entity.Model, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModel", ctx, model, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateModel indicates an expected call of CreateModel.
+func (mr *MockIManageRepoMockRecorder) CreateModel(ctx, model, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModel", reflect.TypeOf((*MockIManageRepo)(nil).CreateModel), ctx, model, userID)
+}
+
+// DeleteModel mocks base method.
+func (m *MockIManageRepo) DeleteModel(ctx context.Context, modelID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModel", ctx, modelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteModel indicates an expected call of DeleteModel.
+func (mr *MockIManageRepoMockRecorder) DeleteModel(ctx, modelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModel", reflect.TypeOf((*MockIManageRepo)(nil).DeleteModel), ctx, modelID)
+}
+
+// GetModel mocks base method.
+func (m *MockIManageRepo) GetModel(ctx context.Context, modelID int64) (*entity.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModel", ctx, modelID)
+	ret0, _ := ret[0].(*entity.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModel indicates an expected call of GetModel.
+func (mr *MockIManageRepoMockRecorder) GetModel(ctx, modelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModel", reflect.TypeOf((*MockIManageRepo)(nil).GetModel), ctx, modelID)
+}
+
+// ListModels mocks base method.
+func (m *MockIManageRepo) ListModels(ctx context.Context, spaceID int64) ([]*entity.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModels", ctx, spaceID)
+	ret0, _ := ret[0].([]*entity.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModels indicates an expected call of ListModels.
+func (mr *MockIManageRepoMockRecorder) ListModels(ctx, spaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockIManageRepo)(nil).ListModels), ctx, spaceID)
+}
+
+// UpdateModel mocks base method.
+func (m *MockIManageRepo) UpdateModel(ctx context.Context, model *entity.Model, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModel", ctx, model, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateModel indicates an expected call of UpdateModel.
+func (mr *MockIManageRepoMockRecorder) UpdateModel(ctx, model, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModel", reflect.TypeOf((*MockIManageRepo)(nil).UpdateModel), ctx, model, userID)
+}
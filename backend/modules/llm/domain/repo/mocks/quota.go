@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo (interfaces: IQuotaRepo)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/quota.go -package=mocks . IQuotaRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIQuotaRepo is a mock of IQuotaRepo interface.
+type MockIQuotaRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIQuotaRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIQuotaRepoMockRecorder is the mock recorder for MockIQuotaRepo.
+type MockIQuotaRepoMockRecorder struct {
+	mock *MockIQuotaRepo
+}
+
+// NewMockIQuotaRepo creates a new mock instance.
+func NewMockIQuotaRepo(ctrl *gomock.Controller) *MockIQuotaRepo {
+	mock := &MockIQuotaRepo{ctrl: ctrl}
+	mock.recorder = &MockIQuotaRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIQuotaRepo) EXPECT() *MockIQuotaRepoMockRecorder {
+	return m.recorder
+}
+
+// IncrQuotaUsages mocks base method.
+func (m *MockIQuotaRepo) IncrQuotaUsages(ctx context.Context, keys []*entity.QuotaUsageKey, usage *entity.QuotaUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrQuotaUsages", ctx, keys, usage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrQuotaUsages indicates an expected call of IncrQuotaUsages.
+func (mr *MockIQuotaRepoMockRecorder) IncrQuotaUsages(ctx, keys, usage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrQuotaUsages", reflect.TypeOf((*MockIQuotaRepo)(nil).IncrQuotaUsages), ctx, keys, usage)
+}
+
+// MGetQuotaUsages mocks base method.
+func (m *MockIQuotaRepo) MGetQuotaUsages(ctx context.Context, keys []*entity.QuotaUsageKey) ([]*entity.QuotaUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MGetQuotaUsages", ctx, keys)
+	ret0, _ := ret[0].([]*entity.QuotaUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MGetQuotaUsages indicates an expected call of MGetQuotaUsages.
+func (mr *MockIQuotaRepoMockRecorder) MGetQuotaUsages(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetQuotaUsages", reflect.TypeOf((*MockIQuotaRepo)(nil).MGetQuotaUsages), ctx, keys)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockIRuntimeRepo) EXPECT() *MockIRuntimeRepoMockRecorder {
 	return m.recorder
 }
 
+// AggregateModelRequestRecords mocks base method.
+func (m *MockIRuntimeRepo) AggregateModelRequestRecords(ctx context.Context, query *entity.ModelUsageQuery, limit int) ([]*entity.ModelUsageStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateModelRequestRecords", ctx, query, limit)
+	ret0, _ := ret[0].([]*entity.ModelUsageStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateModelRequestRecords indicates an expected call of AggregateModelRequestRecords.
+func (mr *MockIRuntimeRepoMockRecorder) AggregateModelRequestRecords(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateModelRequestRecords", reflect.TypeOf((*MockIRuntimeRepo)(nil).AggregateModelRequestRecords), ctx, query, limit)
+}
+
 // CreateModelRequestRecord mocks base method.
 func (m *MockIRuntimeRepo) CreateModelRequestRecord(ctx context.Context, record *entity.ModelRequestRecord) error {
 	m.ctrl.T.Helper()
@@ -54,3 +70,32 @@ func (mr *MockIRuntimeRepoMockRecorder) CreateModelRequestRecord(ctx, record any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModelRequestRecord", reflect.TypeOf((*MockIRuntimeRepo)(nil).CreateModelRequestRecord), ctx, record)
 }
+
+// GetResponseCache mocks base method.
+func (m *MockIRuntimeRepo) GetResponseCache(ctx context.Context, key string) (*entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResponseCache", ctx, key)
+	ret0, _ := ret[0].(*entity.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResponseCache indicates an expected call of GetResponseCache.
+func (mr *MockIRuntimeRepoMockRecorder) GetResponseCache(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResponseCache", reflect.TypeOf((*MockIRuntimeRepo)(nil).GetResponseCache), ctx, key)
+}
+
+// SetResponseCache mocks base method.
+func (m *MockIRuntimeRepo) SetResponseCache(ctx context.Context, key string, msg *entity.Message, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetResponseCache", ctx, key, msg, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetResponseCache indicates an expected call of SetResponseCache.
+func (mr *MockIRuntimeRepoMockRecorder) SetResponseCache(ctx, key, msg, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResponseCache", reflect.TypeOf((*MockIRuntimeRepo)(nil).SetResponseCache), ctx, key, msg, ttl)
+}
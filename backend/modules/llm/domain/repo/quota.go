@@ -0,0 +1,18 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/quota.go -package=mocks . IQuotaRepo
+type IQuotaRepo interface {
+	// MGetQuotaUsages 按 keys 顺序返回，计数不存在时用量为0
+	MGetQuotaUsages(ctx context.Context, keys []*entity.QuotaUsageKey) (usages []*entity.QuotaUsage, err error)
+	// IncrQuotaUsages 将同一份用量累加到每个计数上，计数在周期结束后过期
+	IncrQuotaUsages(ctx context.Context, keys []*entity.QuotaUsageKey, usage *entity.QuotaUsage) (err error)
+}
@@ -5,6 +5,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
 )
@@ -12,4 +13,9 @@ import (
 //go:generate mockgen -destination=mocks/runtime.go -package=mocks . IRuntimeRepo
 type IRuntimeRepo interface {
 	CreateModelRequestRecord(ctx context.Context, record *entity.ModelRequestRecord) (err error)
+	// AggregateModelRequestRecords 最多返回 limit 组统计，按时间桶升序、请求数降序排列
+	AggregateModelRequestRecords(ctx context.Context, query *entity.ModelUsageQuery, limit int) (stats []*entity.ModelUsageStat, err error)
+	// GetResponseCache 缓存不存在时返回nil
+	GetResponseCache(ctx context.Context, key string) (msg *entity.Message, err error)
+	SetResponseCache(ctx context.Context, key string, msg *entity.Message, ttl time.Duration) (err error)
 }
@@ -15,17 +15,34 @@ func NewRuntime(
 	idGen idgen.IIDGenerator,
 	runtimeRepo repo.IRuntimeRepo,
 	cfg conf.IConfigRuntime,
+	manageSrv IManage,
 ) IRuntime {
 	return &RuntimeImpl{
 		llmFact:     llmFact,
 		idGen:       idGen,
 		runtimeRepo: runtimeRepo,
 		runtimeCfg:  cfg,
+		manageSrv:   manageSrv,
 	}
 }
 
-func NewManage(cfg conf.IConfigManage) IManage {
+func NewManage(cfg conf.IConfigManage, manageRepo repo.IManageRepo, idGen idgen.IIDGenerator) IManage {
 	return &ManageImpl{
-		conf: cfg,
+		conf:       cfg,
+		manageRepo: manageRepo,
+		idGen:      idGen,
+	}
+}
+
+func NewUsage(runtimeRepo repo.IRuntimeRepo) IUsage {
+	return &UsageImpl{
+		runtimeRepo: runtimeRepo,
+	}
+}
+
+func NewQuota(quotaRepo repo.IQuotaRepo, runtimeCfg conf.IConfigRuntime) IQuota {
+	return &QuotaImpl{
+		quotaRepo:  quotaRepo,
+		runtimeCfg: runtimeCfg,
 	}
 }
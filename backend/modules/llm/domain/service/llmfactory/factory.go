@@ -17,6 +17,7 @@ import (
 //go:generate mockgen -destination=mocks/factory.go -package=mocks . IFactory
 type IFactory interface {
 	CreateLLM(ctx context.Context, model *entity.Model, opts ...entity.Option) (llminterface.ILLM, error)
+	CreateEmbedder(ctx context.Context, model *entity.Model, opts ...entity.EmbeddingOption) (llminterface.IEmbedder, error)
 }
 
 type FactoryImpl struct{}
@@ -38,6 +39,19 @@ func (f *FactoryImpl) CreateLLM(ctx context.Context, model *entity.Model, opts .
 	}
 }
 
+func (f *FactoryImpl) CreateEmbedder(ctx context.Context, model *entity.Model, opts ...entity.EmbeddingOption) (llminterface.IEmbedder, error) {
+	frame, err := f.getFrameByModel(model)
+	if err != nil {
+		return nil, err
+	}
+	switch frame {
+	case entity.FrameEino:
+		return eino.NewEmbedder(ctx, model, opts...)
+	default:
+		return nil, errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(fmt.Sprintf("[CreateEmbedder] frame:%s is not supported", frame)))
+	}
+}
+
 func (f *FactoryImpl) getFrameByModel(model *entity.Model) (entity.Frame, error) {
 	if model == nil {
 		return "", errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg("[getFrameByModel] model is nil"))
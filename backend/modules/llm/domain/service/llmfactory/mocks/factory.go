@@ -42,6 +42,26 @@ func (m *MockIFactory) EXPECT() *MockIFactoryMockRecorder {
 	return m.recorder
 }
 
+// CreateEmbedder mocks base method.
+func (m *MockIFactory) CreateEmbedder(ctx context.Context, model *entity.Model, opts ...entity.EmbeddingOption) (llminterface.IEmbedder, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, model}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEmbedder", varargs...)
+	ret0, _ := ret[0].(llminterface.IEmbedder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEmbedder indicates an expected call of CreateEmbedder.
+func (mr *MockIFactoryMockRecorder) CreateEmbedder(ctx, model any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, model}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmbedder", reflect.TypeOf((*MockIFactory)(nil).CreateEmbedder), varargs...)
+}
+
 // CreateLLM mocks base method.
 func (m *MockIFactory) CreateLLM(ctx context.Context, model *entity.Model, opts ...entity.Option) (llminterface.ILLM, error) {
 	m.ctrl.T.Helper()
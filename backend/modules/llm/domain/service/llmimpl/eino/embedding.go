@@ -0,0 +1,298 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eino
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	acl_openai "github.com/cloudwego/eino-ext/libs/acl/openai"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	einoEmbedding "github.com/cloudwego/eino/components/embedding"
+	"github.com/ollama/ollama/api"
+	"github.com/pkg/errors"
+	"github.com/volcengine/volcengine-go-sdk/service/arkruntime"
+	arkmodel "github.com/volcengine/volcengine-go-sdk/service/arkruntime/model"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+const (
+	defaultQwenBaseURL   = "https://dashscope.aliyuncs.com/compatible-mode/v1"
+	defaultOllamaBaseURL = "http://localhost:11434"
+)
+
+// embeddingBatchSizes 各协议单次请求允许的最大文本数，超出时拆分为多次请求，未配置的协议不拆分
+var embeddingBatchSizes = map[entity.Protocol]int{
+	entity.ProtocolOpenAI: 2048,
+	entity.ProtocolArk:    256,
+	entity.ProtocolQwen:   10,
+}
+
+type Embedder struct {
+	protocol  entity.Protocol
+	batchSize int
+	embedder  IEinoEmbedder
+}
+
+//go:generate mockgen -destination=mocks/embedder.go -package=mocks . IEinoEmbedder
+type IEinoEmbedder interface {
+	einoEmbedding.Embedder
+}
+
+func NewEmbedder(ctx context.Context, model *entity.Model, opts ...entity.EmbeddingOption) (*Embedder, error) {
+	// 根据protocol导航到不同的builder
+	var err error
+	var embedder einoEmbedding.Embedder
+	switch model.Protocol {
+	case entity.ProtocolOpenAI:
+		embedder, err = openAIEmbedderBuilder(ctx, model, opts...)
+	case entity.ProtocolQwen:
+		embedder, err = qwenEmbedderBuilder(ctx, model, opts...)
+	case entity.ProtocolArk:
+		embedder, err = arkEmbedderBuilder(ctx, model, opts...)
+	case entity.ProtocolOllama:
+		embedder, err = ollamaEmbedderBuilder(ctx, model, opts...)
+	default:
+		err = errors.Errorf("eino embedding unsupport the protocol:%s", model.Protocol)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Embedder{
+		protocol:  model.Protocol,
+		batchSize: embeddingBatchSizes[model.Protocol],
+		embedder:  embedder,
+	}, nil
+}
+
+func (e *Embedder) EmbedStrings(ctx context.Context, texts []string) (*entity.EmbeddingResult, error) {
+	res := &entity.EmbeddingResult{
+		Embeddings: make([][]float64, 0, len(texts)),
+		Usage:      &entity.TokenUsage{},
+	}
+	for _, batch := range splitTexts(texts, e.batchSize) {
+		// eino 的 embedder 通过回调上报 token 用量
+		var usage *einoEmbedding.TokenUsage
+		handler := callbacks.NewHandlerBuilder().OnEndFn(func(ctx context.Context, _ *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+			if out := einoEmbedding.ConvCallbackOutput(output); out != nil && out.TokenUsage != nil {
+				usage = out.TokenUsage
+			}
+			return ctx
+		}).Build()
+		cbCtx := callbacks.InitCallbacks(ctx, &callbacks.RunInfo{Type: string(e.protocol), Component: components.ComponentOfEmbedding}, handler)
+		vectors, err := e.embedder.EmbedStrings(cbCtx, batch)
+		if err != nil {
+			return nil, errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg(err.Error()))
+		}
+		if len(vectors) != len(batch) {
+			return nil, errorx.NewByCode(llm_errorx.ParseModelRespFailedCode,
+				errorx.WithExtraMsg(fmt.Sprintf("expect %d embeddings, got %d", len(batch), len(vectors))))
+		}
+		res.Embeddings = append(res.Embeddings, vectors...)
+		if usage != nil {
+			res.Usage.PromptTokens += usage.PromptTokens
+			res.Usage.CompletionTokens += usage.CompletionTokens
+			res.Usage.TotalTokens += usage.TotalTokens
+		}
+	}
+	return res, nil
+}
+
+func splitTexts(texts []string, batchSize int) [][]string {
+	if batchSize <= 0 || len(texts) <= batchSize {
+		return [][]string{texts}
+	}
+	batches := make([][]string, 0, (len(texts)+batchSize-1)/batchSize)
+	for start := 0; start < len(texts); start += batchSize {
+		batches = append(batches, texts[start:min(start+batchSize, len(texts))])
+	}
+	return batches
+}
+
+func openAIEmbedderBuilder(ctx context.Context, model *entity.Model, opts ...entity.EmbeddingOption) (einoEmbedding.Embedder, error) {
+	if err := checkModelBeforeBuild(model); err != nil {
+		return nil, err
+	}
+	p := model.ProtocolConfig
+	ops := entity.ApplyEmbeddingOptions(nil, opts...)
+	cfg := &acl_openai.EmbeddingConfig{
+		APIKey:     p.APIKey,
+		BaseURL:    p.BaseURL,
+		Model:      p.Model,
+		Dimensions: ops.Dimensions,
+		HTTPClient: newHTTPClient(p.TimeoutMs),
+	}
+	if pc := p.ProtocolConfigOpenAI; pc != nil {
+		cfg.ByAzure = pc.ByAzure
+		cfg.APIVersion = pc.ApiVersion
+	}
+	return acl_openai.NewEmbeddingClient(ctx, cfg)
+}
+
+// qwenEmbedderBuilder 通义千问的向量化模型使用 DashScope 的 OpenAI 兼容接口
+func qwenEmbedderBuilder(ctx context.Context, model *entity.Model, opts ...entity.EmbeddingOption) (einoEmbedding.Embedder, error) {
+	if err := checkModelBeforeBuild(model); err != nil {
+		return nil, err
+	}
+	p := model.ProtocolConfig
+	ops := entity.ApplyEmbeddingOptions(nil, opts...)
+	cfg := &acl_openai.EmbeddingConfig{
+		APIKey:     p.APIKey,
+		BaseURL:    p.BaseURL,
+		Model:      p.Model,
+		Dimensions: ops.Dimensions,
+		HTTPClient: newHTTPClient(p.TimeoutMs),
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultQwenBaseURL
+	}
+	return acl_openai.NewEmbeddingClient(ctx, cfg)
+}
+
+func arkEmbedderBuilder(ctx context.Context, model *entity.Model, opts ...entity.EmbeddingOption) (einoEmbedding.Embedder, error) {
+	if err := checkModelBeforeBuild(model); err != nil {
+		return nil, err
+	}
+	p := model.ProtocolConfig
+	ops := entity.ApplyEmbeddingOptions(nil, opts...)
+	var cfgOpts []arkruntime.ConfigOption
+	if p.BaseURL != "" {
+		cfgOpts = append(cfgOpts, arkruntime.WithBaseUrl(p.BaseURL))
+	}
+	if p.TimeoutMs != nil {
+		cfgOpts = append(cfgOpts, arkruntime.WithTimeout(time.Duration(*p.TimeoutMs)*time.Millisecond))
+	}
+	e := &arkEmbedder{model: p.Model, dimensions: ptr.From(ops.Dimensions)}
+	if arkCfg := p.ProtocolConfigArk; arkCfg != nil {
+		if arkCfg.Region != "" {
+			cfgOpts = append(cfgOpts, arkruntime.WithRegion(arkCfg.Region))
+		}
+		if arkCfg.RetryTimes != nil {
+			cfgOpts = append(cfgOpts, arkruntime.WithRetryTimes(int(*arkCfg.RetryTimes)))
+		}
+		e.customHeaders = arkCfg.CustomHeaders
+		if p.APIKey == "" && arkCfg.AccessKey != "" {
+			e.client = arkruntime.NewClientWithAkSk(arkCfg.AccessKey, arkCfg.SecretKey, cfgOpts...)
+			return e, nil
+		}
+	}
+	e.client = arkruntime.NewClientWithApiKey(p.APIKey, cfgOpts...)
+	return e, nil
+}
+
+func ollamaEmbedderBuilder(ctx context.Context, model *entity.Model, opts ...entity.EmbeddingOption) (einoEmbedding.Embedder, error) {
+	if err := checkModelBeforeBuild(model); err != nil {
+		return nil, err
+	}
+	p := model.ProtocolConfig
+	ops := entity.ApplyEmbeddingOptions(nil, opts...)
+	if ops.Dimensions != nil {
+		return nil, errors.Errorf("ollama embedding does not support dimensions")
+	}
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = defaultOllamaBaseURL
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "invalid ollama base url:%s", baseURL)
+	}
+	e := &ollamaEmbedder{
+		client: api.NewClient(u, newHTTPClient(p.TimeoutMs)),
+		model:  p.Model,
+	}
+	if pc := p.ProtocolConfigOllama; pc != nil && pc.KeepAliveMs != nil && *pc.KeepAliveMs > 0 {
+		e.keepAlive = &api.Duration{Duration: time.Duration(*pc.KeepAliveMs) * time.Millisecond}
+	}
+	return e, nil
+}
+
+func newHTTPClient(timeoutMs *int64) *http.Client {
+	cli := &http.Client{}
+	if timeoutMs != nil {
+		cli.Timeout = time.Duration(*timeoutMs) * time.Millisecond
+	}
+	return cli
+}
+
+// arkEmbedder eino-ext 暂未提供与当前 eino 版本兼容的 ark embedding 组件，直接基于 ark sdk 实现
+type arkEmbedder struct {
+	client        *arkruntime.Client
+	model         string
+	dimensions    int
+	customHeaders map[string]string
+}
+
+func (a *arkEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...einoEmbedding.Option) ([][]float64, error) {
+	resp, err := a.client.CreateEmbeddings(ctx, arkmodel.EmbeddingRequestStrings{
+		Input:      texts,
+		Model:      a.model,
+		Dimensions: a.dimensions,
+	}, arkruntime.WithCustomHeaders(a.customHeaders))
+	if err != nil {
+		return nil, err
+	}
+	embeddings := make([][]float64, len(resp.Data))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(embeddings) {
+			return nil, errors.Errorf("invalid embedding index:%d", d.Index)
+		}
+		embeddings[d.Index] = toFloat64s(d.Embedding)
+	}
+	_ = callbacks.OnEnd(ctx, &einoEmbedding.CallbackOutput{
+		Embeddings: embeddings,
+		TokenUsage: &einoEmbedding.TokenUsage{
+			PromptTokens:     resp.Usage.PromptTokens,
+			CompletionTokens: resp.Usage.CompletionTokens,
+			TotalTokens:      resp.Usage.TotalTokens,
+		},
+	})
+	return embeddings, nil
+}
+
+// ollamaEmbedder eino-ext 暂未提供与当前 eino 版本兼容的 ollama embedding 组件，直接基于 ollama sdk 实现
+type ollamaEmbedder struct {
+	client    *api.Client
+	model     string
+	keepAlive *api.Duration
+}
+
+func (o *ollamaEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...einoEmbedding.Option) ([][]float64, error) {
+	resp, err := o.client.Embed(ctx, &api.EmbedRequest{
+		Model:     o.model,
+		Input:     texts,
+		KeepAlive: o.keepAlive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	embeddings := make([][]float64, 0, len(resp.Embeddings))
+	for _, e := range resp.Embeddings {
+		embeddings = append(embeddings, toFloat64s(e))
+	}
+	_ = callbacks.OnEnd(ctx, &einoEmbedding.CallbackOutput{
+		Embeddings: embeddings,
+		TokenUsage: &einoEmbedding.TokenUsage{
+			PromptTokens: resp.PromptEvalCount,
+			TotalTokens:  resp.PromptEvalCount,
+		},
+	})
+	return embeddings, nil
+}
+
+func toFloat64s(vector []float32) []float64 {
+	res := make([]float64, len(vector))
+	for i, v := range vector {
+		res[i] = float64(v)
+	}
+	return res
+}
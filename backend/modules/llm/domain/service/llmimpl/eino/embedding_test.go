@@ -0,0 +1,165 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eino
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/eino/callbacks"
+	einoEmbedding "github.com/cloudwego/eino/components/embedding"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llmimpl/eino/mocks"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func TestEmbedder_EmbedStrings(t *testing.T) {
+	// mockEmbed 按输入条数返回向量，并通过回调上报用量
+	mockEmbed := func(ctx context.Context, texts []string, opts ...einoEmbedding.Option) ([][]float64, error) {
+		vectors := make([][]float64, 0, len(texts))
+		for i := range texts {
+			vectors = append(vectors, []float64{float64(len(texts[i]))})
+		}
+		_ = callbacks.OnEnd(ctx, &einoEmbedding.CallbackOutput{
+			Embeddings: vectors,
+			TokenUsage: &einoEmbedding.TokenUsage{PromptTokens: len(texts), TotalTokens: len(texts)},
+		})
+		return vectors, nil
+	}
+	tests := []struct {
+		name         string
+		batchSize    int
+		texts        []string
+		embedderMock func(ctrl *gomock.Controller) IEinoEmbedder
+		want         *entity.EmbeddingResult
+		wantErrCode  int32
+	}{
+		{
+			name:      "single batch",
+			batchSize: 10,
+			texts:     []string{"a", "bb"},
+			embedderMock: func(ctrl *gomock.Controller) IEinoEmbedder {
+				e := mocks.NewMockIEinoEmbedder(ctrl)
+				e.EXPECT().EmbedStrings(gomock.Any(), []string{"a", "bb"}).DoAndReturn(mockEmbed)
+				return e
+			},
+			want: &entity.EmbeddingResult{
+				Embeddings: [][]float64{{1}, {2}},
+				Usage:      &entity.TokenUsage{PromptTokens: 2, TotalTokens: 2},
+			},
+		},
+		{
+			name:      "split into batches",
+			batchSize: 2,
+			texts:     []string{"a", "bb", "ccc"},
+			embedderMock: func(ctrl *gomock.Controller) IEinoEmbedder {
+				e := mocks.NewMockIEinoEmbedder(ctrl)
+				gomock.InOrder(
+					e.EXPECT().EmbedStrings(gomock.Any(), []string{"a", "bb"}).DoAndReturn(mockEmbed),
+					e.EXPECT().EmbedStrings(gomock.Any(), []string{"ccc"}).DoAndReturn(mockEmbed),
+				)
+				return e
+			},
+			want: &entity.EmbeddingResult{
+				Embeddings: [][]float64{{1}, {2}, {3}},
+				Usage:      &entity.TokenUsage{PromptTokens: 3, TotalTokens: 3},
+			},
+		},
+		{
+			name:      "embedding count mismatch",
+			batchSize: 10,
+			texts:     []string{"a", "bb"},
+			embedderMock: func(ctrl *gomock.Controller) IEinoEmbedder {
+				e := mocks.NewMockIEinoEmbedder(ctrl)
+				e.EXPECT().EmbedStrings(gomock.Any(), gomock.Any()).Return([][]float64{{1}}, nil)
+				return e
+			},
+			wantErrCode: llm_errorx.ParseModelRespFailedCode,
+		},
+		{
+			name:      "call model failed",
+			batchSize: 10,
+			texts:     []string{"a"},
+			embedderMock: func(ctrl *gomock.Controller) IEinoEmbedder {
+				e := mocks.NewMockIEinoEmbedder(ctrl)
+				e.EXPECT().EmbedStrings(gomock.Any(), gomock.Any()).Return(nil, errorx.New("timeout"))
+				return e
+			},
+			wantErrCode: llm_errorx.CallModelFailedCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			e := &Embedder{
+				protocol:  entity.ProtocolOpenAI,
+				batchSize: tt.batchSize,
+				embedder:  tt.embedderMock(ctrl),
+			}
+			got, err := e.EmbedStrings(context.Background(), tt.texts)
+			if tt.wantErrCode != 0 {
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantErrCode, statusErr.Code())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewEmbedder(t *testing.T) {
+	tests := []struct {
+		name    string
+		model   *entity.Model
+		wantErr bool
+	}{
+		{
+			name: "openai",
+			model: &entity.Model{
+				Protocol:       entity.ProtocolOpenAI,
+				ProtocolConfig: &entity.ProtocolConfig{APIKey: "ak", Model: "text-embedding-3-small"},
+			},
+		},
+		{
+			name: "ark",
+			model: &entity.Model{
+				Protocol:       entity.ProtocolArk,
+				ProtocolConfig: &entity.ProtocolConfig{APIKey: "ak", Model: "doubao-embedding"},
+			},
+		},
+		{
+			name: "ollama",
+			model: &entity.Model{
+				Protocol:       entity.ProtocolOllama,
+				ProtocolConfig: &entity.ProtocolConfig{Model: "nomic-embed-text"},
+			},
+		},
+		{
+			name: "unsupported protocol",
+			model: &entity.Model{
+				Protocol:       entity.ProtocolClaude,
+				ProtocolConfig: &entity.ProtocolConfig{APIKey: "ak", Model: "claude"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEmbedder(context.Background(), tt.model)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, embeddingBatchSizes[tt.model.Protocol], got.batchSize)
+		})
+	}
+}
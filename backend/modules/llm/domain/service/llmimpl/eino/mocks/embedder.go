@@ -0,0 +1,62 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llmimpl/eino (interfaces: IEinoEmbedder)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/embedder.go -package=mocks . IEinoEmbedder
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	embedding "github.com/cloudwego/eino/components/embedding"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIEinoEmbedder is a mock of IEinoEmbedder interface.
+type MockIEinoEmbedder struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEinoEmbedderMockRecorder
+	isgomock struct{}
+}
+
+// MockIEinoEmbedderMockRecorder is the mock recorder for MockIEinoEmbedder.
+type MockIEinoEmbedderMockRecorder struct {
+	mock *MockIEinoEmbedder
+}
+
+// NewMockIEinoEmbedder creates a new mock instance.
+func NewMockIEinoEmbedder(ctrl *gomock.Controller) *MockIEinoEmbedder {
+	mock := &MockIEinoEmbedder{ctrl: ctrl}
+	mock.recorder = &MockIEinoEmbedderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEinoEmbedder) EXPECT() *MockIEinoEmbedderMockRecorder {
+	return m.recorder
+}
+
+// EmbedStrings mocks base method.
+func (m *MockIEinoEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, texts}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EmbedStrings", varargs...)
+	ret0, _ := ret[0].([][]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmbedStrings indicates an expected call of EmbedStrings.
+func (mr *MockIEinoEmbedderMockRecorder) EmbedStrings(ctx, texts any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, texts}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbedStrings", reflect.TypeOf((*MockIEinoEmbedder)(nil).EmbedStrings), varargs...)
+}
@@ -17,3 +17,9 @@ type ILLM interface {
 	Stream(ctx context.Context, input []*entity.Message, opts ...entity.Option) (
 		entity.IStreamReader, error)
 }
+
+//go:generate mockgen -destination=mocks/embedder.go -package=mocks . IEmbedder
+type IEmbedder interface {
+	// 批量向量化，返回的向量与输入文本一一对应
+	EmbedStrings(ctx context.Context, texts []string) (*entity.EmbeddingResult, error)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llminterface (interfaces: IEmbedder)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/embedder.go -package=mocks . IEmbedder
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIEmbedder is a mock of IEmbedder interface.
+type MockIEmbedder struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEmbedderMockRecorder
+	isgomock struct{}
+}
+
+// MockIEmbedderMockRecorder is the mock recorder for MockIEmbedder.
+type MockIEmbedderMockRecorder struct {
+	mock *MockIEmbedder
+}
+
+// NewMockIEmbedder creates a new mock instance.
+func NewMockIEmbedder(ctrl *gomock.Controller) *MockIEmbedder {
+	mock := &MockIEmbedder{ctrl: ctrl}
+	mock.recorder = &MockIEmbedderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEmbedder) EXPECT() *MockIEmbedderMockRecorder {
+	return m.recorder
+}
+
+// EmbedStrings mocks base method.
+func (m *MockIEmbedder) EmbedStrings(ctx context.Context, texts []string) (*entity.EmbeddingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmbedStrings", ctx, texts)
+	ret0, _ := ret[0].(*entity.EmbeddingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmbedStrings indicates an expected call of EmbedStrings.
+func (mr *MockIEmbedderMockRecorder) EmbedStrings(ctx, texts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbedStrings", reflect.TypeOf((*MockIEmbedder)(nil).EmbedStrings), ctx, texts)
+}
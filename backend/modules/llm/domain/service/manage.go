@@ -10,8 +10,10 @@ import (
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
 	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 )
@@ -20,19 +22,60 @@ import (
 type IManage interface {
 	ListModels(ctx context.Context, req entity.ListModelReq) (models []*entity.Model, total int64, hasMore bool, nextPageToken int64, err error)
 	GetModelByID(ctx context.Context, id int64) (model *entity.Model, err error)
+	CreateModel(ctx context.Context, model *entity.Model, userID string) (modelID int64, err error)
+	UpdateModel(ctx context.Context, req entity.UpdateModelReq) (err error)
+	DeleteModel(ctx context.Context, spaceID int64, modelID int64) (err error)
 }
 
 type ManageImpl struct {
-	conf conf.IConfigManage
+	conf       conf.IConfigManage
+	manageRepo repo.IManageRepo
+	idGen      idgen.IIDGenerator
 }
 
 var _ IManage = (*ManageImpl)(nil)
 
+// ListModels 配置文件中的模型在前，空间内通过接口创建的模型在后，分页游标为两者合并后的偏移量
 func (m *ManageImpl) ListModels(ctx context.Context, req entity.ListModelReq) (models []*entity.Model, total int64, hasMore bool, nextPageToken int64, err error) {
-	return m.conf.ListModels(ctx, req)
+	var spaceModels []*entity.Model
+	if req.WorkspaceID != nil && *req.WorkspaceID > 0 {
+		dbModels, err := m.manageRepo.ListModels(ctx, *req.WorkspaceID)
+		if err != nil {
+			return nil, 0, false, 0, err
+		}
+		for _, md := range dbModels {
+			if md.Available(req.Scenario) {
+				spaceModels = append(spaceModels, md)
+			}
+		}
+	}
+	cfgModels, cfgTotal, _, _, err := m.conf.ListModels(ctx, req)
+	if err != nil {
+		return nil, 0, false, 0, err
+	}
+	if req.PageToken < cfgTotal {
+		models = cfgModels
+	}
+	if remain := req.PageSize - int64(len(models)); remain > 0 {
+		start := max(req.PageToken-cfgTotal, 0)
+		if start < int64(len(spaceModels)) {
+			end := min(start+remain, int64(len(spaceModels)))
+			models = append(models, spaceModels[start:end]...)
+		}
+	}
+	total = cfgTotal + int64(len(spaceModels))
+	nextPageToken = req.PageToken + int64(len(models))
+	return models, total, nextPageToken < total, nextPageToken, nil
 }
 
 func (m *ManageImpl) GetModelByID(ctx context.Context, id int64) (model *entity.Model, err error) {
+	model, err = m.manageRepo.GetModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if model != nil {
+		return model, nil
+	}
 	model, err = m.conf.GetModel(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -42,3 +85,81 @@ func (m *ManageImpl) GetModelByID(ctx context.Context, id int64) (model *entity.
 	}
 	return model, nil
 }
+
+func (m *ManageImpl) CreateModel(ctx context.Context, model *entity.Model, userID string) (modelID int64, err error) {
+	modelID, err = m.idGen.GenID(ctx)
+	if err != nil {
+		return 0, errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode)
+	}
+	model.ID = modelID
+	if model.Frame == "" {
+		model.Frame = entity.FrameEino
+	}
+	if err = model.Valid(); err != nil {
+		return 0, errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if err = m.manageRepo.CreateModel(ctx, model, userID); err != nil {
+		return 0, err
+	}
+	return modelID, nil
+}
+
+func (m *ManageImpl) UpdateModel(ctx context.Context, req entity.UpdateModelReq) (err error) {
+	model, err := m.getSpaceModel(ctx, req.WorkspaceID, req.ModelID)
+	if err != nil {
+		return err
+	}
+	if req.Name != nil {
+		model.Name = *req.Name
+	}
+	if req.Desc != nil {
+		model.Desc = *req.Desc
+	}
+	if req.Ability != nil {
+		model.Ability = req.Ability
+	}
+	if req.Protocol != nil {
+		model.Protocol = *req.Protocol
+	}
+	if req.ProtocolConfig != nil {
+		model.ProtocolConfig = req.ProtocolConfig
+	}
+	if req.ScenarioConfigs != nil {
+		model.ScenarioConfigs = req.ScenarioConfigs
+	}
+	if req.ParamConfig != nil {
+		model.ParamConfig = req.ParamConfig
+	}
+	if req.Price != nil {
+		model.Price = req.Price
+	}
+	if err = model.Valid(); err != nil {
+		return errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return m.manageRepo.UpdateModel(ctx, model, req.UserID)
+}
+
+func (m *ManageImpl) DeleteModel(ctx context.Context, spaceID int64, modelID int64) (err error) {
+	if _, err = m.getSpaceModel(ctx, spaceID, modelID); err != nil {
+		return err
+	}
+	return m.manageRepo.DeleteModel(ctx, modelID)
+}
+
+// getSpaceModel 获取空间内通过接口创建的模型，配置文件中的模型不允许修改
+func (m *ManageImpl) getSpaceModel(ctx context.Context, spaceID int64, modelID int64) (*entity.Model, error) {
+	model, err := m.manageRepo.GetModel(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if model == nil {
+		if _, err := m.conf.GetModel(ctx, modelID); err == nil {
+			return nil, errorx.NewByCode(llm_errorx.CommonBizInvalidCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d is managed by config file", modelID)))
+		}
+		return nil, errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d not exist", modelID)))
+	}
+	if model.WorkspaceID != spaceID {
+		return nil, errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d not exist in space:%d", modelID, spaceID)))
+	}
+	return model, nil
+}
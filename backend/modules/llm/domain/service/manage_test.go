@@ -12,17 +12,23 @@ import (
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf"
 	llmconfmocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo/mocks"
 	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-loop/backend/pkg/unittest"
 )
 
 func TestManageImpl_GetModelByID(t *testing.T) {
 	type fields struct {
-		conf conf.IConfigManage
+		conf       conf.IConfigManage
+		manageRepo repo.IManageRepo
 	}
 	type args struct {
 		ctx context.Context
@@ -38,9 +44,11 @@ func TestManageImpl_GetModelByID(t *testing.T) {
 		{
 			name: "success",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1)).Return(nil, nil)
 				confMock := llmconfmocks.NewMockIConfigManage(ctrl)
 				confMock.EXPECT().GetModel(gomock.Any(), gomock.Any()).Return(&entity.Model{ID: 1}, nil)
-				return fields{conf: confMock}
+				return fields{conf: confMock, manageRepo: repoMock}
 			},
 			args: args{
 				ctx: context.Background(),
@@ -52,9 +60,11 @@ func TestManageImpl_GetModelByID(t *testing.T) {
 		{
 			name: "model not found",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(2)).Return(nil, nil)
 				confMock := llmconfmocks.NewMockIConfigManage(ctrl)
 				confMock.EXPECT().GetModel(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
-				return fields{conf: confMock}
+				return fields{conf: confMock, manageRepo: repoMock}
 			},
 			args: args{
 				ctx: context.Background(),
@@ -66,9 +76,11 @@ func TestManageImpl_GetModelByID(t *testing.T) {
 		{
 			name: "mysql error",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(3)).Return(nil, nil)
 				confMock := llmconfmocks.NewMockIConfigManage(ctrl)
 				confMock.EXPECT().GetModel(gomock.Any(), gomock.Any()).Return(nil, errors.New("test error"))
-				return fields{conf: confMock}
+				return fields{conf: confMock, manageRepo: repoMock}
 			},
 			args: args{
 				ctx: context.Background(),
@@ -77,6 +89,34 @@ func TestManageImpl_GetModelByID(t *testing.T) {
 			wantModel: nil,
 			wantErr:   errorx.NewByCode(llm_errorx.CommonMySqlErrorCode),
 		},
+		{
+			name: "model in db",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1001)).Return(&entity.Model{ID: 1001, WorkspaceID: 100}, nil)
+				return fields{manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1001,
+			},
+			wantModel: &entity.Model{ID: 1001, WorkspaceID: 100},
+			wantErr:   nil,
+		},
+		{
+			name: "repo error",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1001)).Return(nil, errorx.NewByCode(llm_errorx.CommonMySqlErrorCode))
+				return fields{manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1001,
+			},
+			wantModel: nil,
+			wantErr:   errorx.NewByCode(llm_errorx.CommonMySqlErrorCode),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -86,7 +126,8 @@ func TestManageImpl_GetModelByID(t *testing.T) {
 
 			ttFields := tt.fieldsGetter(ctrl)
 			m := &ManageImpl{
-				conf: ttFields.conf,
+				conf:       ttFields.conf,
+				manageRepo: ttFields.manageRepo,
 			}
 			gotModel, err := m.GetModelByID(tt.args.ctx, tt.args.id)
 			unittest.AssertErrorEqual(t, tt.wantErr, err)
@@ -100,7 +141,8 @@ func TestManageImpl_GetModelByID(t *testing.T) {
 
 func TestManageImpl_ListModels(t *testing.T) {
 	type fields struct {
-		conf conf.IConfigManage
+		conf       conf.IConfigManage
+		manageRepo repo.IManageRepo
 	}
 	type args struct {
 		ctx context.Context
@@ -139,6 +181,78 @@ func TestManageImpl_ListModels(t *testing.T) {
 			wantNextPageToken: 1,
 			wantErr:           nil,
 		},
+		{
+			name: "config models followed by space models",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().ListModels(gomock.Any(), int64(100)).Return([]*entity.Model{
+					{ID: 1001, WorkspaceID: 100},
+					{ID: 1002, WorkspaceID: 100, ScenarioConfigs: map[entity.Scenario]*entity.ScenarioConfig{
+						entity.ScenarioDefault: {Unavailable: true},
+					}},
+					{ID: 1003, WorkspaceID: 100},
+				}, nil)
+				confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+				confMock.EXPECT().ListModels(gomock.Any(), gomock.Any()).Return([]*entity.Model{{ID: 2}}, int64(2), false, int64(2), nil)
+				return fields{conf: confMock, manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: entity.ListModelReq{
+					WorkspaceID: ptr.Of(int64(100)),
+					Scenario:    ptr.Of(entity.ScenarioDefault),
+					PageSize:    2,
+					PageToken:   1,
+				},
+			},
+			wantModelsLength:  2,
+			wantTotal:         4,
+			wantHasMore:       true,
+			wantNextPageToken: 3,
+			wantErr:           nil,
+		},
+		{
+			name: "only space models on page",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().ListModels(gomock.Any(), int64(100)).Return([]*entity.Model{
+					{ID: 1001, WorkspaceID: 100},
+					{ID: 1003, WorkspaceID: 100},
+				}, nil)
+				confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+				confMock.EXPECT().ListModels(gomock.Any(), gomock.Any()).Return([]*entity.Model{{ID: 1}, {ID: 2}}, int64(2), false, int64(3), nil)
+				return fields{conf: confMock, manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: entity.ListModelReq{
+					WorkspaceID: ptr.Of(int64(100)),
+					PageSize:    2,
+					PageToken:   3,
+				},
+			},
+			wantModelsLength:  1,
+			wantTotal:         4,
+			wantHasMore:       false,
+			wantNextPageToken: 4,
+			wantErr:           nil,
+		},
+		{
+			name: "repo error",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().ListModels(gomock.Any(), int64(100)).Return(nil, errorx.NewByCode(llm_errorx.CommonMySqlErrorCode))
+				return fields{manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: entity.ListModelReq{
+					WorkspaceID: ptr.Of(int64(100)),
+					PageSize:    2,
+				},
+			},
+			wantErr: errorx.NewByCode(llm_errorx.CommonMySqlErrorCode),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -148,7 +262,8 @@ func TestManageImpl_ListModels(t *testing.T) {
 
 			ttFields := tt.fieldsGetter(ctrl)
 			m := &ManageImpl{
-				conf: ttFields.conf,
+				conf:       ttFields.conf,
+				manageRepo: ttFields.manageRepo,
 			}
 			gotModels, gotTotal, gotHasMore, gotNextPageToken, err := m.ListModels(tt.args.ctx, tt.args.req)
 			unittest.AssertErrorEqual(t, tt.wantErr, err)
@@ -159,3 +274,285 @@ func TestManageImpl_ListModels(t *testing.T) {
 		})
 	}
 }
+
+func TestManageImpl_CreateModel(t *testing.T) {
+	type fields struct {
+		manageRepo repo.IManageRepo
+		idGen      idgen.IIDGenerator
+	}
+	type args struct {
+		ctx    context.Context
+		model  *entity.Model
+		userID string
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		wantModelID  int64
+		wantErr      error
+	}{
+		{
+			name: "success",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				idGenMock := idgenmocks.NewMockIIDGenerator(ctrl)
+				idGenMock.EXPECT().GenID(gomock.Any()).Return(int64(1001), nil)
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().CreateModel(gomock.Any(), gomock.Any(), "user").DoAndReturn(
+					func(ctx context.Context, model *entity.Model, userID string) error {
+						assert.Equal(t, int64(1001), model.ID)
+						assert.Equal(t, entity.FrameEino, model.Frame)
+						return nil
+					})
+				return fields{manageRepo: repoMock, idGen: idGenMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				model: &entity.Model{
+					WorkspaceID:    100,
+					Name:           "my-model",
+					Protocol:       entity.ProtocolArk,
+					ProtocolConfig: &entity.ProtocolConfig{Model: "ep-xxx", APIKey: "sk-xxx"},
+				},
+				userID: "user",
+			},
+			wantModelID: 1001,
+			wantErr:     nil,
+		},
+		{
+			name: "invalid model",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				idGenMock := idgenmocks.NewMockIIDGenerator(ctrl)
+				idGenMock.EXPECT().GenID(gomock.Any()).Return(int64(1001), nil)
+				return fields{idGen: idGenMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				model: &entity.Model{
+					WorkspaceID: 100,
+					Name:        "my-model",
+					Protocol:    entity.ProtocolArk,
+				},
+				userID: "user",
+			},
+			wantModelID: 0,
+			wantErr:     errorx.NewByCode(llm_errorx.ModelInvalidCode),
+		},
+		{
+			name: "gen id error",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				idGenMock := idgenmocks.NewMockIIDGenerator(ctrl)
+				idGenMock.EXPECT().GenID(gomock.Any()).Return(int64(0), errors.New("gen id error"))
+				return fields{idGen: idGenMock}
+			},
+			args: args{
+				ctx:    context.Background(),
+				model:  &entity.Model{WorkspaceID: 100},
+				userID: "user",
+			},
+			wantModelID: 0,
+			wantErr:     errorx.NewByCode(llm_errorx.CommonInternalErrorCode),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ttFields := tt.fieldsGetter(ctrl)
+			m := &ManageImpl{
+				manageRepo: ttFields.manageRepo,
+				idGen:      ttFields.idGen,
+			}
+			gotModelID, err := m.CreateModel(tt.args.ctx, tt.args.model, tt.args.userID)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantModelID, gotModelID)
+		})
+	}
+}
+
+func TestManageImpl_UpdateModel(t *testing.T) {
+	type fields struct {
+		conf       conf.IConfigManage
+		manageRepo repo.IManageRepo
+	}
+	type args struct {
+		ctx context.Context
+		req entity.UpdateModelReq
+	}
+	spaceModel := func() *entity.Model {
+		return &entity.Model{
+			ID:             1001,
+			WorkspaceID:    100,
+			Name:           "my-model",
+			Protocol:       entity.ProtocolArk,
+			ProtocolConfig: &entity.ProtocolConfig{Model: "ep-xxx", APIKey: "sk-xxx"},
+		}
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		wantErr      error
+	}{
+		{
+			name: "success",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1001)).Return(spaceModel(), nil)
+				repoMock.EXPECT().UpdateModel(gomock.Any(), gomock.Any(), "user").DoAndReturn(
+					func(ctx context.Context, model *entity.Model, userID string) error {
+						assert.Equal(t, "new-name", model.Name)
+						assert.Equal(t, "sk-xxx", model.ProtocolConfig.APIKey)
+						return nil
+					})
+				return fields{manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: entity.UpdateModelReq{
+					WorkspaceID: 100,
+					ModelID:     1001,
+					UserID:      "user",
+					Name:        ptr.Of("new-name"),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid model after update",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1001)).Return(spaceModel(), nil)
+				return fields{manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: entity.UpdateModelReq{
+					WorkspaceID: 100,
+					ModelID:     1001,
+					UserID:      "user",
+					Name:        ptr.Of(""),
+				},
+			},
+			wantErr: errorx.NewByCode(llm_errorx.ModelInvalidCode),
+		},
+		{
+			name: "model managed by config file",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1)).Return(nil, nil)
+				confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+				confMock.EXPECT().GetModel(gomock.Any(), int64(1)).Return(&entity.Model{ID: 1}, nil)
+				return fields{conf: confMock, manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: entity.UpdateModelReq{
+					WorkspaceID: 100,
+					ModelID:     1,
+					Name:        ptr.Of("new-name"),
+				},
+			},
+			wantErr: errorx.NewByCode(llm_errorx.CommonBizInvalidCode),
+		},
+		{
+			name: "model in other space",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1001)).Return(spaceModel(), nil)
+				return fields{manageRepo: repoMock}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: entity.UpdateModelReq{
+					WorkspaceID: 200,
+					ModelID:     1001,
+					Name:        ptr.Of("new-name"),
+				},
+			},
+			wantErr: errorx.NewByCode(llm_errorx.ResourceNotFoundCode),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ttFields := tt.fieldsGetter(ctrl)
+			m := &ManageImpl{
+				conf:       ttFields.conf,
+				manageRepo: ttFields.manageRepo,
+			}
+			err := m.UpdateModel(tt.args.ctx, tt.args.req)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestManageImpl_DeleteModel(t *testing.T) {
+	type fields struct {
+		conf       conf.IConfigManage
+		manageRepo repo.IManageRepo
+	}
+	type args struct {
+		ctx     context.Context
+		spaceID int64
+		modelID int64
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		wantErr      error
+	}{
+		{
+			name: "success",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1001)).Return(&entity.Model{ID: 1001, WorkspaceID: 100}, nil)
+				repoMock.EXPECT().DeleteModel(gomock.Any(), int64(1001)).Return(nil)
+				return fields{manageRepo: repoMock}
+			},
+			args: args{
+				ctx:     context.Background(),
+				spaceID: 100,
+				modelID: 1001,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "model not exist",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				repoMock := repomocks.NewMockIManageRepo(ctrl)
+				repoMock.EXPECT().GetModel(gomock.Any(), int64(1002)).Return(nil, nil)
+				confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+				confMock.EXPECT().GetModel(gomock.Any(), int64(1002)).Return(nil, gorm.ErrRecordNotFound)
+				return fields{conf: confMock, manageRepo: repoMock}
+			},
+			args: args{
+				ctx:     context.Background(),
+				spaceID: 100,
+				modelID: 1002,
+			},
+			wantErr: errorx.NewByCode(llm_errorx.ResourceNotFoundCode),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ttFields := tt.fieldsGetter(ctrl)
+			m := &ManageImpl{
+				conf:       ttFields.conf,
+				manageRepo: ttFields.manageRepo,
+			}
+			err := m.DeleteModel(tt.args.ctx, tt.args.spaceID, tt.args.modelID)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+		})
+	}
+}
@@ -41,6 +41,35 @@ func (m *MockIManage) EXPECT() *MockIManageMockRecorder {
 	return m.recorder
 }
 
+// CreateModel mocks base method.
+func (m *MockIManage) CreateModel(ctx context.Context, model *entity.Model, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModel", ctx, model, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModel indicates an expected call of CreateModel.
+func (mr *MockIManageMockRecorder) CreateModel(ctx, model, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModel", reflect.TypeOf((*MockIManage)(nil).CreateModel), ctx, model, userID)
+}
+
+// DeleteModel mocks base method.
+func (m *MockIManage) DeleteModel(ctx context.Context, spaceID, modelID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModel", ctx, spaceID, modelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteModel indicates an expected call of DeleteModel.
+func (mr *MockIManageMockRecorder) DeleteModel(ctx, spaceID, modelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModel", reflect.TypeOf((*MockIManage)(nil).DeleteModel), ctx, spaceID, modelID)
+}
+
 // GetModelByID mocks base method.
 func (m *MockIManage) GetModelByID(ctx context.Context, id int64) (*entity.Model, error) {
 	m.ctrl.T.Helper()
@@ -73,3 +102,17 @@ func (mr *MockIManageMockRecorder) ListModels(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockIManage)(nil).ListModels), ctx, req)
 }
+
+// UpdateModel mocks base method.
+func (m *MockIManage) UpdateModel(ctx context.Context, req entity.UpdateModelReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModel", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateModel indicates an expected call of UpdateModel.
+func (mr *MockIManageMockRecorder) UpdateModel(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModel", reflect.TypeOf((*MockIManage)(nil).UpdateModel), ctx, req)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/domain/service (interfaces: IQuota)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/quota.go -package=mocks . IQuota
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIQuota is a mock of IQuota interface.
+type MockIQuota struct {
+	ctrl     *gomock.Controller
+	recorder *MockIQuotaMockRecorder
+	isgomock struct{}
+}
+
+// MockIQuotaMockRecorder is the mock recorder for MockIQuota.
+type MockIQuotaMockRecorder struct {
+	mock *MockIQuota
+}
+
+// NewMockIQuota creates a new mock instance.
+func NewMockIQuota(ctrl *gomock.Controller) *MockIQuota {
+	mock := &MockIQuota{ctrl: ctrl}
+	mock.recorder = &MockIQuotaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIQuota) EXPECT() *MockIQuotaMockRecorder {
+	return m.recorder
+}
+
+// CheckQuota mocks base method.
+func (m *MockIQuota) CheckQuota(ctx context.Context, spaceID, modelID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckQuota", ctx, spaceID, modelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckQuota indicates an expected call of CheckQuota.
+func (mr *MockIQuotaMockRecorder) CheckQuota(ctx, spaceID, modelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckQuota", reflect.TypeOf((*MockIQuota)(nil).CheckQuota), ctx, spaceID, modelID)
+}
+
+// ConsumeQuota mocks base method.
+func (m *MockIQuota) ConsumeQuota(ctx context.Context, record *entity.ModelRequestRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeQuota", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeQuota indicates an expected call of ConsumeQuota.
+func (mr *MockIQuotaMockRecorder) ConsumeQuota(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeQuota", reflect.TypeOf((*MockIQuota)(nil).ConsumeQuota), ctx, record)
+}
+
+// GetQuotaStatus mocks base method.
+func (m *MockIQuota) GetQuotaStatus(ctx context.Context, spaceID int64) ([]*entity.QuotaStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaStatus", ctx, spaceID)
+	ret0, _ := ret[0].([]*entity.QuotaStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaStatus indicates an expected call of GetQuotaStatus.
+func (mr *MockIQuotaMockRecorder) GetQuotaStatus(ctx, spaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaStatus", reflect.TypeOf((*MockIQuota)(nil).GetQuotaStatus), ctx, spaceID)
+}
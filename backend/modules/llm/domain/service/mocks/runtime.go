@@ -55,23 +55,44 @@ func (mr *MockIRuntimeMockRecorder) CreateModelRequestRecord(ctx, record any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModelRequestRecord", reflect.TypeOf((*MockIRuntime)(nil).CreateModelRequestRecord), ctx, record)
 }
 
+// Embed mocks base method.
+func (m *MockIRuntime) Embed(ctx context.Context, model *entity.Model, texts []string, opts ...entity.EmbeddingOption) (*entity.EmbeddingResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, model, texts}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Embed", varargs...)
+	ret0, _ := ret[0].(*entity.EmbeddingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Embed indicates an expected call of Embed.
+func (mr *MockIRuntimeMockRecorder) Embed(ctx, model, texts any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, model, texts}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Embed", reflect.TypeOf((*MockIRuntime)(nil).Embed), varargs...)
+}
+
 // Generate mocks base method.
-func (m *MockIRuntime) Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (*entity.Message, error) {
+func (m *MockIRuntime) Generate(ctx context.Context, spaceID int64, model *entity.Model, scenario *entity.Scenario, admit entity.CandidateAdmitter, input []*entity.Message, opts ...entity.Option) (*entity.Message, *entity.Model, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, model, input}
+	varargs := []any{ctx, spaceID, model, scenario, admit, input}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Generate", varargs...)
 	ret0, _ := ret[0].(*entity.Message)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*entity.Model)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Generate indicates an expected call of Generate.
-func (mr *MockIRuntimeMockRecorder) Generate(ctx, model, input any, opts ...any) *gomock.Call {
+func (mr *MockIRuntimeMockRecorder) Generate(ctx, spaceID, model, scenario, admit, input any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, model, input}, opts...)
+	varargs := append([]any{ctx, spaceID, model, scenario, admit, input}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockIRuntime)(nil).Generate), varargs...)
 }
 
@@ -91,22 +112,23 @@ func (mr *MockIRuntimeMockRecorder) HandleMsgsPreCallModel(ctx, model, msgs any)
 }
 
 // Stream mocks base method.
-func (m *MockIRuntime) Stream(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (entity.IStreamReader, error) {
+func (m *MockIRuntime) Stream(ctx context.Context, model *entity.Model, scenario *entity.Scenario, admit entity.CandidateAdmitter, input []*entity.Message, opts ...entity.Option) (entity.IStreamReader, *entity.Model, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, model, input}
+	varargs := []any{ctx, model, scenario, admit, input}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Stream", varargs...)
 	ret0, _ := ret[0].(entity.IStreamReader)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*entity.Model)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Stream indicates an expected call of Stream.
-func (mr *MockIRuntimeMockRecorder) Stream(ctx, model, input any, opts ...any) *gomock.Call {
+func (mr *MockIRuntimeMockRecorder) Stream(ctx, model, scenario, admit, input any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, model, input}, opts...)
+	varargs := append([]any{ctx, model, scenario, admit, input}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockIRuntime)(nil).Stream), varargs...)
 }
 
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/domain/service (interfaces: IUsage)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/usage.go -package=mocks . IUsage
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIUsage is a mock of IUsage interface.
+type MockIUsage struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUsageMockRecorder
+	isgomock struct{}
+}
+
+// MockIUsageMockRecorder is the mock recorder for MockIUsage.
+type MockIUsageMockRecorder struct {
+	mock *MockIUsage
+}
+
+// NewMockIUsage creates a new mock instance.
+func NewMockIUsage(ctrl *gomock.Controller) *MockIUsage {
+	mock := &MockIUsage{ctrl: ctrl}
+	mock.recorder = &MockIUsageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUsage) EXPECT() *MockIUsageMockRecorder {
+	return m.recorder
+}
+
+// QueryModelUsage mocks base method.
+func (m *MockIUsage) QueryModelUsage(ctx context.Context, query *entity.ModelUsageQuery) ([]*entity.ModelUsageStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryModelUsage", ctx, query)
+	ret0, _ := ret[0].([]*entity.ModelUsageStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryModelUsage indicates an expected call of QueryModelUsage.
+func (mr *MockIUsageMockRecorder) QueryModelUsage(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryModelUsage", reflect.TypeOf((*MockIUsage)(nil).QueryModelUsage), ctx, query)
+}
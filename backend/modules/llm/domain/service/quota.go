@@ -0,0 +1,123 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+//go:generate mockgen -destination=mocks/quota.go -package=mocks . IQuota
+type IQuota interface {
+	// CheckQuota 校验空间及模型在当前周期内的额度是否已用完，modelID 为0时只校验空间级额度。
+	// 读取用量失败时放行，只在额度确实用完时返回错误
+	CheckQuota(ctx context.Context, spaceID int64, modelID int64) (err error)
+	// ConsumeQuota 按请求记录累加用量，额度按实际响应请求的模型统计（发生降级时为备用模型），命中响应缓存的请求不消耗额度
+	ConsumeQuota(ctx context.Context, record *entity.ModelRequestRecord) (err error)
+	// GetQuotaStatus 返回空间内所有生效额度在当前周期内的使用情况
+	GetQuotaStatus(ctx context.Context, spaceID int64) (statuses []*entity.QuotaStatus, err error)
+}
+
+type QuotaImpl struct {
+	quotaRepo  repo.IQuotaRepo
+	runtimeCfg conf.IConfigRuntime
+}
+
+var _ IQuota = (*QuotaImpl)(nil)
+
+func (q *QuotaImpl) CheckQuota(ctx context.Context, spaceID int64, modelID int64) (err error) {
+	statuses, err := q.getStatuses(ctx, spaceID, q.runtimeCfg.GetQuotaConfig().GetModelRules(spaceID, modelID))
+	if err != nil {
+		logs.CtxWarn(ctx, "[CheckQuota] get quota usage failed, space_id:%d, model_id:%d, err:%v", spaceID, modelID, err)
+		return nil
+	}
+	for _, s := range statuses {
+		if s.TokenExceeded() {
+			return errorx.NewByCode(llm_errorx.ModelTokenQuotaExceededCode, errorx.WithExtraMsg(quotaExceededMsg(s)))
+		}
+		if s.CostExceeded() {
+			return errorx.NewByCode(llm_errorx.ModelCostQuotaExceededCode, errorx.WithExtraMsg(quotaExceededMsg(s)))
+		}
+	}
+	return nil
+}
+
+func (q *QuotaImpl) ConsumeQuota(ctx context.Context, record *entity.ModelRequestRecord) (err error) {
+	if record == nil || record.CacheHit {
+		return nil
+	}
+	usage := &entity.QuotaUsage{
+		Tokens: record.InputToken + record.OutputToken,
+		Cost:   record.Cost,
+	}
+	if usage.Tokens <= 0 && usage.Cost <= 0 {
+		return nil
+	}
+	modelID, err := strconv.ParseInt(record.ModelID, 10, 64)
+	if err != nil {
+		return errorx.NewByCode(llm_errorx.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid model id:%s", record.ModelID)))
+	}
+	rules := q.runtimeCfg.GetQuotaConfig().GetModelRules(record.SpaceID, modelID)
+	if len(rules) == 0 {
+		return nil
+	}
+	now := time.Now()
+	keys := make([]*entity.QuotaUsageKey, 0, len(rules))
+	for _, r := range rules {
+		keys = append(keys, entity.NewQuotaUsageKey(record.SpaceID, r.ModelID, r.Period, now))
+	}
+	return q.quotaRepo.IncrQuotaUsages(ctx, keys, usage)
+}
+
+func (q *QuotaImpl) GetQuotaStatus(ctx context.Context, spaceID int64) (statuses []*entity.QuotaStatus, err error) {
+	return q.getStatuses(ctx, spaceID, q.runtimeCfg.GetQuotaConfig().GetSpaceRules(spaceID))
+}
+
+func (q *QuotaImpl) getStatuses(ctx context.Context, spaceID int64, rules []*entity.QuotaRule) ([]*entity.QuotaStatus, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	now := time.Now()
+	keys := make([]*entity.QuotaUsageKey, 0, len(rules))
+	for _, r := range rules {
+		keys = append(keys, entity.NewQuotaUsageKey(spaceID, r.ModelID, r.Period, now))
+	}
+	usages, err := q.quotaRepo.MGetQuotaUsages(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*entity.QuotaStatus, 0, len(rules))
+	for i, r := range rules {
+		s := &entity.QuotaStatus{
+			SpaceID:   spaceID,
+			ModelID:   r.ModelID,
+			Period:    r.Period,
+			MaxTokens: max(r.MaxTokens, 0),
+			MaxCost:   max(r.MaxCost, 0),
+			ResetAt:   keys[i].PeriodEnd,
+		}
+		if i < len(usages) && usages[i] != nil {
+			s.UsedTokens = usages[i].Tokens
+			s.UsedCost = usages[i].Cost
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+func quotaExceededMsg(s *entity.QuotaStatus) string {
+	if s.ModelID == 0 {
+		return fmt.Sprintf("%s quota of space:%d is used up", s.Period, s.SpaceID)
+	}
+	return fmt.Sprintf("%s quota of model:%d in space:%d is used up", s.Period, s.ModelID, s.SpaceID)
+}
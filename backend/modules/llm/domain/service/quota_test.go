@@ -0,0 +1,160 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	llmconfmocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo/mocks"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func TestQuotaImpl_CheckQuota(t *testing.T) {
+	quotaCfg := &entity.QuotaConfig{Rules: []*entity.QuotaRule{
+		{WorkspaceID: 1, Period: entity.QuotaPeriodDay, MaxTokens: 1000},
+		{WorkspaceID: 1, ModelID: 10, Period: entity.QuotaPeriodMonth, MaxCost: 5},
+	}}
+	tests := []struct {
+		name        string
+		modelID     int64
+		usages      []*entity.QuotaUsage
+		usageErr    error
+		wantErrCode int32
+	}{
+		{
+			name:    "within quota",
+			modelID: 10,
+			usages:  []*entity.QuotaUsage{{Tokens: 999}, {Cost: 4.9}},
+		},
+		{
+			name:        "token quota exceeded",
+			modelID:     10,
+			usages:      []*entity.QuotaUsage{{Tokens: 1000}, {Cost: 1}},
+			wantErrCode: llm_errorx.ModelTokenQuotaExceededCode,
+		},
+		{
+			name:        "cost quota exceeded",
+			modelID:     10,
+			usages:      []*entity.QuotaUsage{{Tokens: 1}, {Cost: 5}},
+			wantErrCode: llm_errorx.ModelCostQuotaExceededCode,
+		},
+		{
+			name:     "get usage failed",
+			modelID:  10,
+			usageErr: errorx.NewByCode(llm_errorx.CommonRedisErrorCode),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cfg := llmconfmocks.NewMockIConfigRuntime(ctrl)
+			cfg.EXPECT().GetQuotaConfig().Return(quotaCfg)
+			quotaRepo := repomocks.NewMockIQuotaRepo(ctrl)
+			quotaRepo.EXPECT().MGetQuotaUsages(gomock.Any(), gomock.Len(2)).Return(tt.usages, tt.usageErr)
+			q := &QuotaImpl{quotaRepo: quotaRepo, runtimeCfg: cfg}
+			err := q.CheckQuota(context.Background(), 1, tt.modelID)
+			if tt.wantErrCode != 0 {
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantErrCode, statusErr.Code())
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestQuotaImpl_ConsumeQuota(t *testing.T) {
+	quotaCfg := &entity.QuotaConfig{Rules: []*entity.QuotaRule{
+		{Period: entity.QuotaPeriodDay, MaxTokens: 1000},
+		{ModelID: 10, Period: entity.QuotaPeriodMonth, MaxCost: 5},
+	}}
+	t.Run("consume by served model", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cfg := llmconfmocks.NewMockIConfigRuntime(ctrl)
+		cfg.EXPECT().GetQuotaConfig().Return(quotaCfg)
+		quotaRepo := repomocks.NewMockIQuotaRepo(ctrl)
+		quotaRepo.EXPECT().IncrQuotaUsages(gomock.Any(), gomock.Any(), &entity.QuotaUsage{Tokens: 30, Cost: 0.2}).
+			DoAndReturn(func(ctx context.Context, keys []*entity.QuotaUsageKey, usage *entity.QuotaUsage) error {
+				assert.Len(t, keys, 2)
+				assert.Equal(t, int64(0), keys[0].ModelID)
+				assert.Equal(t, int64(10), keys[1].ModelID)
+				return nil
+			})
+		q := &QuotaImpl{quotaRepo: quotaRepo, runtimeCfg: cfg}
+		assert.Nil(t, q.ConsumeQuota(context.Background(), &entity.ModelRequestRecord{
+			SpaceID:       1,
+			ModelID:       "10",
+			OriginModelID: "10",
+			InputToken:    10,
+			OutputToken:   20,
+			Cost:          0.2,
+		}))
+	})
+	t.Run("served by fallback model", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cfg := llmconfmocks.NewMockIConfigRuntime(ctrl)
+		cfg.EXPECT().GetQuotaConfig().Return(quotaCfg)
+		quotaRepo := repomocks.NewMockIQuotaRepo(ctrl)
+		quotaRepo.EXPECT().IncrQuotaUsages(gomock.Any(), gomock.Any(), &entity.QuotaUsage{Tokens: 30, Cost: 0.2}).
+			DoAndReturn(func(ctx context.Context, keys []*entity.QuotaUsageKey, usage *entity.QuotaUsage) error {
+				// 原模型 10 未实际响应，不应计入其模型级额度
+				assert.Len(t, keys, 1)
+				assert.Equal(t, int64(0), keys[0].ModelID)
+				return nil
+			})
+		q := &QuotaImpl{quotaRepo: quotaRepo, runtimeCfg: cfg}
+		assert.Nil(t, q.ConsumeQuota(context.Background(), &entity.ModelRequestRecord{
+			SpaceID:       1,
+			ModelID:       "11",
+			OriginModelID: "10",
+			InputToken:    10,
+			OutputToken:   20,
+			Cost:          0.2,
+		}))
+	})
+	t.Run("cache hit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		q := &QuotaImpl{quotaRepo: repomocks.NewMockIQuotaRepo(ctrl), runtimeCfg: llmconfmocks.NewMockIConfigRuntime(ctrl)}
+		assert.Nil(t, q.ConsumeQuota(context.Background(), &entity.ModelRequestRecord{
+			SpaceID:       1,
+			ModelID:       "10",
+			OriginModelID: "10",
+			InputToken:    10,
+			OutputToken:   20,
+			CacheHit:      true,
+		}))
+	})
+}
+
+func TestQuotaImpl_GetQuotaStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cfg := llmconfmocks.NewMockIConfigRuntime(ctrl)
+	cfg.EXPECT().GetQuotaConfig().Return(&entity.QuotaConfig{Rules: []*entity.QuotaRule{
+		{Period: entity.QuotaPeriodDay, MaxTokens: 1000},
+		{WorkspaceID: 2, Period: entity.QuotaPeriodMonth, MaxCost: 5},
+	}})
+	quotaRepo := repomocks.NewMockIQuotaRepo(ctrl)
+	quotaRepo.EXPECT().MGetQuotaUsages(gomock.Any(), gomock.Len(1)).Return([]*entity.QuotaUsage{{Tokens: 1200, Cost: 0.3}}, nil)
+	q := &QuotaImpl{quotaRepo: quotaRepo, runtimeCfg: cfg}
+	statuses, err := q.GetQuotaStatus(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, entity.QuotaPeriodDay, statuses[0].Period)
+	assert.Equal(t, int64(1200), statuses[0].UsedTokens)
+	assert.True(t, statuses[0].TokenExceeded())
+	assert.False(t, statuses[0].CostExceeded())
+}
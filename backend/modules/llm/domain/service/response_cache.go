@@ -0,0 +1,79 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type responseCacheKeyParam struct {
+	WorkspaceID         int64             `json:"workspace_id"`
+	ModelID             int64             `json:"model_id"`
+	Protocol            entity.Protocol   `json:"protocol"`
+	ModelIdentification string            `json:"model_identification"`
+	BaseURL             string            `json:"base_url"`
+	Messages            []*entity.Message `json:"messages"`
+	Options             *entity.Options   `json:"options"`
+}
+
+// responseCacheKey 以请求空间、模型标识、去除响应元信息后的消息以及请求参数计算缓存key，不同空间间的缓存互相隔离
+func responseCacheKey(spaceID int64, model *entity.Model, input []*entity.Message, opts ...entity.Option) (string, error) {
+	param := &responseCacheKeyParam{
+		WorkspaceID: spaceID,
+		ModelID:     model.ID,
+		Protocol:    model.Protocol,
+		Options:     entity.ApplyOptions(nil, opts...),
+	}
+	if model.ProtocolConfig != nil {
+		param.ModelIdentification = model.ProtocolConfig.Model
+		param.BaseURL = model.ProtocolConfig.BaseURL
+	}
+	for _, msg := range input {
+		if msg == nil {
+			continue
+		}
+		normalized := *msg
+		normalized.ResponseMeta = nil
+		param.Messages = append(param.Messages, &normalized)
+	}
+	b, err := json.Marshal(param)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// getResponseCache 读取缓存失败时不影响模型请求，返回nil
+func (r *RuntimeImpl) getResponseCache(ctx context.Context, key string) *entity.Message {
+	msg, err := r.runtimeRepo.GetResponseCache(ctx, key)
+	if err != nil {
+		logs.CtxWarn(ctx, "[getResponseCache] failed, key=%s, err=%v", key, err)
+		return nil
+	}
+	if msg == nil {
+		return nil
+	}
+	if msg.ResponseMeta == nil {
+		msg.ResponseMeta = &entity.ResponseMeta{}
+	}
+	msg.ResponseMeta.CacheHit = true
+	return msg
+}
+
+func (r *RuntimeImpl) setResponseCache(ctx context.Context, key string, msg *entity.Message, ttl time.Duration) {
+	if msg == nil {
+		return
+	}
+	if err := r.runtimeRepo.SetResponseCache(ctx, key, msg, ttl); err != nil {
+		logs.CtxWarn(ctx, "[setResponseCache] failed, key=%s, err=%v", key, err)
+	}
+}
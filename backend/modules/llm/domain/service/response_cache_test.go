@@ -0,0 +1,71 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TestResponseCacheKey(t *testing.T) {
+	model := &entity.Model{
+		ID:             1,
+		Protocol:       entity.ProtocolOpenAI,
+		ProtocolConfig: &entity.ProtocolConfig{Model: "gpt-4o"},
+	}
+	input := []*entity.Message{{Role: entity.RoleUser, Content: "hi"}}
+	key, err := responseCacheKey(100, model, input, entity.WithTemperature(0.5))
+	assert.NoError(t, err)
+	assert.Len(t, key, 64)
+
+	// 响应元信息不参与计算
+	same, err := responseCacheKey(100, model, []*entity.Message{{Role: entity.RoleUser, Content: "hi", ResponseMeta: &entity.ResponseMeta{FinishReason: "stop"}}},
+		entity.WithTemperature(0.5))
+	assert.NoError(t, err)
+	assert.Equal(t, key, same)
+
+	diffOpt, err := responseCacheKey(100, model, input, entity.WithTemperature(0.6))
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, diffOpt)
+
+	diffMsg, err := responseCacheKey(100, model, []*entity.Message{{Role: entity.RoleUser, Content: "hello"}}, entity.WithTemperature(0.5))
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, diffMsg)
+
+	diffModel, err := responseCacheKey(100, &entity.Model{ID: 1, Protocol: entity.ProtocolOpenAI, ProtocolConfig: &entity.ProtocolConfig{Model: "gpt-4o-mini"}},
+		input, entity.WithTemperature(0.5))
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, diffModel)
+
+	// 不同空间的缓存互相隔离
+	diffSpace, err := responseCacheKey(200, model, input, entity.WithTemperature(0.5))
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, diffSpace)
+}
+
+func TestResponseCacheConfig_GetTTL(t *testing.T) {
+	var nilCfg *entity.ResponseCacheConfig
+	_, ok := nilCfg.GetTTL(nil)
+	assert.False(t, ok)
+
+	cfg := &entity.ResponseCacheConfig{Scenarios: map[entity.Scenario]*entity.ResponseCacheScenarioConfig{
+		entity.ScenarioDefault:    {Enable: true, TTLSeconds: 60},
+		entity.ScenarioEvaluator:  {Enable: true},
+		entity.ScenarioEvalTarget: {Enable: false, TTLSeconds: 60},
+	}}
+	ttl, ok := cfg.GetTTL(nil)
+	assert.True(t, ok)
+	assert.Equal(t, int64(60), int64(ttl.Seconds()))
+	ttl, ok = cfg.GetTTL(ptr.Of(entity.ScenarioEvaluator))
+	assert.True(t, ok)
+	assert.Equal(t, entity.DefaultResponseCacheTTL, ttl)
+	_, ok = cfg.GetTTL(ptr.Of(entity.ScenarioEvalTarget))
+	assert.False(t, ok)
+	_, ok = cfg.GetTTL(ptr.Of(entity.ScenarioPromptDebug))
+	assert.False(t, ok)
+}
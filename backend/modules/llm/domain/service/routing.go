@@ -0,0 +1,160 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"regexp"
+	"strings"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// route 按模型在该场景下的路由策略依次请求候选模型，返回最后一次实际请求的模型，主模型需已通过校验与准入
+func (r *RuntimeImpl) route(ctx context.Context, model *entity.Model, scenario *entity.Scenario, admit entity.CandidateAdmitter,
+	input []*entity.Message, opts []entity.Option, call func(candidate *entity.Model) error,
+) (*entity.Model, error) {
+	policy := model.GetRoutingPolicy(scenario)
+	var lastModel *entity.Model
+	var lastErr error
+	for _, modelID := range routingCandidates(model, policy) {
+		candidate := model
+		if modelID != model.ID {
+			if candidate = r.getRoutingCandidate(ctx, model, modelID, scenario, input, opts...); candidate == nil {
+				continue
+			}
+			if admit != nil {
+				if err := admit(ctx, candidate); err != nil {
+					logs.CtxWarn(ctx, "[route] model %d is not admitted, skip it, err=%v", candidate.ID, err)
+					continue
+				}
+			}
+		}
+		err := call(candidate)
+		if err == nil {
+			return candidate, nil
+		}
+		lastModel, lastErr = candidate, err
+		errType := ClassifyModelError(err)
+		if ctx.Err() != nil || !policy.ShouldFallback(errType) {
+			return candidate, err
+		}
+		logs.CtxWarn(ctx, "[route] call model %d failed with %s, try next candidate, err=%v", candidate.ID, errType, err)
+	}
+	return lastModel, lastErr
+}
+
+// getRoutingCandidate 获取可替代主模型的候选模型，不可用时返回nil
+func (r *RuntimeImpl) getRoutingCandidate(ctx context.Context, origin *entity.Model, modelID int64, scenario *entity.Scenario,
+	input []*entity.Message, opts ...entity.Option,
+) *entity.Model {
+	candidate, err := r.manageSrv.GetModelByID(ctx, modelID)
+	if err != nil {
+		logs.CtxWarn(ctx, "[getRoutingCandidate] get model %d failed, err=%v", modelID, err)
+		return nil
+	}
+	// 空间内的模型只能被同空间的模型路由到
+	if !candidate.VisibleIn(origin.WorkspaceID) || !candidate.Available(scenario) {
+		logs.CtxWarn(ctx, "[getRoutingCandidate] model %d is not available for model %d", modelID, origin.ID)
+		return nil
+	}
+	if err = candidate.Valid(); err != nil {
+		logs.CtxWarn(ctx, "[getRoutingCandidate] model %d is invalid, err=%v", modelID, err)
+		return nil
+	}
+	if err = r.ValidModelAndRequest(ctx, candidate, input, opts...); err != nil {
+		logs.CtxWarn(ctx, "[getRoutingCandidate] model %d is not compatible with request, err=%v", modelID, err)
+		return nil
+	}
+	return candidate
+}
+
+// routingCandidates 返回候选模型id，主模型与 Balance 按权重随机排序，其后为 Fallbacks，重复的模型只保留第一次出现的位置
+func routingCandidates(model *entity.Model, policy *entity.RoutingPolicy) []int64 {
+	if policy == nil {
+		return []int64{model.ID}
+	}
+	group := []*entity.RoutingTarget{{ModelID: model.ID, Weight: policy.Weight}}
+	for _, target := range policy.Balance {
+		if target != nil {
+			group = append(group, target)
+		}
+	}
+	ids := weightedShuffle(group)
+	for _, target := range policy.Fallbacks {
+		if target != nil {
+			ids = append(ids, target.ModelID)
+		}
+	}
+	res := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+func weightedShuffle(targets []*entity.RoutingTarget) []int64 {
+	weights := make([]int64, len(targets))
+	var total int64
+	for i, target := range targets {
+		weights[i] = max(target.Weight, 1)
+		total += weights[i]
+	}
+	res := make([]int64, 0, len(targets))
+	for len(res) < len(targets) {
+		n := rand.Int64N(total)
+		for i, w := range weights {
+			if n < w {
+				res = append(res, targets[i].ModelID)
+				total -= w
+				weights[i] = 0
+				break
+			}
+			n -= w
+		}
+	}
+	return res
+}
+
+var (
+	rateLimitStatusRegexp   = regexp.MustCompile(`(status|code)\D{0,16}429\b`)
+	timeoutStatusRegexp     = regexp.MustCompile(`(status|code)\D{0,16}(408|504)\b`)
+	serverErrorStatusRegexp = regexp.MustCompile(`(status|code)\D{0,16}5\d{2}\b`)
+)
+
+// ClassifyModelError 根据模型请求失败的错误信息判断错误类型，无法识别或不是请求模型失败时返回空
+func ClassifyModelError(err error) entity.ModelErrorType {
+	statusErr, ok := errorx.FromStatusError(err)
+	if !ok || statusErr.Code() != llm_errorx.CallModelFailedCode {
+		return ""
+	}
+	msg := strings.ToLower(errorx.ErrorWithoutStack(err))
+	switch {
+	case rateLimitStatusRegexp.MatchString(msg) || containsAny(msg, "rate limit", "ratelimit", "rate_limit", "too many requests"):
+		return entity.ModelErrorTypeRateLimit
+	case timeoutStatusRegexp.MatchString(msg) || containsAny(msg, "timeout", "timed out", "deadline exceeded"):
+		return entity.ModelErrorTypeTimeout
+	case serverErrorStatusRegexp.MatchString(msg) || containsAny(msg, "internal server error", "bad gateway", "service unavailable", "overloaded"):
+		return entity.ModelErrorTypeServerError
+	default:
+		return ""
+	}
+}
+
+func containsAny(s string, substrs ...string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
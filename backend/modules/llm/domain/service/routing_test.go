@@ -0,0 +1,310 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	llmconfmocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	llmfactorymocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llmfactory/mocks"
+	llmifacemocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llminterface/mocks"
+	servicemocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/mocks"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/unittest"
+)
+
+func TestRuntimeImpl_GenerateWithRouting(t *testing.T) {
+	newModel := func(id, spaceID int64, policy *entity.RoutingPolicy) *entity.Model {
+		return &entity.Model{
+			ID:             id,
+			WorkspaceID:    spaceID,
+			Name:           "model",
+			Frame:          entity.FrameEino,
+			Protocol:       entity.ProtocolArk,
+			ProtocolConfig: &entity.ProtocolConfig{APIKey: "your api key", Model: "your model"},
+			ScenarioConfigs: map[entity.Scenario]*entity.ScenarioConfig{
+				entity.ScenarioEvalTarget: {Scenario: entity.ScenarioEvalTarget, Routing: policy},
+			},
+		}
+	}
+	rateLimitErr := errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("error, status code: 429, message: too many requests"))
+	invalidReqErr := errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("error, status code: 400, message: invalid request"))
+	input := []*entity.Message{{Role: entity.RoleUser, Content: "hi"}}
+
+	tests := []struct {
+		name          string
+		model         *entity.Model
+		scenario      *entity.Scenario
+		admit         entity.CandidateAdmitter
+		mockSetter    func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM)
+		wantModelID   int64
+		wantContent   string
+		wantErr       error
+		wantCallCount int
+	}{
+		{
+			name:     "fallback on rate limit",
+			model:    newModel(1, 0, &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}}}),
+			scenario: ptr.Of(entity.ScenarioEvalTarget),
+			mockSetter: func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM) {
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil).Times(2)
+				manageMock.EXPECT().GetModelByID(gomock.Any(), int64(2)).Return(newModel(2, 0, nil), nil)
+				gomock.InOrder(
+					llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr),
+					llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.Message{Content: "from fallback"}, nil),
+				)
+			},
+			wantModelID: 2,
+			wantContent: "from fallback",
+		},
+		{
+			name:     "no fallback on request error",
+			model:    newModel(1, 0, &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}}}),
+			scenario: ptr.Of(entity.ScenarioEvalTarget),
+			mockSetter: func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM) {
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil)
+				llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, invalidReqErr)
+			},
+			wantModelID: 1,
+			wantErr:     errorx.NewByCode(llm_errorx.CallModelFailedCode),
+		},
+		{
+			name:     "error type not in fallback_on",
+			model:    newModel(1, 0, &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}}, FallbackOn: []entity.ModelErrorType{entity.ModelErrorTypeTimeout}}),
+			scenario: ptr.Of(entity.ScenarioEvalTarget),
+			mockSetter: func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM) {
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil)
+				llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr)
+			},
+			wantModelID: 1,
+			wantErr:     errorx.NewByCode(llm_errorx.CallModelFailedCode),
+		},
+		{
+			name:     "policy of other scenario is ignored",
+			model:    newModel(1, 0, &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}}}),
+			scenario: ptr.Of(entity.ScenarioPromptDebug),
+			mockSetter: func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM) {
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil)
+				llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr)
+			},
+			wantModelID: 1,
+			wantErr:     errorx.NewByCode(llm_errorx.CallModelFailedCode),
+		},
+		{
+			name:     "skip unavailable candidates",
+			model:    newModel(1, 0, &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}, {ModelID: 3}, {ModelID: 4}}}),
+			scenario: ptr.Of(entity.ScenarioEvalTarget),
+			mockSetter: func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM) {
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil).Times(2)
+				// 空间内的模型不能作为配置文件模型的降级目标
+				manageMock.EXPECT().GetModelByID(gomock.Any(), int64(2)).Return(newModel(2, 100, nil), nil)
+				manageMock.EXPECT().GetModelByID(gomock.Any(), int64(3)).Return(nil, errorx.NewByCode(llm_errorx.ResourceNotFoundCode))
+				manageMock.EXPECT().GetModelByID(gomock.Any(), int64(4)).Return(newModel(4, 0, nil), nil)
+				gomock.InOrder(
+					llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr),
+					llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.Message{Content: "from model 4"}, nil),
+				)
+			},
+			wantModelID: 4,
+			wantContent: "from model 4",
+		},
+		{
+			name:     "skip candidates rejected by admitter",
+			model:    newModel(1, 0, &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}, {ModelID: 3}}}),
+			scenario: ptr.Of(entity.ScenarioEvalTarget),
+			admit: func(ctx context.Context, candidate *entity.Model) error {
+				if candidate.ID == 2 {
+					return errorx.NewByCode(llm_errorx.ModelQPMLimitCode)
+				}
+				return nil
+			},
+			mockSetter: func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM) {
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil).Times(2)
+				manageMock.EXPECT().GetModelByID(gomock.Any(), int64(2)).Return(newModel(2, 0, nil), nil)
+				manageMock.EXPECT().GetModelByID(gomock.Any(), int64(3)).Return(newModel(3, 0, nil), nil)
+				gomock.InOrder(
+					llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr),
+					llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.Message{Content: "from model 3"}, nil),
+				)
+			},
+			wantModelID: 3,
+			wantContent: "from model 3",
+		},
+		{
+			name:     "all fallbacks rejected by admitter",
+			model:    newModel(1, 0, &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}}}),
+			scenario: ptr.Of(entity.ScenarioEvalTarget),
+			admit: func(ctx context.Context, candidate *entity.Model) error {
+				return errorx.NewByCode(llm_errorx.ModelTokenQuotaExceededCode)
+			},
+			mockSetter: func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM) {
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil)
+				manageMock.EXPECT().GetModelByID(gomock.Any(), int64(2)).Return(newModel(2, 0, nil), nil)
+				llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr)
+			},
+			wantModelID: 1,
+			wantErr:     errorx.NewByCode(llm_errorx.CallModelFailedCode),
+		},
+		{
+			name:     "all candidates failed",
+			model:    newModel(1, 0, &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}}}),
+			scenario: ptr.Of(entity.ScenarioEvalTarget),
+			mockSetter: func(factMock *llmfactorymocks.MockIFactory, manageMock *servicemocks.MockIManage, llmMock *llmifacemocks.MockILLM) {
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil).Times(2)
+				manageMock.EXPECT().GetModelByID(gomock.Any(), int64(2)).Return(newModel(2, 0, nil), nil)
+				llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr).Times(2)
+			},
+			wantModelID: 2,
+			wantErr:     errorx.NewByCode(llm_errorx.CallModelFailedCode),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			factMock := llmfactorymocks.NewMockIFactory(ctrl)
+			manageMock := servicemocks.NewMockIManage(ctrl)
+			llmMock := llmifacemocks.NewMockILLM(ctrl)
+			tt.mockSetter(factMock, manageMock, llmMock)
+			cfgMock := llmconfmocks.NewMockIConfigRuntime(ctrl)
+			cfgMock.EXPECT().GetResponseCacheTTL(gomock.Any()).Return(time.Duration(0), false)
+			r := &RuntimeImpl{
+				llmFact:    factMock,
+				manageSrv:  manageMock,
+				runtimeCfg: cfgMock,
+			}
+			got, gotModel, err := r.Generate(context.Background(), 100, tt.model, tt.scenario, tt.admit, input)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantModelID, gotModel.ID)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantContent, got.Content)
+		})
+	}
+}
+
+func TestRuntimeImpl_StreamWithRouting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	model := &entity.Model{
+		ID:             1,
+		Name:           "model",
+		Protocol:       entity.ProtocolArk,
+		ProtocolConfig: &entity.ProtocolConfig{},
+		ScenarioConfigs: map[entity.Scenario]*entity.ScenarioConfig{
+			entity.ScenarioDefault: {Routing: &entity.RoutingPolicy{Fallbacks: []*entity.RoutingTarget{{ModelID: 2}}}},
+		},
+	}
+	fallback := &entity.Model{ID: 2, Name: "fallback", Protocol: entity.ProtocolArk, ProtocolConfig: &entity.ProtocolConfig{}}
+	factMock := llmfactorymocks.NewMockIFactory(ctrl)
+	manageMock := servicemocks.NewMockIManage(ctrl)
+	llmMock := llmifacemocks.NewMockILLM(ctrl)
+	factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil).Times(2)
+	manageMock.EXPECT().GetModelByID(gomock.Any(), int64(2)).Return(fallback, nil)
+	sr := &mockIStreamReader{}
+	gomock.InOrder(
+		llmMock.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil,
+			errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("context deadline exceeded"))),
+		llmMock.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any()).Return(sr, nil),
+	)
+	r := &RuntimeImpl{llmFact: factMock, manageSrv: manageMock}
+	got, gotModel, err := r.Stream(context.Background(), model, nil, nil, []*entity.Message{{Role: entity.RoleUser, Content: "hi"}})
+	assert.Nil(t, err)
+	assert.Equal(t, sr, got)
+	assert.Equal(t, int64(2), gotModel.ID)
+}
+
+func TestClassifyModelError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want entity.ModelErrorType
+	}{
+		{
+			name: "nil",
+			err:  nil,
+			want: "",
+		},
+		{
+			name: "not call model failed",
+			err:  errorx.NewByCode(llm_errorx.BuildLLMFailedCode, errorx.WithExtraMsg("status code: 500")),
+			want: "",
+		},
+		{
+			name: "plain error",
+			err:  errors.New("rate limit"),
+			want: "",
+		},
+		{
+			name: "rate limit status",
+			err:  errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("error, status code: 429, message: slow down")),
+			want: entity.ModelErrorTypeRateLimit,
+		},
+		{
+			name: "rate limit message",
+			err:  errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("Request Rate Limit Exceeded")),
+			want: entity.ModelErrorTypeRateLimit,
+		},
+		{
+			name: "timeout",
+			err:  errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("Post \"https://example.com\": context deadline exceeded")),
+			want: entity.ModelErrorTypeTimeout,
+		},
+		{
+			name: "gateway timeout",
+			err:  errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("Error code: 504")),
+			want: entity.ModelErrorTypeTimeout,
+		},
+		{
+			name: "server error",
+			err:  errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("error, status code: 502, message: upstream error")),
+			want: entity.ModelErrorTypeServerError,
+		},
+		{
+			name: "overloaded",
+			err:  errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("overloaded_error: Overloaded")),
+			want: entity.ModelErrorTypeServerError,
+		},
+		{
+			name: "number in message is not status",
+			err:  errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("max_tokens must be less than 512")),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyModelError(tt.err))
+		})
+	}
+}
+
+func TestRoutingCandidates(t *testing.T) {
+	model := &entity.Model{ID: 1}
+	assert.Equal(t, []int64{1}, routingCandidates(model, nil))
+
+	policy := &entity.RoutingPolicy{
+		Weight:    1,
+		Balance:   []*entity.RoutingTarget{{ModelID: 2, Weight: 3}, nil, {ModelID: 3}},
+		Fallbacks: []*entity.RoutingTarget{{ModelID: 4}, {ModelID: 2}, {ModelID: 5}},
+	}
+	for i := 0; i < 20; i++ {
+		got := routingCandidates(model, policy)
+		assert.Len(t, got, 5)
+		assert.ElementsMatch(t, []int64{1, 2, 3}, got[:3])
+		assert.Equal(t, []int64{4, 5}, got[3:])
+	}
+}
@@ -17,21 +17,26 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/llm/pkg/httputil"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/localos"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
 )
 
 //go:generate mockgen -destination=mocks/runtime.go -package=mocks . IRuntime
 type IRuntime interface {
-	// Generate 非流式
-	Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (*entity.Message, error)
-	// Stream 流式
-	Stream(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (
-		entity.IStreamReader, error)
+	// Generate 非流式，场景开启响应缓存时优先读取请求空间内的缓存，否则按模型在该场景下的路由策略请求，返回实际请求的模型。
+	// admit 用于路由到主模型以外的候选模型前做准入校验，可为空
+	Generate(ctx context.Context, spaceID int64, model *entity.Model, scenario *entity.Scenario, admit entity.CandidateAdmitter, input []*entity.Message, opts ...entity.Option) (
+		*entity.Message, *entity.Model, error)
+	// Stream 流式，按模型在该场景下的路由策略请求，返回实际请求的模型。降级仅发生在建立流之前
+	Stream(ctx context.Context, model *entity.Model, scenario *entity.Scenario, admit entity.CandidateAdmitter, input []*entity.Message, opts ...entity.Option) (
+		entity.IStreamReader, *entity.Model, error)
 	// CreateModelRequestRecord 记录模型请求
 	CreateModelRequestRecord(ctx context.Context, record *entity.ModelRequestRecord) (err error)
 	// HandleMsgsPreCallModel 在请求模型前处理消息，如把非公网URL转为base64
 	HandleMsgsPreCallModel(ctx context.Context, model *entity.Model, msgs []*entity.Message) ([]*entity.Message, error)
 	// ValidModelAndRequest 校验模型和请求是否兼容
 	ValidModelAndRequest(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) error
+	// Embed 批量向量化，模型需具备向量化能力。不经过路由与响应缓存
+	Embed(ctx context.Context, model *entity.Model, texts []string, opts ...entity.EmbeddingOption) (*entity.EmbeddingResult, error)
 }
 
 type RuntimeImpl struct {
@@ -39,32 +44,80 @@ type RuntimeImpl struct {
 	idGen       idgen.IIDGenerator
 	runtimeRepo repo.IRuntimeRepo
 	runtimeCfg  conf.IConfigRuntime
+	manageSrv   IManage
 }
 
 var _ IRuntime = (*RuntimeImpl)(nil)
 
-func (r *RuntimeImpl) Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (*entity.Message, error) {
+func (r *RuntimeImpl) Generate(ctx context.Context, spaceID int64, model *entity.Model, scenario *entity.Scenario, admit entity.CandidateAdmitter, input []*entity.Message, opts ...entity.Option) (
+	*entity.Message, *entity.Model, error,
+) {
 	if err := r.ValidModelAndRequest(ctx, model, input, opts...); err != nil {
-		return nil, err
+		return nil, model, err
+	}
+	// 响应缓存以请求的模型为准，命中时不再请求模型
+	var cacheKey string
+	ttl, cacheEnabled := r.runtimeCfg.GetResponseCacheTTL(scenario)
+	if cacheEnabled {
+		key, err := responseCacheKey(spaceID, model, input, opts...)
+		if err != nil {
+			logs.CtxWarn(ctx, "[Generate] build response cache key failed, err=%v", err)
+		} else if msg := r.getResponseCache(ctx, key); msg != nil {
+			return msg, model, nil
+		} else {
+			cacheKey = key
+		}
 	}
-	llm, err := r.buildLLM(ctx, model, opts...)
+	var msg *entity.Message
+	servedModel, err := r.route(ctx, model, scenario, admit, input, opts, func(candidate *entity.Model) error {
+		llm, err := r.buildLLM(ctx, candidate, opts...)
+		if err != nil {
+			return err
+		}
+		msg, err = llm.Generate(ctx, input, opts...)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return nil, servedModel, err
 	}
-	return llm.Generate(ctx, input, opts...)
+	if cacheKey != "" {
+		r.setResponseCache(ctx, cacheKey, msg, ttl)
+	}
+	return msg, servedModel, nil
 }
 
-func (r *RuntimeImpl) Stream(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (
-	entity.IStreamReader, error,
+func (r *RuntimeImpl) Stream(ctx context.Context, model *entity.Model, scenario *entity.Scenario, admit entity.CandidateAdmitter, input []*entity.Message, opts ...entity.Option) (
+	entity.IStreamReader, *entity.Model, error,
 ) {
 	if err := r.ValidModelAndRequest(ctx, model, input, opts...); err != nil {
-		return nil, err
+		return nil, model, err
+	}
+	var sr entity.IStreamReader
+	servedModel, err := r.route(ctx, model, scenario, admit, input, opts, func(candidate *entity.Model) error {
+		llm, err := r.buildLLM(ctx, candidate, opts...)
+		if err != nil {
+			return err
+		}
+		sr, err = llm.Stream(ctx, input, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, servedModel, err
 	}
-	llm, err := r.buildLLM(ctx, model, opts...)
+	return sr, servedModel, nil
+}
+
+func (r *RuntimeImpl) Embed(ctx context.Context, model *entity.Model, texts []string, opts ...entity.EmbeddingOption) (
+	*entity.EmbeddingResult, error,
+) {
+	if !model.SupportEmbedding() {
+		return nil, errorx.NewByCode(llm_errorx.RequestNotCompatibleWithModelAbilityCode, errorx.WithExtraMsg("this model does not support embedding"))
+	}
+	embedder, err := r.llmFact.CreateEmbedder(ctx, model, opts...)
 	if err != nil {
-		return nil, err
+		return nil, errorx.WrapByCode(err, llm_errorx.BuildLLMFailedCode)
 	}
-	return llm.Stream(ctx, input, opts...)
+	return embedder.EmbedStrings(ctx, texts)
 }
 
 func (r *RuntimeImpl) buildLLM(ctx context.Context, model *entity.Model, opts ...entity.Option) (llminterface.ILLM, error) {
@@ -104,6 +157,9 @@ func (r *RuntimeImpl) HandleMsgsPreCallModel(ctx context.Context, model *entity.
 }
 
 func (r *RuntimeImpl) ValidModelAndRequest(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) error {
+	if model.SupportEmbedding() {
+		return errorx.NewByCode(llm_errorx.RequestNotCompatibleWithModelAbilityCode, errorx.WithExtraMsg("this model is an embedding model and does not support chat"))
+	}
 	// 如果msg中有多模态输入，看模型是否支持多模态
 	var hasMultiModal, hasImageURL, hasImageBinary bool
 	var maxImageCnt, maxImageSizeInByte int64
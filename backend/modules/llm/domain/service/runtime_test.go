@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -157,6 +158,7 @@ func TestRuntimeImpl_Generate(t *testing.T) {
 				// idgenMock.EXPECT().GenID(gomock.Any()).Return(int64(1), nil)
 				cfgMock := llmconfmocks.NewMockIConfigRuntime(ctrl)
 				// cfgMock.EXPECT().NeedCvtURLToBase64().Return(true)
+				cfgMock.EXPECT().GetResponseCacheTTL(gomock.Any()).Return(time.Duration(0), false)
 				return fields{
 					llmFact:     factMock,
 					idGen:       idgenMock,
@@ -176,6 +178,70 @@ func TestRuntimeImpl_Generate(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "response cache hit",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				factMock := llmfactorymocks.NewMockIFactory(ctrl)
+				repoMock := llmrepomocks.NewMockIRuntimeRepo(ctrl)
+				repoMock.EXPECT().GetResponseCache(gomock.Any(), gomock.Any()).Return(&entity.Message{
+					Role:    entity.RoleAssistant,
+					Content: "cached content",
+				}, nil)
+				cfgMock := llmconfmocks.NewMockIConfigRuntime(ctrl)
+				cfgMock.EXPECT().GetResponseCacheTTL(gomock.Any()).Return(time.Hour, true)
+				return fields{
+					llmFact:     factMock,
+					runtimeRepo: repoMock,
+					runtimeCfg:  cfgMock,
+				}
+			},
+			args: args{
+				ctx:   context.Background(),
+				model: model,
+				input: multimodalInput,
+				opts:  opts,
+			},
+			want: &entity.Message{
+				Role:         entity.RoleAssistant,
+				Content:      "cached content",
+				ResponseMeta: &entity.ResponseMeta{CacheHit: true},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "response cache miss",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				factMock := llmfactorymocks.NewMockIFactory(ctrl)
+				llmMock := llmifacemocks.NewMockILLM(ctrl)
+				factMock.EXPECT().CreateLLM(gomock.Any(), gomock.Any(), gomock.Any()).Return(llmMock, nil)
+				llmMock.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.Message{
+					Role:    entity.RoleAssistant,
+					Content: "there is content",
+				}, nil)
+				repoMock := llmrepomocks.NewMockIRuntimeRepo(ctrl)
+				// 读缓存失败不影响请求模型
+				repoMock.EXPECT().GetResponseCache(gomock.Any(), gomock.Any()).Return(nil, errorx.NewByCode(llm_errorx.CommonRedisErrorCode))
+				repoMock.EXPECT().SetResponseCache(gomock.Any(), gomock.Any(), gomock.Any(), time.Hour).Return(nil)
+				cfgMock := llmconfmocks.NewMockIConfigRuntime(ctrl)
+				cfgMock.EXPECT().GetResponseCacheTTL(gomock.Any()).Return(time.Hour, true)
+				return fields{
+					llmFact:     factMock,
+					runtimeRepo: repoMock,
+					runtimeCfg:  cfgMock,
+				}
+			},
+			args: args{
+				ctx:   context.Background(),
+				model: model,
+				input: multimodalInput,
+				opts:  opts,
+			},
+			want: &entity.Message{
+				Role:    entity.RoleAssistant,
+				Content: "there is content",
+			},
+			wantErr: nil,
+		},
 		{
 			name: "valid failed",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
@@ -240,12 +306,13 @@ func TestRuntimeImpl_Generate(t *testing.T) {
 				runtimeRepo: ttFields.runtimeRepo,
 				runtimeCfg:  ttFields.runtimeCfg,
 			}
-			got, err := r.Generate(tt.args.ctx, tt.args.model, tt.args.input, tt.args.opts...)
+			got, _, err := r.Generate(tt.args.ctx, 100, tt.args.model, nil, nil, tt.args.input, tt.args.opts...)
 			unittest.AssertErrorEqual(t, tt.wantErr, err)
 			if err != nil {
 				return
 			}
 			assert.Equal(t, tt.want.Content, got.Content)
+			assert.Equal(t, tt.want.IsCacheHit(), got.IsCacheHit())
 		})
 	}
 }
@@ -533,7 +600,7 @@ func TestRuntimeImpl_Stream(t *testing.T) {
 				runtimeRepo: ttFields.runtimeRepo,
 				runtimeCfg:  ttFields.runtimeCfg,
 			}
-			got, err := r.Stream(tt.args.ctx, tt.args.model, tt.args.input, tt.args.opts...)
+			got, _, err := r.Stream(tt.args.ctx, tt.args.model, nil, nil, tt.args.input, tt.args.opts...)
 			unittest.AssertErrorEqual(t, tt.wantErr, err)
 			var content string
 			for {
@@ -548,3 +615,86 @@ func TestRuntimeImpl_Stream(t *testing.T) {
 		})
 	}
 }
+
+func TestRuntimeImpl_Embed(t *testing.T) {
+	embeddingModel := &entity.Model{
+		ID:       1,
+		Name:     "embedding model",
+		Ability:  &entity.Ability{Embedding: true},
+		Frame:    entity.FrameEino,
+		Protocol: entity.ProtocolOpenAI,
+		ProtocolConfig: &entity.ProtocolConfig{
+			APIKey: "your api key",
+			Model:  "text-embedding-3-small",
+		},
+	}
+	chatModel := &entity.Model{
+		ID:       2,
+		Name:     "chat model",
+		Ability:  &entity.Ability{},
+		Frame:    entity.FrameEino,
+		Protocol: entity.ProtocolOpenAI,
+		ProtocolConfig: &entity.ProtocolConfig{
+			APIKey: "your api key",
+			Model:  "gpt-4o",
+		},
+	}
+	result := &entity.EmbeddingResult{
+		Embeddings: [][]float64{{0.1, 0.2}, {0.3, 0.4}},
+		Usage:      &entity.TokenUsage{PromptTokens: 4, TotalTokens: 4},
+	}
+	tests := []struct {
+		name        string
+		model       *entity.Model
+		factGetter  func(ctrl *gomock.Controller) llmfactory.IFactory
+		want        *entity.EmbeddingResult
+		wantErrCode int32
+	}{
+		{
+			name:  "success",
+			model: embeddingModel,
+			factGetter: func(ctrl *gomock.Controller) llmfactory.IFactory {
+				factMock := llmfactorymocks.NewMockIFactory(ctrl)
+				embedderMock := llmifacemocks.NewMockIEmbedder(ctrl)
+				factMock.EXPECT().CreateEmbedder(gomock.Any(), embeddingModel, gomock.Any()).Return(embedderMock, nil)
+				embedderMock.EXPECT().EmbedStrings(gomock.Any(), []string{"hello", "world"}).Return(result, nil)
+				return factMock
+			},
+			want: result,
+		},
+		{
+			name:  "model does not support embedding",
+			model: chatModel,
+			factGetter: func(ctrl *gomock.Controller) llmfactory.IFactory {
+				return llmfactorymocks.NewMockIFactory(ctrl)
+			},
+			wantErrCode: llm_errorx.RequestNotCompatibleWithModelAbilityCode,
+		},
+		{
+			name:  "build embedder failed",
+			model: embeddingModel,
+			factGetter: func(ctrl *gomock.Controller) llmfactory.IFactory {
+				factMock := llmfactorymocks.NewMockIFactory(ctrl)
+				factMock.EXPECT().CreateEmbedder(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errorx.New("build failed"))
+				return factMock
+			},
+			wantErrCode: llm_errorx.BuildLLMFailedCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			r := &RuntimeImpl{llmFact: tt.factGetter(ctrl)}
+			got, err := r.Embed(context.Background(), tt.model, []string{"hello", "world"}, entity.WithDimensions(2))
+			if tt.wantErrCode != 0 {
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantErrCode, statusErr.Code())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+const (
+	// maxUsageStats 单次查询最多返回的统计组数
+	maxUsageStats = 10000
+	// maxUsageWindow 单次查询的最大时间跨度，按小时分桶时跨度更小以控制返回的组数
+	maxUsageWindow       = 366 * 24 * time.Hour
+	maxHourlyUsageWindow = 31 * 24 * time.Hour
+)
+
+//go:generate mockgen -destination=mocks/usage.go -package=mocks . IUsage
+type IUsage interface {
+	// QueryModelUsage 按维度与时间粒度聚合模型请求记录，统计 token、成本、错误率与耗时
+	QueryModelUsage(ctx context.Context, query *entity.ModelUsageQuery) (stats []*entity.ModelUsageStat, err error)
+}
+
+type UsageImpl struct {
+	runtimeRepo repo.IRuntimeRepo
+}
+
+var _ IUsage = (*UsageImpl)(nil)
+
+func (u *UsageImpl) QueryModelUsage(ctx context.Context, query *entity.ModelUsageQuery) (stats []*entity.ModelUsageStat, err error) {
+	if err = validModelUsageQuery(query); err != nil {
+		return nil, errorx.NewByCode(llm_errorx.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return u.runtimeRepo.AggregateModelRequestRecords(ctx, query, maxUsageStats)
+}
+
+func validModelUsageQuery(query *entity.ModelUsageQuery) error {
+	if query == nil || len(query.SpaceIDs) == 0 {
+		return fmt.Errorf("workspace ids are required")
+	}
+	if !query.EndTime.After(query.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	window := query.EndTime.Sub(query.StartTime)
+	if window > maxUsageWindow {
+		return fmt.Errorf("time window must not exceed %d days", int(maxUsageWindow.Hours()/24))
+	}
+	if query.Granularity != nil {
+		if !query.Granularity.Valid() {
+			return fmt.Errorf("granularity %s is not supported", *query.Granularity)
+		}
+		if *query.Granularity == entity.UsageGranularityHour && window > maxHourlyUsageWindow {
+			return fmt.Errorf("time window must not exceed %d days when grouped by hour", int(maxHourlyUsageWindow.Hours()/24))
+		}
+	}
+	for _, dim := range query.GroupBy {
+		if !dim.Valid() {
+			return fmt.Errorf("group by dimension %s is not supported", dim)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo/mocks"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TestUsageImpl_QueryModelUsage(t *testing.T) {
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.Local)
+	stats := []*entity.ModelUsageStat{{Scenario: ptr.Of(entity.ScenarioPromptDebug), RequestCount: 2, Cost: 0.5}}
+	tests := []struct {
+		name        string
+		query       *entity.ModelUsageQuery
+		repoMock    func(ctrl *gomock.Controller) *repomocks.MockIRuntimeRepo
+		want        []*entity.ModelUsageStat
+		wantErrCode int32
+	}{
+		{
+			name: "group by scenario per day",
+			query: &entity.ModelUsageQuery{
+				SpaceIDs:    []int64{1},
+				StartTime:   start,
+				EndTime:     start.AddDate(0, 1, 0),
+				GroupBy:     []entity.UsageDimension{entity.UsageDimensionScenario},
+				Granularity: ptr.Of(entity.UsageGranularityDay),
+			},
+			repoMock: func(ctrl *gomock.Controller) *repomocks.MockIRuntimeRepo {
+				r := repomocks.NewMockIRuntimeRepo(ctrl)
+				r.EXPECT().AggregateModelRequestRecords(gomock.Any(), gomock.Any(), maxUsageStats).Return(stats, nil)
+				return r
+			},
+			want: stats,
+		},
+		{
+			name:        "space required",
+			query:       &entity.ModelUsageQuery{StartTime: start, EndTime: start.Add(time.Hour)},
+			wantErrCode: llm_errorx.CommonInvalidParamCode,
+		},
+		{
+			name:        "end before start",
+			query:       &entity.ModelUsageQuery{SpaceIDs: []int64{1}, StartTime: start, EndTime: start},
+			wantErrCode: llm_errorx.CommonInvalidParamCode,
+		},
+		{
+			name:        "window too large",
+			query:       &entity.ModelUsageQuery{SpaceIDs: []int64{1}, StartTime: start, EndTime: start.AddDate(2, 0, 0)},
+			wantErrCode: llm_errorx.CommonInvalidParamCode,
+		},
+		{
+			name: "hourly window too large",
+			query: &entity.ModelUsageQuery{
+				SpaceIDs:    []int64{1},
+				StartTime:   start,
+				EndTime:     start.AddDate(0, 2, 0),
+				Granularity: ptr.Of(entity.UsageGranularityHour),
+			},
+			wantErrCode: llm_errorx.CommonInvalidParamCode,
+		},
+		{
+			name: "unknown dimension",
+			query: &entity.ModelUsageQuery{
+				SpaceIDs:  []int64{1},
+				StartTime: start,
+				EndTime:   start.Add(time.Hour),
+				GroupBy:   []entity.UsageDimension{"provider"},
+			},
+			wantErrCode: llm_errorx.CommonInvalidParamCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			u := &UsageImpl{}
+			if tt.repoMock != nil {
+				u.runtimeRepo = tt.repoMock(ctrl)
+			}
+			got, err := u.QueryModelUsage(context.Background(), tt.query)
+			if tt.wantErrCode != 0 {
+				statusErr, ok := errorx.FromStatusError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantErrCode, statusErr.Code())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/cloudwego/eino-ext/components/model/qianfan"
 
@@ -40,3 +41,17 @@ func (r *RuntimeImpl) NeedCvtURLToBase64() bool {
 	}
 	return r.cfg.NeedCvtURLToBase64
 }
+
+func (r *RuntimeImpl) GetResponseCacheTTL(scenario *entity.Scenario) (time.Duration, bool) {
+	if r == nil || r.cfg == nil {
+		return 0, false
+	}
+	return r.cfg.ResponseCache.GetTTL(scenario)
+}
+
+func (r *RuntimeImpl) GetQuotaConfig() *entity.QuotaConfig {
+	if r == nil || r.cfg == nil {
+		return nil
+	}
+	return r.cfg.Quota
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+// LlmModelDO2PO 协议配置以明文 json 写入，由 repo 层加密后落库
+func LlmModelDO2PO(do *entity.Model) *model.LlmModel {
+	if do == nil {
+		return nil
+	}
+	return &model.LlmModel{
+		ID:              do.ID,
+		SpaceID:         do.WorkspaceID,
+		Name:            do.Name,
+		Description:     do.Desc,
+		Frame:           string(do.Frame),
+		Protocol:        string(do.Protocol),
+		Ability:         ptr.Of(json.Jsonify(do.Ability)),
+		ProtocolConfig:  ptr.Of(json.Jsonify(do.ProtocolConfig)),
+		ScenarioConfigs: ptr.Of(json.Jsonify(do.ScenarioConfigs)),
+		ParamConfig:     ptr.Of(json.Jsonify(do.ParamConfig)),
+		Price:           ptr.Of(json.Jsonify(do.Price)),
+	}
+}
+
+// LlmModelPO2DO 要求 po 中的协议配置已由 repo 层解密
+func LlmModelPO2DO(po *model.LlmModel) (*entity.Model, error) {
+	if po == nil {
+		return nil, nil
+	}
+	do := &entity.Model{
+		ID:          po.ID,
+		WorkspaceID: po.SpaceID,
+		Name:        po.Name,
+		Desc:        po.Description,
+		Frame:       entity.Frame(po.Frame),
+		Protocol:    entity.Protocol(po.Protocol),
+	}
+	if po.Ability != nil {
+		if err := json.Unmarshal([]byte(ptr.From(po.Ability)), &do.Ability); err != nil {
+			return nil, err
+		}
+	}
+	if po.ProtocolConfig != nil {
+		if err := json.Unmarshal([]byte(ptr.From(po.ProtocolConfig)), &do.ProtocolConfig); err != nil {
+			return nil, err
+		}
+	}
+	if po.ScenarioConfigs != nil {
+		if err := json.Unmarshal([]byte(ptr.From(po.ScenarioConfigs)), &do.ScenarioConfigs); err != nil {
+			return nil, err
+		}
+	}
+	if po.ParamConfig != nil {
+		if err := json.Unmarshal([]byte(ptr.From(po.ParamConfig)), &do.ParamConfig); err != nil {
+			return nil, err
+		}
+	}
+	if po.Price != nil {
+		if err := json.Unmarshal([]byte(ptr.From(po.Price)), &do.Price); err != nil {
+			return nil, err
+		}
+	}
+	return do, nil
+}
@@ -4,10 +4,24 @@
 package convertor
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 )
 
+// timeBucketLayout 各粒度的时间桶均格式化为该布局，便于统一解析
+const timeBucketLayout = "2006-01-02 15:04:05"
+
+var timeBucketFormats = map[entity.UsageGranularity]string{
+	entity.UsageGranularityHour:  "%Y-%m-%d %H:00:00",
+	entity.UsageGranularityDay:   "%Y-%m-%d 00:00:00",
+	entity.UsageGranularityMonth: "%Y-%m-01 00:00:00",
+}
+
 func ModelReqRecordDO2PO(record *entity.ModelRequestRecord) *model.ModelRequestRecord {
 	if record == nil {
 		return nil
@@ -24,8 +38,12 @@ func ModelReqRecordDO2PO(record *entity.ModelRequestRecord) *model.ModelRequestR
 		ModelAk:             record.ModelAk,
 		ModelID:             record.ModelID,
 		ModelName:           record.ModelName,
+		OriginModelID:       record.OriginModelID,
+		CacheHit:            record.CacheHit,
 		InputToken:          record.InputToken,
 		OutputToken:         record.OutputToken,
+		Cost:                record.Cost,
+		LatencyMs:           record.LatencyMs,
 		Logid:               record.Logid,
 		ErrorCode:           record.ErrorCode,
 		ErrorMsg:            record.ErrorMsg,
@@ -33,3 +51,64 @@ func ModelReqRecordDO2PO(record *entity.ModelRequestRecord) *model.ModelRequestR
 		UpdatedAt:           record.UpdatedAt,
 	}
 }
+
+func ModelUsageQueryDO2Param(q *entity.ModelUsageQuery, limit int) *dao.AggregateModelRequestRecordParam {
+	param := &dao.AggregateModelRequestRecordParam{
+		SpaceIDs:          q.SpaceIDs,
+		StartTime:         q.StartTime,
+		EndTime:           q.EndTime,
+		UserID:            q.UserID,
+		GroupBySpace:      q.GroupByDimension(entity.UsageDimensionWorkspace),
+		GroupByUser:       q.GroupByDimension(entity.UsageDimensionUser),
+		GroupByModel:      q.GroupByDimension(entity.UsageDimensionModel),
+		GroupByUsageScene: q.GroupByDimension(entity.UsageDimensionScenario),
+		Limit:             limit,
+	}
+	if q.ModelID != nil {
+		param.ModelID = ptr.Of(strconv.FormatInt(*q.ModelID, 10))
+	}
+	if q.Scenario != nil {
+		param.UsageScene = ptr.Of(string(*q.Scenario))
+	}
+	if q.Granularity != nil {
+		param.TimeBucketFormat = timeBucketFormats[*q.Granularity]
+	}
+	return param
+}
+
+func ModelRequestRecordAggregatesPO2DO(rows []*dao.ModelRequestRecordAggregate) []*entity.ModelUsageStat {
+	stats := make([]*entity.ModelUsageStat, 0, len(rows))
+	for _, row := range rows {
+		if row == nil {
+			continue
+		}
+		stat := &entity.ModelUsageStat{
+			SpaceID:       row.SpaceID,
+			UserID:        row.UserID,
+			ModelName:     row.ModelName,
+			RequestCount:  row.RequestCount,
+			ErrorCount:    row.ErrorCount,
+			CacheHitCount: row.CacheHitCount,
+			InputToken:    row.InputToken,
+			OutputToken:   row.OutputToken,
+			Cost:          row.Cost,
+			AvgLatencyMs:  row.AvgLatencyMs,
+		}
+		if row.ModelID != nil {
+			// 历史记录中的 model_id 可能不是数字，此时只保留模型名称
+			if modelID, err := strconv.ParseInt(*row.ModelID, 10, 64); err == nil {
+				stat.ModelID = ptr.Of(modelID)
+			}
+		}
+		if row.UsageScene != nil {
+			stat.Scenario = ptr.Of(entity.Scenario(*row.UsageScene))
+		}
+		if row.TimeBucket != nil {
+			if t, err := time.ParseInLocation(timeBucketLayout, *row.TimeBucket, time.Local); err == nil {
+				stat.TimeBucket = ptr.Of(t)
+			}
+		}
+		stats = append(stats, stat)
+	}
+	return stats
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
+)
+
+const (
+	costNanosPerUnit = 1e9
+	// quotaUsageTTLBuffer 周期结束后计数再保留一段时间，避免周期切换时读到已过期的计数
+	quotaUsageTTLBuffer = 24 * time.Hour
+)
+
+func QuotaUsageKeyDO2Key(key *entity.QuotaUsageKey) string {
+	return fmt.Sprintf("%d:%d:%s:%s", key.SpaceID, key.ModelID, key.Period, key.PeriodStart.Format("20060102"))
+}
+
+func QuotaUsageKeysDO2Keys(keys []*entity.QuotaUsageKey) []string {
+	res := make([]string, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, QuotaUsageKeyDO2Key(key))
+	}
+	return res
+}
+
+func QuotaUsageKeysDO2Counters(keys []*entity.QuotaUsageKey, now time.Time) []*dao.QuotaUsageCounter {
+	res := make([]*dao.QuotaUsageCounter, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, &dao.QuotaUsageCounter{
+			Key: QuotaUsageKeyDO2Key(key),
+			TTL: key.PeriodEnd.Sub(now) + quotaUsageTTLBuffer,
+		})
+	}
+	return res
+}
+
+func QuotaUsageDO2PO(usage *entity.QuotaUsage) *dao.QuotaUsage {
+	if usage == nil {
+		return nil
+	}
+	return &dao.QuotaUsage{
+		Tokens:    usage.Tokens,
+		CostNanos: int64(math.Round(usage.Cost * costNanosPerUnit)),
+	}
+}
+
+func QuotaUsagesPO2DO(usages []*dao.QuotaUsage) []*entity.QuotaUsage {
+	res := make([]*entity.QuotaUsage, 0, len(usages))
+	for _, usage := range usages {
+		if usage == nil {
+			res = append(res, &entity.QuotaUsage{})
+			continue
+		}
+		res = append(res, &entity.QuotaUsage{
+			Tokens: usage.Tokens,
+			Cost:   float64(usage.CostNanos) / costNanosPerUnit,
+		})
+	}
+	return res
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/query"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+//go:generate mockgen -destination=mocks/llm_model.go -package=mocks . ILlmModelDao
+type ILlmModelDao interface {
+	Create(ctx context.Context, modelPO *model.LlmModel, opts ...db.Option) (err error)
+	Update(ctx context.Context, modelID int64, updateFields map[string]interface{}, opts ...db.Option) (err error)
+	Delete(ctx context.Context, modelID int64, opts ...db.Option) (err error)
+	Get(ctx context.Context, modelID int64, opts ...db.Option) (modelPO *model.LlmModel, err error)
+	List(ctx context.Context, spaceID int64, opts ...db.Option) (modelPOs []*model.LlmModel, err error)
+}
+
+type LlmModelDaoImpl struct {
+	db db.Provider
+}
+
+func NewLlmModelDao(db db.Provider) ILlmModelDao {
+	return &LlmModelDaoImpl{db: db}
+}
+
+func (m *LlmModelDaoImpl) Create(ctx context.Context, modelPO *model.LlmModel, opts ...db.Option) (err error) {
+	if modelPO == nil {
+		return errorx.New("modelPO is empty")
+	}
+	q := query.Use(m.db.NewSession(ctx, opts...)).WithContext(ctx)
+	modelPO.CreatedAt = time.Time{}
+	modelPO.UpdatedAt = time.Time{}
+	if err = q.LlmModel.Create(modelPO); err != nil {
+		return errorx.WrapByCode(err, llm_errorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (m *LlmModelDaoImpl) Update(ctx context.Context, modelID int64, updateFields map[string]interface{}, opts ...db.Option) (err error) {
+	if modelID <= 0 {
+		return errorx.New("modelID is invalid, modelID = %d", modelID)
+	}
+	q := query.Use(m.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).LlmModel.Where(q.LlmModel.ID.Eq(modelID))
+	if _, err = tx.Updates(updateFields); err != nil {
+		return errorx.WrapByCode(err, llm_errorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (m *LlmModelDaoImpl) Delete(ctx context.Context, modelID int64, opts ...db.Option) (err error) {
+	if modelID <= 0 {
+		return errorx.New("modelID is invalid, modelID = %d", modelID)
+	}
+	q := query.Use(m.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).LlmModel.Where(q.LlmModel.ID.Eq(modelID))
+	if _, err = tx.Delete(&model.LlmModel{}); err != nil {
+		return errorx.WrapByCode(err, llm_errorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (m *LlmModelDaoImpl) Get(ctx context.Context, modelID int64, opts ...db.Option) (modelPO *model.LlmModel, err error) {
+	if modelID <= 0 {
+		return nil, errorx.New("modelID is invalid, modelID = %d", modelID)
+	}
+	q := query.Use(m.db.NewSession(ctx, opts...))
+	modelPOs, err := q.WithContext(ctx).LlmModel.Where(q.LlmModel.ID.Eq(modelID)).Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, llm_errorx.CommonMySqlErrorCode)
+	}
+	if len(modelPOs) <= 0 {
+		return nil, nil
+	}
+	return modelPOs[0], nil
+}
+
+func (m *LlmModelDaoImpl) List(ctx context.Context, spaceID int64, opts ...db.Option) (modelPOs []*model.LlmModel, err error) {
+	if spaceID <= 0 {
+		return nil, errorx.New("spaceID is invalid, spaceID = %d", spaceID)
+	}
+	q := query.Use(m.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).LlmModel.Where(q.LlmModel.SpaceID.Eq(spaceID))
+	modelPOs, err = tx.Order(q.LlmModel.ID.Asc()).Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, llm_errorx.CommonMySqlErrorCode)
+	}
+	return modelPOs, nil
+}
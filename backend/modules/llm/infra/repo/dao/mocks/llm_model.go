@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao (interfaces: ILlmModelDao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/llm_model.go -package=mocks . ILlmModelDao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	db "github.com/coze-dev/coze-loop/backend/infra/db"
+	model "github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockILlmModelDao is a mock of ILlmModelDao interface.
+type MockILlmModelDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockILlmModelDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockILlmModelDaoMockRecorder is the mock recorder for MockILlmModelDao.
+type MockILlmModelDaoMockRecorder struct {
+	mock *MockILlmModelDao
+}
+
+// NewMockILlmModelDao creates a new mock instance.
+func NewMockILlmModelDao(ctrl *gomock.Controller) *MockILlmModelDao {
+	mock := &MockILlmModelDao{ctrl: ctrl}
+	mock.recorder = &MockILlmModelDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILlmModelDao) EXPECT() *MockILlmModelDaoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockILlmModelDao) Create(ctx context.Context, modelPO *model.LlmModel, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, modelPO}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockILlmModelDaoMockRecorder) Create(ctx, modelPO any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, modelPO}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockILlmModelDao)(nil).Create), varargs...)
+}
+
+// Delete mocks base method.
+func (m *MockILlmModelDao) Delete(ctx context.Context, modelID int64, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, modelID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockILlmModelDaoMockRecorder) Delete(ctx, modelID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, modelID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockILlmModelDao)(nil).Delete), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockILlmModelDao) Get(ctx context.Context, modelID int64, opts ...db.Option) (*model.LlmModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, modelID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*model.LlmModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockILlmModelDaoMockRecorder) Get(ctx, modelID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, modelID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockILlmModelDao)(nil).Get), varargs...)
+}
+
+// List mocks base method.
+func (m *MockILlmModelDao) List(ctx context.Context, spaceID int64, opts ...db.Option) ([]*model.LlmModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, spaceID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*model.LlmModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockILlmModelDaoMockRecorder) List(ctx, spaceID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, spaceID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockILlmModelDao)(nil).List), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockILlmModelDao) Update(ctx context.Context, modelID int64, updateFields map[string]any, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, modelID, updateFields}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Update", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockILlmModelDaoMockRecorder) Update(ctx, modelID, updateFields any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, modelID, updateFields}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockILlmModelDao)(nil).Update), varargs...)
+}
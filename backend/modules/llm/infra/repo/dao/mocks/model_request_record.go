@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao (interfaces: IModelRequestRecordDao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/model_request_record.go -package=mocks . IModelRequestRecordDao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	db "github.com/coze-dev/coze-loop/backend/infra/db"
+	dao "github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
+	model "github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIModelRequestRecordDao is a mock of IModelRequestRecordDao interface.
+type MockIModelRequestRecordDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockIModelRequestRecordDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockIModelRequestRecordDaoMockRecorder is the mock recorder for MockIModelRequestRecordDao.
+type MockIModelRequestRecordDaoMockRecorder struct {
+	mock *MockIModelRequestRecordDao
+}
+
+// NewMockIModelRequestRecordDao creates a new mock instance.
+func NewMockIModelRequestRecordDao(ctrl *gomock.Controller) *MockIModelRequestRecordDao {
+	mock := &MockIModelRequestRecordDao{ctrl: ctrl}
+	mock.recorder = &MockIModelRequestRecordDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIModelRequestRecordDao) EXPECT() *MockIModelRequestRecordDaoMockRecorder {
+	return m.recorder
+}
+
+// Aggregate mocks base method.
+func (m *MockIModelRequestRecordDao) Aggregate(ctx context.Context, param *dao.AggregateModelRequestRecordParam, opts ...db.Option) ([]*dao.ModelRequestRecordAggregate, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, param}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Aggregate", varargs...)
+	ret0, _ := ret[0].([]*dao.ModelRequestRecordAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockIModelRequestRecordDaoMockRecorder) Aggregate(ctx, param any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, param}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockIModelRequestRecordDao)(nil).Aggregate), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockIModelRequestRecordDao) Create(ctx context.Context, modelPO *model.ModelRequestRecord, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, modelPO}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIModelRequestRecordDaoMockRecorder) Create(ctx, modelPO any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, modelPO}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIModelRequestRecordDao)(nil).Create), varargs...)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao (interfaces: IQuotaUsageDao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/quota_usage.go -package=mocks . IQuotaUsageDao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dao "github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIQuotaUsageDao is a mock of IQuotaUsageDao interface.
+type MockIQuotaUsageDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockIQuotaUsageDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockIQuotaUsageDaoMockRecorder is the mock recorder for MockIQuotaUsageDao.
+type MockIQuotaUsageDaoMockRecorder struct {
+	mock *MockIQuotaUsageDao
+}
+
+// NewMockIQuotaUsageDao creates a new mock instance.
+func NewMockIQuotaUsageDao(ctrl *gomock.Controller) *MockIQuotaUsageDao {
+	mock := &MockIQuotaUsageDao{ctrl: ctrl}
+	mock.recorder = &MockIQuotaUsageDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIQuotaUsageDao) EXPECT() *MockIQuotaUsageDaoMockRecorder {
+	return m.recorder
+}
+
+// Incr mocks base method.
+func (m *MockIQuotaUsageDao) Incr(ctx context.Context, counters []*dao.QuotaUsageCounter, usage *dao.QuotaUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", ctx, counters, usage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockIQuotaUsageDaoMockRecorder) Incr(ctx, counters, usage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockIQuotaUsageDao)(nil).Incr), ctx, counters, usage)
+}
+
+// MGet mocks base method.
+func (m *MockIQuotaUsageDao) MGet(ctx context.Context, keys []string) ([]*dao.QuotaUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MGet", ctx, keys)
+	ret0, _ := ret[0].([]*dao.QuotaUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MGet indicates an expected call of MGet.
+func (mr *MockIQuotaUsageDaoMockRecorder) MGet(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGet", reflect.TypeOf((*MockIQuotaUsageDao)(nil).MGet), ctx, keys)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao (interfaces: IResponseCacheDao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/response_cache.go -package=mocks . IResponseCacheDao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIResponseCacheDao is a mock of IResponseCacheDao interface.
+type MockIResponseCacheDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockIResponseCacheDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockIResponseCacheDaoMockRecorder is the mock recorder for MockIResponseCacheDao.
+type MockIResponseCacheDaoMockRecorder struct {
+	mock *MockIResponseCacheDao
+}
+
+// NewMockIResponseCacheDao creates a new mock instance.
+func NewMockIResponseCacheDao(ctrl *gomock.Controller) *MockIResponseCacheDao {
+	mock := &MockIResponseCacheDao{ctrl: ctrl}
+	mock.recorder = &MockIResponseCacheDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIResponseCacheDao) EXPECT() *MockIResponseCacheDaoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockIResponseCacheDao) Get(ctx context.Context, key string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIResponseCacheDaoMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIResponseCacheDao)(nil).Get), ctx, key)
+}
+
+// Set mocks base method.
+func (m *MockIResponseCacheDao) Set(ctx context.Context, key, val string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, val, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockIResponseCacheDaoMockRecorder) Set(ctx, key, val, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockIResponseCacheDao)(nil).Set), ctx, key, val, ttl)
+}
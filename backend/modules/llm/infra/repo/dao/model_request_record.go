@@ -5,14 +5,57 @@ package dao
 
 import (
 	"context"
+	"time"
+
+	"gorm.io/gen/field"
 
 	"github.com/coze-dev/coze-loop/backend/infra/db"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/query"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 )
 
+//go:generate mockgen -destination=mocks/model_request_record.go -package=mocks . IModelRequestRecordDao
 type IModelRequestRecordDao interface {
 	Create(ctx context.Context, modelPO *model.ModelRequestRecord, opts ...db.Option) (err error)
+	Aggregate(ctx context.Context, param *AggregateModelRequestRecordParam, opts ...db.Option) (rows []*ModelRequestRecordAggregate, err error)
+}
+
+// AggregateModelRequestRecordParam 时间范围左闭右开，TimeBucketFormat 为 DATE_FORMAT 的格式串，为空时不按时间分桶
+type AggregateModelRequestRecordParam struct {
+	SpaceIDs   []int64
+	StartTime  time.Time
+	EndTime    time.Time
+	UserID     *string
+	ModelID    *string
+	UsageScene *string
+
+	GroupBySpace      bool
+	GroupByUser       bool
+	GroupByModel      bool
+	GroupByUsageScene bool
+	TimeBucketFormat  string
+
+	Limit int
+}
+
+// ModelRequestRecordAggregate 未参与分组的维度列为空
+type ModelRequestRecordAggregate struct {
+	SpaceID    *int64  `gorm:"column:space_id"`
+	UserID     *string `gorm:"column:user_id"`
+	ModelID    *string `gorm:"column:model_id"`
+	ModelName  *string `gorm:"column:model_name"`
+	UsageScene *string `gorm:"column:usage_scene"`
+	TimeBucket *string `gorm:"column:time_bucket"`
+
+	RequestCount  int64   `gorm:"column:request_count"`
+	ErrorCount    int64   `gorm:"column:error_count"`
+	CacheHitCount int64   `gorm:"column:cache_hit_count"`
+	InputToken    int64   `gorm:"column:input_token"`
+	OutputToken   int64   `gorm:"column:output_token"`
+	Cost          float64 `gorm:"column:cost"`
+	AvgLatencyMs  float64 `gorm:"column:avg_latency_ms"`
 }
 
 type ModelRequestRecordDaoImpl struct {
@@ -31,3 +74,59 @@ func (m *ModelRequestRecordDaoImpl) Create(ctx context.Context, modelPO *model.M
 	}
 	return nil
 }
+
+func (m *ModelRequestRecordDaoImpl) Aggregate(ctx context.Context, param *AggregateModelRequestRecordParam, opts ...db.Option) (rows []*ModelRequestRecordAggregate, err error) {
+	q := query.Use(m.db.NewSession(ctx, opts...)).ModelRequestRecord
+	do := q.WithContext(ctx).Where(q.SpaceID.In(param.SpaceIDs...), q.CreatedAt.Gte(param.StartTime), q.CreatedAt.Lt(param.EndTime))
+	if param.UserID != nil {
+		do = do.Where(q.UserID.Eq(*param.UserID))
+	}
+	if param.ModelID != nil {
+		do = do.Where(q.ModelID.Eq(*param.ModelID))
+	}
+	if param.UsageScene != nil {
+		do = do.Where(q.UsageScene.Eq(*param.UsageScene))
+	}
+	selects := []field.Expr{
+		q.ID.Count().As("request_count"),
+		field.NewUnsafeFieldRaw("SUM(CASE WHEN error_code <> '' THEN 1 ELSE 0 END)").As("error_count"),
+		field.NewUnsafeFieldRaw("SUM(cache_hit)").As("cache_hit_count"),
+		q.InputToken.Sum().As("input_token"),
+		q.OutputToken.Sum().As("output_token"),
+		q.Cost.Sum().As("cost"),
+		q.LatencyMs.Avg().As("avg_latency_ms"),
+	}
+	var groups, orders []field.Expr
+	if param.TimeBucketFormat != "" {
+		timeBucket := field.NewString("", "time_bucket")
+		selects = append(selects, field.NewUnsafeFieldRaw("DATE_FORMAT(created_at, ?)", param.TimeBucketFormat).As("time_bucket"))
+		groups = append(groups, timeBucket)
+		orders = append(orders, timeBucket)
+	}
+	if param.GroupBySpace {
+		selects = append(selects, q.SpaceID)
+		groups = append(groups, q.SpaceID)
+	}
+	if param.GroupByUser {
+		selects = append(selects, q.UserID)
+		groups = append(groups, q.UserID)
+	}
+	if param.GroupByModel {
+		// 模型改名后仍按 model_id 归为一组，展示名称取其中之一
+		selects = append(selects, q.ModelID, field.NewUnsafeFieldRaw("MAX(model_name)").As("model_name"))
+		groups = append(groups, q.ModelID)
+	}
+	if param.GroupByUsageScene {
+		selects = append(selects, q.UsageScene)
+		groups = append(groups, q.UsageScene)
+	}
+	do = do.Select(selects...)
+	if len(groups) > 0 {
+		do = do.Group(groups...)
+	}
+	orders = append(orders, field.NewInt64("", "request_count").Desc())
+	if err = do.Order(orders...).Limit(param.Limit).Scan(&rows); err != nil {
+		return nil, errorx.WrapByCode(err, llm_errorx.CommonMySqlErrorCode)
+	}
+	return rows, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/coze-dev/coze-loop/backend/infra/redis"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+const (
+	quotaUsageFieldTokens    = "tokens"
+	quotaUsageFieldCostNanos = "cost_nanos"
+)
+
+//go:generate mockgen -destination=mocks/quota_usage.go -package=mocks . IQuotaUsageDao
+type IQuotaUsageDao interface {
+	// MGet 按 keys 顺序返回，计数不存在时各项为0
+	MGet(ctx context.Context, keys []string) (usages []*QuotaUsage, err error)
+	// Incr 累加每个计数，并将计数的过期时间刷新为对应的 TTL
+	Incr(ctx context.Context, counters []*QuotaUsageCounter, usage *QuotaUsage) (err error)
+}
+
+// QuotaUsage 成本以十亿分之一为单位累加，避免浮点累加误差
+type QuotaUsage struct {
+	Tokens    int64
+	CostNanos int64
+}
+
+type QuotaUsageCounter struct {
+	Key string
+	TTL time.Duration
+}
+
+type QuotaUsageDaoImpl struct {
+	redis redis.Cmdable
+}
+
+func NewQuotaUsageDao(redis redis.Cmdable) IQuotaUsageDao {
+	return &QuotaUsageDaoImpl{redis: redis}
+}
+
+func (q *QuotaUsageDaoImpl) MGet(ctx context.Context, keys []string) (usages []*QuotaUsage, err error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	pipe := q.redis.Pipeline()
+	cmds := make([]*goredis.SliceCmd, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, pipe.HMGet(ctx, makeQuotaUsageKey(key), quotaUsageFieldTokens, quotaUsageFieldCostNanos))
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		return nil, errorx.WrapByCode(err, llm_errorx.CommonRedisErrorCode)
+	}
+	usages = make([]*QuotaUsage, 0, len(cmds))
+	for _, cmd := range cmds {
+		usage := &QuotaUsage{}
+		if vals := cmd.Val(); len(vals) == 2 {
+			usage.Tokens = parseQuotaUsageField(vals[0])
+			usage.CostNanos = parseQuotaUsageField(vals[1])
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+func (q *QuotaUsageDaoImpl) Incr(ctx context.Context, counters []*QuotaUsageCounter, usage *QuotaUsage) (err error) {
+	if len(counters) == 0 || usage == nil {
+		return nil
+	}
+	pipe := q.redis.Pipeline()
+	for _, c := range counters {
+		key := makeQuotaUsageKey(c.Key)
+		pipe.HIncrBy(ctx, key, quotaUsageFieldTokens, usage.Tokens)
+		pipe.HIncrBy(ctx, key, quotaUsageFieldCostNanos, usage.CostNanos)
+		pipe.Expire(ctx, key, c.TTL)
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		return errorx.WrapByCode(err, llm_errorx.CommonRedisErrorCode)
+	}
+	return nil
+}
+
+func parseQuotaUsageField(val any) int64 {
+	s, ok := val.(string)
+	if !ok {
+		return 0
+	}
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
+
+func makeQuotaUsageKey(key string) string {
+	return fmt.Sprintf("llm:quota_usage:%s", key)
+}
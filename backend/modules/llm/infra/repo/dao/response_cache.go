@@ -0,0 +1,51 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/redis"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+//go:generate mockgen -destination=mocks/response_cache.go -package=mocks . IResponseCacheDao
+type IResponseCacheDao interface {
+	// Get 缓存不存在时返回空字符串
+	Get(ctx context.Context, key string) (val string, err error)
+	Set(ctx context.Context, key string, val string, ttl time.Duration) (err error)
+}
+
+type ResponseCacheDaoImpl struct {
+	redis redis.Cmdable
+}
+
+func NewResponseCacheDao(redis redis.Cmdable) IResponseCacheDao {
+	return &ResponseCacheDaoImpl{redis: redis}
+}
+
+func (r *ResponseCacheDaoImpl) Get(ctx context.Context, key string) (val string, err error) {
+	val, err = r.redis.Get(ctx, makeResponseCacheKey(key)).Result()
+	if err != nil {
+		if redis.IsNilError(err) {
+			return "", nil
+		}
+		return "", errorx.WrapByCode(err, llm_errorx.CommonRedisErrorCode)
+	}
+	return val, nil
+}
+
+func (r *ResponseCacheDaoImpl) Set(ctx context.Context, key string, val string, ttl time.Duration) (err error) {
+	if err = r.redis.Set(ctx, makeResponseCacheKey(key), val, ttl).Err(); err != nil {
+		return errorx.WrapByCode(err, llm_errorx.CommonRedisErrorCode)
+	}
+	return nil
+}
+
+func makeResponseCacheKey(key string) string {
+	return fmt.Sprintf("llm:response_cache:%s", key)
+}
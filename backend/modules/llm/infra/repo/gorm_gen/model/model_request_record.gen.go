@@ -23,8 +23,12 @@ type ModelRequestRecord struct {
 	ModelAk             string    `gorm:"column:model_ak;type:varchar(1024);not null;comment:æ¨¡åž‹çš„AK" json:"model_ak"`                                                                     // æ¨¡åž‹çš„AK
 	ModelID             string    `gorm:"column:model_id;type:varchar(256);not null;comment:model id" json:"model_id"`                                                                         // model id
 	ModelName           string    `gorm:"column:model_name;type:varchar(1024);not null;comment:æ¨¡åž‹å±•ç¤ºåç§°" json:"model_name"`                                                          // æ¨¡åž‹å±•ç¤ºåç§°
+	OriginModelID       string    `gorm:"column:origin_model_id;type:varchar(256);not null;comment:路由前请求的model id" json:"origin_model_id"`                                                    // 路由前请求的model id
+	CacheHit            bool      `gorm:"column:cache_hit;type:tinyint(1);not null;comment:是否命中响应缓存" json:"cache_hit"`                                                                  // 是否命中响应缓存
 	InputToken          int64     `gorm:"column:input_token;type:bigint unsigned;not null;comment:è¾“å…¥tokenæ•°é‡" json:"input_token"`                                                       // è¾“å…¥tokenæ•°é‡
 	OutputToken         int64     `gorm:"column:output_token;type:bigint unsigned;not null;comment:è¾“å‡ºtokenæ•°é‡" json:"output_token"`                                                     // è¾“å‡ºtokenæ•°é‡
+	Cost                float64   `gorm:"column:cost;type:decimal(20,8);not null;default:0.00000000;comment:按模型单价计算的成本" json:"cost"`                                                      // 按模型单价计算的成本
+	LatencyMs           int64     `gorm:"column:latency_ms;type:bigint unsigned;not null;comment:请求耗时，单位毫秒" json:"latency_ms"`                                                              // 请求耗时，单位毫秒
 	Logid               string    `gorm:"column:logid;type:varchar(128);not null;comment:logid" json:"logid"`                                                                                  // logid
 	ErrorCode           string    `gorm:"column:error_code;type:varchar(128);not null;comment:error_code" json:"error_code"`                                                                   // error_code
 	ErrorMsg            *string   `gorm:"column:error_msg;type:text;comment:error_msg" json:"error_msg"`                                                                                       // error_msg
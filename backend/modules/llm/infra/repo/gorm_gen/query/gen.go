@@ -18,6 +18,7 @@ import (
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:                 db,
+		LlmModel:           newLlmModel(db, opts...),
 		ModelRequestRecord: newModelRequestRecord(db, opts...),
 	}
 }
@@ -25,6 +26,7 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 type Query struct {
 	db *gorm.DB
 
+	LlmModel           llmModel
 	ModelRequestRecord modelRequestRecord
 }
 
@@ -33,6 +35,7 @@ func (q *Query) Available() bool { return q.db != nil }
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:                 db,
+		LlmModel:           q.LlmModel.clone(db),
 		ModelRequestRecord: q.ModelRequestRecord.clone(db),
 	}
 }
@@ -48,16 +51,19 @@ func (q *Query) WriteDB() *Query {
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:                 db,
+		LlmModel:           q.LlmModel.replaceDB(db),
 		ModelRequestRecord: q.ModelRequestRecord.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
+	LlmModel           *llmModelDo
 	ModelRequestRecord *modelRequestRecordDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
+		LlmModel:           q.LlmModel.WithContext(ctx),
 		ModelRequestRecord: q.ModelRequestRecord.WithContext(ctx),
 	}
 }
@@ -35,8 +35,12 @@ func newModelRequestRecord(db *gorm.DB, opts ...gen.DOOption) modelRequestRecord
 	_modelRequestRecord.ModelAk = field.NewString(tableName, "model_ak")
 	_modelRequestRecord.ModelID = field.NewString(tableName, "model_id")
 	_modelRequestRecord.ModelName = field.NewString(tableName, "model_name")
+	_modelRequestRecord.OriginModelID = field.NewString(tableName, "origin_model_id")
+	_modelRequestRecord.CacheHit = field.NewBool(tableName, "cache_hit")
 	_modelRequestRecord.InputToken = field.NewInt64(tableName, "input_token")
 	_modelRequestRecord.OutputToken = field.NewInt64(tableName, "output_token")
+	_modelRequestRecord.Cost = field.NewFloat64(tableName, "cost")
+	_modelRequestRecord.LatencyMs = field.NewInt64(tableName, "latency_ms")
 	_modelRequestRecord.Logid = field.NewString(tableName, "logid")
 	_modelRequestRecord.ErrorCode = field.NewString(tableName, "error_code")
 	_modelRequestRecord.ErrorMsg = field.NewString(tableName, "error_msg")
@@ -53,24 +57,28 @@ type modelRequestRecord struct {
 	modelRequestRecordDo modelRequestRecordDo
 
 	ALL                 field.Asterisk
-	ID                  field.Int64  // è‡ªå¢žä¸»é”®ID
-	SpaceID             field.Int64  // ç©ºé—´id
+	ID                  field.Int64   // è‡ªå¢žä¸»é”®ID
+	SpaceID             field.Int64   // ç©ºé—´id
 	UserID              field.String  // user id
-	UsageScene          field.String // åœºæ™¯
-	UsageSceneEntityID  field.String // åœºæ™¯å®žä½“id
-	Frame               field.String // ä½¿ç”¨çš„æ¡†æž¶ï¼Œå¦‚eino
-	Protocol            field.String // ä½¿ç”¨çš„åè®®ï¼Œå¦‚ark/deepseekç­‰
-	ModelIdentification field.String // æ¨¡åž‹å”¯ä¸€æ ‡è¯†
-	ModelAk             field.String // æ¨¡åž‹çš„AK
-	ModelID             field.String // model id
-	ModelName           field.String // æ¨¡åž‹å±•ç¤ºåç§°
-	InputToken          field.Int64  // è¾“å…¥tokenæ•°é‡
-	OutputToken         field.Int64  // è¾“å‡ºtokenæ•°é‡
-	Logid               field.String // logid
-	ErrorCode           field.String // error_code
-	ErrorMsg            field.String // error_msg
-	CreatedAt           field.Time   // åˆ›å»ºæ—¶é—´
-	UpdatedAt           field.Time   // æ›´æ–°æ—¶é—´
+	UsageScene          field.String  // åœºæ™¯
+	UsageSceneEntityID  field.String  // åœºæ™¯å®žä½“id
+	Frame               field.String  // ä½¿ç”¨çš„æ¡†æž¶ï¼Œå¦‚eino
+	Protocol            field.String  // ä½¿ç”¨çš„åè®®ï¼Œå¦‚ark/deepseekç­‰
+	ModelIdentification field.String  // æ¨¡åž‹å”¯ä¸€æ ‡è¯†
+	ModelAk             field.String  // æ¨¡åž‹çš„AK
+	ModelID             field.String  // model id
+	ModelName           field.String  // æ¨¡åž‹å±•ç¤ºåç§°
+	OriginModelID       field.String  // 路由前请求的model id
+	CacheHit            field.Bool    // 是否命中响应缓存
+	InputToken          field.Int64   // è¾“å…¥tokenæ•°é‡
+	OutputToken         field.Int64   // è¾“å‡ºtokenæ•°é‡
+	Cost                field.Float64 // 按模型单价计算的成本
+	LatencyMs           field.Int64   // 请求耗时，单位毫秒
+	Logid               field.String  // logid
+	ErrorCode           field.String  // error_code
+	ErrorMsg            field.String  // error_msg
+	CreatedAt           field.Time    // åˆ›å»ºæ—¶é—´
+	UpdatedAt           field.Time    // æ›´æ–°æ—¶é—´
 
 	fieldMap map[string]field.Expr
 }
@@ -98,8 +106,12 @@ func (m *modelRequestRecord) updateTableName(table string) *modelRequestRecord {
 	m.ModelAk = field.NewString(table, "model_ak")
 	m.ModelID = field.NewString(table, "model_id")
 	m.ModelName = field.NewString(table, "model_name")
+	m.OriginModelID = field.NewString(table, "origin_model_id")
+	m.CacheHit = field.NewBool(table, "cache_hit")
 	m.InputToken = field.NewInt64(table, "input_token")
 	m.OutputToken = field.NewInt64(table, "output_token")
+	m.Cost = field.NewFloat64(table, "cost")
+	m.LatencyMs = field.NewInt64(table, "latency_ms")
 	m.Logid = field.NewString(table, "logid")
 	m.ErrorCode = field.NewString(table, "error_code")
 	m.ErrorMsg = field.NewString(table, "error_msg")
@@ -133,7 +145,7 @@ func (m *modelRequestRecord) GetFieldByName(fieldName string) (field.OrderExpr,
 }
 
 func (m *modelRequestRecord) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 18)
+	m.fieldMap = make(map[string]field.Expr, 22)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["space_id"] = m.SpaceID
 	m.fieldMap["user_id"] = m.UserID
@@ -145,8 +157,12 @@ func (m *modelRequestRecord) fillFieldMap() {
 	m.fieldMap["model_ak"] = m.ModelAk
 	m.fieldMap["model_id"] = m.ModelID
 	m.fieldMap["model_name"] = m.ModelName
+	m.fieldMap["origin_model_id"] = m.OriginModelID
+	m.fieldMap["cache_hit"] = m.CacheHit
 	m.fieldMap["input_token"] = m.InputToken
 	m.fieldMap["output_token"] = m.OutputToken
+	m.fieldMap["cost"] = m.Cost
+	m.fieldMap["latency_ms"] = m.LatencyMs
 	m.fieldMap["logid"] = m.Logid
 	m.fieldMap["error_code"] = m.ErrorCode
 	m.fieldMap["error_msg"] = m.ErrorMsg
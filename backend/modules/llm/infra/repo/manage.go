@@ -0,0 +1,122 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/convertor"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+type ManageRepoImpl struct {
+	db          db.Provider
+	llmModelDao dao.ILlmModelDao
+	kms         dkms.IDKMS
+}
+
+func NewManageRepo(db db.Provider, llmModelDao dao.ILlmModelDao, kms dkms.IDKMS) repo.IManageRepo {
+	return &ManageRepoImpl{
+		db:          db,
+		llmModelDao: llmModelDao,
+		kms:         kms,
+	}
+}
+
+func (r *ManageRepoImpl) CreateModel(ctx context.Context, model *entity.Model, userID string) (err error) {
+	modelPO, err := r.modelDO2PO(ctx, model)
+	if err != nil {
+		return err
+	}
+	modelPO.CreatedBy = userID
+	modelPO.UpdatedBy = userID
+	return r.llmModelDao.Create(ctx, modelPO)
+}
+
+func (r *ManageRepoImpl) UpdateModel(ctx context.Context, model *entity.Model, userID string) (err error) {
+	modelPO, err := r.modelDO2PO(ctx, model)
+	if err != nil {
+		return err
+	}
+	return r.llmModelDao.Update(ctx, modelPO.ID, map[string]interface{}{
+		"name":             modelPO.Name,
+		"description":      modelPO.Description,
+		"frame":            modelPO.Frame,
+		"protocol":         modelPO.Protocol,
+		"ability":          modelPO.Ability,
+		"protocol_config":  modelPO.ProtocolConfig,
+		"scenario_configs": modelPO.ScenarioConfigs,
+		"param_config":     modelPO.ParamConfig,
+		"updated_by":       userID,
+	})
+}
+
+func (r *ManageRepoImpl) DeleteModel(ctx context.Context, modelID int64) (err error) {
+	return r.llmModelDao.Delete(ctx, modelID)
+}
+
+func (r *ManageRepoImpl) GetModel(ctx context.Context, modelID int64) (model *entity.Model, err error) {
+	modelPO, err := r.llmModelDao.Get(ctx, modelID)
+	if err != nil || modelPO == nil {
+		return nil, err
+	}
+	return r.modelPO2DO(ctx, modelPO)
+}
+
+func (r *ManageRepoImpl) ListModels(ctx context.Context, spaceID int64) (models []*entity.Model, err error) {
+	modelPOs, err := r.llmModelDao.List(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, modelPO := range modelPOs {
+		model, err := r.modelPO2DO(ctx, modelPO)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+// modelDO2PO 协议配置中包含模型密钥，落库前整体加密
+func (r *ManageRepoImpl) modelDO2PO(ctx context.Context, do *entity.Model) (*model.LlmModel, error) {
+	modelPO := convertor.LlmModelDO2PO(do)
+	if modelPO == nil {
+		return nil, errorx.NewByCode(llm_errorx.CommonInvalidParamCode, errorx.WithExtraMsg("model is nil"))
+	}
+	ciphertext, err := r.kms.Encrypt(ctx, protocolConfigDataKey(modelPO.SpaceID), ptr.From(modelPO.ProtocolConfig))
+	if err != nil {
+		return nil, errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode, errorx.WithExtraMsg("encrypt protocol config failed"))
+	}
+	modelPO.ProtocolConfig = ptr.Of(ciphertext)
+	return modelPO, nil
+}
+
+func (r *ManageRepoImpl) modelPO2DO(ctx context.Context, modelPO *model.LlmModel) (*entity.Model, error) {
+	if modelPO.ProtocolConfig != nil {
+		plaintext, err := r.kms.Decrypt(ctx, protocolConfigDataKey(modelPO.SpaceID), ptr.From(modelPO.ProtocolConfig))
+		if err != nil {
+			return nil, errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode, errorx.WithExtraMsg(fmt.Sprintf("decrypt protocol config of model %d failed", modelPO.ID)))
+		}
+		modelPO.ProtocolConfig = ptr.Of(plaintext)
+	}
+	do, err := convertor.LlmModelPO2DO(modelPO)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode, errorx.WithExtraMsg(fmt.Sprintf("unmarshal model %d failed", modelPO.ID)))
+	}
+	return do, nil
+}
+
+func protocolConfigDataKey(spaceID int64) string {
+	return fmt.Sprintf("llm_model_protocol_config_%d", spaceID)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/convertor"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
+)
+
+type QuotaRepoImpl struct {
+	quotaUsageDao dao.IQuotaUsageDao
+}
+
+func NewQuotaRepo(quotaUsageDao dao.IQuotaUsageDao) repo.IQuotaRepo {
+	return &QuotaRepoImpl{
+		quotaUsageDao: quotaUsageDao,
+	}
+}
+
+func (r *QuotaRepoImpl) MGetQuotaUsages(ctx context.Context, keys []*entity.QuotaUsageKey) (usages []*entity.QuotaUsage, err error) {
+	pos, err := r.quotaUsageDao.MGet(ctx, convertor.QuotaUsageKeysDO2Keys(keys))
+	if err != nil {
+		return nil, err
+	}
+	return convertor.QuotaUsagesPO2DO(pos), nil
+}
+
+func (r *QuotaRepoImpl) IncrQuotaUsages(ctx context.Context, keys []*entity.QuotaUsageKey, usage *entity.QuotaUsage) (err error) {
+	return r.quotaUsageDao.Incr(ctx, convertor.QuotaUsageKeysDO2Counters(keys, time.Now()), convertor.QuotaUsageDO2PO(usage))
+}
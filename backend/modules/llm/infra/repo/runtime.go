@@ -5,6 +5,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -13,17 +14,22 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/convertor"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
 )
 
 type RuntimeRepoImpl struct {
 	db                db.Provider
 	modelReqRecordDao dao.IModelRequestRecordDao
+	responseCacheDao  dao.IResponseCacheDao
 }
 
-func NewRuntimeRepo(db db.Provider, modelReqRecordDao dao.IModelRequestRecordDao) repo.IRuntimeRepo {
+func NewRuntimeRepo(db db.Provider, modelReqRecordDao dao.IModelRequestRecordDao, responseCacheDao dao.IResponseCacheDao) repo.IRuntimeRepo {
 	return &RuntimeRepoImpl{
 		db:                db,
 		modelReqRecordDao: modelReqRecordDao,
+		responseCacheDao:  responseCacheDao,
 	}
 }
 
@@ -33,3 +39,31 @@ func (r *RuntimeRepoImpl) CreateModelRequestRecord(ctx context.Context, record *
 		return r.modelReqRecordDao.Create(ctx, convertor.ModelReqRecordDO2PO(record), opt)
 	})
 }
+
+func (r *RuntimeRepoImpl) AggregateModelRequestRecords(ctx context.Context, query *entity.ModelUsageQuery, limit int) (stats []*entity.ModelUsageStat, err error) {
+	rows, err := r.modelReqRecordDao.Aggregate(ctx, convertor.ModelUsageQueryDO2Param(query, limit))
+	if err != nil {
+		return nil, err
+	}
+	return convertor.ModelRequestRecordAggregatesPO2DO(rows), nil
+}
+
+func (r *RuntimeRepoImpl) GetResponseCache(ctx context.Context, key string) (msg *entity.Message, err error) {
+	val, err := r.responseCacheDao.Get(ctx, key)
+	if err != nil || val == "" {
+		return nil, err
+	}
+	msg = &entity.Message{}
+	if err = json.Unmarshal([]byte(val), msg); err != nil {
+		return nil, errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode, errorx.WithExtraMsg("unmarshal response cache failed"))
+	}
+	return msg, nil
+}
+
+func (r *RuntimeRepoImpl) SetResponseCache(ctx context.Context, key string, msg *entity.Message, ttl time.Duration) (err error) {
+	val, err := json.MarshalString(msg)
+	if err != nil {
+		return errorx.WrapByCode(err, llm_errorx.CommonInternalErrorCode, errorx.WithExtraMsg("marshal response cache failed"))
+	}
+	return r.responseCacheDao.Set(ctx, key, val, ttl)
+}
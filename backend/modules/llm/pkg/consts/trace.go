@@ -6,6 +6,7 @@ package consts
 const (
 	SpanTypePromptExecutor = "prompt_executor"
 	SpanTypeSequence       = "sequence"
+	SpanTypeEmbedding      = "embedding"
 )
 
 const (
@@ -17,6 +18,8 @@ const (
 
 const (
 	SpanTagModelID         = "model_id"
+	SpanTagOriginModelID   = "origin_model_id"
+	SpanTagCacheHit        = "cache_hit"
 	SpanTagCallType        = "call_type"
 	SpanTagEnterpriseID    = "enterprise_id"
 	SpanTagTenant          = "tenant"
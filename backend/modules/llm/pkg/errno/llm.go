@@ -95,6 +95,14 @@ const (
 	CallModelTimeoutCode              = 601505012
 	callModelTimeoutMessage           = "call model timeout"
 	callModelTimeoutNoAffectStability = true
+
+	ModelTokenQuotaExceededCode              = 601505013
+	modelTokenQuotaExceededMessage           = "request is rejected, because the token quota of current space or model is used up"
+	modelTokenQuotaExceededNoAffectStability = true
+
+	ModelCostQuotaExceededCode              = 601505014
+	modelCostQuotaExceededMessage           = "request is rejected, because the cost quota of current space or model is used up"
+	modelCostQuotaExceededNoAffectStability = true
 )
 
 func init() {
@@ -231,4 +239,16 @@ func init() {
 		code.WithAffectStability(!callModelTimeoutNoAffectStability),
 	)
 
+	code.Register(
+		ModelTokenQuotaExceededCode,
+		modelTokenQuotaExceededMessage,
+		code.WithAffectStability(!modelTokenQuotaExceededNoAffectStability),
+	)
+
+	code.Register(
+		ModelCostQuotaExceededCode,
+		modelCostQuotaExceededMessage,
+		code.WithAffectStability(!modelCostQuotaExceededNoAffectStability),
+	)
+
 }
@@ -0,0 +1,235 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openai 定义兼容 OpenAI chat completions 接口的请求与响应结构，仅包含网关支持的字段
+package openai
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+const (
+	ObjectChatCompletion      = "chat.completion"
+	ObjectChatCompletionChunk = "chat.completion.chunk"
+
+	ContentPartTypeText     = "text"
+	ContentPartTypeImageURL = "image_url"
+
+	ToolTypeFunction = "function"
+
+	ResponseFormatTypeText       = "text"
+	ResponseFormatTypeJSONObject = "json_object"
+
+	// StreamDone 流式响应结束时发送的数据
+	StreamDone = "[DONE]"
+)
+
+const (
+	ErrorTypeInvalidRequest = "invalid_request_error"
+	ErrorTypePermission     = "permission_error"
+	ErrorTypeNotFound       = "not_found_error"
+	ErrorTypeRateLimit      = "rate_limit_error"
+	ErrorTypeQuota          = "insufficient_quota"
+	ErrorTypeAPI            = "api_error"
+)
+
+type ChatCompletionRequest struct {
+	Model               string          `json:"model"`
+	Messages            []*Message      `json:"messages"`
+	Temperature         *float64        `json:"temperature,omitempty"`
+	TopP                *float64        `json:"top_p,omitempty"`
+	MaxTokens           *int64          `json:"max_tokens,omitempty"`
+	MaxCompletionTokens *int64          `json:"max_completion_tokens,omitempty"`
+	Stop                StringList      `json:"stop,omitempty"`
+	PresencePenalty     *float64        `json:"presence_penalty,omitempty"`
+	FrequencyPenalty    *float64        `json:"frequency_penalty,omitempty"`
+	N                   *int64          `json:"n,omitempty"`
+	Tools               []*Tool         `json:"tools,omitempty"`
+	ToolChoice          *ToolChoice     `json:"tool_choice,omitempty"`
+	ResponseFormat      *ResponseFormat `json:"response_format,omitempty"`
+	Stream              bool            `json:"stream,omitempty"`
+	StreamOptions       *StreamOptions  `json:"stream_options,omitempty"`
+	User                string          `json:"user,omitempty"`
+}
+
+type StreamOptions struct {
+	IncludeUsage bool `json:"include_usage,omitempty"`
+}
+
+type Message struct {
+	Role             string      `json:"role,omitempty"`
+	Content          *Content    `json:"content,omitempty"`
+	ReasoningContent string      `json:"reasoning_content,omitempty"`
+	ToolCalls        []*ToolCall `json:"tool_calls,omitempty"`
+	ToolCallID       string      `json:"tool_call_id,omitempty"`
+	Name             string      `json:"name,omitempty"`
+}
+
+// Content 消息内容，可以是字符串或多模态内容数组
+type Content struct {
+	Text  string
+	Parts []*ContentPart
+}
+
+func (c *Content) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if data[0] == '"' {
+		return json.Unmarshal(data, &c.Text)
+	}
+	return json.Unmarshal(data, &c.Parts)
+}
+
+func (c Content) MarshalJSON() ([]byte, error) {
+	if c.Parts != nil {
+		return json.Marshal(c.Parts)
+	}
+	return json.Marshal(c.Text)
+}
+
+type ContentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *ImageURL `json:"image_url,omitempty"`
+}
+
+type ImageURL struct {
+	URL    string `json:"url"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type Tool struct {
+	Type     string              `json:"type"`
+	Function *FunctionDefinition `json:"function,omitempty"`
+}
+
+type FunctionDefinition struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Parameters JSON Schema 格式的参数定义
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+type ToolCall struct {
+	// Index 仅流式响应中使用
+	Index    *int64        `json:"index,omitempty"`
+	ID       string        `json:"id,omitempty"`
+	Type     string        `json:"type,omitempty"`
+	Function *FunctionCall `json:"function,omitempty"`
+}
+
+type FunctionCall struct {
+	Name      string `json:"name,omitempty"`
+	Arguments string `json:"arguments,omitempty"`
+}
+
+// ToolChoice 可以是 none、auto、required，或指定调用某个函数
+type ToolChoice struct {
+	Mode     string
+	Function *FunctionCall
+}
+
+func (t *ToolChoice) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &t.Mode)
+	}
+	var named struct {
+		Type     string        `json:"type"`
+		Function *FunctionCall `json:"function"`
+	}
+	if err := json.Unmarshal(data, &named); err != nil {
+		return err
+	}
+	if named.Function == nil || named.Function.Name == "" {
+		return fmt.Errorf("tool_choice.function.name is required")
+	}
+	t.Function = named.Function
+	return nil
+}
+
+// StringList 可以是单个字符串或字符串数组
+type StringList []string
+
+func (s *StringList) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		*s = StringList{str}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+type ResponseFormat struct {
+	Type string `json:"type"`
+}
+
+type ChatCompletionResponse struct {
+	ID      string    `json:"id"`
+	Object  string    `json:"object"`
+	Created int64     `json:"created"`
+	Model   string    `json:"model"`
+	Choices []*Choice `json:"choices"`
+	Usage   *Usage    `json:"usage,omitempty"`
+}
+
+type Choice struct {
+	Index        int64    `json:"index"`
+	Message      *Message `json:"message,omitempty"`
+	Delta        *Message `json:"delta,omitempty"`
+	FinishReason *string  `json:"finish_reason"`
+}
+
+type Usage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+	TotalTokens      int64 `json:"total_tokens"`
+}
+
+type ErrorResponse struct {
+	Error *Error `json:"error"`
+}
+
+type Error struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Code    string `json:"code,omitempty"`
+}
+
+// NewErrorResponse 根据错误码返回对应的 HTTP 状态码与 OpenAI 格式的错误
+func NewErrorResponse(code int32, msg string) (int, *ErrorResponse) {
+	status, errType := http.StatusInternalServerError, ErrorTypeAPI
+	switch code {
+	case llm_errorx.RequestNotValidCode, llm_errorx.CommonInvalidParamCode, llm_errorx.CommonBadRequestCode,
+		llm_errorx.RequestNotCompatibleWithModelAbilityCode, llm_errorx.ModelInvalidCode, llm_errorx.RiskContentDetectedCode:
+		status, errType = http.StatusBadRequest, ErrorTypeInvalidRequest
+	case llm_errorx.CommonNoPermissionCode:
+		status, errType = http.StatusForbidden, ErrorTypePermission
+	case llm_errorx.ResourceNotFoundCode:
+		status, errType = http.StatusNotFound, ErrorTypeNotFound
+	case llm_errorx.ModelQPMLimitCode, llm_errorx.ModelTPMLimitCode:
+		status, errType = http.StatusTooManyRequests, ErrorTypeRateLimit
+	case llm_errorx.ModelTokenQuotaExceededCode, llm_errorx.ModelCostQuotaExceededCode:
+		status, errType = http.StatusTooManyRequests, ErrorTypeQuota
+	case llm_errorx.CallModelTimeoutCode:
+		status = http.StatusGatewayTimeout
+	}
+	return status, &ErrorResponse{Error: &Error{Message: msg, Type: errType, Code: strconv.FormatInt(int64(code), 10)}}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openai
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func TestChatCompletionRequest_Unmarshal(t *testing.T) {
+	body := `{"model":"m","messages":[{"role":"user","content":[{"type":"image_url","image_url":{"url":"u"}}]},{"role":"assistant","content":null}],` +
+		`"stop":["a","b"],"tool_choice":{"type":"function","function":{"name":"f"}}}`
+	req := &ChatCompletionRequest{}
+	assert.Nil(t, json.Unmarshal([]byte(body), req))
+	assert.Equal(t, []*ContentPart{{Type: ContentPartTypeImageURL, ImageURL: &ImageURL{URL: "u"}}}, req.Messages[0].Content.Parts)
+	assert.Nil(t, req.Messages[1].Content)
+	assert.Equal(t, StringList{"a", "b"}, req.Stop)
+	assert.Equal(t, &ToolChoice{Function: &FunctionCall{Name: "f"}}, req.ToolChoice)
+
+	req = &ChatCompletionRequest{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"stop":"a","tool_choice":"auto"}`), req))
+	assert.Equal(t, StringList{"a"}, req.Stop)
+	assert.Equal(t, &ToolChoice{Mode: "auto"}, req.ToolChoice)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"tool_choice":{"type":"function"}}`), &ChatCompletionRequest{}))
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	tests := []struct {
+		code       int32
+		wantStatus int
+		wantType   string
+	}{
+		{code: llm_errorx.RequestNotValidCode, wantStatus: http.StatusBadRequest, wantType: ErrorTypeInvalidRequest},
+		{code: llm_errorx.CommonNoPermissionCode, wantStatus: http.StatusForbidden, wantType: ErrorTypePermission},
+		{code: llm_errorx.ResourceNotFoundCode, wantStatus: http.StatusNotFound, wantType: ErrorTypeNotFound},
+		{code: llm_errorx.ModelTPMLimitCode, wantStatus: http.StatusTooManyRequests, wantType: ErrorTypeRateLimit},
+		{code: llm_errorx.ModelCostQuotaExceededCode, wantStatus: http.StatusTooManyRequests, wantType: ErrorTypeQuota},
+		{code: llm_errorx.CallModelFailedCode, wantStatus: http.StatusInternalServerError, wantType: ErrorTypeAPI},
+	}
+	for _, tt := range tests {
+		status, resp := NewErrorResponse(tt.code, "msg")
+		assert.Equal(t, tt.wantStatus, status)
+		assert.Equal(t, tt.wantType, resp.Error.Type)
+		assert.Equal(t, "msg", resp.Error.Message)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"time"
+
+	commondto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/online_eval"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func OnlineEvalRuleDO2DTO(r *entity.OnlineEvalRule) *online_eval.OnlineEvalRule {
+	if r == nil {
+		return nil
+	}
+	return &online_eval.OnlineEvalRule{
+		ID:                  ptr.Of(r.ID),
+		WorkspaceID:         ptr.Of(r.WorkspaceID),
+		Name:                ptr.Of(r.Name),
+		Enabled:             ptr.Of(r.Enabled),
+		SpanFilters:         FilterFieldsDO2DTO(r.SpanFilters),
+		SampleRate:          ptr.Of(r.SampleRate),
+		EvaluatorVersionIds: r.EvaluatorVersionIDs,
+		FieldMappings:       OnlineEvalFieldMappingsDO2DTO(r.FieldMappings),
+		BaseInfo: &commondto.BaseInfo{
+			CreatedBy: &commondto.UserInfo{UserID: ptr.Of(r.CreatedBy)},
+			UpdatedBy: &commondto.UserInfo{UserID: ptr.Of(r.UpdatedBy)},
+			CreatedAt: ptr.Of(r.CreatedAt.UnixMilli()),
+			UpdatedAt: ptr.Of(r.UpdatedAt.UnixMilli()),
+		},
+	}
+}
+
+func BatchOnlineEvalRuleDO2DTO(rules []*entity.OnlineEvalRule) []*online_eval.OnlineEvalRule {
+	ret := make([]*online_eval.OnlineEvalRule, len(rules))
+	for i, r := range rules {
+		ret[i] = OnlineEvalRuleDO2DTO(r)
+	}
+	return ret
+}
+
+func CreateOnlineEvalRuleDTO2DO(workspaceID int64, r *online_eval.OnlineEvalRule, userID string) *entity.OnlineEvalRule {
+	if r == nil {
+		return nil
+	}
+	return &entity.OnlineEvalRule{
+		WorkspaceID:         workspaceID,
+		Name:                r.GetName(),
+		Enabled:             r.GetEnabled(),
+		SpanFilters:         FilterFieldsDTO2DO(r.SpanFilters),
+		SampleRate:          r.GetSampleRate(),
+		EvaluatorVersionIDs: r.GetEvaluatorVersionIds(),
+		FieldMappings:       OnlineEvalFieldMappingsDTO2DO(r.GetFieldMappings()),
+		CreatedAt:           time.Now(),
+		CreatedBy:           userID,
+		UpdatedAt:           time.Now(),
+		UpdatedBy:           userID,
+	}
+}
+
+func OnlineEvalFieldMappingsDTO2DO(mappings []*online_eval.OnlineEvalFieldMapping) []*entity.OnlineEvalFieldMapping {
+	ret := make([]*entity.OnlineEvalFieldMapping, 0, len(mappings))
+	for _, m := range mappings {
+		if m == nil {
+			continue
+		}
+		ret = append(ret, &entity.OnlineEvalFieldMapping{
+			EvaluatorFieldKey:  m.GetEvaluatorFieldKey(),
+			TraceFieldKey:      m.GetTraceFieldKey(),
+			TraceFieldJsonpath: m.GetTraceFieldJsonpath(),
+		})
+	}
+	return ret
+}
+
+func OnlineEvalFieldMappingsDO2DTO(mappings []*entity.OnlineEvalFieldMapping) []*online_eval.OnlineEvalFieldMapping {
+	ret := make([]*online_eval.OnlineEvalFieldMapping, 0, len(mappings))
+	for _, m := range mappings {
+		if m == nil {
+			continue
+		}
+		mapping := &online_eval.OnlineEvalFieldMapping{
+			EvaluatorFieldKey: m.EvaluatorFieldKey,
+			TraceFieldKey:     m.TraceFieldKey,
+		}
+		if m.TraceFieldJsonpath != "" {
+			mapping.TraceFieldJsonpath = ptr.Of(m.TraceFieldJsonpath)
+		}
+		ret = append(ret, mapping)
+	}
+	return ret
+}
+
+func FilterFieldsDO2DTO(f *loop_span.FilterFields) *filter.FilterFields {
+	if f == nil {
+		return nil
+	}
+	ret := &filter.FilterFields{}
+	if f.QueryAndOr != nil {
+		ret.QueryAndOr = ptr.Of(filter.QueryRelation(*f.QueryAndOr))
+	}
+	ret.FilterFields = make([]*filter.FilterField, 0, len(f.FilterFields))
+	for _, field := range f.FilterFields {
+		if field == nil {
+			continue
+		}
+		fField := &filter.FilterField{
+			FieldName: ptr.Of(field.FieldName),
+			FieldType: ptr.Of(filter.FieldType(field.FieldType)),
+			Values:    field.Values,
+		}
+		if field.QueryAndOr != nil {
+			fField.QueryAndOr = ptr.Of(filter.QueryRelation(*field.QueryAndOr))
+		}
+		if field.QueryType != nil {
+			fField.QueryType = ptr.Of(filter.QueryType(*field.QueryType))
+		}
+		if field.SubFilter != nil {
+			fField.SubFilter = FilterFieldsDO2DTO(field.SubFilter)
+		}
+		ret.FilterFields = append(ret.FilterFields, fField)
+	}
+	return ret
+}
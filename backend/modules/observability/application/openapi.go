@@ -45,9 +45,15 @@ type IAnnotationQueueConsumer interface {
 	Send(context.Context, *entity.AnnotationEvent) error
 }
 
+type IOnlineEvalConsumer interface {
+	DispatchOnlineEvalSpans(context.Context, *entity.TraceData) error
+	RunOnlineEvalTask(context.Context, *entity.OnlineEvalTaskEvent) error
+}
+
 type IObservabilityOpenAPIApplication interface {
 	openapi.OpenAPIService
 	IAnnotationQueueConsumer
+	IOnlineEvalConsumer
 }
 
 func NewOpenAPIApplication(
@@ -58,26 +64,29 @@ func NewOpenAPIApplication(
 	workspace workspace.IWorkSpaceProvider,
 	rateLimiter limiter.IRateLimiterFactory,
 	traceConfig config.ITraceConfig,
+	onlineEvalService service.IOnlineEvalService,
 ) (IObservabilityOpenAPIApplication, error) {
 	return &OpenAPIApplication{
-		traceService: traceService,
-		auth:         auth,
-		benefit:      benefit,
-		tenant:       tenant,
-		workspace:    workspace,
-		rateLimiter:  rateLimiter.NewRateLimiter(),
-		traceConfig:  traceConfig,
+		traceService:      traceService,
+		auth:              auth,
+		benefit:           benefit,
+		tenant:            tenant,
+		workspace:         workspace,
+		rateLimiter:       rateLimiter.NewRateLimiter(),
+		traceConfig:       traceConfig,
+		onlineEvalService: onlineEvalService,
 	}, nil
 }
 
 type OpenAPIApplication struct {
-	traceService service.ITraceService
-	auth         rpc.IAuthProvider
-	benefit      benefit.IBenefitService
-	tenant       tenant.ITenantProvider
-	workspace    workspace.IWorkSpaceProvider
-	rateLimiter  limiter.IRateLimiter
-	traceConfig  config.ITraceConfig
+	traceService      service.ITraceService
+	auth              rpc.IAuthProvider
+	benefit           benefit.IBenefitService
+	tenant            tenant.ITenantProvider
+	workspace         workspace.IWorkSpaceProvider
+	rateLimiter       limiter.IRateLimiter
+	traceConfig       config.ITraceConfig
+	onlineEvalService service.IOnlineEvalService
 }
 
 func (o *OpenAPIApplication) IngestTraces(ctx context.Context, req *openapi.IngestTracesRequest) (*openapi.IngestTracesResponse, error) {
@@ -615,6 +624,14 @@ func (o *OpenAPIApplication) Send(ctx context.Context, event *entity.AnnotationE
 	return o.traceService.Send(ctx, event)
 }
 
+func (o *OpenAPIApplication) DispatchOnlineEvalSpans(ctx context.Context, td *entity.TraceData) error {
+	return o.onlineEvalService.DispatchSpans(ctx, td)
+}
+
+func (o *OpenAPIApplication) RunOnlineEvalTask(ctx context.Context, event *entity.OnlineEvalTaskEvent) error {
+	return o.onlineEvalService.RunTask(ctx, event)
+}
+
 func (p *OpenAPIApplication) AllowBySpace(ctx context.Context, workspaceID int64) bool {
 	maxQPS, err := p.traceConfig.GetQueryMaxQPSBySpace(ctx, workspaceID)
 	if err != nil {
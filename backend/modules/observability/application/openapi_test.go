@@ -145,7 +145,7 @@ func TestOpenAPIApplication_IngestTraces(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			fields := tt.fieldsGetter(ctrl)
-			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig)
+			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig, nil)
 			assert.NoError(t, err)
 			got, err := o.IngestTraces(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -251,7 +251,7 @@ func TestOpenAPIApplication_CreateAnnotation(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			fields := tt.fieldsGetter(ctrl)
-			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig)
+			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig, nil)
 			assert.NoError(t, err)
 			got, err := o.CreateAnnotation(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -322,7 +322,7 @@ func TestOpenAPIApplication_DeleteAnnotation(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			fields := tt.fieldsGetter(ctrl)
-			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig)
+			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig, nil)
 			assert.NoError(t, err)
 			got, err := o.DeleteAnnotation(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -385,7 +385,7 @@ func TestOpenAPIApplication_Send(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			fields := tt.fieldsGetter(ctrl)
-			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig)
+			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig, nil)
 			assert.NoError(t, err)
 			err = o.Send(tt.args.ctx, tt.args.event)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -393,6 +393,22 @@ func TestOpenAPIApplication_Send(t *testing.T) {
 	}
 }
 
+func TestOpenAPIApplication_OnlineEval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	rateLimiterMock := limitermocks.NewMockIRateLimiterFactory(ctrl)
+	rateLimiterMock.EXPECT().NewRateLimiter().Return(limitermocks.NewMockIRateLimiter(ctrl)).AnyTimes()
+	onlineEvalMock := servicemocks.NewMockIOnlineEvalService(ctrl)
+	td := &entity.TraceData{Tenant: "cozeloop"}
+	event := &entity.OnlineEvalTaskEvent{RuleID: 1, WorkspaceID: 100}
+	onlineEvalMock.EXPECT().DispatchSpans(gomock.Any(), td).Return(nil)
+	onlineEvalMock.EXPECT().RunTask(gomock.Any(), event).Return(nil)
+	o, err := NewOpenAPIApplication(nil, nil, nil, nil, nil, rateLimiterMock, nil, onlineEvalMock)
+	assert.NoError(t, err)
+	assert.NoError(t, o.DispatchOnlineEvalSpans(context.Background(), td))
+	assert.NoError(t, o.RunOnlineEvalTask(context.Background(), event))
+}
+
 func TestOpenAPIApplication_OtelIngestTraces(t *testing.T) {
 	type fields struct {
 		traceService service.ITraceService
@@ -979,7 +995,7 @@ func TestOpenAPIApplication_OtelIngestTraces(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			fields := tt.fieldsGetter(ctrl)
-			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig)
+			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig, nil)
 			assert.NoError(t, err)
 			got, err := o.OtelIngestTraces(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -1357,7 +1373,7 @@ func TestOpenAPIApplication_ListSpansOApi(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			fields := tt.fieldsGetter(ctrl)
-			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig)
+			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig, nil)
 			assert.NoError(t, err)
 			got, err := o.ListSpansOApi(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -1658,7 +1674,7 @@ func TestOpenAPIApplication_SearchTraceOApi(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			fields := tt.fieldsGetter(ctrl)
-			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig)
+			o, err := NewOpenAPIApplication(fields.traceService, fields.auth, fields.benefit, fields.tenant, fields.workspace, fields.rateLimiter, fields.traceConfig, nil)
 			assert.NoError(t, err)
 			got, err := o.SearchTraceOApi(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -5,6 +5,7 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/metrics"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
 	commdo "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/common"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
@@ -51,6 +53,7 @@ func NewTraceApplication(
 	traceService service.ITraceService,
 	traceExportService service.ITraceExportService,
 	viewRepo repo.IViewRepo,
+	onlineEvalRuleRepo repo.IOnlineEvalRuleRepo,
 	benefitService benefit.IBenefitService,
 	tenant tenant.ITenantProvider,
 	traceMetrics metrics.ITraceMetrics,
@@ -64,6 +67,7 @@ func NewTraceApplication(
 		traceService:       traceService,
 		traceExportService: traceExportService,
 		viewRepo:           viewRepo,
+		onlineEvalRuleRepo: onlineEvalRuleRepo,
 		traceConfig:        traceConfig,
 		metrics:            traceMetrics,
 		benefit:            benefitService,
@@ -79,6 +83,7 @@ type TraceApplication struct {
 	traceService       service.ITraceService
 	traceExportService service.ITraceExportService
 	viewRepo           repo.IViewRepo
+	onlineEvalRuleRepo repo.IOnlineEvalRuleRepo
 	traceConfig        config.ITraceConfig
 	metrics            metrics.ITraceMetrics
 	benefit            benefit.IBenefitService
@@ -578,6 +583,149 @@ func (t *TraceApplication) ListViews(ctx context.Context, req *trace.ListViewsRe
 	}, nil
 }
 
+func (t *TraceApplication) CreateOnlineEvalRule(ctx context.Context, req *trace.CreateOnlineEvalRuleRequest) (*trace.CreateOnlineEvalRuleResponse, error) {
+	if req == nil || req.Rule == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no request provided"))
+	} else if req.GetWorkspaceID() <= 0 {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid workspace_id"))
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionOnlineEvalRuleEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10)); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	rule := tconv.CreateOnlineEvalRuleDTO2DO(req.GetWorkspaceID(), req.Rule, userID)
+	if err := rule.Validate(); err != nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if err := t.validateOnlineEvalRuleEvaluators(ctx, rule); err != nil {
+		return nil, err
+	}
+	id, err := t.onlineEvalRuleRepo.CreateOnlineEvalRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	return &trace.CreateOnlineEvalRuleResponse{ID: id}, nil
+}
+
+func (t *TraceApplication) UpdateOnlineEvalRule(ctx context.Context, req *trace.UpdateOnlineEvalRuleRequest) (*trace.UpdateOnlineEvalRuleResponse, error) {
+	if req == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no request provided"))
+	} else if req.GetID() <= 0 || req.GetWorkspaceID() <= 0 {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid workspace_id"))
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionOnlineEvalRuleEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10)); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	rule, err := t.onlineEvalRuleRepo.GetOnlineEvalRule(ctx, req.GetID(), req.GetWorkspaceID())
+	if err != nil {
+		return nil, err
+	} else if rule == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("online eval rule not found"))
+	}
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.SpanFilters != nil {
+		rule.SpanFilters = tconv.FilterFieldsDTO2DO(req.SpanFilters)
+	}
+	if req.SampleRate != nil {
+		rule.SampleRate = *req.SampleRate
+	}
+	if req.EvaluatorVersionIds != nil {
+		rule.EvaluatorVersionIDs = req.EvaluatorVersionIds
+	}
+	if req.FieldMappings != nil {
+		rule.FieldMappings = tconv.OnlineEvalFieldMappingsDTO2DO(req.FieldMappings)
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if err := t.validateOnlineEvalRuleEvaluators(ctx, rule); err != nil {
+		return nil, err
+	}
+	rule.UpdatedBy = userID
+	rule.UpdatedAt = time.Now()
+	logs.CtxInfo(ctx, "Update online eval rule %d at %d by %s", req.GetID(), req.GetWorkspaceID(), userID)
+	if err := t.onlineEvalRuleRepo.UpdateOnlineEvalRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return trace.NewUpdateOnlineEvalRuleResponse(), nil
+}
+
+// validateOnlineEvalRuleEvaluators 校验规则引用的评估器版本均属于规则所在空间, 避免借规则运行其他空间的评估器
+func (t *TraceApplication) validateOnlineEvalRuleEvaluators(ctx context.Context, rule *entity.OnlineEvalRule) error {
+	_, evalMap, err := t.evalSvc.BatchGetEvaluatorVersions(ctx, &rpc.BatchGetEvaluatorVersionsParam{
+		WorkspaceID:         rule.WorkspaceID,
+		EvaluatorVersionIds: rule.EvaluatorVersionIDs,
+	})
+	if err != nil {
+		return err
+	}
+	for _, evaluatorVersionID := range rule.EvaluatorVersionIDs {
+		if evalMap[evaluatorVersionID] == nil {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode,
+				errorx.WithExtraMsg(fmt.Sprintf("evaluator version %d not found in workspace %d", evaluatorVersionID, rule.WorkspaceID)))
+		}
+	}
+	return nil
+}
+
+func (t *TraceApplication) DeleteOnlineEvalRule(ctx context.Context, req *trace.DeleteOnlineEvalRuleRequest) (*trace.DeleteOnlineEvalRuleResponse, error) {
+	if req == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no request provided"))
+	} else if req.GetID() <= 0 || req.GetWorkspaceID() <= 0 {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid workspace_id"))
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionOnlineEvalRuleEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10)); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	logs.CtxInfo(ctx, "Delete online eval rule %d at %d by %s", req.GetID(), req.GetWorkspaceID(), userID)
+	if err := t.onlineEvalRuleRepo.DeleteOnlineEvalRule(ctx, req.GetID(), req.GetWorkspaceID(), userID); err != nil {
+		return nil, err
+	}
+	return trace.NewDeleteOnlineEvalRuleResponse(), nil
+}
+
+func (t *TraceApplication) ListOnlineEvalRules(ctx context.Context, req *trace.ListOnlineEvalRulesRequest) (*trace.ListOnlineEvalRulesResponse, error) {
+	if req == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no request provided"))
+	} else if req.GetWorkspaceID() <= 0 {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid workspace_id"))
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionOnlineEvalRuleList,
+		strconv.FormatInt(req.GetWorkspaceID(), 10)); err != nil {
+		return nil, err
+	}
+	rules, err := t.onlineEvalRuleRepo.ListOnlineEvalRules(ctx, req.GetWorkspaceID(), false)
+	if err != nil {
+		return nil, err
+	}
+	return &trace.ListOnlineEvalRulesResponse{
+		Rules: tconv.BatchOnlineEvalRuleDO2DTO(rules),
+	}, nil
+}
+
 func (t *TraceApplication) getSystemViews(ctx context.Context) ([]*view.View, error) {
 	systemViews, err := t.traceConfig.GetSystemViews(ctx)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	annodto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/annotation"
 	commondto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
 	dataset0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dataset"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/online_eval"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/span"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/view"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/trace"
@@ -1748,3 +1749,275 @@ func TestTraceApplication_PreviewExportTracesToDataset(t *testing.T) {
 		})
 	}
 }
+
+func TestTraceApplication_CreateOnlineEvalRule(t *testing.T) {
+	validRule := &online_eval.OnlineEvalRule{
+		Name:                ptr.Of("rule"),
+		Enabled:             ptr.Of(true),
+		SampleRate:          ptr.Of(0.5),
+		EvaluatorVersionIds: []int64{11},
+		FieldMappings: []*online_eval.OnlineEvalFieldMapping{
+			{EvaluatorFieldKey: "input", TraceFieldKey: "Input"},
+		},
+	}
+	type fields struct {
+		repo repo.IOnlineEvalRuleRepo
+		auth rpc.IAuthProvider
+		eval rpc.IEvaluatorRPCAdapter
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		req          *trace.CreateOnlineEvalRuleRequest
+		want         *trace.CreateOnlineEvalRuleResponse
+		wantErr      bool
+	}{
+		{
+			name: "success case",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockRepo := repomock.NewMockIOnlineEvalRuleRepo(ctrl)
+				mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+				mockEval := rpcmock.NewMockIEvaluatorRPCAdapter(ctrl)
+				mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionOnlineEvalRuleEdit, "12").Return(nil)
+				mockEval.EXPECT().BatchGetEvaluatorVersions(gomock.Any(), &rpc.BatchGetEvaluatorVersionsParam{
+					WorkspaceID:         12,
+					EvaluatorVersionIds: []int64{11},
+				}).Return(nil, map[int64]*rpc.Evaluator{11: {EvaluatorVersionID: 11}}, nil)
+				mockRepo.EXPECT().CreateOnlineEvalRule(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, rule *entity.OnlineEvalRule) (int64, error) {
+						assert.Equal(t, int64(12), rule.WorkspaceID)
+						assert.Equal(t, "123", rule.CreatedBy)
+						assert.Equal(t, "123", rule.UpdatedBy)
+						return 1, nil
+					})
+				return fields{repo: mockRepo, auth: mockAuth, eval: mockEval}
+			},
+			req:  &trace.CreateOnlineEvalRuleRequest{WorkspaceID: 12, Rule: validRule},
+			want: &trace.CreateOnlineEvalRuleResponse{ID: 1},
+		},
+		{
+			name: "evaluator version not in workspace",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+				mockEval := rpcmock.NewMockIEvaluatorRPCAdapter(ctrl)
+				mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockEval.EXPECT().BatchGetEvaluatorVersions(gomock.Any(), gomock.Any()).Return(nil, map[int64]*rpc.Evaluator{}, nil)
+				return fields{auth: mockAuth, eval: mockEval}
+			},
+			req:     &trace.CreateOnlineEvalRuleRequest{WorkspaceID: 12, Rule: validRule},
+			wantErr: true,
+		},
+		{
+			name: "invalid sample rate",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				return fields{auth: mockAuth}
+			},
+			req: &trace.CreateOnlineEvalRuleRequest{WorkspaceID: 12, Rule: &online_eval.OnlineEvalRule{
+				Name:                ptr.Of("rule"),
+				SampleRate:          ptr.Of(1.5),
+				EvaluatorVersionIds: []int64{11},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "permission denied",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(assert.AnError)
+				return fields{auth: mockAuth}
+			},
+			req:     &trace.CreateOnlineEvalRuleRequest{WorkspaceID: 12, Rule: validRule},
+			wantErr: true,
+		},
+		{
+			name: "nil rule",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			req:     &trace.CreateOnlineEvalRuleRequest{WorkspaceID: 12},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			fields := tt.fieldsGetter(ctrl)
+			tr := &TraceApplication{
+				onlineEvalRuleRepo: fields.repo,
+				authSvc:            fields.auth,
+				evalSvc:            fields.eval,
+			}
+			got, err := tr.CreateOnlineEvalRule(session.WithCtxUser(context.Background(), &session.User{ID: "123"}), tt.req)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTraceApplication_UpdateOnlineEvalRule(t *testing.T) {
+	type fields struct {
+		repo repo.IOnlineEvalRuleRepo
+		auth rpc.IAuthProvider
+		eval rpc.IEvaluatorRPCAdapter
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		req          *trace.UpdateOnlineEvalRuleRequest
+		wantErr      bool
+	}{
+		{
+			name: "success case",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockRepo := repomock.NewMockIOnlineEvalRuleRepo(ctrl)
+				mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+				mockEval := rpcmock.NewMockIEvaluatorRPCAdapter(ctrl)
+				mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionOnlineEvalRuleEdit, "12").Return(nil)
+				mockRepo.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(1), int64(12)).Return(&entity.OnlineEvalRule{
+					ID:                  1,
+					WorkspaceID:         12,
+					Name:                "rule",
+					Enabled:             true,
+					SampleRate:          1,
+					EvaluatorVersionIDs: []int64{11},
+					CreatedBy:           "u1",
+					UpdatedBy:           "u1",
+				}, nil)
+				mockEval.EXPECT().BatchGetEvaluatorVersions(gomock.Any(), &rpc.BatchGetEvaluatorVersionsParam{
+					WorkspaceID:         12,
+					EvaluatorVersionIds: []int64{11},
+				}).Return(nil, map[int64]*rpc.Evaluator{11: {EvaluatorVersionID: 11}}, nil)
+				mockRepo.EXPECT().UpdateOnlineEvalRule(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, rule *entity.OnlineEvalRule) error {
+						assert.False(t, rule.Enabled)
+						assert.Equal(t, "u1", rule.CreatedBy)
+						assert.Equal(t, "123", rule.UpdatedBy)
+						return nil
+					})
+				return fields{repo: mockRepo, auth: mockAuth, eval: mockEval}
+			},
+			req: &trace.UpdateOnlineEvalRuleRequest{ID: 1, WorkspaceID: 12, Enabled: ptr.Of(false)},
+		},
+		{
+			name: "evaluator version of other workspace",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockRepo := repomock.NewMockIOnlineEvalRuleRepo(ctrl)
+				mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+				mockEval := rpcmock.NewMockIEvaluatorRPCAdapter(ctrl)
+				mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(1), int64(12)).Return(&entity.OnlineEvalRule{
+					ID:                  1,
+					WorkspaceID:         12,
+					Name:                "rule",
+					SampleRate:          1,
+					EvaluatorVersionIDs: []int64{11},
+				}, nil)
+				mockEval.EXPECT().BatchGetEvaluatorVersions(gomock.Any(), &rpc.BatchGetEvaluatorVersionsParam{
+					WorkspaceID:         12,
+					EvaluatorVersionIds: []int64{11, 21},
+				}).Return(nil, map[int64]*rpc.Evaluator{11: {EvaluatorVersionID: 11}}, nil)
+				return fields{repo: mockRepo, auth: mockAuth, eval: mockEval}
+			},
+			req:     &trace.UpdateOnlineEvalRuleRequest{ID: 1, WorkspaceID: 12, EvaluatorVersionIds: []int64{11, 21}},
+			wantErr: true,
+		},
+		{
+			name: "rule not found",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockRepo := repomock.NewMockIOnlineEvalRuleRepo(ctrl)
+				mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(1), int64(12)).Return(nil, nil)
+				return fields{repo: mockRepo, auth: mockAuth}
+			},
+			req:     &trace.UpdateOnlineEvalRuleRequest{ID: 1, WorkspaceID: 12, Enabled: ptr.Of(false)},
+			wantErr: true,
+		},
+		{
+			name: "invalid sample rate",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockRepo := repomock.NewMockIOnlineEvalRuleRepo(ctrl)
+				mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(1), int64(12)).Return(&entity.OnlineEvalRule{
+					ID:                  1,
+					WorkspaceID:         12,
+					Name:                "rule",
+					SampleRate:          1,
+					EvaluatorVersionIDs: []int64{11},
+				}, nil)
+				return fields{repo: mockRepo, auth: mockAuth}
+			},
+			req:     &trace.UpdateOnlineEvalRuleRequest{ID: 1, WorkspaceID: 12, SampleRate: ptr.Of(0.0)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			fields := tt.fieldsGetter(ctrl)
+			tr := &TraceApplication{
+				onlineEvalRuleRepo: fields.repo,
+				authSvc:            fields.auth,
+				evalSvc:            fields.eval,
+			}
+			_, err := tr.UpdateOnlineEvalRule(session.WithCtxUser(context.Background(), &session.User{ID: "123"}), tt.req)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTraceApplication_DeleteOnlineEvalRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := repomock.NewMockIOnlineEvalRuleRepo(ctrl)
+	mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+	mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionOnlineEvalRuleEdit, "12").Return(nil)
+	mockRepo.EXPECT().DeleteOnlineEvalRule(gomock.Any(), int64(1), int64(12), "123").Return(nil)
+	tr := &TraceApplication{
+		onlineEvalRuleRepo: mockRepo,
+		authSvc:            mockAuth,
+	}
+	ctx := session.WithCtxUser(context.Background(), &session.User{ID: "123"})
+	_, err := tr.DeleteOnlineEvalRule(ctx, &trace.DeleteOnlineEvalRuleRequest{ID: 1, WorkspaceID: 12})
+	assert.NoError(t, err)
+	_, err = tr.DeleteOnlineEvalRule(ctx, &trace.DeleteOnlineEvalRuleRequest{WorkspaceID: 12})
+	assert.Error(t, err)
+}
+
+func TestTraceApplication_ListOnlineEvalRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := repomock.NewMockIOnlineEvalRuleRepo(ctrl)
+	mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+	mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionOnlineEvalRuleList, "12").Return(nil)
+	mockRepo.EXPECT().ListOnlineEvalRules(gomock.Any(), int64(12), false).Return([]*entity.OnlineEvalRule{{
+		ID:          1,
+		WorkspaceID: 12,
+		Name:        "rule",
+		SpanFilters: &loop_span.FilterFields{
+			FilterFields: []*loop_span.FilterField{{
+				FieldName: loop_span.SpanFieldSpanType,
+				FieldType: loop_span.FieldTypeString,
+				Values:    []string{"model"},
+				QueryType: ptr.Of(loop_span.QueryTypeEnumIn),
+			}},
+		},
+		FieldMappings: []*entity.OnlineEvalFieldMapping{{EvaluatorFieldKey: "input", TraceFieldKey: "Input"}},
+		UpdatedBy:     "u1",
+	}}, nil)
+	tr := &TraceApplication{
+		onlineEvalRuleRepo: mockRepo,
+		authSvc:            mockAuth,
+	}
+	got, err := tr.ListOnlineEvalRules(context.Background(), &trace.ListOnlineEvalRulesRequest{WorkspaceID: 12})
+	assert.NoError(t, err)
+	assert.Len(t, got.Rules, 1)
+	assert.Equal(t, "rule", got.Rules[0].GetName())
+	assert.Equal(t, loop_span.SpanFieldSpanType, got.Rules[0].GetSpanFilters().GetFilterFields()[0].GetFieldName())
+	assert.Equal(t, "u1", got.Rules[0].GetBaseInfo().GetUpdatedBy().GetUserID())
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
+	"github.com/coze-dev/coze-loop/backend/infra/lock"
 	"github.com/coze-dev/coze-loop/backend/infra/metrics"
 	"github.com/coze-dev/coze-loop/backend/infra/mq"
+	"github.com/coze-dev/coze-loop/backend/infra/redis"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/dataset/datasetservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/tag/tagservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluationsetservice"
@@ -75,6 +77,8 @@ var (
 		NewTraceApplication,
 		obrepo.NewViewRepoImpl,
 		mysqldao.NewViewDaoImpl,
+		obrepo.NewOnlineEvalRuleRepoImpl,
+		mysqldao.NewOnlineEvalRuleDaoImpl,
 		auth.NewAuthProvider,
 		user.NewUserRPCProvider,
 		tag.NewTagRPCProvider,
@@ -93,11 +97,21 @@ var (
 	)
 	openApiSet = wire.NewSet(
 		NewOpenAPIApplication,
+		service.NewOnlineEvalServiceImpl,
+		obrepo.NewOnlineEvalRuleRepoImpl,
+		mysqldao.NewOnlineEvalRuleDaoImpl,
+		mq2.NewOnlineEvalTaskProducerImpl,
+		NewOnlineEvalLocker,
 		auth.NewAuthProvider,
+		evaluator.NewEvaluatorRPCProvider,
 		traceDomainSet,
 	)
 )
 
+func NewOnlineEvalLocker(cmdable redis.Cmdable) lock.ILocker {
+	return lock.NewRedisLockerWithHolder(cmdable, "observability")
+}
+
 func NewTraceProcessorBuilder(
 	traceConfig config.ITraceConfig,
 	fileProvider rpc.IFileProvider,
@@ -189,6 +203,8 @@ func InitTraceApplication(
 }
 
 func InitOpenAPIApplication(
+	db db.Provider,
+	idgen idgen.IIDGenerator,
 	mqFactory mq.IFactory,
 	configFactory conf.IConfigLoaderFactory,
 	fileClient fileservice.Client,
@@ -197,6 +213,8 @@ func InitOpenAPIApplication(
 	limiterFactory limiter.IRateLimiterFactory,
 	authClient authservice.Client,
 	meter metrics.Meter,
+	evalService evaluatorservice.Client,
+	cmdable redis.Cmdable,
 ) (IObservabilityOpenAPIApplication, error) {
 	wire.Build(openApiSet)
 	return nil, nil
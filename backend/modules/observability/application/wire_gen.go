@@ -12,8 +12,10 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
+	"github.com/coze-dev/coze-loop/backend/infra/lock"
 	"github.com/coze-dev/coze-loop/backend/infra/metrics"
 	"github.com/coze-dev/coze-loop/backend/infra/mq"
+	"github.com/coze-dev/coze-loop/backend/infra/redis"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/dataset/datasetservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/tag/tagservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluationsetservice"
@@ -96,18 +98,20 @@ func InitTraceApplication(db2 db.Provider, ckDb ck.Provider, meter metrics.Meter
 	}
 	iViewDao := mysql.NewViewDaoImpl(db2)
 	iViewRepo := repo.NewViewRepoImpl(iViewDao, idgen2)
+	iOnlineEvalRuleDao := mysql.NewOnlineEvalRuleDaoImpl(db2)
+	iOnlineEvalRuleRepo := repo.NewOnlineEvalRuleRepoImpl(iOnlineEvalRuleDao, idgen2)
 	iAuthProvider := auth.NewAuthProvider(authClient)
 	iEvaluatorRPCAdapter := evaluator.NewEvaluatorRPCProvider(evalService)
 	iUserProvider := user.NewUserRPCProvider(userClient)
 	iTagRPCAdapter := tag.NewTagRPCProvider(tagService)
-	iTraceApplication, err := NewTraceApplication(iTraceService, iTraceExportService, iViewRepo, benefit2, iTenantProvider, iTraceMetrics, iTraceConfig, iAuthProvider, iEvaluatorRPCAdapter, iUserProvider, iTagRPCAdapter)
+	iTraceApplication, err := NewTraceApplication(iTraceService, iTraceExportService, iViewRepo, iOnlineEvalRuleRepo, benefit2, iTenantProvider, iTraceMetrics, iTraceConfig, iAuthProvider, iEvaluatorRPCAdapter, iUserProvider, iTagRPCAdapter)
 	if err != nil {
 		return nil, err
 	}
 	return iTraceApplication, nil
 }
 
-func InitOpenAPIApplication(mqFactory mq.IFactory, configFactory conf.IConfigLoaderFactory, fileClient fileservice.Client, ckDb ck.Provider, benefit2 benefit.IBenefitService, limiterFactory limiter.IRateLimiterFactory, authClient authservice.Client, meter metrics.Meter) (IObservabilityOpenAPIApplication, error) {
+func InitOpenAPIApplication(db2 db.Provider, idgen2 idgen.IIDGenerator, mqFactory mq.IFactory, configFactory conf.IConfigLoaderFactory, fileClient fileservice.Client, ckDb ck.Provider, benefit2 benefit.IBenefitService, limiterFactory limiter.IRateLimiterFactory, authClient authservice.Client, meter metrics.Meter, evalService evaluatorservice.Client, cmdable redis.Cmdable) (IObservabilityOpenAPIApplication, error) {
 	iSpansDao, err := ck2.NewSpansCkDaoImpl(ckDb)
 	if err != nil {
 		return nil, err
@@ -143,7 +147,16 @@ func InitOpenAPIApplication(mqFactory mq.IFactory, configFactory conf.IConfigLoa
 	}
 	iAuthProvider := auth.NewAuthProvider(authClient)
 	iWorkSpaceProvider := workspace.NewWorkspaceProvider()
-	iObservabilityOpenAPIApplication, err := NewOpenAPIApplication(iTraceService, iAuthProvider, benefit2, iTenantProvider, iWorkSpaceProvider, limiterFactory, iTraceConfig)
+	iOnlineEvalRuleDao := mysql.NewOnlineEvalRuleDaoImpl(db2)
+	iOnlineEvalRuleRepo := repo.NewOnlineEvalRuleRepoImpl(iOnlineEvalRuleDao, idgen2)
+	iOnlineEvalTaskProducer, err := producer.NewOnlineEvalTaskProducerImpl(iTraceConfig, mqFactory)
+	if err != nil {
+		return nil, err
+	}
+	iEvaluatorRPCAdapter := evaluator.NewEvaluatorRPCProvider(evalService)
+	iLocker := NewOnlineEvalLocker(cmdable)
+	iOnlineEvalService := service.NewOnlineEvalServiceImpl(iOnlineEvalRuleRepo, iOnlineEvalTaskProducer, iEvaluatorRPCAdapter, iTraceService, iLocker)
+	iObservabilityOpenAPIApplication, err := NewOpenAPIApplication(iTraceService, iAuthProvider, benefit2, iTenantProvider, iWorkSpaceProvider, limiterFactory, iTraceConfig, iOnlineEvalService)
 	if err != nil {
 		return nil, err
 	}
@@ -184,17 +197,21 @@ var (
 		NewTraceProcessorBuilder, config.NewTraceConfigCenter, tenant.NewTenantProvider, workspace.NewWorkspaceProvider, NewDatasetServiceAdapter,
 	)
 	traceSet = wire.NewSet(
-		NewTraceApplication, repo.NewViewRepoImpl, mysql.NewViewDaoImpl, auth.NewAuthProvider, user.NewUserRPCProvider, tag.NewTagRPCProvider, evaluator.NewEvaluatorRPCProvider, traceDomainSet,
+		NewTraceApplication, repo.NewViewRepoImpl, mysql.NewViewDaoImpl, repo.NewOnlineEvalRuleRepoImpl, mysql.NewOnlineEvalRuleDaoImpl, auth.NewAuthProvider, user.NewUserRPCProvider, tag.NewTagRPCProvider, evaluator.NewEvaluatorRPCProvider, traceDomainSet,
 	)
 	traceIngestionSet = wire.NewSet(
 		NewIngestionApplication, service.NewIngestionServiceImpl, repo.NewTraceCKRepoImpl, ck2.NewSpansCkDaoImpl, ck2.NewAnnotationCkDaoImpl, config.NewTraceConfigCenter, NewTraceConfigLoader,
 		NewIngestionCollectorFactory,
 	)
 	openApiSet = wire.NewSet(
-		NewOpenAPIApplication, auth.NewAuthProvider, traceDomainSet,
+		NewOpenAPIApplication, service.NewOnlineEvalServiceImpl, repo.NewOnlineEvalRuleRepoImpl, mysql.NewOnlineEvalRuleDaoImpl, producer.NewOnlineEvalTaskProducerImpl, NewOnlineEvalLocker, auth.NewAuthProvider, evaluator.NewEvaluatorRPCProvider, traceDomainSet,
 	)
 )
 
+func NewOnlineEvalLocker(cmdable redis.Cmdable) lock.ILocker {
+	return lock.NewRedisLockerWithHolder(cmdable, "observability")
+}
+
 func NewTraceProcessorBuilder(
 	traceConfig config2.ITraceConfig,
 	fileProvider rpc.IFileProvider,
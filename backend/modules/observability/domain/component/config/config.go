@@ -116,6 +116,7 @@ type ITraceConfig interface {
 	GetDefaultTraceTenant(ctx context.Context) string
 	GetAnnotationSourceCfg(ctx context.Context) (*AnnotationSourceConfig, error)
 	GetQueryMaxQPSBySpace(ctx context.Context, workspaceID int64) (int, error)
+	GetOnlineEvalTaskMqProducerCfg(ctx context.Context) (*MqProducerCfg, error)
 
 	conf.IConfigLoader
 }
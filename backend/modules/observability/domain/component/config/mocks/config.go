@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks/config.go -package=mocks . ITraceConfig
+//	mockgen6 -destination=mocks/config.go -package=mocks . ITraceConfig
 //
 
 // Package mocks is a generated GoMock package.
@@ -100,6 +100,21 @@ func (mr *MockITraceConfigMockRecorder) GetDefaultTraceTenant(ctx any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultTraceTenant", reflect.TypeOf((*MockITraceConfig)(nil).GetDefaultTraceTenant), ctx)
 }
 
+// GetOnlineEvalTaskMqProducerCfg mocks base method.
+func (m *MockITraceConfig) GetOnlineEvalTaskMqProducerCfg(ctx context.Context) (*config.MqProducerCfg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnlineEvalTaskMqProducerCfg", ctx)
+	ret0, _ := ret[0].(*config.MqProducerCfg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOnlineEvalTaskMqProducerCfg indicates an expected call of GetOnlineEvalTaskMqProducerCfg.
+func (mr *MockITraceConfigMockRecorder) GetOnlineEvalTaskMqProducerCfg(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnlineEvalTaskMqProducerCfg", reflect.TypeOf((*MockITraceConfig)(nil).GetOnlineEvalTaskMqProducerCfg), ctx)
+}
+
 // GetPlatformSpansTrans mocks base method.
 func (m *MockITraceConfig) GetPlatformSpansTrans(ctx context.Context) (*config.SpanTransHandlerConfig, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/mq (interfaces: IOnlineEvalTaskProducer)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/online_eval_producer.go -package=mocks . IOnlineEvalTaskProducer
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOnlineEvalTaskProducer is a mock of IOnlineEvalTaskProducer interface.
+type MockIOnlineEvalTaskProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOnlineEvalTaskProducerMockRecorder
+	isgomock struct{}
+}
+
+// MockIOnlineEvalTaskProducerMockRecorder is the mock recorder for MockIOnlineEvalTaskProducer.
+type MockIOnlineEvalTaskProducerMockRecorder struct {
+	mock *MockIOnlineEvalTaskProducer
+}
+
+// NewMockIOnlineEvalTaskProducer creates a new mock instance.
+func NewMockIOnlineEvalTaskProducer(ctrl *gomock.Controller) *MockIOnlineEvalTaskProducer {
+	mock := &MockIOnlineEvalTaskProducer{ctrl: ctrl}
+	mock.recorder = &MockIOnlineEvalTaskProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOnlineEvalTaskProducer) EXPECT() *MockIOnlineEvalTaskProducerMockRecorder {
+	return m.recorder
+}
+
+// SendOnlineEvalTask mocks base method.
+func (m *MockIOnlineEvalTaskProducer) SendOnlineEvalTask(ctx context.Context, message *entity.OnlineEvalTaskEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendOnlineEvalTask", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendOnlineEvalTask indicates an expected call of SendOnlineEvalTask.
+func (mr *MockIOnlineEvalTaskProducerMockRecorder) SendOnlineEvalTask(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOnlineEvalTask", reflect.TypeOf((*MockIOnlineEvalTaskProducer)(nil).SendOnlineEvalTask), ctx, message)
+}
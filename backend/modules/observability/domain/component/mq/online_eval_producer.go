@@ -0,0 +1,15 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mq
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+)
+
+//go:generate mockgen -destination=mocks/online_eval_producer.go -package=mocks . IOnlineEvalTaskProducer
+type IOnlineEvalTaskProducer interface {
+	SendOnlineEvalTask(ctx context.Context, message *entity.OnlineEvalTaskEvent) error
+}
@@ -14,6 +14,8 @@ const (
 	AuthActionAnnotationCreate   = "createLoopTraceAnnotation"
 	AuthActionTraceExport        = "exportLoopTrace"
 	AuthActionTracePreviewExport = "previewExportLoopTrace"
+	AuthActionOnlineEvalRuleEdit = "editLoopOnlineEvalRule"
+	AuthActionOnlineEvalRuleList = "listLoopOnlineEvalRule"
 )
 
 //go:generate mockgen -destination=mocks/auth_provider.go -package=mocks . IAuthProvider
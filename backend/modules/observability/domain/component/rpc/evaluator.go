@@ -16,7 +16,21 @@ type BatchGetEvaluatorVersionsParam struct {
 	EvaluatorVersionIds []int64
 }
 
+type RunEvaluatorParam struct {
+	WorkspaceID        int64
+	EvaluatorVersionID int64
+	InputFields        map[string]string
+}
+
+type EvaluatorRecord struct {
+	RecordID  int64
+	Score     *float64
+	Reasoning string
+	ErrMsg    string
+}
+
 //go:generate mockgen -destination=mocks/evaluator.go -package=mocks . IEvaluatorRPCAdapter
 type IEvaluatorRPCAdapter interface {
 	BatchGetEvaluatorVersions(ctx context.Context, param *BatchGetEvaluatorVersionsParam) ([]*Evaluator, map[int64]*Evaluator, error)
+	RunEvaluator(ctx context.Context, param *RunEvaluatorParam) (*EvaluatorRecord, error)
 }
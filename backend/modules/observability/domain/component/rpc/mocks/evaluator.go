@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks/evaluator.go -package=mocks . IEvaluatorRPCAdapter
+//	mockgen6 -destination=mocks/evaluator.go -package=mocks . IEvaluatorRPCAdapter
 //
 
 // Package mocks is a generated GoMock package.
@@ -56,3 +56,18 @@ func (mr *MockIEvaluatorRPCAdapterMockRecorder) BatchGetEvaluatorVersions(ctx, p
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetEvaluatorVersions", reflect.TypeOf((*MockIEvaluatorRPCAdapter)(nil).BatchGetEvaluatorVersions), ctx, param)
 }
+
+// RunEvaluator mocks base method.
+func (m *MockIEvaluatorRPCAdapter) RunEvaluator(ctx context.Context, param *rpc.RunEvaluatorParam) (*rpc.EvaluatorRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunEvaluator", ctx, param)
+	ret0, _ := ret[0].(*rpc.EvaluatorRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunEvaluator indicates an expected call of RunEvaluator.
+func (mr *MockIEvaluatorRPCAdapterMockRecorder) RunEvaluator(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunEvaluator", reflect.TypeOf((*MockIEvaluatorRPCAdapter)(nil).RunEvaluator), ctx, param)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+// OnlineEvalRule 空间级在线评测规则: 对新上报且满足过滤条件的 span 按采样率运行评估器, 并将得分回写为 span 标注
+type OnlineEvalRule struct {
+	ID                  int64
+	WorkspaceID         int64
+	Name                string
+	Enabled             bool
+	SpanFilters         *loop_span.FilterFields
+	SampleRate          float64 // (0, 1]
+	EvaluatorVersionIDs []int64
+	FieldMappings       []*OnlineEvalFieldMapping
+	CreatedAt           time.Time
+	CreatedBy           string
+	UpdatedAt           time.Time
+	// UpdatedBy 以最后修改人身份运行评估器
+	UpdatedBy string
+}
+
+// OnlineEvalFieldMapping 评估器输入字段与 span 字段的映射, TraceFieldKey 取值 Input/Output/Tags.xxx
+type OnlineEvalFieldMapping struct {
+	EvaluatorFieldKey  string `json:"evaluator_field_key"`
+	TraceFieldKey      string `json:"trace_field_key"`
+	TraceFieldJsonpath string `json:"trace_field_jsonpath,omitempty"`
+}
+
+func (r *OnlineEvalRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if r.SampleRate <= 0 || r.SampleRate > 1 {
+		return fmt.Errorf("sample rate must be in (0, 1], got %v", r.SampleRate)
+	}
+	if len(r.EvaluatorVersionIDs) == 0 {
+		return fmt.Errorf("evaluator_version_ids is required")
+	}
+	for _, mapping := range r.FieldMappings {
+		if mapping == nil || mapping.EvaluatorFieldKey == "" || mapping.TraceFieldKey == "" {
+			return fmt.Errorf("invalid field mapping")
+		}
+	}
+	if r.SpanFilters != nil {
+		if err := r.SpanFilters.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnlineEvalTaskEvent 在线评测任务, 每条消息对应一条规则命中的一个 span
+type OnlineEvalTaskEvent struct {
+	RuleID      int64           `json:"rule_id"`
+	WorkspaceID int64           `json:"workspace_id"`
+	Span        *loop_span.Span `json:"span"`
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo (interfaces: IOnlineEvalRuleRepo)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/online_eval_rule.go -package=mocks . IOnlineEvalRuleRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOnlineEvalRuleRepo is a mock of IOnlineEvalRuleRepo interface.
+type MockIOnlineEvalRuleRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOnlineEvalRuleRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockIOnlineEvalRuleRepoMockRecorder is the mock recorder for MockIOnlineEvalRuleRepo.
+type MockIOnlineEvalRuleRepoMockRecorder struct {
+	mock *MockIOnlineEvalRuleRepo
+}
+
+// NewMockIOnlineEvalRuleRepo creates a new mock instance.
+func NewMockIOnlineEvalRuleRepo(ctrl *gomock.Controller) *MockIOnlineEvalRuleRepo {
+	mock := &MockIOnlineEvalRuleRepo{ctrl: ctrl}
+	mock.recorder = &MockIOnlineEvalRuleRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOnlineEvalRuleRepo) EXPECT() *MockIOnlineEvalRuleRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateOnlineEvalRule mocks base method.
+func (m *MockIOnlineEvalRuleRepo) CreateOnlineEvalRule(ctx context.Context, do *entity.OnlineEvalRule) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOnlineEvalRule", ctx, do)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOnlineEvalRule indicates an expected call of CreateOnlineEvalRule.
+func (mr *MockIOnlineEvalRuleRepoMockRecorder) CreateOnlineEvalRule(ctx, do any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOnlineEvalRule", reflect.TypeOf((*MockIOnlineEvalRuleRepo)(nil).CreateOnlineEvalRule), ctx, do)
+}
+
+// DeleteOnlineEvalRule mocks base method.
+func (m *MockIOnlineEvalRuleRepo) DeleteOnlineEvalRule(ctx context.Context, id, workspaceID int64, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOnlineEvalRule", ctx, id, workspaceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOnlineEvalRule indicates an expected call of DeleteOnlineEvalRule.
+func (mr *MockIOnlineEvalRuleRepoMockRecorder) DeleteOnlineEvalRule(ctx, id, workspaceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOnlineEvalRule", reflect.TypeOf((*MockIOnlineEvalRuleRepo)(nil).DeleteOnlineEvalRule), ctx, id, workspaceID, userID)
+}
+
+// GetOnlineEvalRule mocks base method.
+func (m *MockIOnlineEvalRuleRepo) GetOnlineEvalRule(ctx context.Context, id, workspaceID int64) (*entity.OnlineEvalRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnlineEvalRule", ctx, id, workspaceID)
+	ret0, _ := ret[0].(*entity.OnlineEvalRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOnlineEvalRule indicates an expected call of GetOnlineEvalRule.
+func (mr *MockIOnlineEvalRuleRepoMockRecorder) GetOnlineEvalRule(ctx, id, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnlineEvalRule", reflect.TypeOf((*MockIOnlineEvalRuleRepo)(nil).GetOnlineEvalRule), ctx, id, workspaceID)
+}
+
+// ListOnlineEvalRules mocks base method.
+func (m *MockIOnlineEvalRuleRepo) ListOnlineEvalRules(ctx context.Context, workspaceID int64, enabledOnly bool) ([]*entity.OnlineEvalRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOnlineEvalRules", ctx, workspaceID, enabledOnly)
+	ret0, _ := ret[0].([]*entity.OnlineEvalRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOnlineEvalRules indicates an expected call of ListOnlineEvalRules.
+func (mr *MockIOnlineEvalRuleRepoMockRecorder) ListOnlineEvalRules(ctx, workspaceID, enabledOnly any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOnlineEvalRules", reflect.TypeOf((*MockIOnlineEvalRuleRepo)(nil).ListOnlineEvalRules), ctx, workspaceID, enabledOnly)
+}
+
+// UpdateOnlineEvalRule mocks base method.
+func (m *MockIOnlineEvalRuleRepo) UpdateOnlineEvalRule(ctx context.Context, do *entity.OnlineEvalRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOnlineEvalRule", ctx, do)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOnlineEvalRule indicates an expected call of UpdateOnlineEvalRule.
+func (mr *MockIOnlineEvalRuleRepoMockRecorder) UpdateOnlineEvalRule(ctx, do any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOnlineEvalRule", reflect.TypeOf((*MockIOnlineEvalRuleRepo)(nil).UpdateOnlineEvalRule), ctx, do)
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+)
+
+//go:generate mockgen -destination=mocks/online_eval_rule.go -package=mocks . IOnlineEvalRuleRepo
+type IOnlineEvalRuleRepo interface {
+	// GetOnlineEvalRule 规则不存在时返回 nil
+	GetOnlineEvalRule(ctx context.Context, id int64, workspaceID int64) (*entity.OnlineEvalRule, error)
+	ListOnlineEvalRules(ctx context.Context, workspaceID int64, enabledOnly bool) ([]*entity.OnlineEvalRule, error)
+	CreateOnlineEvalRule(ctx context.Context, do *entity.OnlineEvalRule) (int64, error)
+	UpdateOnlineEvalRule(ctx context.Context, do *entity.OnlineEvalRule) error
+	DeleteOnlineEvalRule(ctx context.Context, id int64, workspaceID int64, userID string) error
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service (interfaces: IOnlineEvalService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/online_eval_service.go -package=mocks . IOnlineEvalService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOnlineEvalService is a mock of IOnlineEvalService interface.
+type MockIOnlineEvalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOnlineEvalServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIOnlineEvalServiceMockRecorder is the mock recorder for MockIOnlineEvalService.
+type MockIOnlineEvalServiceMockRecorder struct {
+	mock *MockIOnlineEvalService
+}
+
+// NewMockIOnlineEvalService creates a new mock instance.
+func NewMockIOnlineEvalService(ctrl *gomock.Controller) *MockIOnlineEvalService {
+	mock := &MockIOnlineEvalService{ctrl: ctrl}
+	mock.recorder = &MockIOnlineEvalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOnlineEvalService) EXPECT() *MockIOnlineEvalServiceMockRecorder {
+	return m.recorder
+}
+
+// DispatchSpans mocks base method.
+func (m *MockIOnlineEvalService) DispatchSpans(ctx context.Context, td *entity.TraceData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchSpans", ctx, td)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DispatchSpans indicates an expected call of DispatchSpans.
+func (mr *MockIOnlineEvalServiceMockRecorder) DispatchSpans(ctx, td any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchSpans", reflect.TypeOf((*MockIOnlineEvalService)(nil).DispatchSpans), ctx, td)
+}
+
+// RunTask mocks base method.
+func (m *MockIOnlineEvalService) RunTask(ctx context.Context, event *entity.OnlineEvalTaskEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunTask", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunTask indicates an expected call of RunTask.
+func (mr *MockIOnlineEvalServiceMockRecorder) RunTask(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockIOnlineEvalService)(nil).RunTask), ctx, event)
+}
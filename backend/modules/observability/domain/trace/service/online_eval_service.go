@@ -0,0 +1,199 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/lock"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/mq"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	OnlineEvalAnnotationCaller = "online_eval"
+
+	onlineEvalSampleBuckets = 10000
+	onlineEvalQueryDays     = 1
+	// onlineEvalTaskDedupTTL 同一规则对同一 span 的任务在该时间内只运行一次, 覆盖 trace 消息重投的时间窗口
+	onlineEvalTaskDedupTTL = 24 * time.Hour
+)
+
+//go:generate mockgen -destination=mocks/online_eval_service.go -package=mocks . IOnlineEvalService
+type IOnlineEvalService interface {
+	// DispatchSpans 对新上报的 span 匹配空间内启用的在线评测规则, 命中采样的 span 投递到在线评测任务队列
+	DispatchSpans(ctx context.Context, td *entity.TraceData) error
+	// RunTask 运行在线评测任务中的评估器, 并将得分回写为标注
+	RunTask(ctx context.Context, event *entity.OnlineEvalTaskEvent) error
+}
+
+func NewOnlineEvalServiceImpl(
+	ruleRepo repo.IOnlineEvalRuleRepo,
+	taskProducer mq.IOnlineEvalTaskProducer,
+	evalSvc rpc.IEvaluatorRPCAdapter,
+	traceService ITraceService,
+	locker lock.ILocker,
+) IOnlineEvalService {
+	return &OnlineEvalServiceImpl{
+		ruleRepo:     ruleRepo,
+		taskProducer: taskProducer,
+		evalSvc:      evalSvc,
+		traceService: traceService,
+		locker:       locker,
+	}
+}
+
+type OnlineEvalServiceImpl struct {
+	ruleRepo     repo.IOnlineEvalRuleRepo
+	taskProducer mq.IOnlineEvalTaskProducer
+	evalSvc      rpc.IEvaluatorRPCAdapter
+	traceService ITraceService
+	locker       lock.ILocker
+}
+
+func (o *OnlineEvalServiceImpl) DispatchSpans(ctx context.Context, td *entity.TraceData) error {
+	if td == nil || len(td.SpanList) == 0 {
+		return nil
+	}
+	rulesBySpace := make(map[string][]*entity.OnlineEvalRule)
+	for _, span := range td.SpanList {
+		rules, ok := rulesBySpace[span.WorkspaceID]
+		if !ok {
+			workspaceID, err := strconv.ParseInt(span.WorkspaceID, 10, 64)
+			if err != nil {
+				logs.CtxWarn(ctx, "invalid workspace id %s of span %s, skip online eval", span.WorkspaceID, span.SpanID)
+				rulesBySpace[span.WorkspaceID] = nil
+				continue
+			}
+			rules, err = o.ruleRepo.ListOnlineEvalRules(ctx, workspaceID, true)
+			if err != nil {
+				return err
+			}
+			rulesBySpace[span.WorkspaceID] = rules
+		}
+		for _, rule := range rules {
+			if !matchOnlineEvalRule(rule, span) {
+				continue
+			}
+			if err := o.taskProducer.SendOnlineEvalTask(ctx, &entity.OnlineEvalTaskEvent{
+				RuleID:      rule.ID,
+				WorkspaceID: rule.WorkspaceID,
+				Span:        span,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (o *OnlineEvalServiceImpl) RunTask(ctx context.Context, event *entity.OnlineEvalTaskEvent) error {
+	if event == nil || event.Span == nil {
+		return nil
+	}
+	// 以执行时的规则为准, 投递后被删除或停用的规则不再运行
+	rule, err := o.ruleRepo.GetOnlineEvalRule(ctx, event.RuleID, event.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if rule == nil || !rule.Enabled {
+		logs.CtxInfo(ctx, "online eval rule %d not found or disabled, skip span %s", event.RuleID, event.Span.SpanID)
+		return nil
+	}
+	// trace 消息部分投递失败时整批重投, 同一 span 的任务会被重复投递, 按规则与 span 去重避免重复打分
+	first, err := o.locker.Lock(ctx, onlineEvalTaskDedupKey(rule.ID, event.Span), onlineEvalTaskDedupTTL)
+	if err != nil {
+		return err
+	}
+	if !first {
+		logs.CtxInfo(ctx, "online eval rule %d already ran on span %s, skip", rule.ID, event.Span.SpanID)
+		return nil
+	}
+	o.evaluateSpan(ctx, rule, event.Span)
+	return nil
+}
+
+// evaluateSpan 运行规则中的评估器, 单个评估器失败不影响其他评估器与后续 span
+func (o *OnlineEvalServiceImpl) evaluateSpan(ctx context.Context, rule *entity.OnlineEvalRule, span *loop_span.Span) {
+	inputFields := make(map[string]string, len(rule.FieldMappings))
+	for _, mapping := range rule.FieldMappings {
+		value, err := span.ExtractByJsonpath(ctx, mapping.TraceFieldKey, mapping.TraceFieldJsonpath)
+		if err != nil {
+			logs.CtxWarn(ctx, "online eval rule %d fail to extract field %s of span %s, %v", rule.ID, mapping.TraceFieldKey, span.SpanID, err)
+			return
+		}
+		inputFields[mapping.EvaluatorFieldKey] = value
+	}
+	if rule.UpdatedBy != "" {
+		ctx = session.WithCtxUser(ctx, &session.User{ID: rule.UpdatedBy})
+	}
+	for _, evaluatorVersionID := range rule.EvaluatorVersionIDs {
+		record, err := o.evalSvc.RunEvaluator(ctx, &rpc.RunEvaluatorParam{
+			WorkspaceID:        rule.WorkspaceID,
+			EvaluatorVersionID: evaluatorVersionID,
+			InputFields:        inputFields,
+		})
+		if err != nil {
+			logs.CtxWarn(ctx, "online eval rule %d fail to run evaluator %d on span %s, %v", rule.ID, evaluatorVersionID, span.SpanID, err)
+			continue
+		}
+		if record.Score == nil {
+			logs.CtxWarn(ctx, "online eval rule %d got no score from evaluator %d on span %s, err_msg: %s", rule.ID, evaluatorVersionID, span.SpanID, record.ErrMsg)
+			continue
+		}
+		if err := o.traceService.CreateAnnotation(ctx, &CreateAnnotationReq{
+			WorkspaceID:   rule.WorkspaceID,
+			SpanID:        span.SpanID,
+			TraceID:       span.TraceID,
+			AnnotationKey: strconv.FormatInt(evaluatorVersionID, 10),
+			AnnotationVal: loop_span.NewDoubleValue(*record.Score),
+			Reasoning:     record.Reasoning,
+			Metadata: loop_span.AutoEvaluateMetadata{
+				TaskID:             rule.ID,
+				EvaluatorRecordID:  record.RecordID,
+				EvaluatorVersionID: evaluatorVersionID,
+			},
+			QueryDays: onlineEvalQueryDays,
+			Caller:    OnlineEvalAnnotationCaller,
+		}); err != nil {
+			logs.CtxWarn(ctx, "online eval rule %d fail to create annotation on span %s, %v", rule.ID, span.SpanID, err)
+		}
+	}
+}
+
+func onlineEvalTaskDedupKey(ruleID int64, span *loop_span.Span) string {
+	return fmt.Sprintf("online_eval_task:%d:%s:%s", ruleID, span.TraceID, span.SpanID)
+}
+
+func matchOnlineEvalRule(rule *entity.OnlineEvalRule, span *loop_span.Span) bool {
+	if rule == nil || len(rule.EvaluatorVersionIDs) == 0 {
+		return false
+	}
+	if rule.SpanFilters != nil && !rule.SpanFilters.Satisfied(span) {
+		return false
+	}
+	return sampleSpan(span.SpanID, rule.SampleRate)
+}
+
+// sampleSpan 按 span id 哈希采样, 保证消息重复投递时采样结果一致
+func sampleSpan(spanID string, rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	if rate >= 1 {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(spanID))
+	return float64(h.Sum32()%onlineEvalSampleBuckets) < rate*onlineEvalSampleBuckets
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	lockmocks "github.com/coze-dev/coze-loop/backend/infra/lock/mocks"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	mqmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/mq/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+type annotationRecorder struct {
+	ITraceService
+	reqs []*CreateAnnotationReq
+}
+
+func (a *annotationRecorder) CreateAnnotation(ctx context.Context, req *CreateAnnotationReq) error {
+	a.reqs = append(a.reqs, req)
+	return nil
+}
+
+func newTestOnlineEvalRule() *entity.OnlineEvalRule {
+	return &entity.OnlineEvalRule{
+		ID:          7,
+		WorkspaceID: 100,
+		Enabled:     true,
+		SpanFilters: &loop_span.FilterFields{
+			FilterFields: []*loop_span.FilterField{{
+				FieldName: loop_span.SpanFieldSpanType,
+				FieldType: loop_span.FieldTypeString,
+				Values:    []string{"model"},
+				QueryType: ptr.Of(loop_span.QueryTypeEnumIn),
+			}},
+		},
+		SampleRate:          1,
+		EvaluatorVersionIDs: []int64{11, 12},
+		FieldMappings: []*entity.OnlineEvalFieldMapping{
+			{EvaluatorFieldKey: "input", TraceFieldKey: "Input"},
+			{EvaluatorFieldKey: "answer", TraceFieldKey: "Output", TraceFieldJsonpath: "choices"},
+		},
+		CreatedBy: "u1",
+		UpdatedBy: "u2",
+	}
+}
+
+func TestOnlineEvalServiceImpl_DispatchSpans(t *testing.T) {
+	rule := newTestOnlineEvalRule()
+	td := &entity.TraceData{SpanList: loop_span.SpanList{
+		{SpanID: "s1", TraceID: "t1", WorkspaceID: "100", SpanType: "model"},
+		{SpanID: "s2", TraceID: "t1", WorkspaceID: "100", SpanType: "tool"},
+		{SpanID: "s3", TraceID: "t2", WorkspaceID: "200", SpanType: "model"},
+	}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repoMock := repomocks.NewMockIOnlineEvalRuleRepo(ctrl)
+	repoMock.EXPECT().ListOnlineEvalRules(gomock.Any(), int64(100), true).Return([]*entity.OnlineEvalRule{rule}, nil).Times(1)
+	repoMock.EXPECT().ListOnlineEvalRules(gomock.Any(), int64(200), true).Return(nil, nil).Times(1)
+	producerMock := mqmocks.NewMockIOnlineEvalTaskProducer(ctrl)
+	producerMock.EXPECT().SendOnlineEvalTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, event *entity.OnlineEvalTaskEvent) error {
+			assert.Equal(t, int64(7), event.RuleID)
+			assert.Equal(t, int64(100), event.WorkspaceID)
+			assert.Equal(t, "s1", event.Span.SpanID)
+			return nil
+		}).Times(1)
+
+	svc := NewOnlineEvalServiceImpl(repoMock, producerMock, nil, nil, nil)
+	assert.NoError(t, svc.DispatchSpans(context.Background(), td))
+	assert.NoError(t, svc.DispatchSpans(context.Background(), nil))
+}
+
+func TestOnlineEvalServiceImpl_DispatchSpans_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repoMock := repomocks.NewMockIOnlineEvalRuleRepo(ctrl)
+	repoMock.EXPECT().ListOnlineEvalRules(gomock.Any(), int64(100), true).Return(nil, fmt.Errorf("db error"))
+
+	svc := NewOnlineEvalServiceImpl(repoMock, nil, nil, nil, nil)
+	err := svc.DispatchSpans(context.Background(), &entity.TraceData{SpanList: loop_span.SpanList{{SpanID: "s1", WorkspaceID: "100"}}})
+	assert.Error(t, err)
+}
+
+func TestOnlineEvalServiceImpl_RunTask(t *testing.T) {
+	span := &loop_span.Span{SpanID: "s1", TraceID: "t1", WorkspaceID: "100", SpanType: "model", Input: "hi", Output: `{"choices":"hello"}`}
+	event := &entity.OnlineEvalTaskEvent{RuleID: 7, WorkspaceID: 100, Span: span}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repoMock := repomocks.NewMockIOnlineEvalRuleRepo(ctrl)
+	repoMock.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(7), int64(100)).Return(newTestOnlineEvalRule(), nil)
+	evalMock := rpcmocks.NewMockIEvaluatorRPCAdapter(ctrl)
+	evalMock.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, param *rpc.RunEvaluatorParam) (*rpc.EvaluatorRecord, error) {
+			assert.Equal(t, "u2", session.UserIDInCtxOrEmpty(ctx))
+			assert.Equal(t, map[string]string{"input": "hi", "answer": "hello"}, param.InputFields)
+			if param.EvaluatorVersionID == 12 {
+				return nil, fmt.Errorf("rpc error")
+			}
+			return &rpc.EvaluatorRecord{RecordID: 99, Score: ptr.Of(0.6), Reasoning: "ok"}, nil
+		}).Times(2)
+	lockMock := lockmocks.NewMockILocker(ctrl)
+	lockMock.EXPECT().Lock(gomock.Any(), "online_eval_task:7:t1:s1", onlineEvalTaskDedupTTL).Return(true, nil)
+	recorder := &annotationRecorder{}
+
+	svc := NewOnlineEvalServiceImpl(repoMock, nil, evalMock, recorder, lockMock)
+	assert.NoError(t, svc.RunTask(context.Background(), event))
+
+	assert.Len(t, recorder.reqs, 1)
+	req := recorder.reqs[0]
+	assert.Equal(t, "s1", req.SpanID)
+	assert.Equal(t, "11", req.AnnotationKey)
+	assert.Equal(t, 0.6, req.AnnotationVal.FloatValue)
+	assert.Equal(t, OnlineEvalAnnotationCaller, req.Caller)
+	assert.Equal(t, loop_span.AutoEvaluateMetadata{TaskID: 7, EvaluatorRecordID: 99, EvaluatorVersionID: 11}, req.Metadata)
+}
+
+func TestOnlineEvalServiceImpl_RunTask_RuleChanged(t *testing.T) {
+	event := &entity.OnlineEvalTaskEvent{RuleID: 7, WorkspaceID: 100, Span: &loop_span.Span{SpanID: "s1"}}
+	disabled := newTestOnlineEvalRule()
+	disabled.Enabled = false
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repoMock := repomocks.NewMockIOnlineEvalRuleRepo(ctrl)
+	gomock.InOrder(
+		repoMock.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(7), int64(100)).Return(nil, nil),
+		repoMock.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(7), int64(100)).Return(disabled, nil),
+		repoMock.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(7), int64(100)).Return(nil, fmt.Errorf("db error")),
+	)
+
+	svc := NewOnlineEvalServiceImpl(repoMock, nil, nil, nil, nil)
+	assert.NoError(t, svc.RunTask(context.Background(), event))
+	assert.NoError(t, svc.RunTask(context.Background(), event))
+	assert.Error(t, svc.RunTask(context.Background(), event))
+	assert.NoError(t, svc.RunTask(context.Background(), nil))
+}
+
+func TestOnlineEvalServiceImpl_RunTask_Redelivered(t *testing.T) {
+	event := &entity.OnlineEvalTaskEvent{RuleID: 7, WorkspaceID: 100, Span: &loop_span.Span{SpanID: "s1", TraceID: "t1", Input: "hi", Output: `{"choices":"hello"}`}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repoMock := repomocks.NewMockIOnlineEvalRuleRepo(ctrl)
+	repoMock.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(7), int64(100)).Return(newTestOnlineEvalRule(), nil).Times(3)
+	lockMock := lockmocks.NewMockILocker(ctrl)
+	gomock.InOrder(
+		lockMock.EXPECT().Lock(gomock.Any(), "online_eval_task:7:t1:s1", onlineEvalTaskDedupTTL).Return(true, nil),
+		lockMock.EXPECT().Lock(gomock.Any(), "online_eval_task:7:t1:s1", onlineEvalTaskDedupTTL).Return(false, nil),
+		lockMock.EXPECT().Lock(gomock.Any(), "online_eval_task:7:t1:s1", onlineEvalTaskDedupTTL).Return(false, fmt.Errorf("redis error")),
+	)
+	evalMock := rpcmocks.NewMockIEvaluatorRPCAdapter(ctrl)
+	evalMock.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).Return(&rpc.EvaluatorRecord{RecordID: 99, Score: ptr.Of(1.0)}, nil).Times(2)
+	recorder := &annotationRecorder{}
+
+	svc := NewOnlineEvalServiceImpl(repoMock, nil, evalMock, recorder, lockMock)
+	assert.NoError(t, svc.RunTask(context.Background(), event))
+	// 重投的任务不再运行评估器
+	assert.NoError(t, svc.RunTask(context.Background(), event))
+	// 去重状态未知时返回错误, 由消息队列重试
+	assert.Error(t, svc.RunTask(context.Background(), event))
+	assert.Len(t, recorder.reqs, 2)
+}
+
+func Test_sampleSpan(t *testing.T) {
+	assert.False(t, sampleSpan("s1", 0))
+	assert.True(t, sampleSpan("s1", 1))
+	assert.Equal(t, sampleSpan("s1", 0.5), sampleSpan("s1", 0.5))
+
+	hit := 0
+	for i := 0; i < 1000; i++ {
+		if sampleSpan(fmt.Sprintf("span-%d", i), 0.3) {
+			hit++
+		}
+	}
+	assert.InDelta(t, 300, hit, 60)
+}
@@ -141,6 +141,7 @@ type CreateAnnotationReq struct {
 	AnnotationKey string
 	AnnotationVal loop_span.AnnotationValue
 	Reasoning     string
+	Metadata      any
 	QueryDays     int64
 	Caller        string
 }
@@ -731,6 +732,7 @@ func (r *TraceServiceImpl) CreateAnnotation(ctx context.Context, req *CreateAnno
 				Key:            req.AnnotationKey,
 				Value:          req.AnnotationVal,
 				Reasoning:      req.Reasoning,
+				Metadata:       req.Metadata,
 				Status:         loop_span.AnnotationStatusNormal,
 				CreatedAt:      time.Now(),
 				UpdatedAt:      time.Now(),
@@ -750,6 +752,7 @@ func (r *TraceServiceImpl) CreateAnnotation(ctx context.Context, req *CreateAnno
 	if err != nil {
 		return errorx.WrapByCode(err, obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid annotation"))
 	}
+	annotation.Metadata = req.Metadata
 	existedAnno, err := r.traceRepo.GetAnnotation(ctx, &repo.GetAnnotationParam{
 		Tenants: cfg.Tenants,
 		ID:      annotation.ID,
@@ -14,17 +14,18 @@ import (
 )
 
 const (
-	systemViewsCfgKey          = "trace_system_view_cfg"
-	platformTenantCfgKey       = "trace_platform_tenants"
-	platformSpanHandlerCfgKey  = "trace_platform_span_handler_config"
-	traceIngestTenantCfgKey    = "trace_ingest_tenant_config"
-	annotationMqProducerCfgKey = "annotation_mq_producer_config"
-	tenantTablesCfgKey         = "trace_tenant_cfg"
-	traceCkCfgKey              = "trace_ck_cfg"
-	traceFieldMetaInfoCfgKey   = "trace_field_meta_info"
-	traceMaxDurationDay        = "trace_max_duration_day"
-	annotationSourceCfgKey     = "annotation_source_cfg"
-	queryTraceRateLimitCfgKey  = "query_trace_rate_limit_config"
+	systemViewsCfgKey              = "trace_system_view_cfg"
+	platformTenantCfgKey           = "trace_platform_tenants"
+	platformSpanHandlerCfgKey      = "trace_platform_span_handler_config"
+	traceIngestTenantCfgKey        = "trace_ingest_tenant_config"
+	annotationMqProducerCfgKey     = "annotation_mq_producer_config"
+	tenantTablesCfgKey             = "trace_tenant_cfg"
+	traceCkCfgKey                  = "trace_ck_cfg"
+	traceFieldMetaInfoCfgKey       = "trace_field_meta_info"
+	traceMaxDurationDay            = "trace_max_duration_day"
+	annotationSourceCfgKey         = "annotation_source_cfg"
+	queryTraceRateLimitCfgKey      = "query_trace_rate_limit_config"
+	onlineEvalTaskMqProducerCfgKey = "online_eval_task_mq_producer_config"
 )
 
 type TraceConfigCenter struct {
@@ -152,6 +153,14 @@ func (t *TraceConfigCenter) GetQueryMaxQPSBySpace(ctx context.Context, workspace
 	return qpsConfig.DefaultMaxQPS, nil
 }
 
+func (t *TraceConfigCenter) GetOnlineEvalTaskMqProducerCfg(ctx context.Context) (*config.MqProducerCfg, error) {
+	cfg := new(config.MqProducerCfg)
+	if err := t.UnmarshalKey(context.Background(), onlineEvalTaskMqProducerCfgKey, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func NewTraceConfigCenter(confP conf.IConfigLoader) config.ITraceConfig {
 	ret := &TraceConfigCenter{
 		IConfigLoader: confP,
@@ -12,8 +12,11 @@ import (
 func NewConsumerWorkers(
 	loader conf.IConfigLoader,
 	handler application.IAnnotationQueueConsumer,
+	onlineEvalHandler application.IOnlineEvalConsumer,
 ) ([]mq.IConsumerWorker, error) {
 	return []mq.IConsumerWorker{
 		newAnnotationConsumer(handler, loader),
+		newOnlineEvalConsumer(onlineEvalHandler, loader),
+		newOnlineEvalTaskConsumer(onlineEvalHandler, loader),
 	}, nil
 }
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/mq"
+	obapp "github.com/coze-dev/coze-loop/backend/modules/observability/application"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/conf"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/conv"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// OnlineEvalConsumer 以独立消费组订阅 trace 上报 topic, 仅匹配在线评测规则并投递评测任务, 不在此处运行评估器
+type OnlineEvalConsumer struct {
+	handler obapp.IOnlineEvalConsumer
+	conf.IConfigLoader
+}
+
+func newOnlineEvalConsumer(handler obapp.IOnlineEvalConsumer, loader conf.IConfigLoader) mq.IConsumerWorker {
+	return &OnlineEvalConsumer{
+		handler:       handler,
+		IConfigLoader: loader,
+	}
+}
+
+func (e *OnlineEvalConsumer) ConsumerCfg(ctx context.Context) (*mq.ConsumerConfig, error) {
+	const key = "online_eval_mq_consumer_config"
+	cfg := &config.MqConsumerCfg{}
+	if err := e.UnmarshalKey(ctx, key, cfg); err != nil {
+		return nil, err
+	}
+	res := &mq.ConsumerConfig{
+		Addr:                 cfg.Addr,
+		Topic:                cfg.Topic,
+		ConsumerGroup:        cfg.ConsumerGroup,
+		ConsumeTimeout:       time.Duration(cfg.Timeout) * time.Millisecond,
+		ConsumeGoroutineNums: cfg.WorkerNum,
+	}
+	return res, nil
+}
+
+func (e *OnlineEvalConsumer) HandleMessage(ctx context.Context, ext *mq.MessageExt) error {
+	td := new(entity.TraceData)
+	if err := json.Unmarshal(ext.Body, td); err != nil {
+		logs.CtxError(ctx, "trace data json unmarshal fail, raw: %v, err: %s", conv.UnsafeBytesToString(ext.Body), err)
+		return nil
+	}
+	logs.CtxInfo(ctx, "Handle online eval message, tenant: %s, spans count %d", td.Tenant, len(td.SpanList))
+	return e.handler.DispatchOnlineEvalSpans(ctx, td)
+}
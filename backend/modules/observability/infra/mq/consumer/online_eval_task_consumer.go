@@ -0,0 +1,57 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/mq"
+	obapp "github.com/coze-dev/coze-loop/backend/modules/observability/application"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/conf"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/conv"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// OnlineEvalTaskConsumer 消费在线评测任务并运行评估器, 并发度由 worker_num 控制, 与 trace 上报消费互不阻塞
+type OnlineEvalTaskConsumer struct {
+	handler obapp.IOnlineEvalConsumer
+	conf.IConfigLoader
+}
+
+func newOnlineEvalTaskConsumer(handler obapp.IOnlineEvalConsumer, loader conf.IConfigLoader) mq.IConsumerWorker {
+	return &OnlineEvalTaskConsumer{
+		handler:       handler,
+		IConfigLoader: loader,
+	}
+}
+
+func (e *OnlineEvalTaskConsumer) ConsumerCfg(ctx context.Context) (*mq.ConsumerConfig, error) {
+	const key = "online_eval_task_mq_consumer_config"
+	cfg := &config.MqConsumerCfg{}
+	if err := e.UnmarshalKey(ctx, key, cfg); err != nil {
+		return nil, err
+	}
+	res := &mq.ConsumerConfig{
+		Addr:                 cfg.Addr,
+		Topic:                cfg.Topic,
+		ConsumerGroup:        cfg.ConsumerGroup,
+		ConsumeTimeout:       time.Duration(cfg.Timeout) * time.Millisecond,
+		ConsumeGoroutineNums: cfg.WorkerNum,
+	}
+	return res, nil
+}
+
+func (e *OnlineEvalTaskConsumer) HandleMessage(ctx context.Context, ext *mq.MessageExt) error {
+	event := new(entity.OnlineEvalTaskEvent)
+	if err := json.Unmarshal(ext.Body, event); err != nil {
+		logs.CtxError(ctx, "online eval task json unmarshal fail, raw: %v, err: %s", conv.UnsafeBytesToString(ext.Body), err)
+		return nil
+	}
+	logs.CtxInfo(ctx, "Handle online eval task, rule_id: %d, workspace_id: %d", event.RuleID, event.WorkspaceID)
+	return e.handler.RunOnlineEvalTask(ctx, event)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package producer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/mq"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	mq2 "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/mq"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+var (
+	onlineEvalTaskProducerOnce      sync.Once
+	singletonOnlineEvalTaskProducer mq2.IOnlineEvalTaskProducer
+)
+
+type OnlineEvalTaskProducerImpl struct {
+	topic      string
+	mqProducer mq.IProducer
+}
+
+func (o *OnlineEvalTaskProducerImpl) SendOnlineEvalTask(ctx context.Context, message *entity.OnlineEvalTaskEvent) error {
+	bytes, err := json.Marshal(message)
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommercialCommonInternalErrorCodeCode)
+	}
+	msg := mq.NewMessage(o.topic, bytes)
+	_, err = o.mqProducer.Send(ctx, msg)
+	if err != nil {
+		logs.CtxWarn(ctx, "send online eval task msg err: %v", err)
+		return errorx.WrapByCode(err, obErrorx.CommercialCommonRPCErrorCodeCode)
+	}
+	logs.CtxInfo(ctx, "send online eval task msg successfully, rule_id: %d, span_id: %s", message.RuleID, message.Span.SpanID)
+	return nil
+}
+
+func NewOnlineEvalTaskProducerImpl(traceConfig config.ITraceConfig, mqFactory mq.IFactory) (mq2.IOnlineEvalTaskProducer, error) {
+	var err error
+	onlineEvalTaskProducerOnce.Do(func() {
+		singletonOnlineEvalTaskProducer, err = newOnlineEvalTaskProducerImpl(traceConfig, mqFactory)
+	})
+	if err != nil {
+		return nil, err
+	} else {
+		return singletonOnlineEvalTaskProducer, nil
+	}
+}
+
+func newOnlineEvalTaskProducerImpl(traceConfig config.ITraceConfig, mqFactory mq.IFactory) (mq2.IOnlineEvalTaskProducer, error) {
+	mqCfg, err := traceConfig.GetOnlineEvalTaskMqProducerCfg(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if mqCfg.Topic == "" {
+		return nil, fmt.Errorf("online eval task topic required")
+	}
+	mqProducer, err := mqFactory.NewProducer(mq.ProducerConfig{
+		Addr:           mqCfg.Addr,
+		ProduceTimeout: time.Duration(mqCfg.Timeout) * time.Millisecond,
+		RetryTimes:     mqCfg.RetryTimes,
+		ProducerGroup:  ptr.Of(mqCfg.ProducerGroup),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := mqProducer.Start(); err != nil {
+		return nil, fmt.Errorf("fail to start producer, %v", err)
+	}
+	return &OnlineEvalTaskProducerImpl{
+		topic:      mqCfg.Topic,
+		mqProducer: mqProducer,
+	}, nil
+}
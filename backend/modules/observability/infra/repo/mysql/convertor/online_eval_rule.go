@@ -0,0 +1,74 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func OnlineEvalRuleDO2PO(rule *entity.OnlineEvalRule) (*model.ObservabilityOnlineEvalRule, error) {
+	po := &model.ObservabilityOnlineEvalRule{
+		ID:          rule.ID,
+		WorkspaceID: rule.WorkspaceID,
+		Name:        rule.Name,
+		Enabled:     rule.Enabled,
+		SampleRate:  rule.SampleRate,
+		CreatedAt:   rule.CreatedAt,
+		CreatedBy:   rule.CreatedBy,
+		UpdatedAt:   rule.UpdatedAt,
+		UpdatedBy:   rule.UpdatedBy,
+	}
+	if rule.SpanFilters != nil {
+		filters, err := json.MarshalString(rule.SpanFilters)
+		if err != nil {
+			return nil, err
+		}
+		po.SpanFilters = filters
+	}
+	evaluatorVersionIDs, err := json.MarshalString(rule.EvaluatorVersionIDs)
+	if err != nil {
+		return nil, err
+	}
+	po.EvaluatorVersionIDs = evaluatorVersionIDs
+	fieldMappings, err := json.MarshalString(rule.FieldMappings)
+	if err != nil {
+		return nil, err
+	}
+	po.FieldMappings = fieldMappings
+	return po, nil
+}
+
+func OnlineEvalRulePO2DO(po *model.ObservabilityOnlineEvalRule) (*entity.OnlineEvalRule, error) {
+	rule := &entity.OnlineEvalRule{
+		ID:          po.ID,
+		WorkspaceID: po.WorkspaceID,
+		Name:        po.Name,
+		Enabled:     po.Enabled,
+		SampleRate:  po.SampleRate,
+		CreatedAt:   po.CreatedAt,
+		CreatedBy:   po.CreatedBy,
+		UpdatedAt:   po.UpdatedAt,
+		UpdatedBy:   po.UpdatedBy,
+	}
+	if po.SpanFilters != "" {
+		rule.SpanFilters = new(loop_span.FilterFields)
+		if err := json.Unmarshal([]byte(po.SpanFilters), rule.SpanFilters); err != nil {
+			return nil, err
+		}
+	}
+	if po.EvaluatorVersionIDs != "" {
+		if err := json.Unmarshal([]byte(po.EvaluatorVersionIDs), &rule.EvaluatorVersionIDs); err != nil {
+			return nil, err
+		}
+	}
+	if po.FieldMappings != "" {
+		if err := json.Unmarshal([]byte(po.FieldMappings), &rule.FieldMappings); err != nil {
+			return nil, err
+		}
+	}
+	return rule, nil
+}
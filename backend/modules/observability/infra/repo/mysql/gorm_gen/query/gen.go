@@ -17,23 +17,26 @@ import (
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:                db,
-		ObservabilityView: newObservabilityView(db, opts...),
+		db:                          db,
+		ObservabilityOnlineEvalRule: newObservabilityOnlineEvalRule(db, opts...),
+		ObservabilityView:           newObservabilityView(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	ObservabilityView observabilityView
+	ObservabilityOnlineEvalRule observabilityOnlineEvalRule
+	ObservabilityView           observabilityView
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:                db,
-		ObservabilityView: q.ObservabilityView.clone(db),
+		db:                          db,
+		ObservabilityOnlineEvalRule: q.ObservabilityOnlineEvalRule.clone(db),
+		ObservabilityView:           q.ObservabilityView.clone(db),
 	}
 }
 
@@ -47,18 +50,21 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:                db,
-		ObservabilityView: q.ObservabilityView.replaceDB(db),
+		db:                          db,
+		ObservabilityOnlineEvalRule: q.ObservabilityOnlineEvalRule.replaceDB(db),
+		ObservabilityView:           q.ObservabilityView.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	ObservabilityView *observabilityViewDo
+	ObservabilityOnlineEvalRule *observabilityOnlineEvalRuleDo
+	ObservabilityView           *observabilityViewDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		ObservabilityView: q.ObservabilityView.WithContext(ctx),
+		ObservabilityOnlineEvalRule: q.ObservabilityOnlineEvalRule.WithContext(ctx),
+		ObservabilityView:           q.ObservabilityView.WithContext(ctx),
 	}
 }
 
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql (interfaces: IOnlineEvalRuleDao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/online_eval_rule.go -package=mocks . IOnlineEvalRuleDao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOnlineEvalRuleDao is a mock of IOnlineEvalRuleDao interface.
+type MockIOnlineEvalRuleDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOnlineEvalRuleDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockIOnlineEvalRuleDaoMockRecorder is the mock recorder for MockIOnlineEvalRuleDao.
+type MockIOnlineEvalRuleDaoMockRecorder struct {
+	mock *MockIOnlineEvalRuleDao
+}
+
+// NewMockIOnlineEvalRuleDao creates a new mock instance.
+func NewMockIOnlineEvalRuleDao(ctrl *gomock.Controller) *MockIOnlineEvalRuleDao {
+	mock := &MockIOnlineEvalRuleDao{ctrl: ctrl}
+	mock.recorder = &MockIOnlineEvalRuleDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOnlineEvalRuleDao) EXPECT() *MockIOnlineEvalRuleDaoMockRecorder {
+	return m.recorder
+}
+
+// CreateOnlineEvalRule mocks base method.
+func (m *MockIOnlineEvalRuleDao) CreateOnlineEvalRule(ctx context.Context, po *model.ObservabilityOnlineEvalRule) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOnlineEvalRule", ctx, po)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOnlineEvalRule indicates an expected call of CreateOnlineEvalRule.
+func (mr *MockIOnlineEvalRuleDaoMockRecorder) CreateOnlineEvalRule(ctx, po any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOnlineEvalRule", reflect.TypeOf((*MockIOnlineEvalRuleDao)(nil).CreateOnlineEvalRule), ctx, po)
+}
+
+// DeleteOnlineEvalRule mocks base method.
+func (m *MockIOnlineEvalRuleDao) DeleteOnlineEvalRule(ctx context.Context, id, workspaceID int64, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOnlineEvalRule", ctx, id, workspaceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOnlineEvalRule indicates an expected call of DeleteOnlineEvalRule.
+func (mr *MockIOnlineEvalRuleDaoMockRecorder) DeleteOnlineEvalRule(ctx, id, workspaceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOnlineEvalRule", reflect.TypeOf((*MockIOnlineEvalRuleDao)(nil).DeleteOnlineEvalRule), ctx, id, workspaceID, userID)
+}
+
+// GetOnlineEvalRule mocks base method.
+func (m *MockIOnlineEvalRuleDao) GetOnlineEvalRule(ctx context.Context, id, workspaceID int64) (*model.ObservabilityOnlineEvalRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnlineEvalRule", ctx, id, workspaceID)
+	ret0, _ := ret[0].(*model.ObservabilityOnlineEvalRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOnlineEvalRule indicates an expected call of GetOnlineEvalRule.
+func (mr *MockIOnlineEvalRuleDaoMockRecorder) GetOnlineEvalRule(ctx, id, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnlineEvalRule", reflect.TypeOf((*MockIOnlineEvalRuleDao)(nil).GetOnlineEvalRule), ctx, id, workspaceID)
+}
+
+// ListOnlineEvalRules mocks base method.
+func (m *MockIOnlineEvalRuleDao) ListOnlineEvalRules(ctx context.Context, workspaceID int64, enabledOnly bool) ([]*model.ObservabilityOnlineEvalRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOnlineEvalRules", ctx, workspaceID, enabledOnly)
+	ret0, _ := ret[0].([]*model.ObservabilityOnlineEvalRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOnlineEvalRules indicates an expected call of ListOnlineEvalRules.
+func (mr *MockIOnlineEvalRuleDaoMockRecorder) ListOnlineEvalRules(ctx, workspaceID, enabledOnly any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOnlineEvalRules", reflect.TypeOf((*MockIOnlineEvalRuleDao)(nil).ListOnlineEvalRules), ctx, workspaceID, enabledOnly)
+}
+
+// UpdateOnlineEvalRule mocks base method.
+func (m *MockIOnlineEvalRuleDao) UpdateOnlineEvalRule(ctx context.Context, po *model.ObservabilityOnlineEvalRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOnlineEvalRule", ctx, po)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOnlineEvalRule indicates an expected call of UpdateOnlineEvalRule.
+func (mr *MockIOnlineEvalRuleDaoMockRecorder) UpdateOnlineEvalRule(ctx, po any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOnlineEvalRule", reflect.TypeOf((*MockIOnlineEvalRuleDao)(nil).UpdateOnlineEvalRule), ctx, po)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	genquery "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/query"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+//go:generate mockgen -destination=mocks/online_eval_rule.go -package=mocks . IOnlineEvalRuleDao
+type IOnlineEvalRuleDao interface {
+	GetOnlineEvalRule(ctx context.Context, id int64, workspaceID int64) (*model.ObservabilityOnlineEvalRule, error)
+	ListOnlineEvalRules(ctx context.Context, workspaceID int64, enabledOnly bool) ([]*model.ObservabilityOnlineEvalRule, error)
+	CreateOnlineEvalRule(ctx context.Context, po *model.ObservabilityOnlineEvalRule) (int64, error)
+	UpdateOnlineEvalRule(ctx context.Context, po *model.ObservabilityOnlineEvalRule) error
+	DeleteOnlineEvalRule(ctx context.Context, id int64, workspaceID int64, userID string) error
+}
+
+func NewOnlineEvalRuleDaoImpl(db db.Provider) IOnlineEvalRuleDao {
+	return &OnlineEvalRuleDaoImpl{
+		dbMgr: db,
+	}
+}
+
+type OnlineEvalRuleDaoImpl struct {
+	dbMgr db.Provider
+}
+
+func (o *OnlineEvalRuleDaoImpl) GetOnlineEvalRule(ctx context.Context, id int64, workspaceID int64) (*model.ObservabilityOnlineEvalRule, error) {
+	q := genquery.Use(o.dbMgr.NewSession(ctx)).ObservabilityOnlineEvalRule
+	rulePo, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.WorkspaceID.Eq(workspaceID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return rulePo, nil
+}
+
+func (o *OnlineEvalRuleDaoImpl) ListOnlineEvalRules(ctx context.Context, workspaceID int64, enabledOnly bool) ([]*model.ObservabilityOnlineEvalRule, error) {
+	q := genquery.Use(o.dbMgr.NewSession(ctx)).ObservabilityOnlineEvalRule
+	qd := q.WithContext(ctx).Where(q.WorkspaceID.Eq(workspaceID))
+	if enabledOnly {
+		qd = qd.Where(q.Enabled.Is(true))
+	}
+	results, err := qd.Order(q.ID.Desc()).Limit(100).Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return results, nil
+}
+
+func (o *OnlineEvalRuleDaoImpl) CreateOnlineEvalRule(ctx context.Context, po *model.ObservabilityOnlineEvalRule) (int64, error) {
+	q := genquery.Use(o.dbMgr.NewSession(ctx)).ObservabilityOnlineEvalRule
+	if err := q.WithContext(ctx).Create(po); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return 0, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("online eval rule duplicate key"))
+		}
+		return 0, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return po.ID, nil
+}
+
+func (o *OnlineEvalRuleDaoImpl) UpdateOnlineEvalRule(ctx context.Context, po *model.ObservabilityOnlineEvalRule) error {
+	q := genquery.Use(o.dbMgr.NewSession(ctx)).ObservabilityOnlineEvalRule
+	if err := q.WithContext(ctx).Save(po); err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (o *OnlineEvalRuleDaoImpl) DeleteOnlineEvalRule(ctx context.Context, id int64, workspaceID int64, userID string) error {
+	q := genquery.Use(o.dbMgr.NewSession(ctx)).ObservabilityOnlineEvalRule
+	info, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.WorkspaceID.Eq(workspaceID)).Delete()
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	logs.CtxInfo(ctx, "%d online eval rules deleted by %s", info.RowsAffected, userID)
+	return nil
+}
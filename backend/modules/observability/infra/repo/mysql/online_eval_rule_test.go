@@ -0,0 +1,71 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	dbmock "github.com/coze-dev/coze-loop/backend/infra/db/mocks"
+)
+
+func newOnlineEvalRuleSqlMock(t *testing.T, ctrl *gomock.Controller) (*OnlineEvalRuleDaoImpl, sqlmock.Sqlmock) {
+	d, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	gormDb, err := gorm.Open(mysql.New(mysql.Config{Conn: d, SkipInitializeWithVersion: true}), &gorm.Config{})
+	assert.NoError(t, err)
+	mockdb := dbmock.NewMockProvider(ctrl)
+	mockdb.EXPECT().NewSession(gomock.Any()).Return(gormDb).AnyTimes()
+	return &OnlineEvalRuleDaoImpl{dbMgr: mockdb}, mock
+}
+
+func TestOnlineEvalRuleDaoImpl_GetOnlineEvalRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	dao, mock := newOnlineEvalRuleSqlMock(t, ctrl)
+
+	mock.ExpectQuery("^SELECT").WillReturnRows(sqlmock.NewRows([]string{"id", "workspace_id"}).AddRow(1, 12))
+	got, err := dao.GetOnlineEvalRule(context.Background(), 1, 12)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), got.ID)
+
+	mock.ExpectQuery("^SELECT").WillReturnError(gorm.ErrRecordNotFound)
+	got, err = dao.GetOnlineEvalRule(context.Background(), 2, 12)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	mock.ExpectQuery("^SELECT").WillReturnError(assert.AnError)
+	_, err = dao.GetOnlineEvalRule(context.Background(), 3, 12)
+	assert.Error(t, err)
+}
+
+func TestOnlineEvalRuleDaoImpl_ListOnlineEvalRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	dao, mock := newOnlineEvalRuleSqlMock(t, ctrl)
+
+	mock.ExpectQuery("SELECT .* WHERE .*workspace_id.* AND .*enabled").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	got, err := dao.ListOnlineEvalRules(context.Background(), 12, true)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOnlineEvalRuleDaoImpl_DeleteOnlineEvalRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	dao, mock := newOnlineEvalRuleSqlMock(t, ctrl)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("^UPDATE").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	assert.NoError(t, dao.DeleteOnlineEvalRule(context.Background(), 1, 12, "u1"))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/convertor"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func NewOnlineEvalRuleRepoImpl(ruleDao mysql.IOnlineEvalRuleDao, idGenerator idgen.IIDGenerator) repo.IOnlineEvalRuleRepo {
+	return &OnlineEvalRuleRepoImpl{
+		ruleDao:     ruleDao,
+		idGenerator: idGenerator,
+	}
+}
+
+type OnlineEvalRuleRepoImpl struct {
+	ruleDao     mysql.IOnlineEvalRuleDao
+	idGenerator idgen.IIDGenerator
+}
+
+func (o *OnlineEvalRuleRepoImpl) GetOnlineEvalRule(ctx context.Context, id int64, workspaceID int64) (*entity.OnlineEvalRule, error) {
+	rulePo, err := o.ruleDao.GetOnlineEvalRule(ctx, id, workspaceID)
+	if err != nil || rulePo == nil {
+		return nil, err
+	}
+	rule, err := convertor.OnlineEvalRulePO2DO(rulePo)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInternalErrorCodeCode)
+	}
+	return rule, nil
+}
+
+func (o *OnlineEvalRuleRepoImpl) ListOnlineEvalRules(ctx context.Context, workspaceID int64, enabledOnly bool) ([]*entity.OnlineEvalRule, error) {
+	results, err := o.ruleDao.ListOnlineEvalRules(ctx, workspaceID, enabledOnly)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*entity.OnlineEvalRule, 0, len(results))
+	for _, result := range results {
+		rule, err := convertor.OnlineEvalRulePO2DO(result)
+		if err != nil {
+			return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInternalErrorCodeCode)
+		}
+		resp = append(resp, rule)
+	}
+	return resp, nil
+}
+
+func (o *OnlineEvalRuleRepoImpl) CreateOnlineEvalRule(ctx context.Context, do *entity.OnlineEvalRule) (int64, error) {
+	id, err := o.idGenerator.GenID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rulePo, err := convertor.OnlineEvalRuleDO2PO(do)
+	if err != nil {
+		return 0, errorx.WrapByCode(err, obErrorx.CommercialCommonInternalErrorCodeCode)
+	}
+	rulePo.ID = id
+	return o.ruleDao.CreateOnlineEvalRule(ctx, rulePo)
+}
+
+func (o *OnlineEvalRuleRepoImpl) UpdateOnlineEvalRule(ctx context.Context, do *entity.OnlineEvalRule) error {
+	rulePo, err := convertor.OnlineEvalRuleDO2PO(do)
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommercialCommonInternalErrorCodeCode)
+	}
+	return o.ruleDao.UpdateOnlineEvalRule(ctx, rulePo)
+}
+
+func (o *OnlineEvalRuleRepoImpl) DeleteOnlineEvalRule(ctx context.Context, id int64, workspaceID int64, userID string) error {
+	return o.ruleDao.DeleteOnlineEvalRule(ctx, id, workspaceID, userID)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	idgenmock "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	mysqlmock "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TestOnlineEvalRuleRepoImpl_CreateAndGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ruleDao := mysqlmock.NewMockIOnlineEvalRuleDao(ctrl)
+	idGenerator := idgenmock.NewMockIIDGenerator(ctrl)
+	idGenerator.EXPECT().GenID(gomock.Any()).Return(int64(1), nil)
+
+	rule := &entity.OnlineEvalRule{
+		WorkspaceID: 12,
+		Name:        "rule",
+		Enabled:     true,
+		SpanFilters: &loop_span.FilterFields{
+			FilterFields: []*loop_span.FilterField{{
+				FieldName: loop_span.SpanFieldSpanType,
+				FieldType: loop_span.FieldTypeString,
+				Values:    []string{"model"},
+				QueryType: ptr.Of(loop_span.QueryTypeEnumIn),
+			}},
+		},
+		SampleRate:          0.5,
+		EvaluatorVersionIDs: []int64{11, 12},
+		FieldMappings:       []*entity.OnlineEvalFieldMapping{{EvaluatorFieldKey: "input", TraceFieldKey: "Input"}},
+		UpdatedBy:           "u1",
+	}
+	var saved *model.ObservabilityOnlineEvalRule
+	ruleDao.EXPECT().CreateOnlineEvalRule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, po *model.ObservabilityOnlineEvalRule) (int64, error) {
+			saved = po
+			return po.ID, nil
+		})
+	r := NewOnlineEvalRuleRepoImpl(ruleDao, idGenerator)
+	id, err := r.CreateOnlineEvalRule(context.Background(), rule)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+
+	ruleDao.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(1), int64(12)).Return(saved, nil)
+	got, err := r.GetOnlineEvalRule(context.Background(), 1, 12)
+	assert.NoError(t, err)
+	rule.ID = 1
+	assert.Equal(t, rule, got)
+
+	ruleDao.EXPECT().GetOnlineEvalRule(gomock.Any(), int64(2), int64(12)).Return(nil, nil)
+	got, err = r.GetOnlineEvalRule(context.Background(), 2, 12)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestOnlineEvalRuleRepoImpl_ListOnlineEvalRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ruleDao := mysqlmock.NewMockIOnlineEvalRuleDao(ctrl)
+	ruleDao.EXPECT().ListOnlineEvalRules(gomock.Any(), int64(12), true).Return([]*model.ObservabilityOnlineEvalRule{
+		{ID: 1, WorkspaceID: 12, EvaluatorVersionIDs: "[11]", FieldMappings: "[]"},
+		{ID: 2, WorkspaceID: 12, EvaluatorVersionIDs: "invalid"},
+	}, nil)
+	r := NewOnlineEvalRuleRepoImpl(ruleDao, nil)
+	_, err := r.ListOnlineEvalRules(context.Background(), 12, true)
+	assert.Error(t, err)
+}
@@ -6,6 +6,8 @@ package evaluator
 import (
 	"context"
 
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
+	evaluatordto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/evaluator"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluator"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluatorservice"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
@@ -50,3 +52,33 @@ func (r *EvaluatorRPCAdapter) BatchGetEvaluatorVersions(ctx context.Context, par
 	})
 	return evalInfos, evalMap, nil
 }
+
+func (r *EvaluatorRPCAdapter) RunEvaluator(ctx context.Context, param *rpc.RunEvaluatorParam) (*rpc.EvaluatorRecord, error) {
+	inputFields := make(map[string]*common.Content, len(param.InputFields))
+	for key, text := range param.InputFields {
+		inputFields[key] = &common.Content{
+			ContentType: ptr.Of(common.ContentTypeText),
+			Text:        ptr.Of(text),
+		}
+	}
+	res, err := r.client.RunEvaluator(ctx, &evaluator.RunEvaluatorRequest{
+		WorkspaceID:        param.WorkspaceID,
+		EvaluatorVersionID: param.EvaluatorVersionID,
+		InputData:          &evaluatordto.EvaluatorInputData{InputFields: inputFields},
+	})
+	if err != nil {
+		logs.CtxWarn(ctx, "run evaluator failed: %v", err)
+		return nil, err
+	}
+	record := res.GetRecord()
+	output := record.GetEvaluatorOutputData()
+	ret := &rpc.EvaluatorRecord{
+		RecordID:  record.GetID(),
+		Reasoning: output.GetEvaluatorResult_().GetReasoning(),
+		ErrMsg:    output.GetEvaluatorRunError().GetMessage(),
+	}
+	if result := output.GetEvaluatorResult_(); result != nil {
+		ret.Score = result.Score
+	}
+	return ret, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/domain/prompt"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func PromptDetailDiffDO2DTO(do *entity.PromptDetailDiff) *prompt.PromptDetailDiff {
+	if do == nil {
+		return nil
+	}
+	return &prompt.PromptDetailDiff{
+		FieldDiffs:       BatchFieldDiffDO2DTO(do.FieldDiffs),
+		MessageDiffs:     BatchMessageDiffDO2DTO(do.MessageDiffs),
+		VariableDefDiffs: BatchVariableDefDiffDO2DTO(do.VariableDefDiffs),
+		ToolDiffs:        BatchToolDiffDO2DTO(do.ToolDiffs),
+		ModelConfigDiffs: BatchFieldDiffDO2DTO(do.ModelConfigDiffs),
+	}
+}
+
+func BatchMessageDiffDO2DTO(dos []*entity.MessageDiff) []*prompt.MessageDiff {
+	if len(dos) <= 0 {
+		return nil
+	}
+	dtos := make([]*prompt.MessageDiff, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, MessageDiffDO2DTO(do))
+	}
+	return dtos
+}
+
+func MessageDiffDO2DTO(do *entity.MessageDiff) *prompt.MessageDiff {
+	if do == nil {
+		return nil
+	}
+	dto := &prompt.MessageDiff{
+		DiffType:      ptr.Of(prompt.DiffType(do.DiffType)),
+		BaseMessage:   MessageDO2DTO(do.BaseMessage),
+		TargetMessage: MessageDO2DTO(do.TargetMessage),
+		ContentDiff:   BatchTextDiffLineDO2DTO(do.ContentDiff),
+	}
+	if do.BaseIndex != nil {
+		dto.BaseIndex = ptr.Of(int32(*do.BaseIndex))
+	}
+	if do.TargetIndex != nil {
+		dto.TargetIndex = ptr.Of(int32(*do.TargetIndex))
+	}
+	return dto
+}
+
+func BatchTextDiffLineDO2DTO(dos []*entity.TextDiffLine) []*prompt.TextDiffLine {
+	if len(dos) <= 0 {
+		return nil
+	}
+	dtos := make([]*prompt.TextDiffLine, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, &prompt.TextDiffLine{
+			Operation: ptr.Of(prompt.TextDiffOperation(do.Operation)),
+			Text:      ptr.Of(do.Text),
+		})
+	}
+	return dtos
+}
+
+func BatchVariableDefDiffDO2DTO(dos []*entity.VariableDefDiff) []*prompt.VariableDefDiff {
+	if len(dos) <= 0 {
+		return nil
+	}
+	dtos := make([]*prompt.VariableDefDiff, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, &prompt.VariableDefDiff{
+			DiffType:  ptr.Of(prompt.DiffType(do.DiffType)),
+			Key:       ptr.Of(do.Key),
+			BaseDef:   VariableDefDO2DTO(do.BaseDef),
+			TargetDef: VariableDefDO2DTO(do.TargetDef),
+		})
+	}
+	return dtos
+}
+
+func BatchToolDiffDO2DTO(dos []*entity.ToolDiff) []*prompt.ToolDiff {
+	if len(dos) <= 0 {
+		return nil
+	}
+	dtos := make([]*prompt.ToolDiff, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, &prompt.ToolDiff{
+			DiffType:   ptr.Of(prompt.DiffType(do.DiffType)),
+			Name:       ptr.Of(do.Name),
+			BaseTool:   ToolDO2DTO(do.BaseTool),
+			TargetTool: ToolDO2DTO(do.TargetTool),
+		})
+	}
+	return dtos
+}
+
+func BatchFieldDiffDO2DTO(dos []*entity.FieldDiff) []*prompt.FieldDiff {
+	if len(dos) <= 0 {
+		return nil
+	}
+	dtos := make([]*prompt.FieldDiff, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, &prompt.FieldDiff{
+			DiffType:    ptr.Of(prompt.DiffType(do.DiffType)),
+			Field:       ptr.Of(do.Field),
+			BaseValue:   do.BaseValue,
+			TargetValue: do.TargetValue,
+		})
+	}
+	return dtos
+}
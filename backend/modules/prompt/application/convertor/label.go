@@ -0,0 +1,73 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/domain/prompt"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func BatchPromptLabelDO2DTO(dos []*entity.PromptLabel) []*prompt.PromptLabel {
+	if len(dos) <= 0 {
+		return nil
+	}
+	dtos := make([]*prompt.PromptLabel, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, PromptLabelDO2DTO(do))
+	}
+	if len(dtos) <= 0 {
+		return nil
+	}
+	return dtos
+}
+
+func PromptLabelDO2DTO(do *entity.PromptLabel) *prompt.PromptLabel {
+	if do == nil {
+		return nil
+	}
+	return &prompt.PromptLabel{
+		LabelKey:      ptr.Of(do.LabelKey),
+		CommitVersion: ptr.Of(do.CommitVersion),
+		CreatedBy:     ptr.Of(do.CreatedBy),
+		UpdatedBy:     ptr.Of(do.UpdatedBy),
+		CreatedAt:     ptr.Of(do.CreatedAt.UnixMilli()),
+		UpdatedAt:     ptr.Of(do.UpdatedAt.UnixMilli()),
+	}
+}
+
+func BatchPromptLabelHistoryDO2DTO(dos []*entity.PromptLabelHistory) []*prompt.PromptLabelHistory {
+	if len(dos) <= 0 {
+		return nil
+	}
+	dtos := make([]*prompt.PromptLabelHistory, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, PromptLabelHistoryDO2DTO(do))
+	}
+	if len(dtos) <= 0 {
+		return nil
+	}
+	return dtos
+}
+
+func PromptLabelHistoryDO2DTO(do *entity.PromptLabelHistory) *prompt.PromptLabelHistory {
+	if do == nil {
+		return nil
+	}
+	return &prompt.PromptLabelHistory{
+		ID:                    ptr.Of(do.ID),
+		LabelKey:              ptr.Of(do.LabelKey),
+		CommitVersion:         ptr.Of(do.CommitVersion),
+		PreviousCommitVersion: ptr.Of(do.PreviousCommitVersion),
+		Operation:             ptr.Of(prompt.PromptLabelOperation(do.Operation)),
+		OperatedBy:            ptr.Of(do.OperatedBy),
+		OperatedAt:            ptr.Of(do.OperatedAt.UnixMilli()),
+	}
+}
@@ -150,3 +150,73 @@ func OpenAPIModelConfigDO2DTO(do *entity.ModelConfig) *openapi.LLMConfig {
 		JSONMode:         do.JSONMode,
 	}
 }
+
+func OpenAPIBatchMessageDTO2DO(dtos []*openapi.Message) []*entity.Message {
+	if dtos == nil {
+		return nil
+	}
+	messages := make([]*entity.Message, 0, len(dtos))
+	for _, dto := range dtos {
+		if dto == nil {
+			continue
+		}
+		messages = append(messages, OpenAPIMessageDTO2DO(dto))
+	}
+	return messages
+}
+
+func OpenAPIMessageDTO2DO(dto *openapi.Message) *entity.Message {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Message{
+		Role:    RoleDTO2DO(dto.GetRole()),
+		Content: dto.Content,
+	}
+}
+
+func OpenAPIBatchVariableValDTO2DO(dtos []*openapi.VariableVal) []*entity.VariableVal {
+	if dtos == nil {
+		return nil
+	}
+	variableVals := make([]*entity.VariableVal, 0, len(dtos))
+	for _, dto := range dtos {
+		if dto == nil {
+			continue
+		}
+		variableVals = append(variableVals, OpenAPIVariableValDTO2DO(dto))
+	}
+	return variableVals
+}
+
+func OpenAPIVariableValDTO2DO(dto *openapi.VariableVal) *entity.VariableVal {
+	if dto == nil {
+		return nil
+	}
+	return &entity.VariableVal{
+		Key:                 dto.GetKey(),
+		Value:               dto.Value,
+		PlaceholderMessages: OpenAPIBatchMessageDTO2DO(dto.PlaceholderMessages),
+	}
+}
+
+func OpenAPITokenUsageDO2DTO(do *entity.TokenUsage) *openapi.TokenUsage {
+	if do == nil {
+		return nil
+	}
+	return &openapi.TokenUsage{
+		InputTokens:  ptr.Of(do.InputTokens),
+		OutputTokens: ptr.Of(do.OutputTokens),
+	}
+}
+
+func OpenAPIReplyItemDO2DTO(do *entity.ReplyItem) *openapi.ExecuteData {
+	if do == nil {
+		return nil
+	}
+	return &openapi.ExecuteData{
+		Message:      OpenAPIMessageDO2DTO(do.Message),
+		FinishReason: ptr.Of(do.FinishReason),
+		Usage:        OpenAPITokenUsageDO2DTO(do.TokenUsage),
+	}
+}
@@ -488,3 +488,82 @@ func TestOpenAPIToolCallConfigDO2DTO(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenAPIBatchVariableValDTO2DO(t *testing.T) {
+	tests := []struct {
+		name string
+		dtos []*openapi.VariableVal
+		want []*entity.VariableVal
+	}{
+		{
+			name: "nil input",
+			dtos: nil,
+			want: nil,
+		},
+		{
+			name: "with placeholder messages",
+			dtos: []*openapi.VariableVal{
+				{
+					Key:   ptr.Of("var1"),
+					Value: ptr.Of("value1"),
+				},
+				nil,
+				{
+					Key: ptr.Of("history"),
+					PlaceholderMessages: []*openapi.Message{
+						{
+							Role:    ptr.Of(prompt.RoleUser),
+							Content: ptr.Of("hi"),
+						},
+					},
+				},
+			},
+			want: []*entity.VariableVal{
+				{
+					Key:   "var1",
+					Value: ptr.Of("value1"),
+				},
+				{
+					Key: "history",
+					PlaceholderMessages: []*entity.Message{
+						{
+							Role:    entity.RoleUser,
+							Content: ptr.Of("hi"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, OpenAPIBatchVariableValDTO2DO(tt.dtos))
+		})
+	}
+}
+
+func TestOpenAPIReplyItemDO2DTO(t *testing.T) {
+	assert.Nil(t, OpenAPIReplyItemDO2DTO(nil))
+	assert.Equal(t, &openapi.ExecuteData{
+		Message: &openapi.Message{
+			Role:    ptr.Of(prompt.RoleAssistant),
+			Content: ptr.Of("Hello!"),
+		},
+		FinishReason: ptr.Of("stop"),
+		Usage: &openapi.TokenUsage{
+			InputTokens:  ptr.Of(int64(10)),
+			OutputTokens: ptr.Of(int64(2)),
+		},
+	}, OpenAPIReplyItemDO2DTO(&entity.ReplyItem{
+		Message: &entity.Message{
+			Role:    entity.RoleAssistant,
+			Content: ptr.Of("Hello!"),
+		},
+		FinishReason: "stop",
+		TokenUsage: &entity.TokenUsage{
+			InputTokens:  10,
+			OutputTokens: 2,
+		},
+	}))
+}
@@ -78,6 +78,7 @@ func PromptBasicDTO2DO(dto *prompt.PromptBasic) *entity.PromptBasic {
 	return &entity.PromptBasic{
 		DisplayName:   dto.GetDisplayName(),
 		Description:   dto.GetDescription(),
+		PromptType:    entity.PromptType(dto.GetPromptType()),
 		LatestVersion: dto.GetLatestVersion(),
 		CreatedBy:     dto.GetCreatedBy(),
 		UpdatedBy:     dto.GetUpdatedBy(),
@@ -683,8 +684,14 @@ func PromptBasicDO2DTO(do *entity.PromptBasic) *prompt.PromptBasic {
 		return nil
 	}
 	return &prompt.PromptBasic{
-		DisplayName:   ptr.Of(do.DisplayName),
-		Description:   ptr.Of(do.Description),
+		DisplayName: ptr.Of(do.DisplayName),
+		Description: ptr.Of(do.Description),
+		PromptType: func() *prompt.PromptType {
+			if do.PromptType == "" {
+				return nil
+			}
+			return ptr.Of(prompt.PromptType(do.PromptType))
+		}(),
 		LatestVersion: ptr.Of(do.LatestVersion),
 		CreatedBy:     ptr.Of(do.CreatedBy),
 		UpdatedBy:     ptr.Of(do.UpdatedBy),
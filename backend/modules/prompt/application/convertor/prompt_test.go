@@ -65,6 +65,7 @@ func mockPromptCases() []promptTestCase {
 				PromptBasic: &prompt.PromptBasic{
 					DisplayName:   ptr.Of("Test Prompt"),
 					Description:   ptr.Of("Test PromptDescription"),
+					PromptType:    ptr.Of(prompt.PromptTypeNormal),
 					LatestVersion: ptr.Of("1.0.0"),
 					CreatedBy:     ptr.Of("test_user"),
 					UpdatedBy:     ptr.Of("test_user"),
@@ -160,6 +161,7 @@ func mockPromptCases() []promptTestCase {
 				PromptBasic: &entity.PromptBasic{
 					DisplayName:   "Test Prompt",
 					Description:   "Test PromptDescription",
+					PromptType:    entity.PromptTypeNormal,
 					LatestVersion: "1.0.0",
 					CreatedBy:     "test_user",
 					UpdatedBy:     "test_user",
@@ -258,6 +260,7 @@ func mockPromptCases() []promptTestCase {
 				PromptBasic: &prompt.PromptBasic{
 					DisplayName:   ptr.Of("Test Prompt"),
 					Description:   ptr.Of("Test PromptDescription"),
+					PromptType:    ptr.Of(prompt.PromptTypeNormal),
 					LatestVersion: ptr.Of("1.0.0"),
 					CreatedBy:     ptr.Of("test_user"),
 					UpdatedBy:     ptr.Of("test_user"),
@@ -272,6 +275,7 @@ func mockPromptCases() []promptTestCase {
 				PromptBasic: &entity.PromptBasic{
 					DisplayName:   "Test Prompt",
 					Description:   "Test PromptDescription",
+					PromptType:    entity.PromptTypeNormal,
 					LatestVersion: "1.0.0",
 					CreatedBy:     "test_user",
 					UpdatedBy:     "test_user",
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/domain/prompt"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func BatchSnippetReferenceDO2DTO(dos []*entity.PromptSnippetReference) []*prompt.SnippetReference {
+	if len(dos) <= 0 {
+		return nil
+	}
+	dtos := make([]*prompt.SnippetReference, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, SnippetReferenceDO2DTO(do))
+	}
+	if len(dtos) <= 0 {
+		return nil
+	}
+	return dtos
+}
+
+func SnippetReferenceDO2DTO(do *entity.PromptSnippetReference) *prompt.SnippetReference {
+	if do == nil {
+		return nil
+	}
+	return &prompt.SnippetReference{
+		PromptID:       ptr.Of(do.PromptID),
+		PromptKey:      ptr.Of(do.PromptKey),
+		CommitVersion:  ptr.Of(do.CommitVersion),
+		SnippetVersion: ptr.Of(do.SnippetVersion),
+		CreatedAt:      ptr.Of(do.CreatedAt.UnixMilli()),
+	}
+}
@@ -45,7 +45,7 @@ type PromptExecuteApplicationImpl struct {
 func (p *PromptExecuteApplicationImpl) ExecuteInternal(ctx context.Context, req *execute.ExecuteInternalRequest) (r *execute.ExecuteInternalResponse, err error) {
 	r = execute.NewExecuteInternalResponse()
 	var span cozeloop.Span
-	ctx, span = p.startPromptExecutorSpan(ctx, startPromptExecutorSpanParam{
+	ctx, span = startPromptExecutorSpan(ctx, startPromptExecutorSpanParam{
 		workspaceID:      req.GetWorkspaceID(),
 		bizScene:         req.Scenario,
 		stream:           false,
@@ -58,7 +58,7 @@ func (p *PromptExecuteApplicationImpl) ExecuteInternal(ctx context.Context, req
 	var promptDO *entity.Prompt
 	var reply *entity.Reply
 	defer func() {
-		p.finishPromptExecutorSpan(ctx, span, promptDO, reply, err)
+		finishPromptExecutorSpan(ctx, span, promptDO, reply, err)
 	}()
 	// 内部接口不鉴权
 	// retrieve prompt
@@ -88,6 +88,7 @@ func (p *PromptExecuteApplicationImpl) ExecuteInternal(ctx context.Context, req
 
 type startPromptExecutorSpanParam struct {
 	workspaceID      int64
+	callType         string
 	bizScene         *prompt.Scenario
 	stream           bool
 	reqPromptID      int64
@@ -111,7 +112,7 @@ func overrideParamsConvert(dto *prompt.OverridePromptParams) *overrideParams {
 	}
 }
 
-func (p *PromptExecuteApplicationImpl) startPromptExecutorSpan(ctx context.Context, param startPromptExecutorSpanParam) (context.Context, cozeloop.Span) {
+func startPromptExecutorSpan(ctx context.Context, param startPromptExecutorSpanParam) (context.Context, cozeloop.Span) {
 	// 上游已经设置call_type过则不再设置
 	var hasSetCallType bool
 	if parentSpan := cozeloop.GetSpanFromContext(ctx); parentSpan != nil && parentSpan.GetSpanID() != "" {
@@ -127,8 +128,12 @@ func (p *PromptExecuteApplicationImpl) startPromptExecutorSpan(ctx context.Conte
 		looptracer.WithSpanWorkspaceID(strconv.FormatInt(param.workspaceID, 10)))
 	if span != nil {
 		if !hasSetCallType {
-			// todo: 目前只有评测，默认为评测
-			span.SetCallType(consts.SpanTagCallTypeEvaluation)
+			callType := param.callType
+			if callType == "" {
+				// 未指定时默认为评测
+				callType = consts.SpanTagCallTypeEvaluation
+			}
+			span.SetCallType(callType)
 		}
 		intput := map[string]any{
 			tracespec.PromptVersion:            param.reqPromptVersion,
@@ -149,7 +154,7 @@ func (p *PromptExecuteApplicationImpl) startPromptExecutorSpan(ctx context.Conte
 	return ctx, span
 }
 
-func (p *PromptExecuteApplicationImpl) finishPromptExecutorSpan(ctx context.Context, span cozeloop.Span, prompt *entity.Prompt, reply *entity.Reply, err error) {
+func finishPromptExecutorSpan(ctx context.Context, span cozeloop.Span, prompt *entity.Prompt, reply *entity.Reply, err error) {
 	if span == nil || prompt == nil {
 		return
 	}
@@ -30,6 +30,7 @@ import (
 
 func NewPromptManageApplication(
 	promptManageRepo repo.IManageRepo,
+	labelRepo repo.ILabelRepo,
 	promptService service.IPromptService,
 	authRPCProvider rpc.IAuthProvider,
 	userRPCProvider rpc.IUserProvider,
@@ -38,6 +39,7 @@ func NewPromptManageApplication(
 ) manage.PromptManageService {
 	return &PromptManageApplicationImpl{
 		manageRepo:       promptManageRepo,
+		labelRepo:        labelRepo,
 		promptService:    promptService,
 		authRPCProvider:  authRPCProvider,
 		userRPCProvider:  userRPCProvider,
@@ -48,6 +50,7 @@ func NewPromptManageApplication(
 
 type PromptManageApplicationImpl struct {
 	manageRepo       repo.IManageRepo
+	labelRepo        repo.ILabelRepo
 	promptService    service.IPromptService
 	authRPCProvider  rpc.IAuthProvider
 	userRPCProvider  rpc.IUserProvider
@@ -64,6 +67,15 @@ func (app *PromptManageApplicationImpl) CreatePrompt(ctx context.Context, reques
 		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
 	}
 
+	// 校验
+	promptType := prompt.PromptTypeNormal
+	if request.PromptType != nil {
+		promptType = request.GetPromptType()
+	}
+	if !lo.Contains([]string{prompt.PromptTypeNormal, prompt.PromptTypeSnippet}, promptType) {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("Prompt type is invalid, prompt type = %s", promptType)))
+	}
+
 	// 权限
 	err = app.authRPCProvider.CheckSpacePermission(ctx, request.GetWorkspaceID(), consts.ActionWorkspaceCreateLoopPrompt)
 	if err != nil {
@@ -77,6 +89,7 @@ func (app *PromptManageApplicationImpl) CreatePrompt(ctx context.Context, reques
 		PromptBasic: &prompt.PromptBasic{
 			DisplayName: request.PromptName,
 			Description: request.PromptDescription,
+			PromptType:  ptr.Of(promptType),
 			CreatedBy:   ptr.Of(userID),
 			UpdatedBy:   ptr.Of(userID),
 		},
@@ -148,6 +161,9 @@ func (app *PromptManageApplicationImpl) ClonePrompt(ctx context.Context, request
 		Description: request.GetClonedPromptDescription(),
 		CreatedBy:   userID,
 	}
+	if promptDO.PromptBasic != nil {
+		clonedPromptDO.PromptBasic.PromptType = promptDO.PromptBasic.PromptType
+	}
 	clonedPromptDO.PromptDraft = &entity.PromptDraft{
 		DraftInfo: &entity.DraftInfo{
 			UserID:     userID,
@@ -188,6 +204,22 @@ func (app *PromptManageApplicationImpl) DeletePrompt(ctx context.Context, reques
 		return r, err
 	}
 
+	// 片段被其他prompt引用时不允许删除
+	if promptDO.PromptBasic != nil && promptDO.PromptBasic.PromptType == entity.PromptTypeSnippet {
+		refResult, err := app.manageRepo.ListSnippetReference(ctx, repo.ListSnippetReferenceParam{
+			SnippetPromptID: request.GetPromptID(),
+			PageSize:        1,
+		})
+		if err != nil {
+			return r, err
+		}
+		if refResult != nil && len(refResult.ReferenceDOs) > 0 {
+			return r, errorx.NewByCode(prompterr.PromptSnippetReferencedCode,
+				errorx.WithExtraMsg(fmt.Sprintf("snippet prompt id: %d is referenced by prompt key: %s", request.GetPromptID(), refResult.ReferenceDOs[0].PromptKey)),
+				errorx.WithExtra(map[string]string{"prompt_key": promptDO.PromptKey}))
+		}
+	}
+
 	// delete prompt
 	err = app.manageRepo.DeletePrompt(ctx, request.GetPromptID())
 	return r, err
@@ -258,15 +290,34 @@ func (app *PromptManageApplicationImpl) GetPrompt(ctx context.Context, request *
 func (app *PromptManageApplicationImpl) BatchGetPrompt(ctx context.Context, request *manage.BatchGetPromptRequest) (r *manage.BatchGetPromptResponse, err error) {
 	r = manage.NewBatchGetPromptResponse()
 	// 内部接口不鉴权
+	// 未指定提交版本但指定了发布标签的，先解析标签指向的提交版本
+	var labelPairs []service.PromptIDLabelPair
+	for _, query := range request.Queries {
+		if query == nil || !query.GetWithCommit() || query.GetCommitVersion() != "" || query.GetLabel() == "" {
+			continue
+		}
+		labelPairs = append(labelPairs, service.PromptIDLabelPair{PromptID: query.GetPromptID(), Label: query.GetLabel()})
+	}
+	var labelCommitVersionMap map[service.PromptIDLabelPair]string
+	if len(labelPairs) > 0 {
+		labelCommitVersionMap, err = app.promptService.MParseCommitVersionByLabel(ctx, labelPairs)
+		if err != nil {
+			return r, err
+		}
+	}
 	paramMap := make(map[repo.GetPromptParam]*manage.PromptQuery)
 	for _, query := range request.Queries {
 		if query == nil {
 			continue
 		}
+		commitVersion := query.GetCommitVersion()
+		if query.GetWithCommit() && commitVersion == "" && query.GetLabel() != "" {
+			commitVersion = labelCommitVersionMap[service.PromptIDLabelPair{PromptID: query.GetPromptID(), Label: query.GetLabel()}]
+		}
 		paramMap[repo.GetPromptParam{
 			PromptID:      query.GetPromptID(),
 			WithCommit:    query.GetWithCommit(),
-			CommitVersion: query.GetCommitVersion(),
+			CommitVersion: commitVersion,
 		}] = query
 	}
 	promptMap, err := app.manageRepo.MGetPrompt(ctx, maps.Keys(paramMap))
@@ -305,6 +356,7 @@ func (app *PromptManageApplicationImpl) ListPrompt(ctx context.Context, request
 		CreatedBys:    request.GetCreatedBys(),
 		UserID:        userID,
 		CommittedOnly: request.GetCommittedOnly(),
+		PromptTypes:   request.GetPromptTypes(),
 
 		PageNum:  int(request.GetPageNum()),
 		PageSize: int(request.GetPageSize()),
@@ -452,6 +504,9 @@ func (app *PromptManageApplicationImpl) CommitDraft(ctx context.Context, request
 	// prompt
 	getPromptParam := repo.GetPromptParam{
 		PromptID: request.GetPromptID(),
+
+		WithDraft: true,
+		UserID:    userID,
 	}
 	promptDO, err := app.manageRepo.GetPrompt(ctx, getPromptParam)
 	if err != nil {
@@ -464,6 +519,14 @@ func (app *PromptManageApplicationImpl) CommitDraft(ctx context.Context, request
 		return r, err
 	}
 
+	// 片段引用必须能完整展开，避免提交出无法使用的版本
+	if len(promptDO.GetPromptDetail().GetSnippetRefs()) > 0 {
+		_, err = app.promptService.ExpandSnippets(ctx, promptDO)
+		if err != nil {
+			return r, err
+		}
+	}
+
 	// commit
 	commitDraftParam := repo.CommitDraftParam{
 		PromptID: request.GetPromptID(),
@@ -589,6 +652,337 @@ func (app *PromptManageApplicationImpl) RevertDraftFromCommit(ctx context.Contex
 	return r, err
 }
 
+func (app *PromptManageApplicationImpl) DiffCommit(ctx context.Context, request *manage.DiffCommitRequest) (r *manage.DiffCommitResponse, err error) {
+	r = manage.NewDiffCommitResponse()
+
+	// 用户
+	_, ok := session.UserIDInCtx(ctx)
+	if !ok {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
+	}
+
+	// prompt
+	getPromptParam := repo.GetPromptParam{
+		PromptID: request.GetPromptID(),
+	}
+	promptDO, err := app.manageRepo.GetPrompt(ctx, getPromptParam)
+	if err != nil {
+		return r, err
+	}
+
+	// 权限
+	err = app.authRPCProvider.MCheckPromptPermission(ctx, promptDO.SpaceID, []int64{request.GetPromptID()}, consts.ActionLoopPromptRead)
+	if err != nil {
+		return r, err
+	}
+
+	// get commits
+	baseParam := repo.GetPromptParam{
+		PromptID: request.GetPromptID(),
+
+		WithCommit:    true,
+		CommitVersion: request.GetBaseCommitVersion(),
+	}
+	targetParam := repo.GetPromptParam{
+		PromptID: request.GetPromptID(),
+
+		WithCommit:    true,
+		CommitVersion: request.GetTargetCommitVersion(),
+	}
+	promptDOMap, err := app.manageRepo.MGetPrompt(ctx, []repo.GetPromptParam{baseParam, targetParam}, repo.WithPromptCacheEnable())
+	if err != nil {
+		return r, err
+	}
+	baseDO, targetDO := promptDOMap[baseParam], promptDOMap[targetParam]
+	if baseDO == nil || baseDO.PromptCommit == nil || targetDO == nil || targetDO.PromptCommit == nil {
+		return r, errorx.NewByCode(prompterr.PromptVersionNotExistCode, errorx.WithExtraMsg(
+			fmt.Sprintf("prompt id = %d, base commit version = %s, target commit version = %s",
+				request.GetPromptID(), request.GetBaseCommitVersion(), request.GetTargetCommitVersion())))
+	}
+
+	// diff
+	diffDO := entity.DiffPromptDetail(baseDO.PromptCommit.PromptDetail, targetDO.PromptCommit.PromptDetail)
+	r.Diff = convertor.PromptDetailDiffDO2DTO(diffDO)
+	return r, nil
+}
+
+func (app *PromptManageApplicationImpl) SetPromptLabel(ctx context.Context, request *manage.SetPromptLabelRequest) (r *manage.SetPromptLabelResponse, err error) {
+	r = manage.NewSetPromptLabelResponse()
+
+	// 用户
+	userID, ok := session.UserIDInCtx(ctx)
+	if !ok {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
+	}
+
+	// 校验
+	if !entity.IsValidPromptLabelKey(request.GetLabelKey()) {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg(
+			fmt.Sprintf("Label key is invalid, label key = %s", request.GetLabelKey())))
+	}
+
+	// prompt
+	getPromptParam := repo.GetPromptParam{
+		PromptID: request.GetPromptID(),
+	}
+	promptDO, err := app.manageRepo.GetPrompt(ctx, getPromptParam)
+	if err != nil {
+		return r, err
+	}
+
+	// 权限
+	err = app.authRPCProvider.MCheckPromptPermission(ctx, promptDO.SpaceID, []int64{request.GetPromptID()}, consts.ActionLoopPromptEdit)
+	if err != nil {
+		return r, err
+	}
+
+	// set label
+	setLabelParam := repo.SetLabelParam{
+		SpaceID:  promptDO.SpaceID,
+		PromptID: request.GetPromptID(),
+		UserID:   userID,
+
+		LabelKey:      request.GetLabelKey(),
+		CommitVersion: request.GetCommitVersion(),
+		Operation:     entity.PromptLabelOperationSet,
+	}
+	labelDO, err := app.labelRepo.SetLabel(ctx, setLabelParam)
+	if err != nil {
+		return r, err
+	}
+	r.Label = convertor.PromptLabelDO2DTO(labelDO)
+	return r, nil
+}
+
+func (app *PromptManageApplicationImpl) ListPromptLabel(ctx context.Context, request *manage.ListPromptLabelRequest) (r *manage.ListPromptLabelResponse, err error) {
+	r = manage.NewListPromptLabelResponse()
+
+	// 用户
+	_, ok := session.UserIDInCtx(ctx)
+	if !ok {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
+	}
+
+	// prompt
+	getPromptParam := repo.GetPromptParam{
+		PromptID: request.GetPromptID(),
+	}
+	promptDO, err := app.manageRepo.GetPrompt(ctx, getPromptParam)
+	if err != nil {
+		return r, err
+	}
+
+	// 权限
+	err = app.authRPCProvider.MCheckPromptPermission(ctx, promptDO.SpaceID, []int64{request.GetPromptID()}, consts.ActionLoopPromptRead)
+	if err != nil {
+		return r, err
+	}
+
+	// list label
+	labelDOs, err := app.labelRepo.ListLabel(ctx, request.GetPromptID())
+	if err != nil {
+		return r, err
+	}
+	r.Labels = convertor.BatchPromptLabelDO2DTO(labelDOs)
+	userIDSet := make(map[string]struct{})
+	for _, labelDO := range labelDOs {
+		if labelDO == nil {
+			continue
+		}
+		for _, userID := range []string{labelDO.CreatedBy, labelDO.UpdatedBy} {
+			if lo.IsEmpty(userID) {
+				continue
+			}
+			userIDSet[userID] = struct{}{}
+		}
+	}
+	userDOs, err := app.userRPCProvider.MGetUserInfo(ctx, maps.Keys(userIDSet))
+	if err != nil {
+		return manage.NewListPromptLabelResponse(), err
+	}
+	r.Users = convertor.BatchUserInfoDO2DTO(userDOs)
+	return r, nil
+}
+
+func (app *PromptManageApplicationImpl) ListPromptLabelHistory(ctx context.Context, request *manage.ListPromptLabelHistoryRequest) (r *manage.ListPromptLabelHistoryResponse, err error) {
+	r = manage.NewListPromptLabelHistoryResponse()
+
+	// 用户
+	_, ok := session.UserIDInCtx(ctx)
+	if !ok {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
+	}
+
+	// prompt
+	getPromptParam := repo.GetPromptParam{
+		PromptID: request.GetPromptID(),
+	}
+	promptDO, err := app.manageRepo.GetPrompt(ctx, getPromptParam)
+	if err != nil {
+		return r, err
+	}
+
+	// 权限
+	err = app.authRPCProvider.MCheckPromptPermission(ctx, promptDO.SpaceID, []int64{request.GetPromptID()}, consts.ActionLoopPromptRead)
+	if err != nil {
+		return r, err
+	}
+
+	// 校验
+	var pageTokenPtr *int64
+	if request.PageToken != nil {
+		pageToken, err := strconv.ParseInt(request.GetPageToken(), 10, 64)
+		if err != nil {
+			return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg(
+				fmt.Sprintf("Page token is invalid, page token = %s", request.GetPageToken())))
+		}
+		pageTokenPtr = ptr.Of(pageToken)
+	}
+
+	// list label history
+	listLabelHistoryParam := repo.ListLabelHistoryParam{
+		PromptID: request.GetPromptID(),
+		LabelKey: request.GetLabelKey(),
+
+		PageSize:  int(request.GetPageSize()),
+		PageToken: pageTokenPtr,
+	}
+	listLabelHistoryResult, err := app.labelRepo.ListLabelHistory(ctx, listLabelHistoryParam)
+	if err != nil {
+		return r, err
+	}
+	if listLabelHistoryResult == nil {
+		return r, nil
+	}
+	if listLabelHistoryResult.NextPageToken > 0 {
+		r.NextPageToken = ptr.Of(strconv.FormatInt(listLabelHistoryResult.NextPageToken, 10))
+		r.HasMore = ptr.Of(true)
+	}
+	r.Histories = convertor.BatchPromptLabelHistoryDO2DTO(listLabelHistoryResult.HistoryDOs)
+	userIDSet := make(map[string]struct{})
+	for _, historyDO := range listLabelHistoryResult.HistoryDOs {
+		if historyDO == nil || lo.IsEmpty(historyDO.OperatedBy) {
+			continue
+		}
+		userIDSet[historyDO.OperatedBy] = struct{}{}
+	}
+	userDOs, err := app.userRPCProvider.MGetUserInfo(ctx, maps.Keys(userIDSet))
+	if err != nil {
+		return manage.NewListPromptLabelHistoryResponse(), err
+	}
+	r.Users = convertor.BatchUserInfoDO2DTO(userDOs)
+	return r, nil
+}
+
+func (app *PromptManageApplicationImpl) RollbackPromptLabel(ctx context.Context, request *manage.RollbackPromptLabelRequest) (r *manage.RollbackPromptLabelResponse, err error) {
+	r = manage.NewRollbackPromptLabelResponse()
+
+	// 用户
+	userID, ok := session.UserIDInCtx(ctx)
+	if !ok {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
+	}
+
+	// prompt
+	getPromptParam := repo.GetPromptParam{
+		PromptID: request.GetPromptID(),
+	}
+	promptDO, err := app.manageRepo.GetPrompt(ctx, getPromptParam)
+	if err != nil {
+		return r, err
+	}
+
+	// 权限
+	err = app.authRPCProvider.MCheckPromptPermission(ctx, promptDO.SpaceID, []int64{request.GetPromptID()}, consts.ActionLoopPromptEdit)
+	if err != nil {
+		return r, err
+	}
+
+	// 校验
+	historyDO, err := app.labelRepo.GetLabelHistory(ctx, request.GetHistoryID())
+	if err != nil {
+		return r, err
+	}
+	if historyDO.PromptID != request.GetPromptID() || historyDO.LabelKey != request.GetLabelKey() {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg(
+			fmt.Sprintf("Label history does not belong to the label, history id = %d, label key = %s", request.GetHistoryID(), request.GetLabelKey())))
+	}
+
+	// rollback label
+	setLabelParam := repo.SetLabelParam{
+		SpaceID:  promptDO.SpaceID,
+		PromptID: request.GetPromptID(),
+		UserID:   userID,
+
+		LabelKey:      historyDO.LabelKey,
+		CommitVersion: historyDO.CommitVersion,
+		Operation:     entity.PromptLabelOperationRollback,
+	}
+	labelDO, err := app.labelRepo.SetLabel(ctx, setLabelParam)
+	if err != nil {
+		return r, err
+	}
+	r.Label = convertor.PromptLabelDO2DTO(labelDO)
+	return r, nil
+}
+
+func (app *PromptManageApplicationImpl) ListSnippetReference(ctx context.Context, request *manage.ListSnippetReferenceRequest) (r *manage.ListSnippetReferenceResponse, err error) {
+	r = manage.NewListSnippetReferenceResponse()
+
+	// 用户
+	_, ok := session.UserIDInCtx(ctx)
+	if !ok {
+		return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found"))
+	}
+
+	// prompt
+	getPromptParam := repo.GetPromptParam{
+		PromptID: request.GetPromptID(),
+	}
+	promptDO, err := app.manageRepo.GetPrompt(ctx, getPromptParam)
+	if err != nil {
+		return r, err
+	}
+
+	// 权限
+	err = app.authRPCProvider.MCheckPromptPermission(ctx, promptDO.SpaceID, []int64{request.GetPromptID()}, consts.ActionLoopPromptRead)
+	if err != nil {
+		return r, err
+	}
+
+	// 校验
+	var pageTokenPtr *int64
+	if request.PageToken != nil {
+		pageToken, err := strconv.ParseInt(request.GetPageToken(), 10, 64)
+		if err != nil {
+			return r, errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg(
+				fmt.Sprintf("Page token is invalid, page token = %s", request.GetPageToken())))
+		}
+		pageTokenPtr = ptr.Of(pageToken)
+	}
+
+	// list snippet reference
+	listSnippetReferenceParam := repo.ListSnippetReferenceParam{
+		SnippetPromptID: request.GetPromptID(),
+
+		PageSize:  int(request.GetPageSize()),
+		PageToken: pageTokenPtr,
+	}
+	listSnippetReferenceResult, err := app.manageRepo.ListSnippetReference(ctx, listSnippetReferenceParam)
+	if err != nil {
+		return r, err
+	}
+	if listSnippetReferenceResult == nil {
+		return r, nil
+	}
+	if listSnippetReferenceResult.NextPageToken > 0 {
+		r.NextPageToken = ptr.Of(strconv.FormatInt(listSnippetReferenceResult.NextPageToken, 10))
+		r.HasMore = ptr.Of(true)
+	}
+	r.References = convertor.BatchSnippetReferenceDO2DTO(listSnippetReferenceResult.ReferenceDOs)
+	return r, nil
+}
+
 func (app *PromptManageApplicationImpl) listPromptOrderBy(dtoEnum *manage.ListPromptOrderBy) int {
 	if dtoEnum == nil {
 		return mysql.ListPromptBasicOrderByID
@@ -23,6 +23,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
 	repomocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/service"
+	servicemocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/service/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/consts"
 	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
@@ -1678,7 +1679,9 @@ func TestPromptManageApplicationImpl_CommitDraft(t *testing.T) {
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
 				mockRepo := repomocks.NewMockIManageRepo(ctrl)
 				mockRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{
-					PromptID: 1,
+					PromptID:  1,
+					WithDraft: true,
+					UserID:    "123",
 				}).Return(nil, errorx.New("get prompt error"))
 
 				return fields{
@@ -1700,7 +1703,9 @@ func TestPromptManageApplicationImpl_CommitDraft(t *testing.T) {
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
 				mockRepo := repomocks.NewMockIManageRepo(ctrl)
 				mockRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{
-					PromptID: 1,
+					PromptID:  1,
+					WithDraft: true,
+					UserID:    "123",
 				}).Return(&entity.Prompt{
 					ID:      1,
 					SpaceID: 100,
@@ -1729,7 +1734,9 @@ func TestPromptManageApplicationImpl_CommitDraft(t *testing.T) {
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
 				mockRepo := repomocks.NewMockIManageRepo(ctrl)
 				mockRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{
-					PromptID: 1,
+					PromptID:  1,
+					WithDraft: true,
+					UserID:    "123",
 				}).Return(&entity.Prompt{
 					ID:      1,
 					SpaceID: 100,
@@ -1761,12 +1768,61 @@ func TestPromptManageApplicationImpl_CommitDraft(t *testing.T) {
 			want:    manage.NewCommitDraftResponse(),
 			wantErr: errorx.New("commit draft error"),
 		},
+		{
+			name: "snippet reference invalid",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				promptDO := &entity.Prompt{
+					ID:        1,
+					SpaceID:   100,
+					PromptKey: "parent",
+					PromptDraft: &entity.PromptDraft{
+						PromptDetail: &entity.PromptDetail{
+							PromptTemplate: &entity.PromptTemplate{
+								TemplateType: entity.TemplateTypeNormal,
+								Messages: []*entity.Message{
+									{Role: entity.RoleSystem, Content: ptr.Of("{{#snippet:parent@prod}}")},
+								},
+							},
+						},
+					},
+				}
+				mockRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{
+					PromptID:  1,
+					WithDraft: true,
+					UserID:    "123",
+				}).Return(promptDO, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{1}, consts.ActionLoopPromptEdit).Return(nil)
+
+				mockPromptService := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptService.EXPECT().ExpandSnippets(gomock.Any(), promptDO).Return(nil, errorx.NewByCode(prompterr.PromptSnippetCycleCode))
+
+				return fields{
+					manageRepo:      mockRepo,
+					promptService:   mockPromptService,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.CommitDraftRequest{
+					PromptID:      ptr.Of(int64(1)),
+					CommitVersion: ptr.Of("1.0.0"),
+				},
+			},
+			want:    manage.NewCommitDraftResponse(),
+			wantErr: errorx.NewByCode(prompterr.PromptSnippetCycleCode),
+		},
 		{
 			name: "success",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
 				mockRepo := repomocks.NewMockIManageRepo(ctrl)
 				mockRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{
-					PromptID: 1,
+					PromptID:  1,
+					WithDraft: true,
+					UserID:    "123",
 				}).Return(&entity.Prompt{
 					ID:      1,
 					SpaceID: 100,
@@ -2283,3 +2339,741 @@ func TestPromptManageApplicationImpl_ListPrompt(t *testing.T) {
 		})
 	}
 }
+
+func TestPromptManageApplicationImpl_SetPromptLabel(t *testing.T) {
+	type fields struct {
+		manageRepo      repo.IManageRepo
+		labelRepo       repo.ILabelRepo
+		authRPCProvider rpc.IAuthProvider
+	}
+	type args struct {
+		ctx     context.Context
+		request *manage.SetPromptLabelRequest
+	}
+	now := time.Now()
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		want         *manage.SetPromptLabelResponse
+		wantErr      error
+	}{
+		{
+			name: "user not found",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx: context.Background(),
+				request: &manage.SetPromptLabelRequest{
+					PromptID:      ptr.Of(int64(1)),
+					LabelKey:      ptr.Of("prod"),
+					CommitVersion: ptr.Of("1.0.0"),
+				},
+			},
+			want:    manage.NewSetPromptLabelResponse(),
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found")),
+		},
+		{
+			name: "invalid label key",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.SetPromptLabelRequest{
+					PromptID:      ptr.Of(int64(1)),
+					LabelKey:      ptr.Of("1.0.0"),
+					CommitVersion: ptr.Of("1.0.0"),
+				},
+			},
+			want:    manage.NewSetPromptLabelResponse(),
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("Label key is invalid, label key = 1.0.0")),
+		},
+		{
+			name: "permission denied",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), gomock.Any()).Return(&entity.Prompt{ID: 1, SpaceID: 100}, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{1}, consts.ActionLoopPromptEdit).Return(errorx.NewByCode(prompterr.CommonNoPermissionCode))
+				return fields{
+					manageRepo:      mockManageRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.SetPromptLabelRequest{
+					PromptID:      ptr.Of(int64(1)),
+					LabelKey:      ptr.Of("prod"),
+					CommitVersion: ptr.Of("1.0.0"),
+				},
+			},
+			want:    manage.NewSetPromptLabelResponse(),
+			wantErr: errorx.NewByCode(prompterr.CommonNoPermissionCode),
+		},
+		{
+			name: "success",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), gomock.Any()).Return(&entity.Prompt{ID: 1, SpaceID: 100}, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{1}, consts.ActionLoopPromptEdit).Return(nil)
+
+				mockLabelRepo := repomocks.NewMockILabelRepo(ctrl)
+				mockLabelRepo.EXPECT().SetLabel(gomock.Any(), repo.SetLabelParam{
+					SpaceID:       100,
+					PromptID:      1,
+					UserID:        "123",
+					LabelKey:      "prod",
+					CommitVersion: "1.0.0",
+					Operation:     entity.PromptLabelOperationSet,
+				}).Return(&entity.PromptLabel{
+					PromptID:      1,
+					LabelKey:      "prod",
+					CommitVersion: "1.0.0",
+					CreatedBy:     "123",
+					UpdatedBy:     "123",
+					CreatedAt:     now,
+					UpdatedAt:     now,
+				}, nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					labelRepo:       mockLabelRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.SetPromptLabelRequest{
+					PromptID:      ptr.Of(int64(1)),
+					LabelKey:      ptr.Of("prod"),
+					CommitVersion: ptr.Of("1.0.0"),
+				},
+			},
+			want: &manage.SetPromptLabelResponse{
+				Label: &prompt.PromptLabel{
+					LabelKey:      ptr.Of("prod"),
+					CommitVersion: ptr.Of("1.0.0"),
+					CreatedBy:     ptr.Of("123"),
+					UpdatedBy:     ptr.Of("123"),
+					CreatedAt:     ptr.Of(now.UnixMilli()),
+					UpdatedAt:     ptr.Of(now.UnixMilli()),
+				},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			app := &PromptManageApplicationImpl{
+				manageRepo:      ttFields.manageRepo,
+				labelRepo:       ttFields.labelRepo,
+				authRPCProvider: ttFields.authRPCProvider,
+			}
+
+			got, err := app.SetPromptLabel(tt.args.ctx, tt.args.request)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPromptManageApplicationImpl_RollbackPromptLabel(t *testing.T) {
+	type fields struct {
+		manageRepo      repo.IManageRepo
+		labelRepo       repo.ILabelRepo
+		authRPCProvider rpc.IAuthProvider
+	}
+	type args struct {
+		ctx     context.Context
+		request *manage.RollbackPromptLabelRequest
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		wantVersion  string
+		wantErr      error
+	}{
+		{
+			name: "history belongs to another label",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), gomock.Any()).Return(&entity.Prompt{ID: 1, SpaceID: 100}, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{1}, consts.ActionLoopPromptEdit).Return(nil)
+
+				mockLabelRepo := repomocks.NewMockILabelRepo(ctrl)
+				mockLabelRepo.EXPECT().GetLabelHistory(gomock.Any(), int64(20)).Return(&entity.PromptLabelHistory{
+					ID:            20,
+					PromptID:      1,
+					LabelKey:      "gray",
+					CommitVersion: "1.0.0",
+				}, nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					labelRepo:       mockLabelRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.RollbackPromptLabelRequest{
+					PromptID:  ptr.Of(int64(1)),
+					LabelKey:  ptr.Of("prod"),
+					HistoryID: ptr.Of(int64(20)),
+				},
+			},
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("Label history does not belong to the label, history id = 20, label key = prod")),
+		},
+		{
+			name: "success",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), gomock.Any()).Return(&entity.Prompt{ID: 1, SpaceID: 100}, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{1}, consts.ActionLoopPromptEdit).Return(nil)
+
+				mockLabelRepo := repomocks.NewMockILabelRepo(ctrl)
+				mockLabelRepo.EXPECT().GetLabelHistory(gomock.Any(), int64(20)).Return(&entity.PromptLabelHistory{
+					ID:                    20,
+					PromptID:              1,
+					LabelKey:              "prod",
+					CommitVersion:         "1.0.0",
+					PreviousCommitVersion: "",
+				}, nil)
+				mockLabelRepo.EXPECT().SetLabel(gomock.Any(), repo.SetLabelParam{
+					SpaceID:       100,
+					PromptID:      1,
+					UserID:        "123",
+					LabelKey:      "prod",
+					CommitVersion: "1.0.0",
+					Operation:     entity.PromptLabelOperationRollback,
+				}).Return(&entity.PromptLabel{
+					PromptID:      1,
+					LabelKey:      "prod",
+					CommitVersion: "1.0.0",
+				}, nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					labelRepo:       mockLabelRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.RollbackPromptLabelRequest{
+					PromptID:  ptr.Of(int64(1)),
+					LabelKey:  ptr.Of("prod"),
+					HistoryID: ptr.Of(int64(20)),
+				},
+			},
+			wantVersion: "1.0.0",
+			wantErr:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			app := &PromptManageApplicationImpl{
+				manageRepo:      ttFields.manageRepo,
+				labelRepo:       ttFields.labelRepo,
+				authRPCProvider: ttFields.authRPCProvider,
+			}
+
+			got, err := app.RollbackPromptLabel(tt.args.ctx, tt.args.request)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.wantVersion, got.GetLabel().GetCommitVersion())
+			}
+		})
+	}
+}
+
+func TestPromptManageApplicationImpl_BatchGetPrompt(t *testing.T) {
+	type fields struct {
+		manageRepo    repo.IManageRepo
+		promptService service.IPromptService
+	}
+	type args struct {
+		ctx     context.Context
+		request *manage.BatchGetPromptRequest
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		wantVersions []string
+		wantErr      error
+	}{
+		{
+			name: "resolve commit version by label",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockPromptService := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptService.EXPECT().MParseCommitVersionByLabel(gomock.Any(), []service.PromptIDLabelPair{{PromptID: 1, Label: "prod"}}).
+					Return(map[service.PromptIDLabelPair]string{{PromptID: 1, Label: "prod"}: "1.0.1"}, nil)
+
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPrompt(gomock.Any(), []repo.GetPromptParam{{PromptID: 1, WithCommit: true, CommitVersion: "1.0.1"}}).
+					Return(map[repo.GetPromptParam]*entity.Prompt{
+						{PromptID: 1, WithCommit: true, CommitVersion: "1.0.1"}: {
+							ID: 1,
+							PromptCommit: &entity.PromptCommit{
+								CommitInfo: &entity.CommitInfo{Version: "1.0.1"},
+							},
+						},
+					}, nil)
+				return fields{
+					manageRepo:    mockManageRepo,
+					promptService: mockPromptService,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				request: &manage.BatchGetPromptRequest{
+					Queries: []*manage.PromptQuery{
+						{
+							PromptID:   ptr.Of(int64(1)),
+							WithCommit: ptr.Of(true),
+							Label:      ptr.Of("prod"),
+						},
+					},
+				},
+			},
+			wantVersions: []string{"1.0.1"},
+			wantErr:      nil,
+		},
+		{
+			name: "label not exist",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockPromptService := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptService.EXPECT().MParseCommitVersionByLabel(gomock.Any(), gomock.Any()).Return(nil, errorx.NewByCode(prompterr.PromptLabelNotExistCode))
+				return fields{
+					promptService: mockPromptService,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				request: &manage.BatchGetPromptRequest{
+					Queries: []*manage.PromptQuery{
+						{
+							PromptID:   ptr.Of(int64(1)),
+							WithCommit: ptr.Of(true),
+							Label:      ptr.Of("gray"),
+						},
+					},
+				},
+			},
+			wantErr: errorx.NewByCode(prompterr.PromptLabelNotExistCode),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			app := &PromptManageApplicationImpl{
+				manageRepo:    ttFields.manageRepo,
+				promptService: ttFields.promptService,
+			}
+
+			got, err := app.BatchGetPrompt(tt.args.ctx, tt.args.request)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if err == nil {
+				var versions []string
+				for _, result := range got.Results {
+					versions = append(versions, result.GetPrompt().GetPromptCommit().GetCommitInfo().GetVersion())
+				}
+				assert.Equal(t, tt.wantVersions, versions)
+			}
+		})
+	}
+}
+
+func TestPromptManageApplicationImpl_DiffCommit(t *testing.T) {
+	type fields struct {
+		manageRepo      repo.IManageRepo
+		authRPCProvider rpc.IAuthProvider
+	}
+	type args struct {
+		ctx     context.Context
+		request *manage.DiffCommitRequest
+	}
+	baseParam := repo.GetPromptParam{PromptID: 1, WithCommit: true, CommitVersion: "1.0.0"}
+	targetParam := repo.GetPromptParam{PromptID: 1, WithCommit: true, CommitVersion: "1.0.1"}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		wantDiff     *prompt.PromptDetailDiff
+		wantErr      error
+	}{
+		{
+			name: "user not found",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx: context.Background(),
+				request: &manage.DiffCommitRequest{
+					PromptID:            ptr.Of(int64(1)),
+					BaseCommitVersion:   ptr.Of("1.0.0"),
+					TargetCommitVersion: ptr.Of("1.0.1"),
+				},
+			},
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found")),
+		},
+		{
+			name: "success",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), gomock.Any()).Return(&entity.Prompt{ID: 1, SpaceID: 100}, nil)
+				mockManageRepo.EXPECT().MGetPrompt(gomock.Any(), []repo.GetPromptParam{baseParam, targetParam}, gomock.Any()).Return(map[repo.GetPromptParam]*entity.Prompt{
+					baseParam: {
+						ID: 1,
+						PromptCommit: &entity.PromptCommit{
+							PromptDetail: &entity.PromptDetail{
+								ModelConfig: &entity.ModelConfig{ModelID: 1, Temperature: ptr.Of(0.7)},
+							},
+						},
+					},
+					targetParam: {
+						ID: 1,
+						PromptCommit: &entity.PromptCommit{
+							PromptDetail: &entity.PromptDetail{
+								ModelConfig: &entity.ModelConfig{ModelID: 1, Temperature: ptr.Of(0.2)},
+							},
+						},
+					},
+				}, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{1}, consts.ActionLoopPromptRead).Return(nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.DiffCommitRequest{
+					PromptID:            ptr.Of(int64(1)),
+					BaseCommitVersion:   ptr.Of("1.0.0"),
+					TargetCommitVersion: ptr.Of("1.0.1"),
+				},
+			},
+			wantDiff: &prompt.PromptDetailDiff{
+				ModelConfigDiffs: []*prompt.FieldDiff{
+					{
+						DiffType:    ptr.Of(prompt.DiffTypeModified),
+						Field:       ptr.Of("temperature"),
+						BaseValue:   ptr.Of("0.7"),
+						TargetValue: ptr.Of("0.2"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			app := &PromptManageApplicationImpl{
+				manageRepo:      ttFields.manageRepo,
+				authRPCProvider: ttFields.authRPCProvider,
+			}
+
+			got, err := app.DiffCommit(tt.args.ctx, tt.args.request)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.wantDiff, got.GetDiff())
+			}
+		})
+	}
+}
+
+func TestPromptManageApplicationImpl_ListSnippetReference(t *testing.T) {
+	type fields struct {
+		manageRepo      repo.IManageRepo
+		authRPCProvider rpc.IAuthProvider
+	}
+	type args struct {
+		ctx     context.Context
+		request *manage.ListSnippetReferenceRequest
+	}
+	createdAt := time.UnixMilli(1700000000000)
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		want         *manage.ListSnippetReferenceResponse
+		wantErr      error
+	}{
+		{
+			name: "user not found",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx: context.Background(),
+				request: &manage.ListSnippetReferenceRequest{
+					PromptID: ptr.Of(int64(2)),
+					PageSize: ptr.Of(int32(10)),
+				},
+			},
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found")),
+		},
+		{
+			name: "invalid page token",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 2}).Return(&entity.Prompt{ID: 2, SpaceID: 100}, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{2}, consts.ActionLoopPromptRead).Return(nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.ListSnippetReferenceRequest{
+					PromptID:  ptr.Of(int64(2)),
+					PageSize:  ptr.Of(int32(10)),
+					PageToken: ptr.Of("abc"),
+				},
+			},
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("Page token is invalid, page token = abc")),
+		},
+		{
+			name: "success with more pages",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 2}).Return(&entity.Prompt{ID: 2, SpaceID: 100}, nil)
+				mockManageRepo.EXPECT().ListSnippetReference(gomock.Any(), repo.ListSnippetReferenceParam{
+					SnippetPromptID: 2,
+					PageSize:        1,
+					PageToken:       ptr.Of(int64(30)),
+				}).Return(&repo.ListSnippetReferenceResult{
+					ReferenceDOs: []*entity.PromptSnippetReference{
+						{
+							ID:              20,
+							SpaceID:         100,
+							PromptID:        1,
+							PromptKey:       "parent",
+							CommitVersion:   "1.0.0",
+							SnippetPromptID: 2,
+							SnippetVersion:  "prod",
+							CreatedAt:       createdAt,
+						},
+					},
+					NextPageToken: 10,
+				}, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{2}, consts.ActionLoopPromptRead).Return(nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.ListSnippetReferenceRequest{
+					PromptID:  ptr.Of(int64(2)),
+					PageSize:  ptr.Of(int32(1)),
+					PageToken: ptr.Of("30"),
+				},
+			},
+			want: &manage.ListSnippetReferenceResponse{
+				References: []*prompt.SnippetReference{
+					{
+						PromptID:       ptr.Of(int64(1)),
+						PromptKey:      ptr.Of("parent"),
+						CommitVersion:  ptr.Of("1.0.0"),
+						SnippetVersion: ptr.Of("prod"),
+						CreatedAt:      ptr.Of(createdAt.UnixMilli()),
+					},
+				},
+				HasMore:       ptr.Of(true),
+				NextPageToken: ptr.Of("10"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			app := &PromptManageApplicationImpl{
+				manageRepo:      ttFields.manageRepo,
+				authRPCProvider: ttFields.authRPCProvider,
+			}
+
+			got, err := app.ListSnippetReference(tt.args.ctx, tt.args.request)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPromptManageApplicationImpl_DeletePrompt(t *testing.T) {
+	type fields struct {
+		manageRepo      repo.IManageRepo
+		authRPCProvider rpc.IAuthProvider
+	}
+	type args struct {
+		ctx     context.Context
+		request *manage.DeletePromptRequest
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		wantErr      error
+	}{
+		{
+			name: "user not found",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &manage.DeletePromptRequest{PromptID: ptr.Of(int64(2))},
+			},
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("User not found")),
+		},
+		{
+			name: "snippet is referenced",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 2}).Return(&entity.Prompt{
+					ID:          2,
+					SpaceID:     100,
+					PromptKey:   "persona",
+					PromptBasic: &entity.PromptBasic{PromptType: entity.PromptTypeSnippet},
+				}, nil)
+				mockManageRepo.EXPECT().ListSnippetReference(gomock.Any(), repo.ListSnippetReferenceParam{
+					SnippetPromptID: 2,
+					PageSize:        1,
+				}).Return(&repo.ListSnippetReferenceResult{
+					ReferenceDOs: []*entity.PromptSnippetReference{
+						{ID: 20, PromptID: 1, PromptKey: "parent", CommitVersion: "1.0.0", SnippetPromptID: 2, SnippetVersion: "prod"},
+					},
+				}, nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{2}, consts.ActionLoopPromptEdit).Return(nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx:     session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.DeletePromptRequest{PromptID: ptr.Of(int64(2))},
+			},
+			wantErr: errorx.NewByCode(prompterr.PromptSnippetReferencedCode,
+				errorx.WithExtraMsg("snippet prompt id: 2 is referenced by prompt key: parent"),
+				errorx.WithExtra(map[string]string{"prompt_key": "persona"})),
+		},
+		{
+			name: "success: snippet without reference",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 2}).Return(&entity.Prompt{
+					ID:          2,
+					SpaceID:     100,
+					PromptKey:   "persona",
+					PromptBasic: &entity.PromptBasic{PromptType: entity.PromptTypeSnippet},
+				}, nil)
+				mockManageRepo.EXPECT().ListSnippetReference(gomock.Any(), gomock.Any()).Return(&repo.ListSnippetReferenceResult{}, nil)
+				mockManageRepo.EXPECT().DeletePrompt(gomock.Any(), int64(2)).Return(nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{2}, consts.ActionLoopPromptEdit).Return(nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx:     session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.DeletePromptRequest{PromptID: ptr.Of(int64(2))},
+			},
+		},
+		{
+			name: "success: normal prompt",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 1}).Return(&entity.Prompt{
+					ID:          1,
+					SpaceID:     100,
+					PromptKey:   "parent",
+					PromptBasic: &entity.PromptBasic{PromptType: entity.PromptTypeNormal},
+				}, nil)
+				mockManageRepo.EXPECT().DeletePrompt(gomock.Any(), int64(1)).Return(nil)
+
+				mockAuth := mocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(100), []int64{1}, consts.ActionLoopPromptEdit).Return(nil)
+				return fields{
+					manageRepo:      mockManageRepo,
+					authRPCProvider: mockAuth,
+				}
+			},
+			args: args{
+				ctx:     session.WithCtxUser(context.Background(), &session.User{ID: "123"}),
+				request: &manage.DeletePromptRequest{PromptID: ptr.Of(int64(1))},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			app := &PromptManageApplicationImpl{
+				manageRepo:      ttFields.manageRepo,
+				authRPCProvider: ttFields.authRPCProvider,
+			}
+
+			_, err := app.DeletePrompt(tt.args.ctx, tt.args.request)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+		})
+	}
+}
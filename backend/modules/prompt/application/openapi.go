@@ -5,17 +5,25 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2/codes"
+	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2/status"
+	"github.com/coze-dev/cozeloop-go"
+	loopentity "github.com/coze-dev/cozeloop-go/entity"
+	"github.com/coze-dev/cozeloop-go/spec/tracespec"
 	"golang.org/x/exp/maps"
 
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
+	"github.com/coze-dev/coze-loop/backend/infra/looptracer"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/openapi"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/application/convertor"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/trace"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/service"
@@ -23,7 +31,9 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/consts"
 	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
 	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+	"github.com/coze-dev/coze-loop/backend/pkg/traceutil"
 )
 
 func NewPromptOpenAPIApplication(
@@ -102,6 +112,7 @@ func (p *PromptOpenAPIApplicationImpl) fetchPromptResults(ctx context.Context, r
 		pairs = append(pairs, service.PromptKeyVersionPair{
 			PromptKey: q.GetPromptKey(),
 			Version:   q.GetVersion(),
+			Label:     q.GetLabel(),
 		})
 	}
 	// 解析具体的提交版本
@@ -116,7 +127,7 @@ func (p *PromptOpenAPIApplicationImpl) fetchPromptResults(ctx context.Context, r
 		mgetParams = append(mgetParams, repo.GetPromptParam{
 			PromptID:      promptKeyIDMap[query.GetPromptKey()],
 			WithCommit:    true,
-			CommitVersion: promptKeyCommitVersionMap[service.PromptKeyVersionPair{PromptKey: query.GetPromptKey(), Version: query.GetVersion()}],
+			CommitVersion: promptKeyCommitVersionMap[service.PromptKeyVersionPair{PromptKey: query.GetPromptKey(), Version: query.GetVersion(), Label: query.GetLabel()}],
 		})
 	}
 
@@ -145,6 +156,20 @@ func (p *PromptOpenAPIApplicationImpl) fetchPromptResults(ctx context.Context, r
 		}] = prompt
 	}
 
+	// 展开片段引用，SDK拿到的模板可直接渲染
+	expandedPromptMap := make(map[service.PromptKeyVersionPair]*entity.Prompt, len(promptMap))
+	for pair, prompt := range promptMap {
+		if len(prompt.GetPromptDetail().GetSnippetRefs()) == 0 {
+			expandedPromptMap[pair] = prompt
+			continue
+		}
+		expandedPrompt, err := p.promptService.ExpandSnippets(ctx, prompt)
+		if err != nil {
+			return nil, err
+		}
+		expandedPromptMap[pair] = expandedPrompt
+	}
+
 	// 构建响应
 	r := openapi.NewBatchGetPromptByPromptKeyResponse()
 	r.Data = openapi.NewPromptResultData()
@@ -154,8 +179,8 @@ func (p *PromptOpenAPIApplicationImpl) fetchPromptResults(ctx context.Context, r
 			continue
 		}
 		// 找到具体的版本
-		commitVersion := promptKeyCommitVersionMap[service.PromptKeyVersionPair{PromptKey: q.GetPromptKey(), Version: q.GetVersion()}]
-		promptDTO := convertor.OpenAPIPromptDO2DTO(promptMap[service.PromptKeyVersionPair{PromptKey: q.GetPromptKey(), Version: commitVersion}])
+		commitVersion := promptKeyCommitVersionMap[service.PromptKeyVersionPair{PromptKey: q.GetPromptKey(), Version: q.GetVersion(), Label: q.GetLabel()}]
+		promptDTO := convertor.OpenAPIPromptDO2DTO(expandedPromptMap[service.PromptKeyVersionPair{PromptKey: q.GetPromptKey(), Version: commitVersion}])
 		if promptDTO == nil {
 			return nil, errorx.NewByCode(prompterr.PromptVersionNotExistCode,
 				errorx.WithExtraMsg("prompt version not exist"),
@@ -175,6 +200,219 @@ func (p *PromptOpenAPIApplicationImpl) fetchPromptResults(ctx context.Context, r
 	return r, nil
 }
 
+func (p *PromptOpenAPIApplicationImpl) Execute(ctx context.Context, req *openapi.ExecuteRequest) (r *openapi.ExecuteResponse, err error) {
+	r = openapi.NewExecuteResponse()
+	if err = validateExecuteRequest(req); err != nil {
+		return r, err
+	}
+	defer func() {
+		if err != nil {
+			logs.CtxError(ctx, "openapi execute prompt failed, err=%v", err)
+		}
+	}()
+
+	// 限流检查
+	if !p.AllowBySpace(ctx, req.GetWorkspaceID()) {
+		return r, errorx.NewByCode(prompterr.PromptHubQPSLimitCode, errorx.WithExtraMsg("qps limit exceeded"))
+	}
+
+	messages := convertor.OpenAPIBatchMessageDTO2DO(req.Messages)
+	variableVals := convertor.OpenAPIBatchVariableValDTO2DO(req.VariableVals)
+	var span cozeloop.Span
+	ctx, span = startPromptExecutorSpan(ctx, startPromptExecutorSpanParam{
+		workspaceID:      req.GetWorkspaceID(),
+		callType:         consts.SpanTagCallTypePromptOpenAPI,
+		stream:           false,
+		reqPromptKey:     req.GetPromptIdentifier().GetPromptKey(),
+		reqPromptVersion: req.GetPromptIdentifier().GetVersion(),
+		messages:         messages,
+		variableVals:     variableVals,
+	})
+	var promptDO *entity.Prompt
+	var reply *entity.Reply
+	defer func() {
+		finishPromptExecutorSpan(ctx, span, promptDO, reply, err)
+	}()
+
+	promptDO, err = p.getPromptByPromptKey(ctx, req.GetWorkspaceID(), req.GetPromptIdentifier())
+	if err != nil {
+		return r, err
+	}
+	reply, err = p.promptService.Execute(ctx, service.ExecuteParam{
+		Prompt:       promptDO,
+		Messages:     messages,
+		VariableVals: variableVals,
+		Scenario:     entity.ScenarioDefault,
+	})
+	if err != nil {
+		return r, err
+	}
+	if reply != nil {
+		r.Data = convertor.OpenAPIReplyItemDO2DTO(reply.Item)
+	}
+	return r, nil
+}
+
+func (p *PromptOpenAPIApplicationImpl) ExecuteStreaming(ctx context.Context, req *openapi.ExecuteRequest, stream openapi.PromptOpenAPIService_ExecuteStreamingServer) (err error) {
+	// 流式接口没有中间件，需要手动校验
+	if err = validateExecuteRequest(req); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			logs.CtxError(ctx, "openapi execute streaming failed, err=%v", err)
+		}
+	}()
+
+	// 限流检查
+	if !p.AllowBySpace(ctx, req.GetWorkspaceID()) {
+		return errorx.NewByCode(prompterr.PromptHubQPSLimitCode, errorx.WithExtraMsg("qps limit exceeded"))
+	}
+
+	messages := convertor.OpenAPIBatchMessageDTO2DO(req.Messages)
+	variableVals := convertor.OpenAPIBatchVariableValDTO2DO(req.VariableVals)
+	var span cozeloop.Span
+	ctx, span = startPromptExecutorSpan(ctx, startPromptExecutorSpanParam{
+		workspaceID:      req.GetWorkspaceID(),
+		callType:         consts.SpanTagCallTypePromptOpenAPI,
+		stream:           true,
+		reqPromptKey:     req.GetPromptIdentifier().GetPromptKey(),
+		reqPromptVersion: req.GetPromptIdentifier().GetVersion(),
+		messages:         messages,
+		variableVals:     variableVals,
+	})
+	var promptDO *entity.Prompt
+	var aggregatedReply *entity.Reply
+	defer func() {
+		finishPromptExecutorSpan(ctx, span, promptDO, aggregatedReply, err)
+	}()
+
+	promptDO, err = p.getPromptByPromptKey(ctx, req.GetWorkspaceID(), req.GetPromptIdentifier())
+	if err != nil {
+		return err
+	}
+	aggregatedReply, err = p.doExecuteStreaming(ctx, service.ExecuteParam{
+		Prompt:       promptDO,
+		Messages:     messages,
+		VariableVals: variableVals,
+		Scenario:     entity.ScenarioDefault,
+	}, stream)
+	return err
+}
+
+func (p *PromptOpenAPIApplicationImpl) doExecuteStreaming(ctx context.Context, param service.ExecuteParam, stream openapi.PromptOpenAPIService_ExecuteStreamingServer) (aggregatedReply *entity.Reply, err error) {
+	// 为了span的正确串联，该方法中ctx不能再从stream中获取
+	resultStream := make(chan *entity.Reply)
+	errChan := make(chan error)
+	go func() {
+		var executeErr error
+		defer func() {
+			e := recover()
+			if e != nil {
+				executeErr = errorx.New("panic occurred, reason=%v", e)
+			}
+			// 确保errChan和resultStream被关闭
+			close(resultStream)
+			if executeErr != nil {
+				errChan <- executeErr
+			}
+			close(errChan)
+		}()
+		aggregatedReply, executeErr = p.promptService.ExecuteStreaming(ctx, service.ExecuteStreamingParam{
+			ExecuteParam: param,
+			ResultStream: resultStream,
+		})
+	}()
+	// send result
+	for reply := range resultStream {
+		if reply == nil || reply.Item == nil {
+			continue
+		}
+		err = stream.Send(ctx, &openapi.ExecuteStreamingResponse{
+			Data: convertor.OpenAPIReplyItemDO2DTO(reply.Item),
+		})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.Canceled {
+				err = nil
+				logs.CtxWarn(ctx, "openapi execute streaming canceled")
+			} else {
+				logs.CtxError(ctx, "send chunk failed, err=%v", err)
+			}
+			return nil, err
+		}
+	}
+	err, ok := <-errChan
+	if !ok {
+		return aggregatedReply, nil
+	}
+	if st, ok := status.FromError(err); ok && st.Code() == codes.Canceled {
+		logs.CtxWarn(ctx, "openapi execute streaming canceled")
+		return aggregatedReply, nil
+	}
+	return aggregatedReply, err
+}
+
+// getPromptByPromptKey 鉴权并按prompt key和版本获取已提交的prompt
+func (p *PromptOpenAPIApplicationImpl) getPromptByPromptKey(ctx context.Context, spaceID int64, query *openapi.PromptQuery) (prompt *entity.Prompt, err error) {
+	var span looptracer.Span
+	ctx, span = looptracer.GetTracer().StartSpan(ctx, consts.SpanNamePromptHub, tracespec.VPromptHubSpanType, looptracer.WithSpanWorkspaceID(strconv.FormatInt(spaceID, 10)))
+	if span != nil {
+		span.SetInput(ctx, json.Jsonify(map[string]any{
+			tracespec.PromptKey:     query.GetPromptKey(),
+			tracespec.PromptVersion: query.GetVersion(),
+		}))
+		defer func() {
+			if prompt != nil {
+				span.SetPrompt(ctx, loopentity.Prompt{PromptKey: prompt.PromptKey, Version: prompt.GetVersion()})
+				span.SetOutput(ctx, json.Jsonify(trace.PromptToSpanPrompt(prompt)))
+			}
+			if err != nil {
+				span.SetStatusCode(ctx, int(traceutil.GetTraceStatusCode(err)))
+				span.SetError(ctx, errors.New(errorx.ErrorWithoutStack(err)))
+			}
+			span.Finish(ctx)
+		}()
+	}
+	promptKeyIDMap, err := p.promptService.MGetPromptIDs(ctx, spaceID, []string{query.GetPromptKey()})
+	if err != nil {
+		return nil, err
+	}
+	promptID := promptKeyIDMap[query.GetPromptKey()]
+	if err = p.auth.MCheckPromptPermission(ctx, spaceID, []int64{promptID}, consts.ActionLoopPromptDebug); err != nil {
+		return nil, err
+	}
+	pair := service.PromptKeyVersionPair{PromptKey: query.GetPromptKey(), Version: query.GetVersion(), Label: query.GetLabel()}
+	commitVersionMap, err := p.promptService.MParseCommitVersionByPromptKey(ctx, spaceID, []service.PromptKeyVersionPair{pair})
+	if err != nil {
+		return nil, err
+	}
+	prompt, err = p.promptManageRepo.GetPrompt(ctx, repo.GetPromptParam{
+		PromptID:      promptID,
+		WithCommit:    true,
+		CommitVersion: commitVersionMap[pair],
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.collector.CollectPromptHubEvent(ctx, spaceID, []*entity.Prompt{prompt})
+	return prompt, nil
+}
+
+func validateExecuteRequest(req *openapi.ExecuteRequest) error {
+	if req.GetWorkspaceID() == 0 {
+		return errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtra(map[string]string{"invalid_param": "workspace_id参数为空"}))
+	}
+	if req.GetPromptIdentifier().GetPromptKey() == "" {
+		return errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtra(map[string]string{"invalid_param": "prompt_key参数为空"}))
+	}
+	for _, message := range req.Messages {
+		if message == nil || message.Role == nil {
+			return errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtra(map[string]string{"invalid_param": "message role参数为空"}))
+		}
+	}
+	return nil
+}
+
 func (p *PromptOpenAPIApplicationImpl) AllowBySpace(ctx context.Context, workspaceID int64) bool {
 	maxQPS, err := p.config.GetPromptHubMaxQPSBySpace(ctx, workspaceID)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	limitermocks "github.com/coze-dev/coze-loop/backend/infra/limiter/mocks"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/domain/prompt"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/openapi"
+	"github.com/coze-dev/coze-loop/backend/loop_gen/infra/kitex/localstream"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf"
 	confmocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/rpc"
@@ -722,6 +723,171 @@ func TestPromptOpenAPIApplicationImpl_BatchGetPromptByPromptKey(t *testing.T) {
 			wantR:   nil,
 			wantErr: errorx.NewByCode(prompterr.PromptVersionNotExistCode, errorx.WithExtraMsg("prompt version not exist")),
 		},
+		{
+			name: "success: expand snippet refs",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				snippetPrompt := &entity.Prompt{
+					ID:        123,
+					SpaceID:   123456,
+					PromptKey: "test_prompt1",
+					PromptCommit: &entity.PromptCommit{
+						CommitInfo: &entity.CommitInfo{Version: "1.0.0"},
+						PromptDetail: &entity.PromptDetail{
+							PromptTemplate: &entity.PromptTemplate{
+								TemplateType: entity.TemplateTypeNormal,
+								Messages: []*entity.Message{
+									{Role: entity.RoleSystem, Content: ptr.Of("{{#snippet:persona@1.0.0}} Answer briefly.")},
+								},
+							},
+						},
+					},
+				}
+				expandedPrompt := snippetPrompt.Clone()
+				expandedPrompt.PromptCommit.PromptDetail.PromptTemplate.Messages[0].Content = ptr.Of("You are a helpful assistant. Answer briefly.")
+
+				mockPromptService := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptService.EXPECT().MGetPromptIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[string]int64{
+					"test_prompt1": 123,
+				}, nil)
+				mockPromptService.EXPECT().MParseCommitVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[service.PromptKeyVersionPair]string{
+					{PromptKey: "test_prompt1", Version: "1.0.0"}: "1.0.0",
+				}, nil)
+				mockPromptService.EXPECT().ExpandSnippets(gomock.Any(), snippetPrompt).Return(expandedPrompt, nil)
+
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPrompt(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[repo.GetPromptParam]*entity.Prompt{
+					{PromptID: 123, WithCommit: true, CommitVersion: "1.0.0"}: snippetPrompt,
+				}, nil)
+
+				mockConfig := confmocks.NewMockIConfigProvider(ctrl)
+				mockConfig.EXPECT().GetPromptHubMaxQPSBySpace(gomock.Any(), gomock.Any()).Return(100, nil)
+
+				mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+				mockRateLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockRateLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{
+					Allowed: true,
+				}, nil)
+
+				mockCollector := collectormocks.NewMockICollectorProvider(ctrl)
+				mockCollector.EXPECT().CollectPromptHubEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return()
+				return fields{
+					promptService:    mockPromptService,
+					promptManageRepo: mockManageRepo,
+					config:           mockConfig,
+					auth:             mockAuth,
+					rateLimiter:      mockRateLimiter,
+					collector:        mockCollector,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &openapi.BatchGetPromptByPromptKeyRequest{
+					WorkspaceID: ptr.Of(int64(123456)),
+					Queries: []*openapi.PromptQuery{
+						{
+							PromptKey: ptr.Of("test_prompt1"),
+							Version:   ptr.Of("1.0.0"),
+						},
+					},
+				},
+			},
+			wantR: &openapi.BatchGetPromptByPromptKeyResponse{
+				Data: &openapi.PromptResultData{
+					Items: []*openapi.PromptResult_{
+						{
+							Query: &openapi.PromptQuery{
+								PromptKey: ptr.Of("test_prompt1"),
+								Version:   ptr.Of("1.0.0"),
+							},
+							Prompt: &openapi.Prompt{
+								WorkspaceID: ptr.Of(int64(123456)),
+								PromptKey:   ptr.Of("test_prompt1"),
+								Version:     ptr.Of("1.0.0"),
+								PromptTemplate: &openapi.PromptTemplate{
+									TemplateType: ptr.Of(prompt.TemplateTypeNormal),
+									Messages: []*openapi.Message{
+										{
+											Role:    ptr.Of(prompt.RoleSystem),
+											Content: ptr.Of("You are a helpful assistant. Answer briefly."),
+										},
+									},
+									VariableDefs: make([]*openapi.VariableDef, 0),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "expand snippet refs error",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockPromptService := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptService.EXPECT().MGetPromptIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[string]int64{
+					"test_prompt1": 123,
+				}, nil)
+				mockPromptService.EXPECT().MParseCommitVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[service.PromptKeyVersionPair]string{
+					{PromptKey: "test_prompt1", Version: "1.0.0"}: "1.0.0",
+				}, nil)
+				mockPromptService.EXPECT().ExpandSnippets(gomock.Any(), gomock.Any()).Return(nil, errorx.NewByCode(prompterr.PromptSnippetCycleCode))
+
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPrompt(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[repo.GetPromptParam]*entity.Prompt{
+					{PromptID: 123, WithCommit: true, CommitVersion: "1.0.0"}: {
+						ID:        123,
+						SpaceID:   123456,
+						PromptKey: "test_prompt1",
+						PromptCommit: &entity.PromptCommit{
+							CommitInfo: &entity.CommitInfo{Version: "1.0.0"},
+							PromptDetail: &entity.PromptDetail{
+								PromptTemplate: &entity.PromptTemplate{
+									TemplateType: entity.TemplateTypeNormal,
+									Messages: []*entity.Message{
+										{Role: entity.RoleSystem, Content: ptr.Of("{{#snippet:persona@1.0.0}}")},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+
+				mockConfig := confmocks.NewMockIConfigProvider(ctrl)
+				mockConfig.EXPECT().GetPromptHubMaxQPSBySpace(gomock.Any(), gomock.Any()).Return(100, nil)
+
+				mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+				mockRateLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockRateLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{
+					Allowed: true,
+				}, nil)
+
+				return fields{
+					promptService:    mockPromptService,
+					promptManageRepo: mockManageRepo,
+					config:           mockConfig,
+					auth:             mockAuth,
+					rateLimiter:      mockRateLimiter,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &openapi.BatchGetPromptByPromptKeyRequest{
+					WorkspaceID: ptr.Of(int64(123456)),
+					Queries: []*openapi.PromptQuery{
+						{
+							PromptKey: ptr.Of("test_prompt1"),
+							Version:   ptr.Of("1.0.0"),
+						},
+					},
+				},
+			},
+			wantR:   nil,
+			wantErr: errorx.NewByCode(prompterr.PromptSnippetCycleCode),
+		},
 		{
 			name: "workspace_id is empty",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
@@ -834,3 +1000,330 @@ func TestPromptOpenAPIApplicationImpl_BatchGetPromptByPromptKey(t *testing.T) {
 		})
 	}
 }
+
+func mockOpenAPIExecutePrompt() *entity.Prompt {
+	return &entity.Prompt{
+		ID:        123,
+		SpaceID:   123456,
+		PromptKey: "test_prompt",
+		PromptCommit: &entity.PromptCommit{
+			CommitInfo: &entity.CommitInfo{
+				Version: "1.0.0",
+			},
+			PromptDetail: &entity.PromptDetail{
+				PromptTemplate: &entity.PromptTemplate{
+					TemplateType: entity.TemplateTypeNormal,
+					Messages: []*entity.Message{
+						{
+							Role:    entity.RoleSystem,
+							Content: ptr.Of("You are a helpful assistant named {{name}}."),
+						},
+					},
+				},
+				ModelConfig: &entity.ModelConfig{
+					ModelID: 123,
+				},
+			},
+		},
+	}
+}
+
+func TestPromptOpenAPIApplicationImpl_Execute(t *testing.T) {
+	type fields struct {
+		promptService    service.IPromptService
+		promptManageRepo repo.IManageRepo
+		config           conf.IConfigProvider
+		auth             rpc.IAuthProvider
+		rateLimiter      limiter.IRateLimiter
+		collector        collector.ICollectorProvider
+	}
+	type args struct {
+		ctx context.Context
+		req *openapi.ExecuteRequest
+	}
+
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		wantR        *openapi.ExecuteResponse
+		wantErr      error
+	}{
+		{
+			name: "success: latest version",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockPromptService := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptService.EXPECT().MGetPromptIDs(gomock.Any(), int64(123456), []string{"test_prompt"}).Return(map[string]int64{
+					"test_prompt": 123,
+				}, nil)
+				mockPromptService.EXPECT().MParseCommitVersion(gomock.Any(), int64(123456), gomock.Any()).Return(map[service.PromptKeyVersionPair]string{
+					{PromptKey: "test_prompt", Version: ""}: "1.0.0",
+				}, nil)
+				mockPromptService.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param service.ExecuteParam) (*entity.Reply, error) {
+					assert.Equal(t, "1.0.0", param.Prompt.GetVersion())
+					assert.Equal(t, []*entity.VariableVal{{Key: "name", Value: ptr.Of("loop")}}, param.VariableVals)
+					assert.Equal(t, []*entity.Message{{Role: entity.RoleUser, Content: ptr.Of("hi")}}, param.Messages)
+					return &entity.Reply{
+						Item: &entity.ReplyItem{
+							Message: &entity.Message{
+								Role:    entity.RoleAssistant,
+								Content: ptr.Of("Hello!"),
+							},
+							FinishReason: "stop",
+							TokenUsage: &entity.TokenUsage{
+								InputTokens:  10,
+								OutputTokens: 2,
+							},
+						},
+					}, nil
+				})
+
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{
+					PromptID:      123,
+					WithCommit:    true,
+					CommitVersion: "1.0.0",
+				}).Return(mockOpenAPIExecutePrompt(), nil)
+
+				mockConfig := confmocks.NewMockIConfigProvider(ctrl)
+				mockConfig.EXPECT().GetPromptHubMaxQPSBySpace(gomock.Any(), gomock.Any()).Return(100, nil)
+
+				mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(123456), []int64{123}, gomock.Any()).Return(nil)
+
+				mockRateLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockRateLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{
+					Allowed: true,
+				}, nil)
+
+				mockCollector := collectormocks.NewMockICollectorProvider(ctrl)
+				mockCollector.EXPECT().CollectPromptHubEvent(gomock.Any(), int64(123456), gomock.Any()).Return()
+
+				return fields{
+					promptService:    mockPromptService,
+					promptManageRepo: mockManageRepo,
+					config:           mockConfig,
+					auth:             mockAuth,
+					rateLimiter:      mockRateLimiter,
+					collector:        mockCollector,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &openapi.ExecuteRequest{
+					WorkspaceID: ptr.Of(int64(123456)),
+					PromptIdentifier: &openapi.PromptQuery{
+						PromptKey: ptr.Of("test_prompt"),
+					},
+					VariableVals: []*openapi.VariableVal{
+						{
+							Key:   ptr.Of("name"),
+							Value: ptr.Of("loop"),
+						},
+					},
+					Messages: []*openapi.Message{
+						{
+							Role:    ptr.Of(prompt.RoleUser),
+							Content: ptr.Of("hi"),
+						},
+					},
+				},
+			},
+			wantR: &openapi.ExecuteResponse{
+				Data: &openapi.ExecuteData{
+					Message: &openapi.Message{
+						Role:    ptr.Of(prompt.RoleAssistant),
+						Content: ptr.Of("Hello!"),
+					},
+					FinishReason: ptr.Of("stop"),
+					Usage: &openapi.TokenUsage{
+						InputTokens:  ptr.Of(int64(10)),
+						OutputTokens: ptr.Of(int64(2)),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid param: empty prompt key",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &openapi.ExecuteRequest{
+					WorkspaceID: ptr.Of(int64(123456)),
+				},
+			},
+			wantR:   openapi.NewExecuteResponse(),
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtra(map[string]string{"invalid_param": "prompt_key参数为空"})),
+		},
+		{
+			name: "rate limited",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockConfig := confmocks.NewMockIConfigProvider(ctrl)
+				mockConfig.EXPECT().GetPromptHubMaxQPSBySpace(gomock.Any(), gomock.Any()).Return(1, nil)
+
+				mockRateLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockRateLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{
+					Allowed: false,
+				}, nil)
+
+				return fields{
+					config:      mockConfig,
+					rateLimiter: mockRateLimiter,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &openapi.ExecuteRequest{
+					WorkspaceID: ptr.Of(int64(123456)),
+					PromptIdentifier: &openapi.PromptQuery{
+						PromptKey: ptr.Of("test_prompt"),
+					},
+				},
+			},
+			wantR:   openapi.NewExecuteResponse(),
+			wantErr: errorx.NewByCode(prompterr.PromptHubQPSLimitCode, errorx.WithExtraMsg("qps limit exceeded")),
+		},
+		{
+			name: "permission denied",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockPromptService := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptService.EXPECT().MGetPromptIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[string]int64{
+					"test_prompt": 123,
+				}, nil)
+
+				mockConfig := confmocks.NewMockIConfigProvider(ctrl)
+				mockConfig.EXPECT().GetPromptHubMaxQPSBySpace(gomock.Any(), gomock.Any()).Return(100, nil)
+
+				mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+				mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errorx.NewByCode(prompterr.CommonNoPermissionCode))
+
+				mockRateLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+				mockRateLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{
+					Allowed: true,
+				}, nil)
+
+				return fields{
+					promptService: mockPromptService,
+					config:        mockConfig,
+					auth:          mockAuth,
+					rateLimiter:   mockRateLimiter,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &openapi.ExecuteRequest{
+					WorkspaceID: ptr.Of(int64(123456)),
+					PromptIdentifier: &openapi.PromptQuery{
+						PromptKey: ptr.Of("test_prompt"),
+					},
+				},
+			},
+			wantR:   openapi.NewExecuteResponse(),
+			wantErr: errorx.NewByCode(prompterr.CommonNoPermissionCode),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+			p := &PromptOpenAPIApplicationImpl{
+				promptService:    ttFields.promptService,
+				promptManageRepo: ttFields.promptManageRepo,
+				config:           ttFields.config,
+				auth:             ttFields.auth,
+				rateLimiter:      ttFields.rateLimiter,
+				collector:        ttFields.collector,
+			}
+			gotR, err := p.Execute(tt.args.ctx, tt.args.req)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantR, gotR)
+		})
+	}
+}
+
+func TestPromptOpenAPIApplicationImpl_ExecuteStreaming(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContent := "Hello!"
+	mockPromptService := servicemocks.NewMockIPromptService(ctrl)
+	mockPromptService.EXPECT().MGetPromptIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[string]int64{
+		"test_prompt": 123,
+	}, nil)
+	mockPromptService.EXPECT().MParseCommitVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[service.PromptKeyVersionPair]string{
+		{PromptKey: "test_prompt", Version: "1.0.0"}: "1.0.0",
+	}, nil)
+	mockPromptService.EXPECT().ExecuteStreaming(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param service.ExecuteStreamingParam) (*entity.Reply, error) {
+		for _, v := range mockContent {
+			param.ResultStream <- &entity.Reply{
+				Item: &entity.ReplyItem{
+					Message: &entity.Message{
+						Role:    entity.RoleAssistant,
+						Content: ptr.Of(string(v)),
+					},
+				},
+			}
+		}
+		return &entity.Reply{
+			Item: &entity.ReplyItem{
+				Message: &entity.Message{
+					Role:    entity.RoleAssistant,
+					Content: ptr.Of(mockContent),
+				},
+			},
+		}, nil
+	})
+	mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+	mockManageRepo.EXPECT().GetPrompt(gomock.Any(), gomock.Any()).Return(mockOpenAPIExecutePrompt(), nil)
+	mockConfig := confmocks.NewMockIConfigProvider(ctrl)
+	mockConfig.EXPECT().GetPromptHubMaxQPSBySpace(gomock.Any(), gomock.Any()).Return(100, nil)
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockAuth.EXPECT().MCheckPromptPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockRateLimiter := limitermocks.NewMockIRateLimiter(ctrl)
+	mockRateLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{
+		Allowed: true,
+	}, nil)
+	mockCollector := collectormocks.NewMockICollectorProvider(ctrl)
+	mockCollector.EXPECT().CollectPromptHubEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return()
+
+	p := &PromptOpenAPIApplicationImpl{
+		promptService:    mockPromptService,
+		promptManageRepo: mockManageRepo,
+		config:           mockConfig,
+		auth:             mockAuth,
+		rateLimiter:      mockRateLimiter,
+		collector:        mockCollector,
+	}
+	ctx := context.Background()
+	stream := localstream.NewInMemStream(ctx, make(chan *openapi.ExecuteStreamingResponse), make(chan error))
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(errCh)
+		defer func() {
+			_ = stream.CloseSend(ctx)
+		}()
+		errCh <- p.ExecuteStreaming(ctx, &openapi.ExecuteRequest{
+			WorkspaceID: ptr.Of(int64(123456)),
+			PromptIdentifier: &openapi.PromptQuery{
+				PromptKey: ptr.Of("test_prompt"),
+				Version:   ptr.Of("1.0.0"),
+			},
+		}, stream)
+	}()
+	var content string
+	for {
+		resp, err := stream.Recv(ctx)
+		if err != nil {
+			break
+		}
+		assert.NotNil(t, resp.Data)
+		content += ptr.From(resp.Data.Message.Content)
+	}
+	assert.Equal(t, mockContent, content)
+	assert.NoError(t, <-errCh)
+}
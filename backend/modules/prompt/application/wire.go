@@ -40,9 +40,13 @@ var (
 		repo.NewManageRepo,
 		repo.NewDebugLogRepo,
 		repo.NewDebugContextRepo,
+		repo.NewLabelRepo,
 		mysql.NewPromptBasicDAO,
 		mysql.NewPromptCommitDAO,
 		mysql.NewPromptUserDraftDAO,
+		mysql.NewPromptLabelDAO,
+		mysql.NewPromptLabelHistoryDAO,
+		mysql.NewPromptSnippetRefDAO,
 		mysql.NewDebugLogDAO,
 		mysql.NewDebugContextDAO,
 		rediscache.NewPromptBasicDAO,
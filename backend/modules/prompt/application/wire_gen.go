@@ -39,9 +39,13 @@ func InitPromptManageApplication(idgen2 idgen.IIDGenerator, db2 db.Provider, red
 	iPromptBasicDAO := mysql.NewPromptBasicDAO(db2, redisCli)
 	iPromptCommitDAO := mysql.NewPromptCommitDAO(db2, redisCli)
 	iPromptUserDraftDAO := mysql.NewPromptUserDraftDAO(db2, redisCli)
+	iPromptSnippetRefDAO := mysql.NewPromptSnippetRefDAO(db2)
 	redisIPromptBasicDAO := redis2.NewPromptBasicDAO()
 	iPromptDAO := redis2.NewPromptDAO()
-	iManageRepo := repo.NewManageRepo(db2, idgen2, meter, iPromptBasicDAO, iPromptCommitDAO, iPromptUserDraftDAO, redisIPromptBasicDAO, iPromptDAO)
+	iManageRepo := repo.NewManageRepo(db2, idgen2, meter, iPromptBasicDAO, iPromptCommitDAO, iPromptUserDraftDAO, iPromptSnippetRefDAO, redisIPromptBasicDAO, iPromptDAO)
+	iPromptLabelDAO := mysql.NewPromptLabelDAO(db2, redisCli)
+	iPromptLabelHistoryDAO := mysql.NewPromptLabelHistoryDAO(db2)
+	iLabelRepo := repo.NewLabelRepo(db2, idgen2, iPromptCommitDAO, iPromptLabelDAO, iPromptLabelHistoryDAO)
 	iDebugLogDAO := mysql.NewDebugLogDAO(db2)
 	iDebugLogRepo := repo.NewDebugLogRepo(idgen2, iDebugLogDAO)
 	iDebugContextDAO := mysql.NewDebugContextDAO(db2)
@@ -52,11 +56,11 @@ func InitPromptManageApplication(idgen2 idgen.IIDGenerator, db2 db.Provider, red
 	}
 	illmProvider := rpc.NewLLMRPCProvider(llmClient)
 	iFileProvider := rpc.NewFileRPCProvider(fileClient)
-	iPromptService := service.NewPromptService(idgen2, iDebugLogRepo, iDebugContextRepo, iManageRepo, iConfigProvider, illmProvider, iFileProvider)
+	iPromptService := service.NewPromptService(idgen2, iDebugLogRepo, iDebugContextRepo, iManageRepo, iLabelRepo, iConfigProvider, illmProvider, iFileProvider)
 	iAuthProvider := rpc.NewAuthRPCProvider(authClient)
 	iUserProvider := rpc.NewUserRPCProvider(userClient)
 	iAuditProvider := rpc.NewAuditRPCProvider(auditClient)
-	promptManageService := NewPromptManageApplication(iManageRepo, iPromptService, iAuthProvider, iUserProvider, iAuditProvider, iConfigProvider)
+	promptManageService := NewPromptManageApplication(iManageRepo, iLabelRepo, iPromptService, iAuthProvider, iUserProvider, iAuditProvider, iConfigProvider)
 	return promptManageService, nil
 }
 
@@ -68,16 +72,20 @@ func InitPromptDebugApplication(idgen2 idgen.IIDGenerator, db2 db.Provider, redi
 	iPromptBasicDAO := mysql.NewPromptBasicDAO(db2, redisCli)
 	iPromptCommitDAO := mysql.NewPromptCommitDAO(db2, redisCli)
 	iPromptUserDraftDAO := mysql.NewPromptUserDraftDAO(db2, redisCli)
+	iPromptSnippetRefDAO := mysql.NewPromptSnippetRefDAO(db2)
 	redisIPromptBasicDAO := redis2.NewPromptBasicDAO()
 	iPromptDAO := redis2.NewPromptDAO()
-	iManageRepo := repo.NewManageRepo(db2, idgen2, meter, iPromptBasicDAO, iPromptCommitDAO, iPromptUserDraftDAO, redisIPromptBasicDAO, iPromptDAO)
+	iManageRepo := repo.NewManageRepo(db2, idgen2, meter, iPromptBasicDAO, iPromptCommitDAO, iPromptUserDraftDAO, iPromptSnippetRefDAO, redisIPromptBasicDAO, iPromptDAO)
+	iPromptLabelDAO := mysql.NewPromptLabelDAO(db2, redisCli)
+	iPromptLabelHistoryDAO := mysql.NewPromptLabelHistoryDAO(db2)
+	iLabelRepo := repo.NewLabelRepo(db2, idgen2, iPromptCommitDAO, iPromptLabelDAO, iPromptLabelHistoryDAO)
 	iConfigProvider, err := conf2.NewPromptConfigProvider(configFactory)
 	if err != nil {
 		return nil, err
 	}
 	illmProvider := rpc.NewLLMRPCProvider(llmClient)
 	iFileProvider := rpc.NewFileRPCProvider(fileClient)
-	iPromptService := service.NewPromptService(idgen2, iDebugLogRepo, iDebugContextRepo, iManageRepo, iConfigProvider, illmProvider, iFileProvider)
+	iPromptService := service.NewPromptService(idgen2, iDebugLogRepo, iDebugContextRepo, iManageRepo, iLabelRepo, iConfigProvider, illmProvider, iFileProvider)
 	iAuthProvider := rpc.NewAuthRPCProvider(authClient)
 	promptDebugService := NewPromptDebugApplication(iDebugLogRepo, iDebugContextRepo, iPromptService, benefitSvc, iAuthProvider, iFileProvider)
 	return promptDebugService, nil
@@ -91,16 +99,20 @@ func InitPromptExecuteApplication(idgen2 idgen.IIDGenerator, db2 db.Provider, re
 	iPromptBasicDAO := mysql.NewPromptBasicDAO(db2, redisCli)
 	iPromptCommitDAO := mysql.NewPromptCommitDAO(db2, redisCli)
 	iPromptUserDraftDAO := mysql.NewPromptUserDraftDAO(db2, redisCli)
+	iPromptSnippetRefDAO := mysql.NewPromptSnippetRefDAO(db2)
 	redisIPromptBasicDAO := redis2.NewPromptBasicDAO()
 	iPromptDAO := redis2.NewPromptDAO()
-	iManageRepo := repo.NewManageRepo(db2, idgen2, meter, iPromptBasicDAO, iPromptCommitDAO, iPromptUserDraftDAO, redisIPromptBasicDAO, iPromptDAO)
+	iManageRepo := repo.NewManageRepo(db2, idgen2, meter, iPromptBasicDAO, iPromptCommitDAO, iPromptUserDraftDAO, iPromptSnippetRefDAO, redisIPromptBasicDAO, iPromptDAO)
+	iPromptLabelDAO := mysql.NewPromptLabelDAO(db2, redisCli)
+	iPromptLabelHistoryDAO := mysql.NewPromptLabelHistoryDAO(db2)
+	iLabelRepo := repo.NewLabelRepo(db2, idgen2, iPromptCommitDAO, iPromptLabelDAO, iPromptLabelHistoryDAO)
 	iConfigProvider, err := conf2.NewPromptConfigProvider(configFactory)
 	if err != nil {
 		return nil, err
 	}
 	illmProvider := rpc.NewLLMRPCProvider(llmClient)
 	iFileProvider := rpc.NewFileRPCProvider(fileClient)
-	iPromptService := service.NewPromptService(idgen2, iDebugLogRepo, iDebugContextRepo, iManageRepo, iConfigProvider, illmProvider, iFileProvider)
+	iPromptService := service.NewPromptService(idgen2, iDebugLogRepo, iDebugContextRepo, iManageRepo, iLabelRepo, iConfigProvider, illmProvider, iFileProvider)
 	promptExecuteService := NewPromptExecuteApplication(iPromptService, iManageRepo)
 	return promptExecuteService, nil
 }
@@ -113,16 +125,20 @@ func InitPromptOpenAPIApplication(idgen2 idgen.IIDGenerator, db2 db.Provider, re
 	iPromptBasicDAO := mysql.NewPromptBasicDAO(db2, redisCli)
 	iPromptCommitDAO := mysql.NewPromptCommitDAO(db2, redisCli)
 	iPromptUserDraftDAO := mysql.NewPromptUserDraftDAO(db2, redisCli)
+	iPromptSnippetRefDAO := mysql.NewPromptSnippetRefDAO(db2)
 	redisIPromptBasicDAO := redis2.NewPromptBasicDAO()
 	iPromptDAO := redis2.NewPromptDAO()
-	iManageRepo := repo.NewManageRepo(db2, idgen2, meter, iPromptBasicDAO, iPromptCommitDAO, iPromptUserDraftDAO, redisIPromptBasicDAO, iPromptDAO)
+	iManageRepo := repo.NewManageRepo(db2, idgen2, meter, iPromptBasicDAO, iPromptCommitDAO, iPromptUserDraftDAO, iPromptSnippetRefDAO, redisIPromptBasicDAO, iPromptDAO)
+	iPromptLabelDAO := mysql.NewPromptLabelDAO(db2, redisCli)
+	iPromptLabelHistoryDAO := mysql.NewPromptLabelHistoryDAO(db2)
+	iLabelRepo := repo.NewLabelRepo(db2, idgen2, iPromptCommitDAO, iPromptLabelDAO, iPromptLabelHistoryDAO)
 	iConfigProvider, err := conf2.NewPromptConfigProvider(configFactory)
 	if err != nil {
 		return nil, err
 	}
 	illmProvider := rpc.NewLLMRPCProvider(llmClient)
 	iFileProvider := rpc.NewFileRPCProvider(fileClient)
-	iPromptService := service.NewPromptService(idgen2, iDebugLogRepo, iDebugContextRepo, iManageRepo, iConfigProvider, illmProvider, iFileProvider)
+	iPromptService := service.NewPromptService(idgen2, iDebugLogRepo, iDebugContextRepo, iManageRepo, iLabelRepo, iConfigProvider, illmProvider, iFileProvider)
 	iAuthProvider := rpc.NewAuthRPCProvider(authClient)
 	iCollectorProvider := collector.NewEventCollectorProvider()
 	promptOpenAPIService, err := NewPromptOpenAPIApplication(iPromptService, iManageRepo, iConfigProvider, iAuthProvider, limiterFactory, iCollectorProvider)
@@ -135,7 +151,7 @@ func InitPromptOpenAPIApplication(idgen2 idgen.IIDGenerator, db2 db.Provider, re
 // wire.go:
 
 var (
-	promptDomainSet = wire.NewSet(service.NewPromptService, repo.NewManageRepo, repo.NewDebugLogRepo, repo.NewDebugContextRepo, mysql.NewPromptBasicDAO, mysql.NewPromptCommitDAO, mysql.NewPromptUserDraftDAO, mysql.NewDebugLogDAO, mysql.NewDebugContextDAO, redis2.NewPromptBasicDAO, redis2.NewPromptDAO, conf2.NewPromptConfigProvider, rpc.NewLLMRPCProvider, rpc.NewAuthRPCProvider, rpc.NewFileRPCProvider, rpc.NewUserRPCProvider, rpc.NewAuditRPCProvider, collector.NewEventCollectorProvider)
+	promptDomainSet = wire.NewSet(service.NewPromptService, repo.NewManageRepo, repo.NewDebugLogRepo, repo.NewDebugContextRepo, repo.NewLabelRepo, mysql.NewPromptBasicDAO, mysql.NewPromptCommitDAO, mysql.NewPromptUserDraftDAO, mysql.NewPromptLabelDAO, mysql.NewPromptLabelHistoryDAO, mysql.NewPromptSnippetRefDAO, mysql.NewDebugLogDAO, mysql.NewDebugContextDAO, redis2.NewPromptBasicDAO, redis2.NewPromptDAO, conf2.NewPromptConfigProvider, rpc.NewLLMRPCProvider, rpc.NewAuthRPCProvider, rpc.NewFileRPCProvider, rpc.NewUserRPCProvider, rpc.NewAuditRPCProvider, collector.NewEventCollectorProvider)
 	manageSet       = wire.NewSet(
 		NewPromptManageApplication,
 		promptDomainSet,
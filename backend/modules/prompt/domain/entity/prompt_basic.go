@@ -8,6 +8,7 @@ import "time"
 type PromptBasic struct {
 	DisplayName       string     `json:"display_name"`
 	Description       string     `json:"description"`
+	PromptType        PromptType `json:"prompt_type"`
 	LatestVersion     string     `json:"latest_version"`
 	CreatedBy         string     `json:"created_by"`
 	UpdatedBy         string     `json:"updated_by"`
@@ -15,3 +16,11 @@ type PromptBasic struct {
 	UpdatedAt         time.Time  `json:"updated_at"`
 	LatestCommittedAt *time.Time `json:"latest_committed_at"`
 }
+
+type PromptType string
+
+const (
+	PromptTypeNormal PromptType = "normal"
+	// PromptTypeSnippet 片段Prompt，可被其他Prompt的消息通过引用语法嵌入
+	PromptTypeSnippet PromptType = "snippet"
+)
@@ -0,0 +1,384 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+// PromptDetailDiff 两个PromptDetail之间的结构化差异，只包含有变化的部分
+type PromptDetailDiff struct {
+	FieldDiffs       []*FieldDiff       `json:"field_diffs,omitempty"`
+	MessageDiffs     []*MessageDiff     `json:"message_diffs,omitempty"`
+	VariableDefDiffs []*VariableDefDiff `json:"variable_def_diffs,omitempty"`
+	ToolDiffs        []*ToolDiff        `json:"tool_diffs,omitempty"`
+	ModelConfigDiffs []*FieldDiff       `json:"model_config_diffs,omitempty"`
+}
+
+type DiffType string
+
+const (
+	DiffTypeAdded    DiffType = "added"
+	DiffTypeRemoved  DiffType = "removed"
+	DiffTypeModified DiffType = "modified"
+)
+
+type MessageDiff struct {
+	DiffType      DiffType        `json:"diff_type"`
+	BaseIndex     *int            `json:"base_index,omitempty"`
+	TargetIndex   *int            `json:"target_index,omitempty"`
+	BaseMessage   *Message        `json:"base_message,omitempty"`
+	TargetMessage *Message        `json:"target_message,omitempty"`
+	ContentDiff   []*TextDiffLine `json:"content_diff,omitempty"`
+}
+
+type TextDiffOperation string
+
+const (
+	TextDiffOperationEqual  TextDiffOperation = "equal"
+	TextDiffOperationInsert TextDiffOperation = "insert"
+	TextDiffOperationDelete TextDiffOperation = "delete"
+)
+
+type TextDiffLine struct {
+	Operation TextDiffOperation `json:"operation"`
+	Text      string            `json:"text"`
+}
+
+type VariableDefDiff struct {
+	DiffType  DiffType     `json:"diff_type"`
+	Key       string       `json:"key"`
+	BaseDef   *VariableDef `json:"base_def,omitempty"`
+	TargetDef *VariableDef `json:"target_def,omitempty"`
+}
+
+type ToolDiff struct {
+	DiffType   DiffType `json:"diff_type"`
+	Name       string   `json:"name"`
+	BaseTool   *Tool    `json:"base_tool,omitempty"`
+	TargetTool *Tool    `json:"target_tool,omitempty"`
+}
+
+type FieldDiff struct {
+	DiffType    DiffType `json:"diff_type"`
+	Field       string   `json:"field"`
+	BaseValue   *string  `json:"base_value,omitempty"`
+	TargetValue *string  `json:"target_value,omitempty"`
+}
+
+// DiffPromptDetail 对比base和target两个版本的PromptDetail，nil视为空内容
+func DiffPromptDetail(base, target *PromptDetail) *PromptDetailDiff {
+	if base == nil {
+		base = &PromptDetail{}
+	}
+	if target == nil {
+		target = &PromptDetail{}
+	}
+	diff := &PromptDetailDiff{}
+
+	var baseTemplateType, targetTemplateType *string
+	var baseMessages, targetMessages []*Message
+	var baseVariableDefs, targetVariableDefs []*VariableDef
+	if base.PromptTemplate != nil {
+		baseTemplateType = ptr.Of(string(base.PromptTemplate.TemplateType))
+		baseMessages = base.PromptTemplate.Messages
+		baseVariableDefs = base.PromptTemplate.VariableDefs
+	}
+	if target.PromptTemplate != nil {
+		targetTemplateType = ptr.Of(string(target.PromptTemplate.TemplateType))
+		targetMessages = target.PromptTemplate.Messages
+		targetVariableDefs = target.PromptTemplate.VariableDefs
+	}
+	var baseToolChoice, targetToolChoice *string
+	if base.ToolCallConfig != nil {
+		baseToolChoice = ptr.Of(string(base.ToolCallConfig.ToolChoice))
+	}
+	if target.ToolCallConfig != nil {
+		targetToolChoice = ptr.Of(string(target.ToolCallConfig.ToolChoice))
+	}
+	diff.FieldDiffs = appendFieldDiff(diff.FieldDiffs, "template_type", baseTemplateType, targetTemplateType)
+	diff.FieldDiffs = appendFieldDiff(diff.FieldDiffs, "tool_choice", baseToolChoice, targetToolChoice)
+
+	diff.MessageDiffs = diffMessages(baseMessages, targetMessages)
+	diff.VariableDefDiffs = diffVariableDefs(baseVariableDefs, targetVariableDefs)
+	diff.ToolDiffs = diffTools(base.Tools, target.Tools)
+	diff.ModelConfigDiffs = diffModelConfig(base.ModelConfig, target.ModelConfig)
+	return diff
+}
+
+// diffMessages 先按完全相同的消息做最长公共子序列对齐，对齐间隙中角色相同的消息视为修改，其余视为删除或新增
+func diffMessages(base, target []*Message) []*MessageDiff {
+	var diffs []*MessageDiff
+	matches := lcsMatches(len(base), len(target), func(i, j int) bool {
+		return cmp.Equal(base[i], target[j])
+	})
+	i, j := 0, 0
+	for _, match := range append(matches, [2]int{len(base), len(target)}) {
+		for i < match[0] || j < match[1] {
+			switch {
+			case i < match[0] && j < match[1] && messageRole(base[i]) == messageRole(target[j]):
+				diffs = append(diffs, &MessageDiff{
+					DiffType:      DiffTypeModified,
+					BaseIndex:     ptr.Of(i),
+					TargetIndex:   ptr.Of(j),
+					BaseMessage:   base[i],
+					TargetMessage: target[j],
+					ContentDiff:   DiffText(messageText(base[i]), messageText(target[j])),
+				})
+				i++
+				j++
+			case i < match[0]:
+				diffs = append(diffs, &MessageDiff{
+					DiffType:    DiffTypeRemoved,
+					BaseIndex:   ptr.Of(i),
+					BaseMessage: base[i],
+				})
+				i++
+			default:
+				diffs = append(diffs, &MessageDiff{
+					DiffType:      DiffTypeAdded,
+					TargetIndex:   ptr.Of(j),
+					TargetMessage: target[j],
+				})
+				j++
+			}
+		}
+		i, j = match[0]+1, match[1]+1
+	}
+	return diffs
+}
+
+func messageRole(message *Message) Role {
+	if message == nil {
+		return ""
+	}
+	return message.Role
+}
+
+// messageText 消息的文本内容，多模态消息取所有文本片段按行拼接
+func messageText(message *Message) string {
+	if message == nil {
+		return ""
+	}
+	if ptr.From(message.Content) != "" {
+		return ptr.From(message.Content)
+	}
+	var texts []string
+	for _, part := range message.Parts {
+		if part != nil && part.Type == ContentTypeText {
+			texts = append(texts, ptr.From(part.Text))
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// DiffText 按行对比两段文本，返回逐行的保留、删除、新增结果
+func DiffText(base, target string) []*TextDiffLine {
+	if base == target {
+		return nil
+	}
+	baseLines := splitLines(base)
+	targetLines := splitLines(target)
+	matches := lcsMatches(len(baseLines), len(targetLines), func(i, j int) bool {
+		return baseLines[i] == targetLines[j]
+	})
+	var lines []*TextDiffLine
+	i, j := 0, 0
+	for _, match := range append(matches, [2]int{len(baseLines), len(targetLines)}) {
+		for ; i < match[0]; i++ {
+			lines = append(lines, &TextDiffLine{Operation: TextDiffOperationDelete, Text: baseLines[i]})
+		}
+		for ; j < match[1]; j++ {
+			lines = append(lines, &TextDiffLine{Operation: TextDiffOperationInsert, Text: targetLines[j]})
+		}
+		if match[0] < len(baseLines) {
+			lines = append(lines, &TextDiffLine{Operation: TextDiffOperationEqual, Text: baseLines[match[0]]})
+		}
+		i, j = match[0]+1, match[1]+1
+	}
+	return lines
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// lcsMatches 返回最长公共子序列中按顺序排列的匹配下标对
+func lcsMatches(n, m int, equal func(i, j int) bool) [][2]int {
+	// dp[i][j] 为 base[i:] 与 target[j:] 的最长公共子序列长度
+	dp := make([][]int, n+1)
+	for i := range dp {
+		dp[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case equal(i, j):
+				dp[i][j] = dp[i+1][j+1] + 1
+			case dp[i+1][j] >= dp[i][j+1]:
+				dp[i][j] = dp[i+1][j]
+			default:
+				dp[i][j] = dp[i][j+1]
+			}
+		}
+	}
+	var matches [][2]int
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case equal(i, j):
+			matches = append(matches, [2]int{i, j})
+			i++
+			j++
+		case dp[i+1][j] >= dp[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return matches
+}
+
+func diffVariableDefs(base, target []*VariableDef) []*VariableDefDiff {
+	var diffs []*VariableDefDiff
+	targetMap := make(map[string]*VariableDef)
+	for _, def := range target {
+		if def != nil {
+			targetMap[def.Key] = def
+		}
+	}
+	baseMap := make(map[string]*VariableDef)
+	for _, def := range base {
+		if def == nil {
+			continue
+		}
+		baseMap[def.Key] = def
+		targetDef, ok := targetMap[def.Key]
+		switch {
+		case !ok:
+			diffs = append(diffs, &VariableDefDiff{DiffType: DiffTypeRemoved, Key: def.Key, BaseDef: def})
+		case !cmp.Equal(def, targetDef):
+			diffs = append(diffs, &VariableDefDiff{DiffType: DiffTypeModified, Key: def.Key, BaseDef: def, TargetDef: targetDef})
+		}
+	}
+	for _, def := range target {
+		if def == nil {
+			continue
+		}
+		if _, ok := baseMap[def.Key]; !ok {
+			diffs = append(diffs, &VariableDefDiff{DiffType: DiffTypeAdded, Key: def.Key, TargetDef: def})
+		}
+	}
+	return diffs
+}
+
+func diffTools(base, target []*Tool) []*ToolDiff {
+	var diffs []*ToolDiff
+	targetMap := make(map[string]*Tool)
+	for _, tool := range target {
+		if tool != nil {
+			targetMap[toolName(tool)] = tool
+		}
+	}
+	baseMap := make(map[string]*Tool)
+	for _, tool := range base {
+		if tool == nil {
+			continue
+		}
+		name := toolName(tool)
+		baseMap[name] = tool
+		targetTool, ok := targetMap[name]
+		switch {
+		case !ok:
+			diffs = append(diffs, &ToolDiff{DiffType: DiffTypeRemoved, Name: name, BaseTool: tool})
+		case !cmp.Equal(tool, targetTool):
+			diffs = append(diffs, &ToolDiff{DiffType: DiffTypeModified, Name: name, BaseTool: tool, TargetTool: targetTool})
+		}
+	}
+	for _, tool := range target {
+		if tool == nil {
+			continue
+		}
+		if _, ok := baseMap[toolName(tool)]; !ok {
+			diffs = append(diffs, &ToolDiff{DiffType: DiffTypeAdded, Name: toolName(tool), TargetTool: tool})
+		}
+	}
+	return diffs
+}
+
+func toolName(tool *Tool) string {
+	if tool == nil || tool.Function == nil {
+		return ""
+	}
+	return tool.Function.Name
+}
+
+func diffModelConfig(base, target *ModelConfig) []*FieldDiff {
+	if base == nil {
+		base = &ModelConfig{}
+	}
+	if target == nil {
+		target = &ModelConfig{}
+	}
+	var diffs []*FieldDiff
+	var baseModelID, targetModelID *string
+	if base.ModelID != 0 {
+		baseModelID = ptr.Of(strconv.FormatInt(base.ModelID, 10))
+	}
+	if target.ModelID != 0 {
+		targetModelID = ptr.Of(strconv.FormatInt(target.ModelID, 10))
+	}
+	diffs = appendFieldDiff(diffs, "model_id", baseModelID, targetModelID)
+	diffs = appendFieldDiff(diffs, "max_tokens", formatInt32(base.MaxTokens), formatInt32(target.MaxTokens))
+	diffs = appendFieldDiff(diffs, "temperature", formatFloat(base.Temperature), formatFloat(target.Temperature))
+	diffs = appendFieldDiff(diffs, "top_k", formatInt32(base.TopK), formatInt32(target.TopK))
+	diffs = appendFieldDiff(diffs, "top_p", formatFloat(base.TopP), formatFloat(target.TopP))
+	diffs = appendFieldDiff(diffs, "presence_penalty", formatFloat(base.PresencePenalty), formatFloat(target.PresencePenalty))
+	diffs = appendFieldDiff(diffs, "frequency_penalty", formatFloat(base.FrequencyPenalty), formatFloat(target.FrequencyPenalty))
+	diffs = appendFieldDiff(diffs, "json_mode", formatBool(base.JSONMode), formatBool(target.JSONMode))
+	return diffs
+}
+
+func appendFieldDiff(diffs []*FieldDiff, field string, baseValue, targetValue *string) []*FieldDiff {
+	switch {
+	case baseValue == nil && targetValue == nil:
+		return diffs
+	case baseValue == nil:
+		return append(diffs, &FieldDiff{DiffType: DiffTypeAdded, Field: field, TargetValue: targetValue})
+	case targetValue == nil:
+		return append(diffs, &FieldDiff{DiffType: DiffTypeRemoved, Field: field, BaseValue: baseValue})
+	case *baseValue != *targetValue:
+		return append(diffs, &FieldDiff{DiffType: DiffTypeModified, Field: field, BaseValue: baseValue, TargetValue: targetValue})
+	default:
+		return diffs
+	}
+}
+
+func formatInt32(v *int32) *string {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(strconv.FormatInt(int64(*v), 10))
+}
+
+func formatFloat(v *float64) *string {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(strconv.FormatFloat(*v, 'f', -1, 64))
+}
+
+func formatBool(v *bool) *string {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(strconv.FormatBool(*v))
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TestDiffText(t *testing.T) {
+	tests := []struct {
+		name   string
+		base   string
+		target string
+		want   []*TextDiffLine
+	}{
+		{
+			name:   "same text",
+			base:   "a\nb",
+			target: "a\nb",
+			want:   nil,
+		},
+		{
+			name:   "modify middle line",
+			base:   "a\nb\nc",
+			target: "a\nx\nc",
+			want: []*TextDiffLine{
+				{Operation: TextDiffOperationEqual, Text: "a"},
+				{Operation: TextDiffOperationDelete, Text: "b"},
+				{Operation: TextDiffOperationInsert, Text: "x"},
+				{Operation: TextDiffOperationEqual, Text: "c"},
+			},
+		},
+		{
+			name:   "append line",
+			base:   "a",
+			target: "a\nb",
+			want: []*TextDiffLine{
+				{Operation: TextDiffOperationEqual, Text: "a"},
+				{Operation: TextDiffOperationInsert, Text: "b"},
+			},
+		},
+		{
+			name:   "from empty",
+			base:   "",
+			target: "a",
+			want: []*TextDiffLine{
+				{Operation: TextDiffOperationInsert, Text: "a"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DiffText(tt.base, tt.target))
+		})
+	}
+}
+
+func TestDiffPromptDetail(t *testing.T) {
+	tests := []struct {
+		name   string
+		base   *PromptDetail
+		target *PromptDetail
+		want   *PromptDetailDiff
+	}{
+		{
+			name:   "both nil",
+			base:   nil,
+			target: nil,
+			want:   &PromptDetailDiff{},
+		},
+		{
+			name: "messages",
+			base: &PromptDetail{
+				PromptTemplate: &PromptTemplate{
+					TemplateType: TemplateTypeNormal,
+					Messages: []*Message{
+						{Role: RoleSystem, Content: ptr.Of("You are a helpful assistant.\nAnswer briefly.")},
+						{Role: RoleUser, Content: ptr.Of("{{question}}")},
+						{Role: RoleAssistant, Content: ptr.Of("OK")},
+					},
+				},
+			},
+			target: &PromptDetail{
+				PromptTemplate: &PromptTemplate{
+					TemplateType: TemplateTypeNormal,
+					Messages: []*Message{
+						{Role: RoleSystem, Content: ptr.Of("You are a helpful assistant.\nAnswer in detail.")},
+						{Role: RoleUser, Content: ptr.Of("{{question}}")},
+						{Role: RolePlaceholder, Content: ptr.Of("history")},
+					},
+				},
+			},
+			want: &PromptDetailDiff{
+				MessageDiffs: []*MessageDiff{
+					{
+						DiffType:      DiffTypeModified,
+						BaseIndex:     ptr.Of(0),
+						TargetIndex:   ptr.Of(0),
+						BaseMessage:   &Message{Role: RoleSystem, Content: ptr.Of("You are a helpful assistant.\nAnswer briefly.")},
+						TargetMessage: &Message{Role: RoleSystem, Content: ptr.Of("You are a helpful assistant.\nAnswer in detail.")},
+						ContentDiff: []*TextDiffLine{
+							{Operation: TextDiffOperationEqual, Text: "You are a helpful assistant."},
+							{Operation: TextDiffOperationDelete, Text: "Answer briefly."},
+							{Operation: TextDiffOperationInsert, Text: "Answer in detail."},
+						},
+					},
+					{
+						DiffType:    DiffTypeRemoved,
+						BaseIndex:   ptr.Of(2),
+						BaseMessage: &Message{Role: RoleAssistant, Content: ptr.Of("OK")},
+					},
+					{
+						DiffType:      DiffTypeAdded,
+						TargetIndex:   ptr.Of(2),
+						TargetMessage: &Message{Role: RolePlaceholder, Content: ptr.Of("history")},
+					},
+				},
+			},
+		},
+		{
+			name: "variables, tools and configs",
+			base: &PromptDetail{
+				PromptTemplate: &PromptTemplate{
+					TemplateType: TemplateTypeNormal,
+					VariableDefs: []*VariableDef{
+						{Key: "question", Type: VariableTypeString},
+						{Key: "count", Type: VariableTypeInteger},
+					},
+				},
+				Tools: []*Tool{
+					{Type: ToolTypeFunction, Function: &Function{Name: "search", Parameters: "{}"}},
+					{Type: ToolTypeFunction, Function: &Function{Name: "weather"}},
+				},
+				ToolCallConfig: &ToolCallConfig{ToolChoice: ToolChoiceTypeAuto},
+				ModelConfig: &ModelConfig{
+					ModelID:     1,
+					Temperature: ptr.Of(0.7),
+					MaxTokens:   ptr.Of(int32(1024)),
+				},
+			},
+			target: &PromptDetail{
+				PromptTemplate: &PromptTemplate{
+					TemplateType: TemplateTypeJinja2,
+					VariableDefs: []*VariableDef{
+						{Key: "question", Type: VariableTypeString},
+						{Key: "count", Type: VariableTypeFloat},
+						{Key: "lang", Type: VariableTypeString},
+					},
+				},
+				Tools: []*Tool{
+					{Type: ToolTypeFunction, Function: &Function{Name: "search", Parameters: `{"type":"object"}`}},
+				},
+				ToolCallConfig: &ToolCallConfig{ToolChoice: ToolChoiceTypeAuto},
+				ModelConfig: &ModelConfig{
+					ModelID:     2,
+					Temperature: ptr.Of(0.2),
+					JSONMode:    ptr.Of(true),
+				},
+			},
+			want: &PromptDetailDiff{
+				FieldDiffs: []*FieldDiff{
+					{DiffType: DiffTypeModified, Field: "template_type", BaseValue: ptr.Of("normal"), TargetValue: ptr.Of("jinja2")},
+				},
+				VariableDefDiffs: []*VariableDefDiff{
+					{
+						DiffType:  DiffTypeModified,
+						Key:       "count",
+						BaseDef:   &VariableDef{Key: "count", Type: VariableTypeInteger},
+						TargetDef: &VariableDef{Key: "count", Type: VariableTypeFloat},
+					},
+					{
+						DiffType:  DiffTypeAdded,
+						Key:       "lang",
+						TargetDef: &VariableDef{Key: "lang", Type: VariableTypeString},
+					},
+				},
+				ToolDiffs: []*ToolDiff{
+					{
+						DiffType:   DiffTypeModified,
+						Name:       "search",
+						BaseTool:   &Tool{Type: ToolTypeFunction, Function: &Function{Name: "search", Parameters: "{}"}},
+						TargetTool: &Tool{Type: ToolTypeFunction, Function: &Function{Name: "search", Parameters: `{"type":"object"}`}},
+					},
+					{
+						DiffType: DiffTypeRemoved,
+						Name:     "weather",
+						BaseTool: &Tool{Type: ToolTypeFunction, Function: &Function{Name: "weather"}},
+					},
+				},
+				ModelConfigDiffs: []*FieldDiff{
+					{DiffType: DiffTypeModified, Field: "model_id", BaseValue: ptr.Of("1"), TargetValue: ptr.Of("2")},
+					{DiffType: DiffTypeRemoved, Field: "max_tokens", BaseValue: ptr.Of("1024")},
+					{DiffType: DiffTypeModified, Field: "temperature", BaseValue: ptr.Of("0.7"), TargetValue: ptr.Of("0.2")},
+					{DiffType: DiffTypeAdded, Field: "json_mode", TargetValue: ptr.Of("true")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DiffPromptDetail(tt.base, tt.target))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"regexp"
+	"time"
+)
+
+// PromptLabel 发布标签，指向Prompt的某个提交版本，调用方通过标签获取Prompt时无需感知具体版本号
+type PromptLabel struct {
+	PromptID      int64     `json:"prompt_id"`
+	LabelKey      string    `json:"label_key"`
+	CommitVersion string    `json:"commit_version"`
+	CreatedBy     string    `json:"created_by"`
+	UpdatedBy     string    `json:"updated_by"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type PromptLabelHistory struct {
+	ID                    int64                `json:"id"`
+	PromptID              int64                `json:"prompt_id"`
+	LabelKey              string               `json:"label_key"`
+	CommitVersion         string               `json:"commit_version"`
+	PreviousCommitVersion string               `json:"previous_commit_version"`
+	Operation             PromptLabelOperation `json:"operation"`
+	OperatedBy            string               `json:"operated_by"`
+	OperatedAt            time.Time            `json:"operated_at"`
+}
+
+type PromptLabelOperation string
+
+const (
+	PromptLabelOperationSet      PromptLabelOperation = "set"
+	PromptLabelOperationRollback PromptLabelOperation = "rollback"
+)
+
+// 标签必须以小写字母开头，保证不会与semver格式的提交版本混淆
+var promptLabelKeyRegexp = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+
+func IsValidPromptLabelKey(labelKey string) bool {
+	return promptLabelKeyRegexp.MatchString(labelKey)
+}
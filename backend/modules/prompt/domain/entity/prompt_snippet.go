@@ -0,0 +1,143 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+// SnippetRef 消息中对片段Prompt的引用，写法为 {{#snippet:<prompt_key>@<version>}}，version可以是提交版本或发布标签
+type SnippetRef struct {
+	PromptKey string
+	Version   string
+}
+
+var snippetRefRegexp = regexp.MustCompile(`\{\{#snippet:([^@{}\s]+)@([A-Za-z0-9_.\-]+)\}\}`)
+
+// IsLabel 发布标签以小写字母开头，提交版本为semver格式，二者不会混淆
+func (r SnippetRef) IsLabel() bool {
+	return IsValidPromptLabelKey(r.Version)
+}
+
+func (r SnippetRef) String() string {
+	return fmt.Sprintf("{{#snippet:%s@%s}}", r.PromptKey, r.Version)
+}
+
+// PromptSnippetReference 某个Prompt提交版本对片段Prompt的引用记录，用于查询片段被哪些Prompt使用
+type PromptSnippetReference struct {
+	ID              int64     `json:"id"`
+	SpaceID         int64     `json:"space_id"`
+	PromptID        int64     `json:"prompt_id"`
+	PromptKey       string    `json:"prompt_key"`
+	CommitVersion   string    `json:"commit_version"`
+	SnippetPromptID int64     `json:"snippet_prompt_id"`
+	SnippetVersion  string    `json:"snippet_version"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// GetSnippetRefs 按出现顺序返回模板消息中引用的片段，重复引用只返回一次
+func (pd *PromptDetail) GetSnippetRefs() []SnippetRef {
+	if pd == nil || pd.PromptTemplate == nil {
+		return nil
+	}
+	var refs []SnippetRef
+	seen := make(map[SnippetRef]bool)
+	collect := func(text string) {
+		for _, match := range snippetRefRegexp.FindAllStringSubmatch(text, -1) {
+			ref := SnippetRef{PromptKey: match[1], Version: match[2]}
+			if !seen[ref] {
+				seen[ref] = true
+				refs = append(refs, ref)
+			}
+		}
+	}
+	for _, message := range pd.PromptTemplate.Messages {
+		if message == nil || message.Role == RolePlaceholder {
+			continue
+		}
+		collect(ptr.From(message.Content))
+		for _, part := range message.Parts {
+			if part != nil && part.Type == ContentTypeText {
+				collect(ptr.From(part.Text))
+			}
+		}
+	}
+	return refs
+}
+
+// ReplaceSnippetRefs 将模板消息中的片段引用替换为对应内容，未提供内容的引用保持原样
+func (pd *PromptDetail) ReplaceSnippetRefs(contents map[SnippetRef]string) {
+	if pd == nil || pd.PromptTemplate == nil {
+		return
+	}
+	replace := func(text string) string {
+		return snippetRefRegexp.ReplaceAllStringFunc(text, func(matched string) string {
+			match := snippetRefRegexp.FindStringSubmatch(matched)
+			if content, ok := contents[SnippetRef{PromptKey: match[1], Version: match[2]}]; ok {
+				return content
+			}
+			return matched
+		})
+	}
+	for _, message := range pd.PromptTemplate.Messages {
+		if message == nil || message.Role == RolePlaceholder {
+			continue
+		}
+		if message.Content != nil {
+			message.Content = ptr.Of(replace(*message.Content))
+		}
+		for _, part := range message.Parts {
+			if part != nil && part.Type == ContentTypeText && part.Text != nil {
+				part.Text = ptr.Of(replace(*part.Text))
+			}
+		}
+	}
+}
+
+// GetSnippetContent 片段被引用时嵌入的文本，由各条模板消息的文本内容按换行拼接而成
+func (pd *PromptDetail) GetSnippetContent() string {
+	if pd == nil || pd.PromptTemplate == nil {
+		return ""
+	}
+	var texts []string
+	for _, message := range pd.PromptTemplate.Messages {
+		if message == nil || message.Role == RolePlaceholder {
+			continue
+		}
+		if text := ptr.From(message.Content); text != "" {
+			texts = append(texts, text)
+		}
+		for _, part := range message.Parts {
+			if part != nil && part.Type == ContentTypeText && ptr.From(part.Text) != "" {
+				texts = append(texts, ptr.From(part.Text))
+			}
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// MergeSnippetVariableDefs 片段中使用的变量需要由引用方传入，引用方未定义的变量定义从片段补齐
+func (pd *PromptDetail) MergeSnippetVariableDefs(snippet *PromptDetail) {
+	if pd == nil || pd.PromptTemplate == nil || snippet == nil || snippet.PromptTemplate == nil {
+		return
+	}
+	defined := make(map[string]bool)
+	for _, variableDef := range pd.PromptTemplate.VariableDefs {
+		if variableDef != nil {
+			defined[variableDef.Key] = true
+		}
+	}
+	for _, variableDef := range snippet.PromptTemplate.VariableDefs {
+		if variableDef == nil || defined[variableDef.Key] {
+			continue
+		}
+		defined[variableDef.Key] = true
+		pd.PromptTemplate.VariableDefs = append(pd.PromptTemplate.VariableDefs, variableDef)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TestPromptDetail_GetSnippetRefs(t *testing.T) {
+	tests := []struct {
+		name   string
+		detail *PromptDetail
+		want   []SnippetRef
+	}{
+		{
+			name:   "nil detail",
+			detail: nil,
+			want:   nil,
+		},
+		{
+			name: "no reference",
+			detail: &PromptDetail{
+				PromptTemplate: &PromptTemplate{
+					Messages: []*Message{{Role: RoleSystem, Content: ptr.Of("Hello {{name}}")}},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "content and parts, duplicated and placeholder ignored",
+			detail: &PromptDetail{
+				PromptTemplate: &PromptTemplate{
+					Messages: []*Message{
+						{Role: RoleSystem, Content: ptr.Of("{{#snippet:persona@0.0.1}}\n{{#snippet:rules@prod}}")},
+						{Role: RolePlaceholder, Content: ptr.Of("{{#snippet:ignored@0.0.1}}")},
+						{
+							Role: RoleUser,
+							Parts: []*ContentPart{
+								{Type: ContentTypeText, Text: ptr.Of("{{#snippet:persona@0.0.1}} {{#snippet:persona@0.0.2}}")},
+								{Type: ContentTypeImageURL, ImageURL: &ImageURL{URI: "uri"}},
+							},
+						},
+					},
+				},
+			},
+			want: []SnippetRef{
+				{PromptKey: "persona", Version: "0.0.1"},
+				{PromptKey: "rules", Version: "prod"},
+				{PromptKey: "persona", Version: "0.0.2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.detail.GetSnippetRefs())
+		})
+	}
+}
+
+func TestSnippetRef_IsLabel(t *testing.T) {
+	assert.True(t, SnippetRef{PromptKey: "a", Version: "prod"}.IsLabel())
+	assert.False(t, SnippetRef{PromptKey: "a", Version: "1.0.0"}.IsLabel())
+	assert.Equal(t, "{{#snippet:a@prod}}", SnippetRef{PromptKey: "a", Version: "prod"}.String())
+}
+
+func TestPromptDetail_ReplaceSnippetRefs(t *testing.T) {
+	detail := &PromptDetail{
+		PromptTemplate: &PromptTemplate{
+			Messages: []*Message{
+				{Role: RoleSystem, Content: ptr.Of("{{#snippet:persona@0.0.1}}\nAnswer {{question}}. {{#snippet:missing@prod}}")},
+				{
+					Role:  RoleUser,
+					Parts: []*ContentPart{{Type: ContentTypeText, Text: ptr.Of("[{{#snippet:persona@0.0.1}}]")}},
+				},
+			},
+		},
+	}
+	detail.ReplaceSnippetRefs(map[SnippetRef]string{
+		{PromptKey: "persona", Version: "0.0.1"}: "You are {{role}}.",
+	})
+	assert.Equal(t, "You are {{role}}.\nAnswer {{question}}. {{#snippet:missing@prod}}", ptr.From(detail.PromptTemplate.Messages[0].Content))
+	assert.Equal(t, "[You are {{role}}.]", ptr.From(detail.PromptTemplate.Messages[1].Parts[0].Text))
+}
+
+func TestPromptDetail_GetSnippetContent(t *testing.T) {
+	detail := &PromptDetail{
+		PromptTemplate: &PromptTemplate{
+			Messages: []*Message{
+				{Role: RoleSystem, Content: ptr.Of("line1")},
+				{Role: RolePlaceholder, Content: ptr.Of("history")},
+				{Role: RoleUser, Parts: []*ContentPart{{Type: ContentTypeText, Text: ptr.Of("line2")}}},
+			},
+		},
+	}
+	assert.Equal(t, "line1\nline2", detail.GetSnippetContent())
+	assert.Equal(t, "", (*PromptDetail)(nil).GetSnippetContent())
+}
+
+func TestPromptDetail_MergeSnippetVariableDefs(t *testing.T) {
+	detail := &PromptDetail{
+		PromptTemplate: &PromptTemplate{
+			VariableDefs: []*VariableDef{{Key: "question", Type: VariableTypeString}},
+		},
+	}
+	detail.MergeSnippetVariableDefs(&PromptDetail{
+		PromptTemplate: &PromptTemplate{
+			VariableDefs: []*VariableDef{
+				{Key: "question", Type: VariableTypeInteger},
+				{Key: "role", Type: VariableTypeString},
+			},
+		},
+	})
+	assert.Equal(t, []*VariableDef{
+		{Key: "question", Type: VariableTypeString},
+		{Key: "role", Type: VariableTypeString},
+	}, detail.PromptTemplate.VariableDefs)
+}
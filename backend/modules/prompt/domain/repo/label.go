@@ -0,0 +1,48 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/label_repo.go -package=mocks . ILabelRepo
+type ILabelRepo interface {
+	SetLabel(ctx context.Context, param SetLabelParam) (labelDO *entity.PromptLabel, err error)
+	ListLabel(ctx context.Context, promptID int64) (labelDOs []*entity.PromptLabel, err error)
+	MGetLabel(ctx context.Context, queries []GetLabelParam) (labelDOMap map[GetLabelParam]*entity.PromptLabel, err error)
+
+	GetLabelHistory(ctx context.Context, historyID int64) (historyDO *entity.PromptLabelHistory, err error)
+	ListLabelHistory(ctx context.Context, param ListLabelHistoryParam) (result *ListLabelHistoryResult, err error)
+}
+
+type SetLabelParam struct {
+	SpaceID  int64
+	PromptID int64
+	UserID   string
+
+	LabelKey      string
+	CommitVersion string
+	Operation     entity.PromptLabelOperation
+}
+
+type GetLabelParam struct {
+	PromptID int64
+	LabelKey string
+}
+
+type ListLabelHistoryParam struct {
+	PromptID int64
+	LabelKey string
+
+	PageSize  int
+	PageToken *int64
+}
+
+type ListLabelHistoryResult struct {
+	HistoryDOs    []*entity.PromptLabelHistory
+	NextPageToken int64
+}
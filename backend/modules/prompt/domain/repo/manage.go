@@ -26,6 +26,8 @@ type IManageRepo interface {
 
 	CommitDraft(ctx context.Context, param CommitDraftParam) (err error)
 	ListCommitInfo(ctx context.Context, param ListCommitInfoParam) (result *ListCommitResult, err error)
+
+	ListSnippetReference(ctx context.Context, param ListSnippetReferenceParam) (result *ListSnippetReferenceResult, err error)
 }
 
 type GetPromptParam struct {
@@ -45,6 +47,7 @@ type ListPromptParam struct {
 	CreatedBys    []string
 	UserID        string
 	CommittedOnly bool
+	PromptTypes   []string
 
 	PageNum  int
 	PageSize int
@@ -87,6 +90,18 @@ type ListCommitResult struct {
 	NextPageToken int64
 }
 
+type ListSnippetReferenceParam struct {
+	SnippetPromptID int64
+
+	PageSize  int
+	PageToken *int64
+}
+
+type ListSnippetReferenceResult struct {
+	ReferenceDOs  []*entity.PromptSnippetReference
+	NextPageToken int64
+}
+
 type CacheOption struct {
 	CacheEnable bool
 }
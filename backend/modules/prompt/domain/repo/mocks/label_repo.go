@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo (interfaces: ILabelRepo)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/label_repo.go -package=mocks . ILabelRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	repo "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockILabelRepo is a mock of ILabelRepo interface.
+type MockILabelRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockILabelRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockILabelRepoMockRecorder is the mock recorder for MockILabelRepo.
+type MockILabelRepoMockRecorder struct {
+	mock *MockILabelRepo
+}
+
+// NewMockILabelRepo creates a new mock instance.
+func NewMockILabelRepo(ctrl *gomock.Controller) *MockILabelRepo {
+	mock := &MockILabelRepo{ctrl: ctrl}
+	mock.recorder = &MockILabelRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILabelRepo) EXPECT() *MockILabelRepoMockRecorder {
+	return m.recorder
+}
+
+// GetLabelHistory mocks base method.
+func (m *MockILabelRepo) GetLabelHistory(ctx context.Context, historyID int64) (*entity.PromptLabelHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelHistory", ctx, historyID)
+	ret0, _ := ret[0].(*entity.PromptLabelHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelHistory indicates an expected call of GetLabelHistory.
+func (mr *MockILabelRepoMockRecorder) GetLabelHistory(ctx, historyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelHistory", reflect.TypeOf((*MockILabelRepo)(nil).GetLabelHistory), ctx, historyID)
+}
+
+// ListLabel mocks base method.
+func (m *MockILabelRepo) ListLabel(ctx context.Context, promptID int64) ([]*entity.PromptLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLabel", ctx, promptID)
+	ret0, _ := ret[0].([]*entity.PromptLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLabel indicates an expected call of ListLabel.
+func (mr *MockILabelRepoMockRecorder) ListLabel(ctx, promptID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLabel", reflect.TypeOf((*MockILabelRepo)(nil).ListLabel), ctx, promptID)
+}
+
+// ListLabelHistory mocks base method.
+func (m *MockILabelRepo) ListLabelHistory(ctx context.Context, param repo.ListLabelHistoryParam) (*repo.ListLabelHistoryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLabelHistory", ctx, param)
+	ret0, _ := ret[0].(*repo.ListLabelHistoryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLabelHistory indicates an expected call of ListLabelHistory.
+func (mr *MockILabelRepoMockRecorder) ListLabelHistory(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLabelHistory", reflect.TypeOf((*MockILabelRepo)(nil).ListLabelHistory), ctx, param)
+}
+
+// MGetLabel mocks base method.
+func (m *MockILabelRepo) MGetLabel(ctx context.Context, queries []repo.GetLabelParam) (map[repo.GetLabelParam]*entity.PromptLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MGetLabel", ctx, queries)
+	ret0, _ := ret[0].(map[repo.GetLabelParam]*entity.PromptLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MGetLabel indicates an expected call of MGetLabel.
+func (mr *MockILabelRepoMockRecorder) MGetLabel(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetLabel", reflect.TypeOf((*MockILabelRepo)(nil).MGetLabel), ctx, queries)
+}
+
+// SetLabel mocks base method.
+func (m *MockILabelRepo) SetLabel(ctx context.Context, param repo.SetLabelParam) (*entity.PromptLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLabel", ctx, param)
+	ret0, _ := ret[0].(*entity.PromptLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLabel indicates an expected call of SetLabel.
+func (mr *MockILabelRepoMockRecorder) SetLabel(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabel", reflect.TypeOf((*MockILabelRepo)(nil).SetLabel), ctx, param)
+}
@@ -130,6 +130,21 @@ func (mr *MockIManageRepoMockRecorder) ListPrompt(ctx, param any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrompt", reflect.TypeOf((*MockIManageRepo)(nil).ListPrompt), ctx, param)
 }
 
+// ListSnippetReference mocks base method.
+func (m *MockIManageRepo) ListSnippetReference(ctx context.Context, param repo.ListSnippetReferenceParam) (*repo.ListSnippetReferenceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnippetReference", ctx, param)
+	ret0, _ := ret[0].(*repo.ListSnippetReferenceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnippetReference indicates an expected call of ListSnippetReference.
+func (mr *MockIManageRepoMockRecorder) ListSnippetReference(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnippetReference", reflect.TypeOf((*MockIManageRepo)(nil).ListSnippetReference), ctx, param)
+}
+
 // MGetPrompt mocks base method.
 func (m *MockIManageRepo) MGetPrompt(ctx context.Context, queries []repo.GetPromptParam, opts ...repo.GetPromptOptionFunc) (map[repo.GetPromptParam]*entity.Prompt, error) {
 	m.ctrl.T.Helper()
@@ -74,7 +74,11 @@ func (p *PromptServiceImpl) FormatPrompt(ctx context.Context, prompt *entity.Pro
 			}()
 		}
 	}
-	return prompt.FormatMessages(messages, variableVals)
+	expandedPrompt, err := p.ExpandSnippets(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return expandedPrompt.FormatMessages(messages, variableVals)
 }
 
 func (p *PromptServiceImpl) ExecuteStreaming(ctx context.Context, param ExecuteStreamingParam) (aggregatedReply *entity.Reply, err error) {
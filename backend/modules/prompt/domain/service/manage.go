@@ -38,30 +38,87 @@ func (p *PromptServiceImpl) MGetPromptIDs(ctx context.Context, spaceID int64, pr
 func (p *PromptServiceImpl) MParseCommitVersionByPromptKey(ctx context.Context, spaceID int64, pairs []PromptKeyVersionPair) (promptKeyCommitVersionMap map[PromptKeyVersionPair]string, err error) {
 	promptKeyCommitVersionMap = make(map[PromptKeyVersionPair]string)
 	var emptyVersionPromptKeys []string
+	var labelPairs []PromptKeyVersionPair
 	for _, pair := range pairs {
-		if pair.Version == "" {
-			emptyVersionPromptKeys = append(emptyVersionPromptKeys, pair.PromptKey)
+		// 版本号与发布标签只能指定其一
+		if pair.Version != "" && pair.Label != "" {
+			return nil, errorx.NewByCode(prompterr.CommonInvalidParamCode,
+				errorx.WithExtraMsg(fmt.Sprintf("prompt key: %s, version and label cannot be specified at the same time", pair.PromptKey)),
+				errorx.WithExtra(map[string]string{"prompt_key": pair.PromptKey}))
 		}
 		// 不管原始版本号是否为空，都先用原始版本号占位
 		promptKeyCommitVersionMap[pair] = pair.Version
+		if pair.Version != "" {
+			continue
+		}
+		if pair.Label != "" {
+			labelPairs = append(labelPairs, pair)
+			continue
+		}
+		emptyVersionPromptKeys = append(emptyVersionPromptKeys, pair.PromptKey)
 	}
-	if len(emptyVersionPromptKeys) == 0 {
-		return promptKeyCommitVersionMap, nil
+	if len(emptyVersionPromptKeys) > 0 {
+		basics, err := p.manageRepo.MGetPromptBasicByPromptKey(ctx, spaceID, emptyVersionPromptKeys, repo.WithPromptBasicCacheEnable())
+		if err != nil {
+			return nil, err
+		}
+		for _, basic := range basics {
+			if basic != nil && basic.PromptBasic != nil {
+				lastestCommitVersion := basic.PromptBasic.LatestVersion
+				if lastestCommitVersion == "" {
+					return nil, errorx.NewByCode(prompterr.PromptUncommittedCode, errorx.WithExtraMsg(fmt.Sprintf("prompt key: %s", basic.PromptKey)), errorx.WithExtra(map[string]string{"prompt_key": basic.PromptKey}))
+				}
+				promptKeyCommitVersionMap[PromptKeyVersionPair{PromptKey: basic.PromptKey}] = lastestCommitVersion
+			}
+		}
 	}
-	basics, err := p.manageRepo.MGetPromptBasicByPromptKey(ctx, spaceID, emptyVersionPromptKeys, repo.WithPromptBasicCacheEnable())
+	if len(labelPairs) > 0 {
+		promptKeys := make([]string, 0, len(labelPairs))
+		for _, pair := range labelPairs {
+			promptKeys = append(promptKeys, pair.PromptKey)
+		}
+		promptKeyIDMap, err := p.MGetPromptIDs(ctx, spaceID, promptKeys)
+		if err != nil {
+			return nil, err
+		}
+		idLabelPairs := make([]PromptIDLabelPair, 0, len(labelPairs))
+		for _, pair := range labelPairs {
+			idLabelPairs = append(idLabelPairs, PromptIDLabelPair{PromptID: promptKeyIDMap[pair.PromptKey], Label: pair.Label})
+		}
+		labelCommitVersionMap, err := p.MParseCommitVersionByLabel(ctx, idLabelPairs)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range labelPairs {
+			promptKeyCommitVersionMap[pair] = labelCommitVersionMap[PromptIDLabelPair{PromptID: promptKeyIDMap[pair.PromptKey], Label: pair.Label}]
+		}
+	}
+	return promptKeyCommitVersionMap, nil
+}
+
+func (p *PromptServiceImpl) MParseCommitVersionByLabel(ctx context.Context, pairs []PromptIDLabelPair) (promptIDLabelCommitVersionMap map[PromptIDLabelPair]string, err error) {
+	promptIDLabelCommitVersionMap = make(map[PromptIDLabelPair]string)
+	if len(pairs) == 0 {
+		return promptIDLabelCommitVersionMap, nil
+	}
+	queries := make([]repo.GetLabelParam, 0, len(pairs))
+	for _, pair := range pairs {
+		queries = append(queries, repo.GetLabelParam{PromptID: pair.PromptID, LabelKey: pair.Label})
+	}
+	labelMap, err := p.labelRepo.MGetLabel(ctx, queries)
 	if err != nil {
 		return nil, err
 	}
-	for _, basic := range basics {
-		if basic != nil && basic.PromptBasic != nil {
-			lastestCommitVersion := basic.PromptBasic.LatestVersion
-			if lastestCommitVersion == "" {
-				return nil, errorx.NewByCode(prompterr.PromptUncommittedCode, errorx.WithExtraMsg(fmt.Sprintf("prompt key: %s", basic.PromptKey)), errorx.WithExtra(map[string]string{"prompt_key": basic.PromptKey}))
-			}
-			promptKeyCommitVersionMap[PromptKeyVersionPair{PromptKey: basic.PromptKey}] = lastestCommitVersion
+	for _, pair := range pairs {
+		label, ok := labelMap[repo.GetLabelParam{PromptID: pair.PromptID, LabelKey: pair.Label}]
+		if !ok || label == nil {
+			return nil, errorx.NewByCode(prompterr.PromptLabelNotExistCode,
+				errorx.WithExtraMsg(fmt.Sprintf("prompt id: %d, label: %s", pair.PromptID, pair.Label)),
+				errorx.WithExtra(map[string]string{"label": pair.Label}))
 		}
+		promptIDLabelCommitVersionMap[pair] = label.CommitVersion
 	}
-	return promptKeyCommitVersionMap, nil
+	return promptIDLabelCommitVersionMap, nil
 }
 
 func (p *PromptServiceImpl) MCompleteMultiModalFileURL(ctx context.Context, messages []*entity.Message) error {
@@ -350,6 +350,7 @@ func TestPromptServiceImpl_MParseCommitVersionByPromptKey(t *testing.T) {
 		debugLogRepo     repo.IDebugLogRepo
 		debugContextRepo repo.IDebugContextRepo
 		manageRepo       repo.IManageRepo
+		labelRepo        repo.ILabelRepo
 		configProvider   conf.IConfigProvider
 		llm              rpc.ILLMProvider
 		file             rpc.IFileProvider
@@ -501,6 +502,108 @@ func TestPromptServiceImpl_MParseCommitVersionByPromptKey(t *testing.T) {
 			want:    nil,
 			wantErr: errors.New("database error"),
 		},
+		{
+			name: "resolve version by label",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPromptBasicByPromptKey(
+					gomock.Any(),
+					gomock.Eq(int64(123)),
+					gomock.Eq([]string{"test_prompt2"}),
+					gomock.Any(),
+				).Return([]*entity.Prompt{
+					{
+						ID:        2,
+						PromptKey: "test_prompt2",
+					},
+				}, nil)
+				mockLabelRepo := repomocks.NewMockILabelRepo(ctrl)
+				mockLabelRepo.EXPECT().MGetLabel(gomock.Any(), []repo.GetLabelParam{{PromptID: 2, LabelKey: "prod"}}).Return(map[repo.GetLabelParam]*entity.PromptLabel{
+					{PromptID: 2, LabelKey: "prod"}: {
+						PromptID:      2,
+						LabelKey:      "prod",
+						CommitVersion: "1.2.0",
+					},
+				}, nil)
+				return fields{
+					manageRepo: mockManageRepo,
+					labelRepo:  mockLabelRepo,
+				}
+			},
+			args: args{
+				ctx:     context.Background(),
+				spaceID: 123,
+				pairs: []PromptKeyVersionPair{
+					{
+						PromptKey: "test_prompt1",
+						Version:   "1.0.0",
+					},
+					{
+						PromptKey: "test_prompt2",
+						Label:     "prod",
+					},
+				},
+			},
+			want: map[PromptKeyVersionPair]string{
+				{PromptKey: "test_prompt1", Version: "1.0.0"}: "1.0.0",
+				{PromptKey: "test_prompt2", Label: "prod"}:    "1.2.0",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "version and label both specified",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx:     context.Background(),
+				spaceID: 123,
+				pairs: []PromptKeyVersionPair{
+					{
+						PromptKey: "test_prompt1",
+						Version:   "1.0.0",
+						Label:     "prod",
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errorx.NewByCode(prompterr.CommonInvalidParamCode, errorx.WithExtraMsg("prompt key: test_prompt1, version and label cannot be specified at the same time"), errorx.WithExtra(map[string]string{"prompt_key": "test_prompt1"})),
+		},
+		{
+			name: "label not exist",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPromptBasicByPromptKey(
+					gomock.Any(),
+					gomock.Eq(int64(123)),
+					gomock.Eq([]string{"test_prompt2"}),
+					gomock.Any(),
+				).Return([]*entity.Prompt{
+					{
+						ID:        2,
+						PromptKey: "test_prompt2",
+					},
+				}, nil)
+				mockLabelRepo := repomocks.NewMockILabelRepo(ctrl)
+				mockLabelRepo.EXPECT().MGetLabel(gomock.Any(), gomock.Any()).Return(map[repo.GetLabelParam]*entity.PromptLabel{}, nil)
+				return fields{
+					manageRepo: mockManageRepo,
+					labelRepo:  mockLabelRepo,
+				}
+			},
+			args: args{
+				ctx:     context.Background(),
+				spaceID: 123,
+				pairs: []PromptKeyVersionPair{
+					{
+						PromptKey: "test_prompt2",
+						Label:     "gray",
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errorx.NewByCode(prompterr.PromptLabelNotExistCode, errorx.WithExtraMsg("prompt id: 2, label: gray"), errorx.WithExtra(map[string]string{"label": "gray"})),
+		},
 	}
 
 	for _, tt := range tests {
@@ -515,6 +618,7 @@ func TestPromptServiceImpl_MParseCommitVersionByPromptKey(t *testing.T) {
 				debugLogRepo:     ttFields.debugLogRepo,
 				debugContextRepo: ttFields.debugContextRepo,
 				manageRepo:       ttFields.manageRepo,
+				labelRepo:        ttFields.labelRepo,
 				configProvider:   ttFields.configProvider,
 				llm:              ttFields.llm,
 				file:             ttFields.file,
@@ -72,6 +72,21 @@ func (mr *MockIPromptServiceMockRecorder) ExecuteStreaming(ctx, param any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteStreaming", reflect.TypeOf((*MockIPromptService)(nil).ExecuteStreaming), ctx, param)
 }
 
+// ExpandSnippets mocks base method.
+func (m *MockIPromptService) ExpandSnippets(ctx context.Context, prompt *entity.Prompt) (*entity.Prompt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpandSnippets", ctx, prompt)
+	ret0, _ := ret[0].(*entity.Prompt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpandSnippets indicates an expected call of ExpandSnippets.
+func (mr *MockIPromptServiceMockRecorder) ExpandSnippets(ctx, prompt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpandSnippets", reflect.TypeOf((*MockIPromptService)(nil).ExpandSnippets), ctx, prompt)
+}
+
 // FormatPrompt mocks base method.
 func (m *MockIPromptService) FormatPrompt(ctx context.Context, prompt *entity.Prompt, messages []*entity.Message, variableVals []*entity.VariableVal) ([]*entity.Message, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +131,21 @@ func (mr *MockIPromptServiceMockRecorder) MGetPromptIDs(ctx, spaceID, promptKeys
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetPromptIDs", reflect.TypeOf((*MockIPromptService)(nil).MGetPromptIDs), ctx, spaceID, promptKeys)
 }
 
+// MParseCommitVersionByLabel mocks base method.
+func (m *MockIPromptService) MParseCommitVersionByLabel(ctx context.Context, pairs []service.PromptIDLabelPair) (map[service.PromptIDLabelPair]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MParseCommitVersionByLabel", ctx, pairs)
+	ret0, _ := ret[0].(map[service.PromptIDLabelPair]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MParseCommitVersionByLabel indicates an expected call of MParseCommitVersionByLabel.
+func (mr *MockIPromptServiceMockRecorder) MParseCommitVersionByLabel(ctx, pairs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MParseCommitVersionByLabel", reflect.TypeOf((*MockIPromptService)(nil).MParseCommitVersionByLabel), ctx, pairs)
+}
+
 // MParseCommitVersion mocks base method.
 func (m *MockIPromptService) MParseCommitVersionByPromptKey(ctx context.Context, spaceID int64, pairs []service.PromptKeyVersionPair) (map[service.PromptKeyVersionPair]string, error) {
 	m.ctrl.T.Helper()
@@ -23,8 +23,13 @@ type IPromptService interface {
 
 	// MGetPromptIDs 根据prompt key获取prompt id
 	MGetPromptIDs(ctx context.Context, spaceID int64, promptKeys []string) (PromptKeyIDMap map[string]int64, err error)
-	// MParseCommitVersionByPromptKey 根据prompt key解析提交版本，如果提交版本为空，则使用最新版本，否则返回指定版本
+	// MParseCommitVersionByPromptKey 根据prompt key解析提交版本，如果提交版本为空，则按发布标签解析，标签也为空时使用最新版本，否则返回指定版本
 	MParseCommitVersionByPromptKey(ctx context.Context, spaceID int64, pairs []PromptKeyVersionPair) (promptKeyCommitVersionMap map[PromptKeyVersionPair]string, err error)
+	// MParseCommitVersionByLabel 根据发布标签解析提交版本，标签不存在时返回错误
+	MParseCommitVersionByLabel(ctx context.Context, pairs []PromptIDLabelPair) (promptIDLabelCommitVersionMap map[PromptIDLabelPair]string, err error)
+
+	// ExpandSnippets 将prompt模板中引用的片段递归展开为片段内容，返回展开后的副本，存在循环引用时返回错误
+	ExpandSnippets(ctx context.Context, prompt *entity.Prompt) (expandedPrompt *entity.Prompt, err error)
 }
 
 type GetBasicParam struct {
@@ -45,6 +50,7 @@ type GetPromptDraftParam struct {
 type PromptKeyVersionPair struct {
 	PromptKey string
 	Version   string
+	Label     string
 }
 
 type PromptIDVersionPair struct {
@@ -52,11 +58,17 @@ type PromptIDVersionPair struct {
 	Version  string
 }
 
+type PromptIDLabelPair struct {
+	PromptID int64
+	Label    string
+}
+
 type PromptServiceImpl struct {
 	idgen            idgen.IIDGenerator
 	debugLogRepo     repo.IDebugLogRepo
 	debugContextRepo repo.IDebugContextRepo
 	manageRepo       repo.IManageRepo
+	labelRepo        repo.ILabelRepo
 	configProvider   conf.IConfigProvider
 	llm              rpc.ILLMProvider
 	file             rpc.IFileProvider
@@ -67,6 +79,7 @@ func NewPromptService(
 	debugLogRepo repo.IDebugLogRepo,
 	debugContextRepo repo.IDebugContextRepo,
 	promptManageRepo repo.IManageRepo,
+	labelRepo repo.ILabelRepo,
 	configProvider conf.IConfigProvider,
 	llm rpc.ILLMProvider,
 	file rpc.IFileProvider,
@@ -76,6 +89,7 @@ func NewPromptService(
 		debugLogRepo:     debugLogRepo,
 		debugContextRepo: debugContextRepo,
 		manageRepo:       promptManageRepo,
+		labelRepo:        labelRepo,
 		configProvider:   configProvider,
 		llm:              llm,
 		file:             file,
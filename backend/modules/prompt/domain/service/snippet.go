@@ -0,0 +1,139 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
+	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// maxSnippetDepth 片段允许的最大嵌套层数
+const maxSnippetDepth = 5
+
+func (p *PromptServiceImpl) ExpandSnippets(ctx context.Context, prompt *entity.Prompt) (expandedPrompt *entity.Prompt, err error) {
+	if len(prompt.GetPromptDetail().GetSnippetRefs()) == 0 {
+		return prompt, nil
+	}
+	expandedPrompt = prompt.Clone()
+	err = p.expandSnippets(ctx, prompt.SpaceID, expandedPrompt.GetPromptDetail(), []string{prompt.PromptKey})
+	if err != nil {
+		return nil, err
+	}
+	return expandedPrompt, nil
+}
+
+// expandSnippets 展开detail中的片段引用，path为从根prompt到当前片段的引用链路，用于检测循环引用
+func (p *PromptServiceImpl) expandSnippets(ctx context.Context, spaceID int64, detail *entity.PromptDetail, path []string) error {
+	refs := detail.GetSnippetRefs()
+	if len(refs) == 0 {
+		return nil
+	}
+	for _, ref := range refs {
+		if slices.Contains(path, ref.PromptKey) {
+			return errorx.NewByCode(prompterr.PromptSnippetCycleCode,
+				errorx.WithExtraMsg(fmt.Sprintf("snippet reference cycle: %s -> %s", strings.Join(path, " -> "), ref.PromptKey)),
+				errorx.WithExtra(map[string]string{"prompt_key": ref.PromptKey}))
+		}
+	}
+	if len(path) > maxSnippetDepth {
+		return errorx.NewByCode(prompterr.PromptSnippetInvalidCode,
+			errorx.WithExtraMsg(fmt.Sprintf("snippet nesting exceeds max depth %d: %s", maxSnippetDepth, strings.Join(path, " -> "))))
+	}
+
+	snippetMap, err := p.mGetSnippets(ctx, spaceID, refs)
+	if err != nil {
+		return err
+	}
+	contents := make(map[entity.SnippetRef]string, len(refs))
+	for _, ref := range refs {
+		snippetDetail := snippetMap[ref].Clone().GetPromptDetail()
+		err = p.expandSnippets(ctx, spaceID, snippetDetail, append(slices.Clone(path), ref.PromptKey))
+		if err != nil {
+			return err
+		}
+		contents[ref] = snippetDetail.GetSnippetContent()
+		detail.MergeSnippetVariableDefs(snippetDetail)
+	}
+	detail.ReplaceSnippetRefs(contents)
+	return nil
+}
+
+// mGetSnippets 批量获取被引用片段的提交版本，引用的prompt必须是片段类型
+func (p *PromptServiceImpl) mGetSnippets(ctx context.Context, spaceID int64, refs []entity.SnippetRef) (map[entity.SnippetRef]*entity.Prompt, error) {
+	var promptKeys []string
+	for _, ref := range refs {
+		if !slices.Contains(promptKeys, ref.PromptKey) {
+			promptKeys = append(promptKeys, ref.PromptKey)
+		}
+	}
+	basics, err := p.manageRepo.MGetPromptBasicByPromptKey(ctx, spaceID, promptKeys, repo.WithPromptBasicCacheEnable())
+	if err != nil {
+		return nil, err
+	}
+	promptKeyIDMap := make(map[string]int64, len(basics))
+	for _, basic := range basics {
+		if basic == nil || basic.PromptBasic == nil {
+			continue
+		}
+		if basic.PromptBasic.PromptType != entity.PromptTypeSnippet {
+			return nil, errorx.NewByCode(prompterr.PromptSnippetInvalidCode,
+				errorx.WithExtraMsg(fmt.Sprintf("prompt key: %s is not a snippet", basic.PromptKey)),
+				errorx.WithExtra(map[string]string{"prompt_key": basic.PromptKey}))
+		}
+		promptKeyIDMap[basic.PromptKey] = basic.ID
+	}
+	for _, promptKey := range promptKeys {
+		if _, ok := promptKeyIDMap[promptKey]; !ok {
+			return nil, errorx.NewByCode(prompterr.PromptSnippetInvalidCode,
+				errorx.WithExtraMsg(fmt.Sprintf("snippet prompt key: %s not found", promptKey)),
+				errorx.WithExtra(map[string]string{"prompt_key": promptKey}))
+		}
+	}
+
+	var labelPairs []PromptIDLabelPair
+	for _, ref := range refs {
+		if ref.IsLabel() {
+			labelPairs = append(labelPairs, PromptIDLabelPair{PromptID: promptKeyIDMap[ref.PromptKey], Label: ref.Version})
+		}
+	}
+	labelCommitVersionMap, err := p.MParseCommitVersionByLabel(ctx, labelPairs)
+	if err != nil {
+		return nil, err
+	}
+
+	refQueryMap := make(map[entity.SnippetRef]repo.GetPromptParam, len(refs))
+	queries := make([]repo.GetPromptParam, 0, len(refs))
+	for _, ref := range refs {
+		promptID := promptKeyIDMap[ref.PromptKey]
+		commitVersion := ref.Version
+		if ref.IsLabel() {
+			commitVersion = labelCommitVersionMap[PromptIDLabelPair{PromptID: promptID, Label: ref.Version}]
+		}
+		query := repo.GetPromptParam{PromptID: promptID, WithCommit: true, CommitVersion: commitVersion}
+		refQueryMap[ref] = query
+		queries = append(queries, query)
+	}
+	promptMap, err := p.manageRepo.MGetPrompt(ctx, queries, repo.WithPromptCacheEnable())
+	if err != nil {
+		return nil, err
+	}
+	snippetMap := make(map[entity.SnippetRef]*entity.Prompt, len(refs))
+	for ref, query := range refQueryMap {
+		snippet, ok := promptMap[query]
+		if !ok || snippet == nil {
+			return nil, errorx.NewByCode(prompterr.PromptVersionNotExistCode,
+				errorx.WithExtraMsg(fmt.Sprintf("snippet prompt key: %s, version: %s", ref.PromptKey, ref.Version)),
+				errorx.WithExtra(map[string]string{"prompt_key": ref.PromptKey, "version": ref.Version}))
+		}
+		snippetMap[ref] = snippet
+	}
+	return snippetMap, nil
+}
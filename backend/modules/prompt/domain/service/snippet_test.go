@@ -0,0 +1,169 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo/mocks"
+	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/unittest"
+)
+
+func newSnippetTestPrompt(id int64, promptKey string, promptType entity.PromptType, version string, content string, variableDefs ...*entity.VariableDef) *entity.Prompt {
+	return &entity.Prompt{
+		ID:        id,
+		SpaceID:   123,
+		PromptKey: promptKey,
+		PromptBasic: &entity.PromptBasic{
+			PromptType: promptType,
+		},
+		PromptCommit: &entity.PromptCommit{
+			CommitInfo: &entity.CommitInfo{Version: version},
+			PromptDetail: &entity.PromptDetail{
+				PromptTemplate: &entity.PromptTemplate{
+					TemplateType: entity.TemplateTypeNormal,
+					Messages:     []*entity.Message{{Role: entity.RoleSystem, Content: ptr.Of(content)}},
+					VariableDefs: variableDefs,
+				},
+			},
+		},
+	}
+}
+
+func TestPromptServiceImpl_ExpandSnippets(t *testing.T) {
+	type fields struct {
+		manageRepo repo.IManageRepo
+		labelRepo  repo.ILabelRepo
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		prompt       *entity.Prompt
+		want         *entity.Prompt
+		wantErr      error
+	}{
+		{
+			name: "no snippet reference",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			prompt: newSnippetTestPrompt(1, "parent", entity.PromptTypeNormal, "1.0.0", "Hello {{name}}"),
+			want:   newSnippetTestPrompt(1, "parent", entity.PromptTypeNormal, "1.0.0", "Hello {{name}}"),
+		},
+		{
+			name: "nested snippets by label and version",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockLabelRepo := repomocks.NewMockILabelRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPromptBasicByPromptKey(gomock.Any(), int64(123), []string{"persona"}, gomock.Any()).Return([]*entity.Prompt{
+					newSnippetTestPrompt(2, "persona", entity.PromptTypeSnippet, "", ""),
+				}, nil)
+				mockLabelRepo.EXPECT().MGetLabel(gomock.Any(), []repo.GetLabelParam{{PromptID: 2, LabelKey: "prod"}}).Return(map[repo.GetLabelParam]*entity.PromptLabel{
+					{PromptID: 2, LabelKey: "prod"}: {PromptID: 2, LabelKey: "prod", CommitVersion: "1.0.0"},
+				}, nil)
+				personaQuery := repo.GetPromptParam{PromptID: 2, WithCommit: true, CommitVersion: "1.0.0"}
+				mockManageRepo.EXPECT().MGetPrompt(gomock.Any(), []repo.GetPromptParam{personaQuery}, gomock.Any()).Return(map[repo.GetPromptParam]*entity.Prompt{
+					personaQuery: newSnippetTestPrompt(2, "persona", entity.PromptTypeSnippet, "1.0.0", "You are {{role}}. {{#snippet:tone@0.0.1}}",
+						&entity.VariableDef{Key: "role", Type: entity.VariableTypeString}),
+				}, nil)
+				mockManageRepo.EXPECT().MGetPromptBasicByPromptKey(gomock.Any(), int64(123), []string{"tone"}, gomock.Any()).Return([]*entity.Prompt{
+					newSnippetTestPrompt(3, "tone", entity.PromptTypeSnippet, "", ""),
+				}, nil)
+				toneQuery := repo.GetPromptParam{PromptID: 3, WithCommit: true, CommitVersion: "0.0.1"}
+				mockManageRepo.EXPECT().MGetPrompt(gomock.Any(), []repo.GetPromptParam{toneQuery}, gomock.Any()).Return(map[repo.GetPromptParam]*entity.Prompt{
+					toneQuery: newSnippetTestPrompt(3, "tone", entity.PromptTypeSnippet, "0.0.1", "Be concise."),
+				}, nil)
+				return fields{manageRepo: mockManageRepo, labelRepo: mockLabelRepo}
+			},
+			prompt: newSnippetTestPrompt(1, "parent", entity.PromptTypeNormal, "1.0.0", "{{#snippet:persona@prod}}\nAnswer {{question}}",
+				&entity.VariableDef{Key: "question", Type: entity.VariableTypeString}),
+			want: newSnippetTestPrompt(1, "parent", entity.PromptTypeNormal, "1.0.0", "You are {{role}}. Be concise.\nAnswer {{question}}",
+				&entity.VariableDef{Key: "question", Type: entity.VariableTypeString},
+				&entity.VariableDef{Key: "role", Type: entity.VariableTypeString}),
+		},
+		{
+			name: "reference cycle",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPromptBasicByPromptKey(gomock.Any(), int64(123), []string{"a"}, gomock.Any()).Return([]*entity.Prompt{
+					newSnippetTestPrompt(2, "a", entity.PromptTypeSnippet, "", ""),
+				}, nil)
+				query := repo.GetPromptParam{PromptID: 2, WithCommit: true, CommitVersion: "1.0.0"}
+				mockManageRepo.EXPECT().MGetPrompt(gomock.Any(), []repo.GetPromptParam{query}, gomock.Any()).Return(map[repo.GetPromptParam]*entity.Prompt{
+					query: newSnippetTestPrompt(2, "a", entity.PromptTypeSnippet, "1.0.0", "{{#snippet:parent@1.0.0}}"),
+				}, nil)
+				return fields{manageRepo: mockManageRepo}
+			},
+			prompt: newSnippetTestPrompt(1, "parent", entity.PromptTypeSnippet, "1.0.0", "{{#snippet:a@1.0.0}}"),
+			wantErr: errorx.NewByCode(prompterr.PromptSnippetCycleCode,
+				errorx.WithExtraMsg("snippet reference cycle: parent -> a -> parent"),
+				errorx.WithExtra(map[string]string{"prompt_key": "parent"})),
+		},
+		{
+			name: "self reference",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			prompt: newSnippetTestPrompt(1, "parent", entity.PromptTypeSnippet, "1.0.0", "{{#snippet:parent@prod}}"),
+			wantErr: errorx.NewByCode(prompterr.PromptSnippetCycleCode,
+				errorx.WithExtraMsg("snippet reference cycle: parent -> parent"),
+				errorx.WithExtra(map[string]string{"prompt_key": "parent"})),
+		},
+		{
+			name: "referenced prompt is not a snippet",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPromptBasicByPromptKey(gomock.Any(), int64(123), []string{"normal"}, gomock.Any()).Return([]*entity.Prompt{
+					newSnippetTestPrompt(2, "normal", entity.PromptTypeNormal, "", ""),
+				}, nil)
+				return fields{manageRepo: mockManageRepo}
+			},
+			prompt: newSnippetTestPrompt(1, "parent", entity.PromptTypeNormal, "1.0.0", "{{#snippet:normal@1.0.0}}"),
+			wantErr: errorx.NewByCode(prompterr.PromptSnippetInvalidCode,
+				errorx.WithExtraMsg("prompt key: normal is not a snippet"),
+				errorx.WithExtra(map[string]string{"prompt_key": "normal"})),
+		},
+		{
+			name: "snippet not found",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+				mockManageRepo.EXPECT().MGetPromptBasicByPromptKey(gomock.Any(), int64(123), []string{"missing"}, gomock.Any()).Return(nil, nil)
+				return fields{manageRepo: mockManageRepo}
+			},
+			prompt: newSnippetTestPrompt(1, "parent", entity.PromptTypeNormal, "1.0.0", "{{#snippet:missing@1.0.0}}"),
+			wantErr: errorx.NewByCode(prompterr.PromptSnippetInvalidCode,
+				errorx.WithExtraMsg("snippet prompt key: missing not found"),
+				errorx.WithExtra(map[string]string{"prompt_key": "missing"})),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			p := &PromptServiceImpl{
+				manageRepo: ttFields.manageRepo,
+				labelRepo:  ttFields.labelRepo,
+			}
+			original := tt.prompt.Clone()
+			got, err := p.ExpandSnippets(context.Background(), tt.prompt)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+			assert.Equal(t, original, tt.prompt)
+		})
+	}
+}
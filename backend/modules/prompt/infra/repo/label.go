@@ -0,0 +1,197 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/convertor"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+type LabelRepoImpl struct {
+	db    db.Provider
+	idgen idgen.IIDGenerator
+
+	promptCommitDAO       mysql.IPromptCommitDAO
+	promptLabelDAO        mysql.IPromptLabelDAO
+	promptLabelHistoryDAO mysql.IPromptLabelHistoryDAO
+}
+
+func NewLabelRepo(
+	db db.Provider,
+	idgen idgen.IIDGenerator,
+	promptCommitDao mysql.IPromptCommitDAO,
+	promptLabelDao mysql.IPromptLabelDAO,
+	promptLabelHistoryDao mysql.IPromptLabelHistoryDAO,
+) repo.ILabelRepo {
+	return &LabelRepoImpl{
+		db:                    db,
+		idgen:                 idgen,
+		promptCommitDAO:       promptCommitDao,
+		promptLabelDAO:        promptLabelDao,
+		promptLabelHistoryDAO: promptLabelHistoryDao,
+	}
+}
+
+func (d *LabelRepoImpl) SetLabel(ctx context.Context, param repo.SetLabelParam) (labelDO *entity.PromptLabel, err error) {
+	if param.PromptID <= 0 || param.LabelKey == "" || param.CommitVersion == "" || param.UserID == "" {
+		return nil, errorx.New("Param(PromptID or LabelKey or CommitVersion or UserID) is invalid, param = %s", json.Jsonify(param))
+	}
+	ids, err := d.idgen.GenMultiIDs(ctx, 2)
+	if err != nil {
+		return nil, err
+	}
+	labelID, historyID := ids[0], ids[1]
+
+	err = d.db.Transaction(ctx, func(tx *gorm.DB) error {
+		opt := db.WithTransaction(tx)
+		timeNow := time.Now()
+
+		commitPO, err := d.promptCommitDAO.Get(ctx, param.PromptID, param.CommitVersion, opt)
+		if err != nil {
+			return err
+		}
+		if commitPO == nil {
+			return errorx.NewByCode(prompterr.PromptVersionNotExistCode, errorx.WithExtraMsg(fmt.Sprintf("prompt id = %d, commit version = %s", param.PromptID, param.CommitVersion)))
+		}
+
+		labelPO, err := d.promptLabelDAO.Get(ctx, param.PromptID, param.LabelKey, opt)
+		if err != nil {
+			return err
+		}
+		var previousVersion string
+		if labelPO == nil {
+			labelPO = &model.PromptLabel{
+				ID:        labelID,
+				SpaceID:   param.SpaceID,
+				PromptID:  param.PromptID,
+				LabelKey:  param.LabelKey,
+				Version:   param.CommitVersion,
+				CreatedBy: param.UserID,
+				UpdatedBy: param.UserID,
+			}
+			err = d.promptLabelDAO.Create(ctx, labelPO, timeNow, opt)
+			if err != nil {
+				return err
+			}
+		} else {
+			previousVersion = labelPO.Version
+			err = d.promptLabelDAO.Update(ctx, labelPO.ID, labelPO.PromptID, map[string]interface{}{
+				"version":    param.CommitVersion,
+				"updated_by": param.UserID,
+				"updated_at": timeNow,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			labelPO.Version = param.CommitVersion
+			labelPO.UpdatedBy = param.UserID
+			labelPO.UpdatedAt = timeNow
+		}
+
+		historyPO := &model.PromptLabelHistory{
+			ID:              historyID,
+			SpaceID:         param.SpaceID,
+			PromptID:        param.PromptID,
+			LabelKey:        param.LabelKey,
+			Version:         param.CommitVersion,
+			PreviousVersion: previousVersion,
+			Operation:       string(param.Operation),
+			OperatedBy:      param.UserID,
+		}
+		err = d.promptLabelHistoryDAO.Create(ctx, historyPO, timeNow, opt)
+		if err != nil {
+			return err
+		}
+
+		labelDO = convertor.LabelPO2DO(labelPO)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return labelDO, nil
+}
+
+func (d *LabelRepoImpl) ListLabel(ctx context.Context, promptID int64) (labelDOs []*entity.PromptLabel, err error) {
+	labelPOs, err := d.promptLabelDAO.List(ctx, promptID)
+	if err != nil {
+		return nil, err
+	}
+	return convertor.BatchLabelPO2DO(labelPOs), nil
+}
+
+func (d *LabelRepoImpl) MGetLabel(ctx context.Context, queries []repo.GetLabelParam) (labelDOMap map[repo.GetLabelParam]*entity.PromptLabel, err error) {
+	if len(queries) <= 0 {
+		return nil, nil
+	}
+	pairs := make([]mysql.PromptIDLabelKeyPair, 0, len(queries))
+	for _, query := range queries {
+		pairs = append(pairs, mysql.PromptIDLabelKeyPair{
+			PromptID: query.PromptID,
+			LabelKey: query.LabelKey,
+		})
+	}
+	pairLabelPOMap, err := d.promptLabelDAO.MGet(ctx, pairs)
+	if err != nil {
+		return nil, err
+	}
+	labelDOMap = make(map[repo.GetLabelParam]*entity.PromptLabel, len(pairLabelPOMap))
+	for _, query := range queries {
+		labelPO, ok := pairLabelPOMap[mysql.PromptIDLabelKeyPair{PromptID: query.PromptID, LabelKey: query.LabelKey}]
+		if !ok {
+			continue
+		}
+		labelDOMap[query] = convertor.LabelPO2DO(labelPO)
+	}
+	return labelDOMap, nil
+}
+
+func (d *LabelRepoImpl) GetLabelHistory(ctx context.Context, historyID int64) (historyDO *entity.PromptLabelHistory, err error) {
+	historyPO, err := d.promptLabelHistoryDAO.Get(ctx, historyID)
+	if err != nil {
+		return nil, err
+	}
+	if historyPO == nil {
+		return nil, errorx.NewByCode(prompterr.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("label history id = %d", historyID)))
+	}
+	return convertor.LabelHistoryPO2DO(historyPO), nil
+}
+
+func (d *LabelRepoImpl) ListLabelHistory(ctx context.Context, param repo.ListLabelHistoryParam) (result *repo.ListLabelHistoryResult, err error) {
+	if param.PromptID <= 0 || param.PageSize <= 0 {
+		return nil, errorx.New("Param(PromptID or PageSize) is invalid, param = %s", json.Jsonify(param))
+	}
+	historyPOs, err := d.promptLabelHistoryDAO.List(ctx, mysql.ListLabelHistoryParam{
+		PromptID: param.PromptID,
+		LabelKey: param.LabelKey,
+
+		Cursor: param.PageToken,
+		Limit:  param.PageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result = &repo.ListLabelHistoryResult{}
+	if len(historyPOs) <= param.PageSize {
+		result.HistoryDOs = convertor.BatchLabelHistoryPO2DO(historyPOs)
+		return result, nil
+	}
+	result.NextPageToken = historyPOs[param.PageSize].ID
+	result.HistoryDOs = convertor.BatchLabelHistoryPO2DO(historyPOs[:param.PageSize])
+	return result, nil
+}
@@ -0,0 +1,457 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	dbmocks "github.com/coze-dev/coze-loop/backend/infra/db/mocks"
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+	daomocks "github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/unittest"
+)
+
+func TestLabelRepoImpl_SetLabel(t *testing.T) {
+	type fields struct {
+		db                    db.Provider
+		idgen                 idgen.IIDGenerator
+		promptCommitDAO       mysql.IPromptCommitDAO
+		promptLabelDAO        mysql.IPromptLabelDAO
+		promptLabelHistoryDAO mysql.IPromptLabelHistoryDAO
+	}
+	type args struct {
+		ctx   context.Context
+		param repo.SetLabelParam
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		want         *entity.PromptLabel
+		wantErr      error
+	}{
+		{
+			name: "invalid param",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: repo.SetLabelParam{
+					PromptID: 1,
+					UserID:   "user",
+					LabelKey: "prod",
+				},
+			},
+			want:    nil,
+			wantErr: errorx.New("Param(PromptID or LabelKey or CommitVersion or UserID) is invalid, param = {\"SpaceID\":0,\"PromptID\":1,\"UserID\":\"user\",\"LabelKey\":\"prod\",\"CommitVersion\":\"\",\"Operation\":\"\"}"),
+		},
+		{
+			name: "commit version not exist",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockIDGen := idgenmocks.NewMockIIDGenerator(ctrl)
+				mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{10, 20}, nil)
+
+				mockDB := dbmocks.NewMockProvider(ctrl)
+				mockDB.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fc func(*gorm.DB) error, opts ...db.Option) error {
+					return fc(nil)
+				})
+
+				mockCommitDAO := daomocks.NewMockIPromptCommitDAO(ctrl)
+				mockCommitDAO.EXPECT().Get(gomock.Any(), int64(1), "1.0.0", gomock.Any()).Return(nil, nil)
+
+				return fields{
+					db:              mockDB,
+					idgen:           mockIDGen,
+					promptCommitDAO: mockCommitDAO,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: repo.SetLabelParam{
+					SpaceID:       100,
+					PromptID:      1,
+					UserID:        "user",
+					LabelKey:      "prod",
+					CommitVersion: "1.0.0",
+					Operation:     entity.PromptLabelOperationSet,
+				},
+			},
+			want:    nil,
+			wantErr: errorx.NewByCode(errno.PromptVersionNotExistCode),
+		},
+		{
+			name: "create new label",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockIDGen := idgenmocks.NewMockIIDGenerator(ctrl)
+				mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{10, 20}, nil)
+
+				mockDB := dbmocks.NewMockProvider(ctrl)
+				mockDB.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fc func(*gorm.DB) error, opts ...db.Option) error {
+					return fc(nil)
+				})
+
+				mockCommitDAO := daomocks.NewMockIPromptCommitDAO(ctrl)
+				mockCommitDAO.EXPECT().Get(gomock.Any(), int64(1), "1.0.0", gomock.Any()).Return(&model.PromptCommit{PromptID: 1, Version: "1.0.0"}, nil)
+
+				mockLabelDAO := daomocks.NewMockIPromptLabelDAO(ctrl)
+				mockLabelDAO.EXPECT().Get(gomock.Any(), int64(1), "prod", gomock.Any()).Return(nil, nil)
+				mockLabelDAO.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, labelPO *model.PromptLabel, timeNow time.Time, opts ...db.Option) error {
+						assert.Equal(t, int64(10), labelPO.ID)
+						assert.Equal(t, "1.0.0", labelPO.Version)
+						return nil
+					})
+
+				mockHistoryDAO := daomocks.NewMockIPromptLabelHistoryDAO(ctrl)
+				mockHistoryDAO.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, historyPO *model.PromptLabelHistory, timeNow time.Time, opts ...db.Option) error {
+						assert.Equal(t, int64(20), historyPO.ID)
+						assert.Equal(t, "", historyPO.PreviousVersion)
+						assert.Equal(t, "set", historyPO.Operation)
+						return nil
+					})
+
+				return fields{
+					db:                    mockDB,
+					idgen:                 mockIDGen,
+					promptCommitDAO:       mockCommitDAO,
+					promptLabelDAO:        mockLabelDAO,
+					promptLabelHistoryDAO: mockHistoryDAO,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: repo.SetLabelParam{
+					SpaceID:       100,
+					PromptID:      1,
+					UserID:        "user",
+					LabelKey:      "prod",
+					CommitVersion: "1.0.0",
+					Operation:     entity.PromptLabelOperationSet,
+				},
+			},
+			want: &entity.PromptLabel{
+				PromptID:      1,
+				LabelKey:      "prod",
+				CommitVersion: "1.0.0",
+				CreatedBy:     "user",
+				UpdatedBy:     "user",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "move existing label",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockIDGen := idgenmocks.NewMockIIDGenerator(ctrl)
+				mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{10, 20}, nil)
+
+				mockDB := dbmocks.NewMockProvider(ctrl)
+				mockDB.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fc func(*gorm.DB) error, opts ...db.Option) error {
+					return fc(nil)
+				})
+
+				mockCommitDAO := daomocks.NewMockIPromptCommitDAO(ctrl)
+				mockCommitDAO.EXPECT().Get(gomock.Any(), int64(1), "1.0.1", gomock.Any()).Return(&model.PromptCommit{PromptID: 1, Version: "1.0.1"}, nil)
+
+				mockLabelDAO := daomocks.NewMockIPromptLabelDAO(ctrl)
+				mockLabelDAO.EXPECT().Get(gomock.Any(), int64(1), "prod", gomock.Any()).Return(&model.PromptLabel{
+					ID:        5,
+					SpaceID:   100,
+					PromptID:  1,
+					LabelKey:  "prod",
+					Version:   "1.0.0",
+					CreatedBy: "creator",
+					UpdatedBy: "creator",
+				}, nil)
+				mockLabelDAO.EXPECT().Update(gomock.Any(), int64(5), int64(1), gomock.Any(), gomock.Any()).Return(nil)
+
+				mockHistoryDAO := daomocks.NewMockIPromptLabelHistoryDAO(ctrl)
+				mockHistoryDAO.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, historyPO *model.PromptLabelHistory, timeNow time.Time, opts ...db.Option) error {
+						assert.Equal(t, "1.0.0", historyPO.PreviousVersion)
+						assert.Equal(t, "1.0.1", historyPO.Version)
+						assert.Equal(t, "rollback", historyPO.Operation)
+						return nil
+					})
+
+				return fields{
+					db:                    mockDB,
+					idgen:                 mockIDGen,
+					promptCommitDAO:       mockCommitDAO,
+					promptLabelDAO:        mockLabelDAO,
+					promptLabelHistoryDAO: mockHistoryDAO,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: repo.SetLabelParam{
+					SpaceID:       100,
+					PromptID:      1,
+					UserID:        "user",
+					LabelKey:      "prod",
+					CommitVersion: "1.0.1",
+					Operation:     entity.PromptLabelOperationRollback,
+				},
+			},
+			want: &entity.PromptLabel{
+				PromptID:      1,
+				LabelKey:      "prod",
+				CommitVersion: "1.0.1",
+				CreatedBy:     "creator",
+				UpdatedBy:     "user",
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			d := &LabelRepoImpl{
+				db:                    ttFields.db,
+				idgen:                 ttFields.idgen,
+				promptCommitDAO:       ttFields.promptCommitDAO,
+				promptLabelDAO:        ttFields.promptLabelDAO,
+				promptLabelHistoryDAO: ttFields.promptLabelHistoryDAO,
+			}
+
+			got, err := d.SetLabel(tt.args.ctx, tt.args.param)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.want.PromptID, got.PromptID)
+				assert.Equal(t, tt.want.LabelKey, got.LabelKey)
+				assert.Equal(t, tt.want.CommitVersion, got.CommitVersion)
+				assert.Equal(t, tt.want.CreatedBy, got.CreatedBy)
+				assert.Equal(t, tt.want.UpdatedBy, got.UpdatedBy)
+			}
+		})
+	}
+}
+
+func TestLabelRepoImpl_MGetLabel(t *testing.T) {
+	type fields struct {
+		promptLabelDAO mysql.IPromptLabelDAO
+	}
+	type args struct {
+		ctx     context.Context
+		queries []repo.GetLabelParam
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		want         map[repo.GetLabelParam]*entity.PromptLabel
+		wantErr      error
+	}{
+		{
+			name: "empty queries",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name: "dao error",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockLabelDAO := daomocks.NewMockIPromptLabelDAO(ctrl)
+				mockLabelDAO.EXPECT().MGet(gomock.Any(), gomock.Any()).Return(nil, errorx.NewByCode(errno.CommonMySqlErrorCode))
+				return fields{
+					promptLabelDAO: mockLabelDAO,
+				}
+			},
+			args: args{
+				ctx:     context.Background(),
+				queries: []repo.GetLabelParam{{PromptID: 1, LabelKey: "prod"}},
+			},
+			want:    nil,
+			wantErr: errorx.NewByCode(errno.CommonMySqlErrorCode),
+		},
+		{
+			name: "partial found",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockLabelDAO := daomocks.NewMockIPromptLabelDAO(ctrl)
+				mockLabelDAO.EXPECT().MGet(gomock.Any(), []mysql.PromptIDLabelKeyPair{
+					{PromptID: 1, LabelKey: "prod"},
+					{PromptID: 2, LabelKey: "prod"},
+				}).Return(map[mysql.PromptIDLabelKeyPair]*model.PromptLabel{
+					{PromptID: 1, LabelKey: "prod"}: {
+						ID:       5,
+						PromptID: 1,
+						LabelKey: "prod",
+						Version:  "1.0.0",
+					},
+				}, nil)
+				return fields{
+					promptLabelDAO: mockLabelDAO,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				queries: []repo.GetLabelParam{
+					{PromptID: 1, LabelKey: "prod"},
+					{PromptID: 2, LabelKey: "prod"},
+				},
+			},
+			want: map[repo.GetLabelParam]*entity.PromptLabel{
+				{PromptID: 1, LabelKey: "prod"}: {
+					PromptID:      1,
+					LabelKey:      "prod",
+					CommitVersion: "1.0.0",
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			d := &LabelRepoImpl{
+				promptLabelDAO: ttFields.promptLabelDAO,
+			}
+
+			got, err := d.MGetLabel(tt.args.ctx, tt.args.queries)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLabelRepoImpl_ListLabelHistory(t *testing.T) {
+	type fields struct {
+		promptLabelHistoryDAO mysql.IPromptLabelHistoryDAO
+	}
+	type args struct {
+		ctx   context.Context
+		param repo.ListLabelHistoryParam
+	}
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		args         args
+		want         *repo.ListLabelHistoryResult
+		wantErr      error
+	}{
+		{
+			name: "invalid page size",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: repo.ListLabelHistoryParam{
+					PromptID: 1,
+				},
+			},
+			want:    nil,
+			wantErr: errorx.New("Param(PromptID or PageSize) is invalid, param = {\"PromptID\":1,\"LabelKey\":\"\",\"PageSize\":0,\"PageToken\":null}"),
+		},
+		{
+			name: "has more",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockHistoryDAO := daomocks.NewMockIPromptLabelHistoryDAO(ctrl)
+				mockHistoryDAO.EXPECT().List(gomock.Any(), mysql.ListLabelHistoryParam{
+					PromptID: 1,
+					LabelKey: "prod",
+					Cursor:   ptr.Of(int64(30)),
+					Limit:    2,
+				}).Return([]*model.PromptLabelHistory{
+					{ID: 30, PromptID: 1, LabelKey: "prod", Version: "1.0.1", PreviousVersion: "1.0.0", Operation: "set"},
+					{ID: 20, PromptID: 1, LabelKey: "prod", Version: "1.0.0", Operation: "set"},
+				}, nil)
+				return fields{
+					promptLabelHistoryDAO: mockHistoryDAO,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: repo.ListLabelHistoryParam{
+					PromptID:  1,
+					LabelKey:  "prod",
+					PageSize:  1,
+					PageToken: ptr.Of(int64(30)),
+				},
+			},
+			want: &repo.ListLabelHistoryResult{
+				HistoryDOs: []*entity.PromptLabelHistory{
+					{
+						ID:                    30,
+						PromptID:              1,
+						LabelKey:              "prod",
+						CommitVersion:         "1.0.1",
+						PreviousCommitVersion: "1.0.0",
+						Operation:             entity.PromptLabelOperationSet,
+					},
+				},
+				NextPageToken: 20,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "no more",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockHistoryDAO := daomocks.NewMockIPromptLabelHistoryDAO(ctrl)
+				mockHistoryDAO.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil)
+				return fields{
+					promptLabelHistoryDAO: mockHistoryDAO,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: repo.ListLabelHistoryParam{
+					PromptID: 1,
+					PageSize: 10,
+				},
+			},
+			want:    &repo.ListLabelHistoryResult{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			d := &LabelRepoImpl{
+				promptLabelHistoryDAO: ttFields.promptLabelHistoryDAO,
+			}
+
+			got, err := d.ListLabelHistory(tt.args.ctx, tt.args.param)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -38,6 +38,8 @@ type ManageRepoImpl struct {
 	promptCommitDAO mysql.IPromptCommitDAO
 	promptDraftDAO  mysql.IPromptUserDraftDAO
 
+	promptSnippetRefDAO mysql.IPromptSnippetRefDAO
+
 	promptBasicCacheDAO redis.IPromptBasicDAO
 	promptCacheDAO      redis.IPromptDAO
 
@@ -51,6 +53,7 @@ func NewManageRepo(
 	promptBasicDao mysql.IPromptBasicDAO,
 	promptCommitDao mysql.IPromptCommitDAO,
 	promptDraftDao mysql.IPromptUserDraftDAO,
+	promptSnippetRefDao mysql.IPromptSnippetRefDAO,
 	promptBasicCacheDAO redis.IPromptBasicDAO,
 	promptCacheDAO redis.IPromptDAO,
 ) repo.IManageRepo {
@@ -60,6 +63,7 @@ func NewManageRepo(
 		promptBasicDAO:      promptBasicDao,
 		promptCommitDAO:     promptCommitDao,
 		promptDraftDAO:      promptDraftDao,
+		promptSnippetRefDAO: promptSnippetRefDao,
 		promptBasicCacheDAO: promptBasicCacheDAO,
 		promptCacheDAO:      promptCacheDAO,
 		promptCacheMetrics:  metricsinfra.NewPromptCacheMetrics(meter),
@@ -118,7 +122,16 @@ func (d *ManageRepoImpl) DeletePrompt(ctx context.Context, promptID int64) (err
 	if promptBasicPO == nil {
 		return errorx.NewByCode(prompterr.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("prompt is not found, prompt id = %d", promptID)))
 	}
-	err = d.promptBasicDAO.Delete(ctx, promptID)
+	err = d.db.Transaction(ctx, func(tx *gorm.DB) error {
+		opt := db.WithTransaction(tx)
+
+		err = d.promptBasicDAO.Delete(ctx, promptID, opt)
+		if err != nil {
+			return err
+		}
+		// 删除后不再作为引用方，避免阻止被引用片段的删除
+		return d.promptSnippetRefDAO.DeleteByPromptID(ctx, promptID, opt)
+	})
 	if err != nil {
 		return err
 	}
@@ -405,6 +418,7 @@ func (d *ManageRepoImpl) ListPrompt(ctx context.Context, param repo.ListPromptPa
 		KeyWord:       param.KeyWord,
 		CreatedBys:    param.CreatedBys,
 		CommittedOnly: param.CommittedOnly,
+		PromptTypes:   param.PromptTypes,
 
 		Offset:  (param.PageNum - 1) * param.PageSize,
 		Limit:   param.PageSize,
@@ -621,6 +635,10 @@ func (d *ManageRepoImpl) CommitDraft(ctx context.Context, param repo.CommitDraft
 		if err != nil {
 			return err
 		}
+		err = d.createSnippetRefs(ctx, basicPO, param.CommitVersion, draftDO.PromptDetail, timeNow, opt)
+		if err != nil {
+			return err
+		}
 		err = d.promptDraftDAO.Delete(ctx, draftPO.ID, opt)
 		if err != nil {
 			return err
@@ -647,6 +665,44 @@ func (d *ManageRepoImpl) CommitDraft(ctx context.Context, param repo.CommitDraft
 	return nil
 }
 
+// createSnippetRefs 记录提交版本引用的片段，用于反查片段被哪些Prompt使用
+func (d *ManageRepoImpl) createSnippetRefs(ctx context.Context, basicPO *model.PromptBasic, commitVersion string, detail *entity.PromptDetail, timeNow time.Time, opts ...db.Option) error {
+	refs := detail.GetSnippetRefs()
+	if len(refs) == 0 {
+		return nil
+	}
+	snippetKeys := lo.Uniq(lo.Map(refs, func(ref entity.SnippetRef, _ int) string { return ref.PromptKey }))
+	snippetPOs, err := d.promptBasicDAO.MGetByPromptKey(ctx, basicPO.SpaceID, snippetKeys, opts...)
+	if err != nil {
+		return err
+	}
+	snippetKeyIDMap := make(map[string]int64, len(snippetPOs))
+	for _, snippetPO := range snippetPOs {
+		snippetKeyIDMap[snippetPO.PromptKey] = snippetPO.ID
+	}
+	ids, err := d.idgen.GenMultiIDs(ctx, len(refs))
+	if err != nil {
+		return err
+	}
+	refPOs := make([]*model.PromptSnippetRef, 0, len(refs))
+	for i, ref := range refs {
+		snippetPromptID, ok := snippetKeyIDMap[ref.PromptKey]
+		if !ok {
+			return errorx.NewByCode(prompterr.PromptSnippetInvalidCode, errorx.WithExtraMsg(fmt.Sprintf("snippet prompt key: %s not found", ref.PromptKey)))
+		}
+		refPOs = append(refPOs, &model.PromptSnippetRef{
+			ID:              ids[i],
+			SpaceID:         basicPO.SpaceID,
+			PromptID:        basicPO.ID,
+			PromptKey:       basicPO.PromptKey,
+			Version:         commitVersion,
+			SnippetPromptID: snippetPromptID,
+			SnippetVersion:  ref.Version,
+		})
+	}
+	return d.promptSnippetRefDAO.BatchCreate(ctx, refPOs, timeNow, opts...)
+}
+
 func (d *ManageRepoImpl) ListCommitInfo(ctx context.Context, param repo.ListCommitInfoParam) (result *repo.ListCommitResult, err error) {
 	if param.PromptID <= 0 || param.PageSize <= 0 {
 		return nil, errorx.New("Param(PromptID or PageSize) is invalid, param = %s", json.Jsonify(param))
@@ -678,3 +734,25 @@ func (d *ManageRepoImpl) ListCommitInfo(ctx context.Context, param repo.ListComm
 	result.CommitInfoDOs = commitInfoDOs[:len(commitPOs)-1]
 	return result, nil
 }
+
+func (d *ManageRepoImpl) ListSnippetReference(ctx context.Context, param repo.ListSnippetReferenceParam) (result *repo.ListSnippetReferenceResult, err error) {
+	if param.SnippetPromptID <= 0 || param.PageSize <= 0 {
+		return nil, errorx.New("Param(SnippetPromptID or PageSize) is invalid, param = %s", json.Jsonify(param))
+	}
+	refPOs, err := d.promptSnippetRefDAO.List(ctx, mysql.ListSnippetRefParam{
+		SnippetPromptID: param.SnippetPromptID,
+		Cursor:          param.PageToken,
+		Limit:           param.PageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result = &repo.ListSnippetReferenceResult{}
+	if len(refPOs) <= param.PageSize {
+		result.ReferenceDOs = convertor.BatchSnippetRefPO2DO(refPOs)
+		return result, nil
+	}
+	result.NextPageToken = refPOs[param.PageSize].ID
+	result.ReferenceDOs = convertor.BatchSnippetRefPO2DO(refPOs[:param.PageSize])
+	return result, nil
+}
@@ -2352,6 +2352,7 @@ func TestManageRepoImpl_CommitDraft(t *testing.T) {
 		promptBasicDAO      mysql.IPromptBasicDAO
 		promptCommitDAO     mysql.IPromptCommitDAO
 		promptDraftDAO      mysql.IPromptUserDraftDAO
+		promptSnippetRefDAO mysql.IPromptSnippetRefDAO
 		promptBasicCacheDAO redis.IPromptBasicDAO
 		promptCacheDAO      redis.IPromptDAO
 	}
@@ -2771,6 +2772,71 @@ func TestManageRepoImpl_CommitDraft(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "success with snippet references",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockIDGen := idgenmocks.NewMockIIDGenerator(ctrl)
+				mockIDGen.EXPECT().GenID(gomock.Any()).Return(int64(1001), nil)
+				mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{2001, 2002}, nil)
+
+				mockDB := dbmocks.NewMockProvider(ctrl)
+				mockDB.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fc func(*gorm.DB) error, opts ...db.Option) error {
+					return fc(nil)
+				})
+				nilDB, _ := gorm.Open(nil)
+				mockDB.EXPECT().NewSession(gomock.Any(), gomock.Any()).Return(nilDB)
+
+				mockBasicDAO := daomocks.NewMockIPromptBasicDAO(ctrl)
+				mockBasicDAO.EXPECT().Get(gomock.Any(), int64(1), true, gomock.Any()).Return(&model.PromptBasic{
+					ID:        1,
+					SpaceID:   100,
+					PromptKey: "test_key",
+				}, nil)
+				mockBasicDAO.EXPECT().MGetByPromptKey(gomock.Any(), int64(100), []string{"persona"}, gomock.Any()).Return([]*model.PromptBasic{
+					{ID: 2, SpaceID: 100, PromptKey: "persona"},
+				}, nil)
+
+				mockDraftDAO := daomocks.NewMockIPromptUserDraftDAO(ctrl)
+				mockDraftDAO.EXPECT().Get(gomock.Any(), int64(1), "test_user", gomock.Any()).Return(&model.PromptUserDraft{
+					ID:       1001,
+					Messages: ptr.Of(`[{"role":"system","content":"{{#snippet:persona@prod}} {{#snippet:persona@1.0.0}}"}]`),
+				}, nil)
+				mockDraftDAO.EXPECT().Delete(gomock.Any(), int64(1001), gomock.Any()).Return(nil)
+
+				mockCommitDAO := daomocks.NewMockIPromptCommitDAO(ctrl)
+				mockCommitDAO.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+				mockSnippetRefDAO := daomocks.NewMockIPromptSnippetRefDAO(ctrl)
+				mockSnippetRefDAO.EXPECT().BatchCreate(gomock.Any(), []*model.PromptSnippetRef{
+					{ID: 2001, SpaceID: 100, PromptID: 1, PromptKey: "test_key", Version: "2.0.0", SnippetPromptID: 2, SnippetVersion: "prod"},
+					{ID: 2002, SpaceID: 100, PromptID: 1, PromptKey: "test_key", Version: "2.0.0", SnippetPromptID: 2, SnippetVersion: "1.0.0"},
+				}, gomock.Any(), gomock.Any()).Return(nil)
+
+				mockBasicDAO.EXPECT().Update(gomock.Any(), int64(1), gomock.Any(), gomock.Any()).Return(nil)
+
+				mockPromptBasicCacheDAO := redismocks.NewMockIPromptBasicDAO(ctrl)
+				mockPromptBasicCacheDAO.EXPECT().DelByPromptKey(gomock.Any(), int64(100), "test_key").Return(nil)
+
+				return fields{
+					db:                  mockDB,
+					idgen:               mockIDGen,
+					promptBasicDAO:      mockBasicDAO,
+					promptDraftDAO:      mockDraftDAO,
+					promptCommitDAO:     mockCommitDAO,
+					promptSnippetRefDAO: mockSnippetRefDAO,
+					promptBasicCacheDAO: mockPromptBasicCacheDAO,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: repo.CommitDraftParam{
+					PromptID:      1,
+					UserID:        "test_user",
+					CommitVersion: "2.0.0",
+				},
+			},
+			wantErr: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2786,6 +2852,7 @@ func TestManageRepoImpl_CommitDraft(t *testing.T) {
 				promptBasicDAO:      ttFields.promptBasicDAO,
 				promptCommitDAO:     ttFields.promptCommitDAO,
 				promptDraftDAO:      ttFields.promptDraftDAO,
+				promptSnippetRefDAO: ttFields.promptSnippetRefDAO,
 				promptBasicCacheDAO: ttFields.promptBasicCacheDAO,
 				promptCacheDAO:      ttFields.promptCacheDAO,
 			}
@@ -2826,7 +2893,7 @@ func TestManageRepoImpl_ListPrompt(t *testing.T) {
 				},
 			},
 			want:    nil,
-			wantErr: errorx.New("param(SpaceID or PageNum or PageSize) is invalid, param = {\"SpaceID\":0,\"KeyWord\":\"\",\"CreatedBys\":null,\"UserID\":\"\",\"CommittedOnly\":false,\"PageNum\":1,\"PageSize\":10,\"OrderBy\":0,\"Asc\":false}"),
+			wantErr: errorx.New("param(SpaceID or PageNum or PageSize) is invalid, param = {\"SpaceID\":0,\"KeyWord\":\"\",\"CreatedBys\":null,\"UserID\":\"\",\"CommittedOnly\":false,\"PromptTypes\":null,\"PageNum\":1,\"PageSize\":10,\"OrderBy\":0,\"Asc\":false}"),
 		},
 		{
 			name: "invalid page num",
@@ -2842,7 +2909,7 @@ func TestManageRepoImpl_ListPrompt(t *testing.T) {
 				},
 			},
 			want:    nil,
-			wantErr: errorx.New("param(SpaceID or PageNum or PageSize) is invalid, param = {\"SpaceID\":123,\"KeyWord\":\"\",\"CreatedBys\":null,\"UserID\":\"\",\"CommittedOnly\":false,\"PageNum\":0,\"PageSize\":10,\"OrderBy\":0,\"Asc\":false}"),
+			wantErr: errorx.New("param(SpaceID or PageNum or PageSize) is invalid, param = {\"SpaceID\":123,\"KeyWord\":\"\",\"CreatedBys\":null,\"UserID\":\"\",\"CommittedOnly\":false,\"PromptTypes\":null,\"PageNum\":0,\"PageSize\":10,\"OrderBy\":0,\"Asc\":false}"),
 		},
 		{
 			name: "invalid page size",
@@ -2858,7 +2925,7 @@ func TestManageRepoImpl_ListPrompt(t *testing.T) {
 				},
 			},
 			want:    nil,
-			wantErr: errorx.New("param(SpaceID or PageNum or PageSize) is invalid, param = {\"SpaceID\":123,\"KeyWord\":\"\",\"CreatedBys\":null,\"UserID\":\"\",\"CommittedOnly\":false,\"PageNum\":1,\"PageSize\":0,\"OrderBy\":0,\"Asc\":false}"),
+			wantErr: errorx.New("param(SpaceID or PageNum or PageSize) is invalid, param = {\"SpaceID\":123,\"KeyWord\":\"\",\"CreatedBys\":null,\"UserID\":\"\",\"CommittedOnly\":false,\"PromptTypes\":null,\"PageNum\":1,\"PageSize\":0,\"OrderBy\":0,\"Asc\":false}"),
 		},
 		{
 			name: "empty result",
@@ -3227,3 +3294,86 @@ func TestManageRepoImpl_ListPrompt(t *testing.T) {
 		})
 	}
 }
+
+func TestManageRepoImpl_ListSnippetReference(t *testing.T) {
+	type fields struct {
+		promptSnippetRefDAO mysql.IPromptSnippetRefDAO
+	}
+	createdAt := time.Now()
+	tests := []struct {
+		name         string
+		fieldsGetter func(ctrl *gomock.Controller) fields
+		param        repo.ListSnippetReferenceParam
+		want         *repo.ListSnippetReferenceResult
+		wantErr      error
+	}{
+		{
+			name: "invalid snippet prompt id",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				return fields{}
+			},
+			param:   repo.ListSnippetReferenceParam{PageSize: 10},
+			wantErr: errorx.New("Param(SnippetPromptID or PageSize) is invalid, param = {\"SnippetPromptID\":0,\"PageSize\":10,\"PageToken\":null}"),
+		},
+		{
+			name: "last page",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockSnippetRefDAO := daomocks.NewMockIPromptSnippetRefDAO(ctrl)
+				mockSnippetRefDAO.EXPECT().List(gomock.Any(), mysql.ListSnippetRefParam{
+					SnippetPromptID: 2,
+					Limit:           3,
+				}).Return([]*model.PromptSnippetRef{
+					{ID: 20, SpaceID: 100, PromptID: 1, PromptKey: "a", Version: "1.0.0", SnippetPromptID: 2, SnippetVersion: "prod", CreatedAt: createdAt},
+				}, nil)
+				return fields{promptSnippetRefDAO: mockSnippetRefDAO}
+			},
+			param: repo.ListSnippetReferenceParam{SnippetPromptID: 2, PageSize: 2},
+			want: &repo.ListSnippetReferenceResult{
+				ReferenceDOs: []*entity.PromptSnippetReference{
+					{ID: 20, SpaceID: 100, PromptID: 1, PromptKey: "a", CommitVersion: "1.0.0", SnippetPromptID: 2, SnippetVersion: "prod", CreatedAt: createdAt},
+				},
+			},
+		},
+		{
+			name: "has more",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockSnippetRefDAO := daomocks.NewMockIPromptSnippetRefDAO(ctrl)
+				mockSnippetRefDAO.EXPECT().List(gomock.Any(), mysql.ListSnippetRefParam{
+					SnippetPromptID: 2,
+					Cursor:          ptr.Of(int64(30)),
+					Limit:           2,
+				}).Return([]*model.PromptSnippetRef{
+					{ID: 30, SpaceID: 100, PromptID: 1, PromptKey: "a", Version: "1.0.1", SnippetPromptID: 2, SnippetVersion: "1.0.0", CreatedAt: createdAt},
+					{ID: 20, SpaceID: 100, PromptID: 1, PromptKey: "a", Version: "1.0.0", SnippetPromptID: 2, SnippetVersion: "prod", CreatedAt: createdAt},
+				}, nil)
+				return fields{promptSnippetRefDAO: mockSnippetRefDAO}
+			},
+			param: repo.ListSnippetReferenceParam{SnippetPromptID: 2, PageSize: 1, PageToken: ptr.Of(int64(30))},
+			want: &repo.ListSnippetReferenceResult{
+				ReferenceDOs: []*entity.PromptSnippetReference{
+					{ID: 30, SpaceID: 100, PromptID: 1, PromptKey: "a", CommitVersion: "1.0.1", SnippetPromptID: 2, SnippetVersion: "1.0.0", CreatedAt: createdAt},
+				},
+				NextPageToken: 20,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ttFields := tt.fieldsGetter(ctrl)
+
+			d := &ManageRepoImpl{
+				promptSnippetRefDAO: ttFields.promptSnippetRefDAO,
+			}
+
+			got, err := d.ListSnippetReference(context.Background(), tt.param)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
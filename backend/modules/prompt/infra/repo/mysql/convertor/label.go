@@ -0,0 +1,68 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+)
+
+func BatchLabelPO2DO(pos []*model.PromptLabel) []*entity.PromptLabel {
+	if pos == nil {
+		return nil
+	}
+	labels := make([]*entity.PromptLabel, 0, len(pos))
+	for _, po := range pos {
+		if po == nil {
+			continue
+		}
+		labels = append(labels, LabelPO2DO(po))
+	}
+	return labels
+}
+
+func LabelPO2DO(po *model.PromptLabel) *entity.PromptLabel {
+	if po == nil {
+		return nil
+	}
+	return &entity.PromptLabel{
+		PromptID:      po.PromptID,
+		LabelKey:      po.LabelKey,
+		CommitVersion: po.Version,
+		CreatedBy:     po.CreatedBy,
+		UpdatedBy:     po.UpdatedBy,
+		CreatedAt:     po.CreatedAt,
+		UpdatedAt:     po.UpdatedAt,
+	}
+}
+
+func BatchLabelHistoryPO2DO(pos []*model.PromptLabelHistory) []*entity.PromptLabelHistory {
+	if pos == nil {
+		return nil
+	}
+	histories := make([]*entity.PromptLabelHistory, 0, len(pos))
+	for _, po := range pos {
+		if po == nil {
+			continue
+		}
+		histories = append(histories, LabelHistoryPO2DO(po))
+	}
+	return histories
+}
+
+func LabelHistoryPO2DO(po *model.PromptLabelHistory) *entity.PromptLabelHistory {
+	if po == nil {
+		return nil
+	}
+	return &entity.PromptLabelHistory{
+		ID:                    po.ID,
+		PromptID:              po.PromptID,
+		LabelKey:              po.LabelKey,
+		CommitVersion:         po.Version,
+		PreviousCommitVersion: po.PreviousVersion,
+		Operation:             entity.PromptLabelOperation(po.Operation),
+		OperatedBy:            po.OperatedBy,
+		OperatedAt:            po.CreatedAt,
+	}
+}
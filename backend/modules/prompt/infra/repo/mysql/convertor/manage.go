@@ -73,6 +73,7 @@ func BasicPO2DO(promptPO *model.PromptBasic) *entity.PromptBasic {
 	return &entity.PromptBasic{
 		DisplayName:       promptPO.Name,
 		Description:       promptPO.Description,
+		PromptType:        entity.PromptType(promptPO.PromptType),
 		LatestVersion:     promptPO.LatestVersion,
 		CreatedBy:         promptPO.CreatedBy,
 		UpdatedBy:         promptPO.UpdatedBy,
@@ -142,6 +143,7 @@ func PromptDO2BasicPO(do *entity.Prompt) *model.PromptBasic {
 		PromptKey:     do.PromptKey,
 		Name:          do.PromptBasic.DisplayName,
 		Description:   do.PromptBasic.Description,
+		PromptType:    string(do.PromptBasic.PromptType),
 		CreatedBy:     do.PromptBasic.CreatedBy,
 		UpdatedBy:     do.PromptBasic.UpdatedBy,
 		LatestVersion: do.PromptBasic.LatestVersion,
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+)
+
+func BatchSnippetRefPO2DO(pos []*model.PromptSnippetRef) []*entity.PromptSnippetReference {
+	if pos == nil {
+		return nil
+	}
+	refs := make([]*entity.PromptSnippetReference, 0, len(pos))
+	for _, po := range pos {
+		if po == nil {
+			continue
+		}
+		refs = append(refs, SnippetRefPO2DO(po))
+	}
+	return refs
+}
+
+func SnippetRefPO2DO(po *model.PromptSnippetRef) *entity.PromptSnippetReference {
+	if po == nil {
+		return nil
+	}
+	return &entity.PromptSnippetReference{
+		ID:              po.ID,
+		SpaceID:         po.SpaceID,
+		PromptID:        po.PromptID,
+		PromptKey:       po.PromptKey,
+		CommitVersion:   po.Version,
+		SnippetPromptID: po.SnippetPromptID,
+		SnippetVersion:  po.SnippetVersion,
+		CreatedAt:       po.CreatedAt,
+	}
+}
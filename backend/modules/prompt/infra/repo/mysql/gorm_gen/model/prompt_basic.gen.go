@@ -19,6 +19,7 @@ type PromptBasic struct {
 	PromptKey        string                `gorm:"column:prompt_key;type:varchar(128);not null;uniqueIndex:uniq_space_id_prompt_key_deleted_at,priority:2;comment:Prompt key" json:"prompt_key"`                                               // Prompt key
 	Name             string                `gorm:"column:name;type:varchar(128);not null;comment:Prompt名称" json:"name"`                                                                                                                        // Prompt名称
 	Description      string                `gorm:"column:description;type:varchar(1024);not null;comment:描述" json:"description"`                                                                                                               // 描述
+	PromptType       string                `gorm:"column:prompt_type;type:varchar(64);not null;default:normal;comment:Prompt类型" json:"prompt_type"`                                                                                            // Prompt类型
 	CreatedBy        string                `gorm:"column:created_by;type:varchar(128);not null;comment:创建人" json:"created_by"`                                                                                                                 // 创建人
 	UpdatedBy        string                `gorm:"column:updated_by;type:varchar(128);not null;comment:更新人" json:"updated_by"`                                                                                                                 // 更新人
 	LatestVersion    string                `gorm:"column:latest_version;type:varchar(128);not null;comment:最新版本" json:"latest_version"`                                                                                                        // 最新版本
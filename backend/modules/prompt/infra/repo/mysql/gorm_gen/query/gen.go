@@ -22,6 +22,9 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		PromptCommit:       newPromptCommit(db, opts...),
 		PromptDebugContext: newPromptDebugContext(db, opts...),
 		PromptDebugLog:     newPromptDebugLog(db, opts...),
+		PromptLabel:        newPromptLabel(db, opts...),
+		PromptLabelHistory: newPromptLabelHistory(db, opts...),
+		PromptSnippetRef:   newPromptSnippetRef(db, opts...),
 		PromptUserDraft:    newPromptUserDraft(db, opts...),
 	}
 }
@@ -33,6 +36,9 @@ type Query struct {
 	PromptCommit       promptCommit
 	PromptDebugContext promptDebugContext
 	PromptDebugLog     promptDebugLog
+	PromptLabel        promptLabel
+	PromptLabelHistory promptLabelHistory
+	PromptSnippetRef   promptSnippetRef
 	PromptUserDraft    promptUserDraft
 }
 
@@ -45,6 +51,9 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		PromptCommit:       q.PromptCommit.clone(db),
 		PromptDebugContext: q.PromptDebugContext.clone(db),
 		PromptDebugLog:     q.PromptDebugLog.clone(db),
+		PromptLabel:        q.PromptLabel.clone(db),
+		PromptLabelHistory: q.PromptLabelHistory.clone(db),
+		PromptSnippetRef:   q.PromptSnippetRef.clone(db),
 		PromptUserDraft:    q.PromptUserDraft.clone(db),
 	}
 }
@@ -64,6 +73,9 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		PromptCommit:       q.PromptCommit.replaceDB(db),
 		PromptDebugContext: q.PromptDebugContext.replaceDB(db),
 		PromptDebugLog:     q.PromptDebugLog.replaceDB(db),
+		PromptLabel:        q.PromptLabel.replaceDB(db),
+		PromptLabelHistory: q.PromptLabelHistory.replaceDB(db),
+		PromptSnippetRef:   q.PromptSnippetRef.replaceDB(db),
 		PromptUserDraft:    q.PromptUserDraft.replaceDB(db),
 	}
 }
@@ -73,6 +85,9 @@ type queryCtx struct {
 	PromptCommit       *promptCommitDo
 	PromptDebugContext *promptDebugContextDo
 	PromptDebugLog     *promptDebugLogDo
+	PromptLabel        *promptLabelDo
+	PromptLabelHistory *promptLabelHistoryDo
+	PromptSnippetRef   *promptSnippetRefDo
 	PromptUserDraft    *promptUserDraftDo
 }
 
@@ -82,6 +97,9 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		PromptCommit:       q.PromptCommit.WithContext(ctx),
 		PromptDebugContext: q.PromptDebugContext.WithContext(ctx),
 		PromptDebugLog:     q.PromptDebugLog.WithContext(ctx),
+		PromptLabel:        q.PromptLabel.WithContext(ctx),
+		PromptLabelHistory: q.PromptLabelHistory.WithContext(ctx),
+		PromptSnippetRef:   q.PromptSnippetRef.WithContext(ctx),
 		PromptUserDraft:    q.PromptUserDraft.WithContext(ctx),
 	}
 }
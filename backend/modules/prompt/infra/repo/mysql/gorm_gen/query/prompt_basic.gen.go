@@ -32,6 +32,7 @@ func newPromptBasic(db *gorm.DB, opts ...gen.DOOption) promptBasic {
 	_promptBasic.PromptKey = field.NewString(tableName, "prompt_key")
 	_promptBasic.Name = field.NewString(tableName, "name")
 	_promptBasic.Description = field.NewString(tableName, "description")
+	_promptBasic.PromptType = field.NewString(tableName, "prompt_type")
 	_promptBasic.CreatedBy = field.NewString(tableName, "created_by")
 	_promptBasic.UpdatedBy = field.NewString(tableName, "updated_by")
 	_promptBasic.LatestVersion = field.NewString(tableName, "latest_version")
@@ -55,6 +56,7 @@ type promptBasic struct {
 	PromptKey        field.String // Prompt key
 	Name             field.String // Prompt名称
 	Description      field.String // 描述
+	PromptType       field.String // Prompt类型
 	CreatedBy        field.String // 创建人
 	UpdatedBy        field.String // 更新人
 	LatestVersion    field.String // 最新版本
@@ -83,6 +85,7 @@ func (p *promptBasic) updateTableName(table string) *promptBasic {
 	p.PromptKey = field.NewString(table, "prompt_key")
 	p.Name = field.NewString(table, "name")
 	p.Description = field.NewString(table, "description")
+	p.PromptType = field.NewString(table, "prompt_type")
 	p.CreatedBy = field.NewString(table, "created_by")
 	p.UpdatedBy = field.NewString(table, "updated_by")
 	p.LatestVersion = field.NewString(table, "latest_version")
@@ -116,12 +119,13 @@ func (p *promptBasic) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (p *promptBasic) fillFieldMap() {
-	p.fieldMap = make(map[string]field.Expr, 12)
+	p.fieldMap = make(map[string]field.Expr, 13)
 	p.fieldMap["id"] = p.ID
 	p.fieldMap["space_id"] = p.SpaceID
 	p.fieldMap["prompt_key"] = p.PromptKey
 	p.fieldMap["name"] = p.Name
 	p.fieldMap["description"] = p.Description
+	p.fieldMap["prompt_type"] = p.PromptType
 	p.fieldMap["created_by"] = p.CreatedBy
 	p.fieldMap["updated_by"] = p.UpdatedBy
 	p.fieldMap["latest_version"] = p.LatestVersion
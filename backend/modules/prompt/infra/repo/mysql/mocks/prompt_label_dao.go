@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql (interfaces: IPromptLabelDAO)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/prompt_label_dao.go -package=mocks . IPromptLabelDAO
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	db "github.com/coze-dev/coze-loop/backend/infra/db"
+	mysql "github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql"
+	model "github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPromptLabelDAO is a mock of IPromptLabelDAO interface.
+type MockIPromptLabelDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPromptLabelDAOMockRecorder
+	isgomock struct{}
+}
+
+// MockIPromptLabelDAOMockRecorder is the mock recorder for MockIPromptLabelDAO.
+type MockIPromptLabelDAOMockRecorder struct {
+	mock *MockIPromptLabelDAO
+}
+
+// NewMockIPromptLabelDAO creates a new mock instance.
+func NewMockIPromptLabelDAO(ctrl *gomock.Controller) *MockIPromptLabelDAO {
+	mock := &MockIPromptLabelDAO{ctrl: ctrl}
+	mock.recorder = &MockIPromptLabelDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPromptLabelDAO) EXPECT() *MockIPromptLabelDAOMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIPromptLabelDAO) Create(ctx context.Context, labelPO *model.PromptLabel, timeNow time.Time, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, labelPO, timeNow}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIPromptLabelDAOMockRecorder) Create(ctx, labelPO, timeNow any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, labelPO, timeNow}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIPromptLabelDAO)(nil).Create), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockIPromptLabelDAO) Get(ctx context.Context, promptID int64, labelKey string, opts ...db.Option) (*model.PromptLabel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, promptID, labelKey}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*model.PromptLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIPromptLabelDAOMockRecorder) Get(ctx, promptID, labelKey any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, promptID, labelKey}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIPromptLabelDAO)(nil).Get), varargs...)
+}
+
+// List mocks base method.
+func (m *MockIPromptLabelDAO) List(ctx context.Context, promptID int64, opts ...db.Option) ([]*model.PromptLabel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, promptID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*model.PromptLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIPromptLabelDAOMockRecorder) List(ctx, promptID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, promptID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIPromptLabelDAO)(nil).List), varargs...)
+}
+
+// MGet mocks base method.
+func (m *MockIPromptLabelDAO) MGet(ctx context.Context, pairs []mysql.PromptIDLabelKeyPair, opts ...db.Option) (map[mysql.PromptIDLabelKeyPair]*model.PromptLabel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, pairs}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MGet", varargs...)
+	ret0, _ := ret[0].(map[mysql.PromptIDLabelKeyPair]*model.PromptLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MGet indicates an expected call of MGet.
+func (mr *MockIPromptLabelDAOMockRecorder) MGet(ctx, pairs any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, pairs}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGet", reflect.TypeOf((*MockIPromptLabelDAO)(nil).MGet), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockIPromptLabelDAO) Update(ctx context.Context, labelID, promptID int64, updateFields map[string]any, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, labelID, promptID, updateFields}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Update", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIPromptLabelDAOMockRecorder) Update(ctx, labelID, promptID, updateFields any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, labelID, promptID, updateFields}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIPromptLabelDAO)(nil).Update), varargs...)
+}
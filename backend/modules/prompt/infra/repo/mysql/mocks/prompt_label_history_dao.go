@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql (interfaces: IPromptLabelHistoryDAO)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/prompt_label_history_dao.go -package=mocks . IPromptLabelHistoryDAO
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	db "github.com/coze-dev/coze-loop/backend/infra/db"
+	mysql "github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql"
+	model "github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPromptLabelHistoryDAO is a mock of IPromptLabelHistoryDAO interface.
+type MockIPromptLabelHistoryDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPromptLabelHistoryDAOMockRecorder
+	isgomock struct{}
+}
+
+// MockIPromptLabelHistoryDAOMockRecorder is the mock recorder for MockIPromptLabelHistoryDAO.
+type MockIPromptLabelHistoryDAOMockRecorder struct {
+	mock *MockIPromptLabelHistoryDAO
+}
+
+// NewMockIPromptLabelHistoryDAO creates a new mock instance.
+func NewMockIPromptLabelHistoryDAO(ctrl *gomock.Controller) *MockIPromptLabelHistoryDAO {
+	mock := &MockIPromptLabelHistoryDAO{ctrl: ctrl}
+	mock.recorder = &MockIPromptLabelHistoryDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPromptLabelHistoryDAO) EXPECT() *MockIPromptLabelHistoryDAOMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIPromptLabelHistoryDAO) Create(ctx context.Context, historyPO *model.PromptLabelHistory, timeNow time.Time, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, historyPO, timeNow}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIPromptLabelHistoryDAOMockRecorder) Create(ctx, historyPO, timeNow any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, historyPO, timeNow}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIPromptLabelHistoryDAO)(nil).Create), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockIPromptLabelHistoryDAO) Get(ctx context.Context, historyID int64, opts ...db.Option) (*model.PromptLabelHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, historyID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*model.PromptLabelHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIPromptLabelHistoryDAOMockRecorder) Get(ctx, historyID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, historyID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIPromptLabelHistoryDAO)(nil).Get), varargs...)
+}
+
+// List mocks base method.
+func (m *MockIPromptLabelHistoryDAO) List(ctx context.Context, param mysql.ListLabelHistoryParam, opts ...db.Option) ([]*model.PromptLabelHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, param}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*model.PromptLabelHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIPromptLabelHistoryDAOMockRecorder) List(ctx, param any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, param}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIPromptLabelHistoryDAO)(nil).List), varargs...)
+}
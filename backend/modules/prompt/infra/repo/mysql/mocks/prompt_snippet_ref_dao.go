@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql (interfaces: IPromptSnippetRefDAO)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/prompt_snippet_ref_dao.go -package=mocks . IPromptSnippetRefDAO
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	db "github.com/coze-dev/coze-loop/backend/infra/db"
+	mysql "github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql"
+	model "github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPromptSnippetRefDAO is a mock of IPromptSnippetRefDAO interface.
+type MockIPromptSnippetRefDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPromptSnippetRefDAOMockRecorder
+	isgomock struct{}
+}
+
+// MockIPromptSnippetRefDAOMockRecorder is the mock recorder for MockIPromptSnippetRefDAO.
+type MockIPromptSnippetRefDAOMockRecorder struct {
+	mock *MockIPromptSnippetRefDAO
+}
+
+// NewMockIPromptSnippetRefDAO creates a new mock instance.
+func NewMockIPromptSnippetRefDAO(ctrl *gomock.Controller) *MockIPromptSnippetRefDAO {
+	mock := &MockIPromptSnippetRefDAO{ctrl: ctrl}
+	mock.recorder = &MockIPromptSnippetRefDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPromptSnippetRefDAO) EXPECT() *MockIPromptSnippetRefDAOMockRecorder {
+	return m.recorder
+}
+
+// BatchCreate mocks base method.
+func (m *MockIPromptSnippetRefDAO) BatchCreate(ctx context.Context, refPOs []*model.PromptSnippetRef, timeNow time.Time, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, refPOs, timeNow}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchCreate", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockIPromptSnippetRefDAOMockRecorder) BatchCreate(ctx, refPOs, timeNow any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, refPOs, timeNow}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockIPromptSnippetRefDAO)(nil).BatchCreate), varargs...)
+}
+
+// DeleteByPromptID mocks base method.
+func (m *MockIPromptSnippetRefDAO) DeleteByPromptID(ctx context.Context, promptID int64, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, promptID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteByPromptID", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByPromptID indicates an expected call of DeleteByPromptID.
+func (mr *MockIPromptSnippetRefDAOMockRecorder) DeleteByPromptID(ctx, promptID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, promptID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByPromptID", reflect.TypeOf((*MockIPromptSnippetRefDAO)(nil).DeleteByPromptID), varargs...)
+}
+
+// List mocks base method.
+func (m *MockIPromptSnippetRefDAO) List(ctx context.Context, param mysql.ListSnippetRefParam, opts ...db.Option) ([]*model.PromptSnippetRef, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, param}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*model.PromptSnippetRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIPromptSnippetRefDAOMockRecorder) List(ctx, param any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, param}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIPromptSnippetRefDAO)(nil).List), varargs...)
+}
@@ -44,6 +44,7 @@ type ListPromptBasicParam struct {
 	KeyWord       string
 	CreatedBys    []string
 	CommittedOnly bool
+	PromptTypes   []string
 
 	Offset  int
 	Limit   int
@@ -188,6 +189,9 @@ func (d *PromptBasicDAOImpl) List(ctx context.Context, param ListPromptBasicPara
 	if param.CommittedOnly {
 		tx = tx.Where(q.PromptBasic.LatestVersion.Neq(""))
 	}
+	if len(param.PromptTypes) > 0 {
+		tx = tx.Where(q.PromptBasic.PromptType.In(param.PromptTypes...))
+	}
 	total, err = tx.Count()
 	if err != nil {
 		return nil, 0, errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
@@ -0,0 +1,157 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/platestwrite"
+	"github.com/coze-dev/coze-loop/backend/infra/redis"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/query"
+	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+//go:generate mockgen -destination=mocks/prompt_label_dao.go -package=mocks . IPromptLabelDAO
+type IPromptLabelDAO interface {
+	Create(ctx context.Context, labelPO *model.PromptLabel, timeNow time.Time, opts ...db.Option) (err error)
+	Get(ctx context.Context, promptID int64, labelKey string, opts ...db.Option) (labelPO *model.PromptLabel, err error)
+	MGet(ctx context.Context, pairs []PromptIDLabelKeyPair, opts ...db.Option) (pairLabelPOMap map[PromptIDLabelKeyPair]*model.PromptLabel, err error)
+	List(ctx context.Context, promptID int64, opts ...db.Option) (labelPOs []*model.PromptLabel, err error)
+	Update(ctx context.Context, labelID int64, promptID int64, updateFields map[string]interface{}, opts ...db.Option) (err error)
+}
+
+type PromptIDLabelKeyPair struct {
+	PromptID int64
+	LabelKey string
+}
+
+type PromptLabelDAOImpl struct {
+	db           db.Provider
+	writeTracker platestwrite.ILatestWriteTracker
+}
+
+func NewPromptLabelDAO(db db.Provider, redisCli redis.Cmdable) IPromptLabelDAO {
+	return &PromptLabelDAOImpl{
+		db:           db,
+		writeTracker: platestwrite.NewLatestWriteTracker(redisCli),
+	}
+}
+
+func (d *PromptLabelDAOImpl) Create(ctx context.Context, labelPO *model.PromptLabel, timeNow time.Time, opts ...db.Option) (err error) {
+	if labelPO == nil {
+		return errorx.New("labelPO is empty")
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...)).WithContext(ctx)
+	labelPO.CreatedAt = timeNow
+	labelPO.UpdatedAt = timeNow
+	err = q.PromptLabel.Create(labelPO)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errorx.WrapByCode(err, prompterr.CommonResourceDuplicatedCode)
+		}
+		return errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	d.writeTracker.SetWriteFlag(ctx, platestwrite.ResourceTypePromptLabel, labelPO.PromptID)
+	return nil
+}
+
+func (d *PromptLabelDAOImpl) Get(ctx context.Context, promptID int64, labelKey string, opts ...db.Option) (labelPO *model.PromptLabel, err error) {
+	if promptID <= 0 || labelKey == "" {
+		return nil, errorx.New("promptID or labelKey is invalid, promptID = %d, labelKey = %s", promptID, labelKey)
+	}
+	if d.writeTracker.CheckWriteFlagByID(ctx, platestwrite.ResourceTypePromptLabel, promptID) {
+		opts = append(opts, db.WithMaster())
+	}
+
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).PromptLabel
+	tx = tx.Where(q.PromptLabel.PromptID.Eq(promptID))
+	tx = tx.Where(q.PromptLabel.LabelKey.Eq(labelKey))
+	labelPOs, err := tx.Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	if len(labelPOs) <= 0 {
+		return nil, nil
+	}
+	return labelPOs[0], nil
+}
+
+func (d *PromptLabelDAOImpl) MGet(ctx context.Context, pairs []PromptIDLabelKeyPair, opts ...db.Option) (pairLabelPOMap map[PromptIDLabelKeyPair]*model.PromptLabel, err error) {
+	if len(pairs) <= 0 {
+		return nil, errorx.New("invalid param")
+	}
+	for _, pair := range pairs {
+		if d.writeTracker.CheckWriteFlagByID(ctx, platestwrite.ResourceTypePromptLabel, pair.PromptID) {
+			opts = append(opts, db.WithMaster())
+			break
+		}
+	}
+
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).PromptLabel
+	oriTx := tx
+	for _, pair := range pairs {
+		subCon := oriTx.Where(q.PromptLabel.PromptID.Eq(pair.PromptID), q.PromptLabel.LabelKey.Eq(pair.LabelKey))
+		tx = tx.Or(subCon)
+	}
+	labelPOs, err := tx.Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	if len(labelPOs) <= 0 {
+		return nil, nil
+	}
+	pairLabelPOMap = make(map[PromptIDLabelKeyPair]*model.PromptLabel)
+	for _, po := range labelPOs {
+		pairLabelPOMap[PromptIDLabelKeyPair{
+			PromptID: po.PromptID,
+			LabelKey: po.LabelKey,
+		}] = po
+	}
+	return pairLabelPOMap, nil
+}
+
+func (d *PromptLabelDAOImpl) List(ctx context.Context, promptID int64, opts ...db.Option) (labelPOs []*model.PromptLabel, err error) {
+	if promptID <= 0 {
+		return nil, errorx.New("promptID is invalid, promptID = %d", promptID)
+	}
+	if d.writeTracker.CheckWriteFlagByID(ctx, platestwrite.ResourceTypePromptLabel, promptID) {
+		opts = append(opts, db.WithMaster())
+	}
+
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).PromptLabel
+	tx = tx.Where(q.PromptLabel.PromptID.Eq(promptID)).Order(q.PromptLabel.ID.Asc())
+	labelPOs, err = tx.Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	if len(labelPOs) <= 0 {
+		return nil, nil
+	}
+	return labelPOs, nil
+}
+
+func (d *PromptLabelDAOImpl) Update(ctx context.Context, labelID int64, promptID int64, updateFields map[string]interface{}, opts ...db.Option) (err error) {
+	if labelID <= 0 {
+		return errorx.New("labelID is invalid, labelID = %d", labelID)
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).PromptLabel
+	tx = tx.Where(q.PromptLabel.ID.Eq(labelID))
+	_, err = tx.Updates(updateFields)
+	if err != nil {
+		return errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	d.writeTracker.SetWriteFlag(ctx, platestwrite.ResourceTypePromptLabel, promptID)
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/query"
+	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+//go:generate mockgen -destination=mocks/prompt_label_history_dao.go -package=mocks . IPromptLabelHistoryDAO
+type IPromptLabelHistoryDAO interface {
+	Create(ctx context.Context, historyPO *model.PromptLabelHistory, timeNow time.Time, opts ...db.Option) (err error)
+	Get(ctx context.Context, historyID int64, opts ...db.Option) (historyPO *model.PromptLabelHistory, err error)
+	List(ctx context.Context, param ListLabelHistoryParam, opts ...db.Option) (historyPOs []*model.PromptLabelHistory, err error)
+}
+
+type ListLabelHistoryParam struct {
+	PromptID int64
+	LabelKey string
+
+	Cursor *int64
+	Limit  int
+}
+
+type PromptLabelHistoryDAOImpl struct {
+	db db.Provider
+}
+
+func NewPromptLabelHistoryDAO(db db.Provider) IPromptLabelHistoryDAO {
+	return &PromptLabelHistoryDAOImpl{
+		db: db,
+	}
+}
+
+func (d *PromptLabelHistoryDAOImpl) Create(ctx context.Context, historyPO *model.PromptLabelHistory, timeNow time.Time, opts ...db.Option) (err error) {
+	if historyPO == nil {
+		return errorx.New("historyPO is empty")
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...)).WithContext(ctx)
+	historyPO.CreatedAt = timeNow
+	err = q.PromptLabelHistory.Create(historyPO)
+	if err != nil {
+		return errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (d *PromptLabelHistoryDAOImpl) Get(ctx context.Context, historyID int64, opts ...db.Option) (historyPO *model.PromptLabelHistory, err error) {
+	if historyID <= 0 {
+		return nil, errorx.New("historyID is invalid, historyID = %d", historyID)
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).PromptLabelHistory
+	tx = tx.Where(q.PromptLabelHistory.ID.Eq(historyID))
+	historyPOs, err := tx.Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	if len(historyPOs) <= 0 {
+		return nil, nil
+	}
+	return historyPOs[0], nil
+}
+
+func (d *PromptLabelHistoryDAOImpl) List(ctx context.Context, param ListLabelHistoryParam, opts ...db.Option) (historyPOs []*model.PromptLabelHistory, err error) {
+	if param.PromptID <= 0 || param.Limit <= 0 {
+		return nil, errorx.New("Param(PromptID or Limit) is invalid, param = %s", json.Jsonify(param))
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).PromptLabelHistory
+	tx = tx.Where(q.PromptLabelHistory.PromptID.Eq(param.PromptID))
+	if param.LabelKey != "" {
+		tx = tx.Where(q.PromptLabelHistory.LabelKey.Eq(param.LabelKey))
+	}
+	if param.Cursor != nil {
+		tx = tx.Where(q.PromptLabelHistory.ID.Lte(*param.Cursor))
+	}
+	tx = tx.Order(q.PromptLabelHistory.ID.Desc()).Limit(param.Limit)
+	historyPOs, err = tx.Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	if len(historyPOs) <= 0 {
+		return nil, nil
+	}
+	return historyPOs, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql/gorm_gen/query"
+	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+//go:generate mockgen -destination=mocks/prompt_snippet_ref_dao.go -package=mocks . IPromptSnippetRefDAO
+type IPromptSnippetRefDAO interface {
+	BatchCreate(ctx context.Context, refPOs []*model.PromptSnippetRef, timeNow time.Time, opts ...db.Option) (err error)
+	List(ctx context.Context, param ListSnippetRefParam, opts ...db.Option) (refPOs []*model.PromptSnippetRef, err error)
+	DeleteByPromptID(ctx context.Context, promptID int64, opts ...db.Option) (err error)
+}
+
+type ListSnippetRefParam struct {
+	SnippetPromptID int64
+
+	Cursor *int64
+	Limit  int
+}
+
+type PromptSnippetRefDAOImpl struct {
+	db db.Provider
+}
+
+func NewPromptSnippetRefDAO(db db.Provider) IPromptSnippetRefDAO {
+	return &PromptSnippetRefDAOImpl{
+		db: db,
+	}
+}
+
+func (d *PromptSnippetRefDAOImpl) BatchCreate(ctx context.Context, refPOs []*model.PromptSnippetRef, timeNow time.Time, opts ...db.Option) (err error) {
+	if len(refPOs) <= 0 {
+		return nil
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...)).WithContext(ctx)
+	for _, refPO := range refPOs {
+		refPO.CreatedAt = timeNow
+	}
+	err = q.PromptSnippetRef.CreateInBatches(refPOs, len(refPOs))
+	if err != nil {
+		return errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (d *PromptSnippetRefDAOImpl) List(ctx context.Context, param ListSnippetRefParam, opts ...db.Option) (refPOs []*model.PromptSnippetRef, err error) {
+	if param.SnippetPromptID <= 0 || param.Limit <= 0 {
+		return nil, errorx.New("Param(SnippetPromptID or Limit) is invalid, param = %s", json.Jsonify(param))
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).PromptSnippetRef
+	tx = tx.Where(q.PromptSnippetRef.SnippetPromptID.Eq(param.SnippetPromptID))
+	if param.Cursor != nil {
+		tx = tx.Where(q.PromptSnippetRef.ID.Lte(*param.Cursor))
+	}
+	tx = tx.Order(q.PromptSnippetRef.ID.Desc()).Limit(param.Limit)
+	refPOs, err = tx.Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	if len(refPOs) <= 0 {
+		return nil, nil
+	}
+	return refPOs, nil
+}
+
+func (d *PromptSnippetRefDAOImpl) DeleteByPromptID(ctx context.Context, promptID int64, opts ...db.Option) (err error) {
+	if promptID <= 0 {
+		return errorx.New("promptID is invalid, promptID = %d", promptID)
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	tx := q.WithContext(ctx).PromptSnippetRef
+	tx = tx.Where(q.PromptSnippetRef.PromptID.Eq(promptID))
+	_, err = tx.Delete(&model.PromptSnippetRef{})
+	if err != nil {
+		return errorx.WrapByCode(err, prompterr.CommonMySqlErrorCode)
+	}
+	return nil
+}
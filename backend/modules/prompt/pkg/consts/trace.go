@@ -29,4 +29,5 @@ const (
 	SpanTagCallTypePromptPlayground = "PromptPlayground"
 	SpanTagCallTypePromptDebug      = "PromptDebug"
 	SpanTagCallTypeEvaluation       = "Evaluation"
+	SpanTagCallTypePromptOpenAPI    = "PromptOpenAPI"
 )
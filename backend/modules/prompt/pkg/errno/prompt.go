@@ -72,6 +72,22 @@ const (
 	unsupportedTemplateTypeMessage           = "unsupported template type"
 	unsupportedTemplateTypeNoAffectStability = true
 
+	PromptLabelNotExistCode              = 600501007
+	promptLabelNotExistMessage           = "prompt label not exist"
+	promptLabelNotExistNoAffectStability = true
+
+	PromptSnippetInvalidCode              = 600501008
+	promptSnippetInvalidMessage           = "prompt snippet reference is invalid"
+	promptSnippetInvalidNoAffectStability = true
+
+	PromptSnippetCycleCode              = 600501009
+	promptSnippetCycleMessage           = "prompt snippet reference cycle detected"
+	promptSnippetCycleNoAffectStability = true
+
+	PromptSnippetReferencedCode              = 600501010
+	promptSnippetReferencedMessage           = "prompt snippet is referenced by other prompts"
+	promptSnippetReferencedNoAffectStability = true
+
 	PromptHubQPSLimitCode              = 600502001
 	promptHubQPSLimitMessage           = "request is limited, cause prompt hub qps of current space reached the upper limit"
 	promptHubQPSLimitNoAffectStability = true
@@ -179,6 +195,30 @@ func init() {
 		code.WithAffectStability(!unsupportedTemplateTypeNoAffectStability),
 	)
 
+	code.Register(
+		PromptLabelNotExistCode,
+		promptLabelNotExistMessage,
+		code.WithAffectStability(!promptLabelNotExistNoAffectStability),
+	)
+
+	code.Register(
+		PromptSnippetInvalidCode,
+		promptSnippetInvalidMessage,
+		code.WithAffectStability(!promptSnippetInvalidNoAffectStability),
+	)
+
+	code.Register(
+		PromptSnippetCycleCode,
+		promptSnippetCycleMessage,
+		code.WithAffectStability(!promptSnippetCycleNoAffectStability),
+	)
+
+	code.Register(
+		PromptSnippetReferencedCode,
+		promptSnippetReferencedMessage,
+		code.WithAffectStability(!promptSnippetReferencedNoAffectStability),
+	)
+
 	code.Register(
 		PromptHubQPSLimitCode,
 		promptHubQPSLimitMessage,
@@ -15,6 +15,7 @@ import (
 func main() {
 	db := initDB()
 	generateForPrompt(db)
+	generateForLLM(db)
 	generateForData(db)
 	generateForEvaluationTarget(db)
 	generateForEvaluationEvaluator(db)
@@ -65,7 +66,7 @@ func generateForPrompt(db *gorm.DB) {
 			})))
 	}
 
-	for _, table := range []string{"prompt_commit"} {
+	for _, table := range []string{"prompt_commit", "prompt_label", "prompt_label_history", "prompt_snippet_ref"} {
 		models = append(models, g.GenerateModel(table,
 			gen.FieldGORMTag("*", func(tag field.GormTag) field.GormTag {
 				return tag.Set("charset=utf8mb4")
@@ -75,6 +76,22 @@ func generateForPrompt(db *gorm.DB) {
 	g.Execute()
 }
 
+func generateForLLM(db *gorm.DB) {
+	path := "modules/llm/infra/repo/gorm_gen"
+	g := gen.NewGenerator(getGenerateConfig(path))
+	g.UseDB(db)
+
+	llmModel := g.GenerateModelAs("llm_model", "LlmModel",
+		// 添加软删除字段
+		gen.FieldType("deleted_at", "soft_delete.DeletedAt"),
+		gen.FieldGORMTag("deleted_at", func(tag field.GormTag) field.GormTag {
+			return tag.Set("column:deleted_at;not null;default:0;softDelete:milli")
+		}))
+	modelRequestRecord := g.GenerateModelAs("model_request_record", "ModelRequestRecord")
+	g.ApplyBasic(llmModel, modelRequestRecord)
+	g.Execute()
+}
+
 func generateForDataset(db *gorm.DB) {
 	path := "modules/data/infra/repo/dataset/mysql/gorm_gen"
 	g := gen.NewGenerator(getGenerateConfig(path))
@@ -195,6 +212,8 @@ func generateForEvaluationExpt(db *gorm.DB) {
 		"expt_turn_result_tag_ref",
 		"expt_turn_annotate_record_ref",
 		"expt_result_export_record",
+		"expt_schedule",
+		"expt_schedule_run",
 	}
 
 	var models []any
@@ -243,8 +262,9 @@ func generateForObservability(db *gorm.DB) {
 
 	// 为 observability_view 表添加软删除字段
 	observabilityView := g.GenerateModelAs("observability_view", "ObservabilityView")
+	observabilityOnlineEvalRule := g.GenerateModelAs("observability_online_eval_rule", "ObservabilityOnlineEvalRule")
 
-	g.ApplyBasic(observabilityView)
+	g.ApplyBasic(observabilityView, observabilityOnlineEvalRule)
 	g.Execute()
 }
 
@@ -16,6 +16,8 @@ export interface CreateEvalTargetParam {
   bot_info_type?: eval_target.CozeBotInfoType,
   /** 如果是发布版本则需要填充这个字段 */
   bot_publish_version?: string,
+  /** eval_target_type 为 HTTP 时需要填充这个字段 */
+  http_target?: eval_target.HTTPTarget,
 }
 export interface CreateEvalTargetResponse {
   id?: string,
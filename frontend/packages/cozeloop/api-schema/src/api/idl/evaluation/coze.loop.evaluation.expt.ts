@@ -119,6 +119,16 @@ export interface KillExperimentRequest {
   workspace_id?: string,
 }
 export interface KillExperimentResponse {}
+export interface PauseExperimentRequest {
+  expt_id?: string,
+  workspace_id?: string,
+}
+export interface PauseExperimentResponse {}
+export interface ResumeExperimentRequest {
+  expt_id?: string,
+  workspace_id?: string,
+}
+export interface ResumeExperimentResponse {}
 export interface CloneExperimentRequest {
   expt_id?: string,
   workspace_id?: string,
@@ -154,6 +164,22 @@ export interface BatchGetExperimentAggrResultRequest {
 export interface BatchGetExperimentAggrResultResponse {
   expt_aggregate_result?: expt.ExptAggregateResult[]
 }
+export interface CompareExperimentsRequest {
+  workspace_id: string,
+  expt_id: string,
+  baseline_expt_id: string,
+  /** 为空时对比两个实验共有的全部评估器 */
+  evaluator_version_ids?: string[],
+  /** 默认 0.95 */
+  confidence_level?: number,
+  /** 默认 1000 */
+  bootstrap_iterations?: number,
+  /** 得分下降超过该值视为退化，默认 0 */
+  regression_threshold?: number,
+}
+export interface CompareExperimentsResponse {
+  evaluator_compare_results?: expt.EvaluatorCompareResult[]
+}
 export interface CheckExperimentNameRequest {
   workspace_id: string,
   name?: string,
@@ -203,6 +229,59 @@ export interface ListExperimentStatsResponse {
   expt_stats_infos?: expt.ExptStatsInfo[],
   total?: number,
 }
+export interface CreateExptScheduleRequest {
+  workspace_id: string,
+  name: string,
+  desc?: string,
+  template_expt_id: string,
+  cron_expr: string,
+  timezone?: string,
+  use_latest_target_version?: boolean,
+  use_latest_eval_set_version?: boolean,
+  enabled?: boolean,
+}
+export interface CreateExptScheduleResponse {
+  schedule?: expt.ExptSchedule
+}
+export interface UpdateExptScheduleRequest {
+  workspace_id: string,
+  schedule_id: string,
+  name?: string,
+  desc?: string,
+  cron_expr?: string,
+  timezone?: string,
+  use_latest_target_version?: boolean,
+  use_latest_eval_set_version?: boolean,
+  enabled?: boolean,
+}
+export interface UpdateExptScheduleResponse {
+  schedule?: expt.ExptSchedule
+}
+export interface DeleteExptScheduleRequest {
+  workspace_id: string,
+  schedule_id: string,
+}
+export interface DeleteExptScheduleResponse {}
+export interface ListExptSchedulesRequest {
+  workspace_id: string,
+  template_expt_id?: string,
+  page_number?: number,
+  page_size?: number,
+}
+export interface ListExptSchedulesResponse {
+  schedules?: expt.ExptSchedule[],
+  total?: string,
+}
+export interface ListExptScheduleRunsRequest {
+  workspace_id: string,
+  schedule_id: string,
+  page_number?: number,
+  page_size?: number,
+}
+export interface ListExptScheduleRunsResponse {
+  runs?: expt.ExptScheduleRun[],
+  total?: string,
+}
 export const CheckExperimentName = /*#__PURE__*/createAPI<CheckExperimentNameRequest, CheckExperimentNameResponse>({
   "url": "/api/evaluation/v1/experiments/check_name",
   "method": "POST",
@@ -329,6 +408,34 @@ export const KillExperiment = /*#__PURE__*/createAPI<KillExperimentRequest, Kill
   "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
   "service": "evaluationExpt"
 });
+/** PauseExperiment 暂停实验，已提交的数据继续执行，不再提交新的数据 */
+export const PauseExperiment = /*#__PURE__*/createAPI<PauseExperimentRequest, PauseExperimentResponse>({
+  "url": "/api/evaluation/v1/experiments/:expt_id/pause",
+  "method": "POST",
+  "name": "PauseExperiment",
+  "reqType": "PauseExperimentRequest",
+  "reqMapping": {
+    "path": ["expt_id"],
+    "body": ["workspace_id"]
+  },
+  "resType": "PauseExperimentResponse",
+  "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
+  "service": "evaluationExpt"
+});
+/** ResumeExperiment 恢复已暂停的实验，继续执行未完成的数据 */
+export const ResumeExperiment = /*#__PURE__*/createAPI<ResumeExperimentRequest, ResumeExperimentResponse>({
+  "url": "/api/evaluation/v1/experiments/:expt_id/resume",
+  "method": "POST",
+  "name": "ResumeExperiment",
+  "reqType": "ResumeExperimentRequest",
+  "reqMapping": {
+    "path": ["expt_id"],
+    "body": ["workspace_id"]
+  },
+  "resType": "ResumeExperimentResponse",
+  "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
+  "service": "evaluationExpt"
+});
 /** MGetExperimentResult 获取实验结果 */
 export const BatchGetExperimentResult = /*#__PURE__*/createAPI<BatchGetExperimentResultRequest, BatchGetExperimentResultResponse>({
   "url": "/api/evaluation/v1/experiments/results/batch_get",
@@ -355,4 +462,81 @@ export const BatchGetExperimentAggrResult = /*#__PURE__*/createAPI<BatchGetExper
   "resType": "BatchGetExperimentAggrResultResponse",
   "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
   "service": "evaluationExpt"
+});
+export const CompareExperiments = /*#__PURE__*/createAPI<CompareExperimentsRequest, CompareExperimentsResponse>({
+  "url": "/api/evaluation/v1/experiments/:expt_id/compare",
+  "method": "POST",
+  "name": "CompareExperiments",
+  "reqType": "CompareExperimentsRequest",
+  "reqMapping": {
+    "path": ["expt_id"],
+    "body": ["workspace_id", "baseline_expt_id", "evaluator_version_ids", "confidence_level", "bootstrap_iterations", "regression_threshold"]
+  },
+  "resType": "CompareExperimentsResponse",
+  "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
+  "service": "evaluationExpt"
+});
+export const CreateExptSchedule = /*#__PURE__*/createAPI<CreateExptScheduleRequest, CreateExptScheduleResponse>({
+  "url": "/api/evaluation/v1/expt_schedules",
+  "method": "POST",
+  "name": "CreateExptSchedule",
+  "reqType": "CreateExptScheduleRequest",
+  "reqMapping": {
+    "body": ["workspace_id", "name", "desc", "template_expt_id", "cron_expr", "timezone", "use_latest_target_version", "use_latest_eval_set_version", "enabled"]
+  },
+  "resType": "CreateExptScheduleResponse",
+  "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
+  "service": "evaluationExpt"
+});
+export const UpdateExptSchedule = /*#__PURE__*/createAPI<UpdateExptScheduleRequest, UpdateExptScheduleResponse>({
+  "url": "/api/evaluation/v1/expt_schedules/:schedule_id",
+  "method": "PATCH",
+  "name": "UpdateExptSchedule",
+  "reqType": "UpdateExptScheduleRequest",
+  "reqMapping": {
+    "path": ["schedule_id"],
+    "body": ["workspace_id", "name", "desc", "cron_expr", "timezone", "use_latest_target_version", "use_latest_eval_set_version", "enabled"]
+  },
+  "resType": "UpdateExptScheduleResponse",
+  "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
+  "service": "evaluationExpt"
+});
+export const DeleteExptSchedule = /*#__PURE__*/createAPI<DeleteExptScheduleRequest, DeleteExptScheduleResponse>({
+  "url": "/api/evaluation/v1/expt_schedules/:schedule_id",
+  "method": "DELETE",
+  "name": "DeleteExptSchedule",
+  "reqType": "DeleteExptScheduleRequest",
+  "reqMapping": {
+    "path": ["schedule_id"],
+    "body": ["workspace_id"]
+  },
+  "resType": "DeleteExptScheduleResponse",
+  "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
+  "service": "evaluationExpt"
+});
+export const ListExptSchedules = /*#__PURE__*/createAPI<ListExptSchedulesRequest, ListExptSchedulesResponse>({
+  "url": "/api/evaluation/v1/expt_schedules/list",
+  "method": "POST",
+  "name": "ListExptSchedules",
+  "reqType": "ListExptSchedulesRequest",
+  "reqMapping": {
+    "body": ["workspace_id", "template_expt_id", "page_number", "page_size"]
+  },
+  "resType": "ListExptSchedulesResponse",
+  "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
+  "service": "evaluationExpt"
+});
+/** ListExptScheduleRuns 按触发时间倒序返回定时实验的历史触发记录 */
+export const ListExptScheduleRuns = /*#__PURE__*/createAPI<ListExptScheduleRunsRequest, ListExptScheduleRunsResponse>({
+  "url": "/api/evaluation/v1/expt_schedules/:schedule_id/runs/list",
+  "method": "POST",
+  "name": "ListExptScheduleRuns",
+  "reqType": "ListExptScheduleRunsRequest",
+  "reqMapping": {
+    "path": ["schedule_id"],
+    "body": ["workspace_id", "page_number", "page_size"]
+  },
+  "resType": "ListExptScheduleRunsResponse",
+  "schemaRoot": "api://schemas/evaluation_coze.loop.evaluation.expt",
+  "service": "evaluationExpt"
 });
\ No newline at end of file
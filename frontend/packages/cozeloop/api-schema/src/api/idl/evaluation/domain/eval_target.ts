@@ -49,6 +49,8 @@ export interface EvalTargetContent {
   coze_bot?: CozeBot,
   /** EvalTargetType=1 时，传参此字段。 评测对象为 EvalPrompt 时, 需要设置 Prompt 信息 */
   prompt?: EvalPrompt,
+  /** EvalTargetType=5 时，传参此字段。 评测对象为 HTTP 服务时, 需要设置 HTTP 调用信息 */
+  http_target?: HTTPTarget,
 }
 export enum EvalTargetType {
   /** CozeBot */
@@ -57,6 +59,48 @@ export enum EvalTargetType {
   CozeLoopPrompt = 2,
   /** Trace */
   Trace = 3,
+  /** 自定义 HTTP 服务 */
+  HTTP = 5,
+}
+export interface HTTPTarget {
+  /** 请求地址，可用 {{key}} 引用输入变量 */
+  url?: string,
+  /** 请求方法，默认 POST */
+  method?: string,
+  headers?: {
+    [key: string | number]: string
+  },
+  auth?: HTTPAuth,
+  /** 请求体模板，可用 {{key}} 引用输入变量，变量值按 JSON 字符串转义 */
+  body_template?: string,
+  /** 响应字段映射，为空时整个响应体作为 actual_output */
+  output_mappings?: HTTPOutputMapping[],
+  /** 单次请求超时时间 */
+  timeout_ms?: string,
+  /** 失败重试次数，网络错误、429 与 5xx 时重试 */
+  retry_times?: number,
+  retry_interval_ms?: string,
+}
+export enum HTTPAuthType {
+  /** Authorization: Bearer <token> */
+  Bearer = 1,
+  /** Authorization: Basic base64(username:password) */
+  Basic = 2,
+  /** <header_name>: <token> */
+  APIKey = 3,
+}
+export interface HTTPAuth {
+  auth_type?: HTTPAuthType,
+  token?: string,
+  username?: string,
+  password?: string,
+  header_name?: string,
+}
+export interface HTTPOutputMapping {
+  /** 输出字段名 */
+  field_key?: string,
+  /** 响应体中的 JSONPath，例如 $.data.answer */
+  json_path?: string,
 }
 export interface EvalPrompt {
   prompt_id?: string,
@@ -167,6 +211,8 @@ export interface EvalTargetOutputData {
   eval_target_run_error?: EvalTargetRunError,
   /** 运行耗时 */
   time_consuming_ms?: string,
+  /** 按发布标签执行时实际运行的提交版本 */
+  resolved_source_target_version?: string,
 }
 export interface EvalTargetUsage {
   input_tokens: string,
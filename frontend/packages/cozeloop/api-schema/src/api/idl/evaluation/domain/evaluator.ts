@@ -5,11 +5,36 @@ export { common };
 export enum EvaluatorType {
   Prompt = 1,
   Code = 2,
+  Builtin = 3,
+  Pairwise = 4,
 }
 export enum LanguageType {
   Python = 1,
   JS = 2,
 }
+/** 内置评估器类型，纯规则计算，不依赖 LLM */
+export enum BuiltinEvaluatorType {
+  /** 精确匹配 */
+  ExactMatch = 1,
+  /** 基于编辑距离的模糊匹配 */
+  FuzzyMatch = 2,
+  RougeL = 3,
+  BLEU = 4,
+  /** 编辑距离，分数为 1 - 距离/较长文本长度，取值 [0, 1] */
+  EditDistance = 5,
+  /** JSON 合法性及 schema 校验 */
+  JSONSchema = 6,
+  /** 正则匹配 */
+  Regex = 7,
+  /** 数值误差容忍 */
+  NumericTolerance = 8,
+}
+/** 对比评估器的偏好结果，A 为基准实验的输出，B 为当前实验的输出 */
+export enum PairwisePreference {
+  A = 1,
+  B = 2,
+  Tie = 3,
+}
 export enum PromptSourceType {
   BuiltinTemplate = 1,
   LoopPrompt = 2,
@@ -51,6 +76,36 @@ export interface CodeEvaluator {
   language_type?: LanguageType,
   code?: string,
 }
+export interface BuiltinEvaluatorConfig {
+  /** 字符串比较是否区分大小写 */
+  case_sensitive?: boolean,
+  /** 比较前是否去除首尾空白并合并连续空白 */
+  ignore_whitespace?: boolean,
+  /** 模糊匹配阈值，设置后分数为 0/1 */
+  threshold?: number,
+  /** 正则表达式 */
+  pattern?: string,
+  /** 正则是否要求整串匹配 */
+  full_match?: boolean,
+  /** 为空时只校验 JSON 合法性 */
+  json_schema?: string,
+  /** 数值容忍误差 */
+  tolerance?: number,
+  /** 是否按参考值的相对误差计算 */
+  relative_tolerance?: boolean,
+  /** BLEU 最大 n-gram 阶数，默认 4 */
+  max_ngram?: number,
+}
+export interface BuiltinEvaluator {
+  builtin_evaluator_type?: BuiltinEvaluatorType,
+  config?: BuiltinEvaluatorConfig,
+}
+export interface PairwiseEvaluator {
+  message_list: common.Message[],
+  model_config?: common.ModelConfig,
+  /** 交换 A、B 位置再评估一次，两次结论不一致时判为平局，用于消除位置偏差 */
+  swap_check?: boolean,
+}
 export interface EvaluatorVersion {
   /** 版本id */
   id?: string,
@@ -65,6 +120,8 @@ export interface EvaluatorContent {
   /** 101-200 Evaluator类型 */
   prompt_evaluator?: PromptEvaluator,
   code_evaluator?: CodeEvaluator,
+  builtin_evaluator?: BuiltinEvaluator,
+  pairwise_evaluator?: PairwiseEvaluator,
 }
 export interface Evaluator {
   evaluator_id?: string,
@@ -109,6 +166,8 @@ export interface EvaluatorResult {
   score?: number,
   correction?: Correction,
   reasoning?: string,
+  /** 仅对比评估器返回 */
+  preference?: PairwisePreference,
 }
 export interface EvaluatorUsage {
   input_tokens?: string,
@@ -12,6 +12,8 @@ export enum ExptStatus {
   Pending = 2,
   /** In progress */
   Processing = 3,
+  /** Paused, no new items are submitted */
+  Paused = 4,
   /** Execution succeeded */
   Success = 11,
   /** Execution failed */
@@ -82,6 +84,14 @@ export interface EvaluatorFieldMapping {
   evaluator_version_id: string,
   from_eval_set?: FieldMapping[],
   from_target?: FieldMapping[],
+  /** 对比评估器的基准实验 */
+  baseline_expt_id?: string,
+  /** 对比评估器从基准实验的评测对象输出中取值 */
+  from_baseline_target?: FieldMapping[],
+  /** 额外启用的聚合器，支持 P50/P90/P99/StdDev/PassRate */
+  aggregator_types?: AggregatorType[],
+  /** 得分不低于该值视为通过，启用 PassRate 时必填 */
+  pass_threshold?: number,
 }
 export interface FieldMapping {
   field_name?: string,
@@ -274,6 +284,7 @@ export interface ExptAggregateResult {
     [key: string | number]: EvaluatorAggregateResult
   },
   status?: ExptAggregateCalculateStatus,
+  target_result?: TargetAggregateResult,
 }
 /** 评估器版本粒度聚合结果 */
 export interface EvaluatorAggregateResult {
@@ -282,6 +293,13 @@ export interface EvaluatorAggregateResult {
   name?: string,
   version?: string,
 }
+/** 评测对象运行指标聚合结果 */
+export interface TargetAggregateResult {
+  /** 运行耗时，单位毫秒 */
+  latency_ms?: AggregatorResult[],
+  input_tokens?: AggregatorResult[],
+  output_tokens?: AggregatorResult[],
+}
 /** 一种聚合器类型的聚合结果 */
 export interface AggregatorResult {
   aggregator_type: AggregatorType,
@@ -295,6 +313,20 @@ export enum AggregatorType {
   Min = 4,
   /** 得分的分布情况 */
   Distribution = 5,
+  /** 对比评估器中当前实验的胜率 */
+  WinRate = 6,
+  /** 对比评估器中的平局率 */
+  TieRate = 7,
+  /** 对比评估器中当前实验的负率 */
+  LossRate = 8,
+  /** 中位数 */
+  P50 = 9,
+  P90 = 10,
+  P99 = 11,
+  /** 样本标准差 */
+  StdDev = 12,
+  /** 得分不低于通过阈值的比例，未配置通过阈值时不计算，聚合结果中不包含该项 */
+  PassRate = 13,
 }
 export enum DataType {
   /** 默认，有小数的浮点数值类型 */
@@ -319,4 +351,77 @@ export interface ExptStatsInfo {
   expt_id?: number,
   source_id?: string,
   expt_stats?: ExptStatistics,
-}
\ No newline at end of file
+}
+export interface ConfidenceInterval {
+  lower?: number,
+  upper?: number,
+  confidence_level?: number,
+}
+/** 配对 t 检验结果 */
+export interface PairedTTestResult {
+  t_statistic?: number,
+  p_value?: number,
+  degrees_of_freedom?: number,
+  /** 得分差均值的 t 分布置信区间 */
+  confidence_interval?: ConfidenceInterval,
+}
+/** 单条数据上的得分变化 */
+export interface ItemScoreDiff {
+  item_id: string,
+  turn_id?: string,
+  baseline_score?: number,
+  score?: number,
+  /** score - baseline_score */
+  diff?: number,
+}
+/** 评估器版本粒度的实验对比结果，差值均为当前实验减去基准实验 */
+export interface EvaluatorCompareResult {
+  evaluator_version_id: string,
+  /** 两个实验均有得分的数据条数 */
+  paired_count?: number,
+  baseline_mean?: number,
+  mean?: number,
+  mean_diff?: number,
+  t_test?: PairedTTestResult,
+  bootstrap_confidence_interval?: ConfidenceInterval,
+  /** t 检验的 p 值小于 1 - confidence_level */
+  significant?: boolean,
+  /** 得分下降超过阈值的数据，按下降幅度倒序 */
+  regressions?: ItemScoreDiff[],
+}
+/** 定时实验，按 cron 表达式以模板实验为蓝本周期性创建并运行新实验 */
+export interface ExptSchedule {
+  id?: string,
+  workspace_id?: string,
+  name?: string,
+  desc?: string,
+  /** 模板实验，沿用其评测集、评测对象、评估器与字段映射 */
+  template_expt_id?: string,
+  /** 5 段 cron 表达式 (分 时 日 月 周)，也支持 @daily 等 */
+  cron_expr?: string,
+  /** IANA 时区，为空时为 UTC */
+  timezone?: string,
+  /** 使用评测对象的最新提交版本，仅 Prompt 评测对象生效 */
+  use_latest_target_version?: boolean,
+  /** 使用评测集的最新版本 */
+  use_latest_eval_set_version?: boolean,
+  enabled?: boolean,
+  next_trigger_time?: string,
+  last_trigger_time?: string,
+  base_info?: common.BaseInfo,
+}
+export enum ExptScheduleRunStatus {
+  Unknown = "Unknown",
+  Success = "Success",
+  Failed = "Failed",
+}
+/** 定时实验的一次触发记录，可结合 BatchGetExperimentAggrResult 绘制趋势 */
+export interface ExptScheduleRun {
+  id?: string,
+  schedule_id?: string,
+  expt_id?: string,
+  run_id?: string,
+  status?: ExptScheduleRunStatus,
+  error_message?: string,
+  trigger_time?: string,
+}
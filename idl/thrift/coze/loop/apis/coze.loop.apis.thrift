@@ -10,6 +10,7 @@ include "../evaluation/coze.loop.evaluation.eval_set.thrift"
 include "../evaluation/coze.loop.evaluation.evaluator.thrift"
 include "../evaluation/coze.loop.evaluation.eval_target.thrift"
 include "../evaluation/coze.loop.evaluation.expt.thrift"
+include "../evaluation/coze.loop.evaluation.openapi.thrift"
 include "../data/coze.loop.data.dataset.thrift"
 include "../prompt/coze.loop.prompt.manage.thrift"
 include "../prompt/coze.loop.prompt.debug.thrift"
@@ -17,6 +18,7 @@ include "../prompt/coze.loop.prompt.execute.thrift"
 include "../prompt/coze.loop.prompt.openapi.thrift"
 include "../llm/coze.loop.llm.runtime.thrift"
 include "../llm/coze.loop.llm.manage.thrift"
+include "../llm/coze.loop.llm.openapi.thrift"
 include "../observability/coze.loop.observability.trace.thrift"
 include "../data/coze.loop.data.tag.thrift"
 include "../observability/coze.loop.observability.openapi.thrift"
@@ -25,6 +27,7 @@ service EvaluationSetService extends coze.loop.evaluation.eval_set.EvaluationSet
 service EvaluatorService extends coze.loop.evaluation.evaluator.EvaluatorService{}
 service EvalTargetService extends coze.loop.evaluation.eval_target.EvalTargetService{}
 service ExperimentService extends coze.loop.evaluation.expt.ExperimentService{}
+service EvaluationOpenAPIService extends coze.loop.evaluation.openapi.EvaluationOpenAPIService{}
 
 service DatasetService extends coze.loop.data.dataset.DatasetService{}
 service TagService extends coze.loop.data.tag.TagService{}
@@ -36,6 +39,7 @@ service PromptOpenAPIService extends coze.loop.prompt.openapi.PromptOpenAPIServi
 
 service LLMManageService extends coze.loop.llm.manage.LLMManageService {}
 service LLMRuntimeService extends coze.loop.llm.runtime.LLMRuntimeService {}
+service LLMOpenAPIService extends coze.loop.llm.openapi.LLMOpenAPIService {}
 service ObservabilityTraceService extends coze.loop.observability.trace.TraceService{}
 service ObservabilityOpenAPIService extends coze.loop.observability.openapi.OpenAPIService{}
 
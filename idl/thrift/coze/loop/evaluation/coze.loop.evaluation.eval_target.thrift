@@ -17,6 +17,8 @@ struct CreateEvalTargetParam {
     3: optional eval_target.EvalTargetType eval_target_type
     4: optional eval_target.CozeBotInfoType bot_info_type
     5: optional string bot_publish_version // 如果是发布版本则需要填充这个字段
+    6: optional eval_target.HTTPTarget http_target // eval_target_type 为 HTTP 时需要填充这个字段
+    7: optional string prompt_label // eval_target_type 为 CozeLoopPrompt 时可选，填充后按发布标签执行，忽略 source_target_version
 }
 
 struct CreateEvalTargetResponse {
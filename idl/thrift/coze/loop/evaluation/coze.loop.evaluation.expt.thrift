@@ -23,6 +23,7 @@ struct CreateExperimentRequest {
     23: optional i32 evaluators_concur_num (api.body = 'evaluators_concur_num')
     24: optional coze.loop.evaluation.eval_target.CreateEvalTargetParam create_eval_target_param (api.body = 'create_eval_target_param')
     25: optional common.RuntimeParam target_runtime_param (api.body = 'target_runtime_param')
+    26: optional expt.ExptBudget budget (api.body = 'budget')
 
     30: optional expt.ExptType expt_type (api.body = 'expt_type')
     31: optional i64 max_alive_time (api.body = 'max_alive_time')
@@ -56,6 +57,7 @@ struct SubmitExperimentRequest {
     23: optional i32 evaluators_concur_num (api.body = 'evaluators_concur_num')
     24: optional coze.loop.evaluation.eval_target.CreateEvalTargetParam create_eval_target_param (api.body = 'create_eval_target_param')
     25: optional common.RuntimeParam target_runtime_param (api.body = 'target_runtime_param')
+    26: optional expt.ExptBudget budget (api.body = 'budget')
 
     30: optional expt.ExptType expt_type (api.body = 'expt_type')
     31: optional i64 max_alive_time (api.body = 'max_alive_time')
@@ -191,6 +193,28 @@ struct KillExperimentResponse {
     255: base.BaseResp BaseResp
 }
 
+struct PauseExperimentRequest {
+    1: optional i64 expt_id (api.path = 'expt_id', api.js_conv = 'true', go.tag = 'json:"expt_id"')
+    2: optional i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+
+    255: optional base.Base Base
+}
+
+struct PauseExperimentResponse {
+    255: base.BaseResp BaseResp
+}
+
+struct ResumeExperimentRequest {
+    1: optional i64 expt_id (api.path = 'expt_id', api.js_conv = 'true', go.tag = 'json:"expt_id"')
+    2: optional i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+
+    255: optional base.Base Base
+}
+
+struct ResumeExperimentResponse {
+    255: base.BaseResp BaseResp
+}
+
 struct CloneExperimentRequest {
     1: optional i64 expt_id (api.path = 'expt_id', api.js_conv = 'true', go.tag = 'json:"expt_id"')
     2: optional i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
@@ -249,6 +273,24 @@ struct BatchGetExperimentAggrResultResponse {
     255: base.BaseResp BaseResp
 }
 
+struct CompareExperimentsRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required i64 expt_id (api.path = 'expt_id', api.js_conv = 'true', go.tag = 'json:"expt_id"')
+    3: required i64 baseline_expt_id (api.body = 'baseline_expt_id', api.js_conv = 'true', go.tag = 'json:"baseline_expt_id"')
+    4: optional list<i64> evaluator_version_ids (api.body = 'evaluator_version_ids', api.js_conv = 'true', go.tag = 'json:"evaluator_version_ids"') // 为空时对比两个实验共有的全部评估器
+    5: optional double confidence_level (api.body = 'confidence_level')            // 默认 0.95
+    6: optional i32 bootstrap_iterations (api.body = 'bootstrap_iterations')       // 默认 1000
+    7: optional double regression_threshold (api.body = 'regression_threshold')   // 得分下降超过该值视为退化，默认 0
+
+    255: optional base.Base Base
+}
+
+struct CompareExperimentsResponse {
+    1: optional list<expt.EvaluatorCompareResult> evaluator_compare_results (api.body = 'evaluator_compare_results')
+
+    255: base.BaseResp BaseResp
+}
+
 struct CheckExperimentNameRequest {
     1: required i64 workspace_id (api.body='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
     2: optional string name (api.body='name')
@@ -451,6 +493,88 @@ struct GetExptResultExportRecordResponse {
 }
 
 
+struct CreateExptScheduleRequest {
+    1: required i64 workspace_id (api.body='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
+    2: required string name (api.body='name')
+    3: optional string desc (api.body='desc')
+    4: required i64 template_expt_id (api.body='template_expt_id', api.js_conv='true', go.tag='json:"template_expt_id"')
+    5: required string cron_expr (api.body='cron_expr')
+    6: optional string timezone (api.body='timezone')
+    7: optional bool use_latest_target_version (api.body='use_latest_target_version')
+    8: optional bool use_latest_eval_set_version (api.body='use_latest_eval_set_version')
+    9: optional bool enabled (api.body='enabled')
+
+    255: optional base.Base Base
+}
+
+struct CreateExptScheduleResponse {
+    1: optional expt.ExptSchedule schedule (api.body = 'schedule')
+
+    255: base.BaseResp BaseResp
+}
+
+struct UpdateExptScheduleRequest {
+    1: required i64 workspace_id (api.body='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
+    2: required i64 schedule_id (api.path='schedule_id', api.js_conv='true', go.tag='json:"schedule_id"')
+    3: optional string name (api.body='name')
+    4: optional string desc (api.body='desc')
+    5: optional string cron_expr (api.body='cron_expr')
+    6: optional string timezone (api.body='timezone')
+    7: optional bool use_latest_target_version (api.body='use_latest_target_version')
+    8: optional bool use_latest_eval_set_version (api.body='use_latest_eval_set_version')
+    9: optional bool enabled (api.body='enabled')
+
+    255: optional base.Base Base
+}
+
+struct UpdateExptScheduleResponse {
+    1: optional expt.ExptSchedule schedule (api.body = 'schedule')
+
+    255: base.BaseResp BaseResp
+}
+
+struct DeleteExptScheduleRequest {
+    1: required i64 workspace_id (api.body='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
+    2: required i64 schedule_id (api.path='schedule_id', api.js_conv='true', go.tag='json:"schedule_id"')
+
+    255: optional base.Base Base
+}
+
+struct DeleteExptScheduleResponse {
+    255: base.BaseResp BaseResp
+}
+
+struct ListExptSchedulesRequest {
+    1: required i64 workspace_id (api.body='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
+    2: optional i64 template_expt_id (api.body='template_expt_id', api.js_conv='true', go.tag='json:"template_expt_id"')
+    3: optional i32 page_number (api.body='page_number')
+    4: optional i32 page_size (api.body='page_size')
+
+    255: optional base.Base Base
+}
+
+struct ListExptSchedulesResponse {
+    1: optional list<expt.ExptSchedule> schedules (api.body = 'schedules')
+    20: optional i64 total (api.body = "total", go.tag = 'json:"total"')
+
+    255: base.BaseResp BaseResp
+}
+
+struct ListExptScheduleRunsRequest {
+    1: required i64 workspace_id (api.body='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
+    2: required i64 schedule_id (api.path='schedule_id', api.js_conv='true', go.tag='json:"schedule_id"')
+    3: optional i32 page_number (api.body='page_number')
+    4: optional i32 page_size (api.body='page_size')
+
+    255: optional base.Base Base
+}
+
+struct ListExptScheduleRunsResponse {
+    1: optional list<expt.ExptScheduleRun> runs (api.body = 'runs')
+    20: optional i64 total (api.body = "total", go.tag = 'json:"total"')
+
+    255: base.BaseResp BaseResp
+}
 
 service ExperimentService {
 
@@ -481,11 +605,20 @@ service ExperimentService {
 
     KillExperimentResponse KillExperiment(1: KillExperimentRequest req) (api.post = '/api/evaluation/v1/experiments/:expt_id/kill')
 
+    // PauseExperiment 暂停实验，已提交的数据继续执行，不再提交新的数据
+    PauseExperimentResponse PauseExperiment(1: PauseExperimentRequest req) (api.post = '/api/evaluation/v1/experiments/:expt_id/pause')
+
+    // ResumeExperiment 恢复已暂停的实验，继续执行未完成的数据
+    ResumeExperimentResponse ResumeExperiment(1: ResumeExperimentRequest req) (api.post = '/api/evaluation/v1/experiments/:expt_id/resume')
+
     // MGetExperimentResult 获取实验结果
     BatchGetExperimentResultResponse BatchGetExperimentResult(1: BatchGetExperimentResultRequest req) (api.post = "/api/evaluation/v1/experiments/results/batch_get")
 
     BatchGetExperimentAggrResultResponse BatchGetExperimentAggrResult(1: BatchGetExperimentAggrResultRequest req) (api.post = "/api/evaluation/v1/experiments/aggr_results/batch_get")
 
+    // CompareExperiments 与基准实验逐条配对得分，给出显著性检验与退化数据
+    CompareExperimentsResponse CompareExperiments(1: CompareExperimentsRequest req) (api.post = "/api/evaluation/v1/experiments/:expt_id/compare")
+
     // 在线实验
     InvokeExperimentResponse InvokeExperiment(1: InvokeExperimentRequest req)
 
@@ -506,5 +639,13 @@ service ExperimentService {
     ExportExptResultResponse ExportExptResult(1: ExportExptResultRequest req) (api.post="/api/evaluation/v1/experiments/:expt_id/results/export")
     ListExptResultExportRecordResponse ListExptResultExportRecord(1: ListExptResultExportRecordRequest req) (api.post="/api/evaluation/v1/experiments/:expt_id/export_records/list")
     GetExptResultExportRecordResponse GetExptResultExportRecord(1: GetExptResultExportRecordRequest req) (api.post="/api/evaluation/v1/experiments/:expt_id/export_records/:export_id")
+
+    // 定时实验
+    CreateExptScheduleResponse CreateExptSchedule(1: CreateExptScheduleRequest req) (api.post = "/api/evaluation/v1/expt_schedules")
+    UpdateExptScheduleResponse UpdateExptSchedule(1: UpdateExptScheduleRequest req) (api.patch = "/api/evaluation/v1/expt_schedules/:schedule_id")
+    DeleteExptScheduleResponse DeleteExptSchedule(1: DeleteExptScheduleRequest req) (api.delete = "/api/evaluation/v1/expt_schedules/:schedule_id")
+    ListExptSchedulesResponse ListExptSchedules(1: ListExptSchedulesRequest req) (api.post = "/api/evaluation/v1/expt_schedules/list")
+    // ListExptScheduleRuns 按触发时间倒序返回定时实验的历史触发记录
+    ListExptScheduleRunsResponse ListExptScheduleRuns(1: ListExptScheduleRunsRequest req) (api.post = "/api/evaluation/v1/expt_schedules/:schedule_id/runs/list")
 }
 
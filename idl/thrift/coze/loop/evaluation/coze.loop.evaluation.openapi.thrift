@@ -0,0 +1,139 @@
+namespace go coze.loop.evaluation.openapi
+
+include "../../../base.thrift"
+include "../data/domain/dataset.thrift"
+include "./domain/common.thrift"
+include "./domain/eval_set.thrift"
+include "./domain/expt.thrift"
+include "coze.loop.evaluation.eval_target.thrift"
+
+// 创建并运行实验
+struct SubmitExperimentOApiRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"', vt.gt = "0")
+    2: optional i64 eval_set_version_id (api.body = 'eval_set_version_id', api.js_conv = 'true', go.tag = 'json:"eval_set_version_id"')
+    3: optional i64 target_version_id (api.body = 'target_version_id', api.js_conv = 'true', go.tag = 'json:"target_version_id"')
+    4: optional list<i64> evaluator_version_ids (api.body = 'evaluator_version_ids', api.js_conv = 'true', go.tag = 'json:"evaluator_version_ids"')
+    5: optional string name (api.body = 'name')
+    6: optional string desc (api.body = 'desc')
+    7: optional i64 eval_set_id (api.body = 'eval_set_id', api.js_conv = 'true', go.tag = 'json:"eval_set_id"')
+    8: optional i64 target_id (api.body = 'target_id', api.js_conv = 'true', go.tag = 'json:"target_id"')
+
+    20: optional expt.TargetFieldMapping target_field_mapping (api.body = 'target_field_mapping')
+    21: optional list<expt.EvaluatorFieldMapping> evaluator_field_mapping (api.body = 'evaluator_field_mapping')
+    22: optional i32 item_concur_num (api.body = 'item_concur_num')
+    23: optional i32 evaluators_concur_num (api.body = 'evaluators_concur_num')
+    24: optional coze.loop.evaluation.eval_target.CreateEvalTargetParam create_eval_target_param (api.body = 'create_eval_target_param')
+    25: optional common.RuntimeParam target_runtime_param (api.body = 'target_runtime_param')
+
+    255: optional base.Base Base
+}
+
+struct SubmitExperimentOApiResponse {
+    1: optional i32 code (api.body = "code")
+    2: optional string msg (api.body = "msg")
+    3: optional SubmitExperimentOApiData data (api.body = "data")
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct SubmitExperimentOApiData {
+    1: optional expt.Experiment experiment
+    2: optional i64 run_id (api.js_conv = 'true', go.tag = 'json:"run_id"')
+}
+
+// 查询实验，用于轮询实验状态
+struct GetExperimentOApiRequest {
+    1: required i64 workspace_id (api.query = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"', vt.gt = "0")
+    2: required i64 expt_id (api.path = 'expt_id', api.js_conv = 'true', go.tag = 'json:"expt_id"', vt.gt = "0")
+
+    255: optional base.Base Base
+}
+
+struct GetExperimentOApiResponse {
+    1: optional i32 code (api.body = "code")
+    2: optional string msg (api.body = "msg")
+    3: optional GetExperimentOApiData data (api.body = "data")
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct GetExperimentOApiData {
+    1: optional expt.Experiment experiment
+}
+
+// 查询实验的评估器、评测对象聚合结果
+struct GetExperimentAggrResultOApiRequest {
+    1: required i64 workspace_id (api.query = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"', vt.gt = "0")
+    2: required i64 expt_id (api.path = 'expt_id', api.js_conv = 'true', go.tag = 'json:"expt_id"', vt.gt = "0")
+
+    255: optional base.Base Base
+}
+
+struct GetExperimentAggrResultOApiResponse {
+    1: optional i32 code (api.body = "code")
+    2: optional string msg (api.body = "msg")
+    3: optional GetExperimentAggrResultOApiData data (api.body = "data")
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct GetExperimentAggrResultOApiData {
+    1: optional expt.ExptAggregateResult expt_aggregate_result
+}
+
+// 分页查询实验的数据行粒度结果
+struct ListExperimentResultOApiRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"', vt.gt = "0")
+    2: required i64 expt_id (api.path = 'expt_id', api.js_conv = 'true', go.tag = 'json:"expt_id"', vt.gt = "0")
+    3: optional i32 page_number (api.body = 'page_number')
+    4: optional i32 page_size (api.body = 'page_size')
+
+    255: optional base.Base Base
+}
+
+struct ListExperimentResultOApiResponse {
+    1: optional i32 code (api.body = "code")
+    2: optional string msg (api.body = "msg")
+    3: optional ListExperimentResultOApiData data (api.body = "data")
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct ListExperimentResultOApiData {
+    1: optional list<expt.ColumnEvalSetField> column_eval_set_fields
+    2: optional list<expt.ColumnEvaluator> column_evaluators
+    3: optional list<expt.ItemResult> item_results
+    4: optional i64 total (api.js_conv = 'true', go.tag = 'json:"total"')
+}
+
+// 向评测集草稿中批量添加数据
+struct BatchCreateEvaluationSetItemsOApiRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"', vt.gt = "0")
+    2: required i64 evaluation_set_id (api.path = 'evaluation_set_id', api.js_conv = 'true', go.tag = 'json:"evaluation_set_id"', vt.gt = "0")
+    3: optional list<eval_set.EvaluationSetItem> items (api.body = 'items', vt.min_size = '1', vt.max_size = '100')
+    4: optional bool skip_invalid_items (api.body = 'skip_invalid_items')  // items 中存在无效数据时，默认不会写入任何数据；设置为 true 会跳过无效数据，写入有效数据
+    5: optional bool allow_partial_add (api.body = 'allow_partial_add')    // 超出评测集容量限制时，默认不会写入任何数据；设置为 true 会写入不超出容量限制的前 N 条
+
+    255: optional base.Base Base
+}
+
+struct BatchCreateEvaluationSetItemsOApiResponse {
+    1: optional i32 code (api.body = "code")
+    2: optional string msg (api.body = "msg")
+    3: optional BatchCreateEvaluationSetItemsOApiData data (api.body = "data")
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct BatchCreateEvaluationSetItemsOApiData {
+    1: optional map<i64, i64> added_items (api.js_conv = 'true', go.tag = 'json:"added_items"') // key: item 在 items 中的索引
+    2: optional list<dataset.ItemErrorGroup> errors
+}
+
+service EvaluationOpenAPIService {
+    SubmitExperimentOApiResponse SubmitExperimentOApi(1: SubmitExperimentOApiRequest req) (api.tag = "openapi", api.post = '/v1/loop/evaluation/experiments')
+    GetExperimentOApiResponse GetExperimentOApi(1: GetExperimentOApiRequest req) (api.tag = "openapi", api.get = '/v1/loop/evaluation/experiments/:expt_id')
+    GetExperimentAggrResultOApiResponse GetExperimentAggrResultOApi(1: GetExperimentAggrResultOApiRequest req) (api.tag = "openapi", api.get = '/v1/loop/evaluation/experiments/:expt_id/aggr_results')
+    ListExperimentResultOApiResponse ListExperimentResultOApi(1: ListExperimentResultOApiRequest req) (api.tag = "openapi", api.post = '/v1/loop/evaluation/experiments/:expt_id/results/list')
+    BatchCreateEvaluationSetItemsOApiResponse BatchCreateEvaluationSetItemsOApi(1: BatchCreateEvaluationSetItemsOApiRequest req) (api.tag = "openapi", api.post = '/v1/loop/evaluation/evaluation_sets/:evaluation_set_id/items/batch_create')
+}
@@ -4,6 +4,7 @@ include "coze.loop.evaluation.eval_set.thrift"
 include "coze.loop.evaluation.evaluator.thrift"
 include "coze.loop.evaluation.expt.thrift"
 include "coze.loop.evaluation.eval_target.thrift"
+include "coze.loop.evaluation.openapi.thrift"
 
 service EvaluationSetService extends coze.loop.evaluation.eval_set.EvaluationSetService{}
 
@@ -11,4 +12,6 @@ service EvaluatorService extends coze.loop.evaluation.evaluator.EvaluatorService
 
 service ExperimentService extends coze.loop.evaluation.expt.ExperimentService{}
 
-service EvalTargetService extends coze.loop.evaluation.eval_target.EvalTargetService{}
\ No newline at end of file
+service EvalTargetService extends coze.loop.evaluation.eval_target.EvalTargetService{}
+
+service EvaluationOpenAPIService extends coze.loop.evaluation.openapi.EvaluationOpenAPIService{}
\ No newline at end of file
@@ -40,6 +40,8 @@ struct EvalTargetContent {
     102: optional EvalPrompt prompt
     // EvalTargetType=4 时，传参此字段。 评测对象为 CozeWorkflow 时, 需要设置 CozeWorkflow 信息
     103: optional CozeWorkflow coze_workflow
+    // EvalTargetType=5 时，传参此字段。 评测对象为 HTTP 服务时, 需要设置 HTTP 调用信息
+    104: optional HTTPTarget http_target
 }
 
 enum EvalTargetType {
@@ -47,6 +49,38 @@ enum EvalTargetType {
     CozeLoopPrompt = 2 // Prompt
     Trace = 3 // Trace
     CozeWorkflow = 4
+    HTTP = 5 // 自定义 HTTP 服务
+}
+
+struct HTTPTarget {
+    1: optional string url // 请求地址，可用 {{key}} 引用输入变量
+    2: optional string method // 请求方法，默认 POST
+    3: optional map<string, string> headers
+    4: optional HTTPAuth auth
+    5: optional string body_template // 请求体模板，可用 {{key}} 引用输入变量，变量值按 JSON 字符串转义
+    6: optional list<HTTPOutputMapping> output_mappings // 响应字段映射，为空时整个响应体作为 actual_output
+    7: optional i64 timeout_ms (api.js_conv='true', go.tag='json:"timeout_ms"') // 单次请求超时时间
+    8: optional i32 retry_times // 失败重试次数，网络错误、429 与 5xx 时重试
+    9: optional i64 retry_interval_ms (api.js_conv='true', go.tag='json:"retry_interval_ms"')
+}
+
+enum HTTPAuthType {
+    Bearer = 1 // Authorization: Bearer <token>
+    Basic = 2 // Authorization: Basic base64(username:password)
+    APIKey = 3 // <header_name>: <token>
+}
+
+struct HTTPAuth {
+    1: optional HTTPAuthType auth_type
+    2: optional string token
+    3: optional string username
+    4: optional string password
+    5: optional string header_name
+}
+
+struct HTTPOutputMapping {
+    1: optional string field_key // 输出字段名
+    2: optional string json_path // 响应体中的 JSONPath，例如 $.data.answer
 }
 
 struct CozeWorkflow {
@@ -67,6 +101,7 @@ struct EvalPrompt{
     4: optional string prompt_key  // DTO使用，不存数据库
     5: optional SubmitStatus submit_status  // DTO使用，不存数据库
     6: optional string description  // DTO使用，不存数据库
+    7: optional string label // 发布标签，非空时每次执行按标签解析最新指向的提交版本
 }
 
 enum SubmitStatus {
@@ -144,6 +179,7 @@ struct EvalTargetOutputData {
     2: optional EvalTargetUsage eval_target_usage             // 运行消耗
     3: optional EvalTargetRunError eval_target_run_error         // 运行报错
     4: optional i64 time_consuming_ms (api.js_conv='true', go.tag='json:\"time_consuming_ms\"') // 运行耗时
+    5: optional string resolved_source_target_version // 按发布标签执行时实际运行的提交版本
 }
 
 struct EvalTargetUsage {
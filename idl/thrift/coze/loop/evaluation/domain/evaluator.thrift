@@ -6,6 +6,8 @@ include "../../llm/domain/runtime.thrift"
 enum EvaluatorType {
     Prompt = 1
     Code = 2
+    Builtin = 3
+    Pairwise = 4
 }
 
 enum LanguageType {
@@ -13,6 +15,25 @@ enum LanguageType {
     JS = 2
 }
 
+// 内置评估器类型，纯规则计算，不依赖 LLM
+enum BuiltinEvaluatorType {
+    ExactMatch = 1       // 精确匹配
+    FuzzyMatch = 2       // 基于编辑距离的模糊匹配
+    RougeL = 3
+    BLEU = 4
+    EditDistance = 5     // 编辑距离，分数为 1 - 距离/较长文本长度，取值 [0, 1]
+    JSONSchema = 6       // JSON 合法性及 schema 校验
+    Regex = 7            // 正则匹配
+    NumericTolerance = 8 // 数值误差容忍
+}
+
+// 对比评估器的偏好结果，A 为基准实验的输出，B 为当前实验的输出
+enum PairwisePreference {
+    A = 1
+    B = 2
+    Tie = 3
+}
+
 enum PromptSourceType {
     BuiltinTemplate = 1
     LoopPrompt = 2
@@ -60,6 +81,30 @@ struct CodeEvaluator {
     2: optional string code
 }
 
+struct BuiltinEvaluatorConfig {
+    1: optional bool case_sensitive         // 字符串比较是否区分大小写
+    2: optional bool ignore_whitespace      // 比较前是否去除首尾空白并合并连续空白
+    3: optional double threshold            // 模糊匹配阈值，设置后分数为 0/1
+    4: optional string pattern              // 正则表达式
+    5: optional bool full_match             // 正则是否要求整串匹配
+    6: optional string json_schema          // 为空时只校验 JSON 合法性
+    7: optional double tolerance            // 数值容忍误差
+    8: optional bool relative_tolerance     // 是否按参考值的相对误差计算
+    9: optional i32 max_ngram               // BLEU 最大 n-gram 阶数，默认 4
+}
+
+struct BuiltinEvaluator {
+    1: optional BuiltinEvaluatorType builtin_evaluator_type
+    2: optional BuiltinEvaluatorConfig config
+}
+
+// 对比评估器，由 LLM 比较两个实验在同一评测集数据上的输出 output_a、output_b 并给出偏好
+struct PairwiseEvaluator {
+    1: list<common.Message> message_list
+    2: optional common.ModelConfig model_config
+    3: optional bool swap_check             // 交换 A、B 位置再评估一次，两次结论不一致时判为平局，用于消除位置偏差
+}
+
 struct EvaluatorVersion {
     1: optional i64 id (api.js_conv = 'true', go.tag = 'json:"id"')          // 版本id
     3: optional string version
@@ -75,6 +120,8 @@ struct EvaluatorContent {
     // 101-200 Evaluator类型
     101: optional PromptEvaluator prompt_evaluator (go.tag ='mapstructure:"prompt_evaluator"')
     102: optional CodeEvaluator code_evaluator
+    103: optional BuiltinEvaluator builtin_evaluator
+    104: optional PairwiseEvaluator pairwise_evaluator
 }
 
 struct Evaluator {
@@ -123,6 +170,7 @@ struct EvaluatorResult {
     1: optional double score
     2: optional Correction correction
     3: optional string reasoning
+    4: optional PairwisePreference preference   // 仅对比评估器返回
 }
 
 struct EvaluatorUsage {
@@ -11,6 +11,7 @@ enum ExptStatus {
 
     Pending = 2    // Awaiting execution
     Processing = 3 // In progress
+    Paused = 4     // Paused, no new items are submitted
 
     Success = 11   // Execution succeeded
     Failed = 12    // Execution failed
@@ -60,6 +61,13 @@ struct Experiment {
     41: optional i64 max_alive_time
     42: optional SourceType source_type
     43: optional string source_id
+
+    50: optional ExptBudget budget
+}
+
+// 实验预算，超出后实验被系统终止
+struct ExptBudget {
+    1: optional i64 max_tokens (api.js_conv='true', go.tag='json:"max_tokens"') // 评测对象与评估器的 input + output token 总量上限
 }
 
 struct TokenUsage {
@@ -91,6 +99,10 @@ struct EvaluatorFieldMapping {
     1: required i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"')
     2: optional list<FieldMapping> from_eval_set
     3: optional list<FieldMapping> from_target
+    4: optional i64 baseline_expt_id (api.js_conv='true', go.tag='json:"baseline_expt_id"') // 对比评估器的基准实验
+    5: optional list<FieldMapping> from_baseline_target                                     // 对比评估器从基准实验的评测对象输出中取值
+    6: optional list<AggregatorType> aggregator_types                                       // 额外启用的聚合器，支持 P50/P90/P99/StdDev/PassRate
+    7: optional double pass_threshold                                                       // 得分不低于该值视为通过，启用 PassRate 时必填
 }
 
 struct FieldMapping {
@@ -336,6 +348,7 @@ struct ExptAggregateResult {
     2: optional map<i64, EvaluatorAggregateResult> evaluator_results (go.tag = 'json:"evaluator_results"')
     3: optional ExptAggregateCalculateStatus status
     4: optional map<i64, AnnotationAggregateResult> annotation_results (go.tag = 'json:"annotation_results"')    // tag_key_id -> result
+    5: optional TargetAggregateResult target_result
 }
 
 // 评估器版本粒度聚合结果
@@ -346,6 +359,13 @@ struct EvaluatorAggregateResult {
     4: optional string version
 }
 
+// 评测对象运行指标聚合结果
+struct TargetAggregateResult {
+    1: optional list<AggregatorResult> latency_ms     // 运行耗时，单位毫秒
+    2: optional list<AggregatorResult> input_tokens
+    3: optional list<AggregatorResult> output_tokens
+}
+
 // 人工标注项粒度聚合结果
 struct AnnotationAggregateResult {
     1: required i64 tag_key_id (api.js_conv = 'true', go.tag = 'json:"tag_key_id"')
@@ -366,6 +386,14 @@ enum AggregatorType {
       Max = 3
       Min = 4
       Distribution = 5; // 得分的分布情况
+      WinRate = 6;      // 对比评估器中当前实验的胜率
+      TieRate = 7;      // 对比评估器中的平局率
+      LossRate = 8;     // 对比评估器中当前实验的负率
+      P50 = 9;          // 中位数
+      P90 = 10;
+      P99 = 11;
+      StdDev = 12;      // 样本标准差
+      PassRate = 13;    // 得分不低于通过阈值的比例，未配置通过阈值时不计算，聚合结果中不包含该项
 }
 
 enum DataType {
@@ -427,6 +455,9 @@ struct ColumnAnnotation {
 typedef string ExptResultExportType(ts.enum="true")
 
 const ExptResultExportType ExptResultExportType_CSV = "CSV"
+const ExptResultExportType ExptResultExportType_JSONL = "JSONL"
+const ExptResultExportType ExptResultExportType_Parquet = "Parquet"
+const ExptResultExportType ExptResultExportType_XLSX = "XLSX"
 
 typedef string CSVExportStatus(ts.enum="true")
 
@@ -447,3 +478,74 @@ struct ExptResultExportRecord {
     9: optional bool expired
     10: optional RunError error
 }
+
+// 置信区间
+struct ConfidenceInterval {
+    1: optional double lower
+    2: optional double upper
+    3: optional double confidence_level
+}
+
+// 配对 t 检验结果
+struct PairedTTestResult {
+    1: optional double t_statistic
+    2: optional double p_value
+    3: optional i32 degrees_of_freedom
+    4: optional ConfidenceInterval confidence_interval  // 得分差均值的 t 分布置信区间
+}
+
+// 单条数据上的得分变化
+struct ItemScoreDiff {
+    1: required i64 item_id (api.js_conv = 'true', go.tag = 'json:"item_id"')
+    2: optional i64 turn_id (api.js_conv = 'true', go.tag = 'json:"turn_id"')
+    3: optional double baseline_score
+    4: optional double score
+    5: optional double diff     // score - baseline_score
+}
+
+// 评估器版本粒度的实验对比结果，差值均为当前实验减去基准实验
+struct EvaluatorCompareResult {
+    1: required i64 evaluator_version_id (api.js_conv = 'true', go.tag = 'json:"evaluator_version_id"')
+    2: optional i32 paired_count                            // 两个实验均有得分的数据条数
+    3: optional double baseline_mean
+    4: optional double mean
+    5: optional double mean_diff
+    6: optional PairedTTestResult t_test
+    7: optional ConfidenceInterval bootstrap_confidence_interval
+    8: optional bool significant                            // t 检验的 p 值小于 1 - confidence_level
+    9: optional list<ItemScoreDiff> regressions             // 得分下降超过阈值的数据，按下降幅度倒序
+}
+
+// 定时实验，按 cron 表达式以模板实验为蓝本周期性创建并运行新实验
+struct ExptSchedule {
+    1: optional i64 id (api.js_conv = 'true', go.tag = 'json:"id"')
+    2: optional i64 workspace_id (api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    3: optional string name
+    4: optional string desc
+    5: optional i64 template_expt_id (api.js_conv = 'true', go.tag = 'json:"template_expt_id"')  // 模板实验，沿用其评测集、评测对象、评估器与字段映射
+    6: optional string cron_expr                                                               // 5 段 cron 表达式 (分 时 日 月 周)，也支持 @daily 等
+    7: optional string timezone                                                                // IANA 时区，为空时为 UTC
+    8: optional bool use_latest_target_version                                                 // 使用评测对象的最新提交版本，仅 Prompt 评测对象生效
+    9: optional bool use_latest_eval_set_version                                               // 使用评测集的最新版本
+    10: optional bool enabled
+    11: optional i64 next_trigger_time (api.js_conv = 'true', go.tag = 'json:"next_trigger_time"')
+    12: optional i64 last_trigger_time (api.js_conv = 'true', go.tag = 'json:"last_trigger_time"')
+    13: optional common.BaseInfo base_info
+}
+
+typedef string ExptScheduleRunStatus(ts.enum="true")
+
+const ExptScheduleRunStatus ExptScheduleRunStatus_Unknown = "Unknown"
+const ExptScheduleRunStatus ExptScheduleRunStatus_Success = "Success"
+const ExptScheduleRunStatus ExptScheduleRunStatus_Failed = "Failed"
+
+// 定时实验的一次触发记录，可结合 BatchGetExperimentAggrResult 绘制趋势
+struct ExptScheduleRun {
+    1: optional i64 id (api.js_conv = 'true', go.tag = 'json:"id"')
+    2: optional i64 schedule_id (api.js_conv = 'true', go.tag = 'json:"schedule_id"')
+    3: optional i64 expt_id (api.js_conv = 'true', go.tag = 'json:"expt_id"')
+    4: optional i64 run_id (api.js_conv = 'true', go.tag = 'json:"run_id"')
+    5: optional ExptScheduleRunStatus status
+    6: optional string error_message
+    7: optional i64 trigger_time (api.js_conv = 'true', go.tag = 'json:"trigger_time"')
+}
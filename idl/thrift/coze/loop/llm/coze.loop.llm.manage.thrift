@@ -35,7 +35,88 @@ struct GetModelResponse {
     255: base.BaseResp BaseResp
 }
 
+struct CreateModelRequest {
+    1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
+    2: optional manage.Model model (vt.not_nil='true')
+
+    255: optional base.Base Base
+}
+
+struct CreateModelResponse {
+    1: optional i64 model_id (api.js_conv='true', go.tag='json:"model_id"')
+
+    255: base.BaseResp BaseResp
+}
+
+struct UpdateModelRequest {
+    1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
+    2: optional i64 model_id (api.js_conv='true', api.path='model_id', vt.not_nil='true', vt.gt='0', go.tag='json:"model_id"')
+    3: optional string name
+    4: optional string desc
+    5: optional manage.Ability ability
+    6: optional manage.Protocol protocol
+    7: optional manage.ProtocolConfig protocol_config // 为空时保持原有协议配置（含密钥）不变
+    8: optional map<common.Scenario, manage.ScenarioConfig> scenario_configs
+    9: optional manage.ParamConfig param_config
+    10: optional manage.Price price
+
+    255: optional base.Base Base
+}
+
+struct UpdateModelResponse {
+    255: base.BaseResp BaseResp
+}
+
+struct DeleteModelRequest {
+    1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
+    2: optional i64 model_id (api.js_conv='true', api.path='model_id', vt.not_nil='true', vt.gt='0', go.tag='json:"model_id"')
+
+    255: optional base.Base Base
+}
+
+struct DeleteModelResponse {
+    255: base.BaseResp BaseResp
+}
+
+struct QueryModelUsageRequest {
+    1: optional list<i64> workspace_ids (api.js_conv='true', vt.min_size='1', vt.max_size='100', go.tag='json:"workspace_ids"')
+    2: optional i64 start_time (api.js_conv='true', vt.not_nil='true', go.tag='json:"start_time"') // 毫秒时间戳，包含
+    3: optional i64 end_time (api.js_conv='true', vt.not_nil='true', go.tag='json:"end_time"') // 毫秒时间戳，不包含
+    4: optional list<manage.UsageDimension> group_by
+    5: optional manage.UsageGranularity granularity // 为空时不按时间分桶
+    6: optional string user_id
+    7: optional i64 model_id (api.js_conv='true', go.tag='json:"model_id"')
+    8: optional common.Scenario scenario
+
+    255: optional base.Base Base
+}
+
+struct QueryModelUsageResponse {
+    1: optional list<manage.ModelUsageStat> stats
+
+    255: base.BaseResp BaseResp
+}
+
+struct GetQuotaStatusRequest {
+    1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
+
+    255: optional base.Base Base
+}
+
+struct GetQuotaStatusResponse {
+    1: optional list<manage.QuotaStatus> quotas
+
+    255: base.BaseResp BaseResp
+}
+
 service LLMManageService {
     ListModelsResponse ListModels(1: ListModelsRequest req) (api.post="/api/llm/v1/models/list")
     GetModelResponse GetModel(1: GetModelRequest req) (api.post="/api/llm/v1/models/:model_id")
+    CreateModelResponse CreateModel(1: CreateModelRequest req) (api.post="/api/llm/v1/models")
+    UpdateModelResponse UpdateModel(1: UpdateModelRequest req) (api.put="/api/llm/v1/models/:model_id")
+    DeleteModelResponse DeleteModel(1: DeleteModelRequest req) (api.delete="/api/llm/v1/models/:model_id")
+    // 按空间、用户、模型、场景及时间窗口聚合模型调用的 token、成本、错误率与耗时
+    QueryModelUsageResponse QueryModelUsage(1: QueryModelUsageRequest req) (api.post="/api/llm/v1/usage/query")
+    // 查询空间在当前日/月周期内的 token 与成本额度使用情况
+    GetQuotaStatusResponse GetQuotaStatus(1: GetQuotaStatusRequest req) (api.post="/api/llm/v1/quotas/status")
 }
\ No newline at end of file
@@ -0,0 +1,32 @@
+namespace go coze.loop.llm.openapi
+
+include "../../../base.thrift"
+
+struct ChatCompletionsRequest {
+    // OpenAI chat completions 格式的请求体
+    1: required binary body (api.body="body", agw.source="raw_body")
+    2: required i64 workspace_id (api.path="workspace_id", api.js_conv='true', go.tag='json:"workspace_id"')
+
+    255: optional base.Base Base
+}
+
+struct ChatCompletionsResponse {
+    // OpenAI chat.completion 格式的响应体
+    1: optional binary body (api.body="body")
+
+    255: base.BaseResp BaseResp
+}
+
+struct ChatCompletionsStreamResponse {
+    // OpenAI chat.completion.chunk 格式的数据
+    1: optional binary chunk
+
+    255: optional base.BaseResp BaseResp
+}
+
+service LLMOpenAPIService {
+    // 兼容 OpenAI 的非流式接口
+    ChatCompletionsResponse ChatCompletions(1: ChatCompletionsRequest req) (api.tag="openapi", api.post='/v1/loop/llm/openai/:workspace_id/chat/completions')
+    // 兼容 OpenAI 的流式接口，与非流式接口共用路由，由请求体中的 stream 字段区分
+    ChatCompletionsStreamResponse ChatCompletionsStream(1: ChatCompletionsRequest req) (streaming.mode='server')
+}
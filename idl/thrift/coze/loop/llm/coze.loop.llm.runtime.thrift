@@ -21,9 +21,30 @@ struct ChatResponse {
     255: base.BaseResp BaseResp
 }
 
+struct EmbedRequest {
+    // 向量化模型id
+    1: optional i64 model_id (api.js_conv='true', go.tag='json:"model_id"')
+    // 待向量化的文本，返回的向量与之一一对应
+    2: optional list<string> texts
+    // 输出向量的维度，仅部分模型支持
+    3: optional i32 dimensions
+    // 业务参数
+    4: optional runtime.BizParam biz_param
+
+    255: optional base.Base Base
+}
+struct EmbedResponse {
+    1: optional list<list<double>> embeddings
+    2: optional runtime.TokenUsage usage
+
+    255: base.BaseResp BaseResp
+}
+
 service LLMRuntimeService {
     // 非流式接口
     ChatResponse Chat(1: ChatRequest req)
     // 流式接口
     ChatResponse ChatStream(1: ChatRequest req) (streaming.mode="server")
+    // 批量向量化接口
+    EmbedResponse Embed(1: EmbedRequest req)
 }
\ No newline at end of file
@@ -2,6 +2,8 @@ namespace go coze.loop.prompt
 
 include "coze.loop.llm.manage.thrift"
 include "coze.loop.llm.runtime.thrift"
+include "coze.loop.llm.openapi.thrift"
 
 service LLMManageService extends coze.loop.llm.manage.LLMManageService {}
-service LLMRuntimeService extends coze.loop.llm.runtime.LLMRuntimeService {}
\ No newline at end of file
+service LLMRuntimeService extends coze.loop.llm.runtime.LLMRuntimeService {}
+service LLMOpenAPIService extends coze.loop.llm.openapi.LLMOpenAPIService {}
\ No newline at end of file
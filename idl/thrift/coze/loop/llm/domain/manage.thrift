@@ -12,6 +12,7 @@ struct Model {
     7: optional ProtocolConfig protocol_config
     8: optional map<common.Scenario, ScenarioConfig> scenario_configs
     9: optional ParamConfig param_config
+    10: optional Price price // 模型单价，用于统计调用成本
 }
 
 struct Ability {
@@ -22,6 +23,8 @@ struct Ability {
     5: optional bool json_mode
     6: optional bool multi_modal
     7: optional AbilityMultiModal ability_multi_modal
+    // 是否为向量化模型，向量化模型只能通过向量化接口调用
+    8: optional bool embedding
 }
 
 struct AbilityMultiModal {
@@ -120,6 +123,19 @@ struct ScenarioConfig {
     1: optional common.Scenario scenario
     3: optional Quota quota
     4: optional bool unavailable
+    5: optional RoutingPolicy routing // 该场景下的路由策略
+}
+
+struct RoutingPolicy {
+    1: optional i64 weight (api.js_conv='true', go.tag='json:"weight"') // 主模型在负载均衡中的权重
+    2: optional list<RoutingTarget> balance // 与主模型等价的模型，按权重分流
+    3: optional list<RoutingTarget> fallbacks // 请求失败时按顺序降级的模型
+    4: optional list<ModelErrorType> fallback_on // 触发降级的错误类型，为空时限流、超时、服务端错误均降级
+}
+
+struct RoutingTarget {
+    1: optional i64 model_id (api.js_conv='true', go.tag='json:"model_id"')
+    2: optional i64 weight (api.js_conv='true', go.tag='json:"weight"')
 }
 
 struct ParamConfig {
@@ -142,6 +158,44 @@ struct ParamOption {
     2: optional string label // 展示值
 }
 
+// 单价均为每百万 token 的价格，全局使用同一币种
+struct Price {
+    1: optional double input_price
+    2: optional double output_price
+}
+
+// 模型调用统计，未参与分组的维度为空
+struct ModelUsageStat {
+    1: optional i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"')
+    2: optional string user_id
+    3: optional i64 model_id (api.js_conv='true', go.tag='json:"model_id"')
+    4: optional string model_name
+    5: optional common.Scenario scenario
+    6: optional i64 time_bucket (api.js_conv='true', go.tag='json:"time_bucket"') // 时间桶的起始时间，毫秒时间戳
+
+    20: optional i64 request_count (api.js_conv='true', go.tag='json:"request_count"')
+    21: optional i64 error_count (api.js_conv='true', go.tag='json:"error_count"')
+    22: optional double error_rate
+    23: optional i64 cache_hit_count (api.js_conv='true', go.tag='json:"cache_hit_count"')
+    24: optional i64 input_tokens (api.js_conv='true', go.tag='json:"input_tokens"')
+    25: optional i64 output_tokens (api.js_conv='true', go.tag='json:"output_tokens"')
+    26: optional double cost
+    27: optional double avg_latency_ms
+}
+
+// 空间或模型在当前周期内的额度使用情况
+struct QuotaStatus {
+    1: optional i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"')
+    2: optional i64 model_id (api.js_conv='true', go.tag='json:"model_id"') // 为0时表示空间内所有模型共用的额度
+    3: optional QuotaPeriod period
+    4: optional i64 max_tokens (api.js_conv='true', go.tag='json:"max_tokens"') // 为0时不限制
+    5: optional i64 used_tokens (api.js_conv='true', go.tag='json:"used_tokens"')
+    6: optional double max_cost // 为0时不限制
+    7: optional double used_cost
+    8: optional i64 reset_at (api.js_conv='true', go.tag='json:"reset_at"') // 额度重置时间，毫秒时间戳
+    9: optional bool exceeded
+}
+
 struct Quota {
     1: optional i64 qpm (api.js_conv='true', go.tag='json:"qpm"')
     2: optional i64 tpm (api.js_conv='true', go.tag='json:"tpm"')
@@ -158,8 +212,28 @@ const Protocol protocol_qwen = "qwen"
 const Protocol protocol_qianfan = "qianfan"
 const Protocol protocol_arkbot = "arkbot"
 
+typedef string ModelErrorType (ts.enum="true")
+const ModelErrorType model_error_type_rate_limit = "rate_limit"
+const ModelErrorType model_error_type_timeout = "timeout"
+const ModelErrorType model_error_type_server_error = "server_error"
+
 typedef string ParamType (ts.enum="true")
 const ParamType param_type_float = "float"
 const ParamType param_type_int = "int"
 const ParamType param_type_boolean = "boolean"
-const ParamType param_type_string = "string"
\ No newline at end of file
+const ParamType param_type_string = "string"
+
+typedef string UsageDimension (ts.enum="true")
+const UsageDimension usage_dimension_workspace = "workspace"
+const UsageDimension usage_dimension_user = "user"
+const UsageDimension usage_dimension_model = "model"
+const UsageDimension usage_dimension_scenario = "scenario"
+
+typedef string UsageGranularity (ts.enum="true")
+const UsageGranularity usage_granularity_hour = "hour"
+const UsageGranularity usage_granularity_day = "day"
+const UsageGranularity usage_granularity_month = "month"
+
+typedef string QuotaPeriod (ts.enum="true")
+const QuotaPeriod quota_period_day = "day"
+const QuotaPeriod quota_period_month = "month"
\ No newline at end of file
@@ -8,6 +8,7 @@ include "./domain/filter.thrift"
 include "./domain/view.thrift"
 include "./domain/annotation.thrift"
 include "./domain/export_dataset.thrift"
+include "./domain/online_eval.thrift"
 
 struct ListSpansRequest {
     1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
@@ -289,6 +290,59 @@ struct PreviewExportTracesToDatasetResponse {
     257: optional string Msg (agw.key = "msg")    // 仅供http请求使用; 内部RPC不予使用，统一通过BaseResp获取Code和Msg
 }
 
+struct CreateOnlineEvalRuleRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
+    2: required online_eval.OnlineEvalRule rule (api.body="rule")
+
+    255: optional base.Base Base
+}
+
+struct CreateOnlineEvalRuleResponse {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"', api.body="id")
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct UpdateOnlineEvalRuleRequest {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"', api.path="rule_id")
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
+    3: optional string name (api.body="name")
+    4: optional bool enabled (api.body="enabled")
+    5: optional filter.FilterFields span_filters (api.body="span_filters")
+    6: optional double sample_rate (api.body="sample_rate")
+    7: optional list<i64> evaluator_version_ids (api.js_conv='true', go.tag='json:"evaluator_version_ids"', api.body="evaluator_version_ids")
+    8: optional list<online_eval.OnlineEvalFieldMapping> field_mappings (api.body="field_mappings")
+
+    255: optional base.Base Base
+}
+
+struct UpdateOnlineEvalRuleResponse {
+    255: optional base.BaseResp BaseResp
+}
+
+struct DeleteOnlineEvalRuleRequest {
+    1: required i64 id (api.path="rule_id", api.js_conv='true', go.tag='json:"id"'),
+    2: required i64 workspace_id (api.query='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"'),
+
+    255: optional base.Base Base
+}
+
+struct DeleteOnlineEvalRuleResponse {
+    255: optional base.BaseResp BaseResp
+}
+
+struct ListOnlineEvalRulesRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
+
+    255: optional base.Base Base
+}
+
+struct ListOnlineEvalRulesResponse {
+    1: required list<online_eval.OnlineEvalRule> rules
+
+    255: optional base.BaseResp BaseResp
+}
+
 service TraceService {
     ListSpansResponse ListSpans(1: ListSpansRequest req) (api.post = '/api/observability/v1/spans/list')
     GetTraceResponse GetTrace(1: GetTraceRequest req) (api.get = '/api/observability/v1/traces/:trace_id')
@@ -305,4 +359,8 @@ service TraceService {
     ListAnnotationsResponse ListAnnotations(1: ListAnnotationsRequest req) (api.post = '/api/observability/v1/annotations/list')
     ExportTracesToDatasetResponse ExportTracesToDataset(1: ExportTracesToDatasetRequest Req)(api.post = '/api/observability/v1/traces/export_to_dataset')
     PreviewExportTracesToDatasetResponse PreviewExportTracesToDataset(1: PreviewExportTracesToDatasetRequest Req)(api.post = '/api/observability/v1/traces/preview_export_to_dataset')
+    CreateOnlineEvalRuleResponse CreateOnlineEvalRule(1: CreateOnlineEvalRuleRequest req) (api.post = '/api/observability/v1/online_eval_rules')
+    UpdateOnlineEvalRuleResponse UpdateOnlineEvalRule(1: UpdateOnlineEvalRuleRequest req) (api.put = '/api/observability/v1/online_eval_rules/:rule_id')
+    DeleteOnlineEvalRuleResponse DeleteOnlineEvalRule(1: DeleteOnlineEvalRuleRequest req) (api.delete = '/api/observability/v1/online_eval_rules/:rule_id')
+    ListOnlineEvalRulesResponse ListOnlineEvalRules(1: ListOnlineEvalRulesRequest req) (api.post = '/api/observability/v1/online_eval_rules/list')
 }
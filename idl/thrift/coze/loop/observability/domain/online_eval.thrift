@@ -0,0 +1,25 @@
+namespace go coze.loop.observability.domain.online_eval
+
+include "common.thrift"
+include "filter.thrift"
+
+// 在线评测规则: 对新上报且满足过滤条件的 span 按采样率运行评估器, 并将得分回写为 span 标注
+struct OnlineEvalRule {
+    1: optional i64 id (api.js_conv="true", go.tag='json:"id"')
+    2: optional i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"')
+    3: optional string name
+    4: optional bool enabled
+    5: optional filter.FilterFields span_filters
+    6: optional double sample_rate // (0, 1]
+    7: optional list<i64> evaluator_version_ids (api.js_conv="true", go.tag='json:"evaluator_version_ids"')
+    8: optional list<OnlineEvalFieldMapping> field_mappings
+
+    100: optional common.BaseInfo base_info
+}
+
+// 评估器输入字段与 span 字段的映射, trace_field_key 取值 Input/Output/Tags.xxx
+struct OnlineEvalFieldMapping {
+    1: required string evaluator_field_key
+    2: required string trace_field_key
+    3: optional string trace_field_jsonpath
+}
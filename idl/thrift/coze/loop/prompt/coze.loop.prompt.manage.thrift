@@ -30,6 +30,18 @@ service PromptManageService {
     ListCommitResponse ListCommit(1: ListCommitRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/commits/list')
     CommitDraftResponse CommitDraft(1: CommitDraftRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/drafts/commit')
     RevertDraftFromCommitResponse RevertDraftFromCommit(1: RevertDraftFromCommitRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/drafts/revert_from_commit')
+    DiffCommitResponse DiffCommit(1: DiffCommitRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/commits/diff')
+
+// --------------- Prompt发布标签 --------------- //
+
+    SetPromptLabelResponse SetPromptLabel(1: SetPromptLabelRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/labels/set')
+    ListPromptLabelResponse ListPromptLabel(1: ListPromptLabelRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/labels/list')
+    ListPromptLabelHistoryResponse ListPromptLabelHistory(1: ListPromptLabelHistoryRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/labels/history/list')
+    RollbackPromptLabelResponse RollbackPromptLabel(1: RollbackPromptLabelRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/labels/rollback')
+
+// --------------- Prompt片段 --------------- //
+
+    ListSnippetReferenceResponse ListSnippetReference(1: ListSnippetReferenceRequest request) (api.post = '/api/prompt/v1/prompts/:prompt_id/snippet_references/list')
 
 }
 
@@ -41,6 +53,7 @@ struct CreatePromptRequest {
     11: optional string prompt_name (vt.not_nil="true", vt.min_size="1")
     12: optional string prompt_key (vt.not_nil="true", vt.min_size="1")
     13: optional string prompt_description
+    14: optional prompt.PromptType prompt_type
 
     21: optional prompt.PromptDetail draft_detail
 
@@ -103,6 +116,7 @@ struct PromptQuery {
 
     11: optional bool with_commit
     12: optional string commit_version
+    13: optional string label // 发布标签，commit_version为空时按标签解析提交版本
 }
 
 struct BatchGetPromptRequest {
@@ -128,6 +142,7 @@ struct ListPromptRequest {
     11: optional string key_word
     12: optional list<string> created_bys
     13: optional bool committed_only
+    14: optional list<prompt.PromptType> prompt_types
 
     127: optional i32 page_num (vt.not_nil="true", vt.gt="0")
     128: optional i32 page_size (vt.not_nil="true", vt.gt="0", vt.le="100")
@@ -216,3 +231,103 @@ struct RevertDraftFromCommitRequest {
 struct RevertDraftFromCommitResponse {
     255: optional base.BaseResp  BaseResp
 }
+
+// 对比两个提交版本的差异
+struct DiffCommitRequest {
+    1: optional i64 prompt_id (api.path='prompt_id', api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"prompt_id"')
+    2: optional string base_commit_version (vt.not_nil="true", vt.min_size="1")
+    3: optional string target_commit_version (vt.not_nil="true", vt.min_size="1")
+
+    255: optional base.Base Base
+}
+struct DiffCommitResponse {
+    1: optional prompt.PromptDetailDiff diff
+
+    255: optional base.BaseResp  BaseResp
+}
+
+// --------------- Prompt发布标签 --------------- //
+
+// 创建标签或将标签移动到指定提交版本
+struct SetPromptLabelRequest {
+    1: optional i64 prompt_id (api.path='prompt_id', api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"prompt_id"')
+
+    11: optional string label_key (vt.not_nil="true", vt.min_size="1")
+    12: optional string commit_version (vt.not_nil="true", vt.min_size="1")
+
+    255: optional base.Base Base
+}
+struct SetPromptLabelResponse {
+    1: optional prompt.PromptLabel label
+
+    255: optional base.BaseResp  BaseResp
+}
+
+struct ListPromptLabelRequest {
+    1: optional i64 prompt_id (api.path='prompt_id', api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"prompt_id"')
+
+    255: optional base.Base Base
+}
+struct ListPromptLabelResponse {
+    1: optional list<prompt.PromptLabel> labels
+
+    11: optional list<user.UserInfoDetail> users
+
+    255: optional base.BaseResp  BaseResp
+}
+
+struct ListPromptLabelHistoryRequest {
+    1: optional i64 prompt_id (api.path='prompt_id', api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"prompt_id"')
+
+    11: optional string label_key
+
+    127: optional i32 page_size (vt.not_nil="true", vt.gt="0", vt.le="100")
+    128: optional string page_token
+
+    255: optional base.Base Base
+}
+struct ListPromptLabelHistoryResponse {
+    1: optional list<prompt.PromptLabelHistory> histories
+
+    11: optional list<user.UserInfoDetail> users
+
+    127: optional bool has_more
+    128: optional string next_page_token
+
+    255: optional base.BaseResp  BaseResp
+}
+
+// 将标签回滚到某条变更历史中的提交版本
+struct RollbackPromptLabelRequest {
+    1: optional i64 prompt_id (api.path='prompt_id', api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"prompt_id"')
+
+    11: optional string label_key (vt.not_nil="true", vt.min_size="1")
+    12: optional i64 history_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"history_id"')
+
+    255: optional base.Base Base
+}
+struct RollbackPromptLabelResponse {
+    1: optional prompt.PromptLabel label
+
+    255: optional base.BaseResp  BaseResp
+}
+
+// --------------- Prompt片段 --------------- //
+
+// 查询引用了该片段的Prompt提交版本
+struct ListSnippetReferenceRequest {
+    1: optional i64 prompt_id (api.path='prompt_id', api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"prompt_id"')
+
+    127: optional i32 page_size (vt.not_nil="true", vt.gt="0", vt.le="100")
+    128: optional string page_token
+
+    255: optional base.Base Base
+}
+struct ListSnippetReferenceResponse {
+    1: optional list<prompt.SnippetReference> references
+
+    127: optional bool has_more
+    128: optional string next_page_token
+
+    255: optional base.BaseResp  BaseResp
+}
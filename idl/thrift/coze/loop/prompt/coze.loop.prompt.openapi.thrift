@@ -5,6 +5,8 @@ include "./domain/prompt.thrift"
 
 service PromptOpenAPIService {
     BatchGetPromptByPromptKeyResponse BatchGetPromptByPromptKey(1: BatchGetPromptByPromptKeyRequest req) (api.tag="openapi", api.post='/v1/loop/prompts/mget')
+    ExecuteResponse Execute(1: ExecuteRequest req) (api.tag="openapi", api.post='/v1/loop/prompts/execute')
+    ExecuteStreamingResponse ExecuteStreaming(1: ExecuteRequest req) (api.tag="openapi", api.post='/v1/loop/prompts/execute_streaming', streaming.mode='server')
 }
 
 struct BatchGetPromptByPromptKeyRequest {
@@ -22,6 +24,35 @@ struct BatchGetPromptByPromptKeyResponse {
     255: optional base.BaseResp  BaseResp
 }
 
+struct ExecuteRequest {
+    1: optional i64 workspace_id (api.body="workspace_id", api.js_conv='true', go.tag='json:"workspace_id"')
+    2: optional PromptQuery prompt_identifier (api.body="prompt_identifier") // Prompt标识，version为空时使用最新版本
+    3: optional list<VariableVal> variable_vals (api.body="variable_vals") // 变量值
+    4: optional list<Message> messages (api.body="messages") // 追加在模板之后的消息
+
+    255: optional base.Base Base
+}
+
+struct ExecuteResponse {
+    1: optional i32 code
+    2: optional string msg
+    3: optional ExecuteData data
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct ExecuteStreamingResponse {
+    1: optional ExecuteData data
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct ExecuteData {
+    1: optional Message message // 模型回复，流式接口中为增量内容
+    2: optional string finish_reason
+    3: optional TokenUsage usage
+}
+
 struct PromptResultData {
     1: optional list<PromptResult> items
 }
@@ -29,6 +60,7 @@ struct PromptResultData {
 struct PromptQuery {
     1: optional string prompt_key
     2: optional string version
+    3: optional string label // 发布标签，version为空时按标签解析提交版本
 }
 
 struct PromptResult {
@@ -70,6 +102,17 @@ struct Message {
     2: optional string content
 }
 
+struct VariableVal {
+    1: optional string key // 变量名字
+    2: optional string value // 变量值
+    3: optional list<Message> placeholder_messages // placeholder变量的消息列表
+}
+
+struct TokenUsage {
+    1: optional i64 input_tokens (api.js_conv="true", go.tag='json:"input_tokens"')
+    2: optional i64 output_tokens (api.js_conv="true", go.tag='json:"output_tokens"')
+}
+
 struct VariableDef {
      1: optional string key // 变量名字
      2: optional string desc // 变量描述
@@ -18,9 +18,13 @@ struct PromptBasic {
     6: optional i64 created_at (api.js_conv="true", go.tag='json:"created_at"')
     7: optional i64 updated_at (api.js_conv="true", go.tag='json:"updated_at"')
     8: optional i64 latest_committed_at (api.js_conv="true", go.tag='json:"latest_committed_at"')
-
+    9: optional PromptType prompt_type
 }
 
+typedef string PromptType (ts.enum="true")
+const PromptType PromptType_Normal = "normal"
+const PromptType PromptType_Snippet = "snippet"
+
 struct PromptCommit {
     1: optional PromptDetail detail
     2: optional CommitInfo commit_info
@@ -34,6 +38,39 @@ struct CommitInfo {
     5: optional i64 committed_at (api.js_conv="true", go.tag='json:"committed_at"')
 }
 
+struct PromptLabel {
+    1: optional string label_key
+    2: optional string commit_version
+
+    11: optional string created_by
+    12: optional string updated_by
+    13: optional i64 created_at (api.js_conv="true", go.tag='json:"created_at"')
+    14: optional i64 updated_at (api.js_conv="true", go.tag='json:"updated_at"')
+}
+
+struct PromptLabelHistory {
+    1: optional i64 id (api.js_conv="true", go.tag='json:"id"')
+    2: optional string label_key
+    3: optional string commit_version
+    4: optional string previous_commit_version
+    5: optional PromptLabelOperation operation
+    6: optional string operated_by
+    7: optional i64 operated_at (api.js_conv="true", go.tag='json:"operated_at"')
+}
+
+typedef string PromptLabelOperation (ts.enum="true")
+const PromptLabelOperation PromptLabelOperation_Set = "set"
+const PromptLabelOperation PromptLabelOperation_Rollback = "rollback"
+
+// 片段被其他Prompt提交版本引用的记录
+struct SnippetReference {
+    1: optional i64 prompt_id (api.js_conv="true", go.tag='json:"prompt_id"')
+    2: optional string prompt_key
+    3: optional string commit_version
+    4: optional string snippet_version
+    5: optional i64 created_at (api.js_conv="true", go.tag='json:"created_at"')
+}
+
 struct PromptDraft {
     1: optional PromptDetail detail
     2: optional DraftInfo draft_info
@@ -169,6 +206,60 @@ const VariableType VariableType_Array_Float = "array<float>"
 const VariableType VariableType_Array_Object = "array<object>"
 const VariableType VariableType_Placeholder = "placeholder"
 
+// 两个版本PromptDetail之间的结构化差异，只包含有变化的部分
+struct PromptDetailDiff {
+    1: optional list<FieldDiff> field_diffs // 模板类型、工具调用配置等字段差异
+    2: optional list<MessageDiff> message_diffs
+    3: optional list<VariableDefDiff> variable_def_diffs
+    4: optional list<ToolDiff> tool_diffs
+    5: optional list<FieldDiff> model_config_diffs
+}
+
+typedef string DiffType (ts.enum="true")
+const DiffType DiffType_Added = "added"
+const DiffType DiffType_Removed = "removed"
+const DiffType DiffType_Modified = "modified"
+
+struct MessageDiff {
+    1: optional DiffType diff_type
+    2: optional i32 base_index // 消息在基准版本中的位置，新增消息为空
+    3: optional i32 target_index // 消息在目标版本中的位置，删除消息为空
+    4: optional Message base_message
+    5: optional Message target_message
+    6: optional list<TextDiffLine> content_diff // 修改消息的文本逐行差异
+}
+
+struct TextDiffLine {
+    1: optional TextDiffOperation operation
+    2: optional string text
+}
+
+typedef string TextDiffOperation (ts.enum="true")
+const TextDiffOperation TextDiffOperation_Equal = "equal"
+const TextDiffOperation TextDiffOperation_Insert = "insert"
+const TextDiffOperation TextDiffOperation_Delete = "delete"
+
+struct VariableDefDiff {
+    1: optional DiffType diff_type
+    2: optional string key
+    3: optional VariableDef base_def
+    4: optional VariableDef target_def
+}
+
+struct ToolDiff {
+    1: optional DiffType diff_type
+    2: optional string name
+    3: optional Tool base_tool
+    4: optional Tool target_tool
+}
+
+struct FieldDiff {
+    1: optional DiffType diff_type
+    2: optional string field
+    3: optional string base_value
+    4: optional string target_value
+}
+
 struct TokenUsage {
     1: optional i64 input_tokens (api.js_conv="true", go.tag='json:"input_tokens"')
     2: optional i64 output_tokens (api.js_conv="true", go.tag='json:"output_tokens"')
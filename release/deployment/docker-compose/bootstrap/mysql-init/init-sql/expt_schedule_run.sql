@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS `expt_schedule_run`
+(
+    `id`          bigint unsigned NOT NULL COMMENT 'id',
+    `space_id`    bigint unsigned NOT NULL COMMENT '空间 id',
+    `schedule_id` bigint unsigned NOT NULL COMMENT '定时实验 id',
+    `expt_id`     bigint unsigned NOT NULL DEFAULT '0' COMMENT '触发创建的实验 id',
+    `expt_run_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '实验运行 id',
+    `status`      int             NOT NULL DEFAULT '0' COMMENT '触发状态：1-成功, 2-失败',
+    `err_msg`     blob COMMENT '错误信息',
+    `trigger_at`  timestamp       NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '触发时间',
+    `created_at`  timestamp       NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`  timestamp       NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    `deleted_at`  timestamp       NULL     DEFAULT NULL COMMENT '删除时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_space_id_schedule_id_trigger_at` (`space_id`, `schedule_id`, `trigger_at`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='定时实验触发记录表';
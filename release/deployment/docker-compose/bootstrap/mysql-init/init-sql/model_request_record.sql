@@ -11,8 +11,12 @@ CREATE TABLE IF NOT EXISTS `model_request_record`
     `model_ak`              varchar(1024)   NOT NULL DEFAULT '' COMMENT '模型的AK',
     `model_id`              varchar(256)    NOT NULL DEFAULT '' COMMENT 'model id',
     `model_name`            varchar(1024)   NOT NULL DEFAULT '' COMMENT '模型展示名称',
+    `origin_model_id`       varchar(256)    NOT NULL DEFAULT '' COMMENT '路由前请求的model id',
+    `cache_hit`             tinyint(1)      NOT NULL DEFAULT '0' COMMENT '是否命中响应缓存',
     `input_token`           bigint unsigned NOT NULL DEFAULT '0' COMMENT '输入token数量',
     `output_token`          bigint unsigned NOT NULL DEFAULT '0' COMMENT '输出token数量',
+    `cost`                  decimal(20, 8)  NOT NULL DEFAULT '0.00000000' COMMENT '按模型单价计算的成本',
+    `latency_ms`            bigint unsigned NOT NULL DEFAULT '0' COMMENT '请求耗时，单位毫秒',
     `logid`                 varchar(128)    NOT NULL DEFAULT '' COMMENT 'logid',
     `error_code`            varchar(128)    NOT NULL DEFAULT '' COMMENT 'error_code',
     `error_msg`             text COLLATE utf8mb4_general_ci COMMENT 'error_msg',
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `prompt_label`
+(
+    `id`             bigint unsigned                         NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `space_id`       bigint unsigned                         NOT NULL COMMENT '空间ID',
+    `prompt_id`      bigint unsigned                         NOT NULL COMMENT 'Prompt ID',
+    `label_key`      varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '标签',
+    `version`        varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '标签指向的提交版本',
+    `created_by`     varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建人',
+    `updated_by`     varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '更新人',
+    `created_at`     datetime                                NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`     datetime                                NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_prompt_id_label_key` (`prompt_id`, `label_key`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='Prompt发布标签表';
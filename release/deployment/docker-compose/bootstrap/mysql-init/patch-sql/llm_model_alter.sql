@@ -0,0 +1 @@
+ALTER TABLE `llm_model` ADD COLUMN `price` text COLLATE utf8mb4_general_ci COMMENT '模型单价';
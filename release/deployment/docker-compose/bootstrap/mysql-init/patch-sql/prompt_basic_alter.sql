@@ -0,0 +1 @@
+ALTER TABLE `prompt_basic` ADD COLUMN `prompt_type` varchar(64) COLLATE utf8mb4_bin NOT NULL DEFAULT 'normal' COMMENT 'Prompt type';
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS `expt_schedule`
+(
+    `id`                          bigint unsigned NOT NULL COMMENT 'id',
+    `space_id`                    bigint unsigned NOT NULL COMMENT '空间 id',
+    `name`                        varchar(255)    NOT NULL DEFAULT '' COMMENT '名称',
+    `description`                 varchar(2048)   NOT NULL DEFAULT '' COMMENT '描述',
+    `template_expt_id`            bigint unsigned NOT NULL COMMENT '模板实验 id',
+    `cron_expr`                   varchar(128)    NOT NULL DEFAULT '' COMMENT 'cron 表达式',
+    `timezone`                    varchar(64)     NOT NULL DEFAULT '' COMMENT 'cron 表达式时区',
+    `use_latest_target_version`   tinyint(1)      NOT NULL DEFAULT '0' COMMENT '是否使用评测对象最新版本',
+    `use_latest_eval_set_version` tinyint(1)      NOT NULL DEFAULT '0' COMMENT '是否使用评测集最新版本',
+    `enabled`                     tinyint(1)      NOT NULL DEFAULT '0' COMMENT '是否启用',
+    `next_trigger_at`             timestamp       NULL     DEFAULT NULL COMMENT '下次触发时间',
+    `last_trigger_at`             timestamp       NULL     DEFAULT NULL COMMENT '上次触发时间',
+    `created_by`                  varchar(128)    NOT NULL DEFAULT '' COMMENT '创建者 id',
+    `updated_by`                  varchar(128)    NOT NULL DEFAULT '' COMMENT '最后修改人 id',
+    `created_at`                  timestamp       NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`                  timestamp       NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    `deleted_at`                  timestamp       NULL     DEFAULT NULL COMMENT '删除时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_space_id_template_expt_id` (`space_id`, `template_expt_id`),
+    KEY `idx_enabled_next_trigger_at` (`enabled`, `next_trigger_at`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='定时实验表';
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS `llm_model`
+(
+    `id`               bigint unsigned                  NOT NULL COMMENT '模型ID',
+    `space_id`         bigint unsigned                  NOT NULL DEFAULT '0' COMMENT '空间ID',
+    `name`             varchar(256)                     NOT NULL DEFAULT '' COMMENT '模型展示名称',
+    `description`      varchar(1024)                    NOT NULL DEFAULT '' COMMENT '模型描述',
+    `frame`            varchar(128)                     NOT NULL DEFAULT '' COMMENT '使用的框架，如eino',
+    `protocol`         varchar(128)                     NOT NULL DEFAULT '' COMMENT '使用的协议，如ark/deepseek等',
+    `ability`          text COLLATE utf8mb4_general_ci COMMENT '模型能力',
+    `protocol_config`  text COLLATE utf8mb4_general_ci COMMENT '协议配置，加密存储',
+    `scenario_configs` text COLLATE utf8mb4_general_ci COMMENT '场景配置',
+    `param_config`     text COLLATE utf8mb4_general_ci COMMENT '参数配置',
+    `price`            text COLLATE utf8mb4_general_ci COMMENT '模型单价',
+    `created_by`       varchar(128)                     NOT NULL DEFAULT '' COMMENT '创建人',
+    `updated_by`       varchar(128)                     NOT NULL DEFAULT '' COMMENT '更新人',
+    `created_at`       datetime                         NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`       datetime                         NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    `deleted_at`       bigint                           NOT NULL DEFAULT '0' COMMENT '删除时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_space_id_deleted_at` (`space_id`, `deleted_at`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='模型配置表';
@@ -0,0 +1,4 @@
+ALTER TABLE `model_request_record` ADD COLUMN `origin_model_id` varchar(256) NOT NULL DEFAULT '' COMMENT '路由前请求的model id';
+ALTER TABLE `model_request_record` ADD COLUMN `cache_hit` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否命中响应缓存';
+ALTER TABLE `model_request_record` ADD COLUMN `cost` decimal(20, 8) NOT NULL DEFAULT '0.00000000' COMMENT '按模型单价计算的成本';
+ALTER TABLE `model_request_record` ADD COLUMN `latency_ms` bigint unsigned NOT NULL DEFAULT '0' COMMENT '请求耗时，单位毫秒';
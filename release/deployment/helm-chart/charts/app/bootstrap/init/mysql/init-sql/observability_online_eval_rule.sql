@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS `observability_online_eval_rule`
+(
+    `id`                    bigint unsigned                         NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `workspace_id`          bigint unsigned                         NOT NULL DEFAULT '0' COMMENT '空间 ID',
+    `name`                  varchar(256) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '规则名称',
+    `enabled`               tinyint(1)                              NOT NULL DEFAULT '0' COMMENT '是否启用',
+    `span_filters`          text COLLATE utf8mb4_general_ci COMMENT 'span 过滤条件',
+    `sample_rate`           double                                  NOT NULL DEFAULT '0' COMMENT '采样率',
+    `evaluator_version_ids` text COLLATE utf8mb4_general_ci COMMENT '评估器版本 ID 列表',
+    `field_mappings`        text COLLATE utf8mb4_general_ci COMMENT '字段映射',
+    `created_at`            datetime                                NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `created_by`            varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建人',
+    `updated_at`            datetime                                NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '修改时间',
+    `updated_by`            varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '修改人',
+    `is_deleted`            tinyint(1)                              NOT NULL DEFAULT '0' COMMENT '是否删除, 0 表示未删除, 1 表示已删除',
+    `deleted_at`            datetime                                         DEFAULT NULL COMMENT '删除时间',
+    `deleted_by`            varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '删除人',
+    PRIMARY KEY (`id`),
+    KEY `idx_workspace_id_enabled` (`workspace_id`, `enabled`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='在线评测规则';
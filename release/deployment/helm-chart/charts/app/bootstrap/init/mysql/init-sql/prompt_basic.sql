@@ -5,6 +5,7 @@ CREATE TABLE IF NOT EXISTS `prompt_basic`
     `prompt_key`         varchar(128) COLLATE utf8mb4_bin  NOT NULL DEFAULT '' COMMENT 'Prompt key',
     `name`               varchar(128) COLLATE utf8mb4_bin  NOT NULL DEFAULT '' COMMENT 'Prompt名称',
     `description`        varchar(1024) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT '描述',
+    `prompt_type`        varchar(64) COLLATE utf8mb4_bin   NOT NULL DEFAULT 'normal' COMMENT 'Prompt类型',
     `created_by`         varchar(128) COLLATE utf8mb4_bin  NOT NULL DEFAULT '' COMMENT '创建人',
     `updated_by`         varchar(128) COLLATE utf8mb4_bin  NOT NULL DEFAULT '' COMMENT '更新人',
 --    `commit_status`      tinyint                           NOT NULL DEFAULT '0' COMMENT '提交状态',
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `prompt_label_history`
+(
+    `id`               bigint unsigned                         NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `space_id`         bigint unsigned                         NOT NULL COMMENT '空间ID',
+    `prompt_id`        bigint unsigned                         NOT NULL COMMENT 'Prompt ID',
+    `label_key`        varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '标签',
+    `version`          varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '变更后指向的提交版本',
+    `previous_version` varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '变更前指向的提交版本',
+    `operation`        varchar(64) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '操作类型',
+    `operated_by`      varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '操作人',
+    `created_at`       datetime                                NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_prompt_id_label_key` (`prompt_id`, `label_key`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='Prompt发布标签变更历史表';
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `prompt_snippet_ref`
+(
+    `id`                bigint unsigned                         NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `space_id`          bigint unsigned                         NOT NULL COMMENT '空间ID',
+    `prompt_id`         bigint unsigned                         NOT NULL COMMENT '引用方Prompt ID',
+    `prompt_key`        varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '引用方Prompt key',
+    `version`           varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '引用方提交版本',
+    `snippet_prompt_id` bigint unsigned                         NOT NULL COMMENT '被引用的片段Prompt ID',
+    `snippet_version`   varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '引用的片段提交版本或发布标签',
+    `created_at`        datetime                                NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_prompt_id_version_snippet` (`prompt_id`, `version`, `snippet_prompt_id`, `snippet_version`),
+    KEY `idx_snippet_prompt_id` (`snippet_prompt_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='Prompt片段引用关系表';